@@ -0,0 +1,65 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by thriftrw-plugin-yarpc
+// @generated
+
+package adminservicefx
+
+import (
+	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/encoding/thrift"
+)
+
+// ServerParams defines the dependencies for the AdminService server.
+type ServerParams struct {
+	fx.In
+
+	Handler adminserviceserver.Interface
+}
+
+// ServerResult defines the output of AdminService server module. It provides the
+// procedures of a AdminService handler to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group. Dig 1.2 or newer
+// must be used for this feature to work.
+type ServerResult struct {
+	fx.Out
+
+	Procedures []transport.Procedure `group:"yarpcfx"`
+}
+
+// Server provides procedures for AdminService to an Fx application. It expects a
+// adminservicefx.Interface to be present in the container.
+//
+// 	fx.Provide(
+// 		func(h *MyAdminServiceHandler) adminserviceserver.Interface {
+// 			return h
+// 		},
+// 		adminservicefx.Server(),
+// 	)
+func Server(opts ...thrift.RegisterOption) interface{} {
+	return func(p ServerParams) ServerResult {
+		procedures := adminserviceserver.New(p.Handler, opts...)
+		return ServerResult{Procedures: procedures}
+	}
+}
@@ -221,6 +221,8 @@ func init() {
 			return true
 		case *shared.EntityNotExistsError:
 			return true
+		case *shared.AccessDeniedError:
+			return true
 		default:
 			return false
 		}
@@ -247,6 +249,11 @@ func init() {
 				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_DescribeWorkflowExecution_Result.EntityNotExistError")
 			}
 			return &WorkflowService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_DescribeWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &WorkflowService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
@@ -264,6 +271,10 @@ func init() {
 			err = result.EntityNotExistError
 			return
 		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -287,6 +298,7 @@ type WorkflowService_DescribeWorkflowExecution_Result struct {
 	BadRequestError      *shared.BadRequestError                   `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError              `json:"internalServiceError,omitempty"`
 	EntityNotExistError  *shared.EntityNotExistsError              `json:"entityNotExistError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError                 `json:"accessDeniedError,omitempty"`
 }
 
 // ToWire translates a WorkflowService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
@@ -306,7 +318,7 @@ type WorkflowService_DescribeWorkflowExecution_Result struct {
 //   }
 func (v *WorkflowService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -344,6 +356,14 @@ func (v *WorkflowService_DescribeWorkflowExecution_Result) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
 	if i != 1 {
 		return wire.Value{}, fmt.Errorf("WorkflowService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
@@ -411,6 +431,14 @@ func (v *WorkflowService_DescribeWorkflowExecution_Result) FromWire(w wire.Value
 					return err
 				}
 
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -428,6 +456,9 @@ func (v *WorkflowService_DescribeWorkflowExecution_Result) FromWire(w wire.Value
 	if v.EntityNotExistError != nil {
 		count++
 	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
 	if count != 1 {
 		return fmt.Errorf("WorkflowService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
 	}
@@ -442,7 +473,7 @@ func (v *WorkflowService_DescribeWorkflowExecution_Result) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -460,6 +491,10 @@ func (v *WorkflowService_DescribeWorkflowExecution_Result) String() string {
 		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
 }
@@ -481,6 +516,9 @@ func (v *WorkflowService_DescribeWorkflowExecution_Result) Equals(rhs *WorkflowS
 	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
 
 	return true
 }
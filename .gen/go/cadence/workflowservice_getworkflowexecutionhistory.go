@@ -223,6 +223,8 @@ func init() {
 			return true
 		case *shared.ServiceBusyError:
 			return true
+		case *shared.AccessDeniedError:
+			return true
 		default:
 			return false
 		}
@@ -254,6 +256,11 @@ func init() {
 				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_GetWorkflowExecutionHistory_Result.ServiceBusyError")
 			}
 			return &WorkflowService_GetWorkflowExecutionHistory_Result{ServiceBusyError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_GetWorkflowExecutionHistory_Result.AccessDeniedError")
+			}
+			return &WorkflowService_GetWorkflowExecutionHistory_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
@@ -275,6 +282,10 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -299,6 +310,7 @@ type WorkflowService_GetWorkflowExecutionHistory_Result struct {
 	InternalServiceError *shared.InternalServiceError                `json:"internalServiceError,omitempty"`
 	EntityNotExistError  *shared.EntityNotExistsError                `json:"entityNotExistError,omitempty"`
 	ServiceBusyError     *shared.ServiceBusyError                    `json:"serviceBusyError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError                   `json:"accessDeniedError,omitempty"`
 }
 
 // ToWire translates a WorkflowService_GetWorkflowExecutionHistory_Result struct into a Thrift-level intermediate
@@ -318,7 +330,7 @@ type WorkflowService_GetWorkflowExecutionHistory_Result struct {
 //   }
 func (v *WorkflowService_GetWorkflowExecutionHistory_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -364,6 +376,14 @@ func (v *WorkflowService_GetWorkflowExecutionHistory_Result) ToWire() (wire.Valu
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 
 	if i != 1 {
 		return wire.Value{}, fmt.Errorf("WorkflowService_GetWorkflowExecutionHistory_Result should have exactly one field: got %v fields", i)
@@ -378,12 +398,6 @@ func _GetWorkflowExecutionHistoryResponse_Read(w wire.Value) (*shared.GetWorkflo
 	return &v, err
 }
 
-func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
-	err := v.FromWire(w)
-	return &v, err
-}
-
 // FromWire deserializes a WorkflowService_GetWorkflowExecutionHistory_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -445,6 +459,14 @@ func (v *WorkflowService_GetWorkflowExecutionHistory_Result) FromWire(w wire.Val
 					return err
 				}
 
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -465,6 +487,9 @@ func (v *WorkflowService_GetWorkflowExecutionHistory_Result) FromWire(w wire.Val
 	if v.ServiceBusyError != nil {
 		count++
 	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
 	if count != 1 {
 		return fmt.Errorf("WorkflowService_GetWorkflowExecutionHistory_Result should have exactly one field: got %v fields", count)
 	}
@@ -479,7 +504,7 @@ func (v *WorkflowService_GetWorkflowExecutionHistory_Result) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -501,6 +526,10 @@ func (v *WorkflowService_GetWorkflowExecutionHistory_Result) String() string {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowService_GetWorkflowExecutionHistory_Result{%v}", strings.Join(fields[:i], ", "))
 }
@@ -525,6 +554,9 @@ func (v *WorkflowService_GetWorkflowExecutionHistory_Result) Equals(rhs *Workflo
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
 
 	return true
 }
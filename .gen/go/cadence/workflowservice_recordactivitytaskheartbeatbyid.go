@@ -223,6 +223,8 @@ func init() {
 			return true
 		case *shared.DomainNotActiveError:
 			return true
+		case *shared.AccessDeniedError:
+			return true
 		default:
 			return false
 		}
@@ -254,6 +256,11 @@ func init() {
 				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_RecordActivityTaskHeartbeatByID_Result.DomainNotActiveError")
 			}
 			return &WorkflowService_RecordActivityTaskHeartbeatByID_Result{DomainNotActiveError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_RecordActivityTaskHeartbeatByID_Result.AccessDeniedError")
+			}
+			return &WorkflowService_RecordActivityTaskHeartbeatByID_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
@@ -275,6 +282,10 @@ func init() {
 			err = result.DomainNotActiveError
 			return
 		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -299,6 +310,7 @@ type WorkflowService_RecordActivityTaskHeartbeatByID_Result struct {
 	InternalServiceError *shared.InternalServiceError                `json:"internalServiceError,omitempty"`
 	EntityNotExistError  *shared.EntityNotExistsError                `json:"entityNotExistError,omitempty"`
 	DomainNotActiveError *shared.DomainNotActiveError                `json:"domainNotActiveError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError                   `json:"accessDeniedError,omitempty"`
 }
 
 // ToWire translates a WorkflowService_RecordActivityTaskHeartbeatByID_Result struct into a Thrift-level intermediate
@@ -318,7 +330,7 @@ type WorkflowService_RecordActivityTaskHeartbeatByID_Result struct {
 //   }
 func (v *WorkflowService_RecordActivityTaskHeartbeatByID_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -364,6 +376,14 @@ func (v *WorkflowService_RecordActivityTaskHeartbeatByID_Result) ToWire() (wire.
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 
 	if i != 1 {
 		return wire.Value{}, fmt.Errorf("WorkflowService_RecordActivityTaskHeartbeatByID_Result should have exactly one field: got %v fields", i)
@@ -433,6 +453,14 @@ func (v *WorkflowService_RecordActivityTaskHeartbeatByID_Result) FromWire(w wire
 					return err
 				}
 
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -453,6 +481,9 @@ func (v *WorkflowService_RecordActivityTaskHeartbeatByID_Result) FromWire(w wire
 	if v.DomainNotActiveError != nil {
 		count++
 	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
 	if count != 1 {
 		return fmt.Errorf("WorkflowService_RecordActivityTaskHeartbeatByID_Result should have exactly one field: got %v fields", count)
 	}
@@ -467,7 +498,7 @@ func (v *WorkflowService_RecordActivityTaskHeartbeatByID_Result) String() string
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -489,6 +520,10 @@ func (v *WorkflowService_RecordActivityTaskHeartbeatByID_Result) String() string
 		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowService_RecordActivityTaskHeartbeatByID_Result{%v}", strings.Join(fields[:i], ", "))
 }
@@ -513,6 +548,9 @@ func (v *WorkflowService_RecordActivityTaskHeartbeatByID_Result) Equals(rhs *Wor
 	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
 
 	return true
 }
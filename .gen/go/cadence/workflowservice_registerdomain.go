@@ -222,6 +222,8 @@ func init() {
 			return true
 		case *shared.DomainAlreadyExistsError:
 			return true
+		case *shared.AccessDeniedError:
+			return true
 		default:
 			return false
 		}
@@ -248,6 +250,11 @@ func init() {
 				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_RegisterDomain_Result.DomainExistsError")
 			}
 			return &WorkflowService_RegisterDomain_Result{DomainExistsError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_RegisterDomain_Result.AccessDeniedError")
+			}
+			return &WorkflowService_RegisterDomain_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
@@ -265,6 +272,10 @@ func init() {
 			err = result.DomainExistsError
 			return
 		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
 		return
 	}
 
@@ -277,6 +288,7 @@ type WorkflowService_RegisterDomain_Result struct {
 	BadRequestError      *shared.BadRequestError          `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError     `json:"internalServiceError,omitempty"`
 	DomainExistsError    *shared.DomainAlreadyExistsError `json:"domainExistsError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError        `json:"accessDeniedError,omitempty"`
 }
 
 // ToWire translates a WorkflowService_RegisterDomain_Result struct into a Thrift-level intermediate
@@ -296,7 +308,7 @@ type WorkflowService_RegisterDomain_Result struct {
 //   }
 func (v *WorkflowService_RegisterDomain_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -326,6 +338,14 @@ func (v *WorkflowService_RegisterDomain_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
 	if i > 1 {
 		return wire.Value{}, fmt.Errorf("WorkflowService_RegisterDomain_Result should have at most one field: got %v fields", i)
@@ -385,6 +405,14 @@ func (v *WorkflowService_RegisterDomain_Result) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -399,6 +427,9 @@ func (v *WorkflowService_RegisterDomain_Result) FromWire(w wire.Value) error {
 	if v.DomainExistsError != nil {
 		count++
 	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
 	if count > 1 {
 		return fmt.Errorf("WorkflowService_RegisterDomain_Result should have at most one field: got %v fields", count)
 	}
@@ -413,7 +444,7 @@ func (v *WorkflowService_RegisterDomain_Result) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -427,6 +458,10 @@ func (v *WorkflowService_RegisterDomain_Result) String() string {
 		fields[i] = fmt.Sprintf("DomainExistsError: %v", v.DomainExistsError)
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowService_RegisterDomain_Result{%v}", strings.Join(fields[:i], ", "))
 }
@@ -445,6 +480,9 @@ func (v *WorkflowService_RegisterDomain_Result) Equals(rhs *WorkflowService_Regi
 	if !((v.DomainExistsError == nil && rhs.DomainExistsError == nil) || (v.DomainExistsError != nil && rhs.DomainExistsError != nil && v.DomainExistsError.Equals(rhs.DomainExistsError))) {
 		return false
 	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
 
 	return true
 }
@@ -228,6 +228,8 @@ func init() {
 			return true
 		case *shared.DomainNotActiveError:
 			return true
+		case *shared.AccessDeniedError:
+			return true
 		default:
 			return false
 		}
@@ -269,6 +271,11 @@ func init() {
 				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_RequestCancelWorkflowExecution_Result.DomainNotActiveError")
 			}
 			return &WorkflowService_RequestCancelWorkflowExecution_Result{DomainNotActiveError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_RequestCancelWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &WorkflowService_RequestCancelWorkflowExecution_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
@@ -298,6 +305,10 @@ func init() {
 			err = result.DomainNotActiveError
 			return
 		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
 		return
 	}
 
@@ -313,6 +324,7 @@ type WorkflowService_RequestCancelWorkflowExecution_Result struct {
 	CancellationAlreadyRequestedError *shared.CancellationAlreadyRequestedError `json:"cancellationAlreadyRequestedError,omitempty"`
 	ServiceBusyError                  *shared.ServiceBusyError                  `json:"serviceBusyError,omitempty"`
 	DomainNotActiveError              *shared.DomainNotActiveError              `json:"domainNotActiveError,omitempty"`
+	AccessDeniedError                 *shared.AccessDeniedError                 `json:"accessDeniedError,omitempty"`
 }
 
 // ToWire translates a WorkflowService_RequestCancelWorkflowExecution_Result struct into a Thrift-level intermediate
@@ -332,7 +344,7 @@ type WorkflowService_RequestCancelWorkflowExecution_Result struct {
 //   }
 func (v *WorkflowService_RequestCancelWorkflowExecution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -386,6 +398,14 @@ func (v *WorkflowService_RequestCancelWorkflowExecution_Result) ToWire() (wire.V
 		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
 
 	if i > 1 {
 		return wire.Value{}, fmt.Errorf("WorkflowService_RequestCancelWorkflowExecution_Result should have at most one field: got %v fields", i)
@@ -469,6 +489,14 @@ func (v *WorkflowService_RequestCancelWorkflowExecution_Result) FromWire(w wire.
 					return err
 				}
 
+			}
+		case 7:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -492,6 +520,9 @@ func (v *WorkflowService_RequestCancelWorkflowExecution_Result) FromWire(w wire.
 	if v.DomainNotActiveError != nil {
 		count++
 	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
 	if count > 1 {
 		return fmt.Errorf("WorkflowService_RequestCancelWorkflowExecution_Result should have at most one field: got %v fields", count)
 	}
@@ -506,7 +537,7 @@ func (v *WorkflowService_RequestCancelWorkflowExecution_Result) String() string
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -532,6 +563,10 @@ func (v *WorkflowService_RequestCancelWorkflowExecution_Result) String() string
 		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowService_RequestCancelWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
 }
@@ -559,6 +594,9 @@ func (v *WorkflowService_RequestCancelWorkflowExecution_Result) Equals(rhs *Work
 	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
 
 	return true
 }
@@ -225,6 +225,8 @@ func init() {
 			return true
 		case *shared.DomainNotActiveError:
 			return true
+		case *shared.AccessDeniedError:
+			return true
 		default:
 			return false
 		}
@@ -261,6 +263,11 @@ func init() {
 				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWithStartWorkflowExecution_Result.DomainNotActiveError")
 			}
 			return &WorkflowService_SignalWithStartWorkflowExecution_Result{DomainNotActiveError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for WorkflowService_SignalWithStartWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &WorkflowService_SignalWithStartWorkflowExecution_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
@@ -286,6 +293,10 @@ func init() {
 			err = result.DomainNotActiveError
 			return
 		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -311,6 +322,7 @@ type WorkflowService_SignalWithStartWorkflowExecution_Result struct {
 	EntityNotExistError  *shared.EntityNotExistsError           `json:"entityNotExistError,omitempty"`
 	ServiceBusyError     *shared.ServiceBusyError               `json:"serviceBusyError,omitempty"`
 	DomainNotActiveError *shared.DomainNotActiveError           `json:"domainNotActiveError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError              `json:"accessDeniedError,omitempty"`
 }
 
 // ToWire translates a WorkflowService_SignalWithStartWorkflowExecution_Result struct into a Thrift-level intermediate
@@ -330,7 +342,7 @@ type WorkflowService_SignalWithStartWorkflowExecution_Result struct {
 //   }
 func (v *WorkflowService_SignalWithStartWorkflowExecution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -384,6 +396,14 @@ func (v *WorkflowService_SignalWithStartWorkflowExecution_Result) ToWire() (wire
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
 
 	if i != 1 {
 		return wire.Value{}, fmt.Errorf("WorkflowService_SignalWithStartWorkflowExecution_Result should have exactly one field: got %v fields", i)
@@ -467,6 +487,14 @@ func (v *WorkflowService_SignalWithStartWorkflowExecution_Result) FromWire(w wir
 					return err
 				}
 
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -490,6 +518,9 @@ func (v *WorkflowService_SignalWithStartWorkflowExecution_Result) FromWire(w wir
 	if v.DomainNotActiveError != nil {
 		count++
 	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
 	if count != 1 {
 		return fmt.Errorf("WorkflowService_SignalWithStartWorkflowExecution_Result should have exactly one field: got %v fields", count)
 	}
@@ -504,7 +535,7 @@ func (v *WorkflowService_SignalWithStartWorkflowExecution_Result) String() strin
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -530,6 +561,10 @@ func (v *WorkflowService_SignalWithStartWorkflowExecution_Result) String() strin
 		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowService_SignalWithStartWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
 }
@@ -557,6 +592,9 @@ func (v *WorkflowService_SignalWithStartWorkflowExecution_Result) Equals(rhs *Wo
 	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
 		return false
 	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
 
 	return true
 }
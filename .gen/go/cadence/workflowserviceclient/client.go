@@ -36,6 +36,12 @@ import (
 
 // Interface is a client for the WorkflowService service.
 type Interface interface {
+	CountWorkflowExecutions(
+		ctx context.Context,
+		CountRequest *shared.CountWorkflowExecutionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.CountWorkflowExecutionsResponse, error)
+
 	DeprecateDomain(
 		ctx context.Context,
 		DeprecateRequest *shared.DeprecateDomainRequest,
@@ -78,6 +84,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListOpenWorkflowExecutionsResponse, error)
 
+	ListWorkflowExecutions(
+		ctx context.Context,
+		ListRequest *shared.ListWorkflowExecutionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListWorkflowExecutionsResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -120,6 +132,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetWorkflowExecution(
+		ctx context.Context,
+		ResetRequest *shared.ResetWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ResetWorkflowExecutionResponse, error)
+
 	RespondActivityTaskCanceled(
 		ctx context.Context,
 		CanceledRequest *shared.RespondActivityTaskCanceledRequest,
@@ -229,6 +247,29 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) CountWorkflowExecutions(
+	ctx context.Context,
+	_CountRequest *shared.CountWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.CountWorkflowExecutionsResponse, err error) {
+
+	args := cadence.WorkflowService_CountWorkflowExecutions_Helper.Args(_CountRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_CountWorkflowExecutions_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_CountWorkflowExecutions_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DeprecateDomain(
 	ctx context.Context,
 	_DeprecateRequest *shared.DeprecateDomainRequest,
@@ -390,6 +431,29 @@ func (c client) ListOpenWorkflowExecutions(
 	return
 }
 
+func (c client) ListWorkflowExecutions(
+	ctx context.Context,
+	_ListRequest *shared.ListWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListWorkflowExecutionsResponse, err error) {
+
+	args := cadence.WorkflowService_ListWorkflowExecutions_Helper.Args(_ListRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_ListWorkflowExecutions_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_ListWorkflowExecutions_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *shared.PollForActivityTaskRequest,
@@ -551,6 +615,29 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) ResetWorkflowExecution(
+	ctx context.Context,
+	_ResetRequest *shared.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ResetWorkflowExecutionResponse, err error) {
+
+	args := cadence.WorkflowService_ResetWorkflowExecution_Helper.Args(_ResetRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_ResetWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_ResetWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondActivityTaskCanceled(
 	ctx context.Context,
 	_CanceledRequest *shared.RespondActivityTaskCanceledRequest,
@@ -36,18 +36,54 @@ import (
 
 // Interface is a client for the HistoryService service.
 type Interface interface {
+	DescribeHistoryHost(
+		ctx context.Context,
+		Request *shared.DescribeHistoryHostRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeHistoryHostResponse, error)
+
+	DescribeMutableState(
+		ctx context.Context,
+		Request *history.DescribeMutableStateRequest,
+		opts ...yarpc.CallOption,
+	) (*history.DescribeMutableStateResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	GetDomainReplicationLag(
+		ctx context.Context,
+		Request *history.GetDomainReplicationLagRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetDomainReplicationLagResponse, error)
+
 	GetMutableState(
 		ctx context.Context,
 		GetRequest *history.GetMutableStateRequest,
 		opts ...yarpc.CallOption,
 	) (*history.GetMutableStateResponse, error)
 
+	GetReplicationMessages(
+		ctx context.Context,
+		Request *history.GetReplicationMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetReplicationMessagesResponse, error)
+
+	GetReplicationStatus(
+		ctx context.Context,
+		Request *history.GetReplicationStatusRequest,
+		opts ...yarpc.CallOption,
+	) (*history.ShardReplicationStatus, error)
+
+	GetWorkflowReplicationTasks(
+		ctx context.Context,
+		Request *history.GetWorkflowReplicationTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetWorkflowReplicationTasksResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -96,6 +132,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.ResetStickyTaskListResponse, error)
 
+	ResetWorkflowExecution(
+		ctx context.Context,
+		ResetRequest *history.ResetWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ResetWorkflowExecutionResponse, error)
+
 	RespondActivityTaskCanceled(
 		ctx context.Context,
 		CanceledRequest *history.RespondActivityTaskCanceledRequest,
@@ -181,6 +223,52 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) DescribeHistoryHost(
+	ctx context.Context,
+	_Request *shared.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeHistoryHostResponse, err error) {
+
+	args := history.HistoryService_DescribeHistoryHost_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DescribeHistoryHost_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DescribeHistoryHost_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeMutableState(
+	ctx context.Context,
+	_Request *history.DescribeMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (success *history.DescribeMutableStateResponse, err error) {
+
+	args := history.HistoryService_DescribeMutableState_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DescribeMutableState_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DescribeMutableState_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -204,6 +292,29 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) GetDomainReplicationLag(
+	ctx context.Context,
+	_Request *history.GetDomainReplicationLagRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetDomainReplicationLagResponse, err error) {
+
+	args := history.HistoryService_GetDomainReplicationLag_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetDomainReplicationLag_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetDomainReplicationLag_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetMutableState(
 	ctx context.Context,
 	_GetRequest *history.GetMutableStateRequest,
@@ -227,6 +338,75 @@ func (c client) GetMutableState(
 	return
 }
 
+func (c client) GetReplicationMessages(
+	ctx context.Context,
+	_Request *history.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetReplicationMessagesResponse, err error) {
+
+	args := history.HistoryService_GetReplicationMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetReplicationMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetReplicationMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetReplicationStatus(
+	ctx context.Context,
+	_Request *history.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption,
+) (success *history.ShardReplicationStatus, err error) {
+
+	args := history.HistoryService_GetReplicationStatus_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetReplicationStatus_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetReplicationStatus_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetWorkflowReplicationTasks(
+	ctx context.Context,
+	_Request *history.GetWorkflowReplicationTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetWorkflowReplicationTasksResponse, err error) {
+
+	args := history.HistoryService_GetWorkflowReplicationTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_GetWorkflowReplicationTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_GetWorkflowReplicationTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -411,6 +591,29 @@ func (c client) ResetStickyTaskList(
 	return
 }
 
+func (c client) ResetWorkflowExecution(
+	ctx context.Context,
+	_ResetRequest *history.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ResetWorkflowExecutionResponse, err error) {
+
+	args := history.HistoryService_ResetWorkflowExecution_Helper.Args(_ResetRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ResetWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ResetWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondActivityTaskCanceled(
 	ctx context.Context,
 	_CanceledRequest *history.RespondActivityTaskCanceledRequest,
@@ -31,12 +31,14 @@ import (
 	"strings"
 )
 
-type DescribeWorkflowExecutionRequest struct {
-	DomainUUID *string                                  `json:"domainUUID,omitempty"`
-	Request    *shared.DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+type ClusterReplicationStatus struct {
+	ReplicationAckLevel     *int64 `json:"replicationAckLevel,omitempty"`
+	TransferAckLevel        *int64 `json:"transferAckLevel,omitempty"`
+	TimerAckLevel           *int64 `json:"timerAckLevel,omitempty"`
+	LastReplicatedTimestamp *int64 `json:"lastReplicatedTimestamp,omitempty"`
 }
 
-// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a ClusterReplicationStatus struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -51,45 +53,55 @@ type DescribeWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *ClusterReplicationStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.ReplicationAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.ReplicationAckLevel)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Request != nil {
-		w, err = v.Request.ToWire()
+	if v.TransferAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.TransferAckLevel)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.TimerAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.TimerAckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.LastReplicatedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastReplicatedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*shared.DescribeWorkflowExecutionRequest, error) {
-	var v shared.DescribeWorkflowExecutionRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a ClusterReplicationStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a ClusterReplicationStatus struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -97,29 +109,51 @@ func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*shared.DescribeWorkf
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionRequest
+//   var v ClusterReplicationStatus
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *ClusterReplicationStatus) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReplicationAckLevel = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TransferAckLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TimerAckLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastReplicatedTimestamp = &x
 				if err != nil {
 					return err
 				}
@@ -131,179 +165,102 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// String returns a readable string representation of a ClusterReplicationStatus
 // struct.
-func (v *DescribeWorkflowExecutionRequest) String() string {
+func (v *ClusterReplicationStatus) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.ReplicationAckLevel != nil {
+		fields[i] = fmt.Sprintf("ReplicationAckLevel: %v", *(v.ReplicationAckLevel))
 		i++
 	}
-	if v.Request != nil {
-		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+	if v.TransferAckLevel != nil {
+		fields[i] = fmt.Sprintf("TransferAckLevel: %v", *(v.TransferAckLevel))
 		i++
 	}
-
-	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	if v.TimerAckLevel != nil {
+		fields[i] = fmt.Sprintf("TimerAckLevel: %v", *(v.TimerAckLevel))
+		i++
 	}
-	return lhs == nil && rhs == nil
+	if v.LastReplicatedTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastReplicatedTimestamp: %v", *(v.LastReplicatedTimestamp))
+		i++
+	}
+
+	return fmt.Sprintf("ClusterReplicationStatus{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
-// provided DescribeWorkflowExecutionRequest.
+// Equals returns true if all the fields of this ClusterReplicationStatus match the
+// provided ClusterReplicationStatus.
 //
 // This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+func (v *ClusterReplicationStatus) Equals(rhs *ClusterReplicationStatus) bool {
+	if !_I64_EqualsPtr(v.ReplicationAckLevel, rhs.ReplicationAckLevel) {
 		return false
 	}
-	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+	if !_I64_EqualsPtr(v.TransferAckLevel, rhs.TransferAckLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TimerAckLevel, rhs.TimerAckLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastReplicatedTimestamp, rhs.LastReplicatedTimestamp) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetReplicationAckLevel returns the value of ReplicationAckLevel if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetDomainUUID() (o string) {
-	if v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *ClusterReplicationStatus) GetReplicationAckLevel() (o int64) {
+	if v.ReplicationAckLevel != nil {
+		return *v.ReplicationAckLevel
 	}
 
 	return
 }
 
-type EventAlreadyStartedError struct {
-	Message string `json:"message,required"`
-}
-
-// ToWire translates a EventAlreadyStartedError struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *EventAlreadyStartedError) ToWire() (wire.Value, error) {
-	var (
-		fields [1]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a EventAlreadyStartedError struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a EventAlreadyStartedError struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v EventAlreadyStartedError
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *EventAlreadyStartedError) FromWire(w wire.Value) error {
-	var err error
-
-	messageIsSet := false
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				messageIsSet = true
-			}
-		}
-	}
-
-	if !messageIsSet {
-		return errors.New("field Message of EventAlreadyStartedError is required")
+// GetTransferAckLevel returns the value of TransferAckLevel if it is set or its
+// zero value if it is unset.
+func (v *ClusterReplicationStatus) GetTransferAckLevel() (o int64) {
+	if v.TransferAckLevel != nil {
+		return *v.TransferAckLevel
 	}
 
-	return nil
+	return
 }
 
-// String returns a readable string representation of a EventAlreadyStartedError
-// struct.
-func (v *EventAlreadyStartedError) String() string {
-	if v == nil {
-		return "<nil>"
+// GetTimerAckLevel returns the value of TimerAckLevel if it is set or its
+// zero value if it is unset.
+func (v *ClusterReplicationStatus) GetTimerAckLevel() (o int64) {
+	if v.TimerAckLevel != nil {
+		return *v.TimerAckLevel
 	}
 
-	var fields [1]string
-	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-
-	return fmt.Sprintf("EventAlreadyStartedError{%v}", strings.Join(fields[:i], ", "))
+	return
 }
 
-// Equals returns true if all the fields of this EventAlreadyStartedError match the
-// provided EventAlreadyStartedError.
-//
-// This function performs a deep comparison.
-func (v *EventAlreadyStartedError) Equals(rhs *EventAlreadyStartedError) bool {
-	if !(v.Message == rhs.Message) {
-		return false
+// GetLastReplicatedTimestamp returns the value of LastReplicatedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *ClusterReplicationStatus) GetLastReplicatedTimestamp() (o int64) {
+	if v.LastReplicatedTimestamp != nil {
+		return *v.LastReplicatedTimestamp
 	}
 
-	return true
-}
-
-func (v *EventAlreadyStartedError) Error() string {
-	return v.String()
+	return
 }
 
-type GetMutableStateRequest struct {
-	DomainUUID          *string                   `json:"domainUUID,omitempty"`
-	Execution           *shared.WorkflowExecution `json:"execution,omitempty"`
-	ExpectedNextEventId *int64                    `json:"expectedNextEventId,omitempty"`
+type DescribeMutableStateRequest struct {
+	DomainUUID *string                   `json:"domainUUID,omitempty"`
+	Execution  *shared.WorkflowExecution `json:"execution,omitempty"`
 }
 
-// ToWire translates a GetMutableStateRequest struct into a Thrift-level intermediate
+// ToWire translates a DescribeMutableStateRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -318,9 +275,9 @@ type GetMutableStateRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetMutableStateRequest) ToWire() (wire.Value, error) {
+func (v *DescribeMutableStateRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -342,14 +299,6 @@ func (v *GetMutableStateRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ExpectedNextEventId != nil {
-		w, err = wire.NewValueI64(*(v.ExpectedNextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -360,11 +309,11 @@ func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
 	return &v, err
 }
 
-// FromWire deserializes a GetMutableStateRequest struct from its Thrift-level
+// FromWire deserializes a DescribeMutableStateRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetMutableStateRequest struct
+// An error is returned if we were unable to build a DescribeMutableStateRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -372,12 +321,12 @@ func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
 //     return nil, err
 //   }
 //
-//   var v GetMutableStateRequest
+//   var v DescribeMutableStateRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetMutableStateRequest) FromWire(w wire.Value) error {
+func (v *DescribeMutableStateRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -399,16 +348,6 @@ func (v *GetMutableStateRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ExpectedNextEventId = &x
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
@@ -416,14 +355,14 @@ func (v *GetMutableStateRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetMutableStateRequest
+// String returns a readable string representation of a DescribeMutableStateRequest
 // struct.
-func (v *GetMutableStateRequest) String() string {
+func (v *DescribeMutableStateRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -433,15 +372,11 @@ func (v *GetMutableStateRequest) String() string {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
-	if v.ExpectedNextEventId != nil {
-		fields[i] = fmt.Sprintf("ExpectedNextEventId: %v", *(v.ExpectedNextEventId))
-		i++
-	}
 
-	return fmt.Sprintf("GetMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
+func _String_EqualsPtr(lhs, rhs *string) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
@@ -451,27 +386,24 @@ func _I64_EqualsPtr(lhs, rhs *int64) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this GetMutableStateRequest match the
-// provided GetMutableStateRequest.
+// Equals returns true if all the fields of this DescribeMutableStateRequest match the
+// provided DescribeMutableStateRequest.
 //
 // This function performs a deep comparison.
-func (v *GetMutableStateRequest) Equals(rhs *GetMutableStateRequest) bool {
+func (v *DescribeMutableStateRequest) Equals(rhs *DescribeMutableStateRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
 	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ExpectedNextEventId, rhs.ExpectedNextEventId) {
-		return false
-	}
 
 	return true
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *GetMutableStateRequest) GetDomainUUID() (o string) {
+func (v *DescribeMutableStateRequest) GetDomainUUID() (o string) {
 	if v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -479,31 +411,12 @@ func (v *GetMutableStateRequest) GetDomainUUID() (o string) {
 	return
 }
 
-// GetExpectedNextEventId returns the value of ExpectedNextEventId if it is set or its
-// zero value if it is unset.
-func (v *GetMutableStateRequest) GetExpectedNextEventId() (o int64) {
-	if v.ExpectedNextEventId != nil {
-		return *v.ExpectedNextEventId
-	}
-
-	return
-}
-
-type GetMutableStateResponse struct {
-	Execution                            *shared.WorkflowExecution `json:"execution,omitempty"`
-	WorkflowType                         *shared.WorkflowType      `json:"workflowType,omitempty"`
-	NextEventId                          *int64                    `json:"NextEventId,omitempty"`
-	LastFirstEventId                     *int64                    `json:"LastFirstEventId,omitempty"`
-	TaskList                             *shared.TaskList          `json:"taskList,omitempty"`
-	StickyTaskList                       *shared.TaskList          `json:"stickyTaskList,omitempty"`
-	ClientLibraryVersion                 *string                   `json:"clientLibraryVersion,omitempty"`
-	ClientFeatureVersion                 *string                   `json:"clientFeatureVersion,omitempty"`
-	ClientImpl                           *string                   `json:"clientImpl,omitempty"`
-	IsWorkflowRunning                    *bool                     `json:"isWorkflowRunning,omitempty"`
-	StickyTaskListScheduleToStartTimeout *int32                    `json:"stickyTaskListScheduleToStartTimeout,omitempty"`
+type DescribeMutableStateResponse struct {
+	MutableStateInCache    *string `json:"mutableStateInCache,omitempty"`
+	MutableStateInDatabase *string `json:"mutableStateInDatabase,omitempty"`
 }
 
-// ToWire translates a GetMutableStateResponse struct into a Thrift-level intermediate
+// ToWire translates a DescribeMutableStateResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -518,123 +431,39 @@ type GetMutableStateResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetMutableStateResponse) ToWire() (wire.Value, error) {
+func (v *DescribeMutableStateResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+	if v.MutableStateInCache != nil {
+		w, err = wire.NewValueString(*(v.MutableStateInCache)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.LastFirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.LastFirstEventId)), error(nil)
+	if v.MutableStateInDatabase != nil {
+		w, err = wire.NewValueString(*(v.MutableStateInDatabase)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.StickyTaskList != nil {
-		w, err = v.StickyTaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.ClientLibraryVersion != nil {
-		w, err = wire.NewValueString(*(v.ClientLibraryVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.ClientFeatureVersion != nil {
-		w, err = wire.NewValueString(*(v.ClientFeatureVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.ClientImpl != nil {
-		w, err = wire.NewValueString(*(v.ClientImpl)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.IsWorkflowRunning != nil {
-		w, err = wire.NewValueBool(*(v.IsWorkflowRunning)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.StickyTaskListScheduleToStartTimeout != nil {
-		w, err = wire.NewValueI32(*(v.StickyTaskListScheduleToStartTimeout)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowType_Read(w wire.Value) (*shared.WorkflowType, error) {
-	var v shared.WorkflowType
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
-	var v shared.TaskList
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a GetMutableStateResponse struct from its Thrift-level
+// FromWire deserializes a DescribeMutableStateResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetMutableStateResponse struct
+// An error is returned if we were unable to build a DescribeMutableStateResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -642,113 +471,31 @@ func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
 //     return nil, err
 //   }
 //
-//   var v GetMutableStateResponse
+//   var v DescribeMutableStateResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetMutableStateResponse) FromWire(w wire.Value) error {
+func (v *DescribeMutableStateResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastFirstEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.StickyTaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ClientLibraryVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ClientFeatureVersion = &x
+				v.MutableStateInCache = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 90:
+		case 40:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ClientImpl = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 100:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.IsWorkflowRunning = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.StickyTaskListScheduleToStartTimeout = &x
+				v.MutableStateInDatabase = &x
 				if err != nil {
 					return err
 				}
@@ -760,193 +507,2264 @@ func (v *GetMutableStateResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetMutableStateResponse
+// String returns a readable string representation of a DescribeMutableStateResponse
 // struct.
-func (v *GetMutableStateResponse) String() string {
+func (v *DescribeMutableStateResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [2]string
 	i := 0
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
-	}
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.LastFirstEventId != nil {
-		fields[i] = fmt.Sprintf("LastFirstEventId: %v", *(v.LastFirstEventId))
-		i++
-	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
-		i++
-	}
-	if v.StickyTaskList != nil {
-		fields[i] = fmt.Sprintf("StickyTaskList: %v", v.StickyTaskList)
-		i++
-	}
-	if v.ClientLibraryVersion != nil {
-		fields[i] = fmt.Sprintf("ClientLibraryVersion: %v", *(v.ClientLibraryVersion))
-		i++
-	}
-	if v.ClientFeatureVersion != nil {
-		fields[i] = fmt.Sprintf("ClientFeatureVersion: %v", *(v.ClientFeatureVersion))
+	if v.MutableStateInCache != nil {
+		fields[i] = fmt.Sprintf("MutableStateInCache: %v", *(v.MutableStateInCache))
 		i++
 	}
-	if v.ClientImpl != nil {
-		fields[i] = fmt.Sprintf("ClientImpl: %v", *(v.ClientImpl))
+	if v.MutableStateInDatabase != nil {
+		fields[i] = fmt.Sprintf("MutableStateInDatabase: %v", *(v.MutableStateInDatabase))
 		i++
 	}
-	if v.IsWorkflowRunning != nil {
-		fields[i] = fmt.Sprintf("IsWorkflowRunning: %v", *(v.IsWorkflowRunning))
-		i++
+
+	return fmt.Sprintf("DescribeMutableStateResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeMutableStateResponse match the
+// provided DescribeMutableStateResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeMutableStateResponse) Equals(rhs *DescribeMutableStateResponse) bool {
+	if !_String_EqualsPtr(v.MutableStateInCache, rhs.MutableStateInCache) {
+		return false
 	}
-	if v.StickyTaskListScheduleToStartTimeout != nil {
-		fields[i] = fmt.Sprintf("StickyTaskListScheduleToStartTimeout: %v", *(v.StickyTaskListScheduleToStartTimeout))
-		i++
+	if !_String_EqualsPtr(v.MutableStateInDatabase, rhs.MutableStateInDatabase) {
+		return false
 	}
 
-	return fmt.Sprintf("GetMutableStateResponse{%v}", strings.Join(fields[:i], ", "))
+	return true
 }
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
+// GetMutableStateInCache returns the value of MutableStateInCache if it is set or its
+// zero value if it is unset.
+func (v *DescribeMutableStateResponse) GetMutableStateInCache() (o string) {
+	if v.MutableStateInCache != nil {
+		return *v.MutableStateInCache
+	}
+
+	return
+}
+
+// GetMutableStateInDatabase returns the value of MutableStateInDatabase if it is set or its
+// zero value if it is unset.
+func (v *DescribeMutableStateResponse) GetMutableStateInDatabase() (o string) {
+	if v.MutableStateInDatabase != nil {
+		return *v.MutableStateInDatabase
+	}
+
+	return
+}
+
+type DescribeWorkflowExecutionRequest struct {
+	DomainUUID *string                                  `json:"domainUUID,omitempty"`
+	Request    *shared.DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*shared.DescribeWorkflowExecutionRequest, error) {
+	var v shared.DescribeWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// struct.
+func (v *DescribeWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
+// provided DescribeWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+type EventAlreadyStartedError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a EventAlreadyStartedError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *EventAlreadyStartedError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a EventAlreadyStartedError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a EventAlreadyStartedError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v EventAlreadyStartedError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *EventAlreadyStartedError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of EventAlreadyStartedError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a EventAlreadyStartedError
+// struct.
+func (v *EventAlreadyStartedError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("EventAlreadyStartedError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this EventAlreadyStartedError match the
+// provided EventAlreadyStartedError.
+//
+// This function performs a deep comparison.
+func (v *EventAlreadyStartedError) Equals(rhs *EventAlreadyStartedError) bool {
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+func (v *EventAlreadyStartedError) Error() string {
+	return v.String()
+}
+
+type GetDomainReplicationLagRequest struct {
+	ShardId     *int32  `json:"shardId,omitempty"`
+	DomainUUID  *string `json:"domainUUID,omitempty"`
+	ClusterName *string `json:"clusterName,omitempty"`
+}
+
+// ToWire translates a GetDomainReplicationLagRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetDomainReplicationLagRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardId != nil {
+		w, err = wire.NewValueI32(*(v.ShardId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetDomainReplicationLagRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetDomainReplicationLagRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetDomainReplicationLagRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetDomainReplicationLagRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetDomainReplicationLagRequest
+// struct.
+func (v *GetDomainReplicationLagRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+		i++
+	}
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+
+	return fmt.Sprintf("GetDomainReplicationLagRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GetDomainReplicationLagRequest match the
+// provided GetDomainReplicationLagRequest.
+//
+// This function performs a deep comparison.
+func (v *GetDomainReplicationLagRequest) Equals(rhs *GetDomainReplicationLagRequest) bool {
+	if !_I32_EqualsPtr(v.ShardId, rhs.ShardId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+		return false
+	}
+
+	return true
+}
+
+// GetShardId returns the value of ShardId if it is set or its
+// zero value if it is unset.
+func (v *GetDomainReplicationLagRequest) GetShardId() (o int32) {
+	if v.ShardId != nil {
+		return *v.ShardId
+	}
+
+	return
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *GetDomainReplicationLagRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *GetDomainReplicationLagRequest) GetClusterName() (o string) {
+	if v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
+}
+
+type GetDomainReplicationLagResponse struct {
+	PendingReplicationTasks *int64 `json:"pendingReplicationTasks,omitempty"`
+}
+
+// ToWire translates a GetDomainReplicationLagResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetDomainReplicationLagResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.PendingReplicationTasks != nil {
+		w, err = wire.NewValueI64(*(v.PendingReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetDomainReplicationLagResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetDomainReplicationLagResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetDomainReplicationLagResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetDomainReplicationLagResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PendingReplicationTasks = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetDomainReplicationLagResponse
+// struct.
+func (v *GetDomainReplicationLagResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.PendingReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("PendingReplicationTasks: %v", *(v.PendingReplicationTasks))
+		i++
+	}
+
+	return fmt.Sprintf("GetDomainReplicationLagResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GetDomainReplicationLagResponse match the
+// provided GetDomainReplicationLagResponse.
+//
+// This function performs a deep comparison.
+func (v *GetDomainReplicationLagResponse) Equals(rhs *GetDomainReplicationLagResponse) bool {
+	if !_I64_EqualsPtr(v.PendingReplicationTasks, rhs.PendingReplicationTasks) {
+		return false
+	}
+
+	return true
+}
+
+// GetPendingReplicationTasks returns the value of PendingReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *GetDomainReplicationLagResponse) GetPendingReplicationTasks() (o int64) {
+	if v.PendingReplicationTasks != nil {
+		return *v.PendingReplicationTasks
+	}
+
+	return
+}
+
+type GetMutableStateRequest struct {
+	DomainUUID          *string                   `json:"domainUUID,omitempty"`
+	Execution           *shared.WorkflowExecution `json:"execution,omitempty"`
+	ExpectedNextEventId *int64                    `json:"expectedNextEventId,omitempty"`
+}
+
+// ToWire translates a GetMutableStateRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetMutableStateRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ExpectedNextEventId != nil {
+		w, err = wire.NewValueI64(*(v.ExpectedNextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetMutableStateRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetMutableStateRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetMutableStateRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetMutableStateRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpectedNextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetMutableStateRequest
+// struct.
+func (v *GetMutableStateRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.ExpectedNextEventId != nil {
+		fields[i] = fmt.Sprintf("ExpectedNextEventId: %v", *(v.ExpectedNextEventId))
+		i++
+	}
+
+	return fmt.Sprintf("GetMutableStateRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this GetMutableStateRequest match the
+// provided GetMutableStateRequest.
+//
+// This function performs a deep comparison.
+func (v *GetMutableStateRequest) Equals(rhs *GetMutableStateRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpectedNextEventId, rhs.ExpectedNextEventId) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// GetExpectedNextEventId returns the value of ExpectedNextEventId if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateRequest) GetExpectedNextEventId() (o int64) {
+	if v.ExpectedNextEventId != nil {
+		return *v.ExpectedNextEventId
+	}
+
+	return
+}
+
+type GetMutableStateResponse struct {
+	Execution                            *shared.WorkflowExecution `json:"execution,omitempty"`
+	WorkflowType                         *shared.WorkflowType      `json:"workflowType,omitempty"`
+	NextEventId                          *int64                    `json:"NextEventId,omitempty"`
+	LastFirstEventId                     *int64                    `json:"LastFirstEventId,omitempty"`
+	TaskList                             *shared.TaskList          `json:"taskList,omitempty"`
+	StickyTaskList                       *shared.TaskList          `json:"stickyTaskList,omitempty"`
+	ClientLibraryVersion                 *string                   `json:"clientLibraryVersion,omitempty"`
+	ClientFeatureVersion                 *string                   `json:"clientFeatureVersion,omitempty"`
+	ClientImpl                           *string                   `json:"clientImpl,omitempty"`
+	IsWorkflowRunning                    *bool                     `json:"isWorkflowRunning,omitempty"`
+	StickyTaskListScheduleToStartTimeout *int32                    `json:"stickyTaskListScheduleToStartTimeout,omitempty"`
+}
+
+// ToWire translates a GetMutableStateResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetMutableStateResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [11]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.LastFirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.LastFirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.StickyTaskList != nil {
+		w, err = v.StickyTaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ClientLibraryVersion != nil {
+		w, err = wire.NewValueString(*(v.ClientLibraryVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.ClientFeatureVersion != nil {
+		w, err = wire.NewValueString(*(v.ClientFeatureVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.ClientImpl != nil {
+		w, err = wire.NewValueString(*(v.ClientImpl)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.IsWorkflowRunning != nil {
+		w, err = wire.NewValueBool(*(v.IsWorkflowRunning)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.StickyTaskListScheduleToStartTimeout != nil {
+		w, err = wire.NewValueI32(*(v.StickyTaskListScheduleToStartTimeout)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowType_Read(w wire.Value) (*shared.WorkflowType, error) {
+	var v shared.WorkflowType
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TaskList_Read(w wire.Value) (*shared.TaskList, error) {
+	var v shared.TaskList
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a GetMutableStateResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetMutableStateResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetMutableStateResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetMutableStateResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastFirstEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.StickyTaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClientLibraryVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClientFeatureVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClientImpl = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsWorkflowRunning = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StickyTaskListScheduleToStartTimeout = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetMutableStateResponse
+// struct.
+func (v *GetMutableStateResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [11]string
+	i := 0
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.LastFirstEventId != nil {
+		fields[i] = fmt.Sprintf("LastFirstEventId: %v", *(v.LastFirstEventId))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.StickyTaskList != nil {
+		fields[i] = fmt.Sprintf("StickyTaskList: %v", v.StickyTaskList)
+		i++
+	}
+	if v.ClientLibraryVersion != nil {
+		fields[i] = fmt.Sprintf("ClientLibraryVersion: %v", *(v.ClientLibraryVersion))
+		i++
+	}
+	if v.ClientFeatureVersion != nil {
+		fields[i] = fmt.Sprintf("ClientFeatureVersion: %v", *(v.ClientFeatureVersion))
+		i++
+	}
+	if v.ClientImpl != nil {
+		fields[i] = fmt.Sprintf("ClientImpl: %v", *(v.ClientImpl))
+		i++
+	}
+	if v.IsWorkflowRunning != nil {
+		fields[i] = fmt.Sprintf("IsWorkflowRunning: %v", *(v.IsWorkflowRunning))
+		i++
+	}
+	if v.StickyTaskListScheduleToStartTimeout != nil {
+		fields[i] = fmt.Sprintf("StickyTaskListScheduleToStartTimeout: %v", *(v.StickyTaskListScheduleToStartTimeout))
+		i++
+	}
+
+	return fmt.Sprintf("GetMutableStateResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
 
 		x := *lhs
 		y := *rhs
 		return (x == y)
 	}
-	return lhs == nil && rhs == nil
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this GetMutableStateResponse match the
+// provided GetMutableStateResponse.
+//
+// This function performs a deep comparison.
+func (v *GetMutableStateResponse) Equals(rhs *GetMutableStateResponse) bool {
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastFirstEventId, rhs.LastFirstEventId) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !((v.StickyTaskList == nil && rhs.StickyTaskList == nil) || (v.StickyTaskList != nil && rhs.StickyTaskList != nil && v.StickyTaskList.Equals(rhs.StickyTaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClientLibraryVersion, rhs.ClientLibraryVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClientFeatureVersion, rhs.ClientFeatureVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClientImpl, rhs.ClientImpl) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsWorkflowRunning, rhs.IsWorkflowRunning) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StickyTaskListScheduleToStartTimeout, rhs.StickyTaskListScheduleToStartTimeout) {
+		return false
+	}
+
+	return true
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateResponse) GetNextEventId() (o int64) {
+	if v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// GetLastFirstEventId returns the value of LastFirstEventId if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateResponse) GetLastFirstEventId() (o int64) {
+	if v.LastFirstEventId != nil {
+		return *v.LastFirstEventId
+	}
+
+	return
+}
+
+// GetClientLibraryVersion returns the value of ClientLibraryVersion if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateResponse) GetClientLibraryVersion() (o string) {
+	if v.ClientLibraryVersion != nil {
+		return *v.ClientLibraryVersion
+	}
+
+	return
+}
+
+// GetClientFeatureVersion returns the value of ClientFeatureVersion if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateResponse) GetClientFeatureVersion() (o string) {
+	if v.ClientFeatureVersion != nil {
+		return *v.ClientFeatureVersion
+	}
+
+	return
+}
+
+// GetClientImpl returns the value of ClientImpl if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateResponse) GetClientImpl() (o string) {
+	if v.ClientImpl != nil {
+		return *v.ClientImpl
+	}
+
+	return
+}
+
+// GetIsWorkflowRunning returns the value of IsWorkflowRunning if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateResponse) GetIsWorkflowRunning() (o bool) {
+	if v.IsWorkflowRunning != nil {
+		return *v.IsWorkflowRunning
+	}
+
+	return
+}
+
+// GetStickyTaskListScheduleToStartTimeout returns the value of StickyTaskListScheduleToStartTimeout if it is set or its
+// zero value if it is unset.
+func (v *GetMutableStateResponse) GetStickyTaskListScheduleToStartTimeout() (o int32) {
+	if v.StickyTaskListScheduleToStartTimeout != nil {
+		return *v.StickyTaskListScheduleToStartTimeout
+	}
+
+	return
+}
+
+type GetReplicationMessagesRequest struct {
+	ShardId                *int32  `json:"shardId,omitempty"`
+	ClusterName            *string `json:"clusterName,omitempty"`
+	LastRetrievedMessageId *int64  `json:"lastRetrievedMessageId,omitempty"`
+	LastProcessedMessageId *int64  `json:"lastProcessedMessageId,omitempty"`
+}
+
+// ToWire translates a GetReplicationMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardId != nil {
+		w, err = wire.NewValueI32(*(v.ShardId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrievedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.LastProcessedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastProcessedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetReplicationMessagesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationMessagesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetReplicationMessagesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetReplicationMessagesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastRetrievedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastProcessedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetReplicationMessagesRequest
+// struct.
+func (v *GetReplicationMessagesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+		i++
+	}
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastRetrievedMessageId: %v", *(v.LastRetrievedMessageId))
+		i++
+	}
+	if v.LastProcessedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastProcessedMessageId: %v", *(v.LastProcessedMessageId))
+		i++
+	}
+
+	return fmt.Sprintf("GetReplicationMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GetReplicationMessagesRequest match the
+// provided GetReplicationMessagesRequest.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationMessagesRequest) Equals(rhs *GetReplicationMessagesRequest) bool {
+	if !_I32_EqualsPtr(v.ShardId, rhs.ShardId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastRetrievedMessageId, rhs.LastRetrievedMessageId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastProcessedMessageId, rhs.LastProcessedMessageId) {
+		return false
+	}
+
+	return true
+}
+
+// GetShardId returns the value of ShardId if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesRequest) GetShardId() (o int32) {
+	if v.ShardId != nil {
+		return *v.ShardId
+	}
+
+	return
+}
+
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesRequest) GetClusterName() (o string) {
+	if v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
+}
+
+// GetLastRetrievedMessageId returns the value of LastRetrievedMessageId if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesRequest) GetLastRetrievedMessageId() (o int64) {
+	if v.LastRetrievedMessageId != nil {
+		return *v.LastRetrievedMessageId
+	}
+
+	return
+}
+
+// GetLastProcessedMessageId returns the value of LastProcessedMessageId if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesRequest) GetLastProcessedMessageId() (o int64) {
+	if v.LastProcessedMessageId != nil {
+		return *v.LastProcessedMessageId
+	}
+
+	return
+}
+
+type GetReplicationMessagesResponse struct {
+	Messages               []*ReplicationMessage `json:"messages,omitempty"`
+	LastRetrievedMessageId *int64                `json:"lastRetrievedMessageId,omitempty"`
+	HasMore                *bool                 `json:"hasMore,omitempty"`
+}
+
+type _List_ReplicationMessage_ValueList []*ReplicationMessage
+
+func (v _List_ReplicationMessage_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationMessage_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationMessage_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationMessage_ValueList) Close() {}
+
+// ToWire translates a GetReplicationMessagesResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationMessagesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Messages != nil {
+		w, err = wire.NewValueList(_List_ReplicationMessage_ValueList(v.Messages)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrievedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HasMore != nil {
+		w, err = wire.NewValueBool(*(v.HasMore)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationMessage_Read(w wire.Value) (*ReplicationMessage, error) {
+	var v ReplicationMessage
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationMessage_Read(l wire.ValueList) ([]*ReplicationMessage, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationMessage, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationMessage_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetReplicationMessagesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationMessagesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetReplicationMessagesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetReplicationMessagesResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Messages, err = _List_ReplicationMessage_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastRetrievedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.HasMore = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetReplicationMessagesResponse
+// struct.
+func (v *GetReplicationMessagesResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Messages != nil {
+		fields[i] = fmt.Sprintf("Messages: %v", v.Messages)
+		i++
+	}
+	if v.LastRetrievedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastRetrievedMessageId: %v", *(v.LastRetrievedMessageId))
+		i++
+	}
+	if v.HasMore != nil {
+		fields[i] = fmt.Sprintf("HasMore: %v", *(v.HasMore))
+		i++
+	}
+
+	return fmt.Sprintf("GetReplicationMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationMessage_Equals(lhs, rhs []*ReplicationMessage) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this GetReplicationMessagesResponse match the
+// provided GetReplicationMessagesResponse.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationMessagesResponse) Equals(rhs *GetReplicationMessagesResponse) bool {
+	if !((v.Messages == nil && rhs.Messages == nil) || (v.Messages != nil && rhs.Messages != nil && _List_ReplicationMessage_Equals(v.Messages, rhs.Messages))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastRetrievedMessageId, rhs.LastRetrievedMessageId) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.HasMore, rhs.HasMore) {
+		return false
+	}
+
+	return true
+}
+
+// GetLastRetrievedMessageId returns the value of LastRetrievedMessageId if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesResponse) GetLastRetrievedMessageId() (o int64) {
+	if v.LastRetrievedMessageId != nil {
+		return *v.LastRetrievedMessageId
+	}
+
+	return
+}
+
+// GetHasMore returns the value of HasMore if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesResponse) GetHasMore() (o bool) {
+	if v.HasMore != nil {
+		return *v.HasMore
+	}
+
+	return
+}
+
+type GetReplicationStatusRequest struct {
+	ShardId *int32 `json:"shardId,omitempty"`
+}
+
+// ToWire translates a GetReplicationStatusRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationStatusRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardId != nil {
+		w, err = wire.NewValueI32(*(v.ShardId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetReplicationStatusRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationStatusRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetReplicationStatusRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetReplicationStatusRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetReplicationStatusRequest
+// struct.
+func (v *GetReplicationStatusRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+		i++
+	}
+
+	return fmt.Sprintf("GetReplicationStatusRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GetReplicationStatusRequest match the
+// provided GetReplicationStatusRequest.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationStatusRequest) Equals(rhs *GetReplicationStatusRequest) bool {
+	if !_I32_EqualsPtr(v.ShardId, rhs.ShardId) {
+		return false
+	}
+
+	return true
+}
+
+// GetShardId returns the value of ShardId if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationStatusRequest) GetShardId() (o int32) {
+	if v.ShardId != nil {
+		return *v.ShardId
+	}
+
+	return
+}
+
+type GetWorkflowReplicationTasksRequest struct {
+	DomainUUID        *string                   `json:"domainUUID,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
+	FirstEventId      *int64                    `json:"firstEventId,omitempty"`
+	NextEventId       *int64                    `json:"nextEventId,omitempty"`
+	MaximumPageSize   *int32                    `json:"maximumPageSize,omitempty"`
+}
+
+// ToWire translates a GetWorkflowReplicationTasksRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowReplicationTasksRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
+// FromWire deserializes a GetWorkflowReplicationTasksRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowReplicationTasksRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkflowReplicationTasksRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkflowReplicationTasksRequest) FromWire(w wire.Value) error {
+	var err error
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
-	return lhs == nil && rhs == nil
+
+	return nil
 }
 
-// Equals returns true if all the fields of this GetMutableStateResponse match the
-// provided GetMutableStateResponse.
-//
-// This function performs a deep comparison.
-func (v *GetMutableStateResponse) Equals(rhs *GetMutableStateResponse) bool {
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
-		return false
+// String returns a readable string representation of a GetWorkflowReplicationTasksRequest
+// struct.
+func (v *GetWorkflowReplicationTasksRequest) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
-		return false
+
+	var fields [5]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
 	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
 	}
-	if !_I64_EqualsPtr(v.LastFirstEventId, rhs.LastFirstEventId) {
-		return false
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+		i++
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
 	}
-	if !((v.StickyTaskList == nil && rhs.StickyTaskList == nil) || (v.StickyTaskList != nil && rhs.StickyTaskList != nil && v.StickyTaskList.Equals(rhs.StickyTaskList))) {
-		return false
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
 	}
-	if !_String_EqualsPtr(v.ClientLibraryVersion, rhs.ClientLibraryVersion) {
+
+	return fmt.Sprintf("GetWorkflowReplicationTasksRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GetWorkflowReplicationTasksRequest match the
+// provided GetWorkflowReplicationTasksRequest.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowReplicationTasksRequest) Equals(rhs *GetWorkflowReplicationTasksRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ClientFeatureVersion, rhs.ClientFeatureVersion) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ClientImpl, rhs.ClientImpl) {
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.IsWorkflowRunning, rhs.IsWorkflowRunning) {
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.StickyTaskListScheduleToStartTimeout, rhs.StickyTaskListScheduleToStartTimeout) {
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
 		return false
 	}
 
 	return true
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *GetMutableStateResponse) GetNextEventId() (o int64) {
-	if v.NextEventId != nil {
-		return *v.NextEventId
+func (v *GetWorkflowReplicationTasksRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// GetLastFirstEventId returns the value of LastFirstEventId if it is set or its
+// GetFirstEventId returns the value of FirstEventId if it is set or its
 // zero value if it is unset.
-func (v *GetMutableStateResponse) GetLastFirstEventId() (o int64) {
-	if v.LastFirstEventId != nil {
-		return *v.LastFirstEventId
+func (v *GetWorkflowReplicationTasksRequest) GetFirstEventId() (o int64) {
+	if v.FirstEventId != nil {
+		return *v.FirstEventId
 	}
 
 	return
 }
 
-// GetClientLibraryVersion returns the value of ClientLibraryVersion if it is set or its
+// GetNextEventId returns the value of NextEventId if it is set or its
 // zero value if it is unset.
-func (v *GetMutableStateResponse) GetClientLibraryVersion() (o string) {
-	if v.ClientLibraryVersion != nil {
-		return *v.ClientLibraryVersion
+func (v *GetWorkflowReplicationTasksRequest) GetNextEventId() (o int64) {
+	if v.NextEventId != nil {
+		return *v.NextEventId
 	}
 
 	return
 }
 
-// GetClientFeatureVersion returns the value of ClientFeatureVersion if it is set or its
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
 // zero value if it is unset.
-func (v *GetMutableStateResponse) GetClientFeatureVersion() (o string) {
-	if v.ClientFeatureVersion != nil {
-		return *v.ClientFeatureVersion
+func (v *GetWorkflowReplicationTasksRequest) GetMaximumPageSize() (o int32) {
+	if v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+type GetWorkflowReplicationTasksResponse struct {
+	ReplicationTasks []*ReplicateEventsRequest `json:"replicationTasks,omitempty"`
+}
+
+type _List_ReplicateEventsRequest_ValueList []*ReplicateEventsRequest
+
+func (v _List_ReplicateEventsRequest_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicateEventsRequest_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicateEventsRequest_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicateEventsRequest_ValueList) Close() {}
+
+// ToWire translates a GetWorkflowReplicationTasksResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowReplicationTasksResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ReplicationTasks != nil {
+		w, err = wire.NewValueList(_List_ReplicateEventsRequest_ValueList(v.ReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_ReplicateEventsRequest_Read(l wire.ValueList) ([]*ReplicateEventsRequest, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicateEventsRequest, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicateEventsRequest_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetWorkflowReplicationTasksResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowReplicationTasksResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkflowReplicationTasksResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkflowReplicationTasksResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.ReplicationTasks, err = _List_ReplicateEventsRequest_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowReplicationTasksResponse
+// struct.
+func (v *GetWorkflowReplicationTasksResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
+		i++
 	}
 
-	return
+	return fmt.Sprintf("GetWorkflowReplicationTasksResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetClientImpl returns the value of ClientImpl if it is set or its
-// zero value if it is unset.
-func (v *GetMutableStateResponse) GetClientImpl() (o string) {
-	if v.ClientImpl != nil {
-		return *v.ClientImpl
+func _List_ReplicateEventsRequest_Equals(lhs, rhs []*ReplicateEventsRequest) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
 
-	return
-}
-
-// GetIsWorkflowRunning returns the value of IsWorkflowRunning if it is set or its
-// zero value if it is unset.
-func (v *GetMutableStateResponse) GetIsWorkflowRunning() (o bool) {
-	if v.IsWorkflowRunning != nil {
-		return *v.IsWorkflowRunning
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
 	}
 
-	return
+	return true
 }
 
-// GetStickyTaskListScheduleToStartTimeout returns the value of StickyTaskListScheduleToStartTimeout if it is set or its
-// zero value if it is unset.
-func (v *GetMutableStateResponse) GetStickyTaskListScheduleToStartTimeout() (o int32) {
-	if v.StickyTaskListScheduleToStartTimeout != nil {
-		return *v.StickyTaskListScheduleToStartTimeout
+// Equals returns true if all the fields of this GetWorkflowReplicationTasksResponse match the
+// provided GetWorkflowReplicationTasksResponse.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowReplicationTasksResponse) Equals(rhs *GetWorkflowReplicationTasksResponse) bool {
+	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicateEventsRequest_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
+		return false
 	}
 
-	return
+	return true
 }
 
 type ParentExecutionInfo struct {
@@ -3355,23 +5173,321 @@ func (v *ReplicationInfo) ToWire() (wire.Value, error) {
 func (v *ReplicationInfo) FromWire(w wire.Value) error {
 	var err error
 
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationInfo
+// struct.
+func (v *ReplicationInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.LastEventId != nil {
+		fields[i] = fmt.Sprintf("LastEventId: %v", *(v.LastEventId))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReplicationInfo match the
+// provided ReplicationInfo.
+//
+// This function performs a deep comparison.
+func (v *ReplicationInfo) Equals(rhs *ReplicationInfo) bool {
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastEventId, rhs.LastEventId) {
+		return false
+	}
+
+	return true
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *ReplicationInfo) GetVersion() (o int64) {
+	if v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// GetLastEventId returns the value of LastEventId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationInfo) GetLastEventId() (o int64) {
+	if v.LastEventId != nil {
+		return *v.LastEventId
+	}
+
+	return
+}
+
+type ReplicationMessage struct {
+	TaskId          *int64                      `json:"taskId,omitempty"`
+	DomainId        *string                     `json:"domainId,omitempty"`
+	WorkflowId      *string                     `json:"workflowId,omitempty"`
+	RunId           *string                     `json:"runId,omitempty"`
+	FirstEventId    *int64                      `json:"firstEventId,omitempty"`
+	NextEventId     *int64                      `json:"nextEventId,omitempty"`
+	Version         *int64                      `json:"version,omitempty"`
+	ReplicationInfo map[string]*ReplicationInfo `json:"replicationInfo,omitempty"`
+	History         *shared.History             `json:"history,omitempty"`
+	NewRunHistory   *shared.History             `json:"newRunHistory,omitempty"`
+}
+
+// ToWire translates a ReplicationMessage struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationMessage) ToWire() (wire.Value, error) {
+	var (
+		fields [10]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskId != nil {
+		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.NewRunHistory != nil {
+		w, err = v.NewRunHistory.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationMessage struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationMessage struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationMessage
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationMessage) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
+				v.FirstEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
+		case 60:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.LastEventId = &x
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TMap {
+				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.History, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.NewRunHistory, err = _History_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3383,57 +5499,163 @@ func (v *ReplicationInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicationInfo
+// String returns a readable string representation of a ReplicationMessage
 // struct.
-func (v *ReplicationInfo) String() string {
+func (v *ReplicationMessage) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [10]string
 	i := 0
+	if v.TaskId != nil {
+		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+		i++
+	}
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
+		i++
+	}
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
 	if v.Version != nil {
 		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
 		i++
 	}
-	if v.LastEventId != nil {
-		fields[i] = fmt.Sprintf("LastEventId: %v", *(v.LastEventId))
+	if v.ReplicationInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.NewRunHistory != nil {
+		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
 		i++
 	}
 
-	return fmt.Sprintf("ReplicationInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ReplicationMessage{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ReplicationInfo match the
-// provided ReplicationInfo.
+// Equals returns true if all the fields of this ReplicationMessage match the
+// provided ReplicationMessage.
 //
 // This function performs a deep comparison.
-func (v *ReplicationInfo) Equals(rhs *ReplicationInfo) bool {
+func (v *ReplicationMessage) Equals(rhs *ReplicationMessage) bool {
+	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
 	if !_I64_EqualsPtr(v.Version, rhs.Version) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.LastEventId, rhs.LastEventId) {
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
 		return false
 	}
 
 	return true
 }
 
-// GetVersion returns the value of Version if it is set or its
+// GetTaskId returns the value of TaskId if it is set or its
 // zero value if it is unset.
-func (v *ReplicationInfo) GetVersion() (o int64) {
-	if v.Version != nil {
-		return *v.Version
+func (v *ReplicationMessage) GetTaskId() (o int64) {
+	if v.TaskId != nil {
+		return *v.TaskId
 	}
 
 	return
 }
 
-// GetLastEventId returns the value of LastEventId if it is set or its
+// GetDomainId returns the value of DomainId if it is set or its
 // zero value if it is unset.
-func (v *ReplicationInfo) GetLastEventId() (o int64) {
-	if v.LastEventId != nil {
-		return *v.LastEventId
+func (v *ReplicationMessage) GetDomainId() (o string) {
+	if v.DomainId != nil {
+		return *v.DomainId
+	}
+
+	return
+}
+
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessage) GetWorkflowId() (o string) {
+	if v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessage) GetRunId() (o string) {
+	if v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// GetFirstEventId returns the value of FirstEventId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessage) GetFirstEventId() (o int64) {
+	if v.FirstEventId != nil {
+		return *v.FirstEventId
+	}
+
+	return
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessage) GetNextEventId() (o int64) {
+	if v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessage) GetVersion() (o int64) {
+	if v.Version != nil {
+		return *v.Version
 	}
 
 	return
@@ -3848,11 +6070,114 @@ func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetStickyTaskListResponse struct from its Thrift-level
+// FromWire deserializes a ResetStickyTaskListResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResetStickyTaskListResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResetStickyTaskListResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResetStickyTaskListResponse) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResetStickyTaskListResponse
+// struct.
+func (v *ResetStickyTaskListResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("ResetStickyTaskListResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResetStickyTaskListResponse match the
+// provided ResetStickyTaskListResponse.
+//
+// This function performs a deep comparison.
+func (v *ResetStickyTaskListResponse) Equals(rhs *ResetStickyTaskListResponse) bool {
+
+	return true
+}
+
+type ResetWorkflowExecutionRequest struct {
+	DomainUUID   *string                               `json:"domainUUID,omitempty"`
+	ResetRequest *shared.ResetWorkflowExecutionRequest `json:"resetRequest,omitempty"`
+}
+
+// ToWire translates a ResetWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ResetRequest != nil {
+		w, err = v.ResetRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ResetWorkflowExecutionRequest_Read(w wire.Value) (*shared.ResetWorkflowExecutionRequest, error) {
+	var v shared.ResetWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ResetWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetStickyTaskListResponse struct
+// An error is returned if we were unable to build a ResetWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3860,43 +6185,86 @@ func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetStickyTaskListResponse
+//   var v ResetWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetStickyTaskListResponse) FromWire(w wire.Value) error {
+func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.ResetRequest, err = _ResetWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a ResetStickyTaskListResponse
+// String returns a readable string representation of a ResetWorkflowExecutionRequest
 // struct.
-func (v *ResetStickyTaskListResponse) String() string {
+func (v *ResetWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [0]string
+	var fields [2]string
 	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.ResetRequest != nil {
+		fields[i] = fmt.Sprintf("ResetRequest: %v", v.ResetRequest)
+		i++
+	}
 
-	return fmt.Sprintf("ResetStickyTaskListResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetStickyTaskListResponse match the
-// provided ResetStickyTaskListResponse.
+// Equals returns true if all the fields of this ResetWorkflowExecutionRequest match the
+// provided ResetWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *ResetStickyTaskListResponse) Equals(rhs *ResetStickyTaskListResponse) bool {
+func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.ResetRequest == nil && rhs.ResetRequest == nil) || (v.ResetRequest != nil && rhs.ResetRequest != nil && v.ResetRequest.Equals(rhs.ResetRequest))) {
+		return false
+	}
 
 	return true
 }
 
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
 type RespondActivityTaskCanceledRequest struct {
 	DomainUUID    *string                                    `json:"domainUUID,omitempty"`
 	CancelRequest *shared.RespondActivityTaskCanceledRequest `json:"cancelRequest,omitempty"`
@@ -4803,23 +7171,278 @@ func (v *ShardOwnershipLostError) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Owner != nil {
-		w, err = wire.NewValueString(*(v.Owner)), error(nil)
+	if v.Owner != nil {
+		w, err = wire.NewValueString(*(v.Owner)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ShardOwnershipLostError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ShardOwnershipLostError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ShardOwnershipLostError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ShardOwnershipLostError) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Owner = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ShardOwnershipLostError
+// struct.
+func (v *ShardOwnershipLostError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+	if v.Owner != nil {
+		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
+		i++
+	}
+
+	return fmt.Sprintf("ShardOwnershipLostError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ShardOwnershipLostError match the
+// provided ShardOwnershipLostError.
+//
+// This function performs a deep comparison.
+func (v *ShardOwnershipLostError) Equals(rhs *ShardOwnershipLostError) bool {
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Owner, rhs.Owner) {
+		return false
+	}
+
+	return true
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *ShardOwnershipLostError) GetMessage() (o string) {
+	if v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// GetOwner returns the value of Owner if it is set or its
+// zero value if it is unset.
+func (v *ShardOwnershipLostError) GetOwner() (o string) {
+	if v.Owner != nil {
+		return *v.Owner
+	}
+
+	return
+}
+
+func (v *ShardOwnershipLostError) Error() string {
+	return v.String()
+}
+
+type ShardReplicationStatus struct {
+	ShardId             *int32                               `json:"shardId,omitempty"`
+	Timestamp           *int64                               `json:"timestamp,omitempty"`
+	MaxReadLevel        *int64                               `json:"maxReadLevel,omitempty"`
+	ReplicationAckLevel *int64                               `json:"replicationAckLevel,omitempty"`
+	RemoteClusters      map[string]*ClusterReplicationStatus `json:"remoteClusters,omitempty"`
+}
+
+type _Map_String_ClusterReplicationStatus_MapItemList map[string]*ClusterReplicationStatus
+
+func (m _Map_String_ClusterReplicationStatus_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_ClusterReplicationStatus_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_ClusterReplicationStatus_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_ClusterReplicationStatus_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_ClusterReplicationStatus_MapItemList) Close() {}
+
+// ToWire translates a ShardReplicationStatus struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ShardReplicationStatus) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardId != nil {
+		w, err = wire.NewValueI32(*(v.ShardId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MaxReadLevel != nil {
+		w, err = wire.NewValueI64(*(v.MaxReadLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ReplicationAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.ReplicationAckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.RemoteClusters != nil {
+		w, err = wire.NewValueMap(_Map_String_ClusterReplicationStatus_MapItemList(v.RemoteClusters)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ShardOwnershipLostError struct from its Thrift-level
+func _ClusterReplicationStatus_Read(w wire.Value) (*ClusterReplicationStatus, error) {
+	var v ClusterReplicationStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_ClusterReplicationStatus_Read(m wire.MapItemList) (map[string]*ClusterReplicationStatus, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*ClusterReplicationStatus, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ClusterReplicationStatus_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a ShardReplicationStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ShardOwnershipLostError struct
+// An error is returned if we were unable to build a ShardReplicationStatus struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4827,31 +7450,59 @@ func (v *ShardOwnershipLostError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ShardOwnershipLostError
+//   var v ShardReplicationStatus
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ShardOwnershipLostError) FromWire(w wire.Value) error {
+func (v *ShardReplicationStatus) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Message = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Owner = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxReadLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReplicationAckLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TMap {
+				v.RemoteClusters, err = _Map_String_ClusterReplicationStatus_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
@@ -4863,64 +7514,118 @@ func (v *ShardOwnershipLostError) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ShardOwnershipLostError
+// String returns a readable string representation of a ShardReplicationStatus
 // struct.
-func (v *ShardOwnershipLostError) String() string {
+func (v *ShardReplicationStatus) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [5]string
 	i := 0
-	if v.Message != nil {
-		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
 		i++
 	}
-	if v.Owner != nil {
-		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+	if v.MaxReadLevel != nil {
+		fields[i] = fmt.Sprintf("MaxReadLevel: %v", *(v.MaxReadLevel))
+		i++
+	}
+	if v.ReplicationAckLevel != nil {
+		fields[i] = fmt.Sprintf("ReplicationAckLevel: %v", *(v.ReplicationAckLevel))
+		i++
+	}
+	if v.RemoteClusters != nil {
+		fields[i] = fmt.Sprintf("RemoteClusters: %v", v.RemoteClusters)
 		i++
 	}
 
-	return fmt.Sprintf("ShardOwnershipLostError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ShardReplicationStatus{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ShardOwnershipLostError match the
-// provided ShardOwnershipLostError.
+func _Map_String_ClusterReplicationStatus_Equals(lhs, rhs map[string]*ClusterReplicationStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this ShardReplicationStatus match the
+// provided ShardReplicationStatus.
 //
 // This function performs a deep comparison.
-func (v *ShardOwnershipLostError) Equals(rhs *ShardOwnershipLostError) bool {
-	if !_String_EqualsPtr(v.Message, rhs.Message) {
+func (v *ShardReplicationStatus) Equals(rhs *ShardReplicationStatus) bool {
+	if !_I32_EqualsPtr(v.ShardId, rhs.ShardId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Owner, rhs.Owner) {
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MaxReadLevel, rhs.MaxReadLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ReplicationAckLevel, rhs.ReplicationAckLevel) {
+		return false
+	}
+	if !((v.RemoteClusters == nil && rhs.RemoteClusters == nil) || (v.RemoteClusters != nil && rhs.RemoteClusters != nil && _Map_String_ClusterReplicationStatus_Equals(v.RemoteClusters, rhs.RemoteClusters))) {
 		return false
 	}
 
 	return true
 }
 
-// GetMessage returns the value of Message if it is set or its
+// GetShardId returns the value of ShardId if it is set or its
 // zero value if it is unset.
-func (v *ShardOwnershipLostError) GetMessage() (o string) {
-	if v.Message != nil {
-		return *v.Message
+func (v *ShardReplicationStatus) GetShardId() (o int32) {
+	if v.ShardId != nil {
+		return *v.ShardId
 	}
 
 	return
 }
 
-// GetOwner returns the value of Owner if it is set or its
+// GetTimestamp returns the value of Timestamp if it is set or its
 // zero value if it is unset.
-func (v *ShardOwnershipLostError) GetOwner() (o string) {
-	if v.Owner != nil {
-		return *v.Owner
+func (v *ShardReplicationStatus) GetTimestamp() (o int64) {
+	if v.Timestamp != nil {
+		return *v.Timestamp
 	}
 
 	return
 }
 
-func (v *ShardOwnershipLostError) Error() string {
-	return v.String()
+// GetMaxReadLevel returns the value of MaxReadLevel if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetMaxReadLevel() (o int64) {
+	if v.MaxReadLevel != nil {
+		return *v.MaxReadLevel
+	}
+
+	return
+}
+
+// GetReplicationAckLevel returns the value of ReplicationAckLevel if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetReplicationAckLevel() (o int64) {
+	if v.ReplicationAckLevel != nil {
+		return *v.ReplicationAckLevel
+	}
+
+	return
 }
 
 type SignalWithStartWorkflowExecutionRequest struct {
@@ -5276,9 +7981,13 @@ func (v *SignalWorkflowExecutionRequest) GetChildWorkflowOnly() (o bool) {
 }
 
 type StartWorkflowExecutionRequest struct {
-	DomainUUID          *string                               `json:"domainUUID,omitempty"`
-	StartRequest        *shared.StartWorkflowExecutionRequest `json:"startRequest,omitempty"`
-	ParentExecutionInfo *ParentExecutionInfo                  `json:"parentExecutionInfo,omitempty"`
+	DomainUUID                      *string                               `json:"domainUUID,omitempty"`
+	StartRequest                    *shared.StartWorkflowExecutionRequest `json:"startRequest,omitempty"`
+	ParentExecutionInfo             *ParentExecutionInfo                  `json:"parentExecutionInfo,omitempty"`
+	ContinueAsNewInitiator          *shared.ContinueAsNewInitiator        `json:"continueAsNewInitiator,omitempty"`
+	FirstDecisionTaskBackoffSeconds *int32                                `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	Attempt                         *int32                                `json:"attempt,omitempty"`
+	ExpirationTimestamp             *int64                                `json:"expirationTimestamp,omitempty"`
 }
 
 // ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -5298,7 +8007,7 @@ type StartWorkflowExecutionRequest struct {
 //   }
 func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5328,6 +8037,38 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.ContinueAsNewInitiator != nil {
+		w, err = v.ContinueAsNewInitiator.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FirstDecisionTaskBackoffSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -5344,6 +8085,12 @@ func _ParentExecutionInfo_Read(w wire.Value) (*ParentExecutionInfo, error) {
 	return &v, err
 }
 
+func _ContinueAsNewInitiator_Read(w wire.Value) (shared.ContinueAsNewInitiator, error) {
+	var v shared.ContinueAsNewInitiator
+	err := v.FromWire(w)
+	return v, err
+}
+
 // FromWire deserializes a StartWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -5391,6 +8138,46 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x shared.ContinueAsNewInitiator
+				x, err = _ContinueAsNewInitiator_Read(field.Value)
+				v.ContinueAsNewInitiator = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FirstDecisionTaskBackoffSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpirationTimestamp = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -5405,7 +8192,7 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [7]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -5419,10 +8206,36 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("ParentExecutionInfo: %v", v.ParentExecutionInfo)
 		i++
 	}
+	if v.ContinueAsNewInitiator != nil {
+		fields[i] = fmt.Sprintf("ContinueAsNewInitiator: %v", *(v.ContinueAsNewInitiator))
+		i++
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		fields[i] = fmt.Sprintf("FirstDecisionTaskBackoffSeconds: %v", *(v.FirstDecisionTaskBackoffSeconds))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
+		i++
+	}
 
 	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _ContinueAsNewInitiator_EqualsPtr(lhs, rhs *shared.ContinueAsNewInitiator) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this StartWorkflowExecutionRequest match the
 // provided StartWorkflowExecutionRequest.
 //
@@ -5437,6 +8250,18 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 	if !((v.ParentExecutionInfo == nil && rhs.ParentExecutionInfo == nil) || (v.ParentExecutionInfo != nil && rhs.ParentExecutionInfo != nil && v.ParentExecutionInfo.Equals(rhs.ParentExecutionInfo))) {
 		return false
 	}
+	if !_ContinueAsNewInitiator_EqualsPtr(v.ContinueAsNewInitiator, rhs.ContinueAsNewInitiator) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.FirstDecisionTaskBackoffSeconds, rhs.FirstDecisionTaskBackoffSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
+		return false
+	}
 
 	return true
 }
@@ -5451,6 +8276,46 @@ func (v *StartWorkflowExecutionRequest) GetDomainUUID() (o string) {
 	return
 }
 
+// GetContinueAsNewInitiator returns the value of ContinueAsNewInitiator if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetContinueAsNewInitiator() (o shared.ContinueAsNewInitiator) {
+	if v.ContinueAsNewInitiator != nil {
+		return *v.ContinueAsNewInitiator
+	}
+
+	return
+}
+
+// GetFirstDecisionTaskBackoffSeconds returns the value of FirstDecisionTaskBackoffSeconds if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetFirstDecisionTaskBackoffSeconds() (o int32) {
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		return *v.FirstDecisionTaskBackoffSeconds
+	}
+
+	return
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetAttempt() (o int32) {
+	if v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// GetExpirationTimestamp returns the value of ExpirationTimestamp if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetExpirationTimestamp() (o int64) {
+	if v.ExpirationTimestamp != nil {
+		return *v.ExpirationTimestamp
+	}
+
+	return
+}
+
 type TerminateWorkflowExecutionRequest struct {
 	DomainUUID       *string                                   `json:"domainUUID,omitempty"`
 	TerminateRequest *shared.TerminateWorkflowExecutionRequest `json:"terminateRequest,omitempty"`
@@ -34,6 +34,116 @@ import (
 	"strings"
 )
 
+type AccessDeniedError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a AccessDeniedError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AccessDeniedError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AccessDeniedError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AccessDeniedError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AccessDeniedError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AccessDeniedError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of AccessDeniedError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AccessDeniedError
+// struct.
+func (v *AccessDeniedError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("AccessDeniedError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AccessDeniedError match the
+// provided AccessDeniedError.
+//
+// This function performs a deep comparison.
+func (v *AccessDeniedError) Equals(rhs *AccessDeniedError) bool {
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+func (v *AccessDeniedError) Error() string {
+	return v.String()
+}
+
 type ActivityTaskCancelRequestedEventAttributes struct {
 	ActivityId                   *string `json:"activityId,omitempty"`
 	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
@@ -1938,6 +2048,141 @@ func (v *ActivityType) GetName() (o string) {
 	return
 }
 
+type ArchivalStatus int32
+
+const (
+	ArchivalStatusDisabled ArchivalStatus = 0
+	ArchivalStatusEnabled  ArchivalStatus = 1
+)
+
+// ArchivalStatus_Values returns all recognized values of ArchivalStatus.
+func ArchivalStatus_Values() []ArchivalStatus {
+	return []ArchivalStatus{
+		ArchivalStatusDisabled,
+		ArchivalStatusEnabled,
+	}
+}
+
+// UnmarshalText tries to decode ArchivalStatus from a byte slice
+// containing its name.
+//
+//   var v ArchivalStatus
+//   err := v.UnmarshalText([]byte("DISABLED"))
+func (v *ArchivalStatus) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "DISABLED":
+		*v = ArchivalStatusDisabled
+		return nil
+	case "ENABLED":
+		*v = ArchivalStatusEnabled
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "ArchivalStatus")
+	}
+}
+
+// Ptr returns a pointer to this enum value.
+func (v ArchivalStatus) Ptr() *ArchivalStatus {
+	return &v
+}
+
+// ToWire translates ArchivalStatus into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v ArchivalStatus) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes ArchivalStatus from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return ArchivalStatus(0), err
+//   }
+//
+//   var v ArchivalStatus
+//   if err := v.FromWire(x); err != nil {
+//     return ArchivalStatus(0), err
+//   }
+//   return v, nil
+func (v *ArchivalStatus) FromWire(w wire.Value) error {
+	*v = (ArchivalStatus)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of ArchivalStatus.
+func (v ArchivalStatus) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "DISABLED"
+	case 1:
+		return "ENABLED"
+	}
+	return fmt.Sprintf("ArchivalStatus(%d)", w)
+}
+
+// Equals returns true if this ArchivalStatus value matches the provided
+// value.
+func (v ArchivalStatus) Equals(rhs ArchivalStatus) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes ArchivalStatus into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v ArchivalStatus) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"DISABLED\""), nil
+	case 1:
+		return ([]byte)("\"ENABLED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode ArchivalStatus from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *ArchivalStatus) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ArchivalStatus")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ArchivalStatus")
+		}
+		*v = (ArchivalStatus)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ArchivalStatus")
+	}
+}
+
 type BadRequestError struct {
 	Message string `json:"message,required"`
 }
@@ -4754,58 +4999,208 @@ func (v *CompleteWorkflowExecutionDecisionAttributes) Equals(rhs *CompleteWorkfl
 	return true
 }
 
-type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
-	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
-	TaskList                            *TaskList     `json:"taskList,omitempty"`
-	Input                               []byte        `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+type ContinueAsNewInitiator int32
+
+const (
+	ContinueAsNewInitiatorDecider      ContinueAsNewInitiator = 0
+	ContinueAsNewInitiatorRetryPolicy  ContinueAsNewInitiator = 1
+	ContinueAsNewInitiatorCronSchedule ContinueAsNewInitiator = 2
+)
+
+// ContinueAsNewInitiator_Values returns all recognized values of ContinueAsNewInitiator.
+func ContinueAsNewInitiator_Values() []ContinueAsNewInitiator {
+	return []ContinueAsNewInitiator{
+		ContinueAsNewInitiatorDecider,
+		ContinueAsNewInitiatorRetryPolicy,
+		ContinueAsNewInitiatorCronSchedule,
+	}
 }
 
-// ToWire translates a ContinueAsNewWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
+// UnmarshalText tries to decode ContinueAsNewInitiator from a byte slice
+// containing its name.
+//
+//   var v ContinueAsNewInitiator
+//   err := v.UnmarshalText([]byte("Decider"))
+func (v *ContinueAsNewInitiator) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "Decider":
+		*v = ContinueAsNewInitiatorDecider
+		return nil
+	case "RetryPolicy":
+		*v = ContinueAsNewInitiatorRetryPolicy
+		return nil
+	case "CronSchedule":
+		*v = ContinueAsNewInitiatorCronSchedule
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "ContinueAsNewInitiator")
+	}
+}
+
+// Ptr returns a pointer to this enum value.
+func (v ContinueAsNewInitiator) Ptr() *ContinueAsNewInitiator {
+	return &v
+}
+
+// ToWire translates ContinueAsNewInitiator into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
-// An error is returned if the struct or any of its fields failed to
-// validate.
+// Enums are represented as 32-bit integers over the wire.
+func (v ContinueAsNewInitiator) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes ContinueAsNewInitiator from its Thrift-level
+// representation.
 //
-//   x, err := v.ToWire()
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return err
+//     return ContinueAsNewInitiator(0), err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
+//   var v ContinueAsNewInitiator
+//   if err := v.FromWire(x); err != nil {
+//     return ContinueAsNewInitiator(0), err
 //   }
-func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
-	var (
-		fields [5]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+//   return v, nil
+func (v *ContinueAsNewInitiator) FromWire(w wire.Value) error {
+	*v = (ContinueAsNewInitiator)(w.GetI32())
+	return nil
+}
 
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
+// String returns a readable string representation of ContinueAsNewInitiator.
+func (v ContinueAsNewInitiator) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Decider"
+	case 1:
+		return "RetryPolicy"
+	case 2:
+		return "CronSchedule"
 	}
-	if v.Input != nil {
-		w, err = wire.NewValueBinary(v.Input), error(nil)
-		if err != nil {
-			return w, err
-		}
+	return fmt.Sprintf("ContinueAsNewInitiator(%d)", w)
+}
+
+// Equals returns true if this ContinueAsNewInitiator value matches the provided
+// value.
+func (v ContinueAsNewInitiator) Equals(rhs ContinueAsNewInitiator) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes ContinueAsNewInitiator into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v ContinueAsNewInitiator) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Decider\""), nil
+	case 1:
+		return ([]byte)("\"RetryPolicy\""), nil
+	case 2:
+		return ([]byte)("\"CronSchedule\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode ContinueAsNewInitiator from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *ContinueAsNewInitiator) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ContinueAsNewInitiator")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ContinueAsNewInitiator")
+		}
+		*v = (ContinueAsNewInitiator)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ContinueAsNewInitiator")
+	}
+}
+
+type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
+	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
+	TaskList                            *TaskList               `json:"taskList,omitempty"`
+	Input                               []byte                  `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                  `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                  `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	BackoffStartIntervalInSeconds       *int32                  `json:"backoffStartIntervalInSeconds,omitempty"`
+	Initiator                           *ContinueAsNewInitiator `json:"initiator,omitempty"`
+	RetryPolicy                         *RetryPolicy            `json:"retryPolicy,omitempty"`
+	FailureReason                       *string                 `json:"failureReason,omitempty"`
+	FailureDetails                      []byte                  `json:"failureDetails,omitempty"`
+	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
+}
+
+// ToWire translates a ContinueAsNewWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [11]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
@@ -4825,10 +5220,70 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.BackoffStartIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Initiator != nil {
+		w, err = v.Initiator.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.FailureReason != nil {
+		w, err = wire.NewValueString(*(v.FailureReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.FailureDetails != nil {
+		w, err = wire.NewValueBinary(v.FailureDetails), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _ContinueAsNewInitiator_Read(w wire.Value) (ContinueAsNewInitiator, error) {
+	var v ContinueAsNewInitiator
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _SearchAttributes_Read(w wire.Value) (*SearchAttributes, error) {
+	var v SearchAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a ContinueAsNewWorkflowExecutionDecisionAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -4894,6 +5349,60 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) FromWire(w wire.Value
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.BackoffStartIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x ContinueAsNewInitiator
+				x, err = _ContinueAsNewInitiator_Read(field.Value)
+				v.Initiator = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailureReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TBinary {
+				v.FailureDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4908,7 +5417,7 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [11]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -4930,10 +5439,44 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("BackoffStartIntervalInSeconds: %v", *(v.BackoffStartIntervalInSeconds))
+		i++
+	}
+	if v.Initiator != nil {
+		fields[i] = fmt.Sprintf("Initiator: %v", *(v.Initiator))
+		i++
+	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+	if v.FailureReason != nil {
+		fields[i] = fmt.Sprintf("FailureReason: %v", *(v.FailureReason))
+		i++
+	}
+	if v.FailureDetails != nil {
+		fields[i] = fmt.Sprintf("FailureDetails: %v", v.FailureDetails)
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("ContinueAsNewWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _ContinueAsNewInitiator_EqualsPtr(lhs, rhs *ContinueAsNewInitiator) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this ContinueAsNewWorkflowExecutionDecisionAttributes match the
 // provided ContinueAsNewWorkflowExecutionDecisionAttributes.
 //
@@ -4954,6 +5497,24 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) Equals(rhs *ContinueA
 	if !_I32_EqualsPtr(v.TaskStartToCloseTimeoutSeconds, rhs.TaskStartToCloseTimeoutSeconds) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.BackoffStartIntervalInSeconds, rhs.BackoffStartIntervalInSeconds) {
+		return false
+	}
+	if !_ContinueAsNewInitiator_EqualsPtr(v.Initiator, rhs.Initiator) {
+		return false
+	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FailureReason, rhs.FailureReason) {
+		return false
+	}
+	if !((v.FailureDetails == nil && rhs.FailureDetails == nil) || (v.FailureDetails != nil && rhs.FailureDetails != nil && bytes.Equal(v.FailureDetails, rhs.FailureDetails))) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -4978,236 +5539,104 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetTaskStartToCloseTi
 	return
 }
 
-type Decision struct {
-	DecisionType                                             *DecisionType                                             `json:"decisionType,omitempty"`
-	ScheduleActivityTaskDecisionAttributes                   *ScheduleActivityTaskDecisionAttributes                   `json:"scheduleActivityTaskDecisionAttributes,omitempty"`
-	StartTimerDecisionAttributes                             *StartTimerDecisionAttributes                             `json:"startTimerDecisionAttributes,omitempty"`
-	CompleteWorkflowExecutionDecisionAttributes              *CompleteWorkflowExecutionDecisionAttributes              `json:"completeWorkflowExecutionDecisionAttributes,omitempty"`
-	FailWorkflowExecutionDecisionAttributes                  *FailWorkflowExecutionDecisionAttributes                  `json:"failWorkflowExecutionDecisionAttributes,omitempty"`
-	RequestCancelActivityTaskDecisionAttributes              *RequestCancelActivityTaskDecisionAttributes              `json:"requestCancelActivityTaskDecisionAttributes,omitempty"`
-	CancelTimerDecisionAttributes                            *CancelTimerDecisionAttributes                            `json:"cancelTimerDecisionAttributes,omitempty"`
-	CancelWorkflowExecutionDecisionAttributes                *CancelWorkflowExecutionDecisionAttributes                `json:"cancelWorkflowExecutionDecisionAttributes,omitempty"`
-	RequestCancelExternalWorkflowExecutionDecisionAttributes *RequestCancelExternalWorkflowExecutionDecisionAttributes `json:"requestCancelExternalWorkflowExecutionDecisionAttributes,omitempty"`
-	RecordMarkerDecisionAttributes                           *RecordMarkerDecisionAttributes                           `json:"recordMarkerDecisionAttributes,omitempty"`
-	ContinueAsNewWorkflowExecutionDecisionAttributes         *ContinueAsNewWorkflowExecutionDecisionAttributes         `json:"continueAsNewWorkflowExecutionDecisionAttributes,omitempty"`
-	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
-	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
+// GetBackoffStartIntervalInSeconds returns the value of BackoffStartIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetBackoffStartIntervalInSeconds() (o int32) {
+	if v.BackoffStartIntervalInSeconds != nil {
+		return *v.BackoffStartIntervalInSeconds
+	}
+
+	return
 }
 
-// ToWire translates a Decision struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
+// GetInitiator returns the value of Initiator if it is set or its
+// zero value if it is unset.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetInitiator() (o ContinueAsNewInitiator) {
+	if v.Initiator != nil {
+		return *v.Initiator
+	}
+
+	return
+}
+
+// GetFailureReason returns the value of FailureReason if it is set or its
+// zero value if it is unset.
+func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetFailureReason() (o string) {
+	if v.FailureReason != nil {
+		return *v.FailureReason
+	}
+
+	return
+}
+
+type CountWorkflowExecutionsGroup struct {
+	WorkflowType *string                       `json:"workflowType,omitempty"`
+	CloseStatus  *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	Count        *int64                        `json:"count,omitempty"`
+}
+
+// ToWire translates a CountWorkflowExecutionsGroup struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
 //
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *Decision) ToWire() (wire.Value, error) {
+func (v *CountWorkflowExecutionsGroup) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DecisionType != nil {
-		w, err = v.DecisionType.ToWire()
+	if v.WorkflowType != nil {
+		w, err = wire.NewValueString(*(v.WorkflowType)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ScheduleActivityTaskDecisionAttributes != nil {
-		w, err = v.ScheduleActivityTaskDecisionAttributes.ToWire()
+	if v.CloseStatus != nil {
+		w, err = v.CloseStatus.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.StartTimerDecisionAttributes != nil {
-		w, err = v.StartTimerDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 25, Value: w}
-		i++
-	}
-	if v.CompleteWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.CompleteWorkflowExecutionDecisionAttributes.ToWire()
+	if v.Count != nil {
+		w, err = wire.NewValueI64(*(v.Count)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.FailWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.FailWorkflowExecutionDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 35, Value: w}
-		i++
-	}
-	if v.RequestCancelActivityTaskDecisionAttributes != nil {
-		w, err = v.RequestCancelActivityTaskDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.CancelTimerDecisionAttributes != nil {
-		w, err = v.CancelTimerDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.CancelWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.CancelWorkflowExecutionDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.RequestCancelExternalWorkflowExecutionDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.RecordMarkerDecisionAttributes != nil {
-		w, err = v.RecordMarkerDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.ContinueAsNewWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.ContinueAsNewWorkflowExecutionDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.StartChildWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.StartChildWorkflowExecutionDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.SignalExternalWorkflowExecutionDecisionAttributes != nil {
-		w, err = v.SignalExternalWorkflowExecutionDecisionAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DecisionType_Read(w wire.Value) (DecisionType, error) {
-	var v DecisionType
+func _WorkflowExecutionCloseStatus_Read(w wire.Value) (WorkflowExecutionCloseStatus, error) {
+	var v WorkflowExecutionCloseStatus
 	err := v.FromWire(w)
 	return v, err
 }
 
-func _ScheduleActivityTaskDecisionAttributes_Read(w wire.Value) (*ScheduleActivityTaskDecisionAttributes, error) {
-	var v ScheduleActivityTaskDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _StartTimerDecisionAttributes_Read(w wire.Value) (*StartTimerDecisionAttributes, error) {
-	var v StartTimerDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _CompleteWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*CompleteWorkflowExecutionDecisionAttributes, error) {
-	var v CompleteWorkflowExecutionDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _FailWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*FailWorkflowExecutionDecisionAttributes, error) {
-	var v FailWorkflowExecutionDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _RequestCancelActivityTaskDecisionAttributes_Read(w wire.Value) (*RequestCancelActivityTaskDecisionAttributes, error) {
-	var v RequestCancelActivityTaskDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _CancelTimerDecisionAttributes_Read(w wire.Value) (*CancelTimerDecisionAttributes, error) {
-	var v CancelTimerDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _CancelWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*CancelWorkflowExecutionDecisionAttributes, error) {
-	var v CancelWorkflowExecutionDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _RequestCancelExternalWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*RequestCancelExternalWorkflowExecutionDecisionAttributes, error) {
-	var v RequestCancelExternalWorkflowExecutionDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _RecordMarkerDecisionAttributes_Read(w wire.Value) (*RecordMarkerDecisionAttributes, error) {
-	var v RecordMarkerDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ContinueAsNewWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*ContinueAsNewWorkflowExecutionDecisionAttributes, error) {
-	var v ContinueAsNewWorkflowExecutionDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _StartChildWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*StartChildWorkflowExecutionDecisionAttributes, error) {
-	var v StartChildWorkflowExecutionDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _SignalExternalWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*SignalExternalWorkflowExecutionDecisionAttributes, error) {
-	var v SignalExternalWorkflowExecutionDecisionAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a Decision struct from its Thrift-level
+// FromWire deserializes a CountWorkflowExecutionsGroup struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a Decision struct
+// An error is returned if we were unable to build a CountWorkflowExecutionsGroup struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5215,194 +5644,78 @@ func _SignalExternalWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*Sig
 //     return nil, err
 //   }
 //
-//   var v Decision
+//   var v CountWorkflowExecutionsGroup
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *Decision) FromWire(w wire.Value) error {
+func (v *CountWorkflowExecutionsGroup) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x DecisionType
-				x, err = _DecisionType_Read(field.Value)
-				v.DecisionType = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowType = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.ScheduleActivityTaskDecisionAttributes, err = _ScheduleActivityTaskDecisionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 25:
-			if field.Value.Type() == wire.TStruct {
-				v.StartTimerDecisionAttributes, err = _StartTimerDecisionAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowExecutionCloseStatus
+				x, err = _WorkflowExecutionCloseStatus_Read(field.Value)
+				v.CloseStatus = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.CompleteWorkflowExecutionDecisionAttributes, err = _CompleteWorkflowExecutionDecisionAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Count = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 35:
-			if field.Value.Type() == wire.TStruct {
-				v.FailWorkflowExecutionDecisionAttributes, err = _FailWorkflowExecutionDecisionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.RequestCancelActivityTaskDecisionAttributes, err = _RequestCancelActivityTaskDecisionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.CancelTimerDecisionAttributes, err = _CancelTimerDecisionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
+// String returns a readable string representation of a CountWorkflowExecutionsGroup
+// struct.
+func (v *CountWorkflowExecutionsGroup) String() string {
+	if v == nil {
+		return "<nil>"
+	}
 
-			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.CancelWorkflowExecutionDecisionAttributes, err = _CancelWorkflowExecutionDecisionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TStruct {
-				v.RequestCancelExternalWorkflowExecutionDecisionAttributes, err = _RequestCancelExternalWorkflowExecutionDecisionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.RecordMarkerDecisionAttributes, err = _RecordMarkerDecisionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.ContinueAsNewWorkflowExecutionDecisionAttributes, err = _ContinueAsNewWorkflowExecutionDecisionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 100:
-			if field.Value.Type() == wire.TStruct {
-				v.StartChildWorkflowExecutionDecisionAttributes, err = _StartChildWorkflowExecutionDecisionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TStruct {
-				v.SignalExternalWorkflowExecutionDecisionAttributes, err = _SignalExternalWorkflowExecutionDecisionAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a Decision
-// struct.
-func (v *Decision) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [13]string
+	var fields [3]string
 	i := 0
-	if v.DecisionType != nil {
-		fields[i] = fmt.Sprintf("DecisionType: %v", *(v.DecisionType))
-		i++
-	}
-	if v.ScheduleActivityTaskDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("ScheduleActivityTaskDecisionAttributes: %v", v.ScheduleActivityTaskDecisionAttributes)
-		i++
-	}
-	if v.StartTimerDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("StartTimerDecisionAttributes: %v", v.StartTimerDecisionAttributes)
-		i++
-	}
-	if v.CompleteWorkflowExecutionDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("CompleteWorkflowExecutionDecisionAttributes: %v", v.CompleteWorkflowExecutionDecisionAttributes)
-		i++
-	}
-	if v.FailWorkflowExecutionDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("FailWorkflowExecutionDecisionAttributes: %v", v.FailWorkflowExecutionDecisionAttributes)
-		i++
-	}
-	if v.RequestCancelActivityTaskDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("RequestCancelActivityTaskDecisionAttributes: %v", v.RequestCancelActivityTaskDecisionAttributes)
-		i++
-	}
-	if v.CancelTimerDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("CancelTimerDecisionAttributes: %v", v.CancelTimerDecisionAttributes)
-		i++
-	}
-	if v.CancelWorkflowExecutionDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("CancelWorkflowExecutionDecisionAttributes: %v", v.CancelWorkflowExecutionDecisionAttributes)
-		i++
-	}
-	if v.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("RequestCancelExternalWorkflowExecutionDecisionAttributes: %v", v.RequestCancelExternalWorkflowExecutionDecisionAttributes)
-		i++
-	}
-	if v.RecordMarkerDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("RecordMarkerDecisionAttributes: %v", v.RecordMarkerDecisionAttributes)
-		i++
-	}
-	if v.ContinueAsNewWorkflowExecutionDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("ContinueAsNewWorkflowExecutionDecisionAttributes: %v", v.ContinueAsNewWorkflowExecutionDecisionAttributes)
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", *(v.WorkflowType))
 		i++
 	}
-	if v.StartChildWorkflowExecutionDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionDecisionAttributes: %v", v.StartChildWorkflowExecutionDecisionAttributes)
+	if v.CloseStatus != nil {
+		fields[i] = fmt.Sprintf("CloseStatus: %v", *(v.CloseStatus))
 		i++
 	}
-	if v.SignalExternalWorkflowExecutionDecisionAttributes != nil {
-		fields[i] = fmt.Sprintf("SignalExternalWorkflowExecutionDecisionAttributes: %v", v.SignalExternalWorkflowExecutionDecisionAttributes)
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
 		i++
 	}
 
-	return fmt.Sprintf("Decision{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("CountWorkflowExecutionsGroup{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _DecisionType_EqualsPtr(lhs, rhs *DecisionType) bool {
+func _WorkflowExecutionCloseStatus_EqualsPtr(lhs, rhs *WorkflowExecutionCloseStatus) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
@@ -5412,185 +5725,299 @@ func _DecisionType_EqualsPtr(lhs, rhs *DecisionType) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this Decision match the
-// provided Decision.
+// Equals returns true if all the fields of this CountWorkflowExecutionsGroup match the
+// provided CountWorkflowExecutionsGroup.
 //
 // This function performs a deep comparison.
-func (v *Decision) Equals(rhs *Decision) bool {
-	if !_DecisionType_EqualsPtr(v.DecisionType, rhs.DecisionType) {
-		return false
-	}
-	if !((v.ScheduleActivityTaskDecisionAttributes == nil && rhs.ScheduleActivityTaskDecisionAttributes == nil) || (v.ScheduleActivityTaskDecisionAttributes != nil && rhs.ScheduleActivityTaskDecisionAttributes != nil && v.ScheduleActivityTaskDecisionAttributes.Equals(rhs.ScheduleActivityTaskDecisionAttributes))) {
-		return false
-	}
-	if !((v.StartTimerDecisionAttributes == nil && rhs.StartTimerDecisionAttributes == nil) || (v.StartTimerDecisionAttributes != nil && rhs.StartTimerDecisionAttributes != nil && v.StartTimerDecisionAttributes.Equals(rhs.StartTimerDecisionAttributes))) {
-		return false
-	}
-	if !((v.CompleteWorkflowExecutionDecisionAttributes == nil && rhs.CompleteWorkflowExecutionDecisionAttributes == nil) || (v.CompleteWorkflowExecutionDecisionAttributes != nil && rhs.CompleteWorkflowExecutionDecisionAttributes != nil && v.CompleteWorkflowExecutionDecisionAttributes.Equals(rhs.CompleteWorkflowExecutionDecisionAttributes))) {
-		return false
-	}
-	if !((v.FailWorkflowExecutionDecisionAttributes == nil && rhs.FailWorkflowExecutionDecisionAttributes == nil) || (v.FailWorkflowExecutionDecisionAttributes != nil && rhs.FailWorkflowExecutionDecisionAttributes != nil && v.FailWorkflowExecutionDecisionAttributes.Equals(rhs.FailWorkflowExecutionDecisionAttributes))) {
-		return false
-	}
-	if !((v.RequestCancelActivityTaskDecisionAttributes == nil && rhs.RequestCancelActivityTaskDecisionAttributes == nil) || (v.RequestCancelActivityTaskDecisionAttributes != nil && rhs.RequestCancelActivityTaskDecisionAttributes != nil && v.RequestCancelActivityTaskDecisionAttributes.Equals(rhs.RequestCancelActivityTaskDecisionAttributes))) {
-		return false
-	}
-	if !((v.CancelTimerDecisionAttributes == nil && rhs.CancelTimerDecisionAttributes == nil) || (v.CancelTimerDecisionAttributes != nil && rhs.CancelTimerDecisionAttributes != nil && v.CancelTimerDecisionAttributes.Equals(rhs.CancelTimerDecisionAttributes))) {
-		return false
-	}
-	if !((v.CancelWorkflowExecutionDecisionAttributes == nil && rhs.CancelWorkflowExecutionDecisionAttributes == nil) || (v.CancelWorkflowExecutionDecisionAttributes != nil && rhs.CancelWorkflowExecutionDecisionAttributes != nil && v.CancelWorkflowExecutionDecisionAttributes.Equals(rhs.CancelWorkflowExecutionDecisionAttributes))) {
-		return false
-	}
-	if !((v.RequestCancelExternalWorkflowExecutionDecisionAttributes == nil && rhs.RequestCancelExternalWorkflowExecutionDecisionAttributes == nil) || (v.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil && rhs.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil && v.RequestCancelExternalWorkflowExecutionDecisionAttributes.Equals(rhs.RequestCancelExternalWorkflowExecutionDecisionAttributes))) {
+func (v *CountWorkflowExecutionsGroup) Equals(rhs *CountWorkflowExecutionsGroup) bool {
+	if !_String_EqualsPtr(v.WorkflowType, rhs.WorkflowType) {
 		return false
 	}
-	if !((v.RecordMarkerDecisionAttributes == nil && rhs.RecordMarkerDecisionAttributes == nil) || (v.RecordMarkerDecisionAttributes != nil && rhs.RecordMarkerDecisionAttributes != nil && v.RecordMarkerDecisionAttributes.Equals(rhs.RecordMarkerDecisionAttributes))) {
+	if !_WorkflowExecutionCloseStatus_EqualsPtr(v.CloseStatus, rhs.CloseStatus) {
 		return false
 	}
-	if !((v.ContinueAsNewWorkflowExecutionDecisionAttributes == nil && rhs.ContinueAsNewWorkflowExecutionDecisionAttributes == nil) || (v.ContinueAsNewWorkflowExecutionDecisionAttributes != nil && rhs.ContinueAsNewWorkflowExecutionDecisionAttributes != nil && v.ContinueAsNewWorkflowExecutionDecisionAttributes.Equals(rhs.ContinueAsNewWorkflowExecutionDecisionAttributes))) {
+	if !_I64_EqualsPtr(v.Count, rhs.Count) {
 		return false
 	}
-	if !((v.StartChildWorkflowExecutionDecisionAttributes == nil && rhs.StartChildWorkflowExecutionDecisionAttributes == nil) || (v.StartChildWorkflowExecutionDecisionAttributes != nil && rhs.StartChildWorkflowExecutionDecisionAttributes != nil && v.StartChildWorkflowExecutionDecisionAttributes.Equals(rhs.StartChildWorkflowExecutionDecisionAttributes))) {
-		return false
+
+	return true
+}
+
+// GetWorkflowType returns the value of WorkflowType if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsGroup) GetWorkflowType() (o string) {
+	if v.WorkflowType != nil {
+		return *v.WorkflowType
 	}
-	if !((v.SignalExternalWorkflowExecutionDecisionAttributes == nil && rhs.SignalExternalWorkflowExecutionDecisionAttributes == nil) || (v.SignalExternalWorkflowExecutionDecisionAttributes != nil && rhs.SignalExternalWorkflowExecutionDecisionAttributes != nil && v.SignalExternalWorkflowExecutionDecisionAttributes.Equals(rhs.SignalExternalWorkflowExecutionDecisionAttributes))) {
-		return false
+
+	return
+}
+
+// GetCloseStatus returns the value of CloseStatus if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsGroup) GetCloseStatus() (o WorkflowExecutionCloseStatus) {
+	if v.CloseStatus != nil {
+		return *v.CloseStatus
 	}
 
-	return true
+	return
 }
 
-// GetDecisionType returns the value of DecisionType if it is set or its
+// GetCount returns the value of Count if it is set or its
 // zero value if it is unset.
-func (v *Decision) GetDecisionType() (o DecisionType) {
-	if v.DecisionType != nil {
-		return *v.DecisionType
+func (v *CountWorkflowExecutionsGroup) GetCount() (o int64) {
+	if v.Count != nil {
+		return *v.Count
 	}
 
 	return
 }
 
-type DecisionTaskCompletedEventAttributes struct {
-	ExecutionContext []byte  `json:"executionContext,omitempty"`
-	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
-	StartedEventId   *int64  `json:"startedEventId,omitempty"`
-	Identity         *string `json:"identity,omitempty"`
+type CountWorkflowExecutionsGroupBy int32
+
+const (
+	CountWorkflowExecutionsGroupByWorkflowType CountWorkflowExecutionsGroupBy = 0
+	CountWorkflowExecutionsGroupByCloseStatus  CountWorkflowExecutionsGroupBy = 1
+)
+
+// CountWorkflowExecutionsGroupBy_Values returns all recognized values of CountWorkflowExecutionsGroupBy.
+func CountWorkflowExecutionsGroupBy_Values() []CountWorkflowExecutionsGroupBy {
+	return []CountWorkflowExecutionsGroupBy{
+		CountWorkflowExecutionsGroupByWorkflowType,
+		CountWorkflowExecutionsGroupByCloseStatus,
+	}
 }
 
-// ToWire translates a DecisionTaskCompletedEventAttributes struct into a Thrift-level intermediate
+// UnmarshalText tries to decode CountWorkflowExecutionsGroupBy from a byte slice
+// containing its name.
+//
+//   var v CountWorkflowExecutionsGroupBy
+//   err := v.UnmarshalText([]byte("WORKFLOW_TYPE"))
+func (v *CountWorkflowExecutionsGroupBy) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "WORKFLOW_TYPE":
+		*v = CountWorkflowExecutionsGroupByWorkflowType
+		return nil
+	case "CLOSE_STATUS":
+		*v = CountWorkflowExecutionsGroupByCloseStatus
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "CountWorkflowExecutionsGroupBy")
+	}
+}
+
+// Ptr returns a pointer to this enum value.
+func (v CountWorkflowExecutionsGroupBy) Ptr() *CountWorkflowExecutionsGroupBy {
+	return &v
+}
+
+// ToWire translates CountWorkflowExecutionsGroupBy into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
-// An error is returned if the struct or any of its fields failed to
-// validate.
+// Enums are represented as 32-bit integers over the wire.
+func (v CountWorkflowExecutionsGroupBy) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes CountWorkflowExecutionsGroupBy from its Thrift-level
+// representation.
 //
-//   x, err := v.ToWire()
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return err
+//     return CountWorkflowExecutionsGroupBy(0), err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
+//   var v CountWorkflowExecutionsGroupBy
+//   if err := v.FromWire(x); err != nil {
+//     return CountWorkflowExecutionsGroupBy(0), err
 //   }
-func (v *DecisionTaskCompletedEventAttributes) ToWire() (wire.Value, error) {
-	var (
-		fields [4]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+//   return v, nil
+func (v *CountWorkflowExecutionsGroupBy) FromWire(w wire.Value) error {
+	*v = (CountWorkflowExecutionsGroupBy)(w.GetI32())
+	return nil
+}
 
-	if v.ExecutionContext != nil {
-		w, err = wire.NewValueBinary(v.ExecutionContext), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.ScheduledEventId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+// String returns a readable string representation of CountWorkflowExecutionsGroupBy.
+func (v CountWorkflowExecutionsGroupBy) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "WORKFLOW_TYPE"
+	case 1:
+		return "CLOSE_STATUS"
 	}
+	return fmt.Sprintf("CountWorkflowExecutionsGroupBy(%d)", w)
+}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+// Equals returns true if this CountWorkflowExecutionsGroupBy value matches the provided
+// value.
+func (v CountWorkflowExecutionsGroupBy) Equals(rhs CountWorkflowExecutionsGroupBy) bool {
+	return v == rhs
 }
 
-// FromWire deserializes a DecisionTaskCompletedEventAttributes struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
+// MarshalJSON serializes CountWorkflowExecutionsGroupBy into JSON.
 //
-// An error is returned if we were unable to build a DecisionTaskCompletedEventAttributes struct
-// from the provided intermediate representation.
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v CountWorkflowExecutionsGroupBy) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"WORKFLOW_TYPE\""), nil
+	case 1:
+		return ([]byte)("\"CLOSE_STATUS\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode CountWorkflowExecutionsGroupBy from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *CountWorkflowExecutionsGroupBy) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "CountWorkflowExecutionsGroupBy")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "CountWorkflowExecutionsGroupBy")
+		}
+		*v = (CountWorkflowExecutionsGroupBy)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "CountWorkflowExecutionsGroupBy")
+	}
+}
+
+type CountWorkflowExecutionsRequest struct {
+	Domain  *string                         `json:"domain,omitempty"`
+	Query   *string                         `json:"query,omitempty"`
+	GroupBy *CountWorkflowExecutionsGroupBy `json:"groupBy,omitempty"`
+}
+
+// ToWire translates a CountWorkflowExecutionsRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *CountWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Query != nil {
+		w, err = wire.NewValueString(*(v.Query)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.GroupBy != nil {
+		w, err = v.GroupBy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _CountWorkflowExecutionsGroupBy_Read(w wire.Value) (CountWorkflowExecutionsGroupBy, error) {
+	var v CountWorkflowExecutionsGroupBy
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a CountWorkflowExecutionsRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CountWorkflowExecutionsRequest struct
+// from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
 //   if err != nil {
 //     return nil, err
 //   }
 //
-//   var v DecisionTaskCompletedEventAttributes
+//   var v CountWorkflowExecutionsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DecisionTaskCompletedEventAttributes) FromWire(w wire.Value) error {
+func (v *CountWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.ExecutionContext, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Query = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+			if field.Value.Type() == wire.TI32 {
+				var x CountWorkflowExecutionsGroupBy
+				x, err = _CountWorkflowExecutionsGroupBy_Read(field.Value)
+				v.GroupBy = &x
 				if err != nil {
 					return err
 				}
@@ -5602,358 +6029,126 @@ func (v *DecisionTaskCompletedEventAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DecisionTaskCompletedEventAttributes
+// String returns a readable string representation of a CountWorkflowExecutionsRequest
 // struct.
-func (v *DecisionTaskCompletedEventAttributes) String() string {
+func (v *CountWorkflowExecutionsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [3]string
 	i := 0
-	if v.ExecutionContext != nil {
-		fields[i] = fmt.Sprintf("ExecutionContext: %v", v.ExecutionContext)
-		i++
-	}
-	if v.ScheduledEventId != nil {
-		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", *(v.Query))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.GroupBy != nil {
+		fields[i] = fmt.Sprintf("GroupBy: %v", *(v.GroupBy))
 		i++
 	}
 
-	return fmt.Sprintf("DecisionTaskCompletedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("CountWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DecisionTaskCompletedEventAttributes match the
-// provided DecisionTaskCompletedEventAttributes.
+func _CountWorkflowExecutionsGroupBy_EqualsPtr(lhs, rhs *CountWorkflowExecutionsGroupBy) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this CountWorkflowExecutionsRequest match the
+// provided CountWorkflowExecutionsRequest.
 //
 // This function performs a deep comparison.
-func (v *DecisionTaskCompletedEventAttributes) Equals(rhs *DecisionTaskCompletedEventAttributes) bool {
-	if !((v.ExecutionContext == nil && rhs.ExecutionContext == nil) || (v.ExecutionContext != nil && rhs.ExecutionContext != nil && bytes.Equal(v.ExecutionContext, rhs.ExecutionContext))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
+func (v *CountWorkflowExecutionsRequest) Equals(rhs *CountWorkflowExecutionsRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+	if !_String_EqualsPtr(v.Query, rhs.Query) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !_CountWorkflowExecutionsGroupBy_EqualsPtr(v.GroupBy, rhs.GroupBy) {
 		return false
 	}
 
 	return true
 }
 
-// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *DecisionTaskCompletedEventAttributes) GetScheduledEventId() (o int64) {
-	if v.ScheduledEventId != nil {
-		return *v.ScheduledEventId
+func (v *CountWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// GetStartedEventId returns the value of StartedEventId if it is set or its
+// GetQuery returns the value of Query if it is set or its
 // zero value if it is unset.
-func (v *DecisionTaskCompletedEventAttributes) GetStartedEventId() (o int64) {
-	if v.StartedEventId != nil {
-		return *v.StartedEventId
+func (v *CountWorkflowExecutionsRequest) GetQuery() (o string) {
+	if v.Query != nil {
+		return *v.Query
 	}
 
 	return
 }
 
-// GetIdentity returns the value of Identity if it is set or its
+// GetGroupBy returns the value of GroupBy if it is set or its
 // zero value if it is unset.
-func (v *DecisionTaskCompletedEventAttributes) GetIdentity() (o string) {
-	if v.Identity != nil {
-		return *v.Identity
+func (v *CountWorkflowExecutionsRequest) GetGroupBy() (o CountWorkflowExecutionsGroupBy) {
+	if v.GroupBy != nil {
+		return *v.GroupBy
 	}
 
 	return
 }
 
-type DecisionTaskFailedCause int32
+type CountWorkflowExecutionsResponse struct {
+	Count  *int64                          `json:"count,omitempty"`
+	Groups []*CountWorkflowExecutionsGroup `json:"groups,omitempty"`
+}
 
-const (
-	DecisionTaskFailedCauseUnhandledDecision                                   DecisionTaskFailedCause = 0
-	DecisionTaskFailedCauseBadScheduleActivityAttributes                       DecisionTaskFailedCause = 1
-	DecisionTaskFailedCauseBadRequestCancelActivityAttributes                  DecisionTaskFailedCause = 2
-	DecisionTaskFailedCauseBadStartTimerAttributes                             DecisionTaskFailedCause = 3
-	DecisionTaskFailedCauseBadCancelTimerAttributes                            DecisionTaskFailedCause = 4
-	DecisionTaskFailedCauseBadRecordMarkerAttributes                           DecisionTaskFailedCause = 5
-	DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes              DecisionTaskFailedCause = 6
-	DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes                  DecisionTaskFailedCause = 7
-	DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes                DecisionTaskFailedCause = 8
-	DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes DecisionTaskFailedCause = 9
-	DecisionTaskFailedCauseBadContinueAsNewAttributes                          DecisionTaskFailedCause = 10
-	DecisionTaskFailedCauseStartTimerDuplicateID                               DecisionTaskFailedCause = 11
-	DecisionTaskFailedCauseResetStickyTasklist                                 DecisionTaskFailedCause = 12
-	DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure                      DecisionTaskFailedCause = 13
-	DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes                DecisionTaskFailedCause = 14
-	DecisionTaskFailedCauseBadStartChildExecutionAttributes                    DecisionTaskFailedCause = 15
-)
+type _List_CountWorkflowExecutionsGroup_ValueList []*CountWorkflowExecutionsGroup
 
-// DecisionTaskFailedCause_Values returns all recognized values of DecisionTaskFailedCause.
-func DecisionTaskFailedCause_Values() []DecisionTaskFailedCause {
-	return []DecisionTaskFailedCause{
-		DecisionTaskFailedCauseUnhandledDecision,
-		DecisionTaskFailedCauseBadScheduleActivityAttributes,
-		DecisionTaskFailedCauseBadRequestCancelActivityAttributes,
-		DecisionTaskFailedCauseBadStartTimerAttributes,
-		DecisionTaskFailedCauseBadCancelTimerAttributes,
-		DecisionTaskFailedCauseBadRecordMarkerAttributes,
-		DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes,
-		DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes,
-		DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes,
-		DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes,
-		DecisionTaskFailedCauseBadContinueAsNewAttributes,
-		DecisionTaskFailedCauseStartTimerDuplicateID,
-		DecisionTaskFailedCauseResetStickyTasklist,
-		DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure,
-		DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes,
-		DecisionTaskFailedCauseBadStartChildExecutionAttributes,
+func (v _List_CountWorkflowExecutionsGroup_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-// UnmarshalText tries to decode DecisionTaskFailedCause from a byte slice
-// containing its name.
-//
-//   var v DecisionTaskFailedCause
-//   err := v.UnmarshalText([]byte("UNHANDLED_DECISION"))
-func (v *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
-	switch string(value) {
-	case "UNHANDLED_DECISION":
-		*v = DecisionTaskFailedCauseUnhandledDecision
-		return nil
-	case "BAD_SCHEDULE_ACTIVITY_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadScheduleActivityAttributes
-		return nil
-	case "BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadRequestCancelActivityAttributes
-		return nil
-	case "BAD_START_TIMER_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadStartTimerAttributes
-		return nil
-	case "BAD_CANCEL_TIMER_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadCancelTimerAttributes
-		return nil
-	case "BAD_RECORD_MARKER_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadRecordMarkerAttributes
-		return nil
-	case "BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes
-		return nil
-	case "BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes
-		return nil
-	case "BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes
-		return nil
-	case "BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes
-		return nil
-	case "BAD_CONTINUE_AS_NEW_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadContinueAsNewAttributes
-		return nil
-	case "START_TIMER_DUPLICATE_ID":
-		*v = DecisionTaskFailedCauseStartTimerDuplicateID
-		return nil
-	case "RESET_STICKY_TASKLIST":
-		*v = DecisionTaskFailedCauseResetStickyTasklist
-		return nil
-	case "WORKFLOW_WORKER_UNHANDLED_FAILURE":
-		*v = DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure
-		return nil
-	case "BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes
-		return nil
-	case "BAD_START_CHILD_EXECUTION_ATTRIBUTES":
-		*v = DecisionTaskFailedCauseBadStartChildExecutionAttributes
-		return nil
-	default:
-		return fmt.Errorf("unknown enum value %q for %q", value, "DecisionTaskFailedCause")
-	}
-}
-
-// Ptr returns a pointer to this enum value.
-func (v DecisionTaskFailedCause) Ptr() *DecisionTaskFailedCause {
-	return &v
-}
-
-// ToWire translates DecisionTaskFailedCause into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v DecisionTaskFailedCause) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes DecisionTaskFailedCause from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return DecisionTaskFailedCause(0), err
-//   }
-//
-//   var v DecisionTaskFailedCause
-//   if err := v.FromWire(x); err != nil {
-//     return DecisionTaskFailedCause(0), err
-//   }
-//   return v, nil
-func (v *DecisionTaskFailedCause) FromWire(w wire.Value) error {
-	*v = (DecisionTaskFailedCause)(w.GetI32())
-	return nil
-}
-
-// String returns a readable string representation of DecisionTaskFailedCause.
-func (v DecisionTaskFailedCause) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "UNHANDLED_DECISION"
-	case 1:
-		return "BAD_SCHEDULE_ACTIVITY_ATTRIBUTES"
-	case 2:
-		return "BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES"
-	case 3:
-		return "BAD_START_TIMER_ATTRIBUTES"
-	case 4:
-		return "BAD_CANCEL_TIMER_ATTRIBUTES"
-	case 5:
-		return "BAD_RECORD_MARKER_ATTRIBUTES"
-	case 6:
-		return "BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES"
-	case 7:
-		return "BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES"
-	case 8:
-		return "BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES"
-	case 9:
-		return "BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES"
-	case 10:
-		return "BAD_CONTINUE_AS_NEW_ATTRIBUTES"
-	case 11:
-		return "START_TIMER_DUPLICATE_ID"
-	case 12:
-		return "RESET_STICKY_TASKLIST"
-	case 13:
-		return "WORKFLOW_WORKER_UNHANDLED_FAILURE"
-	case 14:
-		return "BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES"
-	case 15:
-		return "BAD_START_CHILD_EXECUTION_ATTRIBUTES"
-	}
-	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
-}
-
-// Equals returns true if this DecisionTaskFailedCause value matches the provided
-// value.
-func (v DecisionTaskFailedCause) Equals(rhs DecisionTaskFailedCause) bool {
-	return v == rhs
-}
-
-// MarshalJSON serializes DecisionTaskFailedCause into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v DecisionTaskFailedCause) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"UNHANDLED_DECISION\""), nil
-	case 1:
-		return ([]byte)("\"BAD_SCHEDULE_ACTIVITY_ATTRIBUTES\""), nil
-	case 2:
-		return ([]byte)("\"BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES\""), nil
-	case 3:
-		return ([]byte)("\"BAD_START_TIMER_ATTRIBUTES\""), nil
-	case 4:
-		return ([]byte)("\"BAD_CANCEL_TIMER_ATTRIBUTES\""), nil
-	case 5:
-		return ([]byte)("\"BAD_RECORD_MARKER_ATTRIBUTES\""), nil
-	case 6:
-		return ([]byte)("\"BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
-	case 7:
-		return ([]byte)("\"BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
-	case 8:
-		return ([]byte)("\"BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
-	case 9:
-		return ([]byte)("\"BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
-	case 10:
-		return ([]byte)("\"BAD_CONTINUE_AS_NEW_ATTRIBUTES\""), nil
-	case 11:
-		return ([]byte)("\"START_TIMER_DUPLICATE_ID\""), nil
-	case 12:
-		return ([]byte)("\"RESET_STICKY_TASKLIST\""), nil
-	case 13:
-		return ([]byte)("\"WORKFLOW_WORKER_UNHANDLED_FAILURE\""), nil
-	case 14:
-		return ([]byte)("\"BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
-	case 15:
-		return ([]byte)("\"BAD_START_CHILD_EXECUTION_ATTRIBUTES\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// UnmarshalJSON attempts to decode DecisionTaskFailedCause from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *DecisionTaskFailedCause) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DecisionTaskFailedCause")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DecisionTaskFailedCause")
-		}
-		*v = (DecisionTaskFailedCause)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DecisionTaskFailedCause")
-	}
-}
-
-type DecisionTaskFailedEventAttributes struct {
-	ScheduledEventId *int64                   `json:"scheduledEventId,omitempty"`
-	StartedEventId   *int64                   `json:"startedEventId,omitempty"`
-	Cause            *DecisionTaskFailedCause `json:"cause,omitempty"`
-	Details          []byte                   `json:"details,omitempty"`
-	Identity         *string                  `json:"identity,omitempty"`
-}
-
-// ToWire translates a DecisionTaskFailedEventAttributes struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+func (v _List_CountWorkflowExecutionsGroup_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_CountWorkflowExecutionsGroup_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_CountWorkflowExecutionsGroup_ValueList) Close() {}
+
+// ToWire translates a CountWorkflowExecutionsResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
@@ -5966,82 +6161,76 @@ type DecisionTaskFailedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DecisionTaskFailedEventAttributes) ToWire() (wire.Value, error) {
+func (v *CountWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ScheduledEventId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
+	if v.Count != nil {
+		w, err = wire.NewValueI64(*(v.Count)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+	if v.Groups != nil {
+		w, err = wire.NewValueList(_List_CountWorkflowExecutionsGroup_ValueList(v.Groups)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Cause != nil {
-		w, err = v.Cause.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 35, Value: w}
-		i++
-	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DecisionTaskFailedCause_Read(w wire.Value) (DecisionTaskFailedCause, error) {
-	var v DecisionTaskFailedCause
+func _CountWorkflowExecutionsGroup_Read(w wire.Value) (*CountWorkflowExecutionsGroup, error) {
+	var v CountWorkflowExecutionsGroup
 	err := v.FromWire(w)
-	return v, err
+	return &v, err
 }
 
-// FromWire deserializes a DecisionTaskFailedEventAttributes struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a DecisionTaskFailedEventAttributes struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
+func _List_CountWorkflowExecutionsGroup_Read(l wire.ValueList) ([]*CountWorkflowExecutionsGroup, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*CountWorkflowExecutionsGroup, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _CountWorkflowExecutionsGroup_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a CountWorkflowExecutionsResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CountWorkflowExecutionsResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
 //     return nil, err
 //   }
 //
-//   var v DecisionTaskFailedEventAttributes
+//   var v CountWorkflowExecutionsResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DecisionTaskFailedEventAttributes) FromWire(w wire.Value) error {
+func (v *CountWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -6050,45 +6239,15 @@ func (v *DecisionTaskFailedEventAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledEventId = &x
+				v.Count = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI32 {
-				var x DecisionTaskFailedCause
-				x, err = _DecisionTaskFailedCause_Read(field.Value)
-				v.Cause = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 35:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+			if field.Value.Type() == wire.TList {
+				v.Groups, err = _List_CountWorkflowExecutionsGroup_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -6100,120 +6259,85 @@ func (v *DecisionTaskFailedEventAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DecisionTaskFailedEventAttributes
+// String returns a readable string representation of a CountWorkflowExecutionsResponse
 // struct.
-func (v *DecisionTaskFailedEventAttributes) String() string {
+func (v *CountWorkflowExecutionsResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [2]string
 	i := 0
-	if v.ScheduledEventId != nil {
-		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
 		i++
 	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
-		i++
-	}
-	if v.Cause != nil {
-		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
-		i++
-	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
-		i++
-	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.Groups != nil {
+		fields[i] = fmt.Sprintf("Groups: %v", v.Groups)
 		i++
 	}
 
-	return fmt.Sprintf("DecisionTaskFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("CountWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _DecisionTaskFailedCause_EqualsPtr(lhs, rhs *DecisionTaskFailedCause) bool {
-	if lhs != nil && rhs != nil {
+func _List_CountWorkflowExecutionsGroup_Equals(lhs, rhs []*CountWorkflowExecutionsGroup) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
 	}
-	return lhs == nil && rhs == nil
+
+	return true
 }
 
-// Equals returns true if all the fields of this DecisionTaskFailedEventAttributes match the
-// provided DecisionTaskFailedEventAttributes.
+// Equals returns true if all the fields of this CountWorkflowExecutionsResponse match the
+// provided CountWorkflowExecutionsResponse.
 //
 // This function performs a deep comparison.
-func (v *DecisionTaskFailedEventAttributes) Equals(rhs *DecisionTaskFailedEventAttributes) bool {
-	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
-		return false
-	}
-	if !_DecisionTaskFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
-		return false
-	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+func (v *CountWorkflowExecutionsResponse) Equals(rhs *CountWorkflowExecutionsResponse) bool {
+	if !_I64_EqualsPtr(v.Count, rhs.Count) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !((v.Groups == nil && rhs.Groups == nil) || (v.Groups != nil && rhs.Groups != nil && _List_CountWorkflowExecutionsGroup_Equals(v.Groups, rhs.Groups))) {
 		return false
 	}
 
 	return true
 }
 
-// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
-// zero value if it is unset.
-func (v *DecisionTaskFailedEventAttributes) GetScheduledEventId() (o int64) {
-	if v.ScheduledEventId != nil {
-		return *v.ScheduledEventId
-	}
-
-	return
-}
-
-// GetStartedEventId returns the value of StartedEventId if it is set or its
-// zero value if it is unset.
-func (v *DecisionTaskFailedEventAttributes) GetStartedEventId() (o int64) {
-	if v.StartedEventId != nil {
-		return *v.StartedEventId
-	}
-
-	return
-}
-
-// GetCause returns the value of Cause if it is set or its
-// zero value if it is unset.
-func (v *DecisionTaskFailedEventAttributes) GetCause() (o DecisionTaskFailedCause) {
-	if v.Cause != nil {
-		return *v.Cause
-	}
-
-	return
-}
-
-// GetIdentity returns the value of Identity if it is set or its
+// GetCount returns the value of Count if it is set or its
 // zero value if it is unset.
-func (v *DecisionTaskFailedEventAttributes) GetIdentity() (o string) {
-	if v.Identity != nil {
-		return *v.Identity
+func (v *CountWorkflowExecutionsResponse) GetCount() (o int64) {
+	if v.Count != nil {
+		return *v.Count
 	}
 
 	return
 }
 
-type DecisionTaskScheduledEventAttributes struct {
-	TaskList                   *TaskList `json:"taskList,omitempty"`
-	StartToCloseTimeoutSeconds *int32    `json:"startToCloseTimeoutSeconds,omitempty"`
-	Attempt                    *int64    `json:"attempt,omitempty"`
+type Decision struct {
+	DecisionType                                             *DecisionType                                             `json:"decisionType,omitempty"`
+	ScheduleActivityTaskDecisionAttributes                   *ScheduleActivityTaskDecisionAttributes                   `json:"scheduleActivityTaskDecisionAttributes,omitempty"`
+	StartTimerDecisionAttributes                             *StartTimerDecisionAttributes                             `json:"startTimerDecisionAttributes,omitempty"`
+	CompleteWorkflowExecutionDecisionAttributes              *CompleteWorkflowExecutionDecisionAttributes              `json:"completeWorkflowExecutionDecisionAttributes,omitempty"`
+	FailWorkflowExecutionDecisionAttributes                  *FailWorkflowExecutionDecisionAttributes                  `json:"failWorkflowExecutionDecisionAttributes,omitempty"`
+	RequestCancelActivityTaskDecisionAttributes              *RequestCancelActivityTaskDecisionAttributes              `json:"requestCancelActivityTaskDecisionAttributes,omitempty"`
+	CancelTimerDecisionAttributes                            *CancelTimerDecisionAttributes                            `json:"cancelTimerDecisionAttributes,omitempty"`
+	CancelWorkflowExecutionDecisionAttributes                *CancelWorkflowExecutionDecisionAttributes                `json:"cancelWorkflowExecutionDecisionAttributes,omitempty"`
+	RequestCancelExternalWorkflowExecutionDecisionAttributes *RequestCancelExternalWorkflowExecutionDecisionAttributes `json:"requestCancelExternalWorkflowExecutionDecisionAttributes,omitempty"`
+	RecordMarkerDecisionAttributes                           *RecordMarkerDecisionAttributes                           `json:"recordMarkerDecisionAttributes,omitempty"`
+	ContinueAsNewWorkflowExecutionDecisionAttributes         *ContinueAsNewWorkflowExecutionDecisionAttributes         `json:"continueAsNewWorkflowExecutionDecisionAttributes,omitempty"`
+	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
+	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
+	UpsertWorkflowSearchAttributesDecisionAttributes         *UpsertWorkflowSearchAttributesDecisionAttributes         `json:"upsertWorkflowSearchAttributesDecisionAttributes,omitempty"`
 }
 
-// ToWire translates a DecisionTaskScheduledEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a Decision struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6228,223 +6352,219 @@ type DecisionTaskScheduledEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DecisionTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
+func (v *Decision) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.DecisionType != nil {
+		w, err = v.DecisionType.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.StartToCloseTimeoutSeconds)), error(nil)
+	if v.ScheduleActivityTaskDecisionAttributes != nil {
+		w, err = v.ScheduleActivityTaskDecisionAttributes.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+	if v.StartTimerDecisionAttributes != nil {
+		w, err = v.StartTimerDecisionAttributes.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 25, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a DecisionTaskScheduledEventAttributes struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a DecisionTaskScheduledEventAttributes struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v DecisionTaskScheduledEventAttributes
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *DecisionTaskScheduledEventAttributes) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.StartToCloseTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
+	if v.CompleteWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.CompleteWorkflowExecutionDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
 		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a DecisionTaskScheduledEventAttributes
-// struct.
-func (v *DecisionTaskScheduledEventAttributes) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [3]string
-	i := 0
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
+	if v.FailWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.FailWorkflowExecutionDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 35, Value: w}
 		i++
 	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+	if v.RequestCancelActivityTaskDecisionAttributes != nil {
+		w, err = v.RequestCancelActivityTaskDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-
-	return fmt.Sprintf("DecisionTaskScheduledEventAttributes{%v}", strings.Join(fields[:i], ", "))
-}
-
-// Equals returns true if all the fields of this DecisionTaskScheduledEventAttributes match the
-// provided DecisionTaskScheduledEventAttributes.
-//
-// This function performs a deep comparison.
-func (v *DecisionTaskScheduledEventAttributes) Equals(rhs *DecisionTaskScheduledEventAttributes) bool {
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
+	if v.CancelTimerDecisionAttributes != nil {
+		w, err = v.CancelTimerDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
 	}
-	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
-		return false
+	if v.CancelWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.CancelWorkflowExecutionDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
 	}
-	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
+	if v.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.RequestCancelExternalWorkflowExecutionDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
 	}
-
-	return true
-}
-
-// GetStartToCloseTimeoutSeconds returns the value of StartToCloseTimeoutSeconds if it is set or its
-// zero value if it is unset.
-func (v *DecisionTaskScheduledEventAttributes) GetStartToCloseTimeoutSeconds() (o int32) {
-	if v.StartToCloseTimeoutSeconds != nil {
-		return *v.StartToCloseTimeoutSeconds
+	if v.RecordMarkerDecisionAttributes != nil {
+		w, err = v.RecordMarkerDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
 	}
-
-	return
-}
-
-// GetAttempt returns the value of Attempt if it is set or its
-// zero value if it is unset.
-func (v *DecisionTaskScheduledEventAttributes) GetAttempt() (o int64) {
-	if v.Attempt != nil {
-		return *v.Attempt
+	if v.ContinueAsNewWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.ContinueAsNewWorkflowExecutionDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
 	}
-
-	return
-}
-
-type DecisionTaskStartedEventAttributes struct {
-	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
-	Identity         *string `json:"identity,omitempty"`
-	RequestId        *string `json:"requestId,omitempty"`
-}
-
-// ToWire translates a DecisionTaskStartedEventAttributes struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *DecisionTaskStartedEventAttributes) ToWire() (wire.Value, error) {
-	var (
-		fields [3]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.ScheduledEventId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
+	if v.StartChildWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.StartChildWorkflowExecutionDecisionAttributes.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 100, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.SignalExternalWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.SignalExternalWorkflowExecutionDecisionAttributes.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+	if v.UpsertWorkflowSearchAttributesDecisionAttributes != nil {
+		w, err = v.UpsertWorkflowSearchAttributesDecisionAttributes.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DecisionTaskStartedEventAttributes struct from its Thrift-level
+func _DecisionType_Read(w wire.Value) (DecisionType, error) {
+	var v DecisionType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _ScheduleActivityTaskDecisionAttributes_Read(w wire.Value) (*ScheduleActivityTaskDecisionAttributes, error) {
+	var v ScheduleActivityTaskDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _StartTimerDecisionAttributes_Read(w wire.Value) (*StartTimerDecisionAttributes, error) {
+	var v StartTimerDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _CompleteWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*CompleteWorkflowExecutionDecisionAttributes, error) {
+	var v CompleteWorkflowExecutionDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _FailWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*FailWorkflowExecutionDecisionAttributes, error) {
+	var v FailWorkflowExecutionDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _RequestCancelActivityTaskDecisionAttributes_Read(w wire.Value) (*RequestCancelActivityTaskDecisionAttributes, error) {
+	var v RequestCancelActivityTaskDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _CancelTimerDecisionAttributes_Read(w wire.Value) (*CancelTimerDecisionAttributes, error) {
+	var v CancelTimerDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _CancelWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*CancelWorkflowExecutionDecisionAttributes, error) {
+	var v CancelWorkflowExecutionDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _RequestCancelExternalWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*RequestCancelExternalWorkflowExecutionDecisionAttributes, error) {
+	var v RequestCancelExternalWorkflowExecutionDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _RecordMarkerDecisionAttributes_Read(w wire.Value) (*RecordMarkerDecisionAttributes, error) {
+	var v RecordMarkerDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ContinueAsNewWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*ContinueAsNewWorkflowExecutionDecisionAttributes, error) {
+	var v ContinueAsNewWorkflowExecutionDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _StartChildWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*StartChildWorkflowExecutionDecisionAttributes, error) {
+	var v StartChildWorkflowExecutionDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SignalExternalWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*SignalExternalWorkflowExecutionDecisionAttributes, error) {
+	var v SignalExternalWorkflowExecutionDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _UpsertWorkflowSearchAttributesDecisionAttributes_Read(w wire.Value) (*UpsertWorkflowSearchAttributesDecisionAttributes, error) {
+	var v UpsertWorkflowSearchAttributesDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Decision struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DecisionTaskStartedEventAttributes struct
+// An error is returned if we were unable to build a Decision struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6452,132 +6572,284 @@ func (v *DecisionTaskStartedEventAttributes) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DecisionTaskStartedEventAttributes
+//   var v Decision
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DecisionTaskStartedEventAttributes) FromWire(w wire.Value) error {
+func (v *Decision) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledEventId = &x
+			if field.Value.Type() == wire.TI32 {
+				var x DecisionType
+				x, err = _DecisionType_Read(field.Value)
+				v.DecisionType = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+			if field.Value.Type() == wire.TStruct {
+				v.ScheduleActivityTaskDecisionAttributes, err = _ScheduleActivityTaskDecisionAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
+		case 25:
+			if field.Value.Type() == wire.TStruct {
+				v.StartTimerDecisionAttributes, err = _StartTimerDecisionAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		}
-	}
-
-	return nil
-}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.CompleteWorkflowExecutionDecisionAttributes, err = _CompleteWorkflowExecutionDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-// String returns a readable string representation of a DecisionTaskStartedEventAttributes
+			}
+		case 35:
+			if field.Value.Type() == wire.TStruct {
+				v.FailWorkflowExecutionDecisionAttributes, err = _FailWorkflowExecutionDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.RequestCancelActivityTaskDecisionAttributes, err = _RequestCancelActivityTaskDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.CancelTimerDecisionAttributes, err = _CancelTimerDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.CancelWorkflowExecutionDecisionAttributes, err = _CancelWorkflowExecutionDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.RequestCancelExternalWorkflowExecutionDecisionAttributes, err = _RequestCancelExternalWorkflowExecutionDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.RecordMarkerDecisionAttributes, err = _RecordMarkerDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.ContinueAsNewWorkflowExecutionDecisionAttributes, err = _ContinueAsNewWorkflowExecutionDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.StartChildWorkflowExecutionDecisionAttributes, err = _StartChildWorkflowExecutionDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TStruct {
+				v.SignalExternalWorkflowExecutionDecisionAttributes, err = _SignalExternalWorkflowExecutionDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.UpsertWorkflowSearchAttributesDecisionAttributes, err = _UpsertWorkflowSearchAttributesDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Decision
 // struct.
-func (v *DecisionTaskStartedEventAttributes) String() string {
+func (v *Decision) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [14]string
 	i := 0
-	if v.ScheduledEventId != nil {
-		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
+	if v.DecisionType != nil {
+		fields[i] = fmt.Sprintf("DecisionType: %v", *(v.DecisionType))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.ScheduleActivityTaskDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("ScheduleActivityTaskDecisionAttributes: %v", v.ScheduleActivityTaskDecisionAttributes)
 		i++
 	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+	if v.StartTimerDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("StartTimerDecisionAttributes: %v", v.StartTimerDecisionAttributes)
+		i++
+	}
+	if v.CompleteWorkflowExecutionDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("CompleteWorkflowExecutionDecisionAttributes: %v", v.CompleteWorkflowExecutionDecisionAttributes)
+		i++
+	}
+	if v.FailWorkflowExecutionDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("FailWorkflowExecutionDecisionAttributes: %v", v.FailWorkflowExecutionDecisionAttributes)
+		i++
+	}
+	if v.RequestCancelActivityTaskDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("RequestCancelActivityTaskDecisionAttributes: %v", v.RequestCancelActivityTaskDecisionAttributes)
+		i++
+	}
+	if v.CancelTimerDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("CancelTimerDecisionAttributes: %v", v.CancelTimerDecisionAttributes)
+		i++
+	}
+	if v.CancelWorkflowExecutionDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("CancelWorkflowExecutionDecisionAttributes: %v", v.CancelWorkflowExecutionDecisionAttributes)
+		i++
+	}
+	if v.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("RequestCancelExternalWorkflowExecutionDecisionAttributes: %v", v.RequestCancelExternalWorkflowExecutionDecisionAttributes)
+		i++
+	}
+	if v.RecordMarkerDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("RecordMarkerDecisionAttributes: %v", v.RecordMarkerDecisionAttributes)
+		i++
+	}
+	if v.ContinueAsNewWorkflowExecutionDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("ContinueAsNewWorkflowExecutionDecisionAttributes: %v", v.ContinueAsNewWorkflowExecutionDecisionAttributes)
+		i++
+	}
+	if v.StartChildWorkflowExecutionDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionDecisionAttributes: %v", v.StartChildWorkflowExecutionDecisionAttributes)
+		i++
+	}
+	if v.SignalExternalWorkflowExecutionDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("SignalExternalWorkflowExecutionDecisionAttributes: %v", v.SignalExternalWorkflowExecutionDecisionAttributes)
+		i++
+	}
+	if v.UpsertWorkflowSearchAttributesDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("UpsertWorkflowSearchAttributesDecisionAttributes: %v", v.UpsertWorkflowSearchAttributesDecisionAttributes)
 		i++
 	}
 
-	return fmt.Sprintf("DecisionTaskStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("Decision{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DecisionTaskStartedEventAttributes match the
-// provided DecisionTaskStartedEventAttributes.
+func _DecisionType_EqualsPtr(lhs, rhs *DecisionType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Decision match the
+// provided Decision.
 //
 // This function performs a deep comparison.
-func (v *DecisionTaskStartedEventAttributes) Equals(rhs *DecisionTaskStartedEventAttributes) bool {
-	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
+func (v *Decision) Equals(rhs *Decision) bool {
+	if !_DecisionType_EqualsPtr(v.DecisionType, rhs.DecisionType) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !((v.ScheduleActivityTaskDecisionAttributes == nil && rhs.ScheduleActivityTaskDecisionAttributes == nil) || (v.ScheduleActivityTaskDecisionAttributes != nil && rhs.ScheduleActivityTaskDecisionAttributes != nil && v.ScheduleActivityTaskDecisionAttributes.Equals(rhs.ScheduleActivityTaskDecisionAttributes))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+	if !((v.StartTimerDecisionAttributes == nil && rhs.StartTimerDecisionAttributes == nil) || (v.StartTimerDecisionAttributes != nil && rhs.StartTimerDecisionAttributes != nil && v.StartTimerDecisionAttributes.Equals(rhs.StartTimerDecisionAttributes))) {
 		return false
 	}
-
-	return true
-}
-
-// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
-// zero value if it is unset.
-func (v *DecisionTaskStartedEventAttributes) GetScheduledEventId() (o int64) {
-	if v.ScheduledEventId != nil {
-		return *v.ScheduledEventId
+	if !((v.CompleteWorkflowExecutionDecisionAttributes == nil && rhs.CompleteWorkflowExecutionDecisionAttributes == nil) || (v.CompleteWorkflowExecutionDecisionAttributes != nil && rhs.CompleteWorkflowExecutionDecisionAttributes != nil && v.CompleteWorkflowExecutionDecisionAttributes.Equals(rhs.CompleteWorkflowExecutionDecisionAttributes))) {
+		return false
 	}
-
-	return
-}
-
-// GetIdentity returns the value of Identity if it is set or its
-// zero value if it is unset.
-func (v *DecisionTaskStartedEventAttributes) GetIdentity() (o string) {
-	if v.Identity != nil {
-		return *v.Identity
+	if !((v.FailWorkflowExecutionDecisionAttributes == nil && rhs.FailWorkflowExecutionDecisionAttributes == nil) || (v.FailWorkflowExecutionDecisionAttributes != nil && rhs.FailWorkflowExecutionDecisionAttributes != nil && v.FailWorkflowExecutionDecisionAttributes.Equals(rhs.FailWorkflowExecutionDecisionAttributes))) {
+		return false
+	}
+	if !((v.RequestCancelActivityTaskDecisionAttributes == nil && rhs.RequestCancelActivityTaskDecisionAttributes == nil) || (v.RequestCancelActivityTaskDecisionAttributes != nil && rhs.RequestCancelActivityTaskDecisionAttributes != nil && v.RequestCancelActivityTaskDecisionAttributes.Equals(rhs.RequestCancelActivityTaskDecisionAttributes))) {
+		return false
+	}
+	if !((v.CancelTimerDecisionAttributes == nil && rhs.CancelTimerDecisionAttributes == nil) || (v.CancelTimerDecisionAttributes != nil && rhs.CancelTimerDecisionAttributes != nil && v.CancelTimerDecisionAttributes.Equals(rhs.CancelTimerDecisionAttributes))) {
+		return false
+	}
+	if !((v.CancelWorkflowExecutionDecisionAttributes == nil && rhs.CancelWorkflowExecutionDecisionAttributes == nil) || (v.CancelWorkflowExecutionDecisionAttributes != nil && rhs.CancelWorkflowExecutionDecisionAttributes != nil && v.CancelWorkflowExecutionDecisionAttributes.Equals(rhs.CancelWorkflowExecutionDecisionAttributes))) {
+		return false
+	}
+	if !((v.RequestCancelExternalWorkflowExecutionDecisionAttributes == nil && rhs.RequestCancelExternalWorkflowExecutionDecisionAttributes == nil) || (v.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil && rhs.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil && v.RequestCancelExternalWorkflowExecutionDecisionAttributes.Equals(rhs.RequestCancelExternalWorkflowExecutionDecisionAttributes))) {
+		return false
+	}
+	if !((v.RecordMarkerDecisionAttributes == nil && rhs.RecordMarkerDecisionAttributes == nil) || (v.RecordMarkerDecisionAttributes != nil && rhs.RecordMarkerDecisionAttributes != nil && v.RecordMarkerDecisionAttributes.Equals(rhs.RecordMarkerDecisionAttributes))) {
+		return false
+	}
+	if !((v.ContinueAsNewWorkflowExecutionDecisionAttributes == nil && rhs.ContinueAsNewWorkflowExecutionDecisionAttributes == nil) || (v.ContinueAsNewWorkflowExecutionDecisionAttributes != nil && rhs.ContinueAsNewWorkflowExecutionDecisionAttributes != nil && v.ContinueAsNewWorkflowExecutionDecisionAttributes.Equals(rhs.ContinueAsNewWorkflowExecutionDecisionAttributes))) {
+		return false
+	}
+	if !((v.StartChildWorkflowExecutionDecisionAttributes == nil && rhs.StartChildWorkflowExecutionDecisionAttributes == nil) || (v.StartChildWorkflowExecutionDecisionAttributes != nil && rhs.StartChildWorkflowExecutionDecisionAttributes != nil && v.StartChildWorkflowExecutionDecisionAttributes.Equals(rhs.StartChildWorkflowExecutionDecisionAttributes))) {
+		return false
+	}
+	if !((v.SignalExternalWorkflowExecutionDecisionAttributes == nil && rhs.SignalExternalWorkflowExecutionDecisionAttributes == nil) || (v.SignalExternalWorkflowExecutionDecisionAttributes != nil && rhs.SignalExternalWorkflowExecutionDecisionAttributes != nil && v.SignalExternalWorkflowExecutionDecisionAttributes.Equals(rhs.SignalExternalWorkflowExecutionDecisionAttributes))) {
+		return false
+	}
+	if !((v.UpsertWorkflowSearchAttributesDecisionAttributes == nil && rhs.UpsertWorkflowSearchAttributesDecisionAttributes == nil) || (v.UpsertWorkflowSearchAttributesDecisionAttributes != nil && rhs.UpsertWorkflowSearchAttributesDecisionAttributes != nil && v.UpsertWorkflowSearchAttributesDecisionAttributes.Equals(rhs.UpsertWorkflowSearchAttributesDecisionAttributes))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// GetRequestId returns the value of RequestId if it is set or its
+// GetDecisionType returns the value of DecisionType if it is set or its
 // zero value if it is unset.
-func (v *DecisionTaskStartedEventAttributes) GetRequestId() (o string) {
-	if v.RequestId != nil {
-		return *v.RequestId
+func (v *Decision) GetDecisionType() (o DecisionType) {
+	if v.DecisionType != nil {
+		return *v.DecisionType
 	}
 
 	return
 }
 
-type DecisionTaskTimedOutEventAttributes struct {
-	ScheduledEventId *int64       `json:"scheduledEventId,omitempty"`
-	StartedEventId   *int64       `json:"startedEventId,omitempty"`
-	TimeoutType      *TimeoutType `json:"timeoutType,omitempty"`
+type DecisionTaskCompletedEventAttributes struct {
+	ExecutionContext []byte  `json:"executionContext,omitempty"`
+	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
+	StartedEventId   *int64  `json:"startedEventId,omitempty"`
+	Identity         *string `json:"identity,omitempty"`
 }
 
-// ToWire translates a DecisionTaskTimedOutEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a DecisionTaskCompletedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6592,47 +6864,55 @@ type DecisionTaskTimedOutEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DecisionTaskTimedOutEventAttributes) ToWire() (wire.Value, error) {
+func (v *DecisionTaskCompletedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ScheduledEventId != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
+	if v.ExecutionContext != nil {
+		w, err = wire.NewValueBinary(v.ExecutionContext), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+	if v.ScheduledEventId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.TimeoutType != nil {
-		w, err = v.TimeoutType.ToWire()
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a DecisionTaskTimedOutEventAttributes struct from its Thrift-level
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DecisionTaskCompletedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DecisionTaskTimedOutEventAttributes struct
+// An error is returned if we were unable to build a DecisionTaskCompletedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6640,17 +6920,25 @@ func (v *DecisionTaskTimedOutEventAttributes) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DecisionTaskTimedOutEventAttributes
+//   var v DecisionTaskCompletedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DecisionTaskTimedOutEventAttributes) FromWire(w wire.Value) error {
+func (v *DecisionTaskCompletedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.ExecutionContext, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
@@ -6660,7 +6948,7 @@ func (v *DecisionTaskTimedOutEventAttributes) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 20:
+		case 30:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
@@ -6670,11 +6958,11 @@ func (v *DecisionTaskTimedOutEventAttributes) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TI32 {
-				var x TimeoutType
-				x, err = _TimeoutType_Read(field.Value)
-				v.TimeoutType = &x
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -6686,15 +6974,19 @@ func (v *DecisionTaskTimedOutEventAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DecisionTaskTimedOutEventAttributes
+// String returns a readable string representation of a DecisionTaskCompletedEventAttributes
 // struct.
-func (v *DecisionTaskTimedOutEventAttributes) String() string {
+func (v *DecisionTaskCompletedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
+	if v.ExecutionContext != nil {
+		fields[i] = fmt.Sprintf("ExecutionContext: %v", v.ExecutionContext)
+		i++
+	}
 	if v.ScheduledEventId != nil {
 		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
 		i++
@@ -6703,26 +6995,29 @@ func (v *DecisionTaskTimedOutEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
 		i++
 	}
-	if v.TimeoutType != nil {
-		fields[i] = fmt.Sprintf("TimeoutType: %v", *(v.TimeoutType))
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("DecisionTaskTimedOutEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DecisionTaskCompletedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DecisionTaskTimedOutEventAttributes match the
-// provided DecisionTaskTimedOutEventAttributes.
+// Equals returns true if all the fields of this DecisionTaskCompletedEventAttributes match the
+// provided DecisionTaskCompletedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *DecisionTaskTimedOutEventAttributes) Equals(rhs *DecisionTaskTimedOutEventAttributes) bool {
+func (v *DecisionTaskCompletedEventAttributes) Equals(rhs *DecisionTaskCompletedEventAttributes) bool {
+	if !((v.ExecutionContext == nil && rhs.ExecutionContext == nil) || (v.ExecutionContext != nil && rhs.ExecutionContext != nil && bytes.Equal(v.ExecutionContext, rhs.ExecutionContext))) {
+		return false
+	}
 	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
 		return false
 	}
 	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
 		return false
 	}
-	if !_TimeoutType_EqualsPtr(v.TimeoutType, rhs.TimeoutType) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
@@ -6731,7 +7026,7 @@ func (v *DecisionTaskTimedOutEventAttributes) Equals(rhs *DecisionTaskTimedOutEv
 
 // GetScheduledEventId returns the value of ScheduledEventId if it is set or its
 // zero value if it is unset.
-func (v *DecisionTaskTimedOutEventAttributes) GetScheduledEventId() (o int64) {
+func (v *DecisionTaskCompletedEventAttributes) GetScheduledEventId() (o int64) {
 	if v.ScheduledEventId != nil {
 		return *v.ScheduledEventId
 	}
@@ -6741,7 +7036,7 @@ func (v *DecisionTaskTimedOutEventAttributes) GetScheduledEventId() (o int64) {
 
 // GetStartedEventId returns the value of StartedEventId if it is set or its
 // zero value if it is unset.
-func (v *DecisionTaskTimedOutEventAttributes) GetStartedEventId() (o int64) {
+func (v *DecisionTaskCompletedEventAttributes) GetStartedEventId() (o int64) {
 	if v.StartedEventId != nil {
 		return *v.StartedEventId
 	}
@@ -6749,213 +7044,267 @@ func (v *DecisionTaskTimedOutEventAttributes) GetStartedEventId() (o int64) {
 	return
 }
 
-// GetTimeoutType returns the value of TimeoutType if it is set or its
+// GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *DecisionTaskTimedOutEventAttributes) GetTimeoutType() (o TimeoutType) {
-	if v.TimeoutType != nil {
-		return *v.TimeoutType
+func (v *DecisionTaskCompletedEventAttributes) GetIdentity() (o string) {
+	if v.Identity != nil {
+		return *v.Identity
 	}
 
 	return
 }
 
-type DecisionType int32
+type DecisionTaskFailedCause int32
 
 const (
-	DecisionTypeScheduleActivityTask                   DecisionType = 0
-	DecisionTypeRequestCancelActivityTask              DecisionType = 1
-	DecisionTypeStartTimer                             DecisionType = 2
-	DecisionTypeCompleteWorkflowExecution              DecisionType = 3
-	DecisionTypeFailWorkflowExecution                  DecisionType = 4
-	DecisionTypeCancelTimer                            DecisionType = 5
-	DecisionTypeCancelWorkflowExecution                DecisionType = 6
-	DecisionTypeRequestCancelExternalWorkflowExecution DecisionType = 7
-	DecisionTypeRecordMarker                           DecisionType = 8
-	DecisionTypeContinueAsNewWorkflowExecution         DecisionType = 9
-	DecisionTypeStartChildWorkflowExecution            DecisionType = 10
-	DecisionTypeSignalExternalWorkflowExecution        DecisionType = 11
+	DecisionTaskFailedCauseUnhandledDecision                                   DecisionTaskFailedCause = 0
+	DecisionTaskFailedCauseBadScheduleActivityAttributes                       DecisionTaskFailedCause = 1
+	DecisionTaskFailedCauseBadRequestCancelActivityAttributes                  DecisionTaskFailedCause = 2
+	DecisionTaskFailedCauseBadStartTimerAttributes                             DecisionTaskFailedCause = 3
+	DecisionTaskFailedCauseBadCancelTimerAttributes                            DecisionTaskFailedCause = 4
+	DecisionTaskFailedCauseBadRecordMarkerAttributes                           DecisionTaskFailedCause = 5
+	DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes              DecisionTaskFailedCause = 6
+	DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes                  DecisionTaskFailedCause = 7
+	DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes                DecisionTaskFailedCause = 8
+	DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes DecisionTaskFailedCause = 9
+	DecisionTaskFailedCauseBadContinueAsNewAttributes                          DecisionTaskFailedCause = 10
+	DecisionTaskFailedCauseStartTimerDuplicateID                               DecisionTaskFailedCause = 11
+	DecisionTaskFailedCauseResetStickyTasklist                                 DecisionTaskFailedCause = 12
+	DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure                      DecisionTaskFailedCause = 13
+	DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes                DecisionTaskFailedCause = 14
+	DecisionTaskFailedCauseBadStartChildExecutionAttributes                    DecisionTaskFailedCause = 15
+	DecisionTaskFailedCauseResetWorkflow                                       DecisionTaskFailedCause = 16
+	DecisionTaskFailedCauseBadSearchAttributes                                 DecisionTaskFailedCause = 17
 )
 
-// DecisionType_Values returns all recognized values of DecisionType.
-func DecisionType_Values() []DecisionType {
-	return []DecisionType{
-		DecisionTypeScheduleActivityTask,
-		DecisionTypeRequestCancelActivityTask,
-		DecisionTypeStartTimer,
-		DecisionTypeCompleteWorkflowExecution,
-		DecisionTypeFailWorkflowExecution,
-		DecisionTypeCancelTimer,
-		DecisionTypeCancelWorkflowExecution,
-		DecisionTypeRequestCancelExternalWorkflowExecution,
-		DecisionTypeRecordMarker,
-		DecisionTypeContinueAsNewWorkflowExecution,
-		DecisionTypeStartChildWorkflowExecution,
-		DecisionTypeSignalExternalWorkflowExecution,
+// DecisionTaskFailedCause_Values returns all recognized values of DecisionTaskFailedCause.
+func DecisionTaskFailedCause_Values() []DecisionTaskFailedCause {
+	return []DecisionTaskFailedCause{
+		DecisionTaskFailedCauseUnhandledDecision,
+		DecisionTaskFailedCauseBadScheduleActivityAttributes,
+		DecisionTaskFailedCauseBadRequestCancelActivityAttributes,
+		DecisionTaskFailedCauseBadStartTimerAttributes,
+		DecisionTaskFailedCauseBadCancelTimerAttributes,
+		DecisionTaskFailedCauseBadRecordMarkerAttributes,
+		DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes,
+		DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes,
+		DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes,
+		DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes,
+		DecisionTaskFailedCauseBadContinueAsNewAttributes,
+		DecisionTaskFailedCauseStartTimerDuplicateID,
+		DecisionTaskFailedCauseResetStickyTasklist,
+		DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure,
+		DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes,
+		DecisionTaskFailedCauseBadStartChildExecutionAttributes,
+		DecisionTaskFailedCauseResetWorkflow,
+		DecisionTaskFailedCauseBadSearchAttributes,
 	}
 }
 
-// UnmarshalText tries to decode DecisionType from a byte slice
+// UnmarshalText tries to decode DecisionTaskFailedCause from a byte slice
 // containing its name.
 //
-//   var v DecisionType
-//   err := v.UnmarshalText([]byte("ScheduleActivityTask"))
-func (v *DecisionType) UnmarshalText(value []byte) error {
+//   var v DecisionTaskFailedCause
+//   err := v.UnmarshalText([]byte("UNHANDLED_DECISION"))
+func (v *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	switch string(value) {
-	case "ScheduleActivityTask":
-		*v = DecisionTypeScheduleActivityTask
+	case "UNHANDLED_DECISION":
+		*v = DecisionTaskFailedCauseUnhandledDecision
 		return nil
-	case "RequestCancelActivityTask":
-		*v = DecisionTypeRequestCancelActivityTask
+	case "BAD_SCHEDULE_ACTIVITY_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadScheduleActivityAttributes
 		return nil
-	case "StartTimer":
-		*v = DecisionTypeStartTimer
+	case "BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadRequestCancelActivityAttributes
 		return nil
-	case "CompleteWorkflowExecution":
-		*v = DecisionTypeCompleteWorkflowExecution
+	case "BAD_START_TIMER_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadStartTimerAttributes
 		return nil
-	case "FailWorkflowExecution":
-		*v = DecisionTypeFailWorkflowExecution
+	case "BAD_CANCEL_TIMER_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadCancelTimerAttributes
 		return nil
-	case "CancelTimer":
-		*v = DecisionTypeCancelTimer
+	case "BAD_RECORD_MARKER_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadRecordMarkerAttributes
 		return nil
-	case "CancelWorkflowExecution":
-		*v = DecisionTypeCancelWorkflowExecution
+	case "BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes
 		return nil
-	case "RequestCancelExternalWorkflowExecution":
-		*v = DecisionTypeRequestCancelExternalWorkflowExecution
+	case "BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes
 		return nil
-	case "RecordMarker":
-		*v = DecisionTypeRecordMarker
+	case "BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes
 		return nil
-	case "ContinueAsNewWorkflowExecution":
-		*v = DecisionTypeContinueAsNewWorkflowExecution
+	case "BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes
 		return nil
-	case "StartChildWorkflowExecution":
-		*v = DecisionTypeStartChildWorkflowExecution
+	case "BAD_CONTINUE_AS_NEW_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadContinueAsNewAttributes
 		return nil
-	case "SignalExternalWorkflowExecution":
-		*v = DecisionTypeSignalExternalWorkflowExecution
+	case "START_TIMER_DUPLICATE_ID":
+		*v = DecisionTaskFailedCauseStartTimerDuplicateID
+		return nil
+	case "RESET_STICKY_TASKLIST":
+		*v = DecisionTaskFailedCauseResetStickyTasklist
+		return nil
+	case "WORKFLOW_WORKER_UNHANDLED_FAILURE":
+		*v = DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure
+		return nil
+	case "BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes
+		return nil
+	case "BAD_START_CHILD_EXECUTION_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadStartChildExecutionAttributes
+		return nil
+	case "RESET_WORKFLOW":
+		*v = DecisionTaskFailedCauseResetWorkflow
+		return nil
+	case "BAD_SEARCH_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
 	default:
-		return fmt.Errorf("unknown enum value %q for %q", value, "DecisionType")
+		return fmt.Errorf("unknown enum value %q for %q", value, "DecisionTaskFailedCause")
 	}
 }
 
 // Ptr returns a pointer to this enum value.
-func (v DecisionType) Ptr() *DecisionType {
+func (v DecisionTaskFailedCause) Ptr() *DecisionTaskFailedCause {
 	return &v
 }
 
-// ToWire translates DecisionType into a Thrift-level intermediate
+// ToWire translates DecisionTaskFailedCause into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // Enums are represented as 32-bit integers over the wire.
-func (v DecisionType) ToWire() (wire.Value, error) {
+func (v DecisionTaskFailedCause) ToWire() (wire.Value, error) {
 	return wire.NewValueI32(int32(v)), nil
 }
 
-// FromWire deserializes DecisionType from its Thrift-level
+// FromWire deserializes DecisionTaskFailedCause from its Thrift-level
 // representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return DecisionType(0), err
+//     return DecisionTaskFailedCause(0), err
 //   }
 //
-//   var v DecisionType
+//   var v DecisionTaskFailedCause
 //   if err := v.FromWire(x); err != nil {
-//     return DecisionType(0), err
+//     return DecisionTaskFailedCause(0), err
 //   }
 //   return v, nil
-func (v *DecisionType) FromWire(w wire.Value) error {
-	*v = (DecisionType)(w.GetI32())
+func (v *DecisionTaskFailedCause) FromWire(w wire.Value) error {
+	*v = (DecisionTaskFailedCause)(w.GetI32())
 	return nil
 }
 
-// String returns a readable string representation of DecisionType.
-func (v DecisionType) String() string {
+// String returns a readable string representation of DecisionTaskFailedCause.
+func (v DecisionTaskFailedCause) String() string {
 	w := int32(v)
 	switch w {
 	case 0:
-		return "ScheduleActivityTask"
+		return "UNHANDLED_DECISION"
 	case 1:
-		return "RequestCancelActivityTask"
+		return "BAD_SCHEDULE_ACTIVITY_ATTRIBUTES"
 	case 2:
-		return "StartTimer"
+		return "BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES"
 	case 3:
-		return "CompleteWorkflowExecution"
+		return "BAD_START_TIMER_ATTRIBUTES"
 	case 4:
-		return "FailWorkflowExecution"
+		return "BAD_CANCEL_TIMER_ATTRIBUTES"
 	case 5:
-		return "CancelTimer"
+		return "BAD_RECORD_MARKER_ATTRIBUTES"
 	case 6:
-		return "CancelWorkflowExecution"
+		return "BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES"
 	case 7:
-		return "RequestCancelExternalWorkflowExecution"
+		return "BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES"
 	case 8:
-		return "RecordMarker"
+		return "BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES"
 	case 9:
-		return "ContinueAsNewWorkflowExecution"
+		return "BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES"
 	case 10:
-		return "StartChildWorkflowExecution"
+		return "BAD_CONTINUE_AS_NEW_ATTRIBUTES"
 	case 11:
-		return "SignalExternalWorkflowExecution"
+		return "START_TIMER_DUPLICATE_ID"
+	case 12:
+		return "RESET_STICKY_TASKLIST"
+	case 13:
+		return "WORKFLOW_WORKER_UNHANDLED_FAILURE"
+	case 14:
+		return "BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES"
+	case 15:
+		return "BAD_START_CHILD_EXECUTION_ATTRIBUTES"
+	case 16:
+		return "RESET_WORKFLOW"
+	case 17:
+		return "BAD_SEARCH_ATTRIBUTES"
 	}
-	return fmt.Sprintf("DecisionType(%d)", w)
+	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
 
-// Equals returns true if this DecisionType value matches the provided
+// Equals returns true if this DecisionTaskFailedCause value matches the provided
 // value.
-func (v DecisionType) Equals(rhs DecisionType) bool {
+func (v DecisionTaskFailedCause) Equals(rhs DecisionTaskFailedCause) bool {
 	return v == rhs
 }
 
-// MarshalJSON serializes DecisionType into JSON.
+// MarshalJSON serializes DecisionTaskFailedCause into JSON.
 //
 // If the enum value is recognized, its name is returned. Otherwise,
 // its integer value is returned.
 //
 // This implements json.Marshaler.
-func (v DecisionType) MarshalJSON() ([]byte, error) {
+func (v DecisionTaskFailedCause) MarshalJSON() ([]byte, error) {
 	switch int32(v) {
 	case 0:
-		return ([]byte)("\"ScheduleActivityTask\""), nil
+		return ([]byte)("\"UNHANDLED_DECISION\""), nil
 	case 1:
-		return ([]byte)("\"RequestCancelActivityTask\""), nil
+		return ([]byte)("\"BAD_SCHEDULE_ACTIVITY_ATTRIBUTES\""), nil
 	case 2:
-		return ([]byte)("\"StartTimer\""), nil
+		return ([]byte)("\"BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES\""), nil
 	case 3:
-		return ([]byte)("\"CompleteWorkflowExecution\""), nil
+		return ([]byte)("\"BAD_START_TIMER_ATTRIBUTES\""), nil
 	case 4:
-		return ([]byte)("\"FailWorkflowExecution\""), nil
+		return ([]byte)("\"BAD_CANCEL_TIMER_ATTRIBUTES\""), nil
 	case 5:
-		return ([]byte)("\"CancelTimer\""), nil
+		return ([]byte)("\"BAD_RECORD_MARKER_ATTRIBUTES\""), nil
 	case 6:
-		return ([]byte)("\"CancelWorkflowExecution\""), nil
+		return ([]byte)("\"BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
 	case 7:
-		return ([]byte)("\"RequestCancelExternalWorkflowExecution\""), nil
+		return ([]byte)("\"BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
 	case 8:
-		return ([]byte)("\"RecordMarker\""), nil
+		return ([]byte)("\"BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
 	case 9:
-		return ([]byte)("\"ContinueAsNewWorkflowExecution\""), nil
+		return ([]byte)("\"BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
 	case 10:
-		return ([]byte)("\"StartChildWorkflowExecution\""), nil
+		return ([]byte)("\"BAD_CONTINUE_AS_NEW_ATTRIBUTES\""), nil
 	case 11:
-		return ([]byte)("\"SignalExternalWorkflowExecution\""), nil
+		return ([]byte)("\"START_TIMER_DUPLICATE_ID\""), nil
+	case 12:
+		return ([]byte)("\"RESET_STICKY_TASKLIST\""), nil
+	case 13:
+		return ([]byte)("\"WORKFLOW_WORKER_UNHANDLED_FAILURE\""), nil
+	case 14:
+		return ([]byte)("\"BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
+	case 15:
+		return ([]byte)("\"BAD_START_CHILD_EXECUTION_ATTRIBUTES\""), nil
+	case 16:
+		return ([]byte)("\"RESET_WORKFLOW\""), nil
+	case 17:
+		return ([]byte)("\"BAD_SEARCH_ATTRIBUTES\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// UnmarshalJSON attempts to decode DecisionType from its JSON
+// UnmarshalJSON attempts to decode DecisionTaskFailedCause from its JSON
 // representation.
 //
 // This implementation supports both, numeric and string inputs. If a
 // string is provided, it must be a known enum name.
 //
 // This implements json.Unmarshaler.
-func (v *DecisionType) UnmarshalJSON(text []byte) error {
+func (v *DecisionTaskFailedCause) UnmarshalJSON(text []byte) error {
 	d := json.NewDecoder(bytes.NewReader(text))
 	d.UseNumber()
 	t, err := d.Token()
@@ -6970,25 +7319,29 @@ func (v *DecisionType) UnmarshalJSON(text []byte) error {
 			return err
 		}
 		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DecisionType")
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DecisionTaskFailedCause")
 		}
 		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DecisionType")
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DecisionTaskFailedCause")
 		}
-		*v = (DecisionType)(x)
+		*v = (DecisionTaskFailedCause)(x)
 		return nil
 	case string:
 		return v.UnmarshalText([]byte(w))
 	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DecisionType")
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DecisionTaskFailedCause")
 	}
 }
 
-type DeprecateDomainRequest struct {
-	Name *string `json:"name,omitempty"`
+type DecisionTaskFailedEventAttributes struct {
+	ScheduledEventId *int64                   `json:"scheduledEventId,omitempty"`
+	StartedEventId   *int64                   `json:"startedEventId,omitempty"`
+	Cause            *DecisionTaskFailedCause `json:"cause,omitempty"`
+	Details          []byte                   `json:"details,omitempty"`
+	Identity         *string                  `json:"identity,omitempty"`
 }
 
-// ToWire translates a DeprecateDomainRequest struct into a Thrift-level intermediate
+// ToWire translates a DecisionTaskFailedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7003,31 +7356,69 @@ type DeprecateDomainRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DeprecateDomainRequest) ToWire() (wire.Value, error) {
+func (v *DecisionTaskFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.ScheduledEventId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Cause != nil {
+		w, err = v.Cause.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 35, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DeprecateDomainRequest struct from its Thrift-level
+func _DecisionTaskFailedCause_Read(w wire.Value) (DecisionTaskFailedCause, error) {
+	var v DecisionTaskFailedCause
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a DecisionTaskFailedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DeprecateDomainRequest struct
+// An error is returned if we were unable to build a DecisionTaskFailedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7035,21 +7426,59 @@ func (v *DeprecateDomainRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DeprecateDomainRequest
+//   var v DecisionTaskFailedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
+func (v *DecisionTaskFailedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x DecisionTaskFailedCause
+				x, err = _DecisionTaskFailedCause_Read(field.Value)
+				v.Cause = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 35:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -7061,55 +7490,125 @@ func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DeprecateDomainRequest
+// String returns a readable string representation of a DecisionTaskFailedEventAttributes
 // struct.
-func (v *DeprecateDomainRequest) String() string {
+func (v *DecisionTaskFailedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [5]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+	if v.ScheduledEventId != nil {
+		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("DeprecateDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DecisionTaskFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DeprecateDomainRequest match the
-// provided DeprecateDomainRequest.
+func _DecisionTaskFailedCause_EqualsPtr(lhs, rhs *DecisionTaskFailedCause) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DecisionTaskFailedEventAttributes match the
+// provided DecisionTaskFailedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *DeprecateDomainRequest) Equals(rhs *DeprecateDomainRequest) bool {
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
+func (v *DecisionTaskFailedEventAttributes) Equals(rhs *DecisionTaskFailedEventAttributes) bool {
+	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+	if !_DecisionTaskFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
 	return true
 }
 
-// GetName returns the value of Name if it is set or its
+// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
 // zero value if it is unset.
-func (v *DeprecateDomainRequest) GetName() (o string) {
-	if v.Name != nil {
-		return *v.Name
+func (v *DecisionTaskFailedEventAttributes) GetScheduledEventId() (o int64) {
+	if v.ScheduledEventId != nil {
+		return *v.ScheduledEventId
 	}
 
 	return
 }
 
-type DescribeDomainRequest struct {
-	Name *string `json:"name,omitempty"`
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *DecisionTaskFailedEventAttributes) GetStartedEventId() (o int64) {
+	if v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
 }
 
-// ToWire translates a DescribeDomainRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
+// GetCause returns the value of Cause if it is set or its
+// zero value if it is unset.
+func (v *DecisionTaskFailedEventAttributes) GetCause() (o DecisionTaskFailedCause) {
+	if v.Cause != nil {
+		return *v.Cause
+	}
+
+	return
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *DecisionTaskFailedEventAttributes) GetIdentity() (o string) {
+	if v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+type DecisionTaskScheduledEventAttributes struct {
+	TaskList                   *TaskList `json:"taskList,omitempty"`
+	StartToCloseTimeoutSeconds *int32    `json:"startToCloseTimeoutSeconds,omitempty"`
+	Attempt                    *int64    `json:"attempt,omitempty"`
+}
+
+// ToWire translates a DecisionTaskScheduledEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
 //
 //   x, err := v.ToWire()
 //   if err != nil {
@@ -7119,31 +7618,47 @@ type DescribeDomainRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
+func (v *DecisionTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.StartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DescribeDomainRequest struct from its Thrift-level
+// FromWire deserializes a DecisionTaskScheduledEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeDomainRequest struct
+// An error is returned if we were unable to build a DecisionTaskScheduledEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7151,21 +7666,39 @@ func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeDomainRequest
+//   var v DecisionTaskScheduledEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
+func (v *DecisionTaskScheduledEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
 				if err != nil {
 					return err
 				}
@@ -7177,54 +7710,76 @@ func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeDomainRequest
+// String returns a readable string representation of a DecisionTaskScheduledEventAttributes
 // struct.
-func (v *DescribeDomainRequest) String() string {
+func (v *DecisionTaskScheduledEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [3]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
 		i++
 	}
 
-	return fmt.Sprintf("DescribeDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DecisionTaskScheduledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeDomainRequest match the
-// provided DescribeDomainRequest.
+// Equals returns true if all the fields of this DecisionTaskScheduledEventAttributes match the
+// provided DecisionTaskScheduledEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *DescribeDomainRequest) Equals(rhs *DescribeDomainRequest) bool {
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
+func (v *DecisionTaskScheduledEventAttributes) Equals(rhs *DecisionTaskScheduledEventAttributes) bool {
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
 		return false
 	}
 
 	return true
 }
 
-// GetName returns the value of Name if it is set or its
+// GetStartToCloseTimeoutSeconds returns the value of StartToCloseTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *DescribeDomainRequest) GetName() (o string) {
-	if v.Name != nil {
-		return *v.Name
+func (v *DecisionTaskScheduledEventAttributes) GetStartToCloseTimeoutSeconds() (o int32) {
+	if v.StartToCloseTimeoutSeconds != nil {
+		return *v.StartToCloseTimeoutSeconds
 	}
 
 	return
 }
 
-type DescribeDomainResponse struct {
-	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
-	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
-	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
-	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
-	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *DecisionTaskScheduledEventAttributes) GetAttempt() (o int64) {
+	if v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
 }
 
-// ToWire translates a DescribeDomainResponse struct into a Thrift-level intermediate
+type DecisionTaskStartedEventAttributes struct {
+	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
+	Identity         *string `json:"identity,omitempty"`
+	RequestId        *string `json:"requestId,omitempty"`
+}
+
+// ToWire translates a DecisionTaskStartedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7239,81 +7794,47 @@ type DescribeDomainResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
+func (v *DecisionTaskStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainInfo != nil {
-		w, err = v.DomainInfo.ToWire()
+	if v.ScheduledEventId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Configuration != nil {
-		w, err = v.Configuration.ToWire()
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ReplicationConfiguration != nil {
-		w, err = v.ReplicationConfiguration.ToWire()
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.FailoverVersion != nil {
-		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.IsGlobalDomain != nil {
-		w, err = wire.NewValueBool(*(v.IsGlobalDomain)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DomainInfo_Read(w wire.Value) (*DomainInfo, error) {
-	var v DomainInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DomainConfiguration_Read(w wire.Value) (*DomainConfiguration, error) {
-	var v DomainConfiguration
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DomainReplicationConfiguration_Read(w wire.Value) (*DomainReplicationConfiguration, error) {
-	var v DomainReplicationConfiguration
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a DescribeDomainResponse struct from its Thrift-level
+// FromWire deserializes a DecisionTaskStartedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeDomainResponse struct
+// An error is returned if we were unable to build a DecisionTaskStartedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7321,55 +7842,41 @@ func _DomainReplicationConfiguration_Read(w wire.Value) (*DomainReplicationConfi
 //     return nil, err
 //   }
 //
-//   var v DescribeDomainResponse
+//   var v DecisionTaskStartedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeDomainResponse) FromWire(w wire.Value) error {
+func (v *DecisionTaskStartedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainInfo, err = _DomainInfo_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Configuration, err = _DomainConfiguration_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FailoverVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.IsGlobalDomain = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
 				if err != nil {
 					return err
 				}
@@ -7381,100 +7888,86 @@ func (v *DescribeDomainResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeDomainResponse
+// String returns a readable string representation of a DecisionTaskStartedEventAttributes
 // struct.
-func (v *DescribeDomainResponse) String() string {
+func (v *DecisionTaskStartedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
-	if v.DomainInfo != nil {
-		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
-		i++
-	}
-	if v.Configuration != nil {
-		fields[i] = fmt.Sprintf("Configuration: %v", v.Configuration)
-		i++
-	}
-	if v.ReplicationConfiguration != nil {
-		fields[i] = fmt.Sprintf("ReplicationConfiguration: %v", v.ReplicationConfiguration)
+	if v.ScheduledEventId != nil {
+		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
 		i++
 	}
-	if v.FailoverVersion != nil {
-		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
-	if v.IsGlobalDomain != nil {
-		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
 		i++
 	}
 
-	return fmt.Sprintf("DescribeDomainResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DecisionTaskStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeDomainResponse match the
-// provided DescribeDomainResponse.
+// Equals returns true if all the fields of this DecisionTaskStartedEventAttributes match the
+// provided DecisionTaskStartedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *DescribeDomainResponse) Equals(rhs *DescribeDomainResponse) bool {
-	if !((v.DomainInfo == nil && rhs.DomainInfo == nil) || (v.DomainInfo != nil && rhs.DomainInfo != nil && v.DomainInfo.Equals(rhs.DomainInfo))) {
-		return false
-	}
-	if !((v.Configuration == nil && rhs.Configuration == nil) || (v.Configuration != nil && rhs.Configuration != nil && v.Configuration.Equals(rhs.Configuration))) {
-		return false
-	}
-	if !((v.ReplicationConfiguration == nil && rhs.ReplicationConfiguration == nil) || (v.ReplicationConfiguration != nil && rhs.ReplicationConfiguration != nil && v.ReplicationConfiguration.Equals(rhs.ReplicationConfiguration))) {
+func (v *DecisionTaskStartedEventAttributes) Equals(rhs *DecisionTaskStartedEventAttributes) bool {
+	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
 
 	return true
 }
 
-// GetFailoverVersion returns the value of FailoverVersion if it is set or its
+// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
 // zero value if it is unset.
-func (v *DescribeDomainResponse) GetFailoverVersion() (o int64) {
-	if v.FailoverVersion != nil {
-		return *v.FailoverVersion
+func (v *DecisionTaskStartedEventAttributes) GetScheduledEventId() (o int64) {
+	if v.ScheduledEventId != nil {
+		return *v.ScheduledEventId
 	}
 
 	return
 }
 
-// GetIsGlobalDomain returns the value of IsGlobalDomain if it is set or its
+// GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *DescribeDomainResponse) GetIsGlobalDomain() (o bool) {
-	if v.IsGlobalDomain != nil {
-		return *v.IsGlobalDomain
+func (v *DecisionTaskStartedEventAttributes) GetIdentity() (o string) {
+	if v.Identity != nil {
+		return *v.Identity
 	}
 
 	return
 }
 
-type DescribeTaskListRequest struct {
-	Domain       *string       `json:"domain,omitempty"`
-	TaskList     *TaskList     `json:"taskList,omitempty"`
-	TaskListType *TaskListType `json:"taskListType,omitempty"`
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *DecisionTaskStartedEventAttributes) GetRequestId() (o string) {
+	if v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
 }
 
-// ToWire translates a DescribeTaskListRequest struct into a Thrift-level intermediate
+type DecisionTaskTimedOutEventAttributes struct {
+	ScheduledEventId *int64       `json:"scheduledEventId,omitempty"`
+	StartedEventId   *int64       `json:"startedEventId,omitempty"`
+	TimeoutType      *TimeoutType `json:"timeoutType,omitempty"`
+}
+
+// ToWire translates a DecisionTaskTimedOutEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7489,7 +7982,7 @@ type DescribeTaskListRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
+func (v *DecisionTaskTimedOutEventAttributes) ToWire() (wire.Value, error) {
 	var (
 		fields [3]wire.Field
 		i      int = 0
@@ -7497,24 +7990,24 @@ func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.ScheduledEventId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.TaskListType != nil {
-		w, err = v.TaskListType.ToWire()
+	if v.TimeoutType != nil {
+		w, err = v.TimeoutType.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -7525,17 +8018,11 @@ func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _TaskListType_Read(w wire.Value) (TaskListType, error) {
-	var v TaskListType
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a DescribeTaskListRequest struct from its Thrift-level
+// FromWire deserializes a DecisionTaskTimedOutEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeTaskListRequest struct
+// An error is returned if we were unable to build a DecisionTaskTimedOutEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7543,29 +8030,31 @@ func _TaskListType_Read(w wire.Value) (TaskListType, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeTaskListRequest
+//   var v DecisionTaskTimedOutEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
+func (v *DecisionTaskTimedOutEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
 				if err != nil {
 					return err
 				}
@@ -7573,9 +8062,9 @@ func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 			}
 		case 30:
 			if field.Value.Type() == wire.TI32 {
-				var x TaskListType
-				x, err = _TaskListType_Read(field.Value)
-				v.TaskListType = &x
+				var x TimeoutType
+				x, err = _TimeoutType_Read(field.Value)
+				v.TimeoutType = &x
 				if err != nil {
 					return err
 				}
@@ -7587,262 +8076,323 @@ func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeTaskListRequest
+// String returns a readable string representation of a DecisionTaskTimedOutEventAttributes
 // struct.
-func (v *DescribeTaskListRequest) String() string {
+func (v *DecisionTaskTimedOutEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [3]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.ScheduledEventId != nil {
+		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
 		i++
 	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
 		i++
 	}
-	if v.TaskListType != nil {
-		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+	if v.TimeoutType != nil {
+		fields[i] = fmt.Sprintf("TimeoutType: %v", *(v.TimeoutType))
 		i++
 	}
 
-	return fmt.Sprintf("DescribeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _TaskListType_EqualsPtr(lhs, rhs *TaskListType) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DecisionTaskTimedOutEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeTaskListRequest match the
-// provided DescribeTaskListRequest.
+// Equals returns true if all the fields of this DecisionTaskTimedOutEventAttributes match the
+// provided DecisionTaskTimedOutEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+func (v *DecisionTaskTimedOutEventAttributes) Equals(rhs *DecisionTaskTimedOutEventAttributes) bool {
+	if !_I64_EqualsPtr(v.ScheduledEventId, rhs.ScheduledEventId) {
 		return false
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
 		return false
 	}
-	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+	if !_TimeoutType_EqualsPtr(v.TimeoutType, rhs.TimeoutType) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetScheduledEventId returns the value of ScheduledEventId if it is set or its
 // zero value if it is unset.
-func (v *DescribeTaskListRequest) GetDomain() (o string) {
-	if v.Domain != nil {
-		return *v.Domain
+func (v *DecisionTaskTimedOutEventAttributes) GetScheduledEventId() (o int64) {
+	if v.ScheduledEventId != nil {
+		return *v.ScheduledEventId
 	}
 
 	return
 }
 
-// GetTaskListType returns the value of TaskListType if it is set or its
+// GetStartedEventId returns the value of StartedEventId if it is set or its
 // zero value if it is unset.
-func (v *DescribeTaskListRequest) GetTaskListType() (o TaskListType) {
-	if v.TaskListType != nil {
-		return *v.TaskListType
+func (v *DecisionTaskTimedOutEventAttributes) GetStartedEventId() (o int64) {
+	if v.StartedEventId != nil {
+		return *v.StartedEventId
 	}
 
 	return
 }
 
-type DescribeTaskListResponse struct {
-	Pollers []*PollerInfo `json:"pollers,omitempty"`
-}
-
-type _List_PollerInfo_ValueList []*PollerInfo
-
-func (v _List_PollerInfo_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetTimeoutType returns the value of TimeoutType if it is set or its
+// zero value if it is unset.
+func (v *DecisionTaskTimedOutEventAttributes) GetTimeoutType() (o TimeoutType) {
+	if v.TimeoutType != nil {
+		return *v.TimeoutType
 	}
-	return nil
-}
 
-func (v _List_PollerInfo_ValueList) Size() int {
-	return len(v)
+	return
 }
 
-func (_List_PollerInfo_ValueList) ValueType() wire.Type {
-	return wire.TStruct
-}
+type DecisionType int32
 
-func (_List_PollerInfo_ValueList) Close() {}
+const (
+	DecisionTypeScheduleActivityTask                   DecisionType = 0
+	DecisionTypeRequestCancelActivityTask              DecisionType = 1
+	DecisionTypeStartTimer                             DecisionType = 2
+	DecisionTypeCompleteWorkflowExecution              DecisionType = 3
+	DecisionTypeFailWorkflowExecution                  DecisionType = 4
+	DecisionTypeCancelTimer                            DecisionType = 5
+	DecisionTypeCancelWorkflowExecution                DecisionType = 6
+	DecisionTypeRequestCancelExternalWorkflowExecution DecisionType = 7
+	DecisionTypeRecordMarker                           DecisionType = 8
+	DecisionTypeContinueAsNewWorkflowExecution         DecisionType = 9
+	DecisionTypeStartChildWorkflowExecution            DecisionType = 10
+	DecisionTypeSignalExternalWorkflowExecution        DecisionType = 11
+	DecisionTypeUpsertWorkflowSearchAttributes         DecisionType = 12
+)
 
-// ToWire translates a DescribeTaskListResponse struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
-	var (
-		fields [1]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.Pollers != nil {
-		w, err = wire.NewValueList(_List_PollerInfo_ValueList(v.Pollers)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
+// DecisionType_Values returns all recognized values of DecisionType.
+func DecisionType_Values() []DecisionType {
+	return []DecisionType{
+		DecisionTypeScheduleActivityTask,
+		DecisionTypeRequestCancelActivityTask,
+		DecisionTypeStartTimer,
+		DecisionTypeCompleteWorkflowExecution,
+		DecisionTypeFailWorkflowExecution,
+		DecisionTypeCancelTimer,
+		DecisionTypeCancelWorkflowExecution,
+		DecisionTypeRequestCancelExternalWorkflowExecution,
+		DecisionTypeRecordMarker,
+		DecisionTypeContinueAsNewWorkflowExecution,
+		DecisionTypeStartChildWorkflowExecution,
+		DecisionTypeSignalExternalWorkflowExecution,
+		DecisionTypeUpsertWorkflowSearchAttributes,
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _PollerInfo_Read(w wire.Value) (*PollerInfo, error) {
-	var v PollerInfo
-	err := v.FromWire(w)
-	return &v, err
 }
 
-func _List_PollerInfo_Read(l wire.ValueList) ([]*PollerInfo, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
+// UnmarshalText tries to decode DecisionType from a byte slice
+// containing its name.
+//
+//   var v DecisionType
+//   err := v.UnmarshalText([]byte("ScheduleActivityTask"))
+func (v *DecisionType) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "ScheduleActivityTask":
+		*v = DecisionTypeScheduleActivityTask
+		return nil
+	case "RequestCancelActivityTask":
+		*v = DecisionTypeRequestCancelActivityTask
+		return nil
+	case "StartTimer":
+		*v = DecisionTypeStartTimer
+		return nil
+	case "CompleteWorkflowExecution":
+		*v = DecisionTypeCompleteWorkflowExecution
+		return nil
+	case "FailWorkflowExecution":
+		*v = DecisionTypeFailWorkflowExecution
+		return nil
+	case "CancelTimer":
+		*v = DecisionTypeCancelTimer
+		return nil
+	case "CancelWorkflowExecution":
+		*v = DecisionTypeCancelWorkflowExecution
+		return nil
+	case "RequestCancelExternalWorkflowExecution":
+		*v = DecisionTypeRequestCancelExternalWorkflowExecution
+		return nil
+	case "RecordMarker":
+		*v = DecisionTypeRecordMarker
+		return nil
+	case "ContinueAsNewWorkflowExecution":
+		*v = DecisionTypeContinueAsNewWorkflowExecution
+		return nil
+	case "StartChildWorkflowExecution":
+		*v = DecisionTypeStartChildWorkflowExecution
+		return nil
+	case "SignalExternalWorkflowExecution":
+		*v = DecisionTypeSignalExternalWorkflowExecution
+		return nil
+	case "UpsertWorkflowSearchAttributes":
+		*v = DecisionTypeUpsertWorkflowSearchAttributes
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "DecisionType")
 	}
+}
 
-	o := make([]*PollerInfo, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _PollerInfo_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+// Ptr returns a pointer to this enum value.
+func (v DecisionType) Ptr() *DecisionType {
+	return &v
 }
 
-// FromWire deserializes a DescribeTaskListResponse struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
+// ToWire translates DecisionType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeTaskListResponse struct
-// from the provided intermediate representation.
+// Enums are represented as 32-bit integers over the wire.
+func (v DecisionType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes DecisionType from its Thrift-level
+// representation.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return nil, err
+//     return DecisionType(0), err
 //   }
 //
-//   var v DescribeTaskListResponse
+//   var v DecisionType
 //   if err := v.FromWire(x); err != nil {
-//     return nil, err
+//     return DecisionType(0), err
 //   }
-//   return &v, nil
-func (v *DescribeTaskListResponse) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TList {
-				v.Pollers, err = _List_PollerInfo_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
+//   return v, nil
+func (v *DecisionType) FromWire(w wire.Value) error {
+	*v = (DecisionType)(w.GetI32())
 	return nil
 }
 
-// String returns a readable string representation of a DescribeTaskListResponse
-// struct.
-func (v *DescribeTaskListResponse) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [1]string
-	i := 0
-	if v.Pollers != nil {
-		fields[i] = fmt.Sprintf("Pollers: %v", v.Pollers)
-		i++
+// String returns a readable string representation of DecisionType.
+func (v DecisionType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ScheduleActivityTask"
+	case 1:
+		return "RequestCancelActivityTask"
+	case 2:
+		return "StartTimer"
+	case 3:
+		return "CompleteWorkflowExecution"
+	case 4:
+		return "FailWorkflowExecution"
+	case 5:
+		return "CancelTimer"
+	case 6:
+		return "CancelWorkflowExecution"
+	case 7:
+		return "RequestCancelExternalWorkflowExecution"
+	case 8:
+		return "RecordMarker"
+	case 9:
+		return "ContinueAsNewWorkflowExecution"
+	case 10:
+		return "StartChildWorkflowExecution"
+	case 11:
+		return "SignalExternalWorkflowExecution"
+	case 12:
+		return "UpsertWorkflowSearchAttributes"
 	}
-
-	return fmt.Sprintf("DescribeTaskListResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DecisionType(%d)", w)
 }
 
-func _List_PollerInfo_Equals(lhs, rhs []*PollerInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
+// Equals returns true if this DecisionType value matches the provided
+// value.
+func (v DecisionType) Equals(rhs DecisionType) bool {
+	return v == rhs
 }
 
-// Equals returns true if all the fields of this DescribeTaskListResponse match the
-// provided DescribeTaskListResponse.
+// MarshalJSON serializes DecisionType into JSON.
 //
-// This function performs a deep comparison.
-func (v *DescribeTaskListResponse) Equals(rhs *DescribeTaskListResponse) bool {
-	if !((v.Pollers == nil && rhs.Pollers == nil) || (v.Pollers != nil && rhs.Pollers != nil && _List_PollerInfo_Equals(v.Pollers, rhs.Pollers))) {
-		return false
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v DecisionType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ScheduleActivityTask\""), nil
+	case 1:
+		return ([]byte)("\"RequestCancelActivityTask\""), nil
+	case 2:
+		return ([]byte)("\"StartTimer\""), nil
+	case 3:
+		return ([]byte)("\"CompleteWorkflowExecution\""), nil
+	case 4:
+		return ([]byte)("\"FailWorkflowExecution\""), nil
+	case 5:
+		return ([]byte)("\"CancelTimer\""), nil
+	case 6:
+		return ([]byte)("\"CancelWorkflowExecution\""), nil
+	case 7:
+		return ([]byte)("\"RequestCancelExternalWorkflowExecution\""), nil
+	case 8:
+		return ([]byte)("\"RecordMarker\""), nil
+	case 9:
+		return ([]byte)("\"ContinueAsNewWorkflowExecution\""), nil
+	case 10:
+		return ([]byte)("\"StartChildWorkflowExecution\""), nil
+	case 11:
+		return ([]byte)("\"SignalExternalWorkflowExecution\""), nil
+	case 12:
+		return ([]byte)("\"UpsertWorkflowSearchAttributes\""), nil
 	}
-
-	return true
-}
-
-type DescribeWorkflowExecutionRequest struct {
-	Domain    *string            `json:"domain,omitempty"`
-	Execution *WorkflowExecution `json:"execution,omitempty"`
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+// UnmarshalJSON attempts to decode DecisionType from its JSON
+// representation.
 //
-// An error is returned if the struct or any of its fields failed to
-// validate.
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *DecisionType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DecisionType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DecisionType")
+		}
+		*v = (DecisionType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DecisionType")
+	}
+}
+
+type DeprecateDomainRequest struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// ToWire translates a DeprecateDomainRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
 //
 //   x, err := v.ToWire()
 //   if err != nil {
@@ -7852,39 +8402,31 @@ type DescribeWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *DeprecateDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a DeprecateDomainRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a DeprecateDomainRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7892,12 +8434,12 @@ func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionRequest
+//   var v DeprecateDomainRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -7906,15 +8448,7 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				v.Name = &x
 				if err != nil {
 					return err
 				}
@@ -7926,88 +8460,50 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// String returns a readable string representation of a DeprecateDomainRequest
 // struct.
-func (v *DescribeWorkflowExecutionRequest) String() string {
+func (v *DeprecateDomainRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
 		i++
 	}
 
-	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DeprecateDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
-// provided DescribeWorkflowExecutionRequest.
+// Equals returns true if all the fields of this DeprecateDomainRequest match the
+// provided DeprecateDomainRequest.
 //
 // This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+func (v *DeprecateDomainRequest) Equals(rhs *DeprecateDomainRequest) bool {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
-	if v.Domain != nil {
-		return *v.Domain
+func (v *DeprecateDomainRequest) GetName() (o string) {
+	if v.Name != nil {
+		return *v.Name
 	}
 
 	return
 }
 
-type DescribeWorkflowExecutionResponse struct {
-	ExecutionConfiguration *WorkflowExecutionConfiguration `json:"executionConfiguration,omitempty"`
-	WorkflowExecutionInfo  *WorkflowExecutionInfo          `json:"workflowExecutionInfo,omitempty"`
-	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
-}
-
-type _List_PendingActivityInfo_ValueList []*PendingActivityInfo
-
-func (v _List_PendingActivityInfo_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_PendingActivityInfo_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_PendingActivityInfo_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+type DescribeDomainRequest struct {
+	Name *string `json:"name,omitempty"`
 }
 
-func (_List_PendingActivityInfo_ValueList) Close() {}
-
-// ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
+// ToWire translates a DescribeDomainRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8022,83 +8518,31 @@ func (_List_PendingActivityInfo_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+func (v *DescribeDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ExecutionConfiguration != nil {
-		w, err = v.ExecutionConfiguration.ToWire()
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionInfo != nil {
-		w, err = v.WorkflowExecutionInfo.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.PendingActivities != nil {
-		w, err = wire.NewValueList(_List_PendingActivityInfo_ValueList(v.PendingActivities)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowExecutionConfiguration_Read(w wire.Value) (*WorkflowExecutionConfiguration, error) {
-	var v WorkflowExecutionConfiguration
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionInfo_Read(w wire.Value) (*WorkflowExecutionInfo, error) {
-	var v WorkflowExecutionInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _PendingActivityInfo_Read(w wire.Value) (*PendingActivityInfo, error) {
-	var v PendingActivityInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_PendingActivityInfo_Read(l wire.ValueList) ([]*PendingActivityInfo, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*PendingActivityInfo, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _PendingActivityInfo_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a DescribeWorkflowExecutionResponse struct from its Thrift-level
+// FromWire deserializes a DescribeDomainRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionResponse struct
+// An error is returned if we were unable to build a DescribeDomainRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8106,35 +8550,21 @@ func _List_PendingActivityInfo_Read(l wire.ValueList) ([]*PendingActivityInfo, e
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionResponse
+//   var v DescribeDomainRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
+func (v *DescribeDomainRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.ExecutionConfiguration, err = _WorkflowExecutionConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionInfo, err = _WorkflowExecutionInfo_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TList {
-				v.PendingActivities, err = _List_PendingActivityInfo_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
 				if err != nil {
 					return err
 				}
@@ -8146,69 +8576,55 @@ func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionResponse
+// String returns a readable string representation of a DescribeDomainRequest
 // struct.
-func (v *DescribeWorkflowExecutionResponse) String() string {
+func (v *DescribeDomainRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [1]string
 	i := 0
-	if v.ExecutionConfiguration != nil {
-		fields[i] = fmt.Sprintf("ExecutionConfiguration: %v", v.ExecutionConfiguration)
-		i++
-	}
-	if v.WorkflowExecutionInfo != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionInfo: %v", v.WorkflowExecutionInfo)
-		i++
-	}
-	if v.PendingActivities != nil {
-		fields[i] = fmt.Sprintf("PendingActivities: %v", v.PendingActivities)
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
 		i++
 	}
 
-	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_PendingActivityInfo_Equals(lhs, rhs []*PendingActivityInfo) bool {
-	if len(lhs) != len(rhs) {
+// Equals returns true if all the fields of this DescribeDomainRequest match the
+// provided DescribeDomainRequest.
+//
+// This function performs a deep comparison.
+func (v *DescribeDomainRequest) Equals(rhs *DescribeDomainRequest) bool {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
 		return false
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
 	return true
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionResponse match the
-// provided DescribeWorkflowExecutionResponse.
-//
-// This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutionResponse) bool {
-	if !((v.ExecutionConfiguration == nil && rhs.ExecutionConfiguration == nil) || (v.ExecutionConfiguration != nil && rhs.ExecutionConfiguration != nil && v.ExecutionConfiguration.Equals(rhs.ExecutionConfiguration))) {
-		return false
-	}
-	if !((v.WorkflowExecutionInfo == nil && rhs.WorkflowExecutionInfo == nil) || (v.WorkflowExecutionInfo != nil && rhs.WorkflowExecutionInfo != nil && v.WorkflowExecutionInfo.Equals(rhs.WorkflowExecutionInfo))) {
-		return false
-	}
-	if !((v.PendingActivities == nil && rhs.PendingActivities == nil) || (v.PendingActivities != nil && rhs.PendingActivities != nil && _List_PendingActivityInfo_Equals(v.PendingActivities, rhs.PendingActivities))) {
-		return false
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainRequest) GetName() (o string) {
+	if v.Name != nil {
+		return *v.Name
 	}
 
-	return true
+	return
 }
 
-type DomainAlreadyExistsError struct {
-	Message string `json:"message,required"`
+type DescribeDomainResponse struct {
+	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
+	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
+	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
+	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+	HandoverInfo             *DomainHandoverInfo             `json:"handoverInfo,omitempty"`
 }
 
-// ToWire translates a DomainAlreadyExistsError struct into a Thrift-level intermediate
+// ToWire translates a DescribeDomainResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8223,150 +8639,95 @@ type DomainAlreadyExistsError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DomainAlreadyExistsError) ToWire() (wire.Value, error) {
+func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a DomainAlreadyExistsError struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a DomainAlreadyExistsError struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v DomainAlreadyExistsError
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *DomainAlreadyExistsError) FromWire(w wire.Value) error {
-	var err error
-
-	messageIsSet := false
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				messageIsSet = true
-			}
+	if v.DomainInfo != nil {
+		w, err = v.DomainInfo.ToWire()
+		if err != nil {
+			return w, err
 		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-
-	if !messageIsSet {
-		return errors.New("field Message of DomainAlreadyExistsError is required")
+	if v.Configuration != nil {
+		w, err = v.Configuration.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-
-	return nil
-}
-
-// String returns a readable string representation of a DomainAlreadyExistsError
-// struct.
-func (v *DomainAlreadyExistsError) String() string {
-	if v == nil {
-		return "<nil>"
+	if v.ReplicationConfiguration != nil {
+		w, err = v.ReplicationConfiguration.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
-
-	var fields [1]string
-	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-
-	return fmt.Sprintf("DomainAlreadyExistsError{%v}", strings.Join(fields[:i], ", "))
-}
-
-// Equals returns true if all the fields of this DomainAlreadyExistsError match the
-// provided DomainAlreadyExistsError.
-//
-// This function performs a deep comparison.
-func (v *DomainAlreadyExistsError) Equals(rhs *DomainAlreadyExistsError) bool {
-	if !(v.Message == rhs.Message) {
-		return false
+	if v.FailoverVersion != nil {
+		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
 	}
-
-	return true
-}
-
-func (v *DomainAlreadyExistsError) Error() string {
-	return v.String()
-}
-
-type DomainConfiguration struct {
-	WorkflowExecutionRetentionPeriodInDays *int32 `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             *bool  `json:"emitMetric,omitempty"`
-}
-
-// ToWire translates a DomainConfiguration struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *DomainConfiguration) ToWire() (wire.Value, error) {
-	var (
-		fields [2]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
+	if v.IsGlobalDomain != nil {
+		w, err = wire.NewValueBool(*(v.IsGlobalDomain)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.EmitMetric != nil {
-		w, err = wire.NewValueBool(*(v.EmitMetric)), error(nil)
+	if v.HandoverInfo != nil {
+		w, err = v.HandoverInfo.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DomainConfiguration struct from its Thrift-level
+func _DomainInfo_Read(w wire.Value) (*DomainInfo, error) {
+	var v DomainInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainConfiguration_Read(w wire.Value) (*DomainConfiguration, error) {
+	var v DomainConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainReplicationConfiguration_Read(w wire.Value) (*DomainReplicationConfiguration, error) {
+	var v DomainReplicationConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainHandoverInfo_Read(w wire.Value) (*DomainHandoverInfo, error) {
+	var v DomainHandoverInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a DescribeDomainResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainConfiguration struct
+// An error is returned if we were unable to build a DescribeDomainResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8374,106 +8735,175 @@ func (v *DomainConfiguration) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DomainConfiguration
+//   var v DescribeDomainResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DomainConfiguration) FromWire(w wire.Value) error {
+func (v *DescribeDomainResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.WorkflowExecutionRetentionPeriodInDays = &x
+			if field.Value.Type() == wire.TStruct {
+				v.DomainInfo, err = _DomainInfo_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.EmitMetric = &x
+			if field.Value.Type() == wire.TStruct {
+				v.Configuration, err = _DomainConfiguration_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		}
-	}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-	return nil
-}
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FailoverVersion = &x
+				if err != nil {
+					return err
+				}
 
-// String returns a readable string representation of a DomainConfiguration
-// struct.
-func (v *DomainConfiguration) String() string {
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsGlobalDomain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.HandoverInfo, err = _DomainHandoverInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeDomainResponse
+// struct.
+func (v *DescribeDomainResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [6]string
 	i := 0
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
+	if v.DomainInfo != nil {
+		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
 		i++
 	}
-	if v.EmitMetric != nil {
-		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
+	if v.Configuration != nil {
+		fields[i] = fmt.Sprintf("Configuration: %v", v.Configuration)
+		i++
+	}
+	if v.ReplicationConfiguration != nil {
+		fields[i] = fmt.Sprintf("ReplicationConfiguration: %v", v.ReplicationConfiguration)
+		i++
+	}
+	if v.FailoverVersion != nil {
+		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
+		i++
+	}
+	if v.IsGlobalDomain != nil {
+		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
+		i++
+	}
+	if v.HandoverInfo != nil {
+		fields[i] = fmt.Sprintf("HandoverInfo: %v", v.HandoverInfo)
 		i++
 	}
 
-	return fmt.Sprintf("DomainConfiguration{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DomainConfiguration match the
-// provided DomainConfiguration.
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DescribeDomainResponse match the
+// provided DescribeDomainResponse.
 //
 // This function performs a deep comparison.
-func (v *DomainConfiguration) Equals(rhs *DomainConfiguration) bool {
-	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
+func (v *DescribeDomainResponse) Equals(rhs *DescribeDomainResponse) bool {
+	if !((v.DomainInfo == nil && rhs.DomainInfo == nil) || (v.DomainInfo != nil && rhs.DomainInfo != nil && v.DomainInfo.Equals(rhs.DomainInfo))) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
+	if !((v.Configuration == nil && rhs.Configuration == nil) || (v.Configuration != nil && rhs.Configuration != nil && v.Configuration.Equals(rhs.Configuration))) {
+		return false
+	}
+	if !((v.ReplicationConfiguration == nil && rhs.ReplicationConfiguration == nil) || (v.ReplicationConfiguration != nil && rhs.ReplicationConfiguration != nil && v.ReplicationConfiguration.Equals(rhs.ReplicationConfiguration))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
+		return false
+	}
+	if !((v.HandoverInfo == nil && rhs.HandoverInfo == nil) || (v.HandoverInfo != nil && rhs.HandoverInfo != nil && v.HandoverInfo.Equals(rhs.HandoverInfo))) {
 		return false
 	}
 
 	return true
 }
 
-// GetWorkflowExecutionRetentionPeriodInDays returns the value of WorkflowExecutionRetentionPeriodInDays if it is set or its
+// GetFailoverVersion returns the value of FailoverVersion if it is set or its
 // zero value if it is unset.
-func (v *DomainConfiguration) GetWorkflowExecutionRetentionPeriodInDays() (o int32) {
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		return *v.WorkflowExecutionRetentionPeriodInDays
+func (v *DescribeDomainResponse) GetFailoverVersion() (o int64) {
+	if v.FailoverVersion != nil {
+		return *v.FailoverVersion
 	}
 
 	return
 }
 
-// GetEmitMetric returns the value of EmitMetric if it is set or its
+// GetIsGlobalDomain returns the value of IsGlobalDomain if it is set or its
 // zero value if it is unset.
-func (v *DomainConfiguration) GetEmitMetric() (o bool) {
-	if v.EmitMetric != nil {
-		return *v.EmitMetric
+func (v *DescribeDomainResponse) GetIsGlobalDomain() (o bool) {
+	if v.IsGlobalDomain != nil {
+		return *v.IsGlobalDomain
 	}
 
 	return
 }
 
-type DomainInfo struct {
-	Name        *string       `json:"name,omitempty"`
-	Status      *DomainStatus `json:"status,omitempty"`
-	Description *string       `json:"description,omitempty"`
-	OwnerEmail  *string       `json:"ownerEmail,omitempty"`
+type DescribeHistoryHostRequest struct {
+	HostAddress      *string            `json:"hostAddress,omitempty"`
+	ShardIdForHost   *int32             `json:"shardIdForHost,omitempty"`
+	ExecutionForHost *WorkflowExecution `json:"executionForHost,omitempty"`
 }
 
-// ToWire translates a DomainInfo struct into a Thrift-level intermediate
+// ToWire translates a DescribeHistoryHostRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8488,61 +8918,47 @@ type DomainInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DomainInfo) ToWire() (wire.Value, error) {
+func (v *DescribeHistoryHostRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.HostAddress != nil {
+		w, err = wire.NewValueString(*(v.HostAddress)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Status != nil {
-		w, err = v.Status.ToWire()
+	if v.ShardIdForHost != nil {
+		w, err = wire.NewValueI32(*(v.ShardIdForHost)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Description != nil {
-		w, err = wire.NewValueString(*(v.Description)), error(nil)
+	if v.ExecutionForHost != nil {
+		w, err = v.ExecutionForHost.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.OwnerEmail != nil {
-		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DomainStatus_Read(w wire.Value) (DomainStatus, error) {
-	var v DomainStatus
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a DomainInfo struct from its Thrift-level
+// FromWire deserializes a DescribeHistoryHostRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainInfo struct
+// An error is returned if we were unable to build a DescribeHistoryHostRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8550,12 +8966,12 @@ func _DomainStatus_Read(w wire.Value) (DomainStatus, error) {
 //     return nil, err
 //   }
 //
-//   var v DomainInfo
+//   var v DescribeHistoryHostRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DomainInfo) FromWire(w wire.Value) error {
+func (v *DescribeHistoryHostRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -8564,7 +8980,7 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+				v.HostAddress = &x
 				if err != nil {
 					return err
 				}
@@ -8572,29 +8988,17 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TI32 {
-				var x DomainStatus
-				x, err = _DomainStatus_Read(field.Value)
-				v.Status = &x
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardIdForHost = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Description = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.OwnerEmail = &x
+			if field.Value.Type() == wire.TStruct {
+				v.ExecutionForHost, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -8606,114 +9010,139 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DomainInfo
+// String returns a readable string representation of a DescribeHistoryHostRequest
 // struct.
-func (v *DomainInfo) String() string {
+func (v *DescribeHistoryHostRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [3]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
-		i++
-	}
-	if v.Status != nil {
-		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+	if v.HostAddress != nil {
+		fields[i] = fmt.Sprintf("HostAddress: %v", *(v.HostAddress))
 		i++
 	}
-	if v.Description != nil {
-		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
+	if v.ShardIdForHost != nil {
+		fields[i] = fmt.Sprintf("ShardIdForHost: %v", *(v.ShardIdForHost))
 		i++
 	}
-	if v.OwnerEmail != nil {
-		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+	if v.ExecutionForHost != nil {
+		fields[i] = fmt.Sprintf("ExecutionForHost: %v", v.ExecutionForHost)
 		i++
 	}
 
-	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _DomainStatus_EqualsPtr(lhs, rhs *DomainStatus) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DescribeHistoryHostRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DomainInfo match the
-// provided DomainInfo.
+// Equals returns true if all the fields of this DescribeHistoryHostRequest match the
+// provided DescribeHistoryHostRequest.
 //
 // This function performs a deep comparison.
-func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
+func (v *DescribeHistoryHostRequest) Equals(rhs *DescribeHistoryHostRequest) bool {
+	if !_String_EqualsPtr(v.HostAddress, rhs.HostAddress) {
 		return false
 	}
-	if !_DomainStatus_EqualsPtr(v.Status, rhs.Status) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Description, rhs.Description) {
+	if !_I32_EqualsPtr(v.ShardIdForHost, rhs.ShardIdForHost) {
 		return false
 	}
-	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+	if !((v.ExecutionForHost == nil && rhs.ExecutionForHost == nil) || (v.ExecutionForHost != nil && rhs.ExecutionForHost != nil && v.ExecutionForHost.Equals(rhs.ExecutionForHost))) {
 		return false
 	}
 
 	return true
 }
 
-// GetName returns the value of Name if it is set or its
+// GetHostAddress returns the value of HostAddress if it is set or its
 // zero value if it is unset.
-func (v *DomainInfo) GetName() (o string) {
-	if v.Name != nil {
-		return *v.Name
+func (v *DescribeHistoryHostRequest) GetHostAddress() (o string) {
+	if v.HostAddress != nil {
+		return *v.HostAddress
 	}
 
 	return
 }
 
-// GetStatus returns the value of Status if it is set or its
+// GetShardIdForHost returns the value of ShardIdForHost if it is set or its
 // zero value if it is unset.
-func (v *DomainInfo) GetStatus() (o DomainStatus) {
-	if v.Status != nil {
-		return *v.Status
+func (v *DescribeHistoryHostRequest) GetShardIdForHost() (o int32) {
+	if v.ShardIdForHost != nil {
+		return *v.ShardIdForHost
 	}
 
 	return
 }
 
-// GetDescription returns the value of Description if it is set or its
-// zero value if it is unset.
-func (v *DomainInfo) GetDescription() (o string) {
-	if v.Description != nil {
-		return *v.Description
+type DescribeHistoryHostResponse struct {
+	NumberOfShards        *int32          `json:"numberOfShards,omitempty"`
+	ShardIDs              []int32         `json:"shardIDs,omitempty"`
+	HistoryCacheSizes     map[int32]int32 `json:"historyCacheSizes,omitempty"`
+	ShardControllerStatus *string         `json:"shardControllerStatus,omitempty"`
+	Address               *string         `json:"address,omitempty"`
+}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
 }
 
-// GetOwnerEmail returns the value of OwnerEmail if it is set or its
-// zero value if it is unset.
-func (v *DomainInfo) GetOwnerEmail() (o string) {
-	if v.OwnerEmail != nil {
-		return *v.OwnerEmail
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+type _Map_I32_I32_MapItemList map[int32]int32
+
+func (m _Map_I32_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return
+func (m _Map_I32_I32_MapItemList) Size() int {
+	return len(m)
 }
 
-type DomainNotActiveError struct {
-	Message        string `json:"message,required"`
-	DomainName     string `json:"domainName,required"`
-	CurrentCluster string `json:"currentCluster,required"`
-	ActiveCluster  string `json:"activeCluster,required"`
+func (_Map_I32_I32_MapItemList) KeyType() wire.Type {
+	return wire.TI32
 }
 
-// ToWire translates a DomainNotActiveError struct into a Thrift-level intermediate
+func (_Map_I32_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_I32_MapItemList) Close() {}
+
+// ToWire translates a DescribeHistoryHostResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8728,50 +9157,109 @@ type DomainNotActiveError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DomainNotActiveError) ToWire() (wire.Value, error) {
+func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
+	if v.NumberOfShards != nil {
+		w, err = wire.NewValueI32(*(v.NumberOfShards)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ShardIDs != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.ShardIDs)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HistoryCacheSizes != nil {
+		w, err = wire.NewValueMap(_Map_I32_I32_MapItemList(v.HistoryCacheSizes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ShardControllerStatus != nil {
+		w, err = wire.NewValueString(*(v.ShardControllerStatus)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Address != nil {
+		w, err = wire.NewValueString(*(v.Address)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
-	w, err = wire.NewValueString(v.DomainName), error(nil)
-	if err != nil {
-		return w, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
 	}
-	fields[i] = wire.Field{ID: 2, Value: w}
-	i++
 
-	w, err = wire.NewValueString(v.CurrentCluster), error(nil)
-	if err != nil {
-		return w, err
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_I32_I32_Read(m wire.MapItemList) (map[int32]int32, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
 	}
-	fields[i] = wire.Field{ID: 3, Value: w}
-	i++
 
-	w, err = wire.NewValueString(v.ActiveCluster), error(nil)
-	if err != nil {
-		return w, err
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
 	}
-	fields[i] = wire.Field{ID: 4, Value: w}
-	i++
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+	o := make(map[int32]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
 }
 
-// FromWire deserializes a DomainNotActiveError struct from its Thrift-level
+// FromWire deserializes a DescribeHistoryHostResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainNotActiveError struct
+// An error is returned if we were unable to build a DescribeHistoryHostResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8779,156 +9267,194 @@ func (v *DomainNotActiveError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DomainNotActiveError
+//   var v DescribeHistoryHostResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DomainNotActiveError) FromWire(w wire.Value) error {
+func (v *DescribeHistoryHostResponse) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-	domainNameIsSet := false
-	currentClusterIsSet := false
-	activeClusterIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumberOfShards = &x
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
 			}
-		case 2:
-			if field.Value.Type() == wire.TBinary {
-				v.DomainName, err = field.Value.GetString(), error(nil)
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.ShardIDs, err = _List_I32_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
-				domainNameIsSet = true
+
 			}
-		case 3:
+		case 30:
+			if field.Value.Type() == wire.TMap {
+				v.HistoryCacheSizes, err = _Map_I32_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TBinary {
-				v.CurrentCluster, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ShardControllerStatus = &x
 				if err != nil {
 					return err
 				}
-				currentClusterIsSet = true
+
 			}
-		case 4:
+		case 50:
 			if field.Value.Type() == wire.TBinary {
-				v.ActiveCluster, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Address = &x
 				if err != nil {
 					return err
 				}
-				activeClusterIsSet = true
+
 			}
 		}
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of DomainNotActiveError is required")
+	return nil
+}
+
+// String returns a readable string representation of a DescribeHistoryHostResponse
+// struct.
+func (v *DescribeHistoryHostResponse) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	if !domainNameIsSet {
-		return errors.New("field DomainName of DomainNotActiveError is required")
+	var fields [5]string
+	i := 0
+	if v.NumberOfShards != nil {
+		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
+		i++
+	}
+	if v.ShardIDs != nil {
+		fields[i] = fmt.Sprintf("ShardIDs: %v", v.ShardIDs)
+		i++
+	}
+	if v.HistoryCacheSizes != nil {
+		fields[i] = fmt.Sprintf("HistoryCacheSizes: %v", v.HistoryCacheSizes)
+		i++
+	}
+	if v.ShardControllerStatus != nil {
+		fields[i] = fmt.Sprintf("ShardControllerStatus: %v", *(v.ShardControllerStatus))
+		i++
+	}
+	if v.Address != nil {
+		fields[i] = fmt.Sprintf("Address: %v", *(v.Address))
+		i++
 	}
 
-	if !currentClusterIsSet {
-		return errors.New("field CurrentCluster of DomainNotActiveError is required")
+	return fmt.Sprintf("DescribeHistoryHostResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
 
-	if !activeClusterIsSet {
-		return errors.New("field ActiveCluster of DomainNotActiveError is required")
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
 	}
 
-	return nil
+	return true
 }
 
-// String returns a readable string representation of a DomainNotActiveError
-// struct.
-func (v *DomainNotActiveError) String() string {
-	if v == nil {
-		return "<nil>"
+func _Map_I32_I32_Equals(lhs, rhs map[int32]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
 
-	var fields [4]string
-	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-	fields[i] = fmt.Sprintf("DomainName: %v", v.DomainName)
-	i++
-	fields[i] = fmt.Sprintf("CurrentCluster: %v", v.CurrentCluster)
-	i++
-	fields[i] = fmt.Sprintf("ActiveCluster: %v", v.ActiveCluster)
-	i++
-
-	return fmt.Sprintf("DomainNotActiveError{%v}", strings.Join(fields[:i], ", "))
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
 }
 
-// Equals returns true if all the fields of this DomainNotActiveError match the
-// provided DomainNotActiveError.
+// Equals returns true if all the fields of this DescribeHistoryHostResponse match the
+// provided DescribeHistoryHostResponse.
 //
 // This function performs a deep comparison.
-func (v *DomainNotActiveError) Equals(rhs *DomainNotActiveError) bool {
-	if !(v.Message == rhs.Message) {
+func (v *DescribeHistoryHostResponse) Equals(rhs *DescribeHistoryHostResponse) bool {
+	if !_I32_EqualsPtr(v.NumberOfShards, rhs.NumberOfShards) {
 		return false
 	}
-	if !(v.DomainName == rhs.DomainName) {
+	if !((v.ShardIDs == nil && rhs.ShardIDs == nil) || (v.ShardIDs != nil && rhs.ShardIDs != nil && _List_I32_Equals(v.ShardIDs, rhs.ShardIDs))) {
 		return false
 	}
-	if !(v.CurrentCluster == rhs.CurrentCluster) {
+	if !((v.HistoryCacheSizes == nil && rhs.HistoryCacheSizes == nil) || (v.HistoryCacheSizes != nil && rhs.HistoryCacheSizes != nil && _Map_I32_I32_Equals(v.HistoryCacheSizes, rhs.HistoryCacheSizes))) {
 		return false
 	}
-	if !(v.ActiveCluster == rhs.ActiveCluster) {
+	if !_String_EqualsPtr(v.ShardControllerStatus, rhs.ShardControllerStatus) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Address, rhs.Address) {
 		return false
 	}
 
 	return true
 }
 
-func (v *DomainNotActiveError) Error() string {
-	return v.String()
-}
+// GetNumberOfShards returns the value of NumberOfShards if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostResponse) GetNumberOfShards() (o int32) {
+	if v.NumberOfShards != nil {
+		return *v.NumberOfShards
+	}
 
-type DomainReplicationConfiguration struct {
-	ActiveClusterName *string                            `json:"activeClusterName,omitempty"`
-	Clusters          []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+	return
 }
 
-type _List_ClusterReplicationConfiguration_ValueList []*ClusterReplicationConfiguration
-
-func (v _List_ClusterReplicationConfiguration_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetShardControllerStatus returns the value of ShardControllerStatus if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostResponse) GetShardControllerStatus() (o string) {
+	if v.ShardControllerStatus != nil {
+		return *v.ShardControllerStatus
 	}
-	return nil
-}
 
-func (v _List_ClusterReplicationConfiguration_ValueList) Size() int {
-	return len(v)
+	return
 }
 
-func (_List_ClusterReplicationConfiguration_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+// GetAddress returns the value of Address if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostResponse) GetAddress() (o string) {
+	if v.Address != nil {
+		return *v.Address
+	}
+
+	return
 }
 
-func (_List_ClusterReplicationConfiguration_ValueList) Close() {}
+type DescribeTaskListRequest struct {
+	Domain       *string       `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
 
-// ToWire translates a DomainReplicationConfiguration struct into a Thrift-level intermediate
+// ToWire translates a DescribeTaskListRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8943,63 +9469,53 @@ func (_List_ClusterReplicationConfiguration_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DomainReplicationConfiguration) ToWire() (wire.Value, error) {
+func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ActiveClusterName != nil {
-		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Clusters != nil {
-		w, err = wire.NewValueList(_List_ClusterReplicationConfiguration_ValueList(v.Clusters)), error(nil)
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ClusterReplicationConfiguration_Read(w wire.Value) (*ClusterReplicationConfiguration, error) {
-	var v ClusterReplicationConfiguration
+func _TaskListType_Read(w wire.Value) (TaskListType, error) {
+	var v TaskListType
 	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_ClusterReplicationConfiguration_Read(l wire.ValueList) ([]*ClusterReplicationConfiguration, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*ClusterReplicationConfiguration, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _ClusterReplicationConfiguration_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+	return v, err
 }
 
-// FromWire deserializes a DomainReplicationConfiguration struct from its Thrift-level
+// FromWire deserializes a DescribeTaskListRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainReplicationConfiguration struct
+// An error is returned if we were unable to build a DescribeTaskListRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9007,12 +9523,12 @@ func _List_ClusterReplicationConfiguration_Read(l wire.ValueList) ([]*ClusterRep
 //     return nil, err
 //   }
 //
-//   var v DomainReplicationConfiguration
+//   var v DescribeTaskListRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
+func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -9021,15 +9537,25 @@ func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ActiveClusterName = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TList {
-				v.Clusters, err = _List_ClusterReplicationConfiguration_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
 				if err != nil {
 					return err
 				}
@@ -9041,216 +9567,257 @@ func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DomainReplicationConfiguration
+// String returns a readable string representation of a DescribeTaskListRequest
 // struct.
-func (v *DomainReplicationConfiguration) String() string {
+func (v *DescribeTaskListRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
-	if v.ActiveClusterName != nil {
-		fields[i] = fmt.Sprintf("ActiveClusterName: %v", *(v.ActiveClusterName))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.Clusters != nil {
-		fields[i] = fmt.Sprintf("Clusters: %v", v.Clusters)
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
 		i++
 	}
 
-	return fmt.Sprintf("DomainReplicationConfiguration{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_ClusterReplicationConfiguration_Equals(lhs, rhs []*ClusterReplicationConfiguration) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
+func _TaskListType_EqualsPtr(lhs, rhs *TaskListType) bool {
+	if lhs != nil && rhs != nil {
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
 	}
-
-	return true
+	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this DomainReplicationConfiguration match the
-// provided DomainReplicationConfiguration.
+// Equals returns true if all the fields of this DescribeTaskListRequest match the
+// provided DescribeTaskListRequest.
 //
 // This function performs a deep comparison.
-func (v *DomainReplicationConfiguration) Equals(rhs *DomainReplicationConfiguration) bool {
-	if !_String_EqualsPtr(v.ActiveClusterName, rhs.ActiveClusterName) {
+func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.Clusters == nil && rhs.Clusters == nil) || (v.Clusters != nil && rhs.Clusters != nil && _List_ClusterReplicationConfiguration_Equals(v.Clusters, rhs.Clusters))) {
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
 		return false
 	}
 
 	return true
 }
 
-// GetActiveClusterName returns the value of ActiveClusterName if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *DomainReplicationConfiguration) GetActiveClusterName() (o string) {
-	if v.ActiveClusterName != nil {
-		return *v.ActiveClusterName
+func (v *DescribeTaskListRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-type DomainStatus int32
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v.TaskListType != nil {
+		return *v.TaskListType
+	}
 
-const (
-	DomainStatusRegistered DomainStatus = 0
-	DomainStatusDeprecated DomainStatus = 1
-	DomainStatusDeleted    DomainStatus = 2
-)
+	return
+}
 
-// DomainStatus_Values returns all recognized values of DomainStatus.
-func DomainStatus_Values() []DomainStatus {
-	return []DomainStatus{
-		DomainStatusRegistered,
-		DomainStatusDeprecated,
-		DomainStatusDeleted,
-	}
+type DescribeTaskListResponse struct {
+	Pollers []*PollerInfo `json:"pollers,omitempty"`
 }
 
-// UnmarshalText tries to decode DomainStatus from a byte slice
-// containing its name.
-//
-//   var v DomainStatus
-//   err := v.UnmarshalText([]byte("REGISTERED"))
-func (v *DomainStatus) UnmarshalText(value []byte) error {
-	switch string(value) {
-	case "REGISTERED":
-		*v = DomainStatusRegistered
-		return nil
-	case "DEPRECATED":
-		*v = DomainStatusDeprecated
-		return nil
-	case "DELETED":
-		*v = DomainStatusDeleted
-		return nil
-	default:
-		return fmt.Errorf("unknown enum value %q for %q", value, "DomainStatus")
+type _List_PollerInfo_ValueList []*PollerInfo
+
+func (v _List_PollerInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-// Ptr returns a pointer to this enum value.
-func (v DomainStatus) Ptr() *DomainStatus {
-	return &v
+func (v _List_PollerInfo_ValueList) Size() int {
+	return len(v)
 }
 
-// ToWire translates DomainStatus into a Thrift-level intermediate
+func (_List_PollerInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_PollerInfo_ValueList) Close() {}
+
+// ToWire translates a DescribeTaskListResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
-// Enums are represented as 32-bit integers over the wire.
-func (v DomainStatus) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes DomainStatus from its Thrift-level
-// representation.
+// An error is returned if the struct or any of its fields failed to
+// validate.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   x, err := v.ToWire()
 //   if err != nil {
-//     return DomainStatus(0), err
+//     return err
 //   }
 //
-//   var v DomainStatus
-//   if err := v.FromWire(x); err != nil {
-//     return DomainStatus(0), err
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
 //   }
-//   return v, nil
-func (v *DomainStatus) FromWire(w wire.Value) error {
-	*v = (DomainStatus)(w.GetI32())
-	return nil
-}
+func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// String returns a readable string representation of DomainStatus.
-func (v DomainStatus) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "REGISTERED"
-	case 1:
-		return "DEPRECATED"
-	case 2:
-		return "DELETED"
+	if v.Pollers != nil {
+		w, err = wire.NewValueList(_List_PollerInfo_ValueList(v.Pollers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-	return fmt.Sprintf("DomainStatus(%d)", w)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// Equals returns true if this DomainStatus value matches the provided
-// value.
-func (v DomainStatus) Equals(rhs DomainStatus) bool {
-	return v == rhs
+func _PollerInfo_Read(w wire.Value) (*PollerInfo, error) {
+	var v PollerInfo
+	err := v.FromWire(w)
+	return &v, err
 }
 
-// MarshalJSON serializes DomainStatus into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v DomainStatus) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"REGISTERED\""), nil
-	case 1:
-		return ([]byte)("\"DEPRECATED\""), nil
-	case 2:
-		return ([]byte)("\"DELETED\""), nil
+func _List_PollerInfo_Read(l wire.ValueList) ([]*PollerInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
 	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
-}
 
-// UnmarshalJSON attempts to decode DomainStatus from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *DomainStatus) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
+	o := make([]*PollerInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PollerInfo_Read(x)
 		if err != nil {
 			return err
 		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainStatus")
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DescribeTaskListResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeTaskListResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeTaskListResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeTaskListResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Pollers, err = _List_PollerInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
 		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainStatus")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeTaskListResponse
+// struct.
+func (v *DescribeTaskListResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Pollers != nil {
+		fields[i] = fmt.Sprintf("Pollers: %v", v.Pollers)
+		i++
+	}
+
+	return fmt.Sprintf("DescribeTaskListResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_PollerInfo_Equals(lhs, rhs []*PollerInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
 		}
-		*v = (DomainStatus)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainStatus")
 	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DescribeTaskListResponse match the
+// provided DescribeTaskListResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeTaskListResponse) Equals(rhs *DescribeTaskListResponse) bool {
+	if !((v.Pollers == nil && rhs.Pollers == nil) || (v.Pollers != nil && rhs.Pollers != nil && _List_PollerInfo_Equals(v.Pollers, rhs.Pollers))) {
+		return false
+	}
+
+	return true
 }
 
-type EntityNotExistsError struct {
-	Message string `json:"message,required"`
+type DescribeWorkflowExecutionRequest struct {
+	Domain    *string            `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
 }
 
-// ToWire translates a EntityNotExistsError struct into a Thrift-level intermediate
+// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -9265,29 +9832,39 @@ type EntityNotExistsError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *EntityNotExistsError) ToWire() (wire.Value, error) {
+func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a EntityNotExistsError struct from its Thrift-level
+// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a EntityNotExistsError struct
+// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -9295,600 +9872,162 @@ func (v *EntityNotExistsError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v EntityNotExistsError
+//   var v DescribeWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *EntityNotExistsError) FromWire(w wire.Value) error {
+func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
 			}
-		}
-	}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-	if !messageIsSet {
-		return errors.New("field Message of EntityNotExistsError is required")
+			}
+		}
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a EntityNotExistsError
+// String returns a readable string representation of a DescribeWorkflowExecutionRequest
 // struct.
-func (v *EntityNotExistsError) String() string {
+func (v *DescribeWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
 
-	return fmt.Sprintf("EntityNotExistsError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this EntityNotExistsError match the
-// provided EntityNotExistsError.
+// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
+// provided DescribeWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *EntityNotExistsError) Equals(rhs *EntityNotExistsError) bool {
-	if !(v.Message == rhs.Message) {
+func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
 
 	return true
 }
 
-func (v *EntityNotExistsError) Error() string {
-	return v.String()
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
 }
 
-type EventType int32
+type DescribeWorkflowExecutionResponse struct {
+	ExecutionConfiguration *WorkflowExecutionConfiguration `json:"executionConfiguration,omitempty"`
+	WorkflowExecutionInfo  *WorkflowExecutionInfo          `json:"workflowExecutionInfo,omitempty"`
+	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
+}
 
-const (
-	EventTypeWorkflowExecutionStarted                        EventType = 0
-	EventTypeWorkflowExecutionCompleted                      EventType = 1
-	EventTypeWorkflowExecutionFailed                         EventType = 2
-	EventTypeWorkflowExecutionTimedOut                       EventType = 3
-	EventTypeDecisionTaskScheduled                           EventType = 4
-	EventTypeDecisionTaskStarted                             EventType = 5
-	EventTypeDecisionTaskCompleted                           EventType = 6
-	EventTypeDecisionTaskTimedOut                            EventType = 7
-	EventTypeDecisionTaskFailed                              EventType = 8
-	EventTypeActivityTaskScheduled                           EventType = 9
-	EventTypeActivityTaskStarted                             EventType = 10
-	EventTypeActivityTaskCompleted                           EventType = 11
-	EventTypeActivityTaskFailed                              EventType = 12
-	EventTypeActivityTaskTimedOut                            EventType = 13
-	EventTypeActivityTaskCancelRequested                     EventType = 14
-	EventTypeRequestCancelActivityTaskFailed                 EventType = 15
-	EventTypeActivityTaskCanceled                            EventType = 16
-	EventTypeTimerStarted                                    EventType = 17
-	EventTypeTimerFired                                      EventType = 18
-	EventTypeCancelTimerFailed                               EventType = 19
-	EventTypeTimerCanceled                                   EventType = 20
-	EventTypeWorkflowExecutionCancelRequested                EventType = 21
-	EventTypeWorkflowExecutionCanceled                       EventType = 22
-	EventTypeRequestCancelExternalWorkflowExecutionInitiated EventType = 23
-	EventTypeRequestCancelExternalWorkflowExecutionFailed    EventType = 24
-	EventTypeExternalWorkflowExecutionCancelRequested        EventType = 25
-	EventTypeMarkerRecorded                                  EventType = 26
-	EventTypeWorkflowExecutionSignaled                       EventType = 27
-	EventTypeWorkflowExecutionTerminated                     EventType = 28
-	EventTypeWorkflowExecutionContinuedAsNew                 EventType = 29
-	EventTypeStartChildWorkflowExecutionInitiated            EventType = 30
-	EventTypeStartChildWorkflowExecutionFailed               EventType = 31
-	EventTypeChildWorkflowExecutionStarted                   EventType = 32
-	EventTypeChildWorkflowExecutionCompleted                 EventType = 33
-	EventTypeChildWorkflowExecutionFailed                    EventType = 34
-	EventTypeChildWorkflowExecutionCanceled                  EventType = 35
-	EventTypeChildWorkflowExecutionTimedOut                  EventType = 36
-	EventTypeChildWorkflowExecutionTerminated                EventType = 37
-	EventTypeSignalExternalWorkflowExecutionInitiated        EventType = 38
-	EventTypeSignalExternalWorkflowExecutionFailed           EventType = 39
-	EventTypeExternalWorkflowExecutionSignaled               EventType = 40
-)
+type _List_PendingActivityInfo_ValueList []*PendingActivityInfo
 
-// EventType_Values returns all recognized values of EventType.
-func EventType_Values() []EventType {
-	return []EventType{
-		EventTypeWorkflowExecutionStarted,
-		EventTypeWorkflowExecutionCompleted,
-		EventTypeWorkflowExecutionFailed,
-		EventTypeWorkflowExecutionTimedOut,
-		EventTypeDecisionTaskScheduled,
-		EventTypeDecisionTaskStarted,
-		EventTypeDecisionTaskCompleted,
-		EventTypeDecisionTaskTimedOut,
-		EventTypeDecisionTaskFailed,
-		EventTypeActivityTaskScheduled,
-		EventTypeActivityTaskStarted,
-		EventTypeActivityTaskCompleted,
-		EventTypeActivityTaskFailed,
-		EventTypeActivityTaskTimedOut,
-		EventTypeActivityTaskCancelRequested,
-		EventTypeRequestCancelActivityTaskFailed,
-		EventTypeActivityTaskCanceled,
-		EventTypeTimerStarted,
-		EventTypeTimerFired,
-		EventTypeCancelTimerFailed,
-		EventTypeTimerCanceled,
-		EventTypeWorkflowExecutionCancelRequested,
-		EventTypeWorkflowExecutionCanceled,
-		EventTypeRequestCancelExternalWorkflowExecutionInitiated,
-		EventTypeRequestCancelExternalWorkflowExecutionFailed,
-		EventTypeExternalWorkflowExecutionCancelRequested,
-		EventTypeMarkerRecorded,
-		EventTypeWorkflowExecutionSignaled,
-		EventTypeWorkflowExecutionTerminated,
-		EventTypeWorkflowExecutionContinuedAsNew,
-		EventTypeStartChildWorkflowExecutionInitiated,
-		EventTypeStartChildWorkflowExecutionFailed,
-		EventTypeChildWorkflowExecutionStarted,
-		EventTypeChildWorkflowExecutionCompleted,
-		EventTypeChildWorkflowExecutionFailed,
-		EventTypeChildWorkflowExecutionCanceled,
-		EventTypeChildWorkflowExecutionTimedOut,
-		EventTypeChildWorkflowExecutionTerminated,
-		EventTypeSignalExternalWorkflowExecutionInitiated,
-		EventTypeSignalExternalWorkflowExecutionFailed,
-		EventTypeExternalWorkflowExecutionSignaled,
+func (v _List_PendingActivityInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-// UnmarshalText tries to decode EventType from a byte slice
-// containing its name.
-//
-//   var v EventType
-//   err := v.UnmarshalText([]byte("WorkflowExecutionStarted"))
-func (v *EventType) UnmarshalText(value []byte) error {
-	switch string(value) {
-	case "WorkflowExecutionStarted":
-		*v = EventTypeWorkflowExecutionStarted
-		return nil
-	case "WorkflowExecutionCompleted":
-		*v = EventTypeWorkflowExecutionCompleted
-		return nil
-	case "WorkflowExecutionFailed":
-		*v = EventTypeWorkflowExecutionFailed
-		return nil
-	case "WorkflowExecutionTimedOut":
-		*v = EventTypeWorkflowExecutionTimedOut
-		return nil
-	case "DecisionTaskScheduled":
-		*v = EventTypeDecisionTaskScheduled
-		return nil
-	case "DecisionTaskStarted":
-		*v = EventTypeDecisionTaskStarted
-		return nil
-	case "DecisionTaskCompleted":
-		*v = EventTypeDecisionTaskCompleted
-		return nil
-	case "DecisionTaskTimedOut":
-		*v = EventTypeDecisionTaskTimedOut
-		return nil
-	case "DecisionTaskFailed":
-		*v = EventTypeDecisionTaskFailed
-		return nil
-	case "ActivityTaskScheduled":
-		*v = EventTypeActivityTaskScheduled
-		return nil
-	case "ActivityTaskStarted":
-		*v = EventTypeActivityTaskStarted
-		return nil
-	case "ActivityTaskCompleted":
-		*v = EventTypeActivityTaskCompleted
-		return nil
-	case "ActivityTaskFailed":
-		*v = EventTypeActivityTaskFailed
-		return nil
-	case "ActivityTaskTimedOut":
-		*v = EventTypeActivityTaskTimedOut
-		return nil
-	case "ActivityTaskCancelRequested":
-		*v = EventTypeActivityTaskCancelRequested
-		return nil
-	case "RequestCancelActivityTaskFailed":
-		*v = EventTypeRequestCancelActivityTaskFailed
-		return nil
-	case "ActivityTaskCanceled":
-		*v = EventTypeActivityTaskCanceled
-		return nil
-	case "TimerStarted":
-		*v = EventTypeTimerStarted
-		return nil
-	case "TimerFired":
-		*v = EventTypeTimerFired
-		return nil
-	case "CancelTimerFailed":
-		*v = EventTypeCancelTimerFailed
-		return nil
-	case "TimerCanceled":
-		*v = EventTypeTimerCanceled
-		return nil
-	case "WorkflowExecutionCancelRequested":
-		*v = EventTypeWorkflowExecutionCancelRequested
-		return nil
-	case "WorkflowExecutionCanceled":
-		*v = EventTypeWorkflowExecutionCanceled
-		return nil
-	case "RequestCancelExternalWorkflowExecutionInitiated":
-		*v = EventTypeRequestCancelExternalWorkflowExecutionInitiated
-		return nil
-	case "RequestCancelExternalWorkflowExecutionFailed":
-		*v = EventTypeRequestCancelExternalWorkflowExecutionFailed
-		return nil
-	case "ExternalWorkflowExecutionCancelRequested":
-		*v = EventTypeExternalWorkflowExecutionCancelRequested
-		return nil
-	case "MarkerRecorded":
-		*v = EventTypeMarkerRecorded
-		return nil
-	case "WorkflowExecutionSignaled":
-		*v = EventTypeWorkflowExecutionSignaled
-		return nil
-	case "WorkflowExecutionTerminated":
-		*v = EventTypeWorkflowExecutionTerminated
-		return nil
-	case "WorkflowExecutionContinuedAsNew":
-		*v = EventTypeWorkflowExecutionContinuedAsNew
-		return nil
-	case "StartChildWorkflowExecutionInitiated":
-		*v = EventTypeStartChildWorkflowExecutionInitiated
-		return nil
-	case "StartChildWorkflowExecutionFailed":
-		*v = EventTypeStartChildWorkflowExecutionFailed
-		return nil
-	case "ChildWorkflowExecutionStarted":
-		*v = EventTypeChildWorkflowExecutionStarted
-		return nil
-	case "ChildWorkflowExecutionCompleted":
-		*v = EventTypeChildWorkflowExecutionCompleted
-		return nil
-	case "ChildWorkflowExecutionFailed":
-		*v = EventTypeChildWorkflowExecutionFailed
-		return nil
-	case "ChildWorkflowExecutionCanceled":
-		*v = EventTypeChildWorkflowExecutionCanceled
-		return nil
-	case "ChildWorkflowExecutionTimedOut":
-		*v = EventTypeChildWorkflowExecutionTimedOut
-		return nil
-	case "ChildWorkflowExecutionTerminated":
-		*v = EventTypeChildWorkflowExecutionTerminated
-		return nil
-	case "SignalExternalWorkflowExecutionInitiated":
-		*v = EventTypeSignalExternalWorkflowExecutionInitiated
-		return nil
-	case "SignalExternalWorkflowExecutionFailed":
-		*v = EventTypeSignalExternalWorkflowExecutionFailed
-		return nil
-	case "ExternalWorkflowExecutionSignaled":
-		*v = EventTypeExternalWorkflowExecutionSignaled
-		return nil
-	default:
-		return fmt.Errorf("unknown enum value %q for %q", value, "EventType")
-	}
+func (v _List_PendingActivityInfo_ValueList) Size() int {
+	return len(v)
 }
 
-// Ptr returns a pointer to this enum value.
-func (v EventType) Ptr() *EventType {
-	return &v
+func (_List_PendingActivityInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-// ToWire translates EventType into a Thrift-level intermediate
+func (_List_PendingActivityInfo_ValueList) Close() {}
+
+// ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
-// Enums are represented as 32-bit integers over the wire.
-func (v EventType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-// FromWire deserializes EventType from its Thrift-level
-// representation.
+// An error is returned if the struct or any of its fields failed to
+// validate.
 //
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   x, err := v.ToWire()
 //   if err != nil {
-//     return EventType(0), err
+//     return err
 //   }
 //
-//   var v EventType
-//   if err := v.FromWire(x); err != nil {
-//     return EventType(0), err
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
 //   }
-//   return v, nil
-func (v *EventType) FromWire(w wire.Value) error {
-	*v = (EventType)(w.GetI32())
-	return nil
-}
+func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// String returns a readable string representation of EventType.
-func (v EventType) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "WorkflowExecutionStarted"
-	case 1:
-		return "WorkflowExecutionCompleted"
-	case 2:
-		return "WorkflowExecutionFailed"
-	case 3:
-		return "WorkflowExecutionTimedOut"
-	case 4:
-		return "DecisionTaskScheduled"
-	case 5:
-		return "DecisionTaskStarted"
-	case 6:
-		return "DecisionTaskCompleted"
-	case 7:
-		return "DecisionTaskTimedOut"
-	case 8:
-		return "DecisionTaskFailed"
-	case 9:
-		return "ActivityTaskScheduled"
-	case 10:
-		return "ActivityTaskStarted"
-	case 11:
-		return "ActivityTaskCompleted"
-	case 12:
-		return "ActivityTaskFailed"
-	case 13:
-		return "ActivityTaskTimedOut"
-	case 14:
-		return "ActivityTaskCancelRequested"
-	case 15:
-		return "RequestCancelActivityTaskFailed"
-	case 16:
-		return "ActivityTaskCanceled"
-	case 17:
-		return "TimerStarted"
-	case 18:
-		return "TimerFired"
-	case 19:
-		return "CancelTimerFailed"
-	case 20:
-		return "TimerCanceled"
-	case 21:
-		return "WorkflowExecutionCancelRequested"
-	case 22:
-		return "WorkflowExecutionCanceled"
-	case 23:
-		return "RequestCancelExternalWorkflowExecutionInitiated"
-	case 24:
-		return "RequestCancelExternalWorkflowExecutionFailed"
-	case 25:
-		return "ExternalWorkflowExecutionCancelRequested"
-	case 26:
-		return "MarkerRecorded"
-	case 27:
-		return "WorkflowExecutionSignaled"
-	case 28:
-		return "WorkflowExecutionTerminated"
-	case 29:
-		return "WorkflowExecutionContinuedAsNew"
-	case 30:
-		return "StartChildWorkflowExecutionInitiated"
-	case 31:
-		return "StartChildWorkflowExecutionFailed"
-	case 32:
-		return "ChildWorkflowExecutionStarted"
-	case 33:
-		return "ChildWorkflowExecutionCompleted"
-	case 34:
-		return "ChildWorkflowExecutionFailed"
-	case 35:
-		return "ChildWorkflowExecutionCanceled"
-	case 36:
-		return "ChildWorkflowExecutionTimedOut"
-	case 37:
-		return "ChildWorkflowExecutionTerminated"
-	case 38:
-		return "SignalExternalWorkflowExecutionInitiated"
-	case 39:
-		return "SignalExternalWorkflowExecutionFailed"
-	case 40:
-		return "ExternalWorkflowExecutionSignaled"
-	}
-	return fmt.Sprintf("EventType(%d)", w)
-}
-
-// Equals returns true if this EventType value matches the provided
-// value.
-func (v EventType) Equals(rhs EventType) bool {
-	return v == rhs
-}
-
-// MarshalJSON serializes EventType into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v EventType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"WorkflowExecutionStarted\""), nil
-	case 1:
-		return ([]byte)("\"WorkflowExecutionCompleted\""), nil
-	case 2:
-		return ([]byte)("\"WorkflowExecutionFailed\""), nil
-	case 3:
-		return ([]byte)("\"WorkflowExecutionTimedOut\""), nil
-	case 4:
-		return ([]byte)("\"DecisionTaskScheduled\""), nil
-	case 5:
-		return ([]byte)("\"DecisionTaskStarted\""), nil
-	case 6:
-		return ([]byte)("\"DecisionTaskCompleted\""), nil
-	case 7:
-		return ([]byte)("\"DecisionTaskTimedOut\""), nil
-	case 8:
-		return ([]byte)("\"DecisionTaskFailed\""), nil
-	case 9:
-		return ([]byte)("\"ActivityTaskScheduled\""), nil
-	case 10:
-		return ([]byte)("\"ActivityTaskStarted\""), nil
-	case 11:
-		return ([]byte)("\"ActivityTaskCompleted\""), nil
-	case 12:
-		return ([]byte)("\"ActivityTaskFailed\""), nil
-	case 13:
-		return ([]byte)("\"ActivityTaskTimedOut\""), nil
-	case 14:
-		return ([]byte)("\"ActivityTaskCancelRequested\""), nil
-	case 15:
-		return ([]byte)("\"RequestCancelActivityTaskFailed\""), nil
-	case 16:
-		return ([]byte)("\"ActivityTaskCanceled\""), nil
-	case 17:
-		return ([]byte)("\"TimerStarted\""), nil
-	case 18:
-		return ([]byte)("\"TimerFired\""), nil
-	case 19:
-		return ([]byte)("\"CancelTimerFailed\""), nil
-	case 20:
-		return ([]byte)("\"TimerCanceled\""), nil
-	case 21:
-		return ([]byte)("\"WorkflowExecutionCancelRequested\""), nil
-	case 22:
-		return ([]byte)("\"WorkflowExecutionCanceled\""), nil
-	case 23:
-		return ([]byte)("\"RequestCancelExternalWorkflowExecutionInitiated\""), nil
-	case 24:
-		return ([]byte)("\"RequestCancelExternalWorkflowExecutionFailed\""), nil
-	case 25:
-		return ([]byte)("\"ExternalWorkflowExecutionCancelRequested\""), nil
-	case 26:
-		return ([]byte)("\"MarkerRecorded\""), nil
-	case 27:
-		return ([]byte)("\"WorkflowExecutionSignaled\""), nil
-	case 28:
-		return ([]byte)("\"WorkflowExecutionTerminated\""), nil
-	case 29:
-		return ([]byte)("\"WorkflowExecutionContinuedAsNew\""), nil
-	case 30:
-		return ([]byte)("\"StartChildWorkflowExecutionInitiated\""), nil
-	case 31:
-		return ([]byte)("\"StartChildWorkflowExecutionFailed\""), nil
-	case 32:
-		return ([]byte)("\"ChildWorkflowExecutionStarted\""), nil
-	case 33:
-		return ([]byte)("\"ChildWorkflowExecutionCompleted\""), nil
-	case 34:
-		return ([]byte)("\"ChildWorkflowExecutionFailed\""), nil
-	case 35:
-		return ([]byte)("\"ChildWorkflowExecutionCanceled\""), nil
-	case 36:
-		return ([]byte)("\"ChildWorkflowExecutionTimedOut\""), nil
-	case 37:
-		return ([]byte)("\"ChildWorkflowExecutionTerminated\""), nil
-	case 38:
-		return ([]byte)("\"SignalExternalWorkflowExecutionInitiated\""), nil
-	case 39:
-		return ([]byte)("\"SignalExternalWorkflowExecutionFailed\""), nil
-	case 40:
-		return ([]byte)("\"ExternalWorkflowExecutionSignaled\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// UnmarshalJSON attempts to decode EventType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *EventType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "EventType")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "EventType")
-		}
-		*v = (EventType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "EventType")
-	}
-}
-
-type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
-	InitiatedEventId  *int64             `json:"initiatedEventId,omitempty"`
-	Domain            *string            `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-}
-
-// ToWire translates a ExternalWorkflowExecutionCancelRequestedEventAttributes struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) ToWire() (wire.Value, error) {
-	var (
-		fields [3]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.InitiatedEventId != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+	if v.ExecutionConfiguration != nil {
+		w, err = v.ExecutionConfiguration.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.WorkflowExecutionInfo != nil {
+		w, err = v.WorkflowExecutionInfo.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.PendingActivities != nil {
+		w, err = wire.NewValueList(_List_PendingActivityInfo_ValueList(v.PendingActivities)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -9899,51 +10038,83 @@ func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) ToWire() (wire
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ExternalWorkflowExecutionCancelRequestedEventAttributes struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a ExternalWorkflowExecutionCancelRequestedEventAttributes struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
+func _WorkflowExecutionConfiguration_Read(w wire.Value) (*WorkflowExecutionConfiguration, error) {
+	var v WorkflowExecutionConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionInfo_Read(w wire.Value) (*WorkflowExecutionInfo, error) {
+	var v WorkflowExecutionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _PendingActivityInfo_Read(w wire.Value) (*PendingActivityInfo, error) {
+	var v PendingActivityInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_PendingActivityInfo_Read(l wire.ValueList) ([]*PendingActivityInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*PendingActivityInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PendingActivityInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DescribeWorkflowExecutionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeWorkflowExecutionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
 //   }
 //
-//   var v ExternalWorkflowExecutionCancelRequestedEventAttributes
+//   var v DescribeWorkflowExecutionResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) FromWire(w wire.Value) error {
+func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedEventId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.ExecutionConfiguration, err = _WorkflowExecutionConfiguration_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionInfo, err = _WorkflowExecutionInfo_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TList {
+				v.PendingActivities, err = _List_PendingActivityInfo_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -9955,77 +10126,182 @@ func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) FromWire(w wir
 	return nil
 }
 
-// String returns a readable string representation of a ExternalWorkflowExecutionCancelRequestedEventAttributes
+// String returns a readable string representation of a DescribeWorkflowExecutionResponse
 // struct.
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) String() string {
+func (v *DescribeWorkflowExecutionResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [3]string
 	i := 0
-	if v.InitiatedEventId != nil {
-		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+	if v.ExecutionConfiguration != nil {
+		fields[i] = fmt.Sprintf("ExecutionConfiguration: %v", v.ExecutionConfiguration)
 		i++
 	}
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.WorkflowExecutionInfo != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionInfo: %v", v.WorkflowExecutionInfo)
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.PendingActivities != nil {
+		fields[i] = fmt.Sprintf("PendingActivities: %v", v.PendingActivities)
 		i++
 	}
 
-	return fmt.Sprintf("ExternalWorkflowExecutionCancelRequestedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ExternalWorkflowExecutionCancelRequestedEventAttributes match the
-// provided ExternalWorkflowExecutionCancelRequestedEventAttributes.
+func _List_PendingActivityInfo_Equals(lhs, rhs []*PendingActivityInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DescribeWorkflowExecutionResponse match the
+// provided DescribeWorkflowExecutionResponse.
 //
 // This function performs a deep comparison.
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) Equals(rhs *ExternalWorkflowExecutionCancelRequestedEventAttributes) bool {
-	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutionResponse) bool {
+	if !((v.ExecutionConfiguration == nil && rhs.ExecutionConfiguration == nil) || (v.ExecutionConfiguration != nil && rhs.ExecutionConfiguration != nil && v.ExecutionConfiguration.Equals(rhs.ExecutionConfiguration))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !((v.WorkflowExecutionInfo == nil && rhs.WorkflowExecutionInfo == nil) || (v.WorkflowExecutionInfo != nil && rhs.WorkflowExecutionInfo != nil && v.WorkflowExecutionInfo.Equals(rhs.WorkflowExecutionInfo))) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !((v.PendingActivities == nil && rhs.PendingActivities == nil) || (v.PendingActivities != nil && rhs.PendingActivities != nil && _List_PendingActivityInfo_Equals(v.PendingActivities, rhs.PendingActivities))) {
 		return false
 	}
 
 	return true
 }
 
-// GetInitiatedEventId returns the value of InitiatedEventId if it is set or its
-// zero value if it is unset.
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetInitiatedEventId() (o int64) {
-	if v.InitiatedEventId != nil {
-		return *v.InitiatedEventId
+type DomainAlreadyExistsError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a DomainAlreadyExistsError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DomainAlreadyExistsError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
 
-	return
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// GetDomain returns the value of Domain if it is set or its
-// zero value if it is unset.
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetDomain() (o string) {
-	if v.Domain != nil {
-		return *v.Domain
+// FromWire deserializes a DomainAlreadyExistsError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainAlreadyExistsError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DomainAlreadyExistsError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DomainAlreadyExistsError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
 	}
 
-	return
+	if !messageIsSet {
+		return errors.New("field Message of DomainAlreadyExistsError is required")
+	}
+
+	return nil
 }
 
-type ExternalWorkflowExecutionSignaledEventAttributes struct {
-	InitiatedEventId  *int64             `json:"initiatedEventId,omitempty"`
-	Domain            *string            `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Control           []byte             `json:"control,omitempty"`
+// String returns a readable string representation of a DomainAlreadyExistsError
+// struct.
+func (v *DomainAlreadyExistsError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("DomainAlreadyExistsError{%v}", strings.Join(fields[:i], ", "))
 }
 
-// ToWire translates a ExternalWorkflowExecutionSignaledEventAttributes struct into a Thrift-level intermediate
+// Equals returns true if all the fields of this DomainAlreadyExistsError match the
+// provided DomainAlreadyExistsError.
+//
+// This function performs a deep comparison.
+func (v *DomainAlreadyExistsError) Equals(rhs *DomainAlreadyExistsError) bool {
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+func (v *DomainAlreadyExistsError) Error() string {
+	return v.String()
+}
+
+type DomainConfiguration struct {
+	WorkflowExecutionRetentionPeriodInDays *int32          `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             *bool           `json:"emitMetric,omitempty"`
+	ArchivalBucketName                     *string         `json:"archivalBucketName,omitempty"`
+	ArchivalStatus                         *ArchivalStatus `json:"archivalStatus,omitempty"`
+}
+
+// ToWire translates a DomainConfiguration struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10040,7 +10316,7 @@ type ExternalWorkflowExecutionSignaledEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) ToWire() (wire.Value, error) {
+func (v *DomainConfiguration) ToWire() (wire.Value, error) {
 	var (
 		fields [4]wire.Field
 		i      int = 0
@@ -10048,32 +10324,32 @@ func (v *ExternalWorkflowExecutionSignaledEventAttributes) ToWire() (wire.Value,
 		err    error
 	)
 
-	if v.InitiatedEventId != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.EmitMetric != nil {
+		w, err = wire.NewValueBool(*(v.EmitMetric)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.ArchivalBucketName != nil {
+		w, err = wire.NewValueString(*(v.ArchivalBucketName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Control != nil {
-		w, err = wire.NewValueBinary(v.Control), error(nil)
+	if v.ArchivalStatus != nil {
+		w, err = v.ArchivalStatus.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -10084,11 +10360,17 @@ func (v *ExternalWorkflowExecutionSignaledEventAttributes) ToWire() (wire.Value,
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ExternalWorkflowExecutionSignaledEventAttributes struct from its Thrift-level
+func _ArchivalStatus_Read(w wire.Value) (ArchivalStatus, error) {
+	var v ArchivalStatus
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a DomainConfiguration struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ExternalWorkflowExecutionSignaledEventAttributes struct
+// An error is returned if we were unable to build a DomainConfiguration struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10096,47 +10378,51 @@ func (v *ExternalWorkflowExecutionSignaledEventAttributes) ToWire() (wire.Value,
 //     return nil, err
 //   }
 //
-//   var v ExternalWorkflowExecutionSignaledEventAttributes
+//   var v DomainConfiguration
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) FromWire(w wire.Value) error {
+func (v *DomainConfiguration) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedEventId = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionRetentionPeriodInDays = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.EmitMetric = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ArchivalBucketName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TBinary {
-				v.Control, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				var x ArchivalStatus
+				x, err = _ArchivalStatus_Read(field.Value)
+				v.ArchivalStatus = &x
 				if err != nil {
 					return err
 				}
@@ -10148,82 +10434,114 @@ func (v *ExternalWorkflowExecutionSignaledEventAttributes) FromWire(w wire.Value
 	return nil
 }
 
-// String returns a readable string representation of a ExternalWorkflowExecutionSignaledEventAttributes
+// String returns a readable string representation of a DomainConfiguration
 // struct.
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) String() string {
+func (v *DomainConfiguration) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [4]string
 	i := 0
-	if v.InitiatedEventId != nil {
-		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
 		i++
 	}
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.EmitMetric != nil {
+		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.ArchivalBucketName != nil {
+		fields[i] = fmt.Sprintf("ArchivalBucketName: %v", *(v.ArchivalBucketName))
 		i++
 	}
-	if v.Control != nil {
-		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+	if v.ArchivalStatus != nil {
+		fields[i] = fmt.Sprintf("ArchivalStatus: %v", *(v.ArchivalStatus))
 		i++
 	}
 
-	return fmt.Sprintf("ExternalWorkflowExecutionSignaledEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DomainConfiguration{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ExternalWorkflowExecutionSignaledEventAttributes match the
-// provided ExternalWorkflowExecutionSignaledEventAttributes.
+func _ArchivalStatus_EqualsPtr(lhs, rhs *ArchivalStatus) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DomainConfiguration match the
+// provided DomainConfiguration.
 //
 // This function performs a deep comparison.
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) Equals(rhs *ExternalWorkflowExecutionSignaledEventAttributes) bool {
-	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+func (v *DomainConfiguration) Equals(rhs *DomainConfiguration) bool {
+	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !_String_EqualsPtr(v.ArchivalBucketName, rhs.ArchivalBucketName) {
 		return false
 	}
-	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+	if !_ArchivalStatus_EqualsPtr(v.ArchivalStatus, rhs.ArchivalStatus) {
 		return false
 	}
 
 	return true
 }
 
-// GetInitiatedEventId returns the value of InitiatedEventId if it is set or its
+// GetWorkflowExecutionRetentionPeriodInDays returns the value of WorkflowExecutionRetentionPeriodInDays if it is set or its
 // zero value if it is unset.
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetInitiatedEventId() (o int64) {
-	if v.InitiatedEventId != nil {
-		return *v.InitiatedEventId
+func (v *DomainConfiguration) GetWorkflowExecutionRetentionPeriodInDays() (o int32) {
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		return *v.WorkflowExecutionRetentionPeriodInDays
 	}
 
 	return
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetEmitMetric returns the value of EmitMetric if it is set or its
 // zero value if it is unset.
-func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetDomain() (o string) {
-	if v.Domain != nil {
-		return *v.Domain
+func (v *DomainConfiguration) GetEmitMetric() (o bool) {
+	if v.EmitMetric != nil {
+		return *v.EmitMetric
 	}
 
 	return
 }
 
-type FailWorkflowExecutionDecisionAttributes struct {
-	Reason  *string `json:"reason,omitempty"`
-	Details []byte  `json:"details,omitempty"`
+// GetArchivalBucketName returns the value of ArchivalBucketName if it is set or its
+// zero value if it is unset.
+func (v *DomainConfiguration) GetArchivalBucketName() (o string) {
+	if v.ArchivalBucketName != nil {
+		return *v.ArchivalBucketName
+	}
+
+	return
 }
 
-// ToWire translates a FailWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
+// GetArchivalStatus returns the value of ArchivalStatus if it is set or its
+// zero value if it is unset.
+func (v *DomainConfiguration) GetArchivalStatus() (o ArchivalStatus) {
+	if v.ArchivalStatus != nil {
+		return *v.ArchivalStatus
+	}
+
+	return
+}
+
+type DomainHandoverInfo struct {
+	TargetClusterName       *string `json:"targetClusterName,omitempty"`
+	StartTimestamp          *int64  `json:"startTimestamp,omitempty"`
+	ExpiryTimestamp         *int64  `json:"expiryTimestamp,omitempty"`
+	PendingReplicationTasks *int64  `json:"pendingReplicationTasks,omitempty"`
+}
+
+// ToWire translates a DomainHandoverInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10238,39 +10556,55 @@ type FailWorkflowExecutionDecisionAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *FailWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
+func (v *DomainHandoverInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Reason != nil {
-		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+	if v.TargetClusterName != nil {
+		w, err = wire.NewValueString(*(v.TargetClusterName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.StartTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ExpiryTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpiryTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PendingReplicationTasks != nil {
+		w, err = wire.NewValueI64(*(v.PendingReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a FailWorkflowExecutionDecisionAttributes struct from its Thrift-level
+// FromWire deserializes a DomainHandoverInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a FailWorkflowExecutionDecisionAttributes struct
+// An error is returned if we were unable to build a DomainHandoverInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10278,12 +10612,12 @@ func (v *FailWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v FailWorkflowExecutionDecisionAttributes
+//   var v DomainHandoverInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *FailWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
+func (v *DomainHandoverInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -10292,15 +10626,37 @@ func (v *FailWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Reason = &x
+				v.TargetClusterName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpiryTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PendingReplicationTasks = &x
 				if err != nil {
 					return err
 				}
@@ -10312,62 +10668,104 @@ func (v *FailWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a FailWorkflowExecutionDecisionAttributes
+// String returns a readable string representation of a DomainHandoverInfo
 // struct.
-func (v *FailWorkflowExecutionDecisionAttributes) String() string {
+func (v *DomainHandoverInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.Reason != nil {
-		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+	if v.TargetClusterName != nil {
+		fields[i] = fmt.Sprintf("TargetClusterName: %v", *(v.TargetClusterName))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.StartTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartTimestamp: %v", *(v.StartTimestamp))
+		i++
+	}
+	if v.ExpiryTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpiryTimestamp: %v", *(v.ExpiryTimestamp))
+		i++
+	}
+	if v.PendingReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("PendingReplicationTasks: %v", *(v.PendingReplicationTasks))
 		i++
 	}
 
-	return fmt.Sprintf("FailWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DomainHandoverInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this FailWorkflowExecutionDecisionAttributes match the
-// provided FailWorkflowExecutionDecisionAttributes.
+// Equals returns true if all the fields of this DomainHandoverInfo match the
+// provided DomainHandoverInfo.
 //
 // This function performs a deep comparison.
-func (v *FailWorkflowExecutionDecisionAttributes) Equals(rhs *FailWorkflowExecutionDecisionAttributes) bool {
-	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+func (v *DomainHandoverInfo) Equals(rhs *DomainHandoverInfo) bool {
+	if !_String_EqualsPtr(v.TargetClusterName, rhs.TargetClusterName) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_I64_EqualsPtr(v.StartTimestamp, rhs.StartTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpiryTimestamp, rhs.ExpiryTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PendingReplicationTasks, rhs.PendingReplicationTasks) {
 		return false
 	}
 
 	return true
 }
 
-// GetReason returns the value of Reason if it is set or its
+// GetTargetClusterName returns the value of TargetClusterName if it is set or its
 // zero value if it is unset.
-func (v *FailWorkflowExecutionDecisionAttributes) GetReason() (o string) {
-	if v.Reason != nil {
-		return *v.Reason
+func (v *DomainHandoverInfo) GetTargetClusterName() (o string) {
+	if v.TargetClusterName != nil {
+		return *v.TargetClusterName
 	}
 
 	return
 }
 
-type GetWorkflowExecutionHistoryRequest struct {
-	Domain                 *string                 `json:"domain,omitempty"`
-	Execution              *WorkflowExecution      `json:"execution,omitempty"`
-	MaximumPageSize        *int32                  `json:"maximumPageSize,omitempty"`
-	NextPageToken          []byte                  `json:"nextPageToken,omitempty"`
-	WaitForNewEvent        *bool                   `json:"waitForNewEvent,omitempty"`
-	HistoryEventFilterType *HistoryEventFilterType `json:"HistoryEventFilterType,omitempty"`
+// GetStartTimestamp returns the value of StartTimestamp if it is set or its
+// zero value if it is unset.
+func (v *DomainHandoverInfo) GetStartTimestamp() (o int64) {
+	if v.StartTimestamp != nil {
+		return *v.StartTimestamp
+	}
+
+	return
 }
 
-// ToWire translates a GetWorkflowExecutionHistoryRequest struct into a Thrift-level intermediate
+// GetExpiryTimestamp returns the value of ExpiryTimestamp if it is set or its
+// zero value if it is unset.
+func (v *DomainHandoverInfo) GetExpiryTimestamp() (o int64) {
+	if v.ExpiryTimestamp != nil {
+		return *v.ExpiryTimestamp
+	}
+
+	return
+}
+
+// GetPendingReplicationTasks returns the value of PendingReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *DomainHandoverInfo) GetPendingReplicationTasks() (o int64) {
+	if v.PendingReplicationTasks != nil {
+		return *v.PendingReplicationTasks
+	}
+
+	return
+}
+
+type DomainInfo struct {
+	Name        *string       `json:"name,omitempty"`
+	Status      *DomainStatus `json:"status,omitempty"`
+	Description *string       `json:"description,omitempty"`
+	OwnerEmail  *string       `json:"ownerEmail,omitempty"`
+}
+
+// ToWire translates a DomainInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10382,77 +10780,61 @@ type GetWorkflowExecutionHistoryRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
+func (v *DomainInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.Status != nil {
+		w, err = v.Status.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+	if v.Description != nil {
+		w, err = wire.NewValueString(*(v.Description)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.OwnerEmail != nil {
+		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.WaitForNewEvent != nil {
-		w, err = wire.NewValueBool(*(v.WaitForNewEvent)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.HistoryEventFilterType != nil {
-		w, err = v.HistoryEventFilterType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _HistoryEventFilterType_Read(w wire.Value) (HistoryEventFilterType, error) {
-	var v HistoryEventFilterType
+func _DomainStatus_Read(w wire.Value) (DomainStatus, error) {
+	var v DomainStatus
 	err := v.FromWire(w)
 	return v, err
 }
 
-// FromWire deserializes a GetWorkflowExecutionHistoryRequest struct from its Thrift-level
+// FromWire deserializes a DomainInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetWorkflowExecutionHistoryRequest struct
+// An error is returned if we were unable to build a DomainInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10460,12 +10842,12 @@ func _HistoryEventFilterType_Read(w wire.Value) (HistoryEventFilterType, error)
 //     return nil, err
 //   }
 //
-//   var v GetWorkflowExecutionHistoryRequest
+//   var v DomainInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
+func (v *DomainInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -10474,53 +10856,37 @@ func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.Name = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
 			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumPageSize = &x
+				var x DomainStatus
+				x, err = _DomainStatus_Read(field.Value)
+				v.Status = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
+		case 30:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.WaitForNewEvent = &x
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Description = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 60:
-			if field.Value.Type() == wire.TI32 {
-				var x HistoryEventFilterType
-				x, err = _HistoryEventFilterType_Read(field.Value)
-				v.HistoryEventFilterType = &x
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.OwnerEmail = &x
 				if err != nil {
 					return err
 				}
@@ -10532,44 +10898,36 @@ func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetWorkflowExecutionHistoryRequest
+// String returns a readable string representation of a DomainInfo
 // struct.
-func (v *GetWorkflowExecutionHistoryRequest) String() string {
+func (v *DomainInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [4]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
 		i++
 	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.Status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
 		i++
 	}
-	if v.WaitForNewEvent != nil {
-		fields[i] = fmt.Sprintf("WaitForNewEvent: %v", *(v.WaitForNewEvent))
+	if v.Description != nil {
+		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
 		i++
 	}
-	if v.HistoryEventFilterType != nil {
-		fields[i] = fmt.Sprintf("HistoryEventFilterType: %v", *(v.HistoryEventFilterType))
+	if v.OwnerEmail != nil {
+		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
 		i++
 	}
 
-	return fmt.Sprintf("GetWorkflowExecutionHistoryRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _HistoryEventFilterType_EqualsPtr(lhs, rhs *HistoryEventFilterType) bool {
+func _DomainStatus_EqualsPtr(lhs, rhs *DomainStatus) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
@@ -10579,79 +10937,75 @@ func _HistoryEventFilterType_EqualsPtr(lhs, rhs *HistoryEventFilterType) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this GetWorkflowExecutionHistoryRequest match the
-// provided GetWorkflowExecutionHistoryRequest.
+// Equals returns true if all the fields of this DomainInfo match the
+// provided DomainInfo.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionHistoryRequest) Equals(rhs *GetWorkflowExecutionHistoryRequest) bool {
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !_DomainStatus_EqualsPtr(v.Status, rhs.Status) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.WaitForNewEvent, rhs.WaitForNewEvent) {
+	if !_String_EqualsPtr(v.Description, rhs.Description) {
 		return false
 	}
-	if !_HistoryEventFilterType_EqualsPtr(v.HistoryEventFilterType, rhs.HistoryEventFilterType) {
+	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
 		return false
 	}
 
 	return true
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionHistoryRequest) GetDomain() (o string) {
-	if v.Domain != nil {
-		return *v.Domain
+func (v *DomainInfo) GetName() (o string) {
+	if v.Name != nil {
+		return *v.Name
 	}
 
 	return
 }
 
-// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// GetStatus returns the value of Status if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionHistoryRequest) GetMaximumPageSize() (o int32) {
-	if v.MaximumPageSize != nil {
-		return *v.MaximumPageSize
+func (v *DomainInfo) GetStatus() (o DomainStatus) {
+	if v.Status != nil {
+		return *v.Status
 	}
 
 	return
 }
 
-// GetWaitForNewEvent returns the value of WaitForNewEvent if it is set or its
+// GetDescription returns the value of Description if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionHistoryRequest) GetWaitForNewEvent() (o bool) {
-	if v.WaitForNewEvent != nil {
-		return *v.WaitForNewEvent
+func (v *DomainInfo) GetDescription() (o string) {
+	if v.Description != nil {
+		return *v.Description
 	}
 
 	return
 }
 
-// GetHistoryEventFilterType returns the value of HistoryEventFilterType if it is set or its
+// GetOwnerEmail returns the value of OwnerEmail if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionHistoryRequest) GetHistoryEventFilterType() (o HistoryEventFilterType) {
-	if v.HistoryEventFilterType != nil {
-		return *v.HistoryEventFilterType
+func (v *DomainInfo) GetOwnerEmail() (o string) {
+	if v.OwnerEmail != nil {
+		return *v.OwnerEmail
 	}
 
 	return
 }
 
-type GetWorkflowExecutionHistoryResponse struct {
-	History       *History `json:"history,omitempty"`
-	NextPageToken []byte   `json:"nextPageToken,omitempty"`
+type DomainNotActiveError struct {
+	Message        string `json:"message,required"`
+	DomainName     string `json:"domainName,required"`
+	CurrentCluster string `json:"currentCluster,required"`
+	ActiveCluster  string `json:"activeCluster,required"`
 }
 
-// ToWire translates a GetWorkflowExecutionHistoryResponse struct into a Thrift-level intermediate
+// ToWire translates a DomainNotActiveError struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10666,45 +11020,50 @@ type GetWorkflowExecutionHistoryResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetWorkflowExecutionHistoryResponse) ToWire() (wire.Value, error) {
+func (v *DomainNotActiveError) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.History != nil {
-		w, err = v.History.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.DomainName), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
+	w, err = wire.NewValueString(v.CurrentCluster), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
 
-func _History_Read(w wire.Value) (*History, error) {
-	var v History
-	err := v.FromWire(w)
-	return &v, err
+	w, err = wire.NewValueString(v.ActiveCluster), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a GetWorkflowExecutionHistoryResponse struct from its Thrift-level
+// FromWire deserializes a DomainNotActiveError struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetWorkflowExecutionHistoryResponse struct
+// An error is returned if we were unable to build a DomainNotActiveError struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10712,81 +11071,129 @@ func _History_Read(w wire.Value) (*History, error) {
 //     return nil, err
 //   }
 //
-//   var v GetWorkflowExecutionHistoryResponse
+//   var v DomainNotActiveError
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetWorkflowExecutionHistoryResponse) FromWire(w wire.Value) error {
+func (v *DomainNotActiveError) FromWire(w wire.Value) error {
 	var err error
 
+	messageIsSet := false
+	domainNameIsSet := false
+	currentClusterIsSet := false
+	activeClusterIsSet := false
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.History, err = _History_Read(field.Value)
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				messageIsSet = true
 			}
-		case 20:
+		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				v.DomainName, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
-
+				domainNameIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				v.CurrentCluster, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				currentClusterIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				v.ActiveCluster, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				activeClusterIsSet = true
 			}
 		}
 	}
 
+	if !messageIsSet {
+		return errors.New("field Message of DomainNotActiveError is required")
+	}
+
+	if !domainNameIsSet {
+		return errors.New("field DomainName of DomainNotActiveError is required")
+	}
+
+	if !currentClusterIsSet {
+		return errors.New("field CurrentCluster of DomainNotActiveError is required")
+	}
+
+	if !activeClusterIsSet {
+		return errors.New("field ActiveCluster of DomainNotActiveError is required")
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a GetWorkflowExecutionHistoryResponse
+// String returns a readable string representation of a DomainNotActiveError
 // struct.
-func (v *GetWorkflowExecutionHistoryResponse) String() string {
+func (v *DomainNotActiveError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.History != nil {
-		fields[i] = fmt.Sprintf("History: %v", v.History)
-		i++
-	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
-		i++
-	}
-
-	return fmt.Sprintf("GetWorkflowExecutionHistoryResponse{%v}", strings.Join(fields[:i], ", "))
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	fields[i] = fmt.Sprintf("DomainName: %v", v.DomainName)
+	i++
+	fields[i] = fmt.Sprintf("CurrentCluster: %v", v.CurrentCluster)
+	i++
+	fields[i] = fmt.Sprintf("ActiveCluster: %v", v.ActiveCluster)
+	i++
+
+	return fmt.Sprintf("DomainNotActiveError{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetWorkflowExecutionHistoryResponse match the
-// provided GetWorkflowExecutionHistoryResponse.
+// Equals returns true if all the fields of this DomainNotActiveError match the
+// provided DomainNotActiveError.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionHistoryResponse) Equals(rhs *GetWorkflowExecutionHistoryResponse) bool {
-	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+func (v *DomainNotActiveError) Equals(rhs *DomainNotActiveError) bool {
+	if !(v.Message == rhs.Message) {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !(v.DomainName == rhs.DomainName) {
+		return false
+	}
+	if !(v.CurrentCluster == rhs.CurrentCluster) {
+		return false
+	}
+	if !(v.ActiveCluster == rhs.ActiveCluster) {
 		return false
 	}
 
 	return true
 }
 
-type History struct {
-	Events []*HistoryEvent `json:"events,omitempty"`
+func (v *DomainNotActiveError) Error() string {
+	return v.String()
 }
 
-type _List_HistoryEvent_ValueList []*HistoryEvent
+type DomainReplicationConfiguration struct {
+	ActiveClusterName *string                            `json:"activeClusterName,omitempty"`
+	Clusters          []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+}
 
-func (v _List_HistoryEvent_ValueList) ForEach(f func(wire.Value) error) error {
+type _List_ClusterReplicationConfiguration_ValueList []*ClusterReplicationConfiguration
+
+func (v _List_ClusterReplicationConfiguration_ValueList) ForEach(f func(wire.Value) error) error {
 	for i, x := range v {
 		if x == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", i)
@@ -10803,17 +11210,17 @@ func (v _List_HistoryEvent_ValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
-func (v _List_HistoryEvent_ValueList) Size() int {
+func (v _List_ClusterReplicationConfiguration_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_HistoryEvent_ValueList) ValueType() wire.Type {
+func (_List_ClusterReplicationConfiguration_ValueList) ValueType() wire.Type {
 	return wire.TStruct
 }
 
-func (_List_HistoryEvent_ValueList) Close() {}
+func (_List_ClusterReplicationConfiguration_ValueList) Close() {}
 
-// ToWire translates a History struct into a Thrift-level intermediate
+// ToWire translates a DomainReplicationConfiguration struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -10828,40 +11235,48 @@ func (_List_HistoryEvent_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *History) ToWire() (wire.Value, error) {
+func (v *DomainReplicationConfiguration) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Events != nil {
-		w, err = wire.NewValueList(_List_HistoryEvent_ValueList(v.Events)), error(nil)
+	if v.ActiveClusterName != nil {
+		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Clusters != nil {
+		w, err = wire.NewValueList(_List_ClusterReplicationConfiguration_ValueList(v.Clusters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _HistoryEvent_Read(w wire.Value) (*HistoryEvent, error) {
-	var v HistoryEvent
+func _ClusterReplicationConfiguration_Read(w wire.Value) (*ClusterReplicationConfiguration, error) {
+	var v ClusterReplicationConfiguration
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _List_HistoryEvent_Read(l wire.ValueList) ([]*HistoryEvent, error) {
+func _List_ClusterReplicationConfiguration_Read(l wire.ValueList) ([]*ClusterReplicationConfiguration, error) {
 	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make([]*HistoryEvent, 0, l.Size())
+	o := make([]*ClusterReplicationConfiguration, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := _HistoryEvent_Read(x)
+		i, err := _ClusterReplicationConfiguration_Read(x)
 		if err != nil {
 			return err
 		}
@@ -10872,11 +11287,11 @@ func _List_HistoryEvent_Read(l wire.ValueList) ([]*HistoryEvent, error) {
 	return o, err
 }
 
-// FromWire deserializes a History struct from its Thrift-level
+// FromWire deserializes a DomainReplicationConfiguration struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a History struct
+// An error is returned if we were unable to build a DomainReplicationConfiguration struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -10884,19 +11299,29 @@ func _List_HistoryEvent_Read(l wire.ValueList) ([]*HistoryEvent, error) {
 //     return nil, err
 //   }
 //
-//   var v History
+//   var v DomainReplicationConfiguration
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *History) FromWire(w wire.Value) error {
+func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActiveClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
 			if field.Value.Type() == wire.TList {
-				v.Events, err = _List_HistoryEvent_Read(field.Value.GetList())
+				v.Clusters, err = _List_ClusterReplicationConfiguration_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -10908,24 +11333,28 @@ func (v *History) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a History
+// String returns a readable string representation of a DomainReplicationConfiguration
 // struct.
-func (v *History) String() string {
+func (v *DomainReplicationConfiguration) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	if v.Events != nil {
-		fields[i] = fmt.Sprintf("Events: %v", v.Events)
+	if v.ActiveClusterName != nil {
+		fields[i] = fmt.Sprintf("ActiveClusterName: %v", *(v.ActiveClusterName))
+		i++
+	}
+	if v.Clusters != nil {
+		fields[i] = fmt.Sprintf("Clusters: %v", v.Clusters)
 		i++
 	}
 
-	return fmt.Sprintf("History{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DomainReplicationConfiguration{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_HistoryEvent_Equals(lhs, rhs []*HistoryEvent) bool {
+func _List_ClusterReplicationConfiguration_Equals(lhs, rhs []*ClusterReplicationConfiguration) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
@@ -10940,202 +11369,2075 @@ func _List_HistoryEvent_Equals(lhs, rhs []*HistoryEvent) bool {
 	return true
 }
 
-// Equals returns true if all the fields of this History match the
-// provided History.
+// Equals returns true if all the fields of this DomainReplicationConfiguration match the
+// provided DomainReplicationConfiguration.
 //
 // This function performs a deep comparison.
-func (v *History) Equals(rhs *History) bool {
-	if !((v.Events == nil && rhs.Events == nil) || (v.Events != nil && rhs.Events != nil && _List_HistoryEvent_Equals(v.Events, rhs.Events))) {
+func (v *DomainReplicationConfiguration) Equals(rhs *DomainReplicationConfiguration) bool {
+	if !_String_EqualsPtr(v.ActiveClusterName, rhs.ActiveClusterName) {
+		return false
+	}
+	if !((v.Clusters == nil && rhs.Clusters == nil) || (v.Clusters != nil && rhs.Clusters != nil && _List_ClusterReplicationConfiguration_Equals(v.Clusters, rhs.Clusters))) {
 		return false
 	}
 
 	return true
 }
 
-type HistoryEvent struct {
-	EventId                                                        *int64                                                          `json:"eventId,omitempty"`
-	Timestamp                                                      *int64                                                          `json:"timestamp,omitempty"`
-	EventType                                                      *EventType                                                      `json:"eventType,omitempty"`
-	Version                                                        *int64                                                          `json:"version,omitempty"`
-	WorkflowExecutionStartedEventAttributes                        *WorkflowExecutionStartedEventAttributes                        `json:"workflowExecutionStartedEventAttributes,omitempty"`
-	WorkflowExecutionCompletedEventAttributes                      *WorkflowExecutionCompletedEventAttributes                      `json:"workflowExecutionCompletedEventAttributes,omitempty"`
-	WorkflowExecutionFailedEventAttributes                         *WorkflowExecutionFailedEventAttributes                         `json:"workflowExecutionFailedEventAttributes,omitempty"`
-	WorkflowExecutionTimedOutEventAttributes                       *WorkflowExecutionTimedOutEventAttributes                       `json:"workflowExecutionTimedOutEventAttributes,omitempty"`
-	DecisionTaskScheduledEventAttributes                           *DecisionTaskScheduledEventAttributes                           `json:"decisionTaskScheduledEventAttributes,omitempty"`
-	DecisionTaskStartedEventAttributes                             *DecisionTaskStartedEventAttributes                             `json:"decisionTaskStartedEventAttributes,omitempty"`
-	DecisionTaskCompletedEventAttributes                           *DecisionTaskCompletedEventAttributes                           `json:"decisionTaskCompletedEventAttributes,omitempty"`
-	DecisionTaskTimedOutEventAttributes                            *DecisionTaskTimedOutEventAttributes                            `json:"decisionTaskTimedOutEventAttributes,omitempty"`
-	DecisionTaskFailedEventAttributes                              *DecisionTaskFailedEventAttributes                              `json:"decisionTaskFailedEventAttributes,omitempty"`
-	ActivityTaskScheduledEventAttributes                           *ActivityTaskScheduledEventAttributes                           `json:"activityTaskScheduledEventAttributes,omitempty"`
-	ActivityTaskStartedEventAttributes                             *ActivityTaskStartedEventAttributes                             `json:"activityTaskStartedEventAttributes,omitempty"`
-	ActivityTaskCompletedEventAttributes                           *ActivityTaskCompletedEventAttributes                           `json:"activityTaskCompletedEventAttributes,omitempty"`
-	ActivityTaskFailedEventAttributes                              *ActivityTaskFailedEventAttributes                              `json:"activityTaskFailedEventAttributes,omitempty"`
-	ActivityTaskTimedOutEventAttributes                            *ActivityTaskTimedOutEventAttributes                            `json:"activityTaskTimedOutEventAttributes,omitempty"`
-	TimerStartedEventAttributes                                    *TimerStartedEventAttributes                                    `json:"timerStartedEventAttributes,omitempty"`
-	TimerFiredEventAttributes                                      *TimerFiredEventAttributes                                      `json:"timerFiredEventAttributes,omitempty"`
-	ActivityTaskCancelRequestedEventAttributes                     *ActivityTaskCancelRequestedEventAttributes                     `json:"activityTaskCancelRequestedEventAttributes,omitempty"`
-	RequestCancelActivityTaskFailedEventAttributes                 *RequestCancelActivityTaskFailedEventAttributes                 `json:"requestCancelActivityTaskFailedEventAttributes,omitempty"`
-	ActivityTaskCanceledEventAttributes                            *ActivityTaskCanceledEventAttributes                            `json:"activityTaskCanceledEventAttributes,omitempty"`
-	TimerCanceledEventAttributes                                   *TimerCanceledEventAttributes                                   `json:"timerCanceledEventAttributes,omitempty"`
-	CancelTimerFailedEventAttributes                               *CancelTimerFailedEventAttributes                               `json:"cancelTimerFailedEventAttributes,omitempty"`
-	MarkerRecordedEventAttributes                                  *MarkerRecordedEventAttributes                                  `json:"markerRecordedEventAttributes,omitempty"`
-	WorkflowExecutionSignaledEventAttributes                       *WorkflowExecutionSignaledEventAttributes                       `json:"workflowExecutionSignaledEventAttributes,omitempty"`
-	WorkflowExecutionTerminatedEventAttributes                     *WorkflowExecutionTerminatedEventAttributes                     `json:"workflowExecutionTerminatedEventAttributes,omitempty"`
-	WorkflowExecutionCancelRequestedEventAttributes                *WorkflowExecutionCancelRequestedEventAttributes                `json:"workflowExecutionCancelRequestedEventAttributes,omitempty"`
-	WorkflowExecutionCanceledEventAttributes                       *WorkflowExecutionCanceledEventAttributes                       `json:"workflowExecutionCanceledEventAttributes,omitempty"`
-	RequestCancelExternalWorkflowExecutionInitiatedEventAttributes *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes `json:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
-	RequestCancelExternalWorkflowExecutionFailedEventAttributes    *RequestCancelExternalWorkflowExecutionFailedEventAttributes    `json:"requestCancelExternalWorkflowExecutionFailedEventAttributes,omitempty"`
-	ExternalWorkflowExecutionCancelRequestedEventAttributes        *ExternalWorkflowExecutionCancelRequestedEventAttributes        `json:"externalWorkflowExecutionCancelRequestedEventAttributes,omitempty"`
-	WorkflowExecutionContinuedAsNewEventAttributes                 *WorkflowExecutionContinuedAsNewEventAttributes                 `json:"workflowExecutionContinuedAsNewEventAttributes,omitempty"`
-	StartChildWorkflowExecutionInitiatedEventAttributes            *StartChildWorkflowExecutionInitiatedEventAttributes            `json:"startChildWorkflowExecutionInitiatedEventAttributes,omitempty"`
-	StartChildWorkflowExecutionFailedEventAttributes               *StartChildWorkflowExecutionFailedEventAttributes               `json:"startChildWorkflowExecutionFailedEventAttributes,omitempty"`
-	ChildWorkflowExecutionStartedEventAttributes                   *ChildWorkflowExecutionStartedEventAttributes                   `json:"childWorkflowExecutionStartedEventAttributes,omitempty"`
-	ChildWorkflowExecutionCompletedEventAttributes                 *ChildWorkflowExecutionCompletedEventAttributes                 `json:"childWorkflowExecutionCompletedEventAttributes,omitempty"`
-	ChildWorkflowExecutionFailedEventAttributes                    *ChildWorkflowExecutionFailedEventAttributes                    `json:"childWorkflowExecutionFailedEventAttributes,omitempty"`
-	ChildWorkflowExecutionCanceledEventAttributes                  *ChildWorkflowExecutionCanceledEventAttributes                  `json:"childWorkflowExecutionCanceledEventAttributes,omitempty"`
-	ChildWorkflowExecutionTimedOutEventAttributes                  *ChildWorkflowExecutionTimedOutEventAttributes                  `json:"childWorkflowExecutionTimedOutEventAttributes,omitempty"`
-	ChildWorkflowExecutionTerminatedEventAttributes                *ChildWorkflowExecutionTerminatedEventAttributes                `json:"childWorkflowExecutionTerminatedEventAttributes,omitempty"`
-	SignalExternalWorkflowExecutionInitiatedEventAttributes        *SignalExternalWorkflowExecutionInitiatedEventAttributes        `json:"signalExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
-	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
-	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
+// GetActiveClusterName returns the value of ActiveClusterName if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationConfiguration) GetActiveClusterName() (o string) {
+	if v.ActiveClusterName != nil {
+		return *v.ActiveClusterName
+	}
+
+	return
 }
 
-// ToWire translates a HistoryEvent struct into a Thrift-level intermediate
+type DomainStatus int32
+
+const (
+	DomainStatusRegistered DomainStatus = 0
+	DomainStatusDeprecated DomainStatus = 1
+	DomainStatusDeleted    DomainStatus = 2
+)
+
+// DomainStatus_Values returns all recognized values of DomainStatus.
+func DomainStatus_Values() []DomainStatus {
+	return []DomainStatus{
+		DomainStatusRegistered,
+		DomainStatusDeprecated,
+		DomainStatusDeleted,
+	}
+}
+
+// UnmarshalText tries to decode DomainStatus from a byte slice
+// containing its name.
+//
+//   var v DomainStatus
+//   err := v.UnmarshalText([]byte("REGISTERED"))
+func (v *DomainStatus) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "REGISTERED":
+		*v = DomainStatusRegistered
+		return nil
+	case "DEPRECATED":
+		*v = DomainStatusDeprecated
+		return nil
+	case "DELETED":
+		*v = DomainStatusDeleted
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "DomainStatus")
+	}
+}
+
+// Ptr returns a pointer to this enum value.
+func (v DomainStatus) Ptr() *DomainStatus {
+	return &v
+}
+
+// ToWire translates DomainStatus into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
-// An error is returned if the struct or any of its fields failed to
-// validate.
+// Enums are represented as 32-bit integers over the wire.
+func (v DomainStatus) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes DomainStatus from its Thrift-level
+// representation.
 //
-//   x, err := v.ToWire()
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return err
+//     return DomainStatus(0), err
 //   }
 //
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
+//   var v DomainStatus
+//   if err := v.FromWire(x); err != nil {
+//     return DomainStatus(0), err
 //   }
-func (v *HistoryEvent) ToWire() (wire.Value, error) {
-	var (
-		fields [45]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
+//   return v, nil
+func (v *DomainStatus) FromWire(w wire.Value) error {
+	*v = (DomainStatus)(w.GetI32())
+	return nil
+}
 
-	if v.EventId != nil {
-		w, err = wire.NewValueI64(*(v.EventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.Timestamp != nil {
-		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.EventType != nil {
-		w, err = v.EventType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 35, Value: w}
-		i++
+// String returns a readable string representation of DomainStatus.
+func (v DomainStatus) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "REGISTERED"
+	case 1:
+		return "DEPRECATED"
+	case 2:
+		return "DELETED"
 	}
-	if v.WorkflowExecutionStartedEventAttributes != nil {
-		w, err = v.WorkflowExecutionStartedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+	return fmt.Sprintf("DomainStatus(%d)", w)
+}
+
+// Equals returns true if this DomainStatus value matches the provided
+// value.
+func (v DomainStatus) Equals(rhs DomainStatus) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes DomainStatus into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v DomainStatus) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"REGISTERED\""), nil
+	case 1:
+		return ([]byte)("\"DEPRECATED\""), nil
+	case 2:
+		return ([]byte)("\"DELETED\""), nil
 	}
-	if v.WorkflowExecutionCompletedEventAttributes != nil {
-		w, err = v.WorkflowExecutionCompletedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode DomainStatus from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *DomainStatus) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
 	}
-	if v.WorkflowExecutionFailedEventAttributes != nil {
-		w, err = v.WorkflowExecutionFailedEventAttributes.ToWire()
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.WorkflowExecutionTimedOutEventAttributes != nil {
-		w, err = v.WorkflowExecutionTimedOutEventAttributes.ToWire()
-		if err != nil {
-			return w, err
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainStatus")
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.DecisionTaskScheduledEventAttributes != nil {
-		w, err = v.DecisionTaskScheduledEventAttributes.ToWire()
-		if err != nil {
-			return w, err
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainStatus")
 		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
+		*v = (DomainStatus)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainStatus")
 	}
-	if v.DecisionTaskStartedEventAttributes != nil {
-		w, err = v.DecisionTaskStartedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
+}
+
+type EntityNotExistsError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a EntityNotExistsError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *EntityNotExistsError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
-	if v.DecisionTaskCompletedEventAttributes != nil {
-		w, err = v.DecisionTaskCompletedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a EntityNotExistsError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a EntityNotExistsError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v EntityNotExistsError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *EntityNotExistsError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
 		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
 	}
-	if v.DecisionTaskTimedOutEventAttributes != nil {
-		w, err = v.DecisionTaskTimedOutEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
+
+	if !messageIsSet {
+		return errors.New("field Message of EntityNotExistsError is required")
 	}
-	if v.DecisionTaskFailedEventAttributes != nil {
-		w, err = v.DecisionTaskFailedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 120, Value: w}
-		i++
+
+	return nil
+}
+
+// String returns a readable string representation of a EntityNotExistsError
+// struct.
+func (v *EntityNotExistsError) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if v.ActivityTaskScheduledEventAttributes != nil {
-		w, err = v.ActivityTaskScheduledEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 130, Value: w}
-		i++
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("EntityNotExistsError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this EntityNotExistsError match the
+// provided EntityNotExistsError.
+//
+// This function performs a deep comparison.
+func (v *EntityNotExistsError) Equals(rhs *EntityNotExistsError) bool {
+	if !(v.Message == rhs.Message) {
+		return false
 	}
-	if v.ActivityTaskStartedEventAttributes != nil {
+
+	return true
+}
+
+func (v *EntityNotExistsError) Error() string {
+	return v.String()
+}
+
+type EventType int32
+
+const (
+	EventTypeWorkflowExecutionStarted                        EventType = 0
+	EventTypeWorkflowExecutionCompleted                      EventType = 1
+	EventTypeWorkflowExecutionFailed                         EventType = 2
+	EventTypeWorkflowExecutionTimedOut                       EventType = 3
+	EventTypeDecisionTaskScheduled                           EventType = 4
+	EventTypeDecisionTaskStarted                             EventType = 5
+	EventTypeDecisionTaskCompleted                           EventType = 6
+	EventTypeDecisionTaskTimedOut                            EventType = 7
+	EventTypeDecisionTaskFailed                              EventType = 8
+	EventTypeActivityTaskScheduled                           EventType = 9
+	EventTypeActivityTaskStarted                             EventType = 10
+	EventTypeActivityTaskCompleted                           EventType = 11
+	EventTypeActivityTaskFailed                              EventType = 12
+	EventTypeActivityTaskTimedOut                            EventType = 13
+	EventTypeActivityTaskCancelRequested                     EventType = 14
+	EventTypeRequestCancelActivityTaskFailed                 EventType = 15
+	EventTypeActivityTaskCanceled                            EventType = 16
+	EventTypeTimerStarted                                    EventType = 17
+	EventTypeTimerFired                                      EventType = 18
+	EventTypeCancelTimerFailed                               EventType = 19
+	EventTypeTimerCanceled                                   EventType = 20
+	EventTypeWorkflowExecutionCancelRequested                EventType = 21
+	EventTypeWorkflowExecutionCanceled                       EventType = 22
+	EventTypeRequestCancelExternalWorkflowExecutionInitiated EventType = 23
+	EventTypeRequestCancelExternalWorkflowExecutionFailed    EventType = 24
+	EventTypeExternalWorkflowExecutionCancelRequested        EventType = 25
+	EventTypeMarkerRecorded                                  EventType = 26
+	EventTypeWorkflowExecutionSignaled                       EventType = 27
+	EventTypeWorkflowExecutionTerminated                     EventType = 28
+	EventTypeWorkflowExecutionContinuedAsNew                 EventType = 29
+	EventTypeStartChildWorkflowExecutionInitiated            EventType = 30
+	EventTypeStartChildWorkflowExecutionFailed               EventType = 31
+	EventTypeChildWorkflowExecutionStarted                   EventType = 32
+	EventTypeChildWorkflowExecutionCompleted                 EventType = 33
+	EventTypeChildWorkflowExecutionFailed                    EventType = 34
+	EventTypeChildWorkflowExecutionCanceled                  EventType = 35
+	EventTypeChildWorkflowExecutionTimedOut                  EventType = 36
+	EventTypeChildWorkflowExecutionTerminated                EventType = 37
+	EventTypeSignalExternalWorkflowExecutionInitiated        EventType = 38
+	EventTypeSignalExternalWorkflowExecutionFailed           EventType = 39
+	EventTypeExternalWorkflowExecutionSignaled               EventType = 40
+	EventTypeUpsertWorkflowSearchAttributes                  EventType = 41
+)
+
+// EventType_Values returns all recognized values of EventType.
+func EventType_Values() []EventType {
+	return []EventType{
+		EventTypeWorkflowExecutionStarted,
+		EventTypeWorkflowExecutionCompleted,
+		EventTypeWorkflowExecutionFailed,
+		EventTypeWorkflowExecutionTimedOut,
+		EventTypeDecisionTaskScheduled,
+		EventTypeDecisionTaskStarted,
+		EventTypeDecisionTaskCompleted,
+		EventTypeDecisionTaskTimedOut,
+		EventTypeDecisionTaskFailed,
+		EventTypeActivityTaskScheduled,
+		EventTypeActivityTaskStarted,
+		EventTypeActivityTaskCompleted,
+		EventTypeActivityTaskFailed,
+		EventTypeActivityTaskTimedOut,
+		EventTypeActivityTaskCancelRequested,
+		EventTypeRequestCancelActivityTaskFailed,
+		EventTypeActivityTaskCanceled,
+		EventTypeTimerStarted,
+		EventTypeTimerFired,
+		EventTypeCancelTimerFailed,
+		EventTypeTimerCanceled,
+		EventTypeWorkflowExecutionCancelRequested,
+		EventTypeWorkflowExecutionCanceled,
+		EventTypeRequestCancelExternalWorkflowExecutionInitiated,
+		EventTypeRequestCancelExternalWorkflowExecutionFailed,
+		EventTypeExternalWorkflowExecutionCancelRequested,
+		EventTypeMarkerRecorded,
+		EventTypeWorkflowExecutionSignaled,
+		EventTypeWorkflowExecutionTerminated,
+		EventTypeWorkflowExecutionContinuedAsNew,
+		EventTypeStartChildWorkflowExecutionInitiated,
+		EventTypeStartChildWorkflowExecutionFailed,
+		EventTypeChildWorkflowExecutionStarted,
+		EventTypeChildWorkflowExecutionCompleted,
+		EventTypeChildWorkflowExecutionFailed,
+		EventTypeChildWorkflowExecutionCanceled,
+		EventTypeChildWorkflowExecutionTimedOut,
+		EventTypeChildWorkflowExecutionTerminated,
+		EventTypeSignalExternalWorkflowExecutionInitiated,
+		EventTypeSignalExternalWorkflowExecutionFailed,
+		EventTypeExternalWorkflowExecutionSignaled,
+		EventTypeUpsertWorkflowSearchAttributes,
+	}
+}
+
+// UnmarshalText tries to decode EventType from a byte slice
+// containing its name.
+//
+//   var v EventType
+//   err := v.UnmarshalText([]byte("WorkflowExecutionStarted"))
+func (v *EventType) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "WorkflowExecutionStarted":
+		*v = EventTypeWorkflowExecutionStarted
+		return nil
+	case "WorkflowExecutionCompleted":
+		*v = EventTypeWorkflowExecutionCompleted
+		return nil
+	case "WorkflowExecutionFailed":
+		*v = EventTypeWorkflowExecutionFailed
+		return nil
+	case "WorkflowExecutionTimedOut":
+		*v = EventTypeWorkflowExecutionTimedOut
+		return nil
+	case "DecisionTaskScheduled":
+		*v = EventTypeDecisionTaskScheduled
+		return nil
+	case "DecisionTaskStarted":
+		*v = EventTypeDecisionTaskStarted
+		return nil
+	case "DecisionTaskCompleted":
+		*v = EventTypeDecisionTaskCompleted
+		return nil
+	case "DecisionTaskTimedOut":
+		*v = EventTypeDecisionTaskTimedOut
+		return nil
+	case "DecisionTaskFailed":
+		*v = EventTypeDecisionTaskFailed
+		return nil
+	case "ActivityTaskScheduled":
+		*v = EventTypeActivityTaskScheduled
+		return nil
+	case "ActivityTaskStarted":
+		*v = EventTypeActivityTaskStarted
+		return nil
+	case "ActivityTaskCompleted":
+		*v = EventTypeActivityTaskCompleted
+		return nil
+	case "ActivityTaskFailed":
+		*v = EventTypeActivityTaskFailed
+		return nil
+	case "ActivityTaskTimedOut":
+		*v = EventTypeActivityTaskTimedOut
+		return nil
+	case "ActivityTaskCancelRequested":
+		*v = EventTypeActivityTaskCancelRequested
+		return nil
+	case "RequestCancelActivityTaskFailed":
+		*v = EventTypeRequestCancelActivityTaskFailed
+		return nil
+	case "ActivityTaskCanceled":
+		*v = EventTypeActivityTaskCanceled
+		return nil
+	case "TimerStarted":
+		*v = EventTypeTimerStarted
+		return nil
+	case "TimerFired":
+		*v = EventTypeTimerFired
+		return nil
+	case "CancelTimerFailed":
+		*v = EventTypeCancelTimerFailed
+		return nil
+	case "TimerCanceled":
+		*v = EventTypeTimerCanceled
+		return nil
+	case "WorkflowExecutionCancelRequested":
+		*v = EventTypeWorkflowExecutionCancelRequested
+		return nil
+	case "WorkflowExecutionCanceled":
+		*v = EventTypeWorkflowExecutionCanceled
+		return nil
+	case "RequestCancelExternalWorkflowExecutionInitiated":
+		*v = EventTypeRequestCancelExternalWorkflowExecutionInitiated
+		return nil
+	case "RequestCancelExternalWorkflowExecutionFailed":
+		*v = EventTypeRequestCancelExternalWorkflowExecutionFailed
+		return nil
+	case "ExternalWorkflowExecutionCancelRequested":
+		*v = EventTypeExternalWorkflowExecutionCancelRequested
+		return nil
+	case "MarkerRecorded":
+		*v = EventTypeMarkerRecorded
+		return nil
+	case "WorkflowExecutionSignaled":
+		*v = EventTypeWorkflowExecutionSignaled
+		return nil
+	case "WorkflowExecutionTerminated":
+		*v = EventTypeWorkflowExecutionTerminated
+		return nil
+	case "WorkflowExecutionContinuedAsNew":
+		*v = EventTypeWorkflowExecutionContinuedAsNew
+		return nil
+	case "StartChildWorkflowExecutionInitiated":
+		*v = EventTypeStartChildWorkflowExecutionInitiated
+		return nil
+	case "StartChildWorkflowExecutionFailed":
+		*v = EventTypeStartChildWorkflowExecutionFailed
+		return nil
+	case "ChildWorkflowExecutionStarted":
+		*v = EventTypeChildWorkflowExecutionStarted
+		return nil
+	case "ChildWorkflowExecutionCompleted":
+		*v = EventTypeChildWorkflowExecutionCompleted
+		return nil
+	case "ChildWorkflowExecutionFailed":
+		*v = EventTypeChildWorkflowExecutionFailed
+		return nil
+	case "ChildWorkflowExecutionCanceled":
+		*v = EventTypeChildWorkflowExecutionCanceled
+		return nil
+	case "ChildWorkflowExecutionTimedOut":
+		*v = EventTypeChildWorkflowExecutionTimedOut
+		return nil
+	case "ChildWorkflowExecutionTerminated":
+		*v = EventTypeChildWorkflowExecutionTerminated
+		return nil
+	case "SignalExternalWorkflowExecutionInitiated":
+		*v = EventTypeSignalExternalWorkflowExecutionInitiated
+		return nil
+	case "SignalExternalWorkflowExecutionFailed":
+		*v = EventTypeSignalExternalWorkflowExecutionFailed
+		return nil
+	case "ExternalWorkflowExecutionSignaled":
+		*v = EventTypeExternalWorkflowExecutionSignaled
+		return nil
+	case "UpsertWorkflowSearchAttributes":
+		*v = EventTypeUpsertWorkflowSearchAttributes
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "EventType")
+	}
+}
+
+// Ptr returns a pointer to this enum value.
+func (v EventType) Ptr() *EventType {
+	return &v
+}
+
+// ToWire translates EventType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v EventType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes EventType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return EventType(0), err
+//   }
+//
+//   var v EventType
+//   if err := v.FromWire(x); err != nil {
+//     return EventType(0), err
+//   }
+//   return v, nil
+func (v *EventType) FromWire(w wire.Value) error {
+	*v = (EventType)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of EventType.
+func (v EventType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "WorkflowExecutionStarted"
+	case 1:
+		return "WorkflowExecutionCompleted"
+	case 2:
+		return "WorkflowExecutionFailed"
+	case 3:
+		return "WorkflowExecutionTimedOut"
+	case 4:
+		return "DecisionTaskScheduled"
+	case 5:
+		return "DecisionTaskStarted"
+	case 6:
+		return "DecisionTaskCompleted"
+	case 7:
+		return "DecisionTaskTimedOut"
+	case 8:
+		return "DecisionTaskFailed"
+	case 9:
+		return "ActivityTaskScheduled"
+	case 10:
+		return "ActivityTaskStarted"
+	case 11:
+		return "ActivityTaskCompleted"
+	case 12:
+		return "ActivityTaskFailed"
+	case 13:
+		return "ActivityTaskTimedOut"
+	case 14:
+		return "ActivityTaskCancelRequested"
+	case 15:
+		return "RequestCancelActivityTaskFailed"
+	case 16:
+		return "ActivityTaskCanceled"
+	case 17:
+		return "TimerStarted"
+	case 18:
+		return "TimerFired"
+	case 19:
+		return "CancelTimerFailed"
+	case 20:
+		return "TimerCanceled"
+	case 21:
+		return "WorkflowExecutionCancelRequested"
+	case 22:
+		return "WorkflowExecutionCanceled"
+	case 23:
+		return "RequestCancelExternalWorkflowExecutionInitiated"
+	case 24:
+		return "RequestCancelExternalWorkflowExecutionFailed"
+	case 25:
+		return "ExternalWorkflowExecutionCancelRequested"
+	case 26:
+		return "MarkerRecorded"
+	case 27:
+		return "WorkflowExecutionSignaled"
+	case 28:
+		return "WorkflowExecutionTerminated"
+	case 29:
+		return "WorkflowExecutionContinuedAsNew"
+	case 30:
+		return "StartChildWorkflowExecutionInitiated"
+	case 31:
+		return "StartChildWorkflowExecutionFailed"
+	case 32:
+		return "ChildWorkflowExecutionStarted"
+	case 33:
+		return "ChildWorkflowExecutionCompleted"
+	case 34:
+		return "ChildWorkflowExecutionFailed"
+	case 35:
+		return "ChildWorkflowExecutionCanceled"
+	case 36:
+		return "ChildWorkflowExecutionTimedOut"
+	case 37:
+		return "ChildWorkflowExecutionTerminated"
+	case 38:
+		return "SignalExternalWorkflowExecutionInitiated"
+	case 39:
+		return "SignalExternalWorkflowExecutionFailed"
+	case 40:
+		return "ExternalWorkflowExecutionSignaled"
+	case 41:
+		return "UpsertWorkflowSearchAttributes"
+	}
+	return fmt.Sprintf("EventType(%d)", w)
+}
+
+// Equals returns true if this EventType value matches the provided
+// value.
+func (v EventType) Equals(rhs EventType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes EventType into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v EventType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"WorkflowExecutionStarted\""), nil
+	case 1:
+		return ([]byte)("\"WorkflowExecutionCompleted\""), nil
+	case 2:
+		return ([]byte)("\"WorkflowExecutionFailed\""), nil
+	case 3:
+		return ([]byte)("\"WorkflowExecutionTimedOut\""), nil
+	case 4:
+		return ([]byte)("\"DecisionTaskScheduled\""), nil
+	case 5:
+		return ([]byte)("\"DecisionTaskStarted\""), nil
+	case 6:
+		return ([]byte)("\"DecisionTaskCompleted\""), nil
+	case 7:
+		return ([]byte)("\"DecisionTaskTimedOut\""), nil
+	case 8:
+		return ([]byte)("\"DecisionTaskFailed\""), nil
+	case 9:
+		return ([]byte)("\"ActivityTaskScheduled\""), nil
+	case 10:
+		return ([]byte)("\"ActivityTaskStarted\""), nil
+	case 11:
+		return ([]byte)("\"ActivityTaskCompleted\""), nil
+	case 12:
+		return ([]byte)("\"ActivityTaskFailed\""), nil
+	case 13:
+		return ([]byte)("\"ActivityTaskTimedOut\""), nil
+	case 14:
+		return ([]byte)("\"ActivityTaskCancelRequested\""), nil
+	case 15:
+		return ([]byte)("\"RequestCancelActivityTaskFailed\""), nil
+	case 16:
+		return ([]byte)("\"ActivityTaskCanceled\""), nil
+	case 17:
+		return ([]byte)("\"TimerStarted\""), nil
+	case 18:
+		return ([]byte)("\"TimerFired\""), nil
+	case 19:
+		return ([]byte)("\"CancelTimerFailed\""), nil
+	case 20:
+		return ([]byte)("\"TimerCanceled\""), nil
+	case 21:
+		return ([]byte)("\"WorkflowExecutionCancelRequested\""), nil
+	case 22:
+		return ([]byte)("\"WorkflowExecutionCanceled\""), nil
+	case 23:
+		return ([]byte)("\"RequestCancelExternalWorkflowExecutionInitiated\""), nil
+	case 24:
+		return ([]byte)("\"RequestCancelExternalWorkflowExecutionFailed\""), nil
+	case 25:
+		return ([]byte)("\"ExternalWorkflowExecutionCancelRequested\""), nil
+	case 26:
+		return ([]byte)("\"MarkerRecorded\""), nil
+	case 27:
+		return ([]byte)("\"WorkflowExecutionSignaled\""), nil
+	case 28:
+		return ([]byte)("\"WorkflowExecutionTerminated\""), nil
+	case 29:
+		return ([]byte)("\"WorkflowExecutionContinuedAsNew\""), nil
+	case 30:
+		return ([]byte)("\"StartChildWorkflowExecutionInitiated\""), nil
+	case 31:
+		return ([]byte)("\"StartChildWorkflowExecutionFailed\""), nil
+	case 32:
+		return ([]byte)("\"ChildWorkflowExecutionStarted\""), nil
+	case 33:
+		return ([]byte)("\"ChildWorkflowExecutionCompleted\""), nil
+	case 34:
+		return ([]byte)("\"ChildWorkflowExecutionFailed\""), nil
+	case 35:
+		return ([]byte)("\"ChildWorkflowExecutionCanceled\""), nil
+	case 36:
+		return ([]byte)("\"ChildWorkflowExecutionTimedOut\""), nil
+	case 37:
+		return ([]byte)("\"ChildWorkflowExecutionTerminated\""), nil
+	case 38:
+		return ([]byte)("\"SignalExternalWorkflowExecutionInitiated\""), nil
+	case 39:
+		return ([]byte)("\"SignalExternalWorkflowExecutionFailed\""), nil
+	case 40:
+		return ([]byte)("\"ExternalWorkflowExecutionSignaled\""), nil
+	case 41:
+		return ([]byte)("\"UpsertWorkflowSearchAttributes\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode EventType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *EventType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "EventType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "EventType")
+		}
+		*v = (EventType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "EventType")
+	}
+}
+
+type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
+	InitiatedEventId  *int64             `json:"initiatedEventId,omitempty"`
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// ToWire translates a ExternalWorkflowExecutionCancelRequestedEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.InitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ExternalWorkflowExecutionCancelRequestedEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ExternalWorkflowExecutionCancelRequestedEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ExternalWorkflowExecutionCancelRequestedEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ExternalWorkflowExecutionCancelRequestedEventAttributes
+// struct.
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.InitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+
+	return fmt.Sprintf("ExternalWorkflowExecutionCancelRequestedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ExternalWorkflowExecutionCancelRequestedEventAttributes match the
+// provided ExternalWorkflowExecutionCancelRequestedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) Equals(rhs *ExternalWorkflowExecutionCancelRequestedEventAttributes) bool {
+	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+
+	return true
+}
+
+// GetInitiatedEventId returns the value of InitiatedEventId if it is set or its
+// zero value if it is unset.
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetInitiatedEventId() (o int64) {
+	if v.InitiatedEventId != nil {
+		return *v.InitiatedEventId
+	}
+
+	return
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+type ExternalWorkflowExecutionSignaledEventAttributes struct {
+	InitiatedEventId  *int64             `json:"initiatedEventId,omitempty"`
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Control           []byte             `json:"control,omitempty"`
+}
+
+// ToWire translates a ExternalWorkflowExecutionSignaledEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.InitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ExternalWorkflowExecutionSignaledEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ExternalWorkflowExecutionSignaledEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ExternalWorkflowExecutionSignaledEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Control, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ExternalWorkflowExecutionSignaledEventAttributes
+// struct.
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.InitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+		i++
+	}
+
+	return fmt.Sprintf("ExternalWorkflowExecutionSignaledEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ExternalWorkflowExecutionSignaledEventAttributes match the
+// provided ExternalWorkflowExecutionSignaledEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) Equals(rhs *ExternalWorkflowExecutionSignaledEventAttributes) bool {
+	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+		return false
+	}
+
+	return true
+}
+
+// GetInitiatedEventId returns the value of InitiatedEventId if it is set or its
+// zero value if it is unset.
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetInitiatedEventId() (o int64) {
+	if v.InitiatedEventId != nil {
+		return *v.InitiatedEventId
+	}
+
+	return
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+type FailWorkflowExecutionDecisionAttributes struct {
+	Reason  *string `json:"reason,omitempty"`
+	Details []byte  `json:"details,omitempty"`
+}
+
+// ToWire translates a FailWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *FailWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a FailWorkflowExecutionDecisionAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a FailWorkflowExecutionDecisionAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v FailWorkflowExecutionDecisionAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *FailWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a FailWorkflowExecutionDecisionAttributes
+// struct.
+func (v *FailWorkflowExecutionDecisionAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+
+	return fmt.Sprintf("FailWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this FailWorkflowExecutionDecisionAttributes match the
+// provided FailWorkflowExecutionDecisionAttributes.
+//
+// This function performs a deep comparison.
+func (v *FailWorkflowExecutionDecisionAttributes) Equals(rhs *FailWorkflowExecutionDecisionAttributes) bool {
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+
+	return true
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *FailWorkflowExecutionDecisionAttributes) GetReason() (o string) {
+	if v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+type GetWorkflowExecutionHistoryRequest struct {
+	Domain                 *string                 `json:"domain,omitempty"`
+	Execution              *WorkflowExecution      `json:"execution,omitempty"`
+	MaximumPageSize        *int32                  `json:"maximumPageSize,omitempty"`
+	NextPageToken          []byte                  `json:"nextPageToken,omitempty"`
+	WaitForNewEvent        *bool                   `json:"waitForNewEvent,omitempty"`
+	HistoryEventFilterType *HistoryEventFilterType `json:"HistoryEventFilterType,omitempty"`
+}
+
+// ToWire translates a GetWorkflowExecutionHistoryRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.WaitForNewEvent != nil {
+		w, err = wire.NewValueBool(*(v.WaitForNewEvent)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.HistoryEventFilterType != nil {
+		w, err = v.HistoryEventFilterType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _HistoryEventFilterType_Read(w wire.Value) (HistoryEventFilterType, error) {
+	var v HistoryEventFilterType
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a GetWorkflowExecutionHistoryRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowExecutionHistoryRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkflowExecutionHistoryRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.WaitForNewEvent = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x HistoryEventFilterType
+				x, err = _HistoryEventFilterType_Read(field.Value)
+				v.HistoryEventFilterType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowExecutionHistoryRequest
+// struct.
+func (v *GetWorkflowExecutionHistoryRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.WaitForNewEvent != nil {
+		fields[i] = fmt.Sprintf("WaitForNewEvent: %v", *(v.WaitForNewEvent))
+		i++
+	}
+	if v.HistoryEventFilterType != nil {
+		fields[i] = fmt.Sprintf("HistoryEventFilterType: %v", *(v.HistoryEventFilterType))
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkflowExecutionHistoryRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _HistoryEventFilterType_EqualsPtr(lhs, rhs *HistoryEventFilterType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this GetWorkflowExecutionHistoryRequest match the
+// provided GetWorkflowExecutionHistoryRequest.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowExecutionHistoryRequest) Equals(rhs *GetWorkflowExecutionHistoryRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.WaitForNewEvent, rhs.WaitForNewEvent) {
+		return false
+	}
+	if !_HistoryEventFilterType_EqualsPtr(v.HistoryEventFilterType, rhs.HistoryEventFilterType) {
+		return false
+	}
+
+	return true
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionHistoryRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionHistoryRequest) GetMaximumPageSize() (o int32) {
+	if v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// GetWaitForNewEvent returns the value of WaitForNewEvent if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionHistoryRequest) GetWaitForNewEvent() (o bool) {
+	if v.WaitForNewEvent != nil {
+		return *v.WaitForNewEvent
+	}
+
+	return
+}
+
+// GetHistoryEventFilterType returns the value of HistoryEventFilterType if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionHistoryRequest) GetHistoryEventFilterType() (o HistoryEventFilterType) {
+	if v.HistoryEventFilterType != nil {
+		return *v.HistoryEventFilterType
+	}
+
+	return
+}
+
+type GetWorkflowExecutionHistoryResponse struct {
+	History       *History `json:"history,omitempty"`
+	NextPageToken []byte   `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a GetWorkflowExecutionHistoryResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowExecutionHistoryResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _History_Read(w wire.Value) (*History, error) {
+	var v History
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a GetWorkflowExecutionHistoryResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowExecutionHistoryResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkflowExecutionHistoryResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkflowExecutionHistoryResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.History, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowExecutionHistoryResponse
+// struct.
+func (v *GetWorkflowExecutionHistoryResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkflowExecutionHistoryResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GetWorkflowExecutionHistoryResponse match the
+// provided GetWorkflowExecutionHistoryResponse.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowExecutionHistoryResponse) Equals(rhs *GetWorkflowExecutionHistoryResponse) bool {
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+type History struct {
+	Events []*HistoryEvent `json:"events,omitempty"`
+}
+
+type _List_HistoryEvent_ValueList []*HistoryEvent
+
+func (v _List_HistoryEvent_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_HistoryEvent_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_HistoryEvent_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_HistoryEvent_ValueList) Close() {}
+
+// ToWire translates a History struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *History) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Events != nil {
+		w, err = wire.NewValueList(_List_HistoryEvent_ValueList(v.Events)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _HistoryEvent_Read(w wire.Value) (*HistoryEvent, error) {
+	var v HistoryEvent
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_HistoryEvent_Read(l wire.ValueList) ([]*HistoryEvent, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*HistoryEvent, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _HistoryEvent_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a History struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a History struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v History
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *History) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Events, err = _List_HistoryEvent_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a History
+// struct.
+func (v *History) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Events != nil {
+		fields[i] = fmt.Sprintf("Events: %v", v.Events)
+		i++
+	}
+
+	return fmt.Sprintf("History{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_HistoryEvent_Equals(lhs, rhs []*HistoryEvent) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this History match the
+// provided History.
+//
+// This function performs a deep comparison.
+func (v *History) Equals(rhs *History) bool {
+	if !((v.Events == nil && rhs.Events == nil) || (v.Events != nil && rhs.Events != nil && _List_HistoryEvent_Equals(v.Events, rhs.Events))) {
+		return false
+	}
+
+	return true
+}
+
+type HistoryEvent struct {
+	EventId                                                        *int64                                                          `json:"eventId,omitempty"`
+	Timestamp                                                      *int64                                                          `json:"timestamp,omitempty"`
+	EventType                                                      *EventType                                                      `json:"eventType,omitempty"`
+	Version                                                        *int64                                                          `json:"version,omitempty"`
+	WorkflowExecutionStartedEventAttributes                        *WorkflowExecutionStartedEventAttributes                        `json:"workflowExecutionStartedEventAttributes,omitempty"`
+	WorkflowExecutionCompletedEventAttributes                      *WorkflowExecutionCompletedEventAttributes                      `json:"workflowExecutionCompletedEventAttributes,omitempty"`
+	WorkflowExecutionFailedEventAttributes                         *WorkflowExecutionFailedEventAttributes                         `json:"workflowExecutionFailedEventAttributes,omitempty"`
+	WorkflowExecutionTimedOutEventAttributes                       *WorkflowExecutionTimedOutEventAttributes                       `json:"workflowExecutionTimedOutEventAttributes,omitempty"`
+	DecisionTaskScheduledEventAttributes                           *DecisionTaskScheduledEventAttributes                           `json:"decisionTaskScheduledEventAttributes,omitempty"`
+	DecisionTaskStartedEventAttributes                             *DecisionTaskStartedEventAttributes                             `json:"decisionTaskStartedEventAttributes,omitempty"`
+	DecisionTaskCompletedEventAttributes                           *DecisionTaskCompletedEventAttributes                           `json:"decisionTaskCompletedEventAttributes,omitempty"`
+	DecisionTaskTimedOutEventAttributes                            *DecisionTaskTimedOutEventAttributes                            `json:"decisionTaskTimedOutEventAttributes,omitempty"`
+	DecisionTaskFailedEventAttributes                              *DecisionTaskFailedEventAttributes                              `json:"decisionTaskFailedEventAttributes,omitempty"`
+	ActivityTaskScheduledEventAttributes                           *ActivityTaskScheduledEventAttributes                           `json:"activityTaskScheduledEventAttributes,omitempty"`
+	ActivityTaskStartedEventAttributes                             *ActivityTaskStartedEventAttributes                             `json:"activityTaskStartedEventAttributes,omitempty"`
+	ActivityTaskCompletedEventAttributes                           *ActivityTaskCompletedEventAttributes                           `json:"activityTaskCompletedEventAttributes,omitempty"`
+	ActivityTaskFailedEventAttributes                              *ActivityTaskFailedEventAttributes                              `json:"activityTaskFailedEventAttributes,omitempty"`
+	ActivityTaskTimedOutEventAttributes                            *ActivityTaskTimedOutEventAttributes                            `json:"activityTaskTimedOutEventAttributes,omitempty"`
+	TimerStartedEventAttributes                                    *TimerStartedEventAttributes                                    `json:"timerStartedEventAttributes,omitempty"`
+	TimerFiredEventAttributes                                      *TimerFiredEventAttributes                                      `json:"timerFiredEventAttributes,omitempty"`
+	ActivityTaskCancelRequestedEventAttributes                     *ActivityTaskCancelRequestedEventAttributes                     `json:"activityTaskCancelRequestedEventAttributes,omitempty"`
+	RequestCancelActivityTaskFailedEventAttributes                 *RequestCancelActivityTaskFailedEventAttributes                 `json:"requestCancelActivityTaskFailedEventAttributes,omitempty"`
+	ActivityTaskCanceledEventAttributes                            *ActivityTaskCanceledEventAttributes                            `json:"activityTaskCanceledEventAttributes,omitempty"`
+	TimerCanceledEventAttributes                                   *TimerCanceledEventAttributes                                   `json:"timerCanceledEventAttributes,omitempty"`
+	CancelTimerFailedEventAttributes                               *CancelTimerFailedEventAttributes                               `json:"cancelTimerFailedEventAttributes,omitempty"`
+	MarkerRecordedEventAttributes                                  *MarkerRecordedEventAttributes                                  `json:"markerRecordedEventAttributes,omitempty"`
+	WorkflowExecutionSignaledEventAttributes                       *WorkflowExecutionSignaledEventAttributes                       `json:"workflowExecutionSignaledEventAttributes,omitempty"`
+	WorkflowExecutionTerminatedEventAttributes                     *WorkflowExecutionTerminatedEventAttributes                     `json:"workflowExecutionTerminatedEventAttributes,omitempty"`
+	WorkflowExecutionCancelRequestedEventAttributes                *WorkflowExecutionCancelRequestedEventAttributes                `json:"workflowExecutionCancelRequestedEventAttributes,omitempty"`
+	WorkflowExecutionCanceledEventAttributes                       *WorkflowExecutionCanceledEventAttributes                       `json:"workflowExecutionCanceledEventAttributes,omitempty"`
+	RequestCancelExternalWorkflowExecutionInitiatedEventAttributes *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes `json:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
+	RequestCancelExternalWorkflowExecutionFailedEventAttributes    *RequestCancelExternalWorkflowExecutionFailedEventAttributes    `json:"requestCancelExternalWorkflowExecutionFailedEventAttributes,omitempty"`
+	ExternalWorkflowExecutionCancelRequestedEventAttributes        *ExternalWorkflowExecutionCancelRequestedEventAttributes        `json:"externalWorkflowExecutionCancelRequestedEventAttributes,omitempty"`
+	WorkflowExecutionContinuedAsNewEventAttributes                 *WorkflowExecutionContinuedAsNewEventAttributes                 `json:"workflowExecutionContinuedAsNewEventAttributes,omitempty"`
+	StartChildWorkflowExecutionInitiatedEventAttributes            *StartChildWorkflowExecutionInitiatedEventAttributes            `json:"startChildWorkflowExecutionInitiatedEventAttributes,omitempty"`
+	StartChildWorkflowExecutionFailedEventAttributes               *StartChildWorkflowExecutionFailedEventAttributes               `json:"startChildWorkflowExecutionFailedEventAttributes,omitempty"`
+	ChildWorkflowExecutionStartedEventAttributes                   *ChildWorkflowExecutionStartedEventAttributes                   `json:"childWorkflowExecutionStartedEventAttributes,omitempty"`
+	ChildWorkflowExecutionCompletedEventAttributes                 *ChildWorkflowExecutionCompletedEventAttributes                 `json:"childWorkflowExecutionCompletedEventAttributes,omitempty"`
+	ChildWorkflowExecutionFailedEventAttributes                    *ChildWorkflowExecutionFailedEventAttributes                    `json:"childWorkflowExecutionFailedEventAttributes,omitempty"`
+	ChildWorkflowExecutionCanceledEventAttributes                  *ChildWorkflowExecutionCanceledEventAttributes                  `json:"childWorkflowExecutionCanceledEventAttributes,omitempty"`
+	ChildWorkflowExecutionTimedOutEventAttributes                  *ChildWorkflowExecutionTimedOutEventAttributes                  `json:"childWorkflowExecutionTimedOutEventAttributes,omitempty"`
+	ChildWorkflowExecutionTerminatedEventAttributes                *ChildWorkflowExecutionTerminatedEventAttributes                `json:"childWorkflowExecutionTerminatedEventAttributes,omitempty"`
+	SignalExternalWorkflowExecutionInitiatedEventAttributes        *SignalExternalWorkflowExecutionInitiatedEventAttributes        `json:"signalExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
+	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
+	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
+	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+}
+
+// ToWire translates a HistoryEvent struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HistoryEvent) ToWire() (wire.Value, error) {
+	var (
+		fields [46]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.EventId != nil {
+		w, err = wire.NewValueI64(*(v.EventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.EventType != nil {
+		w, err = v.EventType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 35, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionStartedEventAttributes != nil {
+		w, err = v.WorkflowExecutionStartedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionCompletedEventAttributes != nil {
+		w, err = v.WorkflowExecutionCompletedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionFailedEventAttributes != nil {
+		w, err = v.WorkflowExecutionFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTimedOutEventAttributes != nil {
+		w, err = v.WorkflowExecutionTimedOutEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.DecisionTaskScheduledEventAttributes != nil {
+		w, err = v.DecisionTaskScheduledEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.DecisionTaskStartedEventAttributes != nil {
+		w, err = v.DecisionTaskStartedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.DecisionTaskCompletedEventAttributes != nil {
+		w, err = v.DecisionTaskCompletedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.DecisionTaskTimedOutEventAttributes != nil {
+		w, err = v.DecisionTaskTimedOutEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.DecisionTaskFailedEventAttributes != nil {
+		w, err = v.DecisionTaskFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.ActivityTaskScheduledEventAttributes != nil {
+		w, err = v.ActivityTaskScheduledEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.ActivityTaskStartedEventAttributes != nil {
 		w, err = v.ActivityTaskStartedEventAttributes.ToWire()
 		if err != nil {
 			return w, err
@@ -11383,6 +13685,14 @@ func (v *HistoryEvent) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 440, Value: w}
 		i++
 	}
+	if v.UpsertWorkflowSearchAttributesEventAttributes != nil {
+		w, err = v.UpsertWorkflowSearchAttributesEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 450, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -11639,6 +13949,12 @@ func _ExternalWorkflowExecutionSignaledEventAttributes_Read(w wire.Value) (*Exte
 	return &v, err
 }
 
+func _UpsertWorkflowSearchAttributesEventAttributes_Read(w wire.Value) (*UpsertWorkflowSearchAttributesEventAttributes, error) {
+	var v UpsertWorkflowSearchAttributesEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a HistoryEvent struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -12028,6 +14344,14 @@ func (v *HistoryEvent) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 450:
+			if field.Value.Type() == wire.TStruct {
+				v.UpsertWorkflowSearchAttributesEventAttributes, err = _UpsertWorkflowSearchAttributesEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -12042,7 +14366,7 @@ func (v *HistoryEvent) String() string {
 		return "<nil>"
 	}
 
-	var fields [45]string
+	var fields [46]string
 	i := 0
 	if v.EventId != nil {
 		fields[i] = fmt.Sprintf("EventId: %v", *(v.EventId))
@@ -12176,392 +14500,865 @@ func (v *HistoryEvent) String() string {
 		fields[i] = fmt.Sprintf("ExternalWorkflowExecutionCancelRequestedEventAttributes: %v", v.ExternalWorkflowExecutionCancelRequestedEventAttributes)
 		i++
 	}
-	if v.WorkflowExecutionContinuedAsNewEventAttributes != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionContinuedAsNewEventAttributes: %v", v.WorkflowExecutionContinuedAsNewEventAttributes)
-		i++
+	if v.WorkflowExecutionContinuedAsNewEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionContinuedAsNewEventAttributes: %v", v.WorkflowExecutionContinuedAsNewEventAttributes)
+		i++
+	}
+	if v.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionInitiatedEventAttributes: %v", v.StartChildWorkflowExecutionInitiatedEventAttributes)
+		i++
+	}
+	if v.StartChildWorkflowExecutionFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionFailedEventAttributes: %v", v.StartChildWorkflowExecutionFailedEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionStartedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionStartedEventAttributes: %v", v.ChildWorkflowExecutionStartedEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionCompletedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionCompletedEventAttributes: %v", v.ChildWorkflowExecutionCompletedEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionFailedEventAttributes: %v", v.ChildWorkflowExecutionFailedEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionCanceledEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionCanceledEventAttributes: %v", v.ChildWorkflowExecutionCanceledEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionTimedOutEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionTimedOutEventAttributes: %v", v.ChildWorkflowExecutionTimedOutEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionTerminatedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionTerminatedEventAttributes: %v", v.ChildWorkflowExecutionTerminatedEventAttributes)
+		i++
+	}
+	if v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("SignalExternalWorkflowExecutionInitiatedEventAttributes: %v", v.SignalExternalWorkflowExecutionInitiatedEventAttributes)
+		i++
+	}
+	if v.SignalExternalWorkflowExecutionFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("SignalExternalWorkflowExecutionFailedEventAttributes: %v", v.SignalExternalWorkflowExecutionFailedEventAttributes)
+		i++
+	}
+	if v.ExternalWorkflowExecutionSignaledEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ExternalWorkflowExecutionSignaledEventAttributes: %v", v.ExternalWorkflowExecutionSignaledEventAttributes)
+		i++
+	}
+	if v.UpsertWorkflowSearchAttributesEventAttributes != nil {
+		fields[i] = fmt.Sprintf("UpsertWorkflowSearchAttributesEventAttributes: %v", v.UpsertWorkflowSearchAttributesEventAttributes)
+		i++
+	}
+
+	return fmt.Sprintf("HistoryEvent{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _EventType_EqualsPtr(lhs, rhs *EventType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this HistoryEvent match the
+// provided HistoryEvent.
+//
+// This function performs a deep comparison.
+func (v *HistoryEvent) Equals(rhs *HistoryEvent) bool {
+	if !_I64_EqualsPtr(v.EventId, rhs.EventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+	if !_EventType_EqualsPtr(v.EventType, rhs.EventType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !((v.WorkflowExecutionStartedEventAttributes == nil && rhs.WorkflowExecutionStartedEventAttributes == nil) || (v.WorkflowExecutionStartedEventAttributes != nil && rhs.WorkflowExecutionStartedEventAttributes != nil && v.WorkflowExecutionStartedEventAttributes.Equals(rhs.WorkflowExecutionStartedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionCompletedEventAttributes == nil && rhs.WorkflowExecutionCompletedEventAttributes == nil) || (v.WorkflowExecutionCompletedEventAttributes != nil && rhs.WorkflowExecutionCompletedEventAttributes != nil && v.WorkflowExecutionCompletedEventAttributes.Equals(rhs.WorkflowExecutionCompletedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionFailedEventAttributes == nil && rhs.WorkflowExecutionFailedEventAttributes == nil) || (v.WorkflowExecutionFailedEventAttributes != nil && rhs.WorkflowExecutionFailedEventAttributes != nil && v.WorkflowExecutionFailedEventAttributes.Equals(rhs.WorkflowExecutionFailedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionTimedOutEventAttributes == nil && rhs.WorkflowExecutionTimedOutEventAttributes == nil) || (v.WorkflowExecutionTimedOutEventAttributes != nil && rhs.WorkflowExecutionTimedOutEventAttributes != nil && v.WorkflowExecutionTimedOutEventAttributes.Equals(rhs.WorkflowExecutionTimedOutEventAttributes))) {
+		return false
+	}
+	if !((v.DecisionTaskScheduledEventAttributes == nil && rhs.DecisionTaskScheduledEventAttributes == nil) || (v.DecisionTaskScheduledEventAttributes != nil && rhs.DecisionTaskScheduledEventAttributes != nil && v.DecisionTaskScheduledEventAttributes.Equals(rhs.DecisionTaskScheduledEventAttributes))) {
+		return false
+	}
+	if !((v.DecisionTaskStartedEventAttributes == nil && rhs.DecisionTaskStartedEventAttributes == nil) || (v.DecisionTaskStartedEventAttributes != nil && rhs.DecisionTaskStartedEventAttributes != nil && v.DecisionTaskStartedEventAttributes.Equals(rhs.DecisionTaskStartedEventAttributes))) {
+		return false
+	}
+	if !((v.DecisionTaskCompletedEventAttributes == nil && rhs.DecisionTaskCompletedEventAttributes == nil) || (v.DecisionTaskCompletedEventAttributes != nil && rhs.DecisionTaskCompletedEventAttributes != nil && v.DecisionTaskCompletedEventAttributes.Equals(rhs.DecisionTaskCompletedEventAttributes))) {
+		return false
+	}
+	if !((v.DecisionTaskTimedOutEventAttributes == nil && rhs.DecisionTaskTimedOutEventAttributes == nil) || (v.DecisionTaskTimedOutEventAttributes != nil && rhs.DecisionTaskTimedOutEventAttributes != nil && v.DecisionTaskTimedOutEventAttributes.Equals(rhs.DecisionTaskTimedOutEventAttributes))) {
+		return false
+	}
+	if !((v.DecisionTaskFailedEventAttributes == nil && rhs.DecisionTaskFailedEventAttributes == nil) || (v.DecisionTaskFailedEventAttributes != nil && rhs.DecisionTaskFailedEventAttributes != nil && v.DecisionTaskFailedEventAttributes.Equals(rhs.DecisionTaskFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskScheduledEventAttributes == nil && rhs.ActivityTaskScheduledEventAttributes == nil) || (v.ActivityTaskScheduledEventAttributes != nil && rhs.ActivityTaskScheduledEventAttributes != nil && v.ActivityTaskScheduledEventAttributes.Equals(rhs.ActivityTaskScheduledEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskStartedEventAttributes == nil && rhs.ActivityTaskStartedEventAttributes == nil) || (v.ActivityTaskStartedEventAttributes != nil && rhs.ActivityTaskStartedEventAttributes != nil && v.ActivityTaskStartedEventAttributes.Equals(rhs.ActivityTaskStartedEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskCompletedEventAttributes == nil && rhs.ActivityTaskCompletedEventAttributes == nil) || (v.ActivityTaskCompletedEventAttributes != nil && rhs.ActivityTaskCompletedEventAttributes != nil && v.ActivityTaskCompletedEventAttributes.Equals(rhs.ActivityTaskCompletedEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskFailedEventAttributes == nil && rhs.ActivityTaskFailedEventAttributes == nil) || (v.ActivityTaskFailedEventAttributes != nil && rhs.ActivityTaskFailedEventAttributes != nil && v.ActivityTaskFailedEventAttributes.Equals(rhs.ActivityTaskFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskTimedOutEventAttributes == nil && rhs.ActivityTaskTimedOutEventAttributes == nil) || (v.ActivityTaskTimedOutEventAttributes != nil && rhs.ActivityTaskTimedOutEventAttributes != nil && v.ActivityTaskTimedOutEventAttributes.Equals(rhs.ActivityTaskTimedOutEventAttributes))) {
+		return false
+	}
+	if !((v.TimerStartedEventAttributes == nil && rhs.TimerStartedEventAttributes == nil) || (v.TimerStartedEventAttributes != nil && rhs.TimerStartedEventAttributes != nil && v.TimerStartedEventAttributes.Equals(rhs.TimerStartedEventAttributes))) {
+		return false
+	}
+	if !((v.TimerFiredEventAttributes == nil && rhs.TimerFiredEventAttributes == nil) || (v.TimerFiredEventAttributes != nil && rhs.TimerFiredEventAttributes != nil && v.TimerFiredEventAttributes.Equals(rhs.TimerFiredEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskCancelRequestedEventAttributes == nil && rhs.ActivityTaskCancelRequestedEventAttributes == nil) || (v.ActivityTaskCancelRequestedEventAttributes != nil && rhs.ActivityTaskCancelRequestedEventAttributes != nil && v.ActivityTaskCancelRequestedEventAttributes.Equals(rhs.ActivityTaskCancelRequestedEventAttributes))) {
+		return false
+	}
+	if !((v.RequestCancelActivityTaskFailedEventAttributes == nil && rhs.RequestCancelActivityTaskFailedEventAttributes == nil) || (v.RequestCancelActivityTaskFailedEventAttributes != nil && rhs.RequestCancelActivityTaskFailedEventAttributes != nil && v.RequestCancelActivityTaskFailedEventAttributes.Equals(rhs.RequestCancelActivityTaskFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskCanceledEventAttributes == nil && rhs.ActivityTaskCanceledEventAttributes == nil) || (v.ActivityTaskCanceledEventAttributes != nil && rhs.ActivityTaskCanceledEventAttributes != nil && v.ActivityTaskCanceledEventAttributes.Equals(rhs.ActivityTaskCanceledEventAttributes))) {
+		return false
+	}
+	if !((v.TimerCanceledEventAttributes == nil && rhs.TimerCanceledEventAttributes == nil) || (v.TimerCanceledEventAttributes != nil && rhs.TimerCanceledEventAttributes != nil && v.TimerCanceledEventAttributes.Equals(rhs.TimerCanceledEventAttributes))) {
+		return false
+	}
+	if !((v.CancelTimerFailedEventAttributes == nil && rhs.CancelTimerFailedEventAttributes == nil) || (v.CancelTimerFailedEventAttributes != nil && rhs.CancelTimerFailedEventAttributes != nil && v.CancelTimerFailedEventAttributes.Equals(rhs.CancelTimerFailedEventAttributes))) {
+		return false
+	}
+	if !((v.MarkerRecordedEventAttributes == nil && rhs.MarkerRecordedEventAttributes == nil) || (v.MarkerRecordedEventAttributes != nil && rhs.MarkerRecordedEventAttributes != nil && v.MarkerRecordedEventAttributes.Equals(rhs.MarkerRecordedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionSignaledEventAttributes == nil && rhs.WorkflowExecutionSignaledEventAttributes == nil) || (v.WorkflowExecutionSignaledEventAttributes != nil && rhs.WorkflowExecutionSignaledEventAttributes != nil && v.WorkflowExecutionSignaledEventAttributes.Equals(rhs.WorkflowExecutionSignaledEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionTerminatedEventAttributes == nil && rhs.WorkflowExecutionTerminatedEventAttributes == nil) || (v.WorkflowExecutionTerminatedEventAttributes != nil && rhs.WorkflowExecutionTerminatedEventAttributes != nil && v.WorkflowExecutionTerminatedEventAttributes.Equals(rhs.WorkflowExecutionTerminatedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionCancelRequestedEventAttributes == nil && rhs.WorkflowExecutionCancelRequestedEventAttributes == nil) || (v.WorkflowExecutionCancelRequestedEventAttributes != nil && rhs.WorkflowExecutionCancelRequestedEventAttributes != nil && v.WorkflowExecutionCancelRequestedEventAttributes.Equals(rhs.WorkflowExecutionCancelRequestedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionCanceledEventAttributes == nil && rhs.WorkflowExecutionCanceledEventAttributes == nil) || (v.WorkflowExecutionCanceledEventAttributes != nil && rhs.WorkflowExecutionCanceledEventAttributes != nil && v.WorkflowExecutionCanceledEventAttributes.Equals(rhs.WorkflowExecutionCanceledEventAttributes))) {
+		return false
+	}
+	if !((v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes == nil && rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes == nil) || (v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil && rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil && v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Equals(rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes))) {
+		return false
+	}
+	if !((v.RequestCancelExternalWorkflowExecutionFailedEventAttributes == nil && rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes == nil) || (v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil && rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil && v.RequestCancelExternalWorkflowExecutionFailedEventAttributes.Equals(rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ExternalWorkflowExecutionCancelRequestedEventAttributes == nil && rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes == nil) || (v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil && rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil && v.ExternalWorkflowExecutionCancelRequestedEventAttributes.Equals(rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionContinuedAsNewEventAttributes == nil && rhs.WorkflowExecutionContinuedAsNewEventAttributes == nil) || (v.WorkflowExecutionContinuedAsNewEventAttributes != nil && rhs.WorkflowExecutionContinuedAsNewEventAttributes != nil && v.WorkflowExecutionContinuedAsNewEventAttributes.Equals(rhs.WorkflowExecutionContinuedAsNewEventAttributes))) {
+		return false
+	}
+	if !((v.StartChildWorkflowExecutionInitiatedEventAttributes == nil && rhs.StartChildWorkflowExecutionInitiatedEventAttributes == nil) || (v.StartChildWorkflowExecutionInitiatedEventAttributes != nil && rhs.StartChildWorkflowExecutionInitiatedEventAttributes != nil && v.StartChildWorkflowExecutionInitiatedEventAttributes.Equals(rhs.StartChildWorkflowExecutionInitiatedEventAttributes))) {
+		return false
+	}
+	if !((v.StartChildWorkflowExecutionFailedEventAttributes == nil && rhs.StartChildWorkflowExecutionFailedEventAttributes == nil) || (v.StartChildWorkflowExecutionFailedEventAttributes != nil && rhs.StartChildWorkflowExecutionFailedEventAttributes != nil && v.StartChildWorkflowExecutionFailedEventAttributes.Equals(rhs.StartChildWorkflowExecutionFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionStartedEventAttributes == nil && rhs.ChildWorkflowExecutionStartedEventAttributes == nil) || (v.ChildWorkflowExecutionStartedEventAttributes != nil && rhs.ChildWorkflowExecutionStartedEventAttributes != nil && v.ChildWorkflowExecutionStartedEventAttributes.Equals(rhs.ChildWorkflowExecutionStartedEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionCompletedEventAttributes == nil && rhs.ChildWorkflowExecutionCompletedEventAttributes == nil) || (v.ChildWorkflowExecutionCompletedEventAttributes != nil && rhs.ChildWorkflowExecutionCompletedEventAttributes != nil && v.ChildWorkflowExecutionCompletedEventAttributes.Equals(rhs.ChildWorkflowExecutionCompletedEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionFailedEventAttributes == nil && rhs.ChildWorkflowExecutionFailedEventAttributes == nil) || (v.ChildWorkflowExecutionFailedEventAttributes != nil && rhs.ChildWorkflowExecutionFailedEventAttributes != nil && v.ChildWorkflowExecutionFailedEventAttributes.Equals(rhs.ChildWorkflowExecutionFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionCanceledEventAttributes == nil && rhs.ChildWorkflowExecutionCanceledEventAttributes == nil) || (v.ChildWorkflowExecutionCanceledEventAttributes != nil && rhs.ChildWorkflowExecutionCanceledEventAttributes != nil && v.ChildWorkflowExecutionCanceledEventAttributes.Equals(rhs.ChildWorkflowExecutionCanceledEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionTimedOutEventAttributes == nil && rhs.ChildWorkflowExecutionTimedOutEventAttributes == nil) || (v.ChildWorkflowExecutionTimedOutEventAttributes != nil && rhs.ChildWorkflowExecutionTimedOutEventAttributes != nil && v.ChildWorkflowExecutionTimedOutEventAttributes.Equals(rhs.ChildWorkflowExecutionTimedOutEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionTerminatedEventAttributes == nil && rhs.ChildWorkflowExecutionTerminatedEventAttributes == nil) || (v.ChildWorkflowExecutionTerminatedEventAttributes != nil && rhs.ChildWorkflowExecutionTerminatedEventAttributes != nil && v.ChildWorkflowExecutionTerminatedEventAttributes.Equals(rhs.ChildWorkflowExecutionTerminatedEventAttributes))) {
+		return false
+	}
+	if !((v.SignalExternalWorkflowExecutionInitiatedEventAttributes == nil && rhs.SignalExternalWorkflowExecutionInitiatedEventAttributes == nil) || (v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil && rhs.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil && v.SignalExternalWorkflowExecutionInitiatedEventAttributes.Equals(rhs.SignalExternalWorkflowExecutionInitiatedEventAttributes))) {
+		return false
+	}
+	if !((v.SignalExternalWorkflowExecutionFailedEventAttributes == nil && rhs.SignalExternalWorkflowExecutionFailedEventAttributes == nil) || (v.SignalExternalWorkflowExecutionFailedEventAttributes != nil && rhs.SignalExternalWorkflowExecutionFailedEventAttributes != nil && v.SignalExternalWorkflowExecutionFailedEventAttributes.Equals(rhs.SignalExternalWorkflowExecutionFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ExternalWorkflowExecutionSignaledEventAttributes == nil && rhs.ExternalWorkflowExecutionSignaledEventAttributes == nil) || (v.ExternalWorkflowExecutionSignaledEventAttributes != nil && rhs.ExternalWorkflowExecutionSignaledEventAttributes != nil && v.ExternalWorkflowExecutionSignaledEventAttributes.Equals(rhs.ExternalWorkflowExecutionSignaledEventAttributes))) {
+		return false
+	}
+	if !((v.UpsertWorkflowSearchAttributesEventAttributes == nil && rhs.UpsertWorkflowSearchAttributesEventAttributes == nil) || (v.UpsertWorkflowSearchAttributesEventAttributes != nil && rhs.UpsertWorkflowSearchAttributesEventAttributes != nil && v.UpsertWorkflowSearchAttributesEventAttributes.Equals(rhs.UpsertWorkflowSearchAttributesEventAttributes))) {
+		return false
+	}
+
+	return true
+}
+
+// GetEventId returns the value of EventId if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetEventId() (o int64) {
+	if v.EventId != nil {
+		return *v.EventId
+	}
+
+	return
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetTimestamp() (o int64) {
+	if v.Timestamp != nil {
+		return *v.Timestamp
 	}
-	if v.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionInitiatedEventAttributes: %v", v.StartChildWorkflowExecutionInitiatedEventAttributes)
-		i++
+
+	return
+}
+
+// GetEventType returns the value of EventType if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetEventType() (o EventType) {
+	if v.EventType != nil {
+		return *v.EventType
 	}
-	if v.StartChildWorkflowExecutionFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionFailedEventAttributes: %v", v.StartChildWorkflowExecutionFailedEventAttributes)
-		i++
+
+	return
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *HistoryEvent) GetVersion() (o int64) {
+	if v.Version != nil {
+		return *v.Version
 	}
-	if v.ChildWorkflowExecutionStartedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionStartedEventAttributes: %v", v.ChildWorkflowExecutionStartedEventAttributes)
-		i++
+
+	return
+}
+
+type HistoryEventFilterType int32
+
+const (
+	HistoryEventFilterTypeAllEvent   HistoryEventFilterType = 0
+	HistoryEventFilterTypeCloseEvent HistoryEventFilterType = 1
+)
+
+// HistoryEventFilterType_Values returns all recognized values of HistoryEventFilterType.
+func HistoryEventFilterType_Values() []HistoryEventFilterType {
+	return []HistoryEventFilterType{
+		HistoryEventFilterTypeAllEvent,
+		HistoryEventFilterTypeCloseEvent,
 	}
-	if v.ChildWorkflowExecutionCompletedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionCompletedEventAttributes: %v", v.ChildWorkflowExecutionCompletedEventAttributes)
-		i++
+}
+
+// UnmarshalText tries to decode HistoryEventFilterType from a byte slice
+// containing its name.
+//
+//   var v HistoryEventFilterType
+//   err := v.UnmarshalText([]byte("ALL_EVENT"))
+func (v *HistoryEventFilterType) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "ALL_EVENT":
+		*v = HistoryEventFilterTypeAllEvent
+		return nil
+	case "CLOSE_EVENT":
+		*v = HistoryEventFilterTypeCloseEvent
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "HistoryEventFilterType")
 	}
-	if v.ChildWorkflowExecutionFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionFailedEventAttributes: %v", v.ChildWorkflowExecutionFailedEventAttributes)
-		i++
+}
+
+// Ptr returns a pointer to this enum value.
+func (v HistoryEventFilterType) Ptr() *HistoryEventFilterType {
+	return &v
+}
+
+// ToWire translates HistoryEventFilterType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v HistoryEventFilterType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes HistoryEventFilterType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return HistoryEventFilterType(0), err
+//   }
+//
+//   var v HistoryEventFilterType
+//   if err := v.FromWire(x); err != nil {
+//     return HistoryEventFilterType(0), err
+//   }
+//   return v, nil
+func (v *HistoryEventFilterType) FromWire(w wire.Value) error {
+	*v = (HistoryEventFilterType)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of HistoryEventFilterType.
+func (v HistoryEventFilterType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ALL_EVENT"
+	case 1:
+		return "CLOSE_EVENT"
 	}
-	if v.ChildWorkflowExecutionCanceledEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionCanceledEventAttributes: %v", v.ChildWorkflowExecutionCanceledEventAttributes)
-		i++
+	return fmt.Sprintf("HistoryEventFilterType(%d)", w)
+}
+
+// Equals returns true if this HistoryEventFilterType value matches the provided
+// value.
+func (v HistoryEventFilterType) Equals(rhs HistoryEventFilterType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes HistoryEventFilterType into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v HistoryEventFilterType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ALL_EVENT\""), nil
+	case 1:
+		return ([]byte)("\"CLOSE_EVENT\""), nil
 	}
-	if v.ChildWorkflowExecutionTimedOutEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionTimedOutEventAttributes: %v", v.ChildWorkflowExecutionTimedOutEventAttributes)
-		i++
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode HistoryEventFilterType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *HistoryEventFilterType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
 	}
-	if v.ChildWorkflowExecutionTerminatedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionTerminatedEventAttributes: %v", v.ChildWorkflowExecutionTerminatedEventAttributes)
-		i++
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "HistoryEventFilterType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "HistoryEventFilterType")
+		}
+		*v = (HistoryEventFilterType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "HistoryEventFilterType")
 	}
-	if v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("SignalExternalWorkflowExecutionInitiatedEventAttributes: %v", v.SignalExternalWorkflowExecutionInitiatedEventAttributes)
-		i++
+}
+
+type InternalServiceError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a InternalServiceError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *InternalServiceError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
-	if v.SignalExternalWorkflowExecutionFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("SignalExternalWorkflowExecutionFailedEventAttributes: %v", v.SignalExternalWorkflowExecutionFailedEventAttributes)
-		i++
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a InternalServiceError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a InternalServiceError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v InternalServiceError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *InternalServiceError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
 	}
-	if v.ExternalWorkflowExecutionSignaledEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ExternalWorkflowExecutionSignaledEventAttributes: %v", v.ExternalWorkflowExecutionSignaledEventAttributes)
-		i++
+
+	if !messageIsSet {
+		return errors.New("field Message of InternalServiceError is required")
 	}
 
-	return fmt.Sprintf("HistoryEvent{%v}", strings.Join(fields[:i], ", "))
+	return nil
 }
 
-func _EventType_EqualsPtr(lhs, rhs *EventType) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+// String returns a readable string representation of a InternalServiceError
+// struct.
+func (v *InternalServiceError) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	return lhs == nil && rhs == nil
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("InternalServiceError{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this HistoryEvent match the
-// provided HistoryEvent.
+// Equals returns true if all the fields of this InternalServiceError match the
+// provided InternalServiceError.
 //
 // This function performs a deep comparison.
-func (v *HistoryEvent) Equals(rhs *HistoryEvent) bool {
-	if !_I64_EqualsPtr(v.EventId, rhs.EventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
-		return false
-	}
-	if !_EventType_EqualsPtr(v.EventType, rhs.EventType) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
-		return false
-	}
-	if !((v.WorkflowExecutionStartedEventAttributes == nil && rhs.WorkflowExecutionStartedEventAttributes == nil) || (v.WorkflowExecutionStartedEventAttributes != nil && rhs.WorkflowExecutionStartedEventAttributes != nil && v.WorkflowExecutionStartedEventAttributes.Equals(rhs.WorkflowExecutionStartedEventAttributes))) {
-		return false
-	}
-	if !((v.WorkflowExecutionCompletedEventAttributes == nil && rhs.WorkflowExecutionCompletedEventAttributes == nil) || (v.WorkflowExecutionCompletedEventAttributes != nil && rhs.WorkflowExecutionCompletedEventAttributes != nil && v.WorkflowExecutionCompletedEventAttributes.Equals(rhs.WorkflowExecutionCompletedEventAttributes))) {
-		return false
-	}
-	if !((v.WorkflowExecutionFailedEventAttributes == nil && rhs.WorkflowExecutionFailedEventAttributes == nil) || (v.WorkflowExecutionFailedEventAttributes != nil && rhs.WorkflowExecutionFailedEventAttributes != nil && v.WorkflowExecutionFailedEventAttributes.Equals(rhs.WorkflowExecutionFailedEventAttributes))) {
-		return false
-	}
-	if !((v.WorkflowExecutionTimedOutEventAttributes == nil && rhs.WorkflowExecutionTimedOutEventAttributes == nil) || (v.WorkflowExecutionTimedOutEventAttributes != nil && rhs.WorkflowExecutionTimedOutEventAttributes != nil && v.WorkflowExecutionTimedOutEventAttributes.Equals(rhs.WorkflowExecutionTimedOutEventAttributes))) {
-		return false
-	}
-	if !((v.DecisionTaskScheduledEventAttributes == nil && rhs.DecisionTaskScheduledEventAttributes == nil) || (v.DecisionTaskScheduledEventAttributes != nil && rhs.DecisionTaskScheduledEventAttributes != nil && v.DecisionTaskScheduledEventAttributes.Equals(rhs.DecisionTaskScheduledEventAttributes))) {
-		return false
-	}
-	if !((v.DecisionTaskStartedEventAttributes == nil && rhs.DecisionTaskStartedEventAttributes == nil) || (v.DecisionTaskStartedEventAttributes != nil && rhs.DecisionTaskStartedEventAttributes != nil && v.DecisionTaskStartedEventAttributes.Equals(rhs.DecisionTaskStartedEventAttributes))) {
-		return false
-	}
-	if !((v.DecisionTaskCompletedEventAttributes == nil && rhs.DecisionTaskCompletedEventAttributes == nil) || (v.DecisionTaskCompletedEventAttributes != nil && rhs.DecisionTaskCompletedEventAttributes != nil && v.DecisionTaskCompletedEventAttributes.Equals(rhs.DecisionTaskCompletedEventAttributes))) {
-		return false
-	}
-	if !((v.DecisionTaskTimedOutEventAttributes == nil && rhs.DecisionTaskTimedOutEventAttributes == nil) || (v.DecisionTaskTimedOutEventAttributes != nil && rhs.DecisionTaskTimedOutEventAttributes != nil && v.DecisionTaskTimedOutEventAttributes.Equals(rhs.DecisionTaskTimedOutEventAttributes))) {
-		return false
-	}
-	if !((v.DecisionTaskFailedEventAttributes == nil && rhs.DecisionTaskFailedEventAttributes == nil) || (v.DecisionTaskFailedEventAttributes != nil && rhs.DecisionTaskFailedEventAttributes != nil && v.DecisionTaskFailedEventAttributes.Equals(rhs.DecisionTaskFailedEventAttributes))) {
-		return false
-	}
-	if !((v.ActivityTaskScheduledEventAttributes == nil && rhs.ActivityTaskScheduledEventAttributes == nil) || (v.ActivityTaskScheduledEventAttributes != nil && rhs.ActivityTaskScheduledEventAttributes != nil && v.ActivityTaskScheduledEventAttributes.Equals(rhs.ActivityTaskScheduledEventAttributes))) {
-		return false
-	}
-	if !((v.ActivityTaskStartedEventAttributes == nil && rhs.ActivityTaskStartedEventAttributes == nil) || (v.ActivityTaskStartedEventAttributes != nil && rhs.ActivityTaskStartedEventAttributes != nil && v.ActivityTaskStartedEventAttributes.Equals(rhs.ActivityTaskStartedEventAttributes))) {
-		return false
-	}
-	if !((v.ActivityTaskCompletedEventAttributes == nil && rhs.ActivityTaskCompletedEventAttributes == nil) || (v.ActivityTaskCompletedEventAttributes != nil && rhs.ActivityTaskCompletedEventAttributes != nil && v.ActivityTaskCompletedEventAttributes.Equals(rhs.ActivityTaskCompletedEventAttributes))) {
-		return false
-	}
-	if !((v.ActivityTaskFailedEventAttributes == nil && rhs.ActivityTaskFailedEventAttributes == nil) || (v.ActivityTaskFailedEventAttributes != nil && rhs.ActivityTaskFailedEventAttributes != nil && v.ActivityTaskFailedEventAttributes.Equals(rhs.ActivityTaskFailedEventAttributes))) {
-		return false
-	}
-	if !((v.ActivityTaskTimedOutEventAttributes == nil && rhs.ActivityTaskTimedOutEventAttributes == nil) || (v.ActivityTaskTimedOutEventAttributes != nil && rhs.ActivityTaskTimedOutEventAttributes != nil && v.ActivityTaskTimedOutEventAttributes.Equals(rhs.ActivityTaskTimedOutEventAttributes))) {
-		return false
-	}
-	if !((v.TimerStartedEventAttributes == nil && rhs.TimerStartedEventAttributes == nil) || (v.TimerStartedEventAttributes != nil && rhs.TimerStartedEventAttributes != nil && v.TimerStartedEventAttributes.Equals(rhs.TimerStartedEventAttributes))) {
-		return false
-	}
-	if !((v.TimerFiredEventAttributes == nil && rhs.TimerFiredEventAttributes == nil) || (v.TimerFiredEventAttributes != nil && rhs.TimerFiredEventAttributes != nil && v.TimerFiredEventAttributes.Equals(rhs.TimerFiredEventAttributes))) {
-		return false
-	}
-	if !((v.ActivityTaskCancelRequestedEventAttributes == nil && rhs.ActivityTaskCancelRequestedEventAttributes == nil) || (v.ActivityTaskCancelRequestedEventAttributes != nil && rhs.ActivityTaskCancelRequestedEventAttributes != nil && v.ActivityTaskCancelRequestedEventAttributes.Equals(rhs.ActivityTaskCancelRequestedEventAttributes))) {
-		return false
-	}
-	if !((v.RequestCancelActivityTaskFailedEventAttributes == nil && rhs.RequestCancelActivityTaskFailedEventAttributes == nil) || (v.RequestCancelActivityTaskFailedEventAttributes != nil && rhs.RequestCancelActivityTaskFailedEventAttributes != nil && v.RequestCancelActivityTaskFailedEventAttributes.Equals(rhs.RequestCancelActivityTaskFailedEventAttributes))) {
-		return false
-	}
-	if !((v.ActivityTaskCanceledEventAttributes == nil && rhs.ActivityTaskCanceledEventAttributes == nil) || (v.ActivityTaskCanceledEventAttributes != nil && rhs.ActivityTaskCanceledEventAttributes != nil && v.ActivityTaskCanceledEventAttributes.Equals(rhs.ActivityTaskCanceledEventAttributes))) {
-		return false
-	}
-	if !((v.TimerCanceledEventAttributes == nil && rhs.TimerCanceledEventAttributes == nil) || (v.TimerCanceledEventAttributes != nil && rhs.TimerCanceledEventAttributes != nil && v.TimerCanceledEventAttributes.Equals(rhs.TimerCanceledEventAttributes))) {
-		return false
-	}
-	if !((v.CancelTimerFailedEventAttributes == nil && rhs.CancelTimerFailedEventAttributes == nil) || (v.CancelTimerFailedEventAttributes != nil && rhs.CancelTimerFailedEventAttributes != nil && v.CancelTimerFailedEventAttributes.Equals(rhs.CancelTimerFailedEventAttributes))) {
-		return false
-	}
-	if !((v.MarkerRecordedEventAttributes == nil && rhs.MarkerRecordedEventAttributes == nil) || (v.MarkerRecordedEventAttributes != nil && rhs.MarkerRecordedEventAttributes != nil && v.MarkerRecordedEventAttributes.Equals(rhs.MarkerRecordedEventAttributes))) {
-		return false
-	}
-	if !((v.WorkflowExecutionSignaledEventAttributes == nil && rhs.WorkflowExecutionSignaledEventAttributes == nil) || (v.WorkflowExecutionSignaledEventAttributes != nil && rhs.WorkflowExecutionSignaledEventAttributes != nil && v.WorkflowExecutionSignaledEventAttributes.Equals(rhs.WorkflowExecutionSignaledEventAttributes))) {
-		return false
-	}
-	if !((v.WorkflowExecutionTerminatedEventAttributes == nil && rhs.WorkflowExecutionTerminatedEventAttributes == nil) || (v.WorkflowExecutionTerminatedEventAttributes != nil && rhs.WorkflowExecutionTerminatedEventAttributes != nil && v.WorkflowExecutionTerminatedEventAttributes.Equals(rhs.WorkflowExecutionTerminatedEventAttributes))) {
-		return false
-	}
-	if !((v.WorkflowExecutionCancelRequestedEventAttributes == nil && rhs.WorkflowExecutionCancelRequestedEventAttributes == nil) || (v.WorkflowExecutionCancelRequestedEventAttributes != nil && rhs.WorkflowExecutionCancelRequestedEventAttributes != nil && v.WorkflowExecutionCancelRequestedEventAttributes.Equals(rhs.WorkflowExecutionCancelRequestedEventAttributes))) {
-		return false
-	}
-	if !((v.WorkflowExecutionCanceledEventAttributes == nil && rhs.WorkflowExecutionCanceledEventAttributes == nil) || (v.WorkflowExecutionCanceledEventAttributes != nil && rhs.WorkflowExecutionCanceledEventAttributes != nil && v.WorkflowExecutionCanceledEventAttributes.Equals(rhs.WorkflowExecutionCanceledEventAttributes))) {
-		return false
-	}
-	if !((v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes == nil && rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes == nil) || (v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil && rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil && v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Equals(rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes))) {
-		return false
-	}
-	if !((v.RequestCancelExternalWorkflowExecutionFailedEventAttributes == nil && rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes == nil) || (v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil && rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil && v.RequestCancelExternalWorkflowExecutionFailedEventAttributes.Equals(rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes))) {
-		return false
-	}
-	if !((v.ExternalWorkflowExecutionCancelRequestedEventAttributes == nil && rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes == nil) || (v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil && rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil && v.ExternalWorkflowExecutionCancelRequestedEventAttributes.Equals(rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes))) {
-		return false
-	}
-	if !((v.WorkflowExecutionContinuedAsNewEventAttributes == nil && rhs.WorkflowExecutionContinuedAsNewEventAttributes == nil) || (v.WorkflowExecutionContinuedAsNewEventAttributes != nil && rhs.WorkflowExecutionContinuedAsNewEventAttributes != nil && v.WorkflowExecutionContinuedAsNewEventAttributes.Equals(rhs.WorkflowExecutionContinuedAsNewEventAttributes))) {
-		return false
-	}
-	if !((v.StartChildWorkflowExecutionInitiatedEventAttributes == nil && rhs.StartChildWorkflowExecutionInitiatedEventAttributes == nil) || (v.StartChildWorkflowExecutionInitiatedEventAttributes != nil && rhs.StartChildWorkflowExecutionInitiatedEventAttributes != nil && v.StartChildWorkflowExecutionInitiatedEventAttributes.Equals(rhs.StartChildWorkflowExecutionInitiatedEventAttributes))) {
-		return false
-	}
-	if !((v.StartChildWorkflowExecutionFailedEventAttributes == nil && rhs.StartChildWorkflowExecutionFailedEventAttributes == nil) || (v.StartChildWorkflowExecutionFailedEventAttributes != nil && rhs.StartChildWorkflowExecutionFailedEventAttributes != nil && v.StartChildWorkflowExecutionFailedEventAttributes.Equals(rhs.StartChildWorkflowExecutionFailedEventAttributes))) {
-		return false
-	}
-	if !((v.ChildWorkflowExecutionStartedEventAttributes == nil && rhs.ChildWorkflowExecutionStartedEventAttributes == nil) || (v.ChildWorkflowExecutionStartedEventAttributes != nil && rhs.ChildWorkflowExecutionStartedEventAttributes != nil && v.ChildWorkflowExecutionStartedEventAttributes.Equals(rhs.ChildWorkflowExecutionStartedEventAttributes))) {
+func (v *InternalServiceError) Equals(rhs *InternalServiceError) bool {
+	if !(v.Message == rhs.Message) {
 		return false
 	}
-	if !((v.ChildWorkflowExecutionCompletedEventAttributes == nil && rhs.ChildWorkflowExecutionCompletedEventAttributes == nil) || (v.ChildWorkflowExecutionCompletedEventAttributes != nil && rhs.ChildWorkflowExecutionCompletedEventAttributes != nil && v.ChildWorkflowExecutionCompletedEventAttributes.Equals(rhs.ChildWorkflowExecutionCompletedEventAttributes))) {
-		return false
+
+	return true
+}
+
+func (v *InternalServiceError) Error() string {
+	return v.String()
+}
+
+type ListClosedWorkflowExecutionsRequest struct {
+	Domain                 *string                       `json:"domain,omitempty"`
+	MaximumPageSize        *int32                        `json:"maximumPageSize,omitempty"`
+	NextPageToken          []byte                        `json:"nextPageToken,omitempty"`
+	StartTimeFilter        *StartTimeFilter              `json:"StartTimeFilter,omitempty"`
+	ExecutionFilter        *WorkflowExecutionFilter      `json:"executionFilter,omitempty"`
+	TypeFilter             *WorkflowTypeFilter           `json:"typeFilter,omitempty"`
+	StatusFilter           *WorkflowExecutionCloseStatus `json:"statusFilter,omitempty"`
+	SearchAttributesFilter *SearchAttributes             `json:"searchAttributesFilter,omitempty"`
+}
+
+// ToWire translates a ListClosedWorkflowExecutionsRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListClosedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-	if !((v.ChildWorkflowExecutionFailedEventAttributes == nil && rhs.ChildWorkflowExecutionFailedEventAttributes == nil) || (v.ChildWorkflowExecutionFailedEventAttributes != nil && rhs.ChildWorkflowExecutionFailedEventAttributes != nil && v.ChildWorkflowExecutionFailedEventAttributes.Equals(rhs.ChildWorkflowExecutionFailedEventAttributes))) {
-		return false
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-	if !((v.ChildWorkflowExecutionCanceledEventAttributes == nil && rhs.ChildWorkflowExecutionCanceledEventAttributes == nil) || (v.ChildWorkflowExecutionCanceledEventAttributes != nil && rhs.ChildWorkflowExecutionCanceledEventAttributes != nil && v.ChildWorkflowExecutionCanceledEventAttributes.Equals(rhs.ChildWorkflowExecutionCanceledEventAttributes))) {
-		return false
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
-	if !((v.ChildWorkflowExecutionTimedOutEventAttributes == nil && rhs.ChildWorkflowExecutionTimedOutEventAttributes == nil) || (v.ChildWorkflowExecutionTimedOutEventAttributes != nil && rhs.ChildWorkflowExecutionTimedOutEventAttributes != nil && v.ChildWorkflowExecutionTimedOutEventAttributes.Equals(rhs.ChildWorkflowExecutionTimedOutEventAttributes))) {
-		return false
+	if v.StartTimeFilter != nil {
+		w, err = v.StartTimeFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
 	}
-	if !((v.ChildWorkflowExecutionTerminatedEventAttributes == nil && rhs.ChildWorkflowExecutionTerminatedEventAttributes == nil) || (v.ChildWorkflowExecutionTerminatedEventAttributes != nil && rhs.ChildWorkflowExecutionTerminatedEventAttributes != nil && v.ChildWorkflowExecutionTerminatedEventAttributes.Equals(rhs.ChildWorkflowExecutionTerminatedEventAttributes))) {
-		return false
+	if v.ExecutionFilter != nil {
+		w, err = v.ExecutionFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
 	}
-	if !((v.SignalExternalWorkflowExecutionInitiatedEventAttributes == nil && rhs.SignalExternalWorkflowExecutionInitiatedEventAttributes == nil) || (v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil && rhs.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil && v.SignalExternalWorkflowExecutionInitiatedEventAttributes.Equals(rhs.SignalExternalWorkflowExecutionInitiatedEventAttributes))) {
-		return false
+	if v.TypeFilter != nil {
+		w, err = v.TypeFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
 	}
-	if !((v.SignalExternalWorkflowExecutionFailedEventAttributes == nil && rhs.SignalExternalWorkflowExecutionFailedEventAttributes == nil) || (v.SignalExternalWorkflowExecutionFailedEventAttributes != nil && rhs.SignalExternalWorkflowExecutionFailedEventAttributes != nil && v.SignalExternalWorkflowExecutionFailedEventAttributes.Equals(rhs.SignalExternalWorkflowExecutionFailedEventAttributes))) {
-		return false
+	if v.StatusFilter != nil {
+		w, err = v.StatusFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
 	}
-	if !((v.ExternalWorkflowExecutionSignaledEventAttributes == nil && rhs.ExternalWorkflowExecutionSignaledEventAttributes == nil) || (v.ExternalWorkflowExecutionSignaledEventAttributes != nil && rhs.ExternalWorkflowExecutionSignaledEventAttributes != nil && v.ExternalWorkflowExecutionSignaledEventAttributes.Equals(rhs.ExternalWorkflowExecutionSignaledEventAttributes))) {
-		return false
+	if v.SearchAttributesFilter != nil {
+		w, err = v.SearchAttributesFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
 	}
 
-	return true
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _StartTimeFilter_Read(w wire.Value) (*StartTimeFilter, error) {
+	var v StartTimeFilter
+	err := v.FromWire(w)
+	return &v, err
 }
 
-// GetEventId returns the value of EventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryEvent) GetEventId() (o int64) {
-	if v.EventId != nil {
-		return *v.EventId
-	}
+func _WorkflowExecutionFilter_Read(w wire.Value) (*WorkflowExecutionFilter, error) {
+	var v WorkflowExecutionFilter
+	err := v.FromWire(w)
+	return &v, err
+}
 
-	return
+func _WorkflowTypeFilter_Read(w wire.Value) (*WorkflowTypeFilter, error) {
+	var v WorkflowTypeFilter
+	err := v.FromWire(w)
+	return &v, err
 }
 
-// GetTimestamp returns the value of Timestamp if it is set or its
-// zero value if it is unset.
-func (v *HistoryEvent) GetTimestamp() (o int64) {
-	if v.Timestamp != nil {
-		return *v.Timestamp
-	}
+// FromWire deserializes a ListClosedWorkflowExecutionsRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListClosedWorkflowExecutionsRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListClosedWorkflowExecutionsRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+	var err error
 
-	return
-}
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
 
-// GetEventType returns the value of EventType if it is set or its
-// zero value if it is unset.
-func (v *HistoryEvent) GetEventType() (o EventType) {
-	if v.EventType != nil {
-		return *v.EventType
-	}
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
 
-	return
-}
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
 
-// GetVersion returns the value of Version if it is set or its
-// zero value if it is unset.
-func (v *HistoryEvent) GetVersion() (o int64) {
-	if v.Version != nil {
-		return *v.Version
-	}
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.StartTimeFilter, err = _StartTimeFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-	return
-}
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.ExecutionFilter, err = _WorkflowExecutionFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-type HistoryEventFilterType int32
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.TypeFilter, err = _WorkflowTypeFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-const (
-	HistoryEventFilterTypeAllEvent   HistoryEventFilterType = 0
-	HistoryEventFilterTypeCloseEvent HistoryEventFilterType = 1
-)
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowExecutionCloseStatus
+				x, err = _WorkflowExecutionCloseStatus_Read(field.Value)
+				v.StatusFilter = &x
+				if err != nil {
+					return err
+				}
 
-// HistoryEventFilterType_Values returns all recognized values of HistoryEventFilterType.
-func HistoryEventFilterType_Values() []HistoryEventFilterType {
-	return []HistoryEventFilterType{
-		HistoryEventFilterTypeAllEvent,
-		HistoryEventFilterTypeCloseEvent,
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributesFilter, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
+
+	return nil
 }
 
-// UnmarshalText tries to decode HistoryEventFilterType from a byte slice
-// containing its name.
-//
-//   var v HistoryEventFilterType
-//   err := v.UnmarshalText([]byte("ALL_EVENT"))
-func (v *HistoryEventFilterType) UnmarshalText(value []byte) error {
-	switch string(value) {
-	case "ALL_EVENT":
-		*v = HistoryEventFilterTypeAllEvent
-		return nil
-	case "CLOSE_EVENT":
-		*v = HistoryEventFilterTypeCloseEvent
-		return nil
-	default:
-		return fmt.Errorf("unknown enum value %q for %q", value, "HistoryEventFilterType")
+// String returns a readable string representation of a ListClosedWorkflowExecutionsRequest
+// struct.
+func (v *ListClosedWorkflowExecutionsRequest) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-}
 
-// Ptr returns a pointer to this enum value.
-func (v HistoryEventFilterType) Ptr() *HistoryEventFilterType {
-	return &v
-}
+	var fields [8]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.StartTimeFilter != nil {
+		fields[i] = fmt.Sprintf("StartTimeFilter: %v", v.StartTimeFilter)
+		i++
+	}
+	if v.ExecutionFilter != nil {
+		fields[i] = fmt.Sprintf("ExecutionFilter: %v", v.ExecutionFilter)
+		i++
+	}
+	if v.TypeFilter != nil {
+		fields[i] = fmt.Sprintf("TypeFilter: %v", v.TypeFilter)
+		i++
+	}
+	if v.StatusFilter != nil {
+		fields[i] = fmt.Sprintf("StatusFilter: %v", *(v.StatusFilter))
+		i++
+	}
+	if v.SearchAttributesFilter != nil {
+		fields[i] = fmt.Sprintf("SearchAttributesFilter: %v", v.SearchAttributesFilter)
+		i++
+	}
 
-// ToWire translates HistoryEventFilterType into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v HistoryEventFilterType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
+	return fmt.Sprintf("ListClosedWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// FromWire deserializes HistoryEventFilterType from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return HistoryEventFilterType(0), err
-//   }
+// Equals returns true if all the fields of this ListClosedWorkflowExecutionsRequest match the
+// provided ListClosedWorkflowExecutionsRequest.
 //
-//   var v HistoryEventFilterType
-//   if err := v.FromWire(x); err != nil {
-//     return HistoryEventFilterType(0), err
-//   }
-//   return v, nil
-func (v *HistoryEventFilterType) FromWire(w wire.Value) error {
-	*v = (HistoryEventFilterType)(w.GetI32())
-	return nil
-}
-
-// String returns a readable string representation of HistoryEventFilterType.
-func (v HistoryEventFilterType) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "ALL_EVENT"
-	case 1:
-		return "CLOSE_EVENT"
+// This function performs a deep comparison.
+func (v *ListClosedWorkflowExecutionsRequest) Equals(rhs *ListClosedWorkflowExecutionsRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !((v.StartTimeFilter == nil && rhs.StartTimeFilter == nil) || (v.StartTimeFilter != nil && rhs.StartTimeFilter != nil && v.StartTimeFilter.Equals(rhs.StartTimeFilter))) {
+		return false
+	}
+	if !((v.ExecutionFilter == nil && rhs.ExecutionFilter == nil) || (v.ExecutionFilter != nil && rhs.ExecutionFilter != nil && v.ExecutionFilter.Equals(rhs.ExecutionFilter))) {
+		return false
+	}
+	if !((v.TypeFilter == nil && rhs.TypeFilter == nil) || (v.TypeFilter != nil && rhs.TypeFilter != nil && v.TypeFilter.Equals(rhs.TypeFilter))) {
+		return false
+	}
+	if !_WorkflowExecutionCloseStatus_EqualsPtr(v.StatusFilter, rhs.StatusFilter) {
+		return false
 	}
-	return fmt.Sprintf("HistoryEventFilterType(%d)", w)
+	if !((v.SearchAttributesFilter == nil && rhs.SearchAttributesFilter == nil) || (v.SearchAttributesFilter != nil && rhs.SearchAttributesFilter != nil && v.SearchAttributesFilter.Equals(rhs.SearchAttributesFilter))) {
+		return false
+	}
+
+	return true
 }
 
-// Equals returns true if this HistoryEventFilterType value matches the provided
-// value.
-func (v HistoryEventFilterType) Equals(rhs HistoryEventFilterType) bool {
-	return v == rhs
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ListClosedWorkflowExecutionsRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
 }
 
-// MarshalJSON serializes HistoryEventFilterType into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v HistoryEventFilterType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"ALL_EVENT\""), nil
-	case 1:
-		return ([]byte)("\"CLOSE_EVENT\""), nil
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *ListClosedWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
+	if v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
 	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+
+	return
 }
 
-// UnmarshalJSON attempts to decode HistoryEventFilterType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *HistoryEventFilterType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
+// GetStatusFilter returns the value of StatusFilter if it is set or its
+// zero value if it is unset.
+func (v *ListClosedWorkflowExecutionsRequest) GetStatusFilter() (o WorkflowExecutionCloseStatus) {
+	if v.StatusFilter != nil {
+		return *v.StatusFilter
 	}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
+	return
+}
+
+type ListClosedWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
+	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
+}
+
+type _List_WorkflowExecutionInfo_ValueList []*WorkflowExecutionInfo
+
+func (v _List_WorkflowExecutionInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
 		if err != nil {
 			return err
 		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "HistoryEventFilterType")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "HistoryEventFilterType")
+		err = f(w)
+		if err != nil {
+			return err
 		}
-		*v = (HistoryEventFilterType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "HistoryEventFilterType")
 	}
+	return nil
 }
 
-type InternalServiceError struct {
-	Message string `json:"message,required"`
+func (v _List_WorkflowExecutionInfo_ValueList) Size() int {
+	return len(v)
 }
 
-// ToWire translates a InternalServiceError struct into a Thrift-level intermediate
+func (_List_WorkflowExecutionInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_WorkflowExecutionInfo_ValueList) Close() {}
+
+// ToWire translates a ListClosedWorkflowExecutionsResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -12576,29 +15373,57 @@ type InternalServiceError struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *InternalServiceError) ToWire() (wire.Value, error) {
+func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.Executions != nil {
+		w, err = wire.NewValueList(_List_WorkflowExecutionInfo_ValueList(v.Executions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a InternalServiceError struct from its Thrift-level
+func _List_WorkflowExecutionInfo_Read(l wire.ValueList) ([]*WorkflowExecutionInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*WorkflowExecutionInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkflowExecutionInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ListClosedWorkflowExecutionsResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a InternalServiceError struct
+// An error is returned if we were unable to build a ListClosedWorkflowExecutionsResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -12606,78 +15431,100 @@ func (v *InternalServiceError) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v InternalServiceError
+//   var v ListClosedWorkflowExecutionsResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *InternalServiceError) FromWire(w wire.Value) error {
+func (v *ListClosedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	var err error
 
-	messageIsSet := false
-
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Executions, err = _List_WorkflowExecutionInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
+
 			}
 		}
 	}
 
-	if !messageIsSet {
-		return errors.New("field Message of InternalServiceError is required")
-	}
-
 	return nil
 }
 
-// String returns a readable string representation of a InternalServiceError
+// String returns a readable string representation of a ListClosedWorkflowExecutionsResponse
 // struct.
-func (v *InternalServiceError) String() string {
+func (v *ListClosedWorkflowExecutionsResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
+	if v.Executions != nil {
+		fields[i] = fmt.Sprintf("Executions: %v", v.Executions)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
 
-	return fmt.Sprintf("InternalServiceError{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ListClosedWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this InternalServiceError match the
-// provided InternalServiceError.
-//
-// This function performs a deep comparison.
-func (v *InternalServiceError) Equals(rhs *InternalServiceError) bool {
-	if !(v.Message == rhs.Message) {
+func _List_WorkflowExecutionInfo_Equals(lhs, rhs []*WorkflowExecutionInfo) bool {
+	if len(lhs) != len(rhs) {
 		return false
 	}
 
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
 	return true
 }
 
-func (v *InternalServiceError) Error() string {
-	return v.String()
+// Equals returns true if all the fields of this ListClosedWorkflowExecutionsResponse match the
+// provided ListClosedWorkflowExecutionsResponse.
+//
+// This function performs a deep comparison.
+func (v *ListClosedWorkflowExecutionsResponse) Equals(rhs *ListClosedWorkflowExecutionsResponse) bool {
+	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
 }
 
-type ListClosedWorkflowExecutionsRequest struct {
-	Domain          *string                       `json:"domain,omitempty"`
-	MaximumPageSize *int32                        `json:"maximumPageSize,omitempty"`
-	NextPageToken   []byte                        `json:"nextPageToken,omitempty"`
-	StartTimeFilter *StartTimeFilter              `json:"StartTimeFilter,omitempty"`
-	ExecutionFilter *WorkflowExecutionFilter      `json:"executionFilter,omitempty"`
-	TypeFilter      *WorkflowTypeFilter           `json:"typeFilter,omitempty"`
-	StatusFilter    *WorkflowExecutionCloseStatus `json:"statusFilter,omitempty"`
+type ListOpenWorkflowExecutionsRequest struct {
+	Domain                 *string                  `json:"domain,omitempty"`
+	MaximumPageSize        *int32                   `json:"maximumPageSize,omitempty"`
+	NextPageToken          []byte                   `json:"nextPageToken,omitempty"`
+	StartTimeFilter        *StartTimeFilter         `json:"StartTimeFilter,omitempty"`
+	ExecutionFilter        *WorkflowExecutionFilter `json:"executionFilter,omitempty"`
+	TypeFilter             *WorkflowTypeFilter      `json:"typeFilter,omitempty"`
+	SearchAttributesFilter *SearchAttributes        `json:"searchAttributesFilter,omitempty"`
 }
 
-// ToWire translates a ListClosedWorkflowExecutionsRequest struct into a Thrift-level intermediate
+// ToWire translates a ListOpenWorkflowExecutionsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -12692,7 +15539,7 @@ type ListClosedWorkflowExecutionsRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ListClosedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+func (v *ListOpenWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [7]wire.Field
 		i      int = 0
@@ -12748,8 +15595,8 @@ func (v *ListClosedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.StatusFilter != nil {
-		w, err = v.StatusFilter.ToWire()
+	if v.SearchAttributesFilter != nil {
+		w, err = v.SearchAttributesFilter.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -12760,35 +15607,11 @@ func (v *ListClosedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _StartTimeFilter_Read(w wire.Value) (*StartTimeFilter, error) {
-	var v StartTimeFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionFilter_Read(w wire.Value) (*WorkflowExecutionFilter, error) {
-	var v WorkflowExecutionFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowTypeFilter_Read(w wire.Value) (*WorkflowTypeFilter, error) {
-	var v WorkflowTypeFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionCloseStatus_Read(w wire.Value) (WorkflowExecutionCloseStatus, error) {
-	var v WorkflowExecutionCloseStatus
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a ListClosedWorkflowExecutionsRequest struct from its Thrift-level
+// FromWire deserializes a ListOpenWorkflowExecutionsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListClosedWorkflowExecutionsRequest struct
+// An error is returned if we were unable to build a ListOpenWorkflowExecutionsRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -12796,12 +15619,12 @@ func _WorkflowExecutionCloseStatus_Read(w wire.Value) (WorkflowExecutionCloseSta
 //     return nil, err
 //   }
 //
-//   var v ListClosedWorkflowExecutionsRequest
+//   var v ListOpenWorkflowExecutionsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+func (v *ListOpenWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -12859,10 +15682,8 @@ func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 
 			}
 		case 70:
-			if field.Value.Type() == wire.TI32 {
-				var x WorkflowExecutionCloseStatus
-				x, err = _WorkflowExecutionCloseStatus_Read(field.Value)
-				v.StatusFilter = &x
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributesFilter, err = _SearchAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -12874,9 +15695,9 @@ func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ListClosedWorkflowExecutionsRequest
+// String returns a readable string representation of a ListOpenWorkflowExecutionsRequest
 // struct.
-func (v *ListClosedWorkflowExecutionsRequest) String() string {
+func (v *ListOpenWorkflowExecutionsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -12907,29 +15728,19 @@ func (v *ListClosedWorkflowExecutionsRequest) String() string {
 		fields[i] = fmt.Sprintf("TypeFilter: %v", v.TypeFilter)
 		i++
 	}
-	if v.StatusFilter != nil {
-		fields[i] = fmt.Sprintf("StatusFilter: %v", *(v.StatusFilter))
+	if v.SearchAttributesFilter != nil {
+		fields[i] = fmt.Sprintf("SearchAttributesFilter: %v", v.SearchAttributesFilter)
 		i++
 	}
 
-	return fmt.Sprintf("ListClosedWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _WorkflowExecutionCloseStatus_EqualsPtr(lhs, rhs *WorkflowExecutionCloseStatus) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("ListOpenWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ListClosedWorkflowExecutionsRequest match the
-// provided ListClosedWorkflowExecutionsRequest.
+// Equals returns true if all the fields of this ListOpenWorkflowExecutionsRequest match the
+// provided ListOpenWorkflowExecutionsRequest.
 //
 // This function performs a deep comparison.
-func (v *ListClosedWorkflowExecutionsRequest) Equals(rhs *ListClosedWorkflowExecutionsRequest) bool {
+func (v *ListOpenWorkflowExecutionsRequest) Equals(rhs *ListOpenWorkflowExecutionsRequest) bool {
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
@@ -12948,7 +15759,7 @@ func (v *ListClosedWorkflowExecutionsRequest) Equals(rhs *ListClosedWorkflowExec
 	if !((v.TypeFilter == nil && rhs.TypeFilter == nil) || (v.TypeFilter != nil && rhs.TypeFilter != nil && v.TypeFilter.Equals(rhs.TypeFilter))) {
 		return false
 	}
-	if !_WorkflowExecutionCloseStatus_EqualsPtr(v.StatusFilter, rhs.StatusFilter) {
+	if !((v.SearchAttributesFilter == nil && rhs.SearchAttributesFilter == nil) || (v.SearchAttributesFilter != nil && rhs.SearchAttributesFilter != nil && v.SearchAttributesFilter.Equals(rhs.SearchAttributesFilter))) {
 		return false
 	}
 
@@ -12957,7 +15768,7 @@ func (v *ListClosedWorkflowExecutionsRequest) Equals(rhs *ListClosedWorkflowExec
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsRequest) GetDomain() (o string) {
+func (v *ListOpenWorkflowExecutionsRequest) GetDomain() (o string) {
 	if v.Domain != nil {
 		return *v.Domain
 	}
@@ -12967,7 +15778,7 @@ func (v *ListClosedWorkflowExecutionsRequest) GetDomain() (o string) {
 
 // GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
 // zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
+func (v *ListOpenWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
 	if v.MaximumPageSize != nil {
 		return *v.MaximumPageSize
 	}
@@ -12975,51 +15786,12 @@ func (v *ListClosedWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
 	return
 }
 
-// GetStatusFilter returns the value of StatusFilter if it is set or its
-// zero value if it is unset.
-func (v *ListClosedWorkflowExecutionsRequest) GetStatusFilter() (o WorkflowExecutionCloseStatus) {
-	if v.StatusFilter != nil {
-		return *v.StatusFilter
-	}
-
-	return
-}
-
-type ListClosedWorkflowExecutionsResponse struct {
+type ListOpenWorkflowExecutionsResponse struct {
 	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
 	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
 }
 
-type _List_WorkflowExecutionInfo_ValueList []*WorkflowExecutionInfo
-
-func (v _List_WorkflowExecutionInfo_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_WorkflowExecutionInfo_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_WorkflowExecutionInfo_ValueList) ValueType() wire.Type {
-	return wire.TStruct
-}
-
-func (_List_WorkflowExecutionInfo_ValueList) Close() {}
-
-// ToWire translates a ListClosedWorkflowExecutionsResponse struct into a Thrift-level intermediate
+// ToWire translates a ListOpenWorkflowExecutionsResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -13034,7 +15806,7 @@ func (_List_WorkflowExecutionInfo_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+func (v *ListOpenWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -13062,29 +15834,11 @@ func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_WorkflowExecutionInfo_Read(l wire.ValueList) ([]*WorkflowExecutionInfo, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make([]*WorkflowExecutionInfo, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _WorkflowExecutionInfo_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a ListClosedWorkflowExecutionsResponse struct from its Thrift-level
+// FromWire deserializes a ListOpenWorkflowExecutionsResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListClosedWorkflowExecutionsResponse struct
+// An error is returned if we were unable to build a ListOpenWorkflowExecutionsResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -13092,12 +15846,12 @@ func _List_WorkflowExecutionInfo_Read(l wire.ValueList) ([]*WorkflowExecutionInf
 //     return nil, err
 //   }
 //
-//   var v ListClosedWorkflowExecutionsResponse
+//   var v ListOpenWorkflowExecutionsResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ListClosedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+func (v *ListOpenWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -13124,9 +15878,9 @@ func (v *ListClosedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ListClosedWorkflowExecutionsResponse
+// String returns a readable string representation of a ListOpenWorkflowExecutionsResponse
 // struct.
-func (v *ListClosedWorkflowExecutionsResponse) String() string {
+func (v *ListOpenWorkflowExecutionsResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -13142,29 +15896,14 @@ func (v *ListClosedWorkflowExecutionsResponse) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("ListClosedWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_WorkflowExecutionInfo_Equals(lhs, rhs []*WorkflowExecutionInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
+	return fmt.Sprintf("ListOpenWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ListClosedWorkflowExecutionsResponse match the
-// provided ListClosedWorkflowExecutionsResponse.
+// Equals returns true if all the fields of this ListOpenWorkflowExecutionsResponse match the
+// provided ListOpenWorkflowExecutionsResponse.
 //
 // This function performs a deep comparison.
-func (v *ListClosedWorkflowExecutionsResponse) Equals(rhs *ListClosedWorkflowExecutionsResponse) bool {
+func (v *ListOpenWorkflowExecutionsResponse) Equals(rhs *ListOpenWorkflowExecutionsResponse) bool {
 	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
 		return false
 	}
@@ -13175,16 +15914,14 @@ func (v *ListClosedWorkflowExecutionsResponse) Equals(rhs *ListClosedWorkflowExe
 	return true
 }
 
-type ListOpenWorkflowExecutionsRequest struct {
-	Domain          *string                  `json:"domain,omitempty"`
-	MaximumPageSize *int32                   `json:"maximumPageSize,omitempty"`
-	NextPageToken   []byte                   `json:"nextPageToken,omitempty"`
-	StartTimeFilter *StartTimeFilter         `json:"StartTimeFilter,omitempty"`
-	ExecutionFilter *WorkflowExecutionFilter `json:"executionFilter,omitempty"`
-	TypeFilter      *WorkflowTypeFilter      `json:"typeFilter,omitempty"`
+type ListWorkflowExecutionsRequest struct {
+	Domain        *string `json:"domain,omitempty"`
+	PageSize      *int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte  `json:"nextPageToken,omitempty"`
+	Query         *string `json:"query,omitempty"`
 }
 
-// ToWire translates a ListOpenWorkflowExecutionsRequest struct into a Thrift-level intermediate
+// ToWire translates a ListWorkflowExecutionsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -13199,9 +15936,9 @@ type ListOpenWorkflowExecutionsRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ListOpenWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+func (v *ListWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -13215,8 +15952,8 @@ func (v *ListOpenWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+	if v.PageSize != nil {
+		w, err = wire.NewValueI32(*(v.PageSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -13231,39 +15968,23 @@ func (v *ListOpenWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.StartTimeFilter != nil {
-		w, err = v.StartTimeFilter.ToWire()
+	if v.Query != nil {
+		w, err = wire.NewValueString(*(v.Query)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.ExecutionFilter != nil {
-		w, err = v.ExecutionFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.TypeFilter != nil {
-		w, err = v.TypeFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ListOpenWorkflowExecutionsRequest struct from its Thrift-level
+// FromWire deserializes a ListWorkflowExecutionsRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListOpenWorkflowExecutionsRequest struct
+// An error is returned if we were unable to build a ListWorkflowExecutionsRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -13271,12 +15992,12 @@ func (v *ListOpenWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ListOpenWorkflowExecutionsRequest
+//   var v ListWorkflowExecutionsRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ListOpenWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+func (v *ListWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -13295,7 +16016,7 @@ func (v *ListOpenWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumPageSize = &x
+				v.PageSize = &x
 				if err != nil {
 					return err
 				}
@@ -13310,24 +16031,10 @@ func (v *ListOpenWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.StartTimeFilter, err = _StartTimeFilter_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.ExecutionFilter, err = _WorkflowExecutionFilter_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.TypeFilter, err = _WorkflowTypeFilter_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Query = &x
 				if err != nil {
 					return err
 				}
@@ -13339,64 +16046,50 @@ func (v *ListOpenWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ListOpenWorkflowExecutionsRequest
+// String returns a readable string representation of a ListWorkflowExecutionsRequest
 // struct.
-func (v *ListOpenWorkflowExecutionsRequest) String() string {
+func (v *ListWorkflowExecutionsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [4]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+	if v.PageSize != nil {
+		fields[i] = fmt.Sprintf("PageSize: %v", *(v.PageSize))
 		i++
 	}
 	if v.NextPageToken != nil {
 		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
-	if v.StartTimeFilter != nil {
-		fields[i] = fmt.Sprintf("StartTimeFilter: %v", v.StartTimeFilter)
-		i++
-	}
-	if v.ExecutionFilter != nil {
-		fields[i] = fmt.Sprintf("ExecutionFilter: %v", v.ExecutionFilter)
-		i++
-	}
-	if v.TypeFilter != nil {
-		fields[i] = fmt.Sprintf("TypeFilter: %v", v.TypeFilter)
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", *(v.Query))
 		i++
 	}
 
-	return fmt.Sprintf("ListOpenWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ListWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ListOpenWorkflowExecutionsRequest match the
-// provided ListOpenWorkflowExecutionsRequest.
+// Equals returns true if all the fields of this ListWorkflowExecutionsRequest match the
+// provided ListWorkflowExecutionsRequest.
 //
 // This function performs a deep comparison.
-func (v *ListOpenWorkflowExecutionsRequest) Equals(rhs *ListOpenWorkflowExecutionsRequest) bool {
+func (v *ListWorkflowExecutionsRequest) Equals(rhs *ListWorkflowExecutionsRequest) bool {
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+	if !_I32_EqualsPtr(v.PageSize, rhs.PageSize) {
 		return false
 	}
 	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
-	if !((v.StartTimeFilter == nil && rhs.StartTimeFilter == nil) || (v.StartTimeFilter != nil && rhs.StartTimeFilter != nil && v.StartTimeFilter.Equals(rhs.StartTimeFilter))) {
-		return false
-	}
-	if !((v.ExecutionFilter == nil && rhs.ExecutionFilter == nil) || (v.ExecutionFilter != nil && rhs.ExecutionFilter != nil && v.ExecutionFilter.Equals(rhs.ExecutionFilter))) {
-		return false
-	}
-	if !((v.TypeFilter == nil && rhs.TypeFilter == nil) || (v.TypeFilter != nil && rhs.TypeFilter != nil && v.TypeFilter.Equals(rhs.TypeFilter))) {
+	if !_String_EqualsPtr(v.Query, rhs.Query) {
 		return false
 	}
 
@@ -13405,7 +16098,7 @@ func (v *ListOpenWorkflowExecutionsRequest) Equals(rhs *ListOpenWorkflowExecutio
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *ListOpenWorkflowExecutionsRequest) GetDomain() (o string) {
+func (v *ListWorkflowExecutionsRequest) GetDomain() (o string) {
 	if v.Domain != nil {
 		return *v.Domain
 	}
@@ -13413,22 +16106,32 @@ func (v *ListOpenWorkflowExecutionsRequest) GetDomain() (o string) {
 	return
 }
 
-// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// GetPageSize returns the value of PageSize if it is set or its
 // zero value if it is unset.
-func (v *ListOpenWorkflowExecutionsRequest) GetMaximumPageSize() (o int32) {
-	if v.MaximumPageSize != nil {
-		return *v.MaximumPageSize
+func (v *ListWorkflowExecutionsRequest) GetPageSize() (o int32) {
+	if v.PageSize != nil {
+		return *v.PageSize
 	}
 
 	return
 }
 
-type ListOpenWorkflowExecutionsResponse struct {
+// GetQuery returns the value of Query if it is set or its
+// zero value if it is unset.
+func (v *ListWorkflowExecutionsRequest) GetQuery() (o string) {
+	if v.Query != nil {
+		return *v.Query
+	}
+
+	return
+}
+
+type ListWorkflowExecutionsResponse struct {
 	Executions    []*WorkflowExecutionInfo `json:"executions,omitempty"`
 	NextPageToken []byte                   `json:"nextPageToken,omitempty"`
 }
 
-// ToWire translates a ListOpenWorkflowExecutionsResponse struct into a Thrift-level intermediate
+// ToWire translates a ListWorkflowExecutionsResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -13443,7 +16146,7 @@ type ListOpenWorkflowExecutionsResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ListOpenWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+func (v *ListWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -13471,11 +16174,11 @@ func (v *ListOpenWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ListOpenWorkflowExecutionsResponse struct from its Thrift-level
+// FromWire deserializes a ListWorkflowExecutionsResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListOpenWorkflowExecutionsResponse struct
+// An error is returned if we were unable to build a ListWorkflowExecutionsResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -13483,12 +16186,12 @@ func (v *ListOpenWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ListOpenWorkflowExecutionsResponse
+//   var v ListWorkflowExecutionsResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ListOpenWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+func (v *ListWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -13515,9 +16218,9 @@ func (v *ListOpenWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ListOpenWorkflowExecutionsResponse
+// String returns a readable string representation of a ListWorkflowExecutionsResponse
 // struct.
-func (v *ListOpenWorkflowExecutionsResponse) String() string {
+func (v *ListWorkflowExecutionsResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -13533,14 +16236,14 @@ func (v *ListOpenWorkflowExecutionsResponse) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("ListOpenWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ListWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ListOpenWorkflowExecutionsResponse match the
-// provided ListOpenWorkflowExecutionsResponse.
+// Equals returns true if all the fields of this ListWorkflowExecutionsResponse match the
+// provided ListWorkflowExecutionsResponse.
 //
 // This function performs a deep comparison.
-func (v *ListOpenWorkflowExecutionsResponse) Equals(rhs *ListOpenWorkflowExecutionsResponse) bool {
+func (v *ListWorkflowExecutionsResponse) Equals(rhs *ListWorkflowExecutionsResponse) bool {
 	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
 		return false
 	}
@@ -16720,6 +19423,8 @@ type RegisterDomainRequest struct {
 	EmitMetric                             *bool                              `json:"emitMetric,omitempty"`
 	Clusters                               []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
 	ActiveClusterName                      *string                            `json:"activeClusterName,omitempty"`
+	ArchivalBucketName                     *string                            `json:"archivalBucketName,omitempty"`
+	ArchivalStatus                         *ArchivalStatus                    `json:"archivalStatus,omitempty"`
 }
 
 // ToWire translates a RegisterDomainRequest struct into a Thrift-level intermediate
@@ -16739,7 +19444,7 @@ type RegisterDomainRequest struct {
 //   }
 func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -16793,12 +19498,28 @@ func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.ActiveClusterName != nil {
-		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
+	if v.ActiveClusterName != nil {
+		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.ArchivalBucketName != nil {
+		w, err = wire.NewValueString(*(v.ArchivalBucketName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.ArchivalStatus != nil {
+		w, err = v.ArchivalStatus.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
+		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
 
@@ -16894,6 +19615,26 @@ func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ArchivalBucketName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI32 {
+				var x ArchivalStatus
+				x, err = _ArchivalStatus_Read(field.Value)
+				v.ArchivalStatus = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -16908,7 +19649,7 @@ func (v *RegisterDomainRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [9]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -16938,6 +19679,14 @@ func (v *RegisterDomainRequest) String() string {
 		fields[i] = fmt.Sprintf("ActiveClusterName: %v", *(v.ActiveClusterName))
 		i++
 	}
+	if v.ArchivalBucketName != nil {
+		fields[i] = fmt.Sprintf("ArchivalBucketName: %v", *(v.ArchivalBucketName))
+		i++
+	}
+	if v.ArchivalStatus != nil {
+		fields[i] = fmt.Sprintf("ArchivalStatus: %v", *(v.ArchivalStatus))
+		i++
+	}
 
 	return fmt.Sprintf("RegisterDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -16968,6 +19717,12 @@ func (v *RegisterDomainRequest) Equals(rhs *RegisterDomainRequest) bool {
 	if !_String_EqualsPtr(v.ActiveClusterName, rhs.ActiveClusterName) {
 		return false
 	}
+	if !_String_EqualsPtr(v.ArchivalBucketName, rhs.ArchivalBucketName) {
+		return false
+	}
+	if !_ArchivalStatus_EqualsPtr(v.ArchivalStatus, rhs.ArchivalStatus) {
+		return false
+	}
 
 	return true
 }
@@ -17032,6 +19787,26 @@ func (v *RegisterDomainRequest) GetActiveClusterName() (o string) {
 	return
 }
 
+// GetArchivalBucketName returns the value of ArchivalBucketName if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetArchivalBucketName() (o string) {
+	if v.ArchivalBucketName != nil {
+		return *v.ArchivalBucketName
+	}
+
+	return
+}
+
+// GetArchivalStatus returns the value of ArchivalStatus if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetArchivalStatus() (o ArchivalStatus) {
+	if v.ArchivalStatus != nil {
+		return *v.ArchivalStatus
+	}
+
+	return
+}
+
 type RequestCancelActivityTaskDecisionAttributes struct {
 	ActivityId *string `json:"activityId,omitempty"`
 }
@@ -18051,71 +20826,285 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String(
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionInitiatedEventAttributes match the
+// provided RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
+//
+// This function performs a deep comparison.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) bool {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
+		return false
+	}
+
+	return true
+}
+
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
+	}
+
+	return
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() (o bool) {
+	if v.ChildWorkflowOnly != nil {
+		return *v.ChildWorkflowOnly
+	}
+
+	return
+}
+
+type RequestCancelWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
+	RequestId         *string            `json:"requestId,omitempty"`
+}
+
+// ToWire translates a RequestCancelWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RequestCancelWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RequestCancelWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RequestCancelWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RequestCancelWorkflowExecutionRequest
+// struct.
+func (v *RequestCancelWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
+
+	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionInitiatedEventAttributes match the
-// provided RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
+// Equals returns true if all the fields of this RequestCancelWorkflowExecutionRequest match the
+// provided RequestCancelWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) bool {
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
-		return false
-	}
+func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
 	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
 
 	return true
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+func (v *RequestCancelWorkflowExecutionRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
-	if v.Domain != nil {
-		return *v.Domain
+func (v *RequestCancelWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v.Identity != nil {
+		return *v.Identity
 	}
 
 	return
 }
 
-// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// GetRequestId returns the value of RequestId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() (o bool) {
-	if v.ChildWorkflowOnly != nil {
-		return *v.ChildWorkflowOnly
+func (v *RequestCancelWorkflowExecutionRequest) GetRequestId() (o string) {
+	if v.RequestId != nil {
+		return *v.RequestId
 	}
 
 	return
 }
 
-type RequestCancelWorkflowExecutionRequest struct {
-	Domain            *string            `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Identity          *string            `json:"identity,omitempty"`
-	RequestId         *string            `json:"requestId,omitempty"`
+type ResetWorkflowExecutionRequest struct {
+	Domain                *string            `json:"domain,omitempty"`
+	WorkflowExecution     *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason                *string            `json:"reason,omitempty"`
+	DecisionFinishEventId *int64             `json:"decisionFinishEventId,omitempty"`
+	RequestId             *string            `json:"requestId,omitempty"`
+	Identity              *string            `json:"identity,omitempty"`
 }
 
-// ToWire translates a RequestCancelWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a ResetWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -18130,9 +21119,9 @@ type RequestCancelWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -18154,31 +21143,47 @@ func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.DecisionFinishEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionFinishEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 	if v.RequestId != nil {
 		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RequestCancelWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a ResetWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a ResetWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -18186,12 +21191,12 @@ func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RequestCancelWorkflowExecutionRequest
+//   var v ResetWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -18218,13 +21223,23 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.Reason = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionFinishEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -18233,6 +21248,16 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -18240,14 +21265,14 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelWorkflowExecutionRequest
+// String returns a readable string representation of a ResetWorkflowExecutionRequest
 // struct.
-func (v *RequestCancelWorkflowExecutionRequest) String() string {
+func (v *ResetWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -18257,42 +21282,56 @@ func (v *RequestCancelWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.DecisionFinishEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionFinishEventId: %v", *(v.DecisionFinishEventId))
 		i++
 	}
 	if v.RequestId != nil {
 		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
 		i++
 	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
 
-	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelWorkflowExecutionRequest match the
-// provided RequestCancelWorkflowExecutionRequest.
+// Equals returns true if all the fields of this ResetWorkflowExecutionRequest match the
+// provided ResetWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
+func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionRequest) bool {
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
 	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionFinishEventId, rhs.DecisionFinishEventId) {
 		return false
 	}
 	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
 
 	return true
 }
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetDomain() (o string) {
+func (v *ResetWorkflowExecutionRequest) GetDomain() (o string) {
 	if v.Domain != nil {
 		return *v.Domain
 	}
@@ -18300,11 +21339,21 @@ func (v *RequestCancelWorkflowExecutionRequest) GetDomain() (o string) {
 	return
 }
 
-// GetIdentity returns the value of Identity if it is set or its
+// GetReason returns the value of Reason if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetIdentity() (o string) {
-	if v.Identity != nil {
-		return *v.Identity
+func (v *ResetWorkflowExecutionRequest) GetReason() (o string) {
+	if v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// GetDecisionFinishEventId returns the value of DecisionFinishEventId if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionRequest) GetDecisionFinishEventId() (o int64) {
+	if v.DecisionFinishEventId != nil {
+		return *v.DecisionFinishEventId
 	}
 
 	return
@@ -18312,7 +21361,7 @@ func (v *RequestCancelWorkflowExecutionRequest) GetIdentity() (o string) {
 
 // GetRequestId returns the value of RequestId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetRequestId() (o string) {
+func (v *ResetWorkflowExecutionRequest) GetRequestId() (o string) {
 	if v.RequestId != nil {
 		return *v.RequestId
 	}
@@ -18320,6 +21369,132 @@ func (v *RequestCancelWorkflowExecutionRequest) GetRequestId() (o string) {
 	return
 }
 
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+type ResetWorkflowExecutionResponse struct {
+	RunId *string `json:"runId,omitempty"`
+}
+
+// ToWire translates a ResetWorkflowExecutionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResetWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResetWorkflowExecutionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResetWorkflowExecutionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResetWorkflowExecutionResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResetWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResetWorkflowExecutionResponse
+// struct.
+func (v *ResetWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+
+	return fmt.Sprintf("ResetWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResetWorkflowExecutionResponse match the
+// provided ResetWorkflowExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *ResetWorkflowExecutionResponse) Equals(rhs *ResetWorkflowExecutionResponse) bool {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+
+	return true
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *ResetWorkflowExecutionResponse) GetRunId() (o string) {
+	if v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
 type RespondActivityTaskCanceledByIDRequest struct {
 	Domain     *string `json:"domain,omitempty"`
 	WorkflowID *string `json:"workflowID,omitempty"`
@@ -20439,11 +23614,12 @@ func (v *RespondQueryTaskCompletedRequest) GetErrorMessage() (o string) {
 }
 
 type RetryPolicy struct {
-	InitialIntervalInSeconds *int32   `json:"initialIntervalInSeconds,omitempty"`
-	BackoffCoefficient       *float64 `json:"backoffCoefficient,omitempty"`
-	MaximumIntervalInSeconds *int32   `json:"maximumIntervalInSeconds,omitempty"`
-	MaximumAttempts          *int32   `json:"maximumAttempts,omitempty"`
-	NonRetriableErrorReasons []string `json:"nonRetriableErrorReasons,omitempty"`
+	InitialIntervalInSeconds    *int32   `json:"initialIntervalInSeconds,omitempty"`
+	BackoffCoefficient          *float64 `json:"backoffCoefficient,omitempty"`
+	MaximumIntervalInSeconds    *int32   `json:"maximumIntervalInSeconds,omitempty"`
+	MaximumAttempts             *int32   `json:"maximumAttempts,omitempty"`
+	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons,omitempty"`
+	ExpirationIntervalInSeconds *int32   `json:"expirationIntervalInSeconds,omitempty"`
 }
 
 type _List_String_ValueList []string
@@ -20489,7 +23665,7 @@ func (_List_String_ValueList) Close() {}
 //   }
 func (v *RetryPolicy) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -20535,6 +23711,14 @@ func (v *RetryPolicy) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.ExpirationIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ExpirationIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -20626,6 +23810,16 @@ func (v *RetryPolicy) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExpirationIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -20640,7 +23834,7 @@ func (v *RetryPolicy) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.InitialIntervalInSeconds != nil {
 		fields[i] = fmt.Sprintf("InitialIntervalInSeconds: %v", *(v.InitialIntervalInSeconds))
@@ -20662,6 +23856,10 @@ func (v *RetryPolicy) String() string {
 		fields[i] = fmt.Sprintf("NonRetriableErrorReasons: %v", v.NonRetriableErrorReasons)
 		i++
 	}
+	if v.ExpirationIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("ExpirationIntervalInSeconds: %v", *(v.ExpirationIntervalInSeconds))
+		i++
+	}
 
 	return fmt.Sprintf("RetryPolicy{%v}", strings.Join(fields[:i], ", "))
 }
@@ -20711,6 +23909,9 @@ func (v *RetryPolicy) Equals(rhs *RetryPolicy) bool {
 	if !((v.NonRetriableErrorReasons == nil && rhs.NonRetriableErrorReasons == nil) || (v.NonRetriableErrorReasons != nil && rhs.NonRetriableErrorReasons != nil && _List_String_Equals(v.NonRetriableErrorReasons, rhs.NonRetriableErrorReasons))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.ExpirationIntervalInSeconds, rhs.ExpirationIntervalInSeconds) {
+		return false
+	}
 
 	return true
 }
@@ -20755,6 +23956,16 @@ func (v *RetryPolicy) GetMaximumAttempts() (o int32) {
 	return
 }
 
+// GetExpirationIntervalInSeconds returns the value of ExpirationIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *RetryPolicy) GetExpirationIntervalInSeconds() (o int32) {
+	if v.ExpirationIntervalInSeconds != nil {
+		return *v.ExpirationIntervalInSeconds
+	}
+
+	return
+}
+
 type ScheduleActivityTaskDecisionAttributes struct {
 	ActivityId                    *string       `json:"activityId,omitempty"`
 	ActivityType                  *ActivityType `json:"activityType,omitempty"`
@@ -21147,6 +24358,193 @@ func (v *ScheduleActivityTaskDecisionAttributes) GetHeartbeatTimeoutSeconds() (o
 	return
 }
 
+type SearchAttributes struct {
+	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
+}
+
+type _Map_String_Binary_MapItemList map[string][]byte
+
+func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueBinary(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Binary_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) Close() {}
+
+// ToWire translates a SearchAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SearchAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.IndexedFields != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.IndexedFields)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string][]byte, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a SearchAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SearchAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SearchAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SearchAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.IndexedFields, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SearchAttributes
+// struct.
+func (v *SearchAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.IndexedFields != nil {
+		fields[i] = fmt.Sprintf("IndexedFields: %v", v.IndexedFields)
+		i++
+	}
+
+	return fmt.Sprintf("SearchAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this SearchAttributes match the
+// provided SearchAttributes.
+//
+// This function performs a deep comparison.
+func (v *SearchAttributes) Equals(rhs *SearchAttributes) bool {
+	if !((v.IndexedFields == nil && rhs.IndexedFields == nil) || (v.IndexedFields != nil && rhs.IndexedFields != nil && _Map_String_Binary_Equals(v.IndexedFields, rhs.IndexedFields))) {
+		return false
+	}
+
+	return true
+}
+
 type ServiceBusyError struct {
 	Message string `json:"message,required"`
 }
@@ -22241,6 +25639,9 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	SignalName                          *string                `json:"signalName,omitempty"`
 	SignalInput                         []byte                 `json:"signalInput,omitempty"`
 	Control                             []byte                 `json:"control,omitempty"`
+	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
+	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
+	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 }
 
 // ToWire translates a SignalWithStartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -22260,7 +25661,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 //   }
 func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [13]wire.Field
+		fields [16]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -22370,6 +25771,30 @@ func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 130, Value: w}
 		i++
 	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.CronSchedule != nil {
+		w, err = wire.NewValueString(*(v.CronSchedule)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -22521,6 +25946,32 @@ func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 140:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 150:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.CronSchedule = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 160:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -22535,7 +25986,7 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [13]string
+	var fields [16]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -22589,6 +26040,18 @@ func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("Control: %v", v.Control)
 		i++
 	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+	if v.CronSchedule != nil {
+		fields[i] = fmt.Sprintf("CronSchedule: %v", *(v.CronSchedule))
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -22647,6 +26110,15 @@ func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWor
 	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
 		return false
 	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.CronSchedule, rhs.CronSchedule) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -22731,6 +26203,16 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetSignalName() (o string) {
 	return
 }
 
+// GetCronSchedule returns the value of CronSchedule if it is set or its
+// zero value if it is unset.
+func (v *SignalWithStartWorkflowExecutionRequest) GetCronSchedule() (o string) {
+	if v.CronSchedule != nil {
+		return *v.CronSchedule
+	}
+
+	return
+}
+
 type SignalWorkflowExecutionRequest struct {
 	Domain            *string            `json:"domain,omitempty"`
 	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -23038,6 +26520,8 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	ChildPolicy                         *ChildPolicy           `json:"childPolicy,omitempty"`
 	Control                             []byte                 `json:"control,omitempty"`
 	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
+	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 }
 
 // ToWire translates a StartChildWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
@@ -23057,7 +26541,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 //   }
 func (v *StartChildWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [10]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -23143,6 +26627,22 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 100, Value: w}
 		i++
 	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -23257,11 +26757,27 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) e
 				}
 
 			}
-		case 100:
-			if field.Value.Type() == wire.TI32 {
-				var x WorkflowIdReusePolicy
-				x, err = _WorkflowIdReusePolicy_Read(field.Value)
-				v.WorkflowIdReusePolicy = &x
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowIdReusePolicy
+				x, err = _WorkflowIdReusePolicy_Read(field.Value)
+				v.WorkflowIdReusePolicy = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -23280,7 +26796,7 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [10]string
+	var fields [12]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -23322,6 +26838,14 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("WorkflowIdReusePolicy: %v", *(v.WorkflowIdReusePolicy))
 		i++
 	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("StartChildWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -23371,6 +26895,12 @@ func (v *StartChildWorkflowExecutionDecisionAttributes) Equals(rhs *StartChildWo
 	if !_WorkflowIdReusePolicy_EqualsPtr(v.WorkflowIdReusePolicy, rhs.WorkflowIdReusePolicy) {
 		return false
 	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -23771,6 +27301,8 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	Control                             []byte                 `json:"control,omitempty"`
 	DecisionTaskCompletedEventId        *int64                 `json:"decisionTaskCompletedEventId,omitempty"`
 	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
+	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 }
 
 // ToWire translates a StartChildWorkflowExecutionInitiatedEventAttributes struct into a Thrift-level intermediate
@@ -23790,7 +27322,7 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 //   }
 func (v *StartChildWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [13]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -23884,6 +27416,22 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Val
 		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -24011,6 +27559,22 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) FromWire(w wire.Va
 					return err
 				}
 
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -24025,7 +27589,7 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [13]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -24071,6 +27635,14 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("WorkflowIdReusePolicy: %v", *(v.WorkflowIdReusePolicy))
 		i++
 	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("StartChildWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -24113,6 +27685,12 @@ func (v *StartChildWorkflowExecutionInitiatedEventAttributes) Equals(rhs *StartC
 	if !_WorkflowIdReusePolicy_EqualsPtr(v.WorkflowIdReusePolicy, rhs.WorkflowIdReusePolicy) {
 		return false
 	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -24503,6 +28081,9 @@ type StartWorkflowExecutionRequest struct {
 	RequestId                           *string                `json:"requestId,omitempty"`
 	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
 	ChildPolicy                         *ChildPolicy           `json:"childPolicy,omitempty"`
+	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
+	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
+	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 }
 
 // ToWire translates a StartWorkflowExecutionRequest struct into a Thrift-level intermediate
@@ -24522,7 +28103,7 @@ type StartWorkflowExecutionRequest struct {
 //   }
 func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -24616,6 +28197,30 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.CronSchedule != nil {
+		w, err = wire.NewValueString(*(v.CronSchedule)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -24745,6 +28350,32 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.CronSchedule = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -24759,7 +28390,7 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [14]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -24805,6 +28436,18 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("ChildPolicy: %v", *(v.ChildPolicy))
 		i++
 	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+	if v.CronSchedule != nil {
+		fields[i] = fmt.Sprintf("CronSchedule: %v", *(v.CronSchedule))
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -24847,6 +28490,15 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 	if !_ChildPolicy_EqualsPtr(v.ChildPolicy, rhs.ChildPolicy) {
 		return false
 	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.CronSchedule, rhs.CronSchedule) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -24931,6 +28583,16 @@ func (v *StartWorkflowExecutionRequest) GetChildPolicy() (o ChildPolicy) {
 	return
 }
 
+// GetCronSchedule returns the value of CronSchedule if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionRequest) GetCronSchedule() (o string) {
+	if v.CronSchedule != nil {
+		return *v.CronSchedule
+	}
+
+	return
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId *string `json:"runId,omitempty"`
 }
@@ -25008,51 +28670,191 @@ func (v *StartWorkflowExecutionResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a StartWorkflowExecutionResponse
+// String returns a readable string representation of a StartWorkflowExecutionResponse
+// struct.
+func (v *StartWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+
+	return fmt.Sprintf("StartWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this StartWorkflowExecutionResponse match the
+// provided StartWorkflowExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *StartWorkflowExecutionResponse) Equals(rhs *StartWorkflowExecutionResponse) bool {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+
+	return true
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *StartWorkflowExecutionResponse) GetRunId() (o string) {
+	if v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+type StickyExecutionAttributes struct {
+	WorkerTaskList                *TaskList `json:"workerTaskList,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32    `json:"scheduleToStartTimeoutSeconds,omitempty"`
+}
+
+// ToWire translates a StickyExecutionAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StickyExecutionAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.WorkerTaskList != nil {
+		w, err = v.WorkerTaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a StickyExecutionAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StickyExecutionAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StickyExecutionAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StickyExecutionAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkerTaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToStartTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a StickyExecutionAttributes
 // struct.
-func (v *StartWorkflowExecutionResponse) String() string {
+func (v *StickyExecutionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+	if v.WorkerTaskList != nil {
+		fields[i] = fmt.Sprintf("WorkerTaskList: %v", v.WorkerTaskList)
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
 		i++
 	}
 
-	return fmt.Sprintf("StartWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("StickyExecutionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this StartWorkflowExecutionResponse match the
-// provided StartWorkflowExecutionResponse.
+// Equals returns true if all the fields of this StickyExecutionAttributes match the
+// provided StickyExecutionAttributes.
 //
 // This function performs a deep comparison.
-func (v *StartWorkflowExecutionResponse) Equals(rhs *StartWorkflowExecutionResponse) bool {
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+func (v *StickyExecutionAttributes) Equals(rhs *StickyExecutionAttributes) bool {
+	if !((v.WorkerTaskList == nil && rhs.WorkerTaskList == nil) || (v.WorkerTaskList != nil && rhs.WorkerTaskList != nil && v.WorkerTaskList.Equals(rhs.WorkerTaskList))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
 		return false
 	}
 
 	return true
 }
 
-// GetRunId returns the value of RunId if it is set or its
+// GetScheduleToStartTimeoutSeconds returns the value of ScheduleToStartTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *StartWorkflowExecutionResponse) GetRunId() (o string) {
-	if v.RunId != nil {
-		return *v.RunId
+func (v *StickyExecutionAttributes) GetScheduleToStartTimeoutSeconds() (o int32) {
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		return *v.ScheduleToStartTimeoutSeconds
 	}
 
 	return
 }
 
-type StickyExecutionAttributes struct {
-	WorkerTaskList                *TaskList `json:"workerTaskList,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32    `json:"scheduleToStartTimeoutSeconds,omitempty"`
+type TaskList struct {
+	Name *string       `json:"name,omitempty"`
+	Kind *TaskListKind `json:"kind,omitempty"`
 }
 
-// ToWire translates a StickyExecutionAttributes struct into a Thrift-level intermediate
+// ToWire translates a TaskList struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -25067,7 +28869,7 @@ type StickyExecutionAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *StickyExecutionAttributes) ToWire() (wire.Value, error) {
+func (v *TaskList) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -25075,16 +28877,16 @@ func (v *StickyExecutionAttributes) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.WorkerTaskList != nil {
-		w, err = v.WorkerTaskList.ToWire()
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeoutSeconds)), error(nil)
+	if v.Kind != nil {
+		w, err = v.Kind.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -25095,11 +28897,17 @@ func (v *StickyExecutionAttributes) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a StickyExecutionAttributes struct from its Thrift-level
+func _TaskListKind_Read(w wire.Value) (TaskListKind, error) {
+	var v TaskListKind
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a TaskList struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a StickyExecutionAttributes struct
+// An error is returned if we were unable to build a TaskList struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25107,19 +28915,21 @@ func (v *StickyExecutionAttributes) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v StickyExecutionAttributes
+//   var v TaskList
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *StickyExecutionAttributes) FromWire(w wire.Value) error {
+func (v *TaskList) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkerTaskList, err = _TaskList_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
 				if err != nil {
 					return err
 				}
@@ -25127,9 +28937,9 @@ func (v *StickyExecutionAttributes) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ScheduleToStartTimeoutSeconds = &x
+				var x TaskListKind
+				x, err = _TaskListKind_Read(field.Value)
+				v.Kind = &x
 				if err != nil {
 					return err
 				}
@@ -25141,58 +28951,212 @@ func (v *StickyExecutionAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a StickyExecutionAttributes
+// String returns a readable string representation of a TaskList
 // struct.
-func (v *StickyExecutionAttributes) String() string {
+func (v *TaskList) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.WorkerTaskList != nil {
-		fields[i] = fmt.Sprintf("WorkerTaskList: %v", v.WorkerTaskList)
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
 		i++
 	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+	if v.Kind != nil {
+		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
 		i++
 	}
 
-	return fmt.Sprintf("StickyExecutionAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TaskList{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this StickyExecutionAttributes match the
-// provided StickyExecutionAttributes.
+func _TaskListKind_EqualsPtr(lhs, rhs *TaskListKind) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this TaskList match the
+// provided TaskList.
 //
 // This function performs a deep comparison.
-func (v *StickyExecutionAttributes) Equals(rhs *StickyExecutionAttributes) bool {
-	if !((v.WorkerTaskList == nil && rhs.WorkerTaskList == nil) || (v.WorkerTaskList != nil && rhs.WorkerTaskList != nil && v.WorkerTaskList.Equals(rhs.WorkerTaskList))) {
+func (v *TaskList) Equals(rhs *TaskList) bool {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+	if !_TaskListKind_EqualsPtr(v.Kind, rhs.Kind) {
 		return false
 	}
 
 	return true
 }
 
-// GetScheduleToStartTimeoutSeconds returns the value of ScheduleToStartTimeoutSeconds if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *StickyExecutionAttributes) GetScheduleToStartTimeoutSeconds() (o int32) {
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		return *v.ScheduleToStartTimeoutSeconds
+func (v *TaskList) GetName() (o string) {
+	if v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// GetKind returns the value of Kind if it is set or its
+// zero value if it is unset.
+func (v *TaskList) GetKind() (o TaskListKind) {
+	if v.Kind != nil {
+		return *v.Kind
+	}
+
+	return
+}
+
+type TaskListKind int32
+
+const (
+	TaskListKindNormal TaskListKind = 0
+	TaskListKindSticky TaskListKind = 1
+)
+
+// TaskListKind_Values returns all recognized values of TaskListKind.
+func TaskListKind_Values() []TaskListKind {
+	return []TaskListKind{
+		TaskListKindNormal,
+		TaskListKindSticky,
+	}
+}
+
+// UnmarshalText tries to decode TaskListKind from a byte slice
+// containing its name.
+//
+//   var v TaskListKind
+//   err := v.UnmarshalText([]byte("NORMAL"))
+func (v *TaskListKind) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "NORMAL":
+		*v = TaskListKindNormal
+		return nil
+	case "STICKY":
+		*v = TaskListKindSticky
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "TaskListKind")
+	}
+}
+
+// Ptr returns a pointer to this enum value.
+func (v TaskListKind) Ptr() *TaskListKind {
+	return &v
+}
+
+// ToWire translates TaskListKind into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v TaskListKind) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes TaskListKind from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return TaskListKind(0), err
+//   }
+//
+//   var v TaskListKind
+//   if err := v.FromWire(x); err != nil {
+//     return TaskListKind(0), err
+//   }
+//   return v, nil
+func (v *TaskListKind) FromWire(w wire.Value) error {
+	*v = (TaskListKind)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of TaskListKind.
+func (v TaskListKind) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "NORMAL"
+	case 1:
+		return "STICKY"
+	}
+	return fmt.Sprintf("TaskListKind(%d)", w)
+}
+
+// Equals returns true if this TaskListKind value matches the provided
+// value.
+func (v TaskListKind) Equals(rhs TaskListKind) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes TaskListKind into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v TaskListKind) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"NORMAL\""), nil
+	case 1:
+		return ([]byte)("\"STICKY\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode TaskListKind from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *TaskListKind) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
 	}
 
-	return
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskListKind")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskListKind")
+		}
+		*v = (TaskListKind)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskListKind")
+	}
 }
 
-type TaskList struct {
-	Name *string       `json:"name,omitempty"`
-	Kind *TaskListKind `json:"kind,omitempty"`
+type TaskListMetadata struct {
+	MaxTasksPerSecond *float64 `json:"maxTasksPerSecond,omitempty"`
 }
 
-// ToWire translates a TaskList struct into a Thrift-level intermediate
+// ToWire translates a TaskListMetadata struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -25207,45 +29171,31 @@ type TaskList struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TaskList) ToWire() (wire.Value, error) {
+func (v *TaskListMetadata) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.MaxTasksPerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.MaxTasksPerSecond)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Kind != nil {
-		w, err = v.Kind.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _TaskListKind_Read(w wire.Value) (TaskListKind, error) {
-	var v TaskListKind
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a TaskList struct from its Thrift-level
+// FromWire deserializes a TaskListMetadata struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TaskList struct
+// An error is returned if we were unable to build a TaskListMetadata struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25253,31 +29203,21 @@ func _TaskListKind_Read(w wire.Value) (TaskListKind, error) {
 //     return nil, err
 //   }
 //
-//   var v TaskList
+//   var v TaskListMetadata
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TaskList) FromWire(w wire.Value) error {
+func (v *TaskListMetadata) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x TaskListKind
-				x, err = _TaskListKind_Read(field.Value)
-				v.Kind = &x
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.MaxTasksPerSecond = &x
 				if err != nil {
 					return err
 				}
@@ -25289,179 +29229,152 @@ func (v *TaskList) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a TaskList
+// String returns a readable string representation of a TaskListMetadata
 // struct.
-func (v *TaskList) String() string {
+func (v *TaskListMetadata) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
-		i++
-	}
-	if v.Kind != nil {
-		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
+	if v.MaxTasksPerSecond != nil {
+		fields[i] = fmt.Sprintf("MaxTasksPerSecond: %v", *(v.MaxTasksPerSecond))
 		i++
 	}
 
-	return fmt.Sprintf("TaskList{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _TaskListKind_EqualsPtr(lhs, rhs *TaskListKind) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("TaskListMetadata{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TaskList match the
-// provided TaskList.
+// Equals returns true if all the fields of this TaskListMetadata match the
+// provided TaskListMetadata.
 //
 // This function performs a deep comparison.
-func (v *TaskList) Equals(rhs *TaskList) bool {
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
-		return false
-	}
-	if !_TaskListKind_EqualsPtr(v.Kind, rhs.Kind) {
+func (v *TaskListMetadata) Equals(rhs *TaskListMetadata) bool {
+	if !_Double_EqualsPtr(v.MaxTasksPerSecond, rhs.MaxTasksPerSecond) {
 		return false
 	}
 
 	return true
 }
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *TaskList) GetName() (o string) {
-	if v.Name != nil {
-		return *v.Name
-	}
-
-	return
-}
-
-// GetKind returns the value of Kind if it is set or its
+// GetMaxTasksPerSecond returns the value of MaxTasksPerSecond if it is set or its
 // zero value if it is unset.
-func (v *TaskList) GetKind() (o TaskListKind) {
-	if v.Kind != nil {
-		return *v.Kind
+func (v *TaskListMetadata) GetMaxTasksPerSecond() (o float64) {
+	if v.MaxTasksPerSecond != nil {
+		return *v.MaxTasksPerSecond
 	}
 
 	return
 }
 
-type TaskListKind int32
+type TaskListType int32
 
 const (
-	TaskListKindNormal TaskListKind = 0
-	TaskListKindSticky TaskListKind = 1
+	TaskListTypeDecision TaskListType = 0
+	TaskListTypeActivity TaskListType = 1
 )
 
-// TaskListKind_Values returns all recognized values of TaskListKind.
-func TaskListKind_Values() []TaskListKind {
-	return []TaskListKind{
-		TaskListKindNormal,
-		TaskListKindSticky,
+// TaskListType_Values returns all recognized values of TaskListType.
+func TaskListType_Values() []TaskListType {
+	return []TaskListType{
+		TaskListTypeDecision,
+		TaskListTypeActivity,
 	}
 }
 
-// UnmarshalText tries to decode TaskListKind from a byte slice
+// UnmarshalText tries to decode TaskListType from a byte slice
 // containing its name.
 //
-//   var v TaskListKind
-//   err := v.UnmarshalText([]byte("NORMAL"))
-func (v *TaskListKind) UnmarshalText(value []byte) error {
+//   var v TaskListType
+//   err := v.UnmarshalText([]byte("Decision"))
+func (v *TaskListType) UnmarshalText(value []byte) error {
 	switch string(value) {
-	case "NORMAL":
-		*v = TaskListKindNormal
+	case "Decision":
+		*v = TaskListTypeDecision
 		return nil
-	case "STICKY":
-		*v = TaskListKindSticky
+	case "Activity":
+		*v = TaskListTypeActivity
 		return nil
 	default:
-		return fmt.Errorf("unknown enum value %q for %q", value, "TaskListKind")
+		return fmt.Errorf("unknown enum value %q for %q", value, "TaskListType")
 	}
 }
 
 // Ptr returns a pointer to this enum value.
-func (v TaskListKind) Ptr() *TaskListKind {
+func (v TaskListType) Ptr() *TaskListType {
 	return &v
 }
 
-// ToWire translates TaskListKind into a Thrift-level intermediate
+// ToWire translates TaskListType into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // Enums are represented as 32-bit integers over the wire.
-func (v TaskListKind) ToWire() (wire.Value, error) {
+func (v TaskListType) ToWire() (wire.Value, error) {
 	return wire.NewValueI32(int32(v)), nil
 }
 
-// FromWire deserializes TaskListKind from its Thrift-level
+// FromWire deserializes TaskListType from its Thrift-level
 // representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return TaskListKind(0), err
+//     return TaskListType(0), err
 //   }
 //
-//   var v TaskListKind
+//   var v TaskListType
 //   if err := v.FromWire(x); err != nil {
-//     return TaskListKind(0), err
+//     return TaskListType(0), err
 //   }
 //   return v, nil
-func (v *TaskListKind) FromWire(w wire.Value) error {
-	*v = (TaskListKind)(w.GetI32())
+func (v *TaskListType) FromWire(w wire.Value) error {
+	*v = (TaskListType)(w.GetI32())
 	return nil
 }
 
-// String returns a readable string representation of TaskListKind.
-func (v TaskListKind) String() string {
+// String returns a readable string representation of TaskListType.
+func (v TaskListType) String() string {
 	w := int32(v)
 	switch w {
 	case 0:
-		return "NORMAL"
+		return "Decision"
 	case 1:
-		return "STICKY"
+		return "Activity"
 	}
-	return fmt.Sprintf("TaskListKind(%d)", w)
+	return fmt.Sprintf("TaskListType(%d)", w)
 }
 
-// Equals returns true if this TaskListKind value matches the provided
+// Equals returns true if this TaskListType value matches the provided
 // value.
-func (v TaskListKind) Equals(rhs TaskListKind) bool {
+func (v TaskListType) Equals(rhs TaskListType) bool {
 	return v == rhs
 }
 
-// MarshalJSON serializes TaskListKind into JSON.
+// MarshalJSON serializes TaskListType into JSON.
 //
 // If the enum value is recognized, its name is returned. Otherwise,
 // its integer value is returned.
 //
 // This implements json.Marshaler.
-func (v TaskListKind) MarshalJSON() ([]byte, error) {
+func (v TaskListType) MarshalJSON() ([]byte, error) {
 	switch int32(v) {
 	case 0:
-		return ([]byte)("\"NORMAL\""), nil
+		return ([]byte)("\"Decision\""), nil
 	case 1:
-		return ([]byte)("\"STICKY\""), nil
+		return ([]byte)("\"Activity\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// UnmarshalJSON attempts to decode TaskListKind from its JSON
+// UnmarshalJSON attempts to decode TaskListType from its JSON
 // representation.
 //
 // This implementation supports both, numeric and string inputs. If a
 // string is provided, it must be a known enum name.
 //
 // This implements json.Unmarshaler.
-func (v *TaskListKind) UnmarshalJSON(text []byte) error {
+func (v *TaskListType) UnmarshalJSON(text []byte) error {
 	d := json.NewDecoder(bytes.NewReader(text))
 	d.UseNumber()
 	t, err := d.Token()
@@ -25476,25 +29389,29 @@ func (v *TaskListKind) UnmarshalJSON(text []byte) error {
 			return err
 		}
 		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskListKind")
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskListType")
 		}
 		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskListKind")
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskListType")
 		}
-		*v = (TaskListKind)(x)
+		*v = (TaskListType)(x)
 		return nil
 	case string:
 		return v.UnmarshalText([]byte(w))
 	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskListKind")
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskListType")
 	}
 }
 
-type TaskListMetadata struct {
-	MaxTasksPerSecond *float64 `json:"maxTasksPerSecond,omitempty"`
+type TerminateWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason            *string            `json:"reason,omitempty"`
+	Details           []byte             `json:"details,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
 }
 
-// ToWire translates a TaskListMetadata struct into a Thrift-level intermediate
+// ToWire translates a TerminateWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -25509,31 +29426,63 @@ type TaskListMetadata struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TaskListMetadata) ToWire() (wire.Value, error) {
+func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.MaxTasksPerSecond != nil {
-		w, err = wire.NewValueDouble(*(v.MaxTasksPerSecond)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TaskListMetadata struct from its Thrift-level
+// FromWire deserializes a TerminateWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TaskListMetadata struct
+// An error is returned if we were unable to build a TerminateWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25541,21 +29490,57 @@ func (v *TaskListMetadata) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TaskListMetadata
+//   var v TerminateWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TaskListMetadata) FromWire(w wire.Value) error {
+func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TDouble {
-				var x float64
-				x, err = field.Value.GetDouble(), error(nil)
-				v.MaxTasksPerSecond = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -25567,152 +29552,218 @@ func (v *TaskListMetadata) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a TaskListMetadata
+// String returns a readable string representation of a TerminateWorkflowExecutionRequest
 // struct.
-func (v *TaskListMetadata) String() string {
+func (v *TerminateWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [5]string
 	i := 0
-	if v.MaxTasksPerSecond != nil {
-		fields[i] = fmt.Sprintf("MaxTasksPerSecond: %v", *(v.MaxTasksPerSecond))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
 
-	return fmt.Sprintf("TaskListMetadata{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TerminateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TaskListMetadata match the
-// provided TaskListMetadata.
+// Equals returns true if all the fields of this TerminateWorkflowExecutionRequest match the
+// provided TerminateWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *TaskListMetadata) Equals(rhs *TaskListMetadata) bool {
-	if !_Double_EqualsPtr(v.MaxTasksPerSecond, rhs.MaxTasksPerSecond) {
+func (v *TerminateWorkflowExecutionRequest) Equals(rhs *TerminateWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 
 	return true
 }
 
-// GetMaxTasksPerSecond returns the value of MaxTasksPerSecond if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *TaskListMetadata) GetMaxTasksPerSecond() (o float64) {
-	if v.MaxTasksPerSecond != nil {
-		return *v.MaxTasksPerSecond
+func (v *TerminateWorkflowExecutionRequest) GetDomain() (o string) {
+	if v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-type TaskListType int32
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *TerminateWorkflowExecutionRequest) GetReason() (o string) {
+	if v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *TerminateWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+type TimeoutType int32
 
 const (
-	TaskListTypeDecision TaskListType = 0
-	TaskListTypeActivity TaskListType = 1
+	TimeoutTypeStartToClose    TimeoutType = 0
+	TimeoutTypeScheduleToStart TimeoutType = 1
+	TimeoutTypeScheduleToClose TimeoutType = 2
+	TimeoutTypeHeartbeat       TimeoutType = 3
 )
 
-// TaskListType_Values returns all recognized values of TaskListType.
-func TaskListType_Values() []TaskListType {
-	return []TaskListType{
-		TaskListTypeDecision,
-		TaskListTypeActivity,
+// TimeoutType_Values returns all recognized values of TimeoutType.
+func TimeoutType_Values() []TimeoutType {
+	return []TimeoutType{
+		TimeoutTypeStartToClose,
+		TimeoutTypeScheduleToStart,
+		TimeoutTypeScheduleToClose,
+		TimeoutTypeHeartbeat,
 	}
 }
 
-// UnmarshalText tries to decode TaskListType from a byte slice
+// UnmarshalText tries to decode TimeoutType from a byte slice
 // containing its name.
 //
-//   var v TaskListType
-//   err := v.UnmarshalText([]byte("Decision"))
-func (v *TaskListType) UnmarshalText(value []byte) error {
+//   var v TimeoutType
+//   err := v.UnmarshalText([]byte("START_TO_CLOSE"))
+func (v *TimeoutType) UnmarshalText(value []byte) error {
 	switch string(value) {
-	case "Decision":
-		*v = TaskListTypeDecision
+	case "START_TO_CLOSE":
+		*v = TimeoutTypeStartToClose
 		return nil
-	case "Activity":
-		*v = TaskListTypeActivity
+	case "SCHEDULE_TO_START":
+		*v = TimeoutTypeScheduleToStart
+		return nil
+	case "SCHEDULE_TO_CLOSE":
+		*v = TimeoutTypeScheduleToClose
+		return nil
+	case "HEARTBEAT":
+		*v = TimeoutTypeHeartbeat
 		return nil
 	default:
-		return fmt.Errorf("unknown enum value %q for %q", value, "TaskListType")
+		return fmt.Errorf("unknown enum value %q for %q", value, "TimeoutType")
 	}
 }
 
 // Ptr returns a pointer to this enum value.
-func (v TaskListType) Ptr() *TaskListType {
+func (v TimeoutType) Ptr() *TimeoutType {
 	return &v
 }
 
-// ToWire translates TaskListType into a Thrift-level intermediate
+// ToWire translates TimeoutType into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // Enums are represented as 32-bit integers over the wire.
-func (v TaskListType) ToWire() (wire.Value, error) {
+func (v TimeoutType) ToWire() (wire.Value, error) {
 	return wire.NewValueI32(int32(v)), nil
 }
 
-// FromWire deserializes TaskListType from its Thrift-level
+// FromWire deserializes TimeoutType from its Thrift-level
 // representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return TaskListType(0), err
+//     return TimeoutType(0), err
 //   }
 //
-//   var v TaskListType
+//   var v TimeoutType
 //   if err := v.FromWire(x); err != nil {
-//     return TaskListType(0), err
+//     return TimeoutType(0), err
 //   }
 //   return v, nil
-func (v *TaskListType) FromWire(w wire.Value) error {
-	*v = (TaskListType)(w.GetI32())
+func (v *TimeoutType) FromWire(w wire.Value) error {
+	*v = (TimeoutType)(w.GetI32())
 	return nil
 }
 
-// String returns a readable string representation of TaskListType.
-func (v TaskListType) String() string {
+// String returns a readable string representation of TimeoutType.
+func (v TimeoutType) String() string {
 	w := int32(v)
 	switch w {
 	case 0:
-		return "Decision"
+		return "START_TO_CLOSE"
 	case 1:
-		return "Activity"
+		return "SCHEDULE_TO_START"
+	case 2:
+		return "SCHEDULE_TO_CLOSE"
+	case 3:
+		return "HEARTBEAT"
 	}
-	return fmt.Sprintf("TaskListType(%d)", w)
+	return fmt.Sprintf("TimeoutType(%d)", w)
 }
 
-// Equals returns true if this TaskListType value matches the provided
+// Equals returns true if this TimeoutType value matches the provided
 // value.
-func (v TaskListType) Equals(rhs TaskListType) bool {
+func (v TimeoutType) Equals(rhs TimeoutType) bool {
 	return v == rhs
 }
 
-// MarshalJSON serializes TaskListType into JSON.
+// MarshalJSON serializes TimeoutType into JSON.
 //
 // If the enum value is recognized, its name is returned. Otherwise,
 // its integer value is returned.
 //
 // This implements json.Marshaler.
-func (v TaskListType) MarshalJSON() ([]byte, error) {
+func (v TimeoutType) MarshalJSON() ([]byte, error) {
 	switch int32(v) {
 	case 0:
-		return ([]byte)("\"Decision\""), nil
+		return ([]byte)("\"START_TO_CLOSE\""), nil
 	case 1:
-		return ([]byte)("\"Activity\""), nil
+		return ([]byte)("\"SCHEDULE_TO_START\""), nil
+	case 2:
+		return ([]byte)("\"SCHEDULE_TO_CLOSE\""), nil
+	case 3:
+		return ([]byte)("\"HEARTBEAT\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// UnmarshalJSON attempts to decode TaskListType from its JSON
+// UnmarshalJSON attempts to decode TimeoutType from its JSON
 // representation.
 //
 // This implementation supports both, numeric and string inputs. If a
 // string is provided, it must be a known enum name.
 //
 // This implements json.Unmarshaler.
-func (v *TaskListType) UnmarshalJSON(text []byte) error {
+func (v *TimeoutType) UnmarshalJSON(text []byte) error {
 	d := json.NewDecoder(bytes.NewReader(text))
 	d.UseNumber()
 	t, err := d.Token()
@@ -25727,29 +29778,28 @@ func (v *TaskListType) UnmarshalJSON(text []byte) error {
 			return err
 		}
 		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskListType")
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TimeoutType")
 		}
 		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskListType")
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TimeoutType")
 		}
-		*v = (TaskListType)(x)
+		*v = (TimeoutType)(x)
 		return nil
 	case string:
 		return v.UnmarshalText([]byte(w))
 	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskListType")
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TimeoutType")
 	}
 }
 
-type TerminateWorkflowExecutionRequest struct {
-	Domain            *string            `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Reason            *string            `json:"reason,omitempty"`
-	Details           []byte             `json:"details,omitempty"`
-	Identity          *string            `json:"identity,omitempty"`
+type TimerCanceledEventAttributes struct {
+	TimerId                      *string `json:"timerId,omitempty"`
+	StartedEventId               *int64  `json:"startedEventId,omitempty"`
+	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+	Identity                     *string `json:"identity,omitempty"`
 }
 
-// ToWire translates a TerminateWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a TimerCanceledEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -25764,63 +29814,55 @@ type TerminateWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *TimerCanceledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.TimerId != nil {
+		w, err = wire.NewValueString(*(v.TimerId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Reason != nil {
-		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 	if v.Identity != nil {
 		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TerminateWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a TimerCanceledEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TerminateWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a TimerCanceledEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -25828,12 +29870,12 @@ func (v *TerminateWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TerminateWorkflowExecutionRequest
+//   var v TimerCanceledEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *TimerCanceledEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -25842,39 +29884,33 @@ func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.TimerId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Reason = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -25890,29 +29926,25 @@ func (v *TerminateWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a TerminateWorkflowExecutionRequest
+// String returns a readable string representation of a TimerCanceledEventAttributes
 // struct.
-func (v *TerminateWorkflowExecutionRequest) String() string {
+func (v *TimerCanceledEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [4]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.TimerId != nil {
+		fields[i] = fmt.Sprintf("TimerId: %v", *(v.TimerId))
 		i++
 	}
-	if v.Reason != nil {
-		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
 	if v.Identity != nil {
@@ -25920,24 +29952,21 @@ func (v *TerminateWorkflowExecutionRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("TerminateWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TimerCanceledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TerminateWorkflowExecutionRequest match the
-// provided TerminateWorkflowExecutionRequest.
+// Equals returns true if all the fields of this TimerCanceledEventAttributes match the
+// provided TimerCanceledEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *TerminateWorkflowExecutionRequest) Equals(rhs *TerminateWorkflowExecutionRequest) bool {
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+func (v *TimerCanceledEventAttributes) Equals(rhs *TimerCanceledEventAttributes) bool {
+	if !_String_EqualsPtr(v.TimerId, rhs.TimerId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
@@ -25947,21 +29976,31 @@ func (v *TerminateWorkflowExecutionRequest) Equals(rhs *TerminateWorkflowExecuti
 	return true
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetTimerId returns the value of TimerId if it is set or its
 // zero value if it is unset.
-func (v *TerminateWorkflowExecutionRequest) GetDomain() (o string) {
-	if v.Domain != nil {
-		return *v.Domain
+func (v *TimerCanceledEventAttributes) GetTimerId() (o string) {
+	if v.TimerId != nil {
+		return *v.TimerId
 	}
 
 	return
 }
 
-// GetReason returns the value of Reason if it is set or its
+// GetStartedEventId returns the value of StartedEventId if it is set or its
 // zero value if it is unset.
-func (v *TerminateWorkflowExecutionRequest) GetReason() (o string) {
-	if v.Reason != nil {
-		return *v.Reason
+func (v *TimerCanceledEventAttributes) GetStartedEventId() (o int64) {
+	if v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
+}
+
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// zero value if it is unset.
+func (v *TimerCanceledEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
 	}
 
 	return
@@ -25969,7 +30008,7 @@ func (v *TerminateWorkflowExecutionRequest) GetReason() (o string) {
 
 // GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
-func (v *TerminateWorkflowExecutionRequest) GetIdentity() (o string) {
+func (v *TimerCanceledEventAttributes) GetIdentity() (o string) {
 	if v.Identity != nil {
 		return *v.Identity
 	}
@@ -25977,167 +30016,165 @@ func (v *TerminateWorkflowExecutionRequest) GetIdentity() (o string) {
 	return
 }
 
-type TimeoutType int32
-
-const (
-	TimeoutTypeStartToClose    TimeoutType = 0
-	TimeoutTypeScheduleToStart TimeoutType = 1
-	TimeoutTypeScheduleToClose TimeoutType = 2
-	TimeoutTypeHeartbeat       TimeoutType = 3
-)
-
-// TimeoutType_Values returns all recognized values of TimeoutType.
-func TimeoutType_Values() []TimeoutType {
-	return []TimeoutType{
-		TimeoutTypeStartToClose,
-		TimeoutTypeScheduleToStart,
-		TimeoutTypeScheduleToClose,
-		TimeoutTypeHeartbeat,
-	}
+type TimerFiredEventAttributes struct {
+	TimerId        *string `json:"timerId,omitempty"`
+	StartedEventId *int64  `json:"startedEventId,omitempty"`
 }
 
-// UnmarshalText tries to decode TimeoutType from a byte slice
-// containing its name.
+// ToWire translates a TimerFiredEventAttributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
 //
-//   var v TimeoutType
-//   err := v.UnmarshalText([]byte("START_TO_CLOSE"))
-func (v *TimeoutType) UnmarshalText(value []byte) error {
-	switch string(value) {
-	case "START_TO_CLOSE":
-		*v = TimeoutTypeStartToClose
-		return nil
-	case "SCHEDULE_TO_START":
-		*v = TimeoutTypeScheduleToStart
-		return nil
-	case "SCHEDULE_TO_CLOSE":
-		*v = TimeoutTypeScheduleToClose
-		return nil
-	case "HEARTBEAT":
-		*v = TimeoutTypeHeartbeat
-		return nil
-	default:
-		return fmt.Errorf("unknown enum value %q for %q", value, "TimeoutType")
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TimerFiredEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TimerId != nil {
+		w, err = wire.NewValueString(*(v.TimerId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-}
 
-// Ptr returns a pointer to this enum value.
-func (v TimeoutType) Ptr() *TimeoutType {
-	return &v
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// ToWire translates TimeoutType into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+// FromWire deserializes a TimerFiredEventAttributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
 //
-// Enums are represented as 32-bit integers over the wire.
-func (v TimeoutType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
+// An error is returned if we were unable to build a TimerFiredEventAttributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TimerFiredEventAttributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TimerFiredEventAttributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TimerId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
 
-// FromWire deserializes TimeoutType from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return TimeoutType(0), err
-//   }
-//
-//   var v TimeoutType
-//   if err := v.FromWire(x); err != nil {
-//     return TimeoutType(0), err
-//   }
-//   return v, nil
-func (v *TimeoutType) FromWire(w wire.Value) error {
-	*v = (TimeoutType)(w.GetI32())
 	return nil
 }
 
-// String returns a readable string representation of TimeoutType.
-func (v TimeoutType) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "START_TO_CLOSE"
-	case 1:
-		return "SCHEDULE_TO_START"
-	case 2:
-		return "SCHEDULE_TO_CLOSE"
-	case 3:
-		return "HEARTBEAT"
+// String returns a readable string representation of a TimerFiredEventAttributes
+// struct.
+func (v *TimerFiredEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	return fmt.Sprintf("TimeoutType(%d)", w)
-}
 
-// Equals returns true if this TimeoutType value matches the provided
-// value.
-func (v TimeoutType) Equals(rhs TimeoutType) bool {
-	return v == rhs
+	var fields [2]string
+	i := 0
+	if v.TimerId != nil {
+		fields[i] = fmt.Sprintf("TimerId: %v", *(v.TimerId))
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+
+	return fmt.Sprintf("TimerFiredEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// MarshalJSON serializes TimeoutType into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
+// Equals returns true if all the fields of this TimerFiredEventAttributes match the
+// provided TimerFiredEventAttributes.
 //
-// This implements json.Marshaler.
-func (v TimeoutType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"START_TO_CLOSE\""), nil
-	case 1:
-		return ([]byte)("\"SCHEDULE_TO_START\""), nil
-	case 2:
-		return ([]byte)("\"SCHEDULE_TO_CLOSE\""), nil
-	case 3:
-		return ([]byte)("\"HEARTBEAT\""), nil
+// This function performs a deep comparison.
+func (v *TimerFiredEventAttributes) Equals(rhs *TimerFiredEventAttributes) bool {
+	if !_String_EqualsPtr(v.TimerId, rhs.TimerId) {
+		return false
 	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+
+	return true
 }
 
-// UnmarshalJSON attempts to decode TimeoutType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *TimeoutType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
+// GetTimerId returns the value of TimerId if it is set or its
+// zero value if it is unset.
+func (v *TimerFiredEventAttributes) GetTimerId() (o string) {
+	if v.TimerId != nil {
+		return *v.TimerId
 	}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TimeoutType")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TimeoutType")
-		}
-		*v = (TimeoutType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TimeoutType")
+	return
+}
+
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *TimerFiredEventAttributes) GetStartedEventId() (o int64) {
+	if v.StartedEventId != nil {
+		return *v.StartedEventId
 	}
+
+	return
 }
 
-type TimerCanceledEventAttributes struct {
+type TimerStartedEventAttributes struct {
 	TimerId                      *string `json:"timerId,omitempty"`
-	StartedEventId               *int64  `json:"startedEventId,omitempty"`
+	StartToFireTimeoutSeconds    *int64  `json:"startToFireTimeoutSeconds,omitempty"`
 	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
-	Identity                     *string `json:"identity,omitempty"`
 }
 
-// ToWire translates a TimerCanceledEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a TimerStartedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26152,9 +30189,9 @@ type TimerCanceledEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TimerCanceledEventAttributes) ToWire() (wire.Value, error) {
+func (v *TimerStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -26168,8 +30205,8 @@ func (v *TimerCanceledEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+	if v.StartToFireTimeoutSeconds != nil {
+		w, err = wire.NewValueI64(*(v.StartToFireTimeoutSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -26184,23 +30221,15 @@ func (v *TimerCanceledEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TimerCanceledEventAttributes struct from its Thrift-level
+// FromWire deserializes a TimerStartedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TimerCanceledEventAttributes struct
+// An error is returned if we were unable to build a TimerStartedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26208,12 +30237,12 @@ func (v *TimerCanceledEventAttributes) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TimerCanceledEventAttributes
+//   var v TimerStartedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TimerCanceledEventAttributes) FromWire(w wire.Value) error {
+func (v *TimerStartedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -26232,7 +30261,7 @@ func (v *TimerCanceledEventAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
+				v.StartToFireTimeoutSeconds = &x
 				if err != nil {
 					return err
 				}
@@ -26247,16 +30276,6 @@ func (v *TimerCanceledEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
@@ -26264,59 +30283,52 @@ func (v *TimerCanceledEventAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a TimerCanceledEventAttributes
+// String returns a readable string representation of a TimerStartedEventAttributes
 // struct.
-func (v *TimerCanceledEventAttributes) String() string {
+func (v *TimerStartedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [3]string
 	i := 0
 	if v.TimerId != nil {
 		fields[i] = fmt.Sprintf("TimerId: %v", *(v.TimerId))
 		i++
 	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+	if v.StartToFireTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("StartToFireTimeoutSeconds: %v", *(v.StartToFireTimeoutSeconds))
 		i++
 	}
 	if v.DecisionTaskCompletedEventId != nil {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
-		i++
-	}
 
-	return fmt.Sprintf("TimerCanceledEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TimerStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TimerCanceledEventAttributes match the
-// provided TimerCanceledEventAttributes.
+// Equals returns true if all the fields of this TimerStartedEventAttributes match the
+// provided TimerStartedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *TimerCanceledEventAttributes) Equals(rhs *TimerCanceledEventAttributes) bool {
+func (v *TimerStartedEventAttributes) Equals(rhs *TimerStartedEventAttributes) bool {
 	if !_String_EqualsPtr(v.TimerId, rhs.TimerId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+	if !_I64_EqualsPtr(v.StartToFireTimeoutSeconds, rhs.StartToFireTimeoutSeconds) {
 		return false
 	}
 	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
-		return false
-	}
 
 	return true
 }
 
 // GetTimerId returns the value of TimerId if it is set or its
 // zero value if it is unset.
-func (v *TimerCanceledEventAttributes) GetTimerId() (o string) {
+func (v *TimerStartedEventAttributes) GetTimerId() (o string) {
 	if v.TimerId != nil {
 		return *v.TimerId
 	}
@@ -26324,11 +30336,11 @@ func (v *TimerCanceledEventAttributes) GetTimerId() (o string) {
 	return
 }
 
-// GetStartedEventId returns the value of StartedEventId if it is set or its
+// GetStartToFireTimeoutSeconds returns the value of StartToFireTimeoutSeconds if it is set or its
 // zero value if it is unset.
-func (v *TimerCanceledEventAttributes) GetStartedEventId() (o int64) {
-	if v.StartedEventId != nil {
-		return *v.StartedEventId
+func (v *TimerStartedEventAttributes) GetStartToFireTimeoutSeconds() (o int64) {
+	if v.StartToFireTimeoutSeconds != nil {
+		return *v.StartToFireTimeoutSeconds
 	}
 
 	return
@@ -26336,7 +30348,7 @@ func (v *TimerCanceledEventAttributes) GetStartedEventId() (o int64) {
 
 // GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *TimerCanceledEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+func (v *TimerStartedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
 	if v.DecisionTaskCompletedEventId != nil {
 		return *v.DecisionTaskCompletedEventId
 	}
@@ -26344,22 +30356,12 @@ func (v *TimerCanceledEventAttributes) GetDecisionTaskCompletedEventId() (o int6
 	return
 }
 
-// GetIdentity returns the value of Identity if it is set or its
-// zero value if it is unset.
-func (v *TimerCanceledEventAttributes) GetIdentity() (o string) {
-	if v.Identity != nil {
-		return *v.Identity
-	}
-
-	return
-}
-
-type TimerFiredEventAttributes struct {
-	TimerId        *string `json:"timerId,omitempty"`
-	StartedEventId *int64  `json:"startedEventId,omitempty"`
+type TransientDecisionInfo struct {
+	ScheduledEvent *HistoryEvent `json:"scheduledEvent,omitempty"`
+	StartedEvent   *HistoryEvent `json:"startedEvent,omitempty"`
 }
 
-// ToWire translates a TimerFiredEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a TransientDecisionInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26374,7 +30376,7 @@ type TimerFiredEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TimerFiredEventAttributes) ToWire() (wire.Value, error) {
+func (v *TransientDecisionInfo) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -26382,16 +30384,16 @@ func (v *TimerFiredEventAttributes) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.TimerId != nil {
-		w, err = wire.NewValueString(*(v.TimerId)), error(nil)
+	if v.ScheduledEvent != nil {
+		w, err = v.ScheduledEvent.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+	if v.StartedEvent != nil {
+		w, err = v.StartedEvent.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -26402,11 +30404,11 @@ func (v *TimerFiredEventAttributes) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TimerFiredEventAttributes struct from its Thrift-level
+// FromWire deserializes a TransientDecisionInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TimerFiredEventAttributes struct
+// An error is returned if we were unable to build a TransientDecisionInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26414,31 +30416,27 @@ func (v *TimerFiredEventAttributes) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TimerFiredEventAttributes
+//   var v TransientDecisionInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TimerFiredEventAttributes) FromWire(w wire.Value) error {
+func (v *TransientDecisionInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.TimerId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.ScheduledEvent, err = _HistoryEvent_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
-			}
-		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.StartedEvent, err = _HistoryEvent_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -26450,69 +30448,48 @@ func (v *TimerFiredEventAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a TimerFiredEventAttributes
+// String returns a readable string representation of a TransientDecisionInfo
 // struct.
-func (v *TimerFiredEventAttributes) String() string {
+func (v *TransientDecisionInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.TimerId != nil {
-		fields[i] = fmt.Sprintf("TimerId: %v", *(v.TimerId))
+	if v.ScheduledEvent != nil {
+		fields[i] = fmt.Sprintf("ScheduledEvent: %v", v.ScheduledEvent)
 		i++
 	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+	if v.StartedEvent != nil {
+		fields[i] = fmt.Sprintf("StartedEvent: %v", v.StartedEvent)
 		i++
 	}
 
-	return fmt.Sprintf("TimerFiredEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TransientDecisionInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TimerFiredEventAttributes match the
-// provided TimerFiredEventAttributes.
+// Equals returns true if all the fields of this TransientDecisionInfo match the
+// provided TransientDecisionInfo.
 //
 // This function performs a deep comparison.
-func (v *TimerFiredEventAttributes) Equals(rhs *TimerFiredEventAttributes) bool {
-	if !_String_EqualsPtr(v.TimerId, rhs.TimerId) {
+func (v *TransientDecisionInfo) Equals(rhs *TransientDecisionInfo) bool {
+	if !((v.ScheduledEvent == nil && rhs.ScheduledEvent == nil) || (v.ScheduledEvent != nil && rhs.ScheduledEvent != nil && v.ScheduledEvent.Equals(rhs.ScheduledEvent))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+	if !((v.StartedEvent == nil && rhs.StartedEvent == nil) || (v.StartedEvent != nil && rhs.StartedEvent != nil && v.StartedEvent.Equals(rhs.StartedEvent))) {
 		return false
 	}
 
 	return true
 }
 
-// GetTimerId returns the value of TimerId if it is set or its
-// zero value if it is unset.
-func (v *TimerFiredEventAttributes) GetTimerId() (o string) {
-	if v.TimerId != nil {
-		return *v.TimerId
-	}
-
-	return
-}
-
-// GetStartedEventId returns the value of StartedEventId if it is set or its
-// zero value if it is unset.
-func (v *TimerFiredEventAttributes) GetStartedEventId() (o int64) {
-	if v.StartedEventId != nil {
-		return *v.StartedEventId
-	}
-
-	return
-}
-
-type TimerStartedEventAttributes struct {
-	TimerId                      *string `json:"timerId,omitempty"`
-	StartToFireTimeoutSeconds    *int64  `json:"startToFireTimeoutSeconds,omitempty"`
-	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+type UpdateDomainInfo struct {
+	Description *string `json:"description,omitempty"`
+	OwnerEmail  *string `json:"ownerEmail,omitempty"`
 }
 
-// ToWire translates a TimerStartedEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a UpdateDomainInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26527,47 +30504,39 @@ type TimerStartedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TimerStartedEventAttributes) ToWire() (wire.Value, error) {
+func (v *UpdateDomainInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TimerId != nil {
-		w, err = wire.NewValueString(*(v.TimerId)), error(nil)
+	if v.Description != nil {
+		w, err = wire.NewValueString(*(v.Description)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartToFireTimeoutSeconds != nil {
-		w, err = wire.NewValueI64(*(v.StartToFireTimeoutSeconds)), error(nil)
+	if v.OwnerEmail != nil {
+		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TimerStartedEventAttributes struct from its Thrift-level
+// FromWire deserializes a UpdateDomainInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TimerStartedEventAttributes struct
+// An error is returned if we were unable to build a UpdateDomainInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26575,12 +30544,12 @@ func (v *TimerStartedEventAttributes) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TimerStartedEventAttributes
+//   var v UpdateDomainInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TimerStartedEventAttributes) FromWire(w wire.Value) error {
+func (v *UpdateDomainInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -26589,27 +30558,17 @@ func (v *TimerStartedEventAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.TimerId = &x
+				v.Description = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartToFireTimeoutSeconds = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.OwnerEmail = &x
 				if err != nil {
 					return err
 				}
@@ -26621,85 +30580,71 @@ func (v *TimerStartedEventAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a TimerStartedEventAttributes
+// String returns a readable string representation of a UpdateDomainInfo
 // struct.
-func (v *TimerStartedEventAttributes) String() string {
+func (v *UpdateDomainInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [2]string
 	i := 0
-	if v.TimerId != nil {
-		fields[i] = fmt.Sprintf("TimerId: %v", *(v.TimerId))
-		i++
-	}
-	if v.StartToFireTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("StartToFireTimeoutSeconds: %v", *(v.StartToFireTimeoutSeconds))
+	if v.Description != nil {
+		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.OwnerEmail != nil {
+		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
 		i++
 	}
 
-	return fmt.Sprintf("TimerStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpdateDomainInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TimerStartedEventAttributes match the
-// provided TimerStartedEventAttributes.
+// Equals returns true if all the fields of this UpdateDomainInfo match the
+// provided UpdateDomainInfo.
 //
 // This function performs a deep comparison.
-func (v *TimerStartedEventAttributes) Equals(rhs *TimerStartedEventAttributes) bool {
-	if !_String_EqualsPtr(v.TimerId, rhs.TimerId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartToFireTimeoutSeconds, rhs.StartToFireTimeoutSeconds) {
+func (v *UpdateDomainInfo) Equals(rhs *UpdateDomainInfo) bool {
+	if !_String_EqualsPtr(v.Description, rhs.Description) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
 		return false
 	}
 
 	return true
 }
 
-// GetTimerId returns the value of TimerId if it is set or its
-// zero value if it is unset.
-func (v *TimerStartedEventAttributes) GetTimerId() (o string) {
-	if v.TimerId != nil {
-		return *v.TimerId
-	}
-
-	return
-}
-
-// GetStartToFireTimeoutSeconds returns the value of StartToFireTimeoutSeconds if it is set or its
+// GetDescription returns the value of Description if it is set or its
 // zero value if it is unset.
-func (v *TimerStartedEventAttributes) GetStartToFireTimeoutSeconds() (o int64) {
-	if v.StartToFireTimeoutSeconds != nil {
-		return *v.StartToFireTimeoutSeconds
+func (v *UpdateDomainInfo) GetDescription() (o string) {
+	if v.Description != nil {
+		return *v.Description
 	}
 
 	return
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// GetOwnerEmail returns the value of OwnerEmail if it is set or its
 // zero value if it is unset.
-func (v *TimerStartedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+func (v *UpdateDomainInfo) GetOwnerEmail() (o string) {
+	if v.OwnerEmail != nil {
+		return *v.OwnerEmail
 	}
 
 	return
 }
 
-type TransientDecisionInfo struct {
-	ScheduledEvent *HistoryEvent `json:"scheduledEvent,omitempty"`
-	StartedEvent   *HistoryEvent `json:"startedEvent,omitempty"`
+type UpdateDomainRequest struct {
+	Name                             *string                         `json:"name,omitempty"`
+	UpdatedInfo                      *UpdateDomainInfo               `json:"updatedInfo,omitempty"`
+	Configuration                    *DomainConfiguration            `json:"configuration,omitempty"`
+	ReplicationConfiguration         *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+	GracefulFailoverTimeoutInSeconds *int32                          `json:"gracefulFailoverTimeoutInSeconds,omitempty"`
 }
 
-// ToWire translates a TransientDecisionInfo struct into a Thrift-level intermediate
+// ToWire translates a UpdateDomainRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26714,39 +30659,69 @@ type TransientDecisionInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TransientDecisionInfo) ToWire() (wire.Value, error) {
+func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ScheduledEvent != nil {
-		w, err = v.ScheduledEvent.ToWire()
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartedEvent != nil {
-		w, err = v.StartedEvent.ToWire()
+	if v.UpdatedInfo != nil {
+		w, err = v.UpdatedInfo.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Configuration != nil {
+		w, err = v.Configuration.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ReplicationConfiguration != nil {
+		w, err = v.ReplicationConfiguration.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.GracefulFailoverTimeoutInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.GracefulFailoverTimeoutInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TransientDecisionInfo struct from its Thrift-level
+func _UpdateDomainInfo_Read(w wire.Value) (*UpdateDomainInfo, error) {
+	var v UpdateDomainInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a UpdateDomainRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TransientDecisionInfo struct
+// An error is returned if we were unable to build a UpdateDomainRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26754,19 +30729,21 @@ func (v *TransientDecisionInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TransientDecisionInfo
+//   var v UpdateDomainRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TransientDecisionInfo) FromWire(w wire.Value) error {
+func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.ScheduledEvent, err = _HistoryEvent_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
 				if err != nil {
 					return err
 				}
@@ -26774,7 +30751,33 @@ func (v *TransientDecisionInfo) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.StartedEvent, err = _HistoryEvent_Read(field.Value)
+				v.UpdatedInfo, err = _UpdateDomainInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.Configuration, err = _DomainConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.GracefulFailoverTimeoutInSeconds = &x
 				if err != nil {
 					return err
 				}
@@ -26786,48 +30789,92 @@ func (v *TransientDecisionInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a TransientDecisionInfo
+// String returns a readable string representation of a UpdateDomainRequest
 // struct.
-func (v *TransientDecisionInfo) String() string {
+func (v *UpdateDomainRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [5]string
 	i := 0
-	if v.ScheduledEvent != nil {
-		fields[i] = fmt.Sprintf("ScheduledEvent: %v", v.ScheduledEvent)
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
 		i++
 	}
-	if v.StartedEvent != nil {
-		fields[i] = fmt.Sprintf("StartedEvent: %v", v.StartedEvent)
+	if v.UpdatedInfo != nil {
+		fields[i] = fmt.Sprintf("UpdatedInfo: %v", v.UpdatedInfo)
+		i++
+	}
+	if v.Configuration != nil {
+		fields[i] = fmt.Sprintf("Configuration: %v", v.Configuration)
+		i++
+	}
+	if v.ReplicationConfiguration != nil {
+		fields[i] = fmt.Sprintf("ReplicationConfiguration: %v", v.ReplicationConfiguration)
+		i++
+	}
+	if v.GracefulFailoverTimeoutInSeconds != nil {
+		fields[i] = fmt.Sprintf("GracefulFailoverTimeoutInSeconds: %v", *(v.GracefulFailoverTimeoutInSeconds))
 		i++
 	}
 
-	return fmt.Sprintf("TransientDecisionInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpdateDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TransientDecisionInfo match the
-// provided TransientDecisionInfo.
+// Equals returns true if all the fields of this UpdateDomainRequest match the
+// provided UpdateDomainRequest.
 //
 // This function performs a deep comparison.
-func (v *TransientDecisionInfo) Equals(rhs *TransientDecisionInfo) bool {
-	if !((v.ScheduledEvent == nil && rhs.ScheduledEvent == nil) || (v.ScheduledEvent != nil && rhs.ScheduledEvent != nil && v.ScheduledEvent.Equals(rhs.ScheduledEvent))) {
+func (v *UpdateDomainRequest) Equals(rhs *UpdateDomainRequest) bool {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
 		return false
 	}
-	if !((v.StartedEvent == nil && rhs.StartedEvent == nil) || (v.StartedEvent != nil && rhs.StartedEvent != nil && v.StartedEvent.Equals(rhs.StartedEvent))) {
+	if !((v.UpdatedInfo == nil && rhs.UpdatedInfo == nil) || (v.UpdatedInfo != nil && rhs.UpdatedInfo != nil && v.UpdatedInfo.Equals(rhs.UpdatedInfo))) {
+		return false
+	}
+	if !((v.Configuration == nil && rhs.Configuration == nil) || (v.Configuration != nil && rhs.Configuration != nil && v.Configuration.Equals(rhs.Configuration))) {
+		return false
+	}
+	if !((v.ReplicationConfiguration == nil && rhs.ReplicationConfiguration == nil) || (v.ReplicationConfiguration != nil && rhs.ReplicationConfiguration != nil && v.ReplicationConfiguration.Equals(rhs.ReplicationConfiguration))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.GracefulFailoverTimeoutInSeconds, rhs.GracefulFailoverTimeoutInSeconds) {
 		return false
 	}
 
 	return true
 }
 
-type UpdateDomainInfo struct {
-	Description *string `json:"description,omitempty"`
-	OwnerEmail  *string `json:"ownerEmail,omitempty"`
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetName() (o string) {
+	if v.Name != nil {
+		return *v.Name
+	}
+
+	return
 }
 
-// ToWire translates a UpdateDomainInfo struct into a Thrift-level intermediate
+// GetGracefulFailoverTimeoutInSeconds returns the value of GracefulFailoverTimeoutInSeconds if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetGracefulFailoverTimeoutInSeconds() (o int32) {
+	if v.GracefulFailoverTimeoutInSeconds != nil {
+		return *v.GracefulFailoverTimeoutInSeconds
+	}
+
+	return
+}
+
+type UpdateDomainResponse struct {
+	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
+	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
+	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
+	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+}
+
+// ToWire translates a UpdateDomainResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26842,39 +30889,63 @@ type UpdateDomainInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *UpdateDomainInfo) ToWire() (wire.Value, error) {
+func (v *UpdateDomainResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Description != nil {
-		w, err = wire.NewValueString(*(v.Description)), error(nil)
+	if v.DomainInfo != nil {
+		w, err = v.DomainInfo.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.OwnerEmail != nil {
-		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+	if v.Configuration != nil {
+		w, err = v.Configuration.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ReplicationConfiguration != nil {
+		w, err = v.ReplicationConfiguration.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.FailoverVersion != nil {
+		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.IsGlobalDomain != nil {
+		w, err = wire.NewValueBool(*(v.IsGlobalDomain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a UpdateDomainInfo struct from its Thrift-level
+// FromWire deserializes a UpdateDomainResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a UpdateDomainInfo struct
+// An error is returned if we were unable to build a UpdateDomainResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -26882,31 +30953,55 @@ func (v *UpdateDomainInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v UpdateDomainInfo
+//   var v UpdateDomainResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *UpdateDomainInfo) FromWire(w wire.Value) error {
+func (v *UpdateDomainResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Description = &x
+			if field.Value.Type() == wire.TStruct {
+				v.DomainInfo, err = _DomainInfo_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.OwnerEmail = &x
+			if field.Value.Type() == wire.TStruct {
+				v.Configuration, err = _DomainConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FailoverVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.IsGlobalDomain = &x
 				if err != nil {
 					return err
 				}
@@ -26918,70 +31013,88 @@ func (v *UpdateDomainInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a UpdateDomainInfo
+// String returns a readable string representation of a UpdateDomainResponse
 // struct.
-func (v *UpdateDomainInfo) String() string {
+func (v *UpdateDomainResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [5]string
 	i := 0
-	if v.Description != nil {
-		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
+	if v.DomainInfo != nil {
+		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
 		i++
 	}
-	if v.OwnerEmail != nil {
-		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+	if v.Configuration != nil {
+		fields[i] = fmt.Sprintf("Configuration: %v", v.Configuration)
+		i++
+	}
+	if v.ReplicationConfiguration != nil {
+		fields[i] = fmt.Sprintf("ReplicationConfiguration: %v", v.ReplicationConfiguration)
+		i++
+	}
+	if v.FailoverVersion != nil {
+		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
+		i++
+	}
+	if v.IsGlobalDomain != nil {
+		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
 		i++
 	}
 
-	return fmt.Sprintf("UpdateDomainInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpdateDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this UpdateDomainInfo match the
-// provided UpdateDomainInfo.
+// Equals returns true if all the fields of this UpdateDomainResponse match the
+// provided UpdateDomainResponse.
 //
 // This function performs a deep comparison.
-func (v *UpdateDomainInfo) Equals(rhs *UpdateDomainInfo) bool {
-	if !_String_EqualsPtr(v.Description, rhs.Description) {
+func (v *UpdateDomainResponse) Equals(rhs *UpdateDomainResponse) bool {
+	if !((v.DomainInfo == nil && rhs.DomainInfo == nil) || (v.DomainInfo != nil && rhs.DomainInfo != nil && v.DomainInfo.Equals(rhs.DomainInfo))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+	if !((v.Configuration == nil && rhs.Configuration == nil) || (v.Configuration != nil && rhs.Configuration != nil && v.Configuration.Equals(rhs.Configuration))) {
+		return false
+	}
+	if !((v.ReplicationConfiguration == nil && rhs.ReplicationConfiguration == nil) || (v.ReplicationConfiguration != nil && rhs.ReplicationConfiguration != nil && v.ReplicationConfiguration.Equals(rhs.ReplicationConfiguration))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
 		return false
 	}
 
 	return true
 }
 
-// GetDescription returns the value of Description if it is set or its
+// GetFailoverVersion returns the value of FailoverVersion if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainInfo) GetDescription() (o string) {
-	if v.Description != nil {
-		return *v.Description
+func (v *UpdateDomainResponse) GetFailoverVersion() (o int64) {
+	if v.FailoverVersion != nil {
+		return *v.FailoverVersion
 	}
 
 	return
 }
 
-// GetOwnerEmail returns the value of OwnerEmail if it is set or its
+// GetIsGlobalDomain returns the value of IsGlobalDomain if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainInfo) GetOwnerEmail() (o string) {
-	if v.OwnerEmail != nil {
-		return *v.OwnerEmail
+func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
+	if v.IsGlobalDomain != nil {
+		return *v.IsGlobalDomain
 	}
 
 	return
 }
 
-type UpdateDomainRequest struct {
-	Name                     *string                         `json:"name,omitempty"`
-	UpdatedInfo              *UpdateDomainInfo               `json:"updatedInfo,omitempty"`
-	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
-	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+type UpsertWorkflowSearchAttributesDecisionAttributes struct {
+	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
 }
 
-// ToWire translates a UpdateDomainRequest struct into a Thrift-level intermediate
+// ToWire translates a UpsertWorkflowSearchAttributesDecisionAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -26996,61 +31109,31 @@ type UpdateDomainRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.UpdatedInfo != nil {
-		w, err = v.UpdatedInfo.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.Configuration != nil {
-		w, err = v.Configuration.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.ReplicationConfiguration != nil {
-		w, err = v.ReplicationConfiguration.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _UpdateDomainInfo_Read(w wire.Value) (*UpdateDomainInfo, error) {
-	var v UpdateDomainInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a UpdateDomainRequest struct from its Thrift-level
+// FromWire deserializes a UpsertWorkflowSearchAttributesDecisionAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a UpdateDomainRequest struct
+// An error is returned if we were unable to build a UpsertWorkflowSearchAttributesDecisionAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -27058,45 +31141,19 @@ func _UpdateDomainInfo_Read(w wire.Value) (*UpdateDomainInfo, error) {
 //     return nil, err
 //   }
 //
-//   var v UpdateDomainRequest
+//   var v UpsertWorkflowSearchAttributesDecisionAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.UpdatedInfo, err = _UpdateDomainInfo_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.Configuration, err = _DomainConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
 			if field.Value.Type() == wire.TStruct {
-				v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Read(field.Value)
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -27108,75 +31165,41 @@ func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a UpdateDomainRequest
+// String returns a readable string representation of a UpsertWorkflowSearchAttributesDecisionAttributes
 // struct.
-func (v *UpdateDomainRequest) String() string {
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [1]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
-		i++
-	}
-	if v.UpdatedInfo != nil {
-		fields[i] = fmt.Sprintf("UpdatedInfo: %v", v.UpdatedInfo)
-		i++
-	}
-	if v.Configuration != nil {
-		fields[i] = fmt.Sprintf("Configuration: %v", v.Configuration)
-		i++
-	}
-	if v.ReplicationConfiguration != nil {
-		fields[i] = fmt.Sprintf("ReplicationConfiguration: %v", v.ReplicationConfiguration)
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
 		i++
 	}
 
-	return fmt.Sprintf("UpdateDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpsertWorkflowSearchAttributesDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this UpdateDomainRequest match the
-// provided UpdateDomainRequest.
+// Equals returns true if all the fields of this UpsertWorkflowSearchAttributesDecisionAttributes match the
+// provided UpsertWorkflowSearchAttributesDecisionAttributes.
 //
 // This function performs a deep comparison.
-func (v *UpdateDomainRequest) Equals(rhs *UpdateDomainRequest) bool {
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
-		return false
-	}
-	if !((v.UpdatedInfo == nil && rhs.UpdatedInfo == nil) || (v.UpdatedInfo != nil && rhs.UpdatedInfo != nil && v.UpdatedInfo.Equals(rhs.UpdatedInfo))) {
-		return false
-	}
-	if !((v.Configuration == nil && rhs.Configuration == nil) || (v.Configuration != nil && rhs.Configuration != nil && v.Configuration.Equals(rhs.Configuration))) {
-		return false
-	}
-	if !((v.ReplicationConfiguration == nil && rhs.ReplicationConfiguration == nil) || (v.ReplicationConfiguration != nil && rhs.ReplicationConfiguration != nil && v.ReplicationConfiguration.Equals(rhs.ReplicationConfiguration))) {
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Equals(rhs *UpsertWorkflowSearchAttributesDecisionAttributes) bool {
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
 		return false
 	}
 
 	return true
 }
 
-// GetName returns the value of Name if it is set or its
-// zero value if it is unset.
-func (v *UpdateDomainRequest) GetName() (o string) {
-	if v.Name != nil {
-		return *v.Name
-	}
-
-	return
-}
-
-type UpdateDomainResponse struct {
-	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
-	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
-	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
-	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
-	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+type UpsertWorkflowSearchAttributesEventAttributes struct {
+	DecisionTaskCompletedEventId *int64            `json:"decisionTaskCompletedEventId,omitempty"`
+	SearchAttributes             *SearchAttributes `json:"searchAttributes,omitempty"`
 }
 
-// ToWire translates a UpdateDomainResponse struct into a Thrift-level intermediate
+// ToWire translates a UpsertWorkflowSearchAttributesEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -27191,63 +31214,39 @@ type UpdateDomainResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *UpdateDomainResponse) ToWire() (wire.Value, error) {
+func (v *UpsertWorkflowSearchAttributesEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainInfo != nil {
-		w, err = v.DomainInfo.ToWire()
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Configuration != nil {
-		w, err = v.Configuration.ToWire()
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ReplicationConfiguration != nil {
-		w, err = v.ReplicationConfiguration.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.FailoverVersion != nil {
-		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.IsGlobalDomain != nil {
-		w, err = wire.NewValueBool(*(v.IsGlobalDomain)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a UpdateDomainResponse struct from its Thrift-level
+// FromWire deserializes a UpsertWorkflowSearchAttributesEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a UpdateDomainResponse struct
+// An error is returned if we were unable to build a UpsertWorkflowSearchAttributesEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -27255,55 +31254,29 @@ func (v *UpdateDomainResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v UpdateDomainResponse
+//   var v UpsertWorkflowSearchAttributesEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *UpdateDomainResponse) FromWire(w wire.Value) error {
+func (v *UpsertWorkflowSearchAttributesEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainInfo, err = _DomainInfo_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Configuration, err = _DomainConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.ReplicationConfiguration, err = _DomainReplicationConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.FailoverVersion = &x
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 50:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.IsGlobalDomain = &x
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -27315,78 +31288,47 @@ func (v *UpdateDomainResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a UpdateDomainResponse
+// String returns a readable string representation of a UpsertWorkflowSearchAttributesEventAttributes
 // struct.
-func (v *UpdateDomainResponse) String() string {
+func (v *UpsertWorkflowSearchAttributesEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [2]string
 	i := 0
-	if v.DomainInfo != nil {
-		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
-		i++
-	}
-	if v.Configuration != nil {
-		fields[i] = fmt.Sprintf("Configuration: %v", v.Configuration)
-		i++
-	}
-	if v.ReplicationConfiguration != nil {
-		fields[i] = fmt.Sprintf("ReplicationConfiguration: %v", v.ReplicationConfiguration)
-		i++
-	}
-	if v.FailoverVersion != nil {
-		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.IsGlobalDomain != nil {
-		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
 		i++
 	}
 
-	return fmt.Sprintf("UpdateDomainResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("UpsertWorkflowSearchAttributesEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this UpdateDomainResponse match the
-// provided UpdateDomainResponse.
+// Equals returns true if all the fields of this UpsertWorkflowSearchAttributesEventAttributes match the
+// provided UpsertWorkflowSearchAttributesEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *UpdateDomainResponse) Equals(rhs *UpdateDomainResponse) bool {
-	if !((v.DomainInfo == nil && rhs.DomainInfo == nil) || (v.DomainInfo != nil && rhs.DomainInfo != nil && v.DomainInfo.Equals(rhs.DomainInfo))) {
-		return false
-	}
-	if !((v.Configuration == nil && rhs.Configuration == nil) || (v.Configuration != nil && rhs.Configuration != nil && v.Configuration.Equals(rhs.Configuration))) {
-		return false
-	}
-	if !((v.ReplicationConfiguration == nil && rhs.ReplicationConfiguration == nil) || (v.ReplicationConfiguration != nil && rhs.ReplicationConfiguration != nil && v.ReplicationConfiguration.Equals(rhs.ReplicationConfiguration))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
+func (v *UpsertWorkflowSearchAttributesEventAttributes) Equals(rhs *UpsertWorkflowSearchAttributesEventAttributes) bool {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
 		return false
 	}
 
 	return true
 }
 
-// GetFailoverVersion returns the value of FailoverVersion if it is set or its
-// zero value if it is unset.
-func (v *UpdateDomainResponse) GetFailoverVersion() (o int64) {
-	if v.FailoverVersion != nil {
-		return *v.FailoverVersion
-	}
-
-	return
-}
-
-// GetIsGlobalDomain returns the value of IsGlobalDomain if it is set or its
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
-	if v.IsGlobalDomain != nil {
-		return *v.IsGlobalDomain
+func (v *UpsertWorkflowSearchAttributesEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
 	}
 
 	return
@@ -28612,13 +32554,17 @@ func (v *WorkflowExecutionConfiguration) GetChildPolicy() (o ChildPolicy) {
 }
 
 type WorkflowExecutionContinuedAsNewEventAttributes struct {
-	NewExecutionRunId                   *string       `json:"newExecutionRunId,omitempty"`
-	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
-	TaskList                            *TaskList     `json:"taskList,omitempty"`
-	Input                               []byte        `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	DecisionTaskCompletedEventId        *int64        `json:"decisionTaskCompletedEventId,omitempty"`
+	NewExecutionRunId                   *string                 `json:"newExecutionRunId,omitempty"`
+	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
+	TaskList                            *TaskList               `json:"taskList,omitempty"`
+	Input                               []byte                  `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                  `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                  `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	DecisionTaskCompletedEventId        *int64                  `json:"decisionTaskCompletedEventId,omitempty"`
+	BackoffStartIntervalInSeconds       *int32                  `json:"backoffStartIntervalInSeconds,omitempty"`
+	Initiator                           *ContinueAsNewInitiator `json:"initiator,omitempty"`
+	FailureReason                       *string                 `json:"failureReason,omitempty"`
+	FailureDetails                      []byte                  `json:"failureDetails,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionContinuedAsNewEventAttributes struct into a Thrift-level intermediate
@@ -28638,7 +32584,7 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 //   }
 func (v *WorkflowExecutionContinuedAsNewEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -28700,6 +32646,38 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) ToWire() (wire.Value, e
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.BackoffStartIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.Initiator != nil {
+		w, err = v.Initiator.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.FailureReason != nil {
+		w, err = wire.NewValueString(*(v.FailureReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.FailureDetails != nil {
+		w, err = wire.NewValueBinary(v.FailureDetails), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -28760,31 +32738,69 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) FromWire(w wire.Value)
 				}
 
 			}
-		case 50:
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExecutionStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.ExecutionStartToCloseTimeoutSeconds = &x
+				v.BackoffStartIntervalInSeconds = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 60:
+		case 90:
 			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.TaskStartToCloseTimeoutSeconds = &x
+				var x ContinueAsNewInitiator
+				x, err = _ContinueAsNewInitiator_Read(field.Value)
+				v.Initiator = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 70:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+		case 100:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailureReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				v.FailureDetails, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -28803,7 +32819,7 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [11]string
 	i := 0
 	if v.NewExecutionRunId != nil {
 		fields[i] = fmt.Sprintf("NewExecutionRunId: %v", *(v.NewExecutionRunId))
@@ -28833,6 +32849,22 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("BackoffStartIntervalInSeconds: %v", *(v.BackoffStartIntervalInSeconds))
+		i++
+	}
+	if v.Initiator != nil {
+		fields[i] = fmt.Sprintf("Initiator: %v", *(v.Initiator))
+		i++
+	}
+	if v.FailureReason != nil {
+		fields[i] = fmt.Sprintf("FailureReason: %v", *(v.FailureReason))
+		i++
+	}
+	if v.FailureDetails != nil {
+		fields[i] = fmt.Sprintf("FailureDetails: %v", v.FailureDetails)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionContinuedAsNewEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -28863,6 +32895,18 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Equals(rhs *WorkflowExe
 	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.BackoffStartIntervalInSeconds, rhs.BackoffStartIntervalInSeconds) {
+		return false
+	}
+	if !_ContinueAsNewInitiator_EqualsPtr(v.Initiator, rhs.Initiator) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FailureReason, rhs.FailureReason) {
+		return false
+	}
+	if !((v.FailureDetails == nil && rhs.FailureDetails == nil) || (v.FailureDetails != nil && rhs.FailureDetails != nil && bytes.Equal(v.FailureDetails, rhs.FailureDetails))) {
+		return false
+	}
 
 	return true
 }
@@ -28907,6 +32951,36 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetDecisionTaskComplete
 	return
 }
 
+// GetBackoffStartIntervalInSeconds returns the value of BackoffStartIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetBackoffStartIntervalInSeconds() (o int32) {
+	if v.BackoffStartIntervalInSeconds != nil {
+		return *v.BackoffStartIntervalInSeconds
+	}
+
+	return
+}
+
+// GetInitiator returns the value of Initiator if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetInitiator() (o ContinueAsNewInitiator) {
+	if v.Initiator != nil {
+		return *v.Initiator
+	}
+
+	return
+}
+
+// GetFailureReason returns the value of FailureReason if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionContinuedAsNewEventAttributes) GetFailureReason() (o string) {
+	if v.FailureReason != nil {
+		return *v.FailureReason
+	}
+
+	return
+}
+
 type WorkflowExecutionFailedEventAttributes struct {
 	Reason                       *string `json:"reason,omitempty"`
 	Details                      []byte  `json:"details,omitempty"`
@@ -29200,12 +33274,13 @@ func (v *WorkflowExecutionFilter) GetWorkflowId() (o string) {
 }
 
 type WorkflowExecutionInfo struct {
-	Execution     *WorkflowExecution            `json:"execution,omitempty"`
-	Type          *WorkflowType                 `json:"type,omitempty"`
-	StartTime     *int64                        `json:"startTime,omitempty"`
-	CloseTime     *int64                        `json:"closeTime,omitempty"`
-	CloseStatus   *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
-	HistoryLength *int64                        `json:"historyLength,omitempty"`
+	Execution        *WorkflowExecution            `json:"execution,omitempty"`
+	Type             *WorkflowType                 `json:"type,omitempty"`
+	StartTime        *int64                        `json:"startTime,omitempty"`
+	CloseTime        *int64                        `json:"closeTime,omitempty"`
+	CloseStatus      *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	HistoryLength    *int64                        `json:"historyLength,omitempty"`
+	SearchAttributes *SearchAttributes             `json:"searchAttributes,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionInfo struct into a Thrift-level intermediate
@@ -29225,7 +33300,7 @@ type WorkflowExecutionInfo struct {
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -29279,6 +33354,14 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -29360,6 +33443,14 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -29374,7 +33465,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.Execution != nil {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
@@ -29400,6 +33491,10 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("HistoryLength: %v", *(v.HistoryLength))
 		i++
 	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -29427,6 +33522,9 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_I64_EqualsPtr(v.HistoryLength, rhs.HistoryLength) {
 		return false
 	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -29648,17 +33746,24 @@ func (v *WorkflowExecutionSignaledEventAttributes) GetIdentity() (o string) {
 }
 
 type WorkflowExecutionStartedEventAttributes struct {
-	WorkflowType                        *WorkflowType      `json:"workflowType,omitempty"`
-	ParentWorkflowDomain                *string            `json:"parentWorkflowDomain,omitempty"`
-	ParentWorkflowExecution             *WorkflowExecution `json:"parentWorkflowExecution,omitempty"`
-	ParentInitiatedEventId              *int64             `json:"parentInitiatedEventId,omitempty"`
-	TaskList                            *TaskList          `json:"taskList,omitempty"`
-	Input                               []byte             `json:"input,omitempty"`
-	ExecutionStartToCloseTimeoutSeconds *int32             `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32             `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	ChildPolicy                         *ChildPolicy       `json:"childPolicy,omitempty"`
-	ContinuedExecutionRunId             *string            `json:"continuedExecutionRunId,omitempty"`
-	Identity                            *string            `json:"identity,omitempty"`
+	WorkflowType                        *WorkflowType           `json:"workflowType,omitempty"`
+	ParentWorkflowDomain                *string                 `json:"parentWorkflowDomain,omitempty"`
+	ParentWorkflowExecution             *WorkflowExecution      `json:"parentWorkflowExecution,omitempty"`
+	ParentInitiatedEventId              *int64                  `json:"parentInitiatedEventId,omitempty"`
+	TaskList                            *TaskList               `json:"taskList,omitempty"`
+	Input                               []byte                  `json:"input,omitempty"`
+	ExecutionStartToCloseTimeoutSeconds *int32                  `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                  `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	ChildPolicy                         *ChildPolicy            `json:"childPolicy,omitempty"`
+	ContinuedExecutionRunId             *string                 `json:"continuedExecutionRunId,omitempty"`
+	Initiator                           *ContinueAsNewInitiator `json:"initiator,omitempty"`
+	Identity                            *string                 `json:"identity,omitempty"`
+	RetryPolicy                         *RetryPolicy            `json:"retryPolicy,omitempty"`
+	Attempt                             *int32                  `json:"attempt,omitempty"`
+	CronSchedule                        *string                 `json:"cronSchedule,omitempty"`
+	FirstDecisionTaskBackoffSeconds     *int32                  `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	ExpirationTimestamp                 *int64                  `json:"expirationTimestamp,omitempty"`
+	SearchAttributes                    *SearchAttributes       `json:"searchAttributes,omitempty"`
 }
 
 // ToWire translates a WorkflowExecutionStartedEventAttributes struct into a Thrift-level intermediate
@@ -29678,7 +33783,7 @@ type WorkflowExecutionStartedEventAttributes struct {
 //   }
 func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [18]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -29764,6 +33869,14 @@ func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 54, Value: w}
 		i++
 	}
+	if v.Initiator != nil {
+		w, err = v.Initiator.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 55, Value: w}
+		i++
+	}
 	if v.Identity != nil {
 		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
@@ -29772,6 +33885,54 @@ func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.CronSchedule != nil {
+		w, err = wire.NewValueString(*(v.CronSchedule)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FirstDecisionTaskBackoffSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -29889,6 +34050,16 @@ func (v *WorkflowExecutionStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 55:
+			if field.Value.Type() == wire.TI32 {
+				var x ContinueAsNewInitiator
+				x, err = _ContinueAsNewInitiator_Read(field.Value)
+				v.Initiator = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		case 60:
 			if field.Value.Type() == wire.TBinary {
@@ -29899,6 +34070,62 @@ func (v *WorkflowExecutionStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.CronSchedule = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FirstDecisionTaskBackoffSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpirationTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -29913,7 +34140,7 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [18]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -29955,10 +34182,38 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("ContinuedExecutionRunId: %v", *(v.ContinuedExecutionRunId))
 		i++
 	}
+	if v.Initiator != nil {
+		fields[i] = fmt.Sprintf("Initiator: %v", *(v.Initiator))
+		i++
+	}
 	if v.Identity != nil {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.CronSchedule != nil {
+		fields[i] = fmt.Sprintf("CronSchedule: %v", *(v.CronSchedule))
+		i++
+	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		fields[i] = fmt.Sprintf("FirstDecisionTaskBackoffSeconds: %v", *(v.FirstDecisionTaskBackoffSeconds))
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -29998,9 +34253,30 @@ func (v *WorkflowExecutionStartedEventAttributes) Equals(rhs *WorkflowExecutionS
 	if !_String_EqualsPtr(v.ContinuedExecutionRunId, rhs.ContinuedExecutionRunId) {
 		return false
 	}
+	if !_ContinueAsNewInitiator_EqualsPtr(v.Initiator, rhs.Initiator) {
+		return false
+	}
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_String_EqualsPtr(v.CronSchedule, rhs.CronSchedule) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.FirstDecisionTaskBackoffSeconds, rhs.FirstDecisionTaskBackoffSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -30065,6 +34341,16 @@ func (v *WorkflowExecutionStartedEventAttributes) GetContinuedExecutionRunId() (
 	return
 }
 
+// GetInitiator returns the value of Initiator if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetInitiator() (o ContinueAsNewInitiator) {
+	if v.Initiator != nil {
+		return *v.Initiator
+	}
+
+	return
+}
+
 // GetIdentity returns the value of Identity if it is set or its
 // zero value if it is unset.
 func (v *WorkflowExecutionStartedEventAttributes) GetIdentity() (o string) {
@@ -30075,6 +34361,46 @@ func (v *WorkflowExecutionStartedEventAttributes) GetIdentity() (o string) {
 	return
 }
 
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetAttempt() (o int32) {
+	if v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// GetCronSchedule returns the value of CronSchedule if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetCronSchedule() (o string) {
+	if v.CronSchedule != nil {
+		return *v.CronSchedule
+	}
+
+	return
+}
+
+// GetFirstDecisionTaskBackoffSeconds returns the value of FirstDecisionTaskBackoffSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetFirstDecisionTaskBackoffSeconds() (o int32) {
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		return *v.FirstDecisionTaskBackoffSeconds
+	}
+
+	return
+}
+
+// GetExpirationTimestamp returns the value of ExpirationTimestamp if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionStartedEventAttributes) GetExpirationTimestamp() (o int64) {
+	if v.ExpirationTimestamp != nil {
+		return *v.ExpirationTimestamp
+	}
+
+	return
+}
+
 type WorkflowExecutionTerminatedEventAttributes struct {
 	Reason   *string `json:"reason,omitempty"`
 	Details  []byte  `json:"details,omitempty"`
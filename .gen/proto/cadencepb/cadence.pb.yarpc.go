@@ -0,0 +1,1596 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-yarpc-go
+// source: uber/cadence/cadence.proto
+// DO NOT EDIT!
+
+package cadencepb
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/encoding/protobuf"
+)
+
+// WorkflowServiceYARPCClient is the YARPC client-side interface for the WorkflowService service.
+type WorkflowServiceYARPCClient interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest, ...yarpc.CallOption) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest, ...yarpc.CallOption) (*DescribeDomainResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest, ...yarpc.CallOption) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest, ...yarpc.CallOption) (*DeprecateDomainResponse, error)
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest, ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error)
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest, ...yarpc.CallOption) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest, ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest, ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest, ...yarpc.CallOption) (*PollForActivityTaskResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error)
+	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error)
+	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error)
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest, ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
+	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error)
+	ListOpenWorkflowExecutions(context.Context, *ListOpenWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListOpenWorkflowExecutionsResponse, error)
+	ListClosedWorkflowExecutions(context.Context, *ListClosedWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListClosedWorkflowExecutionsResponse, error)
+	ListWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListWorkflowExecutionsResponse, error)
+	CountWorkflowExecutions(context.Context, *CountWorkflowExecutionsRequest, ...yarpc.CallOption) (*CountWorkflowExecutionsResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest, ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error)
+	QueryWorkflow(context.Context, *QueryWorkflowRequest, ...yarpc.CallOption) (*QueryWorkflowResponse, error)
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
+}
+
+// NewWorkflowServiceYARPCClient builds a new YARPC client for the WorkflowService service.
+func NewWorkflowServiceYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) WorkflowServiceYARPCClient {
+	return &_WorkflowServiceYARPCCaller{protobuf.NewClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.WorkflowService",
+			ClientConfig: clientConfig,
+			Options:      options,
+		},
+	)}
+}
+
+// WorkflowServiceYARPCServer is the YARPC server-side interface for the WorkflowService service.
+type WorkflowServiceYARPCServer interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest) (*DescribeDomainResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest) (*DeprecateDomainResponse, error)
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error)
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest) (*PollForActivityTaskResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest) (*RespondActivityTaskCanceledByIDResponse, error)
+	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest) (*RequestCancelWorkflowExecutionResponse, error)
+	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest) (*SignalWorkflowExecutionResponse, error)
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
+	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
+	ListOpenWorkflowExecutions(context.Context, *ListOpenWorkflowExecutionsRequest) (*ListOpenWorkflowExecutionsResponse, error)
+	ListClosedWorkflowExecutions(context.Context, *ListClosedWorkflowExecutionsRequest) (*ListClosedWorkflowExecutionsResponse, error)
+	ListWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
+	CountWorkflowExecutions(context.Context, *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest) (*RespondQueryTaskCompletedResponse, error)
+	QueryWorkflow(context.Context, *QueryWorkflowRequest) (*QueryWorkflowResponse, error)
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
+}
+
+// BuildWorkflowServiceYARPCProcedures prepares an implementation of the WorkflowService service for YARPC registration.
+func BuildWorkflowServiceYARPCProcedures(server WorkflowServiceYARPCServer) []transport.Procedure {
+	handler := &_WorkflowServiceYARPCHandler{server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.WorkflowService",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "RegisterDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RegisterDomain,
+							NewRequest: newWorkflowServiceServiceRegisterDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeDomain,
+							NewRequest: newWorkflowServiceServiceDescribeDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.UpdateDomain,
+							NewRequest: newWorkflowServiceServiceUpdateDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DeprecateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DeprecateDomain,
+							NewRequest: newWorkflowServiceServiceDeprecateDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "StartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.StartWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceStartWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "GetWorkflowExecutionHistory",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.GetWorkflowExecutionHistory,
+							NewRequest: newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "PollForDecisionTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.PollForDecisionTask,
+							NewRequest: newWorkflowServiceServicePollForDecisionTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondDecisionTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondDecisionTaskFailed,
+							NewRequest: newWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "PollForActivityTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.PollForActivityTask,
+							NewRequest: newWorkflowServiceServicePollForActivityTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeat",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RecordActivityTaskHeartbeat,
+							NewRequest: newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeatByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RecordActivityTaskHeartbeatByID,
+							NewRequest: newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompletedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCompletedByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskFailed,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskFailedByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceled",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCanceled,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceledByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCanceledByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RequestCancelWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RequestCancelWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "SignalWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.SignalWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "SignalWithStartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.SignalWithStartWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "TerminateWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.TerminateWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ResetWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ResetWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceResetWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListOpenWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListOpenWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListClosedWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListClosedWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "CountWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.CountWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondQueryTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondQueryTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "QueryWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.QueryWorkflow,
+							NewRequest: newWorkflowServiceServiceQueryWorkflowYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeTaskList,
+							NewRequest: newWorkflowServiceServiceDescribeTaskListYARPCRequest,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+		},
+	)
+}
+
+type _WorkflowServiceYARPCCaller struct {
+	client protobuf.Client
+}
+
+func (c *_WorkflowServiceYARPCCaller) RegisterDomain(ctx context.Context, request *RegisterDomainRequest, options ...yarpc.CallOption) (*RegisterDomainResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RegisterDomain", request, newWorkflowServiceServiceRegisterDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RegisterDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRegisterDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeDomain(ctx context.Context, request *DescribeDomainRequest, options ...yarpc.CallOption) (*DescribeDomainResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "DescribeDomain", request, newWorkflowServiceServiceDescribeDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) UpdateDomain(ctx context.Context, request *UpdateDomainRequest, options ...yarpc.CallOption) (*UpdateDomainResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "UpdateDomain", request, newWorkflowServiceServiceUpdateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceUpdateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DeprecateDomain(ctx context.Context, request *DeprecateDomainRequest, options ...yarpc.CallOption) (*DeprecateDomainResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "DeprecateDomain", request, newWorkflowServiceServiceDeprecateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeprecateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDeprecateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) StartWorkflowExecution(ctx context.Context, request *StartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "StartWorkflowExecution", request, newWorkflowServiceServiceStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) GetWorkflowExecutionHistory(ctx context.Context, request *GetWorkflowExecutionHistoryRequest, options ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "GetWorkflowExecutionHistory", request, newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetWorkflowExecutionHistoryResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PollForDecisionTask(ctx context.Context, request *PollForDecisionTaskRequest, options ...yarpc.CallOption) (*PollForDecisionTaskResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "PollForDecisionTask", request, newWorkflowServiceServicePollForDecisionTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForDecisionTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePollForDecisionTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondDecisionTaskCompleted(ctx context.Context, request *RespondDecisionTaskCompletedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RespondDecisionTaskCompleted", request, newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondDecisionTaskFailed(ctx context.Context, request *RespondDecisionTaskFailedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RespondDecisionTaskFailed", request, newWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PollForActivityTask(ctx context.Context, request *PollForActivityTaskRequest, options ...yarpc.CallOption) (*PollForActivityTaskResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "PollForActivityTask", request, newWorkflowServiceServicePollForActivityTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForActivityTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePollForActivityTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RecordActivityTaskHeartbeat(ctx context.Context, request *RecordActivityTaskHeartbeatRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RecordActivityTaskHeartbeat", request, newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RecordActivityTaskHeartbeatByID(ctx context.Context, request *RecordActivityTaskHeartbeatByIDRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RecordActivityTaskHeartbeatByID", request, newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCompleted(ctx context.Context, request *RespondActivityTaskCompletedRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RespondActivityTaskCompleted", request, newWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCompletedByID(ctx context.Context, request *RespondActivityTaskCompletedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RespondActivityTaskCompletedByID", request, newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskFailed(ctx context.Context, request *RespondActivityTaskFailedRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RespondActivityTaskFailed", request, newWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskFailedByID(ctx context.Context, request *RespondActivityTaskFailedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RespondActivityTaskFailedByID", request, newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCanceled(ctx context.Context, request *RespondActivityTaskCanceledRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RespondActivityTaskCanceled", request, newWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCanceledByID(ctx context.Context, request *RespondActivityTaskCanceledByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RespondActivityTaskCanceledByID", request, newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RequestCancelWorkflowExecution(ctx context.Context, request *RequestCancelWorkflowExecutionRequest, options ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RequestCancelWorkflowExecution", request, newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RequestCancelWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) SignalWorkflowExecution(ctx context.Context, request *SignalWorkflowExecutionRequest, options ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "SignalWorkflowExecution", request, newWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*SignalWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) SignalWithStartWorkflowExecution(ctx context.Context, request *SignalWithStartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "SignalWithStartWorkflowExecution", request, newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) TerminateWorkflowExecution(ctx context.Context, request *TerminateWorkflowExecutionRequest, options ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "TerminateWorkflowExecution", request, newWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*TerminateWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ResetWorkflowExecution(ctx context.Context, request *ResetWorkflowExecutionRequest, options ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "ResetWorkflowExecution", request, newWorkflowServiceServiceResetWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceResetWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListOpenWorkflowExecutions(ctx context.Context, request *ListOpenWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListOpenWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "ListOpenWorkflowExecutions", request, newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListOpenWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListClosedWorkflowExecutions(ctx context.Context, request *ListClosedWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListClosedWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "ListClosedWorkflowExecutions", request, newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListClosedWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "ListWorkflowExecutions", request, newWorkflowServiceServiceListWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest, options ...yarpc.CallOption) (*CountWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "CountWorkflowExecutions", request, newWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*CountWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondQueryTaskCompleted(ctx context.Context, request *RespondQueryTaskCompletedRequest, options ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "RespondQueryTaskCompleted", request, newWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondQueryTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) QueryWorkflow(ctx context.Context, request *QueryWorkflowRequest, options ...yarpc.CallOption) (*QueryWorkflowResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "QueryWorkflow", request, newWorkflowServiceServiceQueryWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*QueryWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceQueryWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeWorkflowExecution(ctx context.Context, request *DescribeWorkflowExecutionRequest, options ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "DescribeWorkflowExecution", request, newWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeTaskList(ctx context.Context, request *DescribeTaskListRequest, options ...yarpc.CallOption) (*DescribeTaskListResponse, error) {
+	responseMessage, err := c.client.Call(ctx, "DescribeTaskList", request, newWorkflowServiceServiceDescribeTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _WorkflowServiceYARPCHandler struct {
+	server WorkflowServiceYARPCServer
+}
+
+func (h *_WorkflowServiceYARPCHandler) RegisterDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RegisterDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RegisterDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRegisterDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RegisterDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) UpdateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceUpdateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DeprecateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeprecateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeprecateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDeprecateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeprecateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) StartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *StartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*StartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.StartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) GetWorkflowExecutionHistory(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetWorkflowExecutionHistoryRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetWorkflowExecutionHistoryRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetWorkflowExecutionHistory(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PollForDecisionTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForDecisionTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForDecisionTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePollForDecisionTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForDecisionTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondDecisionTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondDecisionTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PollForActivityTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForActivityTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForActivityTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePollForActivityTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForActivityTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RecordActivityTaskHeartbeat(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeat(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeatByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCompletedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompletedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskFailedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCanceled(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceled(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCanceledByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceledByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RequestCancelWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RequestCancelWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RequestCancelWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RequestCancelWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) SignalWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) SignalWithStartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalWithStartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalWithStartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalWithStartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) TerminateWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *TerminateWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*TerminateWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.TerminateWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ResetWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceResetWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListOpenWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListOpenWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListOpenWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListOpenWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListClosedWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListClosedWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListClosedWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListClosedWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) CountWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *CountWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*CountWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.CountWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondQueryTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondQueryTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondQueryTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondQueryTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) QueryWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *QueryWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*QueryWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceQueryWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.QueryWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newWorkflowServiceServiceRegisterDomainYARPCRequest() proto.Message {
+	return &RegisterDomainRequest{}
+}
+
+func newWorkflowServiceServiceRegisterDomainYARPCResponse() proto.Message {
+	return &RegisterDomainResponse{}
+}
+
+func newWorkflowServiceServiceDescribeDomainYARPCRequest() proto.Message {
+	return &DescribeDomainRequest{}
+}
+
+func newWorkflowServiceServiceDescribeDomainYARPCResponse() proto.Message {
+	return &DescribeDomainResponse{}
+}
+
+func newWorkflowServiceServiceUpdateDomainYARPCRequest() proto.Message {
+	return &UpdateDomainRequest{}
+}
+
+func newWorkflowServiceServiceUpdateDomainYARPCResponse() proto.Message {
+	return &UpdateDomainResponse{}
+}
+
+func newWorkflowServiceServiceDeprecateDomainYARPCRequest() proto.Message {
+	return &DeprecateDomainRequest{}
+}
+
+func newWorkflowServiceServiceDeprecateDomainYARPCResponse() proto.Message {
+	return &DeprecateDomainResponse{}
+}
+
+func newWorkflowServiceServiceStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &StartWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest() proto.Message {
+	return &GetWorkflowExecutionHistoryRequest{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse() proto.Message {
+	return &GetWorkflowExecutionHistoryResponse{}
+}
+
+func newWorkflowServiceServicePollForDecisionTaskYARPCRequest() proto.Message {
+	return &PollForDecisionTaskRequest{}
+}
+
+func newWorkflowServiceServicePollForDecisionTaskYARPCResponse() proto.Message {
+	return &PollForDecisionTaskResponse{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskFailedRequest{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskFailedResponse{}
+}
+
+func newWorkflowServiceServicePollForActivityTaskYARPCRequest() proto.Message {
+	return &PollForActivityTaskRequest{}
+}
+
+func newWorkflowServiceServicePollForActivityTaskYARPCResponse() proto.Message {
+	return &PollForActivityTaskResponse{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatRequest{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatByIDRequest{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedByIDResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedByIDResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledByIDResponse{}
+}
+
+func newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest() proto.Message {
+	return &RequestCancelWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse() proto.Message {
+	return &RequestCancelWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse() proto.Message {
+	return &SignalWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalWithStartWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest() proto.Message {
+	return &TerminateWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse() proto.Message {
+	return &TerminateWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceResetWorkflowExecutionYARPCRequest() proto.Message {
+	return &ResetWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceResetWorkflowExecutionYARPCResponse() proto.Message {
+	return &ResetWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListOpenWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListOpenWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListClosedWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListClosedWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceListWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest() proto.Message {
+	return &CountWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse() proto.Message {
+	return &CountWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest() proto.Message {
+	return &RespondQueryTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse() proto.Message {
+	return &RespondQueryTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceQueryWorkflowYARPCRequest() proto.Message {
+	return &QueryWorkflowRequest{}
+}
+
+func newWorkflowServiceServiceQueryWorkflowYARPCResponse() proto.Message {
+	return &QueryWorkflowResponse{}
+}
+
+func newWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
+	return &DescribeWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse() proto.Message {
+	return &DescribeWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceDescribeTaskListYARPCRequest() proto.Message {
+	return &DescribeTaskListRequest{}
+}
+
+func newWorkflowServiceServiceDescribeTaskListYARPCResponse() proto.Message {
+	return &DescribeTaskListResponse{}
+}
+
+var (
+	emptyWorkflowServiceServiceRegisterDomainYARPCRequest                    = &RegisterDomainRequest{}
+	emptyWorkflowServiceServiceRegisterDomainYARPCResponse                   = &RegisterDomainResponse{}
+	emptyWorkflowServiceServiceDescribeDomainYARPCRequest                    = &DescribeDomainRequest{}
+	emptyWorkflowServiceServiceDescribeDomainYARPCResponse                   = &DescribeDomainResponse{}
+	emptyWorkflowServiceServiceUpdateDomainYARPCRequest                      = &UpdateDomainRequest{}
+	emptyWorkflowServiceServiceUpdateDomainYARPCResponse                     = &UpdateDomainResponse{}
+	emptyWorkflowServiceServiceDeprecateDomainYARPCRequest                   = &DeprecateDomainRequest{}
+	emptyWorkflowServiceServiceDeprecateDomainYARPCResponse                  = &DeprecateDomainResponse{}
+	emptyWorkflowServiceServiceStartWorkflowExecutionYARPCRequest            = &StartWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceStartWorkflowExecutionYARPCResponse           = &StartWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest       = &GetWorkflowExecutionHistoryRequest{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse      = &GetWorkflowExecutionHistoryResponse{}
+	emptyWorkflowServiceServicePollForDecisionTaskYARPCRequest               = &PollForDecisionTaskRequest{}
+	emptyWorkflowServiceServicePollForDecisionTaskYARPCResponse              = &PollForDecisionTaskResponse{}
+	emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest      = &RespondDecisionTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse     = &RespondDecisionTaskCompletedResponse{}
+	emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest         = &RespondDecisionTaskFailedRequest{}
+	emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse        = &RespondDecisionTaskFailedResponse{}
+	emptyWorkflowServiceServicePollForActivityTaskYARPCRequest               = &PollForActivityTaskRequest{}
+	emptyWorkflowServiceServicePollForActivityTaskYARPCResponse              = &PollForActivityTaskResponse{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest       = &RecordActivityTaskHeartbeatRequest{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse      = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest   = &RecordActivityTaskHeartbeatByIDRequest{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse  = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest      = &RespondActivityTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse     = &RespondActivityTaskCompletedResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest  = &RespondActivityTaskCompletedByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse = &RespondActivityTaskCompletedByIDResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest         = &RespondActivityTaskFailedRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse        = &RespondActivityTaskFailedResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest     = &RespondActivityTaskFailedByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse    = &RespondActivityTaskFailedByIDResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest       = &RespondActivityTaskCanceledRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse      = &RespondActivityTaskCanceledResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest   = &RespondActivityTaskCanceledByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse  = &RespondActivityTaskCanceledByIDResponse{}
+	emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest    = &RequestCancelWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse   = &RequestCancelWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest           = &SignalWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse          = &SignalWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest  = &SignalWithStartWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse = &StartWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest        = &TerminateWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse       = &TerminateWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceResetWorkflowExecutionYARPCRequest            = &ResetWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceResetWorkflowExecutionYARPCResponse           = &ResetWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest        = &ListOpenWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse       = &ListOpenWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest      = &ListClosedWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse     = &ListClosedWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceListWorkflowExecutionsYARPCRequest            = &ListWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListWorkflowExecutionsYARPCResponse           = &ListWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCRequest           = &CountWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceCountWorkflowExecutionsYARPCResponse          = &CountWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest         = &RespondQueryTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse        = &RespondQueryTaskCompletedResponse{}
+	emptyWorkflowServiceServiceQueryWorkflowYARPCRequest                     = &QueryWorkflowRequest{}
+	emptyWorkflowServiceServiceQueryWorkflowYARPCResponse                    = &QueryWorkflowResponse{}
+	emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest         = &DescribeWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse        = &DescribeWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceDescribeTaskListYARPCRequest                  = &DescribeTaskListRequest{}
+	emptyWorkflowServiceServiceDescribeTaskListYARPCResponse                 = &DescribeTaskListResponse{}
+)
@@ -21,6 +21,9 @@
 package client
 
 import (
+	"errors"
+
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
@@ -32,23 +35,33 @@ import (
 type Factory interface {
 	NewHistoryClient() (history.Client, error)
 	NewMatchingClient() (matching.Client, error)
+	// NewRemoteAdminClient creates a client of the admin API of the frontend of a remote cluster, each client opens
+	// its own outbound connection so callers are expected to keep it
+	NewRemoteAdminClient(clusterName string) (adminserviceclient.Interface, error)
 }
 
+// ErrUnknownRemoteCluster is returned when creating the client of a remote cluster whose frontend address is not
+// configured
+var ErrUnknownRemoteCluster = errors.New("frontend address of the remote cluster is not configured")
+
 type rpcClientFactory struct {
-	df                    common.RPCFactory
-	monitor               membership.Monitor
-	metricsClient         metrics.Client
-	numberOfHistoryShards int
+	df                       common.RPCFactory
+	monitor                  membership.Monitor
+	metricsClient            metrics.Client
+	numberOfHistoryShards    int
+	clusterFrontendAddresses map[string]string
 }
 
 // NewRPCClientFactory creates an instance of client factory that knows how to dispatch RPC calls.
 func NewRPCClientFactory(df common.RPCFactory,
-	monitor membership.Monitor, metricsClient metrics.Client, numberOfHistoryShards int) Factory {
+	monitor membership.Monitor, metricsClient metrics.Client, numberOfHistoryShards int,
+	clusterFrontendAddresses map[string]string) Factory {
 	return &rpcClientFactory{
-		df:                    df,
-		monitor:               monitor,
-		metricsClient:         metricsClient,
-		numberOfHistoryShards: numberOfHistoryShards,
+		df:                       df,
+		monitor:                  monitor,
+		metricsClient:            metricsClient,
+		numberOfHistoryShards:    numberOfHistoryShards,
+		clusterFrontendAddresses: clusterFrontendAddresses,
 	}
 }
 
@@ -73,3 +86,13 @@ func (cf *rpcClientFactory) NewMatchingClient() (matching.Client, error) {
 	}
 	return client, nil
 }
+
+func (cf *rpcClientFactory) NewRemoteAdminClient(clusterName string) (adminserviceclient.Interface, error) {
+	address, ok := cf.clusterFrontendAddresses[clusterName]
+	if !ok {
+		return nil, ErrUnknownRemoteCluster
+	}
+
+	dispatcher := cf.df.CreateDispatcherForOutbound("cadence-remote-admin-client", common.FrontendServiceName, address)
+	return adminserviceclient.New(dispatcher.ClientConfig(common.FrontendServiceName)), nil
+}
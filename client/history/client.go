@@ -110,6 +110,57 @@ func (c *clientImpl) GetMutableState(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeMutableState(
+	ctx context.Context,
+	request *h.DescribeMutableStateRequest,
+	opts ...yarpc.CallOption) (*h.DescribeMutableStateResponse, error) {
+	client, err := c.getHostForRequest(*request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.DescribeMutableStateResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeMutableState(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeHistoryHost(
+	ctx context.Context,
+	request *workflow.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeHistoryHostResponse, error) {
+
+	var client historyserviceclient.Interface
+	var err error
+	switch {
+	case request.HostAddress != nil:
+		client = c.getThriftClient(request.GetHostAddress())
+	case request.ShardIdForHost != nil:
+		client, err = c.getHostForShard(int(request.GetShardIdForHost()))
+	case request.ExecutionForHost != nil:
+		client, err = c.getHostForRequest(request.ExecutionForHost.GetWorkflowId())
+	default:
+		return nil, &workflow.BadRequestError{Message: "Host address, shard ID or workflow execution must be provided."}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeHistoryHost(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResetStickyTaskList(
 	ctx context.Context,
 	request *h.ResetStickyTaskListRequest,
@@ -441,6 +492,31 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) ResetWorkflowExecution(
+	ctx context.Context,
+	request *h.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*workflow.ResetWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(*request.ResetRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.ResetWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ResetWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, err
+}
+
 func (c *clientImpl) ScheduleDecisionTask(
 	ctx context.Context,
 	request *h.ScheduleDecisionTaskRequest,
@@ -495,9 +571,109 @@ func (c *clientImpl) ReplicateEvents(
 	return err
 }
 
+func (c *clientImpl) GetReplicationMessages(
+	ctx context.Context,
+	request *h.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (*h.GetReplicationMessagesResponse, error) {
+	client, err := c.getHostForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.GetReplicationMessagesResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GetReplicationMessages(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *h.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption) (*h.ShardReplicationStatus, error) {
+	client, err := c.getHostForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.ShardReplicationStatus
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GetReplicationStatus(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetDomainReplicationLag(
+	ctx context.Context,
+	request *h.GetDomainReplicationLagRequest,
+	opts ...yarpc.CallOption) (*h.GetDomainReplicationLagResponse, error) {
+	client, err := c.getHostForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.GetDomainReplicationLagResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GetDomainReplicationLag(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) GetWorkflowReplicationTasks(
+	ctx context.Context,
+	request *h.GetWorkflowReplicationTasksRequest,
+	opts ...yarpc.CallOption) (*h.GetWorkflowReplicationTasksResponse, error) {
+	client, err := c.getHostForRequest(request.WorkflowExecution.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *h.GetWorkflowReplicationTasksResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.GetWorkflowReplicationTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) getHostForRequest(workflowID string) (historyserviceclient.Interface, error) {
 	key := common.WorkflowIDToHistoryShard(workflowID, c.numberOfShards)
-	host, err := c.resolver.Lookup(string(key))
+	return c.getHostForShard(key)
+}
+
+func (c *clientImpl) getHostForShard(shardID int) (historyserviceclient.Interface, error) {
+	host, err := c.resolver.Lookup(string(shardID))
 	if err != nil {
 		return nil, err
 	}
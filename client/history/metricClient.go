@@ -78,6 +78,40 @@ func (c *metricClient) GetMutableState(
 	return resp, err
 }
 
+func (c *metricClient) DescribeMutableState(
+	context context.Context,
+	request *h.DescribeMutableStateRequest,
+	opts ...yarpc.CallOption) (*h.DescribeMutableStateResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeMutableStateScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeMutableStateScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeMutableState(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeMutableStateScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeHistoryHost(
+	context context.Context,
+	request *shared.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeHistoryHostResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeHistoryHostScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeHistoryHostScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeHistoryHost(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeHistoryHostScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) ResetStickyTaskList(
 	context context.Context,
 	request *h.ResetStickyTaskListRequest,
@@ -333,6 +367,23 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) ResetWorkflowExecution(
+	context context.Context,
+	request *h.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.ResetWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.ResetWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResetWorkflowExecutionScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) ScheduleDecisionTask(
 	context context.Context,
 	request *h.ScheduleDecisionTaskRequest,
@@ -383,3 +434,71 @@ func (c *metricClient) ReplicateEvents(
 
 	return err
 }
+
+func (c *metricClient) GetReplicationMessages(
+	context context.Context,
+	request *h.GetReplicationMessagesRequest,
+	opts ...yarpc.CallOption) (*h.GetReplicationMessagesResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationMessagesScope, metrics.CadenceLatency)
+	resp, err := c.client.GetReplicationMessages(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationMessagesScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) GetReplicationStatus(
+	context context.Context,
+	request *h.GetReplicationStatusRequest,
+	opts ...yarpc.CallOption) (*h.ShardReplicationStatus, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationStatusScope, metrics.CadenceLatency)
+	resp, err := c.client.GetReplicationStatus(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationStatusScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) GetDomainReplicationLag(
+	context context.Context,
+	request *h.GetDomainReplicationLagRequest,
+	opts ...yarpc.CallOption) (*h.GetDomainReplicationLagResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetDomainReplicationLagScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetDomainReplicationLagScope, metrics.CadenceLatency)
+	resp, err := c.client.GetDomainReplicationLag(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetDomainReplicationLagScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) GetWorkflowReplicationTasks(
+	context context.Context,
+	request *h.GetWorkflowReplicationTasksRequest,
+	opts ...yarpc.CallOption) (*h.GetWorkflowReplicationTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetWorkflowReplicationTasksScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetWorkflowReplicationTasksScope, metrics.CadenceLatency)
+	resp, err := c.client.GetWorkflowReplicationTasks(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetWorkflowReplicationTasksScope, metrics.HistoryClientFailures)
+	}
+
+	return resp, err
+}
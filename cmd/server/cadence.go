@@ -27,6 +27,7 @@ import (
 
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/tools/cassandra"
+	"github.com/uber/cadence/tools/sql"
 
 	"github.com/urfave/cli"
 )
@@ -58,12 +59,23 @@ func startHandler(c *cli.Context) {
 	}
 	log.Printf("config=\n%v\n", cfg.String())
 
-	cassCfg := cfg.Cassandra
+	if err := cfg.Validate(); err != nil {
+		log.Fatal("Invalid config.", err)
+	}
+
 	dir, err := os.Getwd()
 	if err != nil {
 		log.Fatal("Unable to get current directory")
 	}
-	if err := cassandra.VerifyCompatibleVersion(cassCfg, dir); err != nil {
+	switch cfg.Persistence.GetStoreType() {
+	case config.StoreTypeSQL:
+		err = sql.VerifyCompatibleVersion(*cfg.Persistence.SQL, dir)
+	case config.StoreTypeMemory:
+		// the in-memory store has no schema
+	default:
+		err = cassandra.VerifyCompatibleVersion(cfg.Cassandra, dir)
+	}
+	if err != nil {
 		log.Fatal("Incompatible versions", err)
 	}
 
@@ -21,11 +21,15 @@
 package main
 
 import (
+	"io"
 	"log"
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -33,8 +37,6 @@ import (
 	"github.com/uber/cadence/service/history"
 	"github.com/uber/cadence/service/matching"
 	"github.com/uber/cadence/service/worker"
-
-	"go.uber.org/zap"
 )
 
 type (
@@ -42,7 +44,11 @@ type (
 		name   string
 		cfg    *config.Config
 		doneC  chan struct{}
+		stopC  chan struct{}
 		daemon common.Daemon
+
+		// tracerCloser flushes the spans of the service when it stops
+		tracerCloser io.Closer
 	}
 )
 
@@ -60,6 +66,7 @@ func newServer(service string, cfg *config.Config) common.Daemon {
 		cfg:   cfg,
 		name:  service,
 		doneC: make(chan struct{}),
+		stopC: make(chan struct{}),
 	}
 }
 
@@ -78,6 +85,8 @@ func (s *server) Stop() {
 		return
 	}
 
+	close(s.stopC)
+
 	select {
 	case <-s.doneC:
 	default:
@@ -88,6 +97,10 @@ func (s *server) Stop() {
 			log.Printf("timed out waiting for server %v to exit\n", s.name)
 		}
 	}
+
+	if s.tracerCloser != nil {
+		s.tracerCloser.Close()
+	}
 }
 
 // startService starts a service with the given name and config
@@ -97,18 +110,37 @@ func (s *server) startService() common.Daemon {
 
 	params := service.BootstrapParams{}
 	params.Name = "cadence-" + s.name
-	params.Logger = s.cfg.Log.NewBarkLogger()
+	svcCfg := s.cfg.Services[s.name]
+	logCfg := &s.cfg.Log
+	if svcCfg.Log != nil {
+		logCfg = svcCfg.Log
+	}
+	zapLogger := logCfg.NewZapLogger()
+	params.Logger = logging.NewLogger(zapLogger)
 	params.CassandraConfig = s.cfg.Cassandra
+	params.PersistenceConfig = s.cfg.Persistence
 
 	params.RingpopFactory, err = s.cfg.Ringpop.NewFactory()
 	if err != nil {
 		log.Fatalf("error creating ringpop factory: %v", err)
 	}
 
-	svcCfg := s.cfg.Services[s.name]
+	params.Tracer, s.tracerCloser, err = s.cfg.Tracing.NewTracer(params.Name)
+	if err != nil {
+		log.Fatalf("error creating tracer: %v", err)
+	}
+
 	params.MetricScope = svcCfg.Metrics.NewScope()
-	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger)
+	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger, params.Tracer)
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
+	params.HTTPGatewayAddress, err = svcCfg.RPC.HTTPGatewayAddress()
+	if err != nil {
+		log.Fatalf("error creating http gateway address: %v", err)
+	}
+	params.HTTPGatewayTLSConfig, err = svcCfg.RPC.HTTPGatewayTLSConfig()
+	if err != nil {
+		log.Fatalf("error creating http gateway tls config: %v", err)
+	}
 	params.ClusterMetadata = cluster.NewMetadata(
 		s.cfg.ClustersInfo.EnableGlobalDomain,
 		s.cfg.ClustersInfo.FailoverVersionIncrement,
@@ -116,14 +148,33 @@ func (s *server) startService() common.Daemon {
 		s.cfg.ClustersInfo.CurrentClusterName,
 		s.cfg.ClustersInfo.ClusterInitialFailoverVersions,
 	)
-	// TODO: We need to switch Cadence to use zap logger, until then just pass zap.NewNop
 	if params.ClusterMetadata.IsGlobalDomainEnabled() {
-		params.MessagingClient = s.cfg.Kafka.NewKafkaClient(zap.NewNop(), params.Logger, params.MetricScope)
+		switch s.cfg.Replication.GetTransport() {
+		case messaging.TransportRPC:
+			params.MessagingClient = s.cfg.Replication.RPC.NewRPCClient(params.RPCFactory,
+				s.cfg.Cassandra.NumHistoryShards, params.Logger)
+		default:
+			params.MessagingClient = s.cfg.Kafka.NewKafkaClient(zapLogger, params.Logger, params.MetricScope)
+		}
+		params.ClusterFrontendAddresses = s.cfg.Replication.RPC.GetFrontendAddresses()
 	} else {
 		params.MessagingClient = nil
 	}
 
+	params.BlobstoreClient, err = s.cfg.Archival.NewBlobstoreClient()
+	if err != nil {
+		log.Fatalf("error creating blobstore client: %v", err)
+	}
+
+	params.Authorizer = authorization.NewAuthorizer(&s.cfg.Authorization)
+
 	params.DynamicConfig = dynamicconfig.NewNopClient()
+	if len(s.cfg.DynamicConfigClient.Filepath) > 0 {
+		params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger, s.stopC)
+		if err != nil {
+			log.Fatalf("error creating file based dynamic config client: %v", err)
+		}
+	}
 
 	var daemon common.Daemon
 
@@ -0,0 +1,104 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archival
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/blobstore"
+)
+
+const (
+	historyKeySuffix    = "history"
+	visibilityKeySuffix = "visibility"
+)
+
+type (
+	// HistoryBlob is a page of the archived history of a workflow execution.  The history is archived as a
+	// sequence of pages and the first page is uploaded last, so the history is completely archived once the
+	// first page exists.
+	HistoryBlob struct {
+		DomainID   string `json:"domainId"`
+		WorkflowID string `json:"workflowId"`
+		RunID      string `json:"runId"`
+		// Page is the index of the page, starting at zero
+		Page int `json:"page"`
+		// PageCount is the number of pages of the history, it is only set on the first page
+		PageCount int `json:"pageCount,omitempty"`
+		// IsLast is set on the last page of the history
+		IsLast bool                   `json:"isLast"`
+		Events []*shared.HistoryEvent `json:"events"`
+	}
+)
+
+// UploadHistoryBlob uploads a page of the archived history of a workflow execution
+func UploadHistoryBlob(ctx context.Context, client blobstore.Client, bucket string, historyBlob *HistoryBlob) error {
+	blob, err := json.Marshal(historyBlob)
+	if err != nil {
+		return err
+	}
+	key := HistoryKey(historyBlob.DomainID, historyBlob.WorkflowID, historyBlob.RunID, historyBlob.Page)
+	return client.Upload(ctx, bucket, key, blob)
+}
+
+// DownloadHistoryBlob downloads a page of the archived history of a workflow execution,
+// blobstore.ErrBlobNotExists is returned when the page was not archived
+func DownloadHistoryBlob(ctx context.Context, client blobstore.Client, bucket string,
+	domainID, workflowID, runID string, page int) (*HistoryBlob, error) {
+	blob, err := client.Download(ctx, bucket, HistoryKey(domainID, workflowID, runID, page))
+	if err != nil {
+		return nil, err
+	}
+	historyBlob := &HistoryBlob{}
+	if err := json.Unmarshal(blob, historyBlob); err != nil {
+		return nil, err
+	}
+	return historyBlob, nil
+}
+
+// IsHistoryArchived tells whether the history of a workflow execution is completely archived
+func IsHistoryArchived(ctx context.Context, client blobstore.Client, bucket string,
+	domainID, workflowID, runID string) (bool, error) {
+	return client.Exists(ctx, bucket, HistoryKey(domainID, workflowID, runID, 0))
+}
+
+// HistoryKey returns the blob key of a page of the archived history of a workflow execution
+func HistoryKey(domainID, workflowID, runID string, page int) string {
+	return blobKey(domainID, workflowID, runID, historyKeySuffix, strconv.Itoa(page))
+}
+
+// VisibilityKey returns the blob key of the archived closed visibility record of a workflow execution
+func VisibilityKey(domainID, workflowID, runID string) string {
+	return blobKey(domainID, workflowID, runID, visibilityKeySuffix)
+}
+
+func blobKey(parts ...string) string {
+	escaped := make([]string, len(parts))
+	for i, p := range parts {
+		escaped[i] = url.PathEscape(p)
+	}
+	return strings.Join(escaped, "/")
+}
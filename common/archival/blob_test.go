@@ -0,0 +1,107 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archival
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+)
+
+type BlobSuite struct {
+	*require.Assertions
+	suite.Suite
+	dir    string
+	client blobstore.Client
+}
+
+func TestBlobSuite(t *testing.T) {
+	suite.Run(t, new(BlobSuite))
+}
+
+func (s *BlobSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "archival.BlobSuite")
+	s.Nil(err)
+	s.dir = dir
+	s.client, err = filestore.NewClient(&filestore.Config{StoreDirectory: dir})
+	s.Nil(err)
+}
+
+func (s *BlobSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *BlobSuite) TestUploadDownload() {
+	ctx := context.Background()
+	archived, err := IsHistoryArchived(ctx, s.client, "bucket", "domain", "workflow", "run")
+	s.Nil(err)
+	s.False(archived)
+
+	secondPage := &HistoryBlob{
+		DomainID:   "domain",
+		WorkflowID: "workflow",
+		RunID:      "run",
+		Page:       1,
+		IsLast:     true,
+		Events:     []*shared.HistoryEvent{{EventId: common.Int64Ptr(2)}},
+	}
+	s.Nil(UploadHistoryBlob(ctx, s.client, "bucket", secondPage))
+	archived, err = IsHistoryArchived(ctx, s.client, "bucket", "domain", "workflow", "run")
+	s.Nil(err)
+	s.False(archived)
+
+	firstPage := &HistoryBlob{
+		DomainID:   "domain",
+		WorkflowID: "workflow",
+		RunID:      "run",
+		PageCount:  2,
+		Events:     []*shared.HistoryEvent{{EventId: common.Int64Ptr(1)}},
+	}
+	s.Nil(UploadHistoryBlob(ctx, s.client, "bucket", firstPage))
+	archived, err = IsHistoryArchived(ctx, s.client, "bucket", "domain", "workflow", "run")
+	s.Nil(err)
+	s.True(archived)
+
+	historyBlob, err := DownloadHistoryBlob(ctx, s.client, "bucket", "domain", "workflow", "run", 1)
+	s.Nil(err)
+	s.Equal(secondPage, historyBlob)
+	historyBlob, err = DownloadHistoryBlob(ctx, s.client, "bucket", "domain", "workflow", "run", 0)
+	s.Nil(err)
+	s.Equal(firstPage, historyBlob)
+
+	_, err = DownloadHistoryBlob(ctx, s.client, "bucket", "domain", "workflow", "run", 2)
+	s.Equal(blobstore.ErrBlobNotExists, err)
+}
+
+func (s *BlobSuite) TestKeys() {
+	s.NotEqual(HistoryKey("domain", "a/b", "run", 0), HistoryKey("domain/a", "b", "run", 0))
+	s.NotEqual(HistoryKey("domain", "workflow", "run", 0), HistoryKey("domain", "workflow", "run", 1))
+	s.NotEqual(HistoryKey("domain", "workflow", "run", 0), VisibilityKey("domain", "workflow", "run"))
+}
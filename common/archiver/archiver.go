@@ -0,0 +1,188 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archival"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	historyPageSize = 1000
+)
+
+type (
+	// ArchiveRequest is the request to archive a closed workflow execution
+	ArchiveRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+		Bucket     string
+	}
+
+	// Archiver uploads the history and the closed visibility record of a workflow execution to a blob store,
+	// it is invoked before the execution is deleted on retention expiry
+	Archiver interface {
+		Archive(ctx context.Context, request *ArchiveRequest) error
+	}
+
+	archiverImpl struct {
+		historyMgr         persistence.HistoryManager
+		visibilityMgr      persistence.VisibilityManager
+		blobstoreClient    blobstore.Client
+		hSerializerFactory persistence.HistorySerializerFactory
+		logger             logging.Logger
+	}
+)
+
+var _ Archiver = (*archiverImpl)(nil)
+
+// NewArchiver creates a new archiver which uploads to the given blob store client
+func NewArchiver(historyMgr persistence.HistoryManager, visibilityMgr persistence.VisibilityManager,
+	blobstoreClient blobstore.Client, logger logging.Logger) Archiver {
+	return &archiverImpl{
+		historyMgr:         historyMgr,
+		visibilityMgr:      visibilityMgr,
+		blobstoreClient:    blobstoreClient,
+		hSerializerFactory: persistence.NewHistorySerializerFactory(),
+		logger:             logger.WithTags(tag.ComponentArchiver),
+	}
+}
+
+// Archive uploads the history and the closed visibility record of the execution, it is safe to call
+// multiple times for the same execution.  The first page of the history is uploaded last, once it exists
+// the execution is completely archived and can be deleted.
+func (a *archiverImpl) Archive(ctx context.Context, request *ArchiveRequest) error {
+	logger := a.logger.WithTags(tag.WorkflowDomainID(request.DomainID), tag.WorkflowID(request.WorkflowID), tag.WorkflowRunID(request.RunID))
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(request.WorkflowID),
+		RunId:      common.StringPtr(request.RunID),
+	}
+
+	var firstPage *archival.HistoryBlob
+	var nextPageToken []byte
+	for page := 0; ; page++ {
+		events, token, err := a.readHistoryPage(request.DomainID, execution, nextPageToken)
+		if err != nil {
+			if _, ok := err.(*shared.EntityNotExistsError); ok && page == 0 {
+				// history is already gone, a previous attempt has archived and deleted it
+				logger.Warn("Skipping archival of workflow execution without history.")
+				return nil
+			}
+			return err
+		}
+
+		historyBlob := &archival.HistoryBlob{
+			DomainID:   request.DomainID,
+			WorkflowID: request.WorkflowID,
+			RunID:      request.RunID,
+			Page:       page,
+			IsLast:     len(token) == 0,
+			Events:     events,
+		}
+		if page == 0 {
+			firstPage = historyBlob
+		} else if err := archival.UploadHistoryBlob(ctx, a.blobstoreClient, request.Bucket, historyBlob); err != nil {
+			logger.Error("Failed to upload workflow history.", tag.Error(err))
+			return err
+		}
+
+		if historyBlob.IsLast {
+			firstPage.PageCount = page + 1
+			break
+		}
+		nextPageToken = token
+	}
+
+	if err := a.archiveVisibility(ctx, request, execution, logger); err != nil {
+		return err
+	}
+
+	if err := archival.UploadHistoryBlob(ctx, a.blobstoreClient, request.Bucket, firstPage); err != nil {
+		logger.Error("Failed to upload workflow history.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (a *archiverImpl) archiveVisibility(ctx context.Context, request *ArchiveRequest,
+	execution shared.WorkflowExecution, logger logging.Logger) error {
+	visibilityResponse, err := a.visibilityMgr.GetClosedWorkflowExecution(&persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: request.DomainID,
+		Execution:  execution,
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			logger.Warn("Skipping archival of missing closed visibility record.")
+			return nil
+		}
+		return err
+	}
+
+	visibilityBlob, err := json.Marshal(visibilityResponse.Execution)
+	if err != nil {
+		return err
+	}
+	visibilityKey := archival.VisibilityKey(request.DomainID, request.WorkflowID, request.RunID)
+	if err := a.blobstoreClient.Upload(ctx, request.Bucket, visibilityKey, visibilityBlob); err != nil {
+		logger.Error("Failed to upload closed visibility record.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (a *archiverImpl) readHistoryPage(domainID string, execution shared.WorkflowExecution,
+	nextPageToken []byte) ([]*shared.HistoryEvent, []byte, error) {
+	response, err := a.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:      domainID,
+		Execution:     execution,
+		FirstEventID:  common.FirstEventID,
+		NextEventID:   common.EndEventID,
+		PageSize:      historyPageSize,
+		NextPageToken: nextPageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var events []*shared.HistoryEvent
+	for _, e := range response.Events {
+		persistence.SetSerializedHistoryDefaults(&e)
+		s, err := a.hSerializerFactory.Get(e.EncodingType)
+		if err != nil {
+			return nil, nil, err
+		}
+		batch, err := s.Deserialize(&e)
+		if err != nil {
+			return nil, nil, err
+		}
+		events = append(events, batch.Events...)
+	}
+	return events, response.NextPageToken, nil
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archival"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	archiverSuite struct {
+		suite.Suite
+		*require.Assertions
+		dir               string
+		blobstoreClient   blobstore.Client
+		mockHistoryMgr    *mocks.HistoryManager
+		mockVisibilityMgr *mocks.VisibilityManager
+		archiver          Archiver
+	}
+)
+
+func TestArchiverSuite(t *testing.T) {
+	s := new(archiverSuite)
+	suite.Run(t, s)
+}
+
+func (s *archiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "archiverSuite")
+	s.Nil(err)
+	s.dir = dir
+	s.blobstoreClient, err = filestore.NewClient(&filestore.Config{StoreDirectory: dir})
+	s.Nil(err)
+	s.mockHistoryMgr = &mocks.HistoryManager{}
+	s.mockVisibilityMgr = &mocks.VisibilityManager{}
+	s.archiver = NewArchiver(s.mockHistoryMgr, s.mockVisibilityMgr, s.blobstoreClient,
+		logging.NewDevelopmentLogger())
+}
+
+func (s *archiverSuite) TearDownTest() {
+	s.mockHistoryMgr.AssertExpectations(s.T())
+	s.mockVisibilityMgr.AssertExpectations(s.T())
+	os.RemoveAll(s.dir)
+}
+
+func (s *archiverSuite) TestArchive() {
+	request := &ArchiveRequest{
+		DomainID:   uuid.New(),
+		WorkflowID: "archiver/test-workflow",
+		RunID:      uuid.New(),
+		Bucket:     "test-bucket",
+	}
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(request.WorkflowID),
+		RunId:      common.StringPtr(request.RunID),
+	}
+
+	firstPage := s.serializeEvents(1, 2, 3)
+	secondPage := s.serializeEvents(4, 5)
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.MatchedBy(func(r *persistence.GetWorkflowExecutionHistoryRequest) bool {
+		return len(r.NextPageToken) == 0
+	})).Return(&persistence.GetWorkflowExecutionHistoryResponse{
+		Events:        []persistence.SerializedHistoryEventBatch{*firstPage},
+		NextPageToken: []byte("next"),
+	}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.MatchedBy(func(r *persistence.GetWorkflowExecutionHistoryRequest) bool {
+		return string(r.NextPageToken) == "next"
+	})).Return(&persistence.GetWorkflowExecutionHistoryResponse{
+		Events: []persistence.SerializedHistoryEventBatch{*secondPage},
+	}, nil).Once()
+
+	closeStatus := shared.WorkflowExecutionCloseStatusCompleted
+	s.mockVisibilityMgr.On("GetClosedWorkflowExecution", &persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: request.DomainID,
+		Execution:  execution,
+	}).Return(&persistence.GetClosedWorkflowExecutionResponse{
+		Execution: &shared.WorkflowExecutionInfo{
+			Execution:   &execution,
+			CloseStatus: &closeStatus,
+		},
+	}, nil).Once()
+
+	s.Nil(s.archiver.Archive(context.Background(), request))
+
+	firstBlob, err := archival.DownloadHistoryBlob(context.Background(), s.blobstoreClient, request.Bucket,
+		request.DomainID, request.WorkflowID, request.RunID, 0)
+	s.Nil(err)
+	s.Equal(request.WorkflowID, firstBlob.WorkflowID)
+	s.Equal(request.RunID, firstBlob.RunID)
+	s.Equal(2, firstBlob.PageCount)
+	s.False(firstBlob.IsLast)
+	secondBlob, err := archival.DownloadHistoryBlob(context.Background(), s.blobstoreClient, request.Bucket,
+		request.DomainID, request.WorkflowID, request.RunID, 1)
+	s.Nil(err)
+	s.Equal(1, secondBlob.Page)
+	s.True(secondBlob.IsLast)
+	events := append(firstBlob.Events, secondBlob.Events...)
+	s.Equal(5, len(events))
+	for i, e := range events {
+		s.Equal(int64(i+1), e.GetEventId())
+	}
+
+	blob, err := s.blobstoreClient.Download(context.Background(), request.Bucket,
+		archival.VisibilityKey(request.DomainID, request.WorkflowID, request.RunID))
+	s.Nil(err)
+	info := &shared.WorkflowExecutionInfo{}
+	s.Nil(json.Unmarshal(blob, info))
+	s.Equal(closeStatus, info.GetCloseStatus())
+}
+
+func (s *archiverSuite) TestArchive_VisibilityFailure() {
+	request := &ArchiveRequest{
+		DomainID:   uuid.New(),
+		WorkflowID: "archiver-test-workflow",
+		RunID:      uuid.New(),
+		Bucket:     "test-bucket",
+	}
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(&persistence.GetWorkflowExecutionHistoryResponse{
+		Events: []persistence.SerializedHistoryEventBatch{*s.serializeEvents(1, 2)},
+	}, nil).Once()
+	s.mockVisibilityMgr.On("GetClosedWorkflowExecution", mock.Anything).
+		Return(nil, &shared.InternalServiceError{}).Once()
+
+	s.NotNil(s.archiver.Archive(context.Background(), request))
+
+	// the first page is only uploaded once everything else is archived
+	archived, err := archival.IsHistoryArchived(context.Background(), s.blobstoreClient, request.Bucket,
+		request.DomainID, request.WorkflowID, request.RunID)
+	s.Nil(err)
+	s.False(archived)
+}
+
+func (s *archiverSuite) TestArchive_HistoryNotExists() {
+	request := &ArchiveRequest{
+		DomainID:   uuid.New(),
+		WorkflowID: "archiver-test-workflow",
+		RunID:      uuid.New(),
+		Bucket:     "test-bucket",
+	}
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).
+		Return(nil, &shared.EntityNotExistsError{}).Once()
+
+	s.Nil(s.archiver.Archive(context.Background(), request))
+
+	_, err := archival.DownloadHistoryBlob(context.Background(), s.blobstoreClient, request.Bucket,
+		request.DomainID, request.WorkflowID, request.RunID, 0)
+	s.Equal(blobstore.ErrBlobNotExists, err)
+}
+
+func (s *archiverSuite) serializeEvents(eventIDs ...int64) *persistence.SerializedHistoryEventBatch {
+	var events []*shared.HistoryEvent
+	for _, id := range eventIDs {
+		events = append(events, &shared.HistoryEvent{
+			EventId:   common.Int64Ptr(id),
+			EventType: shared.EventTypeMarkerRecorded.Ptr(),
+		})
+	}
+	serializer := persistence.NewJSONHistorySerializer()
+	batch, err := serializer.Serialize(persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(), events))
+	s.Nil(err)
+	return batch
+}
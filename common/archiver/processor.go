@@ -0,0 +1,164 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archival"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	archiveTimeout = time.Minute
+)
+
+type (
+	// Processor archives workflow executions in the background, the archive requests are handed over to a
+	// pool of workers so that the caller does not wait for the uploads to the blob store
+	Processor interface {
+		common.Daemon
+		// Enqueue hands over an archive request, false is returned when the queue is full.  A request for
+		// an execution which is already queued is ignored.
+		Enqueue(request *ArchiveRequest) bool
+		// IsArchived tells whether the execution is completely archived to the bucket of the request
+		IsArchived(ctx context.Context, request *ArchiveRequest) (bool, error)
+	}
+
+	processorImpl struct {
+		archiver        Archiver
+		blobstoreClient blobstore.Client
+		concurrency     int
+		requestsCh      chan *ArchiveRequest
+		isStarted       int32
+		isStopped       int32
+		shutdownWG      sync.WaitGroup
+		shutdownCh      chan struct{}
+		metricsClient   metrics.Client
+		logger          logging.Logger
+
+		sync.Mutex
+		queued map[ArchiveRequest]struct{}
+	}
+)
+
+var _ Processor = (*processorImpl)(nil)
+
+// NewProcessor creates a new processor which archives the executions with the given archiver
+func NewProcessor(archiver Archiver, blobstoreClient blobstore.Client, concurrency int, queueSize int,
+	metricsClient metrics.Client, logger logging.Logger) Processor {
+	return &processorImpl{
+		archiver:        archiver,
+		blobstoreClient: blobstoreClient,
+		concurrency:     concurrency,
+		requestsCh:      make(chan *ArchiveRequest, queueSize),
+		shutdownCh:      make(chan struct{}),
+		metricsClient:   metricsClient,
+		logger:          logger.WithTags(tag.ComponentArchiver),
+		queued:          make(map[ArchiveRequest]struct{}),
+	}
+}
+
+// Start starts the workers of the processor
+func (p *processorImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&p.isStarted, 0, 1) {
+		return
+	}
+
+	for i := 0; i < p.concurrency; i++ {
+		p.shutdownWG.Add(1)
+		go p.worker()
+	}
+	p.logger.Info("Archiver processor started.")
+}
+
+// Stop stops the workers of the processor, the queued requests are dropped
+func (p *processorImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&p.isStopped, 0, 1) {
+		return
+	}
+
+	close(p.shutdownCh)
+	if success := common.AwaitWaitGroup(&p.shutdownWG, time.Minute); !success {
+		p.logger.Warn("Archiver processor timed out on shutdown.")
+	}
+	p.logger.Info("Archiver processor stopped.")
+}
+
+// Enqueue hands over an archive request to the workers of the processor
+func (p *processorImpl) Enqueue(request *ArchiveRequest) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	if _, ok := p.queued[*request]; ok {
+		return true
+	}
+	select {
+	case p.requestsCh <- request:
+		p.queued[*request] = struct{}{}
+		return true
+	default:
+		return false
+	}
+}
+
+// IsArchived tells whether the first page of the history, which is uploaded last, exists in the bucket
+func (p *processorImpl) IsArchived(ctx context.Context, request *ArchiveRequest) (bool, error) {
+	return archival.IsHistoryArchived(ctx, p.blobstoreClient, request.Bucket, request.DomainID, request.WorkflowID,
+		request.RunID)
+}
+
+func (p *processorImpl) worker() {
+	defer p.shutdownWG.Done()
+
+	for {
+		select {
+		case <-p.shutdownCh:
+			return
+		case request := <-p.requestsCh:
+			p.archive(request)
+		}
+	}
+}
+
+func (p *processorImpl) archive(request *ArchiveRequest) {
+	defer func() {
+		p.Lock()
+		defer p.Unlock()
+		delete(p.queued, *request)
+	}()
+
+	p.metricsClient.IncCounter(metrics.ArchiverProcessorScope, metrics.WorkflowArchivalRequests)
+	ctx, cancel := context.WithTimeout(context.Background(), archiveTimeout)
+	defer cancel()
+	if err := p.archiver.Archive(ctx, request); err != nil {
+		p.metricsClient.IncCounter(metrics.ArchiverProcessorScope, metrics.WorkflowArchivalFailures)
+		p.logger.Error("Failed to archive workflow execution.", tag.WorkflowDomainID(request.DomainID),
+			tag.WorkflowID(request.WorkflowID), tag.WorkflowRunID(request.RunID), tag.Error(err))
+	}
+}
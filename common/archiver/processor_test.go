@@ -0,0 +1,114 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/archival"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	processorSuite struct {
+		suite.Suite
+		*require.Assertions
+		dir             string
+		blobstoreClient blobstore.Client
+	}
+
+	// blockingArchiver uploads the first page of the history once it is unblocked
+	blockingArchiver struct {
+		blobstoreClient blobstore.Client
+		unblockCh       chan struct{}
+		archiveCount    int32
+	}
+)
+
+func TestProcessorSuite(t *testing.T) {
+	s := new(processorSuite)
+	suite.Run(t, s)
+}
+
+func (s *processorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "processorSuite")
+	s.Nil(err)
+	s.dir = dir
+	s.blobstoreClient, err = filestore.NewClient(&filestore.Config{StoreDirectory: dir})
+	s.Nil(err)
+}
+
+func (s *processorSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *processorSuite) TestEnqueue() {
+	archiver := &blockingArchiver{blobstoreClient: s.blobstoreClient, unblockCh: make(chan struct{})}
+	processor := NewProcessor(archiver, s.blobstoreClient, 1, 1,
+		metrics.NewClient(tally.NoopScope, metrics.History), logging.NewDevelopmentLogger())
+	first := &ArchiveRequest{DomainID: "domain", WorkflowID: "workflow", RunID: "run-1", Bucket: "bucket"}
+	second := &ArchiveRequest{DomainID: "domain", WorkflowID: "workflow", RunID: "run-2", Bucket: "bucket"}
+
+	// the workers are not started yet, so the queue holds a single request
+	s.True(processor.Enqueue(first))
+	s.True(processor.Enqueue(&ArchiveRequest{DomainID: "domain", WorkflowID: "workflow", RunID: "run-1", Bucket: "bucket"}))
+	s.False(processor.Enqueue(second))
+
+	archived, err := processor.IsArchived(context.Background(), first)
+	s.Nil(err)
+	s.False(archived)
+
+	processor.Start()
+	archiver.unblockCh <- struct{}{}
+	s.True(processor.Enqueue(second))
+	archiver.unblockCh <- struct{}{}
+	processor.Stop()
+
+	s.Equal(int32(2), atomic.LoadInt32(&archiver.archiveCount))
+	for _, request := range []*ArchiveRequest{first, second} {
+		archived, err := processor.IsArchived(context.Background(), request)
+		s.Nil(err)
+		s.True(archived)
+	}
+}
+
+func (a *blockingArchiver) Archive(ctx context.Context, request *ArchiveRequest) error {
+	<-a.unblockCh
+	atomic.AddInt32(&a.archiveCount, 1)
+	return archival.UploadHistoryBlob(ctx, a.blobstoreClient, request.Bucket, &archival.HistoryBlob{
+		DomainID:   request.DomainID,
+		WorkflowID: request.WorkflowID,
+		RunID:      request.RunID,
+		PageCount:  1,
+		IsLast:     true,
+	})
+}
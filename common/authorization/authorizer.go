@@ -0,0 +1,124 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/yarpc"
+)
+
+type (
+	// Attributes is the input of an authorization decision for a frontend API call
+	Attributes struct {
+		// APIName is the name of the frontend API being called
+		APIName string
+		// DomainName is the name of the domain the API is called on
+		DomainName string
+		// Caller is the name of the calling service as set on the rpc call
+		Caller string
+		// Headers are the rpc headers of the call, they may carry the identity of the caller
+		Headers map[string]string
+	}
+
+	// Decision is the result of an authorization check
+	Decision int
+
+	// Authorizer decides whether a frontend API call is allowed to proceed
+	Authorizer interface {
+		Authorize(ctx context.Context, attributes *Attributes) (Decision, error)
+	}
+
+	nopAuthorizer struct{}
+
+	// callInfo is the caller and headers of a call which is not made over rpc
+	callInfo struct {
+		caller  string
+		headers map[string]string
+	}
+
+	callInfoKey struct{}
+)
+
+const (
+	// DecisionDeny means the call is not allowed
+	DecisionDeny Decision = iota
+	// DecisionAllow means the call is allowed
+	DecisionAllow
+)
+
+// WithCall returns a context carrying the caller and headers of a call which is not made over rpc, such as
+// the calls of the HTTP gateway. Header names are case insensitive, they are lower cased as rpc headers are
+func WithCall(ctx context.Context, caller string, headers map[string]string) context.Context {
+	info := &callInfo{
+		caller:  caller,
+		headers: make(map[string]string, len(headers)),
+	}
+	for key, value := range headers {
+		info.headers[strings.ToLower(key)] = value
+	}
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+// NewAttributes builds the authorization attributes of an API call, the caller and headers are taken
+// from the call set by WithCall, or else from the rpc call in the context
+func NewAttributes(ctx context.Context, apiName string, domainName string) *Attributes {
+	attributes := &Attributes{
+		APIName:    apiName,
+		DomainName: domainName,
+		Headers:    make(map[string]string),
+	}
+	if ctx == nil {
+		return attributes
+	}
+	if info, ok := ctx.Value(callInfoKey{}).(*callInfo); ok {
+		attributes.Caller = info.caller
+		for key, value := range info.headers {
+			attributes.Headers[key] = value
+		}
+		return attributes
+	}
+	call := yarpc.CallFromContext(ctx)
+	attributes.Caller = call.Caller()
+	for _, key := range call.HeaderNames() {
+		attributes.Headers[key] = call.Header(key)
+	}
+	return attributes
+}
+
+// NewNopAuthorizer creates an authorizer which allows every call
+func NewNopAuthorizer() Authorizer {
+	return &nopAuthorizer{}
+}
+
+func (a *nopAuthorizer) Authorize(ctx context.Context, attributes *Attributes) (Decision, error) {
+	return DecisionAllow, nil
+}
+
+// NewAuthorizer creates the authorizer described by the config, every call is allowed
+// when the role based authorizer is not enabled
+func NewAuthorizer(config *Config) Authorizer {
+	if config == nil || !config.Enable {
+		return NewNopAuthorizer()
+	}
+	return NewRoleBasedAuthorizer(config)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"strings"
+)
+
+type (
+	// Role is the access a caller has on a domain, each role includes the access of the roles below it
+	Role string
+
+	// Config is the config for authorizing frontend API calls
+	Config struct {
+		// Enable turns on the role based authorizer, every call is allowed when it is off
+		Enable bool `yaml:"enable"`
+		// IdentityHeader is the rpc or HTTP header carrying the identity of the caller, the caller
+		// service name of the rpc call is used as the identity when it is empty. It is case insensitive
+		IdentityHeader string `yaml:"identityHeader"`
+		// Grants maps a caller identity to the role it has on each domain name,
+		// a grant on AllDomains applies to every domain
+		Grants map[string]map[string]Role `yaml:"grants"`
+	}
+
+	roleBasedAuthorizer struct {
+		identityHeader string
+		grants         map[string]map[string]Role
+	}
+)
+
+const (
+	// RoleRead allows describing domains and reading workflow executions
+	RoleRead Role = "read"
+	// RoleWrite allows starting, signaling and terminating workflow executions and running workers
+	RoleWrite Role = "write"
+	// RoleAdmin allows registering, updating and deprecating domains
+	RoleAdmin Role = "admin"
+
+	// AllDomains is the domain name used to grant a role on every domain
+	AllDomains = "*"
+)
+
+var roleLevels = map[Role]int{
+	RoleRead:  1,
+	RoleWrite: 2,
+	RoleAdmin: 3,
+}
+
+// apiRoles is the role required by each frontend API, APIs missing here require RoleAdmin
+var apiRoles = map[string]Role{
+	"RegisterDomain":   RoleAdmin,
+	"UpdateDomain":     RoleAdmin,
+	"DeprecateDomain":  RoleAdmin,
+	"DescribeDomain":   RoleRead,
+	"DescribeTaskList": RoleRead,
+
+	"GetWorkflowExecutionHistory":  RoleRead,
+	"ListOpenWorkflowExecutions":   RoleRead,
+	"ListClosedWorkflowExecutions": RoleRead,
+	"ListWorkflowExecutions":       RoleRead,
+	"CountWorkflowExecutions":      RoleRead,
+	"QueryWorkflow":                RoleRead,
+	"DescribeWorkflowExecution":    RoleRead,
+
+	"StartWorkflowExecution":           RoleWrite,
+	"SignalWorkflowExecution":          RoleWrite,
+	"SignalWithStartWorkflowExecution": RoleWrite,
+	"TerminateWorkflowExecution":       RoleWrite,
+	"ResetWorkflowExecution":           RoleWrite,
+	"RequestCancelWorkflowExecution":   RoleWrite,
+
+	"PollForDecisionTask":              RoleWrite,
+	"PollForActivityTask":              RoleWrite,
+	"RecordActivityTaskHeartbeat":      RoleWrite,
+	"RecordActivityTaskHeartbeatByID":  RoleWrite,
+	"RespondDecisionTaskCompleted":     RoleWrite,
+	"RespondDecisionTaskFailed":        RoleWrite,
+	"RespondActivityTaskCompleted":     RoleWrite,
+	"RespondActivityTaskCompletedByID": RoleWrite,
+	"RespondActivityTaskFailed":        RoleWrite,
+	"RespondActivityTaskFailedByID":    RoleWrite,
+	"RespondActivityTaskCanceled":      RoleWrite,
+	"RespondActivityTaskCanceledByID":  RoleWrite,
+	"RespondQueryTaskCompleted":        RoleWrite,
+
+	// the admin APIs are authorized on the domain of the request when they have one, and on AllDomains
+	// when they apply to the whole cluster
+	"AdminDescribeWorkflowExecution":   RoleAdmin,
+	"AdminGetWorkflowReplicationTasks": RoleAdmin,
+	"AdminDescribeHistoryHost":         RoleAdmin,
+	"AdminGetReplicationMessages":      RoleAdmin,
+	"AdminReplicateDomain":             RoleAdmin,
+	"AdminDescribeReplicationStatus":   RoleAdmin,
+}
+
+// NewRoleBasedAuthorizer creates an authorizer which allows a call when the caller has been
+// granted the role required by the API on the domain of the call
+func NewRoleBasedAuthorizer(config *Config) Authorizer {
+	return &roleBasedAuthorizer{
+		identityHeader: strings.ToLower(config.IdentityHeader),
+		grants:         config.Grants,
+	}
+}
+
+func (a *roleBasedAuthorizer) Authorize(ctx context.Context, attributes *Attributes) (Decision, error) {
+	required, ok := apiRoles[attributes.APIName]
+	if !ok {
+		required = RoleAdmin
+	}
+
+	domainGrants, ok := a.grants[a.getIdentity(attributes)]
+	if !ok {
+		return DecisionDeny, nil
+	}
+	if hasRole(domainGrants[attributes.DomainName], required) || hasRole(domainGrants[AllDomains], required) {
+		return DecisionAllow, nil
+	}
+	return DecisionDeny, nil
+}
+
+func (a *roleBasedAuthorizer) getIdentity(attributes *Attributes) string {
+	if a.identityHeader != "" {
+		return attributes.Headers[a.identityHeader]
+	}
+	return attributes.Caller
+}
+
+func hasRole(granted Role, required Role) bool {
+	return roleLevels[granted] >= roleLevels[required] && roleLevels[granted] > 0
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	roleBasedAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestRoleBasedAuthorizerSuite(t *testing.T) {
+	s := new(roleBasedAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *roleBasedAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *roleBasedAuthorizerSuite) TestAuthorize() {
+	authorizer := NewAuthorizer(&Config{
+		Enable: true,
+		Grants: map[string]map[string]Role{
+			"reader": {"test-domain": RoleRead},
+			"writer": {"test-domain": RoleWrite},
+			"admin":  {AllDomains: RoleAdmin},
+		},
+	})
+
+	testCases := []struct {
+		caller   string
+		api      string
+		domain   string
+		decision Decision
+	}{
+		{"reader", "DescribeWorkflowExecution", "test-domain", DecisionAllow},
+		{"reader", "StartWorkflowExecution", "test-domain", DecisionDeny},
+		{"reader", "DescribeWorkflowExecution", "other-domain", DecisionDeny},
+		{"writer", "DescribeWorkflowExecution", "test-domain", DecisionAllow},
+		{"writer", "TerminateWorkflowExecution", "test-domain", DecisionAllow},
+		{"writer", "UpdateDomain", "test-domain", DecisionDeny},
+		{"writer", "UnknownAPI", "test-domain", DecisionDeny},
+		{"admin", "RegisterDomain", "new-domain", DecisionAllow},
+		{"admin", "UnknownAPI", "test-domain", DecisionAllow},
+		{"unknown", "DescribeDomain", "test-domain", DecisionDeny},
+	}
+	for _, tc := range testCases {
+		decision, err := authorizer.Authorize(context.Background(), &Attributes{
+			APIName:    tc.api,
+			DomainName: tc.domain,
+			Caller:     tc.caller,
+		})
+		s.NoError(err)
+		s.Equal(tc.decision, decision, "caller: %v, api: %v, domain: %v", tc.caller, tc.api, tc.domain)
+	}
+}
+
+func (s *roleBasedAuthorizerSuite) TestIdentityHeader() {
+	authorizer := NewAuthorizer(&Config{
+		Enable:         true,
+		IdentityHeader: "cadence-caller-identity",
+		Grants: map[string]map[string]Role{
+			"writer": {"test-domain": RoleWrite},
+		},
+	})
+
+	decision, err := authorizer.Authorize(context.Background(), &Attributes{
+		APIName:    "StartWorkflowExecution",
+		DomainName: "test-domain",
+		Caller:     "writer",
+	})
+	s.NoError(err)
+	s.Equal(DecisionDeny, decision)
+
+	decision, err = authorizer.Authorize(context.Background(), &Attributes{
+		APIName:    "StartWorkflowExecution",
+		DomainName: "test-domain",
+		Caller:     "cadence-client",
+		Headers:    map[string]string{"cadence-caller-identity": "writer"},
+	})
+	s.NoError(err)
+	s.Equal(DecisionAllow, decision)
+}
+
+func (s *roleBasedAuthorizerSuite) TestDisabled() {
+	decision, err := NewAuthorizer(&Config{}).Authorize(context.Background(), &Attributes{
+		APIName:    "RegisterDomain",
+		DomainName: "test-domain",
+	})
+	s.NoError(err)
+	s.Equal(DecisionAllow, decision)
+}
+
+func (s *roleBasedAuthorizerSuite) TestWithCall() {
+	authorizer := NewAuthorizer(&Config{
+		Enable:         true,
+		IdentityHeader: "Cadence-Caller-Identity",
+		Grants: map[string]map[string]Role{
+			"writer": {"test-domain": RoleWrite},
+		},
+	})
+
+	ctx := WithCall(context.Background(), "http-client", map[string]string{"Cadence-Caller-Identity": "writer"})
+	attributes := NewAttributes(ctx, "StartWorkflowExecution", "test-domain")
+	s.Equal("http-client", attributes.Caller)
+	s.Equal(map[string]string{"cadence-caller-identity": "writer"}, attributes.Headers)
+
+	decision, err := authorizer.Authorize(ctx, attributes)
+	s.NoError(err)
+	s.Equal(DecisionAllow, decision)
+
+	attributes = NewAttributes(WithCall(context.Background(), "http-client", nil), "StartWorkflowExecution", "test-domain")
+	decision, err = authorizer.Authorize(ctx, attributes)
+	s.NoError(err)
+	s.Equal(DecisionDeny, decision)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var crontests = []struct {
+	cron      string
+	startTime string
+	result    time.Duration
+}{
+	{"0 10 * * *", "2018-12-17T08:00:00-08:00", time.Hour * 18},
+	{"0 10 * * *", "2018-12-18T02:00:00-08:00", time.Hour * 24},
+	{"0 * * * *", "2018-12-17T08:08:00+00:00", time.Minute * 52},
+	{"0 * * * *", "2018-12-17T09:00:00+00:00", time.Hour},
+	{"* * * * *", "2018-12-17T08:08:18+00:00", time.Second * 42},
+	{"0 * * * *", "2018-12-17T09:00:00.5+00:00", time.Hour},
+	{"invalid-cron-spec", "2018-12-17T00:04:00+00:00", NoBackoff},
+	{"", "2018-12-17T00:04:00+00:00", NoBackoff},
+}
+
+func TestCron(t *testing.T) {
+	for _, tt := range crontests {
+		t.Run(tt.cron, func(t *testing.T) {
+			start, _ := time.Parse(time.RFC3339, tt.startTime)
+			backoff := GetBackoffForNextSchedule(tt.cron, start)
+			assert.Equal(t, tt.result, backoff, "The cron spec is %s and the expected result is %s", tt.cron, tt.result)
+		})
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	assert.NoError(t, ValidateSchedule("*/5 * * * *"))
+	assert.Error(t, ValidateSchedule("invalid-cron-spec"))
+}
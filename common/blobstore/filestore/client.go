@@ -0,0 +1,158 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/uber/cadence/common/blobstore"
+)
+
+const (
+	dirMode  os.FileMode = 0700
+	fileMode os.FileMode = 0600
+)
+
+type (
+	// Config is the configuration for the local filesystem blob store
+	Config struct {
+		// StoreDirectory is the root directory, each bucket is a sub directory of it
+		StoreDirectory string `yaml:"storeDirectory" validate:"nonzero"`
+	}
+
+	client struct {
+		storeDirectory string
+	}
+)
+
+var (
+	errInvalidBucket = errors.New("invalid bucket name")
+	errInvalidKey    = errors.New("invalid blob key")
+)
+
+// NewClient returns a blob store client backed by the local filesystem
+func NewClient(cfg *Config) (blobstore.Client, error) {
+	if len(cfg.StoreDirectory) == 0 {
+		return nil, errors.New("store directory is not configured")
+	}
+	if err := os.MkdirAll(cfg.StoreDirectory, dirMode); err != nil {
+		return nil, err
+	}
+	return &client{
+		storeDirectory: cfg.StoreDirectory,
+	}, nil
+}
+
+func (c *client) Upload(ctx context.Context, bucket string, key string, blob []byte) error {
+	path, err := c.blobPath(bucket, key)
+	if err != nil {
+		return err
+	}
+	bucketDir := filepath.Dir(path)
+	if err := os.MkdirAll(bucketDir, dirMode); err != nil {
+		return err
+	}
+
+	// write to a temp file first so a partially written blob is never visible under its key
+	f, err := ioutil.TempFile(bucketDir, ".upload-")
+	if err != nil {
+		return err
+	}
+	tmpName := f.Name()
+	if _, err := f.Write(blob); err != nil {
+		f.Close()
+		os.Remove(tmpName)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	if err := os.Chmod(tmpName, fileMode); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	return nil
+}
+
+func (c *client) Download(ctx context.Context, bucket string, key string) ([]byte, error) {
+	path, err := c.blobPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, blobstore.ErrBlobNotExists
+	}
+	return blob, err
+}
+
+func (c *client) Exists(ctx context.Context, bucket string, key string) (bool, error) {
+	path, err := c.blobPath(bucket, key)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+func (c *client) bucketDirectory(bucket string) (string, error) {
+	name, err := encodeName(bucket)
+	if err != nil {
+		return "", errInvalidBucket
+	}
+	return filepath.Join(c.storeDirectory, name), nil
+}
+
+func (c *client) blobPath(bucket string, key string) (string, error) {
+	bucketDir, err := c.bucketDirectory(bucket)
+	if err != nil {
+		return "", err
+	}
+	name, err := encodeName(key)
+	if err != nil {
+		return "", errInvalidKey
+	}
+	return filepath.Join(bucketDir, name), nil
+}
+
+// encodeName escapes a bucket or key so that it maps to exactly one entry within its parent directory
+func encodeName(name string) (string, error) {
+	if len(name) == 0 || name == "." || name == ".." {
+		return "", errors.New("invalid name")
+	}
+	return url.PathEscape(name), nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/blobstore"
+)
+
+type ClientSuite struct {
+	*require.Assertions
+	suite.Suite
+	dir    string
+	client blobstore.Client
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "filestore.ClientSuite")
+	s.Nil(err)
+	s.dir = dir
+	s.client, err = NewClient(&Config{StoreDirectory: filepath.Join(dir, "store")})
+	s.Nil(err)
+}
+
+func (s *ClientSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *ClientSuite) TestNewClient_MissingDirectory() {
+	_, err := NewClient(&Config{})
+	s.Error(err)
+}
+
+func (s *ClientSuite) TestUploadDownload() {
+	ctx := context.Background()
+	key := "domain/workflow/run"
+	exists, err := s.client.Exists(ctx, "bucket", key)
+	s.Nil(err)
+	s.False(exists)
+
+	_, err = s.client.Download(ctx, "bucket", key)
+	s.Equal(blobstore.ErrBlobNotExists, err)
+
+	s.Nil(s.client.Upload(ctx, "bucket", key, []byte("first")))
+	exists, err = s.client.Exists(ctx, "bucket", key)
+	s.Nil(err)
+	s.True(exists)
+
+	blob, err := s.client.Download(ctx, "bucket", key)
+	s.Nil(err)
+	s.Equal([]byte("first"), blob)
+
+	// uploading to the same key overwrites the previous blob
+	s.Nil(s.client.Upload(ctx, "bucket", key, []byte("second")))
+	blob, err = s.client.Download(ctx, "bucket", key)
+	s.Nil(err)
+	s.Equal([]byte("second"), blob)
+
+	// the same key in another bucket is a different blob
+	exists, err = s.client.Exists(ctx, "other-bucket", key)
+	s.Nil(err)
+	s.False(exists)
+
+	files, err := ioutil.ReadDir(filepath.Join(s.dir, "store", "bucket"))
+	s.Nil(err)
+	s.Equal(1, len(files))
+}
+
+func (s *ClientSuite) TestInvalidNames() {
+	ctx := context.Background()
+	s.Error(s.client.Upload(ctx, "", "key", []byte("blob")))
+	s.Error(s.client.Upload(ctx, "..", "key", []byte("blob")))
+	s.Error(s.client.Upload(ctx, "bucket", "..", []byte("blob")))
+	_, err := s.client.Download(ctx, "bucket", "")
+	s.Error(err)
+	_, err = s.client.Exists(ctx, ".", "key")
+	s.Error(err)
+}
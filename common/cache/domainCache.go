@@ -30,16 +30,19 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/errors"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/persistence"
-
-	"github.com/uber-common/bark"
 )
 
+// DomainCacheRefreshInterval is how long a domain entry is cached before it is reloaded, a domain update takes up to
+// this long to be seen by every host
+const DomainCacheRefreshInterval = 10 * time.Second
+
 const (
 	domainCacheInitialSize     = 1024
 	domainCacheMaxSize         = 16 * 1024
 	domainCacheTTL             = time.Hour
-	domainEntryRefreshInterval = 10 * time.Second
+	domainEntryRefreshInterval = DomainCacheRefreshInterval
 
 	domainCacheLocked   int32 = 0
 	domainCacheReleased int32 = 1
@@ -67,7 +70,7 @@ type (
 		metadataMgr     persistence.MetadataManager
 		clusterMetadata cluster.Metadata
 		timeSource      common.TimeSource
-		logger          bark.Logger
+		logger          logging.Logger
 
 		sync.RWMutex
 		callbacks map[int]callbackFn
@@ -89,7 +92,7 @@ type (
 )
 
 // NewDomainCache creates a new instance of cache for holding onto domain information to reduce the load on persistence
-func NewDomainCache(metadataMgr persistence.MetadataManager, clusterMetadata cluster.Metadata, logger bark.Logger) DomainCache {
+func NewDomainCache(metadataMgr persistence.MetadataManager, clusterMetadata cluster.Metadata, logger logging.Logger) DomainCache {
 	opts := &Options{}
 	opts.InitialCapacity = domainCacheInitialSize
 	opts.TTL = domainCacheTTL
@@ -292,6 +295,13 @@ func (entry *DomainCacheEntry) IsDomainActive() bool {
 	return entry.clusterMetadata.GetCurrentClusterName() == entry.replicationConfig.ActiveClusterName
 }
 
+// IsDomainInHandover return whether a graceful failover is handing the active domain over to another cluster, the
+// handover is abandoned once it expires
+func (entry *DomainCacheEntry) IsDomainInHandover() bool {
+	return entry.isGlobalDomain && entry.IsDomainActive() &&
+		persistence.IsHandoverInProgress(entry.replicationConfig, time.Now())
+}
+
 // ShouldReplicateEvent return whether the workflows within this domain should be replicated
 func (entry *DomainCacheEntry) ShouldReplicateEvent() bool {
 	// frontend guarantee that the clusters always contains the active domain, so if the # of clusters is 1
@@ -26,7 +26,6 @@ import (
 	"os"
 	"strings"
 
-	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/tools/cassandra"
 
 	"github.com/gocql/gocql"
@@ -68,7 +67,7 @@ func CreateCassandraKeyspace(s *gocql.Session, keyspace string, replicas int, ov
 	err = s.Query(fmt.Sprintf(`CREATE KEYSPACE IF NOT EXISTS %s WITH replication = {
 		'class' : 'SimpleStrategy', 'replication_factor' : %d}`, keyspace, replicas)).Exec()
 	if err != nil {
-		log.WithField(logging.TagErr, err).Error(`create keyspace error`)
+		log.WithField(`error`, err).Error(`create keyspace error`)
 		return
 	}
 	log.WithField(`keyspace`, keyspace).Debug(`created namespace`)
@@ -80,7 +79,7 @@ func CreateCassandraKeyspace(s *gocql.Session, keyspace string, replicas int, ov
 func DropCassandraKeyspace(s *gocql.Session, keyspace string) (err error) {
 	err = s.Query(fmt.Sprintf("DROP KEYSPACE IF EXISTS %s", keyspace)).Exec()
 	if err != nil {
-		log.WithField(logging.TagErr, err).Error(`drop keyspace error`)
+		log.WithField(`error`, err).Error(`drop keyspace error`)
 		return
 	}
 	log.WithField(`keyspace`, keyspace).Info(`dropped namespace`)
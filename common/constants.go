@@ -48,8 +48,11 @@ const (
 
 // Data encoding types
 const (
-	EncodingTypeJSON EncodingType = "json"
-	EncodingTypeGob               = "gob"
+	EncodingTypeJSON           EncodingType = "json"
+	EncodingTypeGob                         = "gob"
+	EncodingTypeThriftRW       EncodingType = "thriftrw"
+	EncodingTypeThriftRWSnappy EncodingType = "thriftrw-snappy"
+	EncodingTypeThriftRWGzip   EncodingType = "thriftrw-gzip"
 )
 
 type (
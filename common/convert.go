@@ -124,6 +124,11 @@ func ChildWorkflowExecutionFailedCausePtr(t s.ChildWorkflowExecutionFailedCause)
 	return &t
 }
 
+// ContinueAsNewInitiatorPtr makes a copy and returns the pointer to a ContinueAsNewInitiator.
+func ContinueAsNewInitiatorPtr(t s.ContinueAsNewInitiator) *s.ContinueAsNewInitiator {
+	return &t
+}
+
 // StringDefault returns value if string pointer is set otherwise default value of string
 func StringDefault(v *string) string {
 	var defaultString string
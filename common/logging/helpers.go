@@ -21,8 +21,8 @@
 package logging
 
 import (
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/logging/tag"
 )
 
 //
@@ -30,52 +30,45 @@ import (
 //
 
 // LogPersistantStoreErrorEvent is used to log errors from persistence layer.
-func LogPersistantStoreErrorEvent(logger bark.Logger, operation string, err error, details string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: PersistentStoreErrorEventID,
-		TagStoreOperation:  operation,
-		TagWorkflowErr:     err,
-	}).Errorf("Persistent store operation failure. Operation Details: %v", details)
+func LogPersistantStoreErrorEvent(logger Logger, operation tag.Tag, err error, details string) {
+	logger.Error("Persistent store operation failure.",
+		tag.WorkflowEventID(PersistentStoreErrorEventID),
+		operation,
+		tag.Error(err),
+		tag.Value(details))
 }
 
 // LogTransferTaskProcessingFailedEvent is used to log failures from transfer task processing.
-func LogTransferTaskProcessingFailedEvent(logger bark.Logger, taskID int64, taskType int, err error) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TransferTaskProcessingFailed,
-		TagTaskID:          taskID,
-		TagTaskType:        taskType,
-		TagWorkflowErr:     err,
-	}).Errorf("Processor failed to process transfer task: %v, type: %v.  Error: %v", taskID, taskType, err)
+func LogTransferTaskProcessingFailedEvent(logger Logger, taskID int64, taskType int, err error) {
+	logger.Error("Processor failed to process transfer task.",
+		tag.WorkflowEventID(TransferTaskProcessingFailed),
+		tag.TaskID(taskID),
+		tag.TaskType(taskType),
+		tag.Error(err))
 }
 
 // LogOperationFailedEvent is used to log generic operation failures.
-func LogOperationFailedEvent(logger bark.Logger, msg string, err error) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: OperationFailed,
-		TagWorkflowErr:     err,
-	}).Warnf("%v.  Error: %v", msg, err)
+func LogOperationFailedEvent(logger Logger, msg string, err error) {
+	logger.Warn(msg,
+		tag.WorkflowEventID(OperationFailed),
+		tag.Error(err))
 }
 
 // LogOperationPanicEvent is used to log fatal errors by application to cause panic
-func LogOperationPanicEvent(logger bark.Logger, msg string, err error) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: OperationPanic,
-		TagWorkflowErr:     err,
-	}).Fatalf("%v.  Error: %v", msg, err)
+func LogOperationPanicEvent(logger Logger, msg string, err error) {
+	logger.Fatal(msg,
+		tag.WorkflowEventID(OperationPanic),
+		tag.Error(err))
 }
 
 // LogInternalServiceError is used to log internal service error
-func LogInternalServiceError(logger bark.Logger, err error) {
-	logger.WithFields(bark.Fields{
-		TagErr: err,
-	}).Error("Internal service error")
+func LogInternalServiceError(logger Logger, err error) {
+	logger.Error("Internal service error", tag.Error(err))
 }
 
 // LogUncategorizedError is used to log error that are uncategorized
-func LogUncategorizedError(logger bark.Logger, err error) {
-	logger.WithFields(bark.Fields{
-		TagErr: err,
-	}).Error("Uncategorized error")
+func LogUncategorizedError(logger Logger, err error) {
+	logger.Error("Uncategorized error", tag.Error(err))
 }
 
 //
@@ -83,194 +76,198 @@ func LogUncategorizedError(logger bark.Logger, err error) {
 //
 
 // LogInvalidHistoryActionEvent is used to log invalid history builder state
-func LogInvalidHistoryActionEvent(logger bark.Logger, action string, eventID int64, state string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID:      InvalidHistoryActionEventID,
-		TagHistoryBuilderAction: action,
-	}).Warnf("Invalid history builder state for action: EventID: %v, State: %v", eventID, state)
+func LogInvalidHistoryActionEvent(logger Logger, action tag.Tag, eventID int64, state string) {
+	logger.Warn("Invalid history builder state for action.",
+		tag.WorkflowEventID(InvalidHistoryActionEventID),
+		action,
+		tag.WorkflowHistoryEventID(eventID),
+		tag.WorkflowState(state))
 }
 
 // LogHistorySerializationErrorEvent is used to log errors serializing execution history
-func LogHistorySerializationErrorEvent(logger bark.Logger, err error, msg string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: HistorySerializationErrorEventID,
-		TagWorkflowErr:     err,
-	}).Errorf("Error serializing workflow execution history.  Msg: %v", msg)
+func LogHistorySerializationErrorEvent(logger Logger, err error, msg string) {
+	logger.Error("Error serializing workflow execution history.",
+		tag.WorkflowEventID(HistorySerializationErrorEventID),
+		tag.Error(err),
+		tag.Value(msg))
 }
 
 // LogHistoryDeserializationErrorEvent is used to log errors deserializing execution history
-func LogHistoryDeserializationErrorEvent(logger bark.Logger, err error, msg string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: HistoryDeserializationErrorEventID,
-		TagWorkflowErr:     err,
-	}).Errorf("Error deserializing workflow execution history.  Msg: %v", msg)
+func LogHistoryDeserializationErrorEvent(logger Logger, err error, msg string) {
+	logger.Error("Error deserializing workflow execution history.",
+		tag.WorkflowEventID(HistoryDeserializationErrorEventID),
+		tag.Error(err),
+		tag.Value(msg))
 }
 
 // LogHistoryEngineStartingEvent is used to log history engine starting
-func LogHistoryEngineStartingEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: HistoryEngineStarting,
-	}).Info("HistoryEngine starting.")
+func LogHistoryEngineStartingEvent(logger Logger) {
+	logger.Info("HistoryEngine starting.", tag.WorkflowEventID(HistoryEngineStarting))
 }
 
 // LogHistoryEngineStartedEvent is used to log history engine started
-func LogHistoryEngineStartedEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: HistoryEngineStarted,
-	}).Info("HistoryEngine started.")
+func LogHistoryEngineStartedEvent(logger Logger) {
+	logger.Info("HistoryEngine started.", tag.WorkflowEventID(HistoryEngineStarted))
 }
 
 // LogHistoryEngineShuttingDownEvent is used to log history shutting down
-func LogHistoryEngineShuttingDownEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: HistoryEngineShuttingDown,
-	}).Info("HistoryEngine shutting down.")
+func LogHistoryEngineShuttingDownEvent(logger Logger) {
+	logger.Info("HistoryEngine shutting down.", tag.WorkflowEventID(HistoryEngineShuttingDown))
 }
 
 // LogHistoryEngineShutdownEvent is used to log history shut down complete
-func LogHistoryEngineShutdownEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: HistoryEngineShutdown,
-	}).Info("HistoryEngine shutdown.")
+func LogHistoryEngineShutdownEvent(logger Logger) {
+	logger.Info("HistoryEngine shutdown.", tag.WorkflowEventID(HistoryEngineShutdown))
 }
 
 // LogDuplicateTaskEvent is used to log the event when a duplicate task is detected
-func LogDuplicateTaskEvent(lg bark.Logger, taskType int, taskID int64, requestID string, scheduleID, startedID int64,
+func LogDuplicateTaskEvent(lg Logger, taskType int, taskID int64, requestID string, scheduleID, startedID int64,
 	isRunning bool) {
-	lg.WithFields(bark.Fields{
-		TagWorkflowEventID: DuplicateTaskEventID,
-	}).Debugf("Potentially duplicate task.  TaskID: %v, TaskType: %v, RequestID: %v, scheduleID: %v, startedID: %v, isRunning: %v",
-		taskID, taskType, requestID, scheduleID, startedID, isRunning)
+	lg.Debug("Potentially duplicate task.",
+		tag.WorkflowEventID(DuplicateTaskEventID),
+		tag.TaskID(taskID),
+		tag.TaskType(taskType),
+		tag.WorkflowRequestID(requestID),
+		tag.WorkflowScheduleID(scheduleID),
+		tag.WorkflowStartedID(startedID),
+		tag.Bool(isRunning))
 }
 
 // LogDuplicateTransferTaskEvent is used to log the event when duplicate processing of the same transfer task is detected
-func LogDuplicateTransferTaskEvent(lg bark.Logger, taskType int, taskID int64, scheduleID int64) {
-	lg.WithFields(bark.Fields{
-		TagWorkflowEventID: DuplicateTransferTaskEventID,
-	}).Debugf("Potentially duplicate task.  TaskID: %v, TaskType: %v, scheduleID: %v",
-		taskID, taskType, scheduleID)
+func LogDuplicateTransferTaskEvent(lg Logger, taskType int, taskID int64, scheduleID int64) {
+	lg.Debug("Potentially duplicate task.",
+		tag.WorkflowEventID(DuplicateTransferTaskEventID),
+		tag.TaskID(taskID),
+		tag.TaskType(taskType),
+		tag.WorkflowScheduleID(scheduleID))
 }
 
 // LogShardRangeUpdatedEvent is used to log rangeID update for a shard
-func LogShardRangeUpdatedEvent(logger bark.Logger, shardID int, rangeID, startSequence, endSequence int64) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardRangeUpdatedEventID,
-	}).Infof("Range updated for shardID '%v'.  RangeID: %v, StartSequence: %v, EndSequence: %v", shardID,
-		rangeID, startSequence, endSequence)
+func LogShardRangeUpdatedEvent(logger Logger, shardID int, rangeID, startSequence, endSequence int64) {
+	logger.Info("Range updated for shard.",
+		tag.WorkflowEventID(ShardRangeUpdatedEventID),
+		tag.ShardID(shardID),
+		tag.ShardRangeID(rangeID),
+		tag.TaskID(startSequence),
+		tag.Number(endSequence))
 }
 
 // LogShardControllerStartedEvent is used to log shard controller started
-func LogShardControllerStartedEvent(logger bark.Logger, host string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardControllerStarted,
-	}).Infof("ShardController started on host: %v", host)
+func LogShardControllerStartedEvent(logger Logger, host string) {
+	logger.Info("ShardController started.",
+		tag.WorkflowEventID(ShardControllerStarted),
+		tag.Address(host))
 }
 
 // LogShardControllerShutdownEvent is used to log shard controller shutdown complete
-func LogShardControllerShutdownEvent(logger bark.Logger, host string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardControllerShutdown,
-	}).Infof("ShardController stopped on host: %v", host)
+func LogShardControllerShutdownEvent(logger Logger, host string) {
+	logger.Info("ShardController stopped.",
+		tag.WorkflowEventID(ShardControllerShutdown),
+		tag.Address(host))
 }
 
 // LogShardControllerShuttingDownEvent is used to log shard controller shutting down
-func LogShardControllerShuttingDownEvent(logger bark.Logger, host string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardControllerShuttingDown,
-	}).Infof("ShardController stopping on host: %v", host)
+func LogShardControllerShuttingDownEvent(logger Logger, host string) {
+	logger.Info("ShardController stopping.",
+		tag.WorkflowEventID(ShardControllerShuttingDown),
+		tag.Address(host))
 }
 
 // LogShardControllerShutdownTimedoutEvent is used to log timeout during shard controller shutdown
-func LogShardControllerShutdownTimedoutEvent(logger bark.Logger, host string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardControllerShutdownTimedout,
-	}).Warnf("ShardController timed out during shutdown on host: %v", host)
+func LogShardControllerShutdownTimedoutEvent(logger Logger, host string) {
+	logger.Warn("ShardController timed out during shutdown.",
+		tag.WorkflowEventID(ShardControllerShutdownTimedout),
+		tag.Address(host))
 }
 
 // LogRingMembershipChangedEvent is used to log membership changes events received by shard controller
-func LogRingMembershipChangedEvent(logger bark.Logger, host string, added, removed, updated int) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: RingMembershipChangedEvent,
-	}).Infof("ShardController on host '%v' received ring membership changed event: {Added: %v, Removed: %v, Updated: %v}",
-		host, added, removed, updated)
+func LogRingMembershipChangedEvent(logger Logger, host string, added, removed, updated int) {
+	logger.Info("ShardController received ring membership changed event.",
+		tag.WorkflowEventID(RingMembershipChangedEvent),
+		tag.Address(host),
+		tag.NumberAdded(added),
+		tag.NumberRemoved(removed),
+		tag.NumberUpdated(updated))
 }
 
 // LogShardClosedEvent is used to log shard closed event
-func LogShardClosedEvent(logger bark.Logger, host string, shardID int) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardClosedEvent,
-		TagHistoryShardID:  shardID,
-	}).Infof("ShardController on host '%v' received shard closed event for shardID: %v", host, shardID)
+func LogShardClosedEvent(logger Logger, host string, shardID int) {
+	logger.Info("ShardController received shard closed event.",
+		tag.WorkflowEventID(ShardClosedEvent),
+		tag.Address(host),
+		tag.ShardID(shardID))
 }
 
 // LogShardItemCreatedEvent is used to log creation of a shard item
-func LogShardItemCreatedEvent(logger bark.Logger, host string, shardID int) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardItemCreated,
-	}).Infof("ShardController on host '%v' created a shard item for shardID '%v'.", host, shardID)
+func LogShardItemCreatedEvent(logger Logger, host string, shardID int) {
+	logger.Info("ShardController created a shard item.",
+		tag.WorkflowEventID(ShardItemCreated),
+		tag.Address(host),
+		tag.ShardID(shardID))
 }
 
 // LogShardItemRemovedEvent is used to log removal of a shard item
-func LogShardItemRemovedEvent(logger bark.Logger, host string, shardID int, remainingShards int) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardItemRemoved,
-	}).Infof("ShardController on host '%v' removed shard item for shardID '%v'.  Remaining number of shards: %v",
-		host, shardID, remainingShards)
+func LogShardItemRemovedEvent(logger Logger, host string, shardID int, remainingShards int) {
+	logger.Info("ShardController removed a shard item.",
+		tag.WorkflowEventID(ShardItemRemoved),
+		tag.Address(host),
+		tag.ShardID(shardID),
+		tag.Counter(remainingShards))
 }
 
 // LogShardEngineCreatingEvent is used to log start of history engine creation
-func LogShardEngineCreatingEvent(logger bark.Logger, host string, shardID int) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardEngineCreating,
-	}).Infof("ShardController on host '%v' creating engine for shardID '%v'.", host, shardID)
+func LogShardEngineCreatingEvent(logger Logger, host string, shardID int) {
+	logger.Info("ShardController creating engine for shard.",
+		tag.WorkflowEventID(ShardEngineCreating),
+		tag.Address(host),
+		tag.ShardID(shardID))
 }
 
 // LogShardEngineCreatedEvent is used to log completion of history engine creation
-func LogShardEngineCreatedEvent(logger bark.Logger, host string, shardID int) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardEngineCreated,
-	}).Infof("ShardController on host '%v' created engine for shardID '%v'.", host, shardID)
+func LogShardEngineCreatedEvent(logger Logger, host string, shardID int) {
+	logger.Info("ShardController created engine for shard.",
+		tag.WorkflowEventID(ShardEngineCreated),
+		tag.Address(host),
+		tag.ShardID(shardID))
 }
 
 // LogShardEngineStoppingEvent is used to log when stopping engine for a shard has started
-func LogShardEngineStoppingEvent(logger bark.Logger, host string, shardID int) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardEngineStopping,
-	}).Infof("ShardController on host '%v' stopping engine for shardID '%v'.", host, shardID)
+func LogShardEngineStoppingEvent(logger Logger, host string, shardID int) {
+	logger.Info("ShardController stopping engine for shard.",
+		tag.WorkflowEventID(ShardEngineStopping),
+		tag.Address(host),
+		tag.ShardID(shardID))
 }
 
 // LogShardEngineStoppedEvent is used to log when stopping engine for a shard has completed
-func LogShardEngineStoppedEvent(logger bark.Logger, host string, shardID int) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ShardEngineStopped,
-	}).Infof("ShardController on host '%v' stopped engine for shardID '%v'.", host, shardID)
+func LogShardEngineStoppedEvent(logger Logger, host string, shardID int) {
+	logger.Info("ShardController stopped engine for shard.",
+		tag.WorkflowEventID(ShardEngineStopped),
+		tag.Address(host),
+		tag.ShardID(shardID))
 }
 
 // LogMutableStateInvalidAction is used to log invalid mutable state builder state
-func LogMutableStateInvalidAction(logger bark.Logger, errorMsg string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: InvalidMutableStateActionEventID,
-	}).Errorf("%v.  ", errorMsg)
+func LogMutableStateInvalidAction(logger Logger, errorMsg string) {
+	logger.Error(errorMsg, tag.WorkflowEventID(InvalidMutableStateActionEventID))
 }
 
 // LogMultipleCompletionDecisionsEvent is used to log multiple completion decisions for an execution
-func LogMultipleCompletionDecisionsEvent(lg bark.Logger, decisionType shared.DecisionType) {
-	lg.WithFields(bark.Fields{
-		TagWorkflowEventID: MultipleCompletionDecisionsEventID,
-		TagDecisionType:    decisionType,
-	}).Warnf("Multiple completion decisions.  DecisionType: %v", decisionType)
+func LogMultipleCompletionDecisionsEvent(lg Logger, decisionType shared.DecisionType) {
+	lg.Warn("Multiple completion decisions.",
+		tag.WorkflowEventID(MultipleCompletionDecisionsEventID),
+		tag.WorkflowDecisionType(decisionType))
 }
 
 // LogDecisionFailedEvent is used to log decision failures by RespondDecisionTaskCompleted handler
-func LogDecisionFailedEvent(lg bark.Logger, domainID, workflowID, runID string,
+func LogDecisionFailedEvent(lg Logger, domainID, workflowID, runID string,
 	failCause shared.DecisionTaskFailedCause) {
-	lg.WithFields(bark.Fields{
-		TagWorkflowEventID:     DecisionFailedEventID,
-		TagDomainID:            domainID,
-		TagWorkflowExecutionID: workflowID,
-		TagWorkflowRunID:       runID,
-		TagDecisionFailCause:   failCause,
-	}).Info("Failing the decision.")
+	lg.Info("Failing the decision.",
+		tag.WorkflowEventID(DecisionFailedEventID),
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(workflowID),
+		tag.WorkflowRunID(runID),
+		tag.WorkflowDecisionFailCause(failCause))
 }
 
 //
@@ -278,151 +275,122 @@ func LogDecisionFailedEvent(lg bark.Logger, domainID, workflowID, runID string,
 //
 
 // LogTaskListLoadingEvent is used to log starting of a new task list loading
-func LogTaskListLoadingEvent(logger bark.Logger, taskListName string, taskListType int) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TaskListLoading,
-		TagTaskListName:    taskListName,
-		TagTaskListType:    taskListType,
-	}).Info("Loading TaskList.")
+func LogTaskListLoadingEvent(logger Logger, taskListName string, taskListType int) {
+	logger.Info("Loading TaskList.",
+		tag.WorkflowEventID(TaskListLoading),
+		tag.TaskListName(taskListName),
+		tag.TaskListType(taskListType))
 }
 
 // LogTaskListLoadedEvent is used to log completion of a new task list loading
-func LogTaskListLoadedEvent(logger bark.Logger, taskListName string, taskListType int) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TaskListLoaded,
-		TagTaskListName:    taskListName,
-		TagTaskListType:    taskListType,
-	}).Info("Loaded TaskList.")
+func LogTaskListLoadedEvent(logger Logger, taskListName string, taskListType int) {
+	logger.Info("Loaded TaskList.",
+		tag.WorkflowEventID(TaskListLoaded),
+		tag.TaskListName(taskListName),
+		tag.TaskListType(taskListType))
 }
 
 // LogTaskListLoadingFailedEvent is used to log failure of a new task list loading
-func LogTaskListLoadingFailedEvent(logger bark.Logger, taskListName string, taskListType int, err error) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TaskListLoadingFailed,
-		TagTaskListName:    taskListName,
-		TagTaskListType:    taskListType,
-		TagWorkflowErr:     err,
-	}).Info("Loading TaskList failed.")
+func LogTaskListLoadingFailedEvent(logger Logger, taskListName string, taskListType int, err error) {
+	logger.Info("Loading TaskList failed.",
+		tag.WorkflowEventID(TaskListLoadingFailed),
+		tag.TaskListName(taskListName),
+		tag.TaskListType(taskListType),
+		tag.Error(err))
 }
 
 // LogTaskListUnloadingEvent is used to log starting of a task list unloading
-func LogTaskListUnloadingEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TaskListUnloading,
-	}).Info("Unloading TaskList.")
+func LogTaskListUnloadingEvent(logger Logger) {
+	logger.Info("Unloading TaskList.", tag.WorkflowEventID(TaskListUnloading))
 }
 
 // LogTaskListUnloadedEvent is used to log completion of a task list unloading
-func LogTaskListUnloadedEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TaskListUnloaded,
-	}).Info("Unloaded TaskList.")
+func LogTaskListUnloadedEvent(logger Logger) {
+	logger.Info("Unloaded TaskList.", tag.WorkflowEventID(TaskListUnloaded))
 }
 
 // LogQueryTaskMissingWorkflowTypeErrorEvent is used to log invalid query task that is missing workflow type
-func LogQueryTaskMissingWorkflowTypeErrorEvent(logger bark.Logger, workflowID, runID, queryType string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: InvalidQueryTaskEventID,
-		"WorkflowID":       workflowID,
-		"RunID":            runID,
-		"QueryType":        queryType,
-	}).Error("Cannot get WorkflowType for QueryTask.")
+func LogQueryTaskMissingWorkflowTypeErrorEvent(logger Logger, workflowID, runID, queryType string) {
+	logger.Error("Cannot get WorkflowType for QueryTask.",
+		tag.WorkflowEventID(InvalidQueryTaskEventID),
+		tag.WorkflowID(workflowID),
+		tag.WorkflowRunID(runID),
+		tag.WorkflowQueryType(queryType))
 }
 
 // LogQueryTaskFailedEvent is used to log query task failure
-func LogQueryTaskFailedEvent(logger bark.Logger, domain, workflowID, runID, queryType string) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: QueryTaskFailedEventID,
-		"Domain":           domain,
-		"WorkflowID":       workflowID,
-		"RunID":            runID,
-		"QueryType":        queryType,
-	}).Info("QueryWorkflowFailed.")
+func LogQueryTaskFailedEvent(logger Logger, domain, workflowID, runID, queryType string) {
+	logger.Info("QueryWorkflowFailed.",
+		tag.WorkflowEventID(QueryTaskFailedEventID),
+		tag.WorkflowDomainName(domain),
+		tag.WorkflowID(workflowID),
+		tag.WorkflowRunID(runID),
+		tag.WorkflowQueryType(queryType))
 }
 
 // LogReplicationTaskProcessorStartingEvent is used to log replication task processor starting
-func LogReplicationTaskProcessorStartingEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ReplicationTaskProcessorStarting,
-	}).Info("Replication task processor starting.")
+func LogReplicationTaskProcessorStartingEvent(logger Logger) {
+	logger.Info("Replication task processor starting.", tag.WorkflowEventID(ReplicationTaskProcessorStarting))
 }
 
 // LogReplicationTaskProcessorStartedEvent is used to log replication task processor started
-func LogReplicationTaskProcessorStartedEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ReplicationTaskProcessorStarted,
-	}).Info("Replication task processor started.")
+func LogReplicationTaskProcessorStartedEvent(logger Logger) {
+	logger.Info("Replication task processor started.", tag.WorkflowEventID(ReplicationTaskProcessorStarted))
 }
 
 // LogReplicationTaskProcessorStartFailedEvent is used to log replication task processor started
-func LogReplicationTaskProcessorStartFailedEvent(logger bark.Logger, err error) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ReplicationTaskProcessorStartFailed,
-	}).WithError(err).Warn("Replication task processor failed to start.")
+func LogReplicationTaskProcessorStartFailedEvent(logger Logger, err error) {
+	logger.Warn("Replication task processor failed to start.",
+		tag.WorkflowEventID(ReplicationTaskProcessorStartFailed),
+		tag.Error(err))
 }
 
 // LogReplicationTaskProcessorShuttingDownEvent is used to log replication task processing shutting down
-func LogReplicationTaskProcessorShuttingDownEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ReplicationTaskProcessorShuttingDown,
-	}).Info("Replication task processor shutting down.")
+func LogReplicationTaskProcessorShuttingDownEvent(logger Logger) {
+	logger.Info("Replication task processor shutting down.", tag.WorkflowEventID(ReplicationTaskProcessorShuttingDown))
 }
 
 // LogReplicationTaskProcessorShutdownEvent is used to log replication task processor shutdown complete
-func LogReplicationTaskProcessorShutdownEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ReplicationTaskProcessorShutdown,
-	}).Info("Replication task processor shutdown.")
+func LogReplicationTaskProcessorShutdownEvent(logger Logger) {
+	logger.Info("Replication task processor shutdown.", tag.WorkflowEventID(ReplicationTaskProcessorShutdown))
 }
 
 // LogReplicationTaskProcessorShutdownTimedoutEvent is used to log timeout during replication task processor shutdown
-func LogReplicationTaskProcessorShutdownTimedoutEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: ReplicationTaskProcessorShutdownTimedout,
-	}).Warn("Replication task processor timedout on shutdown.")
+func LogReplicationTaskProcessorShutdownTimedoutEvent(logger Logger) {
+	logger.Warn("Replication task processor timedout on shutdown.",
+		tag.WorkflowEventID(ReplicationTaskProcessorShutdownTimedout))
 }
 
 // LogQueueProcesorStartingEvent is used to log queue processor starting
-func LogQueueProcesorStartingEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TransferQueueProcessorStarting,
-	}).Info("Queue processor starting.")
+func LogQueueProcesorStartingEvent(logger Logger) {
+	logger.Info("Queue processor starting.", tag.WorkflowEventID(TransferQueueProcessorStarting))
 }
 
 // LogQueueProcesorStartedEvent is used to log queue processor started
-func LogQueueProcesorStartedEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TransferQueueProcessorStarted,
-	}).Info("Queue processor started.")
+func LogQueueProcesorStartedEvent(logger Logger) {
+	logger.Info("Queue processor started.", tag.WorkflowEventID(TransferQueueProcessorStarted))
 }
 
 // LogQueueProcesorShuttingDownEvent is used to log queue processor shutting down
-func LogQueueProcesorShuttingDownEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TransferQueueProcessorShuttingDown,
-	}).Info("Queue processor shutting down.")
+func LogQueueProcesorShuttingDownEvent(logger Logger) {
+	logger.Info("Queue processor shutting down.", tag.WorkflowEventID(TransferQueueProcessorShuttingDown))
 }
 
 // LogQueueProcesorShutdownEvent is used to log transfer queue processor shutdown complete
-func LogQueueProcesorShutdownEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TransferQueueProcessorShutdown,
-	}).Info("Queue processor shutdown.")
+func LogQueueProcesorShutdownEvent(logger Logger) {
+	logger.Info("Queue processor shutdown.", tag.WorkflowEventID(TransferQueueProcessorShutdown))
 }
 
 // LogQueueProcesorShutdownTimedoutEvent is used to log timeout during transfer queue processor shutdown
-func LogQueueProcesorShutdownTimedoutEvent(logger bark.Logger) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TransferQueueProcessorShutdownTimedout,
-	}).Warn("Queue processor timedout on shutdown.")
+func LogQueueProcesorShutdownTimedoutEvent(logger Logger) {
+	logger.Warn("Queue processor timedout on shutdown.", tag.WorkflowEventID(TransferQueueProcessorShutdownTimedout))
 }
 
 // LogTaskProcessingFailedEvent is used to log failures from task processing.
-func LogTaskProcessingFailedEvent(logger bark.Logger, taskID int64, taskType int, err error) {
-	logger.WithFields(bark.Fields{
-		TagWorkflowEventID: TransferTaskProcessingFailed,
-		TagTaskID:          taskID,
-		TagTaskType:        taskType,
-		TagWorkflowErr:     err,
-	}).Errorf("Processor failed to process task: %v, type: %v.  Error: %v", taskID, taskType, err)
+func LogTaskProcessingFailedEvent(logger Logger, taskID int64, taskType int, err error) {
+	logger.Error("Processor failed to process task.",
+		tag.WorkflowEventID(TransferTaskProcessingFailed),
+		tag.TaskID(taskID),
+		tag.TaskType(taskType),
+		tag.Error(err))
 }
@@ -0,0 +1,99 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package logging
+
+import (
+	"github.com/uber/cadence/common/logging/tag"
+	"go.uber.org/zap"
+)
+
+type (
+	// Logger is the structured logger of the services, the fields of an entry
+	// are typed tags from the tag package instead of a formatted message
+	Logger interface {
+		Debug(msg string, tags ...tag.Tag)
+		Info(msg string, tags ...tag.Tag)
+		Warn(msg string, tags ...tag.Tag)
+		Error(msg string, tags ...tag.Tag)
+		Fatal(msg string, tags ...tag.Tag)
+		// WithTags returns a logger adding the tags to every entry, it is used to
+		// scope a logger to a component, a shard or a request
+		WithTags(tags ...tag.Tag) Logger
+	}
+
+	zapLogger struct {
+		zl *zap.Logger
+	}
+)
+
+var _ Logger = (*zapLogger)(nil)
+
+// NewLogger returns a logger writing to the zap logger
+func NewLogger(zl *zap.Logger) Logger {
+	// the entries are reported at the caller of the logger methods
+	return &zapLogger{zl: zl.WithOptions(zap.AddCallerSkip(1))}
+}
+
+// NewNopLogger returns a logger dropping every entry
+func NewNopLogger() Logger {
+	return NewLogger(zap.NewNop())
+}
+
+// NewDevelopmentLogger returns a logger writing human readable entries to stderr, for tests
+func NewDevelopmentLogger() Logger {
+	zl, err := zap.NewDevelopment()
+	if err != nil {
+		panic(err)
+	}
+	return NewLogger(zl)
+}
+
+func (l *zapLogger) Debug(msg string, tags ...tag.Tag) {
+	l.zl.Debug(msg, toFields(tags)...)
+}
+
+func (l *zapLogger) Info(msg string, tags ...tag.Tag) {
+	l.zl.Info(msg, toFields(tags)...)
+}
+
+func (l *zapLogger) Warn(msg string, tags ...tag.Tag) {
+	l.zl.Warn(msg, toFields(tags)...)
+}
+
+func (l *zapLogger) Error(msg string, tags ...tag.Tag) {
+	l.zl.Error(msg, toFields(tags)...)
+}
+
+func (l *zapLogger) Fatal(msg string, tags ...tag.Tag) {
+	l.zl.Fatal(msg, toFields(tags)...)
+}
+
+func (l *zapLogger) WithTags(tags ...tag.Tag) Logger {
+	return &zapLogger{zl: l.zl.With(toFields(tags)...)}
+}
+
+func toFields(tags []tag.Tag) []zap.Field {
+	fields := make([]zap.Field, len(tags))
+	for i, t := range tags {
+		fields[i] = t.Field()
+	}
+	return fields
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tag
+
+import (
+	"fmt"
+	"time"
+)
+
+// All the tags of the services are defined here, a helper per key
+// keeps the keys and the types of their values consistent
+
+///////////////////  Common tags  ///////////////////
+
+// Error returns a tag for an error
+func Error(err error) Tag {
+	return newErrorTag("error", err)
+}
+
+// Hostname returns a tag for the host name
+func Hostname(hostname string) Tag {
+	return newStringTag("hostname", hostname)
+}
+
+// Address returns a tag for a host and port
+func Address(address string) Tag {
+	return newStringTag("address", address)
+}
+
+// Port returns a tag for a port
+func Port(port int) Tag {
+	return newIntTag("port", port)
+}
+
+// FilePath returns a tag for the path of a file
+func FilePath(path string) Tag {
+	return newStringTag("file-path", path)
+}
+
+// Service returns a tag for a service name
+
+func Service(service string) Tag {
+	return newStringTag("service", service)
+}
+
+// Timestamp returns a tag for a point in time
+func Timestamp(timestamp time.Time) Tag {
+	return newTimeTag("timestamp", timestamp)
+}
+
+// Duration returns a tag for a duration
+func Duration(duration time.Duration) Tag {
+	return newDurationTag("duration", duration)
+}
+
+// Counter returns a tag for a count
+func Counter(counter int) Tag {
+	return newIntTag("counter", counter)
+}
+
+// Number returns a tag for a number which has no better suited tag
+func Number(number int64) Tag {
+	return newInt64Tag("number", number)
+}
+
+// NumberAdded returns a tag for the number of added items
+func NumberAdded(number int) Tag {
+	return newIntTag("number-added", number)
+}
+
+// NumberRemoved returns a tag for the number of removed items
+func NumberRemoved(number int) Tag {
+	return newIntTag("number-removed", number)
+}
+
+// NumberUpdated returns a tag for the number of updated items
+func NumberUpdated(number int) Tag {
+	return newIntTag("number-updated", number)
+}
+
+// Key returns a tag for the key of a config or a map
+func Key(key string) Tag {
+	return newStringTag("key", key)
+}
+
+// Value returns a tag for a value which has no better suited tag
+func Value(value interface{}) Tag {
+	return newObjectTag("value", value)
+}
+
+// Name returns a tag for a name which has no better suited tag
+func Name(name string) Tag {
+	return newStringTag("name", name)
+}
+
+// Bool returns a tag for a flag which has no better suited tag
+func Bool(value bool) Tag {
+	return newBoolTag("bool", value)
+}
+
+// Attempt returns a tag for the number of attempts of an operation
+func Attempt(attempt int64) Tag {
+	return newInt64Tag("attempt", attempt)
+}
+
+///////////////////  Workflow tags  ///////////////////
+
+// WorkflowEventID returns a tag for the id of a logged event, see the logging events
+func WorkflowEventID(eventID int) Tag {
+	return newIntTag("wf-event-id", eventID)
+}
+
+// WorkflowCluster returns a tag for the cluster a component works for
+func WorkflowCluster(cluster string) Tag {
+	return newStringTag("wf-cluster", cluster)
+}
+
+// WorkflowDomainID returns a tag for a domain id
+func WorkflowDomainID(domainID string) Tag {
+	return newStringTag("domain-id", domainID)
+}
+
+// WorkflowDomainName returns a tag for a domain name
+func WorkflowDomainName(domainName string) Tag {
+	return newStringTag("domain-name", domainName)
+}
+
+// WorkflowID returns a tag for a workflow id
+func WorkflowID(workflowID string) Tag {
+	return newStringTag("execution-id", workflowID)
+}
+
+// WorkflowRunID returns a tag for a workflow run id
+func WorkflowRunID(runID string) Tag {
+	return newStringTag("run-id", runID)
+}
+
+// WorkflowType returns a tag for a workflow type name
+func WorkflowType(workflowType string) Tag {
+	return newStringTag("wf-type", workflowType)
+}
+
+// WorkflowHistoryEventID returns a tag for the id of a history event
+func WorkflowHistoryEventID(eventID int64) Tag {
+	return newInt64Tag("history-event-id", eventID)
+}
+
+// WorkflowNextEventID returns a tag for the next event id of a workflow
+func WorkflowNextEventID(nextEventID int64) Tag {
+	return newInt64Tag("next-event-id", nextEventID)
+}
+
+// WorkflowScheduleID returns a tag for the schedule id of a decision or an activity
+func WorkflowScheduleID(scheduleID int64) Tag {
+	return newInt64Tag("schedule-id", scheduleID)
+}
+
+// WorkflowActivityID returns a tag for the id of an activity
+func WorkflowActivityID(activityID string) Tag {
+	return newStringTag("activity-id", activityID)
+}
+
+// WorkflowStartedID returns a tag for the started id of a decision or an activity
+func WorkflowStartedID(startedID int64) Tag {
+	return newInt64Tag("started-id", startedID)
+}
+
+// WorkflowState returns a tag for the state of a workflow or a builder
+func WorkflowState(state interface{}) Tag {
+	return newObjectTag("wf-state", state)
+}
+
+// WorkflowRequestID returns a tag for the request id of a call
+func WorkflowRequestID(requestID string) Tag {
+	return newStringTag("request-id", requestID)
+}
+
+// WorkflowDecisionType returns a tag for a decision type
+func WorkflowDecisionType(decisionType fmt.Stringer) Tag {
+	return newStringTag("decision-type", decisionType.String())
+}
+
+// WorkflowDecisionFailCause returns a tag for the cause of a decision failure
+func WorkflowDecisionFailCause(cause fmt.Stringer) Tag {
+	return newStringTag("decision-fail-cause", cause.String())
+}
+
+// WorkflowQueryType returns a tag for a query type
+func WorkflowQueryType(queryType string) Tag {
+	return newStringTag("query-type", queryType)
+}
+
+// WorkflowTimeout returns a tag for a timeout of a workflow, decision or activity in seconds
+func WorkflowTimeout(seconds int32) Tag {
+	return newInt32Tag("wf-timeout", seconds)
+}
+
+// WorkflowTimeoutType returns a tag for the type of a timeout
+func WorkflowTimeoutType(timeoutType fmt.Stringer) Tag {
+	return newStringTag("wf-timeout-type", timeoutType.String())
+}
+
+// WorkflowTimerID returns a tag for the id of a user timer
+func WorkflowTimerID(timerID string) Tag {
+	return newStringTag("wf-timer-id", timerID)
+}
+
+// WorkflowLastEventID returns a tag for the id of the last event written to a workflow history
+func WorkflowLastEventID(eventID int64) Tag {
+	return newInt64Tag("wf-last-event-id", eventID)
+}
+
+// WorkflowVersion returns a tag for a failover version
+func WorkflowVersion(version int64) Tag {
+	return newInt64Tag("version", version)
+}
+
+///////////////////  History tags  ///////////////////
+
+// ShardID returns a tag for a history shard id
+func ShardID(shardID int) Tag {
+	return newIntTag("shard-id", shardID)
+}
+
+// ShardRangeID returns a tag for the range id of a shard
+func ShardRangeID(rangeID int64) Tag {
+	return newInt64Tag("shard-range-id", rangeID)
+}
+
+// TaskID returns a tag for the id of a transfer, timer or replication task
+func TaskID(taskID int64) Tag {
+	return newInt64Tag("task-id", taskID)
+}
+
+// TaskType returns a tag for the type of a transfer, timer or replication task
+func TaskType(taskType int) Tag {
+	return newIntTag("task-type", taskType)
+}
+
+// ReadLevel returns a tag for the read level of a queue
+func ReadLevel(readLevel interface{}) Tag {
+	return newObjectTag("read-level", readLevel)
+}
+
+// AckLevel returns a tag for the ack level of a queue
+func AckLevel(ackLevel interface{}) Tag {
+	return newObjectTag("ack-level", ackLevel)
+}
+
+// IncomingVersion returns a tag for the failover version of a replication task
+func IncomingVersion(version int64) Tag {
+	return newInt64Tag("incoming-version", version)
+}
+
+// PrevActiveCluster returns a tag for the cluster which was active before a failover
+func PrevActiveCluster(cluster string) Tag {
+	return newStringTag("prev-active-cluster", cluster)
+}
+
+// SourceCluster returns a tag for the cluster a replication task comes from
+func SourceCluster(cluster string) Tag {
+	return newStringTag("source-cluster", cluster)
+}
+
+// TargetCluster returns a tag for the cluster a replication task is shipped to
+func TargetCluster(cluster string) Tag {
+	return newStringTag("target-cluster", cluster)
+}
+
+///////////////////  Matching tags  ///////////////////
+
+// TaskListName returns a tag for a task list name
+func TaskListName(taskListName string) Tag {
+	return newStringTag("task-list-name", taskListName)
+}
+
+// TaskListType returns a tag for a task list type
+func TaskListType(taskListType int) Tag {
+	return newIntTag("task-list-type", taskListType)
+}
+
+///////////////////  Kafka tags  ///////////////////
+
+// KafkaTopicName returns a tag for a kafka topic
+func KafkaTopicName(topicName string) Tag {
+	return newStringTag("topic-name", topicName)
+}
+
+// KafkaConsumerName returns a tag for a kafka consumer
+func KafkaConsumerName(consumerName string) Tag {
+	return newStringTag("consumer-name", consumerName)
+}
+
+// KafkaPartition returns a tag for a kafka partition
+func KafkaPartition(partition int32) Tag {
+	return newInt32Tag("partition", partition)
+}
+
+// KafkaOffset returns a tag for a kafka offset
+func KafkaOffset(offset int64) Tag {
+	return newInt64Tag("offset", offset)
+}
+
+///////////////////  Persistence tags  ///////////////////
+
+// StoreType returns a tag for the type of the persistence store
+func StoreType(storeType string) Tag {
+	return newStringTag("store-type", storeType)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tag
+
+// The values of the component, history builder action and store operation tags
+var (
+	// component values
+	ComponentHistoryBuilder           = component("history-builder")
+	ComponentHistoryEngine            = component("history-engine")
+	ComponentHistoryCache             = component("history-cache")
+	ComponentTransferQueue            = component("transfer-queue-processor")
+	ComponentTimerQueue               = component("timer-queue-processor")
+	ComponentReplicatorQueue          = component("replicator-queue-processor")
+	ComponentShardController          = component("shard-controller")
+	ComponentMatchingEngine           = component("matching-engine")
+	ComponentReplicator               = component("replicator")
+	ComponentReplicationTaskProcessor = component("replication-task-processor")
+	ComponentWorkflowResetor          = component("workflow-resetor")
+	ComponentArchiver                 = component("archiver")
+	ComponentServiceResolver          = component("service-resolver")
+	ComponentTimerBuilder             = component("timer-builder")
+
+	// history builder action values
+	ActionWorkflowStarted                 = historyBuilderAction("add-workflowexecution-started-event")
+	ActionDecisionTaskScheduled           = historyBuilderAction("add-decisiontask-scheduled-event")
+	ActionDecisionTaskStarted             = historyBuilderAction("add-decisiontask-started-event")
+	ActionDecisionTaskCompleted           = historyBuilderAction("add-decisiontask-completed-event")
+	ActionDecisionTaskTimedOut            = historyBuilderAction("add-decisiontask-timedout-event")
+	ActionDecisionTaskFailed              = historyBuilderAction("add-decisiontask-failed-event")
+	ActionActivityTaskScheduled           = historyBuilderAction("add-activitytask-scheduled-event")
+	ActionActivityTaskStarted             = historyBuilderAction("add-activitytask-started-event")
+	ActionActivityTaskCompleted           = historyBuilderAction("add-activitytask-completed-event")
+	ActionActivityTaskFailed              = historyBuilderAction("add-activitytask-failed-event")
+	ActionActivityTaskTimedOut            = historyBuilderAction("add-activitytask-timed-event")
+	ActionActivityTaskCanceled            = historyBuilderAction("add-activitytask-canceled-event")
+	ActionActivityTaskCancelRequest       = historyBuilderAction("add-activitytask-cancel-request-event")
+	ActionActivityTaskCancelRequestFailed = historyBuilderAction("add-activitytask-cancel-request-failed-event")
+	ActionCompleteWorkflow                = historyBuilderAction("add-complete-workflow-event")
+	ActionFailWorkflow                    = historyBuilderAction("add-fail-workflow-event")
+	ActionTimeoutWorkflow                 = historyBuilderAction("add-timeout-workflow-event")
+	ActionCancelWorkflow                  = historyBuilderAction("add-cancel-workflow-event")
+	ActionTimerStarted                    = historyBuilderAction("add-timer-started-event")
+	ActionTimerFired                      = historyBuilderAction("add-timer-fired-event")
+	ActionTimerCanceled                   = historyBuilderAction("add-timer-Canceled-event")
+	ActionWorkflowTerminated              = historyBuilderAction("add-workflowexecution-terminated-event")
+	ActionWorkflowSignaled                = historyBuilderAction("add-workflowexecution-signaled-event")
+	ActionContinueAsNew                   = historyBuilderAction("add-continue-as-new-event")
+	ActionWorkflowCanceled                = historyBuilderAction("add-workflowexecution-canceled-event")
+	ActionChildExecutionStarted           = historyBuilderAction("add-childexecution-started-event")
+	ActionStartChildExecutionFailed       = historyBuilderAction("add-start-childexecution-failed-event")
+	ActionChildExecutionCompleted         = historyBuilderAction("add-childexecution-completed-event")
+	ActionChildExecutionFailed            = historyBuilderAction("add-childexecution-failed-event")
+	ActionChildExecutionCanceled          = historyBuilderAction("add-childexecution-canceled-event")
+	ActionChildExecutionTerminated        = historyBuilderAction("add-childexecution-terminated-event")
+	ActionChildExecutionTimedOut          = historyBuilderAction("add-childexecution-timedout-event")
+	ActionRequestCancelWorkflow           = historyBuilderAction("add-request-cancel-workflow-event")
+	ActionWorkflowCancelRequested         = historyBuilderAction("add-workflow-execution-cancel-requested-event")
+	ActionWorkflowCancelFailed            = historyBuilderAction("add-workflow-execution-cancel-failed-event")
+	ActionWorkflowSignalRequested         = historyBuilderAction("add-workflow-execution-signal-requested-event")
+	ActionWorkflowSignalFailed            = historyBuilderAction("add-workflow-execution-signal-failed-event")
+	ActionUnknownEvent                    = historyBuilderAction("add-unknown-event")
+
+	// store operation values
+	StoreOperationGetTasks                = storeOperation("get-tasks")
+	StoreOperationCompleteTask            = storeOperation("complete-task")
+	StoreOperationCreateWorkflowExecution = storeOperation("create-wf-execution")
+	StoreOperationGetWorkflowExecution    = storeOperation("get-wf-execution")
+	StoreOperationUpdateWorkflowExecution = storeOperation("update-wf-execution")
+	StoreOperationDeleteWorkflowExecution = storeOperation("delete-wf-execution")
+	StoreOperationUpdateShard             = storeOperation("update-shard")
+	StoreOperationCreateTask              = storeOperation("create-task")
+	StoreOperationUpdateTaskList          = storeOperation("update-task-list")
+	StoreOperationStopTaskList            = storeOperation("stop-task-list")
+)
+
+// component returns a tag for the component of a service logging the entry
+func component(value string) Tag {
+	return newStringTag("wf-component", value)
+}
+
+// historyBuilderAction returns a tag for the history builder action which failed
+func historyBuilderAction(value string) Tag {
+	return newStringTag("history-builder-action", value)
+}
+
+// storeOperation returns a tag for the persistence operation which failed
+func storeOperation(value string) Tag {
+	return newStringTag("store-operation", value)
+}
@@ -24,11 +24,10 @@ import (
 	"testing"
 	"time"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/uber/cadence/common/logging"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 )
 
 type RpoSuite struct {
@@ -50,7 +49,7 @@ func (s *RpoSuite) TestRingpopMonitor() {
 
 	services := []string{"rpm-test"}
 
-	logger := bark.NewLoggerFromLogrus(log.New())
+	logger := logging.NewDevelopmentLogger()
 	rpm := NewRingpopMonitor(services, testService.rings[0], logger)
 	err := rpm.Start()
 	s.Nil(err, "Failed to start ringpop monitor")
@@ -25,9 +25,10 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 
 	"github.com/dgryski/go-farm"
-	"github.com/uber-common/bark"
 	"github.com/uber/ringpop-go"
 	"github.com/uber/ringpop-go/events"
 	"github.com/uber/ringpop-go/hashring"
@@ -49,7 +50,7 @@ type ringpopServiceResolver struct {
 	rp         *ringpop.Ringpop
 	shutdownCh chan struct{}
 	shutdownWG sync.WaitGroup
-	logger     bark.Logger
+	logger     logging.Logger
 
 	ringLock sync.RWMutex
 	ring     *hashring.HashRing
@@ -60,11 +61,11 @@ type ringpopServiceResolver struct {
 
 var _ ServiceResolver = (*ringpopServiceResolver)(nil)
 
-func newRingpopServiceResolver(service string, rp *ringpop.Ringpop, logger bark.Logger) *ringpopServiceResolver {
+func newRingpopServiceResolver(service string, rp *ringpop.Ringpop, logger logging.Logger) *ringpopServiceResolver {
 	return &ringpopServiceResolver{
 		service:    service,
 		rp:         rp,
-		logger:     logger.WithFields(bark.Fields{"component": "ServiceResolver", RoleKey: service}),
+		logger:     logger.WithTags(tag.ComponentServiceResolver, tag.Service(service)),
 		ring:       hashring.New(farm.Fingerprint32, replicaPoints),
 		listeners:  make(map[string]chan<- *ChangedEvent),
 		shutdownCh: make(chan struct{}),
@@ -178,7 +179,7 @@ func (r *ringpopServiceResolver) refresh() {
 	addrs, err := r.rp.GetReachableMembers(swim.MemberWithLabelAndValue(RoleKey, r.service))
 	if err != nil {
 		// This should never happen!
-		r.logger.Fatalf("Error during ringpop refresh.  Error: %v", err)
+		r.logger.Fatal("Error during ringpop refresh.", tag.Error(err))
 	}
 
 	for _, addr := range addrs {
@@ -186,7 +187,7 @@ func (r *ringpopServiceResolver) refresh() {
 		r.ring.AddMembers(host)
 	}
 
-	r.logger.Debugf("Current reachable members: %v", addrs)
+	r.logger.Debug("Current reachable members.", tag.Value(addrs))
 }
 
 func (r *ringpopServiceResolver) emitEvent(rpEvent events.RingChangedEvent) {
@@ -210,7 +211,8 @@ func (r *ringpopServiceResolver) emitEvent(rpEvent events.RingChangedEvent) {
 		select {
 		case ch <- event:
 		default:
-			r.logger.WithFields(bark.Fields{`listenerName`: name}).Error("Failed to send listener notification, channel full")
+			r.logger.Error("Failed to send listener notification, channel full", tag.Name(name))
+
 		}
 	}
 }
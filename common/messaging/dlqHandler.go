@@ -0,0 +1,116 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"errors"
+)
+
+type (
+	// DLQHandler inspects, replays or purges the replication tasks moved to the dlq of a cluster
+	DLQHandler struct {
+		client         Client
+		currentCluster string
+	}
+)
+
+var (
+	// ErrEmptyDLQMessage is the error when a dlq message holds neither a replication task nor a payload
+	ErrEmptyDLQMessage = errors.New("dlq message has neither a replication task nor a payload")
+	// ErrUnknownDLQSourceCluster is the error when replaying a raw dlq message without a source cluster
+	ErrUnknownDLQSourceCluster = errors.New("a source cluster is required to replay a raw dlq message")
+)
+
+// NewDLQHandler creates a new handler for the dlq of the current cluster
+func NewDLQHandler(client Client, currentCluster string) *DLQHandler {
+	return &DLQHandler{
+		client:         client,
+		currentCluster: currentCluster,
+	}
+}
+
+// Read returns up to maxCount replication tasks from the dlq, without acking them
+func (h *DLQHandler) Read(maxCount int) ([]*DLQMessage, error) {
+	reader, err := h.client.NewDLQReader(h.currentCluster)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return reader.Read(maxCount)
+}
+
+// Replay republishes up to maxCount replication tasks from the dlq to the retry topic with a fresh attempt
+// count, and acks them in the dlq.  The raw messages, which do not carry their source cluster, are replayed
+// for sourceCluster.  It returns the number of replayed tasks, and stops at the first message it cannot replay.
+func (h *DLQHandler) Replay(maxCount int, sourceCluster string) (int, error) {
+	reader, err := h.client.NewDLQReader(h.currentCluster)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	producer, err := h.client.NewRetryProducer(h.currentCluster)
+	if err != nil {
+		return 0, err
+	}
+	defer producer.Close()
+
+	msgs, err := reader.Read(maxCount)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, msg := range msgs {
+		if msg.Task == nil || (msg.Task.Task == nil && len(msg.Task.Payload) == 0) {
+			return i, ErrEmptyDLQMessage
+		}
+		task := *msg.Task
+		if task.SourceCluster == "" {
+			if sourceCluster == "" {
+				return i, ErrUnknownDLQSourceCluster
+			}
+			task.SourceCluster = sourceCluster
+		}
+		task.Attempt = 0
+		task.NextAttemptTimestamp = 0
+		task.Error = ""
+		if err := producer.Publish(&task); err != nil {
+			return i, err
+		}
+		if err := reader.Ack(msg); err != nil {
+			return i, err
+		}
+	}
+
+	return len(msgs), nil
+}
+
+// Purge acks all the replication tasks currently in the dlq
+func (h *DLQHandler) Purge() error {
+	reader, err := h.client.NewDLQReader(h.currentCluster)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	return reader.Purge()
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/replicator"
+)
+
+type (
+	dlqHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+		client  *fakeClient
+		handler *DLQHandler
+	}
+
+	// fakeClient keeps the dlq and the retry topic of a single partition in memory
+	fakeClient struct {
+		dlq        []*FailedReplicationTask
+		ackLevel   int64
+		retry      []*FailedReplicationTask
+		publishErr error
+	}
+
+	fakeFailedTaskProducer struct {
+		topic  *[]*FailedReplicationTask
+		client *fakeClient
+	}
+
+	fakeDLQReader struct {
+		client *fakeClient
+	}
+)
+
+func TestDLQHandlerSuite(t *testing.T) {
+	s := new(dlqHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *dlqHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.client = &fakeClient{}
+	s.handler = NewDLQHandler(s.client, "active")
+
+	for i := 0; i < 3; i++ {
+		s.client.dlq = append(s.client.dlq, &FailedReplicationTask{
+			SourceCluster:        "standby",
+			Task:                 &replicator.ReplicationTask{TaskType: replicator.ReplicationTaskTypeHistory.Ptr()},
+			Attempt:              5,
+			NextAttemptTimestamp: 100,
+			Error:                "workflow execution not found",
+		})
+	}
+}
+
+func (s *dlqHandlerSuite) TestRead() {
+	msgs, err := s.handler.Read(2)
+	s.NoError(err)
+	s.Equal(2, len(msgs))
+	s.Equal(int64(0), msgs[0].Offset)
+	s.Equal(int64(1), msgs[1].Offset)
+	s.Equal(5, msgs[0].Task.Attempt)
+
+	// reading does not ack the messages
+	msgs, err = s.handler.Read(10)
+	s.NoError(err)
+	s.Equal(3, len(msgs))
+}
+
+func (s *dlqHandlerSuite) TestReplay() {
+	count, err := s.handler.Replay(2, "")
+	s.NoError(err)
+	s.Equal(2, count)
+	s.Equal(int64(2), s.client.ackLevel)
+	s.Equal(2, len(s.client.retry))
+	for _, task := range s.client.retry {
+		s.Equal("standby", task.SourceCluster)
+		s.Equal(0, task.Attempt)
+		s.Equal(int64(0), task.NextAttemptTimestamp)
+		s.Empty(task.Error)
+	}
+	// the tasks in the dlq are left as is
+	s.Equal(5, s.client.dlq[0].Attempt)
+
+	msgs, err := s.handler.Read(10)
+	s.NoError(err)
+	s.Equal(1, len(msgs))
+	s.Equal(int64(2), msgs[0].Offset)
+}
+
+func (s *dlqHandlerSuite) TestReplay_PublishFailure() {
+	s.client.publishErr = errors.New("retry topic unavailable")
+
+	count, err := s.handler.Replay(10, "")
+	s.Error(err)
+	s.Equal(0, count)
+	s.Equal(int64(0), s.client.ackLevel)
+}
+
+func (s *dlqHandlerSuite) TestReplay_RawPayload() {
+	payload, err := json.Marshal(&replicator.ReplicationTask{TaskType: replicator.ReplicationTaskTypeHistory.Ptr()})
+	s.NoError(err)
+	s.client.ackLevel = 3
+	s.client.dlq = append(s.client.dlq, &FailedReplicationTask{Payload: payload})
+
+	// the source cluster of a raw message is unknown
+	count, err := s.handler.Replay(10, "")
+	s.Equal(ErrUnknownDLQSourceCluster, err)
+	s.Equal(0, count)
+	s.Equal(int64(3), s.client.ackLevel)
+	s.Empty(s.client.retry)
+
+	count, err = s.handler.Replay(10, "standby")
+	s.NoError(err)
+	s.Equal(1, count)
+	s.Equal(int64(4), s.client.ackLevel)
+	s.Equal(1, len(s.client.retry))
+	s.Equal("standby", s.client.retry[0].SourceCluster)
+	s.Nil(s.client.retry[0].Task)
+	s.Equal(payload, s.client.retry[0].Payload)
+}
+
+func (s *dlqHandlerSuite) TestReplay_EmptyMessage() {
+	s.client.ackLevel = 3
+	s.client.dlq = append(s.client.dlq, &FailedReplicationTask{})
+
+	count, err := s.handler.Replay(10, "standby")
+	s.Equal(ErrEmptyDLQMessage, err)
+	s.Equal(0, count)
+	s.Equal(int64(3), s.client.ackLevel)
+	s.Empty(s.client.retry)
+}
+
+func (s *dlqHandlerSuite) TestPurge() {
+	s.NoError(s.handler.Purge())
+
+	msgs, err := s.handler.Read(10)
+	s.NoError(err)
+	s.Empty(msgs)
+	s.Empty(s.client.retry)
+}
+
+func (c *fakeClient) NewConsumer(currentCluster, sourceCluster, consumerName string, concurrency int) (Consumer, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeClient) NewProducer(sourceCluster string) (Producer, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeClient) NewRetryProducer(currentCluster string) (FailedTaskProducer, error) {
+	return &fakeFailedTaskProducer{topic: &c.retry, client: c}, nil
+}
+
+func (c *fakeClient) NewDLQProducer(currentCluster string) (FailedTaskProducer, error) {
+	return &fakeFailedTaskProducer{topic: &c.dlq, client: c}, nil
+}
+
+func (c *fakeClient) NewDLQReader(currentCluster string) (DLQReader, error) {
+	return &fakeDLQReader{client: c}, nil
+}
+
+func (c *fakeClient) IsPullBased() bool {
+	return false
+}
+
+func (p *fakeFailedTaskProducer) Publish(task *FailedReplicationTask) error {
+	if p.client.publishErr != nil {
+		return p.client.publishErr
+	}
+	*p.topic = append(*p.topic, task)
+	return nil
+}
+
+func (p *fakeFailedTaskProducer) Close() error {
+	return nil
+}
+
+func (r *fakeDLQReader) Read(maxCount int) ([]*DLQMessage, error) {
+	var msgs []*DLQMessage
+	for offset := r.client.ackLevel; offset < int64(len(r.client.dlq)) && len(msgs) < maxCount; offset++ {
+		msgs = append(msgs, &DLQMessage{
+			Offset: offset,
+			Task:   r.client.dlq[offset],
+		})
+	}
+	return msgs, nil
+}
+
+func (r *fakeDLQReader) Ack(msg *DLQMessage) error {
+	if msg.Offset+1 > r.client.ackLevel {
+		r.client.ackLevel = msg.Offset + 1
+	}
+	return nil
+}
+
+func (r *fakeDLQReader) Purge() error {
+	r.client.ackLevel = int64(len(r.client.dlq))
+	return nil
+}
+
+func (r *fakeDLQReader) Close() error {
+	return nil
+}
@@ -21,16 +21,38 @@
 package messaging
 
 import (
-	"github.com/uber-go/kafka-client/kafka"
-
 	"github.com/uber/cadence/.gen/go/replicator"
 )
 
 type (
 	// Client is the interface used to abstract out interaction with messaging system for replication
 	Client interface {
-		NewConsumer(currentCluster, sourceCluster, consumerName string, concurrency int) (kafka.Consumer, error)
+		NewConsumer(currentCluster, sourceCluster, consumerName string, concurrency int) (Consumer, error)
 		NewProducer(sourceCluster string) (Producer, error)
+		NewRetryProducer(currentCluster string) (FailedTaskProducer, error)
+		NewDLQProducer(currentCluster string) (FailedTaskProducer, error)
+		NewDLQReader(currentCluster string) (DLQReader, error)
+		// IsPullBased tells whether the remote clusters pull the replication tasks from the history service of the
+		// source cluster, in which case the history service does not publish them
+		IsPullBased() bool
+	}
+
+	// Consumer is the interface used to receive the replication tasks of a source cluster, along with the
+	// replication tasks of the retry topic
+	Consumer interface {
+		Start() error
+		Stop()
+		Messages() <-chan Message
+		Closed() <-chan struct{}
+	}
+
+	// Message is a replication task received by the Consumer, it must be either acked or nacked once handled
+	Message interface {
+		Value() []byte
+		Partition() int32
+		Offset() int64
+		Ack() error
+		Nack() error
 	}
 
 	// Producer is the interface used to send replication tasks to other clusters through replicator
@@ -39,4 +61,44 @@ type (
 		PublishBatch(msgs []*replicator.ReplicationTask) error
 		Close() error
 	}
+
+	// FailedTaskProducer is the interface used to send replication tasks which failed to apply to the retry or
+	// the dlq topic of the current cluster
+	FailedTaskProducer interface {
+		Publish(task *FailedReplicationTask) error
+		Close() error
+	}
+
+	// DLQReader is the interface used to inspect and acknowledge the replication tasks moved to the dlq topic
+	DLQReader interface {
+		// Read returns up to maxCount replication tasks from the dlq which are not acked yet
+		Read(maxCount int) ([]*DLQMessage, error)
+		// Ack marks the message, and all the messages before it on the same partition, as handled
+		Ack(msg *DLQMessage) error
+		// Purge marks all the messages currently in the dlq as handled
+		Purge() error
+		Close() error
+	}
+
+	// FailedReplicationTask wraps a replication task which failed to apply, with the number of failed attempts
+	// and the earliest time for the next one.  SourceCluster is always set on a wrapped task.  Payload holds
+	// the raw message when it could not be deserialized, or when it is not wrapped.
+	// Partition and Offset locate the message the task was first received in from the source cluster.
+	FailedReplicationTask struct {
+		SourceCluster        string                      `json:"sourceCluster"`
+		Task                 *replicator.ReplicationTask `json:"task,omitempty"`
+		Payload              []byte                      `json:"payload,omitempty"`
+		Partition            int32                       `json:"partition,omitempty"`
+		Offset               int64                       `json:"offset,omitempty"`
+		Attempt              int                         `json:"attempt"`
+		NextAttemptTimestamp int64                       `json:"nextAttemptTimestamp,omitempty"`
+		Error                string                      `json:"error,omitempty"`
+	}
+
+	// DLQMessage is a failed replication task read from the dlq topic
+	DLQMessage struct {
+		Partition int32
+		Offset    int64
+		Task      *FailedReplicationTask
+	}
 )
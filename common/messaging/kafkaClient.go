@@ -21,22 +21,24 @@
 package messaging
 
 import (
+	"fmt"
+
 	"github.com/Shopify/sarama"
-	"github.com/uber-common/bark"
 	"github.com/uber-go/kafka-client"
 	"github.com/uber-go/kafka-client/kafka"
+	"github.com/uber/cadence/common/logging"
 )
 
 type (
 	kafkaClient struct {
 		config *KafkaConfig
 		client kafkaclient.Client
-		logger bark.Logger
+		logger logging.Logger
 	}
 )
 
 // NewConsumer is used to create a Kafka consumer
-func (c *kafkaClient) NewConsumer(currentCluster, sourceCluster, consumerName string, concurrency int) (kafka.Consumer, error) {
+func (c *kafkaClient) NewConsumer(currentCluster, sourceCluster, consumerName string, concurrency int) (Consumer, error) {
 	currentTopics := c.config.getTopicsForCadenceCluster(currentCluster)
 	sourceTopics := c.config.getTopicsForCadenceCluster(sourceCluster)
 
@@ -68,7 +70,11 @@ func (c *kafkaClient) NewConsumer(currentCluster, sourceCluster, consumerName st
 	consumerConfig.Offsets.Initial.Offset = kafka.OffsetNewest
 
 	consumer, err := c.client.NewConsumer(consumerConfig)
-	return consumer, err
+	if err != nil {
+		return nil, err
+	}
+
+	return newKafkaConsumer(consumer, concurrency), nil
 }
 
 // NewProducer is used to create a Kafka producer for shipping replication tasks
@@ -84,3 +90,42 @@ func (c *kafkaClient) NewProducer(sourceCluster string) (Producer, error) {
 
 	return NewKafkaProducer(topics.Topic, producer, c.logger), nil
 }
+
+func (c *kafkaClient) NewRetryProducer(currentCluster string) (FailedTaskProducer, error) {
+	topics := c.config.getTopicsForCadenceCluster(currentCluster)
+	return c.newFailedTaskProducer(topics.RetryTopic)
+}
+
+func (c *kafkaClient) NewDLQProducer(currentCluster string) (FailedTaskProducer, error) {
+	topics := c.config.getTopicsForCadenceCluster(currentCluster)
+	return c.newFailedTaskProducer(topics.DLQTopic)
+}
+
+func (c *kafkaClient) NewDLQReader(currentCluster string) (DLQReader, error) {
+	topics := c.config.getTopicsForCadenceCluster(currentCluster)
+	kafkaClusterName := c.config.getKafkaClusterForTopic(topics.DLQTopic)
+	brokers := c.config.getBrokersForKafkaCluster(kafkaClusterName)
+
+	return newKafkaDLQReader(topics.DLQTopic, getDLQReaderGroupName(currentCluster), brokers, c.logger)
+}
+
+// IsPullBased is false for kafka, the history service publishes the replication tasks to the topic of its cluster
+func (c *kafkaClient) IsPullBased() bool {
+	return false
+}
+
+func (c *kafkaClient) newFailedTaskProducer(topic string) (FailedTaskProducer, error) {
+	kafkaClusterName := c.config.getKafkaClusterForTopic(topic)
+	brokers := c.config.getBrokersForKafkaCluster(kafkaClusterName)
+
+	producer, err := sarama.NewSyncProducer(brokers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newKafkaFailedTaskProducer(topic, producer, c.logger), nil
+}
+
+func getDLQReaderGroupName(currentCluster string) string {
+	return fmt.Sprintf("%v_dlq_reader", currentCluster)
+}
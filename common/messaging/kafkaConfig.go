@@ -24,10 +24,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/uber-common/bark"
 	"github.com/uber-go/kafka-client"
 	"github.com/uber-go/kafka-client/kafka"
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common/logging"
 	"go.uber.org/zap"
 )
 
@@ -58,7 +58,7 @@ type (
 )
 
 // NewKafkaClient is used to create an instance of KafkaClient
-func (k *KafkaConfig) NewKafkaClient(zLogger *zap.Logger, logger bark.Logger, metricScope tally.Scope) Client {
+func (k *KafkaConfig) NewKafkaClient(zLogger *zap.Logger, logger logging.Logger, metricScope tally.Scope) Client {
 	k.validate()
 
 	// mapping from cluster name to list of broker ip addresses
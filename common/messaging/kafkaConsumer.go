@@ -0,0 +1,95 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"sync"
+
+	"github.com/uber-go/kafka-client/kafka"
+)
+
+type (
+	// kafkaConsumer adapts the consumer of the kafka client to the Consumer interface
+	kafkaConsumer struct {
+		consumer kafka.Consumer
+		msgC     chan Message
+		stopC    chan struct{}
+		stopOnce sync.Once
+	}
+)
+
+func newKafkaConsumer(consumer kafka.Consumer, concurrency int) Consumer {
+	return &kafkaConsumer{
+		consumer: consumer,
+		msgC:     make(chan Message, concurrency),
+		stopC:    make(chan struct{}),
+	}
+}
+
+// Start starts the underlying kafka consumer
+func (c *kafkaConsumer) Start() error {
+	if err := c.consumer.Start(); err != nil {
+		return err
+	}
+
+	go c.pump()
+	return nil
+}
+
+// Stop stops the underlying kafka consumer, the messages which are not delivered yet are redelivered to the next
+// consumer of the group
+func (c *kafkaConsumer) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopC)
+		c.consumer.Stop()
+	})
+}
+
+// Messages returns the channel the replication tasks are delivered on
+func (c *kafkaConsumer) Messages() <-chan Message {
+	return c.msgC
+}
+
+// Closed returns a channel which is closed once the underlying kafka consumer is closed
+func (c *kafkaConsumer) Closed() <-chan struct{} {
+	return c.consumer.Closed()
+}
+
+func (c *kafkaConsumer) pump() {
+	defer close(c.msgC)
+
+	for {
+		select {
+		case msg, ok := <-c.consumer.Messages():
+			if !ok {
+				return
+			}
+
+			select {
+			case c.msgC <- msg:
+			case <-c.stopC:
+				return
+			}
+		case <-c.stopC:
+			return
+		}
+	}
+}
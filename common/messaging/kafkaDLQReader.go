@@ -0,0 +1,231 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+)
+
+type (
+	// kafkaDLQReader reads the dlq topic with a dedicated consumer group, the committed offset of the group
+	// on each partition is the first message which is not handled yet
+	kafkaDLQReader struct {
+		topic         string
+		client        sarama.Client
+		consumer      sarama.Consumer
+		offsetManager sarama.OffsetManager
+		partitions    map[int32]sarama.PartitionOffsetManager
+		logger        logging.Logger
+	}
+)
+
+const dlqReadTimeout = 10 * time.Second
+
+// errDLQReadTimeout is the error when the dlq messages could not be fetched in time
+var errDLQReadTimeout = errors.New("timed out reading messages from dlq")
+
+func newKafkaDLQReader(topic, group string, brokers []string, logger logging.Logger) (DLQReader, error) {
+	config := sarama.NewConfig()
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	offsetManager, err := sarama.NewOffsetManagerFromClient(group, client)
+	if err != nil {
+		consumer.Close()
+		client.Close()
+		return nil, err
+	}
+
+	return &kafkaDLQReader{
+		topic:         topic,
+		client:        client,
+		consumer:      consumer,
+		offsetManager: offsetManager,
+		partitions:    make(map[int32]sarama.PartitionOffsetManager),
+		logger:        logger.WithTags(tag.KafkaTopicName(topic)),
+	}, nil
+}
+
+func (r *kafkaDLQReader) Read(maxCount int) ([]*DLQMessage, error) {
+	partitions, err := r.client.Partitions(r.topic)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []*DLQMessage
+	for _, partition := range partitions {
+		if len(msgs) >= maxCount {
+			break
+		}
+
+		next, newest, err := r.getPendingRange(partition)
+		if err != nil {
+			return nil, err
+		}
+		if next >= newest {
+			continue
+		}
+
+		partitionConsumer, err := r.consumer.ConsumePartition(r.topic, partition, next)
+		if err != nil {
+			return nil, err
+		}
+
+	ReadLoop:
+		for next < newest && len(msgs) < maxCount {
+			select {
+			case m := <-partitionConsumer.Messages():
+				msgs = append(msgs, r.newDLQMessage(m))
+				next = m.Offset + 1
+			case <-time.After(dlqReadTimeout):
+				err = errDLQReadTimeout
+				break ReadLoop
+			}
+		}
+
+		partitionConsumer.Close()
+		if err != nil {
+			return msgs, err
+		}
+	}
+
+	return msgs, nil
+}
+
+func (r *kafkaDLQReader) Ack(msg *DLQMessage) error {
+	partitionOffsetManager, err := r.getPartitionOffsetManager(msg.Partition)
+	if err != nil {
+		return err
+	}
+
+	partitionOffsetManager.MarkOffset(msg.Offset+1, "")
+	return nil
+}
+
+func (r *kafkaDLQReader) Purge() error {
+	partitions, err := r.client.Partitions(r.topic)
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range partitions {
+		newest, err := r.client.GetOffset(r.topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return err
+		}
+
+		partitionOffsetManager, err := r.getPartitionOffsetManager(partition)
+		if err != nil {
+			return err
+		}
+		partitionOffsetManager.MarkOffset(newest, "")
+	}
+
+	return nil
+}
+
+// Close commits the acked offsets and releases the connections to kafka
+func (r *kafkaDLQReader) Close() error {
+	for _, partitionOffsetManager := range r.partitions {
+		partitionOffsetManager.Close()
+	}
+	if err := r.offsetManager.Close(); err != nil {
+		r.logger.Warn("Failed to commit dlq offsets", tag.Error(err))
+	}
+	r.consumer.Close()
+	return r.client.Close()
+}
+
+// getPendingRange returns the offset of the first message not handled yet and the offset of the next message
+// to be produced on the partition
+func (r *kafkaDLQReader) getPendingRange(partition int32) (int64, int64, error) {
+	partitionOffsetManager, err := r.getPartitionOffsetManager(partition)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	next, _ := partitionOffsetManager.NextOffset()
+	oldest, err := r.client.GetOffset(r.topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, 0, err
+	}
+	if next < oldest {
+		// nothing committed yet, or the committed messages are already removed by retention
+		next = oldest
+	}
+
+	newest, err := r.client.GetOffset(r.topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return next, newest, nil
+}
+
+func (r *kafkaDLQReader) getPartitionOffsetManager(partition int32) (sarama.PartitionOffsetManager, error) {
+	if partitionOffsetManager, ok := r.partitions[partition]; ok {
+		return partitionOffsetManager, nil
+	}
+
+	partitionOffsetManager, err := r.offsetManager.ManagePartition(r.topic, partition)
+	if err != nil {
+		return nil, err
+	}
+	r.partitions[partition] = partitionOffsetManager
+	return partitionOffsetManager, nil
+}
+
+func (r *kafkaDLQReader) newDLQMessage(m *sarama.ConsumerMessage) *DLQMessage {
+	var task FailedReplicationTask
+	err := json.Unmarshal(m.Value, &task)
+	if err != nil || task.SourceCluster == "" {
+		// unknown fields are ignored, so a raw replication task nacked to the dlq by the consumer also decodes,
+		// only the processor of the replicator wraps the tasks with their source cluster
+		task = FailedReplicationTask{Payload: m.Value}
+	}
+	if err != nil {
+		r.logger.Warn("Failed to deserialize dlq message", tag.KafkaPartition(m.Partition),
+			tag.KafkaOffset(m.Offset), tag.Error(err))
+		task.Error = err.Error()
+	}
+
+	return &DLQMessage{
+		Partition: m.Partition,
+		Offset:    m.Offset,
+		Task:      &task,
+	}
+}
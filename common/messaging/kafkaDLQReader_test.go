@@ -0,0 +1,83 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common/logging"
+)
+
+type (
+	kafkaDLQReaderSuite struct {
+		suite.Suite
+		*require.Assertions
+		reader *kafkaDLQReader
+	}
+)
+
+func TestKafkaDLQReaderSuite(t *testing.T) {
+	s := new(kafkaDLQReaderSuite)
+	suite.Run(t, s)
+}
+
+func (s *kafkaDLQReaderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.reader = &kafkaDLQReader{logger: logging.NewNopLogger()}
+}
+
+func (s *kafkaDLQReaderSuite) TestNewDLQMessage_Wrapped() {
+	task := &FailedReplicationTask{
+		SourceCluster: "active",
+		Task:          &replicator.ReplicationTask{TaskType: replicator.ReplicationTaskTypeHistory.Ptr()},
+		Attempt:       3,
+	}
+	msg := s.reader.newDLQMessage(&sarama.ConsumerMessage{Partition: 1, Offset: 10, Value: s.serialize(task)})
+	s.Equal(int32(1), msg.Partition)
+	s.Equal(int64(10), msg.Offset)
+	s.Equal(task, msg.Task)
+}
+
+func (s *kafkaDLQReaderSuite) TestNewDLQMessage_RawTask() {
+	payload := s.serialize(&replicator.ReplicationTask{TaskType: replicator.ReplicationTaskTypeHistory.Ptr()})
+	msg := s.reader.newDLQMessage(&sarama.ConsumerMessage{Value: payload})
+	s.Equal(&FailedReplicationTask{Payload: payload}, msg.Task)
+}
+
+func (s *kafkaDLQReaderSuite) TestNewDLQMessage_NotJSON() {
+	payload := []byte("not a replication task")
+	msg := s.reader.newDLQMessage(&sarama.ConsumerMessage{Value: payload})
+	s.Empty(msg.Task.SourceCluster)
+	s.Nil(msg.Task.Task)
+	s.Equal(payload, msg.Task.Payload)
+	s.NotEmpty(msg.Task.Error)
+}
+
+func (s *kafkaDLQReaderSuite) serialize(value interface{}) []byte {
+	payload, err := json.Marshal(value)
+	s.NoError(err)
+	return payload
+}
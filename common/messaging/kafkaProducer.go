@@ -24,8 +24,8 @@ import (
 	"encoding/json"
 
 	"github.com/Shopify/sarama"
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 
 	"github.com/uber/cadence/.gen/go/replicator"
 )
@@ -34,18 +34,20 @@ type (
 	kafkaProducer struct {
 		topic    string
 		producer sarama.SyncProducer
-		logger   bark.Logger
+		logger   logging.Logger
+	}
+
+	kafkaFailedTaskProducer struct {
+		producer *kafkaProducer
 	}
 )
 
 // NewKafkaProducer is used to create the Kafka based producer implementation
-func NewKafkaProducer(topic string, producer sarama.SyncProducer, logger bark.Logger) Producer {
+func NewKafkaProducer(topic string, producer sarama.SyncProducer, logger logging.Logger) Producer {
 	return &kafkaProducer{
 		topic:    topic,
 		producer: producer,
-		logger: logger.WithFields(bark.Fields{
-			logging.TagTopicName: topic,
-		}),
+		logger:   logger.WithTags(tag.KafkaTopicName(topic)),
 	}
 }
 
@@ -56,6 +58,10 @@ func (p *kafkaProducer) Publish(task *replicator.ReplicationTask) error {
 		return err
 	}
 
+	return p.publishPayload(payload)
+}
+
+func (p *kafkaProducer) publishPayload(payload []byte) error {
 	msg := &sarama.ProducerMessage{
 		Topic: p.topic,
 		Value: sarama.ByteEncoder(payload),
@@ -63,11 +69,10 @@ func (p *kafkaProducer) Publish(task *replicator.ReplicationTask) error {
 
 	partition, offset, err := p.producer.SendMessage(msg)
 	if err != nil {
-		p.logger.WithFields(bark.Fields{
-			logging.TagPartition: partition,
-			logging.TagOffset:    offset,
-			logging.TagErr:       err,
-		}).Warn("Failed to publish message to kafka")
+		p.logger.Warn("Failed to publish message to kafka",
+			tag.KafkaPartition(partition),
+			tag.KafkaOffset(offset),
+			tag.Error(err))
 
 		return err
 	}
@@ -92,9 +97,7 @@ func (p *kafkaProducer) PublishBatch(tasks []*replicator.ReplicationTask) error
 
 	err := p.producer.SendMessages(msgs)
 	if err != nil {
-		p.logger.WithFields(bark.Fields{
-			logging.TagErr: err,
-		}).Warn("Failed to publish batch of messages to kafka")
+		p.logger.Warn("Failed to publish batch of messages to kafka", tag.Error(err))
 
 		return err
 	}
@@ -110,12 +113,35 @@ func (p *kafkaProducer) Close() error {
 func (p *kafkaProducer) serializeTask(task *replicator.ReplicationTask) ([]byte, error) {
 	payload, err := json.Marshal(task)
 	if err != nil {
-		p.logger.WithFields(bark.Fields{
-			logging.TagErr: err,
-		}).Error("Failed to serialize replication task")
+		p.logger.Error("Failed to serialize replication task", tag.Error(err))
 
 		return nil, err
 	}
 
 	return payload, nil
 }
+
+func newKafkaFailedTaskProducer(topic string, producer sarama.SyncProducer, logger logging.Logger) FailedTaskProducer {
+	return &kafkaFailedTaskProducer{
+		producer: &kafkaProducer{
+			topic:    topic,
+			producer: producer,
+			logger:   logger.WithTags(tag.KafkaTopicName(topic)),
+		},
+	}
+}
+
+func (p *kafkaFailedTaskProducer) Publish(task *FailedReplicationTask) error {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		p.producer.logger.Error("Failed to serialize failed replication task", tag.Error(err))
+
+		return err
+	}
+
+	return p.producer.publishPayload(payload)
+}
+
+func (p *kafkaFailedTaskProducer) Close() error {
+	return p.producer.Close()
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+)
+
+type (
+	// rpcClient ships the replication tasks without kafka: the worker pulls the history replication tasks from the
+	// history service of the source cluster, while the frontend pushes the domain replication tasks to the remote
+	// clusters.  The retry topic is kept in memory and there is no dlq, instead the source cluster is not told the
+	// failed tasks are processed, so a restarted worker pulls them again.
+	rpcClient struct {
+		config         *RPCConfig
+		rpcFactory     common.RPCFactory
+		numberOfShards int
+		pollInterval   time.Duration
+		retryQueue     *rpcRetryQueue
+		logger         logging.Logger
+
+		sync.Mutex
+		adminClients map[string]adminserviceclient.Interface
+	}
+
+	// rpcRetryQueue keeps the replication tasks which failed to apply in memory, until the consumer of their
+	// source cluster redelivers them.  It counts the failed tasks by the shard and task ID they were pulled with
+	// until they apply, the consumers report the shards processed up to the first failed task only.
+	rpcRetryQueue struct {
+		sync.Mutex
+		tasks       map[string][]*rpcRetryTask
+		failedTasks map[string]map[int32]map[int64]int
+	}
+
+	rpcRetryTask struct {
+		payload   []byte
+		partition int32
+		offset    int64
+	}
+
+	rpcRetryProducer struct {
+		queue *rpcRetryQueue
+	}
+
+	rpcDLQProducer struct {
+		queue  *rpcRetryQueue
+		logger logging.Logger
+	}
+)
+
+const rpcCallTimeout = 30 * time.Second
+
+// errDLQNotSupported is the error returned when reading the dlq of the rpc transport
+var errDLQNotSupported = errors.New("dlq is not supported by the rpc replication transport")
+
+// NewConsumer is used to create a consumer pulling the replication tasks of all the shards of the source cluster
+func (c *rpcClient) NewConsumer(currentCluster, sourceCluster, consumerName string, concurrency int) (Consumer, error) {
+	adminClient, err := c.getAdminClient(sourceCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRPCConsumer(currentCluster, sourceCluster, adminClient, c.retryQueue, c.numberOfShards, c.pollInterval,
+		concurrency, c.logger), nil
+}
+
+// NewProducer is used to create a producer pushing the domain replication tasks to the remote clusters
+func (c *rpcClient) NewProducer(sourceCluster string) (Producer, error) {
+	var remoteClusters []string
+	for cluster := range c.config.Clusters {
+		if cluster != sourceCluster {
+			remoteClusters = append(remoteClusters, cluster)
+		}
+	}
+
+	return newRPCProducer(remoteClusters, c.getAdminClient, c.logger), nil
+}
+
+func (c *rpcClient) NewRetryProducer(currentCluster string) (FailedTaskProducer, error) {
+	return &rpcRetryProducer{queue: c.retryQueue}, nil
+}
+
+func (c *rpcClient) NewDLQProducer(currentCluster string) (FailedTaskProducer, error) {
+	return &rpcDLQProducer{queue: c.retryQueue, logger: c.logger}, nil
+}
+
+func (c *rpcClient) NewDLQReader(currentCluster string) (DLQReader, error) {
+	return nil, errDLQNotSupported
+}
+
+// IsPullBased is true for rpc, the history service keeps the replication tasks until the remote clusters pull them
+func (c *rpcClient) IsPullBased() bool {
+	return true
+}
+
+// getAdminClient returns the admin client of the frontend of a cluster, the clients are created lazily since the
+// outbound dispatchers can only be created once the service dispatcher is
+func (c *rpcClient) getAdminClient(cluster string) (adminserviceclient.Interface, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if client, ok := c.adminClients[cluster]; ok {
+		return client, nil
+	}
+
+	address, ok := c.config.getAddressForCadenceCluster(cluster)
+	if !ok {
+		return nil, fmt.Errorf("missing frontend address for cluster %v", cluster)
+	}
+
+	dispatcher := c.rpcFactory.CreateDispatcherForOutbound(
+		"cadence-replication-client", common.FrontendServiceName, address)
+	client := adminserviceclient.New(dispatcher.ClientConfig(common.FrontendServiceName))
+	c.adminClients[cluster] = client
+	return client, nil
+}
+
+func newRPCRetryQueue() *rpcRetryQueue {
+	return &rpcRetryQueue{
+		tasks:       make(map[string][]*rpcRetryTask),
+		failedTasks: make(map[string]map[int32]map[int64]int),
+	}
+}
+
+// push queues a failed task for redelivery and counts it as failed
+func (q *rpcRetryQueue) push(task *FailedReplicationTask, payload []byte) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.addFailedTaskLocked(task.SourceCluster, task.Partition, task.Offset)
+	q.tasks[task.SourceCluster] = append(q.tasks[task.SourceCluster], &rpcRetryTask{
+		payload:   payload,
+		partition: task.Partition,
+		offset:    task.Offset,
+	})
+}
+
+// requeue queues again a task which was not redelivered, or nacked, it is still counted as failed
+func (q *rpcRetryQueue) requeue(sourceCluster string, task *rpcRetryTask) {
+	q.Lock()
+	defer q.Unlock()
+	q.tasks[sourceCluster] = append(q.tasks[sourceCluster], task)
+}
+
+func (q *rpcRetryQueue) pop(sourceCluster string) (*rpcRetryTask, bool) {
+	q.Lock()
+	defer q.Unlock()
+
+	tasks := q.tasks[sourceCluster]
+	if len(tasks) == 0 {
+		return nil, false
+	}
+	q.tasks[sourceCluster] = tasks[1:]
+	return tasks[0], true
+}
+
+// hold counts a failed task which is not retried anymore, the shard is not reported processed past it
+func (q *rpcRetryQueue) hold(task *FailedReplicationTask) {
+	q.Lock()
+	defer q.Unlock()
+	q.addFailedTaskLocked(task.SourceCluster, task.Partition, task.Offset)
+}
+
+// release stops counting a redelivered task as failed, it either applied or failed again and was pushed or held
+func (q *rpcRetryQueue) release(sourceCluster string, partition int32, offset int64) {
+	q.Lock()
+	defer q.Unlock()
+
+	offsets := q.failedTasks[sourceCluster][partition]
+	if offsets[offset] <= 1 {
+		delete(offsets, offset)
+		return
+	}
+	offsets[offset]--
+}
+
+// getProcessedLevel returns the last task of a shard which is processed, that is the read level of the shard
+// unless a task before it failed
+func (q *rpcRetryQueue) getProcessedLevel(sourceCluster string, partition int32, readLevel int64) int64 {
+	q.Lock()
+	defer q.Unlock()
+
+	processedLevel := readLevel
+	for offset := range q.failedTasks[sourceCluster][partition] {
+		if offset-1 < processedLevel {
+			processedLevel = offset - 1
+		}
+	}
+	return processedLevel
+}
+
+func (q *rpcRetryQueue) addFailedTaskLocked(sourceCluster string, partition int32, offset int64) {
+	partitions, ok := q.failedTasks[sourceCluster]
+	if !ok {
+		partitions = make(map[int32]map[int64]int)
+		q.failedTasks[sourceCluster] = partitions
+	}
+	offsets, ok := partitions[partition]
+	if !ok {
+		offsets = make(map[int64]int)
+		partitions[partition] = offsets
+	}
+	offsets[offset]++
+}
+
+// Publish keeps the failed task in memory, it is redelivered by the consumer of its source cluster
+func (p *rpcRetryProducer) Publish(task *FailedReplicationTask) error {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+
+	p.queue.push(task, payload)
+	return nil
+}
+
+func (p *rpcRetryProducer) Close() error {
+	return nil
+}
+
+// Publish gives up on the failed task, there is no dlq without kafka so the task is logged and its shard is not
+// reported processed past it, the task is pulled again once the worker restarts
+func (p *rpcDLQProducer) Publish(task *FailedReplicationTask) error {
+	p.logger.Error("Giving up on replication task which failed to apply, there is no dlq for the rpc transport.",
+		tag.SourceCluster(task.SourceCluster),
+		tag.ShardID(int(task.Partition)),
+		tag.TaskID(task.Offset),
+		tag.Attempt(int64(task.Attempt)),
+		tag.Value(task.Task),
+		tag.Error(errors.New(task.Error)))
+	p.queue.hold(task)
+	return nil
+}
+
+func (p *rpcDLQProducer) Close() error {
+	return nil
+}
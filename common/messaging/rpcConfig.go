@@ -0,0 +1,133 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+)
+
+const (
+	// TransportKafka ships the replication tasks between clusters through kafka topics
+	TransportKafka = "kafka"
+	// TransportRPC lets the worker of each cluster pull the replication tasks from the history service of the
+	// remote clusters, through the admin API of their frontend
+	TransportRPC = "rpc"
+
+	defaultRPCPollInterval = 5 * time.Second
+)
+
+type (
+	// ReplicationConfig describes how the replication tasks are shipped between clusters
+	ReplicationConfig struct {
+		// Transport is either kafka or rpc, it defaults to kafka
+		Transport string `yaml:"transport"`
+		// RPC is the config of the rpc transport, the frontend addresses of its clusters are also used by the history
+		// service to fetch the history events missed by the current cluster, whatever the transport
+		RPC RPCConfig `yaml:"rpc"`
+	}
+
+	// RPCConfig describes the configuration needed to pull the replication tasks from the remote clusters
+	RPCConfig struct {
+		// Clusters maps the name of each cadence cluster to the config used to reach it
+		Clusters map[string]RPCClusterConfig `yaml:"clusters"`
+		// PollInterval is the interval between two polls of a shard which had no new replication task
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	// RPCClusterConfig describes how to reach a single cadence cluster
+	RPCClusterConfig struct {
+		// Address is the host:port of the frontend of the cluster
+		Address string `yaml:"address"`
+	}
+)
+
+// GetTransport returns the configured transport, defaulting to kafka
+func (c *ReplicationConfig) GetTransport() string {
+	if len(c.Transport) == 0 {
+		return TransportKafka
+	}
+	return c.Transport
+}
+
+// Validate checks the transport is known and configured
+func (c *ReplicationConfig) Validate() error {
+	switch c.GetTransport() {
+	case TransportKafka:
+		return c.RPC.validateAddresses()
+	case TransportRPC:
+		return c.RPC.validate()
+	default:
+		return fmt.Errorf("unknown replication transport: %v", c.Transport)
+	}
+}
+
+// NewRPCClient is used to create an instance of the Client which pulls the replication tasks over rpc
+func (c *RPCConfig) NewRPCClient(rpcFactory common.RPCFactory, numberOfHistoryShards int, logger logging.Logger) Client {
+	pollInterval := c.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultRPCPollInterval
+	}
+
+	return &rpcClient{
+		config:         c,
+		rpcFactory:     rpcFactory,
+		numberOfShards: numberOfHistoryShards,
+		pollInterval:   pollInterval,
+		retryQueue:     newRPCRetryQueue(),
+		adminClients:   make(map[string]adminserviceclient.Interface),
+		logger:         logger,
+	}
+}
+
+// GetFrontendAddresses returns the address of the frontend of each configured cluster
+func (c *RPCConfig) GetFrontendAddresses() map[string]string {
+	addresses := make(map[string]string, len(c.Clusters))
+	for cluster, cfg := range c.Clusters {
+		addresses[cluster] = cfg.Address
+	}
+	return addresses
+}
+
+func (c *RPCConfig) validate() error {
+	if len(c.Clusters) == 0 {
+		return fmt.Errorf("clusters are required for replication transport %v", TransportRPC)
+	}
+	return c.validateAddresses()
+}
+
+func (c *RPCConfig) validateAddresses() error {
+	for cluster, cfg := range c.Clusters {
+		if len(cfg.Address) == 0 {
+			return fmt.Errorf("frontend address is required for cluster %v", cluster)
+		}
+	}
+	return nil
+}
+
+func (c *RPCConfig) getAddressForCadenceCluster(cadenceCluster string) (string, bool) {
+	cfg, ok := c.Clusters[cadenceCluster]
+	return cfg.Address, ok
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+)
+
+type (
+	// rpcConsumer pulls the replication tasks of every shard of the source cluster, the partition of a message
+	// is its shard and the offset its task ID.  The failed tasks of the in memory retry queue are delivered along.
+	rpcConsumer struct {
+		currentCluster string
+		sourceCluster  string
+		adminClient    adminserviceclient.Interface
+		retryQueue     *rpcRetryQueue
+		shards         []*rpcConsumerShard
+		pollInterval   time.Duration
+		logger         logging.Logger
+
+		msgC       chan Message
+		closedC    chan struct{}
+		stopC      chan struct{}
+		stopOnce   sync.Once
+		shutdownWG sync.WaitGroup
+	}
+
+	// rpcConsumerShard tracks the replication tasks pulled from a shard, the next batch is only pulled once all
+	// the messages of the current one are acked, so the ack level is also the read level.  The source cluster is
+	// told the shard is processed up to the first task of the retry queue pulled from the shard only.
+	rpcConsumerShard struct {
+		sync.Mutex
+		shardID         int32
+		ackLevel        int64
+		batchReadLevel  int64
+		pendingMessages int
+		nacked          bool
+	}
+
+	rpcMessage struct {
+		value     []byte
+		partition int32
+		offset    int64
+		done      func(nack bool)
+	}
+)
+
+func newRPCConsumer(currentCluster, sourceCluster string, adminClient adminserviceclient.Interface,
+	retryQueue *rpcRetryQueue, numberOfShards int, pollInterval time.Duration, concurrency int,
+	logger logging.Logger) Consumer {
+
+	shards := make([]*rpcConsumerShard, numberOfShards)
+	for i := range shards {
+		// the source cluster starts from its own ack level for a read level below zero
+		shards[i] = &rpcConsumerShard{shardID: int32(i), ackLevel: -1}
+	}
+
+	return &rpcConsumer{
+		currentCluster: currentCluster,
+		sourceCluster:  sourceCluster,
+		adminClient:    adminClient,
+		retryQueue:     retryQueue,
+		shards:         shards,
+		pollInterval:   pollInterval,
+		logger:         logger.WithTags(tag.SourceCluster(sourceCluster)),
+		msgC:           make(chan Message, concurrency),
+		closedC:        make(chan struct{}),
+		stopC:          make(chan struct{}),
+	}
+}
+
+// Start starts pulling the replication tasks
+func (c *rpcConsumer) Start() error {
+	c.shutdownWG.Add(1)
+	go c.pump()
+	return nil
+}
+
+// Stop stops pulling the replication tasks, the tasks which are not acked yet are pulled again by the next consumer
+func (c *rpcConsumer) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopC)
+		if success := common.AwaitWaitGroup(&c.shutdownWG, time.Minute); !success {
+			c.logger.Warn("Timed out waiting for the rpc consumer to stop.")
+		}
+		close(c.closedC)
+	})
+}
+
+// Messages returns the channel the replication tasks are delivered on
+func (c *rpcConsumer) Messages() <-chan Message {
+	return c.msgC
+}
+
+// Closed returns a channel which is closed once the consumer is stopped
+func (c *rpcConsumer) Closed() <-chan struct{} {
+	return c.closedC
+}
+
+func (c *rpcConsumer) pump() {
+	defer c.shutdownWG.Done()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+		case <-c.stopC:
+			return
+		}
+
+		if !c.deliverRetries() {
+			return
+		}
+
+		hasMore := false
+		for _, shard := range c.shards {
+			more, ok := c.pollShard(shard)
+			if !ok {
+				return
+			}
+			hasMore = hasMore || more
+		}
+
+		if hasMore {
+			timer.Reset(0)
+		} else {
+			timer.Reset(c.pollInterval)
+		}
+	}
+}
+
+// deliverRetries delivers the failed tasks of the source cluster, it returns false if the consumer is stopped
+func (c *rpcConsumer) deliverRetries() bool {
+	for {
+		retryTask, ok := c.retryQueue.pop(c.sourceCluster)
+		if !ok {
+			return true
+		}
+
+		msg := &rpcMessage{
+			value:     retryTask.payload,
+			partition: retryTask.partition,
+			offset:    retryTask.offset,
+			done: func(nack bool) {
+				if nack {
+					c.retryQueue.requeue(c.sourceCluster, retryTask)
+					return
+				}
+				c.retryQueue.release(c.sourceCluster, retryTask.partition, retryTask.offset)
+			},
+		}
+		if !c.deliver(msg) {
+			// put the task back for the next consumer
+			c.retryQueue.requeue(c.sourceCluster, retryTask)
+			return false
+		}
+	}
+}
+
+// pollShard pulls and delivers the next batch of replication tasks of a shard, it returns whether the shard has
+// more tasks to pull and false as second value if the consumer is stopped
+func (c *rpcConsumer) pollShard(shard *rpcConsumerShard) (bool, bool) {
+	readLevel, ok := shard.getReadLevel()
+	if !ok {
+		// the previous batch is still being processed
+		return false, true
+	}
+
+	// the failed tasks are pulled again from the processed level if the worker restarts before they apply
+	processedLevel := c.retryQueue.getProcessedLevel(c.sourceCluster, shard.shardID, readLevel)
+	ctx, cancel := context.WithTimeout(context.Background(), rpcCallTimeout)
+	response, err := c.adminClient.GetReplicationMessages(ctx, &h.GetReplicationMessagesRequest{
+		ShardId:                common.Int32Ptr(shard.shardID),
+		ClusterName:            common.StringPtr(c.currentCluster),
+		LastRetrievedMessageId: common.Int64Ptr(readLevel),
+		LastProcessedMessageId: common.Int64Ptr(processedLevel),
+	})
+	cancel()
+	if err != nil {
+		c.logger.Warn("Failed to pull replication tasks.", tag.ShardID(int(shard.shardID)), tag.Error(err))
+		return false, true
+	}
+
+	shard.startBatch(len(response.Messages), response.GetLastRetrievedMessageId())
+	for _, message := range response.Messages {
+		payload, err := json.Marshal(toReplicationTask(message))
+		if err != nil {
+			// cannot happen for a task which was just deserialized, fetch the batch again on the next poll
+			c.logger.Error("Failed to serialize replication task.", tag.TaskID(message.GetTaskId()), tag.Error(err))
+			shard.done(true)
+			continue
+		}
+
+		msg := &rpcMessage{
+			value:     payload,
+			partition: shard.shardID,
+			offset:    message.GetTaskId(),
+			done:      shard.done,
+		}
+		if !c.deliver(msg) {
+			return false, false
+		}
+	}
+
+	return response.GetHasMore(), true
+}
+
+func (c *rpcConsumer) deliver(msg Message) bool {
+	select {
+	case c.msgC <- msg:
+		return true
+	case <-c.stopC:
+		return false
+	}
+}
+
+func (s *rpcConsumerShard) getReadLevel() (int64, bool) {
+	s.Lock()
+	defer s.Unlock()
+	return s.ackLevel, s.pendingMessages == 0
+}
+
+func (s *rpcConsumerShard) startBatch(size int, readLevel int64) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.batchReadLevel = readLevel
+	s.pendingMessages = size
+	s.nacked = false
+	if size == 0 {
+		s.ackLevel = readLevel
+	}
+}
+
+// done completes a message of the current batch, the batch is pulled again if any of its messages is nacked
+func (s *rpcConsumerShard) done(nack bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.nacked = s.nacked || nack
+	s.pendingMessages--
+	if s.pendingMessages == 0 && !s.nacked {
+		s.ackLevel = s.batchReadLevel
+	}
+}
+
+func (m *rpcMessage) Value() []byte {
+	return m.value
+}
+
+func (m *rpcMessage) Partition() int32 {
+	return m.partition
+}
+
+func (m *rpcMessage) Offset() int64 {
+	return m.offset
+}
+
+func (m *rpcMessage) Ack() error {
+	m.done(false)
+	return nil
+}
+
+func (m *rpcMessage) Nack() error {
+	m.done(true)
+	return nil
+}
+
+func toReplicationTask(message *h.ReplicationMessage) *replicator.ReplicationTask {
+	return &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskTypeHistory.Ptr(),
+		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+			DomainId:        message.DomainId,
+			WorkflowId:      message.WorkflowId,
+			RunId:           message.RunId,
+			FirstEventId:    message.FirstEventId,
+			NextEventId:     message.NextEventId,
+			Version:         message.Version,
+			ReplicationInfo: message.ReplicationInfo,
+			History:         message.History,
+			NewRunHistory:   message.NewRunHistory,
+		},
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+)
+
+type (
+	rpcConsumerSuite struct {
+		suite.Suite
+		*require.Assertions
+		mockCtrl    *gomock.Controller
+		adminClient *adminservicetest.MockClient
+		retryQueue  *rpcRetryQueue
+		consumer    *rpcConsumer
+	}
+)
+
+func TestRPCConsumerSuite(t *testing.T) {
+	s := new(rpcConsumerSuite)
+	suite.Run(t, s)
+}
+
+func (s *rpcConsumerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockCtrl = gomock.NewController(s.T())
+	s.adminClient = adminservicetest.NewMockClient(s.mockCtrl)
+	s.retryQueue = newRPCRetryQueue()
+	s.consumer = newRPCConsumer("standby", "active", s.adminClient, s.retryQueue, 1, time.Second, 10,
+		logging.NewNopLogger()).(*rpcConsumer)
+}
+
+func (s *rpcConsumerSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *rpcConsumerSuite) TestPollShard_NextBatchAfterAck() {
+	shard := s.consumer.shards[0]
+	s.expectGetReplicationMessages(-1, -1, []int64{11, 12}, 12)
+
+	hasMore, ok := s.consumer.pollShard(shard)
+	s.True(ok)
+	s.False(hasMore)
+	msg1 := s.receive()
+	msg2 := s.receive()
+	s.Equal(int32(0), msg1.Partition())
+	s.Equal(int64(11), msg1.Offset())
+	s.Equal(int64(12), msg2.Offset())
+
+	var task replicator.ReplicationTask
+	s.NoError(json.Unmarshal(msg1.Value(), &task))
+	s.Equal(replicator.ReplicationTaskTypeHistory, task.GetTaskType())
+	s.Equal("wid", task.HistoryTaskAttributes.GetWorkflowId())
+
+	// the shard is not polled until the batch is acked
+	_, ok = s.consumer.pollShard(shard)
+	s.True(ok)
+
+	s.NoError(msg1.Ack())
+	s.NoError(msg2.Ack())
+	s.expectGetReplicationMessages(12, 12, nil, 12)
+	_, ok = s.consumer.pollShard(shard)
+	s.True(ok)
+}
+
+func (s *rpcConsumerSuite) TestPollShard_BatchPulledAgainAfterNack() {
+	shard := s.consumer.shards[0]
+	s.expectGetReplicationMessages(5, 5, []int64{11, 12}, 12)
+	shard.ackLevel = 5
+
+	_, ok := s.consumer.pollShard(shard)
+	s.True(ok)
+	s.NoError(s.receive().Ack())
+	s.NoError(s.receive().Nack())
+
+	s.expectGetReplicationMessages(5, 5, []int64{12}, 12)
+	_, ok = s.consumer.pollShard(shard)
+	s.True(ok)
+	s.NoError(s.receive().Ack())
+	s.Equal(int64(12), shard.ackLevel)
+}
+
+func (s *rpcConsumerSuite) TestDeliverRetries() {
+	producer := &rpcRetryProducer{queue: s.retryQueue}
+	s.NoError(producer.Publish(&FailedReplicationTask{SourceCluster: "active", Attempt: 2}))
+	s.NoError(producer.Publish(&FailedReplicationTask{SourceCluster: "other", Attempt: 3}))
+
+	s.True(s.consumer.deliverRetries())
+	msg := s.receive()
+	var task FailedReplicationTask
+	s.NoError(json.Unmarshal(msg.Value(), &task))
+	s.Equal("active", task.SourceCluster)
+	s.Equal(2, task.Attempt)
+	s.Equal(0, len(s.consumer.msgC))
+
+	// a nacked retry is delivered again
+	s.NoError(msg.Nack())
+	s.True(s.consumer.deliverRetries())
+	s.NoError(s.receive().Ack())
+	_, ok := s.retryQueue.pop("active")
+	s.False(ok)
+	_, ok = s.retryQueue.pop("other")
+	s.True(ok)
+}
+
+func (s *rpcConsumerSuite) TestFailedTaskHoldsProcessedLevel() {
+	shard := s.consumer.shards[0]
+	producer := &rpcRetryProducer{queue: s.retryQueue}
+	s.expectGetReplicationMessages(-1, -1, []int64{11, 12, 13}, 13)
+	_, ok := s.consumer.pollShard(shard)
+	s.True(ok)
+
+	// the replication task processor moves the second task to the retry topic before acking its message
+	s.NoError(s.receive().Ack())
+	msg := s.receive()
+	s.NoError(producer.Publish(&FailedReplicationTask{
+		SourceCluster: "active",
+		Partition:     msg.Partition(),
+		Offset:        msg.Offset(),
+		Attempt:       1,
+	}))
+	s.NoError(msg.Ack())
+	s.NoError(s.receive().Ack())
+
+	s.expectGetReplicationMessages(13, 11, nil, 13)
+	_, ok = s.consumer.pollShard(shard)
+	s.True(ok)
+
+	// the retried task fails again, and applies on the next attempt
+	s.True(s.consumer.deliverRetries())
+	retry := s.receive()
+	s.Equal(int64(12), retry.Offset())
+	s.NoError(producer.Publish(&FailedReplicationTask{SourceCluster: "active", Partition: 0, Offset: 12, Attempt: 2}))
+	s.NoError(retry.Ack())
+	s.expectGetReplicationMessages(13, 11, nil, 13)
+	_, ok = s.consumer.pollShard(shard)
+	s.True(ok)
+
+	s.True(s.consumer.deliverRetries())
+	s.NoError(s.receive().Ack())
+	s.expectGetReplicationMessages(13, 13, nil, 13)
+	_, ok = s.consumer.pollShard(shard)
+	s.True(ok)
+}
+
+func (s *rpcConsumerSuite) TestDLQHoldsProcessedLevel() {
+	shard := s.consumer.shards[0]
+	producer := &rpcDLQProducer{queue: s.retryQueue, logger: logging.NewNopLogger()}
+	s.expectGetReplicationMessages(-1, -1, []int64{11, 12}, 12)
+	_, ok := s.consumer.pollShard(shard)
+	s.True(ok)
+
+	msg := s.receive()
+	s.NoError(producer.Publish(&FailedReplicationTask{
+		SourceCluster: "active",
+		Partition:     msg.Partition(),
+		Offset:        msg.Offset(),
+		Attempt:       10,
+		Error:         "bad request",
+	}))
+	s.NoError(msg.Ack())
+	s.NoError(s.receive().Ack())
+
+	s.expectGetReplicationMessages(12, 10, nil, 12)
+	_, ok = s.consumer.pollShard(shard)
+	s.True(ok)
+}
+
+func (s *rpcConsumerSuite) TestRestartedConsumerPullsFailedTask() {
+	producer := &rpcRetryProducer{queue: s.retryQueue}
+	s.expectGetReplicationMessages(-1, -1, []int64{11, 12}, 12)
+	_, ok := s.consumer.pollShard(s.consumer.shards[0])
+	s.True(ok)
+	s.NoError(s.receive().Ack())
+	msg := s.receive()
+	s.NoError(producer.Publish(&FailedReplicationTask{
+		SourceCluster: "active",
+		Partition:     msg.Partition(),
+		Offset:        msg.Offset(),
+		Attempt:       1,
+	}))
+	s.NoError(msg.Ack())
+
+	// the source cluster records the shard as processed up to the task before the failed one
+	s.expectGetReplicationMessages(12, 11, nil, 12)
+	_, ok = s.consumer.pollShard(s.consumer.shards[0])
+	s.True(ok)
+
+	// the worker restarts, the retry queue kept in memory is lost and the consumer starts from the level
+	// recorded by the source cluster, which serves the failed task again
+	s.retryQueue = newRPCRetryQueue()
+	s.consumer = newRPCConsumer("standby", "active", s.adminClient, s.retryQueue, 1, time.Second, 10,
+		logging.NewNopLogger()).(*rpcConsumer)
+	s.expectGetReplicationMessages(-1, -1, []int64{12}, 12)
+	_, ok = s.consumer.pollShard(s.consumer.shards[0])
+	s.True(ok)
+	msg = s.receive()
+	s.Equal(int64(12), msg.Offset())
+	s.NoError(msg.Ack())
+
+	s.expectGetReplicationMessages(12, 12, nil, 12)
+	_, ok = s.consumer.pollShard(s.consumer.shards[0])
+	s.True(ok)
+}
+
+func (s *rpcConsumerSuite) expectGetReplicationMessages(readLevel int64, processedLevel int64, taskIDs []int64,
+	lastRetrieved int64) {
+	var messages []*h.ReplicationMessage
+	for _, taskID := range taskIDs {
+		messages = append(messages, &h.ReplicationMessage{
+			TaskId:     common.Int64Ptr(taskID),
+			DomainId:   common.StringPtr("did"),
+			WorkflowId: common.StringPtr("wid"),
+			RunId:      common.StringPtr("rid"),
+		})
+	}
+
+	s.adminClient.EXPECT().GetReplicationMessages(gomock.Any(), &h.GetReplicationMessagesRequest{
+		ShardId:                common.Int32Ptr(0),
+		ClusterName:            common.StringPtr("standby"),
+		LastRetrievedMessageId: common.Int64Ptr(readLevel),
+		LastProcessedMessageId: common.Int64Ptr(processedLevel),
+	}).Return(&h.GetReplicationMessagesResponse{
+		Messages:               messages,
+		LastRetrievedMessageId: common.Int64Ptr(lastRetrieved),
+		HasMore:                common.BoolPtr(false),
+	}, nil)
+}
+
+func (s *rpcConsumerSuite) receive() Message {
+	select {
+	case msg := <-s.consumer.Messages():
+		return msg
+	default:
+		s.FailNow("no message delivered")
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+)
+
+type (
+	// rpcProducer pushes the domain replication tasks to the frontend of the remote clusters, the history
+	// replication tasks are not published since the remote clusters pull them
+	rpcProducer struct {
+		remoteClusters []string
+		getAdminClient func(cluster string) (adminserviceclient.Interface, error)
+		logger         logging.Logger
+	}
+)
+
+// errHistoryTaskNotPublished is the error returned when publishing a history replication task over rpc
+var errHistoryTaskNotPublished = errors.New("history replication tasks are pulled by the remote clusters")
+
+func newRPCProducer(remoteClusters []string, getAdminClient func(cluster string) (adminserviceclient.Interface, error),
+	logger logging.Logger) Producer {
+	return &rpcProducer{
+		remoteClusters: remoteClusters,
+		getAdminClient: getAdminClient,
+		logger:         logger,
+	}
+}
+
+// Publish is used to send the domain replication task to all the remote clusters
+func (p *rpcProducer) Publish(task *replicator.ReplicationTask) error {
+	if task.GetTaskType() != replicator.ReplicationTaskTypeDomain {
+		return errHistoryTaskNotPublished
+	}
+
+	for _, cluster := range p.remoteClusters {
+		client, err := p.getAdminClient(cluster)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), rpcCallTimeout)
+		err = client.ReplicateDomain(ctx, task.DomainTaskAttributes)
+		cancel()
+		if err != nil {
+			p.logger.Warn("Failed to replicate domain to remote cluster",
+				tag.TargetCluster(cluster),
+				tag.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PublishBatch is used to send the domain replication tasks to all the remote clusters
+func (p *rpcProducer) PublishBatch(tasks []*replicator.ReplicationTask) error {
+	for _, task := range tasks {
+		if err := p.Publish(task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close is a no-op, the admin clients are shared by the rpc client
+func (p *rpcProducer) Close() error {
+	return nil
+}
@@ -67,6 +67,12 @@ const (
 	OperationTagName = "operation"
 	// ShardTagName is temporary until we can get all metric data removed for the service
 	ShardTagName = "shard"
+	// DomainTagName is the name of the tag for the domain of a request
+	DomainTagName = "domain"
+	// SourceClusterTagName is the name of the tag for the cluster replication tasks are applied from
+	SourceClusterTagName = "source_cluster"
+	// TargetClusterTagName is the name of the tag for the cluster replication tasks are sent to
+	TargetClusterTagName = "target_cluster"
 )
 
 // This package should hold all the metrics and tags for cadence
@@ -168,10 +174,14 @@ const (
 	PersistenceDeleteDomainScope
 	// PersistenceDeleteDomainByNameScope tracks DeleteDomainByName calls made by service to persistence layer
 	PersistenceDeleteDomainByNameScope
+	// PersistenceListDomainScope tracks ListDomains calls made by service to persistence layer
+	PersistenceListDomainScope
 	// PersistenceRecordWorkflowExecutionStartedScope tracks RecordWorkflowExecutionStarted calls made by service to persistence layer
 	PersistenceRecordWorkflowExecutionStartedScope
 	// PersistenceRecordWorkflowExecutionClosedScope tracks RecordWorkflowExecutionClosed calls made by service to persistence layer
 	PersistenceRecordWorkflowExecutionClosedScope
+	// PersistenceUpsertWorkflowExecutionScope tracks UpsertWorkflowExecution calls made by service to persistence layer
+	PersistenceUpsertWorkflowExecutionScope
 	// PersistenceListOpenWorkflowExecutionsScope tracks ListOpenWorkflowExecutions calls made by service to persistence layer
 	PersistenceListOpenWorkflowExecutionsScope
 	// PersistenceListClosedWorkflowExecutionsScope tracks ListClosedWorkflowExecutions calls made by service to persistence layer
@@ -186,6 +196,14 @@ const (
 	PersistenceListClosedWorkflowExecutionsByWorkflowIDScope
 	// PersistenceListClosedWorkflowExecutionsByStatusScope tracks ListClosedWorkflowExecutionsByStatus calls made by service to persistence layer
 	PersistenceListClosedWorkflowExecutionsByStatusScope
+	// PersistenceListOpenWorkflowExecutionsBySearchAttributesScope tracks ListOpenWorkflowExecutionsBySearchAttributes calls made by service to persistence layer
+	PersistenceListOpenWorkflowExecutionsBySearchAttributesScope
+	// PersistenceListClosedWorkflowExecutionsBySearchAttributesScope tracks ListClosedWorkflowExecutionsBySearchAttributes calls made by service to persistence layer
+	PersistenceListClosedWorkflowExecutionsBySearchAttributesScope
+	// PersistenceListWorkflowExecutionsScope tracks ListWorkflowExecutions calls made by service to persistence layer
+	PersistenceListWorkflowExecutionsScope
+	// PersistenceCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to persistence layer
+	PersistenceCountWorkflowExecutionsScope
 	// PersistenceGetClosedWorkflowExecutionScope tracks GetClosedWorkflowExecution calls made by service to persistence layer
 	PersistenceGetClosedWorkflowExecutionScope
 	// HistoryClientStartWorkflowExecutionScope tracks RPC calls to history service
@@ -208,6 +226,10 @@ const (
 	HistoryClientResetStickyTaskListScope
 	// HistoryClientDescribeWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientDescribeWorkflowExecutionScope
+	// HistoryClientDescribeMutableStateScope tracks RPC calls to history service
+	HistoryClientDescribeMutableStateScope
+	// HistoryClientDescribeHistoryHostScope tracks RPC calls to history service
+	HistoryClientDescribeHistoryHostScope
 	// HistoryClientRecordDecisionTaskStartedScope tracks RPC calls to history service
 	HistoryClientRecordDecisionTaskStartedScope
 	// HistoryClientRecordActivityTaskStartedScope tracks RPC calls to history service
@@ -222,12 +244,22 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
 	HistoryClientScheduleDecisionTaskScope
 	// HistoryClientRecordChildExecutionCompletedScope tracks RPC calls to history service
 	HistoryClientRecordChildExecutionCompletedScope
 	// HistoryClientReplicateEventsScope tracks RPC calls to history service
 	HistoryClientReplicateEventsScope
+	// HistoryClientGetReplicationMessagesScope tracks RPC calls to history service
+	HistoryClientGetReplicationMessagesScope
+	// HistoryClientGetReplicationStatusScope tracks RPC calls to history service
+	HistoryClientGetReplicationStatusScope
+	// HistoryClientGetDomainReplicationLagScope tracks RPC calls to history service
+	HistoryClientGetDomainReplicationLagScope
+	// HistoryClientGetWorkflowReplicationTasksScope tracks RPC calls to history service
+	HistoryClientGetWorkflowReplicationTasksScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -286,12 +318,18 @@ const (
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendResetWorkflowExecutionScope is the metric scope for frontend.ResetWorkflowExecution
+	FrontendResetWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
 	FrontendListOpenWorkflowExecutionsScope
 	// FrontendListClosedWorkflowExecutionsScope is the metric scope for frontend.ListClosedWorkflowExecutions
 	FrontendListClosedWorkflowExecutionsScope
+	// FrontendListWorkflowExecutionsScope is the metric scope for frontend.ListWorkflowExecutions
+	FrontendListWorkflowExecutionsScope
+	// FrontendCountWorkflowExecutionsScope is the metric scope for frontend.CountWorkflowExecutions
+	FrontendCountWorkflowExecutionsScope
 	// FrontendRegisterDomainScope is the metric scope for frontend.RegisterDomain
 	FrontendRegisterDomainScope
 	// FrontendDescribeDomainScope is the metric scope for frontend.DescribeDomain
@@ -306,6 +344,20 @@ const (
 	FrontendDescribeWorkflowExecutionScope
 	// FrontendDescribeTaskListScope is the metric scope for frontend.DescribeTaskList
 	FrontendDescribeTaskListScope
+	// AdminDescribeWorkflowExecutionScope is the metric scope for admin.DescribeWorkflowExecution
+	AdminDescribeWorkflowExecutionScope
+	// AdminDescribeHistoryHostScope is the metric scope for admin.DescribeHistoryHost
+	AdminDescribeHistoryHostScope
+	// AdminGetReplicationMessagesScope is the metric scope for admin.GetReplicationMessages
+	AdminGetReplicationMessagesScope
+	// AdminReplicateDomainScope is the metric scope for admin.ReplicateDomain
+	AdminReplicateDomainScope
+	// AdminDescribeReplicationStatusScope is the metric scope for admin.DescribeReplicationStatus
+	AdminDescribeReplicationStatusScope
+	// AdminGetWorkflowReplicationTasksScope is the metric scope for admin.GetWorkflowReplicationTasks
+	AdminGetWorkflowReplicationTasksScope
+	// FrontendDomainHandoverScope is the metric scope for the graceful failover of domains
+	FrontendDomainHandoverScope
 
 	NumFrontendScopes
 )
@@ -332,6 +384,10 @@ const (
 	HistoryResetStickyTaskListScope
 	// HistoryDescribeWorkflowExecutionScope tracks DescribeWorkflowExecution API calls received by service
 	HistoryDescribeWorkflowExecutionScope
+	// HistoryDescribeMutableStateScope tracks DescribeMutableState API calls received by service
+	HistoryDescribeMutableStateScope
+	// HistoryDescribeHistoryHostScope tracks DescribeHistoryHost API calls received by service
+	HistoryDescribeHistoryHostScope
 	// HistoryRecordDecisionTaskStartedScope tracks RecordDecisionTaskStarted API calls received by service
 	HistoryRecordDecisionTaskStartedScope
 	// HistoryRecordActivityTaskStartedScope tracks RecordActivityTaskStarted API calls received by service
@@ -344,6 +400,8 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryResetWorkflowExecutionScope tracks ResetWorkflowExecution API calls received by service
+	HistoryResetWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -352,6 +410,14 @@ const (
 	HistoryRequestCancelWorkflowExecutionScope
 	// HistoryReplicateEventsScope tracks ReplicateEvents API calls received by service
 	HistoryReplicateEventsScope
+	// HistoryGetReplicationMessagesScope tracks GetReplicationMessages API calls received by service
+	HistoryGetReplicationMessagesScope
+	// HistoryGetReplicationStatusScope tracks GetReplicationStatus API calls received by service
+	HistoryGetReplicationStatusScope
+	// HistoryGetDomainReplicationLagScope tracks GetDomainReplicationLag API calls received by service
+	HistoryGetDomainReplicationLagScope
+	// HistoryGetWorkflowReplicationTasksScope tracks GetWorkflowReplicationTasks API calls received by service
+	HistoryGetWorkflowReplicationTasksScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -368,6 +434,8 @@ const (
 	TransferTaskSignalExecutionScope
 	// TransferTaskStartChildExecutionScope is the scope used for start child execution task processing by transfer queue processor
 	TransferTaskStartChildExecutionScope
+	// TransferTaskUpsertWorkflowSearchAttributesScope is the scope used for upsert search attributes task processing by transfer queue processor
+	TransferTaskUpsertWorkflowSearchAttributesScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
@@ -380,6 +448,8 @@ const (
 	TimerTaskWorkflowTimeoutScope
 	// TimerTaskRetryTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerTaskRetryTimerScope
+	// TimerTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing workflow backoff task.
+	TimerTaskWorkflowBackoffTimerScope
 	// TimerTaskDeleteHistoryEvent is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerTaskDeleteHistoryEvent
 	// HistoryEventNotificationScope is the scope used by shard history event nitification
@@ -388,6 +458,8 @@ const (
 	ReplicatorQueueProcessorScope
 	// ReplicatorTaskHistoryScope is the scope used for history task processing by replicator queue processor
 	ReplicatorTaskHistoryScope
+	// ArchiverProcessorScope is the scope used by all metric emitted by the archiver processor
+	ArchiverProcessorScope
 
 	NumHistoryScopes
 )
@@ -428,44 +500,50 @@ const (
 var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	// common scope Names
 	Common: {
-		PersistenceCreateShardScope:                              {operation: "CreateShard", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceGetShardScope:                                 {operation: "GetShard", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceUpdateShardScope:                              {operation: "UpdateShard", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceCreateWorkflowExecutionScope:                  {operation: "CreateWorkflowExecution"},
-		PersistenceGetWorkflowExecutionScope:                     {operation: "GetWorkflowExecution"},
-		PersistenceUpdateWorkflowExecutionScope:                  {operation: "UpdateWorkflowExecution"},
-		PersistenceResetMutableStateScope:                        {operation: "ResetMutableState"},
-		PersistenceDeleteWorkflowExecutionScope:                  {operation: "DeleteWorkflowExecution"},
-		PersistenceGetCurrentExecutionScope:                      {operation: "GetCurrentExecution"},
-		PersistenceGetTransferTasksScope:                         {operation: "GetTransferTasks"},
-		PersistenceGetReplicationTasksScope:                      {operation: "GetReplicationTasks"},
-		PersistenceCompleteTransferTaskScope:                     {operation: "CompleteTransferTask"},
-		PersistenceCompleteReplicationTaskScope:                  {operation: "CompleteReplicationTask"},
-		PersistenceGetTimerIndexTasksScope:                       {operation: "GetTimerIndexTasks"},
-		PersistenceCompleteTimerTaskScope:                        {operation: "CompleteTimerTask"},
-		PersistenceCreateTaskScope:                               {operation: "CreateTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceGetTasksScope:                                 {operation: "GetTasks", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceCompleteTaskScope:                             {operation: "CompleteTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceLeaseTaskListScope:                            {operation: "LeaseTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceUpdateTaskListScope:                           {operation: "UpdateTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceAppendHistoryEventsScope:                      {operation: "AppendHistoryEvents", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceGetWorkflowExecutionHistoryScope:              {operation: "GetWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceDeleteWorkflowExecutionHistoryScope:           {operation: "DeleteWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceCreateDomainScope:                             {operation: "CreateDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceGetDomainScope:                                {operation: "GetDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceUpdateDomainScope:                             {operation: "UpdateDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceDeleteDomainScope:                             {operation: "DeleteDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceDeleteDomainByNameScope:                       {operation: "DeleteDomainByName", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceRecordWorkflowExecutionStartedScope:           {operation: "RecordWorkflowExecutionStarted"},
-		PersistenceRecordWorkflowExecutionClosedScope:            {operation: "RecordWorkflowExecutionClosed"},
-		PersistenceListOpenWorkflowExecutionsScope:               {operation: "ListOpenWorkflowExecutions"},
-		PersistenceListClosedWorkflowExecutionsScope:             {operation: "ListClosedWorkflowExecutions"},
-		PersistenceListOpenWorkflowExecutionsByTypeScope:         {operation: "ListOpenWorkflowExecutionsByType"},
-		PersistenceListClosedWorkflowExecutionsByTypeScope:       {operation: "ListClosedWorkflowExecutionsByType"},
-		PersistenceListOpenWorkflowExecutionsByWorkflowIDScope:   {operation: "ListOpenWorkflowExecutionsByWorkflowID"},
-		PersistenceListClosedWorkflowExecutionsByWorkflowIDScope: {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
-		PersistenceListClosedWorkflowExecutionsByStatusScope:     {operation: "ListClosedWorkflowExecutionsByStatus"},
-		PersistenceGetClosedWorkflowExecutionScope:               {operation: "GetClosedWorkflowExecution"},
+		PersistenceCreateShardScope:                                    {operation: "CreateShard", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetShardScope:                                       {operation: "GetShard", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpdateShardScope:                                    {operation: "UpdateShard", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceCreateWorkflowExecutionScope:                        {operation: "CreateWorkflowExecution"},
+		PersistenceGetWorkflowExecutionScope:                           {operation: "GetWorkflowExecution"},
+		PersistenceUpdateWorkflowExecutionScope:                        {operation: "UpdateWorkflowExecution"},
+		PersistenceResetMutableStateScope:                              {operation: "ResetMutableState"},
+		PersistenceDeleteWorkflowExecutionScope:                        {operation: "DeleteWorkflowExecution"},
+		PersistenceGetCurrentExecutionScope:                            {operation: "GetCurrentExecution"},
+		PersistenceGetTransferTasksScope:                               {operation: "GetTransferTasks"},
+		PersistenceGetReplicationTasksScope:                            {operation: "GetReplicationTasks"},
+		PersistenceCompleteTransferTaskScope:                           {operation: "CompleteTransferTask"},
+		PersistenceCompleteReplicationTaskScope:                        {operation: "CompleteReplicationTask"},
+		PersistenceGetTimerIndexTasksScope:                             {operation: "GetTimerIndexTasks"},
+		PersistenceCompleteTimerTaskScope:                              {operation: "CompleteTimerTask"},
+		PersistenceCreateTaskScope:                                     {operation: "CreateTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetTasksScope:                                       {operation: "GetTasks", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceCompleteTaskScope:                                   {operation: "CompleteTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceLeaseTaskListScope:                                  {operation: "LeaseTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpdateTaskListScope:                                 {operation: "UpdateTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceAppendHistoryEventsScope:                            {operation: "AppendHistoryEvents", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetWorkflowExecutionHistoryScope:                    {operation: "GetWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceDeleteWorkflowExecutionHistoryScope:                 {operation: "DeleteWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceCreateDomainScope:                                   {operation: "CreateDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetDomainScope:                                      {operation: "GetDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpdateDomainScope:                                   {operation: "UpdateDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceDeleteDomainScope:                                   {operation: "DeleteDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceDeleteDomainByNameScope:                             {operation: "DeleteDomainByName", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceListDomainScope:                                     {operation: "ListDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceRecordWorkflowExecutionStartedScope:                 {operation: "RecordWorkflowExecutionStarted"},
+		PersistenceRecordWorkflowExecutionClosedScope:                  {operation: "RecordWorkflowExecutionClosed"},
+		PersistenceUpsertWorkflowExecutionScope:                        {operation: "UpsertWorkflowExecution"},
+		PersistenceListOpenWorkflowExecutionsScope:                     {operation: "ListOpenWorkflowExecutions"},
+		PersistenceListClosedWorkflowExecutionsScope:                   {operation: "ListClosedWorkflowExecutions"},
+		PersistenceListOpenWorkflowExecutionsByTypeScope:               {operation: "ListOpenWorkflowExecutionsByType"},
+		PersistenceListClosedWorkflowExecutionsByTypeScope:             {operation: "ListClosedWorkflowExecutionsByType"},
+		PersistenceListOpenWorkflowExecutionsByWorkflowIDScope:         {operation: "ListOpenWorkflowExecutionsByWorkflowID"},
+		PersistenceListClosedWorkflowExecutionsByWorkflowIDScope:       {operation: "ListClosedWorkflowExecutionsByWorkflowID"},
+		PersistenceListClosedWorkflowExecutionsByStatusScope:           {operation: "ListClosedWorkflowExecutionsByStatus"},
+		PersistenceListOpenWorkflowExecutionsBySearchAttributesScope:   {operation: "ListOpenWorkflowExecutionsBySearchAttributes"},
+		PersistenceListClosedWorkflowExecutionsBySearchAttributesScope: {operation: "ListClosedWorkflowExecutionsBySearchAttributes"},
+		PersistenceListWorkflowExecutionsScope:                         {operation: "ListWorkflowExecutions"},
+		PersistenceCountWorkflowExecutionsScope:                        {operation: "CountWorkflowExecutions"},
+		PersistenceGetClosedWorkflowExecutionScope:                     {operation: "GetClosedWorkflowExecution"},
 
 		HistoryClientStartWorkflowExecutionScope:           {operation: "HistoryClientStartWorkflowExecution"},
 		HistoryClientRecordActivityTaskHeartbeatScope:      {operation: "HistoryClientRecordActivityTaskHeartbeat"},
@@ -477,6 +555,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientGetMutableStateScope:                  {operation: "HistoryClientGetMutableState"},
 		HistoryClientResetStickyTaskListScope:              {operation: "HistoryClientResetStickyTaskListScope"},
 		HistoryClientDescribeWorkflowExecutionScope:        {operation: "HistoryClientDescribeWorkflowExecution"},
+		HistoryClientDescribeMutableStateScope:             {operation: "HistoryClientDescribeMutableState"},
+		HistoryClientDescribeHistoryHostScope:              {operation: "HistoryClientDescribeHistoryHost"},
 		HistoryClientRecordDecisionTaskStartedScope:        {operation: "HistoryClientRecordDecisionTaskStarted"},
 		HistoryClientRecordActivityTaskStartedScope:        {operation: "HistoryClientRecordActivityTaskStarted"},
 		HistoryClientRequestCancelWorkflowExecutionScope:   {operation: "HistoryClientRequestCancelWorkflowExecution"},
@@ -484,9 +564,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWithStartWorkflowExecutionScope: {operation: "HistoryClientSignalWithStartWorkflowExecution"},
 		HistoryClientRemoveSignalMutableStateScope:         {operation: "HistoryClientRemoveSignalMutableStateScope"},
 		HistoryClientTerminateWorkflowExecutionScope:       {operation: "HistoryClientTerminateWorkflowExecution"},
+		HistoryClientResetWorkflowExecutionScope:           {operation: "HistoryClientResetWorkflowExecution"},
 		HistoryClientScheduleDecisionTaskScope:             {operation: "HistoryClientScheduleDecisionTask"},
 		HistoryClientRecordChildExecutionCompletedScope:    {operation: "HistoryClientRecordChildExecutionCompleted"},
 		HistoryClientReplicateEventsScope:                  {operation: "HistoryClientReplicateEvents"},
+		HistoryClientGetReplicationMessagesScope:           {operation: "HistoryClientGetReplicationMessages"},
+		HistoryClientGetReplicationStatusScope:             {operation: "HistoryClientGetReplicationStatus"},
+		HistoryClientGetDomainReplicationLagScope:          {operation: "HistoryClientGetDomainReplicationLag"},
+		HistoryClientGetWorkflowReplicationTasksScope:      {operation: "HistoryClientGetWorkflowReplicationTasks"},
 		MatchingClientPollForDecisionTaskScope:             {operation: "MatchingClientPollForDecisionTask"},
 		MatchingClientPollForActivityTaskScope:             {operation: "MatchingClientPollForActivityTask"},
 		MatchingClientAddActivityTaskScope:                 {operation: "MatchingClientAddActivityTask"},
@@ -516,9 +601,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		FrontendResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
 		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
 		FrontendListClosedWorkflowExecutionsScope:     {operation: "ListClosedWorkflowExecutions"},
+		FrontendListWorkflowExecutionsScope:           {operation: "ListWorkflowExecutions"},
+		FrontendCountWorkflowExecutionsScope:          {operation: "CountWorkflowExecutions"},
 		FrontendRegisterDomainScope:                   {operation: "RegisterDomain"},
 		FrontendDescribeDomainScope:                   {operation: "DescribeDomain"},
 		FrontendUpdateDomainScope:                     {operation: "UpdateDomain"},
@@ -526,47 +614,64 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendQueryWorkflowScope:                    {operation: "QueryWorkflow"},
 		FrontendDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
 		FrontendDescribeTaskListScope:                 {operation: "DescribeTaskList"},
+		AdminDescribeWorkflowExecutionScope:           {operation: "AdminDescribeWorkflowExecution"},
+		AdminDescribeHistoryHostScope:                 {operation: "AdminDescribeHistoryHost"},
+		AdminGetReplicationMessagesScope:              {operation: "AdminGetReplicationMessages"},
+		AdminReplicateDomainScope:                     {operation: "AdminReplicateDomain"},
+		AdminDescribeReplicationStatusScope:           {operation: "AdminDescribeReplicationStatus"},
+		AdminGetWorkflowReplicationTasksScope:         {operation: "AdminGetWorkflowReplicationTasks"},
+		FrontendDomainHandoverScope:                   {operation: "DomainHandover"},
 	},
 	// History Scope Names
 	History: {
-		HistoryStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
-		HistoryRecordActivityTaskHeartbeatScope:      {operation: "RecordActivityTaskHeartbeat"},
-		HistoryRespondDecisionTaskCompletedScope:     {operation: "RespondDecisionTaskCompleted"},
-		HistoryRespondDecisionTaskFailedScope:        {operation: "RespondDecisionTaskFailed"},
-		HistoryRespondActivityTaskCompletedScope:     {operation: "RespondActivityTaskCompleted"},
-		HistoryRespondActivityTaskFailedScope:        {operation: "RespondActivityTaskFailed"},
-		HistoryRespondActivityTaskCanceledScope:      {operation: "RespondActivityTaskCanceled"},
-		HistoryGetMutableStateScope:                  {operation: "GetMutableState"},
-		HistoryResetStickyTaskListScope:              {operation: "ResetStickyTaskListScope"},
-		HistoryDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
-		HistoryRecordDecisionTaskStartedScope:        {operation: "RecordDecisionTaskStarted"},
-		HistoryRecordActivityTaskStartedScope:        {operation: "RecordActivityTaskStarted"},
-		HistorySignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
-		HistorySignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
-		HistoryRemoveSignalMutableStateScope:         {operation: "RemoveSignalMutableState"},
-		HistoryTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
-		HistoryScheduleDecisionTaskScope:             {operation: "ScheduleDecisionTask"},
-		HistoryRecordChildExecutionCompletedScope:    {operation: "RecordChildExecutionCompleted"},
-		HistoryRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
-		HistoryReplicateEventsScope:                  {operation: "ReplicateEvents"},
-		HistoryShardControllerScope:                  {operation: "ShardController"},
-		TransferQueueProcessorScope:                  {operation: "TransferQueueProcessor"},
-		TransferTaskActivityScope:                    {operation: "TransferTaskActivity"},
-		TransferTaskDecisionScope:                    {operation: "TransferTaskDecision"},
-		TransferTaskCloseExecutionScope:              {operation: "TransferTaskCloseExecution"},
-		TransferTaskCancelExecutionScope:             {operation: "TransferTaskCancelExecution"},
-		TransferTaskSignalExecutionScope:             {operation: "TransferTaskSignalExecution"},
-		TransferTaskStartChildExecutionScope:         {operation: "TransferTaskStartChildExecution"},
-		TimerQueueProcessorScope:                     {operation: "TimerQueueProcessor"},
-		TimerTaskActivityTimeoutScope:                {operation: "TimerTaskActivityTimeout"},
-		TimerTaskDecisionTimeoutScope:                {operation: "TimerTaskDecisionTimeout"},
-		TimerTaskUserTimerScope:                      {operation: "TimerTaskUserTimer"},
-		TimerTaskWorkflowTimeoutScope:                {operation: "TimerTaskWorkflowTimeout"},
-		TimerTaskRetryTimerScope:                     {operation: "TimerTaskRetryTimer"},
-		TimerTaskDeleteHistoryEvent:                  {operation: "TimerTaskDeleteHistoryEvent"},
-		HistoryEventNotificationScope:                {operation: "HistoryEventNotification"},
-		ReplicatorQueueProcessorScope:                {operation: "ReplicatorQueueProcessor"},
-		ReplicatorTaskHistoryScope:                   {operation: "ReplicatorTaskHistory"},
+		HistoryStartWorkflowExecutionScope:              {operation: "StartWorkflowExecution"},
+		HistoryRecordActivityTaskHeartbeatScope:         {operation: "RecordActivityTaskHeartbeat"},
+		HistoryRespondDecisionTaskCompletedScope:        {operation: "RespondDecisionTaskCompleted"},
+		HistoryRespondDecisionTaskFailedScope:           {operation: "RespondDecisionTaskFailed"},
+		HistoryRespondActivityTaskCompletedScope:        {operation: "RespondActivityTaskCompleted"},
+		HistoryRespondActivityTaskFailedScope:           {operation: "RespondActivityTaskFailed"},
+		HistoryRespondActivityTaskCanceledScope:         {operation: "RespondActivityTaskCanceled"},
+		HistoryGetMutableStateScope:                     {operation: "GetMutableState"},
+		HistoryResetStickyTaskListScope:                 {operation: "ResetStickyTaskListScope"},
+		HistoryDescribeWorkflowExecutionScope:           {operation: "DescribeWorkflowExecution"},
+		HistoryDescribeMutableStateScope:                {operation: "DescribeMutableState"},
+		HistoryDescribeHistoryHostScope:                 {operation: "DescribeHistoryHost"},
+		HistoryRecordDecisionTaskStartedScope:           {operation: "RecordDecisionTaskStarted"},
+		HistoryRecordActivityTaskStartedScope:           {operation: "RecordActivityTaskStarted"},
+		HistorySignalWorkflowExecutionScope:             {operation: "SignalWorkflowExecution"},
+		HistorySignalWithStartWorkflowExecutionScope:    {operation: "SignalWithStartWorkflowExecution"},
+		HistoryRemoveSignalMutableStateScope:            {operation: "RemoveSignalMutableState"},
+		HistoryTerminateWorkflowExecutionScope:          {operation: "TerminateWorkflowExecution"},
+		HistoryResetWorkflowExecutionScope:              {operation: "ResetWorkflowExecution"},
+		HistoryScheduleDecisionTaskScope:                {operation: "ScheduleDecisionTask"},
+		HistoryRecordChildExecutionCompletedScope:       {operation: "RecordChildExecutionCompleted"},
+		HistoryRequestCancelWorkflowExecutionScope:      {operation: "RequestCancelWorkflowExecution"},
+		HistoryReplicateEventsScope:                     {operation: "ReplicateEvents"},
+		HistoryGetReplicationMessagesScope:              {operation: "GetReplicationMessages"},
+		HistoryGetReplicationStatusScope:                {operation: "GetReplicationStatus"},
+		HistoryGetDomainReplicationLagScope:             {operation: "GetDomainReplicationLag"},
+		HistoryGetWorkflowReplicationTasksScope:         {operation: "GetWorkflowReplicationTasks"},
+		HistoryShardControllerScope:                     {operation: "ShardController"},
+		TransferQueueProcessorScope:                     {operation: "TransferQueueProcessor"},
+		TransferTaskActivityScope:                       {operation: "TransferTaskActivity"},
+		TransferTaskDecisionScope:                       {operation: "TransferTaskDecision"},
+		TransferTaskCloseExecutionScope:                 {operation: "TransferTaskCloseExecution"},
+		TransferTaskCancelExecutionScope:                {operation: "TransferTaskCancelExecution"},
+		TransferTaskSignalExecutionScope:                {operation: "TransferTaskSignalExecution"},
+		TransferTaskStartChildExecutionScope:            {operation: "TransferTaskStartChildExecution"},
+		TransferTaskUpsertWorkflowSearchAttributesScope: {operation: "TransferTaskUpsertWorkflowSearchAttributes"},
+		TimerQueueProcessorScope:                        {operation: "TimerQueueProcessor"},
+		TimerTaskActivityTimeoutScope:                   {operation: "TimerTaskActivityTimeout"},
+		TimerTaskDecisionTimeoutScope:                   {operation: "TimerTaskDecisionTimeout"},
+		TimerTaskUserTimerScope:                         {operation: "TimerTaskUserTimer"},
+		TimerTaskWorkflowTimeoutScope:                   {operation: "TimerTaskWorkflowTimeout"},
+		TimerTaskRetryTimerScope:                        {operation: "TimerTaskRetryTimer"},
+		TimerTaskWorkflowBackoffTimerScope:              {operation: "TimerTaskWorkflowBackoffTimer"},
+		TimerTaskDeleteHistoryEvent:                     {operation: "TimerTaskDeleteHistoryEvent"},
+		HistoryEventNotificationScope:                   {operation: "HistoryEventNotification"},
+		ReplicatorQueueProcessorScope:                   {operation: "ReplicatorQueueProcessor"},
+		ReplicatorTaskHistoryScope:                      {operation: "ReplicatorTaskHistory"},
+		ArchiverProcessorScope:                          {operation: "ArchiverProcessor"},
 	},
 	// Matching Scope Names
 	Matching: {
@@ -586,6 +691,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	},
 }
 
+// GetCommonScopeOperation returns the operation tag of a scope shared by all the services
+func GetCommonScopeOperation(scope int) string {
+	return ScopeDefs[Common][scope].operation
+}
+
 // Common Metrics enum
 const (
 	CadenceRequests = iota
@@ -598,6 +708,7 @@ const (
 	CadenceErrDomainAlreadyExistsCounter
 	CadenceErrCancellationAlreadyRequestedCounter
 	CadenceErrQueryFailedCounter
+	CadenceErrAccessDeniedCounter
 	PersistenceRequests
 	PersistenceFailures
 	PersistenceLatency
@@ -613,6 +724,13 @@ const (
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
+// Frontend metrics enum
+const (
+	DomainThrottleCounter = iota + NumCommonMetrics
+	DomainHandoverCompletedCounter
+	DomainHandoverAbortedCounter
+)
+
 // History Metrics enum
 const (
 	TaskRequests = iota + NumCommonMetrics
@@ -632,6 +750,7 @@ const (
 	DecisionTypeChildWorkflowCounter
 	DecisionTypeContinueAsNewCounter
 	DecisionTypeSignalExternalWorkflowCounter
+	DecisionTypeUpsertWorkflowSearchAttributesCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
 	StaleMutableStateCounter
@@ -661,6 +780,11 @@ const (
 	HistoryEventNotificationFanoutLatency
 	HistoryEventNotificationInFlightMessageGauge
 	HistoryEventNotificationFailDeliveryCount
+	WorkflowArchivalRequests
+	WorkflowArchivalFailures
+	ReplicationTasksLag
+	ReplicationHistoryResendRequests
+	ReplicationHistoryResendFailures
 )
 
 // Matching metrics enum
@@ -681,6 +805,9 @@ const (
 	ReplicatorMessages = iota + NumCommonMetrics
 	ReplicatorFailures
 	ReplicatorLatency
+	ReplicatorRetries
+	ReplicatorDLQMessages
+	ReplicatorLag
 )
 
 // MetricDefs record the metrics for all services
@@ -696,6 +823,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceErrDomainAlreadyExistsCounter:          {metricName: "cadence.errors.domain-already-exists", metricType: Counter},
 		CadenceErrCancellationAlreadyRequestedCounter: {metricName: "cadence.errors.cancellation-already-requested", metricType: Counter},
 		CadenceErrQueryFailedCounter:                  {metricName: "cadence.errors.query-failed", metricType: Counter},
+		CadenceErrAccessDeniedCounter:                 {metricName: "cadence.errors.access-denied", metricType: Counter},
 		PersistenceRequests:                           {metricName: "persistence.requests", metricType: Counter},
 		PersistenceFailures:                           {metricName: "persistence.errors", metricType: Counter},
 		PersistenceLatency:                            {metricName: "persistence.latency", metricType: Timer},
@@ -707,53 +835,63 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		HistoryClientFailures:                         {metricName: "client.history.errors", metricType: Counter},
 		MatchingClientFailures:                        {metricName: "client.matching.errors", metricType: Counter},
 	},
-	Frontend: {},
+	Frontend: {
+		DomainThrottleCounter:          {metricName: "domain.throttle.count"},
+		DomainHandoverCompletedCounter: {metricName: "domain.handover.completed", metricType: Counter},
+		DomainHandoverAbortedCounter:   {metricName: "domain.handover.aborted", metricType: Counter},
+	},
 	History: {
-		TaskRequests:                                 {metricName: "task.requests", metricType: Counter},
-		TaskFailures:                                 {metricName: "task.errors", metricType: Counter},
-		TaskLatency:                                  {metricName: "task.latency", metricType: Counter},
-		AckLevelUpdateCounter:                        {metricName: "ack-level-update", metricType: Counter},
-		AckLevelUpdateFailedCounter:                  {metricName: "ack-level-update-failed", metricType: Counter},
-		DecisionTypeScheduleActivityCounter:          {metricName: "schedule-activity-decision", metricType: Counter},
-		DecisionTypeCompleteWorkflowCounter:          {metricName: "complete-workflow-decision", metricType: Counter},
-		DecisionTypeFailWorkflowCounter:              {metricName: "fail-workflow-decision", metricType: Counter},
-		DecisionTypeCancelWorkflowCounter:            {metricName: "cancel-workflow-decision", metricType: Counter},
-		DecisionTypeStartTimerCounter:                {metricName: "start-timer-decision", metricType: Counter},
-		DecisionTypeCancelActivityCounter:            {metricName: "cancel-activity-decision", metricType: Counter},
-		DecisionTypeCancelTimerCounter:               {metricName: "cancel-timer-decision", metricType: Counter},
-		DecisionTypeRecordMarkerCounter:              {metricName: "record-marker-decision", metricType: Counter},
-		DecisionTypeCancelExternalWorkflowCounter:    {metricName: "cancel-external-workflow-decision", metricType: Counter},
-		DecisionTypeContinueAsNewCounter:             {metricName: "continue-as-new-decision", metricType: Counter},
-		DecisionTypeChildWorkflowCounter:             {metricName: "child-workflow-decision", metricType: Counter},
-		MultipleCompletionDecisionsCounter:           {metricName: "multiple-completion-decisions", metricType: Counter},
-		FailedDecisionsCounter:                       {metricName: "failed-decisions", metricType: Counter},
-		StaleMutableStateCounter:                     {metricName: "stale-mutable-state", metricType: Counter},
-		ConcurrencyUpdateFailureCounter:              {metricName: "concurrency-update-failure", metricType: Counter},
-		CadenceErrShardOwnershipLostCounter:          {metricName: "cadence.errors.shard-ownership-lost", metricType: Counter},
-		CadenceErrEventAlreadyStartedCounter:         {metricName: "cadence.errors.event-already-started", metricType: Counter},
-		HeartbeatTimeoutCounter:                      {metricName: "heartbeat-tiemout", metricType: Counter},
-		ScheduleToStartTimeoutCounter:                {metricName: "schedule-to-start-timeout", metricType: Counter},
-		StartToCloseTimeoutCounter:                   {metricName: "start-to-close-timeout", metricType: Counter},
-		ScheduleToCloseTimeoutCounter:                {metricName: "schedule-to-close-timeout", metricType: Counter},
-		NewActiveTimerCounter:                        {metricName: "new-active-timer", metricType: Counter},
-		NewStandbyTimerCounter:                       {metricName: "new-standby-timer", metricType: Counter},
-		NewTimerNotifyCounter:                        {metricName: "new-timer-notifications", metricType: Counter},
-		AcquireShardsCounter:                         {metricName: "acquire-shards-count", metricType: Counter},
-		AcquireShardsLatency:                         {metricName: "acquire-shards-latency", metricType: Timer},
-		ShardClosedCounter:                           {metricName: "shard-closed-count", metricType: Counter},
-		ShardItemCreatedCounter:                      {metricName: "sharditem-created-count", metricType: Counter},
-		ShardItemRemovedCounter:                      {metricName: "sharditem-removed-count", metricType: Counter},
-		MembershipChangedCounter:                     {metricName: "membership-changed-count", metricType: Counter},
-		NumShardsGauge:                               {metricName: "numshards-gauge", metricType: Gauge},
-		GetEngineForShardErrorCounter:                {metricName: "get-engine-for-shard-errors", metricType: Counter},
-		GetEngineForShardLatency:                     {metricName: "get-engine-for-shard-latency", metricType: Timer},
-		RemoveEngineForShardLatency:                  {metricName: "remove-engine-for-shard-latency", metricType: Timer},
-		CompleteDecisionWithStickyEnabledCounter:     {metricName: "complete-decision-sticky-enabled-count", metricType: Counter},
-		CompleteDecisionWithStickyDisabledCounter:    {metricName: "complete-decision-sticky-disabled-count", metricType: Counter},
-		HistoryEventNotificationQueueingLatency:      {metricName: "history-event-notification-queueing-latency", metricType: Timer},
-		HistoryEventNotificationFanoutLatency:        {metricName: "history-event-notification-fanout-latency", metricType: Timer},
-		HistoryEventNotificationInFlightMessageGauge: {metricName: "history-event-notification-inflight-message-gauge", metricType: Gauge},
-		HistoryEventNotificationFailDeliveryCount:    {metricName: "history-event-notification-fail-delivery-count", metricType: Counter},
+		TaskRequests:                                      {metricName: "task.requests", metricType: Counter},
+		TaskFailures:                                      {metricName: "task.errors", metricType: Counter},
+		TaskLatency:                                       {metricName: "task.latency", metricType: Counter},
+		AckLevelUpdateCounter:                             {metricName: "ack-level-update", metricType: Counter},
+		AckLevelUpdateFailedCounter:                       {metricName: "ack-level-update-failed", metricType: Counter},
+		DecisionTypeScheduleActivityCounter:               {metricName: "schedule-activity-decision", metricType: Counter},
+		DecisionTypeCompleteWorkflowCounter:               {metricName: "complete-workflow-decision", metricType: Counter},
+		DecisionTypeFailWorkflowCounter:                   {metricName: "fail-workflow-decision", metricType: Counter},
+		DecisionTypeCancelWorkflowCounter:                 {metricName: "cancel-workflow-decision", metricType: Counter},
+		DecisionTypeStartTimerCounter:                     {metricName: "start-timer-decision", metricType: Counter},
+		DecisionTypeCancelActivityCounter:                 {metricName: "cancel-activity-decision", metricType: Counter},
+		DecisionTypeCancelTimerCounter:                    {metricName: "cancel-timer-decision", metricType: Counter},
+		DecisionTypeRecordMarkerCounter:                   {metricName: "record-marker-decision", metricType: Counter},
+		DecisionTypeCancelExternalWorkflowCounter:         {metricName: "cancel-external-workflow-decision", metricType: Counter},
+		DecisionTypeContinueAsNewCounter:                  {metricName: "continue-as-new-decision", metricType: Counter},
+		DecisionTypeChildWorkflowCounter:                  {metricName: "child-workflow-decision", metricType: Counter},
+		DecisionTypeUpsertWorkflowSearchAttributesCounter: {metricName: "upsert-workflow-search-attributes-decision", metricType: Counter},
+		MultipleCompletionDecisionsCounter:                {metricName: "multiple-completion-decisions", metricType: Counter},
+		FailedDecisionsCounter:                            {metricName: "failed-decisions", metricType: Counter},
+		StaleMutableStateCounter:                          {metricName: "stale-mutable-state", metricType: Counter},
+		ConcurrencyUpdateFailureCounter:                   {metricName: "concurrency-update-failure", metricType: Counter},
+		CadenceErrShardOwnershipLostCounter:               {metricName: "cadence.errors.shard-ownership-lost", metricType: Counter},
+		CadenceErrEventAlreadyStartedCounter:              {metricName: "cadence.errors.event-already-started", metricType: Counter},
+		HeartbeatTimeoutCounter:                           {metricName: "heartbeat-tiemout", metricType: Counter},
+		ScheduleToStartTimeoutCounter:                     {metricName: "schedule-to-start-timeout", metricType: Counter},
+		StartToCloseTimeoutCounter:                        {metricName: "start-to-close-timeout", metricType: Counter},
+		ScheduleToCloseTimeoutCounter:                     {metricName: "schedule-to-close-timeout", metricType: Counter},
+		NewActiveTimerCounter:                             {metricName: "new-active-timer", metricType: Counter},
+		NewStandbyTimerCounter:                            {metricName: "new-standby-timer", metricType: Counter},
+		NewTimerNotifyCounter:                             {metricName: "new-timer-notifications", metricType: Counter},
+		AcquireShardsCounter:                              {metricName: "acquire-shards-count", metricType: Counter},
+		AcquireShardsLatency:                              {metricName: "acquire-shards-latency", metricType: Timer},
+		ShardClosedCounter:                                {metricName: "shard-closed-count", metricType: Counter},
+		ShardItemCreatedCounter:                           {metricName: "sharditem-created-count", metricType: Counter},
+		ShardItemRemovedCounter:                           {metricName: "sharditem-removed-count", metricType: Counter},
+		MembershipChangedCounter:                          {metricName: "membership-changed-count", metricType: Counter},
+		NumShardsGauge:                                    {metricName: "numshards-gauge", metricType: Gauge},
+		GetEngineForShardErrorCounter:                     {metricName: "get-engine-for-shard-errors", metricType: Counter},
+		GetEngineForShardLatency:                          {metricName: "get-engine-for-shard-latency", metricType: Timer},
+		RemoveEngineForShardLatency:                       {metricName: "remove-engine-for-shard-latency", metricType: Timer},
+		CompleteDecisionWithStickyEnabledCounter:          {metricName: "complete-decision-sticky-enabled-count", metricType: Counter},
+		CompleteDecisionWithStickyDisabledCounter:         {metricName: "complete-decision-sticky-disabled-count", metricType: Counter},
+		HistoryEventNotificationQueueingLatency:           {metricName: "history-event-notification-queueing-latency", metricType: Timer},
+		HistoryEventNotificationFanoutLatency:             {metricName: "history-event-notification-fanout-latency", metricType: Timer},
+		HistoryEventNotificationInFlightMessageGauge:      {metricName: "history-event-notification-inflight-message-gauge", metricType: Gauge},
+		HistoryEventNotificationFailDeliveryCount:         {metricName: "history-event-notification-fail-delivery-count", metricType: Counter},
+		WorkflowArchivalRequests:                          {metricName: "workflow-archival-requests", metricType: Counter},
+		WorkflowArchivalFailures:                          {metricName: "workflow-archival-failures", metricType: Counter},
+		ReplicationTasksLag:                               {metricName: "replication-tasks-lag", metricType: Gauge},
+		ReplicationHistoryResendRequests:                  {metricName: "replication-history-resend-requests", metricType: Counter},
+		ReplicationHistoryResendFailures:                  {metricName: "replication-history-resend-failures", metricType: Counter},
 	},
 	Matching: {
 		PollSuccessCounter:            {metricName: "poll.success"},
@@ -767,9 +905,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		BufferThrottleCounter:         {metricName: "buffer.throttle.count"},
 	},
 	Worker: {
-		ReplicatorMessages: {metricName: "replicator.messages"},
-		ReplicatorFailures: {metricName: "replicator.errors"},
-		ReplicatorLatency:  {metricName: "replicator.latency"},
+		ReplicatorMessages:    {metricName: "replicator.messages"},
+		ReplicatorFailures:    {metricName: "replicator.errors"},
+		ReplicatorLatency:     {metricName: "replicator.latency"},
+		ReplicatorRetries:     {metricName: "replicator.retries"},
+		ReplicatorDLQMessages: {metricName: "replicator.dlq-messages"},
+		ReplicatorLag:         {metricName: "replicator.lag", metricType: Timer},
 	},
 }
 
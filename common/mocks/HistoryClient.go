@@ -54,6 +54,52 @@ func (_m *HistoryClient) GetMutableState(ctx context.Context, getRequest *histor
 	return r0, r1
 }
 
+// DescribeMutableState provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DescribeMutableState(ctx context.Context, request *history.DescribeMutableStateRequest, opts ...yarpc.CallOption) (*history.DescribeMutableStateResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.DescribeMutableStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.DescribeMutableStateRequest) *history.DescribeMutableStateResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.DescribeMutableStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.DescribeMutableStateRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeHistoryHost provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DescribeHistoryHost(ctx context.Context, request *shared.DescribeHistoryHostRequest, opts ...yarpc.CallOption) (*shared.DescribeHistoryHostResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeHistoryHostResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeHistoryHostRequest) *shared.DescribeHistoryHostResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeHistoryHostResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeHistoryHostRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResetStickyTaskList provides a mock function with given fields: ctx, getRequest
 func (_m *HistoryClient) ResetStickyTaskList(ctx context.Context, request *history.ResetStickyTaskListRequest, opts ...yarpc.CallOption) (*history.ResetStickyTaskListResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -343,6 +389,31 @@ func (_m *HistoryClient) TerminateWorkflowExecution(ctx context.Context, termina
 	return r0
 }
 
+// ResetWorkflowExecution provides a mock function with given fields: ctx, resetRequest
+func (_m *HistoryClient) ResetWorkflowExecution(ctx context.Context,
+	resetRequest *history.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.ResetWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, resetRequest)
+
+	var r0 *shared.ResetWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, resetRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ResetWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.ResetWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, resetRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ScheduleDecisionTask provides a mock function with given fields: ctx, request
 func (_m *HistoryClient) ScheduleDecisionTask(ctx context.Context, request *history.ScheduleDecisionTaskRequest, opts ...yarpc.CallOption) error {
 	ret := _m.Called(ctx, request)
@@ -384,3 +455,95 @@ func (_m *HistoryClient) ReplicateEvents(ctx context.Context, request *history.R
 
 	return r0
 }
+
+// GetReplicationMessages provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetReplicationMessages(ctx context.Context, request *history.GetReplicationMessagesRequest, opts ...yarpc.CallOption) (*history.GetReplicationMessagesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.GetReplicationMessagesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.GetReplicationMessagesRequest) *history.GetReplicationMessagesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.GetReplicationMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.GetReplicationMessagesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplicationStatus provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetReplicationStatus(ctx context.Context, request *history.GetReplicationStatusRequest, opts ...yarpc.CallOption) (*history.ShardReplicationStatus, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.ShardReplicationStatus
+	if rf, ok := ret.Get(0).(func(context.Context, *history.GetReplicationStatusRequest) *history.ShardReplicationStatus); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.ShardReplicationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.GetReplicationStatusRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDomainReplicationLag provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetDomainReplicationLag(ctx context.Context, request *history.GetDomainReplicationLagRequest, opts ...yarpc.CallOption) (*history.GetDomainReplicationLagResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.GetDomainReplicationLagResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.GetDomainReplicationLagRequest) *history.GetDomainReplicationLagResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.GetDomainReplicationLagResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.GetDomainReplicationLagRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkflowReplicationTasks provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) GetWorkflowReplicationTasks(ctx context.Context, request *history.GetWorkflowReplicationTasksRequest, opts ...yarpc.CallOption) (*history.GetWorkflowReplicationTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *history.GetWorkflowReplicationTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.GetWorkflowReplicationTasksRequest) *history.GetWorkflowReplicationTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*history.GetWorkflowReplicationTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.GetWorkflowReplicationTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
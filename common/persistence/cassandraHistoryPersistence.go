@@ -24,10 +24,10 @@ import (
 	"fmt"
 
 	"github.com/gocql/gocql"
-	"github.com/uber-common/bark"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 )
 
 const (
@@ -56,13 +56,13 @@ const (
 type (
 	cassandraHistoryPersistence struct {
 		session *gocql.Session
-		logger  bark.Logger
+		logger  logging.Logger
 	}
 )
 
 // NewCassandraHistoryPersistence is used to create an instance of HistoryManager implementation
 func NewCassandraHistoryPersistence(hosts string, port int, user, password, dc string, keyspace string,
-	numConns int, logger bark.Logger) (HistoryManager,
+	numConns int, logger logging.Logger) (HistoryManager,
 	error) {
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
@@ -54,7 +54,7 @@ func (s *historyPersistenceSuite) SetupSuite() {
 		log.SetOutput(os.Stdout)
 	}
 
-	s.SetupWorkflowStore()
+	s.SetupWorkflowStoreWithOptions(testStoreOptions())
 }
 
 func (s *historyPersistenceSuite) SetupTest() {
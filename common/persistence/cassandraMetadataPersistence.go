@@ -24,10 +24,11 @@ import (
 	"fmt"
 
 	"github.com/gocql/gocql"
-	"github.com/uber-common/bark"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 )
 
 const (
@@ -41,12 +42,17 @@ const (
 
 	templateDomainConfigType = `{` +
 		`retention: ?, ` +
-		`emit_metric: ?` +
+		`emit_metric: ?, ` +
+		`archival_bucket: ?, ` +
+		`archival_status: ?` +
 		`}`
 
 	templateDomainReplicationConfigType = `{` +
 		`active_cluster_name: ?, ` +
-		`clusters: ? ` +
+		`clusters: ?, ` +
+		`handover_cluster_name: ?, ` +
+		`handover_start_time: ?, ` +
+		`handover_expiry_time: ? ` +
 		`}`
 
 	templateCreateDomainQuery = `INSERT INTO domains (` +
@@ -61,15 +67,19 @@ const (
 		`FROM domains ` +
 		`WHERE id = ?`
 
-	templateGetDomainByNameQuery = `SELECT domain.id, domain.name, domain.status, domain.description, ` +
+	templateListDomainQuery = `SELECT domain.id, domain.name, domain.status, domain.description, ` +
 		`domain.owner_email, config.retention, config.emit_metric, ` +
+		`config.archival_bucket, config.archival_status, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
+		`replication_config.handover_cluster_name, replication_config.handover_start_time, ` +
+		`replication_config.handover_expiry_time, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
 		`failover_version, ` +
 		`db_version ` +
-		`FROM domains_by_name ` +
-		`WHERE name = ?`
+		`FROM domains_by_name `
+
+	templateGetDomainByNameQuery = templateListDomainQuery + `WHERE name = ?`
 
 	templateUpdateDomainByNameQuery = `UPDATE domains_by_name ` +
 		`SET domain = ` + templateDomainType + `, ` +
@@ -92,13 +102,13 @@ type (
 	cassandraMetadataPersistence struct {
 		session            *gocql.Session
 		currentClusterName string
-		logger             bark.Logger
+		logger             logging.Logger
 	}
 )
 
 // NewCassandraMetadataPersistence is used to create an instance of HistoryManager implementation
 func NewCassandraMetadataPersistence(hosts string, port int, user, password, dc string, keyspace string,
-	currentClusterName string, logger bark.Logger) (MetadataManager,
+	currentClusterName string, logger logging.Logger) (MetadataManager,
 	error) {
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
@@ -146,8 +156,13 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *CreateDomainRequest
 		request.Info.OwnerEmail,
 		request.Config.Retention,
 		request.Config.EmitMetric,
+		request.Config.ArchivalBucket,
+		request.Config.ArchivalStatus,
 		request.ReplicationConfig.ActiveClusterName,
 		serializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.ReplicationConfig.HandoverClusterName,
+		request.ReplicationConfig.HandoverStartTime,
+		request.ReplicationConfig.HandoverExpiryTime,
 		request.IsGlobalDomain,
 		request.ConfigVersion,
 		request.FailoverVersion,
@@ -165,7 +180,7 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *CreateDomainRequest
 	if !applied {
 		// Domain already exist.  Delete orphan domain record before returning back to user
 		if errDelete := m.session.Query(templateDeleteDomainQuery, request.Info.ID).Exec(); errDelete != nil {
-			m.logger.Warnf("Unable to delete orphan domain record. Error: %v", errDelete)
+			m.logger.Warn("Unable to delete orphan domain record", tag.Error(errDelete))
 		}
 
 		if domain, ok := previous["domain"].(map[string]interface{}); ok {
@@ -186,14 +201,6 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *CreateDomainRequest
 func (m *cassandraMetadataPersistence) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
 	var query *gocql.Query
 	var err error
-	info := &DomainInfo{}
-	config := &DomainConfig{}
-	replicationConfig := &DomainReplicationConfig{}
-	var replicationClusters []map[string]interface{}
-	var dbVersion int64
-	var failoverVersion int64
-	var configVersion int64
-	var isGlobalDomain bool
 
 	if len(request.ID) > 0 && len(request.Name) > 0 {
 		return nil, &workflow.BadRequestError{
@@ -230,7 +237,59 @@ func (m *cassandraMetadataPersistence) GetDomain(request *GetDomainRequest) (*Ge
 	}
 
 	query = m.session.Query(templateGetDomainByNameQuery, domainName)
-	err = query.Scan(
+	columns, domain := m.newDomainRow()
+	err = query.Scan(columns...)
+
+	if err != nil {
+		return nil, handleError(request.Name, request.ID, err)
+	}
+
+	return domain(), nil
+}
+
+func (m *cassandraMetadataPersistence) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	query := m.session.Query(templateListDomainQuery)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListDomains operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &ListDomainsResponse{}
+	for {
+		columns, domain := m.newDomainRow()
+		if !iter.Scan(columns...) {
+			break
+		}
+		response.Domains = append(response.Domains, domain())
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListDomains operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+// newDomainRow returns the destinations of the columns of a row of domains_by_name, and a function building the
+// domain once the row is scanned
+func (m *cassandraMetadataPersistence) newDomainRow() ([]interface{}, func() *GetDomainResponse) {
+	info := &DomainInfo{}
+	config := &DomainConfig{}
+	replicationConfig := &DomainReplicationConfig{}
+	var replicationClusters []map[string]interface{}
+	var dbVersion int64
+	var failoverVersion int64
+	var configVersion int64
+	var isGlobalDomain bool
+
+	columns := []interface{}{
 		&info.ID,
 		&info.Name,
 		&info.Status,
@@ -238,31 +297,34 @@ func (m *cassandraMetadataPersistence) GetDomain(request *GetDomainRequest) (*Ge
 		&info.OwnerEmail,
 		&config.Retention,
 		&config.EmitMetric,
+		&config.ArchivalBucket,
+		&config.ArchivalStatus,
 		&replicationConfig.ActiveClusterName,
 		&replicationClusters,
+		&replicationConfig.HandoverClusterName,
+		&replicationConfig.HandoverStartTime,
+		&replicationConfig.HandoverExpiryTime,
 		&isGlobalDomain,
 		&configVersion,
 		&failoverVersion,
 		&dbVersion,
-	)
-
-	if err != nil {
-		return nil, handleError(request.Name, request.ID, err)
 	}
 
-	replicationConfig.ActiveClusterName = GetOrUseDefaultActiveCluster(m.currentClusterName, replicationConfig.ActiveClusterName)
-	replicationConfig.Clusters = deserializeClusterConfigs(replicationClusters)
-	replicationConfig.Clusters = GetOrUseDefaultClusters(m.currentClusterName, replicationConfig.Clusters)
-
-	return &GetDomainResponse{
-		Info:              info,
-		Config:            config,
-		ReplicationConfig: replicationConfig,
-		IsGlobalDomain:    isGlobalDomain,
-		ConfigVersion:     configVersion,
-		FailoverVersion:   failoverVersion,
-		DBVersion:         dbVersion,
-	}, nil
+	return columns, func() *GetDomainResponse {
+		replicationConfig.ActiveClusterName = GetOrUseDefaultActiveCluster(m.currentClusterName, replicationConfig.ActiveClusterName)
+		replicationConfig.Clusters = deserializeClusterConfigs(replicationClusters)
+		replicationConfig.Clusters = GetOrUseDefaultClusters(m.currentClusterName, replicationConfig.Clusters)
+
+		return &GetDomainResponse{
+			Info:              info,
+			Config:            config,
+			ReplicationConfig: replicationConfig,
+			IsGlobalDomain:    isGlobalDomain,
+			ConfigVersion:     configVersion,
+			FailoverVersion:   failoverVersion,
+			DBVersion:         dbVersion,
+		}
+	}
 }
 
 func (m *cassandraMetadataPersistence) UpdateDomain(request *UpdateDomainRequest) error {
@@ -280,8 +342,13 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *UpdateDomainRequest
 		request.Info.OwnerEmail,
 		request.Config.Retention,
 		request.Config.EmitMetric,
+		request.Config.ArchivalBucket,
+		request.Config.ArchivalStatus,
 		request.ReplicationConfig.ActiveClusterName,
 		serializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.ReplicationConfig.HandoverClusterName,
+		request.ReplicationConfig.HandoverStartTime,
+		request.ReplicationConfig.HandoverExpiryTime,
 		request.ConfigVersion,
 		request.FailoverVersion,
 		nextVersion,
@@ -23,6 +23,7 @@ package persistence
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
@@ -53,7 +54,7 @@ func (m *metadataPersistenceSuite) SetupSuite() {
 		log.SetOutput(os.Stdout)
 	}
 
-	m.SetupWorkflowStore()
+	m.SetupWorkflowStoreWithOptions(testStoreOptions())
 }
 
 func (m *metadataPersistenceSuite) SetupTest() {
@@ -149,6 +150,8 @@ func (m *metadataPersistenceSuite) TestGetDomain() {
 	owner := "get-domain-test-owner"
 	retention := int32(10)
 	emitMetric := true
+	archivalBucket := "get-domain-test-archival-bucket"
+	archivalStatus := gen.ArchivalStatusEnabled
 
 	clusterActive := "some random active cluster name"
 	clusterStandby := "some random standby cluster name"
@@ -178,8 +181,10 @@ func (m *metadataPersistenceSuite) TestGetDomain() {
 			OwnerEmail:  owner,
 		},
 		&DomainConfig{
-			Retention:  retention,
-			EmitMetric: emitMetric,
+			Retention:      retention,
+			EmitMetric:     emitMetric,
+			ArchivalBucket: archivalBucket,
+			ArchivalStatus: archivalStatus,
 		},
 		&DomainReplicationConfig{
 			ActiveClusterName: clusterActive,
@@ -203,6 +208,8 @@ func (m *metadataPersistenceSuite) TestGetDomain() {
 	m.Equal(owner, resp2.Info.OwnerEmail)
 	m.Equal(retention, resp2.Config.Retention)
 	m.Equal(emitMetric, resp2.Config.EmitMetric)
+	m.Equal(archivalBucket, resp2.Config.ArchivalBucket)
+	m.Equal(archivalStatus, resp2.Config.ArchivalStatus)
 	m.Equal(clusterActive, resp2.ReplicationConfig.ActiveClusterName)
 	m.Equal(len(clusters), len(resp2.ReplicationConfig.Clusters))
 	for index := range clusters {
@@ -223,6 +230,8 @@ func (m *metadataPersistenceSuite) TestGetDomain() {
 	m.Equal(owner, resp3.Info.OwnerEmail)
 	m.Equal(retention, resp3.Config.Retention)
 	m.Equal(emitMetric, resp3.Config.EmitMetric)
+	m.Equal(archivalBucket, resp3.Config.ArchivalBucket)
+	m.Equal(archivalStatus, resp3.Config.ArchivalStatus)
 	m.Equal(clusterActive, resp3.ReplicationConfig.ActiveClusterName)
 	m.Equal(len(clusters), len(resp3.ReplicationConfig.Clusters))
 	for index := range clusters {
@@ -297,6 +306,8 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 	updateClusterStandby := "other random standby cluster name"
 	updateConfigVersion := int64(12)
 	updateFailoverVersion := int64(28)
+	updateHandoverStartTime := time.Now().Truncate(time.Millisecond)
+	updateHandoverExpiryTime := updateHandoverStartTime.Add(time.Minute)
 	updateClusters := []*ClusterReplicationConfig{
 		&ClusterReplicationConfig{
 			ClusterName: updateClusterActive,
@@ -319,8 +330,11 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 			EmitMetric: updatedEmitMetric,
 		},
 		&DomainReplicationConfig{
-			ActiveClusterName: updateClusterActive,
-			Clusters:          updateClusters,
+			ActiveClusterName:   updateClusterActive,
+			Clusters:            updateClusters,
+			HandoverClusterName: updateClusterStandby,
+			HandoverStartTime:   updateHandoverStartTime,
+			HandoverExpiryTime:  updateHandoverExpiryTime,
 		},
 		updateConfigVersion,
 		updateFailoverVersion,
@@ -344,6 +358,9 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 	for index := range clusters {
 		m.Equal(updateClusters[index], resp4.ReplicationConfig.Clusters[index])
 	}
+	m.Equal(updateClusterStandby, resp4.ReplicationConfig.HandoverClusterName)
+	m.True(updateHandoverStartTime.Equal(resp4.ReplicationConfig.HandoverStartTime))
+	m.True(updateHandoverExpiryTime.Equal(resp4.ReplicationConfig.HandoverExpiryTime))
 	m.Equal(updateConfigVersion, resp4.ConfigVersion)
 	m.Equal(updateFailoverVersion, resp4.FailoverVersion)
 	m.Equal(resp2.DBVersion+1, resp4.DBVersion)
@@ -468,6 +485,77 @@ func (m *metadataPersistenceSuite) TestDeleteDomain() {
 	m.Nil(resp9)
 }
 
+func (m *metadataPersistenceSuite) TestListDomains() {
+	clusterActive := "some random active cluster name"
+	clusterStandby := "some random standby cluster name"
+	clusters := []*ClusterReplicationConfig{
+		&ClusterReplicationConfig{
+			ClusterName: clusterActive,
+		},
+		&ClusterReplicationConfig{
+			ClusterName: clusterStandby,
+		},
+	}
+	handoverStartTime := time.Now().Truncate(time.Millisecond)
+
+	domains := map[string]*DomainReplicationConfig{
+		uuid.New(): &DomainReplicationConfig{
+			ActiveClusterName: clusterActive,
+			Clusters:          clusters,
+		},
+		uuid.New(): &DomainReplicationConfig{
+			ActiveClusterName:   clusterActive,
+			Clusters:            clusters,
+			HandoverClusterName: clusterStandby,
+			HandoverStartTime:   handoverStartTime,
+			HandoverExpiryTime:  handoverStartTime.Add(time.Minute),
+		},
+	}
+	for id, replicationConfig := range domains {
+		_, err := m.CreateDomain(
+			&DomainInfo{
+				ID:     id,
+				Name:   "list-domain-test-name-" + id,
+				Status: DomainStatusRegistered,
+			},
+			&DomainConfig{
+				Retention: 1,
+			},
+			replicationConfig,
+			true,
+			0,
+			0,
+		)
+		m.Nil(err)
+	}
+
+	listed := make(map[string]*GetDomainResponse)
+	var token []byte
+	for {
+		resp, err := m.MetadataManager.ListDomains(&ListDomainsRequest{
+			PageSize:      1,
+			NextPageToken: token,
+		})
+		m.Nil(err)
+		for _, domain := range resp.Domains {
+			listed[domain.Info.ID] = domain
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		token = resp.NextPageToken
+	}
+
+	for id, replicationConfig := range domains {
+		m.Contains(listed, id)
+		m.Equal("list-domain-test-name-"+id, listed[id].Info.Name)
+		m.True(listed[id].IsGlobalDomain)
+		m.Equal(replicationConfig.HandoverClusterName, listed[id].ReplicationConfig.HandoverClusterName)
+		m.True(replicationConfig.HandoverStartTime.Equal(listed[id].ReplicationConfig.HandoverStartTime))
+		m.Equal(2, len(listed[id].ReplicationConfig.Clusters))
+	}
+}
+
 func (m *metadataPersistenceSuite) CreateDomain(info *DomainInfo, config *DomainConfig,
 	replicationConfig *DomainReplicationConfig, isGlobaldomain bool, configVersion int64, failoverVersion int64) (*CreateDomainResponse, error) {
 	return m.MetadataManager.CreateDomain(&CreateDomainRequest{
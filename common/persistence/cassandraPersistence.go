@@ -27,10 +27,10 @@ import (
 
 	"github.com/gocql/gocql"
 	"github.com/pborman/uuid"
-	"github.com/uber-common/bark"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 )
 
 // Guidelines for creating new special UUID constants
@@ -147,7 +147,17 @@ const (
 		`sticky_schedule_to_start_timeout: ?,` +
 		`client_library_version: ?, ` +
 		`client_feature_version: ?, ` +
-		`client_impl: ?` +
+		`client_impl: ?, ` +
+		`cron_schedule: ?, ` +
+		`attempt: ?, ` +
+		`has_retry_policy: ?, ` +
+		`init_interval: ?, ` +
+		`backoff_coefficient: ?, ` +
+		`max_interval: ?, ` +
+		`expiration_time: ?, ` +
+		`max_attempts: ?, ` +
+		`non_retriable_errors: ?, ` +
+		`search_attributes: ?` +
 		`}`
 
 	templateReplicationStateType = `{` +
@@ -345,6 +355,17 @@ const (
 		`shard_id, domain_id, workflow_id, run_id, type, execution, replication_state, next_event_id, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateWorkflowExecutionType + `, ` + templateReplicationStateType + `, ?, ?, ?) `
 
+	templateCreateMutableStateMapsQuery = `UPDATE executions ` +
+		`SET activity_map = ?, timer_map = ?, child_executions_map = ?, request_cancel_map = ?, signal_map = ?, ` +
+		`signal_requested = ? ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
 	templateCreateTransferTaskQuery = `INSERT INTO executions (` +
 		`shard_id, type, domain_id, workflow_id, run_id, transfer, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateTransferTaskType + `, ?, ?)`
@@ -801,13 +822,13 @@ type (
 		session            *gocql.Session
 		shardID            int
 		currentClusterName string
-		logger             bark.Logger
+		logger             logging.Logger
 	}
 )
 
 // NewCassandraShardPersistence is used to create an instance of ShardManager implementation
 func NewCassandraShardPersistence(hosts string, port int, user, password, dc string, keyspace string,
-	currentClusterName string, logger bark.Logger) (ShardManager, error) {
+	currentClusterName string, logger logging.Logger) (ShardManager, error) {
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
 	cluster.ProtoVersion = cassandraProtoVersion
@@ -825,13 +846,13 @@ func NewCassandraShardPersistence(hosts string, port int, user, password, dc str
 
 // NewCassandraWorkflowExecutionPersistence is used to create an instance of workflowExecutionManager implementation
 func NewCassandraWorkflowExecutionPersistence(shardID int, session *gocql.Session,
-	logger bark.Logger) (ExecutionManager, error) {
+	logger logging.Logger) (ExecutionManager, error) {
 	return &cassandraPersistence{shardID: shardID, session: session, logger: logger}, nil
 }
 
 // NewCassandraTaskPersistence is used to create an instance of TaskManager implementation
 func NewCassandraTaskPersistence(hosts string, port int, user, password, dc string, keyspace string,
-	logger bark.Logger) (TaskManager, error) {
+	logger logging.Logger) (TaskManager, error) {
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
 	cluster.ProtoVersion = cassandraProtoVersion
@@ -1153,6 +1174,11 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 		)
 	}
 
+	if request.ResetSnapshot != nil {
+		d.createWorkflowExecutionFromSnapshot(batch, request.ResetSnapshot, cqlNowTimestamp)
+		return
+	}
+
 	if request.ReplicationState == nil {
 		// Cross DC feature is currently disabled so we will be creating workflow executions without replication state
 		batch.Query(templateCreateWorkflowExecutionQuery2,
@@ -1196,6 +1222,16 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			"", // client_library_version
 			"", // client_feature_version
 			"", // client_impl
+			request.CronSchedule,
+			request.Attempt,
+			request.HasRetryPolicy,
+			request.InitialInterval,
+			request.BackoffCoefficient,
+			request.MaximumInterval,
+			request.ExpirationTime,
+			request.MaximumAttempts,
+			request.NonRetriableErrors,
+			request.SearchAttributes,
 			request.NextEventID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
@@ -1246,6 +1282,16 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			"", // client_library_version
 			"", // client_feature_version
 			"", // client_impl
+			request.CronSchedule,
+			request.Attempt,
+			request.HasRetryPolicy,
+			request.InitialInterval,
+			request.BackoffCoefficient,
+			request.MaximumInterval,
+			request.ExpirationTime,
+			request.MaximumAttempts,
+			request.NonRetriableErrors,
+			request.SearchAttributes,
 			request.ReplicationState.CurrentVersion,
 			request.ReplicationState.StartVersion,
 			request.ReplicationState.LastWriteVersion,
@@ -1257,6 +1303,100 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 	}
 }
 
+// createWorkflowExecutionFromSnapshot inserts the execution row with the complete mutable state of the snapshot, the
+// row is created by the batch so its maps are written without condition
+func (d *cassandraPersistence) createWorkflowExecutionFromSnapshot(batch *gocql.Batch,
+	snapshot *ResetMutableStateRequest, cqlNowTimestamp int64) {
+	executionInfo := snapshot.ExecutionInfo
+	args := []interface{}{
+		d.shardID,
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+		rowTypeExecution,
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+		executionInfo.ParentDomainID,
+		executionInfo.ParentWorkflowID,
+		executionInfo.ParentRunID,
+		executionInfo.InitiatedID,
+		executionInfo.CompletionEvent,
+		executionInfo.TaskList,
+		executionInfo.WorkflowTypeName,
+		executionInfo.WorkflowTimeout,
+		executionInfo.DecisionTimeoutValue,
+		executionInfo.ExecutionContext,
+		executionInfo.State,
+		executionInfo.CloseStatus,
+		executionInfo.LastFirstEventID,
+		executionInfo.NextEventID,
+		executionInfo.LastProcessedEvent,
+		executionInfo.StartTimestamp,
+		cqlNowTimestamp,
+		executionInfo.CreateRequestID,
+		executionInfo.DecisionVersion,
+		executionInfo.DecisionScheduleID,
+		executionInfo.DecisionStartedID,
+		executionInfo.DecisionRequestID,
+		executionInfo.DecisionTimeout,
+		executionInfo.DecisionAttempt,
+		executionInfo.DecisionTimestamp,
+		executionInfo.CancelRequested,
+		executionInfo.CancelRequestID,
+		executionInfo.StickyTaskList,
+		executionInfo.StickyScheduleToStartTimeout,
+		executionInfo.ClientLibraryVersion,
+		executionInfo.ClientFeatureVersion,
+		executionInfo.ClientImpl,
+		executionInfo.CronSchedule,
+		executionInfo.Attempt,
+		executionInfo.HasRetryPolicy,
+		executionInfo.InitialInterval,
+		executionInfo.BackoffCoefficient,
+		executionInfo.MaximumInterval,
+		executionInfo.ExpirationTime,
+		executionInfo.MaximumAttempts,
+		executionInfo.NonRetriableErrors,
+		executionInfo.SearchAttributes,
+	}
+
+	if replicationState := snapshot.ReplicationState; replicationState == nil {
+		args = append(args, executionInfo.NextEventID, defaultVisibilityTimestamp, rowTypeExecutionTaskID)
+		batch.Query(templateCreateWorkflowExecutionQuery2, args...)
+	} else {
+		lastReplicationInfo := make(map[string]map[string]interface{})
+		for k, v := range replicationState.LastReplicationInfo {
+			lastReplicationInfo[k] = createReplicationInfoMap(v)
+		}
+		args = append(args,
+			replicationState.CurrentVersion,
+			replicationState.StartVersion,
+			replicationState.LastWriteVersion,
+			replicationState.LastWriteEventID,
+			lastReplicationInfo,
+			executionInfo.NextEventID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID)
+		batch.Query(templateCreateWorkflowExecutionWithReplicationQuery, args...)
+	}
+
+	batch.Query(templateCreateMutableStateMapsQuery,
+		resetActivityInfoMap(snapshot.InsertActivityInfos),
+		resetTimerInfoMap(snapshot.InsertTimerInfos),
+		resetChildExecutionInfoMap(snapshot.InsertChildExecutionInfos),
+		resetRequestCancelInfoMap(snapshot.InsertRequestCancelInfos),
+		resetSignalInfoMap(snapshot.InsertSignalInfos),
+		snapshot.InsertSignalRequestedIDs,
+		d.shardID,
+		rowTypeExecution,
+		executionInfo.DomainID,
+		executionInfo.WorkflowID,
+		executionInfo.RunID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID)
+}
+
 func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
 	*GetWorkflowExecutionResponse, error) {
 	execution := request.Execution
@@ -1404,6 +1544,16 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 			executionInfo.ClientLibraryVersion,
 			executionInfo.ClientFeatureVersion,
 			executionInfo.ClientImpl,
+			executionInfo.CronSchedule,
+			executionInfo.Attempt,
+			executionInfo.HasRetryPolicy,
+			executionInfo.InitialInterval,
+			executionInfo.BackoffCoefficient,
+			executionInfo.MaximumInterval,
+			executionInfo.ExpirationTime,
+			executionInfo.MaximumAttempts,
+			executionInfo.NonRetriableErrors,
+			executionInfo.SearchAttributes,
 			executionInfo.NextEventID,
 			d.shardID,
 			rowTypeExecution,
@@ -1455,6 +1605,16 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 			executionInfo.ClientLibraryVersion,
 			executionInfo.ClientFeatureVersion,
 			executionInfo.ClientImpl,
+			executionInfo.CronSchedule,
+			executionInfo.Attempt,
+			executionInfo.HasRetryPolicy,
+			executionInfo.InitialInterval,
+			executionInfo.BackoffCoefficient,
+			executionInfo.MaximumInterval,
+			executionInfo.ExpirationTime,
+			executionInfo.MaximumAttempts,
+			executionInfo.NonRetriableErrors,
+			executionInfo.SearchAttributes,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -1630,61 +1790,130 @@ func (d *cassandraPersistence) ResetMutableState(request *ResetMutableStateReque
 	executionInfo := request.ExecutionInfo
 	replicationState := request.ReplicationState
 
-	lastReplicationInfo := make(map[string]map[string]interface{})
-	for k, v := range replicationState.LastReplicationInfo {
-		lastReplicationInfo[k] = createReplicationInfoMap(v)
-	}
+	if replicationState == nil {
+		// Resets will be called with null ReplicationState for executions of local domains
+		batch.Query(templateUpdateWorkflowExecutionQuery,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+			executionInfo.ParentDomainID,
+			executionInfo.ParentWorkflowID,
+			executionInfo.ParentRunID,
+			executionInfo.InitiatedID,
+			executionInfo.CompletionEvent,
+			executionInfo.TaskList,
+			executionInfo.WorkflowTypeName,
+			executionInfo.WorkflowTimeout,
+			executionInfo.DecisionTimeoutValue,
+			executionInfo.ExecutionContext,
+			executionInfo.State,
+			executionInfo.CloseStatus,
+			executionInfo.LastFirstEventID,
+			executionInfo.NextEventID,
+			executionInfo.LastProcessedEvent,
+			executionInfo.StartTimestamp,
+			cqlNowTimestamp,
+			executionInfo.CreateRequestID,
+			executionInfo.DecisionVersion,
+			executionInfo.DecisionScheduleID,
+			executionInfo.DecisionStartedID,
+			executionInfo.DecisionRequestID,
+			executionInfo.DecisionTimeout,
+			executionInfo.DecisionAttempt,
+			executionInfo.DecisionTimestamp,
+			executionInfo.CancelRequested,
+			executionInfo.CancelRequestID,
+			executionInfo.StickyTaskList,
+			executionInfo.StickyScheduleToStartTimeout,
+			executionInfo.ClientLibraryVersion,
+			executionInfo.ClientFeatureVersion,
+			executionInfo.ClientImpl,
+			executionInfo.CronSchedule,
+			executionInfo.Attempt,
+			executionInfo.HasRetryPolicy,
+			executionInfo.InitialInterval,
+			executionInfo.BackoffCoefficient,
+			executionInfo.MaximumInterval,
+			executionInfo.ExpirationTime,
+			executionInfo.MaximumAttempts,
+			executionInfo.NonRetriableErrors,
+			executionInfo.SearchAttributes,
+			executionInfo.NextEventID,
+			d.shardID,
+			rowTypeExecution,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID,
+			request.Condition)
+	} else {
+		lastReplicationInfo := make(map[string]map[string]interface{})
+		for k, v := range replicationState.LastReplicationInfo {
+			lastReplicationInfo[k] = createReplicationInfoMap(v)
+		}
 
-	batch.Query(templateUpdateWorkflowExecutionWithReplicationQuery,
-		executionInfo.DomainID,
-		executionInfo.WorkflowID,
-		executionInfo.RunID,
-		executionInfo.ParentDomainID,
-		executionInfo.ParentWorkflowID,
-		executionInfo.ParentRunID,
-		executionInfo.InitiatedID,
-		executionInfo.CompletionEvent,
-		executionInfo.TaskList,
-		executionInfo.WorkflowTypeName,
-		executionInfo.WorkflowTimeout,
-		executionInfo.DecisionTimeoutValue,
-		executionInfo.ExecutionContext,
-		executionInfo.State,
-		executionInfo.CloseStatus,
-		executionInfo.LastFirstEventID,
-		executionInfo.NextEventID,
-		executionInfo.LastProcessedEvent,
-		executionInfo.StartTimestamp,
-		cqlNowTimestamp,
-		executionInfo.CreateRequestID,
-		executionInfo.DecisionVersion,
-		executionInfo.DecisionScheduleID,
-		executionInfo.DecisionStartedID,
-		executionInfo.DecisionRequestID,
-		executionInfo.DecisionTimeout,
-		executionInfo.DecisionAttempt,
-		executionInfo.DecisionTimestamp,
-		executionInfo.CancelRequested,
-		executionInfo.CancelRequestID,
-		executionInfo.StickyTaskList,
-		executionInfo.StickyScheduleToStartTimeout,
-		executionInfo.ClientLibraryVersion,
-		executionInfo.ClientFeatureVersion,
-		executionInfo.ClientImpl,
-		replicationState.CurrentVersion,
-		replicationState.StartVersion,
-		replicationState.LastWriteVersion,
-		replicationState.LastWriteEventID,
-		lastReplicationInfo,
-		executionInfo.NextEventID,
-		d.shardID,
-		rowTypeExecution,
-		executionInfo.DomainID,
-		executionInfo.WorkflowID,
-		executionInfo.RunID,
-		defaultVisibilityTimestamp,
-		rowTypeExecutionTaskID,
-		request.Condition)
+		batch.Query(templateUpdateWorkflowExecutionWithReplicationQuery,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+			executionInfo.ParentDomainID,
+			executionInfo.ParentWorkflowID,
+			executionInfo.ParentRunID,
+			executionInfo.InitiatedID,
+			executionInfo.CompletionEvent,
+			executionInfo.TaskList,
+			executionInfo.WorkflowTypeName,
+			executionInfo.WorkflowTimeout,
+			executionInfo.DecisionTimeoutValue,
+			executionInfo.ExecutionContext,
+			executionInfo.State,
+			executionInfo.CloseStatus,
+			executionInfo.LastFirstEventID,
+			executionInfo.NextEventID,
+			executionInfo.LastProcessedEvent,
+			executionInfo.StartTimestamp,
+			cqlNowTimestamp,
+			executionInfo.CreateRequestID,
+			executionInfo.DecisionVersion,
+			executionInfo.DecisionScheduleID,
+			executionInfo.DecisionStartedID,
+			executionInfo.DecisionRequestID,
+			executionInfo.DecisionTimeout,
+			executionInfo.DecisionAttempt,
+			executionInfo.DecisionTimestamp,
+			executionInfo.CancelRequested,
+			executionInfo.CancelRequestID,
+			executionInfo.StickyTaskList,
+			executionInfo.StickyScheduleToStartTimeout,
+			executionInfo.ClientLibraryVersion,
+			executionInfo.ClientFeatureVersion,
+			executionInfo.ClientImpl,
+			executionInfo.CronSchedule,
+			executionInfo.Attempt,
+			executionInfo.HasRetryPolicy,
+			executionInfo.InitialInterval,
+			executionInfo.BackoffCoefficient,
+			executionInfo.MaximumInterval,
+			executionInfo.ExpirationTime,
+			executionInfo.MaximumAttempts,
+			executionInfo.NonRetriableErrors,
+			executionInfo.SearchAttributes,
+			replicationState.CurrentVersion,
+			replicationState.StartVersion,
+			replicationState.LastWriteVersion,
+			replicationState.LastWriteEventID,
+			lastReplicationInfo,
+			executionInfo.NextEventID,
+			d.shardID,
+			rowTypeExecution,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			executionInfo.RunID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID,
+			request.Condition)
+	}
 
 	d.resetActivityInfos(batch, request.InsertActivityInfos, executionInfo.DomainID, executionInfo.WorkflowID,
 		executionInfo.RunID, request.Condition)
@@ -2416,7 +2645,7 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 			targetWorkflowID = task.(*StartChildExecutionTask).TargetWorkflowID
 			scheduleID = task.(*StartChildExecutionTask).InitiatedID
 
-		case TransferTaskTypeCloseExecution:
+		case TransferTaskTypeCloseExecution, TransferTaskTypeUpsertWorkflowSearchAttributes:
 			// No explicit property needs to be set
 
 		default:
@@ -2513,6 +2742,8 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 		case *RetryTimerTask:
 			eventID = t.EventID
 			attempt = int64(t.Attempt)
+		case *WorkflowBackoffTimerTask:
+			timeoutType = t.TimeoutType
 		}
 
 		ts := common.UnixNanoToCQLTimestamp(GetVisibilityTSFrom(task).UnixNano())
@@ -3072,6 +3303,26 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *WorkflowExecuti
 			info.ClientFeatureVersion = v.(string)
 		case "client_impl":
 			info.ClientImpl = v.(string)
+		case "cron_schedule":
+			info.CronSchedule = v.(string)
+		case "attempt":
+			info.Attempt = int32(v.(int))
+		case "has_retry_policy":
+			info.HasRetryPolicy = v.(bool)
+		case "init_interval":
+			info.InitialInterval = int32(v.(int))
+		case "backoff_coefficient":
+			info.BackoffCoefficient = v.(float64)
+		case "max_interval":
+			info.MaximumInterval = int32(v.(int))
+		case "max_attempts":
+			info.MaximumAttempts = int32(v.(int))
+		case "expiration_time":
+			info.ExpirationTime = v.(time.Time)
+		case "non_retriable_errors":
+			info.NonRetriableErrors = v.([]string)
+		case "search_attributes":
+			info.SearchAttributes = v.(map[string][]byte)
 		}
 	}
 
@@ -3446,16 +3697,18 @@ func resetSignalInfoMap(signalInfos []*SignalInfo) map[int64]map[string]interfac
 }
 
 func createSerializedHistoryEventBatch(result map[string]interface{}) *SerializedHistoryEventBatch {
-	// TODO: default to JSON, update this when we support different encoding types.
-	eventBatch := &SerializedHistoryEventBatch{EncodingType: common.EncodingTypeJSON}
+	eventBatch := &SerializedHistoryEventBatch{}
 	for k, v := range result {
 		switch k {
+		case "encoding_type":
+			eventBatch.EncodingType = common.EncodingType(v.(string))
 		case "version":
 			eventBatch.Version = v.(int)
 		case "data":
 			eventBatch.Data = v.([]byte)
 		}
 	}
+	SetSerializedHistoryDefaults(eventBatch)
 
 	return eventBatch
 }
@@ -3569,6 +3822,9 @@ func GetVisibilityTSFrom(task Task) time.Time {
 
 	case TaskTypeRetryTimer:
 		return task.(*RetryTimerTask).VisibilityTimestamp
+
+	case TaskTypeWorkflowBackoffTimer:
+		return task.(*WorkflowBackoffTimerTask).VisibilityTimestamp
 	}
 	return time.Time{}
 }
@@ -3593,5 +3849,8 @@ func SetVisibilityTSFrom(task Task, t time.Time) {
 
 	case TaskTypeRetryTimer:
 		task.(*RetryTimerTask).VisibilityTimestamp = t
+
+	case TaskTypeWorkflowBackoffTimer:
+		task.(*WorkflowBackoffTimerTask).VisibilityTimestamp = t
 	}
 }
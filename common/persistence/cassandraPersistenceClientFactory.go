@@ -22,8 +22,9 @@ package persistence
 
 import (
 	"github.com/gocql/gocql"
-	"github.com/uber-common/bark"
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
 )
 
@@ -31,13 +32,15 @@ type (
 	cassandraPersistenceClientFactory struct {
 		session       *gocql.Session
 		metricsClient metrics.Client
-		logger        bark.Logger
+		tracer        opentracing.Tracer
+		logger        logging.Logger
 	}
 )
 
 // NewCassandraPersistenceClientFactory is used to create an instance of ExecutionManagerFactory implementation
 func NewCassandraPersistenceClientFactory(hosts string, port int, user, password, dc string, keyspace string,
-	numConns int, logger bark.Logger, metricsClient metrics.Client) (ExecutionManagerFactory, error) {
+	numConns int, logger logging.Logger, metricsClient metrics.Client,
+	tracer opentracing.Tracer) (ExecutionManagerFactory, error) {
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
 	cluster.ProtoVersion = cassandraProtoVersion
@@ -51,7 +54,8 @@ func NewCassandraPersistenceClientFactory(hosts string, port int, user, password
 		return nil, err
 	}
 
-	return &cassandraPersistenceClientFactory{session: session, logger: logger, metricsClient: metricsClient}, nil
+	return &cassandraPersistenceClientFactory{session: session, logger: logger, metricsClient: metricsClient,
+		tracer: tracer}, nil
 }
 
 // CreateExecutionManager implements ExecutionManagerFactory interface
@@ -66,7 +70,7 @@ func (f *cassandraPersistenceClientFactory) CreateExecutionManager(shardID int)
 		return mgr, nil
 	}
 
-	return NewWorkflowExecutionPersistenceClient(mgr, f.metricsClient), nil
+	return NewWorkflowExecutionPersistenceClient(mgr, f.metricsClient, f.tracer), nil
 }
 
 // Close releases the underlying resources held by this object
@@ -59,7 +59,7 @@ func (s *cassandraPersistenceSuite) SetupSuite() {
 		log.SetOutput(os.Stdout)
 	}
 
-	s.SetupWorkflowStore()
+	s.SetupWorkflowStoreWithOptions(testStoreOptions())
 }
 
 func (s *cassandraPersistenceSuite) TearDownSuite() {
@@ -1579,6 +1579,75 @@ func (s *cassandraPersistenceSuite) TestContinueAsNew() {
 	s.Equal(*newWorkflowExecution.RunId, newRunID)
 }
 
+func (s *cassandraPersistenceSuite) TestCreateWorkflowExecutionWithResetSnapshot() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("create-workflow-reset-snapshot-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	requestID := uuid.New()
+	now := time.Now()
+	executionInfo := &WorkflowExecutionInfo{
+		DomainID:             domainID,
+		WorkflowID:           workflowExecution.GetWorkflowId(),
+		RunID:                workflowExecution.GetRunId(),
+		ParentDomainID:       emptyDomainID,
+		ParentRunID:          emptyRunID,
+		InitiatedID:          emptyInitiatedID,
+		TaskList:             "queue1",
+		WorkflowTypeName:     "wType",
+		WorkflowTimeout:      20,
+		DecisionTimeoutValue: 13,
+		State:                WorkflowStateRunning,
+		CloseStatus:          WorkflowCloseStatusNone,
+		LastFirstEventID:     8,
+		NextEventID:          10,
+		LastProcessedEvent:   4,
+		StartTimestamp:       now,
+		LastUpdatedTimestamp: now,
+		CreateRequestID:      requestID,
+		DecisionScheduleID:   9,
+		DecisionStartedID:    common.EmptyEventID,
+		DecisionTimeout:      13,
+	}
+	_, err := s.WorkflowMgr.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{
+		RequestID:          requestID,
+		DomainID:           domainID,
+		Execution:          workflowExecution,
+		TaskList:           "queue1",
+		WorkflowTypeName:   "wType",
+		NextEventID:        10,
+		RangeID:            s.ShardInfo.RangeID,
+		DecisionScheduleID: 9,
+		DecisionStartedID:  common.EmptyEventID,
+		ResetSnapshot: &ResetMutableStateRequest{
+			ExecutionInfo:            executionInfo,
+			InsertActivityInfos:      []*ActivityInfo{{ScheduleID: 5, StartedID: common.EmptyEventID, ActivityID: "activity1"}},
+			InsertTimerInfos:         []*TimerInfo{{TimerID: "timer1", StartedID: 6, ExpiryTime: now, TaskID: 1}},
+			InsertSignalRequestedIDs: []string{uuid.New()},
+		},
+	})
+	s.Nil(err)
+
+	state, err := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err)
+	s.Equal(WorkflowStateRunning, state.ExecutionInfo.State)
+	s.Equal(int64(10), state.ExecutionInfo.NextEventID)
+	s.Equal(int64(8), state.ExecutionInfo.LastFirstEventID)
+	s.Equal(int64(4), state.ExecutionInfo.LastProcessedEvent)
+	s.Equal(int64(9), state.ExecutionInfo.DecisionScheduleID)
+	s.Equal(requestID, state.ExecutionInfo.CreateRequestID)
+	s.Equal(1, len(state.ActivitInfos))
+	s.Equal("activity1", state.ActivitInfos[5].ActivityID)
+	s.Equal(1, len(state.TimerInfos))
+	s.Equal(int64(6), state.TimerInfos["timer1"].StartedID)
+	s.Equal(1, len(state.SignalRequestedIDs))
+
+	runID, err := s.GetCurrentWorkflowRunID(domainID, workflowExecution.GetWorkflowId())
+	s.Nil(err)
+	s.Equal(workflowExecution.GetRunId(), runID)
+}
+
 func (s *cassandraPersistenceSuite) TestReplicationTransferTaskTasks() {
 	domainID := "2466d7de-6602-4ad8-b939-fb8f8c36c711"
 	workflowExecution := gen.WorkflowExecution{
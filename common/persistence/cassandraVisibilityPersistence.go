@@ -22,13 +22,14 @@ package persistence
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/gocql/gocql"
-	"github.com/uber-common/bark"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 )
 
 // Fixed domain values for now
@@ -40,8 +41,8 @@ const (
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO open_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name) ` +
-		`VALUES (?, ?, ?, ?, ?, ?) using TTL ?`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?) using TTL ?`
 
 	templateDeleteWorkflowExecutionStarted = `DELETE FROM open_executions ` +
 		`WHERE domain_id = ? ` +
@@ -50,24 +51,24 @@ const (
 		`AND run_id = ?`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO closed_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
 
-	templateGetOpenWorkflowExecutions = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutions = `SELECT workflow_id, run_id, start_time, workflow_type_name, search_attributes ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition IN (?) ` +
 		`AND start_time >= ? ` +
 		`AND start_time <= ? `
 
-	templateGetClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition IN (?) ` +
 		`AND start_time >= ? ` +
 		`AND start_time <= ? `
 
-	templateGetOpenWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, workflow_type_name, search_attributes ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -75,7 +76,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_type_name = ? `
 
-	templateGetClosedWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -83,7 +84,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_type_name = ? `
 
-	templateGetOpenWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, workflow_type_name, search_attributes ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -91,7 +92,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -99,7 +100,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByStatus = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecutionsByStatus = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -107,25 +108,81 @@ const (
 		`AND start_time <= ? ` +
 		`AND status = ? `
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length ` +
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
 		`AND workflow_id = ? ` +
 		`AND run_id = ? ALLOW FILTERING `
+
+	templateGetOpenWorkflowExecutionsBySearchAttributes = `SELECT workflow_id, run_id, start_time, workflow_type_name, search_attributes ` +
+		`FROM open_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time >= ? ` +
+		`AND start_time <= ? `
+
+	templateGetClosedWorkflowExecutionsBySearchAttributes = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, search_attributes ` +
+		`FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time >= ? ` +
+		`AND start_time <= ? `
+
+	templateSearchAttributeFilter = `AND search_attributes[?] = ? `
+
+	templateCountOpenWorkflowExecutions = `SELECT COUNT(*) ` +
+		`FROM open_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? `
+
+	templateCountClosedWorkflowExecutions = `SELECT COUNT(*) ` +
+		`FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? `
+
+	templateGetOpenWorkflowExecutionTypeNames = `SELECT workflow_type_name ` +
+		`FROM open_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? `
+
+	templateGetClosedWorkflowExecutionTypeNames = `SELECT workflow_type_name ` +
+		`FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? `
+
+	templateStartTimeFilter    = `AND start_time %v ? `
+	templateWorkflowIDFilter   = `AND workflow_id = ? `
+	templateWorkflowTypeFilter = `AND workflow_type_name = ? `
+	templateCloseStatusFilter  = `AND status = ? `
+
+	// countWorkflowTypeNamesPageSize is the number of type names read at a time when counting the executions
+	// grouped by workflow type
+	countWorkflowTypeNamesPageSize = 1000
 )
 
 type (
 	cassandraVisibilityPersistence struct {
 		session      *gocql.Session
 		lowConslevel gocql.Consistency
-		logger       bark.Logger
+		logger       logging.Logger
+	}
+
+	// cassandraCountFilter is a visibility query translated to the tables and the filter of the count queries
+	cassandraCountFilter struct {
+		open   bool
+		closed bool
+		filter string
+		args   []interface{}
+		// index is the field filtered with a secondary index, a query can only use one of them
+		index       string
+		closeStatus *workflow.WorkflowExecutionCloseStatus
 	}
 )
 
 // NewCassandraVisibilityPersistence is used to create an instance of VisibilityManager implementation
 func NewCassandraVisibilityPersistence(
-	hosts string, port int, user, password, dc string, keyspace string, logger bark.Logger) (VisibilityManager, error) {
+	hosts string, port int, user, password, dc string, keyspace string, logger logging.Logger) (VisibilityManager, error) {
 	cluster := common.NewCassandraCluster(hosts, port, user, password, dc)
 	cluster.Keyspace = keyspace
 	cluster.ProtoVersion = cassandraProtoVersion
@@ -158,6 +215,7 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 		*request.Execution.RunId,
 		common.UnixNanoToCQLTimestamp(request.StartTimestamp),
 		request.WorkflowTypeName,
+		request.SearchAttributes,
 		ttl,
 	)
 	query = query.WithTimestamp(common.UnixNanoToCQLTimestamp(request.StartTimestamp))
@@ -176,6 +234,35 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 	return nil
 }
 
+func (v *cassandraVisibilityPersistence) UpsertWorkflowExecution(
+	request *UpsertWorkflowExecutionRequest) error {
+	ttl := request.WorkflowTimeout + openExecutionTTLBuffer
+	// the write timestamp is left to the server so the upsert wins over the original started record
+	query := v.session.Query(templateCreateWorkflowExecutionStarted,
+		request.DomainUUID,
+		domainPartition,
+		*request.Execution.WorkflowId,
+		*request.Execution.RunId,
+		common.UnixNanoToCQLTimestamp(request.StartTimestamp),
+		request.WorkflowTypeName,
+		request.SearchAttributes,
+		ttl,
+	)
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("UpsertWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpsertWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
 func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 	request *RecordWorkflowExecutionClosedRequest) error {
 	batch := v.session.NewBatch(gocql.LoggedBatch)
@@ -206,6 +293,7 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 		request.WorkflowTypeName,
 		request.Status,
 		request.HistoryLength,
+		request.SearchAttributes,
 		retention,
 	)
 
@@ -509,6 +597,321 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 	return response, nil
 }
 
+func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsBySearchAttributes(
+	request *ListWorkflowExecutionsBySearchAttributesRequest) (*ListWorkflowExecutionsResponse, error) {
+	queryStr, args := getSearchAttributesQuery(templateGetOpenWorkflowExecutionsBySearchAttributes, request)
+	query := v.session.Query(queryStr, args...).Consistency(v.lowConslevel)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		// TODO: should return a bad request error if the token is invalid
+		return nil, &workflow.InternalServiceError{
+			Message: "ListOpenWorkflowExecutionsBySearchAttributes operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0)
+	wfexecution, has := readOpenWorkflowExecutionRecord(iter)
+	for has {
+		response.Executions = append(response.Executions, wfexecution)
+		wfexecution, has = readOpenWorkflowExecutionRecord(iter)
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListOpenWorkflowExecutionsBySearchAttributes operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListOpenWorkflowExecutionsBySearchAttributes operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsBySearchAttributes(
+	request *ListWorkflowExecutionsBySearchAttributesRequest) (*ListWorkflowExecutionsResponse, error) {
+	queryStr, args := getSearchAttributesQuery(templateGetClosedWorkflowExecutionsBySearchAttributes, request)
+	query := v.session.Query(queryStr, args...).Consistency(v.lowConslevel)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		// TODO: should return a bad request error if the token is invalid
+		return nil, &workflow.InternalServiceError{
+			Message: "ListClosedWorkflowExecutionsBySearchAttributes operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0)
+	wfexecution, has := readClosedWorkflowExecutionRecord(iter)
+	for has {
+		response.Executions = append(response.Executions, wfexecution)
+		wfexecution, has = readClosedWorkflowExecutionRecord(iter)
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListClosedWorkflowExecutionsBySearchAttributes operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListClosedWorkflowExecutionsBySearchAttributes operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+// ListWorkflowExecutions is not supported, the open and closed executions are kept in separate tables whose
+// indexes can only serve one predicate at a time
+func (v *cassandraVisibilityPersistence) ListWorkflowExecutions(
+	request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	return nil, &workflow.BadRequestError{
+		Message: "ListWorkflowExecutions operation is not supported by the cassandra visibility store.",
+	}
+}
+
+// CountWorkflowExecutions counts the open and closed executions matching a query.  The start time can be
+// compared freely, but the query can only filter by one other field, with the equality of either WorkflowID,
+// WorkflowType, CloseStatus or a search attribute, which is served by the index of that field.
+func (v *cassandraVisibilityPersistence) CountWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	filter, err := getCountFilter(request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CountWorkflowExecutionsResponse{}
+	switch request.GroupBy {
+	case VisibilityQueryFieldWorkflowType:
+		if filter.open {
+			if response.Groups, err = v.countWorkflowExecutionsByType(templateGetOpenWorkflowExecutionTypeNames,
+				request.DomainUUID, filter, response.Groups); err != nil {
+				return nil, err
+			}
+		}
+		if filter.closed {
+			if response.Groups, err = v.countWorkflowExecutionsByType(templateGetClosedWorkflowExecutionTypeNames,
+				request.DomainUUID, filter, response.Groups); err != nil {
+				return nil, err
+			}
+		}
+	case VisibilityQueryFieldCloseStatus:
+		if response.Groups, err = v.countWorkflowExecutionsByCloseStatus(request.DomainUUID, filter); err != nil {
+			return nil, err
+		}
+	default:
+		if filter.open {
+			count, err := v.countWorkflowExecutions(templateCountOpenWorkflowExecutions, request.DomainUUID,
+				filter.filter, filter.args)
+			if err != nil {
+				return nil, err
+			}
+			response.Count += count
+		}
+		if filter.closed {
+			count, err := v.countWorkflowExecutions(templateCountClosedWorkflowExecutions, request.DomainUUID,
+				filter.filter, filter.args)
+			if err != nil {
+				return nil, err
+			}
+			response.Count += count
+		}
+		return response, nil
+	}
+
+	for _, group := range response.Groups {
+		response.Count += group.GetCount()
+	}
+	sortCountWorkflowExecutionsGroups(response.Groups)
+	return response, nil
+}
+
+// countWorkflowExecutionsByCloseStatus counts the open executions in one group, and the closed executions of
+// each close status with the status index
+func (v *cassandraVisibilityPersistence) countWorkflowExecutionsByCloseStatus(domainID string,
+	filter *cassandraCountFilter) ([]*workflow.CountWorkflowExecutionsGroup, error) {
+	var groups []*workflow.CountWorkflowExecutionsGroup
+	if filter.open {
+		count, err := v.countWorkflowExecutions(templateCountOpenWorkflowExecutions, domainID, filter.filter,
+			filter.args)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			groups = addToCountWorkflowExecutionsGroups(groups, VisibilityQueryFieldCloseStatus, "", nil, count)
+		}
+	}
+	if !filter.closed {
+		return groups, nil
+	}
+
+	if filter.closeStatus != nil {
+		// the query already selects a single close status
+		count, err := v.countWorkflowExecutions(templateCountClosedWorkflowExecutions, domainID, filter.filter,
+			filter.args)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			groups = addToCountWorkflowExecutionsGroups(groups, VisibilityQueryFieldCloseStatus, "",
+				filter.closeStatus, count)
+		}
+		return groups, nil
+	}
+	if filter.index != "" {
+		return nil, &workflow.BadRequestError{
+			Message: fmt.Sprintf("CountWorkflowExecutions operation failed. The cassandra visibility store "+
+				"cannot group by %v the executions filtered by %v.", VisibilityQueryFieldCloseStatus, filter.index),
+		}
+	}
+	for _, status := range workflow.WorkflowExecutionCloseStatus_Values() {
+		args := append(append([]interface{}{}, filter.args...), int32(status))
+		count, err := v.countWorkflowExecutions(templateCountClosedWorkflowExecutions, domainID,
+			filter.filter+templateCloseStatusFilter, args)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			groups = addToCountWorkflowExecutionsGroups(groups, VisibilityQueryFieldCloseStatus, "",
+				status.Ptr(), count)
+		}
+	}
+	return groups, nil
+}
+
+// countWorkflowExecutionsByType reads the type names of the executions of a table to count them by type,
+// Cassandra can only group by the columns of the primary key
+func (v *cassandraVisibilityPersistence) countWorkflowExecutionsByType(template string, domainID string,
+	filter *cassandraCountFilter, groups []*workflow.CountWorkflowExecutionsGroup) ([]*workflow.CountWorkflowExecutionsGroup, error) {
+	args := append([]interface{}{domainID, domainPartition}, filter.args...)
+	iter := v.session.Query(template+filter.filter, args...).Consistency(v.lowConslevel).
+		PageSize(countWorkflowTypeNamesPageSize).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "CountWorkflowExecutions operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	counts := make(map[string]int64)
+	var typeName string
+	for iter.Scan(&typeName) {
+		counts[typeName]++
+	}
+	if err := iter.Close(); err != nil {
+		return nil, convertCountError(err)
+	}
+
+	for typeName, count := range counts {
+		groups = addToCountWorkflowExecutionsGroups(groups, VisibilityQueryFieldWorkflowType, typeName, nil, count)
+	}
+	return groups, nil
+}
+
+func (v *cassandraVisibilityPersistence) countWorkflowExecutions(template string, domainID string,
+	filter string, filterArgs []interface{}) (int64, error) {
+	args := append([]interface{}{domainID, domainPartition}, filterArgs...)
+	query := v.session.Query(template+filter, args...).Consistency(v.lowConslevel)
+
+	var count int64
+	if err := query.Scan(&count); err != nil {
+		return 0, convertCountError(err)
+	}
+	return count, nil
+}
+
+func convertCountError(err error) error {
+	if isThrottlingError(err) {
+		return &workflow.ServiceBusyError{
+			Message: fmt.Sprintf("CountWorkflowExecutions operation failed. Error: %v", err),
+		}
+	}
+	return &workflow.InternalServiceError{
+		Message: fmt.Sprintf("CountWorkflowExecutions operation failed. Error: %v", err),
+	}
+}
+
+// getCountFilter translates a visibility query to the filter of the count queries, it rejects the queries
+// which would need more than one secondary index or a range on an indexed column
+func getCountFilter(query *VisibilityQuery) (*cassandraCountFilter, error) {
+	filter := &cassandraCountFilter{
+		open:   query.OrderBy != VisibilityQueryFieldCloseTime,
+		closed: true,
+	}
+	for _, predicate := range query.Predicates {
+		if !predicate.SearchAttribute {
+			switch predicate.Field {
+			case VisibilityQueryFieldExecutionStatus:
+				isOpen := predicate.Value == VisibilityExecutionStatusOpen
+				if isOpen == (predicate.Operator == VisibilityQueryOperatorEqual) {
+					filter.closed = false
+				} else {
+					filter.open = false
+				}
+				continue
+			case VisibilityQueryFieldStartTime:
+				// the start time is a clustering column, it supports ranges but not inequality
+				if predicate.Operator == VisibilityQueryOperatorNotEqual {
+					return nil, newCountFilterError(predicate)
+				}
+				filter.filter += fmt.Sprintf(templateStartTimeFilter, predicate.Operator)
+				filter.args = append(filter.args, common.UnixNanoToCQLTimestamp(predicate.Value.(int64)))
+				continue
+			case VisibilityQueryFieldWorkflowID, VisibilityQueryFieldWorkflowType, VisibilityQueryFieldCloseStatus:
+			default:
+				return nil, newCountFilterError(predicate)
+			}
+		}
+
+		if predicate.Operator != VisibilityQueryOperatorEqual {
+			return nil, newCountFilterError(predicate)
+		}
+		if filter.index != "" {
+			return nil, &workflow.BadRequestError{
+				Message: fmt.Sprintf("CountWorkflowExecutions operation failed. The cassandra visibility store "+
+					"cannot filter by both %v and %v.", filter.index, predicate.Field),
+			}
+		}
+		filter.index = predicate.Field
+
+		switch {
+		case predicate.SearchAttribute:
+			filter.filter += templateSearchAttributeFilter
+			filter.args = append(filter.args, predicate.Field, predicate.Value)
+		case predicate.Field == VisibilityQueryFieldWorkflowID:
+			filter.filter += templateWorkflowIDFilter
+			filter.args = append(filter.args, predicate.Value)
+		case predicate.Field == VisibilityQueryFieldWorkflowType:
+			filter.filter += templateWorkflowTypeFilter
+			filter.args = append(filter.args, predicate.Value)
+		case predicate.Field == VisibilityQueryFieldCloseStatus:
+			// open executions have no close status
+			closeStatus := predicate.Value.(workflow.WorkflowExecutionCloseStatus)
+			filter.open = false
+			filter.closeStatus = &closeStatus
+			filter.filter += templateCloseStatusFilter
+			filter.args = append(filter.args, int32(closeStatus))
+		}
+	}
+	return filter, nil
+}
+
+func newCountFilterError(predicate *VisibilityQueryPredicate) error {
+	return &workflow.BadRequestError{
+		Message: fmt.Sprintf("CountWorkflowExecutions operation failed. The cassandra visibility store "+
+			"does not support %v %v.", predicate.Field, predicate.Operator),
+	}
+}
+
 func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	execution := request.Execution
@@ -554,7 +957,8 @@ func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecut
 	var runID gocql.UUID
 	var typeName string
 	var startTime time.Time
-	if iter.Scan(&workflowID, &runID, &startTime, &typeName) {
+	var searchAttributes map[string][]byte
+	if iter.Scan(&workflowID, &runID, &startTime, &typeName, &searchAttributes) {
 		execution := &workflow.WorkflowExecution{}
 		execution.WorkflowId = common.StringPtr(workflowID)
 		execution.RunId = common.StringPtr(runID.String())
@@ -566,6 +970,7 @@ func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecut
 		record.Execution = execution
 		record.StartTime = common.Int64Ptr(startTime.UnixNano())
 		record.Type = wfType
+		record.SearchAttributes = NewSearchAttributes(searchAttributes)
 		return record, true
 	}
 	return nil, false
@@ -579,7 +984,8 @@ func readClosedWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExec
 	var closeTime time.Time
 	var status workflow.WorkflowExecutionCloseStatus
 	var historyLength int64
-	if iter.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status, &historyLength) {
+	var searchAttributes map[string][]byte
+	if iter.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status, &historyLength, &searchAttributes) {
 		execution := &workflow.WorkflowExecution{}
 		execution.WorkflowId = common.StringPtr(workflowID)
 		execution.RunId = common.StringPtr(runID.String())
@@ -594,7 +1000,36 @@ func readClosedWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExec
 		record.Type = wfType
 		record.CloseStatus = &status
 		record.HistoryLength = common.Int64Ptr(historyLength)
+		record.SearchAttributes = NewSearchAttributes(searchAttributes)
 		return record, true
 	}
 	return nil, false
 }
+
+// getSearchAttributesQuery appends an equality filter on the search_attributes map for each key of the
+// request, keys are sorted so the same filter always results in the same prepared statement
+func getSearchAttributesQuery(
+	template string, request *ListWorkflowExecutionsBySearchAttributesRequest) (string, []interface{}) {
+	keys := make([]string, 0, len(request.SearchAttributes))
+	for key := range request.SearchAttributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	query := template
+	args := []interface{}{
+		request.DomainUUID,
+		domainPartition,
+		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
+		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
+	}
+	for _, key := range keys {
+		query += templateSearchAttributeFilter
+		args = append(args, key, request.SearchAttributes[key])
+	}
+	if len(keys) > 1 {
+		// cassandra can only serve a single secondary index restriction without filtering
+		query += `ALLOW FILTERING `
+	}
+	return query, args
+}
@@ -54,7 +54,7 @@ func (s *visibilityPersistenceSuite) SetupSuite() {
 		log.SetOutput(os.Stdout)
 	}
 
-	s.SetupWorkflowStore()
+	s.SetupWorkflowStoreWithOptions(testStoreOptions())
 }
 
 func (s *visibilityPersistenceSuite) SetupTest() {
@@ -405,6 +405,183 @@ func (s *visibilityPersistenceSuite) TestFilteringByCloseStatus() {
 	s.Equal(workflowExecution2.WorkflowId, resp.Executions[0].Execution.WorkflowId)
 }
 
+func (s *visibilityPersistenceSuite) TestFilteringBySearchAttributes() {
+	testDomainUUID := uuid.New()
+	startTime := time.Now().UnixNano()
+
+	// Create 2 executions with different search attributes
+	workflowExecution1 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-search-attributes-test1"),
+		RunId:      common.StringPtr("2a6c3f0e-7c1b-4b8f-9a5e-0d4f1c2b3a41"),
+	}
+	err0 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution1,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string][]byte{"CustomerID": []byte("customer-1")},
+	})
+	s.Nil(err0)
+
+	workflowExecution2 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-search-attributes-test2"),
+		RunId:      common.StringPtr("6e9b1d4c-35a8-4f27-b0c6-8d1e2f3a4b52"),
+	}
+	err1 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution2,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string][]byte{"CustomerID": []byte("customer-2")},
+	})
+	s.Nil(err1)
+
+	listRequest := &ListWorkflowExecutionsBySearchAttributesRequest{
+		ListWorkflowExecutionsRequest: ListWorkflowExecutionsRequest{
+			DomainUUID:        testDomainUUID,
+			PageSize:          2,
+			EarliestStartTime: startTime,
+			LatestStartTime:   startTime,
+		},
+		SearchAttributes: map[string][]byte{"CustomerID": []byte("customer-1")},
+	}
+
+	// List open with filtering
+	resp, err2 := s.VisibilityMgr.ListOpenWorkflowExecutionsBySearchAttributes(listRequest)
+	s.Nil(err2)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(workflowExecution1.WorkflowId, resp.Executions[0].Execution.WorkflowId)
+	s.Equal([]byte("customer-1"), GetSearchAttributesFields(resp.Executions[0].SearchAttributes)["CustomerID"])
+
+	// Upsert the second execution so it matches the filter as well
+	err3 := s.VisibilityMgr.UpsertWorkflowExecution(&UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution2,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string][]byte{"CustomerID": []byte("customer-1")},
+	})
+	s.Nil(err3)
+
+	resp, err4 := s.VisibilityMgr.ListOpenWorkflowExecutionsBySearchAttributes(listRequest)
+	s.Nil(err4)
+	s.Equal(2, len(resp.Executions))
+
+	// Close the first execution and list closed with filtering
+	err5 := s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution1,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           gen.WorkflowExecutionCloseStatusCompleted,
+		SearchAttributes: map[string][]byte{"CustomerID": []byte("customer-1")},
+	})
+	s.Nil(err5)
+
+	resp, err6 := s.VisibilityMgr.ListClosedWorkflowExecutionsBySearchAttributes(listRequest)
+	s.Nil(err6)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(workflowExecution1.WorkflowId, resp.Executions[0].Execution.WorkflowId)
+}
+
+func (s *visibilityPersistenceSuite) TestCountWorkflowExecutions() {
+	testDomainUUID := uuid.New()
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	for i, typeName := range []string{"visibility-count-1", "visibility-count-1", "visibility-count-2"} {
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+			DomainUUID: testDomainUUID,
+			Execution: gen.WorkflowExecution{
+				WorkflowId: common.StringPtr("visibility-count-test"),
+				RunId:      common.StringPtr(uuid.New()),
+			},
+			WorkflowTypeName: typeName,
+			StartTimestamp:   startTime + int64(i)*int64(time.Millisecond),
+		})
+		s.Nil(err)
+	}
+	err0 := s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+		DomainUUID: testDomainUUID,
+		Execution: gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("visibility-count-closed"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		WorkflowTypeName: "visibility-count-2",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           gen.WorkflowExecutionCloseStatusFailed,
+	})
+	s.Nil(err0)
+
+	resp, err1 := s.VisibilityMgr.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query: &VisibilityQuery{
+			Predicates: []*VisibilityQueryPredicate{{
+				Field:    VisibilityQueryFieldWorkflowType,
+				Operator: VisibilityQueryOperatorEqual,
+				Value:    "visibility-count-2",
+			}, {
+				Field:    VisibilityQueryFieldStartTime,
+				Operator: VisibilityQueryOperatorGreaterThanOrEqual,
+				Value:    startTime,
+			}},
+			OrderBy: VisibilityQueryFieldStartTime,
+		},
+	})
+	s.Nil(err1)
+	s.Equal(int64(2), resp.Count)
+	s.Nil(resp.Groups)
+
+	openQuery := &VisibilityQuery{
+		Predicates: []*VisibilityQueryPredicate{{
+			Field:    VisibilityQueryFieldExecutionStatus,
+			Operator: VisibilityQueryOperatorEqual,
+			Value:    VisibilityExecutionStatusOpen,
+		}},
+		OrderBy: VisibilityQueryFieldStartTime,
+	}
+	resp, err2 := s.VisibilityMgr.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query:      openQuery,
+		GroupBy:    VisibilityQueryFieldWorkflowType,
+	})
+	s.Nil(err2)
+	s.Equal(int64(3), resp.Count)
+	s.Equal(2, len(resp.Groups))
+	s.Equal("visibility-count-1", resp.Groups[0].GetWorkflowType())
+	s.Equal(int64(2), resp.Groups[0].GetCount())
+	s.Equal("visibility-count-2", resp.Groups[1].GetWorkflowType())
+	s.Equal(int64(1), resp.Groups[1].GetCount())
+
+	resp, err3 := s.VisibilityMgr.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query:      &VisibilityQuery{OrderBy: VisibilityQueryFieldStartTime},
+		GroupBy:    VisibilityQueryFieldCloseStatus,
+	})
+	s.Nil(err3)
+	s.Equal(int64(4), resp.Count)
+	s.Equal(2, len(resp.Groups))
+	s.Nil(resp.Groups[0].CloseStatus)
+	s.Equal(int64(3), resp.Groups[0].GetCount())
+	s.Equal(gen.WorkflowExecutionCloseStatusFailed, resp.Groups[1].GetCloseStatus())
+	s.Equal(int64(1), resp.Groups[1].GetCount())
+
+	// the indexes of the workflow type and the workflow id cannot be used by the same cassandra query
+	_, err4 := getCountFilter(&VisibilityQuery{
+		Predicates: []*VisibilityQueryPredicate{{
+			Field:    VisibilityQueryFieldWorkflowType,
+			Operator: VisibilityQueryOperatorEqual,
+			Value:    "visibility-count-2",
+		}, {
+			Field:    VisibilityQueryFieldWorkflowID,
+			Operator: VisibilityQueryOperatorEqual,
+			Value:    "visibility-count-test",
+		}},
+		OrderBy: VisibilityQueryFieldStartTime,
+	})
+	s.IsType(&gen.BadRequestError{}, err4)
+}
+
 func (s *visibilityPersistenceSuite) TestGetClosedExecution() {
 	testDomainUUID := uuid.New()
 
@@ -20,6 +20,10 @@
 
 package persistence
 
+import (
+	"time"
+)
+
 // GetOrUseDefaultActiveCluster return the current cluster name or use the input if valid
 func GetOrUseDefaultActiveCluster(currentClusterName string, activeClusterName string) string {
 	if len(activeClusterName) == 0 {
@@ -39,3 +43,9 @@ func GetOrUseDefaultClusters(currentClusterName string, clusters []*ClusterRepli
 	}
 	return clusters
 }
+
+// IsHandoverInProgress return whether a graceful failover is handing the domain over to another cluster, an expired
+// handover is abandoned
+func IsHandoverInProgress(replicationConfig *DomainReplicationConfig, now time.Time) bool {
+	return len(replicationConfig.HandoverClusterName) != 0 && now.Before(replicationConfig.HandoverExpiryTime)
+}
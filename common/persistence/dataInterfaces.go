@@ -73,6 +73,7 @@ const (
 	TransferTaskTypeCancelExecution
 	TransferTaskTypeStartChildExecution
 	TransferTaskTypeSignalExecution
+	TransferTaskTypeUpsertWorkflowSearchAttributes
 )
 
 // Types of replication tasks
@@ -89,6 +90,13 @@ const (
 	TaskTypeWorkflowTimeout
 	TaskTypeDeleteHistoryEvent
 	TaskTypeRetryTimer
+	TaskTypeWorkflowBackoffTimer
+)
+
+// Types of workflow backoff timeout
+const (
+	WorkflowBackoffTimeoutTypeRetry = iota
+	WorkflowBackoffTimeoutTypeCron
 )
 
 type (
@@ -173,6 +181,18 @@ type (
 		ClientLibraryVersion         string
 		ClientFeatureVersion         string
 		ClientImpl                   string
+		CronSchedule                 string
+		// For retry
+		Attempt            int32
+		HasRetryPolicy     bool
+		InitialInterval    int32
+		BackoffCoefficient float64
+		MaximumInterval    int32
+		ExpirationTime     time.Time
+		MaximumAttempts    int32
+		NonRetriableErrors []string
+		// Indexed in visibility
+		SearchAttributes map[string][]byte
 	}
 
 	// ReplicationState represents mutable state information for global domains.
@@ -327,6 +347,13 @@ type (
 		Version                 int64
 	}
 
+	// UpsertWorkflowSearchAttributesTask identifies a transfer task for updating the search attributes
+	// of an execution in visibility
+	UpsertWorkflowSearchAttributesTask struct {
+		TaskID  int64
+		Version int64
+	}
+
 	// StartChildExecutionTask identifies a transfer task for starting child execution
 	StartChildExecutionTask struct {
 		TaskID           int64
@@ -363,6 +390,14 @@ type (
 		Attempt             int32
 	}
 
+	// WorkflowBackoffTimerTask to schedule first decision task for retried or cron workflow
+	WorkflowBackoffTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+		TimeoutType         int // 0 for retry, 1 for cron.
+	}
+
 	// HistoryReplicationTask is the transfer task created for shipping history replication events to other clusters
 	HistoryReplicationTask struct {
 		TaskID              int64
@@ -518,6 +553,20 @@ type (
 		ContinueAsNew               bool
 		PreviousRunID               string
 		ReplicationState            *ReplicationState
+		CronSchedule                string
+		Attempt                     int32
+		HasRetryPolicy              bool
+		InitialInterval             int32
+		BackoffCoefficient          float64
+		MaximumInterval             int32
+		ExpirationTime              time.Time
+		MaximumAttempts             int32
+		NonRetriableErrors          []string
+		SearchAttributes            map[string][]byte
+		// ResetSnapshot is set when the execution is created with a history already in progress, as done by reset.
+		// The complete mutable state it holds is written instead of the state of a new execution built from the
+		// fields above, only its Condition and RangeID are ignored.
+		ResetSnapshot *ResetMutableStateRequest
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -785,14 +834,21 @@ type (
 	// DomainConfig describes the domain configuration
 	DomainConfig struct {
 		// NOTE: this retention is in days, not in seconds
-		Retention  int32
-		EmitMetric bool
+		Retention      int32
+		EmitMetric     bool
+		ArchivalBucket string
+		ArchivalStatus workflow.ArchivalStatus
 	}
 
 	// DomainReplicationConfig describes the cross DC domain replication configuration
 	DomainReplicationConfig struct {
 		ActiveClusterName string
 		Clusters          []*ClusterReplicationConfig
+		// HandoverClusterName is the cluster a graceful failover hands the domain over to, the handover is only known
+		// to the active cluster and is abandoned after HandoverExpiryTime
+		HandoverClusterName string
+		HandoverStartTime   time.Time
+		HandoverExpiryTime  time.Time
 	}
 
 	// ClusterReplicationConfig describes the cross DC cluster replication configuration
@@ -852,6 +908,18 @@ type (
 		Name string
 	}
 
+	// ListDomainsRequest is used to list domains
+	ListDomainsRequest struct {
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListDomainsResponse is the response for ListDomains
+	ListDomainsResponse struct {
+		Domains       []*GetDomainResponse
+		NextPageToken []byte
+	}
+
 	// Closeable is an interface for any entity that supports a close operation to release resources
 	Closeable interface {
 		Close()
@@ -920,6 +988,7 @@ type (
 		UpdateDomain(request *UpdateDomainRequest) error
 		DeleteDomain(request *DeleteDomainRequest) error
 		DeleteDomainByName(request *DeleteDomainByNameRequest) error
+		ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error)
 	}
 )
 
@@ -1183,6 +1252,41 @@ func (r *RetryTimerTask) SetVisibilityTimestamp(t time.Time) {
 	r.VisibilityTimestamp = t
 }
 
+// GetType returns the type of the workflow backoff timer task
+func (r *WorkflowBackoffTimerTask) GetType() int {
+	return TaskTypeWorkflowBackoffTimer
+}
+
+// GetVersion returns the version of the workflow backoff timer task
+func (r *WorkflowBackoffTimerTask) GetVersion() int64 {
+	return r.Version
+}
+
+// SetVersion returns the version of the workflow backoff timer task
+func (r *WorkflowBackoffTimerTask) SetVersion(version int64) {
+	r.Version = version
+}
+
+// GetTaskID returns the sequence ID.
+func (r *WorkflowBackoffTimerTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID.
+func (r *WorkflowBackoffTimerTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (r *WorkflowBackoffTimerTask) GetVisibilityTimestamp() time.Time {
+	return r.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp gets the visibility time stamp
+func (r *WorkflowBackoffTimerTask) SetVisibilityTimestamp(t time.Time) {
+	r.VisibilityTimestamp = t
+}
+
 // GetType returns the type of the timeout task.
 func (u *WorkflowTimeoutTask) GetType() int {
 	return TaskTypeWorkflowTimeout
@@ -1268,6 +1372,31 @@ func (u *SignalExecutionTask) SetTaskID(id int64) {
 	u.TaskID = id
 }
 
+// GetType returns the type of the upsert search attributes transfer task
+func (u *UpsertWorkflowSearchAttributesTask) GetType() int {
+	return TransferTaskTypeUpsertWorkflowSearchAttributes
+}
+
+// GetVersion returns the version of the upsert search attributes transfer task
+func (u *UpsertWorkflowSearchAttributesTask) GetVersion() int64 {
+	return u.Version
+}
+
+// SetVersion returns the version of the upsert search attributes transfer task
+func (u *UpsertWorkflowSearchAttributesTask) SetVersion(version int64) {
+	u.Version = version
+}
+
+// GetTaskID returns the sequence ID of the upsert search attributes transfer task.
+func (u *UpsertWorkflowSearchAttributesTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the upsert search attributes transfer task.
+func (u *UpsertWorkflowSearchAttributesTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
 // GetType returns the type of the start child transfer task
 func (u *StartChildExecutionTask) GetType() int {
 	return TransferTaskTypeStartChildExecution
@@ -21,11 +21,18 @@
 package persistence
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"sync/atomic"
+
+	"github.com/golang/snappy"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
-	"sync/atomic"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
 )
 
 type (
@@ -73,8 +80,17 @@ type (
 
 	jsonHistorySerializer struct{}
 
+	// thriftRWHistorySerializer encodes the history events with the thriftrw binary protocol, optionally
+	// compressing the encoded data depending on the encoding type
+	thriftRWHistorySerializer struct {
+		encodingType common.EncodingType
+	}
+
 	serializerFactoryImpl struct {
-		jsonSerializer HistorySerializer
+		jsonSerializer           HistorySerializer
+		thriftRWSerializer       HistorySerializer
+		thriftRWSnappySerializer HistorySerializer
+		thriftRWGzipSerializer   HistorySerializer
 	}
 )
 
@@ -120,11 +136,104 @@ func (j *jsonHistorySerializer) Deserialize(batch *SerializedHistoryEventBatch)
 	return &HistoryEventBatch{Version: batch.Version, Events: events}, nil
 }
 
+// NewThriftRWHistorySerializer returns a HistorySerializer which uses the thriftrw binary protocol, the encoding
+// type must be one of the thriftrw encoding types, which also determines the compression of the encoded data
+func NewThriftRWHistorySerializer(encodingType common.EncodingType) HistorySerializer {
+	return &thriftRWHistorySerializer{encodingType: encodingType}
+}
+
+func (t *thriftRWHistorySerializer) Serialize(batch *HistoryEventBatch) (*SerializedHistoryEventBatch, error) {
+
+	if batch.Version > GetMaxSupportedHistoryVersion() {
+		err := NewHistoryVersionCompatibilityError(batch.Version, GetMaxSupportedHistoryVersion())
+		return nil, &HistorySerializationError{msg: err.Error()}
+	}
+
+	history := &workflow.History{Events: batch.Events}
+	value, err := history.ToWire()
+	if err != nil {
+		return nil, &HistorySerializationError{msg: err.Error()}
+	}
+
+	var buf bytes.Buffer
+	if err := protocol.Binary.Encode(value, &buf); err != nil {
+		return nil, &HistorySerializationError{msg: err.Error()}
+	}
+
+	data, err := t.compress(buf.Bytes())
+	if err != nil {
+		return nil, &HistorySerializationError{msg: err.Error()}
+	}
+	return NewSerializedHistoryEventBatch(data, t.encodingType, batch.Version), nil
+}
+
+func (t *thriftRWHistorySerializer) Deserialize(batch *SerializedHistoryEventBatch) (*HistoryEventBatch, error) {
+
+	if batch.Version > GetMaxSupportedHistoryVersion() {
+		err := NewHistoryVersionCompatibilityError(batch.Version, GetMaxSupportedHistoryVersion())
+		return nil, &HistoryDeserializationError{msg: err.Error()}
+	}
+
+	data, err := t.decompress(batch.Data)
+	if err != nil {
+		return nil, &HistoryDeserializationError{msg: err.Error()}
+	}
+
+	value, err := protocol.Binary.Decode(bytes.NewReader(data), wire.TStruct)
+	if err != nil {
+		return nil, &HistoryDeserializationError{msg: err.Error()}
+	}
+
+	var history workflow.History
+	if err := history.FromWire(value); err != nil {
+		return nil, &HistoryDeserializationError{msg: err.Error()}
+	}
+	return &HistoryEventBatch{Version: batch.Version, Events: history.Events}, nil
+}
+
+func (t *thriftRWHistorySerializer) compress(data []byte) ([]byte, error) {
+	switch t.encodingType {
+	case common.EncodingTypeThriftRWSnappy:
+		return snappy.Encode(nil, data), nil
+	case common.EncodingTypeThriftRWGzip:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return data, nil
+	}
+}
+
+func (t *thriftRWHistorySerializer) decompress(data []byte) ([]byte, error) {
+	switch t.encodingType {
+	case common.EncodingTypeThriftRWSnappy:
+		return snappy.Decode(nil, data)
+	case common.EncodingTypeThriftRWGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	default:
+		return data, nil
+	}
+}
+
 // NewHistorySerializerFactory creates and returns an instance
 // of HistorySerializerFactory
 func NewHistorySerializerFactory() HistorySerializerFactory {
 	return &serializerFactoryImpl{
-		jsonSerializer: NewJSONHistorySerializer(),
+		jsonSerializer:           NewJSONHistorySerializer(),
+		thriftRWSerializer:       NewThriftRWHistorySerializer(common.EncodingTypeThriftRW),
+		thriftRWSnappySerializer: NewThriftRWHistorySerializer(common.EncodingTypeThriftRWSnappy),
+		thriftRWGzipSerializer:   NewThriftRWHistorySerializer(common.EncodingTypeThriftRWGzip),
 	}
 }
 
@@ -133,6 +242,12 @@ func (f *serializerFactoryImpl) Get(encodingType common.EncodingType) (HistorySe
 	switch encodingType {
 	case common.EncodingTypeJSON:
 		return f.jsonSerializer, nil
+	case common.EncodingTypeThriftRW:
+		return f.thriftRWSerializer, nil
+	case common.EncodingTypeThriftRWSnappy:
+		return f.thriftRWSnappySerializer, nil
+	case common.EncodingTypeThriftRWGzip:
+		return f.thriftRWGzipSerializer, nil
 	default:
 		return nil, NewUnknownEncodingTypeError(encodingType)
 	}
@@ -21,12 +21,11 @@
 package persistence
 
 import (
-	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 	"sync"
 	"testing"
 	"time"
@@ -38,7 +37,7 @@ type (
 		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
 		// not merely log an error
 		*require.Assertions
-		logger bark.Logger
+		logger logging.Logger
 	}
 )
 
@@ -48,7 +47,7 @@ func TestHistoryBuilderSuite(t *testing.T) {
 }
 
 func (s *historySerializerSuite) SetupTest() {
-	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.logger = logging.NewDevelopmentLogger()
 	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
 	s.Assertions = require.New(s.T())
 }
@@ -134,3 +133,74 @@ func (s *historySerializerSuite) TestSerializerFactory() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *historySerializerSuite) TestThriftRWSerializers() {
+	factory := NewHistorySerializerFactory()
+
+	event1 := &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(999),
+		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+		EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+		ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+			Result:           []byte("result-1-event-1"),
+			ScheduledEventId: common.Int64Ptr(4),
+			StartedEventId:   common.Int64Ptr(5),
+			Identity:         common.StringPtr("event-1"),
+		},
+	}
+	events := []*workflow.HistoryEvent{event1}
+
+	// serialize every batch with a different encoding, as a history with mixed encodings is expected
+	// when the encoding type of a cluster is changed
+	encodingTypes := []common.EncodingType{
+		common.EncodingTypeJSON,
+		common.EncodingTypeThriftRW,
+		common.EncodingTypeThriftRWSnappy,
+		common.EncodingTypeThriftRWGzip,
+	}
+	var batches []*SerializedHistoryEventBatch
+	for _, encodingType := range encodingTypes {
+		serializer, err := factory.Get(encodingType)
+		s.Nil(err)
+		s.NotNil(serializer)
+
+		eventBatch := NewHistoryEventBatch(GetMaxSupportedHistoryVersion()+1, events)
+		_, err = serializer.Serialize(eventBatch)
+		s.NotNil(err)
+		_, ok := err.(*HistorySerializationError)
+		s.True(ok)
+
+		eventBatch.Version = 1
+		sh, err := serializer.Serialize(eventBatch)
+		s.Nil(err)
+		s.Equal(1, sh.Version)
+		s.Equal(encodingType, sh.EncodingType)
+		batches = append(batches, sh)
+	}
+
+	for _, batch := range batches {
+		serializer, err := factory.Get(batch.EncodingType)
+		s.Nil(err)
+
+		dh, err := serializer.Deserialize(batch)
+		s.Nil(err)
+		s.Equal(1, dh.Version)
+		s.Equal(1, len(dh.Events))
+		s.Equal(event1.EventId, dh.Events[0].EventId)
+		s.Equal(event1.Timestamp, dh.Events[0].Timestamp)
+		s.Equal(event1.EventType, dh.Events[0].EventType)
+		s.Equal(event1.ActivityTaskCompletedEventAttributes.Result, dh.Events[0].ActivityTaskCompletedEventAttributes.Result)
+	}
+
+	// data encoded with one encoding type cannot be decoded with another
+	serializer, err := factory.Get(common.EncodingTypeThriftRWGzip)
+	s.Nil(err)
+	_, err = serializer.Deserialize(&SerializedHistoryEventBatch{
+		EncodingType: common.EncodingTypeThriftRWGzip,
+		Version:      1,
+		Data:         batches[1].Data,
+	})
+	s.NotNil(err)
+	_, ok := err.(*HistoryDeserializationError)
+	s.True(ok)
+}
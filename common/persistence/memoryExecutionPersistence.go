@@ -0,0 +1,799 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pborman/uuid"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	memoryExecutionPersistence struct {
+		store   *memoryStore
+		shardID int
+		logger  logging.Logger
+	}
+
+	memoryPersistenceClientFactory struct {
+		store         *memoryStore
+		metricsClient metrics.Client
+		tracer        opentracing.Tracer
+		logger        logging.Logger
+	}
+)
+
+// NewMemoryWorkflowExecutionPersistence is used to create an instance of ExecutionManager implementation
+func NewMemoryWorkflowExecutionPersistence(shardID int, logger logging.Logger) (ExecutionManager, error) {
+	return newMemoryExecutionPersistence(getDefaultMemoryStore(), shardID, logger), nil
+}
+
+func newMemoryExecutionPersistence(store *memoryStore, shardID int, logger logging.Logger) ExecutionManager {
+	return &memoryExecutionPersistence{store: store, shardID: shardID, logger: logger}
+}
+
+// NewMemoryPersistenceClientFactory is used to create an instance of ExecutionManagerFactory implementation
+func NewMemoryPersistenceClientFactory(logger logging.Logger, metricsClient metrics.Client,
+	tracer opentracing.Tracer) (ExecutionManagerFactory, error) {
+	return newMemoryPersistenceClientFactory(getDefaultMemoryStore(), logger, metricsClient, tracer), nil
+}
+
+func newMemoryPersistenceClientFactory(store *memoryStore, logger logging.Logger,
+	metricsClient metrics.Client, tracer opentracing.Tracer) ExecutionManagerFactory {
+	return &memoryPersistenceClientFactory{store: store, logger: logger, metricsClient: metricsClient, tracer: tracer}
+}
+
+// CreateExecutionManager implements ExecutionManagerFactory interface
+func (f *memoryPersistenceClientFactory) CreateExecutionManager(shardID int) (ExecutionManager, error) {
+	mgr := newMemoryExecutionPersistence(f.store, shardID, f.logger)
+	if f.metricsClient == nil {
+		return mgr, nil
+	}
+	return NewWorkflowExecutionPersistenceClient(mgr, f.metricsClient, f.tracer), nil
+}
+
+// Close is a no-op, the data is kept for the lifetime of the process
+func (f *memoryPersistenceClientFactory) Close() {
+}
+
+// Close is a no-op, the data is kept for the lifetime of the process
+func (m *memoryExecutionPersistence) Close() {
+}
+
+func (m *memoryExecutionPersistence) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (
+	*CreateWorkflowExecutionResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	if err := m.checkRangeID(request.RangeID); err != nil {
+		return nil, err
+	}
+	if err := m.checkCreateWorkflowExecution(request); err != nil {
+		return nil, err
+	}
+
+	m.createWorkflowExecution(request)
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+	m.createTransferTasks(request.TransferTasks, request.DomainID, workflowID, runID)
+	m.createReplicationTasks(request.ReplicationTasks, request.DomainID, workflowID, runID)
+	m.createTimerTasks(request.TimerTasks, nil, request.DomainID, workflowID, runID)
+
+	return &CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil
+}
+
+// checkCreateWorkflowExecution verifies that the execution can be created without modifying the store
+func (m *memoryExecutionPersistence) checkCreateWorkflowExecution(request *CreateWorkflowExecutionRequest) error {
+	current := m.store.currentExecutions[m.currentExecutionKey(request.DomainID, request.Execution.GetWorkflowId())]
+	if request.ContinueAsNew {
+		if current == nil || current.RunID != request.PreviousRunID {
+			return m.alreadyStartedError(request, current)
+		}
+	} else if current != nil {
+		return m.alreadyStartedError(request, current)
+	}
+
+	if _, ok := m.store.executions[m.executionKey(request.DomainID, request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId())]; ok {
+		return m.alreadyStartedError(request, current)
+	}
+	return nil
+}
+
+func (m *memoryExecutionPersistence) createWorkflowExecution(request *CreateWorkflowExecutionRequest) {
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+
+	parentDomainID := emptyDomainID
+	parentWorkflowID := ""
+	parentRunID := emptyRunID
+	initiatedID := emptyInitiatedID
+	state := WorkflowStateRunning
+	if request.ParentExecution != nil {
+		parentDomainID = request.ParentDomainID
+		parentWorkflowID = request.ParentExecution.GetWorkflowId()
+		parentRunID = request.ParentExecution.GetRunId()
+		initiatedID = request.InitiatedID
+		state = WorkflowStateCreated
+	}
+
+	m.store.currentExecutions[m.currentExecutionKey(request.DomainID, workflowID)] = &GetCurrentExecutionResponse{
+		StartRequestID: request.RequestID,
+		RunID:          runID,
+		State:          state,
+		CloseStatus:    WorkflowCloseStatusNone,
+	}
+
+	mutableState := newMemoryMutableState()
+	if request.ResetSnapshot != nil {
+		m.updateExecution(mutableState, request.ResetSnapshot.ExecutionInfo, request.ResetSnapshot.ReplicationState)
+		m.resetMutableStateMaps(mutableState, request.ResetSnapshot)
+		m.store.executions[m.executionKey(request.DomainID, workflowID, runID)] = mutableState
+		return
+	}
+
+	now := time.Now()
+	mutableState.ExecutionInfo = &WorkflowExecutionInfo{
+		DomainID:             request.DomainID,
+		WorkflowID:           workflowID,
+		RunID:                runID,
+		ParentDomainID:       parentDomainID,
+		ParentWorkflowID:     parentWorkflowID,
+		ParentRunID:          parentRunID,
+		InitiatedID:          initiatedID,
+		TaskList:             request.TaskList,
+		WorkflowTypeName:     request.WorkflowTypeName,
+		WorkflowTimeout:      request.WorkflowTimeout,
+		DecisionTimeoutValue: request.DecisionTimeoutValue,
+		ExecutionContext:     request.ExecutionContext,
+		State:                WorkflowStateCreated,
+		CloseStatus:          WorkflowCloseStatusNone,
+		LastFirstEventID:     common.FirstEventID,
+		NextEventID:          request.NextEventID,
+		LastProcessedEvent:   request.LastProcessedEvent,
+		StartTimestamp:       now,
+		LastUpdatedTimestamp: now,
+		CreateRequestID:      request.RequestID,
+		DecisionVersion:      request.DecisionVersion,
+		DecisionScheduleID:   request.DecisionScheduleID,
+		DecisionStartedID:    request.DecisionStartedID,
+		DecisionTimeout:      request.DecisionStartToCloseTimeout,
+		CronSchedule:         request.CronSchedule,
+		Attempt:              request.Attempt,
+		HasRetryPolicy:       request.HasRetryPolicy,
+		InitialInterval:      request.InitialInterval,
+		BackoffCoefficient:   request.BackoffCoefficient,
+		MaximumInterval:      request.MaximumInterval,
+		ExpirationTime:       request.ExpirationTime,
+		MaximumAttempts:      request.MaximumAttempts,
+		NonRetriableErrors:   request.NonRetriableErrors,
+		SearchAttributes:     request.SearchAttributes,
+	}
+	mutableState.ReplicationState = cloneReplicationState(request.ReplicationState)
+	m.store.executions[m.executionKey(request.DomainID, workflowID, runID)] = mutableState
+}
+
+func (m *memoryExecutionPersistence) alreadyStartedError(request *CreateWorkflowExecutionRequest,
+	current *GetCurrentExecutionResponse) error {
+	if current == nil {
+		return &WorkflowExecutionAlreadyStartedError{
+			Msg: fmt.Sprintf("Workflow execution already running. WorkflowId: %v, rangeID: %v",
+				request.Execution.GetWorkflowId(), request.RangeID),
+		}
+	}
+	return &WorkflowExecutionAlreadyStartedError{
+		Msg: fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+			request.Execution.GetWorkflowId(), current.RunID, request.RangeID),
+		StartRequestID: current.StartRequestID,
+		RunID:          current.RunID,
+		State:          current.State,
+		CloseStatus:    current.CloseStatus,
+	}
+}
+
+func (m *memoryExecutionPersistence) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
+	*GetWorkflowExecutionResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	execution := request.Execution
+	state, ok := m.store.executions[m.executionKey(request.DomainID, execution.GetWorkflowId(),
+		execution.GetRunId())]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+	return &GetWorkflowExecutionResponse{State: cloneMutableState(state)}, nil
+}
+
+func (m *memoryExecutionPersistence) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	executionInfo := request.ExecutionInfo
+	domainID := executionInfo.DomainID
+	workflowID := executionInfo.WorkflowID
+	runID := executionInfo.RunID
+
+	if err := m.checkRangeID(request.RangeID); err != nil {
+		return err
+	}
+	state, err := m.checkCondition(executionInfo, request.Condition)
+	if err != nil {
+		return err
+	}
+	if request.ContinueAsNew != nil {
+		if err := m.checkCreateWorkflowExecution(request.ContinueAsNew); err != nil {
+			return err
+		}
+	}
+
+	m.updateExecution(state, executionInfo, request.ReplicationState)
+	m.createTransferTasks(request.TransferTasks, domainID, workflowID, runID)
+	m.createReplicationTasks(request.ReplicationTasks, domainID, workflowID, runID)
+	m.createTimerTasks(request.TimerTasks, request.DeleteTimerTask, domainID, workflowID, runID)
+
+	for _, a := range request.UpsertActivityInfos {
+		info := *a
+		state.ActivitInfos[a.ScheduleID] = &info
+	}
+	for _, id := range request.DeleteActivityInfos {
+		delete(state.ActivitInfos, id)
+	}
+	for _, t := range request.UpserTimerInfos {
+		info := *t
+		state.TimerInfos[t.TimerID] = &info
+	}
+	for _, id := range request.DeleteTimerInfos {
+		delete(state.TimerInfos, id)
+	}
+	for _, c := range request.UpsertChildExecutionInfos {
+		info := *c
+		state.ChildExecutionInfos[c.InitiatedID] = &info
+	}
+	if request.DeleteChildExecutionInfo != nil {
+		delete(state.ChildExecutionInfos, *request.DeleteChildExecutionInfo)
+	}
+	for _, r := range request.UpsertRequestCancelInfos {
+		info := *r
+		state.RequestCancelInfos[r.InitiatedID] = &info
+	}
+	if request.DeleteRequestCancelInfo != nil {
+		delete(state.RequestCancelInfos, *request.DeleteRequestCancelInfo)
+	}
+	for _, s := range request.UpsertSignalInfos {
+		info := *s
+		state.SignalInfos[s.InitiatedID] = &info
+	}
+	if request.DeleteSignalInfo != nil {
+		delete(state.SignalInfos, *request.DeleteSignalInfo)
+	}
+	for _, id := range request.UpsertSignalRequestedIDs {
+		state.SignalRequestedIDs[id] = struct{}{}
+	}
+	if request.DeleteSignalRequestedID != "" {
+		delete(state.SignalRequestedIDs, request.DeleteSignalRequestedID)
+	}
+	if request.NewBufferedReplicationTask != nil {
+		task := *request.NewBufferedReplicationTask
+		state.BufferedReplicationTasks[task.FirstEventID] = &task
+	}
+	if request.DeleteBufferedReplicationTask != nil {
+		delete(state.BufferedReplicationTasks, *request.DeleteBufferedReplicationTask)
+	}
+
+	if request.ClearBufferedEvents {
+		state.BufferedEvents = make([]*SerializedHistoryEventBatch, 0)
+	} else if request.NewBufferedEvents != nil {
+		batch := *request.NewBufferedEvents
+		state.BufferedEvents = append(state.BufferedEvents, &batch)
+	}
+
+	if request.ContinueAsNew != nil {
+		startReq := request.ContinueAsNew
+		m.createWorkflowExecution(startReq)
+		m.createTransferTasks(startReq.TransferTasks, startReq.DomainID, startReq.Execution.GetWorkflowId(),
+			startReq.Execution.GetRunId())
+		m.createTimerTasks(startReq.TimerTasks, nil, startReq.DomainID, startReq.Execution.GetWorkflowId(),
+			startReq.Execution.GetRunId())
+	} else if request.FinishExecution {
+		// The current execution is kept around with the final state of the run, so
+		// that duplicate start requests can still be detected
+		current := m.store.currentExecutions[m.currentExecutionKey(domainID, workflowID)]
+		if current != nil && current.RunID == runID {
+			current.State = executionInfo.State
+			current.CloseStatus = executionInfo.CloseStatus
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionPersistence) ResetMutableState(request *ResetMutableStateRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	if err := m.checkRangeID(request.RangeID); err != nil {
+		return err
+	}
+	state, err := m.checkCondition(request.ExecutionInfo, request.Condition)
+	if err != nil {
+		return err
+	}
+
+	m.updateExecution(state, request.ExecutionInfo, request.ReplicationState)
+	m.resetMutableStateMaps(state, request)
+	return nil
+}
+
+// resetMutableStateMaps replaces the maps of the mutable state with the ones of the snapshot
+func (m *memoryExecutionPersistence) resetMutableStateMaps(state *WorkflowMutableState,
+	request *ResetMutableStateRequest) {
+	state.ActivitInfos = make(map[int64]*ActivityInfo)
+	for _, a := range request.InsertActivityInfos {
+		info := *a
+		state.ActivitInfos[a.ScheduleID] = &info
+	}
+	state.TimerInfos = make(map[string]*TimerInfo)
+	for _, t := range request.InsertTimerInfos {
+		info := *t
+		state.TimerInfos[t.TimerID] = &info
+	}
+	state.ChildExecutionInfos = make(map[int64]*ChildExecutionInfo)
+	for _, c := range request.InsertChildExecutionInfos {
+		info := *c
+		state.ChildExecutionInfos[c.InitiatedID] = &info
+	}
+	state.RequestCancelInfos = make(map[int64]*RequestCancelInfo)
+	for _, r := range request.InsertRequestCancelInfos {
+		info := *r
+		state.RequestCancelInfos[r.InitiatedID] = &info
+	}
+	state.SignalInfos = make(map[int64]*SignalInfo)
+	for _, s := range request.InsertSignalInfos {
+		info := *s
+		state.SignalInfos[s.InitiatedID] = &info
+	}
+	state.SignalRequestedIDs = make(map[string]struct{})
+	for _, id := range request.InsertSignalRequestedIDs {
+		state.SignalRequestedIDs[id] = struct{}{}
+	}
+	state.BufferedReplicationTasks = make(map[int64]*BufferedReplicationTask)
+}
+
+func (m *memoryExecutionPersistence) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	delete(m.store.executions, m.executionKey(request.DomainID, request.WorkflowID, request.RunID))
+	return nil
+}
+
+func (m *memoryExecutionPersistence) GetCurrentExecution(request *GetCurrentExecutionRequest) (
+	*GetCurrentExecutionResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	current, ok := m.store.currentExecutions[m.currentExecutionKey(request.DomainID, request.WorkflowID)]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v", request.WorkflowID),
+		}
+	}
+	response := *current
+	return &response, nil
+}
+
+func (m *memoryExecutionPersistence) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse,
+	error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	tasks := m.store.transferTasks[m.shardID]
+	var taskIDs []int64
+	for taskID := range tasks {
+		if taskID > request.ReadLevel && taskID <= request.MaxReadLevel {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sortInt64s(taskIDs)
+
+	response := &GetTransferTasksResponse{}
+	for _, taskID := range taskIDs {
+		if len(response.Tasks) >= request.BatchSize {
+			break
+		}
+		task := *tasks[taskID]
+		response.Tasks = append(response.Tasks, &task)
+	}
+	return response, nil
+}
+
+func (m *memoryExecutionPersistence) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	delete(m.store.transferTasks[m.shardID], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionPersistence) GetReplicationTasks(request *GetReplicationTasksRequest) (
+	*GetReplicationTasksResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	tasks := m.store.replicationTasks[m.shardID]
+	var taskIDs []int64
+	for taskID := range tasks {
+		if taskID > request.ReadLevel && taskID <= request.MaxReadLevel {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sortInt64s(taskIDs)
+
+	response := &GetReplicationTasksResponse{}
+	for _, taskID := range taskIDs {
+		if len(response.Tasks) >= request.BatchSize {
+			break
+		}
+		task := *tasks[taskID]
+		task.LastReplicationInfo = cloneReplicationInfo(task.LastReplicationInfo)
+		response.Tasks = append(response.Tasks, &task)
+	}
+	return response, nil
+}
+
+func (m *memoryExecutionPersistence) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	delete(m.store.replicationTasks[m.shardID], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionPersistence) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (
+	*GetTimerIndexTasksResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	tasks := m.store.timerTasks[m.shardID]
+	var keys []memoryTimerTaskKey
+	for key, task := range tasks {
+		if !task.VisibilityTimestamp.Before(request.MinTimestamp) &&
+			task.VisibilityTimestamp.Before(request.MaxTimestamp) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].visibilityTimestamp != keys[j].visibilityTimestamp {
+			return keys[i].visibilityTimestamp < keys[j].visibilityTimestamp
+		}
+		return keys[i].taskID < keys[j].taskID
+	})
+
+	response := &GetTimerIndexTasksResponse{}
+	for _, key := range keys {
+		if len(response.Timers) >= request.BatchSize {
+			break
+		}
+		task := *tasks[key]
+		response.Timers = append(response.Timers, &task)
+	}
+	return response, nil
+}
+
+func (m *memoryExecutionPersistence) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	delete(m.store.timerTasks[m.shardID], memoryTimerTaskKey{
+		visibilityTimestamp: request.VisibilityTimestamp.UnixNano(),
+		taskID:              request.TaskID,
+	})
+	return nil
+}
+
+// checkRangeID verifies that the shard is still owned by the caller
+func (m *memoryExecutionPersistence) checkRangeID(rangeID int64) error {
+	shardInfo, ok := m.store.shards[m.shardID]
+	if !ok {
+		return &ShardOwnershipLostError{
+			ShardID: m.shardID,
+			Msg:     fmt.Sprintf("Shard not found.  Request RangeID: %v", rangeID),
+		}
+	}
+	if shardInfo.RangeID != rangeID {
+		return &ShardOwnershipLostError{
+			ShardID: m.shardID,
+			Msg: fmt.Sprintf("Failed to update mutable state.  Request RangeID: %v, Actual RangeID: %v",
+				rangeID, shardInfo.RangeID),
+		}
+	}
+	return nil
+}
+
+// checkCondition returns the stored mutable state of the execution if its next event id still matches condition
+func (m *memoryExecutionPersistence) checkCondition(executionInfo *WorkflowExecutionInfo, condition int64) (
+	*WorkflowMutableState, error) {
+	state, ok := m.store.executions[m.executionKey(executionInfo.DomainID, executionInfo.WorkflowID,
+		executionInfo.RunID)]
+	if !ok {
+		return nil, &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update workflow execution.  WorkflowId: %v, RunId: %v does not exist",
+				executionInfo.WorkflowID, executionInfo.RunID),
+		}
+	}
+	if state.ExecutionInfo.NextEventID != condition {
+		return nil, &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update workflow execution.  Request Condition: %v, Actual Value: %v",
+				condition, state.ExecutionInfo.NextEventID),
+		}
+	}
+	return state, nil
+}
+
+func (m *memoryExecutionPersistence) updateExecution(state *WorkflowMutableState,
+	executionInfo *WorkflowExecutionInfo, replicationState *ReplicationState) {
+	info := *executionInfo
+	info.LastUpdatedTimestamp = time.Now()
+	state.ExecutionInfo = &info
+	state.ReplicationState = cloneReplicationState(replicationState)
+}
+
+func (m *memoryExecutionPersistence) createTransferTasks(transferTasks []Task, domainID, workflowID,
+	runID string) {
+	if len(transferTasks) == 0 {
+		return
+	}
+	tasks, ok := m.store.transferTasks[m.shardID]
+	if !ok {
+		tasks = make(map[int64]*TransferTaskInfo)
+		m.store.transferTasks[m.shardID] = tasks
+	}
+
+	for _, task := range transferTasks {
+		info := &TransferTaskInfo{
+			DomainID:         domainID,
+			WorkflowID:       workflowID,
+			RunID:            runID,
+			TaskID:           task.GetTaskID(),
+			TargetDomainID:   domainID,
+			TargetWorkflowID: transferTaskTransferTargetWorkflowID,
+			TargetRunID:      transferTaskTypeTransferTargetRunID,
+			TaskType:         task.GetType(),
+			Version:          task.GetVersion(),
+		}
+
+		switch t := task.(type) {
+		case *ActivityTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+		case *DecisionTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+		case *CancelExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			if t.TargetRunID != "" {
+				info.TargetRunID = t.TargetRunID
+			}
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+		case *SignalExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			if t.TargetRunID != "" {
+				info.TargetRunID = t.TargetRunID
+			}
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+		case *StartChildExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.ScheduleID = t.InitiatedID
+		case *CloseExecutionTask, *UpsertWorkflowSearchAttributesTask:
+			// No explicit property needs to be set
+		default:
+			m.logger.Fatal("Unknown Transfer Task.")
+		}
+
+		tasks[info.TaskID] = info
+	}
+}
+
+func (m *memoryExecutionPersistence) createReplicationTasks(replicationTasks []Task, domainID, workflowID,
+	runID string) {
+	if len(replicationTasks) == 0 {
+		return
+	}
+	tasks, ok := m.store.replicationTasks[m.shardID]
+	if !ok {
+		tasks = make(map[int64]*ReplicationTaskInfo)
+		m.store.replicationTasks[m.shardID] = tasks
+	}
+
+	for _, task := range replicationTasks {
+		info := &ReplicationTaskInfo{
+			DomainID:     domainID,
+			WorkflowID:   workflowID,
+			RunID:        runID,
+			TaskID:       task.GetTaskID(),
+			TaskType:     task.GetType(),
+			FirstEventID: common.EmptyEventID,
+			NextEventID:  common.EmptyEventID,
+		}
+
+		switch t := task.(type) {
+		case *HistoryReplicationTask:
+			info.FirstEventID = t.FirstEventID
+			info.NextEventID = t.NextEventID
+			info.Version = t.Version
+			info.LastReplicationInfo = cloneReplicationInfo(t.LastReplicationInfo)
+		default:
+			m.logger.Fatal("Unknown Replication Task.")
+		}
+
+		tasks[info.TaskID] = info
+	}
+}
+
+func (m *memoryExecutionPersistence) createTimerTasks(timerTasks []Task, deleteTimerTask Task, domainID,
+	workflowID, runID string) {
+	tasks, ok := m.store.timerTasks[m.shardID]
+	if !ok {
+		tasks = make(map[memoryTimerTaskKey]*TimerTaskInfo)
+		m.store.timerTasks[m.shardID] = tasks
+	}
+
+	for _, task := range timerTasks {
+		visibilityTimestamp := GetVisibilityTSFrom(task)
+		info := &TimerTaskInfo{
+			DomainID:            domainID,
+			WorkflowID:          workflowID,
+			RunID:               runID,
+			VisibilityTimestamp: visibilityTimestamp,
+			TaskID:              task.GetTaskID(),
+			TaskType:            task.GetType(),
+			Version:             task.GetVersion(),
+		}
+
+		switch t := task.(type) {
+		case *DecisionTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.ScheduleAttempt
+		case *ActivityTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.Attempt
+		case *UserTimerTask:
+			info.EventID = t.EventID
+		case *RetryTimerTask:
+			info.EventID = t.EventID
+			info.ScheduleAttempt = int64(t.Attempt)
+		case *WorkflowBackoffTimerTask:
+			info.TimeoutType = t.TimeoutType
+		}
+
+		tasks[memoryTimerTaskKey{visibilityTimestamp: visibilityTimestamp.UnixNano(), taskID: info.TaskID}] = info
+	}
+
+	if deleteTimerTask != nil {
+		delete(tasks, memoryTimerTaskKey{
+			visibilityTimestamp: GetVisibilityTSFrom(deleteTimerTask).UnixNano(),
+			taskID:              deleteTimerTask.GetTaskID(),
+		})
+	}
+}
+
+func (m *memoryExecutionPersistence) executionKey(domainID, workflowID, runID string) memoryExecutionKey {
+	return memoryExecutionKey{shardID: m.shardID, domainID: domainID, workflowID: workflowID, runID: runID}
+}
+
+func (m *memoryExecutionPersistence) currentExecutionKey(domainID, workflowID string) memoryCurrentExecutionKey {
+	return memoryCurrentExecutionKey{shardID: m.shardID, domainID: domainID, workflowID: workflowID}
+}
+
+func newMemoryMutableState() *WorkflowMutableState {
+	return &WorkflowMutableState{
+		ActivitInfos:             make(map[int64]*ActivityInfo),
+		TimerInfos:               make(map[string]*TimerInfo),
+		ChildExecutionInfos:      make(map[int64]*ChildExecutionInfo),
+		RequestCancelInfos:       make(map[int64]*RequestCancelInfo),
+		SignalInfos:              make(map[int64]*SignalInfo),
+		SignalRequestedIDs:       make(map[string]struct{}),
+		BufferedEvents:           make([]*SerializedHistoryEventBatch, 0),
+		BufferedReplicationTasks: make(map[int64]*BufferedReplicationTask),
+	}
+}
+
+// cloneMutableState returns a copy of the mutable state which does not share any maps or
+// structs with the store
+func cloneMutableState(state *WorkflowMutableState) *WorkflowMutableState {
+	copied := newMemoryMutableState()
+	info := *state.ExecutionInfo
+	copied.ExecutionInfo = &info
+	copied.ReplicationState = cloneReplicationState(state.ReplicationState)
+	for k, v := range state.ActivitInfos {
+		info := *v
+		copied.ActivitInfos[k] = &info
+	}
+	for k, v := range state.TimerInfos {
+		info := *v
+		copied.TimerInfos[k] = &info
+	}
+	for k, v := range state.ChildExecutionInfos {
+		info := *v
+		copied.ChildExecutionInfos[k] = &info
+	}
+	for k, v := range state.RequestCancelInfos {
+		info := *v
+		copied.RequestCancelInfos[k] = &info
+	}
+	for k, v := range state.SignalInfos {
+		info := *v
+		copied.SignalInfos[k] = &info
+	}
+	for k := range state.SignalRequestedIDs {
+		copied.SignalRequestedIDs[k] = struct{}{}
+	}
+	for _, v := range state.BufferedEvents {
+		batch := *v
+		copied.BufferedEvents = append(copied.BufferedEvents, &batch)
+	}
+	for k, v := range state.BufferedReplicationTasks {
+		task := *v
+		copied.BufferedReplicationTasks[k] = &task
+	}
+	return copied
+}
+
+func cloneReplicationState(state *ReplicationState) *ReplicationState {
+	if state == nil {
+		return nil
+	}
+	copied := *state
+	copied.LastReplicationInfo = cloneReplicationInfo(state.LastReplicationInfo)
+	return &copied
+}
+
+func cloneReplicationInfo(info map[string]*ReplicationInfo) map[string]*ReplicationInfo {
+	if info == nil {
+		return nil
+	}
+	copied := make(map[string]*ReplicationInfo, len(info))
+	for k, v := range info {
+		replicationInfo := *v
+		copied[k] = &replicationInfo
+	}
+	return copied
+}
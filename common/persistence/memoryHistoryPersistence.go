@@ -0,0 +1,174 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/uber/cadence/common/logging"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	memoryHistoryKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	// memoryHistoryBatch is a batch of events written by a single append transaction
+	memoryHistoryBatch struct {
+		rangeID int64
+		txID    int64
+		events  SerializedHistoryEventBatch
+	}
+
+	memoryHistoryPersistence struct {
+		store  *memoryStore
+		logger logging.Logger
+	}
+)
+
+// NewMemoryHistoryPersistence is used to create an instance of HistoryManager implementation
+func NewMemoryHistoryPersistence(logger logging.Logger) (HistoryManager, error) {
+	return newMemoryHistoryPersistence(getDefaultMemoryStore(), logger), nil
+}
+
+func newMemoryHistoryPersistence(store *memoryStore, logger logging.Logger) HistoryManager {
+	return &memoryHistoryPersistence{store: store, logger: logger}
+}
+
+// Close is a no-op, the data is kept for the lifetime of the process
+func (h *memoryHistoryPersistence) Close() {
+}
+
+func (h *memoryHistoryPersistence) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	h.store.Lock()
+	defer h.store.Unlock()
+
+	key := memoryHistoryKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	}
+	batches, ok := h.store.history[key]
+	if !ok {
+		batches = make(map[int64]*memoryHistoryBatch)
+		h.store.history[key] = batches
+	}
+
+	existing, exists := batches[request.FirstEventID]
+	if request.Overwrite {
+		if !exists || existing.rangeID > request.RangeID || existing.txID >= request.TransactionID {
+			return &ConditionFailedError{
+				Msg: "Failed to append history events.",
+			}
+		}
+	} else if exists {
+		return &ConditionFailedError{
+			Msg: "Failed to append history events.",
+		}
+	}
+
+	batch := &memoryHistoryBatch{
+		rangeID: request.RangeID,
+		txID:    request.TransactionID,
+		events:  *request.Events,
+	}
+	batches[request.FirstEventID] = batch
+	return nil
+}
+
+func (h *memoryHistoryPersistence) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (
+	*GetWorkflowExecutionHistoryResponse, error) {
+	execution := request.Execution
+
+	// The page token is the first event id of the last batch returned by the previous page
+	firstEventID := request.FirstEventID
+	if len(request.NextPageToken) > 0 {
+		if len(request.NextPageToken) != 8 {
+			return nil, &workflow.BadRequestError{
+				Message: "GetWorkflowExecutionHistory operation failed. Invalid next page token.",
+			}
+		}
+		firstEventID = int64(binary.BigEndian.Uint64(request.NextPageToken)) + 1
+	}
+
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = math.MaxInt32
+	}
+
+	h.store.Lock()
+	defer h.store.Unlock()
+
+	batches := h.store.history[memoryHistoryKey{
+		domainID:   request.DomainID,
+		workflowID: execution.GetWorkflowId(),
+		runID:      execution.GetRunId(),
+	}]
+	var eventIDs []int64
+	for eventID := range batches {
+		if eventID >= firstEventID && eventID < request.NextEventID {
+			eventIDs = append(eventIDs, eventID)
+		}
+	}
+	sortInt64s(eventIDs)
+
+	response := &GetWorkflowExecutionHistoryResponse{}
+	var lastFirstEventID int64
+	for _, eventID := range eventIDs {
+		if len(response.Events) >= pageSize {
+			break
+		}
+		response.Events = append(response.Events, batches[eventID].events)
+		lastFirstEventID = eventID
+	}
+
+	if len(response.Events) == 0 && len(request.NextPageToken) == 0 {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution history not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+
+	if len(response.Events) == pageSize {
+		response.NextPageToken = make([]byte, 8)
+		binary.BigEndian.PutUint64(response.NextPageToken, uint64(lastFirstEventID))
+	}
+	return response, nil
+}
+
+func (h *memoryHistoryPersistence) DeleteWorkflowExecutionHistory(
+	request *DeleteWorkflowExecutionHistoryRequest) error {
+	h.store.Lock()
+	defer h.store.Unlock()
+
+	delete(h.store.history, memoryHistoryKey{
+		domainID:   request.DomainID,
+		workflowID: request.Execution.GetWorkflowId(),
+		runID:      request.Execution.GetRunId(),
+	})
+	return nil
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/common/logging"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	// memoryDomain is a domain record as kept by the in-memory store
+	memoryDomain struct {
+		info              DomainInfo
+		config            DomainConfig
+		replicationConfig DomainReplicationConfig
+		isGlobalDomain    bool
+		configVersion     int64
+		failoverVersion   int64
+		dbVersion         int64
+	}
+
+	memoryMetadataPersistence struct {
+		store              *memoryStore
+		currentClusterName string
+		logger             logging.Logger
+	}
+)
+
+// NewMemoryMetadataPersistence is used to create an instance of MetadataManager implementation
+func NewMemoryMetadataPersistence(currentClusterName string, logger logging.Logger) (MetadataManager, error) {
+	return newMemoryMetadataPersistence(getDefaultMemoryStore(), currentClusterName, logger), nil
+}
+
+func newMemoryMetadataPersistence(store *memoryStore, currentClusterName string,
+	logger logging.Logger) MetadataManager {
+	return &memoryMetadataPersistence{store: store, currentClusterName: currentClusterName, logger: logger}
+}
+
+// Close is a no-op, the data is kept for the lifetime of the process
+func (m *memoryMetadataPersistence) Close() {
+}
+
+func (m *memoryMetadataPersistence) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	if id, ok := m.store.domainIDsByName[request.Info.Name]; ok {
+		return nil, &workflow.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain already exists.  DomainId: %v", id),
+		}
+	}
+	if _, ok := m.store.domains[request.Info.ID]; ok {
+		return nil, &workflow.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain already exists.  DomainId: %v", request.Info.ID),
+		}
+	}
+
+	domain := &memoryDomain{
+		isGlobalDomain:  request.IsGlobalDomain,
+		configVersion:   request.ConfigVersion,
+		failoverVersion: request.FailoverVersion,
+	}
+	domain.setConfig(request.Info, request.Config, request.ReplicationConfig)
+	m.store.domains[request.Info.ID] = domain
+	m.store.domainIDsByName[request.Info.Name] = request.Info.ID
+
+	return &CreateDomainResponse{ID: request.Info.ID}, nil
+}
+
+func (m *memoryMetadataPersistence) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+	if len(request.ID) > 0 && len(request.Name) > 0 {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name specified in request.",
+		}
+	} else if len(request.ID) == 0 && len(request.Name) == 0 {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name are empty.",
+		}
+	}
+
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	id := request.ID
+	identity := request.ID
+	if len(request.Name) > 0 {
+		id = m.store.domainIDsByName[request.Name]
+		identity = request.Name
+	}
+	domain, ok := m.store.domains[id]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Domain %s does not exist.", identity),
+		}
+	}
+
+	return m.toGetDomainResponse(domain), nil
+}
+
+// ListDomains returns the domains ordered by name, the next page token is the name of the last domain of the page
+func (m *memoryMetadataPersistence) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	var names []string
+	for name := range m.store.domainIDsByName {
+		if name > string(request.NextPageToken) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	response := &ListDomainsResponse{}
+	for _, name := range names {
+		if request.PageSize > 0 && len(response.Domains) == request.PageSize {
+			response.NextPageToken = []byte(response.Domains[len(response.Domains)-1].Info.Name)
+			break
+		}
+		response.Domains = append(response.Domains, m.toGetDomainResponse(m.store.domains[m.store.domainIDsByName[name]]))
+	}
+	return response, nil
+}
+
+func (m *memoryMetadataPersistence) UpdateDomain(request *UpdateDomainRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	domain, ok := m.store.domains[m.store.domainIDsByName[request.Info.Name]]
+	if !ok || domain.dbVersion != request.DBVersion {
+		return &ConditionFailedError{
+			Msg: fmt.Sprintf("UpdateDomain operation failed. Domain: %v, DBVersion: %v",
+				request.Info.Name, request.DBVersion),
+		}
+	}
+
+	domain.setConfig(request.Info, request.Config, request.ReplicationConfig)
+	domain.configVersion = request.ConfigVersion
+	domain.failoverVersion = request.FailoverVersion
+	domain.dbVersion = request.DBVersion + 1
+	return nil
+}
+
+func (m *memoryMetadataPersistence) DeleteDomain(request *DeleteDomainRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	if domain, ok := m.store.domains[request.ID]; ok {
+		m.deleteDomain(domain.info.Name, request.ID)
+	}
+	return nil
+}
+
+func (m *memoryMetadataPersistence) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	if id, ok := m.store.domainIDsByName[request.Name]; ok {
+		m.deleteDomain(request.Name, id)
+	}
+	return nil
+}
+
+func (m *memoryMetadataPersistence) deleteDomain(name, id string) {
+	delete(m.store.domainIDsByName, name)
+	delete(m.store.domains, id)
+}
+
+func (m *memoryMetadataPersistence) toGetDomainResponse(domain *memoryDomain) *GetDomainResponse {
+	info := domain.info
+	config := domain.config
+	replicationConfig := cloneDomainReplicationConfig(&domain.replicationConfig)
+	replicationConfig.ActiveClusterName = GetOrUseDefaultActiveCluster(m.currentClusterName,
+		replicationConfig.ActiveClusterName)
+	replicationConfig.Clusters = GetOrUseDefaultClusters(m.currentClusterName, replicationConfig.Clusters)
+
+	return &GetDomainResponse{
+		Info:              &info,
+		Config:            &config,
+		ReplicationConfig: replicationConfig,
+		IsGlobalDomain:    domain.isGlobalDomain,
+		ConfigVersion:     domain.configVersion,
+		FailoverVersion:   domain.failoverVersion,
+		DBVersion:         domain.dbVersion,
+	}
+}
+
+func (d *memoryDomain) setConfig(info *DomainInfo, config *DomainConfig,
+	replicationConfig *DomainReplicationConfig) {
+	d.info = *info
+	d.config = DomainConfig{}
+	if config != nil {
+		d.config = *config
+	}
+	d.replicationConfig = *cloneDomainReplicationConfig(replicationConfig)
+}
+
+func cloneDomainReplicationConfig(replicationConfig *DomainReplicationConfig) *DomainReplicationConfig {
+	copied := &DomainReplicationConfig{}
+	if replicationConfig == nil {
+		return copied
+	}
+	copied.ActiveClusterName = replicationConfig.ActiveClusterName
+	copied.HandoverClusterName = replicationConfig.HandoverClusterName
+	copied.HandoverStartTime = replicationConfig.HandoverStartTime
+	copied.HandoverExpiryTime = replicationConfig.HandoverExpiryTime
+	for _, cluster := range replicationConfig.Clusters {
+		clusterConfig := *cluster
+		copied.Clusters = append(copied.Clusters, &clusterConfig)
+	}
+	return copied
+}
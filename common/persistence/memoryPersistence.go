@@ -0,0 +1,368 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/logging"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	// memoryStore holds the state of all the in-memory persistence managers.  A single lock guards
+	// the whole store, which makes every operation atomic in the same way a conditional batch is
+	// for the cassandra store.
+	memoryStore struct {
+		sync.Mutex
+		shards            map[int]*ShardInfo
+		taskLists         map[memoryTaskListKey]*TaskListInfo
+		tasks             map[memoryTaskListKey]map[int64]*TaskInfo
+		executions        map[memoryExecutionKey]*WorkflowMutableState
+		currentExecutions map[memoryCurrentExecutionKey]*GetCurrentExecutionResponse
+		transferTasks     map[int]map[int64]*TransferTaskInfo
+		replicationTasks  map[int]map[int64]*ReplicationTaskInfo
+		timerTasks        map[int]map[memoryTimerTaskKey]*TimerTaskInfo
+		history           map[memoryHistoryKey]map[int64]*memoryHistoryBatch
+		domains           map[string]*memoryDomain
+		domainIDsByName   map[string]string
+		visibility        map[memoryVisibilityKey]*workflow.WorkflowExecutionInfo
+	}
+
+	memoryTaskListKey struct {
+		domainID string
+		name     string
+		taskType int
+	}
+
+	memoryExecutionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	memoryCurrentExecutionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+	}
+
+	memoryTimerTaskKey struct {
+		visibilityTimestamp int64
+		taskID              int64
+	}
+
+	memoryShardPersistence struct {
+		store              *memoryStore
+		currentClusterName string
+		logger             logging.Logger
+	}
+
+	memoryTaskPersistence struct {
+		store  *memoryStore
+		logger logging.Logger
+	}
+)
+
+var (
+	defaultMemoryStore     *memoryStore
+	defaultMemoryStoreOnce sync.Once
+)
+
+// newMemoryStore creates an empty in-memory store
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		shards:            make(map[int]*ShardInfo),
+		taskLists:         make(map[memoryTaskListKey]*TaskListInfo),
+		tasks:             make(map[memoryTaskListKey]map[int64]*TaskInfo),
+		executions:        make(map[memoryExecutionKey]*WorkflowMutableState),
+		currentExecutions: make(map[memoryCurrentExecutionKey]*GetCurrentExecutionResponse),
+		transferTasks:     make(map[int]map[int64]*TransferTaskInfo),
+		replicationTasks:  make(map[int]map[int64]*ReplicationTaskInfo),
+		timerTasks:        make(map[int]map[memoryTimerTaskKey]*TimerTaskInfo),
+		history:           make(map[memoryHistoryKey]map[int64]*memoryHistoryBatch),
+		domains:           make(map[string]*memoryDomain),
+		domainIDsByName:   make(map[string]string),
+		visibility:        make(map[memoryVisibilityKey]*workflow.WorkflowExecutionInfo),
+	}
+}
+
+// getDefaultMemoryStore returns the store shared by all the in-memory managers created by the
+// persistence factory, so that all cadence services running within one process see the same data
+func getDefaultMemoryStore() *memoryStore {
+	defaultMemoryStoreOnce.Do(func() {
+		defaultMemoryStore = newMemoryStore()
+	})
+	return defaultMemoryStore
+}
+
+// NewMemoryShardPersistence is used to create an instance of ShardManager implementation
+func NewMemoryShardPersistence(currentClusterName string, logger logging.Logger) (ShardManager, error) {
+	return newMemoryShardPersistence(getDefaultMemoryStore(), currentClusterName, logger), nil
+}
+
+func newMemoryShardPersistence(store *memoryStore, currentClusterName string, logger logging.Logger) ShardManager {
+	return &memoryShardPersistence{store: store, currentClusterName: currentClusterName, logger: logger}
+}
+
+// NewMemoryTaskPersistence is used to create an instance of TaskManager implementation
+func NewMemoryTaskPersistence(logger logging.Logger) (TaskManager, error) {
+	return newMemoryTaskPersistence(getDefaultMemoryStore(), logger), nil
+}
+
+func newMemoryTaskPersistence(store *memoryStore, logger logging.Logger) TaskManager {
+	return &memoryTaskPersistence{store: store, logger: logger}
+}
+
+// Close is a no-op, the data is kept for the lifetime of the process
+func (m *memoryShardPersistence) Close() {
+}
+
+func (m *memoryShardPersistence) CreateShard(request *CreateShardRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	shardID := request.ShardInfo.ShardID
+	if _, ok := m.store.shards[shardID]; ok {
+		return &ShardAlreadyExistError{
+			Msg: fmt.Sprintf("Shard already exists in executions table.  ShardId: %v", shardID),
+		}
+	}
+
+	shardInfo := cloneShardInfo(request.ShardInfo)
+	shardInfo.UpdatedAt = time.Now()
+	m.store.shards[shardID] = shardInfo
+	return nil
+}
+
+func (m *memoryShardPersistence) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	shardInfo, ok := m.store.shards[request.ShardID]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Shard not found.  ShardId: %v", request.ShardID),
+		}
+	}
+
+	info := cloneShardInfo(shardInfo)
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			m.currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			m.currentClusterName: info.TimerAckLevel,
+		}
+	}
+	return &GetShardResponse{ShardInfo: info}, nil
+}
+
+func (m *memoryShardPersistence) UpdateShard(request *UpdateShardRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	shardID := request.ShardInfo.ShardID
+	shardInfo, ok := m.store.shards[shardID]
+	if !ok || shardInfo.RangeID != request.PreviousRangeID {
+		rangeID := int64(-1)
+		if ok {
+			rangeID = shardInfo.RangeID
+		}
+		return &ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg: fmt.Sprintf("Failed to update shard.  previous_range_id: %v, actual_range_id: %v",
+				request.PreviousRangeID, rangeID),
+		}
+	}
+
+	updatedInfo := cloneShardInfo(request.ShardInfo)
+	updatedInfo.UpdatedAt = time.Now()
+	m.store.shards[shardID] = updatedInfo
+	return nil
+}
+
+// Close is a no-op, the data is kept for the lifetime of the process
+func (m *memoryTaskPersistence) Close() {
+}
+
+// From TaskManager interface
+func (m *memoryTaskPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	if len(request.TaskList) == 0 {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("LeaseTaskList requires non empty task list"),
+		}
+	}
+
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	key := memoryTaskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	tli, ok := m.store.taskLists[key]
+	if ok {
+		tli.RangeID++
+	} else {
+		// First time task list is used
+		tli = &TaskListInfo{
+			DomainID: request.DomainID,
+			Name:     request.TaskList,
+			TaskType: request.TaskType,
+			RangeID:  initialRangeID,
+			AckLevel: 0,
+			Kind:     request.TaskListKind,
+		}
+		m.store.taskLists[key] = tli
+	}
+
+	response := *tli
+	response.Kind = request.TaskListKind
+	return &LeaseTaskListResponse{TaskListInfo: &response}, nil
+}
+
+// From TaskManager interface
+func (m *memoryTaskPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	tli := request.TaskListInfo
+	key := memoryTaskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+
+	if tli.Kind == TaskListKindSticky { // sticky task lists are not owned, so they are updated unconditionally
+		updated := *tli
+		m.store.taskLists[key] = &updated
+		return &UpdateTaskListResponse{}, nil
+	}
+
+	current, ok := m.store.taskLists[key]
+	if !ok || current.RangeID != tli.RangeID {
+		return nil, &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update task list. name: %v, type: %v, rangeID: %v",
+				tli.Name, tli.TaskType, tli.RangeID),
+		}
+	}
+	current.AckLevel = tli.AckLevel
+	current.Kind = tli.Kind
+	return &UpdateTaskListResponse{}, nil
+}
+
+// From TaskManager interface
+func (m *memoryTaskPersistence) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	tli := request.TaskListInfo
+	key := memoryTaskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	current, ok := m.store.taskLists[key]
+	if !ok {
+		return nil, &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to create task. TaskList: %v, taskListType: %v does not exist",
+				tli.Name, tli.TaskType),
+		}
+	}
+	if current.RangeID != tli.RangeID {
+		return nil, &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to create task. TaskList: %v, taskListType: %v, rangeID: %v, db rangeID: %v",
+				tli.Name, tli.TaskType, tli.RangeID, current.RangeID),
+		}
+	}
+
+	tasks, ok := m.store.tasks[key]
+	if !ok {
+		tasks = make(map[int64]*TaskInfo)
+		m.store.tasks[key] = tasks
+	}
+	for _, task := range request.Tasks {
+		tasks[task.TaskID] = &TaskInfo{
+			DomainID:               tli.DomainID,
+			WorkflowID:             task.Execution.GetWorkflowId(),
+			RunID:                  task.Execution.GetRunId(),
+			TaskID:                 task.TaskID,
+			ScheduleID:             task.Data.ScheduleID,
+			ScheduleToStartTimeout: task.Data.ScheduleToStartTimeout,
+		}
+	}
+	return &CreateTasksResponse{}, nil
+}
+
+// From TaskManager interface
+func (m *memoryTaskPersistence) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	key := memoryTaskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	var taskIDs []int64
+	for taskID := range m.store.tasks[key] {
+		if taskID > request.ReadLevel && taskID <= request.MaxReadLevel {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sortInt64s(taskIDs)
+
+	response := &GetTasksResponse{}
+	for _, taskID := range taskIDs {
+		if len(response.Tasks) >= request.BatchSize {
+			break
+		}
+		task := *m.store.tasks[key][taskID]
+		response.Tasks = append(response.Tasks, &task)
+	}
+	return response, nil
+}
+
+// From TaskManager interface
+func (m *memoryTaskPersistence) CompleteTask(request *CompleteTaskRequest) error {
+	m.store.Lock()
+	defer m.store.Unlock()
+
+	tli := request.TaskList
+	key := memoryTaskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	delete(m.store.tasks[key], request.TaskID)
+	return nil
+}
+
+// cloneShardInfo returns a deep copy of the shard info, so that callers never share state with the store
+func cloneShardInfo(info *ShardInfo) *ShardInfo {
+	copied := *info
+	if info.ClusterTransferAckLevel != nil {
+		copied.ClusterTransferAckLevel = make(map[string]int64, len(info.ClusterTransferAckLevel))
+		for k, v := range info.ClusterTransferAckLevel {
+			copied.ClusterTransferAckLevel[k] = v
+		}
+	}
+	if info.ClusterTimerAckLevel != nil {
+		copied.ClusterTimerAckLevel = make(map[string]time.Time, len(info.ClusterTimerAckLevel))
+		for k, v := range info.ClusterTimerAckLevel {
+			copied.ClusterTimerAckLevel[k] = v
+		}
+	}
+	return &copied
+}
+
+func sortInt64s(values []int64) {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+}
@@ -0,0 +1,731 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	memoryPersistenceSuite struct {
+		suite.Suite
+		TestBase
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestMemoryPersistenceSuite(t *testing.T) {
+	s := new(memoryPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *memoryPersistenceSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	s.SetupWorkflowStoreWithOptions(TestBaseOptions{InMemory: true})
+}
+
+func (s *memoryPersistenceSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+	s.ClearTasks()
+}
+
+func (s *memoryPersistenceSuite) TearDownSuite() {
+	s.TearDownWorkflowStore()
+}
+
+func (s *memoryPersistenceSuite) TestShardOwnership() {
+	s.Nil(s.CreateShard(10, "owner", 5))
+
+	err := s.CreateShard(10, "other_owner", 6)
+	s.IsType(&ShardAlreadyExistError{}, err)
+
+	info, err := s.GetShard(10)
+	s.Nil(err)
+	s.Equal(int64(5), info.RangeID)
+	s.NotNil(info.ClusterTransferAckLevel)
+	s.NotNil(info.ClusterTimerAckLevel)
+
+	info.RangeID = 6
+	err = s.UpdateShard(info, 4)
+	s.IsType(&ShardOwnershipLostError{}, err)
+
+	s.Nil(s.UpdateShard(info, 5))
+	info, err = s.GetShard(10)
+	s.Nil(err)
+	s.Equal(int64(6), info.RangeID)
+
+	_, err = s.GetShard(11)
+	s.IsType(&gen.EntityNotExistsError{}, err)
+}
+
+func (s *memoryPersistenceSuite) TestCreateWorkflowExecutionAlreadyStarted() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("create-workflow-already-started"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	_, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.Nil(err)
+
+	workflowExecution.RunId = common.StringPtr(uuid.New())
+	_, err = s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.IsType(&WorkflowExecutionAlreadyStartedError{}, err)
+	startedErr := err.(*WorkflowExecutionAlreadyStartedError)
+	s.NotEqual(workflowExecution.GetRunId(), startedErr.RunID)
+	s.Equal(WorkflowStateRunning, startedErr.State)
+}
+
+func (s *memoryPersistenceSuite) TestCreateWorkflowExecutionShardOwnershipLost() {
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("create-workflow-shard-ownership-lost"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	_, err := s.WorkflowMgr.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{
+		RequestID:          uuid.New(),
+		DomainID:           uuid.New(),
+		Execution:          workflowExecution,
+		TaskList:           "queue1",
+		WorkflowTypeName:   "wType",
+		NextEventID:        3,
+		RangeID:            s.ShardInfo.RangeID + 1,
+		DecisionScheduleID: 2,
+	})
+	s.IsType(&ShardOwnershipLostError{}, err)
+
+	_, err = s.GetCurrentWorkflowRunID(uuid.New(), workflowExecution.GetWorkflowId())
+	s.IsType(&gen.EntityNotExistsError{}, err)
+}
+
+func (s *memoryPersistenceSuite) TestUpdateWorkflowExecutionConditions() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("update-workflow-conditions"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	_, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.Nil(err)
+
+	state, err := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err)
+	info := state.ExecutionInfo
+	s.Equal(int64(3), info.NextEventID)
+
+	info.NextEventID = 5
+	activityInfos := []*ActivityInfo{{ScheduleID: 4, ActivityID: "activity1"}}
+	err = s.UpdateWorkflowExecution(info, nil, nil, int64(2), nil, nil, activityInfos, nil, nil, nil)
+	s.IsType(&ConditionFailedError{}, err)
+
+	err = s.UpdateWorkflowExecutionWithRangeID(info, nil, nil, s.ShardInfo.RangeID+1, int64(3), nil, nil,
+		activityInfos, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+	s.IsType(&ShardOwnershipLostError{}, err)
+
+	s.Nil(s.UpdateWorkflowExecution(info, nil, nil, int64(3), nil, nil, activityInfos, nil, nil, nil))
+
+	state, err = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err)
+	s.Equal(int64(5), state.ExecutionInfo.NextEventID)
+	s.Equal(1, len(state.ActivitInfos))
+	s.Equal("activity1", state.ActivitInfos[4].ActivityID)
+
+	// the returned state must not be shared with the store
+	state.ActivitInfos[4].ActivityID = "modified"
+	state.ExecutionInfo.NextEventID = 100
+	state, err = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err)
+	s.Equal(int64(5), state.ExecutionInfo.NextEventID)
+	s.Equal("activity1", state.ActivitInfos[4].ActivityID)
+}
+
+func (s *memoryPersistenceSuite) TestContinueAsNewAndFinish() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("continue-as-new-and-finish"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	_, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.Nil(err)
+
+	state, err := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err)
+	info := state.ExecutionInfo
+	info.NextEventID = 5
+	info.State = WorkflowStateCompleted
+	info.CloseStatus = WorkflowCloseStatusContinuedAsNew
+	newExecution := gen.WorkflowExecution{
+		WorkflowId: workflowExecution.WorkflowId,
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	s.Nil(s.ContinueAsNewExecution(info, 3, newExecution, 3, 2))
+
+	runID, err := s.GetCurrentWorkflowRunID(domainID, workflowExecution.GetWorkflowId())
+	s.Nil(err)
+	s.Equal(newExecution.GetRunId(), runID)
+
+	state, err = s.GetWorkflowExecutionInfo(domainID, newExecution)
+	s.Nil(err)
+	info = state.ExecutionInfo
+	info.NextEventID = 6
+	info.State = WorkflowStateCompleted
+	info.CloseStatus = WorkflowCloseStatusCompleted
+	s.Nil(s.UpdateWorkflowExecutionAndFinish(info, 3))
+
+	current, err := s.WorkflowMgr.GetCurrentExecution(&GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+	})
+	s.Nil(err)
+	s.Equal(newExecution.GetRunId(), current.RunID)
+	s.Equal(WorkflowStateCompleted, current.State)
+	s.Equal(WorkflowCloseStatusCompleted, current.CloseStatus)
+
+	s.Nil(s.DeleteWorkflowExecution(info))
+	_, err = s.GetWorkflowExecutionInfo(domainID, newExecution)
+	s.IsType(&gen.EntityNotExistsError{}, err)
+}
+
+func (s *memoryPersistenceSuite) TestCreateWorkflowExecutionWithResetSnapshot() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("create-workflow-reset-snapshot"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	requestID := uuid.New()
+	now := time.Now()
+	executionInfo := &WorkflowExecutionInfo{
+		DomainID:             domainID,
+		WorkflowID:           workflowExecution.GetWorkflowId(),
+		RunID:                workflowExecution.GetRunId(),
+		ParentDomainID:       emptyDomainID,
+		ParentRunID:          emptyRunID,
+		InitiatedID:          emptyInitiatedID,
+		TaskList:             "queue1",
+		WorkflowTypeName:     "wType",
+		WorkflowTimeout:      20,
+		DecisionTimeoutValue: 13,
+		State:                WorkflowStateRunning,
+		CloseStatus:          WorkflowCloseStatusNone,
+		LastFirstEventID:     8,
+		NextEventID:          10,
+		LastProcessedEvent:   4,
+		StartTimestamp:       now,
+		LastUpdatedTimestamp: now,
+		CreateRequestID:      requestID,
+		DecisionScheduleID:   9,
+		DecisionStartedID:    common.EmptyEventID,
+		DecisionTimeout:      13,
+	}
+	_, err := s.WorkflowMgr.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{
+		RequestID:          requestID,
+		DomainID:           domainID,
+		Execution:          workflowExecution,
+		TaskList:           "queue1",
+		WorkflowTypeName:   "wType",
+		NextEventID:        10,
+		RangeID:            s.ShardInfo.RangeID,
+		DecisionScheduleID: 9,
+		DecisionStartedID:  common.EmptyEventID,
+		ResetSnapshot: &ResetMutableStateRequest{
+			ExecutionInfo:            executionInfo,
+			InsertActivityInfos:      []*ActivityInfo{{ScheduleID: 5, StartedID: common.EmptyEventID, ActivityID: "activity1"}},
+			InsertTimerInfos:         []*TimerInfo{{TimerID: "timer1", StartedID: 6, ExpiryTime: now, TaskID: 1}},
+			InsertSignalRequestedIDs: []string{uuid.New()},
+		},
+	})
+	s.Nil(err)
+
+	state, err := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err)
+	s.Equal(WorkflowStateRunning, state.ExecutionInfo.State)
+	s.Equal(int64(10), state.ExecutionInfo.NextEventID)
+	s.Equal(int64(8), state.ExecutionInfo.LastFirstEventID)
+	s.Equal(int64(4), state.ExecutionInfo.LastProcessedEvent)
+	s.Equal(int64(9), state.ExecutionInfo.DecisionScheduleID)
+	s.Equal(requestID, state.ExecutionInfo.CreateRequestID)
+	s.Equal(1, len(state.ActivitInfos))
+	s.Equal("activity1", state.ActivitInfos[5].ActivityID)
+	s.Equal(1, len(state.TimerInfos))
+	s.Equal(int64(6), state.TimerInfos["timer1"].StartedID)
+	s.Equal(1, len(state.SignalRequestedIDs))
+
+	runID, err := s.GetCurrentWorkflowRunID(domainID, workflowExecution.GetWorkflowId())
+	s.Nil(err)
+	s.Equal(workflowExecution.GetRunId(), runID)
+}
+
+func (s *memoryPersistenceSuite) TestTransferAndTimerTasks() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("transfer-and-timer-tasks"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	now := time.Now()
+	timerTasks := []Task{
+		&UserTimerTask{VisibilityTimestamp: now.Add(2 * time.Second), TaskID: s.GetNextSequenceNumber(), EventID: 2},
+		&UserTimerTask{VisibilityTimestamp: now.Add(time.Second), TaskID: s.GetNextSequenceNumber(), EventID: 3},
+	}
+	_, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2,
+		timerTasks)
+	s.Nil(err)
+
+	transferTasks, err := s.GetTransferTasks(10)
+	s.Nil(err)
+	s.Equal(1, len(transferTasks))
+	s.Equal(TransferTaskTypeDecisionTask, transferTasks[0].TaskType)
+	s.Nil(s.CompleteTransferTask(transferTasks[0].TaskID))
+
+	timers, err := s.GetTimerIndexTasks()
+	s.Nil(err)
+	s.Equal(2, len(timers))
+	s.Equal(int64(3), timers[0].EventID)
+	s.Equal(int64(2), timers[1].EventID)
+	s.Nil(s.CompleteTimerTask(timers[0].VisibilityTimestamp, timers[0].TaskID))
+
+	timers, err = s.GetTimerIndexTasks()
+	s.Nil(err)
+	s.Equal(1, len(timers))
+	s.Equal(int64(2), timers[0].EventID)
+}
+
+func (s *memoryPersistenceSuite) TestTaskListRangeID() {
+	domainID := uuid.New()
+	taskList := "range-id-task-list"
+	response, err := s.TaskMgr.LeaseTaskList(&LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: TaskListTypeActivity,
+	})
+	s.Nil(err)
+	staleInfo := response.TaskListInfo
+	s.Equal(int64(initialRangeID), staleInfo.RangeID)
+
+	response, err = s.TaskMgr.LeaseTaskList(&LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: TaskListTypeActivity,
+	})
+	s.Nil(err)
+	tli := response.TaskListInfo
+	s.Equal(staleInfo.RangeID+1, tli.RangeID)
+
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("task-list-range-id"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	tasks := []*CreateTaskInfo{{
+		TaskID:    s.GetNextSequenceNumber(),
+		Execution: workflowExecution,
+		Data:      &TaskInfo{ScheduleID: 5},
+	}}
+	_, err = s.TaskMgr.CreateTasks(&CreateTasksRequest{TaskListInfo: staleInfo, Tasks: tasks})
+	s.IsType(&ConditionFailedError{}, err)
+	_, err = s.TaskMgr.UpdateTaskList(&UpdateTaskListRequest{TaskListInfo: staleInfo})
+	s.IsType(&ConditionFailedError{}, err)
+
+	_, err = s.TaskMgr.CreateTasks(&CreateTasksRequest{TaskListInfo: tli, Tasks: tasks})
+	s.Nil(err)
+	tli.AckLevel = 1
+	_, err = s.TaskMgr.UpdateTaskList(&UpdateTaskListRequest{TaskListInfo: tli})
+	s.Nil(err)
+
+	getResponse, err := s.GetTasks(domainID, taskList, TaskListTypeActivity, 10)
+	s.Nil(err)
+	s.Equal(1, len(getResponse.Tasks))
+	s.Equal(int64(5), getResponse.Tasks[0].ScheduleID)
+	s.Equal(workflowExecution.GetRunId(), getResponse.Tasks[0].RunID)
+
+	s.Nil(s.CompleteTask(domainID, taskList, TaskListTypeActivity, getResponse.Tasks[0].TaskID, 100))
+	getResponse, err = s.GetTasks(domainID, taskList, TaskListTypeActivity, 10)
+	s.Nil(err)
+	s.Equal(0, len(getResponse.Tasks))
+}
+
+func (s *memoryPersistenceSuite) TestHistoryEvents() {
+	domainID := uuid.New()
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("history-events"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	appendEvents := func(firstEventID, txID int64, overwrite bool) error {
+		return s.HistoryMgr.AppendHistoryEvents(&AppendHistoryEventsRequest{
+			DomainID:      domainID,
+			Execution:     workflowExecution,
+			FirstEventID:  firstEventID,
+			RangeID:       1,
+			TransactionID: txID,
+			Events:        &SerializedHistoryEventBatch{EncodingType: common.EncodingTypeJSON, Data: []byte("events")},
+			Overwrite:     overwrite,
+		})
+	}
+	s.Nil(appendEvents(1, 1, false))
+	s.Nil(appendEvents(3, 2, false))
+	s.Nil(appendEvents(5, 3, false))
+
+	s.IsType(&ConditionFailedError{}, appendEvents(3, 4, false))
+	s.IsType(&ConditionFailedError{}, appendEvents(3, 1, true))
+	s.Nil(appendEvents(3, 4, true))
+
+	response, err := s.HistoryMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+		DomainID:     domainID,
+		Execution:    workflowExecution,
+		FirstEventID: 1,
+		NextEventID:  7,
+		PageSize:     2,
+	})
+	s.Nil(err)
+	s.Equal(2, len(response.Events))
+	s.NotNil(response.NextPageToken)
+
+	response, err = s.HistoryMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+		DomainID:      domainID,
+		Execution:     workflowExecution,
+		FirstEventID:  1,
+		NextEventID:   7,
+		PageSize:      2,
+		NextPageToken: response.NextPageToken,
+	})
+	s.Nil(err)
+	s.Equal(1, len(response.Events))
+	s.Nil(response.NextPageToken)
+
+	s.Nil(s.HistoryMgr.DeleteWorkflowExecutionHistory(&DeleteWorkflowExecutionHistoryRequest{
+		DomainID:  domainID,
+		Execution: workflowExecution,
+	}))
+	_, err = s.HistoryMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+		DomainID:     domainID,
+		Execution:    workflowExecution,
+		FirstEventID: 1,
+		NextEventID:  7,
+		PageSize:     2,
+	})
+	s.IsType(&gen.EntityNotExistsError{}, err)
+}
+
+func (s *memoryPersistenceSuite) TestDomainDBVersion() {
+	id := uuid.New()
+	name := "memory-domain-db-version"
+	_, err := s.MetadataManager.CreateDomain(&CreateDomainRequest{
+		Info:              &DomainInfo{ID: id, Name: name, Status: DomainStatusRegistered},
+		Config:            &DomainConfig{Retention: 1},
+		ReplicationConfig: &DomainReplicationConfig{},
+	})
+	s.Nil(err)
+
+	_, err = s.MetadataManager.CreateDomain(&CreateDomainRequest{
+		Info:   &DomainInfo{ID: uuid.New(), Name: name, Status: DomainStatusRegistered},
+		Config: &DomainConfig{Retention: 1},
+	})
+	s.IsType(&gen.DomainAlreadyExistsError{}, err)
+
+	resp, err := s.MetadataManager.GetDomain(&GetDomainRequest{Name: name})
+	s.Nil(err)
+	s.Equal(id, resp.Info.ID)
+	s.Equal(int64(0), resp.DBVersion)
+	s.Equal(s.ClusterMetadata.GetCurrentClusterName(), resp.ReplicationConfig.ActiveClusterName)
+
+	resp.Config.Retention = 7
+	updateRequest := &UpdateDomainRequest{
+		Info:              resp.Info,
+		Config:            resp.Config,
+		ReplicationConfig: resp.ReplicationConfig,
+		DBVersion:         resp.DBVersion,
+	}
+	s.Nil(s.MetadataManager.UpdateDomain(updateRequest))
+	s.IsType(&ConditionFailedError{}, s.MetadataManager.UpdateDomain(updateRequest))
+
+	resp, err = s.MetadataManager.GetDomain(&GetDomainRequest{ID: id})
+	s.Nil(err)
+	s.Equal(int32(7), resp.Config.Retention)
+	s.Equal(int64(1), resp.DBVersion)
+
+	s.Nil(s.MetadataManager.DeleteDomainByName(&DeleteDomainByNameRequest{Name: name}))
+	_, err = s.MetadataManager.GetDomain(&GetDomainRequest{ID: id})
+	s.IsType(&gen.EntityNotExistsError{}, err)
+}
+
+func (s *memoryPersistenceSuite) TestListDomainsPaging() {
+	names := []string{"memory-list-domains-b", "memory-list-domains-a", "memory-list-domains-c"}
+	for _, name := range names {
+		_, err := s.MetadataManager.CreateDomain(&CreateDomainRequest{
+			Info:              &DomainInfo{ID: uuid.New(), Name: name, Status: DomainStatusRegistered},
+			Config:            &DomainConfig{Retention: 1},
+			ReplicationConfig: &DomainReplicationConfig{},
+		})
+		s.Nil(err)
+		defer s.MetadataManager.DeleteDomainByName(&DeleteDomainByNameRequest{Name: name})
+	}
+
+	var listed []string
+	var token []byte
+	for {
+		resp, err := s.MetadataManager.ListDomains(&ListDomainsRequest{PageSize: 2, NextPageToken: token})
+		s.Nil(err)
+		s.True(len(resp.Domains) <= 2)
+		for _, domain := range resp.Domains {
+			s.Equal(s.ClusterMetadata.GetCurrentClusterName(), domain.ReplicationConfig.ActiveClusterName)
+			listed = append(listed, domain.Info.Name)
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		token = resp.NextPageToken
+	}
+	for _, name := range names {
+		s.Contains(listed, name)
+	}
+	for i := 1; i < len(listed); i++ {
+		s.True(listed[i-1] < listed[i])
+	}
+}
+
+func (s *memoryPersistenceSuite) TestVisibilityPaging() {
+	domainID := uuid.New()
+	startTime := time.Now().UnixNano()
+	for i := 0; i < 3; i++ {
+		s.Nil(s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+			DomainUUID: domainID,
+			Execution: gen.WorkflowExecution{
+				WorkflowId: common.StringPtr("visibility-paging"),
+				RunId:      common.StringPtr(uuid.New()),
+			},
+			WorkflowTypeName: "visibility-type",
+			StartTimestamp:   startTime + int64(i),
+		}))
+	}
+	closedExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-paging-closed"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	s.Nil(s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       domainID,
+		Execution:        closedExecution,
+		WorkflowTypeName: "visibility-type",
+		StartTimestamp:   startTime,
+	}))
+	s.Nil(s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       domainID,
+		Execution:        closedExecution,
+		WorkflowTypeName: "visibility-type",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   startTime + 10,
+		Status:           gen.WorkflowExecutionCloseStatusCompleted,
+		HistoryLength:    3,
+	}))
+
+	request := &ListWorkflowExecutionsRequest{
+		DomainUUID:        domainID,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime + 10,
+		PageSize:          2,
+	}
+	resp, err := s.VisibilityMgr.ListOpenWorkflowExecutions(request)
+	s.Nil(err)
+	s.Equal(2, len(resp.Executions))
+	s.Equal(startTime+2, resp.Executions[0].GetStartTime())
+	s.Equal(startTime+1, resp.Executions[1].GetStartTime())
+
+	request.NextPageToken = resp.NextPageToken
+	resp, err = s.VisibilityMgr.ListOpenWorkflowExecutions(request)
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(startTime, resp.Executions[0].GetStartTime())
+
+	closed, err := s.VisibilityMgr.GetClosedWorkflowExecution(&GetClosedWorkflowExecutionRequest{
+		DomainUUID: domainID,
+		Execution:  closedExecution,
+	})
+	s.Nil(err)
+	s.Equal(gen.WorkflowExecutionCloseStatusCompleted, closed.Execution.GetCloseStatus())
+	s.Equal(int64(3), closed.Execution.GetHistoryLength())
+}
+
+func (s *memoryPersistenceSuite) TestVisibilityQuery() {
+	domainID := uuid.New()
+	startTime := time.Now().UnixNano()
+	for i := 0; i < 3; i++ {
+		execution := gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("visibility-query"),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		s.Nil(s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       domainID,
+			Execution:        execution,
+			WorkflowTypeName: "visibility-type",
+			StartTimestamp:   startTime + int64(i),
+		}))
+		s.Nil(s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+			DomainUUID:       domainID,
+			Execution:        execution,
+			WorkflowTypeName: "visibility-type",
+			StartTimestamp:   startTime + int64(i),
+			CloseTimestamp:   startTime + int64(10-i),
+			Status:           gen.WorkflowExecutionCloseStatusFailed,
+		}))
+	}
+	s.Nil(s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID: domainID,
+		Execution: gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("visibility-query-open"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		WorkflowTypeName: "visibility-type",
+		StartTimestamp:   startTime,
+	}))
+
+	request := &ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: domainID,
+		Query: &VisibilityQuery{
+			Predicates: []*VisibilityQueryPredicate{
+				{
+					Field:    VisibilityQueryFieldWorkflowType,
+					Operator: VisibilityQueryOperatorEqual,
+					Value:    "visibility-type",
+				},
+				{
+					Field:    VisibilityQueryFieldCloseStatus,
+					Operator: VisibilityQueryOperatorEqual,
+					Value:    gen.WorkflowExecutionCloseStatusFailed,
+				},
+				{
+					Field:    VisibilityQueryFieldStartTime,
+					Operator: VisibilityQueryOperatorGreaterThanOrEqual,
+					Value:    startTime,
+				},
+			},
+			OrderBy:   VisibilityQueryFieldCloseTime,
+			Ascending: true,
+		},
+		PageSize: 2,
+	}
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(request)
+	s.Nil(err)
+	s.Equal(2, len(resp.Executions))
+	s.Equal(startTime+8, resp.Executions[0].GetCloseTime())
+	s.Equal(startTime+9, resp.Executions[1].GetCloseTime())
+
+	request.NextPageToken = resp.NextPageToken
+	resp, err = s.VisibilityMgr.ListWorkflowExecutions(request)
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(startTime+10, resp.Executions[0].GetCloseTime())
+	s.Nil(resp.NextPageToken)
+
+	resp, err = s.VisibilityMgr.ListWorkflowExecutions(&ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: domainID,
+		Query: &VisibilityQuery{
+			Predicates: []*VisibilityQueryPredicate{{
+				Field:    VisibilityQueryFieldExecutionStatus,
+				Operator: VisibilityQueryOperatorEqual,
+				Value:    VisibilityExecutionStatusOpen,
+			}},
+			OrderBy: VisibilityQueryFieldStartTime,
+		},
+		PageSize: 10,
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Equal("visibility-query-open", resp.Executions[0].Execution.GetWorkflowId())
+}
+
+func (s *memoryPersistenceSuite) TestVisibilityCount() {
+	domainID := uuid.New()
+	startTime := time.Now().UnixNano()
+	for i, typeName := range []string{"count-type-1", "count-type-1", "count-type-2"} {
+		s.Nil(s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+			DomainUUID: domainID,
+			Execution: gen.WorkflowExecution{
+				WorkflowId: common.StringPtr("visibility-count"),
+				RunId:      common.StringPtr(uuid.New()),
+			},
+			WorkflowTypeName: typeName,
+			StartTimestamp:   startTime + int64(i),
+		}))
+	}
+	s.Nil(s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+		DomainUUID: domainID,
+		Execution: gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("visibility-count-closed"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		WorkflowTypeName: "count-type-2",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   startTime + 10,
+		Status:           gen.WorkflowExecutionCloseStatusFailed,
+	}))
+
+	openQuery := &VisibilityQuery{
+		Predicates: []*VisibilityQueryPredicate{{
+			Field:    VisibilityQueryFieldExecutionStatus,
+			Operator: VisibilityQueryOperatorEqual,
+			Value:    VisibilityExecutionStatusOpen,
+		}},
+		OrderBy: VisibilityQueryFieldStartTime,
+	}
+	resp, err := s.VisibilityMgr.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Query:      openQuery,
+	})
+	s.Nil(err)
+	s.Equal(int64(3), resp.Count)
+	s.Nil(resp.Groups)
+
+	resp, err = s.VisibilityMgr.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Query:      openQuery,
+		GroupBy:    VisibilityQueryFieldWorkflowType,
+	})
+	s.Nil(err)
+	s.Equal(int64(3), resp.Count)
+	s.Equal(2, len(resp.Groups))
+	s.Equal("count-type-1", resp.Groups[0].GetWorkflowType())
+	s.Equal(int64(2), resp.Groups[0].GetCount())
+	s.Equal("count-type-2", resp.Groups[1].GetWorkflowType())
+	s.Equal(int64(1), resp.Groups[1].GetCount())
+
+	resp, err = s.VisibilityMgr.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Query:      &VisibilityQuery{OrderBy: VisibilityQueryFieldStartTime},
+		GroupBy:    VisibilityQueryFieldCloseStatus,
+	})
+	s.Nil(err)
+	s.Equal(int64(4), resp.Count)
+	s.Equal(2, len(resp.Groups))
+	s.Nil(resp.Groups[0].CloseStatus)
+	s.Equal(int64(3), resp.Groups[0].GetCount())
+	s.Equal(gen.WorkflowExecutionCloseStatusFailed, resp.Groups[1].GetCloseStatus())
+	s.Equal(int64(1), resp.Groups[1].GetCount())
+}
@@ -0,0 +1,384 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+)
+
+type (
+	memoryVisibilityKey struct {
+		domainID string
+		runID    string
+	}
+
+	memoryVisibilityPersistence struct {
+		store  *memoryStore
+		logger logging.Logger
+	}
+
+	// memoryVisibilityPageToken is the position of the last execution returned by a page
+	memoryVisibilityPageToken struct {
+		StartTime int64
+		RunID     string
+	}
+
+	// memoryVisibilityFilter selects the execution records returned by a list call
+	memoryVisibilityFilter func(record *workflow.WorkflowExecutionInfo) bool
+)
+
+// NewMemoryVisibilityPersistence is used to create an instance of VisibilityManager implementation
+func NewMemoryVisibilityPersistence(logger logging.Logger) (VisibilityManager, error) {
+	return newMemoryVisibilityPersistence(getDefaultMemoryStore(), logger), nil
+}
+
+func newMemoryVisibilityPersistence(store *memoryStore, logger logging.Logger) VisibilityManager {
+	return &memoryVisibilityPersistence{store: store, logger: logger}
+}
+
+// Close is a no-op, the data is kept for the lifetime of the process
+func (v *memoryVisibilityPersistence) Close() {
+}
+
+func (v *memoryVisibilityPersistence) RecordWorkflowExecutionStarted(
+	request *RecordWorkflowExecutionStartedRequest) error {
+	v.store.Lock()
+	defer v.store.Unlock()
+
+	key := memoryVisibilityKey{domainID: request.DomainUUID, runID: request.Execution.GetRunId()}
+	if _, ok := v.store.visibility[key]; ok {
+		// the record is written again when a transfer task is retried
+		return nil
+	}
+	v.store.visibility[key] = &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(request.Execution.GetWorkflowId()),
+			RunId:      common.StringPtr(request.Execution.GetRunId()),
+		},
+		Type:             &workflow.WorkflowType{Name: common.StringPtr(request.WorkflowTypeName)},
+		StartTime:        common.Int64Ptr(request.StartTimestamp),
+		SearchAttributes: NewSearchAttributes(request.SearchAttributes),
+	}
+	return nil
+}
+
+func (v *memoryVisibilityPersistence) UpsertWorkflowExecution(
+	request *UpsertWorkflowExecutionRequest) error {
+	v.store.Lock()
+	defer v.store.Unlock()
+
+	key := memoryVisibilityKey{domainID: request.DomainUUID, runID: request.Execution.GetRunId()}
+	if record, ok := v.store.visibility[key]; ok && isClosedExecutionRecord(record) {
+		// the execution has been closed after the upsert was issued
+		return nil
+	}
+	v.store.visibility[key] = &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(request.Execution.GetWorkflowId()),
+			RunId:      common.StringPtr(request.Execution.GetRunId()),
+		},
+		Type:             &workflow.WorkflowType{Name: common.StringPtr(request.WorkflowTypeName)},
+		StartTime:        common.Int64Ptr(request.StartTimestamp),
+		SearchAttributes: NewSearchAttributes(request.SearchAttributes),
+	}
+	return nil
+}
+
+// RecordWorkflowExecutionClosed replaces the open record of the execution with a closed one.  Unlike
+// the cassandra store the closed records are not expired based on the retention of the domain.
+func (v *memoryVisibilityPersistence) RecordWorkflowExecutionClosed(
+	request *RecordWorkflowExecutionClosedRequest) error {
+	v.store.Lock()
+	defer v.store.Unlock()
+
+	status := request.Status
+	key := memoryVisibilityKey{domainID: request.DomainUUID, runID: request.Execution.GetRunId()}
+	v.store.visibility[key] = &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(request.Execution.GetWorkflowId()),
+			RunId:      common.StringPtr(request.Execution.GetRunId()),
+		},
+		Type:             &workflow.WorkflowType{Name: common.StringPtr(request.WorkflowTypeName)},
+		StartTime:        common.Int64Ptr(request.StartTimestamp),
+		CloseTime:        common.Int64Ptr(request.CloseTimestamp),
+		CloseStatus:      &status,
+		HistoryLength:    common.Int64Ptr(request.HistoryLength),
+		SearchAttributes: NewSearchAttributes(request.SearchAttributes),
+	}
+	return nil
+}
+
+func (v *memoryVisibilityPersistence) ListOpenWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutions", request, isOpenExecutionRecord)
+}
+
+func (v *memoryVisibilityPersistence) ListClosedWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutions", request, isClosedExecutionRecord)
+}
+
+func (v *memoryVisibilityPersistence) ListOpenWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest,
+		func(record *workflow.WorkflowExecutionInfo) bool {
+			return isOpenExecutionRecord(record) && record.Type.GetName() == request.WorkflowTypeName
+		})
+}
+
+func (v *memoryVisibilityPersistence) ListClosedWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest,
+		func(record *workflow.WorkflowExecutionInfo) bool {
+			return isClosedExecutionRecord(record) && record.Type.GetName() == request.WorkflowTypeName
+		})
+}
+
+func (v *memoryVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", &request.ListWorkflowExecutionsRequest,
+		func(record *workflow.WorkflowExecutionInfo) bool {
+			return isOpenExecutionRecord(record) && record.Execution.GetWorkflowId() == request.WorkflowID
+		})
+}
+
+func (v *memoryVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID",
+		&request.ListWorkflowExecutionsRequest, func(record *workflow.WorkflowExecutionInfo) bool {
+			return isClosedExecutionRecord(record) && record.Execution.GetWorkflowId() == request.WorkflowID
+		})
+}
+
+func (v *memoryVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
+	request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus", &request.ListWorkflowExecutionsRequest,
+		func(record *workflow.WorkflowExecutionInfo) bool {
+			return isClosedExecutionRecord(record) && record.GetCloseStatus() == request.Status
+		})
+}
+
+func (v *memoryVisibilityPersistence) ListOpenWorkflowExecutionsBySearchAttributes(
+	request *ListWorkflowExecutionsBySearchAttributesRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsBySearchAttributes", &request.ListWorkflowExecutionsRequest,
+		func(record *workflow.WorkflowExecutionInfo) bool {
+			return isOpenExecutionRecord(record) &&
+				MatchSearchAttributes(GetSearchAttributesFields(record.SearchAttributes), request.SearchAttributes)
+		})
+}
+
+func (v *memoryVisibilityPersistence) ListClosedWorkflowExecutionsBySearchAttributes(
+	request *ListWorkflowExecutionsBySearchAttributesRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsBySearchAttributes", &request.ListWorkflowExecutionsRequest,
+		func(record *workflow.WorkflowExecutionInfo) bool {
+			return isClosedExecutionRecord(record) &&
+				MatchSearchAttributes(GetSearchAttributesFields(record.SearchAttributes), request.SearchAttributes)
+		})
+}
+
+// ListWorkflowExecutions lists both open and closed executions matching the query
+func (v *memoryVisibilityPersistence) ListWorkflowExecutions(
+	request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	query := request.Query
+	token, err := deserializeVisibilityQueryPageToken("ListWorkflowExecutions", request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	v.store.Lock()
+	defer v.store.Unlock()
+
+	var records []*workflow.WorkflowExecutionInfo
+	for key, record := range v.store.visibility {
+		if key.domainID != request.DomainUUID || !MatchVisibilityQuery(record, query) {
+			continue
+		}
+		if token != nil {
+			sortTime := getVisibilityQuerySortTime(record, query)
+			if (query.Ascending && sortTime < token.SortTime) || (!query.Ascending && sortTime > token.SortTime) ||
+				(sortTime == token.SortTime && record.Execution.GetRunId() <= token.RunID) {
+				continue
+			}
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		left := getVisibilityQuerySortTime(records[i], query)
+		right := getVisibilityQuerySortTime(records[j], query)
+		if left != right {
+			return (left < right) == query.Ascending
+		}
+		return records[i].Execution.GetRunId() < records[j].Execution.GetRunId()
+	})
+	if request.PageSize > 0 && len(records) > request.PageSize {
+		records = records[:request.PageSize]
+	}
+
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0, len(records))
+	for _, record := range records {
+		response.Executions = append(response.Executions, cloneExecutionRecord(record))
+	}
+
+	if request.PageSize > 0 && len(response.Executions) == request.PageSize {
+		last := response.Executions[len(response.Executions)-1]
+		if response.NextPageToken, err = serializeVisibilityQueryPageToken("ListWorkflowExecutions", last,
+			query); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (v *memoryVisibilityPersistence) CountWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	v.store.Lock()
+	defer v.store.Unlock()
+
+	response := &CountWorkflowExecutionsResponse{}
+	for key, record := range v.store.visibility {
+		if key.domainID != request.DomainUUID || !MatchVisibilityQuery(record, request.Query) {
+			continue
+		}
+		response.Count++
+		response.Groups = addToCountWorkflowExecutionsGroups(response.Groups, request.GroupBy, record.Type.GetName(),
+			record.CloseStatus, 1)
+	}
+	sortCountWorkflowExecutionsGroups(response.Groups)
+	return response, nil
+}
+
+func (v *memoryVisibilityPersistence) GetClosedWorkflowExecution(
+	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	v.store.Lock()
+	defer v.store.Unlock()
+
+	execution := request.Execution
+	record, ok := v.store.visibility[memoryVisibilityKey{domainID: request.DomainUUID, runID: execution.GetRunId()}]
+	if !ok || !isClosedExecutionRecord(record) {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+	return &GetClosedWorkflowExecutionResponse{
+		Execution: cloneExecutionRecord(record),
+	}, nil
+}
+
+func (v *memoryVisibilityPersistence) listWorkflowExecutions(operation string, request *ListWorkflowExecutionsRequest,
+	filter memoryVisibilityFilter) (*ListWorkflowExecutionsResponse, error) {
+	token := &memoryVisibilityPageToken{StartTime: request.LatestStartTime}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &workflow.BadRequestError{
+				Message: fmt.Sprintf("%v operation failed. Invalid next page token. Error: %v", operation, err),
+			}
+		}
+	}
+
+	v.store.Lock()
+	defer v.store.Unlock()
+
+	var records []*workflow.WorkflowExecutionInfo
+	for key, record := range v.store.visibility {
+		if key.domainID != request.DomainUUID || !filter(record) {
+			continue
+		}
+		startTime := record.GetStartTime()
+		if startTime < request.EarliestStartTime || startTime > token.StartTime ||
+			(startTime == token.StartTime && record.Execution.GetRunId() <= token.RunID) {
+			continue
+		}
+		records = append(records, record)
+	}
+	// Executions are listed by descending start time, the run id breaks ties between
+	// executions started at the same time so that pages are stable
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].GetStartTime() != records[j].GetStartTime() {
+			return records[i].GetStartTime() > records[j].GetStartTime()
+		}
+		return records[i].Execution.GetRunId() < records[j].Execution.GetRunId()
+	})
+	if request.PageSize > 0 && len(records) > request.PageSize {
+		records = records[:request.PageSize]
+	}
+
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0, len(records))
+	for _, record := range records {
+		response.Executions = append(response.Executions, cloneExecutionRecord(record))
+	}
+
+	if request.PageSize > 0 && len(response.Executions) == request.PageSize {
+		last := response.Executions[len(response.Executions)-1]
+		nextPageToken, err := json.Marshal(&memoryVisibilityPageToken{
+			StartTime: last.GetStartTime(),
+			RunID:     last.Execution.GetRunId(),
+		})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+			}
+		}
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
+func isOpenExecutionRecord(record *workflow.WorkflowExecutionInfo) bool {
+	return record.CloseTime == nil
+}
+
+func isClosedExecutionRecord(record *workflow.WorkflowExecutionInfo) bool {
+	return record.CloseTime != nil
+}
+
+func cloneExecutionRecord(record *workflow.WorkflowExecutionInfo) *workflow.WorkflowExecutionInfo {
+	copied := &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(record.Execution.GetWorkflowId()),
+			RunId:      common.StringPtr(record.Execution.GetRunId()),
+		},
+		Type:      &workflow.WorkflowType{Name: common.StringPtr(record.Type.GetName())},
+		StartTime: common.Int64Ptr(record.GetStartTime()),
+	}
+	if indexedFields := GetSearchAttributesFields(record.SearchAttributes); len(indexedFields) > 0 {
+		copiedFields := make(map[string][]byte, len(indexedFields))
+		for key, value := range indexedFields {
+			copiedFields[key] = append([]byte(nil), value...)
+		}
+		copied.SearchAttributes = NewSearchAttributes(copiedFields)
+	}
+	if isClosedExecutionRecord(record) {
+		status := record.GetCloseStatus()
+		copied.CloseTime = common.Int64Ptr(record.GetCloseTime())
+		copied.CloseStatus = &status
+		copied.HistoryLength = common.Int64Ptr(record.GetHistoryLength())
+	}
+	return copied
+}
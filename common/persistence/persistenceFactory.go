@@ -0,0 +1,156 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// Factory creates the persistence managers for the store selected in the config
+	Factory interface {
+		NewShardManager() (ShardManager, error)
+		NewTaskManager() (TaskManager, error)
+		NewHistoryManager(numConns int) (HistoryManager, error)
+		NewMetadataManager() (MetadataManager, error)
+		NewVisibilityManager() (VisibilityManager, error)
+		NewExecutionManagerFactory(numConns int, metricsClient metrics.Client,
+			tracer opentracing.Tracer) (ExecutionManagerFactory, error)
+	}
+
+	cassandraFactory struct {
+		cfg                *config.Cassandra
+		currentClusterName string
+		logger             logging.Logger
+	}
+
+	sqlFactory struct {
+		cfg                *config.SQL
+		currentClusterName string
+		logger             logging.Logger
+	}
+
+	memoryFactory struct {
+		store              *memoryStore
+		currentClusterName string
+		logger             logging.Logger
+	}
+)
+
+// NewFactory returns a Factory for the store selected by the persistence config
+func NewFactory(cfg *config.Persistence, cassandraCfg *config.Cassandra, currentClusterName string,
+	logger logging.Logger) Factory {
+	switch cfg.GetStoreType() {
+	case config.StoreTypeSQL:
+		return &sqlFactory{cfg: cfg.SQL, currentClusterName: currentClusterName, logger: logger}
+	case config.StoreTypeMemory:
+		return newMemoryFactory(getDefaultMemoryStore(), currentClusterName, logger)
+	}
+	return &cassandraFactory{cfg: cassandraCfg, currentClusterName: currentClusterName, logger: logger}
+}
+
+func (f *cassandraFactory) NewShardManager() (ShardManager, error) {
+	return NewCassandraShardPersistence(f.cfg.Hosts, f.cfg.Port, f.cfg.User, f.cfg.Password, f.cfg.Datacenter,
+		f.cfg.Keyspace, f.currentClusterName, f.logger)
+}
+
+func (f *cassandraFactory) NewTaskManager() (TaskManager, error) {
+	return NewCassandraTaskPersistence(f.cfg.Hosts, f.cfg.Port, f.cfg.User, f.cfg.Password, f.cfg.Datacenter,
+		f.cfg.Keyspace, f.logger)
+}
+
+func (f *cassandraFactory) NewHistoryManager(numConns int) (HistoryManager, error) {
+	return NewCassandraHistoryPersistence(f.cfg.Hosts, f.cfg.Port, f.cfg.User, f.cfg.Password, f.cfg.Datacenter,
+		f.cfg.Keyspace, numConns, f.logger)
+}
+
+func (f *cassandraFactory) NewMetadataManager() (MetadataManager, error) {
+	return NewCassandraMetadataPersistence(f.cfg.Hosts, f.cfg.Port, f.cfg.User, f.cfg.Password, f.cfg.Datacenter,
+		f.cfg.Keyspace, f.currentClusterName, f.logger)
+}
+
+func (f *cassandraFactory) NewVisibilityManager() (VisibilityManager, error) {
+	return NewCassandraVisibilityPersistence(f.cfg.Hosts, f.cfg.Port, f.cfg.User, f.cfg.Password, f.cfg.Datacenter,
+		f.cfg.VisibilityKeyspace, f.logger)
+}
+
+func (f *cassandraFactory) NewExecutionManagerFactory(numConns int,
+	metricsClient metrics.Client, tracer opentracing.Tracer) (ExecutionManagerFactory, error) {
+	return NewCassandraPersistenceClientFactory(f.cfg.Hosts, f.cfg.Port, f.cfg.User, f.cfg.Password,
+		f.cfg.Datacenter, f.cfg.Keyspace, numConns, f.logger, metricsClient, tracer)
+}
+
+func (f *sqlFactory) NewShardManager() (ShardManager, error) {
+	return NewSQLShardPersistence(f.cfg, f.currentClusterName, f.logger)
+}
+
+func (f *sqlFactory) NewTaskManager() (TaskManager, error) {
+	return NewSQLTaskPersistence(f.cfg, f.logger)
+}
+
+func (f *sqlFactory) NewHistoryManager(numConns int) (HistoryManager, error) {
+	return NewSQLHistoryPersistence(f.cfg, numConns, f.logger)
+}
+
+func (f *sqlFactory) NewMetadataManager() (MetadataManager, error) {
+	return NewSQLMetadataPersistence(f.cfg, f.currentClusterName, f.logger)
+}
+
+func (f *sqlFactory) NewVisibilityManager() (VisibilityManager, error) {
+	return NewSQLVisibilityPersistence(f.cfg, f.logger)
+}
+
+func (f *sqlFactory) NewExecutionManagerFactory(numConns int,
+	metricsClient metrics.Client, tracer opentracing.Tracer) (ExecutionManagerFactory, error) {
+	return NewSQLPersistenceClientFactory(f.cfg, numConns, f.logger, metricsClient, tracer)
+}
+
+func newMemoryFactory(store *memoryStore, currentClusterName string, logger logging.Logger) Factory {
+	return &memoryFactory{store: store, currentClusterName: currentClusterName, logger: logger}
+}
+
+func (f *memoryFactory) NewShardManager() (ShardManager, error) {
+	return newMemoryShardPersistence(f.store, f.currentClusterName, f.logger), nil
+}
+
+func (f *memoryFactory) NewTaskManager() (TaskManager, error) {
+	return newMemoryTaskPersistence(f.store, f.logger), nil
+}
+
+func (f *memoryFactory) NewHistoryManager(numConns int) (HistoryManager, error) {
+	return newMemoryHistoryPersistence(f.store, f.logger), nil
+}
+
+func (f *memoryFactory) NewMetadataManager() (MetadataManager, error) {
+	return newMemoryMetadataPersistence(f.store, f.currentClusterName, f.logger), nil
+}
+
+func (f *memoryFactory) NewVisibilityManager() (VisibilityManager, error) {
+	return newMemoryVisibilityPersistence(f.store, f.logger), nil
+}
+
+func (f *memoryFactory) NewExecutionManagerFactory(numConns int,
+	metricsClient metrics.Client, tracer opentracing.Tracer) (ExecutionManagerFactory, error) {
+	return newMemoryPersistenceClientFactory(f.store, f.logger, metricsClient, tracer), nil
+}
@@ -21,39 +21,55 @@
 package persistence
 
 import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/tracing"
 )
 
 type (
 	shardPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  ShardManager
+		tracer       opentracing.Tracer
+		parent       opentracing.SpanContext
 	}
 
 	workflowExecutionPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  ExecutionManager
+		tracer       opentracing.Tracer
+		parent       opentracing.SpanContext
 	}
 
 	taskPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  TaskManager
+		tracer       opentracing.Tracer
+		parent       opentracing.SpanContext
 	}
 
 	historyPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  HistoryManager
+		tracer       opentracing.Tracer
+		parent       opentracing.SpanContext
 	}
 
 	metadataPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  MetadataManager
+		tracer       opentracing.Tracer
+		parent       opentracing.SpanContext
 	}
 
 	visibilityPersistenceClient struct {
 		metricClient metrics.Client
 		persistence  VisibilityManager
+		tracer       opentracing.Tracer
+		parent       opentracing.SpanContext
 	}
 )
 
@@ -65,59 +81,73 @@ var _ MetadataManager = (*metadataPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityPersistenceClient)(nil)
 
 // NewShardPersistenceClient creates a client to manage shards
-func NewShardPersistenceClient(persistence ShardManager, metricClient metrics.Client) ShardManager {
+func NewShardPersistenceClient(persistence ShardManager, metricClient metrics.Client,
+	tracer opentracing.Tracer) ShardManager {
 	return &shardPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
+		tracer:       tracer,
 	}
 }
 
 // NewWorkflowExecutionPersistenceClient creates a client to manage executions
-func NewWorkflowExecutionPersistenceClient(persistence ExecutionManager, metricClient metrics.Client) ExecutionManager {
+func NewWorkflowExecutionPersistenceClient(persistence ExecutionManager, metricClient metrics.Client,
+	tracer opentracing.Tracer) ExecutionManager {
 	return &workflowExecutionPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
+		tracer:       tracer,
 	}
 }
 
 // NewTaskPersistenceClient creates a client to manage tasks
-func NewTaskPersistenceClient(persistence TaskManager, metricClient metrics.Client) TaskManager {
+func NewTaskPersistenceClient(persistence TaskManager, metricClient metrics.Client,
+	tracer opentracing.Tracer) TaskManager {
 	return &taskPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
+		tracer:       tracer,
 	}
 }
 
 // NewHistoryPersistenceClient creates a HistoryManager client to manage workflow execution history
-func NewHistoryPersistenceClient(persistence HistoryManager, metricClient metrics.Client) HistoryManager {
+func NewHistoryPersistenceClient(persistence HistoryManager, metricClient metrics.Client,
+	tracer opentracing.Tracer) HistoryManager {
 	return &historyPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
+		tracer:       tracer,
 	}
 }
 
 // NewMetadataPersistenceClient creates a MetadataManager client to manage metadata
-func NewMetadataPersistenceClient(persistence MetadataManager, metricClient metrics.Client) MetadataManager {
+func NewMetadataPersistenceClient(persistence MetadataManager, metricClient metrics.Client,
+	tracer opentracing.Tracer) MetadataManager {
 	return &metadataPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
+		tracer:       tracer,
 	}
 }
 
 // NewVisibilityPersistenceClient creates a client to manage visibility
-func NewVisibilityPersistenceClient(persistence VisibilityManager, metricClient metrics.Client) VisibilityManager {
+func NewVisibilityPersistenceClient(persistence VisibilityManager, metricClient metrics.Client,
+	tracer opentracing.Tracer) VisibilityManager {
 	return &visibilityPersistenceClient{
 		persistence:  persistence,
 		metricClient: metricClient,
+		tracer:       tracer,
 	}
 }
 
 func (p *shardPersistenceClient) CreateShard(request *CreateShardRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCreateShardScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceCreateShardScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateShardScope, metrics.PersistenceLatency)
 	err := p.persistence.CreateShard(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		if _, ok := err.(*ShardAlreadyExistError); ok {
@@ -134,9 +164,11 @@ func (p *shardPersistenceClient) GetShard(
 	request *GetShardRequest) (*GetShardResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceGetShardScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetShardScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetShard(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		switch err.(type) {
@@ -153,9 +185,11 @@ func (p *shardPersistenceClient) GetShard(
 func (p *shardPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateShardScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceUpdateShardScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateShardScope, metrics.PersistenceLatency)
 	err := p.persistence.UpdateShard(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		if _, ok := err.(*ShardOwnershipLostError); ok {
@@ -175,9 +209,11 @@ func (p *shardPersistenceClient) Close() {
 func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceCreateWorkflowExecutionScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceLatency)
 	response, err := p.persistence.CreateWorkflowExecution(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCreateWorkflowExecutionScope, err)
@@ -189,9 +225,11 @@ func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(request *Cr
 func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceGetWorkflowExecutionScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetWorkflowExecution(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionScope, err)
@@ -203,9 +241,11 @@ func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(request *GetWo
 func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceUpdateWorkflowExecutionScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceLatency)
 	err := p.persistence.UpdateWorkflowExecution(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceUpdateWorkflowExecutionScope, err)
@@ -217,9 +257,11 @@ func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *Up
 func (p *workflowExecutionPersistenceClient) ResetMutableState(request *ResetMutableStateRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceResetMutableStateScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceResetMutableStateScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceResetMutableStateScope, metrics.PersistenceLatency)
 	err := p.persistence.ResetMutableState(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceResetMutableStateScope, err)
@@ -231,9 +273,11 @@ func (p *workflowExecutionPersistenceClient) ResetMutableState(request *ResetMut
 func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceDeleteWorkflowExecutionScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceLatency)
 	err := p.persistence.DeleteWorkflowExecution(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteWorkflowExecutionScope, err)
@@ -245,9 +289,11 @@ func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *De
 func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceGetCurrentExecutionScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetCurrentExecution(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetCurrentExecutionScope, err)
@@ -259,9 +305,11 @@ func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCur
 func (p *workflowExecutionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceGetTransferTasksScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTransferTasksScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetTransferTasks(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTransferTasksScope, err)
@@ -273,9 +321,11 @@ func (p *workflowExecutionPersistenceClient) GetTransferTasks(request *GetTransf
 func (p *workflowExecutionPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTasksScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceGetReplicationTasksScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetReplicationTasksScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetReplicationTasks(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetReplicationTasksScope, err)
@@ -287,9 +337,11 @@ func (p *workflowExecutionPersistenceClient) GetReplicationTasks(request *GetRep
 func (p *workflowExecutionPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTransferTaskScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceCompleteTransferTaskScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTransferTaskScope, metrics.PersistenceLatency)
 	err := p.persistence.CompleteTransferTask(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteTransferTaskScope, err)
@@ -301,9 +353,11 @@ func (p *workflowExecutionPersistenceClient) CompleteTransferTask(request *Compl
 func (p *workflowExecutionPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceCompleteReplicationTaskScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceLatency)
 	err := p.persistence.CompleteReplicationTask(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteReplicationTaskScope, err)
@@ -315,9 +369,11 @@ func (p *workflowExecutionPersistenceClient) CompleteReplicationTask(request *Co
 func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceGetTimerIndexTasksScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTimerIndexTasksScope, metrics.PersistenceLatency)
 	resonse, err := p.persistence.GetTimerIndexTasks(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTimerIndexTasksScope, err)
@@ -329,9 +385,11 @@ func (p *workflowExecutionPersistenceClient) GetTimerIndexTasks(request *GetTime
 func (p *workflowExecutionPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTimerTaskScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceCompleteTimerTaskScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTimerTaskScope, metrics.PersistenceLatency)
 	err := p.persistence.CompleteTimerTask(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteTimerTaskScope, err)
@@ -366,9 +424,11 @@ func (p *workflowExecutionPersistenceClient) Close() {
 func (p *taskPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateTaskScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceCreateTaskScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateTaskScope, metrics.PersistenceLatency)
 	response, err := p.persistence.CreateTasks(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCreateTaskScope, err)
@@ -380,9 +440,11 @@ func (p *taskPersistenceClient) CreateTasks(request *CreateTasksRequest) (*Creat
 func (p *taskPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTasksScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceGetTasksScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTasksScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetTasks(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTasksScope, err)
@@ -394,9 +456,11 @@ func (p *taskPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksRes
 func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTaskScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceCompleteTaskScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTaskScope, metrics.PersistenceLatency)
 	err := p.persistence.CompleteTask(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteTaskScope, err)
@@ -408,9 +472,11 @@ func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error
 func (p *taskPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceLeaseTaskListScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceLatency)
 	response, err := p.persistence.LeaseTaskList(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceLeaseTaskListScope, err)
@@ -422,9 +488,11 @@ func (p *taskPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*L
 func (p *taskPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateTaskListScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceUpdateTaskListScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateTaskListScope, metrics.PersistenceLatency)
 	response, err := p.persistence.UpdateTaskList(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceUpdateTaskListScope, err)
@@ -452,9 +520,11 @@ func (p *taskPersistenceClient) Close() {
 func (p *historyPersistenceClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceAppendHistoryEventsScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceAppendHistoryEventsScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceAppendHistoryEventsScope, metrics.PersistenceLatency)
 	err := p.persistence.AppendHistoryEvents(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceAppendHistoryEventsScope, err)
@@ -467,9 +537,11 @@ func (p *historyPersistenceClient) GetWorkflowExecutionHistory(
 	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceGetWorkflowExecutionHistoryScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetWorkflowExecutionHistory(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionHistoryScope, err)
@@ -482,9 +554,11 @@ func (p *historyPersistenceClient) DeleteWorkflowExecutionHistory(
 	request *DeleteWorkflowExecutionHistoryRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceDeleteWorkflowExecutionHistoryScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, metrics.PersistenceLatency)
 	err := p.persistence.DeleteWorkflowExecutionHistory(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, err)
@@ -514,9 +588,11 @@ func (p *historyPersistenceClient) Close() {
 func (p *metadataPersistenceClient) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateDomainScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceCreateDomainScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateDomainScope, metrics.PersistenceLatency)
 	response, err := p.persistence.CreateDomain(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCreateDomainScope, err)
@@ -528,9 +604,11 @@ func (p *metadataPersistenceClient) CreateDomain(request *CreateDomainRequest) (
 func (p *metadataPersistenceClient) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetDomainScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceGetDomainScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetDomainScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetDomain(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetDomainScope, err)
@@ -542,9 +620,11 @@ func (p *metadataPersistenceClient) GetDomain(request *GetDomainRequest) (*GetDo
 func (p *metadataPersistenceClient) UpdateDomain(request *UpdateDomainRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateDomainScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceUpdateDomainScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateDomainScope, metrics.PersistenceLatency)
 	err := p.persistence.UpdateDomain(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceUpdateDomainScope, err)
@@ -556,9 +636,11 @@ func (p *metadataPersistenceClient) UpdateDomain(request *UpdateDomainRequest) e
 func (p *metadataPersistenceClient) DeleteDomain(request *DeleteDomainRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteDomainScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceDeleteDomainScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteDomainScope, metrics.PersistenceLatency)
 	err := p.persistence.DeleteDomain(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteDomainScope, err)
@@ -570,9 +652,11 @@ func (p *metadataPersistenceClient) DeleteDomain(request *DeleteDomainRequest) e
 func (p *metadataPersistenceClient) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteDomainByNameScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceDeleteDomainByNameScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteDomainByNameScope, metrics.PersistenceLatency)
 	err := p.persistence.DeleteDomainByName(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteDomainByNameScope, err)
@@ -581,6 +665,22 @@ func (p *metadataPersistenceClient) DeleteDomainByName(request *DeleteDomainByNa
 	return err
 }
 
+func (p *metadataPersistenceClient) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListDomainScope, metrics.PersistenceRequests)
+
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListDomainScope)
+	sw := p.metricClient.StartTimer(metrics.PersistenceListDomainScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListDomains(request)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListDomainScope, err)
+	}
+
+	return response, err
+}
+
 func (p *metadataPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -601,9 +701,11 @@ func (p *metadataPersistenceClient) updateErrorMetric(scope int, err error) {
 func (p *visibilityPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRecordWorkflowExecutionStartedScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceRecordWorkflowExecutionStartedScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceRecordWorkflowExecutionStartedScope, metrics.PersistenceLatency)
 	err := p.persistence.RecordWorkflowExecutionStarted(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceRecordWorkflowExecutionStartedScope, err)
@@ -615,9 +717,11 @@ func (p *visibilityPersistenceClient) RecordWorkflowExecutionStarted(request *Re
 func (p *visibilityPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceRecordWorkflowExecutionClosedScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceRecordWorkflowExecutionClosedScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceRecordWorkflowExecutionClosedScope, metrics.PersistenceLatency)
 	err := p.persistence.RecordWorkflowExecutionClosed(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceRecordWorkflowExecutionClosedScope, err)
@@ -626,12 +730,30 @@ func (p *visibilityPersistenceClient) RecordWorkflowExecutionClosed(request *Rec
 	return err
 }
 
+func (p *visibilityPersistenceClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceUpsertWorkflowExecutionScope, metrics.PersistenceRequests)
+
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceUpsertWorkflowExecutionScope)
+	sw := p.metricClient.StartTimer(metrics.PersistenceUpsertWorkflowExecutionScope, metrics.PersistenceLatency)
+	err := p.persistence.UpsertWorkflowExecution(request)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceUpsertWorkflowExecutionScope, err)
+	}
+
+	return err
+}
+
 func (p *visibilityPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListOpenWorkflowExecutionsScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsScope, metrics.PersistenceLatency)
 	response, err := p.persistence.ListOpenWorkflowExecutions(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceListOpenWorkflowExecutionsScope, err)
@@ -643,9 +765,11 @@ func (p *visibilityPersistenceClient) ListOpenWorkflowExecutions(request *ListWo
 func (p *visibilityPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListClosedWorkflowExecutionsScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsScope, metrics.PersistenceLatency)
 	response, err := p.persistence.ListClosedWorkflowExecutions(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsScope, err)
@@ -657,9 +781,11 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutions(request *List
 func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListOpenWorkflowExecutionsByTypeScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, metrics.PersistenceLatency)
 	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, err)
@@ -671,9 +797,11 @@ func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByType(request *
 func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListClosedWorkflowExecutionsByTypeScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, metrics.PersistenceLatency)
 	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, err)
@@ -685,9 +813,11 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByType(request
 func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceLatency)
 	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, err)
@@ -699,9 +829,11 @@ func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(req
 func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceLatency)
 	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, err)
@@ -713,9 +845,11 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(r
 func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListClosedWorkflowExecutionsByStatusScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, metrics.PersistenceLatency)
 	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, err)
@@ -724,12 +858,78 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(reque
 	return response, err
 }
 
+func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsBySearchAttributes(request *ListWorkflowExecutionsBySearchAttributesRequest) (*ListWorkflowExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsBySearchAttributesScope, metrics.PersistenceRequests)
+
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListOpenWorkflowExecutionsBySearchAttributesScope)
+	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsBySearchAttributesScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListOpenWorkflowExecutionsBySearchAttributes(request)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListOpenWorkflowExecutionsBySearchAttributesScope, err)
+	}
+
+	return response, err
+}
+
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsBySearchAttributes(request *ListWorkflowExecutionsBySearchAttributesRequest) (*ListWorkflowExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsBySearchAttributesScope, metrics.PersistenceRequests)
+
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListClosedWorkflowExecutionsBySearchAttributesScope)
+	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsBySearchAttributesScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListClosedWorkflowExecutionsBySearchAttributes(request)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListClosedWorkflowExecutionsBySearchAttributesScope, err)
+	}
+
+	return response, err
+}
+
+func (p *visibilityPersistenceClient) ListWorkflowExecutions(request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceListWorkflowExecutionsScope, metrics.PersistenceRequests)
+
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceListWorkflowExecutionsScope)
+	sw := p.metricClient.StartTimer(metrics.PersistenceListWorkflowExecutionsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.ListWorkflowExecutions(request)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceListWorkflowExecutionsScope, err)
+	}
+
+	return response, err
+}
+
+func (p *visibilityPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceCountWorkflowExecutionsScope, metrics.PersistenceRequests)
+
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceCountWorkflowExecutionsScope)
+	sw := p.metricClient.StartTimer(metrics.PersistenceCountWorkflowExecutionsScope, metrics.PersistenceLatency)
+	response, err := p.persistence.CountWorkflowExecutions(request)
+	sw.Stop()
+	tracing.FinishSpan(span, err)
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceCountWorkflowExecutionsScope, err)
+	}
+
+	return response, err
+}
+
 func (p *visibilityPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetClosedWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	span := startPersistenceSpan(p.tracer, p.parent, metrics.PersistenceGetClosedWorkflowExecutionScope)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetClosedWorkflowExecutionScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetClosedWorkflowExecution(request)
 	sw.Stop()
+	tracing.FinishSpan(span, err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetClosedWorkflowExecutionScope, err)
@@ -758,3 +958,100 @@ func (p *visibilityPersistenceClient) updateErrorMetric(scope int, err error) {
 func (p *visibilityPersistenceClient) Close() {
 	p.persistence.Close()
 }
+
+// ShardManagerWithContext returns the shard manager tracing its calls as children of the span of the context.
+// The manager is returned as is when it does not trace its calls or the context carries no span
+func ShardManagerWithContext(ctx context.Context, manager ShardManager) ShardManager {
+	client, ok := manager.(*shardPersistenceClient)
+	parent := spanContextFrom(ctx)
+	if !ok || parent == nil {
+		return manager
+	}
+	bound := *client
+	bound.parent = parent
+	return &bound
+}
+
+// ExecutionManagerWithContext returns the execution manager tracing its calls as children of the span of the
+// context
+func ExecutionManagerWithContext(ctx context.Context, manager ExecutionManager) ExecutionManager {
+	client, ok := manager.(*workflowExecutionPersistenceClient)
+	parent := spanContextFrom(ctx)
+	if !ok || parent == nil {
+		return manager
+	}
+	bound := *client
+	bound.parent = parent
+	return &bound
+}
+
+// TaskManagerWithContext returns the task manager tracing its calls as children of the span of the context
+func TaskManagerWithContext(ctx context.Context, manager TaskManager) TaskManager {
+	client, ok := manager.(*taskPersistenceClient)
+	parent := spanContextFrom(ctx)
+	if !ok || parent == nil {
+		return manager
+	}
+	bound := *client
+	bound.parent = parent
+	return &bound
+}
+
+// HistoryManagerWithContext returns the history manager tracing its calls as children of the span of the context
+func HistoryManagerWithContext(ctx context.Context, manager HistoryManager) HistoryManager {
+	client, ok := manager.(*historyPersistenceClient)
+	parent := spanContextFrom(ctx)
+	if !ok || parent == nil {
+		return manager
+	}
+	bound := *client
+	bound.parent = parent
+	return &bound
+}
+
+// MetadataManagerWithContext returns the metadata manager tracing its calls as children of the span of the context
+func MetadataManagerWithContext(ctx context.Context, manager MetadataManager) MetadataManager {
+	client, ok := manager.(*metadataPersistenceClient)
+	parent := spanContextFrom(ctx)
+	if !ok || parent == nil {
+		return manager
+	}
+	bound := *client
+	bound.parent = parent
+	return &bound
+}
+
+// VisibilityManagerWithContext returns the visibility manager tracing its calls as children of the span of the
+// context
+func VisibilityManagerWithContext(ctx context.Context, manager VisibilityManager) VisibilityManager {
+	client, ok := manager.(*visibilityPersistenceClient)
+	parent := spanContextFrom(ctx)
+	if !ok || parent == nil {
+		return manager
+	}
+	bound := *client
+	bound.parent = parent
+	return &bound
+}
+
+func spanContextFrom(ctx context.Context) opentracing.SpanContext {
+	if ctx == nil {
+		return nil
+	}
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		return span.Context()
+	}
+	return nil
+}
+
+// startPersistenceSpan starts a span around a persistence call named after the scope of the call, as a child
+// of the span of the request or task making the call. The persistence APIs do not take a context, so the calls
+// of the managers not bound to a span with the WithContext functions are not traced rather than each starting
+// a trace of its own
+func startPersistenceSpan(tracer opentracing.Tracer, parent opentracing.SpanContext, scope int) opentracing.Span {
+	if tracer == nil || parent == nil {
+		return opentracing.NoopTracer{}.StartSpan("")
+	}
+	return tracer.StartSpan("persistence."+metrics.GetCommonScopeOperation(scope),
+		opentracing.ChildOf(parent), opentracing.Tag{Key: "component", Value: "persistence"})
+}
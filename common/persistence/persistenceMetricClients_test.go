@@ -0,0 +1,91 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	jaeger "github.com/uber/jaeger-client-go"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/tracing"
+)
+
+type (
+	persistenceMetricClientsSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestPersistenceMetricClientsSuite(t *testing.T) {
+	suite.Run(t, new(persistenceMetricClientsSuite))
+}
+
+func (s *persistenceMetricClientsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *persistenceMetricClientsSuite) TestSpansAreChildrenOfTheContextSpan() {
+	tracer, reporter := tracing.NewInMemoryTracer("cadence-frontend")
+	memory, err := NewMemoryMetadataPersistence("active", logging.NewNopLogger())
+	s.NoError(err)
+	manager := NewMetadataPersistenceClient(memory, metrics.NewClient(tally.NoopScope, metrics.Frontend), tracer)
+
+	// the calls made without the span of a request are not traced
+	_, err = manager.GetDomain(&GetDomainRequest{Name: "test-domain"})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+	s.Empty(reporter.GetSpans())
+
+	span, ctx := tracing.StartSpan(context.Background(), tracer, "DescribeDomain")
+	_, err = MetadataManagerWithContext(ctx, manager).GetDomain(&GetDomainRequest{Name: "test-domain"})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+	tracing.FinishSpan(span, nil)
+
+	spans := reporter.GetSpans()
+	s.Len(spans, 2)
+	childSpan := spans[0].(*jaeger.Span)
+	parentSpan := spans[1].(*jaeger.Span)
+	s.Equal("persistence.GetDomain", childSpan.OperationName())
+	s.Equal(parentSpan.SpanContext().SpanID(), childSpan.SpanContext().ParentID())
+	s.Equal(parentSpan.SpanContext().TraceID(), childSpan.SpanContext().TraceID())
+	s.Equal(true, childSpan.Tags()["error"])
+}
+
+func (s *persistenceMetricClientsSuite) TestWithContextWithoutSpan() {
+	tracer, _ := tracing.NewInMemoryTracer("cadence-frontend")
+	memory, err := NewMemoryMetadataPersistence("active", logging.NewNopLogger())
+	s.NoError(err)
+	manager := NewMetadataPersistenceClient(memory, metrics.NewClient(tally.NoopScope, metrics.Frontend), tracer)
+
+	s.Equal(manager, MetadataManagerWithContext(context.Background(), manager))
+	s.Equal(manager, MetadataManagerWithContext(nil, manager))
+
+	// the managers which do not trace their calls are returned as is
+	_, ctx := tracing.StartSpan(context.Background(), tracer, "DescribeDomain")
+	s.Equal(memory, MetadataManagerWithContext(ctx, memory))
+}
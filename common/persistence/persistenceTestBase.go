@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"io/ioutil"
 	"math"
 	"math/rand"
 	"strings"
@@ -31,11 +32,11 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/service/config"
 
 	"github.com/gocql/gocql"
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
-	"github.com/uber-common/bark"
 )
 
 const (
@@ -67,6 +68,12 @@ type (
 		// when crtoss DC is public, remove EnableGlobalDomain
 		EnableGlobalDomain bool
 		IsMasterCluster    bool
+		// SQL when set runs the test base against a sql database instead of cassandra,
+		// a randomly named test database is created on the given server
+		SQL *config.SQL
+		// InMemory when set runs the test base against a new in-memory store, no
+		// external dependencies are needed
+		InMemory bool
 	}
 
 	// TestBase wraps the base setup needed to create workflows over persistence layer.
@@ -84,6 +91,8 @@ type (
 		readLevel            int64
 		replicationReadLevel int64
 		CassandraTestCluster
+		SQLTestCluster
+		inMemory bool
 	}
 
 	// CassandraTestCluster allows executing cassandra operations in testing.
@@ -94,10 +103,16 @@ type (
 		session  *gocql.Session
 	}
 
+	// SQLTestCluster allows executing sql operations in testing.
+	SQLTestCluster struct {
+		cfg *config.SQL
+		db  *sqlDB
+	}
+
 	testExecutionMgrFactory struct {
 		options   TestBaseOptions
 		cassandra CassandraTestCluster
-		logger    bark.Logger
+		logger    logging.Logger
 	}
 
 	testTransferTaskIDGenerator struct {
@@ -111,24 +126,46 @@ func (g *testTransferTaskIDGenerator) GetNextTransferTaskID() (int64, error) {
 
 // SetupWorkflowStoreWithOptions to setup workflow test base
 func (s *TestBase) SetupWorkflowStoreWithOptions(options TestBaseOptions) {
-	log := bark.NewLoggerFromLogrus(log.New())
+	logger := logging.NewDevelopmentLogger()
 
 	s.ClusterMetadata = cluster.GetTestClusterMetadata(
 		options.EnableGlobalDomain,
 		options.IsMasterCluster,
 	)
 
-	// Setup Workflow keyspace and deploy schema for tests
-	s.CassandraTestCluster.setupTestCluster(options)
+	var pFactory Factory
+	currentClusterName := s.ClusterMetadata.GetCurrentClusterName()
+	switch {
+	case options.InMemory:
+		s.inMemory = true
+		pFactory = newMemoryFactory(newMemoryStore(), currentClusterName, logger)
+	case options.SQL != nil:
+		// Setup test database and deploy schema for tests
+		s.SQLTestCluster.setupTestDatabase(options)
+		pConfig := &config.Persistence{DefaultStore: config.StoreTypeSQL, SQL: s.SQLTestCluster.cfg}
+		pFactory = NewFactory(pConfig, nil, currentClusterName, logger)
+	default:
+		// Setup Workflow keyspace and deploy schema for tests
+		s.CassandraTestCluster.setupTestCluster(options)
+		cassandraConfig := &config.Cassandra{
+			Hosts:              options.ClusterHost,
+			Port:               options.ClusterPort,
+			User:               options.ClusterUser,
+			Password:           options.ClusterPassword,
+			Datacenter:         options.Datacenter,
+			Keyspace:           s.CassandraTestCluster.keyspace,
+			VisibilityKeyspace: s.CassandraTestCluster.keyspace,
+		}
+		pFactory = NewFactory(&config.Persistence{}, cassandraConfig, currentClusterName, logger)
+	}
+
 	shardID := 0
 	var err error
-	s.ShardMgr, err = NewCassandraShardPersistence(options.ClusterHost, options.ClusterPort, options.ClusterUser,
-		options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, s.ClusterMetadata.GetCurrentClusterName(), log)
+	s.ShardMgr, err = pFactory.NewShardManager()
 	if err != nil {
 		log.Fatal(err)
 	}
-	s.ExecutionMgrFactory, err = NewCassandraPersistenceClientFactory(options.ClusterHost, options.ClusterPort,
-		options.ClusterUser, options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, 2, log, nil)
+	s.ExecutionMgrFactory, err = pFactory.NewExecutionManagerFactory(2, nil, nil)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -137,27 +174,22 @@ func (s *TestBase) SetupWorkflowStoreWithOptions(options TestBaseOptions) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	s.TaskMgr, err = NewCassandraTaskPersistence(options.ClusterHost, options.ClusterPort, options.ClusterUser,
-		options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace,
-		log)
+	s.TaskMgr, err = pFactory.NewTaskManager()
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	s.HistoryMgr, err = NewCassandraHistoryPersistence(options.ClusterHost, options.ClusterPort, options.ClusterUser,
-		options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, 2, log)
+	s.HistoryMgr, err = pFactory.NewHistoryManager(2)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	s.MetadataManager, err = NewCassandraMetadataPersistence(options.ClusterHost, options.ClusterPort, options.ClusterUser,
-		options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, s.ClusterMetadata.GetCurrentClusterName(), log)
+	s.MetadataManager, err = pFactory.NewMetadataManager()
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	s.VisibilityMgr, err = NewCassandraVisibilityPersistence(options.ClusterHost, options.ClusterPort,
-		options.ClusterUser, options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, log)
+	s.VisibilityMgr, err = pFactory.NewVisibilityManager()
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -261,7 +293,8 @@ func (s *TestBase) CreateWorkflowExecutionWithReplication(domainID string, workf
 	var replicationTasks []Task
 	for _, task := range txTasks {
 		switch t := task.(type) {
-		case *DecisionTask, *ActivityTask, *CloseExecutionTask, *CancelExecutionTask, *StartChildExecutionTask, *SignalExecutionTask:
+		case *DecisionTask, *ActivityTask, *CloseExecutionTask, *CancelExecutionTask, *StartChildExecutionTask, *SignalExecutionTask,
+			*UpsertWorkflowSearchAttributesTask:
 			transferTasks = append(transferTasks, t)
 		case *HistoryReplicationTask:
 			replicationTasks = append(replicationTasks, t)
@@ -589,7 +622,8 @@ func (s *TestBase) UpdateWorkflowExecutionWithReplication(updatedInfo *WorkflowE
 	var replicationTasks []Task
 	for _, task := range txTasks {
 		switch t := task.(type) {
-		case *DecisionTask, *ActivityTask, *CloseExecutionTask, *CancelExecutionTask, *StartChildExecutionTask, *SignalExecutionTask:
+		case *DecisionTask, *ActivityTask, *CloseExecutionTask, *CancelExecutionTask, *StartChildExecutionTask, *SignalExecutionTask,
+			*UpsertWorkflowSearchAttributesTask:
 			transferTasks = append(transferTasks, t)
 		case *HistoryReplicationTask:
 			replicationTasks = append(replicationTasks, t)
@@ -984,6 +1018,13 @@ func (s *TestBase) SetupWorkflowStore() {
 
 // TearDownWorkflowStore to cleanup
 func (s *TestBase) TearDownWorkflowStore() {
+	if s.inMemory {
+		return
+	}
+	if s.SQLTestCluster.cfg != nil {
+		s.SQLTestCluster.tearDownTestDatabase()
+		return
+	}
 	s.CassandraTestCluster.tearDownTestCluster()
 }
 
@@ -1078,7 +1119,7 @@ func (s *CassandraTestCluster) createCluster(
 	var err error
 	s.session, err = s.cluster.CreateSession()
 	if err != nil {
-		log.WithField(logging.TagErr, err).Fatal(`createSession`)
+		log.WithField("error", err).Fatal(`createSession`)
 	}
 	s.keyspace = keyspace
 }
@@ -1123,6 +1164,86 @@ func (s *CassandraTestCluster) loadVisibilitySchema(fileNames []string, schemaDi
 	}
 }
 
+func (s *SQLTestCluster) setupTestDatabase(options TestBaseOptions) {
+	cfg := *options.SQL
+	if cfg.DatabaseName == "" {
+		cfg.DatabaseName = generateRandomKeyspace(10)
+	}
+	cfg.VisibilityDatabaseName = cfg.DatabaseName
+	s.cfg = &cfg
+
+	adminDB, err := newSQLDB(s.cfg, sqlAdminDatabaseName(s.cfg.DriverName))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer adminDB.Close()
+	if options.DropKeySpace {
+		if _, err := adminDB.exec("DROP DATABASE IF EXISTS " + s.cfg.DatabaseName); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if _, err := adminDB.exec("CREATE DATABASE " + s.cfg.DatabaseName); err != nil {
+		log.Fatal(err)
+	}
+
+	s.db, err = newSQLDB(s.cfg, s.cfg.DatabaseName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	schemaDir := "./schema/" + s.cfg.DriverName
+	if options.SchemaDir != "" {
+		schemaDir = options.SchemaDir + "/schema/" + s.cfg.DriverName
+	}
+	s.loadSchema(schemaDir + "/cadence/schema.sql")
+	s.loadSchema(schemaDir + "/visibility/schema.sql")
+}
+
+func (s *SQLTestCluster) tearDownTestDatabase() {
+	s.db.Close()
+	adminDB, err := newSQLDB(s.cfg, sqlAdminDatabaseName(s.cfg.DriverName))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer adminDB.Close()
+	if _, err := adminDB.exec("DROP DATABASE IF EXISTS " + s.cfg.DatabaseName); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (s *SQLTestCluster) loadSchema(filePath string) {
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var lines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "--") {
+			continue
+		}
+		if idx := strings.Index(line, "--"); idx >= 0 {
+			line = line[:idx]
+		}
+		lines = append(lines, line)
+	}
+	for _, stmt := range strings.Split(strings.Join(lines, " "), ";") {
+		if strings.TrimSpace(stmt) == "" {
+			continue
+		}
+		if _, err := s.db.exec(stmt); err != nil {
+			log.WithField("error", err).Fatalf("failed to load schema from %v", filePath)
+		}
+	}
+}
+
+// sqlAdminDatabaseName returns the database to connect to for creating and dropping databases
+func sqlAdminDatabaseName(driverName string) string {
+	if driverName == sqlDriverPostgres {
+		return "postgres"
+	}
+	return ""
+}
+
 func validateTimeRange(t time.Time, expectedDuration time.Duration) bool {
 	currentTime := time.Now()
 	diff := time.Duration(currentTime.UnixNano() - t.UnixNano())
@@ -0,0 +1,68 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"flag"
+
+	"github.com/uber/cadence/common/service/config"
+)
+
+var (
+	testPersistenceType = flag.String("persistenceType", "cassandra",
+		"store the persistence suites run against, valid values are cassandra, mysql and postgres")
+	testSQLConnectAddr = flag.String("sqlConnectAddr", "", "host:port of the sql server, defaults to the local server")
+	testSQLUser        = flag.String("sqlUser", "", "user of the sql server, defaults to the driver admin user")
+	testSQLPassword    = flag.String("sqlPassword", "", "password of the sql server")
+)
+
+// testStoreOptions returns the options to setup the test base against the store selected
+// by the -persistenceType flag, the sql stores get a new database with the schema loaded
+// from the schema directory of their driver
+func testStoreOptions() TestBaseOptions {
+	options := TestBaseOptions{
+		SchemaDir:       testSchemaDir,
+		ClusterHost:     testWorkflowClusterHosts,
+		ClusterPort:     testPort,
+		ClusterUser:     testUser,
+		ClusterPassword: testPassword,
+		DropKeySpace:    true,
+	}
+
+	var sqlConfig config.SQL
+	switch *testPersistenceType {
+	case sqlDriverMySQL:
+		sqlConfig = config.SQL{DriverName: sqlDriverMySQL, ConnectAddr: "127.0.0.1:3306", User: "root"}
+	case sqlDriverPostgres:
+		sqlConfig = config.SQL{DriverName: sqlDriverPostgres, ConnectAddr: "127.0.0.1:5432", User: "postgres"}
+	default:
+		return options
+	}
+	if *testSQLConnectAddr != "" {
+		sqlConfig.ConnectAddr = *testSQLConnectAddr
+	}
+	if *testSQLUser != "" {
+		sqlConfig.User = *testSQLUser
+	}
+	sqlConfig.Password = *testSQLPassword
+	options.SQL = &sqlConfig
+	return options
+}
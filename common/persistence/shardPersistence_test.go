@@ -52,7 +52,7 @@ func (s *shardPersistenceSuite) SetupSuite() {
 		log.SetOutput(os.Stdout)
 	}
 
-	s.SetupWorkflowStore()
+	s.SetupWorkflowStoreWithOptions(testStoreOptions())
 }
 
 func (s *shardPersistenceSuite) SetupTest() {
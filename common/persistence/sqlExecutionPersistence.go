@@ -0,0 +1,1022 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+)
+
+// Types of the entries stored in the mutable_state_maps table
+const (
+	mutableStateMapActivity = iota
+	mutableStateMapTimer
+	mutableStateMapChildExecution
+	mutableStateMapRequestCancel
+	mutableStateMapSignal
+	mutableStateMapSignalRequested
+	mutableStateMapBufferedReplicationTask
+)
+
+const (
+	templateLockShardRangeSQLQuery = `SELECT range_id FROM shards WHERE shard_id = ?`
+
+	templateGetCurrentExecutionSQLQuery = `SELECT run_id, create_request_id, state, close_status ` +
+		`FROM current_executions WHERE shard_id = ? AND domain_id = ? AND workflow_id = ?`
+
+	templateCreateCurrentExecutionSQLQuery = `INSERT INTO current_executions ` +
+		`(shard_id, domain_id, workflow_id, run_id, create_request_id, state, close_status) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpdateCurrentExecutionSQLQuery = `UPDATE current_executions SET ` +
+		`run_id = ?, create_request_id = ?, state = ?, close_status = ? ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	templateCreateExecutionSQLQuery = `INSERT INTO executions ` +
+		`(shard_id, domain_id, workflow_id, run_id, next_event_id, data, replication_state) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	templateGetExecutionSQLQuery = `SELECT data, replication_state FROM executions ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	templateLockExecutionSQLQuery = `SELECT next_event_id FROM executions ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	templateUpdateExecutionSQLQuery = `UPDATE executions SET next_event_id = ?, data = ?, replication_state = ? ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	templateDeleteExecutionSQLQuery = `DELETE FROM executions ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	templateGetMapEntriesSQLQuery = `SELECT map_type, map_key, data FROM mutable_state_maps ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	templateInsertMapEntrySQLQuery = `INSERT INTO mutable_state_maps ` +
+		`(shard_id, domain_id, workflow_id, run_id, map_type, map_key, data) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	templateDeleteMapEntrySQLQuery = `DELETE FROM mutable_state_maps ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ? AND map_type = ? AND map_key = ?`
+
+	templateDeleteMapSQLQuery = `DELETE FROM mutable_state_maps ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ? AND map_type = ?`
+
+	templateDeleteAllMapsSQLQuery = `DELETE FROM mutable_state_maps ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	templateGetBufferedEventsSQLQuery = `SELECT data FROM buffered_events ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ? ORDER BY id`
+
+	templateInsertBufferedEventsSQLQuery = `INSERT INTO buffered_events ` +
+		`(shard_id, domain_id, workflow_id, run_id, data) VALUES (?, ?, ?, ?, ?)`
+
+	templateDeleteBufferedEventsSQLQuery = `DELETE FROM buffered_events ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	templateCreateTransferTaskSQLQuery = `INSERT INTO transfer_tasks (shard_id, task_id, data) VALUES (?, ?, ?)`
+
+	templateGetTransferTasksSQLQuery = `SELECT task_id, data FROM transfer_tasks ` +
+		`WHERE shard_id = ? AND task_id > ? AND task_id <= ? ORDER BY task_id LIMIT ?`
+
+	templateCompleteTransferTaskSQLQuery = `DELETE FROM transfer_tasks WHERE shard_id = ? AND task_id = ?`
+
+	templateCreateReplicationTaskSQLQuery = `INSERT INTO replication_tasks (shard_id, task_id, data) VALUES (?, ?, ?)`
+
+	templateGetReplicationTasksSQLQuery = `SELECT task_id, data FROM replication_tasks ` +
+		`WHERE shard_id = ? AND task_id > ? AND task_id <= ? ORDER BY task_id LIMIT ?`
+
+	templateCompleteReplicationTaskSQLQuery = `DELETE FROM replication_tasks WHERE shard_id = ? AND task_id = ?`
+
+	templateCreateTimerTaskSQLQuery = `INSERT INTO timer_tasks (shard_id, visibility_timestamp, task_id, data) ` +
+		`VALUES (?, ?, ?, ?)`
+
+	templateGetTimerTasksSQLQuery = `SELECT visibility_timestamp, task_id, data FROM timer_tasks ` +
+		`WHERE shard_id = ? AND visibility_timestamp >= ? AND visibility_timestamp < ? ` +
+		`ORDER BY visibility_timestamp, task_id LIMIT ?`
+
+	templateCompleteTimerTaskSQLQuery = `DELETE FROM timer_tasks ` +
+		`WHERE shard_id = ? AND visibility_timestamp = ? AND task_id = ?`
+)
+
+type (
+	sqlExecutionPersistence struct {
+		db      *sqlDB
+		shardID int
+		logger  logging.Logger
+	}
+
+	// sqlMapEntry is a single row of the mutable_state_maps table
+	sqlMapEntry struct {
+		mapType int
+		key     string
+		value   interface{}
+	}
+)
+
+// NewSQLWorkflowExecutionPersistence is used to create an instance of ExecutionManager implementation
+func NewSQLWorkflowExecutionPersistence(shardID int, db *sqlDB, logger logging.Logger) (ExecutionManager, error) {
+	return &sqlExecutionPersistence{db: db, shardID: shardID, logger: logger}, nil
+}
+
+// Close is a no-op, the connection pool is owned by the factory which created this object
+func (m *sqlExecutionPersistence) Close() {
+}
+
+func (m *sqlExecutionPersistence) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (
+	*CreateWorkflowExecutionResponse, error) {
+	err := m.db.txExecute("CreateWorkflowExecution", func(tx *sqlTx) error {
+		if err := m.lockShard(tx, request.RangeID); err != nil {
+			return err
+		}
+		if err := m.createWorkflowExecutionWithinTx(tx, request); err != nil {
+			return err
+		}
+		workflowID := request.Execution.GetWorkflowId()
+		runID := request.Execution.GetRunId()
+		if err := m.createTransferTasks(tx, request.TransferTasks, request.DomainID, workflowID, runID); err != nil {
+			return err
+		}
+		if err := m.createReplicationTasks(tx, request.ReplicationTasks, request.DomainID, workflowID,
+			runID); err != nil {
+			return err
+		}
+		return m.createTimerTasks(tx, request.TimerTasks, nil, request.DomainID, workflowID, runID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateWorkflowExecutionResponse{}, nil
+}
+
+func (m *sqlExecutionPersistence) createWorkflowExecutionWithinTx(tx *sqlTx,
+	request *CreateWorkflowExecutionRequest) error {
+	workflowID := request.Execution.GetWorkflowId()
+	runID := request.Execution.GetRunId()
+
+	parentDomainID := emptyDomainID
+	parentWorkflowID := ""
+	parentRunID := emptyRunID
+	initiatedID := emptyInitiatedID
+	state := WorkflowStateRunning
+	closeStatus := WorkflowCloseStatusNone
+	if request.ParentExecution != nil {
+		parentDomainID = request.ParentDomainID
+		parentWorkflowID = request.ParentExecution.GetWorkflowId()
+		parentRunID = request.ParentExecution.GetRunId()
+		initiatedID = request.InitiatedID
+		state = WorkflowStateCreated
+	}
+
+	current, err := m.lockCurrentExecution(tx, request.DomainID, workflowID)
+	if err != nil {
+		return err
+	}
+
+	if request.ContinueAsNew {
+		if current == nil || current.RunID != request.PreviousRunID {
+			return m.alreadyStartedError(request, current)
+		}
+		if _, err := tx.exec(templateUpdateCurrentExecutionSQLQuery, runID, request.RequestID, state, closeStatus,
+			m.shardID, request.DomainID, workflowID, request.PreviousRunID); err != nil {
+			return err
+		}
+	} else {
+		if current != nil {
+			return m.alreadyStartedError(request, current)
+		}
+		if _, err := tx.exec(templateCreateCurrentExecutionSQLQuery, m.shardID, request.DomainID, workflowID, runID,
+			request.RequestID, state, closeStatus); err != nil {
+			if isDuplicateKeyError(err) {
+				return m.alreadyStartedError(request, nil)
+			}
+			return err
+		}
+	}
+
+	if request.ResetSnapshot != nil {
+		return m.createExecutionFromSnapshotWithinTx(tx, request, current)
+	}
+
+	now := time.Now()
+	info := &WorkflowExecutionInfo{
+		DomainID:             request.DomainID,
+		WorkflowID:           workflowID,
+		RunID:                runID,
+		ParentDomainID:       parentDomainID,
+		ParentWorkflowID:     parentWorkflowID,
+		ParentRunID:          parentRunID,
+		InitiatedID:          initiatedID,
+		TaskList:             request.TaskList,
+		WorkflowTypeName:     request.WorkflowTypeName,
+		WorkflowTimeout:      request.WorkflowTimeout,
+		DecisionTimeoutValue: request.DecisionTimeoutValue,
+		ExecutionContext:     request.ExecutionContext,
+		State:                WorkflowStateCreated,
+		CloseStatus:          WorkflowCloseStatusNone,
+		LastFirstEventID:     common.FirstEventID,
+		NextEventID:          request.NextEventID,
+		LastProcessedEvent:   request.LastProcessedEvent,
+		StartTimestamp:       now,
+		LastUpdatedTimestamp: now,
+		CreateRequestID:      request.RequestID,
+		DecisionVersion:      request.DecisionVersion,
+		DecisionScheduleID:   request.DecisionScheduleID,
+		DecisionStartedID:    request.DecisionStartedID,
+		DecisionTimeout:      request.DecisionStartToCloseTimeout,
+		CronSchedule:         request.CronSchedule,
+		Attempt:              request.Attempt,
+		HasRetryPolicy:       request.HasRetryPolicy,
+		InitialInterval:      request.InitialInterval,
+		BackoffCoefficient:   request.BackoffCoefficient,
+		MaximumInterval:      request.MaximumInterval,
+		ExpirationTime:       request.ExpirationTime,
+		MaximumAttempts:      request.MaximumAttempts,
+		NonRetriableErrors:   request.NonRetriableErrors,
+		SearchAttributes:     request.SearchAttributes,
+	}
+	data, replicationState, err := serializeExecution(info, request.ReplicationState)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.exec(templateCreateExecutionSQLQuery, m.shardID, request.DomainID, workflowID, runID,
+		request.NextEventID, data, replicationState); err != nil {
+		if isDuplicateKeyError(err) {
+			return m.alreadyStartedError(request, current)
+		}
+		return err
+	}
+	return nil
+}
+
+// createExecutionFromSnapshotWithinTx inserts the execution row and the maps of the reset snapshot of the request
+func (m *sqlExecutionPersistence) createExecutionFromSnapshotWithinTx(tx *sqlTx, request *CreateWorkflowExecutionRequest,
+	current *GetCurrentExecutionResponse) error {
+	snapshot := request.ResetSnapshot
+	info := *snapshot.ExecutionInfo
+	info.LastUpdatedTimestamp = time.Now()
+	data, replicationState, err := serializeExecution(&info, snapshot.ReplicationState)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.exec(templateCreateExecutionSQLQuery, m.shardID, info.DomainID, info.WorkflowID, info.RunID,
+		info.NextEventID, data, replicationState); err != nil {
+		if isDuplicateKeyError(err) {
+			return m.alreadyStartedError(request, current)
+		}
+		return err
+	}
+	return m.updateMapEntries(tx, resetMapEntries(snapshot), nil, info.DomainID, info.WorkflowID, info.RunID)
+}
+
+func (m *sqlExecutionPersistence) alreadyStartedError(request *CreateWorkflowExecutionRequest,
+	current *GetCurrentExecutionResponse) error {
+	if current == nil {
+		return &WorkflowExecutionAlreadyStartedError{
+			Msg: fmt.Sprintf("Workflow execution already running. WorkflowId: %v, rangeID: %v",
+				request.Execution.GetWorkflowId(), request.RangeID),
+		}
+	}
+	return &WorkflowExecutionAlreadyStartedError{
+		Msg: fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+			request.Execution.GetWorkflowId(), current.RunID, request.RangeID),
+		StartRequestID: current.StartRequestID,
+		RunID:          current.RunID,
+		State:          current.State,
+		CloseStatus:    current.CloseStatus,
+	}
+}
+
+func (m *sqlExecutionPersistence) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
+	*GetWorkflowExecutionResponse, error) {
+	execution := request.Execution
+	var data, replicationData []byte
+	if err := m.db.queryRow(templateGetExecutionSQLQuery, m.shardID, request.DomainID, execution.GetWorkflowId(),
+		execution.GetRunId()).Scan(&data, &replicationData); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+					execution.GetWorkflowId(), execution.GetRunId()),
+			}
+		}
+		return nil, convertSQLError("GetWorkflowExecution", err)
+	}
+
+	state := &WorkflowMutableState{
+		ExecutionInfo:            &WorkflowExecutionInfo{},
+		ActivitInfos:             make(map[int64]*ActivityInfo),
+		TimerInfos:               make(map[string]*TimerInfo),
+		ChildExecutionInfos:      make(map[int64]*ChildExecutionInfo),
+		RequestCancelInfos:       make(map[int64]*RequestCancelInfo),
+		SignalInfos:              make(map[int64]*SignalInfo),
+		SignalRequestedIDs:       make(map[string]struct{}),
+		BufferedEvents:           make([]*SerializedHistoryEventBatch, 0),
+		BufferedReplicationTasks: make(map[int64]*BufferedReplicationTask),
+	}
+	if err := json.Unmarshal(data, state.ExecutionInfo); err != nil {
+		return nil, convertSQLError("GetWorkflowExecution", err)
+	}
+	if len(replicationData) > 0 {
+		state.ReplicationState = &ReplicationState{}
+		if err := json.Unmarshal(replicationData, state.ReplicationState); err != nil {
+			return nil, convertSQLError("GetWorkflowExecution", err)
+		}
+	}
+
+	if err := m.getMutableStateMaps(state, request.DomainID, execution.GetWorkflowId(),
+		execution.GetRunId()); err != nil {
+		return nil, convertSQLError("GetWorkflowExecution", err)
+	}
+
+	rows, err := m.db.query(templateGetBufferedEventsSQLQuery, m.shardID, request.DomainID,
+		execution.GetWorkflowId(), execution.GetRunId())
+	if err != nil {
+		return nil, convertSQLError("GetWorkflowExecution", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var eventsData []byte
+		if err := rows.Scan(&eventsData); err != nil {
+			return nil, convertSQLError("GetWorkflowExecution", err)
+		}
+		batch := &SerializedHistoryEventBatch{EncodingType: common.EncodingTypeJSON}
+		if err := json.Unmarshal(eventsData, batch); err != nil {
+			return nil, convertSQLError("GetWorkflowExecution", err)
+		}
+		state.BufferedEvents = append(state.BufferedEvents, batch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError("GetWorkflowExecution", err)
+	}
+
+	return &GetWorkflowExecutionResponse{State: state}, nil
+}
+
+func (m *sqlExecutionPersistence) getMutableStateMaps(state *WorkflowMutableState, domainID, workflowID,
+	runID string) error {
+	rows, err := m.db.query(templateGetMapEntriesSQLQuery, m.shardID, domainID, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var mapType int
+		var key string
+		var data []byte
+		if err := rows.Scan(&mapType, &key, &data); err != nil {
+			return err
+		}
+
+		switch mapType {
+		case mutableStateMapActivity:
+			info := &ActivityInfo{}
+			if err := json.Unmarshal(data, info); err != nil {
+				return err
+			}
+			state.ActivitInfos[info.ScheduleID] = info
+		case mutableStateMapTimer:
+			info := &TimerInfo{}
+			if err := json.Unmarshal(data, info); err != nil {
+				return err
+			}
+			state.TimerInfos[info.TimerID] = info
+		case mutableStateMapChildExecution:
+			info := &ChildExecutionInfo{}
+			if err := json.Unmarshal(data, info); err != nil {
+				return err
+			}
+			state.ChildExecutionInfos[info.InitiatedID] = info
+		case mutableStateMapRequestCancel:
+			info := &RequestCancelInfo{}
+			if err := json.Unmarshal(data, info); err != nil {
+				return err
+			}
+			state.RequestCancelInfos[info.InitiatedID] = info
+		case mutableStateMapSignal:
+			info := &SignalInfo{}
+			if err := json.Unmarshal(data, info); err != nil {
+				return err
+			}
+			state.SignalInfos[info.InitiatedID] = info
+		case mutableStateMapSignalRequested:
+			state.SignalRequestedIDs[key] = struct{}{}
+		case mutableStateMapBufferedReplicationTask:
+			info := &BufferedReplicationTask{}
+			if err := json.Unmarshal(data, info); err != nil {
+				return err
+			}
+			state.BufferedReplicationTasks[info.FirstEventID] = info
+		default:
+			return fmt.Errorf("unknown mutable state map type: %v", mapType)
+		}
+	}
+	return rows.Err()
+}
+
+func (m *sqlExecutionPersistence) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error {
+	executionInfo := request.ExecutionInfo
+	domainID := executionInfo.DomainID
+	workflowID := executionInfo.WorkflowID
+	runID := executionInfo.RunID
+
+	return m.db.txExecute("UpdateWorkflowExecution", func(tx *sqlTx) error {
+		if err := m.lockShard(tx, request.RangeID); err != nil {
+			return err
+		}
+		if err := m.updateExecutionWithinTx(tx, executionInfo, request.ReplicationState,
+			request.Condition); err != nil {
+			return err
+		}
+
+		if err := m.createTransferTasks(tx, request.TransferTasks, domainID, workflowID, runID); err != nil {
+			return err
+		}
+		if err := m.createReplicationTasks(tx, request.ReplicationTasks, domainID, workflowID, runID); err != nil {
+			return err
+		}
+		if err := m.createTimerTasks(tx, request.TimerTasks, request.DeleteTimerTask, domainID, workflowID,
+			runID); err != nil {
+			return err
+		}
+
+		var upserts []sqlMapEntry
+		var deletes []sqlMapEntry
+		for _, a := range request.UpsertActivityInfos {
+			upserts = append(upserts, sqlMapEntry{mutableStateMapActivity, int64Key(a.ScheduleID), a})
+		}
+		for _, id := range request.DeleteActivityInfos {
+			deletes = append(deletes, sqlMapEntry{mapType: mutableStateMapActivity, key: int64Key(id)})
+		}
+		for _, t := range request.UpserTimerInfos {
+			upserts = append(upserts, sqlMapEntry{mutableStateMapTimer, t.TimerID, t})
+		}
+		for _, id := range request.DeleteTimerInfos {
+			deletes = append(deletes, sqlMapEntry{mapType: mutableStateMapTimer, key: id})
+		}
+		for _, c := range request.UpsertChildExecutionInfos {
+			upserts = append(upserts, sqlMapEntry{mutableStateMapChildExecution, int64Key(c.InitiatedID), c})
+		}
+		if request.DeleteChildExecutionInfo != nil {
+			deletes = append(deletes, sqlMapEntry{mapType: mutableStateMapChildExecution,
+				key: int64Key(*request.DeleteChildExecutionInfo)})
+		}
+		for _, r := range request.UpsertRequestCancelInfos {
+			upserts = append(upserts, sqlMapEntry{mutableStateMapRequestCancel, int64Key(r.InitiatedID), r})
+		}
+		if request.DeleteRequestCancelInfo != nil {
+			deletes = append(deletes, sqlMapEntry{mapType: mutableStateMapRequestCancel,
+				key: int64Key(*request.DeleteRequestCancelInfo)})
+		}
+		for _, s := range request.UpsertSignalInfos {
+			upserts = append(upserts, sqlMapEntry{mutableStateMapSignal, int64Key(s.InitiatedID), s})
+		}
+		if request.DeleteSignalInfo != nil {
+			deletes = append(deletes, sqlMapEntry{mapType: mutableStateMapSignal,
+				key: int64Key(*request.DeleteSignalInfo)})
+		}
+		for _, id := range request.UpsertSignalRequestedIDs {
+			upserts = append(upserts, sqlMapEntry{mutableStateMapSignalRequested, id, nil})
+		}
+		if request.DeleteSignalRequestedID != "" {
+			deletes = append(deletes, sqlMapEntry{mapType: mutableStateMapSignalRequested,
+				key: request.DeleteSignalRequestedID})
+		}
+		if request.NewBufferedReplicationTask != nil {
+			task := request.NewBufferedReplicationTask
+			upserts = append(upserts, sqlMapEntry{mutableStateMapBufferedReplicationTask,
+				int64Key(task.FirstEventID), task})
+		}
+		if request.DeleteBufferedReplicationTask != nil {
+			deletes = append(deletes, sqlMapEntry{mapType: mutableStateMapBufferedReplicationTask,
+				key: int64Key(*request.DeleteBufferedReplicationTask)})
+		}
+		if err := m.updateMapEntries(tx, upserts, deletes, domainID, workflowID, runID); err != nil {
+			return err
+		}
+
+		if err := m.updateBufferedEvents(tx, request.NewBufferedEvents, request.ClearBufferedEvents, domainID,
+			workflowID, runID); err != nil {
+			return err
+		}
+
+		if request.ContinueAsNew != nil {
+			startReq := request.ContinueAsNew
+			if err := m.createWorkflowExecutionWithinTx(tx, startReq); err != nil {
+				return err
+			}
+			if err := m.createTransferTasks(tx, startReq.TransferTasks, startReq.DomainID,
+				startReq.Execution.GetWorkflowId(), startReq.Execution.GetRunId()); err != nil {
+				return err
+			}
+			return m.createTimerTasks(tx, startReq.TimerTasks, nil, startReq.DomainID,
+				startReq.Execution.GetWorkflowId(), startReq.Execution.GetRunId())
+		} else if request.FinishExecution {
+			// The current execution row is kept around with the final state of the run, so
+			// that duplicate start requests can still be detected
+			if _, err := tx.exec(templateUpdateCurrentExecutionSQLQuery, runID, executionInfo.CreateRequestID,
+				executionInfo.State, executionInfo.CloseStatus, m.shardID, domainID, workflowID, runID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (m *sqlExecutionPersistence) ResetMutableState(request *ResetMutableStateRequest) error {
+	executionInfo := request.ExecutionInfo
+	domainID := executionInfo.DomainID
+	workflowID := executionInfo.WorkflowID
+	runID := executionInfo.RunID
+
+	return m.db.txExecute("ResetMutableState", func(tx *sqlTx) error {
+		if err := m.lockShard(tx, request.RangeID); err != nil {
+			return err
+		}
+		if err := m.updateExecutionWithinTx(tx, executionInfo, request.ReplicationState,
+			request.Condition); err != nil {
+			return err
+		}
+
+		if _, err := tx.exec(templateDeleteAllMapsSQLQuery, m.shardID, domainID, workflowID, runID); err != nil {
+			return err
+		}
+		return m.updateMapEntries(tx, resetMapEntries(request), nil, domainID, workflowID, runID)
+	})
+}
+
+// resetMapEntries returns the map entries of the mutable state snapshot
+func resetMapEntries(request *ResetMutableStateRequest) []sqlMapEntry {
+	var inserts []sqlMapEntry
+	for _, a := range request.InsertActivityInfos {
+		inserts = append(inserts, sqlMapEntry{mutableStateMapActivity, int64Key(a.ScheduleID), a})
+	}
+	for _, t := range request.InsertTimerInfos {
+		inserts = append(inserts, sqlMapEntry{mutableStateMapTimer, t.TimerID, t})
+	}
+	for _, c := range request.InsertChildExecutionInfos {
+		inserts = append(inserts, sqlMapEntry{mutableStateMapChildExecution, int64Key(c.InitiatedID), c})
+	}
+	for _, r := range request.InsertRequestCancelInfos {
+		inserts = append(inserts, sqlMapEntry{mutableStateMapRequestCancel, int64Key(r.InitiatedID), r})
+	}
+	for _, s := range request.InsertSignalInfos {
+		inserts = append(inserts, sqlMapEntry{mutableStateMapSignal, int64Key(s.InitiatedID), s})
+	}
+	for _, id := range request.InsertSignalRequestedIDs {
+		inserts = append(inserts, sqlMapEntry{mutableStateMapSignalRequested, id, nil})
+	}
+	return inserts
+}
+
+func (m *sqlExecutionPersistence) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	return m.db.txExecute("DeleteWorkflowExecution", func(tx *sqlTx) error {
+		if _, err := tx.exec(templateDeleteExecutionSQLQuery, m.shardID, request.DomainID, request.WorkflowID,
+			request.RunID); err != nil {
+			return err
+		}
+		if _, err := tx.exec(templateDeleteAllMapsSQLQuery, m.shardID, request.DomainID, request.WorkflowID,
+			request.RunID); err != nil {
+			return err
+		}
+		_, err := tx.exec(templateDeleteBufferedEventsSQLQuery, m.shardID, request.DomainID, request.WorkflowID,
+			request.RunID)
+		return err
+	})
+}
+
+func (m *sqlExecutionPersistence) GetCurrentExecution(request *GetCurrentExecutionRequest) (
+	*GetCurrentExecutionResponse, error) {
+	response := &GetCurrentExecutionResponse{}
+	if err := m.db.queryRow(templateGetCurrentExecutionSQLQuery, m.shardID, request.DomainID,
+		request.WorkflowID).Scan(&response.RunID, &response.StartRequestID, &response.State,
+		&response.CloseStatus); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v", request.WorkflowID),
+			}
+		}
+		return nil, convertSQLError("GetCurrentExecution", err)
+	}
+	return response, nil
+}
+
+func (m *sqlExecutionPersistence) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse,
+	error) {
+	rows, err := m.db.query(templateGetTransferTasksSQLQuery, m.shardID, request.ReadLevel, request.MaxReadLevel,
+		request.BatchSize)
+	if err != nil {
+		return nil, convertSQLError("GetTransferTasks", err)
+	}
+	defer rows.Close()
+
+	response := &GetTransferTasksResponse{}
+	for rows.Next() {
+		var taskID int64
+		var data []byte
+		if err := rows.Scan(&taskID, &data); err != nil {
+			return nil, convertSQLError("GetTransferTasks", err)
+		}
+		t := &TransferTaskInfo{}
+		if err := json.Unmarshal(data, t); err != nil {
+			return nil, convertSQLError("GetTransferTasks", err)
+		}
+		t.TaskID = taskID
+		response.Tasks = append(response.Tasks, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError("GetTransferTasks", err)
+	}
+	return response, nil
+}
+
+func (m *sqlExecutionPersistence) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	if _, err := m.db.exec(templateCompleteTransferTaskSQLQuery, m.shardID, request.TaskID); err != nil {
+		return convertSQLError("CompleteTransferTask", err)
+	}
+	return nil
+}
+
+func (m *sqlExecutionPersistence) GetReplicationTasks(request *GetReplicationTasksRequest) (
+	*GetReplicationTasksResponse, error) {
+	rows, err := m.db.query(templateGetReplicationTasksSQLQuery, m.shardID, request.ReadLevel,
+		request.MaxReadLevel, request.BatchSize)
+	if err != nil {
+		return nil, convertSQLError("GetReplicationTasks", err)
+	}
+	defer rows.Close()
+
+	response := &GetReplicationTasksResponse{}
+	for rows.Next() {
+		var taskID int64
+		var data []byte
+		if err := rows.Scan(&taskID, &data); err != nil {
+			return nil, convertSQLError("GetReplicationTasks", err)
+		}
+		t := &ReplicationTaskInfo{}
+		if err := json.Unmarshal(data, t); err != nil {
+			return nil, convertSQLError("GetReplicationTasks", err)
+		}
+		t.TaskID = taskID
+		response.Tasks = append(response.Tasks, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError("GetReplicationTasks", err)
+	}
+	return response, nil
+}
+
+func (m *sqlExecutionPersistence) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	if _, err := m.db.exec(templateCompleteReplicationTaskSQLQuery, m.shardID, request.TaskID); err != nil {
+		return convertSQLError("CompleteReplicationTask", err)
+	}
+	return nil
+}
+
+func (m *sqlExecutionPersistence) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (
+	*GetTimerIndexTasksResponse, error) {
+	rows, err := m.db.query(templateGetTimerTasksSQLQuery, m.shardID, request.MinTimestamp.UTC(),
+		request.MaxTimestamp.UTC(), request.BatchSize)
+	if err != nil {
+		return nil, convertSQLError("GetTimerTasks", err)
+	}
+	defer rows.Close()
+
+	response := &GetTimerIndexTasksResponse{}
+	for rows.Next() {
+		var visibilityTimestamp time.Time
+		var taskID int64
+		var data []byte
+		if err := rows.Scan(&visibilityTimestamp, &taskID, &data); err != nil {
+			return nil, convertSQLError("GetTimerTasks", err)
+		}
+		t := &TimerTaskInfo{}
+		if err := json.Unmarshal(data, t); err != nil {
+			return nil, convertSQLError("GetTimerTasks", err)
+		}
+		t.VisibilityTimestamp = visibilityTimestamp
+		t.TaskID = taskID
+		response.Timers = append(response.Timers, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError("GetTimerTasks", err)
+	}
+	return response, nil
+}
+
+func (m *sqlExecutionPersistence) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	if _, err := m.db.exec(templateCompleteTimerTaskSQLQuery, m.shardID, request.VisibilityTimestamp.UTC(),
+		request.TaskID); err != nil {
+		return convertSQLError("CompleteTimerTask", err)
+	}
+	return nil
+}
+
+// lockShard takes a shared lock on the shard row for the duration of the transaction and
+// verifies that the shard is still owned by the caller
+func (m *sqlExecutionPersistence) lockShard(tx *sqlTx, rangeID int64) error {
+	var dbRangeID int64
+	if err := tx.queryRow(templateLockShardRangeSQLQuery+m.db.forShare(), m.shardID).Scan(&dbRangeID); err != nil {
+		if err == sql.ErrNoRows {
+			return &ShardOwnershipLostError{
+				ShardID: m.shardID,
+				Msg:     fmt.Sprintf("Shard not found.  Request RangeID: %v", rangeID),
+			}
+		}
+		return err
+	}
+	if dbRangeID != rangeID {
+		return &ShardOwnershipLostError{
+			ShardID: m.shardID,
+			Msg: fmt.Sprintf("Failed to update mutable state.  Request RangeID: %v, Actual RangeID: %v",
+				rangeID, dbRangeID),
+		}
+	}
+	return nil
+}
+
+// lockCurrentExecution returns the current execution row for the workflow, or nil if
+// it does not exist, and locks it for the duration of the transaction
+func (m *sqlExecutionPersistence) lockCurrentExecution(tx *sqlTx, domainID, workflowID string) (
+	*GetCurrentExecutionResponse, error) {
+	current := &GetCurrentExecutionResponse{}
+	if err := tx.queryRow(templateGetCurrentExecutionSQLQuery+m.db.forUpdate(), m.shardID, domainID,
+		workflowID).Scan(&current.RunID, &current.StartRequestID, &current.State, &current.CloseStatus); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return current, nil
+}
+
+// updateExecutionWithinTx overwrites the execution row if its next_event_id still matches condition
+func (m *sqlExecutionPersistence) updateExecutionWithinTx(tx *sqlTx, executionInfo *WorkflowExecutionInfo,
+	replicationState *ReplicationState, condition int64) error {
+	var nextEventID int64
+	if err := tx.queryRow(templateLockExecutionSQLQuery+m.db.forUpdate(), m.shardID, executionInfo.DomainID,
+		executionInfo.WorkflowID, executionInfo.RunID).Scan(&nextEventID); err != nil {
+		if err == sql.ErrNoRows {
+			return &ConditionFailedError{
+				Msg: fmt.Sprintf("Failed to update workflow execution.  WorkflowId: %v, RunId: %v does not exist",
+					executionInfo.WorkflowID, executionInfo.RunID),
+			}
+		}
+		return err
+	}
+	if nextEventID != condition {
+		return &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update workflow execution.  Request Condition: %v, Actual Value: %v",
+				condition, nextEventID),
+		}
+	}
+
+	info := *executionInfo
+	info.LastUpdatedTimestamp = time.Now()
+	data, replicationData, err := serializeExecution(&info, replicationState)
+	if err != nil {
+		return err
+	}
+	_, err = tx.exec(templateUpdateExecutionSQLQuery, info.NextEventID, data, replicationData, m.shardID,
+		info.DomainID, info.WorkflowID, info.RunID)
+	return err
+}
+
+func (m *sqlExecutionPersistence) updateMapEntries(tx *sqlTx, upserts, deletes []sqlMapEntry, domainID, workflowID,
+	runID string) error {
+	for _, e := range deletes {
+		if _, err := tx.exec(templateDeleteMapEntrySQLQuery, m.shardID, domainID, workflowID, runID, e.mapType,
+			e.key); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range upserts {
+		data := []byte{}
+		if e.value != nil {
+			var err error
+			if data, err = json.Marshal(e.value); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.exec(templateDeleteMapEntrySQLQuery, m.shardID, domainID, workflowID, runID, e.mapType,
+			e.key); err != nil {
+			return err
+		}
+		if _, err := tx.exec(templateInsertMapEntrySQLQuery, m.shardID, domainID, workflowID, runID, e.mapType,
+			e.key, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionPersistence) updateBufferedEvents(tx *sqlTx, newBufferedEvents *SerializedHistoryEventBatch,
+	clearBufferedEvents bool, domainID, workflowID, runID string) error {
+	if clearBufferedEvents {
+		if _, err := tx.exec(templateDeleteBufferedEventsSQLQuery, m.shardID, domainID, workflowID,
+			runID); err != nil {
+			return err
+		}
+	} else if newBufferedEvents != nil {
+		data, err := json.Marshal(newBufferedEvents)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.exec(templateInsertBufferedEventsSQLQuery, m.shardID, domainID, workflowID, runID,
+			data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionPersistence) createTransferTasks(tx *sqlTx, transferTasks []Task, domainID, workflowID,
+	runID string) error {
+	for _, task := range transferTasks {
+		info := &TransferTaskInfo{
+			DomainID:         domainID,
+			WorkflowID:       workflowID,
+			RunID:            runID,
+			TaskID:           task.GetTaskID(),
+			TargetDomainID:   domainID,
+			TargetWorkflowID: transferTaskTransferTargetWorkflowID,
+			TargetRunID:      transferTaskTypeTransferTargetRunID,
+			TaskType:         task.GetType(),
+			Version:          task.GetVersion(),
+		}
+
+		switch t := task.(type) {
+		case *ActivityTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+		case *DecisionTask:
+			info.TargetDomainID = t.DomainID
+			info.TaskList = t.TaskList
+			info.ScheduleID = t.ScheduleID
+		case *CancelExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			if t.TargetRunID != "" {
+				info.TargetRunID = t.TargetRunID
+			}
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+		case *SignalExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			if t.TargetRunID != "" {
+				info.TargetRunID = t.TargetRunID
+			}
+			info.TargetChildWorkflowOnly = t.TargetChildWorkflowOnly
+			info.ScheduleID = t.InitiatedID
+		case *StartChildExecutionTask:
+			info.TargetDomainID = t.TargetDomainID
+			info.TargetWorkflowID = t.TargetWorkflowID
+			info.ScheduleID = t.InitiatedID
+		case *CloseExecutionTask, *UpsertWorkflowSearchAttributesTask:
+			// No explicit property needs to be set
+		default:
+			m.logger.Fatal("Unknown Transfer Task.")
+		}
+
+		data, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.exec(templateCreateTransferTaskSQLQuery, m.shardID, task.GetTaskID(), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionPersistence) createReplicationTasks(tx *sqlTx, replicationTasks []Task, domainID, workflowID,
+	runID string) error {
+	for _, task := range replicationTasks {
+		info := &ReplicationTaskInfo{
+			DomainID:     domainID,
+			WorkflowID:   workflowID,
+			RunID:        runID,
+			TaskID:       task.GetTaskID(),
+			TaskType:     task.GetType(),
+			FirstEventID: common.EmptyEventID,
+			NextEventID:  common.EmptyEventID,
+		}
+
+		switch t := task.(type) {
+		case *HistoryReplicationTask:
+			info.FirstEventID = t.FirstEventID
+			info.NextEventID = t.NextEventID
+			info.Version = t.Version
+			info.LastReplicationInfo = t.LastReplicationInfo
+		default:
+			m.logger.Fatal("Unknown Replication Task.")
+		}
+
+		data, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.exec(templateCreateReplicationTaskSQLQuery, m.shardID, task.GetTaskID(), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionPersistence) createTimerTasks(tx *sqlTx, timerTasks []Task, deleteTimerTask Task, domainID,
+	workflowID, runID string) error {
+	for _, task := range timerTasks {
+		info := &TimerTaskInfo{
+			DomainID:   domainID,
+			WorkflowID: workflowID,
+			RunID:      runID,
+			TaskID:     task.GetTaskID(),
+			TaskType:   task.GetType(),
+			Version:    task.GetVersion(),
+		}
+
+		switch t := task.(type) {
+		case *DecisionTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.ScheduleAttempt
+		case *ActivityTimeoutTask:
+			info.EventID = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.Attempt
+		case *UserTimerTask:
+			info.EventID = t.EventID
+		case *RetryTimerTask:
+			info.EventID = t.EventID
+			info.ScheduleAttempt = int64(t.Attempt)
+		case *WorkflowBackoffTimerTask:
+			info.TimeoutType = t.TimeoutType
+		}
+
+		data, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.exec(templateCreateTimerTaskSQLQuery, m.shardID, GetVisibilityTSFrom(task).UTC(),
+			task.GetTaskID(), data); err != nil {
+			return err
+		}
+	}
+
+	if deleteTimerTask != nil {
+		if _, err := tx.exec(templateCompleteTimerTaskSQLQuery, m.shardID,
+			GetVisibilityTSFrom(deleteTimerTask).UTC(), deleteTimerTask.GetTaskID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serializeExecution encodes the execution info and the optional replication state of an execution row
+func serializeExecution(info *WorkflowExecutionInfo, replicationState *ReplicationState) ([]byte, []byte, error) {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return nil, nil, err
+	}
+	if replicationState == nil {
+		return data, nil, nil
+	}
+	replicationData, err := json.Marshal(replicationState)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, replicationData, nil
+}
+
+func int64Key(key int64) string {
+	return strconv.FormatInt(key, 10)
+}
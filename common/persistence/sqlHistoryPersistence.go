@@ -0,0 +1,197 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	templateAppendHistoryEventsSQLQuery = `INSERT INTO events (` +
+		`domain_id, workflow_id, run_id, first_event_id, range_id, tx_id, data, data_encoding, data_version) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateOverwriteHistoryEventsSQLQuery = `UPDATE events ` +
+		`SET range_id = ?, tx_id = ?, data = ?, data_encoding = ?, data_version = ? ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ? AND first_event_id = ? ` +
+		`AND range_id <= ? AND tx_id < ?`
+
+	templateGetWorkflowExecutionHistorySQLQuery = `SELECT first_event_id, data, data_encoding, data_version ` +
+		`FROM events ` +
+		`WHERE domain_id = ? ` +
+		`AND workflow_id = ? ` +
+		`AND run_id = ? ` +
+		`AND first_event_id >= ? ` +
+		`AND first_event_id < ? ` +
+		`ORDER BY first_event_id LIMIT ?`
+
+	templateDeleteWorkflowExecutionHistorySQLQuery = `DELETE FROM events ` +
+		`WHERE domain_id = ? ` +
+		`AND workflow_id = ? ` +
+		`AND run_id = ?`
+)
+
+type (
+	sqlHistoryPersistence struct {
+		db     *sqlDB
+		logger logging.Logger
+	}
+)
+
+// NewSQLHistoryPersistence is used to create an instance of HistoryManager implementation
+func NewSQLHistoryPersistence(cfg *config.SQL, numConns int, logger logging.Logger) (HistoryManager, error) {
+	sqlCfg := *cfg
+	if numConns > 0 {
+		sqlCfg.MaxConns = numConns
+	}
+	db, err := newSQLDB(&sqlCfg, sqlCfg.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlHistoryPersistence{db: db, logger: logger}, nil
+}
+
+// Close gracefully releases the resources held by this object
+func (h *sqlHistoryPersistence) Close() {
+	h.db.Close()
+}
+
+func (h *sqlHistoryPersistence) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	if request.Overwrite {
+		result, err := h.db.exec(templateOverwriteHistoryEventsSQLQuery,
+			request.RangeID,
+			request.TransactionID,
+			request.Events.Data,
+			request.Events.EncodingType,
+			request.Events.Version,
+			request.DomainID,
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+			request.FirstEventID,
+			request.RangeID,
+			request.TransactionID)
+		if err != nil {
+			return convertSQLError("AppendHistoryEvents", err)
+		}
+		if checkRowsAffected(result) != nil {
+			return &ConditionFailedError{
+				Msg: "Failed to append history events.",
+			}
+		}
+		return nil
+	}
+
+	if _, err := h.db.exec(templateAppendHistoryEventsSQLQuery,
+		request.DomainID,
+		request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId(),
+		request.FirstEventID,
+		request.RangeID,
+		request.TransactionID,
+		request.Events.Data,
+		request.Events.EncodingType,
+		request.Events.Version); err != nil {
+		if isDuplicateKeyError(err) {
+			return &ConditionFailedError{
+				Msg: "Failed to append history events.",
+			}
+		}
+		return convertSQLError("AppendHistoryEvents", err)
+	}
+	return nil
+}
+
+func (h *sqlHistoryPersistence) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (
+	*GetWorkflowExecutionHistoryResponse, error) {
+	execution := request.Execution
+
+	// The page token is the first_event_id of the last batch returned by the previous page
+	firstEventID := request.FirstEventID
+	if len(request.NextPageToken) > 0 {
+		if len(request.NextPageToken) != 8 {
+			return nil, &workflow.BadRequestError{
+				Message: "GetWorkflowExecutionHistory operation failed. Invalid next page token.",
+			}
+		}
+		firstEventID = int64(binary.BigEndian.Uint64(request.NextPageToken)) + 1
+	}
+
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = math.MaxInt32
+	}
+
+	rows, err := h.db.query(templateGetWorkflowExecutionHistorySQLQuery,
+		request.DomainID,
+		execution.GetWorkflowId(),
+		execution.GetRunId(),
+		firstEventID,
+		request.NextEventID,
+		pageSize)
+	if err != nil {
+		return nil, convertSQLError("GetWorkflowExecutionHistory", err)
+	}
+	defer rows.Close()
+
+	var lastFirstEventID int64
+	response := &GetWorkflowExecutionHistoryResponse{}
+	for rows.Next() {
+		var history SerializedHistoryEventBatch
+		if err := rows.Scan(&lastFirstEventID, &history.Data, &history.EncodingType, &history.Version); err != nil {
+			return nil, convertSQLError("GetWorkflowExecutionHistory", err)
+		}
+		response.Events = append(response.Events, history)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError("GetWorkflowExecutionHistory", err)
+	}
+
+	if len(response.Events) == 0 && len(request.NextPageToken) == 0 {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution history not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+
+	if len(response.Events) == pageSize {
+		response.NextPageToken = make([]byte, 8)
+		binary.BigEndian.PutUint64(response.NextPageToken, uint64(lastFirstEventID))
+	}
+	return response, nil
+}
+
+func (h *sqlHistoryPersistence) DeleteWorkflowExecutionHistory(
+	request *DeleteWorkflowExecutionHistoryRequest) error {
+	execution := request.Execution
+	if _, err := h.db.exec(templateDeleteWorkflowExecutionHistorySQLQuery,
+		request.DomainID,
+		execution.GetWorkflowId(),
+		execution.GetRunId()); err != nil {
+		return convertSQLError("DeleteWorkflowExecutionHistory", err)
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	templateCreateDomainSQLQuery = `INSERT INTO domains (` +
+		`id, name, data, is_global_domain, config_version, failover_version, db_version) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, 0)`
+
+	templateGetDomainSQLQuery = `SELECT id, data, is_global_domain, config_version, failover_version, db_version ` +
+		`FROM domains `
+
+	templateGetDomainByIDSQLQuery = templateGetDomainSQLQuery + `WHERE id = ?`
+
+	templateGetDomainByNameSQLQuery = templateGetDomainSQLQuery + `WHERE name = ?`
+
+	templateListDomainsSQLQuery = templateGetDomainSQLQuery + `WHERE name > ? ORDER BY name LIMIT ?`
+
+	templateUpdateDomainSQLQuery = `UPDATE domains ` +
+		`SET data = ?, config_version = ?, failover_version = ?, db_version = ? ` +
+		`WHERE name = ? AND db_version = ?`
+
+	templateDeleteDomainSQLQuery = `DELETE FROM domains WHERE id = ?`
+
+	templateDeleteDomainByNameSQLQuery = `DELETE FROM domains WHERE name = ?`
+)
+
+type (
+	sqlMetadataPersistence struct {
+		db                 *sqlDB
+		currentClusterName string
+		logger             logging.Logger
+	}
+
+	// sqlDomainData is the serialized form of the mutable parts of a domain record
+	sqlDomainData struct {
+		Info              *DomainInfo
+		Config            *DomainConfig
+		ReplicationConfig *DomainReplicationConfig
+	}
+)
+
+// NewSQLMetadataPersistence is used to create an instance of MetadataManager implementation
+func NewSQLMetadataPersistence(cfg *config.SQL, currentClusterName string, logger logging.Logger) (MetadataManager,
+	error) {
+	db, err := newSQLDB(cfg, cfg.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlMetadataPersistence{
+		db:                 db,
+		currentClusterName: currentClusterName,
+		logger:             logger,
+	}, nil
+}
+
+// Close releases the resources held by this object
+func (m *sqlMetadataPersistence) Close() {
+	m.db.Close()
+}
+
+func (m *sqlMetadataPersistence) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	data, err := json.Marshal(&sqlDomainData{
+		Info:              request.Info,
+		Config:            request.Config,
+		ReplicationConfig: request.ReplicationConfig,
+	})
+	if err != nil {
+		return nil, convertSQLError("CreateDomain", err)
+	}
+
+	if _, err := m.db.exec(templateCreateDomainSQLQuery,
+		request.Info.ID,
+		request.Info.Name,
+		data,
+		request.IsGlobalDomain,
+		request.ConfigVersion,
+		request.FailoverVersion,
+	); err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, &workflow.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain already exists.  DomainId: %v", request.Info.ID),
+			}
+		}
+		return nil, convertSQLError("CreateDomain", err)
+	}
+
+	return &CreateDomainResponse{ID: request.Info.ID}, nil
+}
+
+func (m *sqlMetadataPersistence) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+	if len(request.ID) > 0 && len(request.Name) > 0 {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name specified in request.",
+		}
+	} else if len(request.ID) == 0 && len(request.Name) == 0 {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name are empty.",
+		}
+	}
+
+	var row *sql.Row
+	identity := request.Name
+	if len(request.ID) > 0 {
+		identity = request.ID
+		row = m.db.queryRow(templateGetDomainByIDSQLQuery, request.ID)
+	} else {
+		row = m.db.queryRow(templateGetDomainByNameSQLQuery, request.Name)
+	}
+
+	var id string
+	var data []byte
+	response := &GetDomainResponse{}
+	if err := row.Scan(&id, &data, &response.IsGlobalDomain, &response.ConfigVersion, &response.FailoverVersion,
+		&response.DBVersion); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Domain %s does not exist.", identity),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetDomain operation failed. Error %v", err),
+		}
+	}
+
+	if err := m.decodeDomainData(data, response); err != nil {
+		return nil, convertSQLError("GetDomain", err)
+	}
+
+	return response, nil
+}
+
+// ListDomains returns the domains ordered by name, the next page token is the name of the last domain of the page
+func (m *sqlMetadataPersistence) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	rows, err := m.db.query(templateListDomainsSQLQuery, string(request.NextPageToken), request.PageSize)
+	if err != nil {
+		return nil, convertSQLError("ListDomains", err)
+	}
+	defer rows.Close()
+
+	response := &ListDomainsResponse{}
+	for rows.Next() {
+		var id string
+		var data []byte
+		domain := &GetDomainResponse{}
+		if err := rows.Scan(&id, &data, &domain.IsGlobalDomain, &domain.ConfigVersion, &domain.FailoverVersion,
+			&domain.DBVersion); err != nil {
+			return nil, convertSQLError("ListDomains", err)
+		}
+		if err := m.decodeDomainData(data, domain); err != nil {
+			return nil, convertSQLError("ListDomains", err)
+		}
+		response.Domains = append(response.Domains, domain)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError("ListDomains", err)
+	}
+
+	if request.PageSize > 0 && len(response.Domains) == request.PageSize {
+		response.NextPageToken = []byte(response.Domains[len(response.Domains)-1].Info.Name)
+	}
+	return response, nil
+}
+
+// decodeDomainData fills a domain with its serialized record
+func (m *sqlMetadataPersistence) decodeDomainData(data []byte, response *GetDomainResponse) error {
+	domain := &sqlDomainData{}
+	if err := json.Unmarshal(data, domain); err != nil {
+		return err
+	}
+	response.Info = domain.Info
+	response.Config = domain.Config
+	response.ReplicationConfig = domain.ReplicationConfig
+	if response.ReplicationConfig == nil {
+		response.ReplicationConfig = &DomainReplicationConfig{}
+	}
+	response.ReplicationConfig.ActiveClusterName = GetOrUseDefaultActiveCluster(m.currentClusterName,
+		response.ReplicationConfig.ActiveClusterName)
+	response.ReplicationConfig.Clusters = GetOrUseDefaultClusters(m.currentClusterName,
+		response.ReplicationConfig.Clusters)
+	return nil
+}
+
+func (m *sqlMetadataPersistence) UpdateDomain(request *UpdateDomainRequest) error {
+	data, err := json.Marshal(&sqlDomainData{
+		Info:              request.Info,
+		Config:            request.Config,
+		ReplicationConfig: request.ReplicationConfig,
+	})
+	if err != nil {
+		return convertSQLError("UpdateDomain", err)
+	}
+
+	result, err := m.db.exec(templateUpdateDomainSQLQuery,
+		data,
+		request.ConfigVersion,
+		request.FailoverVersion,
+		request.DBVersion+1,
+		request.Info.Name,
+		request.DBVersion,
+	)
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateDomain operation failed. Error %v", err),
+		}
+	}
+	if err := checkRowsAffected(result); err != nil {
+		return &ConditionFailedError{
+			Msg: fmt.Sprintf("UpdateDomain operation failed. Domain: %v, DBVersion: %v, Error: %v",
+				request.Info.Name, request.DBVersion, err),
+		}
+	}
+
+	return nil
+}
+
+func (m *sqlMetadataPersistence) DeleteDomain(request *DeleteDomainRequest) error {
+	if _, err := m.db.exec(templateDeleteDomainSQLQuery, request.ID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteDomain operation failed. Error %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlMetadataPersistence) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+	if _, err := m.db.exec(templateDeleteDomainByNameSQLQuery, request.Name); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteDomainByName operation failed. Error %v", err),
+		}
+	}
+	return nil
+}
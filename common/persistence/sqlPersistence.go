@@ -0,0 +1,533 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// sqlDriverMySQL is the database/sql driver name for mysql
+	sqlDriverMySQL = "mysql"
+	// sqlDriverPostgres is the database/sql driver name for postgres
+	sqlDriverPostgres = "postgres"
+
+	// mysql error code for a duplicate entry on a primary or unique key
+	mysqlErrDupEntry = 1062
+	// postgres error code for a unique constraint violation
+	postgresErrUniqueViolation = "23505"
+)
+
+const (
+	templateCreateShardSQLQuery = `INSERT INTO shards (shard_id, range_id, data) VALUES (?, ?, ?)`
+
+	templateGetShardSQLQuery = `SELECT data FROM shards WHERE shard_id = ?`
+
+	templateUpdateShardSQLQuery = `UPDATE shards SET range_id = ?, data = ? WHERE shard_id = ? AND range_id = ?`
+
+	templateGetTaskListSQLQuery = `SELECT range_id, ack_level, kind FROM task_lists ` +
+		`WHERE domain_id = ? AND name = ? AND task_type = ?`
+
+	templateInsertTaskListSQLQuery = `INSERT INTO task_lists (domain_id, name, task_type, range_id, ack_level, kind) ` +
+		`VALUES (?, ?, ?, ?, ?, ?)`
+
+	templateLeaseTaskListSQLQuery = `UPDATE task_lists SET range_id = ? ` +
+		`WHERE domain_id = ? AND name = ? AND task_type = ? AND range_id = ?`
+
+	templateUpdateTaskListSQLQuery = `UPDATE task_lists SET ack_level = ?, kind = ? ` +
+		`WHERE domain_id = ? AND name = ? AND task_type = ? AND range_id = ?`
+
+	templateUpdateStickyTaskListSQLQuery = `UPDATE task_lists SET range_id = ?, ack_level = ?, kind = ? ` +
+		`WHERE domain_id = ? AND name = ? AND task_type = ?`
+
+	templateLockTaskListSQLQuery = `SELECT range_id FROM task_lists ` +
+		`WHERE domain_id = ? AND name = ? AND task_type = ?`
+
+	templateCreateTaskSQLQuery = `INSERT INTO tasks (domain_id, task_list_name, task_type, task_id, data) ` +
+		`VALUES (?, ?, ?, ?, ?)`
+
+	templateGetTasksSQLQuery = `SELECT task_id, data FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_type = ? AND task_id > ? AND task_id <= ? ` +
+		`ORDER BY task_id LIMIT ?`
+
+	templateCompleteTaskSQLQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_type = ? AND task_id = ?`
+)
+
+type (
+	// sqlDB wraps a database/sql connection pool and hides the differences
+	// between the supported sql dialects
+	sqlDB struct {
+		db         *sql.DB
+		driverName string
+	}
+
+	// sqlTx is a transaction on a sqlDB
+	sqlTx struct {
+		tx *sql.Tx
+		db *sqlDB
+	}
+
+	sqlShardPersistence struct {
+		db                 *sqlDB
+		currentClusterName string
+		logger             logging.Logger
+	}
+
+	sqlTaskPersistence struct {
+		db     *sqlDB
+		logger logging.Logger
+	}
+)
+
+// NewSQLShardPersistence is used to create an instance of ShardManager implementation
+func NewSQLShardPersistence(cfg *config.SQL, currentClusterName string, logger logging.Logger) (ShardManager, error) {
+	db, err := newSQLDB(cfg, cfg.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlShardPersistence{db: db, currentClusterName: currentClusterName, logger: logger}, nil
+}
+
+// NewSQLTaskPersistence is used to create an instance of TaskManager implementation
+func NewSQLTaskPersistence(cfg *config.SQL, logger logging.Logger) (TaskManager, error) {
+	db, err := newSQLDB(cfg, cfg.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlTaskPersistence{db: db, logger: logger}, nil
+}
+
+// newSQLDB opens a connection pool to the given database
+func newSQLDB(cfg *config.SQL, dbName string) (*sqlDB, error) {
+	var dsn string
+	switch cfg.DriverName {
+	case sqlDriverMySQL:
+		// clientFoundRows makes conditional updates report matched rather than changed rows
+		dsn = fmt.Sprintf("%v:%v@tcp(%v)/%v?parseTime=true&clientFoundRows=true",
+			cfg.User, cfg.Password, cfg.ConnectAddr, dbName)
+	case sqlDriverPostgres:
+		dsn = fmt.Sprintf("postgres://%v:%v@%v/%v?sslmode=disable",
+			cfg.User, cfg.Password, cfg.ConnectAddr, dbName)
+	default:
+		return nil, fmt.Errorf("unsupported sql driver: %v", cfg.DriverName)
+	}
+
+	db, err := sql.Open(cfg.DriverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxConns)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqlDB{db: db, driverName: cfg.DriverName}, nil
+}
+
+// rebind converts the ? placeholders used by all queries in this package
+// to the placeholder format of the underlying driver
+func (d *sqlDB) rebind(query string) string {
+	if d.driverName != sqlDriverPostgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, c := range query {
+		if c == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// forUpdate returns the clause used to take an exclusive row lock
+func (d *sqlDB) forUpdate() string {
+	return " FOR UPDATE"
+}
+
+// forShare returns the clause used to take a shared row lock
+func (d *sqlDB) forShare() string {
+	if d.driverName == sqlDriverPostgres {
+		return " FOR SHARE"
+	}
+	return " LOCK IN SHARE MODE"
+}
+
+func (d *sqlDB) exec(query string, args ...interface{}) (sql.Result, error) {
+	return d.db.Exec(d.rebind(query), args...)
+}
+
+func (d *sqlDB) query(query string, args ...interface{}) (*sql.Rows, error) {
+	return d.db.Query(d.rebind(query), args...)
+}
+
+func (d *sqlDB) queryRow(query string, args ...interface{}) *sql.Row {
+	return d.db.QueryRow(d.rebind(query), args...)
+}
+
+// txExecute runs fn within a transaction, the transaction is committed when
+// fn returns nil and rolled back otherwise
+func (d *sqlDB) txExecute(operation string, fn func(tx *sqlTx) error) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Failed to start transaction. Error: %v", operation, err),
+		}
+	}
+
+	if err := fn(&sqlTx{tx: tx, db: d}); err != nil {
+		tx.Rollback()
+		return convertSQLError(operation, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Failed to commit transaction. Error: %v", operation, err),
+		}
+	}
+	return nil
+}
+
+// Close releases the connection pool
+func (d *sqlDB) Close() {
+	if d.db != nil {
+		d.db.Close()
+	}
+}
+
+func (t *sqlTx) exec(query string, args ...interface{}) (sql.Result, error) {
+	return t.tx.Exec(t.db.rebind(query), args...)
+}
+
+func (t *sqlTx) query(query string, args ...interface{}) (*sql.Rows, error) {
+	return t.tx.Query(t.db.rebind(query), args...)
+}
+
+func (t *sqlTx) queryRow(query string, args ...interface{}) *sql.Row {
+	return t.tx.QueryRow(t.db.rebind(query), args...)
+}
+
+// Close releases the resources held by this object
+func (m *sqlShardPersistence) Close() {
+	m.db.Close()
+}
+
+func (m *sqlShardPersistence) CreateShard(request *CreateShardRequest) error {
+	shardInfo := *request.ShardInfo
+	shardInfo.UpdatedAt = time.Now()
+	data, err := json.Marshal(&shardInfo)
+	if err != nil {
+		return convertSQLError("CreateShard", err)
+	}
+
+	if _, err := m.db.exec(templateCreateShardSQLQuery, shardInfo.ShardID, shardInfo.RangeID, data); err != nil {
+		if isDuplicateKeyError(err) {
+			return &ShardAlreadyExistError{
+				Msg: fmt.Sprintf("Shard already exists in shards table.  ShardId: %v", shardInfo.ShardID),
+			}
+		}
+		return convertSQLError("CreateShard", err)
+	}
+	return nil
+}
+
+func (m *sqlShardPersistence) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	var data []byte
+	if err := m.db.queryRow(templateGetShardSQLQuery, request.ShardID).Scan(&data); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Shard not found.  ShardId: %v", request.ShardID),
+			}
+		}
+		return nil, convertSQLError("GetShard", err)
+	}
+
+	info := &ShardInfo{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, convertSQLError("GetShard", err)
+	}
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			m.currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			m.currentClusterName: info.TimerAckLevel,
+		}
+	}
+	return &GetShardResponse{ShardInfo: info}, nil
+}
+
+func (m *sqlShardPersistence) UpdateShard(request *UpdateShardRequest) error {
+	shardInfo := *request.ShardInfo
+	shardInfo.UpdatedAt = time.Now()
+	data, err := json.Marshal(&shardInfo)
+	if err != nil {
+		return convertSQLError("UpdateShard", err)
+	}
+
+	result, err := m.db.exec(templateUpdateShardSQLQuery, shardInfo.RangeID, data, shardInfo.ShardID,
+		request.PreviousRangeID)
+	if err != nil {
+		return convertSQLError("UpdateShard", err)
+	}
+	if err := checkRowsAffected(result); err != nil {
+		return &ShardOwnershipLostError{
+			ShardID: shardInfo.ShardID,
+			Msg: fmt.Sprintf("Failed to update shard.  previous_range_id: %v, error: %v",
+				request.PreviousRangeID, err),
+		}
+	}
+	return nil
+}
+
+// Close releases the resources held by this object
+func (m *sqlTaskPersistence) Close() {
+	m.db.Close()
+}
+
+// From TaskManager interface
+func (m *sqlTaskPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	if len(request.TaskList) == 0 {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("LeaseTaskList requires non empty task list"),
+		}
+	}
+
+	var rangeID, ackLevel int64
+	var kind int
+	err := m.db.queryRow(templateGetTaskListSQLQuery, request.DomainID, request.TaskList, request.TaskType).
+		Scan(&rangeID, &ackLevel, &kind)
+	switch err {
+	case nil:
+		result, err := m.db.exec(templateLeaseTaskListSQLQuery, rangeID+1, request.DomainID, request.TaskList,
+			request.TaskType, rangeID)
+		if err != nil {
+			return nil, convertSQLError("LeaseTaskList", err)
+		}
+		if err := checkRowsAffected(result); err != nil {
+			return nil, &ConditionFailedError{
+				Msg: fmt.Sprintf("LeaseTaskList failed to apply. rangeID %v, error: %v", rangeID, err),
+			}
+		}
+	case sql.ErrNoRows: // First time task list is used
+		rangeID = initialRangeID - 1
+		if _, err := m.db.exec(templateInsertTaskListSQLQuery, request.DomainID, request.TaskList, request.TaskType,
+			initialRangeID, 0, request.TaskListKind); err != nil {
+			if isDuplicateKeyError(err) {
+				return nil, &ConditionFailedError{
+					Msg: fmt.Sprintf("LeaseTaskList failed to apply. TaskList: %v created concurrently",
+						request.TaskList),
+				}
+			}
+			return nil, convertSQLError("LeaseTaskList", err)
+		}
+	default:
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("LeaseTaskList operation failed. TaskList: %v, TaskType: %v, Error: %v",
+				request.TaskList, request.TaskType, err),
+		}
+	}
+
+	tli := &TaskListInfo{DomainID: request.DomainID, Name: request.TaskList, TaskType: request.TaskType,
+		RangeID: rangeID + 1, AckLevel: ackLevel, Kind: request.TaskListKind}
+	return &LeaseTaskListResponse{TaskListInfo: tli}, nil
+}
+
+// From TaskManager interface
+func (m *sqlTaskPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	tli := request.TaskListInfo
+
+	if tli.Kind == TaskListKindSticky { // sticky task lists are not owned, so they are updated unconditionally
+		result, err := m.db.exec(templateUpdateStickyTaskListSQLQuery, tli.RangeID, tli.AckLevel, tli.Kind,
+			tli.DomainID, tli.Name, tli.TaskType)
+		if err != nil {
+			return nil, convertSQLError("UpdateTaskList", err)
+		}
+		if checkRowsAffected(result) != nil {
+			if _, err := m.db.exec(templateInsertTaskListSQLQuery, tli.DomainID, tli.Name, tli.TaskType,
+				tli.RangeID, tli.AckLevel, tli.Kind); err != nil && !isDuplicateKeyError(err) {
+				return nil, convertSQLError("UpdateTaskList", err)
+			}
+		}
+		return &UpdateTaskListResponse{}, nil
+	}
+
+	result, err := m.db.exec(templateUpdateTaskListSQLQuery, tli.AckLevel, tli.Kind, tli.DomainID, tli.Name,
+		tli.TaskType, tli.RangeID)
+	if err != nil {
+		return nil, convertSQLError("UpdateTaskList", err)
+	}
+	if err := checkRowsAffected(result); err != nil {
+		return nil, &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update task list. name: %v, type: %v, rangeID: %v, error: %v",
+				tli.Name, tli.TaskType, tli.RangeID, err),
+		}
+	}
+	return &UpdateTaskListResponse{}, nil
+}
+
+// From TaskManager interface
+func (m *sqlTaskPersistence) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	tli := request.TaskListInfo
+	err := m.db.txExecute("CreateTasks", func(tx *sqlTx) error {
+		// The task list row is locked to ensure that range_id didn't change
+		var rangeID int64
+		if err := tx.queryRow(templateLockTaskListSQLQuery+m.db.forShare(), tli.DomainID, tli.Name, tli.TaskType).
+			Scan(&rangeID); err != nil {
+			if err == sql.ErrNoRows {
+				return &ConditionFailedError{
+					Msg: fmt.Sprintf("Failed to create task. TaskList: %v, taskListType: %v does not exist",
+						tli.Name, tli.TaskType),
+				}
+			}
+			return err
+		}
+		if rangeID != tli.RangeID {
+			return &ConditionFailedError{
+				Msg: fmt.Sprintf("Failed to create task. TaskList: %v, taskListType: %v, rangeID: %v, db rangeID: %v",
+					tli.Name, tli.TaskType, tli.RangeID, rangeID),
+			}
+		}
+
+		for _, task := range request.Tasks {
+			info := &TaskInfo{
+				DomainID:               tli.DomainID,
+				WorkflowID:             task.Execution.GetWorkflowId(),
+				RunID:                  task.Execution.GetRunId(),
+				ScheduleID:             task.Data.ScheduleID,
+				ScheduleToStartTimeout: task.Data.ScheduleToStartTimeout,
+			}
+			data, err := json.Marshal(info)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.exec(templateCreateTaskSQLQuery, tli.DomainID, tli.Name, tli.TaskType, task.TaskID,
+				data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CreateTasksResponse{}, nil
+}
+
+// From TaskManager interface
+func (m *sqlTaskPersistence) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	if request.ReadLevel > request.MaxReadLevel {
+		return &GetTasksResponse{}, nil
+	}
+
+	rows, err := m.db.query(templateGetTasksSQLQuery, request.DomainID, request.TaskList, request.TaskType,
+		request.ReadLevel, request.MaxReadLevel, request.BatchSize)
+	if err != nil {
+		return nil, convertSQLError("GetTasks", err)
+	}
+	defer rows.Close()
+
+	response := &GetTasksResponse{}
+	for rows.Next() {
+		var taskID int64
+		var data []byte
+		if err := rows.Scan(&taskID, &data); err != nil {
+			return nil, convertSQLError("GetTasks", err)
+		}
+		t := &TaskInfo{}
+		if err := json.Unmarshal(data, t); err != nil {
+			return nil, convertSQLError("GetTasks", err)
+		}
+		t.TaskID = taskID
+		response.Tasks = append(response.Tasks, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError("GetTasks", err)
+	}
+	return response, nil
+}
+
+// From TaskManager interface
+func (m *sqlTaskPersistence) CompleteTask(request *CompleteTaskRequest) error {
+	tli := request.TaskList
+	if _, err := m.db.exec(templateCompleteTaskSQLQuery, tli.DomainID, tli.Name, tli.TaskType,
+		request.TaskID); err != nil {
+		return convertSQLError("CompleteTask", err)
+	}
+	return nil
+}
+
+// checkRowsAffected returns an error unless exactly one row was affected
+func checkRowsAffected(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%v rows affected instead of 1", rowsAffected)
+	}
+	return nil
+}
+
+func isDuplicateKeyError(err error) bool {
+	switch e := err.(type) {
+	case *mysql.MySQLError:
+		return e.Number == mysqlErrDupEntry
+	case *pq.Error:
+		return e.Code == postgresErrUniqueViolation
+	}
+	return false
+}
+
+// convertSQLError passes through errors which are already part of the persistence
+// contract and wraps everything else in an InternalServiceError
+func convertSQLError(operation string, err error) error {
+	switch err.(type) {
+	case *ConditionFailedError, *ShardOwnershipLostError, *ShardAlreadyExistError,
+		*WorkflowExecutionAlreadyStartedError, *TimeoutError,
+		*workflow.InternalServiceError, *workflow.EntityNotExistsError, *workflow.ServiceBusyError,
+		*workflow.DomainAlreadyExistsError, *workflow.BadRequestError:
+		return err
+	}
+	return &workflow.InternalServiceError{
+		Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	sqlPersistenceClientFactory struct {
+		db            *sqlDB
+		metricsClient metrics.Client
+		tracer        opentracing.Tracer
+		logger        logging.Logger
+	}
+)
+
+// NewSQLPersistenceClientFactory is used to create an instance of ExecutionManagerFactory implementation
+func NewSQLPersistenceClientFactory(cfg *config.SQL, numConns int, logger logging.Logger,
+	metricsClient metrics.Client, tracer opentracing.Tracer) (ExecutionManagerFactory, error) {
+	sqlCfg := *cfg
+	if numConns > 0 {
+		sqlCfg.MaxConns = numConns
+	}
+	db, err := newSQLDB(&sqlCfg, sqlCfg.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlPersistenceClientFactory{db: db, logger: logger, metricsClient: metricsClient, tracer: tracer}, nil
+}
+
+// CreateExecutionManager implements ExecutionManagerFactory interface
+func (f *sqlPersistenceClientFactory) CreateExecutionManager(shardID int) (ExecutionManager, error) {
+	mgr, err := NewSQLWorkflowExecutionPersistence(shardID, f.db, f.logger)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if f.metricsClient == nil {
+		return mgr, nil
+	}
+
+	return NewWorkflowExecutionPersistenceClient(mgr, f.metricsClient, f.tracer), nil
+}
+
+// Close releases the underlying resources held by this object
+func (f *sqlPersistenceClientFactory) Close() {
+	f.db.Close()
+}
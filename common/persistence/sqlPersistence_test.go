@@ -0,0 +1,82 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	sqlPersistenceSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestSQLPersistenceSuite(t *testing.T) {
+	suite.Run(t, new(sqlPersistenceSuite))
+}
+
+func (s *sqlPersistenceSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *sqlPersistenceSuite) TestRebind() {
+	query := "UPDATE shards SET range_id = ? WHERE shard_id = ? AND range_id = ?"
+
+	mysqlDB := &sqlDB{driverName: sqlDriverMySQL}
+	s.Equal(query, mysqlDB.rebind(query))
+
+	postgresDB := &sqlDB{driverName: sqlDriverPostgres}
+	s.Equal("UPDATE shards SET range_id = $1 WHERE shard_id = $2 AND range_id = $3", postgresDB.rebind(query))
+}
+
+func (s *sqlPersistenceSuite) TestLockClauses() {
+	mysqlDB := &sqlDB{driverName: sqlDriverMySQL}
+	s.Equal(" FOR UPDATE", mysqlDB.forUpdate())
+	s.Equal(" LOCK IN SHARE MODE", mysqlDB.forShare())
+
+	postgresDB := &sqlDB{driverName: sqlDriverPostgres}
+	s.Equal(" FOR UPDATE", postgresDB.forUpdate())
+	s.Equal(" FOR SHARE", postgresDB.forShare())
+}
+
+func (s *sqlPersistenceSuite) TestIsDuplicateKeyError() {
+	s.True(isDuplicateKeyError(&mysql.MySQLError{Number: mysqlErrDupEntry}))
+	s.True(isDuplicateKeyError(&pq.Error{Code: postgresErrUniqueViolation}))
+	s.False(isDuplicateKeyError(&mysql.MySQLError{Number: 1213}))
+	s.False(isDuplicateKeyError(errors.New("some error")))
+}
+
+func (s *sqlPersistenceSuite) TestConvertSQLError() {
+	conditionErr := &ConditionFailedError{Msg: "condition"}
+	s.Equal(conditionErr, convertSQLError("op", conditionErr))
+
+	err := convertSQLError("op", errors.New("boom"))
+	s.IsType(&workflow.InternalServiceError{}, err)
+}
@@ -0,0 +1,595 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	templateCreateWorkflowExecutionStartedSQLQuery = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, workflow_type_name, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?)`
+
+	templateUpdateSearchAttributesSQLQuery = `UPDATE executions_visibility ` +
+		`SET search_attributes = ? ` +
+		`WHERE domain_id = ? AND run_id = ? AND close_time IS NULL`
+
+	templateDeleteWorkflowExecutionSQLQuery = `DELETE FROM executions_visibility ` +
+		`WHERE domain_id = ? AND run_id = ?`
+
+	templateCreateWorkflowExecutionClosedSQLQuery = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, ` +
+		`search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateGetWorkflowExecutionsSQLQuery = `SELECT workflow_id, run_id, start_time, close_time, ` +
+		`workflow_type_name, status, history_length, search_attributes ` +
+		`FROM executions_visibility ` +
+		`WHERE domain_id = ? `
+
+	templateOpenFilterSQLQuery = `AND close_time IS NULL `
+
+	templateClosedFilterSQLQuery = `AND close_time IS NOT NULL `
+
+	// Executions are listed by descending start time, the run_id breaks ties between
+	// executions started at the same time so that pages are stable
+	templatePageSQLQuery = `AND start_time >= ? ` +
+		`AND (start_time < ? OR (start_time = ? AND run_id > ?)) ` +
+		`ORDER BY start_time DESC, run_id LIMIT ?`
+
+	templateGetClosedWorkflowExecutionSQLQuery = templateGetWorkflowExecutionsSQLQuery +
+		templateClosedFilterSQLQuery +
+		`AND run_id = ?`
+
+	templateDeleteSearchAttributesSQLQuery = `DELETE FROM executions_visibility_search_attributes ` +
+		`WHERE domain_id = ? AND run_id = ?`
+
+	templateCreateSearchAttributeSQLQuery = `INSERT INTO executions_visibility_search_attributes (` +
+		`domain_id, run_id, attr_key, attr_value) ` +
+		`VALUES (?, ?, ?, ?)`
+
+	// Every search attribute of the filter is matched through the executions_visibility_search_attributes
+	// table which keeps one row per attribute of an execution
+	templateSearchAttributeFilterSQLQuery = `AND run_id IN (` +
+		`SELECT run_id FROM executions_visibility_search_attributes ` +
+		`WHERE domain_id = ? AND attr_key = ? AND attr_value = ?) `
+
+	// templateSearchAttributeQuerySQLQuery is formatted with the operator of the predicate
+	templateSearchAttributeQuerySQLQuery = `AND run_id IN (` +
+		`SELECT run_id FROM executions_visibility_search_attributes ` +
+		`WHERE domain_id = ? AND attr_key = ? AND attr_value %v ?) `
+
+	// templateQueryPageSQLQuery is formatted with the column the executions are ordered by, the operator
+	// selecting the executions after the page token and the order
+	templateQueryPageSQLQuery = `AND (%[1]v %[2]v ? OR (%[1]v = ? AND run_id > ?)) `
+
+	templateQueryOrderSQLQuery = `ORDER BY %v %v, run_id LIMIT ?`
+
+	templateCountWorkflowExecutionsSQLQuery = `SELECT COUNT(*) ` +
+		`FROM executions_visibility ` +
+		`WHERE domain_id = ? `
+
+	// templateCountWorkflowExecutionsByGroupSQLQuery is formatted with the column the executions are grouped by
+	// and the filter of the query
+	templateCountWorkflowExecutionsByGroupSQLQuery = `SELECT %[1]v, COUNT(*) ` +
+		`FROM executions_visibility ` +
+		`WHERE domain_id = ? ` +
+		`%[2]v` +
+		`GROUP BY %[1]v`
+)
+
+type (
+	sqlVisibilityPersistence struct {
+		db     *sqlDB
+		logger logging.Logger
+	}
+
+	// sqlVisibilityPageToken is the position of the last execution returned by a page
+	sqlVisibilityPageToken struct {
+		StartTime int64
+		RunID     string
+	}
+)
+
+// NewSQLVisibilityPersistence is used to create an instance of VisibilityManager implementation
+func NewSQLVisibilityPersistence(cfg *config.SQL, logger logging.Logger) (VisibilityManager, error) {
+	db, err := newSQLDB(cfg, cfg.VisibilityDatabaseName)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlVisibilityPersistence{db: db, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (v *sqlVisibilityPersistence) Close() {
+	v.db.Close()
+}
+
+func (v *sqlVisibilityPersistence) RecordWorkflowExecutionStarted(
+	request *RecordWorkflowExecutionStartedRequest) error {
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return convertSQLError("RecordWorkflowExecutionStarted", err)
+	}
+	// the search attributes are written first so a retried task always leaves both in sync
+	if err := v.db.txExecute("RecordWorkflowExecutionStarted", func(tx *sqlTx) error {
+		return replaceSearchAttributes(tx, request.DomainUUID, request.Execution.GetRunId(), request.SearchAttributes)
+	}); err != nil {
+		return err
+	}
+	if _, err := v.db.exec(templateCreateWorkflowExecutionStartedSQLQuery,
+		request.DomainUUID,
+		request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId(),
+		request.StartTimestamp,
+		request.WorkflowTypeName,
+		searchAttributes,
+	); err != nil {
+		if isDuplicateKeyError(err) {
+			// the record is written again when a transfer task is retried
+			return nil
+		}
+		return convertSQLError("RecordWorkflowExecutionStarted", err)
+	}
+	return nil
+}
+
+// UpsertWorkflowExecution updates the search attributes of an open execution.  Nothing is written
+// when the execution has no open record, the started or closed record carries the latest attributes.
+func (v *sqlVisibilityPersistence) UpsertWorkflowExecution(
+	request *UpsertWorkflowExecutionRequest) error {
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return convertSQLError("UpsertWorkflowExecution", err)
+	}
+	return v.db.txExecute("UpsertWorkflowExecution", func(tx *sqlTx) error {
+		result, err := tx.exec(templateUpdateSearchAttributesSQLQuery, searchAttributes, request.DomainUUID,
+			request.Execution.GetRunId())
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return nil
+		}
+		return replaceSearchAttributes(tx, request.DomainUUID, request.Execution.GetRunId(), request.SearchAttributes)
+	})
+}
+
+// RecordWorkflowExecutionClosed replaces the open record of the execution with a closed one.  Unlike
+// the cassandra store the closed records are not expired based on the retention of the domain.
+func (v *sqlVisibilityPersistence) RecordWorkflowExecutionClosed(
+	request *RecordWorkflowExecutionClosedRequest) error {
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return convertSQLError("RecordWorkflowExecutionClosed", err)
+	}
+	return v.db.txExecute("RecordWorkflowExecutionClosed", func(tx *sqlTx) error {
+		if _, err := tx.exec(templateDeleteWorkflowExecutionSQLQuery, request.DomainUUID,
+			request.Execution.GetRunId()); err != nil {
+			return err
+		}
+		_, err := tx.exec(templateCreateWorkflowExecutionClosedSQLQuery,
+			request.DomainUUID,
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+			request.StartTimestamp,
+			request.CloseTimestamp,
+			request.WorkflowTypeName,
+			request.Status,
+			request.HistoryLength,
+			searchAttributes,
+		)
+		if err != nil {
+			return err
+		}
+		return replaceSearchAttributes(tx, request.DomainUUID, request.Execution.GetRunId(), request.SearchAttributes)
+	})
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutions", request, templateOpenFilterSQLQuery)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutions", request, templateClosedFilterSQLQuery)
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest,
+		templateOpenFilterSQLQuery+`AND workflow_type_name = ? `, request.WorkflowTypeName)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest,
+		templateClosedFilterSQLQuery+`AND workflow_type_name = ? `, request.WorkflowTypeName)
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", &request.ListWorkflowExecutionsRequest,
+		templateOpenFilterSQLQuery+`AND workflow_id = ? `, request.WorkflowID)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID", &request.ListWorkflowExecutionsRequest,
+		templateClosedFilterSQLQuery+`AND workflow_id = ? `, request.WorkflowID)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
+	request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus", &request.ListWorkflowExecutionsRequest,
+		templateClosedFilterSQLQuery+`AND status = ? `, request.Status)
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutionsBySearchAttributes(
+	request *ListWorkflowExecutionsBySearchAttributesRequest) (*ListWorkflowExecutionsResponse, error) {
+	filter, filterArgs := getSearchAttributesFilter(request.DomainUUID, request.SearchAttributes)
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsBySearchAttributes", &request.ListWorkflowExecutionsRequest,
+		templateOpenFilterSQLQuery+filter, filterArgs...)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsBySearchAttributes(
+	request *ListWorkflowExecutionsBySearchAttributesRequest) (*ListWorkflowExecutionsResponse, error) {
+	filter, filterArgs := getSearchAttributesFilter(request.DomainUUID, request.SearchAttributes)
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsBySearchAttributes", &request.ListWorkflowExecutionsRequest,
+		templateClosedFilterSQLQuery+filter, filterArgs...)
+}
+
+// ListWorkflowExecutions lists both open and closed executions matching the query
+func (v *sqlVisibilityPersistence) ListWorkflowExecutions(
+	request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	query := request.Query
+	token, err := deserializeVisibilityQueryPageToken("ListWorkflowExecutions", request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	orderBy := "start_time"
+	if query.OrderBy == VisibilityQueryFieldCloseTime {
+		orderBy = "close_time"
+	}
+	filter, filterArgs := getQueryFilter(request.DomainUUID, query)
+	args := append([]interface{}{request.DomainUUID}, filterArgs...)
+
+	order := "DESC"
+	pageOperator := "<"
+	if query.Ascending {
+		order = "ASC"
+		pageOperator = ">"
+	}
+	if token != nil {
+		filter += fmt.Sprintf(templateQueryPageSQLQuery, orderBy, pageOperator)
+		args = append(args, token.SortTime, token.SortTime, token.RunID)
+	}
+	filter += fmt.Sprintf(templateQueryOrderSQLQuery, orderBy, order)
+	args = append(args, request.PageSize)
+
+	rows, err := v.db.query(templateGetWorkflowExecutionsSQLQuery+filter, args...)
+	if err != nil {
+		return nil, convertSQLError("ListWorkflowExecutions", err)
+	}
+	defer rows.Close()
+
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0)
+	for rows.Next() {
+		wfexecution, err := readWorkflowExecutionRecord(rows)
+		if err != nil {
+			return nil, convertSQLError("ListWorkflowExecutions", err)
+		}
+		response.Executions = append(response.Executions, wfexecution)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError("ListWorkflowExecutions", err)
+	}
+
+	if request.PageSize > 0 && len(response.Executions) == request.PageSize {
+		last := response.Executions[len(response.Executions)-1]
+		if response.NextPageToken, err = serializeVisibilityQueryPageToken("ListWorkflowExecutions", last,
+			query); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (v *sqlVisibilityPersistence) CountWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	filter, filterArgs := getQueryFilter(request.DomainUUID, request.Query)
+	args := append([]interface{}{request.DomainUUID}, filterArgs...)
+
+	response := &CountWorkflowExecutionsResponse{}
+	if request.GroupBy == "" {
+		if err := v.db.queryRow(templateCountWorkflowExecutionsSQLQuery+filter, args...).
+			Scan(&response.Count); err != nil {
+			return nil, convertSQLError("CountWorkflowExecutions", err)
+		}
+		return response, nil
+	}
+
+	groupBy := "workflow_type_name"
+	if request.GroupBy == VisibilityQueryFieldCloseStatus {
+		groupBy = "status"
+	}
+	rows, err := v.db.query(fmt.Sprintf(templateCountWorkflowExecutionsByGroupSQLQuery, groupBy, filter), args...)
+	if err != nil {
+		return nil, convertSQLError("CountWorkflowExecutions", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var typeName sql.NullString
+		var status sql.NullInt64
+		var count int64
+		var groupValue interface{} = &typeName
+		if request.GroupBy == VisibilityQueryFieldCloseStatus {
+			groupValue = &status
+		}
+		if err := rows.Scan(groupValue, &count); err != nil {
+			return nil, convertSQLError("CountWorkflowExecutions", err)
+		}
+		var closeStatus *workflow.WorkflowExecutionCloseStatus
+		if status.Valid {
+			closeStatus = workflow.WorkflowExecutionCloseStatus(status.Int64).Ptr()
+		}
+		response.Count += count
+		response.Groups = addToCountWorkflowExecutionsGroups(response.Groups, request.GroupBy, typeName.String,
+			closeStatus, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError("CountWorkflowExecutions", err)
+	}
+	sortCountWorkflowExecutionsGroups(response.Groups)
+	return response, nil
+}
+
+func (v *sqlVisibilityPersistence) GetClosedWorkflowExecution(
+	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	execution := request.Execution
+	rows, err := v.db.query(templateGetClosedWorkflowExecutionSQLQuery, request.DomainUUID, execution.GetRunId())
+	if err != nil {
+		return nil, convertSQLError("GetClosedWorkflowExecution", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, convertSQLError("GetClosedWorkflowExecution", err)
+		}
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				execution.GetWorkflowId(), execution.GetRunId()),
+		}
+	}
+
+	wfexecution, err := readWorkflowExecutionRecord(rows)
+	if err != nil {
+		return nil, convertSQLError("GetClosedWorkflowExecution", err)
+	}
+	return &GetClosedWorkflowExecutionResponse{
+		Execution: wfexecution,
+	}, nil
+}
+
+func (v *sqlVisibilityPersistence) listWorkflowExecutions(operation string, request *ListWorkflowExecutionsRequest,
+	filter string, filterArgs ...interface{}) (*ListWorkflowExecutionsResponse, error) {
+	token := &sqlVisibilityPageToken{StartTime: request.LatestStartTime}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &workflow.BadRequestError{
+				Message: fmt.Sprintf("%v operation failed. Invalid next page token. Error: %v", operation, err),
+			}
+		}
+	}
+
+	args := []interface{}{request.DomainUUID}
+	args = append(args, filterArgs...)
+	args = append(args, request.EarliestStartTime, token.StartTime, token.StartTime, token.RunID, request.PageSize)
+	rows, err := v.db.query(templateGetWorkflowExecutionsSQLQuery+filter+templatePageSQLQuery, args...)
+	if err != nil {
+		return nil, convertSQLError(operation, err)
+	}
+	defer rows.Close()
+
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0)
+	for rows.Next() {
+		wfexecution, err := readWorkflowExecutionRecord(rows)
+		if err != nil {
+			return nil, convertSQLError(operation, err)
+		}
+		response.Executions = append(response.Executions, wfexecution)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, convertSQLError(operation, err)
+	}
+
+	if request.PageSize > 0 && len(response.Executions) == request.PageSize {
+		last := response.Executions[len(response.Executions)-1]
+		nextPageToken, err := json.Marshal(&sqlVisibilityPageToken{
+			StartTime: last.GetStartTime(),
+			RunID:     last.Execution.GetRunId(),
+		})
+		if err != nil {
+			return nil, convertSQLError(operation, err)
+		}
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
+// readWorkflowExecutionRecord converts the current row into an execution record, the close
+// attributes are only set for closed executions
+func readWorkflowExecutionRecord(rows *sql.Rows) (*workflow.WorkflowExecutionInfo, error) {
+	var workflowID string
+	var runID string
+	var typeName string
+	var startTime int64
+	var closeTime sql.NullInt64
+	var status sql.NullInt64
+	var historyLength sql.NullInt64
+	var searchAttributes []byte
+	if err := rows.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status,
+		&historyLength, &searchAttributes); err != nil {
+		return nil, err
+	}
+	indexedFields, err := deserializeSearchAttributes(searchAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := &workflow.WorkflowExecution{}
+	execution.WorkflowId = common.StringPtr(workflowID)
+	execution.RunId = common.StringPtr(runID)
+
+	wfType := &workflow.WorkflowType{}
+	wfType.Name = common.StringPtr(typeName)
+
+	record := &workflow.WorkflowExecutionInfo{}
+	record.Execution = execution
+	record.StartTime = common.Int64Ptr(startTime)
+	record.Type = wfType
+	record.SearchAttributes = NewSearchAttributes(indexedFields)
+	if closeTime.Valid {
+		closeStatus := workflow.WorkflowExecutionCloseStatus(status.Int64)
+		record.CloseTime = common.Int64Ptr(closeTime.Int64)
+		record.CloseStatus = &closeStatus
+		record.HistoryLength = common.Int64Ptr(historyLength.Int64)
+	}
+	return record, nil
+}
+
+// replaceSearchAttributes rewrites the rows used to filter the execution by its search attributes
+func replaceSearchAttributes(tx *sqlTx, domainID, runID string, searchAttributes map[string][]byte) error {
+	if _, err := tx.exec(templateDeleteSearchAttributesSQLQuery, domainID, runID); err != nil {
+		return err
+	}
+	for key, value := range searchAttributes {
+		if _, err := tx.exec(templateCreateSearchAttributeSQLQuery, domainID, runID, key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getSearchAttributesFilter returns the filter matching all of the given search attributes, keys are
+// sorted so the same filter always results in the same statement
+func getSearchAttributesFilter(domainID string, searchAttributes map[string][]byte) (string, []interface{}) {
+	keys := make([]string, 0, len(searchAttributes))
+	for key := range searchAttributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	filter := ""
+	var args []interface{}
+	for _, key := range keys {
+		filter += templateSearchAttributeFilterSQLQuery
+		args = append(args, domainID, key, searchAttributes[key])
+	}
+	return filter, args
+}
+
+// getQueryFilter returns the filter matching all of the predicates of the query, only closed executions
+// are matched when they are ordered by close time
+func getQueryFilter(domainID string, query *VisibilityQuery) (string, []interface{}) {
+	filter := ""
+	if query.OrderBy == VisibilityQueryFieldCloseTime {
+		filter = templateClosedFilterSQLQuery
+	}
+	var args []interface{}
+	for _, predicate := range query.Predicates {
+		predicateFilter, predicateArgs := getQueryPredicateFilter(domainID, predicate)
+		filter += predicateFilter
+		args = append(args, predicateArgs...)
+	}
+	return filter, args
+}
+
+// getQueryPredicateFilter returns the filter of a predicate of a visibility query, comparisons with the
+// NULL close attributes of open executions are never true so those only match closed executions
+func getQueryPredicateFilter(domainID string, predicate *VisibilityQueryPredicate) (string, []interface{}) {
+	if predicate.SearchAttribute {
+		return fmt.Sprintf(templateSearchAttributeQuerySQLQuery, predicate.Operator),
+			[]interface{}{domainID, predicate.Field, predicate.Value}
+	}
+
+	var column string
+	value := predicate.Value
+	switch predicate.Field {
+	case VisibilityQueryFieldWorkflowID:
+		column = "workflow_id"
+	case VisibilityQueryFieldRunID:
+		column = "run_id"
+	case VisibilityQueryFieldWorkflowType:
+		column = "workflow_type_name"
+	case VisibilityQueryFieldStartTime:
+		column = "start_time"
+	case VisibilityQueryFieldCloseTime:
+		column = "close_time"
+	case VisibilityQueryFieldCloseStatus:
+		column = "status"
+		value = int32(predicate.Value.(workflow.WorkflowExecutionCloseStatus))
+	case VisibilityQueryFieldExecutionStatus:
+		isOpen := predicate.Value == VisibilityExecutionStatusOpen
+		if isOpen == (predicate.Operator == VisibilityQueryOperatorEqual) {
+			return templateOpenFilterSQLQuery, nil
+		}
+		return templateClosedFilterSQLQuery, nil
+	}
+	return fmt.Sprintf("AND %v %v ? ", column, predicate.Operator), []interface{}{value}
+}
+
+func serializeSearchAttributes(searchAttributes map[string][]byte) ([]byte, error) {
+	if len(searchAttributes) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(searchAttributes)
+}
+
+func deserializeSearchAttributes(data []byte) (map[string][]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	searchAttributes := make(map[string][]byte)
+	if err := json.Unmarshal(data, &searchAttributes); err != nil {
+		return nil, err
+	}
+	return searchAttributes, nil
+}
@@ -20,7 +20,11 @@
 
 package persistence
 
-import s "github.com/uber/cadence/.gen/go/shared"
+import (
+	"bytes"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+)
 
 // Interfaces for the Visibility Store.
 // This is a secondary store that is eventually consistent with the main
@@ -37,6 +41,18 @@ type (
 		WorkflowTypeName string
 		StartTimestamp   int64
 		WorkflowTimeout  int64
+		SearchAttributes map[string][]byte
+	}
+
+	// UpsertWorkflowExecutionRequest is used to update the record of an open
+	// execution, e.g. when its search attributes have changed
+	UpsertWorkflowExecutionRequest struct {
+		DomainUUID       string
+		Execution        s.WorkflowExecution
+		WorkflowTypeName string
+		StartTimestamp   int64
+		WorkflowTimeout  int64
+		SearchAttributes map[string][]byte
 	}
 
 	// RecordWorkflowExecutionClosedRequest is used to add a record of a newly
@@ -50,6 +66,7 @@ type (
 		Status           s.WorkflowExecutionCloseStatus
 		HistoryLength    int64
 		RetentionSeconds int64
+		SearchAttributes map[string][]byte
 	}
 
 	// ListWorkflowExecutionsRequest is used to list executions in a domain
@@ -93,6 +110,13 @@ type (
 		Status s.WorkflowExecutionCloseStatus
 	}
 
+	// ListWorkflowExecutionsBySearchAttributesRequest is used to list executions
+	// whose search attributes contain all of the given key/values
+	ListWorkflowExecutionsBySearchAttributesRequest struct {
+		ListWorkflowExecutionsRequest
+		SearchAttributes map[string][]byte
+	}
+
 	// GetClosedWorkflowExecutionRequest is used retrieve the record for a specific execution
 	GetClosedWorkflowExecutionRequest struct {
 		DomainUUID string
@@ -109,6 +133,7 @@ type (
 		Closeable
 		RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error
 		RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error
+		UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error
 		ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 		ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error)
@@ -116,6 +141,38 @@ type (
 		ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error)
+		ListOpenWorkflowExecutionsBySearchAttributes(request *ListWorkflowExecutionsBySearchAttributesRequest) (*ListWorkflowExecutionsResponse, error)
+		ListClosedWorkflowExecutionsBySearchAttributes(request *ListWorkflowExecutionsBySearchAttributesRequest) (*ListWorkflowExecutionsResponse, error)
+		ListWorkflowExecutions(request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error)
+		CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
 		GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error)
 	}
 )
+
+// NewSearchAttributes converts the search attributes of a visibility record into their thrift representation
+func NewSearchAttributes(indexedFields map[string][]byte) *s.SearchAttributes {
+	if len(indexedFields) == 0 {
+		return nil
+	}
+	return &s.SearchAttributes{IndexedFields: indexedFields}
+}
+
+// GetSearchAttributesFields returns the indexed fields of the thrift search attributes, nil is returned
+// when no search attributes are set
+func GetSearchAttributesFields(searchAttributes *s.SearchAttributes) map[string][]byte {
+	if searchAttributes == nil {
+		return nil
+	}
+	return searchAttributes.IndexedFields
+}
+
+// MatchSearchAttributes returns true when the search attributes contain all of the key/values of the filter
+func MatchSearchAttributes(searchAttributes map[string][]byte, filter map[string][]byte) bool {
+	for key, value := range filter {
+		actual, ok := searchAttributes[key]
+		if !ok || !bytes.Equal(actual, value) {
+			return false
+		}
+	}
+	return true
+}
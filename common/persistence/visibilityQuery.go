@@ -0,0 +1,299 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+)
+
+// Fields of the execution records which can be used by a visibility query, any other field of
+// a query refers to a search attribute
+const (
+	VisibilityQueryFieldWorkflowID      = "WorkflowID"
+	VisibilityQueryFieldRunID           = "RunID"
+	VisibilityQueryFieldWorkflowType    = "WorkflowType"
+	VisibilityQueryFieldStartTime       = "StartTime"
+	VisibilityQueryFieldCloseTime       = "CloseTime"
+	VisibilityQueryFieldCloseStatus     = "CloseStatus"
+	VisibilityQueryFieldExecutionStatus = "ExecutionStatus"
+)
+
+// Values of the ExecutionStatus field
+const (
+	VisibilityExecutionStatusOpen   = "Open"
+	VisibilityExecutionStatusClosed = "Closed"
+)
+
+// Operators of the predicates of a visibility query
+const (
+	VisibilityQueryOperatorEqual VisibilityQueryOperator = iota
+	VisibilityQueryOperatorNotEqual
+	VisibilityQueryOperatorLessThan
+	VisibilityQueryOperatorLessThanOrEqual
+	VisibilityQueryOperatorGreaterThan
+	VisibilityQueryOperatorGreaterThanOrEqual
+)
+
+type (
+	// VisibilityQueryOperator is the comparison done by a predicate of a visibility query
+	VisibilityQueryOperator int
+
+	// VisibilityQueryPredicate compares a field of the execution records, or one of their search attributes,
+	// with a value.  The value is a string for WorkflowID, RunID, WorkflowType and ExecutionStatus, the time in
+	// nanoseconds for StartTime and CloseTime, a WorkflowExecutionCloseStatus for CloseStatus and the raw bytes
+	// for search attributes.
+	VisibilityQueryPredicate struct {
+		Field string
+		// SearchAttribute is set when Field is the key of a search attribute
+		SearchAttribute bool
+		Operator        VisibilityQueryOperator
+		Value           interface{}
+	}
+
+	// VisibilityQuery is the validated form of a list query, an execution is matched by the query
+	// when it is matched by all of its predicates
+	VisibilityQuery struct {
+		Predicates []*VisibilityQueryPredicate
+		// OrderBy is either StartTime or CloseTime, only closed executions are listed when ordered by CloseTime
+		OrderBy   string
+		Ascending bool
+	}
+
+	// ListWorkflowExecutionsByQueryRequest is used to list the executions of a domain matching a query
+	ListWorkflowExecutionsByQueryRequest struct {
+		DomainUUID string
+		Query      *VisibilityQuery
+		// Maximum number of workflow executions per page
+		PageSize int
+		// Token to continue reading next page of workflow executions.
+		// Pass in empty slice for first page.
+		NextPageToken []byte
+	}
+
+	// CountWorkflowExecutionsRequest is used to count the executions of a domain matching a query, the
+	// executions are grouped by the GroupBy field when it is set to either WorkflowType or CloseStatus
+	CountWorkflowExecutionsRequest struct {
+		DomainUUID string
+		Query      *VisibilityQuery
+		GroupBy    string
+	}
+
+	// CountWorkflowExecutionsResponse is the response to CountWorkflowExecutionsRequest, the groups are
+	// only set when the executions are grouped
+	CountWorkflowExecutionsResponse struct {
+		Count  int64
+		Groups []*s.CountWorkflowExecutionsGroup
+	}
+
+	// visibilityQueryPageToken is the position of the last execution returned by a page of a query,
+	// SortTime is the value of the OrderBy field of the execution
+	visibilityQueryPageToken struct {
+		SortTime int64
+		RunID    string
+	}
+)
+
+// String returns the operator as it is written in a query
+func (o VisibilityQueryOperator) String() string {
+	switch o {
+	case VisibilityQueryOperatorEqual:
+		return "="
+	case VisibilityQueryOperatorNotEqual:
+		return "!="
+	case VisibilityQueryOperatorLessThan:
+		return "<"
+	case VisibilityQueryOperatorLessThanOrEqual:
+		return "<="
+	case VisibilityQueryOperatorGreaterThan:
+		return ">"
+	case VisibilityQueryOperatorGreaterThanOrEqual:
+		return ">="
+	}
+	return fmt.Sprintf("VisibilityQueryOperator(%d)", int(o))
+}
+
+// MatchVisibilityQuery returns true when the execution record is matched by all of the predicates of the query
+func MatchVisibilityQuery(record *s.WorkflowExecutionInfo, query *VisibilityQuery) bool {
+	if query.OrderBy == VisibilityQueryFieldCloseTime && record.CloseTime == nil {
+		return false
+	}
+	for _, predicate := range query.Predicates {
+		if !matchVisibilityQueryPredicate(record, predicate) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchVisibilityQueryPredicate(record *s.WorkflowExecutionInfo, predicate *VisibilityQueryPredicate) bool {
+	if predicate.SearchAttribute {
+		actual, ok := GetSearchAttributesFields(record.SearchAttributes)[predicate.Field]
+		if !ok {
+			return false
+		}
+		return compareVisibilityQueryValue(predicate.Operator, bytes.Compare(actual, predicate.Value.([]byte)))
+	}
+
+	switch predicate.Field {
+	case VisibilityQueryFieldWorkflowID:
+		return compareVisibilityQueryString(predicate.Operator, record.Execution.GetWorkflowId(), predicate.Value.(string))
+	case VisibilityQueryFieldRunID:
+		return compareVisibilityQueryString(predicate.Operator, record.Execution.GetRunId(), predicate.Value.(string))
+	case VisibilityQueryFieldWorkflowType:
+		return compareVisibilityQueryString(predicate.Operator, record.Type.GetName(), predicate.Value.(string))
+	case VisibilityQueryFieldStartTime:
+		return compareVisibilityQueryInt(predicate.Operator, record.GetStartTime(), predicate.Value.(int64))
+	case VisibilityQueryFieldCloseTime:
+		// open executions have no close time, they never match a predicate on it
+		return record.CloseTime != nil &&
+			compareVisibilityQueryInt(predicate.Operator, record.GetCloseTime(), predicate.Value.(int64))
+	case VisibilityQueryFieldCloseStatus:
+		return record.CloseStatus != nil && compareVisibilityQueryInt(predicate.Operator,
+			int64(record.GetCloseStatus()), int64(predicate.Value.(s.WorkflowExecutionCloseStatus)))
+	case VisibilityQueryFieldExecutionStatus:
+		status := VisibilityExecutionStatusOpen
+		if record.CloseTime != nil {
+			status = VisibilityExecutionStatusClosed
+		}
+		return compareVisibilityQueryString(predicate.Operator, status, predicate.Value.(string))
+	}
+	return false
+}
+
+func compareVisibilityQueryString(operator VisibilityQueryOperator, actual, expected string) bool {
+	switch {
+	case actual < expected:
+		return compareVisibilityQueryValue(operator, -1)
+	case actual > expected:
+		return compareVisibilityQueryValue(operator, 1)
+	}
+	return compareVisibilityQueryValue(operator, 0)
+}
+
+func compareVisibilityQueryInt(operator VisibilityQueryOperator, actual, expected int64) bool {
+	switch {
+	case actual < expected:
+		return compareVisibilityQueryValue(operator, -1)
+	case actual > expected:
+		return compareVisibilityQueryValue(operator, 1)
+	}
+	return compareVisibilityQueryValue(operator, 0)
+}
+
+// compareVisibilityQueryValue applies the operator to the result of comparing the actual value with the
+// expected one, which is negative, zero or positive when the actual value is respectively lower, equal or greater
+func compareVisibilityQueryValue(operator VisibilityQueryOperator, result int) bool {
+	switch operator {
+	case VisibilityQueryOperatorEqual:
+		return result == 0
+	case VisibilityQueryOperatorNotEqual:
+		return result != 0
+	case VisibilityQueryOperatorLessThan:
+		return result < 0
+	case VisibilityQueryOperatorLessThanOrEqual:
+		return result <= 0
+	case VisibilityQueryOperatorGreaterThan:
+		return result > 0
+	case VisibilityQueryOperatorGreaterThanOrEqual:
+		return result >= 0
+	}
+	return false
+}
+
+// getVisibilityQuerySortTime returns the value of the field the executions listed by the query are ordered by
+func getVisibilityQuerySortTime(record *s.WorkflowExecutionInfo, query *VisibilityQuery) int64 {
+	if query.OrderBy == VisibilityQueryFieldCloseTime {
+		return record.GetCloseTime()
+	}
+	return record.GetStartTime()
+}
+
+// addToCountWorkflowExecutionsGroups adds the count of executions of the given type and close status to
+// their group, the close status is nil for open executions
+func addToCountWorkflowExecutionsGroups(groups []*s.CountWorkflowExecutionsGroup, groupBy string,
+	workflowTypeName string, closeStatus *s.WorkflowExecutionCloseStatus, count int64) []*s.CountWorkflowExecutionsGroup {
+	group := &s.CountWorkflowExecutionsGroup{}
+	switch groupBy {
+	case VisibilityQueryFieldWorkflowType:
+		group.WorkflowType = &workflowTypeName
+	case VisibilityQueryFieldCloseStatus:
+		group.CloseStatus = closeStatus
+	default:
+		return groups
+	}
+
+	for _, existing := range groups {
+		if existing.GetWorkflowType() == group.GetWorkflowType() &&
+			(existing.CloseStatus == nil) == (group.CloseStatus == nil) &&
+			existing.GetCloseStatus() == group.GetCloseStatus() {
+			*existing.Count += count
+			return groups
+		}
+	}
+	group.Count = &count
+	return append(groups, group)
+}
+
+// sortCountWorkflowExecutionsGroups orders the groups by workflow type or close status, the group of open
+// executions comes first
+func sortCountWorkflowExecutionsGroups(groups []*s.CountWorkflowExecutionsGroup) {
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].GetWorkflowType() != groups[j].GetWorkflowType() {
+			return groups[i].GetWorkflowType() < groups[j].GetWorkflowType()
+		}
+		if groups[i].CloseStatus == nil || groups[j].CloseStatus == nil {
+			return groups[i].CloseStatus == nil && groups[j].CloseStatus != nil
+		}
+		return groups[i].GetCloseStatus() < groups[j].GetCloseStatus()
+	})
+}
+
+func deserializeVisibilityQueryPageToken(operation string, data []byte) (*visibilityQueryPageToken, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	token := &visibilityQueryPageToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, &s.BadRequestError{
+			Message: fmt.Sprintf("%v operation failed. Invalid next page token. Error: %v", operation, err),
+		}
+	}
+	return token, nil
+}
+
+func serializeVisibilityQueryPageToken(operation string, last *s.WorkflowExecutionInfo,
+	query *VisibilityQuery) ([]byte, error) {
+	data, err := json.Marshal(&visibilityQueryPageToken{
+		SortTime: getVisibilityQuerySortTime(last, query),
+		RunID:    last.Execution.GetRunId(),
+	})
+	if err != nil {
+		return nil, &s.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,3754 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package protomapper converts the thrift types of the WorkflowService to their protobuf mirror
+// defined in idl/proto/uber/cadence and back
+package protomapper
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/proto/cadencepb"
+)
+
+func toProtoWorkflowIdReusePolicy(in *shared.WorkflowIdReusePolicy) *cadencepb.WorkflowIdReusePolicy {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.WorkflowIdReusePolicy(*in)
+	return &out
+}
+
+func toThriftWorkflowIdReusePolicy(in *cadencepb.WorkflowIdReusePolicy) *shared.WorkflowIdReusePolicy {
+	if in == nil {
+		return nil
+	}
+	out := shared.WorkflowIdReusePolicy(*in)
+	return &out
+}
+
+func toProtoDomainStatus(in *shared.DomainStatus) *cadencepb.DomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.DomainStatus(*in)
+	return &out
+}
+
+func toThriftDomainStatus(in *cadencepb.DomainStatus) *shared.DomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := shared.DomainStatus(*in)
+	return &out
+}
+
+func toProtoArchivalStatus(in *shared.ArchivalStatus) *cadencepb.ArchivalStatus {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.ArchivalStatus(*in)
+	return &out
+}
+
+func toThriftArchivalStatus(in *cadencepb.ArchivalStatus) *shared.ArchivalStatus {
+	if in == nil {
+		return nil
+	}
+	out := shared.ArchivalStatus(*in)
+	return &out
+}
+
+func toProtoTimeoutType(in *shared.TimeoutType) *cadencepb.TimeoutType {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.TimeoutType(*in)
+	return &out
+}
+
+func toThriftTimeoutType(in *cadencepb.TimeoutType) *shared.TimeoutType {
+	if in == nil {
+		return nil
+	}
+	out := shared.TimeoutType(*in)
+	return &out
+}
+
+func toProtoDecisionType(in *shared.DecisionType) *cadencepb.DecisionType {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.DecisionType(*in)
+	return &out
+}
+
+func toThriftDecisionType(in *cadencepb.DecisionType) *shared.DecisionType {
+	if in == nil {
+		return nil
+	}
+	out := shared.DecisionType(*in)
+	return &out
+}
+
+func toProtoEventType(in *shared.EventType) *cadencepb.EventType {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.EventType(*in)
+	return &out
+}
+
+func toThriftEventType(in *cadencepb.EventType) *shared.EventType {
+	if in == nil {
+		return nil
+	}
+	out := shared.EventType(*in)
+	return &out
+}
+
+func toProtoDecisionTaskFailedCause(in *shared.DecisionTaskFailedCause) *cadencepb.DecisionTaskFailedCause {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.DecisionTaskFailedCause(*in)
+	return &out
+}
+
+func toThriftDecisionTaskFailedCause(in *cadencepb.DecisionTaskFailedCause) *shared.DecisionTaskFailedCause {
+	if in == nil {
+		return nil
+	}
+	out := shared.DecisionTaskFailedCause(*in)
+	return &out
+}
+
+func toProtoCancelExternalWorkflowExecutionFailedCause(in *shared.CancelExternalWorkflowExecutionFailedCause) *cadencepb.CancelExternalWorkflowExecutionFailedCause {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.CancelExternalWorkflowExecutionFailedCause(*in)
+	return &out
+}
+
+func toThriftCancelExternalWorkflowExecutionFailedCause(in *cadencepb.CancelExternalWorkflowExecutionFailedCause) *shared.CancelExternalWorkflowExecutionFailedCause {
+	if in == nil {
+		return nil
+	}
+	out := shared.CancelExternalWorkflowExecutionFailedCause(*in)
+	return &out
+}
+
+func toProtoSignalExternalWorkflowExecutionFailedCause(in *shared.SignalExternalWorkflowExecutionFailedCause) *cadencepb.SignalExternalWorkflowExecutionFailedCause {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.SignalExternalWorkflowExecutionFailedCause(*in)
+	return &out
+}
+
+func toThriftSignalExternalWorkflowExecutionFailedCause(in *cadencepb.SignalExternalWorkflowExecutionFailedCause) *shared.SignalExternalWorkflowExecutionFailedCause {
+	if in == nil {
+		return nil
+	}
+	out := shared.SignalExternalWorkflowExecutionFailedCause(*in)
+	return &out
+}
+
+func toProtoChildWorkflowExecutionFailedCause(in *shared.ChildWorkflowExecutionFailedCause) *cadencepb.ChildWorkflowExecutionFailedCause {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.ChildWorkflowExecutionFailedCause(*in)
+	return &out
+}
+
+func toThriftChildWorkflowExecutionFailedCause(in *cadencepb.ChildWorkflowExecutionFailedCause) *shared.ChildWorkflowExecutionFailedCause {
+	if in == nil {
+		return nil
+	}
+	out := shared.ChildWorkflowExecutionFailedCause(*in)
+	return &out
+}
+
+func toProtoWorkflowExecutionCloseStatus(in *shared.WorkflowExecutionCloseStatus) *cadencepb.WorkflowExecutionCloseStatus {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.WorkflowExecutionCloseStatus(*in)
+	return &out
+}
+
+func toThriftWorkflowExecutionCloseStatus(in *cadencepb.WorkflowExecutionCloseStatus) *shared.WorkflowExecutionCloseStatus {
+	if in == nil {
+		return nil
+	}
+	out := shared.WorkflowExecutionCloseStatus(*in)
+	return &out
+}
+
+func toProtoChildPolicy(in *shared.ChildPolicy) *cadencepb.ChildPolicy {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.ChildPolicy(*in)
+	return &out
+}
+
+func toThriftChildPolicy(in *cadencepb.ChildPolicy) *shared.ChildPolicy {
+	if in == nil {
+		return nil
+	}
+	out := shared.ChildPolicy(*in)
+	return &out
+}
+
+func toProtoQueryTaskCompletedType(in *shared.QueryTaskCompletedType) *cadencepb.QueryTaskCompletedType {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.QueryTaskCompletedType(*in)
+	return &out
+}
+
+func toThriftQueryTaskCompletedType(in *cadencepb.QueryTaskCompletedType) *shared.QueryTaskCompletedType {
+	if in == nil {
+		return nil
+	}
+	out := shared.QueryTaskCompletedType(*in)
+	return &out
+}
+
+func toProtoPendingActivityState(in *shared.PendingActivityState) *cadencepb.PendingActivityState {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.PendingActivityState(*in)
+	return &out
+}
+
+func toThriftPendingActivityState(in *cadencepb.PendingActivityState) *shared.PendingActivityState {
+	if in == nil {
+		return nil
+	}
+	out := shared.PendingActivityState(*in)
+	return &out
+}
+
+func toProtoHistoryEventFilterType(in *shared.HistoryEventFilterType) *cadencepb.HistoryEventFilterType {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.HistoryEventFilterType(*in)
+	return &out
+}
+
+func toThriftHistoryEventFilterType(in *cadencepb.HistoryEventFilterType) *shared.HistoryEventFilterType {
+	if in == nil {
+		return nil
+	}
+	out := shared.HistoryEventFilterType(*in)
+	return &out
+}
+
+func toProtoTaskListKind(in *shared.TaskListKind) *cadencepb.TaskListKind {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.TaskListKind(*in)
+	return &out
+}
+
+func toThriftTaskListKind(in *cadencepb.TaskListKind) *shared.TaskListKind {
+	if in == nil {
+		return nil
+	}
+	out := shared.TaskListKind(*in)
+	return &out
+}
+
+func toProtoContinueAsNewInitiator(in *shared.ContinueAsNewInitiator) *cadencepb.ContinueAsNewInitiator {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.ContinueAsNewInitiator(*in)
+	return &out
+}
+
+func toThriftContinueAsNewInitiator(in *cadencepb.ContinueAsNewInitiator) *shared.ContinueAsNewInitiator {
+	if in == nil {
+		return nil
+	}
+	out := shared.ContinueAsNewInitiator(*in)
+	return &out
+}
+
+func toProtoCountWorkflowExecutionsGroupBy(in *shared.CountWorkflowExecutionsGroupBy) *cadencepb.CountWorkflowExecutionsGroupBy {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.CountWorkflowExecutionsGroupBy(*in)
+	return &out
+}
+
+func toThriftCountWorkflowExecutionsGroupBy(in *cadencepb.CountWorkflowExecutionsGroupBy) *shared.CountWorkflowExecutionsGroupBy {
+	if in == nil {
+		return nil
+	}
+	out := shared.CountWorkflowExecutionsGroupBy(*in)
+	return &out
+}
+
+func toProtoTaskListType(in *shared.TaskListType) *cadencepb.TaskListType {
+	if in == nil {
+		return nil
+	}
+	out := cadencepb.TaskListType(*in)
+	return &out
+}
+
+func toThriftTaskListType(in *cadencepb.TaskListType) *shared.TaskListType {
+	if in == nil {
+		return nil
+	}
+	out := shared.TaskListType(*in)
+	return &out
+}
+
+func toProtoWorkflowType(in *shared.WorkflowType) *cadencepb.WorkflowType {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowType{
+		Name: in.Name,
+	}
+}
+
+func toThriftWorkflowType(in *cadencepb.WorkflowType) *shared.WorkflowType {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowType{
+		Name: in.Name,
+	}
+}
+
+func toProtoActivityType(in *shared.ActivityType) *cadencepb.ActivityType {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ActivityType{
+		Name: in.Name,
+	}
+}
+
+func toThriftActivityType(in *cadencepb.ActivityType) *shared.ActivityType {
+	if in == nil {
+		return nil
+	}
+	return &shared.ActivityType{
+		Name: in.Name,
+	}
+}
+
+func toProtoTaskList(in *shared.TaskList) *cadencepb.TaskList {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.TaskList{
+		Name: in.Name,
+		Kind: toProtoTaskListKind(in.Kind),
+	}
+}
+
+func toThriftTaskList(in *cadencepb.TaskList) *shared.TaskList {
+	if in == nil {
+		return nil
+	}
+	return &shared.TaskList{
+		Name: in.Name,
+		Kind: toThriftTaskListKind(in.Kind),
+	}
+}
+
+func toProtoTaskListMetadata(in *shared.TaskListMetadata) *cadencepb.TaskListMetadata {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.TaskListMetadata{
+		MaxTasksPerSecond: in.MaxTasksPerSecond,
+	}
+}
+
+func toThriftTaskListMetadata(in *cadencepb.TaskListMetadata) *shared.TaskListMetadata {
+	if in == nil {
+		return nil
+	}
+	return &shared.TaskListMetadata{
+		MaxTasksPerSecond: in.MaxTasksPerSecond,
+	}
+}
+
+func toProtoWorkflowExecution(in *shared.WorkflowExecution) *cadencepb.WorkflowExecution {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecution{
+		WorkflowId: in.WorkflowId,
+		RunId:      in.RunId,
+	}
+}
+
+func toThriftWorkflowExecution(in *cadencepb.WorkflowExecution) *shared.WorkflowExecution {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecution{
+		WorkflowId: in.WorkflowId,
+		RunId:      in.RunId,
+	}
+}
+
+func toProtoSearchAttributes(in *shared.SearchAttributes) *cadencepb.SearchAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.SearchAttributes{
+		IndexedFields: in.IndexedFields,
+	}
+}
+
+func toThriftSearchAttributes(in *cadencepb.SearchAttributes) *shared.SearchAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.SearchAttributes{
+		IndexedFields: in.IndexedFields,
+	}
+}
+
+func toProtoWorkflowExecutionInfo(in *shared.WorkflowExecutionInfo) *cadencepb.WorkflowExecutionInfo {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionInfo{
+		Execution:        toProtoWorkflowExecution(in.Execution),
+		Type:             toProtoWorkflowType(in.Type),
+		StartTime:        in.StartTime,
+		CloseTime:        in.CloseTime,
+		CloseStatus:      toProtoWorkflowExecutionCloseStatus(in.CloseStatus),
+		HistoryLength:    in.HistoryLength,
+		SearchAttributes: toProtoSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toProtoWorkflowExecutionInfoArray(in []*shared.WorkflowExecutionInfo) []*cadencepb.WorkflowExecutionInfo {
+	if in == nil {
+		return nil
+	}
+	out := make([]*cadencepb.WorkflowExecutionInfo, len(in))
+	for i, v := range in {
+		out[i] = toProtoWorkflowExecutionInfo(v)
+	}
+	return out
+}
+
+func toThriftWorkflowExecutionInfo(in *cadencepb.WorkflowExecutionInfo) *shared.WorkflowExecutionInfo {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionInfo{
+		Execution:        toThriftWorkflowExecution(in.Execution),
+		Type:             toThriftWorkflowType(in.Type),
+		StartTime:        in.StartTime,
+		CloseTime:        in.CloseTime,
+		CloseStatus:      toThriftWorkflowExecutionCloseStatus(in.CloseStatus),
+		HistoryLength:    in.HistoryLength,
+		SearchAttributes: toThriftSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toThriftWorkflowExecutionInfoArray(in []*cadencepb.WorkflowExecutionInfo) []*shared.WorkflowExecutionInfo {
+	if in == nil {
+		return nil
+	}
+	out := make([]*shared.WorkflowExecutionInfo, len(in))
+	for i, v := range in {
+		out[i] = toThriftWorkflowExecutionInfo(v)
+	}
+	return out
+}
+
+func toProtoWorkflowExecutionConfiguration(in *shared.WorkflowExecutionConfiguration) *cadencepb.WorkflowExecutionConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionConfiguration{
+		TaskList:                            toProtoTaskList(in.TaskList),
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		ChildPolicy:                         toProtoChildPolicy(in.ChildPolicy),
+	}
+}
+
+func toThriftWorkflowExecutionConfiguration(in *cadencepb.WorkflowExecutionConfiguration) *shared.WorkflowExecutionConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionConfiguration{
+		TaskList:                            toThriftTaskList(in.TaskList),
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		ChildPolicy:                         toThriftChildPolicy(in.ChildPolicy),
+	}
+}
+
+func toProtoScheduleActivityTaskDecisionAttributes(in *shared.ScheduleActivityTaskDecisionAttributes) *cadencepb.ScheduleActivityTaskDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    in.ActivityId,
+		ActivityType:                  toProtoActivityType(in.ActivityType),
+		Domain:                        in.Domain,
+		TaskList:                      toProtoTaskList(in.TaskList),
+		Input:                         in.Input,
+		ScheduleToCloseTimeoutSeconds: in.ScheduleToCloseTimeoutSeconds,
+		ScheduleToStartTimeoutSeconds: in.ScheduleToStartTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    in.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       in.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   toProtoRetryPolicy(in.RetryPolicy),
+	}
+}
+
+func toThriftScheduleActivityTaskDecisionAttributes(in *cadencepb.ScheduleActivityTaskDecisionAttributes) *shared.ScheduleActivityTaskDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    in.ActivityId,
+		ActivityType:                  toThriftActivityType(in.ActivityType),
+		Domain:                        in.Domain,
+		TaskList:                      toThriftTaskList(in.TaskList),
+		Input:                         in.Input,
+		ScheduleToCloseTimeoutSeconds: in.ScheduleToCloseTimeoutSeconds,
+		ScheduleToStartTimeoutSeconds: in.ScheduleToStartTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    in.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       in.HeartbeatTimeoutSeconds,
+		RetryPolicy:                   toThriftRetryPolicy(in.RetryPolicy),
+	}
+}
+
+func toProtoRequestCancelActivityTaskDecisionAttributes(in *shared.RequestCancelActivityTaskDecisionAttributes) *cadencepb.RequestCancelActivityTaskDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: in.ActivityId,
+	}
+}
+
+func toThriftRequestCancelActivityTaskDecisionAttributes(in *cadencepb.RequestCancelActivityTaskDecisionAttributes) *shared.RequestCancelActivityTaskDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: in.ActivityId,
+	}
+}
+
+func toProtoStartTimerDecisionAttributes(in *shared.StartTimerDecisionAttributes) *cadencepb.StartTimerDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.StartTimerDecisionAttributes{
+		TimerId:                   in.TimerId,
+		StartToFireTimeoutSeconds: in.StartToFireTimeoutSeconds,
+	}
+}
+
+func toThriftStartTimerDecisionAttributes(in *cadencepb.StartTimerDecisionAttributes) *shared.StartTimerDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.StartTimerDecisionAttributes{
+		TimerId:                   in.TimerId,
+		StartToFireTimeoutSeconds: in.StartToFireTimeoutSeconds,
+	}
+}
+
+func toProtoCompleteWorkflowExecutionDecisionAttributes(in *shared.CompleteWorkflowExecutionDecisionAttributes) *cadencepb.CompleteWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.CompleteWorkflowExecutionDecisionAttributes{
+		Result: in.Result,
+	}
+}
+
+func toThriftCompleteWorkflowExecutionDecisionAttributes(in *cadencepb.CompleteWorkflowExecutionDecisionAttributes) *shared.CompleteWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.CompleteWorkflowExecutionDecisionAttributes{
+		Result: in.Result,
+	}
+}
+
+func toProtoFailWorkflowExecutionDecisionAttributes(in *shared.FailWorkflowExecutionDecisionAttributes) *cadencepb.FailWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.FailWorkflowExecutionDecisionAttributes{
+		Reason:  in.Reason,
+		Details: in.Details,
+	}
+}
+
+func toThriftFailWorkflowExecutionDecisionAttributes(in *cadencepb.FailWorkflowExecutionDecisionAttributes) *shared.FailWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.FailWorkflowExecutionDecisionAttributes{
+		Reason:  in.Reason,
+		Details: in.Details,
+	}
+}
+
+func toProtoCancelTimerDecisionAttributes(in *shared.CancelTimerDecisionAttributes) *cadencepb.CancelTimerDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.CancelTimerDecisionAttributes{
+		TimerId: in.TimerId,
+	}
+}
+
+func toThriftCancelTimerDecisionAttributes(in *cadencepb.CancelTimerDecisionAttributes) *shared.CancelTimerDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.CancelTimerDecisionAttributes{
+		TimerId: in.TimerId,
+	}
+}
+
+func toProtoCancelWorkflowExecutionDecisionAttributes(in *shared.CancelWorkflowExecutionDecisionAttributes) *cadencepb.CancelWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.CancelWorkflowExecutionDecisionAttributes{
+		Details: in.Details,
+	}
+}
+
+func toThriftCancelWorkflowExecutionDecisionAttributes(in *cadencepb.CancelWorkflowExecutionDecisionAttributes) *shared.CancelWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.CancelWorkflowExecutionDecisionAttributes{
+		Details: in.Details,
+	}
+}
+
+func toProtoRequestCancelExternalWorkflowExecutionDecisionAttributes(in *shared.RequestCancelExternalWorkflowExecutionDecisionAttributes) *cadencepb.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            in.Domain,
+		WorkflowId:        in.WorkflowId,
+		RunId:             in.RunId,
+		Control:           in.Control,
+		ChildWorkflowOnly: in.ChildWorkflowOnly,
+	}
+}
+
+func toThriftRequestCancelExternalWorkflowExecutionDecisionAttributes(in *cadencepb.RequestCancelExternalWorkflowExecutionDecisionAttributes) *shared.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            in.Domain,
+		WorkflowId:        in.WorkflowId,
+		RunId:             in.RunId,
+		Control:           in.Control,
+		ChildWorkflowOnly: in.ChildWorkflowOnly,
+	}
+}
+
+func toProtoSignalExternalWorkflowExecutionDecisionAttributes(in *shared.SignalExternalWorkflowExecutionDecisionAttributes) *cadencepb.SignalExternalWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:            in.Domain,
+		Execution:         toProtoWorkflowExecution(in.Execution),
+		SignalName:        in.SignalName,
+		Input:             in.Input,
+		Control:           in.Control,
+		ChildWorkflowOnly: in.ChildWorkflowOnly,
+	}
+}
+
+func toThriftSignalExternalWorkflowExecutionDecisionAttributes(in *cadencepb.SignalExternalWorkflowExecutionDecisionAttributes) *shared.SignalExternalWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:            in.Domain,
+		Execution:         toThriftWorkflowExecution(in.Execution),
+		SignalName:        in.SignalName,
+		Input:             in.Input,
+		Control:           in.Control,
+		ChildWorkflowOnly: in.ChildWorkflowOnly,
+	}
+}
+
+func toProtoRecordMarkerDecisionAttributes(in *shared.RecordMarkerDecisionAttributes) *cadencepb.RecordMarkerDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RecordMarkerDecisionAttributes{
+		MarkerName: in.MarkerName,
+		Details:    in.Details,
+	}
+}
+
+func toThriftRecordMarkerDecisionAttributes(in *cadencepb.RecordMarkerDecisionAttributes) *shared.RecordMarkerDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.RecordMarkerDecisionAttributes{
+		MarkerName: in.MarkerName,
+		Details:    in.Details,
+	}
+}
+
+func toProtoContinueAsNewWorkflowExecutionDecisionAttributes(in *shared.ContinueAsNewWorkflowExecutionDecisionAttributes) *cadencepb.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        toProtoWorkflowType(in.WorkflowType),
+		TaskList:                            toProtoTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		BackoffStartIntervalInSeconds:       in.BackoffStartIntervalInSeconds,
+		Initiator:                           toProtoContinueAsNewInitiator(in.Initiator),
+		RetryPolicy:                         toProtoRetryPolicy(in.RetryPolicy),
+		FailureReason:                       in.FailureReason,
+		FailureDetails:                      in.FailureDetails,
+		SearchAttributes:                    toProtoSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toThriftContinueAsNewWorkflowExecutionDecisionAttributes(in *cadencepb.ContinueAsNewWorkflowExecutionDecisionAttributes) *shared.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        toThriftWorkflowType(in.WorkflowType),
+		TaskList:                            toThriftTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		BackoffStartIntervalInSeconds:       in.BackoffStartIntervalInSeconds,
+		Initiator:                           toThriftContinueAsNewInitiator(in.Initiator),
+		RetryPolicy:                         toThriftRetryPolicy(in.RetryPolicy),
+		FailureReason:                       in.FailureReason,
+		FailureDetails:                      in.FailureDetails,
+		SearchAttributes:                    toThriftSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toProtoStartChildWorkflowExecutionDecisionAttributes(in *shared.StartChildWorkflowExecutionDecisionAttributes) *cadencepb.StartChildWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:                              in.Domain,
+		WorkflowId:                          in.WorkflowId,
+		WorkflowType:                        toProtoWorkflowType(in.WorkflowType),
+		TaskList:                            toProtoTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		ChildPolicy:                         toProtoChildPolicy(in.ChildPolicy),
+		Control:                             in.Control,
+		WorkflowIdReusePolicy:               toProtoWorkflowIdReusePolicy(in.WorkflowIdReusePolicy),
+		RetryPolicy:                         toProtoRetryPolicy(in.RetryPolicy),
+		SearchAttributes:                    toProtoSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toThriftStartChildWorkflowExecutionDecisionAttributes(in *cadencepb.StartChildWorkflowExecutionDecisionAttributes) *shared.StartChildWorkflowExecutionDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:                              in.Domain,
+		WorkflowId:                          in.WorkflowId,
+		WorkflowType:                        toThriftWorkflowType(in.WorkflowType),
+		TaskList:                            toThriftTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		ChildPolicy:                         toThriftChildPolicy(in.ChildPolicy),
+		Control:                             in.Control,
+		WorkflowIdReusePolicy:               toThriftWorkflowIdReusePolicy(in.WorkflowIdReusePolicy),
+		RetryPolicy:                         toThriftRetryPolicy(in.RetryPolicy),
+		SearchAttributes:                    toThriftSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toProtoUpsertWorkflowSearchAttributesDecisionAttributes(in *shared.UpsertWorkflowSearchAttributesDecisionAttributes) *cadencepb.UpsertWorkflowSearchAttributesDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.UpsertWorkflowSearchAttributesDecisionAttributes{
+		SearchAttributes: toProtoSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toThriftUpsertWorkflowSearchAttributesDecisionAttributes(in *cadencepb.UpsertWorkflowSearchAttributesDecisionAttributes) *shared.UpsertWorkflowSearchAttributesDecisionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowSearchAttributesDecisionAttributes{
+		SearchAttributes: toThriftSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toProtoDecision(in *shared.Decision) *cadencepb.Decision {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.Decision{
+		DecisionType:                                             toProtoDecisionType(in.DecisionType),
+		ScheduleActivityTaskDecisionAttributes:                   toProtoScheduleActivityTaskDecisionAttributes(in.ScheduleActivityTaskDecisionAttributes),
+		StartTimerDecisionAttributes:                             toProtoStartTimerDecisionAttributes(in.StartTimerDecisionAttributes),
+		CompleteWorkflowExecutionDecisionAttributes:              toProtoCompleteWorkflowExecutionDecisionAttributes(in.CompleteWorkflowExecutionDecisionAttributes),
+		FailWorkflowExecutionDecisionAttributes:                  toProtoFailWorkflowExecutionDecisionAttributes(in.FailWorkflowExecutionDecisionAttributes),
+		RequestCancelActivityTaskDecisionAttributes:              toProtoRequestCancelActivityTaskDecisionAttributes(in.RequestCancelActivityTaskDecisionAttributes),
+		CancelTimerDecisionAttributes:                            toProtoCancelTimerDecisionAttributes(in.CancelTimerDecisionAttributes),
+		CancelWorkflowExecutionDecisionAttributes:                toProtoCancelWorkflowExecutionDecisionAttributes(in.CancelWorkflowExecutionDecisionAttributes),
+		RequestCancelExternalWorkflowExecutionDecisionAttributes: toProtoRequestCancelExternalWorkflowExecutionDecisionAttributes(in.RequestCancelExternalWorkflowExecutionDecisionAttributes),
+		RecordMarkerDecisionAttributes:                           toProtoRecordMarkerDecisionAttributes(in.RecordMarkerDecisionAttributes),
+		ContinueAsNewWorkflowExecutionDecisionAttributes:         toProtoContinueAsNewWorkflowExecutionDecisionAttributes(in.ContinueAsNewWorkflowExecutionDecisionAttributes),
+		StartChildWorkflowExecutionDecisionAttributes:            toProtoStartChildWorkflowExecutionDecisionAttributes(in.StartChildWorkflowExecutionDecisionAttributes),
+		SignalExternalWorkflowExecutionDecisionAttributes:        toProtoSignalExternalWorkflowExecutionDecisionAttributes(in.SignalExternalWorkflowExecutionDecisionAttributes),
+		UpsertWorkflowSearchAttributesDecisionAttributes:         toProtoUpsertWorkflowSearchAttributesDecisionAttributes(in.UpsertWorkflowSearchAttributesDecisionAttributes),
+	}
+}
+
+func toProtoDecisionArray(in []*shared.Decision) []*cadencepb.Decision {
+	if in == nil {
+		return nil
+	}
+	out := make([]*cadencepb.Decision, len(in))
+	for i, v := range in {
+		out[i] = toProtoDecision(v)
+	}
+	return out
+}
+
+func toThriftDecision(in *cadencepb.Decision) *shared.Decision {
+	if in == nil {
+		return nil
+	}
+	return &shared.Decision{
+		DecisionType:                                             toThriftDecisionType(in.DecisionType),
+		ScheduleActivityTaskDecisionAttributes:                   toThriftScheduleActivityTaskDecisionAttributes(in.ScheduleActivityTaskDecisionAttributes),
+		StartTimerDecisionAttributes:                             toThriftStartTimerDecisionAttributes(in.StartTimerDecisionAttributes),
+		CompleteWorkflowExecutionDecisionAttributes:              toThriftCompleteWorkflowExecutionDecisionAttributes(in.CompleteWorkflowExecutionDecisionAttributes),
+		FailWorkflowExecutionDecisionAttributes:                  toThriftFailWorkflowExecutionDecisionAttributes(in.FailWorkflowExecutionDecisionAttributes),
+		RequestCancelActivityTaskDecisionAttributes:              toThriftRequestCancelActivityTaskDecisionAttributes(in.RequestCancelActivityTaskDecisionAttributes),
+		CancelTimerDecisionAttributes:                            toThriftCancelTimerDecisionAttributes(in.CancelTimerDecisionAttributes),
+		CancelWorkflowExecutionDecisionAttributes:                toThriftCancelWorkflowExecutionDecisionAttributes(in.CancelWorkflowExecutionDecisionAttributes),
+		RequestCancelExternalWorkflowExecutionDecisionAttributes: toThriftRequestCancelExternalWorkflowExecutionDecisionAttributes(in.RequestCancelExternalWorkflowExecutionDecisionAttributes),
+		RecordMarkerDecisionAttributes:                           toThriftRecordMarkerDecisionAttributes(in.RecordMarkerDecisionAttributes),
+		ContinueAsNewWorkflowExecutionDecisionAttributes:         toThriftContinueAsNewWorkflowExecutionDecisionAttributes(in.ContinueAsNewWorkflowExecutionDecisionAttributes),
+		StartChildWorkflowExecutionDecisionAttributes:            toThriftStartChildWorkflowExecutionDecisionAttributes(in.StartChildWorkflowExecutionDecisionAttributes),
+		SignalExternalWorkflowExecutionDecisionAttributes:        toThriftSignalExternalWorkflowExecutionDecisionAttributes(in.SignalExternalWorkflowExecutionDecisionAttributes),
+		UpsertWorkflowSearchAttributesDecisionAttributes:         toThriftUpsertWorkflowSearchAttributesDecisionAttributes(in.UpsertWorkflowSearchAttributesDecisionAttributes),
+	}
+}
+
+func toThriftDecisionArray(in []*cadencepb.Decision) []*shared.Decision {
+	if in == nil {
+		return nil
+	}
+	out := make([]*shared.Decision, len(in))
+	for i, v := range in {
+		out[i] = toThriftDecision(v)
+	}
+	return out
+}
+
+func toProtoWorkflowExecutionStartedEventAttributes(in *shared.WorkflowExecutionStartedEventAttributes) *cadencepb.WorkflowExecutionStartedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                        toProtoWorkflowType(in.WorkflowType),
+		ParentWorkflowDomain:                in.ParentWorkflowDomain,
+		ParentWorkflowExecution:             toProtoWorkflowExecution(in.ParentWorkflowExecution),
+		ParentInitiatedEventId:              in.ParentInitiatedEventId,
+		TaskList:                            toProtoTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		ChildPolicy:                         toProtoChildPolicy(in.ChildPolicy),
+		ContinuedExecutionRunId:             in.ContinuedExecutionRunId,
+		Initiator:                           toProtoContinueAsNewInitiator(in.Initiator),
+		Identity:                            in.Identity,
+		RetryPolicy:                         toProtoRetryPolicy(in.RetryPolicy),
+		Attempt:                             in.Attempt,
+		CronSchedule:                        in.CronSchedule,
+		FirstDecisionTaskBackoffSeconds:     in.FirstDecisionTaskBackoffSeconds,
+		ExpirationTimestamp:                 in.ExpirationTimestamp,
+		SearchAttributes:                    toProtoSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toThriftWorkflowExecutionStartedEventAttributes(in *cadencepb.WorkflowExecutionStartedEventAttributes) *shared.WorkflowExecutionStartedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                        toThriftWorkflowType(in.WorkflowType),
+		ParentWorkflowDomain:                in.ParentWorkflowDomain,
+		ParentWorkflowExecution:             toThriftWorkflowExecution(in.ParentWorkflowExecution),
+		ParentInitiatedEventId:              in.ParentInitiatedEventId,
+		TaskList:                            toThriftTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		ChildPolicy:                         toThriftChildPolicy(in.ChildPolicy),
+		ContinuedExecutionRunId:             in.ContinuedExecutionRunId,
+		Initiator:                           toThriftContinueAsNewInitiator(in.Initiator),
+		Identity:                            in.Identity,
+		RetryPolicy:                         toThriftRetryPolicy(in.RetryPolicy),
+		Attempt:                             in.Attempt,
+		CronSchedule:                        in.CronSchedule,
+		FirstDecisionTaskBackoffSeconds:     in.FirstDecisionTaskBackoffSeconds,
+		ExpirationTimestamp:                 in.ExpirationTimestamp,
+		SearchAttributes:                    toThriftSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toProtoWorkflowExecutionCompletedEventAttributes(in *shared.WorkflowExecutionCompletedEventAttributes) *cadencepb.WorkflowExecutionCompletedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionCompletedEventAttributes{
+		Result:                       in.Result,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toThriftWorkflowExecutionCompletedEventAttributes(in *cadencepb.WorkflowExecutionCompletedEventAttributes) *shared.WorkflowExecutionCompletedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCompletedEventAttributes{
+		Result:                       in.Result,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toProtoWorkflowExecutionFailedEventAttributes(in *shared.WorkflowExecutionFailedEventAttributes) *cadencepb.WorkflowExecutionFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionFailedEventAttributes{
+		Reason:                       in.Reason,
+		Details:                      in.Details,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toThriftWorkflowExecutionFailedEventAttributes(in *cadencepb.WorkflowExecutionFailedEventAttributes) *shared.WorkflowExecutionFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionFailedEventAttributes{
+		Reason:                       in.Reason,
+		Details:                      in.Details,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toProtoWorkflowExecutionTimedOutEventAttributes(in *shared.WorkflowExecutionTimedOutEventAttributes) *cadencepb.WorkflowExecutionTimedOutEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: toProtoTimeoutType(in.TimeoutType),
+	}
+}
+
+func toThriftWorkflowExecutionTimedOutEventAttributes(in *cadencepb.WorkflowExecutionTimedOutEventAttributes) *shared.WorkflowExecutionTimedOutEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: toThriftTimeoutType(in.TimeoutType),
+	}
+}
+
+func toProtoWorkflowExecutionContinuedAsNewEventAttributes(in *shared.WorkflowExecutionContinuedAsNewEventAttributes) *cadencepb.WorkflowExecutionContinuedAsNewEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId:                   in.NewExecutionRunId,
+		WorkflowType:                        toProtoWorkflowType(in.WorkflowType),
+		TaskList:                            toProtoTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		DecisionTaskCompletedEventId:        in.DecisionTaskCompletedEventId,
+		BackoffStartIntervalInSeconds:       in.BackoffStartIntervalInSeconds,
+		Initiator:                           toProtoContinueAsNewInitiator(in.Initiator),
+		FailureReason:                       in.FailureReason,
+		FailureDetails:                      in.FailureDetails,
+	}
+}
+
+func toThriftWorkflowExecutionContinuedAsNewEventAttributes(in *cadencepb.WorkflowExecutionContinuedAsNewEventAttributes) *shared.WorkflowExecutionContinuedAsNewEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId:                   in.NewExecutionRunId,
+		WorkflowType:                        toThriftWorkflowType(in.WorkflowType),
+		TaskList:                            toThriftTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		DecisionTaskCompletedEventId:        in.DecisionTaskCompletedEventId,
+		BackoffStartIntervalInSeconds:       in.BackoffStartIntervalInSeconds,
+		Initiator:                           toThriftContinueAsNewInitiator(in.Initiator),
+		FailureReason:                       in.FailureReason,
+		FailureDetails:                      in.FailureDetails,
+	}
+}
+
+func toProtoDecisionTaskScheduledEventAttributes(in *shared.DecisionTaskScheduledEventAttributes) *cadencepb.DecisionTaskScheduledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DecisionTaskScheduledEventAttributes{
+		TaskList:                   toProtoTaskList(in.TaskList),
+		StartToCloseTimeoutSeconds: in.StartToCloseTimeoutSeconds,
+		Attempt:                    in.Attempt,
+	}
+}
+
+func toThriftDecisionTaskScheduledEventAttributes(in *cadencepb.DecisionTaskScheduledEventAttributes) *shared.DecisionTaskScheduledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.DecisionTaskScheduledEventAttributes{
+		TaskList:                   toThriftTaskList(in.TaskList),
+		StartToCloseTimeoutSeconds: in.StartToCloseTimeoutSeconds,
+		Attempt:                    in.Attempt,
+	}
+}
+
+func toProtoDecisionTaskStartedEventAttributes(in *shared.DecisionTaskStartedEventAttributes) *cadencepb.DecisionTaskStartedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: in.ScheduledEventId,
+		Identity:         in.Identity,
+		RequestId:        in.RequestId,
+	}
+}
+
+func toThriftDecisionTaskStartedEventAttributes(in *cadencepb.DecisionTaskStartedEventAttributes) *shared.DecisionTaskStartedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: in.ScheduledEventId,
+		Identity:         in.Identity,
+		RequestId:        in.RequestId,
+	}
+}
+
+func toProtoDecisionTaskCompletedEventAttributes(in *shared.DecisionTaskCompletedEventAttributes) *cadencepb.DecisionTaskCompletedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DecisionTaskCompletedEventAttributes{
+		ExecutionContext: in.ExecutionContext,
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		Identity:         in.Identity,
+	}
+}
+
+func toThriftDecisionTaskCompletedEventAttributes(in *cadencepb.DecisionTaskCompletedEventAttributes) *shared.DecisionTaskCompletedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.DecisionTaskCompletedEventAttributes{
+		ExecutionContext: in.ExecutionContext,
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		Identity:         in.Identity,
+	}
+}
+
+func toProtoDecisionTaskTimedOutEventAttributes(in *shared.DecisionTaskTimedOutEventAttributes) *cadencepb.DecisionTaskTimedOutEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DecisionTaskTimedOutEventAttributes{
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		TimeoutType:      toProtoTimeoutType(in.TimeoutType),
+	}
+}
+
+func toThriftDecisionTaskTimedOutEventAttributes(in *cadencepb.DecisionTaskTimedOutEventAttributes) *shared.DecisionTaskTimedOutEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.DecisionTaskTimedOutEventAttributes{
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		TimeoutType:      toThriftTimeoutType(in.TimeoutType),
+	}
+}
+
+func toProtoDecisionTaskFailedEventAttributes(in *shared.DecisionTaskFailedEventAttributes) *cadencepb.DecisionTaskFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DecisionTaskFailedEventAttributes{
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		Cause:            toProtoDecisionTaskFailedCause(in.Cause),
+		Details:          in.Details,
+		Identity:         in.Identity,
+	}
+}
+
+func toThriftDecisionTaskFailedEventAttributes(in *cadencepb.DecisionTaskFailedEventAttributes) *shared.DecisionTaskFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.DecisionTaskFailedEventAttributes{
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		Cause:            toThriftDecisionTaskFailedCause(in.Cause),
+		Details:          in.Details,
+		Identity:         in.Identity,
+	}
+}
+
+func toProtoActivityTaskScheduledEventAttributes(in *shared.ActivityTaskScheduledEventAttributes) *cadencepb.ActivityTaskScheduledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ActivityTaskScheduledEventAttributes{
+		ActivityId:                    in.ActivityId,
+		ActivityType:                  toProtoActivityType(in.ActivityType),
+		Domain:                        in.Domain,
+		TaskList:                      toProtoTaskList(in.TaskList),
+		Input:                         in.Input,
+		ScheduleToCloseTimeoutSeconds: in.ScheduleToCloseTimeoutSeconds,
+		ScheduleToStartTimeoutSeconds: in.ScheduleToStartTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    in.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       in.HeartbeatTimeoutSeconds,
+		DecisionTaskCompletedEventId:  in.DecisionTaskCompletedEventId,
+		RetryPolicy:                   toProtoRetryPolicy(in.RetryPolicy),
+	}
+}
+
+func toThriftActivityTaskScheduledEventAttributes(in *cadencepb.ActivityTaskScheduledEventAttributes) *shared.ActivityTaskScheduledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ActivityTaskScheduledEventAttributes{
+		ActivityId:                    in.ActivityId,
+		ActivityType:                  toThriftActivityType(in.ActivityType),
+		Domain:                        in.Domain,
+		TaskList:                      toThriftTaskList(in.TaskList),
+		Input:                         in.Input,
+		ScheduleToCloseTimeoutSeconds: in.ScheduleToCloseTimeoutSeconds,
+		ScheduleToStartTimeoutSeconds: in.ScheduleToStartTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    in.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       in.HeartbeatTimeoutSeconds,
+		DecisionTaskCompletedEventId:  in.DecisionTaskCompletedEventId,
+		RetryPolicy:                   toThriftRetryPolicy(in.RetryPolicy),
+	}
+}
+
+func toProtoActivityTaskStartedEventAttributes(in *shared.ActivityTaskStartedEventAttributes) *cadencepb.ActivityTaskStartedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ActivityTaskStartedEventAttributes{
+		ScheduledEventId: in.ScheduledEventId,
+		Identity:         in.Identity,
+		RequestId:        in.RequestId,
+		Attempt:          in.Attempt,
+	}
+}
+
+func toThriftActivityTaskStartedEventAttributes(in *cadencepb.ActivityTaskStartedEventAttributes) *shared.ActivityTaskStartedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ActivityTaskStartedEventAttributes{
+		ScheduledEventId: in.ScheduledEventId,
+		Identity:         in.Identity,
+		RequestId:        in.RequestId,
+		Attempt:          in.Attempt,
+	}
+}
+
+func toProtoActivityTaskCompletedEventAttributes(in *shared.ActivityTaskCompletedEventAttributes) *cadencepb.ActivityTaskCompletedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ActivityTaskCompletedEventAttributes{
+		Result:           in.Result,
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		Identity:         in.Identity,
+	}
+}
+
+func toThriftActivityTaskCompletedEventAttributes(in *cadencepb.ActivityTaskCompletedEventAttributes) *shared.ActivityTaskCompletedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCompletedEventAttributes{
+		Result:           in.Result,
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		Identity:         in.Identity,
+	}
+}
+
+func toProtoActivityTaskFailedEventAttributes(in *shared.ActivityTaskFailedEventAttributes) *cadencepb.ActivityTaskFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ActivityTaskFailedEventAttributes{
+		Reason:           in.Reason,
+		Details:          in.Details,
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		Identity:         in.Identity,
+	}
+}
+
+func toThriftActivityTaskFailedEventAttributes(in *cadencepb.ActivityTaskFailedEventAttributes) *shared.ActivityTaskFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ActivityTaskFailedEventAttributes{
+		Reason:           in.Reason,
+		Details:          in.Details,
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		Identity:         in.Identity,
+	}
+}
+
+func toProtoActivityTaskTimedOutEventAttributes(in *shared.ActivityTaskTimedOutEventAttributes) *cadencepb.ActivityTaskTimedOutEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ActivityTaskTimedOutEventAttributes{
+		Details:          in.Details,
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		TimeoutType:      toProtoTimeoutType(in.TimeoutType),
+	}
+}
+
+func toThriftActivityTaskTimedOutEventAttributes(in *cadencepb.ActivityTaskTimedOutEventAttributes) *shared.ActivityTaskTimedOutEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ActivityTaskTimedOutEventAttributes{
+		Details:          in.Details,
+		ScheduledEventId: in.ScheduledEventId,
+		StartedEventId:   in.StartedEventId,
+		TimeoutType:      toThriftTimeoutType(in.TimeoutType),
+	}
+}
+
+func toProtoActivityTaskCancelRequestedEventAttributes(in *shared.ActivityTaskCancelRequestedEventAttributes) *cadencepb.ActivityTaskCancelRequestedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ActivityTaskCancelRequestedEventAttributes{
+		ActivityId:                   in.ActivityId,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toThriftActivityTaskCancelRequestedEventAttributes(in *cadencepb.ActivityTaskCancelRequestedEventAttributes) *shared.ActivityTaskCancelRequestedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCancelRequestedEventAttributes{
+		ActivityId:                   in.ActivityId,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toProtoRequestCancelActivityTaskFailedEventAttributes(in *shared.RequestCancelActivityTaskFailedEventAttributes) *cadencepb.RequestCancelActivityTaskFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   in.ActivityId,
+		Cause:                        in.Cause,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toThriftRequestCancelActivityTaskFailedEventAttributes(in *cadencepb.RequestCancelActivityTaskFailedEventAttributes) *shared.RequestCancelActivityTaskFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   in.ActivityId,
+		Cause:                        in.Cause,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toProtoActivityTaskCanceledEventAttributes(in *shared.ActivityTaskCanceledEventAttributes) *cadencepb.ActivityTaskCanceledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ActivityTaskCanceledEventAttributes{
+		Details:                      in.Details,
+		LatestCancelRequestedEventId: in.LatestCancelRequestedEventId,
+		ScheduledEventId:             in.ScheduledEventId,
+		StartedEventId:               in.StartedEventId,
+		Identity:                     in.Identity,
+	}
+}
+
+func toThriftActivityTaskCanceledEventAttributes(in *cadencepb.ActivityTaskCanceledEventAttributes) *shared.ActivityTaskCanceledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCanceledEventAttributes{
+		Details:                      in.Details,
+		LatestCancelRequestedEventId: in.LatestCancelRequestedEventId,
+		ScheduledEventId:             in.ScheduledEventId,
+		StartedEventId:               in.StartedEventId,
+		Identity:                     in.Identity,
+	}
+}
+
+func toProtoTimerStartedEventAttributes(in *shared.TimerStartedEventAttributes) *cadencepb.TimerStartedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.TimerStartedEventAttributes{
+		TimerId:                      in.TimerId,
+		StartToFireTimeoutSeconds:    in.StartToFireTimeoutSeconds,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toThriftTimerStartedEventAttributes(in *cadencepb.TimerStartedEventAttributes) *shared.TimerStartedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.TimerStartedEventAttributes{
+		TimerId:                      in.TimerId,
+		StartToFireTimeoutSeconds:    in.StartToFireTimeoutSeconds,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toProtoTimerFiredEventAttributes(in *shared.TimerFiredEventAttributes) *cadencepb.TimerFiredEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.TimerFiredEventAttributes{
+		TimerId:        in.TimerId,
+		StartedEventId: in.StartedEventId,
+	}
+}
+
+func toThriftTimerFiredEventAttributes(in *cadencepb.TimerFiredEventAttributes) *shared.TimerFiredEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.TimerFiredEventAttributes{
+		TimerId:        in.TimerId,
+		StartedEventId: in.StartedEventId,
+	}
+}
+
+func toProtoTimerCanceledEventAttributes(in *shared.TimerCanceledEventAttributes) *cadencepb.TimerCanceledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.TimerCanceledEventAttributes{
+		TimerId:                      in.TimerId,
+		StartedEventId:               in.StartedEventId,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Identity:                     in.Identity,
+	}
+}
+
+func toThriftTimerCanceledEventAttributes(in *cadencepb.TimerCanceledEventAttributes) *shared.TimerCanceledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.TimerCanceledEventAttributes{
+		TimerId:                      in.TimerId,
+		StartedEventId:               in.StartedEventId,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Identity:                     in.Identity,
+	}
+}
+
+func toProtoCancelTimerFailedEventAttributes(in *shared.CancelTimerFailedEventAttributes) *cadencepb.CancelTimerFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.CancelTimerFailedEventAttributes{
+		TimerId:                      in.TimerId,
+		Cause:                        in.Cause,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Identity:                     in.Identity,
+	}
+}
+
+func toThriftCancelTimerFailedEventAttributes(in *cadencepb.CancelTimerFailedEventAttributes) *shared.CancelTimerFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.CancelTimerFailedEventAttributes{
+		TimerId:                      in.TimerId,
+		Cause:                        in.Cause,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Identity:                     in.Identity,
+	}
+}
+
+func toProtoWorkflowExecutionCancelRequestedEventAttributes(in *shared.WorkflowExecutionCancelRequestedEventAttributes) *cadencepb.WorkflowExecutionCancelRequestedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                     in.Cause,
+		ExternalInitiatedEventId:  in.ExternalInitiatedEventId,
+		ExternalWorkflowExecution: toProtoWorkflowExecution(in.ExternalWorkflowExecution),
+		Identity:                  in.Identity,
+	}
+}
+
+func toThriftWorkflowExecutionCancelRequestedEventAttributes(in *cadencepb.WorkflowExecutionCancelRequestedEventAttributes) *shared.WorkflowExecutionCancelRequestedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                     in.Cause,
+		ExternalInitiatedEventId:  in.ExternalInitiatedEventId,
+		ExternalWorkflowExecution: toThriftWorkflowExecution(in.ExternalWorkflowExecution),
+		Identity:                  in.Identity,
+	}
+}
+
+func toProtoWorkflowExecutionCanceledEventAttributes(in *shared.WorkflowExecutionCanceledEventAttributes) *cadencepb.WorkflowExecutionCanceledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionCanceledEventAttributes{
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Details:                      in.Details,
+	}
+}
+
+func toThriftWorkflowExecutionCanceledEventAttributes(in *cadencepb.WorkflowExecutionCanceledEventAttributes) *shared.WorkflowExecutionCanceledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCanceledEventAttributes{
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Details:                      in.Details,
+	}
+}
+
+func toProtoMarkerRecordedEventAttributes(in *shared.MarkerRecordedEventAttributes) *cadencepb.MarkerRecordedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.MarkerRecordedEventAttributes{
+		MarkerName:                   in.MarkerName,
+		Details:                      in.Details,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toThriftMarkerRecordedEventAttributes(in *cadencepb.MarkerRecordedEventAttributes) *shared.MarkerRecordedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.MarkerRecordedEventAttributes{
+		MarkerName:                   in.MarkerName,
+		Details:                      in.Details,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toProtoWorkflowExecutionSignaledEventAttributes(in *shared.WorkflowExecutionSignaledEventAttributes) *cadencepb.WorkflowExecutionSignaledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionSignaledEventAttributes{
+		SignalName: in.SignalName,
+		Input:      in.Input,
+		Identity:   in.Identity,
+	}
+}
+
+func toThriftWorkflowExecutionSignaledEventAttributes(in *cadencepb.WorkflowExecutionSignaledEventAttributes) *shared.WorkflowExecutionSignaledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionSignaledEventAttributes{
+		SignalName: in.SignalName,
+		Input:      in.Input,
+		Identity:   in.Identity,
+	}
+}
+
+func toProtoWorkflowExecutionTerminatedEventAttributes(in *shared.WorkflowExecutionTerminatedEventAttributes) *cadencepb.WorkflowExecutionTerminatedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionTerminatedEventAttributes{
+		Reason:   in.Reason,
+		Details:  in.Details,
+		Identity: in.Identity,
+	}
+}
+
+func toThriftWorkflowExecutionTerminatedEventAttributes(in *cadencepb.WorkflowExecutionTerminatedEventAttributes) *shared.WorkflowExecutionTerminatedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTerminatedEventAttributes{
+		Reason:   in.Reason,
+		Details:  in.Details,
+		Identity: in.Identity,
+	}
+}
+
+func toProtoRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(in *shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *cadencepb.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Domain:                       in.Domain,
+		WorkflowExecution:            toProtoWorkflowExecution(in.WorkflowExecution),
+		Control:                      in.Control,
+		ChildWorkflowOnly:            in.ChildWorkflowOnly,
+	}
+}
+
+func toThriftRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(in *cadencepb.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Domain:                       in.Domain,
+		WorkflowExecution:            toThriftWorkflowExecution(in.WorkflowExecution),
+		Control:                      in.Control,
+		ChildWorkflowOnly:            in.ChildWorkflowOnly,
+	}
+}
+
+func toProtoRequestCancelExternalWorkflowExecutionFailedEventAttributes(in *shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *cadencepb.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        toProtoCancelExternalWorkflowExecutionFailedCause(in.Cause),
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Domain:                       in.Domain,
+		WorkflowExecution:            toProtoWorkflowExecution(in.WorkflowExecution),
+		InitiatedEventId:             in.InitiatedEventId,
+		Control:                      in.Control,
+	}
+}
+
+func toThriftRequestCancelExternalWorkflowExecutionFailedEventAttributes(in *cadencepb.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        toThriftCancelExternalWorkflowExecutionFailedCause(in.Cause),
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Domain:                       in.Domain,
+		WorkflowExecution:            toThriftWorkflowExecution(in.WorkflowExecution),
+		InitiatedEventId:             in.InitiatedEventId,
+		Control:                      in.Control,
+	}
+}
+
+func toProtoExternalWorkflowExecutionCancelRequestedEventAttributes(in *shared.ExternalWorkflowExecutionCancelRequestedEventAttributes) *cadencepb.ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+		InitiatedEventId:  in.InitiatedEventId,
+		Domain:            in.Domain,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+	}
+}
+
+func toThriftExternalWorkflowExecutionCancelRequestedEventAttributes(in *cadencepb.ExternalWorkflowExecutionCancelRequestedEventAttributes) *shared.ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+		InitiatedEventId:  in.InitiatedEventId,
+		Domain:            in.Domain,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+	}
+}
+
+func toProtoSignalExternalWorkflowExecutionInitiatedEventAttributes(in *shared.SignalExternalWorkflowExecutionInitiatedEventAttributes) *cadencepb.SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Domain:                       in.Domain,
+		WorkflowExecution:            toProtoWorkflowExecution(in.WorkflowExecution),
+		SignalName:                   in.SignalName,
+		Input:                        in.Input,
+		Control:                      in.Control,
+		ChildWorkflowOnly:            in.ChildWorkflowOnly,
+	}
+}
+
+func toThriftSignalExternalWorkflowExecutionInitiatedEventAttributes(in *cadencepb.SignalExternalWorkflowExecutionInitiatedEventAttributes) *shared.SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Domain:                       in.Domain,
+		WorkflowExecution:            toThriftWorkflowExecution(in.WorkflowExecution),
+		SignalName:                   in.SignalName,
+		Input:                        in.Input,
+		Control:                      in.Control,
+		ChildWorkflowOnly:            in.ChildWorkflowOnly,
+	}
+}
+
+func toProtoSignalExternalWorkflowExecutionFailedEventAttributes(in *shared.SignalExternalWorkflowExecutionFailedEventAttributes) *cadencepb.SignalExternalWorkflowExecutionFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.SignalExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        toProtoSignalExternalWorkflowExecutionFailedCause(in.Cause),
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Domain:                       in.Domain,
+		WorkflowExecution:            toProtoWorkflowExecution(in.WorkflowExecution),
+		InitiatedEventId:             in.InitiatedEventId,
+		Control:                      in.Control,
+	}
+}
+
+func toThriftSignalExternalWorkflowExecutionFailedEventAttributes(in *cadencepb.SignalExternalWorkflowExecutionFailedEventAttributes) *shared.SignalExternalWorkflowExecutionFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        toThriftSignalExternalWorkflowExecutionFailedCause(in.Cause),
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		Domain:                       in.Domain,
+		WorkflowExecution:            toThriftWorkflowExecution(in.WorkflowExecution),
+		InitiatedEventId:             in.InitiatedEventId,
+		Control:                      in.Control,
+	}
+}
+
+func toProtoExternalWorkflowExecutionSignaledEventAttributes(in *shared.ExternalWorkflowExecutionSignaledEventAttributes) *cadencepb.ExternalWorkflowExecutionSignaledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ExternalWorkflowExecutionSignaledEventAttributes{
+		InitiatedEventId:  in.InitiatedEventId,
+		Domain:            in.Domain,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+		Control:           in.Control,
+	}
+}
+
+func toThriftExternalWorkflowExecutionSignaledEventAttributes(in *cadencepb.ExternalWorkflowExecutionSignaledEventAttributes) *shared.ExternalWorkflowExecutionSignaledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ExternalWorkflowExecutionSignaledEventAttributes{
+		InitiatedEventId:  in.InitiatedEventId,
+		Domain:            in.Domain,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+		Control:           in.Control,
+	}
+}
+
+func toProtoUpsertWorkflowSearchAttributesEventAttributes(in *shared.UpsertWorkflowSearchAttributesEventAttributes) *cadencepb.UpsertWorkflowSearchAttributesEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.UpsertWorkflowSearchAttributesEventAttributes{
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		SearchAttributes:             toProtoSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toThriftUpsertWorkflowSearchAttributesEventAttributes(in *cadencepb.UpsertWorkflowSearchAttributesEventAttributes) *shared.UpsertWorkflowSearchAttributesEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowSearchAttributesEventAttributes{
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+		SearchAttributes:             toThriftSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toProtoStartChildWorkflowExecutionInitiatedEventAttributes(in *shared.StartChildWorkflowExecutionInitiatedEventAttributes) *cadencepb.StartChildWorkflowExecutionInitiatedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:                              in.Domain,
+		WorkflowId:                          in.WorkflowId,
+		WorkflowType:                        toProtoWorkflowType(in.WorkflowType),
+		TaskList:                            toProtoTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		ChildPolicy:                         toProtoChildPolicy(in.ChildPolicy),
+		Control:                             in.Control,
+		DecisionTaskCompletedEventId:        in.DecisionTaskCompletedEventId,
+		WorkflowIdReusePolicy:               toProtoWorkflowIdReusePolicy(in.WorkflowIdReusePolicy),
+		RetryPolicy:                         toProtoRetryPolicy(in.RetryPolicy),
+		SearchAttributes:                    toProtoSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toThriftStartChildWorkflowExecutionInitiatedEventAttributes(in *cadencepb.StartChildWorkflowExecutionInitiatedEventAttributes) *shared.StartChildWorkflowExecutionInitiatedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:                              in.Domain,
+		WorkflowId:                          in.WorkflowId,
+		WorkflowType:                        toThriftWorkflowType(in.WorkflowType),
+		TaskList:                            toThriftTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		ChildPolicy:                         toThriftChildPolicy(in.ChildPolicy),
+		Control:                             in.Control,
+		DecisionTaskCompletedEventId:        in.DecisionTaskCompletedEventId,
+		WorkflowIdReusePolicy:               toThriftWorkflowIdReusePolicy(in.WorkflowIdReusePolicy),
+		RetryPolicy:                         toThriftRetryPolicy(in.RetryPolicy),
+		SearchAttributes:                    toThriftSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toProtoStartChildWorkflowExecutionFailedEventAttributes(in *shared.StartChildWorkflowExecutionFailedEventAttributes) *cadencepb.StartChildWorkflowExecutionFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.StartChildWorkflowExecutionFailedEventAttributes{
+		Domain:                       in.Domain,
+		WorkflowId:                   in.WorkflowId,
+		WorkflowType:                 toProtoWorkflowType(in.WorkflowType),
+		Cause:                        toProtoChildWorkflowExecutionFailedCause(in.Cause),
+		Control:                      in.Control,
+		InitiatedEventId:             in.InitiatedEventId,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toThriftStartChildWorkflowExecutionFailedEventAttributes(in *cadencepb.StartChildWorkflowExecutionFailedEventAttributes) *shared.StartChildWorkflowExecutionFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionFailedEventAttributes{
+		Domain:                       in.Domain,
+		WorkflowId:                   in.WorkflowId,
+		WorkflowType:                 toThriftWorkflowType(in.WorkflowType),
+		Cause:                        toThriftChildWorkflowExecutionFailedCause(in.Cause),
+		Control:                      in.Control,
+		InitiatedEventId:             in.InitiatedEventId,
+		DecisionTaskCompletedEventId: in.DecisionTaskCompletedEventId,
+	}
+}
+
+func toProtoChildWorkflowExecutionStartedEventAttributes(in *shared.ChildWorkflowExecutionStartedEventAttributes) *cadencepb.ChildWorkflowExecutionStartedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            in.Domain,
+		InitiatedEventId:  in.InitiatedEventId,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toProtoWorkflowType(in.WorkflowType),
+	}
+}
+
+func toThriftChildWorkflowExecutionStartedEventAttributes(in *cadencepb.ChildWorkflowExecutionStartedEventAttributes) *shared.ChildWorkflowExecutionStartedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            in.Domain,
+		InitiatedEventId:  in.InitiatedEventId,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toThriftWorkflowType(in.WorkflowType),
+	}
+}
+
+func toProtoChildWorkflowExecutionCompletedEventAttributes(in *shared.ChildWorkflowExecutionCompletedEventAttributes) *cadencepb.ChildWorkflowExecutionCompletedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ChildWorkflowExecutionCompletedEventAttributes{
+		Result:            in.Result,
+		Domain:            in.Domain,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toProtoWorkflowType(in.WorkflowType),
+		InitiatedEventId:  in.InitiatedEventId,
+		StartedEventId:    in.StartedEventId,
+	}
+}
+
+func toThriftChildWorkflowExecutionCompletedEventAttributes(in *cadencepb.ChildWorkflowExecutionCompletedEventAttributes) *shared.ChildWorkflowExecutionCompletedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionCompletedEventAttributes{
+		Result:            in.Result,
+		Domain:            in.Domain,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toThriftWorkflowType(in.WorkflowType),
+		InitiatedEventId:  in.InitiatedEventId,
+		StartedEventId:    in.StartedEventId,
+	}
+}
+
+func toProtoChildWorkflowExecutionFailedEventAttributes(in *shared.ChildWorkflowExecutionFailedEventAttributes) *cadencepb.ChildWorkflowExecutionFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ChildWorkflowExecutionFailedEventAttributes{
+		Reason:            in.Reason,
+		Details:           in.Details,
+		Domain:            in.Domain,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toProtoWorkflowType(in.WorkflowType),
+		InitiatedEventId:  in.InitiatedEventId,
+		StartedEventId:    in.StartedEventId,
+	}
+}
+
+func toThriftChildWorkflowExecutionFailedEventAttributes(in *cadencepb.ChildWorkflowExecutionFailedEventAttributes) *shared.ChildWorkflowExecutionFailedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionFailedEventAttributes{
+		Reason:            in.Reason,
+		Details:           in.Details,
+		Domain:            in.Domain,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toThriftWorkflowType(in.WorkflowType),
+		InitiatedEventId:  in.InitiatedEventId,
+		StartedEventId:    in.StartedEventId,
+	}
+}
+
+func toProtoChildWorkflowExecutionCanceledEventAttributes(in *shared.ChildWorkflowExecutionCanceledEventAttributes) *cadencepb.ChildWorkflowExecutionCanceledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ChildWorkflowExecutionCanceledEventAttributes{
+		Details:           in.Details,
+		Domain:            in.Domain,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toProtoWorkflowType(in.WorkflowType),
+		InitiatedEventId:  in.InitiatedEventId,
+		StartedEventId:    in.StartedEventId,
+	}
+}
+
+func toThriftChildWorkflowExecutionCanceledEventAttributes(in *cadencepb.ChildWorkflowExecutionCanceledEventAttributes) *shared.ChildWorkflowExecutionCanceledEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionCanceledEventAttributes{
+		Details:           in.Details,
+		Domain:            in.Domain,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toThriftWorkflowType(in.WorkflowType),
+		InitiatedEventId:  in.InitiatedEventId,
+		StartedEventId:    in.StartedEventId,
+	}
+}
+
+func toProtoChildWorkflowExecutionTimedOutEventAttributes(in *shared.ChildWorkflowExecutionTimedOutEventAttributes) *cadencepb.ChildWorkflowExecutionTimedOutEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ChildWorkflowExecutionTimedOutEventAttributes{
+		TimeoutType:       toProtoTimeoutType(in.TimeoutType),
+		Domain:            in.Domain,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toProtoWorkflowType(in.WorkflowType),
+		InitiatedEventId:  in.InitiatedEventId,
+		StartedEventId:    in.StartedEventId,
+	}
+}
+
+func toThriftChildWorkflowExecutionTimedOutEventAttributes(in *cadencepb.ChildWorkflowExecutionTimedOutEventAttributes) *shared.ChildWorkflowExecutionTimedOutEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionTimedOutEventAttributes{
+		TimeoutType:       toThriftTimeoutType(in.TimeoutType),
+		Domain:            in.Domain,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toThriftWorkflowType(in.WorkflowType),
+		InitiatedEventId:  in.InitiatedEventId,
+		StartedEventId:    in.StartedEventId,
+	}
+}
+
+func toProtoChildWorkflowExecutionTerminatedEventAttributes(in *shared.ChildWorkflowExecutionTerminatedEventAttributes) *cadencepb.ChildWorkflowExecutionTerminatedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ChildWorkflowExecutionTerminatedEventAttributes{
+		Domain:            in.Domain,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toProtoWorkflowType(in.WorkflowType),
+		InitiatedEventId:  in.InitiatedEventId,
+		StartedEventId:    in.StartedEventId,
+	}
+}
+
+func toThriftChildWorkflowExecutionTerminatedEventAttributes(in *cadencepb.ChildWorkflowExecutionTerminatedEventAttributes) *shared.ChildWorkflowExecutionTerminatedEventAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionTerminatedEventAttributes{
+		Domain:            in.Domain,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:      toThriftWorkflowType(in.WorkflowType),
+		InitiatedEventId:  in.InitiatedEventId,
+		StartedEventId:    in.StartedEventId,
+	}
+}
+
+func toProtoHistoryEvent(in *shared.HistoryEvent) *cadencepb.HistoryEvent {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.HistoryEvent{
+		EventId:                                 in.EventId,
+		Timestamp:                               in.Timestamp,
+		EventType:                               toProtoEventType(in.EventType),
+		Version:                                 in.Version,
+		WorkflowExecutionStartedEventAttributes: toProtoWorkflowExecutionStartedEventAttributes(in.WorkflowExecutionStartedEventAttributes),
+		WorkflowExecutionCompletedEventAttributes:                      toProtoWorkflowExecutionCompletedEventAttributes(in.WorkflowExecutionCompletedEventAttributes),
+		WorkflowExecutionFailedEventAttributes:                         toProtoWorkflowExecutionFailedEventAttributes(in.WorkflowExecutionFailedEventAttributes),
+		WorkflowExecutionTimedOutEventAttributes:                       toProtoWorkflowExecutionTimedOutEventAttributes(in.WorkflowExecutionTimedOutEventAttributes),
+		DecisionTaskScheduledEventAttributes:                           toProtoDecisionTaskScheduledEventAttributes(in.DecisionTaskScheduledEventAttributes),
+		DecisionTaskStartedEventAttributes:                             toProtoDecisionTaskStartedEventAttributes(in.DecisionTaskStartedEventAttributes),
+		DecisionTaskCompletedEventAttributes:                           toProtoDecisionTaskCompletedEventAttributes(in.DecisionTaskCompletedEventAttributes),
+		DecisionTaskTimedOutEventAttributes:                            toProtoDecisionTaskTimedOutEventAttributes(in.DecisionTaskTimedOutEventAttributes),
+		DecisionTaskFailedEventAttributes:                              toProtoDecisionTaskFailedEventAttributes(in.DecisionTaskFailedEventAttributes),
+		ActivityTaskScheduledEventAttributes:                           toProtoActivityTaskScheduledEventAttributes(in.ActivityTaskScheduledEventAttributes),
+		ActivityTaskStartedEventAttributes:                             toProtoActivityTaskStartedEventAttributes(in.ActivityTaskStartedEventAttributes),
+		ActivityTaskCompletedEventAttributes:                           toProtoActivityTaskCompletedEventAttributes(in.ActivityTaskCompletedEventAttributes),
+		ActivityTaskFailedEventAttributes:                              toProtoActivityTaskFailedEventAttributes(in.ActivityTaskFailedEventAttributes),
+		ActivityTaskTimedOutEventAttributes:                            toProtoActivityTaskTimedOutEventAttributes(in.ActivityTaskTimedOutEventAttributes),
+		TimerStartedEventAttributes:                                    toProtoTimerStartedEventAttributes(in.TimerStartedEventAttributes),
+		TimerFiredEventAttributes:                                      toProtoTimerFiredEventAttributes(in.TimerFiredEventAttributes),
+		ActivityTaskCancelRequestedEventAttributes:                     toProtoActivityTaskCancelRequestedEventAttributes(in.ActivityTaskCancelRequestedEventAttributes),
+		RequestCancelActivityTaskFailedEventAttributes:                 toProtoRequestCancelActivityTaskFailedEventAttributes(in.RequestCancelActivityTaskFailedEventAttributes),
+		ActivityTaskCanceledEventAttributes:                            toProtoActivityTaskCanceledEventAttributes(in.ActivityTaskCanceledEventAttributes),
+		TimerCanceledEventAttributes:                                   toProtoTimerCanceledEventAttributes(in.TimerCanceledEventAttributes),
+		CancelTimerFailedEventAttributes:                               toProtoCancelTimerFailedEventAttributes(in.CancelTimerFailedEventAttributes),
+		MarkerRecordedEventAttributes:                                  toProtoMarkerRecordedEventAttributes(in.MarkerRecordedEventAttributes),
+		WorkflowExecutionSignaledEventAttributes:                       toProtoWorkflowExecutionSignaledEventAttributes(in.WorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionTerminatedEventAttributes:                     toProtoWorkflowExecutionTerminatedEventAttributes(in.WorkflowExecutionTerminatedEventAttributes),
+		WorkflowExecutionCancelRequestedEventAttributes:                toProtoWorkflowExecutionCancelRequestedEventAttributes(in.WorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionCanceledEventAttributes:                       toProtoWorkflowExecutionCanceledEventAttributes(in.WorkflowExecutionCanceledEventAttributes),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: toProtoRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(in.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes),
+		RequestCancelExternalWorkflowExecutionFailedEventAttributes:    toProtoRequestCancelExternalWorkflowExecutionFailedEventAttributes(in.RequestCancelExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionCancelRequestedEventAttributes:        toProtoExternalWorkflowExecutionCancelRequestedEventAttributes(in.ExternalWorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionContinuedAsNewEventAttributes:                 toProtoWorkflowExecutionContinuedAsNewEventAttributes(in.WorkflowExecutionContinuedAsNewEventAttributes),
+		StartChildWorkflowExecutionInitiatedEventAttributes:            toProtoStartChildWorkflowExecutionInitiatedEventAttributes(in.StartChildWorkflowExecutionInitiatedEventAttributes),
+		StartChildWorkflowExecutionFailedEventAttributes:               toProtoStartChildWorkflowExecutionFailedEventAttributes(in.StartChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionStartedEventAttributes:                   toProtoChildWorkflowExecutionStartedEventAttributes(in.ChildWorkflowExecutionStartedEventAttributes),
+		ChildWorkflowExecutionCompletedEventAttributes:                 toProtoChildWorkflowExecutionCompletedEventAttributes(in.ChildWorkflowExecutionCompletedEventAttributes),
+		ChildWorkflowExecutionFailedEventAttributes:                    toProtoChildWorkflowExecutionFailedEventAttributes(in.ChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionCanceledEventAttributes:                  toProtoChildWorkflowExecutionCanceledEventAttributes(in.ChildWorkflowExecutionCanceledEventAttributes),
+		ChildWorkflowExecutionTimedOutEventAttributes:                  toProtoChildWorkflowExecutionTimedOutEventAttributes(in.ChildWorkflowExecutionTimedOutEventAttributes),
+		ChildWorkflowExecutionTerminatedEventAttributes:                toProtoChildWorkflowExecutionTerminatedEventAttributes(in.ChildWorkflowExecutionTerminatedEventAttributes),
+		SignalExternalWorkflowExecutionInitiatedEventAttributes:        toProtoSignalExternalWorkflowExecutionInitiatedEventAttributes(in.SignalExternalWorkflowExecutionInitiatedEventAttributes),
+		SignalExternalWorkflowExecutionFailedEventAttributes:           toProtoSignalExternalWorkflowExecutionFailedEventAttributes(in.SignalExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionSignaledEventAttributes:               toProtoExternalWorkflowExecutionSignaledEventAttributes(in.ExternalWorkflowExecutionSignaledEventAttributes),
+		UpsertWorkflowSearchAttributesEventAttributes:                  toProtoUpsertWorkflowSearchAttributesEventAttributes(in.UpsertWorkflowSearchAttributesEventAttributes),
+	}
+}
+
+func toProtoHistoryEventArray(in []*shared.HistoryEvent) []*cadencepb.HistoryEvent {
+	if in == nil {
+		return nil
+	}
+	out := make([]*cadencepb.HistoryEvent, len(in))
+	for i, v := range in {
+		out[i] = toProtoHistoryEvent(v)
+	}
+	return out
+}
+
+func toThriftHistoryEvent(in *cadencepb.HistoryEvent) *shared.HistoryEvent {
+	if in == nil {
+		return nil
+	}
+	return &shared.HistoryEvent{
+		EventId:                                 in.EventId,
+		Timestamp:                               in.Timestamp,
+		EventType:                               toThriftEventType(in.EventType),
+		Version:                                 in.Version,
+		WorkflowExecutionStartedEventAttributes: toThriftWorkflowExecutionStartedEventAttributes(in.WorkflowExecutionStartedEventAttributes),
+		WorkflowExecutionCompletedEventAttributes:                      toThriftWorkflowExecutionCompletedEventAttributes(in.WorkflowExecutionCompletedEventAttributes),
+		WorkflowExecutionFailedEventAttributes:                         toThriftWorkflowExecutionFailedEventAttributes(in.WorkflowExecutionFailedEventAttributes),
+		WorkflowExecutionTimedOutEventAttributes:                       toThriftWorkflowExecutionTimedOutEventAttributes(in.WorkflowExecutionTimedOutEventAttributes),
+		DecisionTaskScheduledEventAttributes:                           toThriftDecisionTaskScheduledEventAttributes(in.DecisionTaskScheduledEventAttributes),
+		DecisionTaskStartedEventAttributes:                             toThriftDecisionTaskStartedEventAttributes(in.DecisionTaskStartedEventAttributes),
+		DecisionTaskCompletedEventAttributes:                           toThriftDecisionTaskCompletedEventAttributes(in.DecisionTaskCompletedEventAttributes),
+		DecisionTaskTimedOutEventAttributes:                            toThriftDecisionTaskTimedOutEventAttributes(in.DecisionTaskTimedOutEventAttributes),
+		DecisionTaskFailedEventAttributes:                              toThriftDecisionTaskFailedEventAttributes(in.DecisionTaskFailedEventAttributes),
+		ActivityTaskScheduledEventAttributes:                           toThriftActivityTaskScheduledEventAttributes(in.ActivityTaskScheduledEventAttributes),
+		ActivityTaskStartedEventAttributes:                             toThriftActivityTaskStartedEventAttributes(in.ActivityTaskStartedEventAttributes),
+		ActivityTaskCompletedEventAttributes:                           toThriftActivityTaskCompletedEventAttributes(in.ActivityTaskCompletedEventAttributes),
+		ActivityTaskFailedEventAttributes:                              toThriftActivityTaskFailedEventAttributes(in.ActivityTaskFailedEventAttributes),
+		ActivityTaskTimedOutEventAttributes:                            toThriftActivityTaskTimedOutEventAttributes(in.ActivityTaskTimedOutEventAttributes),
+		TimerStartedEventAttributes:                                    toThriftTimerStartedEventAttributes(in.TimerStartedEventAttributes),
+		TimerFiredEventAttributes:                                      toThriftTimerFiredEventAttributes(in.TimerFiredEventAttributes),
+		ActivityTaskCancelRequestedEventAttributes:                     toThriftActivityTaskCancelRequestedEventAttributes(in.ActivityTaskCancelRequestedEventAttributes),
+		RequestCancelActivityTaskFailedEventAttributes:                 toThriftRequestCancelActivityTaskFailedEventAttributes(in.RequestCancelActivityTaskFailedEventAttributes),
+		ActivityTaskCanceledEventAttributes:                            toThriftActivityTaskCanceledEventAttributes(in.ActivityTaskCanceledEventAttributes),
+		TimerCanceledEventAttributes:                                   toThriftTimerCanceledEventAttributes(in.TimerCanceledEventAttributes),
+		CancelTimerFailedEventAttributes:                               toThriftCancelTimerFailedEventAttributes(in.CancelTimerFailedEventAttributes),
+		MarkerRecordedEventAttributes:                                  toThriftMarkerRecordedEventAttributes(in.MarkerRecordedEventAttributes),
+		WorkflowExecutionSignaledEventAttributes:                       toThriftWorkflowExecutionSignaledEventAttributes(in.WorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionTerminatedEventAttributes:                     toThriftWorkflowExecutionTerminatedEventAttributes(in.WorkflowExecutionTerminatedEventAttributes),
+		WorkflowExecutionCancelRequestedEventAttributes:                toThriftWorkflowExecutionCancelRequestedEventAttributes(in.WorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionCanceledEventAttributes:                       toThriftWorkflowExecutionCanceledEventAttributes(in.WorkflowExecutionCanceledEventAttributes),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: toThriftRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(in.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes),
+		RequestCancelExternalWorkflowExecutionFailedEventAttributes:    toThriftRequestCancelExternalWorkflowExecutionFailedEventAttributes(in.RequestCancelExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionCancelRequestedEventAttributes:        toThriftExternalWorkflowExecutionCancelRequestedEventAttributes(in.ExternalWorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionContinuedAsNewEventAttributes:                 toThriftWorkflowExecutionContinuedAsNewEventAttributes(in.WorkflowExecutionContinuedAsNewEventAttributes),
+		StartChildWorkflowExecutionInitiatedEventAttributes:            toThriftStartChildWorkflowExecutionInitiatedEventAttributes(in.StartChildWorkflowExecutionInitiatedEventAttributes),
+		StartChildWorkflowExecutionFailedEventAttributes:               toThriftStartChildWorkflowExecutionFailedEventAttributes(in.StartChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionStartedEventAttributes:                   toThriftChildWorkflowExecutionStartedEventAttributes(in.ChildWorkflowExecutionStartedEventAttributes),
+		ChildWorkflowExecutionCompletedEventAttributes:                 toThriftChildWorkflowExecutionCompletedEventAttributes(in.ChildWorkflowExecutionCompletedEventAttributes),
+		ChildWorkflowExecutionFailedEventAttributes:                    toThriftChildWorkflowExecutionFailedEventAttributes(in.ChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionCanceledEventAttributes:                  toThriftChildWorkflowExecutionCanceledEventAttributes(in.ChildWorkflowExecutionCanceledEventAttributes),
+		ChildWorkflowExecutionTimedOutEventAttributes:                  toThriftChildWorkflowExecutionTimedOutEventAttributes(in.ChildWorkflowExecutionTimedOutEventAttributes),
+		ChildWorkflowExecutionTerminatedEventAttributes:                toThriftChildWorkflowExecutionTerminatedEventAttributes(in.ChildWorkflowExecutionTerminatedEventAttributes),
+		SignalExternalWorkflowExecutionInitiatedEventAttributes:        toThriftSignalExternalWorkflowExecutionInitiatedEventAttributes(in.SignalExternalWorkflowExecutionInitiatedEventAttributes),
+		SignalExternalWorkflowExecutionFailedEventAttributes:           toThriftSignalExternalWorkflowExecutionFailedEventAttributes(in.SignalExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionSignaledEventAttributes:               toThriftExternalWorkflowExecutionSignaledEventAttributes(in.ExternalWorkflowExecutionSignaledEventAttributes),
+		UpsertWorkflowSearchAttributesEventAttributes:                  toThriftUpsertWorkflowSearchAttributesEventAttributes(in.UpsertWorkflowSearchAttributesEventAttributes),
+	}
+}
+
+func toThriftHistoryEventArray(in []*cadencepb.HistoryEvent) []*shared.HistoryEvent {
+	if in == nil {
+		return nil
+	}
+	out := make([]*shared.HistoryEvent, len(in))
+	for i, v := range in {
+		out[i] = toThriftHistoryEvent(v)
+	}
+	return out
+}
+
+func toProtoHistory(in *shared.History) *cadencepb.History {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.History{
+		Events: toProtoHistoryEventArray(in.Events),
+	}
+}
+
+func toThriftHistory(in *cadencepb.History) *shared.History {
+	if in == nil {
+		return nil
+	}
+	return &shared.History{
+		Events: toThriftHistoryEventArray(in.Events),
+	}
+}
+
+func toProtoWorkflowExecutionFilter(in *shared.WorkflowExecutionFilter) *cadencepb.WorkflowExecutionFilter {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowExecutionFilter{
+		WorkflowId: in.WorkflowId,
+	}
+}
+
+func toThriftWorkflowExecutionFilter(in *cadencepb.WorkflowExecutionFilter) *shared.WorkflowExecutionFilter {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionFilter{
+		WorkflowId: in.WorkflowId,
+	}
+}
+
+func toProtoWorkflowTypeFilter(in *shared.WorkflowTypeFilter) *cadencepb.WorkflowTypeFilter {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowTypeFilter{
+		Name: in.Name,
+	}
+}
+
+func toThriftWorkflowTypeFilter(in *cadencepb.WorkflowTypeFilter) *shared.WorkflowTypeFilter {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowTypeFilter{
+		Name: in.Name,
+	}
+}
+
+func toProtoStartTimeFilter(in *shared.StartTimeFilter) *cadencepb.StartTimeFilter {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.StartTimeFilter{
+		EarliestTime: in.EarliestTime,
+		LatestTime:   in.LatestTime,
+	}
+}
+
+func toThriftStartTimeFilter(in *cadencepb.StartTimeFilter) *shared.StartTimeFilter {
+	if in == nil {
+		return nil
+	}
+	return &shared.StartTimeFilter{
+		EarliestTime: in.EarliestTime,
+		LatestTime:   in.LatestTime,
+	}
+}
+
+func toProtoDomainInfo(in *shared.DomainInfo) *cadencepb.DomainInfo {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DomainInfo{
+		Name:        in.Name,
+		Status:      toProtoDomainStatus(in.Status),
+		Description: in.Description,
+		OwnerEmail:  in.OwnerEmail,
+	}
+}
+
+func toThriftDomainInfo(in *cadencepb.DomainInfo) *shared.DomainInfo {
+	if in == nil {
+		return nil
+	}
+	return &shared.DomainInfo{
+		Name:        in.Name,
+		Status:      toThriftDomainStatus(in.Status),
+		Description: in.Description,
+		OwnerEmail:  in.OwnerEmail,
+	}
+}
+
+func toProtoDomainConfiguration(in *shared.DomainConfiguration) *cadencepb.DomainConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: in.WorkflowExecutionRetentionPeriodInDays,
+		EmitMetric:                             in.EmitMetric,
+		ArchivalBucketName:                     in.ArchivalBucketName,
+		ArchivalStatus:                         toProtoArchivalStatus(in.ArchivalStatus),
+	}
+}
+
+func toThriftDomainConfiguration(in *cadencepb.DomainConfiguration) *shared.DomainConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &shared.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: in.WorkflowExecutionRetentionPeriodInDays,
+		EmitMetric:                             in.EmitMetric,
+		ArchivalBucketName:                     in.ArchivalBucketName,
+		ArchivalStatus:                         toThriftArchivalStatus(in.ArchivalStatus),
+	}
+}
+
+func toProtoUpdateDomainInfo(in *shared.UpdateDomainInfo) *cadencepb.UpdateDomainInfo {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.UpdateDomainInfo{
+		Description: in.Description,
+		OwnerEmail:  in.OwnerEmail,
+	}
+}
+
+func toThriftUpdateDomainInfo(in *cadencepb.UpdateDomainInfo) *shared.UpdateDomainInfo {
+	if in == nil {
+		return nil
+	}
+	return &shared.UpdateDomainInfo{
+		Description: in.Description,
+		OwnerEmail:  in.OwnerEmail,
+	}
+}
+
+func toProtoClusterReplicationConfiguration(in *shared.ClusterReplicationConfiguration) *cadencepb.ClusterReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ClusterReplicationConfiguration{
+		ClusterName: in.ClusterName,
+	}
+}
+
+func toProtoClusterReplicationConfigurationArray(in []*shared.ClusterReplicationConfiguration) []*cadencepb.ClusterReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := make([]*cadencepb.ClusterReplicationConfiguration, len(in))
+	for i, v := range in {
+		out[i] = toProtoClusterReplicationConfiguration(v)
+	}
+	return out
+}
+
+func toThriftClusterReplicationConfiguration(in *cadencepb.ClusterReplicationConfiguration) *shared.ClusterReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &shared.ClusterReplicationConfiguration{
+		ClusterName: in.ClusterName,
+	}
+}
+
+func toThriftClusterReplicationConfigurationArray(in []*cadencepb.ClusterReplicationConfiguration) []*shared.ClusterReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := make([]*shared.ClusterReplicationConfiguration, len(in))
+	for i, v := range in {
+		out[i] = toThriftClusterReplicationConfiguration(v)
+	}
+	return out
+}
+
+func toProtoDomainReplicationConfiguration(in *shared.DomainReplicationConfiguration) *cadencepb.DomainReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DomainReplicationConfiguration{
+		ActiveClusterName: in.ActiveClusterName,
+		Clusters:          toProtoClusterReplicationConfigurationArray(in.Clusters),
+	}
+}
+
+func toThriftDomainReplicationConfiguration(in *cadencepb.DomainReplicationConfiguration) *shared.DomainReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &shared.DomainReplicationConfiguration{
+		ActiveClusterName: in.ActiveClusterName,
+		Clusters:          toThriftClusterReplicationConfigurationArray(in.Clusters),
+	}
+}
+
+func toProtoDomainHandoverInfo(in *shared.DomainHandoverInfo) *cadencepb.DomainHandoverInfo {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DomainHandoverInfo{
+		TargetClusterName:       in.TargetClusterName,
+		StartTimestamp:          in.StartTimestamp,
+		ExpiryTimestamp:         in.ExpiryTimestamp,
+		PendingReplicationTasks: in.PendingReplicationTasks,
+	}
+}
+
+func toThriftDomainHandoverInfo(in *cadencepb.DomainHandoverInfo) *shared.DomainHandoverInfo {
+	if in == nil {
+		return nil
+	}
+	return &shared.DomainHandoverInfo{
+		TargetClusterName:       in.TargetClusterName,
+		StartTimestamp:          in.StartTimestamp,
+		ExpiryTimestamp:         in.ExpiryTimestamp,
+		PendingReplicationTasks: in.PendingReplicationTasks,
+	}
+}
+
+func toProtoRegisterDomainRequest(in *shared.RegisterDomainRequest) *cadencepb.RegisterDomainRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RegisterDomainRequest{
+		Name:                                   in.Name,
+		Description:                            in.Description,
+		OwnerEmail:                             in.OwnerEmail,
+		WorkflowExecutionRetentionPeriodInDays: in.WorkflowExecutionRetentionPeriodInDays,
+		EmitMetric:                             in.EmitMetric,
+		Clusters:                               toProtoClusterReplicationConfigurationArray(in.Clusters),
+		ActiveClusterName:                      in.ActiveClusterName,
+		ArchivalBucketName:                     in.ArchivalBucketName,
+		ArchivalStatus:                         toProtoArchivalStatus(in.ArchivalStatus),
+	}
+}
+
+// ToThriftRegisterDomainRequest converts a proto RegisterDomainRequest to thrift
+func ToThriftRegisterDomainRequest(in *cadencepb.RegisterDomainRequest) *shared.RegisterDomainRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RegisterDomainRequest{
+		Name:                                   in.Name,
+		Description:                            in.Description,
+		OwnerEmail:                             in.OwnerEmail,
+		WorkflowExecutionRetentionPeriodInDays: in.WorkflowExecutionRetentionPeriodInDays,
+		EmitMetric:                             in.EmitMetric,
+		Clusters:                               toThriftClusterReplicationConfigurationArray(in.Clusters),
+		ActiveClusterName:                      in.ActiveClusterName,
+		ArchivalBucketName:                     in.ArchivalBucketName,
+		ArchivalStatus:                         toThriftArchivalStatus(in.ArchivalStatus),
+	}
+}
+
+func toProtoDescribeDomainRequest(in *shared.DescribeDomainRequest) *cadencepb.DescribeDomainRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DescribeDomainRequest{
+		Name: in.Name,
+	}
+}
+
+// ToThriftDescribeDomainRequest converts a proto DescribeDomainRequest to thrift
+func ToThriftDescribeDomainRequest(in *cadencepb.DescribeDomainRequest) *shared.DescribeDomainRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.DescribeDomainRequest{
+		Name: in.Name,
+	}
+}
+
+// ToProtoDescribeDomainResponse converts a thrift DescribeDomainResponse to proto
+func ToProtoDescribeDomainResponse(in *shared.DescribeDomainResponse) *cadencepb.DescribeDomainResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DescribeDomainResponse{
+		DomainInfo:               toProtoDomainInfo(in.DomainInfo),
+		Configuration:            toProtoDomainConfiguration(in.Configuration),
+		ReplicationConfiguration: toProtoDomainReplicationConfiguration(in.ReplicationConfiguration),
+		FailoverVersion:          in.FailoverVersion,
+		IsGlobalDomain:           in.IsGlobalDomain,
+		HandoverInfo:             toProtoDomainHandoverInfo(in.HandoverInfo),
+	}
+}
+
+func toThriftDescribeDomainResponse(in *cadencepb.DescribeDomainResponse) *shared.DescribeDomainResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.DescribeDomainResponse{
+		DomainInfo:               toThriftDomainInfo(in.DomainInfo),
+		Configuration:            toThriftDomainConfiguration(in.Configuration),
+		ReplicationConfiguration: toThriftDomainReplicationConfiguration(in.ReplicationConfiguration),
+		FailoverVersion:          in.FailoverVersion,
+		IsGlobalDomain:           in.IsGlobalDomain,
+		HandoverInfo:             toThriftDomainHandoverInfo(in.HandoverInfo),
+	}
+}
+
+func toProtoUpdateDomainRequest(in *shared.UpdateDomainRequest) *cadencepb.UpdateDomainRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.UpdateDomainRequest{
+		Name:                             in.Name,
+		UpdatedInfo:                      toProtoUpdateDomainInfo(in.UpdatedInfo),
+		Configuration:                    toProtoDomainConfiguration(in.Configuration),
+		ReplicationConfiguration:         toProtoDomainReplicationConfiguration(in.ReplicationConfiguration),
+		GracefulFailoverTimeoutInSeconds: in.GracefulFailoverTimeoutInSeconds,
+	}
+}
+
+// ToThriftUpdateDomainRequest converts a proto UpdateDomainRequest to thrift
+func ToThriftUpdateDomainRequest(in *cadencepb.UpdateDomainRequest) *shared.UpdateDomainRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.UpdateDomainRequest{
+		Name:                             in.Name,
+		UpdatedInfo:                      toThriftUpdateDomainInfo(in.UpdatedInfo),
+		Configuration:                    toThriftDomainConfiguration(in.Configuration),
+		ReplicationConfiguration:         toThriftDomainReplicationConfiguration(in.ReplicationConfiguration),
+		GracefulFailoverTimeoutInSeconds: in.GracefulFailoverTimeoutInSeconds,
+	}
+}
+
+// ToProtoUpdateDomainResponse converts a thrift UpdateDomainResponse to proto
+func ToProtoUpdateDomainResponse(in *shared.UpdateDomainResponse) *cadencepb.UpdateDomainResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.UpdateDomainResponse{
+		DomainInfo:               toProtoDomainInfo(in.DomainInfo),
+		Configuration:            toProtoDomainConfiguration(in.Configuration),
+		ReplicationConfiguration: toProtoDomainReplicationConfiguration(in.ReplicationConfiguration),
+		FailoverVersion:          in.FailoverVersion,
+		IsGlobalDomain:           in.IsGlobalDomain,
+	}
+}
+
+func toThriftUpdateDomainResponse(in *cadencepb.UpdateDomainResponse) *shared.UpdateDomainResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.UpdateDomainResponse{
+		DomainInfo:               toThriftDomainInfo(in.DomainInfo),
+		Configuration:            toThriftDomainConfiguration(in.Configuration),
+		ReplicationConfiguration: toThriftDomainReplicationConfiguration(in.ReplicationConfiguration),
+		FailoverVersion:          in.FailoverVersion,
+		IsGlobalDomain:           in.IsGlobalDomain,
+	}
+}
+
+func toProtoDeprecateDomainRequest(in *shared.DeprecateDomainRequest) *cadencepb.DeprecateDomainRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DeprecateDomainRequest{
+		Name: in.Name,
+	}
+}
+
+// ToThriftDeprecateDomainRequest converts a proto DeprecateDomainRequest to thrift
+func ToThriftDeprecateDomainRequest(in *cadencepb.DeprecateDomainRequest) *shared.DeprecateDomainRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.DeprecateDomainRequest{
+		Name: in.Name,
+	}
+}
+
+func toProtoStartWorkflowExecutionRequest(in *shared.StartWorkflowExecutionRequest) *cadencepb.StartWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.StartWorkflowExecutionRequest{
+		Domain:                              in.Domain,
+		WorkflowId:                          in.WorkflowId,
+		WorkflowType:                        toProtoWorkflowType(in.WorkflowType),
+		TaskList:                            toProtoTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		Identity:                            in.Identity,
+		RequestId:                           in.RequestId,
+		WorkflowIdReusePolicy:               toProtoWorkflowIdReusePolicy(in.WorkflowIdReusePolicy),
+		ChildPolicy:                         toProtoChildPolicy(in.ChildPolicy),
+		RetryPolicy:                         toProtoRetryPolicy(in.RetryPolicy),
+		CronSchedule:                        in.CronSchedule,
+		SearchAttributes:                    toProtoSearchAttributes(in.SearchAttributes),
+	}
+}
+
+// ToThriftStartWorkflowExecutionRequest converts a proto StartWorkflowExecutionRequest to thrift
+func ToThriftStartWorkflowExecutionRequest(in *cadencepb.StartWorkflowExecutionRequest) *shared.StartWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionRequest{
+		Domain:                              in.Domain,
+		WorkflowId:                          in.WorkflowId,
+		WorkflowType:                        toThriftWorkflowType(in.WorkflowType),
+		TaskList:                            toThriftTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		Identity:                            in.Identity,
+		RequestId:                           in.RequestId,
+		WorkflowIdReusePolicy:               toThriftWorkflowIdReusePolicy(in.WorkflowIdReusePolicy),
+		ChildPolicy:                         toThriftChildPolicy(in.ChildPolicy),
+		RetryPolicy:                         toThriftRetryPolicy(in.RetryPolicy),
+		CronSchedule:                        in.CronSchedule,
+		SearchAttributes:                    toThriftSearchAttributes(in.SearchAttributes),
+	}
+}
+
+// ToProtoStartWorkflowExecutionResponse converts a thrift StartWorkflowExecutionResponse to proto
+func ToProtoStartWorkflowExecutionResponse(in *shared.StartWorkflowExecutionResponse) *cadencepb.StartWorkflowExecutionResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.StartWorkflowExecutionResponse{
+		RunId: in.RunId,
+	}
+}
+
+func toThriftStartWorkflowExecutionResponse(in *cadencepb.StartWorkflowExecutionResponse) *shared.StartWorkflowExecutionResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionResponse{
+		RunId: in.RunId,
+	}
+}
+
+func toProtoPollForDecisionTaskRequest(in *shared.PollForDecisionTaskRequest) *cadencepb.PollForDecisionTaskRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.PollForDecisionTaskRequest{
+		Domain:   in.Domain,
+		TaskList: toProtoTaskList(in.TaskList),
+		Identity: in.Identity,
+	}
+}
+
+// ToThriftPollForDecisionTaskRequest converts a proto PollForDecisionTaskRequest to thrift
+func ToThriftPollForDecisionTaskRequest(in *cadencepb.PollForDecisionTaskRequest) *shared.PollForDecisionTaskRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.PollForDecisionTaskRequest{
+		Domain:   in.Domain,
+		TaskList: toThriftTaskList(in.TaskList),
+		Identity: in.Identity,
+	}
+}
+
+// ToProtoPollForDecisionTaskResponse converts a thrift PollForDecisionTaskResponse to proto
+func ToProtoPollForDecisionTaskResponse(in *shared.PollForDecisionTaskResponse) *cadencepb.PollForDecisionTaskResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.PollForDecisionTaskResponse{
+		TaskToken:              in.TaskToken,
+		WorkflowExecution:      toProtoWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:           toProtoWorkflowType(in.WorkflowType),
+		PreviousStartedEventId: in.PreviousStartedEventId,
+		StartedEventId:         in.StartedEventId,
+		Attempt:                in.Attempt,
+		BacklogCountHint:       in.BacklogCountHint,
+		History:                toProtoHistory(in.History),
+		NextPageToken:          in.NextPageToken,
+		Query:                  toProtoWorkflowQuery(in.Query),
+	}
+}
+
+func toThriftPollForDecisionTaskResponse(in *cadencepb.PollForDecisionTaskResponse) *shared.PollForDecisionTaskResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.PollForDecisionTaskResponse{
+		TaskToken:              in.TaskToken,
+		WorkflowExecution:      toThriftWorkflowExecution(in.WorkflowExecution),
+		WorkflowType:           toThriftWorkflowType(in.WorkflowType),
+		PreviousStartedEventId: in.PreviousStartedEventId,
+		StartedEventId:         in.StartedEventId,
+		Attempt:                in.Attempt,
+		BacklogCountHint:       in.BacklogCountHint,
+		History:                toThriftHistory(in.History),
+		NextPageToken:          in.NextPageToken,
+		Query:                  toThriftWorkflowQuery(in.Query),
+	}
+}
+
+func toProtoStickyExecutionAttributes(in *shared.StickyExecutionAttributes) *cadencepb.StickyExecutionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.StickyExecutionAttributes{
+		WorkerTaskList:                toProtoTaskList(in.WorkerTaskList),
+		ScheduleToStartTimeoutSeconds: in.ScheduleToStartTimeoutSeconds,
+	}
+}
+
+func toThriftStickyExecutionAttributes(in *cadencepb.StickyExecutionAttributes) *shared.StickyExecutionAttributes {
+	if in == nil {
+		return nil
+	}
+	return &shared.StickyExecutionAttributes{
+		WorkerTaskList:                toThriftTaskList(in.WorkerTaskList),
+		ScheduleToStartTimeoutSeconds: in.ScheduleToStartTimeoutSeconds,
+	}
+}
+
+func toProtoRespondDecisionTaskCompletedRequest(in *shared.RespondDecisionTaskCompletedRequest) *cadencepb.RespondDecisionTaskCompletedRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RespondDecisionTaskCompletedRequest{
+		TaskToken:        in.TaskToken,
+		Decisions:        toProtoDecisionArray(in.Decisions),
+		ExecutionContext: in.ExecutionContext,
+		Identity:         in.Identity,
+		StickyAttributes: toProtoStickyExecutionAttributes(in.StickyAttributes),
+	}
+}
+
+// ToThriftRespondDecisionTaskCompletedRequest converts a proto RespondDecisionTaskCompletedRequest to thrift
+func ToThriftRespondDecisionTaskCompletedRequest(in *cadencepb.RespondDecisionTaskCompletedRequest) *shared.RespondDecisionTaskCompletedRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskCompletedRequest{
+		TaskToken:        in.TaskToken,
+		Decisions:        toThriftDecisionArray(in.Decisions),
+		ExecutionContext: in.ExecutionContext,
+		Identity:         in.Identity,
+		StickyAttributes: toThriftStickyExecutionAttributes(in.StickyAttributes),
+	}
+}
+
+func toProtoRespondDecisionTaskFailedRequest(in *shared.RespondDecisionTaskFailedRequest) *cadencepb.RespondDecisionTaskFailedRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RespondDecisionTaskFailedRequest{
+		TaskToken: in.TaskToken,
+		Cause:     toProtoDecisionTaskFailedCause(in.Cause),
+		Details:   in.Details,
+		Identity:  in.Identity,
+	}
+}
+
+// ToThriftRespondDecisionTaskFailedRequest converts a proto RespondDecisionTaskFailedRequest to thrift
+func ToThriftRespondDecisionTaskFailedRequest(in *cadencepb.RespondDecisionTaskFailedRequest) *shared.RespondDecisionTaskFailedRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskFailedRequest{
+		TaskToken: in.TaskToken,
+		Cause:     toThriftDecisionTaskFailedCause(in.Cause),
+		Details:   in.Details,
+		Identity:  in.Identity,
+	}
+}
+
+func toProtoPollForActivityTaskRequest(in *shared.PollForActivityTaskRequest) *cadencepb.PollForActivityTaskRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.PollForActivityTaskRequest{
+		Domain:           in.Domain,
+		TaskList:         toProtoTaskList(in.TaskList),
+		Identity:         in.Identity,
+		TaskListMetadata: toProtoTaskListMetadata(in.TaskListMetadata),
+	}
+}
+
+// ToThriftPollForActivityTaskRequest converts a proto PollForActivityTaskRequest to thrift
+func ToThriftPollForActivityTaskRequest(in *cadencepb.PollForActivityTaskRequest) *shared.PollForActivityTaskRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.PollForActivityTaskRequest{
+		Domain:           in.Domain,
+		TaskList:         toThriftTaskList(in.TaskList),
+		Identity:         in.Identity,
+		TaskListMetadata: toThriftTaskListMetadata(in.TaskListMetadata),
+	}
+}
+
+// ToProtoPollForActivityTaskResponse converts a thrift PollForActivityTaskResponse to proto
+func ToProtoPollForActivityTaskResponse(in *shared.PollForActivityTaskResponse) *cadencepb.PollForActivityTaskResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.PollForActivityTaskResponse{
+		TaskToken:                       in.TaskToken,
+		WorkflowExecution:               toProtoWorkflowExecution(in.WorkflowExecution),
+		ActivityId:                      in.ActivityId,
+		ActivityType:                    toProtoActivityType(in.ActivityType),
+		Input:                           in.Input,
+		ScheduledTimestamp:              in.ScheduledTimestamp,
+		ScheduleToCloseTimeoutSeconds:   in.ScheduleToCloseTimeoutSeconds,
+		StartedTimestamp:                in.StartedTimestamp,
+		StartToCloseTimeoutSeconds:      in.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:         in.HeartbeatTimeoutSeconds,
+		Attempt:                         in.Attempt,
+		ScheduledTimestampOfThisAttempt: in.ScheduledTimestampOfThisAttempt,
+	}
+}
+
+func toThriftPollForActivityTaskResponse(in *cadencepb.PollForActivityTaskResponse) *shared.PollForActivityTaskResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.PollForActivityTaskResponse{
+		TaskToken:                       in.TaskToken,
+		WorkflowExecution:               toThriftWorkflowExecution(in.WorkflowExecution),
+		ActivityId:                      in.ActivityId,
+		ActivityType:                    toThriftActivityType(in.ActivityType),
+		Input:                           in.Input,
+		ScheduledTimestamp:              in.ScheduledTimestamp,
+		ScheduleToCloseTimeoutSeconds:   in.ScheduleToCloseTimeoutSeconds,
+		StartedTimestamp:                in.StartedTimestamp,
+		StartToCloseTimeoutSeconds:      in.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:         in.HeartbeatTimeoutSeconds,
+		Attempt:                         in.Attempt,
+		ScheduledTimestampOfThisAttempt: in.ScheduledTimestampOfThisAttempt,
+	}
+}
+
+func toProtoRecordActivityTaskHeartbeatRequest(in *shared.RecordActivityTaskHeartbeatRequest) *cadencepb.RecordActivityTaskHeartbeatRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RecordActivityTaskHeartbeatRequest{
+		TaskToken: in.TaskToken,
+		Details:   in.Details,
+		Identity:  in.Identity,
+	}
+}
+
+// ToThriftRecordActivityTaskHeartbeatRequest converts a proto RecordActivityTaskHeartbeatRequest to thrift
+func ToThriftRecordActivityTaskHeartbeatRequest(in *cadencepb.RecordActivityTaskHeartbeatRequest) *shared.RecordActivityTaskHeartbeatRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatRequest{
+		TaskToken: in.TaskToken,
+		Details:   in.Details,
+		Identity:  in.Identity,
+	}
+}
+
+func toProtoRecordActivityTaskHeartbeatByIDRequest(in *shared.RecordActivityTaskHeartbeatByIDRequest) *cadencepb.RecordActivityTaskHeartbeatByIDRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:     in.Domain,
+		WorkflowId: in.WorkflowID,
+		RunId:      in.RunID,
+		ActivityId: in.ActivityID,
+		Details:    in.Details,
+		Identity:   in.Identity,
+	}
+}
+
+// ToThriftRecordActivityTaskHeartbeatByIDRequest converts a proto RecordActivityTaskHeartbeatByIDRequest to thrift
+func ToThriftRecordActivityTaskHeartbeatByIDRequest(in *cadencepb.RecordActivityTaskHeartbeatByIDRequest) *shared.RecordActivityTaskHeartbeatByIDRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:     in.Domain,
+		WorkflowID: in.WorkflowId,
+		RunID:      in.RunId,
+		ActivityID: in.ActivityId,
+		Details:    in.Details,
+		Identity:   in.Identity,
+	}
+}
+
+// ToProtoRecordActivityTaskHeartbeatResponse converts a thrift RecordActivityTaskHeartbeatResponse to proto
+func ToProtoRecordActivityTaskHeartbeatResponse(in *shared.RecordActivityTaskHeartbeatResponse) *cadencepb.RecordActivityTaskHeartbeatResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RecordActivityTaskHeartbeatResponse{
+		CancelRequested: in.CancelRequested,
+	}
+}
+
+func toThriftRecordActivityTaskHeartbeatResponse(in *cadencepb.RecordActivityTaskHeartbeatResponse) *shared.RecordActivityTaskHeartbeatResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatResponse{
+		CancelRequested: in.CancelRequested,
+	}
+}
+
+func toProtoRespondActivityTaskCompletedRequest(in *shared.RespondActivityTaskCompletedRequest) *cadencepb.RespondActivityTaskCompletedRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RespondActivityTaskCompletedRequest{
+		TaskToken: in.TaskToken,
+		Result:    in.Result,
+		Identity:  in.Identity,
+	}
+}
+
+// ToThriftRespondActivityTaskCompletedRequest converts a proto RespondActivityTaskCompletedRequest to thrift
+func ToThriftRespondActivityTaskCompletedRequest(in *cadencepb.RespondActivityTaskCompletedRequest) *shared.RespondActivityTaskCompletedRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCompletedRequest{
+		TaskToken: in.TaskToken,
+		Result:    in.Result,
+		Identity:  in.Identity,
+	}
+}
+
+func toProtoRespondActivityTaskFailedRequest(in *shared.RespondActivityTaskFailedRequest) *cadencepb.RespondActivityTaskFailedRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RespondActivityTaskFailedRequest{
+		TaskToken: in.TaskToken,
+		Reason:    in.Reason,
+		Details:   in.Details,
+		Identity:  in.Identity,
+	}
+}
+
+// ToThriftRespondActivityTaskFailedRequest converts a proto RespondActivityTaskFailedRequest to thrift
+func ToThriftRespondActivityTaskFailedRequest(in *cadencepb.RespondActivityTaskFailedRequest) *shared.RespondActivityTaskFailedRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedRequest{
+		TaskToken: in.TaskToken,
+		Reason:    in.Reason,
+		Details:   in.Details,
+		Identity:  in.Identity,
+	}
+}
+
+func toProtoRespondActivityTaskCanceledRequest(in *shared.RespondActivityTaskCanceledRequest) *cadencepb.RespondActivityTaskCanceledRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RespondActivityTaskCanceledRequest{
+		TaskToken: in.TaskToken,
+		Details:   in.Details,
+		Identity:  in.Identity,
+	}
+}
+
+// ToThriftRespondActivityTaskCanceledRequest converts a proto RespondActivityTaskCanceledRequest to thrift
+func ToThriftRespondActivityTaskCanceledRequest(in *cadencepb.RespondActivityTaskCanceledRequest) *shared.RespondActivityTaskCanceledRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCanceledRequest{
+		TaskToken: in.TaskToken,
+		Details:   in.Details,
+		Identity:  in.Identity,
+	}
+}
+
+func toProtoRespondActivityTaskCompletedByIDRequest(in *shared.RespondActivityTaskCompletedByIDRequest) *cadencepb.RespondActivityTaskCompletedByIDRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RespondActivityTaskCompletedByIDRequest{
+		Domain:     in.Domain,
+		WorkflowId: in.WorkflowID,
+		RunId:      in.RunID,
+		ActivityId: in.ActivityID,
+		Result:     in.Result,
+		Identity:   in.Identity,
+	}
+}
+
+// ToThriftRespondActivityTaskCompletedByIDRequest converts a proto RespondActivityTaskCompletedByIDRequest to thrift
+func ToThriftRespondActivityTaskCompletedByIDRequest(in *cadencepb.RespondActivityTaskCompletedByIDRequest) *shared.RespondActivityTaskCompletedByIDRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCompletedByIDRequest{
+		Domain:     in.Domain,
+		WorkflowID: in.WorkflowId,
+		RunID:      in.RunId,
+		ActivityID: in.ActivityId,
+		Result:     in.Result,
+		Identity:   in.Identity,
+	}
+}
+
+func toProtoRespondActivityTaskFailedByIDRequest(in *shared.RespondActivityTaskFailedByIDRequest) *cadencepb.RespondActivityTaskFailedByIDRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RespondActivityTaskFailedByIDRequest{
+		Domain:     in.Domain,
+		WorkflowId: in.WorkflowID,
+		RunId:      in.RunID,
+		ActivityId: in.ActivityID,
+		Reason:     in.Reason,
+		Details:    in.Details,
+		Identity:   in.Identity,
+	}
+}
+
+// ToThriftRespondActivityTaskFailedByIDRequest converts a proto RespondActivityTaskFailedByIDRequest to thrift
+func ToThriftRespondActivityTaskFailedByIDRequest(in *cadencepb.RespondActivityTaskFailedByIDRequest) *shared.RespondActivityTaskFailedByIDRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedByIDRequest{
+		Domain:     in.Domain,
+		WorkflowID: in.WorkflowId,
+		RunID:      in.RunId,
+		ActivityID: in.ActivityId,
+		Reason:     in.Reason,
+		Details:    in.Details,
+		Identity:   in.Identity,
+	}
+}
+
+func toProtoRespondActivityTaskCanceledByIDRequest(in *shared.RespondActivityTaskCanceledByIDRequest) *cadencepb.RespondActivityTaskCanceledByIDRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RespondActivityTaskCanceledByIDRequest{
+		Domain:     in.Domain,
+		WorkflowId: in.WorkflowID,
+		RunId:      in.RunID,
+		ActivityId: in.ActivityID,
+		Details:    in.Details,
+		Identity:   in.Identity,
+	}
+}
+
+// ToThriftRespondActivityTaskCanceledByIDRequest converts a proto RespondActivityTaskCanceledByIDRequest to thrift
+func ToThriftRespondActivityTaskCanceledByIDRequest(in *cadencepb.RespondActivityTaskCanceledByIDRequest) *shared.RespondActivityTaskCanceledByIDRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCanceledByIDRequest{
+		Domain:     in.Domain,
+		WorkflowID: in.WorkflowId,
+		RunID:      in.RunId,
+		ActivityID: in.ActivityId,
+		Details:    in.Details,
+		Identity:   in.Identity,
+	}
+}
+
+func toProtoRequestCancelWorkflowExecutionRequest(in *shared.RequestCancelWorkflowExecutionRequest) *cadencepb.RequestCancelWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RequestCancelWorkflowExecutionRequest{
+		Domain:            in.Domain,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+		Identity:          in.Identity,
+		RequestId:         in.RequestId,
+	}
+}
+
+// ToThriftRequestCancelWorkflowExecutionRequest converts a proto RequestCancelWorkflowExecutionRequest to thrift
+func ToThriftRequestCancelWorkflowExecutionRequest(in *cadencepb.RequestCancelWorkflowExecutionRequest) *shared.RequestCancelWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RequestCancelWorkflowExecutionRequest{
+		Domain:            in.Domain,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+		Identity:          in.Identity,
+		RequestId:         in.RequestId,
+	}
+}
+
+func toProtoGetWorkflowExecutionHistoryRequest(in *shared.GetWorkflowExecutionHistoryRequest) *cadencepb.GetWorkflowExecutionHistoryRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.GetWorkflowExecutionHistoryRequest{
+		Domain:                 in.Domain,
+		Execution:              toProtoWorkflowExecution(in.Execution),
+		MaximumPageSize:        in.MaximumPageSize,
+		NextPageToken:          in.NextPageToken,
+		WaitForNewEvent:        in.WaitForNewEvent,
+		HistoryEventFilterType: toProtoHistoryEventFilterType(in.HistoryEventFilterType),
+	}
+}
+
+// ToThriftGetWorkflowExecutionHistoryRequest converts a proto GetWorkflowExecutionHistoryRequest to thrift
+func ToThriftGetWorkflowExecutionHistoryRequest(in *cadencepb.GetWorkflowExecutionHistoryRequest) *shared.GetWorkflowExecutionHistoryRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:                 in.Domain,
+		Execution:              toThriftWorkflowExecution(in.Execution),
+		MaximumPageSize:        in.MaximumPageSize,
+		NextPageToken:          in.NextPageToken,
+		WaitForNewEvent:        in.WaitForNewEvent,
+		HistoryEventFilterType: toThriftHistoryEventFilterType(in.HistoryEventFilterType),
+	}
+}
+
+// ToProtoGetWorkflowExecutionHistoryResponse converts a thrift GetWorkflowExecutionHistoryResponse to proto
+func ToProtoGetWorkflowExecutionHistoryResponse(in *shared.GetWorkflowExecutionHistoryResponse) *cadencepb.GetWorkflowExecutionHistoryResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.GetWorkflowExecutionHistoryResponse{
+		History:       toProtoHistory(in.History),
+		NextPageToken: in.NextPageToken,
+	}
+}
+
+func toThriftGetWorkflowExecutionHistoryResponse(in *cadencepb.GetWorkflowExecutionHistoryResponse) *shared.GetWorkflowExecutionHistoryResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionHistoryResponse{
+		History:       toThriftHistory(in.History),
+		NextPageToken: in.NextPageToken,
+	}
+}
+
+func toProtoSignalWorkflowExecutionRequest(in *shared.SignalWorkflowExecutionRequest) *cadencepb.SignalWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.SignalWorkflowExecutionRequest{
+		Domain:            in.Domain,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+		SignalName:        in.SignalName,
+		Input:             in.Input,
+		Identity:          in.Identity,
+		RequestId:         in.RequestId,
+		Control:           in.Control,
+	}
+}
+
+// ToThriftSignalWorkflowExecutionRequest converts a proto SignalWorkflowExecutionRequest to thrift
+func ToThriftSignalWorkflowExecutionRequest(in *cadencepb.SignalWorkflowExecutionRequest) *shared.SignalWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.SignalWorkflowExecutionRequest{
+		Domain:            in.Domain,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+		SignalName:        in.SignalName,
+		Input:             in.Input,
+		Identity:          in.Identity,
+		RequestId:         in.RequestId,
+		Control:           in.Control,
+	}
+}
+
+func toProtoSignalWithStartWorkflowExecutionRequest(in *shared.SignalWithStartWorkflowExecutionRequest) *cadencepb.SignalWithStartWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              in.Domain,
+		WorkflowId:                          in.WorkflowId,
+		WorkflowType:                        toProtoWorkflowType(in.WorkflowType),
+		TaskList:                            toProtoTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		Identity:                            in.Identity,
+		RequestId:                           in.RequestId,
+		WorkflowIdReusePolicy:               toProtoWorkflowIdReusePolicy(in.WorkflowIdReusePolicy),
+		SignalName:                          in.SignalName,
+		SignalInput:                         in.SignalInput,
+		Control:                             in.Control,
+		RetryPolicy:                         toProtoRetryPolicy(in.RetryPolicy),
+		CronSchedule:                        in.CronSchedule,
+		SearchAttributes:                    toProtoSearchAttributes(in.SearchAttributes),
+	}
+}
+
+// ToThriftSignalWithStartWorkflowExecutionRequest converts a proto SignalWithStartWorkflowExecutionRequest to thrift
+func ToThriftSignalWithStartWorkflowExecutionRequest(in *cadencepb.SignalWithStartWorkflowExecutionRequest) *shared.SignalWithStartWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              in.Domain,
+		WorkflowId:                          in.WorkflowId,
+		WorkflowType:                        toThriftWorkflowType(in.WorkflowType),
+		TaskList:                            toThriftTaskList(in.TaskList),
+		Input:                               in.Input,
+		ExecutionStartToCloseTimeoutSeconds: in.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      in.TaskStartToCloseTimeoutSeconds,
+		Identity:                            in.Identity,
+		RequestId:                           in.RequestId,
+		WorkflowIdReusePolicy:               toThriftWorkflowIdReusePolicy(in.WorkflowIdReusePolicy),
+		SignalName:                          in.SignalName,
+		SignalInput:                         in.SignalInput,
+		Control:                             in.Control,
+		RetryPolicy:                         toThriftRetryPolicy(in.RetryPolicy),
+		CronSchedule:                        in.CronSchedule,
+		SearchAttributes:                    toThriftSearchAttributes(in.SearchAttributes),
+	}
+}
+
+func toProtoTerminateWorkflowExecutionRequest(in *shared.TerminateWorkflowExecutionRequest) *cadencepb.TerminateWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.TerminateWorkflowExecutionRequest{
+		Domain:            in.Domain,
+		WorkflowExecution: toProtoWorkflowExecution(in.WorkflowExecution),
+		Reason:            in.Reason,
+		Details:           in.Details,
+		Identity:          in.Identity,
+	}
+}
+
+// ToThriftTerminateWorkflowExecutionRequest converts a proto TerminateWorkflowExecutionRequest to thrift
+func ToThriftTerminateWorkflowExecutionRequest(in *cadencepb.TerminateWorkflowExecutionRequest) *shared.TerminateWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.TerminateWorkflowExecutionRequest{
+		Domain:            in.Domain,
+		WorkflowExecution: toThriftWorkflowExecution(in.WorkflowExecution),
+		Reason:            in.Reason,
+		Details:           in.Details,
+		Identity:          in.Identity,
+	}
+}
+
+func toProtoResetWorkflowExecutionRequest(in *shared.ResetWorkflowExecutionRequest) *cadencepb.ResetWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ResetWorkflowExecutionRequest{
+		Domain:                in.Domain,
+		WorkflowExecution:     toProtoWorkflowExecution(in.WorkflowExecution),
+		Reason:                in.Reason,
+		DecisionFinishEventId: in.DecisionFinishEventId,
+		RequestId:             in.RequestId,
+		Identity:              in.Identity,
+	}
+}
+
+// ToThriftResetWorkflowExecutionRequest converts a proto ResetWorkflowExecutionRequest to thrift
+func ToThriftResetWorkflowExecutionRequest(in *cadencepb.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.ResetWorkflowExecutionRequest{
+		Domain:                in.Domain,
+		WorkflowExecution:     toThriftWorkflowExecution(in.WorkflowExecution),
+		Reason:                in.Reason,
+		DecisionFinishEventId: in.DecisionFinishEventId,
+		RequestId:             in.RequestId,
+		Identity:              in.Identity,
+	}
+}
+
+// ToProtoResetWorkflowExecutionResponse converts a thrift ResetWorkflowExecutionResponse to proto
+func ToProtoResetWorkflowExecutionResponse(in *shared.ResetWorkflowExecutionResponse) *cadencepb.ResetWorkflowExecutionResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ResetWorkflowExecutionResponse{
+		RunId: in.RunId,
+	}
+}
+
+func toThriftResetWorkflowExecutionResponse(in *cadencepb.ResetWorkflowExecutionResponse) *shared.ResetWorkflowExecutionResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.ResetWorkflowExecutionResponse{
+		RunId: in.RunId,
+	}
+}
+
+func toProtoListOpenWorkflowExecutionsRequest(in *shared.ListOpenWorkflowExecutionsRequest) *cadencepb.ListOpenWorkflowExecutionsRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ListOpenWorkflowExecutionsRequest{
+		Domain:                 in.Domain,
+		MaximumPageSize:        in.MaximumPageSize,
+		NextPageToken:          in.NextPageToken,
+		StartTimeFilter:        toProtoStartTimeFilter(in.StartTimeFilter),
+		ExecutionFilter:        toProtoWorkflowExecutionFilter(in.ExecutionFilter),
+		TypeFilter:             toProtoWorkflowTypeFilter(in.TypeFilter),
+		SearchAttributesFilter: toProtoSearchAttributes(in.SearchAttributesFilter),
+	}
+}
+
+// ToThriftListOpenWorkflowExecutionsRequest converts a proto ListOpenWorkflowExecutionsRequest to thrift
+func ToThriftListOpenWorkflowExecutionsRequest(in *cadencepb.ListOpenWorkflowExecutionsRequest) *shared.ListOpenWorkflowExecutionsRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.ListOpenWorkflowExecutionsRequest{
+		Domain:                 in.Domain,
+		MaximumPageSize:        in.MaximumPageSize,
+		NextPageToken:          in.NextPageToken,
+		StartTimeFilter:        toThriftStartTimeFilter(in.StartTimeFilter),
+		ExecutionFilter:        toThriftWorkflowExecutionFilter(in.ExecutionFilter),
+		TypeFilter:             toThriftWorkflowTypeFilter(in.TypeFilter),
+		SearchAttributesFilter: toThriftSearchAttributes(in.SearchAttributesFilter),
+	}
+}
+
+// ToProtoListOpenWorkflowExecutionsResponse converts a thrift ListOpenWorkflowExecutionsResponse to proto
+func ToProtoListOpenWorkflowExecutionsResponse(in *shared.ListOpenWorkflowExecutionsResponse) *cadencepb.ListOpenWorkflowExecutionsResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ListOpenWorkflowExecutionsResponse{
+		Executions:    toProtoWorkflowExecutionInfoArray(in.Executions),
+		NextPageToken: in.NextPageToken,
+	}
+}
+
+func toThriftListOpenWorkflowExecutionsResponse(in *cadencepb.ListOpenWorkflowExecutionsResponse) *shared.ListOpenWorkflowExecutionsResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.ListOpenWorkflowExecutionsResponse{
+		Executions:    toThriftWorkflowExecutionInfoArray(in.Executions),
+		NextPageToken: in.NextPageToken,
+	}
+}
+
+func toProtoListClosedWorkflowExecutionsRequest(in *shared.ListClosedWorkflowExecutionsRequest) *cadencepb.ListClosedWorkflowExecutionsRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ListClosedWorkflowExecutionsRequest{
+		Domain:                 in.Domain,
+		MaximumPageSize:        in.MaximumPageSize,
+		NextPageToken:          in.NextPageToken,
+		StartTimeFilter:        toProtoStartTimeFilter(in.StartTimeFilter),
+		ExecutionFilter:        toProtoWorkflowExecutionFilter(in.ExecutionFilter),
+		TypeFilter:             toProtoWorkflowTypeFilter(in.TypeFilter),
+		StatusFilter:           toProtoWorkflowExecutionCloseStatus(in.StatusFilter),
+		SearchAttributesFilter: toProtoSearchAttributes(in.SearchAttributesFilter),
+	}
+}
+
+// ToThriftListClosedWorkflowExecutionsRequest converts a proto ListClosedWorkflowExecutionsRequest to thrift
+func ToThriftListClosedWorkflowExecutionsRequest(in *cadencepb.ListClosedWorkflowExecutionsRequest) *shared.ListClosedWorkflowExecutionsRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.ListClosedWorkflowExecutionsRequest{
+		Domain:                 in.Domain,
+		MaximumPageSize:        in.MaximumPageSize,
+		NextPageToken:          in.NextPageToken,
+		StartTimeFilter:        toThriftStartTimeFilter(in.StartTimeFilter),
+		ExecutionFilter:        toThriftWorkflowExecutionFilter(in.ExecutionFilter),
+		TypeFilter:             toThriftWorkflowTypeFilter(in.TypeFilter),
+		StatusFilter:           toThriftWorkflowExecutionCloseStatus(in.StatusFilter),
+		SearchAttributesFilter: toThriftSearchAttributes(in.SearchAttributesFilter),
+	}
+}
+
+// ToProtoListClosedWorkflowExecutionsResponse converts a thrift ListClosedWorkflowExecutionsResponse to proto
+func ToProtoListClosedWorkflowExecutionsResponse(in *shared.ListClosedWorkflowExecutionsResponse) *cadencepb.ListClosedWorkflowExecutionsResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ListClosedWorkflowExecutionsResponse{
+		Executions:    toProtoWorkflowExecutionInfoArray(in.Executions),
+		NextPageToken: in.NextPageToken,
+	}
+}
+
+func toThriftListClosedWorkflowExecutionsResponse(in *cadencepb.ListClosedWorkflowExecutionsResponse) *shared.ListClosedWorkflowExecutionsResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.ListClosedWorkflowExecutionsResponse{
+		Executions:    toThriftWorkflowExecutionInfoArray(in.Executions),
+		NextPageToken: in.NextPageToken,
+	}
+}
+
+func toProtoListWorkflowExecutionsRequest(in *shared.ListWorkflowExecutionsRequest) *cadencepb.ListWorkflowExecutionsRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ListWorkflowExecutionsRequest{
+		Domain:        in.Domain,
+		PageSize:      in.PageSize,
+		NextPageToken: in.NextPageToken,
+		Query:         in.Query,
+	}
+}
+
+// ToThriftListWorkflowExecutionsRequest converts a proto ListWorkflowExecutionsRequest to thrift
+func ToThriftListWorkflowExecutionsRequest(in *cadencepb.ListWorkflowExecutionsRequest) *shared.ListWorkflowExecutionsRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.ListWorkflowExecutionsRequest{
+		Domain:        in.Domain,
+		PageSize:      in.PageSize,
+		NextPageToken: in.NextPageToken,
+		Query:         in.Query,
+	}
+}
+
+// ToProtoListWorkflowExecutionsResponse converts a thrift ListWorkflowExecutionsResponse to proto
+func ToProtoListWorkflowExecutionsResponse(in *shared.ListWorkflowExecutionsResponse) *cadencepb.ListWorkflowExecutionsResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.ListWorkflowExecutionsResponse{
+		Executions:    toProtoWorkflowExecutionInfoArray(in.Executions),
+		NextPageToken: in.NextPageToken,
+	}
+}
+
+func toThriftListWorkflowExecutionsResponse(in *cadencepb.ListWorkflowExecutionsResponse) *shared.ListWorkflowExecutionsResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.ListWorkflowExecutionsResponse{
+		Executions:    toThriftWorkflowExecutionInfoArray(in.Executions),
+		NextPageToken: in.NextPageToken,
+	}
+}
+
+func toProtoCountWorkflowExecutionsRequest(in *shared.CountWorkflowExecutionsRequest) *cadencepb.CountWorkflowExecutionsRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.CountWorkflowExecutionsRequest{
+		Domain:  in.Domain,
+		Query:   in.Query,
+		GroupBy: toProtoCountWorkflowExecutionsGroupBy(in.GroupBy),
+	}
+}
+
+// ToThriftCountWorkflowExecutionsRequest converts a proto CountWorkflowExecutionsRequest to thrift
+func ToThriftCountWorkflowExecutionsRequest(in *cadencepb.CountWorkflowExecutionsRequest) *shared.CountWorkflowExecutionsRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.CountWorkflowExecutionsRequest{
+		Domain:  in.Domain,
+		Query:   in.Query,
+		GroupBy: toThriftCountWorkflowExecutionsGroupBy(in.GroupBy),
+	}
+}
+
+func toProtoCountWorkflowExecutionsGroup(in *shared.CountWorkflowExecutionsGroup) *cadencepb.CountWorkflowExecutionsGroup {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.CountWorkflowExecutionsGroup{
+		WorkflowType: in.WorkflowType,
+		CloseStatus:  toProtoWorkflowExecutionCloseStatus(in.CloseStatus),
+		Count:        in.Count,
+	}
+}
+
+func toProtoCountWorkflowExecutionsGroupArray(in []*shared.CountWorkflowExecutionsGroup) []*cadencepb.CountWorkflowExecutionsGroup {
+	if in == nil {
+		return nil
+	}
+	out := make([]*cadencepb.CountWorkflowExecutionsGroup, len(in))
+	for i, v := range in {
+		out[i] = toProtoCountWorkflowExecutionsGroup(v)
+	}
+	return out
+}
+
+func toThriftCountWorkflowExecutionsGroup(in *cadencepb.CountWorkflowExecutionsGroup) *shared.CountWorkflowExecutionsGroup {
+	if in == nil {
+		return nil
+	}
+	return &shared.CountWorkflowExecutionsGroup{
+		WorkflowType: in.WorkflowType,
+		CloseStatus:  toThriftWorkflowExecutionCloseStatus(in.CloseStatus),
+		Count:        in.Count,
+	}
+}
+
+func toThriftCountWorkflowExecutionsGroupArray(in []*cadencepb.CountWorkflowExecutionsGroup) []*shared.CountWorkflowExecutionsGroup {
+	if in == nil {
+		return nil
+	}
+	out := make([]*shared.CountWorkflowExecutionsGroup, len(in))
+	for i, v := range in {
+		out[i] = toThriftCountWorkflowExecutionsGroup(v)
+	}
+	return out
+}
+
+// ToProtoCountWorkflowExecutionsResponse converts a thrift CountWorkflowExecutionsResponse to proto
+func ToProtoCountWorkflowExecutionsResponse(in *shared.CountWorkflowExecutionsResponse) *cadencepb.CountWorkflowExecutionsResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.CountWorkflowExecutionsResponse{
+		Count:  in.Count,
+		Groups: toProtoCountWorkflowExecutionsGroupArray(in.Groups),
+	}
+}
+
+func toThriftCountWorkflowExecutionsResponse(in *cadencepb.CountWorkflowExecutionsResponse) *shared.CountWorkflowExecutionsResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.CountWorkflowExecutionsResponse{
+		Count:  in.Count,
+		Groups: toThriftCountWorkflowExecutionsGroupArray(in.Groups),
+	}
+}
+
+func toProtoQueryWorkflowRequest(in *shared.QueryWorkflowRequest) *cadencepb.QueryWorkflowRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.QueryWorkflowRequest{
+		Domain:    in.Domain,
+		Execution: toProtoWorkflowExecution(in.Execution),
+		Query:     toProtoWorkflowQuery(in.Query),
+	}
+}
+
+// ToThriftQueryWorkflowRequest converts a proto QueryWorkflowRequest to thrift
+func ToThriftQueryWorkflowRequest(in *cadencepb.QueryWorkflowRequest) *shared.QueryWorkflowRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.QueryWorkflowRequest{
+		Domain:    in.Domain,
+		Execution: toThriftWorkflowExecution(in.Execution),
+		Query:     toThriftWorkflowQuery(in.Query),
+	}
+}
+
+// ToProtoQueryWorkflowResponse converts a thrift QueryWorkflowResponse to proto
+func ToProtoQueryWorkflowResponse(in *shared.QueryWorkflowResponse) *cadencepb.QueryWorkflowResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.QueryWorkflowResponse{
+		QueryResult: in.QueryResult,
+	}
+}
+
+func toThriftQueryWorkflowResponse(in *cadencepb.QueryWorkflowResponse) *shared.QueryWorkflowResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.QueryWorkflowResponse{
+		QueryResult: in.QueryResult,
+	}
+}
+
+func toProtoWorkflowQuery(in *shared.WorkflowQuery) *cadencepb.WorkflowQuery {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.WorkflowQuery{
+		QueryType: in.QueryType,
+		QueryArgs: in.QueryArgs,
+	}
+}
+
+func toThriftWorkflowQuery(in *cadencepb.WorkflowQuery) *shared.WorkflowQuery {
+	if in == nil {
+		return nil
+	}
+	return &shared.WorkflowQuery{
+		QueryType: in.QueryType,
+		QueryArgs: in.QueryArgs,
+	}
+}
+
+func toProtoRespondQueryTaskCompletedRequest(in *shared.RespondQueryTaskCompletedRequest) *cadencepb.RespondQueryTaskCompletedRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RespondQueryTaskCompletedRequest{
+		TaskToken:     in.TaskToken,
+		CompletedType: toProtoQueryTaskCompletedType(in.CompletedType),
+		QueryResult:   in.QueryResult,
+		ErrorMessage:  in.ErrorMessage,
+	}
+}
+
+// ToThriftRespondQueryTaskCompletedRequest converts a proto RespondQueryTaskCompletedRequest to thrift
+func ToThriftRespondQueryTaskCompletedRequest(in *cadencepb.RespondQueryTaskCompletedRequest) *shared.RespondQueryTaskCompletedRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.RespondQueryTaskCompletedRequest{
+		TaskToken:     in.TaskToken,
+		CompletedType: toThriftQueryTaskCompletedType(in.CompletedType),
+		QueryResult:   in.QueryResult,
+		ErrorMessage:  in.ErrorMessage,
+	}
+}
+
+func toProtoDescribeWorkflowExecutionRequest(in *shared.DescribeWorkflowExecutionRequest) *cadencepb.DescribeWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DescribeWorkflowExecutionRequest{
+		Domain:    in.Domain,
+		Execution: toProtoWorkflowExecution(in.Execution),
+	}
+}
+
+// ToThriftDescribeWorkflowExecutionRequest converts a proto DescribeWorkflowExecutionRequest to thrift
+func ToThriftDescribeWorkflowExecutionRequest(in *cadencepb.DescribeWorkflowExecutionRequest) *shared.DescribeWorkflowExecutionRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionRequest{
+		Domain:    in.Domain,
+		Execution: toThriftWorkflowExecution(in.Execution),
+	}
+}
+
+func toProtoPendingActivityInfo(in *shared.PendingActivityInfo) *cadencepb.PendingActivityInfo {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.PendingActivityInfo{
+		ActivityId:             in.ActivityID,
+		ActivityType:           toProtoActivityType(in.ActivityType),
+		State:                  toProtoPendingActivityState(in.State),
+		HeartbeatDetails:       in.HeartbeatDetails,
+		LastHeartbeatTimestamp: in.LastHeartbeatTimestamp,
+	}
+}
+
+func toProtoPendingActivityInfoArray(in []*shared.PendingActivityInfo) []*cadencepb.PendingActivityInfo {
+	if in == nil {
+		return nil
+	}
+	out := make([]*cadencepb.PendingActivityInfo, len(in))
+	for i, v := range in {
+		out[i] = toProtoPendingActivityInfo(v)
+	}
+	return out
+}
+
+func toThriftPendingActivityInfo(in *cadencepb.PendingActivityInfo) *shared.PendingActivityInfo {
+	if in == nil {
+		return nil
+	}
+	return &shared.PendingActivityInfo{
+		ActivityID:             in.ActivityId,
+		ActivityType:           toThriftActivityType(in.ActivityType),
+		State:                  toThriftPendingActivityState(in.State),
+		HeartbeatDetails:       in.HeartbeatDetails,
+		LastHeartbeatTimestamp: in.LastHeartbeatTimestamp,
+	}
+}
+
+func toThriftPendingActivityInfoArray(in []*cadencepb.PendingActivityInfo) []*shared.PendingActivityInfo {
+	if in == nil {
+		return nil
+	}
+	out := make([]*shared.PendingActivityInfo, len(in))
+	for i, v := range in {
+		out[i] = toThriftPendingActivityInfo(v)
+	}
+	return out
+}
+
+// ToProtoDescribeWorkflowExecutionResponse converts a thrift DescribeWorkflowExecutionResponse to proto
+func ToProtoDescribeWorkflowExecutionResponse(in *shared.DescribeWorkflowExecutionResponse) *cadencepb.DescribeWorkflowExecutionResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: toProtoWorkflowExecutionConfiguration(in.ExecutionConfiguration),
+		WorkflowExecutionInfo:  toProtoWorkflowExecutionInfo(in.WorkflowExecutionInfo),
+		PendingActivities:      toProtoPendingActivityInfoArray(in.PendingActivities),
+	}
+}
+
+func toThriftDescribeWorkflowExecutionResponse(in *cadencepb.DescribeWorkflowExecutionResponse) *shared.DescribeWorkflowExecutionResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: toThriftWorkflowExecutionConfiguration(in.ExecutionConfiguration),
+		WorkflowExecutionInfo:  toThriftWorkflowExecutionInfo(in.WorkflowExecutionInfo),
+		PendingActivities:      toThriftPendingActivityInfoArray(in.PendingActivities),
+	}
+}
+
+func toProtoDescribeTaskListRequest(in *shared.DescribeTaskListRequest) *cadencepb.DescribeTaskListRequest {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DescribeTaskListRequest{
+		Domain:       in.Domain,
+		TaskList:     toProtoTaskList(in.TaskList),
+		TaskListType: toProtoTaskListType(in.TaskListType),
+	}
+}
+
+// ToThriftDescribeTaskListRequest converts a proto DescribeTaskListRequest to thrift
+func ToThriftDescribeTaskListRequest(in *cadencepb.DescribeTaskListRequest) *shared.DescribeTaskListRequest {
+	if in == nil {
+		return nil
+	}
+	return &shared.DescribeTaskListRequest{
+		Domain:       in.Domain,
+		TaskList:     toThriftTaskList(in.TaskList),
+		TaskListType: toThriftTaskListType(in.TaskListType),
+	}
+}
+
+// ToProtoDescribeTaskListResponse converts a thrift DescribeTaskListResponse to proto
+func ToProtoDescribeTaskListResponse(in *shared.DescribeTaskListResponse) *cadencepb.DescribeTaskListResponse {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.DescribeTaskListResponse{
+		Pollers: toProtoPollerInfoArray(in.Pollers),
+	}
+}
+
+func toThriftDescribeTaskListResponse(in *cadencepb.DescribeTaskListResponse) *shared.DescribeTaskListResponse {
+	if in == nil {
+		return nil
+	}
+	return &shared.DescribeTaskListResponse{
+		Pollers: toThriftPollerInfoArray(in.Pollers),
+	}
+}
+
+func toProtoPollerInfo(in *shared.PollerInfo) *cadencepb.PollerInfo {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.PollerInfo{
+		LastAccessTime: in.LastAccessTime,
+		Identity:       in.Identity,
+	}
+}
+
+func toProtoPollerInfoArray(in []*shared.PollerInfo) []*cadencepb.PollerInfo {
+	if in == nil {
+		return nil
+	}
+	out := make([]*cadencepb.PollerInfo, len(in))
+	for i, v := range in {
+		out[i] = toProtoPollerInfo(v)
+	}
+	return out
+}
+
+func toThriftPollerInfo(in *cadencepb.PollerInfo) *shared.PollerInfo {
+	if in == nil {
+		return nil
+	}
+	return &shared.PollerInfo{
+		LastAccessTime: in.LastAccessTime,
+		Identity:       in.Identity,
+	}
+}
+
+func toThriftPollerInfoArray(in []*cadencepb.PollerInfo) []*shared.PollerInfo {
+	if in == nil {
+		return nil
+	}
+	out := make([]*shared.PollerInfo, len(in))
+	for i, v := range in {
+		out[i] = toThriftPollerInfo(v)
+	}
+	return out
+}
+
+func toProtoRetryPolicy(in *shared.RetryPolicy) *cadencepb.RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	return &cadencepb.RetryPolicy{
+		InitialIntervalInSeconds:    in.InitialIntervalInSeconds,
+		BackoffCoefficient:          in.BackoffCoefficient,
+		MaximumIntervalInSeconds:    in.MaximumIntervalInSeconds,
+		MaximumAttempts:             in.MaximumAttempts,
+		NonRetriableErrorReasons:    in.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: in.ExpirationIntervalInSeconds,
+	}
+}
+
+func toThriftRetryPolicy(in *cadencepb.RetryPolicy) *shared.RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	return &shared.RetryPolicy{
+		InitialIntervalInSeconds:    in.InitialIntervalInSeconds,
+		BackoffCoefficient:          in.BackoffCoefficient,
+		MaximumIntervalInSeconds:    in.MaximumIntervalInSeconds,
+		MaximumAttempts:             in.MaximumAttempts,
+		NonRetriableErrorReasons:    in.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: in.ExpirationIntervalInSeconds,
+	}
+}
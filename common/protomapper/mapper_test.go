@@ -0,0 +1,591 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protomapper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/proto/cadencepb"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	mapperSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+
+	roundTripCase struct {
+		thrift   interface{}
+		proto    proto.Message
+		toProto  func(interface{}) proto.Message
+		toThrift func(proto.Message) interface{}
+	}
+)
+
+func TestMapperSuite(t *testing.T) {
+	suite.Run(t, new(mapperSuite))
+}
+
+func (s *mapperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+// TestRoundTrip sets every field of the requests and responses of the WorkflowService, sends them through
+// the proto wire format and expects the same thrift values back
+func (s *mapperSuite) TestRoundTrip() {
+	for _, tc := range s.roundTripCases() {
+		fillValue(reflect.ValueOf(tc.thrift).Elem())
+
+		data, err := proto.Marshal(tc.toProto(tc.thrift))
+		s.NoError(err)
+		s.NoError(proto.Unmarshal(data, tc.proto))
+		s.Equal(tc.thrift, tc.toThrift(tc.proto), "%T", tc.thrift)
+	}
+}
+
+func (s *mapperSuite) TestNil() {
+	for _, tc := range s.roundTripCases() {
+		s.Nil(tc.toProto(reflect.Zero(reflect.TypeOf(tc.thrift)).Interface()))
+		s.Nil(tc.toThrift(reflect.Zero(reflect.TypeOf(tc.proto)).Interface().(proto.Message)))
+	}
+}
+
+func (s *mapperSuite) TestEmptyFieldsStayUnset() {
+	request := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("domain"),
+		WorkflowId: common.StringPtr("workflowID"),
+	}
+	data, err := proto.Marshal(toProtoStartWorkflowExecutionRequest(request))
+	s.NoError(err)
+	protoRequest := &cadencepb.StartWorkflowExecutionRequest{}
+	s.NoError(proto.Unmarshal(data, protoRequest))
+	s.Nil(protoRequest.WorkflowIdReusePolicy)
+	s.Nil(protoRequest.ExecutionStartToCloseTimeoutSeconds)
+	s.Equal(request, ToThriftStartWorkflowExecutionRequest(protoRequest))
+}
+
+func (s *mapperSuite) roundTripCases() []roundTripCase {
+	return []roundTripCase{
+		{
+			thrift: &shared.RegisterDomainRequest{},
+			proto:  &cadencepb.RegisterDomainRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRegisterDomainRequest(in.(*shared.RegisterDomainRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRegisterDomainRequest(in.(*cadencepb.RegisterDomainRequest))
+			},
+		},
+		{
+			thrift: &shared.DescribeDomainRequest{},
+			proto:  &cadencepb.DescribeDomainRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoDescribeDomainRequest(in.(*shared.DescribeDomainRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftDescribeDomainRequest(in.(*cadencepb.DescribeDomainRequest))
+			},
+		},
+		{
+			thrift: &shared.DescribeDomainResponse{},
+			proto:  &cadencepb.DescribeDomainResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoDescribeDomainResponse(in.(*shared.DescribeDomainResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftDescribeDomainResponse(in.(*cadencepb.DescribeDomainResponse))
+			},
+		},
+		{
+			thrift: &shared.UpdateDomainRequest{},
+			proto:  &cadencepb.UpdateDomainRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoUpdateDomainRequest(in.(*shared.UpdateDomainRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftUpdateDomainRequest(in.(*cadencepb.UpdateDomainRequest))
+			},
+		},
+		{
+			thrift: &shared.UpdateDomainResponse{},
+			proto:  &cadencepb.UpdateDomainResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoUpdateDomainResponse(in.(*shared.UpdateDomainResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftUpdateDomainResponse(in.(*cadencepb.UpdateDomainResponse))
+			},
+		},
+		{
+			thrift: &shared.DeprecateDomainRequest{},
+			proto:  &cadencepb.DeprecateDomainRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoDeprecateDomainRequest(in.(*shared.DeprecateDomainRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftDeprecateDomainRequest(in.(*cadencepb.DeprecateDomainRequest))
+			},
+		},
+		{
+			thrift: &shared.StartWorkflowExecutionRequest{},
+			proto:  &cadencepb.StartWorkflowExecutionRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoStartWorkflowExecutionRequest(in.(*shared.StartWorkflowExecutionRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftStartWorkflowExecutionRequest(in.(*cadencepb.StartWorkflowExecutionRequest))
+			},
+		},
+		{
+			thrift: &shared.StartWorkflowExecutionResponse{},
+			proto:  &cadencepb.StartWorkflowExecutionResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoStartWorkflowExecutionResponse(in.(*shared.StartWorkflowExecutionResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftStartWorkflowExecutionResponse(in.(*cadencepb.StartWorkflowExecutionResponse))
+			},
+		},
+		{
+			thrift: &shared.GetWorkflowExecutionHistoryRequest{},
+			proto:  &cadencepb.GetWorkflowExecutionHistoryRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoGetWorkflowExecutionHistoryRequest(in.(*shared.GetWorkflowExecutionHistoryRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftGetWorkflowExecutionHistoryRequest(in.(*cadencepb.GetWorkflowExecutionHistoryRequest))
+			},
+		},
+		{
+			thrift: &shared.GetWorkflowExecutionHistoryResponse{},
+			proto:  &cadencepb.GetWorkflowExecutionHistoryResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoGetWorkflowExecutionHistoryResponse(in.(*shared.GetWorkflowExecutionHistoryResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftGetWorkflowExecutionHistoryResponse(in.(*cadencepb.GetWorkflowExecutionHistoryResponse))
+			},
+		},
+		{
+			thrift: &shared.PollForDecisionTaskRequest{},
+			proto:  &cadencepb.PollForDecisionTaskRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoPollForDecisionTaskRequest(in.(*shared.PollForDecisionTaskRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftPollForDecisionTaskRequest(in.(*cadencepb.PollForDecisionTaskRequest))
+			},
+		},
+		{
+			thrift: &shared.PollForDecisionTaskResponse{},
+			proto:  &cadencepb.PollForDecisionTaskResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoPollForDecisionTaskResponse(in.(*shared.PollForDecisionTaskResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftPollForDecisionTaskResponse(in.(*cadencepb.PollForDecisionTaskResponse))
+			},
+		},
+		{
+			thrift: &shared.RespondDecisionTaskCompletedRequest{},
+			proto:  &cadencepb.RespondDecisionTaskCompletedRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRespondDecisionTaskCompletedRequest(in.(*shared.RespondDecisionTaskCompletedRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRespondDecisionTaskCompletedRequest(in.(*cadencepb.RespondDecisionTaskCompletedRequest))
+			},
+		},
+		{
+			thrift: &shared.RespondDecisionTaskFailedRequest{},
+			proto:  &cadencepb.RespondDecisionTaskFailedRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRespondDecisionTaskFailedRequest(in.(*shared.RespondDecisionTaskFailedRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRespondDecisionTaskFailedRequest(in.(*cadencepb.RespondDecisionTaskFailedRequest))
+			},
+		},
+		{
+			thrift: &shared.PollForActivityTaskRequest{},
+			proto:  &cadencepb.PollForActivityTaskRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoPollForActivityTaskRequest(in.(*shared.PollForActivityTaskRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftPollForActivityTaskRequest(in.(*cadencepb.PollForActivityTaskRequest))
+			},
+		},
+		{
+			thrift: &shared.PollForActivityTaskResponse{},
+			proto:  &cadencepb.PollForActivityTaskResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoPollForActivityTaskResponse(in.(*shared.PollForActivityTaskResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftPollForActivityTaskResponse(in.(*cadencepb.PollForActivityTaskResponse))
+			},
+		},
+		{
+			thrift: &shared.RecordActivityTaskHeartbeatRequest{},
+			proto:  &cadencepb.RecordActivityTaskHeartbeatRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRecordActivityTaskHeartbeatRequest(in.(*shared.RecordActivityTaskHeartbeatRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRecordActivityTaskHeartbeatRequest(in.(*cadencepb.RecordActivityTaskHeartbeatRequest))
+			},
+		},
+		{
+			thrift: &shared.RecordActivityTaskHeartbeatResponse{},
+			proto:  &cadencepb.RecordActivityTaskHeartbeatResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoRecordActivityTaskHeartbeatResponse(in.(*shared.RecordActivityTaskHeartbeatResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftRecordActivityTaskHeartbeatResponse(in.(*cadencepb.RecordActivityTaskHeartbeatResponse))
+			},
+		},
+		{
+			thrift: &shared.RecordActivityTaskHeartbeatByIDRequest{},
+			proto:  &cadencepb.RecordActivityTaskHeartbeatByIDRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRecordActivityTaskHeartbeatByIDRequest(in.(*shared.RecordActivityTaskHeartbeatByIDRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRecordActivityTaskHeartbeatByIDRequest(in.(*cadencepb.RecordActivityTaskHeartbeatByIDRequest))
+			},
+		},
+		{
+			thrift: &shared.RespondActivityTaskCompletedRequest{},
+			proto:  &cadencepb.RespondActivityTaskCompletedRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRespondActivityTaskCompletedRequest(in.(*shared.RespondActivityTaskCompletedRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRespondActivityTaskCompletedRequest(in.(*cadencepb.RespondActivityTaskCompletedRequest))
+			},
+		},
+		{
+			thrift: &shared.RespondActivityTaskCompletedByIDRequest{},
+			proto:  &cadencepb.RespondActivityTaskCompletedByIDRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRespondActivityTaskCompletedByIDRequest(in.(*shared.RespondActivityTaskCompletedByIDRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRespondActivityTaskCompletedByIDRequest(in.(*cadencepb.RespondActivityTaskCompletedByIDRequest))
+			},
+		},
+		{
+			thrift: &shared.RespondActivityTaskFailedRequest{},
+			proto:  &cadencepb.RespondActivityTaskFailedRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRespondActivityTaskFailedRequest(in.(*shared.RespondActivityTaskFailedRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRespondActivityTaskFailedRequest(in.(*cadencepb.RespondActivityTaskFailedRequest))
+			},
+		},
+		{
+			thrift: &shared.RespondActivityTaskFailedByIDRequest{},
+			proto:  &cadencepb.RespondActivityTaskFailedByIDRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRespondActivityTaskFailedByIDRequest(in.(*shared.RespondActivityTaskFailedByIDRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRespondActivityTaskFailedByIDRequest(in.(*cadencepb.RespondActivityTaskFailedByIDRequest))
+			},
+		},
+		{
+			thrift: &shared.RespondActivityTaskCanceledRequest{},
+			proto:  &cadencepb.RespondActivityTaskCanceledRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRespondActivityTaskCanceledRequest(in.(*shared.RespondActivityTaskCanceledRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRespondActivityTaskCanceledRequest(in.(*cadencepb.RespondActivityTaskCanceledRequest))
+			},
+		},
+		{
+			thrift: &shared.RespondActivityTaskCanceledByIDRequest{},
+			proto:  &cadencepb.RespondActivityTaskCanceledByIDRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRespondActivityTaskCanceledByIDRequest(in.(*shared.RespondActivityTaskCanceledByIDRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRespondActivityTaskCanceledByIDRequest(in.(*cadencepb.RespondActivityTaskCanceledByIDRequest))
+			},
+		},
+		{
+			thrift: &shared.RequestCancelWorkflowExecutionRequest{},
+			proto:  &cadencepb.RequestCancelWorkflowExecutionRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRequestCancelWorkflowExecutionRequest(in.(*shared.RequestCancelWorkflowExecutionRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRequestCancelWorkflowExecutionRequest(in.(*cadencepb.RequestCancelWorkflowExecutionRequest))
+			},
+		},
+		{
+			thrift: &shared.SignalWorkflowExecutionRequest{},
+			proto:  &cadencepb.SignalWorkflowExecutionRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoSignalWorkflowExecutionRequest(in.(*shared.SignalWorkflowExecutionRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftSignalWorkflowExecutionRequest(in.(*cadencepb.SignalWorkflowExecutionRequest))
+			},
+		},
+		{
+			thrift: &shared.SignalWithStartWorkflowExecutionRequest{},
+			proto:  &cadencepb.SignalWithStartWorkflowExecutionRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoSignalWithStartWorkflowExecutionRequest(in.(*shared.SignalWithStartWorkflowExecutionRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftSignalWithStartWorkflowExecutionRequest(in.(*cadencepb.SignalWithStartWorkflowExecutionRequest))
+			},
+		},
+		{
+			thrift: &shared.TerminateWorkflowExecutionRequest{},
+			proto:  &cadencepb.TerminateWorkflowExecutionRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoTerminateWorkflowExecutionRequest(in.(*shared.TerminateWorkflowExecutionRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftTerminateWorkflowExecutionRequest(in.(*cadencepb.TerminateWorkflowExecutionRequest))
+			},
+		},
+		{
+			thrift: &shared.ResetWorkflowExecutionRequest{},
+			proto:  &cadencepb.ResetWorkflowExecutionRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoResetWorkflowExecutionRequest(in.(*shared.ResetWorkflowExecutionRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftResetWorkflowExecutionRequest(in.(*cadencepb.ResetWorkflowExecutionRequest))
+			},
+		},
+		{
+			thrift: &shared.ResetWorkflowExecutionResponse{},
+			proto:  &cadencepb.ResetWorkflowExecutionResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoResetWorkflowExecutionResponse(in.(*shared.ResetWorkflowExecutionResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftResetWorkflowExecutionResponse(in.(*cadencepb.ResetWorkflowExecutionResponse))
+			},
+		},
+		{
+			thrift: &shared.ListOpenWorkflowExecutionsRequest{},
+			proto:  &cadencepb.ListOpenWorkflowExecutionsRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoListOpenWorkflowExecutionsRequest(in.(*shared.ListOpenWorkflowExecutionsRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftListOpenWorkflowExecutionsRequest(in.(*cadencepb.ListOpenWorkflowExecutionsRequest))
+			},
+		},
+		{
+			thrift: &shared.ListOpenWorkflowExecutionsResponse{},
+			proto:  &cadencepb.ListOpenWorkflowExecutionsResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoListOpenWorkflowExecutionsResponse(in.(*shared.ListOpenWorkflowExecutionsResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftListOpenWorkflowExecutionsResponse(in.(*cadencepb.ListOpenWorkflowExecutionsResponse))
+			},
+		},
+		{
+			thrift: &shared.ListClosedWorkflowExecutionsRequest{},
+			proto:  &cadencepb.ListClosedWorkflowExecutionsRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoListClosedWorkflowExecutionsRequest(in.(*shared.ListClosedWorkflowExecutionsRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftListClosedWorkflowExecutionsRequest(in.(*cadencepb.ListClosedWorkflowExecutionsRequest))
+			},
+		},
+		{
+			thrift: &shared.ListClosedWorkflowExecutionsResponse{},
+			proto:  &cadencepb.ListClosedWorkflowExecutionsResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoListClosedWorkflowExecutionsResponse(in.(*shared.ListClosedWorkflowExecutionsResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftListClosedWorkflowExecutionsResponse(in.(*cadencepb.ListClosedWorkflowExecutionsResponse))
+			},
+		},
+		{
+			thrift: &shared.ListWorkflowExecutionsRequest{},
+			proto:  &cadencepb.ListWorkflowExecutionsRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoListWorkflowExecutionsRequest(in.(*shared.ListWorkflowExecutionsRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftListWorkflowExecutionsRequest(in.(*cadencepb.ListWorkflowExecutionsRequest))
+			},
+		},
+		{
+			thrift: &shared.ListWorkflowExecutionsResponse{},
+			proto:  &cadencepb.ListWorkflowExecutionsResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoListWorkflowExecutionsResponse(in.(*shared.ListWorkflowExecutionsResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftListWorkflowExecutionsResponse(in.(*cadencepb.ListWorkflowExecutionsResponse))
+			},
+		},
+		{
+			thrift: &shared.CountWorkflowExecutionsRequest{},
+			proto:  &cadencepb.CountWorkflowExecutionsRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoCountWorkflowExecutionsRequest(in.(*shared.CountWorkflowExecutionsRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftCountWorkflowExecutionsRequest(in.(*cadencepb.CountWorkflowExecutionsRequest))
+			},
+		},
+		{
+			thrift: &shared.CountWorkflowExecutionsResponse{},
+			proto:  &cadencepb.CountWorkflowExecutionsResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoCountWorkflowExecutionsResponse(in.(*shared.CountWorkflowExecutionsResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftCountWorkflowExecutionsResponse(in.(*cadencepb.CountWorkflowExecutionsResponse))
+			},
+		},
+		{
+			thrift: &shared.RespondQueryTaskCompletedRequest{},
+			proto:  &cadencepb.RespondQueryTaskCompletedRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoRespondQueryTaskCompletedRequest(in.(*shared.RespondQueryTaskCompletedRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftRespondQueryTaskCompletedRequest(in.(*cadencepb.RespondQueryTaskCompletedRequest))
+			},
+		},
+		{
+			thrift: &shared.QueryWorkflowRequest{},
+			proto:  &cadencepb.QueryWorkflowRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoQueryWorkflowRequest(in.(*shared.QueryWorkflowRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftQueryWorkflowRequest(in.(*cadencepb.QueryWorkflowRequest))
+			},
+		},
+		{
+			thrift: &shared.QueryWorkflowResponse{},
+			proto:  &cadencepb.QueryWorkflowResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoQueryWorkflowResponse(in.(*shared.QueryWorkflowResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftQueryWorkflowResponse(in.(*cadencepb.QueryWorkflowResponse))
+			},
+		},
+		{
+			thrift: &shared.DescribeWorkflowExecutionRequest{},
+			proto:  &cadencepb.DescribeWorkflowExecutionRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoDescribeWorkflowExecutionRequest(in.(*shared.DescribeWorkflowExecutionRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftDescribeWorkflowExecutionRequest(in.(*cadencepb.DescribeWorkflowExecutionRequest))
+			},
+		},
+		{
+			thrift: &shared.DescribeWorkflowExecutionResponse{},
+			proto:  &cadencepb.DescribeWorkflowExecutionResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoDescribeWorkflowExecutionResponse(in.(*shared.DescribeWorkflowExecutionResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftDescribeWorkflowExecutionResponse(in.(*cadencepb.DescribeWorkflowExecutionResponse))
+			},
+		},
+		{
+			thrift: &shared.DescribeTaskListRequest{},
+			proto:  &cadencepb.DescribeTaskListRequest{},
+			toProto: func(in interface{}) proto.Message {
+				return toProtoDescribeTaskListRequest(in.(*shared.DescribeTaskListRequest))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return ToThriftDescribeTaskListRequest(in.(*cadencepb.DescribeTaskListRequest))
+			},
+		},
+		{
+			thrift: &shared.DescribeTaskListResponse{},
+			proto:  &cadencepb.DescribeTaskListResponse{},
+			toProto: func(in interface{}) proto.Message {
+				return ToProtoDescribeTaskListResponse(in.(*shared.DescribeTaskListResponse))
+			},
+			toThrift: func(in proto.Message) interface{} {
+				return toThriftDescribeTaskListResponse(in.(*cadencepb.DescribeTaskListResponse))
+			},
+		},
+	}
+}
+
+// fillValue sets every field reachable from v, enums get the value 1 so they differ from their default
+func fillValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillValue(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			fillValue(v.Field(i))
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte("payload"))
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			fillValue(v.Index(i))
+		}
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		value := reflect.New(v.Type().Elem()).Elem()
+		fillValue(key)
+		fillValue(value)
+		v.SetMapIndex(key, value)
+	case reflect.String:
+		v.SetString("value")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Float64:
+		v.SetFloat(1.5)
+	}
+}
@@ -22,10 +22,15 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/uber-go/tally/m3"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/blobstore/filestore"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/tracing"
 	"github.com/uber/ringpop-go/discovery"
 )
 
@@ -36,6 +41,8 @@ type (
 		Ringpop Ringpop `yaml:"ringpop"`
 		// Cassandra is the configuration for connecting to cassandra
 		Cassandra Cassandra `yaml:"cassandra"`
+		// Persistence is the configuration for selecting the persistence store
+		Persistence Persistence `yaml:"persistence"`
 		// Log is the logging config
 		Log Logger `yaml:"log"`
 		// ClustersInfo is the config containing all valid clusters and active acluster
@@ -44,6 +51,21 @@ type (
 		Services map[string]Service `yaml:"services"`
 		// Kafka is the config for connecting to kafka
 		Kafka messaging.KafkaConfig `yaml:"kafka"`
+		// Replication is the config for shipping the replication tasks between clusters,
+		// the replication tasks are shipped through kafka when it is not set
+		Replication messaging.ReplicationConfig `yaml:"replication"`
+		// DynamicConfigClient is the config for the file based dynamic config client,
+		// dynamic config falls back to defaults when no file is configured
+		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// Archival is the config for the blob store used to archive workflow histories,
+		// archival is disabled for the cluster when no blob store is configured
+		Archival Archival `yaml:"archival"`
+		// Authorization is the config for authorizing frontend API calls,
+		// every call is allowed when it is not enabled
+		Authorization authorization.Config `yaml:"authorization"`
+		// Tracing is the config for tracing requests across the services,
+		// tracing is disabled when no exporter is configured
+		Tracing tracing.Config `yaml:"tracing"`
 	}
 
 	// Service contains the service specific config items
@@ -54,6 +76,9 @@ type (
 		Metrics Metrics `yaml:"metrics"`
 		// PProf is the PProf configuration
 		PProf PProf `yaml:"pprof"`
+		// Log is the logging config of the service,
+		// the global logging config is used when it is not set
+		Log *Logger `yaml:"log"`
 	}
 
 	// PProf contains the rpc config items
@@ -66,12 +91,39 @@ type (
 	RPC struct {
 		// Port is the port  on which the channel will bind to
 		Port int `yaml:"port"`
+		// GRPCPort is the port on which the same APIs are served over gRPC,
+		// there is no gRPC inbound when it is not set
+		GRPCPort int `yaml:"grpcPort"`
+		// HTTPPort is the port on which the frontend serves its workflow APIs as JSON over HTTP,
+		// there is no HTTP gateway when it is not set. It is ignored by the other services. The
+		// gateway serves HTTPS with the certificate of the TLS config when it is set
+		HTTPPort int `yaml:"httpPort"`
 		// BindOnLocalHost is true if localhost is the bind address
 		BindOnLocalHost bool `yaml:"bindOnLocalHost"`
 		// DisableLogging disables all logging for rpc
 		DisableLogging bool `yaml:"disableLogging"`
 		// LogLevel is the desired log level
 		LogLevel string `yaml:"logLevel"`
+		// TLS is the config for encrypting the inbound and outbound connections of the service,
+		// connections are not encrypted when it is not set. Since services call each other, it
+		// has to be set on every service of the cluster
+		TLS *TLS `yaml:"tls"`
+	}
+
+	// TLS contains the config items for encrypting rpc connections
+	TLS struct {
+		// CertFile is the path of the PEM encoded certificate presented by this host
+		CertFile string `yaml:"certFile"`
+		// KeyFile is the path of the PEM encoded private key of the certificate
+		KeyFile string `yaml:"keyFile"`
+		// CAFile is the path of the PEM encoded CA certificates used to verify peers,
+		// the system roots are used when it is empty
+		CAFile string `yaml:"caFile"`
+		// RequireClientCert requires callers to present a certificate signed by the CA (mutual TLS)
+		RequireClientCert bool `yaml:"requireClientCert"`
+		// ServerName is the name used to verify the certificate of the server being called,
+		// the host of the address being called is used when it is empty
+		ServerName string `yaml:"serverName"`
 	}
 
 	// Ringpop contains the ringpop config items
@@ -90,10 +142,40 @@ type (
 		DiscoveryProvider discovery.DiscoverProvider `yaml:"-"`
 	}
 
+	// Persistence contains the configuration for selecting the persistence store
+	Persistence struct {
+		// DefaultStore is the store used for all persistence, valid values are
+		// cassandra, sql and memory, defaults to cassandra when empty.  The memory
+		// store keeps all data within the process and is meant for local development
+		// only, all services have to run within the same process
+		DefaultStore string `yaml:"defaultStore"`
+		// SQL is the configuration for connecting to a sql database, required
+		// when DefaultStore is sql
+		SQL *SQL `yaml:"sql"`
+	}
+
+	// SQL contains the configuration to connect to a sql database
+	SQL struct {
+		// DriverName is the name of the database/sql driver, valid values are mysql and postgres
+		DriverName string `yaml:"driverName" validate:"nonzero"`
+		// ConnectAddr is the host:port of the database server
+		ConnectAddr string `yaml:"connectAddr" validate:"nonzero"`
+		// User is the user used for authentication
+		User string `yaml:"user"`
+		// Password is the password used for authentication
+		Password string `yaml:"password"`
+		// DatabaseName is the name of the database holding the cadence tables
+		DatabaseName string `yaml:"databaseName" validate:"nonzero"`
+		// VisibilityDatabaseName is the name of the database holding the visibility tables
+		VisibilityDatabaseName string `yaml:"visibilityDatabaseName" validate:"nonzero"`
+		// MaxConns is the max number of open connections per manager, zero means no limit
+		MaxConns int `yaml:"maxConns"`
+	}
+
 	// Cassandra contains configuration to connect to Cassandra cluster
 	Cassandra struct {
 		// Hosts is a csv of cassandra endpoints
-		Hosts string `yaml:"hosts" validate:"nonzero"`
+		Hosts string `yaml:"hosts"`
 		// Port is the cassandra port used for connection by gocql client
 		Port int `yaml:"port"`
 		// User is the cassandra user used for authentication by gocql client
@@ -101,9 +183,9 @@ type (
 		// Password is the cassandra password used for authentication by gocql client
 		Password string `yaml:"password"`
 		// Keyspace is the cassandra keyspace
-		Keyspace string `yaml:"keyspace" validate:"nonzero"`
+		Keyspace string `yaml:"keyspace"`
 		// VisibilityKeyspace is the cassandra keyspace for visibility store
-		VisibilityKeyspace string `yaml:"visibilityKeyspace" validate:"nonzero"`
+		VisibilityKeyspace string `yaml:"visibilityKeyspace"`
 		// Consistency is the default cassandra consistency level
 		Consistency string `yaml:"consistency"`
 		// Datacenter is the data center filter arg for cassandra
@@ -112,6 +194,12 @@ type (
 		NumHistoryShards int `yaml:"numHistoryShards" validate:"nonzero"`
 	}
 
+	// Archival contains the config for the blob store used by archival
+	Archival struct {
+		// Filestore is the config for the blob store backed by the local filesystem
+		Filestore *filestore.Config `yaml:"filestore"`
+	}
+
 	// Replicator describes the configuration of replicator
 	Replicator struct {
 	}
@@ -124,6 +212,8 @@ type (
 		Level string `yaml:"level"`
 		// OutputFile is the path to the log output file
 		OutputFile string `yaml:"outputFile"`
+		// Encoding is the encoding of the log entries, json (default) or console
+		Encoding string `yaml:"encoding"`
 	}
 
 	// ClustersInfo contains the all cluster names and active cluster
@@ -148,11 +238,25 @@ type (
 		M3 *m3.Configuration `yaml:"m3"`
 		// Statsd is the configuration for statsd reporter
 		Statsd *Statsd `yaml:"statsd"`
+		// Prometheus is the configuration for prometheus reporter
+		Prometheus *Prometheus `yaml:"prometheus"`
 		// Tags is the set of key-value pairs to be reported
 		// as part of every metric
 		Tags map[string]string `yaml:"tags"`
 	}
 
+	// Prometheus contains the config items for prometheus metrics reporter,
+	// the metrics are scraped from an http endpoint served by each service
+	Prometheus struct {
+		// ListenAddress is the host and port on which the metrics are served
+		ListenAddress string `yaml:"listenAddress" validate:"nonzero"`
+		// HandlerPath is the path of the metrics endpoint, it defaults to /metrics
+		HandlerPath string `yaml:"handlerPath"`
+		// HistogramBuckets are the upper bounds, in seconds, of the histogram buckets timers
+		// are reported in. If it is not specified, it defaults to the prometheus default buckets
+		HistogramBuckets []float64 `yaml:"histogramBuckets"`
+	}
+
 	// Statsd contains the config items for statsd metrics reporter
 	Statsd struct {
 		// The host and port of the statsd server
@@ -172,6 +276,45 @@ type (
 	BootstrapMode int
 )
 
+const (
+	// StoreTypeCassandra is the persistence store backed by cassandra
+	StoreTypeCassandra = "cassandra"
+	// StoreTypeSQL is the persistence store backed by a sql database
+	StoreTypeSQL = "sql"
+	// StoreTypeMemory is the persistence store which keeps all data in memory
+	StoreTypeMemory = "memory"
+)
+
+// Validate validates the persistence config against the selected store
+func (c *Config) Validate() error {
+	switch c.Persistence.GetStoreType() {
+	case StoreTypeCassandra:
+		if len(c.Cassandra.Hosts) == 0 || len(c.Cassandra.Keyspace) == 0 || len(c.Cassandra.VisibilityKeyspace) == 0 {
+			return fmt.Errorf("cassandra hosts, keyspace and visibilityKeyspace are required for store %v",
+				StoreTypeCassandra)
+		}
+	case StoreTypeSQL:
+		if c.Persistence.SQL == nil {
+			return fmt.Errorf("sql config is required for store %v", StoreTypeSQL)
+		}
+	case StoreTypeMemory:
+	default:
+		return fmt.Errorf("unknown persistence store: %v", c.Persistence.DefaultStore)
+	}
+	if c.ClustersInfo.EnableGlobalDomain {
+		return c.Replication.Validate()
+	}
+	return nil
+}
+
+// GetStoreType returns the configured store type, defaulting to cassandra
+func (p *Persistence) GetStoreType() string {
+	if len(p.DefaultStore) == 0 {
+		return StoreTypeCassandra
+	}
+	return p.DefaultStore
+}
+
 // String converts the config object into a string
 func (c *Config) String() string {
 	out, _ := json.MarshalIndent(c, "", "    ")
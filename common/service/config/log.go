@@ -21,49 +21,60 @@
 package config
 
 import (
-	"github.com/sirupsen/logrus"
-	"github.com/uber-common/bark"
-	"io"
-	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/uber/cadence/common/logging"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 const fileMode = os.FileMode(0644)
 
-// NewBarkLogger builds and returns a new bark
+// NewLogger builds and returns a new structured
 // logger for this logging configuration
-func (cfg *Logger) NewBarkLogger() bark.Logger {
-
-	logger := logrus.New()
-	logger.Out = ioutil.Discard
-	logger.Level = parseLogrusLevel(cfg.Level)
-	logger.Formatter = getFormatter()
+func (cfg *Logger) NewLogger() logging.Logger {
+	return logging.NewLogger(cfg.NewZapLogger())
+}
 
+// NewZapLogger builds and returns a new zap
+// logger for this logging configuration
+func (cfg *Logger) NewZapLogger() *zap.Logger {
+	var outputPaths []string
 	if cfg.Stdout {
-		logger.Out = os.Stdout
+		outputPaths = append(outputPaths, "stdout")
 	}
-
 	if len(cfg.OutputFile) > 0 {
-		outFile := createLogFile(cfg.OutputFile)
-		logger.Out = outFile
-		if cfg.Stdout {
-			logger.Out = io.MultiWriter(os.Stdout, outFile)
-		}
+		createLogFile(cfg.OutputFile)
+		outputPaths = append(outputPaths, cfg.OutputFile)
+	}
+	if len(outputPaths) == 0 {
+		return zap.NewNop()
 	}
 
-	return bark.NewLoggerFromLogrus(logger)
-}
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.TimeKey = "ts"
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 
-func getFormatter() logrus.Formatter {
-	formatter := &logrus.TextFormatter{}
-	formatter.FullTimestamp = true
-	return formatter
+	config := zap.Config{
+		Level:            zap.NewAtomicLevelAt(parseZapLevel(cfg.Level)),
+		Development:      false,
+		Sampling:         nil,
+		Encoding:         parseZapEncoding(cfg.Encoding),
+		EncoderConfig:    encoderConfig,
+		OutputPaths:      outputPaths,
+		ErrorOutputPaths: []string{"stderr"},
+	}
+	logger, err := config.Build()
+	if err != nil {
+		log.Fatalf("error creating logger, err=%v", err)
+	}
+	return logger
 }
 
-func createLogFile(path string) *os.File {
+func createLogFile(path string) {
 	dir := filepath.Dir(path)
 	if len(dir) > 0 && dir != "." {
 		if err := os.MkdirAll(dir, fileMode); err != nil {
@@ -74,24 +85,35 @@ func createLogFile(path string) *os.File {
 	if err != nil {
 		log.Fatalf("error creating log file %v, err=%v", path, err)
 	}
-	return file
+	file.Close()
 }
 
-// parseLogrusLevel converts the string log
-// level into a logrus level
-func parseLogrusLevel(level string) logrus.Level {
+// parseZapLevel converts the string log
+// level into a zap level
+func parseZapLevel(level string) zapcore.Level {
 	switch strings.ToLower(level) {
 	case "debug":
-		return logrus.DebugLevel
+		return zap.DebugLevel
 	case "info":
-		return logrus.InfoLevel
+		return zap.InfoLevel
 	case "warn":
-		return logrus.WarnLevel
+		return zap.WarnLevel
 	case "error":
-		return logrus.ErrorLevel
+		return zap.ErrorLevel
 	case "fatal":
-		return logrus.FatalLevel
+		return zap.FatalLevel
+	default:
+		return zap.InfoLevel
+	}
+}
+
+// parseZapEncoding converts the string log
+// encoding into a zap encoding
+func parseZapEncoding(encoding string) string {
+	switch strings.ToLower(encoding) {
+	case "console":
+		return "console"
 	default:
-		return logrus.InfoLevel
+		return "json"
 	}
 }
@@ -21,14 +21,63 @@
 package config
 
 import (
+	"log"
+	"net"
+	"net/http"
+	"time"
+
 	"github.com/cactus/go-statsd-client/statsd"
+	prom "github.com/prometheus/client_golang/prometheus"
 	"github.com/uber-go/tally"
+	"github.com/uber-go/tally/prometheus"
 	tallystatsdreporter "github.com/uber-go/tally/statsd"
+	"github.com/uber/cadence/common/metrics"
 	statsdreporter "github.com/uber/cadence/common/metrics/tally/statsd"
-	"log"
-	"time"
 )
 
+const (
+	defaultPrometheusHandlerPath = "/metrics"
+	// prometheusNoneLabelValue is the value of the labels a metric is not tagged with
+	prometheusNoneLabelValue = "none"
+)
+
+type (
+	// prometheusLabelsReporter reports every metric with all the prometheusLabels, prometheus
+	// rejects a metric name registered again with other labels, while the scopes of common/metrics
+	// tag the same metric names differently, e.g. the replicator scope is only tagged with the
+	// source cluster in the worker processor
+	prometheusLabelsReporter struct {
+		prometheus.Reporter
+	}
+)
+
+// prometheusLabels are the tags the scopes of common/metrics may add to their metrics
+var prometheusLabels = []string{
+	metrics.OperationTagName,
+	metrics.ShardTagName,
+	metrics.DomainTagName,
+	metrics.SourceClusterTagName,
+}
+
+// prometheusSanitizeOptions maps the dotted metric names and the tags of
+// common/metrics to valid prometheus metric and label names, e.g. the
+// cadence.errors.access-denied counter is reported as cadence_errors_access_denied
+var prometheusSanitizeOptions = tally.SanitizeOptions{
+	NameCharacters: tally.ValidCharacters{
+		Ranges:     tally.AlphanumericRange,
+		Characters: []rune{'_'},
+	},
+	KeyCharacters: tally.ValidCharacters{
+		Ranges:     tally.AlphanumericRange,
+		Characters: []rune{'_'},
+	},
+	ValueCharacters: tally.ValidCharacters{
+		Ranges:     tally.AlphanumericRange,
+		Characters: []rune{'_', '-', '.', '/', ':'},
+	},
+	ReplacementCharacter: '_',
+}
+
 // NewScope builds a new tally scope
 // for this metrics configuration
 //
@@ -36,7 +85,8 @@ import (
 // valid for multiple reporter types,
 // only one of them will be used for
 // reporting. Currently, m3 is preferred
-// over statsd
+// over statsd, which is preferred over
+// prometheus
 func (c *Metrics) NewScope() tally.Scope {
 	if c.M3 != nil {
 		return c.newM3Scope()
@@ -44,6 +94,9 @@ func (c *Metrics) NewScope() tally.Scope {
 	if c.Statsd != nil {
 		return c.newStatsdScope()
 	}
+	if c.Prometheus != nil {
+		return c.newPrometheusScope()
+	}
 	return tally.NoopScope
 }
 
@@ -83,3 +136,93 @@ func (c *Metrics) newStatsdScope() tally.Scope {
 	scope, _ := tally.NewRootScope(scopeOpts, time.Second)
 	return scope
 }
+
+// newPrometheusScope returns a new prometheus scope with
+// a default reporting interval of a second, and starts
+// serving the metrics on the configured address
+func (c *Metrics) newPrometheusScope() tally.Scope {
+	reporter := c.newPrometheusReporter()
+	mux := http.NewServeMux()
+	mux.Handle(c.Prometheus.handlerPath(), reporter.HTTPHandler())
+	listener, err := net.Listen("tcp", c.Prometheus.ListenAddress)
+	if err != nil {
+		log.Fatalf("error listening for prometheus metrics, err=%v", err)
+	}
+	go func() {
+		if err := http.Serve(listener, mux); err != nil {
+			log.Printf("error serving prometheus metrics, err=%v", err)
+		}
+	}()
+	return c.newPrometheusRootScope(reporter)
+}
+
+// newPrometheusReporter returns a reporter with its own registry,
+// as several services can run and report in the same process
+func (c *Metrics) newPrometheusReporter() prometheus.Reporter {
+	registry := prom.NewRegistry()
+	reporter := prometheus.NewReporter(prometheus.Options{
+		Registerer:              registry,
+		Gatherer:                registry,
+		DefaultTimerType:        prometheus.HistogramTimerType,
+		DefaultHistogramBuckets: c.Prometheus.HistogramBuckets,
+		OnRegisterError: func(err error) {
+			// the metric would not be reported, which is a bug in the tags of the metric
+			log.Fatalf("error registering prometheus metric, err=%v", err)
+		},
+	})
+	return prometheusLabelsReporter{Reporter: reporter}
+}
+
+func (c *Metrics) newPrometheusRootScope(reporter prometheus.Reporter) tally.Scope {
+	scopeOpts := tally.ScopeOptions{
+		Tags:            c.Tags,
+		CachedReporter:  reporter,
+		Separator:       prometheus.DefaultSeparator,
+		SanitizeOptions: &prometheusSanitizeOptions,
+	}
+	scope, _ := tally.NewRootScope(scopeOpts, time.Second)
+	return scope
+}
+
+// AllocateCounter allocates a counter with all the prometheus labels
+func (r prometheusLabelsReporter) AllocateCounter(name string, tags map[string]string) tally.CachedCount {
+	return r.Reporter.AllocateCounter(name, withPrometheusLabels(tags))
+}
+
+// AllocateGauge allocates a gauge with all the prometheus labels
+func (r prometheusLabelsReporter) AllocateGauge(name string, tags map[string]string) tally.CachedGauge {
+	return r.Reporter.AllocateGauge(name, withPrometheusLabels(tags))
+}
+
+// AllocateTimer allocates a timer with all the prometheus labels
+func (r prometheusLabelsReporter) AllocateTimer(name string, tags map[string]string) tally.CachedTimer {
+	return r.Reporter.AllocateTimer(name, withPrometheusLabels(tags))
+}
+
+// AllocateHistogram allocates a histogram with all the prometheus labels
+func (r prometheusLabelsReporter) AllocateHistogram(
+	name string,
+	tags map[string]string,
+	buckets tally.Buckets,
+) tally.CachedHistogram {
+	return r.Reporter.AllocateHistogram(name, withPrometheusLabels(tags), buckets)
+}
+
+// withPrometheusLabels returns the tags with the missing prometheus labels set to none
+func withPrometheusLabels(tags map[string]string) map[string]string {
+	labels := make(map[string]string, len(tags)+len(prometheusLabels))
+	for _, label := range prometheusLabels {
+		labels[label] = prometheusNoneLabelValue
+	}
+	for key, value := range tags {
+		labels[key] = value
+	}
+	return labels
+}
+
+func (p *Prometheus) handlerPath() string {
+	if len(p.HandlerPath) == 0 {
+		return defaultPrometheusHandlerPath
+	}
+	return p.HandlerPath
+}
@@ -21,11 +21,16 @@
 package config
 
 import (
+	"io"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+	"time"
+
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	"github.com/uber-go/tally/m3"
-	"testing"
 )
 
 type MetricsSuite struct {
@@ -65,6 +70,64 @@ func (s *MetricsSuite) TestM3() {
 	s.NotNil(scope)
 }
 
+func (s *MetricsSuite) TestPrometheus() {
+	config := &Metrics{
+		Prometheus: &Prometheus{
+			HistogramBuckets: []float64{0.01, 0.1, 1},
+		},
+		Tags: map[string]string{"service": "frontend"},
+	}
+	reporter := config.newPrometheusReporter()
+	scope := config.newPrometheusRootScope(reporter)
+	tagged := scope.Tagged(map[string]string{"operation": "StartWorkflowExecution"})
+	tagged.Counter("cadence.errors.access-denied").Inc(1)
+	tagged.Timer("cadence.latency").Record(50 * time.Millisecond)
+	s.NoError(scope.(io.Closer).Close())
+
+	server := httptest.NewServer(reporter.HTTPHandler())
+	defer server.Close()
+	resp, err := server.Client().Get(server.URL)
+	s.NoError(err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	s.NoError(err)
+
+	s.Contains(string(body), `cadence_errors_access_denied{domain="none",operation="StartWorkflowExecution",`+
+		`service="frontend",shard="none",source_cluster="none"} 1`)
+	s.Contains(string(body), `cadence_latency_bucket{domain="none",operation="StartWorkflowExecution",`+
+		`service="frontend",shard="none",source_cluster="none",le="0.1"} 1`)
+	s.Contains(string(body), `cadence_latency_bucket{domain="none",operation="StartWorkflowExecution",`+
+		`service="frontend",shard="none",source_cluster="none",le="0.01"} 0`)
+}
+
+func (s *MetricsSuite) TestPrometheusTagsDifferingByScope() {
+	config := &Metrics{Prometheus: &Prometheus{}}
+	reporter := config.newPrometheusReporter()
+	scope := config.newPrometheusRootScope(reporter)
+	replicatorScope := scope.Tagged(map[string]string{"operation": "Replicator"})
+	replicatorScope.Counter("cadence.requests").Inc(1)
+	replicatorScope.Tagged(map[string]string{"source_cluster": "standby"}).Counter("cadence.requests").Inc(2)
+	scope.Tagged(map[string]string{"operation": "CreateShard", "shard": "NONE"}).Counter("cadence.requests").Inc(3)
+	s.NoError(scope.(io.Closer).Close())
+
+	server := httptest.NewServer(reporter.HTTPHandler())
+	defer server.Close()
+	resp, err := server.Client().Get(server.URL)
+	s.NoError(err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	s.NoError(err)
+
+	s.Contains(string(body), `cadence_requests{domain="none",operation="Replicator",shard="none",source_cluster="none"} 1`)
+	s.Contains(string(body), `cadence_requests{domain="none",operation="Replicator",shard="none",source_cluster="standby"} 2`)
+	s.Contains(string(body), `cadence_requests{domain="none",operation="CreateShard",shard="NONE",source_cluster="none"} 3`)
+}
+
+func (s *MetricsSuite) TestPrometheusHandlerPath() {
+	s.Equal("/metrics", (&Prometheus{}).handlerPath())
+	s.Equal("/prometheus", (&Prometheus{HandlerPath: "/prometheus"}).handlerPath())
+}
+
 func (s *MetricsSuite) TestNoop() {
 	config := &Metrics{}
 	scope := config.NewScope()
@@ -23,18 +23,19 @@ package config
 import (
 	"fmt"
 	"net/http"
-	"sync/atomic"
-	// DO NOT REMOVE THE LINE BELOW
 	_ "net/http/pprof"
+	"sync/atomic"
 
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+	// DO NOT REMOVE THE LINE BELOW
 )
 
 type (
 	// PProfInitializerImpl initialize the pprof based on config
 	PProfInitializerImpl struct {
 		PProf  *PProf
-		Logger bark.Logger
+		Logger logging.Logger
 	}
 )
 
@@ -48,7 +49,7 @@ const (
 var pprofStatus = pprofNotInitialized
 
 // NewInitializer create a new instance of PProf Initializer
-func (cfg *PProf) NewInitializer(logger bark.Logger) *PProfInitializerImpl {
+func (cfg *PProf) NewInitializer(logger logging.Logger) *PProfInitializerImpl {
 	return &PProfInitializerImpl{
 		PProf:  cfg,
 		Logger: logger,
@@ -65,7 +66,7 @@ func (initializer *PProfInitializerImpl) Start() error {
 
 	if atomic.CompareAndSwapInt32(&pprofStatus, pprofNotInitialized, pprofInitialized) {
 		go func() {
-			initializer.Logger.Infof("PProf listen on %d", port)
+			initializer.Logger.Info("PProf listen on port", tag.Port(port))
 			http.ListenAndServe(fmt.Sprintf("localhost:%d", port), nil)
 		}()
 	}
@@ -21,11 +21,17 @@
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+
+	"github.com/opentracing/opentracing-go"
+	tcg "github.com/uber/tchannel-go"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
@@ -34,17 +40,20 @@ type RPCFactory struct {
 	config      *RPC
 	serviceName string
 	ch          *tchannel.ChannelTransport
-	logger      bark.Logger
+	logger      logging.Logger
+	tracer      opentracing.Tracer
 }
 
 // NewFactory builds a new RPCFactory
-// conforming to the underlying configuration
-func (cfg *RPC) NewFactory(sName string, logger bark.Logger) *RPCFactory {
-	return newRPCFactory(cfg, sName, logger)
+// conforming to the underlying configuration,
+// the tracer propagates spans on the inbound
+// and outbound calls
+func (cfg *RPC) NewFactory(sName string, logger logging.Logger, tracer opentracing.Tracer) *RPCFactory {
+	return newRPCFactory(cfg, sName, logger, tracer)
 }
 
-func newRPCFactory(cfg *RPC, sName string, logger bark.Logger) *RPCFactory {
-	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger}
+func newRPCFactory(cfg *RPC, sName string, logger logging.Logger, tracer opentracing.Tracer) *RPCFactory {
+	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger, tracer: tracer}
 	return factory
 }
 
@@ -53,17 +62,32 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 	// Setup dispatcher for onebox
 	var err error
 	hostAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.Port)
-	d.ch, err = tchannel.NewChannelTransport(
-		tchannel.ServiceName(d.serviceName),
-		tchannel.ListenAddr(hostAddress))
+	if d.config.TLS != nil {
+		d.ch, err = d.newTLSChannelTransport(hostAddress)
+	} else {
+		d.ch, err = tchannel.NewChannelTransport(
+			tchannel.ServiceName(d.serviceName),
+			tchannel.ListenAddr(hostAddress),
+			tchannel.Tracer(d.tracer))
+	}
 	if err != nil {
-		d.logger.WithField("error", err).Fatal("Failed to create transport channel")
+		d.logger.Fatal("Failed to create transport channel", tag.Error(err))
+	}
+	d.logger.Info("Created RPC dispatcher and listening",
+		tag.Service(d.serviceName),
+		tag.Address(hostAddress))
+
+	inbounds := yarpc.Inbounds{d.ch.NewInbound()}
+	if d.config.GRPCPort != 0 {
+		grpcInbound, err := d.newGRPCInbound()
+		if err != nil {
+			d.logger.Fatal("Failed to create grpc inbound", tag.Error(err))
+		}
+		inbounds = append(inbounds, grpcInbound)
 	}
-	d.logger.Infof("Created RPC dispatcher for '%v' and listening at '%v'",
-		d.serviceName, hostAddress)
 	return yarpc.NewDispatcher(yarpc.Config{
 		Name:     d.serviceName,
-		Inbounds: yarpc.Inbounds{d.ch.NewInbound()},
+		Inbounds: inbounds,
 	})
 }
 
@@ -71,8 +95,9 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 func (d *RPCFactory) CreateDispatcherForOutbound(
 	callerName, serviceName, hostName string) *yarpc.Dispatcher {
 	// Setup dispatcher(outbound) for onebox
-	d.logger.Infof("Created RPC dispatcher outbound for service '%v' for host '%v'",
-		serviceName, hostName)
+	d.logger.Info("Created RPC dispatcher outbound",
+		tag.Service(serviceName),
+		tag.Address(hostName))
 	dispatcher := yarpc.NewDispatcher(yarpc.Config{
 		Name: callerName,
 		Outbounds: yarpc.Outbounds{
@@ -80,18 +105,96 @@ func (d *RPCFactory) CreateDispatcherForOutbound(
 		},
 	})
 	if err := dispatcher.Start(); err != nil {
-		d.logger.WithField("error", err).Fatal("Failed to create outbound transport channel")
+		d.logger.Fatal("Failed to create outbound transport channel", tag.Error(err))
 	}
 	return dispatcher
 }
 
+// newTLSChannelTransport creates a transport whose channel accepts and dials tls connections,
+// the outbound dispatchers share the channel so calls to other services are encrypted as well
+func (d *RPCFactory) newTLSChannelTransport(hostAddress string) (*tchannel.ChannelTransport, error) {
+	serverConfig, err := d.config.TLS.NewServerTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientConfig, err := d.config.TLS.NewClientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	ch, err := tcg.NewChannel(d.serviceName, &tcg.ChannelOptions{
+		Dialer: NewTLSDialer(clientConfig),
+		Tracer: d.tracer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	listener, err := tls.Listen("tcp", hostAddress, serverConfig)
+	if err != nil {
+		return nil, err
+	}
+	// the transport does not listen again on a channel which is already serving
+	if err := ch.Serve(listener); err != nil {
+		return nil, err
+	}
+	return tchannel.NewChannelTransport(tchannel.WithChannel(ch), tchannel.Tracer(d.tracer))
+}
+
+// newGRPCInbound creates an inbound serving the procedures registered on the dispatcher over gRPC,
+// the tchannel inbound has to stay first as ringpop is bootstrapped on its channel
+func (d *RPCFactory) newGRPCInbound() (*grpc.Inbound, error) {
+	hostAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.GRPCPort)
+	listener, err := net.Listen("tcp", hostAddress)
+	if err != nil {
+		return nil, err
+	}
+	if d.config.TLS != nil {
+		tlsConfig, err := d.config.TLS.NewServerTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		// gRPC clients negotiate http2 during the tls handshake
+		tlsConfig.NextProtos = []string{"h2"}
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+	d.logger.Info("Created gRPC inbound and listening",
+		tag.Service(d.serviceName),
+		tag.Address(hostAddress))
+
+	return grpc.NewTransport(grpc.Tracer(d.tracer)).NewInbound(listener), nil
+}
+
+// HTTPGatewayAddress returns the address the HTTP gateway listens on,
+// it is empty when the gateway is not enabled
+func (cfg *RPC) HTTPGatewayAddress() (string, error) {
+	if cfg.HTTPPort == 0 {
+		return "", nil
+	}
+	ip := net.IPv4(127, 0, 0, 1)
+	if !cfg.BindOnLocalHost {
+		var err error
+		if ip, err = ListenIP(); err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("%v:%v", ip, cfg.HTTPPort), nil
+}
+
+// HTTPGatewayTLSConfig returns the tls config of the HTTP gateway, it is nil when the rpc connections
+// are not encrypted so the gateway serves plain HTTP
+func (cfg *RPC) HTTPGatewayTLSConfig() (*tls.Config, error) {
+	if cfg.TLS == nil {
+		return nil, nil
+	}
+	return cfg.TLS.NewServerTLSConfig()
+}
+
 func (d *RPCFactory) getListenIP() net.IP {
 	if d.config.BindOnLocalHost {
 		return net.IPv4(127, 0, 0, 1)
 	}
 	ip, err := ListenIP()
 	if err != nil {
-		d.logger.Fatalf("ListenIP failed, err=%v", err)
+		d.logger.Fatal("ListenIP failed", tag.Error(err))
 	}
 	return ip
 }
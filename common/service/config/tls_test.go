@@ -0,0 +1,191 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	TLSSuite struct {
+		*require.Assertions
+		suite.Suite
+		dir        string
+		serverTLS  *TLS
+		clientTLS  *TLS
+		caCert     *x509.Certificate
+		caKey      *rsa.PrivateKey
+		serialNums int64
+	}
+)
+
+func TestTLSSuite(t *testing.T) {
+	suite.Run(t, new(TLSSuite))
+}
+
+func (s *TLSSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.dir, err = ioutil.TempDir("", "TLSSuite")
+	s.NoError(err)
+
+	s.caKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	caTemplate := s.newTemplate("cadence-test-ca")
+	caTemplate.IsCA = true
+	caTemplate.BasicConstraintsValid = true
+	caTemplate.KeyUsage = x509.KeyUsageCertSign
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &s.caKey.PublicKey, s.caKey)
+	s.NoError(err)
+	s.caCert, err = x509.ParseCertificate(caDER)
+	s.NoError(err)
+	caFile := s.writePEM("ca.pem", "CERTIFICATE", caDER)
+
+	serverCert, serverKey := s.newCert("server")
+	s.serverTLS = &TLS{
+		CertFile:          serverCert,
+		KeyFile:           serverKey,
+		CAFile:            caFile,
+		RequireClientCert: true,
+	}
+	clientCert, clientKey := s.newCert("client")
+	s.clientTLS = &TLS{
+		CertFile: clientCert,
+		KeyFile:  clientKey,
+		CAFile:   caFile,
+	}
+}
+
+func (s *TLSSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *TLSSuite) TestMutualTLS() {
+	s.NoError(s.roundTrip(s.serverTLS, s.clientTLS))
+}
+
+func (s *TLSSuite) TestServerOnlyTLS() {
+	s.serverTLS.RequireClientCert = false
+	s.clientTLS.CertFile = ""
+	s.clientTLS.KeyFile = ""
+	s.NoError(s.roundTrip(s.serverTLS, s.clientTLS))
+}
+
+func (s *TLSSuite) TestClientCertRequired() {
+	s.clientTLS.CertFile = ""
+	s.clientTLS.KeyFile = ""
+	s.Error(s.roundTrip(s.serverTLS, s.clientTLS))
+}
+
+func (s *TLSSuite) TestUntrustedServer() {
+	s.clientTLS.CAFile = ""
+	s.Error(s.roundTrip(s.serverTLS, s.clientTLS))
+}
+
+func (s *TLSSuite) TestServerCertRequired() {
+	s.serverTLS.CertFile = ""
+	_, err := s.serverTLS.NewServerTLSConfig()
+	s.Error(err)
+}
+
+// roundTrip sends a byte from a client to a server over tls, and returns the first error seen by the client
+func (s *TLSSuite) roundTrip(server *TLS, client *TLS) error {
+	serverConfig, err := server.NewServerTLSConfig()
+	s.NoError(err)
+	clientConfig, err := client.NewClientTLSConfig()
+	s.NoError(err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	s.NoError(err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 1)
+		if _, err := conn.Read(buf); err == nil {
+			conn.Write(buf)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := NewTLSDialer(clientConfig)(ctx, "tcp", listener.Addr().String())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte{1}); err != nil {
+		return err
+	}
+	_, err = conn.Read(make([]byte, 1))
+	return err
+}
+
+func (s *TLSSuite) newTemplate(commonName string) *x509.Certificate {
+	s.serialNums++
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(s.serialNums),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+}
+
+// newCert creates a certificate signed by the test CA, and returns the paths of the certificate and key files
+func (s *TLSSuite) newCert(name string) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	template := s.newTemplate(name)
+	template.IPAddresses = []net.IP{net.IPv4(127, 0, 0, 1)}
+	template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, &key.PublicKey, s.caKey)
+	s.NoError(err)
+	certFile := s.writePEM(name+".pem", "CERTIFICATE", der)
+	keyFile := s.writePEM(name+"-key.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key))
+	return certFile, keyFile
+}
+
+func (s *TLSSuite) writePEM(fileName string, blockType string, bytes []byte) string {
+	path := filepath.Join(s.dir, fileName)
+	s.NoError(ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600))
+	return path
+}
@@ -24,8 +24,7 @@ import (
 	"errors"
 	"time"
 
-	"github.com/sirupsen/logrus"
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
 )
 
 // Client allows fetching values from a dynamic configuration system NOTE: This does not have async
@@ -93,5 +92,5 @@ func NewNopClient() Client {
 
 // NewNopCollection creates a new nop collection
 func NewNopCollection() *Collection {
-	return NewCollection(&nopClient{}, bark.NewLoggerFromLogrus(logrus.New()))
+	return NewCollection(&nopClient{}, logging.NewDevelopmentLogger())
 }
@@ -23,11 +23,12 @@ package dynamicconfig
 import (
 	"time"
 
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 )
 
 // NewCollection creates a new collection
-func NewCollection(client Client, logger bark.Logger) *Collection {
+func NewCollection(client Client, logger logging.Logger) *Collection {
 	return &Collection{client, logger}
 }
 
@@ -36,11 +37,12 @@ func NewCollection(client Client, logger bark.Logger) *Collection {
 // code
 type Collection struct {
 	client Client
-	logger bark.Logger
+	logger logging.Logger
 }
 
 func (c *Collection) logNoValue(key Key, err error) {
-	c.logger.Debugf("Failed to fetch key: %s from dynamic config with err: %s", key.String(), err.Error())
+	c.logger.Debug("Failed to fetch key from dynamic config", tag.Key(key.String()), tag.Error(err))
+
 }
 
 // PropertyFn is a wrapper to get property from dynamic config
@@ -58,6 +60,9 @@ type DurationPropertyFn func(opts ...FilterOption) time.Duration
 // BoolPropertyFn is a wrapper to get bool property from dynamic config
 type BoolPropertyFn func(opts ...FilterOption) bool
 
+// StringPropertyFn is a wrapper to get string property from dynamic config
+type StringPropertyFn func(opts ...FilterOption) string
+
 // GetProperty gets a eface property and returns defaultValue if property is not found
 func (c *Collection) GetProperty(key Key, defaultValue interface{}) PropertyFn {
 	return func() interface{} {
@@ -121,3 +126,14 @@ func (c *Collection) GetBoolProperty(key Key, defaultValue bool) BoolPropertyFn
 		return val
 	}
 }
+
+// GetStringProperty gets property and asserts that it's a string
+func (c *Collection) GetStringProperty(key Key, defaultValue string) StringPropertyFn {
+	return func(opts ...FilterOption) string {
+		val, err := c.client.GetStringValue(key, getFilterMap(opts...), defaultValue)
+		if err != nil {
+			c.logNoValue(key, err)
+		}
+		return val
+	}
+}
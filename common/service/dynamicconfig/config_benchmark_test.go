@@ -23,14 +23,13 @@ package dynamicconfig
 import (
 	"testing"
 
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
 )
 
 func BenchmarkGetIntProperty(b *testing.B) {
 	client := newInMemoryClient()
-	cln := NewCollection(client, bark.NewLoggerFromLogrus(logrus.New()))
+	cln := NewCollection(client, logging.NewDevelopmentLogger())
 	key := MatchingMaxTaskBatchSize
 	for i := 0; i < b.N; i++ {
 		size := cln.GetIntProperty(key, 10)
@@ -26,9 +26,8 @@ import (
 	"testing"
 	"time"
 
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
 )
 
 type inMemoryClient struct {
@@ -102,7 +101,7 @@ func TestConfigSuite(t *testing.T) {
 
 func (s *configSuite) SetupSuite() {
 	s.client = newInMemoryClient()
-	s.cln = NewCollection(s.client, bark.NewLoggerFromLogrus(logrus.New()))
+	s.cln = NewCollection(s.client, logging.NewDevelopmentLogger())
 }
 
 func (s *configSuite) TestGetPropertyInt() {
@@ -118,3 +117,9 @@ func (s *configSuite) TestGetDurationProperty() {
 	interval := s.cln.GetDurationProperty(key, time.Second)
 	s.Equal(time.Second, interval())
 }
+
+func (s *configSuite) TestGetStringProperty() {
+	key := HistoryEventEncodingType
+	encodingType := s.cln.GetStringProperty(key, "json")
+	s.Equal("json", encodingType())
+}
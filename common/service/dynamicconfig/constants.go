@@ -30,7 +30,18 @@ func (k Key) String() string {
 	return keys[k]
 }
 
+// parseKey returns the Key for the given key name, or unknownKey if there is none
+func parseKey(name string) Key {
+	for i := range keys {
+		if Key(i) != unknownKey && keys[i] == name {
+			return Key(i)
+		}
+	}
+	return unknownKey
+}
+
 const (
+	_frontendRoot               = "frontend."
 	_matchingRoot               = "matching."
 	_matchingDomainTaskListRoot = _matchingRoot + "domain." + "taskList."
 	_historyRoot                = "history."
@@ -38,6 +49,8 @@ const (
 
 var keys = []string{
 	"unknownKey",
+	_frontendRoot + "domainRPS",
+	_frontendRoot + "domainPollRPS",
 	_matchingDomainTaskListRoot + "minTaskThrottlingBurstSize",
 	_matchingDomainTaskListRoot + "maxTaskBatchSize",
 	_matchingDomainTaskListRoot + "longPollExpirationInterval",
@@ -45,11 +58,19 @@ var keys = []string{
 	_matchingDomainTaskListRoot + "updateAckInterval",
 	_matchingDomainTaskListRoot + "idleTasklistCheckInterval",
 	_historyRoot + "longPollExpirationInterval",
+	_historyRoot + "eventEncodingType",
 }
 
 const (
 	// The order of constants is important. It should match the order in the keys array above.
 	unknownKey Key = iota
+	// Frontend keys
+
+	// FrontendDomainRPS is the per domain rps limit of the frontend for all APIs except polls
+	FrontendDomainRPS
+	// FrontendDomainPollRPS is the per domain rps limit of the frontend for poll APIs
+	FrontendDomainPollRPS
+
 	// Matching keys
 
 	// MatchingMinTaskThrottlingBurstSize is the minimum burst size for task list throttling
@@ -66,6 +87,8 @@ const (
 	MatchingIdleTasklistCheckInterval
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	HistoryLongPollExpirationInterval
+	// HistoryEventEncodingType is the encoding type used to persist history events
+	HistoryEventEncodingType
 )
 
 // Filter represents a filter on the dynamic config key
@@ -78,6 +101,16 @@ func (f Filter) String() string {
 	return filters[f]
 }
 
+// parseFilter returns the Filter for the given filter name, or unknownFilter if there is none
+func parseFilter(name string) Filter {
+	for i := range filters {
+		if Filter(i) != unknownFilter && filters[i] == name {
+			return Filter(i)
+		}
+	}
+	return unknownFilter
+}
+
 var filters = []string{
 	"unknownFilter",
 	"domainName",
@@ -0,0 +1,317 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	minPollInterval     = 5 * time.Second
+	defaultPollInterval = time.Minute
+)
+
+var errKeyNotFound = errors.New("unable to find key")
+
+type (
+	// FileBasedClientConfig is the config for the file based dynamic config client.
+	// The file is a yaml map from key name (as returned by Key.String()) to a list
+	// of values, each of which is optionally scoped by a set of constraints:
+	//
+	//   matching.domain.taskList.maxTaskBatchSize:
+	//   - value: 100
+	//   - value: 500
+	//     constraints:
+	//       domainName: "samples-domain"
+	//       taskListName: "helloWorldGroup"
+	//
+	FileBasedClientConfig struct {
+		// Filepath is the path to the yaml file holding the dynamic config values
+		Filepath string `yaml:"filepath"`
+		// PollInterval is the interval at which the file is checked for changes
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	constrainedValue struct {
+		Value       interface{}            `yaml:"value"`
+		Constraints map[string]interface{} `yaml:"constraints"`
+	}
+
+	fileBasedClient struct {
+		values          atomic.Value // map[string][]*constrainedValue
+		lastUpdatedTime time.Time
+		config          *FileBasedClientConfig
+		doneCh          chan struct{}
+		logger          logging.Logger
+	}
+)
+
+// NewFileBasedClient creates a file based client which loads the config file and then
+// re-reads it every PollInterval until doneCh is closed
+func NewFileBasedClient(config *FileBasedClientConfig, logger logging.Logger, doneCh chan struct{}) (Client, error) {
+	if err := validateFileBasedClientConfig(config); err != nil {
+		return nil, fmt.Errorf("invalid dynamic config client config: %v", err)
+	}
+
+	client := &fileBasedClient{
+		config: config,
+		doneCh: doneCh,
+		logger: logger.WithTags(tag.FilePath(config.Filepath)),
+	}
+	if err := client.update(); err != nil {
+		return nil, err
+	}
+
+	go client.pollLoop()
+	return client, nil
+}
+
+func (fc *fileBasedClient) GetValue(name Key, defaultValue interface{}) (interface{}, error) {
+	return fc.getValueWithFilters(name, nil, defaultValue)
+}
+
+func (fc *fileBasedClient) GetValueWithFilters(
+	name Key, filters map[Filter]interface{}, defaultValue interface{},
+) (interface{}, error) {
+	return fc.getValueWithFilters(name, filters, defaultValue)
+}
+
+func (fc *fileBasedClient) GetIntValue(name Key, filters map[Filter]interface{}, defaultValue int) (int, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if intVal, ok := val.(int); ok {
+		return intVal, nil
+	}
+	return defaultValue, fmt.Errorf("value type for key %v is not int: %v", name, val)
+}
+
+func (fc *fileBasedClient) GetFloatValue(name Key, filters map[Filter]interface{}, defaultValue float64) (float64, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	switch v := val.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	}
+	return defaultValue, fmt.Errorf("value type for key %v is not float: %v", name, val)
+}
+
+func (fc *fileBasedClient) GetBoolValue(name Key, filters map[Filter]interface{}, defaultValue bool) (bool, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if boolVal, ok := val.(bool); ok {
+		return boolVal, nil
+	}
+	return defaultValue, fmt.Errorf("value type for key %v is not bool: %v", name, val)
+}
+
+func (fc *fileBasedClient) GetStringValue(name Key, filters map[Filter]interface{}, defaultValue string) (string, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	if stringVal, ok := val.(string); ok {
+		return stringVal, nil
+	}
+	return defaultValue, fmt.Errorf("value type for key %v is not string: %v", name, val)
+}
+
+func (fc *fileBasedClient) GetMapValue(
+	name Key, filters map[Filter]interface{}, defaultValue map[string]interface{},
+) (map[string]interface{}, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	switch v := val.(type) {
+	case map[string]interface{}:
+		return v, nil
+	case map[interface{}]interface{}:
+		// yaml decodes nested maps with interface{} keys
+		mapVal := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			stringKey, ok := key.(string)
+			if !ok {
+				return defaultValue, fmt.Errorf("map key type for key %v is not string: %v", name, key)
+			}
+			mapVal[stringKey] = value
+		}
+		return mapVal, nil
+	}
+	return defaultValue, fmt.Errorf("value type for key %v is not map: %v", name, val)
+}
+
+func (fc *fileBasedClient) GetDurationValue(
+	name Key, filters map[Filter]interface{}, defaultValue time.Duration,
+) (time.Duration, error) {
+	val, err := fc.getValueWithFilters(name, filters, defaultValue)
+	if err != nil {
+		return defaultValue, err
+	}
+
+	durationString, ok := val.(string)
+	if !ok {
+		return defaultValue, fmt.Errorf("value type for key %v is not string: %v", name, val)
+	}
+	durationVal, err := time.ParseDuration(durationString)
+	if err != nil {
+		return defaultValue, fmt.Errorf("failed to parse duration for key %v: %v", name, err)
+	}
+	return durationVal, nil
+}
+
+// getValueWithFilters returns the value with the most specific set of constraints
+// which are all satisfied by the given filters. A value without constraints acts
+// as the default for the key.
+func (fc *fileBasedClient) getValueWithFilters(
+	name Key, filters map[Filter]interface{}, defaultValue interface{},
+) (interface{}, error) {
+	values := fc.values.Load().(map[string][]*constrainedValue)
+	found := false
+	matchedConstraints := 0
+	result := defaultValue
+	for _, v := range values[name.String()] {
+		if !match(v, filters) {
+			continue
+		}
+		if !found || len(v.Constraints) > matchedConstraints {
+			found = true
+			matchedConstraints = len(v.Constraints)
+			result = v.Value
+		}
+	}
+
+	if !found {
+		return defaultValue, errKeyNotFound
+	}
+	return result, nil
+}
+
+func (fc *fileBasedClient) pollLoop() {
+	ticker := time.NewTicker(fc.config.PollInterval)
+	for {
+		select {
+		case <-ticker.C:
+			if err := fc.update(); err != nil {
+				fc.logger.Error("Failed to update dynamic config", tag.Error(err))
+			}
+		case <-fc.doneCh:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func (fc *fileBasedClient) update() error {
+	info, err := os.Stat(fc.config.Filepath)
+	if err != nil {
+		return fmt.Errorf("failed to get status of dynamic config file: %v", err)
+	}
+	if !info.ModTime().After(fc.lastUpdatedTime) {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(fc.config.Filepath)
+	if err != nil {
+		return fmt.Errorf("failed to read dynamic config file: %v", err)
+	}
+
+	newValues := make(map[string][]*constrainedValue)
+	if err := yaml.Unmarshal(content, newValues); err != nil {
+		return fmt.Errorf("failed to decode dynamic config: %v", err)
+	}
+	if err := validateValues(newValues); err != nil {
+		return err
+	}
+
+	fc.values.Store(newValues)
+	fc.lastUpdatedTime = info.ModTime()
+	fc.logger.Info("Updated dynamic config")
+	return nil
+}
+
+func match(v *constrainedValue, filters map[Filter]interface{}) bool {
+	if len(v.Constraints) > len(filters) {
+		return false
+	}
+	for name, value := range v.Constraints {
+		if filters[parseFilter(name)] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func validateValues(values map[string][]*constrainedValue) error {
+	for key, list := range values {
+		if parseKey(key) == unknownKey {
+			return fmt.Errorf("unknown dynamic config key: %v", key)
+		}
+		for _, v := range list {
+			if v == nil {
+				return fmt.Errorf("empty value for dynamic config key: %v", key)
+			}
+			for name := range v.Constraints {
+				if parseFilter(name) == unknownFilter {
+					return fmt.Errorf("unknown constraint %v for dynamic config key: %v", name, key)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateFileBasedClientConfig(config *FileBasedClientConfig) error {
+	if config == nil {
+		return errors.New("no config found for file based dynamic config client")
+	}
+	if _, err := os.Stat(config.Filepath); err != nil {
+		return fmt.Errorf("error checking dynamic config file at path %v, error: %v", config.Filepath, err)
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.PollInterval < minPollInterval {
+		return fmt.Errorf("poll interval should be at least %v", minPollInterval)
+	}
+	return nil
+}
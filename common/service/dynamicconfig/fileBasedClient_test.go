@@ -0,0 +1,178 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/logging"
+)
+
+const testConfigContent = `
+matching.domain.taskList.maxTaskBatchSize:
+- value: 1000
+- value: 100
+  constraints:
+    domainName: "test-domain"
+- value: 10
+  constraints:
+    domainName: "test-domain"
+    taskListName: "test-tasklist"
+matching.domain.taskList.enableSyncMatch:
+- value: false
+matching.domain.taskList.longPollExpirationInterval:
+- value: "30s"
+history.longPollExpirationInterval:
+- value: "not a duration"
+`
+
+type fileBasedClientSuite struct {
+	suite.Suite
+	configFile *os.File
+	doneCh     chan struct{}
+	client     Client
+}
+
+func TestFileBasedClientSuite(t *testing.T) {
+	s := new(fileBasedClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *fileBasedClientSuite) SetupTest() {
+	var err error
+	s.configFile, err = ioutil.TempFile("", "dynamicconfig")
+	s.Nil(err)
+	_, err = s.configFile.WriteString(testConfigContent)
+	s.Nil(err)
+
+	s.doneCh = make(chan struct{})
+	s.client, err = NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     s.configFile.Name(),
+		PollInterval: minPollInterval,
+	}, logging.NewDevelopmentLogger(), s.doneCh)
+	s.Nil(err)
+}
+
+func (s *fileBasedClientSuite) TearDownTest() {
+	close(s.doneCh)
+	s.configFile.Close()
+	os.Remove(s.configFile.Name())
+}
+
+func (s *fileBasedClientSuite) TestGetIntValue() {
+	v, err := s.client.GetIntValue(MatchingMaxTaskBatchSize, nil, 1)
+	s.Nil(err)
+	s.Equal(1000, v)
+
+	v, err = s.client.GetIntValue(MatchingMaxTaskBatchSize, map[Filter]interface{}{DomainName: "other-domain"}, 1)
+	s.Nil(err)
+	s.Equal(1000, v)
+
+	v, err = s.client.GetIntValue(MatchingMaxTaskBatchSize, map[Filter]interface{}{DomainName: "test-domain"}, 1)
+	s.Nil(err)
+	s.Equal(100, v)
+
+	v, err = s.client.GetIntValue(MatchingMaxTaskBatchSize, map[Filter]interface{}{
+		DomainName:   "test-domain",
+		TaskListName: "test-tasklist",
+	}, 1)
+	s.Nil(err)
+	s.Equal(10, v)
+}
+
+func (s *fileBasedClientSuite) TestGetIntValue_NotFound() {
+	v, err := s.client.GetIntValue(MatchingMinTaskThrottlingBurstSize, nil, 1)
+	s.NotNil(err)
+	s.Equal(1, v)
+}
+
+func (s *fileBasedClientSuite) TestGetIntValue_WrongType() {
+	v, err := s.client.GetIntValue(MatchingEnableSyncMatch, nil, 1)
+	s.NotNil(err)
+	s.Equal(1, v)
+}
+
+func (s *fileBasedClientSuite) TestGetBoolValue() {
+	v, err := s.client.GetBoolValue(MatchingEnableSyncMatch, nil, true)
+	s.Nil(err)
+	s.False(v)
+}
+
+func (s *fileBasedClientSuite) TestGetDurationValue() {
+	v, err := s.client.GetDurationValue(MatchingLongPollExpirationInterval, nil, time.Second)
+	s.Nil(err)
+	s.Equal(30*time.Second, v)
+
+	v, err = s.client.GetDurationValue(HistoryLongPollExpirationInterval, nil, time.Second)
+	s.NotNil(err)
+	s.Equal(time.Second, v)
+}
+
+func (s *fileBasedClientSuite) TestUpdate() {
+	fc := s.client.(*fileBasedClient)
+	err := ioutil.WriteFile(s.configFile.Name(), []byte(`
+matching.domain.taskList.maxTaskBatchSize:
+- value: 2000
+`), 0644)
+	s.Nil(err)
+	// make sure the modification time moves forward on file systems with coarse timestamps
+	modTime := fc.lastUpdatedTime.Add(time.Second)
+	s.Nil(os.Chtimes(s.configFile.Name(), modTime, modTime))
+
+	s.Nil(fc.update())
+	v, err := s.client.GetIntValue(MatchingMaxTaskBatchSize, map[Filter]interface{}{DomainName: "test-domain"}, 1)
+	s.Nil(err)
+	s.Equal(2000, v)
+}
+
+func (s *fileBasedClientSuite) TestUpdate_InvalidKey() {
+	fc := s.client.(*fileBasedClient)
+	err := ioutil.WriteFile(s.configFile.Name(), []byte(`
+some.unknown.key:
+- value: 2000
+`), 0644)
+	s.Nil(err)
+	modTime := fc.lastUpdatedTime.Add(time.Second)
+	s.Nil(os.Chtimes(s.configFile.Name(), modTime, modTime))
+
+	s.NotNil(fc.update())
+	// old values are retained on a bad update
+	v, err := s.client.GetIntValue(MatchingMaxTaskBatchSize, nil, 1)
+	s.Nil(err)
+	s.Equal(1000, v)
+}
+
+func (s *fileBasedClientSuite) TestNewFileBasedClient_InvalidConfig() {
+	_, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath: "/non/existent/file.yaml",
+	}, logging.NewDevelopmentLogger(), s.doneCh)
+	s.NotNil(err)
+
+	_, err = NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     s.configFile.Name(),
+		PollInterval: time.Millisecond,
+	}, logging.NewDevelopmentLogger(), s.doneCh)
+	s.NotNil(err)
+}
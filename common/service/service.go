@@ -21,21 +21,25 @@
 package service
 
 import (
+	"crypto/tls"
 	"math/rand"
 	"os"
 	"time"
 
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 
-	"github.com/uber-common/bark"
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber-go/tally"
 	ringpop "github.com/uber/ringpop-go"
 	"go.uber.org/yarpc"
@@ -52,17 +56,30 @@ type (
 	// BootstrapParams holds the set of parameters
 	// needed to bootstrap a service
 	BootstrapParams struct {
-		Name             string
-		Logger           bark.Logger
-		MetricScope      tally.Scope
-		RingpopFactory   RingpopFactory
-		RPCFactory       common.RPCFactory
-		PProfInitializer common.PProfInitializer
-		CassandraConfig  config.Cassandra
-		ClusterMetadata  cluster.Metadata
-		ReplicatorConfig config.Replicator
-		MessagingClient  messaging.Client
-		DynamicConfig    dynamicconfig.Client
+		Name              string
+		Logger            logging.Logger
+		MetricScope       tally.Scope
+		RingpopFactory    RingpopFactory
+		RPCFactory        common.RPCFactory
+		PProfInitializer  common.PProfInitializer
+		CassandraConfig   config.Cassandra
+		PersistenceConfig config.Persistence
+		ClusterMetadata   cluster.Metadata
+		ReplicatorConfig  config.Replicator
+		MessagingClient   messaging.Client
+		DynamicConfig     dynamicconfig.Client
+		BlobstoreClient   blobstore.Client
+		Authorizer        authorization.Authorizer
+
+		// HTTPGatewayAddress is the address of the frontend HTTP gateway, empty when it is disabled
+		HTTPGatewayAddress string
+		// HTTPGatewayTLSConfig is the tls config of the frontend HTTP gateway, nil when it serves plain HTTP
+		HTTPGatewayTLSConfig *tls.Config
+		// Tracer traces the requests handled by the service, nil disables tracing
+		Tracer opentracing.Tracer
+		// ClusterFrontendAddresses maps the name of the clusters to the address of their frontend, it is used to
+		// reach the admin API of the remote clusters
+		ClusterFrontendAddresses map[string]string
 	}
 
 	// RingpopFactory provides a bootstrapped ringpop
@@ -73,24 +90,27 @@ type (
 
 	// Service contains the objects specific to this service
 	serviceImpl struct {
-		sName                  string
-		hostName               string
-		hostInfo               *membership.HostInfo
-		dispatcher             *yarpc.Dispatcher
-		rp                     *ringpop.Ringpop
-		rpFactory              RingpopFactory
-		membershipMonitor      membership.Monitor
-		rpcFactory             common.RPCFactory
-		pprofInitializer       common.PProfInitializer
-		clientFactory          client.Factory
-		numberOfHistoryShards  int
-		logger                 bark.Logger
-		metricsScope           tally.Scope
-		runtimeMetricsReporter *metrics.RuntimeMetricsReporter
-		metricsClient          metrics.Client
-		clusterMetadata        cluster.Metadata
-		messagingClient        messaging.Client
-		dynamicCollection      *dynamicconfig.Collection
+		sName                    string
+		hostName                 string
+		hostInfo                 *membership.HostInfo
+		dispatcher               *yarpc.Dispatcher
+		rp                       *ringpop.Ringpop
+		rpFactory                RingpopFactory
+		membershipMonitor        membership.Monitor
+		rpcFactory               common.RPCFactory
+		pprofInitializer         common.PProfInitializer
+		clientFactory            client.Factory
+		numberOfHistoryShards    int
+		logger                   logging.Logger
+		metricsScope             tally.Scope
+		runtimeMetricsReporter   *metrics.RuntimeMetricsReporter
+		metricsClient            metrics.Client
+		clusterMetadata          cluster.Metadata
+		messagingClient          messaging.Client
+		blobstoreClient          blobstore.Client
+		dynamicCollection        *dynamicconfig.Collection
+		tracer                   opentracing.Tracer
+		clusterFrontendAddresses map[string]string
 	}
 )
 
@@ -98,16 +118,22 @@ type (
 // TODO: have a better name for Service.
 func New(params *BootstrapParams) Service {
 	sVice := &serviceImpl{
-		sName:                 params.Name,
-		logger:                params.Logger.WithField("Service", params.Name),
-		rpcFactory:            params.RPCFactory,
-		rpFactory:             params.RingpopFactory,
-		pprofInitializer:      params.PProfInitializer,
-		metricsScope:          params.MetricScope,
-		numberOfHistoryShards: params.CassandraConfig.NumHistoryShards,
-		clusterMetadata:       params.ClusterMetadata,
-		messagingClient:       params.MessagingClient,
-		dynamicCollection:     dynamicconfig.NewCollection(params.DynamicConfig, params.Logger),
+		sName:                    params.Name,
+		logger:                   params.Logger.WithTags(tag.Service(params.Name)),
+		rpcFactory:               params.RPCFactory,
+		rpFactory:                params.RingpopFactory,
+		pprofInitializer:         params.PProfInitializer,
+		metricsScope:             params.MetricScope,
+		numberOfHistoryShards:    params.CassandraConfig.NumHistoryShards,
+		clusterMetadata:          params.ClusterMetadata,
+		messagingClient:          params.MessagingClient,
+		blobstoreClient:          params.BlobstoreClient,
+		dynamicCollection:        dynamicconfig.NewCollection(params.DynamicConfig, params.Logger),
+		tracer:                   params.Tracer,
+		clusterFrontendAddresses: params.ClusterFrontendAddresses,
+	}
+	if sVice.tracer == nil {
+		sVice.tracer = opentracing.NoopTracer{}
 	}
 	sVice.runtimeMetricsReporter = metrics.NewRuntimeMetricsReporter(params.MetricScope, time.Minute, sVice.logger)
 	sVice.metricsClient = metrics.NewClient(params.MetricScope, getMetricsServiceIdx(params.Name, params.Logger))
@@ -118,7 +144,7 @@ func New(params *BootstrapParams) Service {
 
 	// Get the host name and set it on the service.  This is used for emitting metric with a tag for hostname
 	if hostName, err := os.Hostname(); err != nil {
-		sVice.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("Error getting hostname")
+		sVice.logger.Fatal("Error getting hostname", tag.Error(err))
 	} else {
 		sVice.hostName = hostName
 	}
@@ -138,42 +164,42 @@ func (h *serviceImpl) Start() {
 	h.runtimeMetricsReporter.Start()
 
 	if err := h.pprofInitializer.Start(); err != nil {
-		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("Failed to start pprof")
+		h.logger.Fatal("Failed to start pprof", tag.Error(err))
 	}
 
 	if err := h.dispatcher.Start(); err != nil {
-		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("Failed to start yarpc dispatcher")
+		h.logger.Fatal("Failed to start yarpc dispatcher", tag.Error(err))
 	}
 
 	// use actual listen port (in case service is bound to :0 or 0.0.0.0:0)
 	h.rp, err = h.rpFactory.CreateRingpop(h.dispatcher)
 	if err != nil {
-		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("Ringpop creation failed")
+		h.logger.Fatal("Ringpop creation failed", tag.Error(err))
 	}
 
 	labels, err := h.rp.Labels()
 	if err != nil {
-		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("Ringpop get node labels failed")
+		h.logger.Fatal("Ringpop get node labels failed", tag.Error(err))
 	}
 	err = labels.Set(membership.RoleKey, h.sName)
 	if err != nil {
-		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("Ringpop setting role label failed")
+		h.logger.Fatal("Ringpop setting role label failed", tag.Error(err))
 	}
 
 	h.membershipMonitor = membership.NewRingpopMonitor(cadenceServices, h.rp, h.logger)
 	err = h.membershipMonitor.Start()
 	if err != nil {
-		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("starting membership monitor failed")
+		h.logger.Fatal("starting membership monitor failed", tag.Error(err))
 	}
 
 	hostInfo, err := h.membershipMonitor.WhoAmI()
 	if err != nil {
-		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("failed to get host info from membership monitor")
+		h.logger.Fatal("failed to get host info from membership monitor", tag.Error(err))
 	}
 	h.hostInfo = hostInfo
 
 	h.clientFactory = client.NewRPCClientFactory(h.rpcFactory, h.membershipMonitor, h.metricsClient,
-		h.numberOfHistoryShards)
+		h.numberOfHistoryShards, h.clusterFrontendAddresses)
 
 	// The service is now started up
 	h.logger.Info("service started")
@@ -200,7 +226,7 @@ func (h *serviceImpl) Stop() {
 }
 
 // GetLogger returns the service logger
-func (h *serviceImpl) GetLogger() bark.Logger {
+func (h *serviceImpl) GetLogger() logging.Logger {
 	return h.logger
 }
 
@@ -234,7 +260,17 @@ func (h *serviceImpl) GetMessagingClient() messaging.Client {
 	return h.messagingClient
 }
 
-func getMetricsServiceIdx(serviceName string, logger bark.Logger) metrics.ServiceIdx {
+// GetBlobstoreClient returns the blob store client used for archival, nil when archival is not configured
+func (h *serviceImpl) GetBlobstoreClient() blobstore.Client {
+	return h.blobstoreClient
+}
+
+// GetTracer returns the tracer of the service
+func (h *serviceImpl) GetTracer() opentracing.Tracer {
+	return h.tracer
+}
+
+func getMetricsServiceIdx(serviceName string, logger logging.Logger) metrics.ServiceIdx {
 	switch serviceName {
 	case common.FrontendServiceName:
 		return metrics.Frontend
@@ -245,7 +281,8 @@ func getMetricsServiceIdx(serviceName string, logger bark.Logger) metrics.Servic
 	case common.WorkerServiceName:
 		return metrics.Worker
 	default:
-		logger.Fatalf("Unknown service name '%v' for metrics!", serviceName)
+		logger.Fatal("Unknown service name for metrics!", tag.Service(serviceName))
+
 	}
 
 	// this should never happen!
@@ -22,12 +22,14 @@ package service
 
 import (
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 
-	"github.com/uber-common/bark"
+	"github.com/opentracing/opentracing-go"
 
 	"go.uber.org/yarpc"
 )
@@ -42,7 +44,7 @@ type (
 		membershipMonitor membership.Monitor
 
 		metrics metrics.Client
-		logger  bark.Logger
+		logger  logging.Logger
 	}
 )
 
@@ -58,7 +60,7 @@ var (
 
 // NewTestService is the new service instance created for testing
 func NewTestService(clusterMetadata cluster.Metadata, messagingClient messaging.Client, metrics metrics.Client,
-	logger bark.Logger) Service {
+	logger logging.Logger) Service {
 	return &serviceTestBase{
 		hostInfo:        testHostInfo,
 		clusterMetadata: clusterMetadata,
@@ -82,7 +84,7 @@ func (s *serviceTestBase) Stop() {
 }
 
 // GetLogger returns the logger for service
-func (s *serviceTestBase) GetLogger() bark.Logger {
+func (s *serviceTestBase) GetLogger() logging.Logger {
 	return s.logger
 }
 
@@ -120,3 +122,13 @@ func (s *serviceTestBase) GetClusterMetadata() cluster.Metadata {
 func (s *serviceTestBase) GetMessagingClient() messaging.Client {
 	return s.messagingClient
 }
+
+// GetBlobstoreClient returns the blob store client used for archival
+func (s *serviceTestBase) GetBlobstoreClient() blobstore.Client {
+	return nil
+}
+
+// GetTracer returns the tracer of the service
+func (s *serviceTestBase) GetTracer() opentracing.Tracer {
+	return opentracing.NoopTracer{}
+}
@@ -21,9 +21,11 @@
 package service
 
 import (
-	"github.com/uber-common/bark"
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
@@ -42,7 +44,7 @@ type (
 		// Stop stops the service
 		Stop()
 
-		GetLogger() bark.Logger
+		GetLogger() logging.Logger
 
 		GetMetricsClient() metrics.Client
 
@@ -59,5 +61,11 @@ type (
 
 		// GetMessagingClient returns the messaging client against Kafka
 		GetMessagingClient() messaging.Client
+
+		// GetBlobstoreClient returns the blob store client used for archival, nil when archival is not configured
+		GetBlobstoreClient() blobstore.Client
+
+		// GetTracer returns the tracer of the service, a noop tracer when tracing is disabled
+		GetTracer() opentracing.Tracer
 	}
 )
@@ -0,0 +1,128 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/log"
+	jaeger "github.com/uber/jaeger-client-go"
+)
+
+type (
+	// Config is the config for tracing the rpc calls, persistence calls and
+	// queue tasks of the services
+	Config struct {
+		// Exporter is where the finished spans go, one of noop, memory or jaeger.
+		// Tracing is disabled when it is not set
+		Exporter string `yaml:"exporter"`
+		// JaegerAgentHostPort is the host and port of the jaeger agent the jaeger exporter sends spans to
+		JaegerAgentHostPort string `yaml:"jaegerAgentHostPort"`
+		// SamplingRate is the fraction of the traces which are sampled, every trace is sampled when it is not set
+		SamplingRate float64 `yaml:"samplingRate"`
+	}
+
+	nopCloser struct{}
+)
+
+const (
+	// ExporterNoop drops every span
+	ExporterNoop = "noop"
+	// ExporterMemory keeps the finished spans in memory, it is meant for tests and local debugging
+	ExporterMemory = "memory"
+	// ExporterJaeger sends the finished spans to a jaeger agent
+	ExporterJaeger = "jaeger"
+)
+
+// NewTracer creates the tracer of a service with the configured exporter,
+// the closer flushes the spans which are not exported yet
+func (c *Config) NewTracer(serviceName string) (opentracing.Tracer, io.Closer, error) {
+	switch c.Exporter {
+	case "", ExporterNoop:
+		return opentracing.NoopTracer{}, nopCloser{}, nil
+	case ExporterMemory:
+		sampler, err := c.newSampler()
+		if err != nil {
+			return nil, nil, err
+		}
+		tracer, closer := jaeger.NewTracer(serviceName, sampler, jaeger.NewInMemoryReporter())
+		return tracer, closer, nil
+	case ExporterJaeger:
+		sampler, err := c.newSampler()
+		if err != nil {
+			return nil, nil, err
+		}
+		transport, err := jaeger.NewUDPTransport(c.JaegerAgentHostPort, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		tracer, closer := jaeger.NewTracer(serviceName, sampler, jaeger.NewRemoteReporter(transport))
+		return tracer, closer, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown tracing exporter %v", c.Exporter)
+	}
+}
+
+// NewInMemoryTracer creates a tracer sampling every trace and keeping the finished spans in the returned reporter
+func NewInMemoryTracer(serviceName string) (opentracing.Tracer, *jaeger.InMemoryReporter) {
+	reporter := jaeger.NewInMemoryReporter()
+	tracer, _ := jaeger.NewTracer(serviceName, jaeger.NewConstSampler(true), reporter)
+	return tracer, reporter
+}
+
+// StartSpan starts a span which is a child of the span carried by the context, if any,
+// and returns a context carrying the new span. Rpc calls made with the returned
+// context are traced as children of the span
+func StartSpan(ctx context.Context, tracer opentracing.Tracer, operation string) (opentracing.Span, context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var opts []opentracing.StartSpanOption
+	if parent := opentracing.SpanFromContext(ctx); parent != nil {
+		opts = append(opts, opentracing.ChildOf(parent.Context()))
+	}
+	span := tracer.StartSpan(operation, opts...)
+	return span, opentracing.ContextWithSpan(ctx, span)
+}
+
+// FinishSpan marks the span as failed when there is an error, and finishes it
+func FinishSpan(span opentracing.Span, err error) {
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogFields(log.Error(err))
+	}
+	span.Finish()
+}
+
+func (c *Config) newSampler() (jaeger.Sampler, error) {
+	if c.SamplingRate == 0 {
+		return jaeger.NewConstSampler(true), nil
+	}
+	return jaeger.NewProbabilisticSampler(c.SamplingRate)
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	jaeger "github.com/uber/jaeger-client-go"
+)
+
+type (
+	tracingSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestTracingSuite(t *testing.T) {
+	suite.Run(t, new(tracingSuite))
+}
+
+func (s *tracingSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *tracingSuite) TestNewTracer() {
+	tracer, closer, err := (&Config{}).NewTracer("cadence-frontend")
+	s.NoError(err)
+	s.Equal(opentracing.NoopTracer{}, tracer)
+	s.NoError(closer.Close())
+
+	tracer, closer, err = (&Config{Exporter: ExporterMemory, SamplingRate: 0.5}).NewTracer("cadence-frontend")
+	s.NoError(err)
+	s.IsType(&jaeger.Tracer{}, tracer)
+	s.NoError(closer.Close())
+
+	_, _, err = (&Config{Exporter: "zipkin"}).NewTracer("cadence-frontend")
+	s.Error(err)
+}
+
+func (s *tracingSuite) TestStartSpan() {
+	tracer, reporter := NewInMemoryTracer("cadence-history")
+
+	parent, ctx := StartSpan(context.Background(), tracer, "StartWorkflowExecution")
+	child, childCtx := StartSpan(ctx, tracer, "persistence.CreateWorkflowExecution")
+	s.Equal(child, opentracing.SpanFromContext(childCtx))
+	FinishSpan(child, errors.New("condition failed"))
+	FinishSpan(parent, nil)
+
+	spans := reporter.GetSpans()
+	s.Len(spans, 2)
+	childSpan := spans[0].(*jaeger.Span)
+	parentSpan := spans[1].(*jaeger.Span)
+	s.Equal("persistence.CreateWorkflowExecution", childSpan.OperationName())
+	s.Equal(parentSpan.SpanContext().SpanID(), childSpan.SpanContext().ParentID())
+	s.Equal(parentSpan.SpanContext().TraceID(), childSpan.SpanContext().TraceID())
+	s.Equal(true, childSpan.Tags()["error"])
+	s.Nil(parentSpan.Tags()["error"])
+}
+
+func (s *tracingSuite) TestStartSpanWithoutParent() {
+	tracer, reporter := NewInMemoryTracer("cadence-history")
+
+	span, _ := StartSpan(context.Background(), tracer, "TransferActiveTask")
+	FinishSpan(span, nil)
+
+	spans := reporter.GetSpans()
+	s.Len(spans, 1)
+	s.Equal(jaeger.SpanID(0), spans[0].(*jaeger.Span).SpanContext().ParentID())
+}
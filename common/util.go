@@ -22,16 +22,18 @@ package common
 
 import (
 	"encoding/json"
+	"fmt"
 	"sync"
 	"time"
 
 	"golang.org/x/net/context"
 
 	farm "github.com/dgryski/go-farm"
-	"github.com/uber-common/bark"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 )
 
 const (
@@ -152,18 +154,54 @@ func WorkflowIDToHistoryShard(workflowID string, numberOfShards int) int {
 }
 
 // PrettyPrintHistory prints history in human readable format
-func PrettyPrintHistory(history *workflow.History, logger bark.Logger) {
+func PrettyPrintHistory(history *workflow.History, logger logging.Logger) {
 	data, err := json.MarshalIndent(history, "", "    ")
 
 	if err != nil {
-		logger.Errorf("Error serializing history: %v\n", err)
+		logger.Error("Error serializing history", tag.Error(err))
 	}
 
 	logger.Info("******************************************")
-	logger.Infof("History: %v", string(data))
+	logger.Info(string(data))
+
 	logger.Info("******************************************")
 }
 
+// ValidateSearchAttributes checks the number of search attributes and the size of their values against
+// the given limits, a BadRequestError is returned when any of the limits is exceeded
+func ValidateSearchAttributes(searchAttributes *workflow.SearchAttributes, numberOfKeysLimit, sizeOfValueLimit,
+	totalSizeLimit int) error {
+	if searchAttributes == nil {
+		return nil
+	}
+
+	indexedFields := searchAttributes.IndexedFields
+	if len(indexedFields) > numberOfKeysLimit {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("Number of search attributes %v exceeds the limit of %v.", len(indexedFields), numberOfKeysLimit),
+		}
+	}
+
+	totalSize := 0
+	for key, value := range indexedFields {
+		if key == "" {
+			return &workflow.BadRequestError{Message: "Search attribute key is not set."}
+		}
+		if len(value) > sizeOfValueLimit {
+			return &workflow.BadRequestError{
+				Message: fmt.Sprintf("Size of search attribute %v exceeds the limit of %v bytes.", key, sizeOfValueLimit),
+			}
+		}
+		totalSize += len(key) + len(value)
+	}
+	if totalSize > totalSizeLimit {
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("Total size of search attributes %v exceeds the limit of %v bytes.", totalSize, totalSizeLimit),
+		}
+	}
+	return nil
+}
+
 // IsValidContext checks that the thrift context is not expired on cancelled.
 // Returns nil if the context is still valid. Otherwise, returns the result of
 // ctx.Err()
@@ -29,11 +29,12 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 
 	wsc "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -50,7 +51,7 @@ type (
 		mockProducer        messaging.Producer
 		host                Cadence
 		engine              wsc.Interface
-		logger              bark.Logger
+		logger              logging.Logger
 		suite.Suite
 		persistence.TestBase
 	}
@@ -76,7 +77,7 @@ func (s *integrationCrossDCSuite) SetupSuite() {
 	formatter.FullTimestamp = true
 	logger.Formatter = formatter
 	//logger.Level = log.DebugLevel
-	s.logger = bark.NewLoggerFromLogrus(logger)
+	s.logger = logging.NewDevelopmentLogger()
 }
 
 func (s *integrationCrossDCSuite) TearDownSuite() {
@@ -101,6 +102,7 @@ func (s *integrationCrossDCSuite) setupTest(enableGlobalDomain bool, isMasterClu
 	options.SchemaDir = ".."
 	options.EnableGlobalDomain = enableGlobalDomain
 	options.IsMasterCluster = isMasterCluster
+	options.InMemory = *inMemoryPersistence
 	s.SetupWorkflowStoreWithOptions(options)
 
 	s.setupShards()
@@ -150,7 +152,7 @@ func (s *integrationCrossDCSuite) setupShards() {
 	for shardID := 1; shardID < testNumberOfHistoryShards; shardID++ {
 		err := s.CreateShard(shardID, "", 0)
 		if err != nil {
-			s.logger.WithField("error", err).Fatal("Failed to create shard")
+			s.logger.Fatal("Failed to create shard", tag.Error(err))
 		}
 	}
 }
@@ -37,13 +37,17 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/yarpcerrors"
 
+	"github.com/uber/cadence/.gen/go/admin"
 	wsc "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/proto/cadencepb"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -62,7 +66,7 @@ type (
 		mockProducer        messaging.Producer
 		host                Cadence
 		engine              wsc.Interface
-		logger              bark.Logger
+		logger              logging.Logger
 		suite.Suite
 		persistence.TestBase
 	}
@@ -84,7 +88,7 @@ type (
 		decisionHandler                     decisionTaskHandler
 		activityHandler                     activityTaskHandler
 		queryHandler                        queryHandler
-		logger                              bark.Logger
+		logger                              logging.Logger
 		suite                               *integrationSuite
 	}
 )
@@ -109,7 +113,7 @@ func (s *integrationSuite) SetupSuite() {
 	formatter.FullTimestamp = true
 	logger.Formatter = formatter
 	//logger.Level = log.DebugLevel
-	s.logger = bark.NewLoggerFromLogrus(logger)
+	s.logger = logging.NewDevelopmentLogger()
 	s.setupSuite(false, false)
 }
 
@@ -137,6 +141,7 @@ func (s *integrationSuite) setupSuite(enableGlobalDomain bool, isMasterCluster b
 	options.SchemaDir = ".."
 	options.EnableGlobalDomain = enableGlobalDomain
 	options.IsMasterCluster = isMasterCluster
+	options.InMemory = *inMemoryPersistence
 	s.SetupWorkflowStoreWithOptions(options)
 
 	s.setupShards()
@@ -258,7 +263,7 @@ func (s *integrationSuite) TestTerminateWorkflow() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	activityCount := int32(1)
 	activityCounter := int32(0)
@@ -310,7 +315,7 @@ func (s *integrationSuite) TestTerminateWorkflow() {
 	}
 
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	terminateReason := "terminate reason."
@@ -343,7 +348,7 @@ GetHistoryLoop:
 
 		lastEvent := history.Events[len(history.Events)-1]
 		if *lastEvent.EventType != workflow.EventTypeWorkflowExecutionTerminated {
-			s.logger.Warnf("Execution not terminated yet.")
+			s.logger.Warn("Execution not terminated yet.")
 			time.Sleep(100 * time.Millisecond)
 			continue GetHistoryLoop
 		}
@@ -375,13 +380,13 @@ StartNewExecutionLoop:
 
 		newExecution, err := s.engine.StartWorkflowExecution(createContext(), request)
 		if err != nil {
-			s.logger.Warnf("Start New Execution failed. Error: %v", err)
+			s.logger.Warn("Start New Execution failed", tag.Error(err))
 			time.Sleep(100 * time.Millisecond)
 			continue StartNewExecutionLoop
 		}
 
-		s.logger.Infof("New Execution Started with the same ID.  WorkflowID: %v, RunID: %v", id,
-			*newExecution.RunId)
+		s.logger.Info("New Execution Started with the same ID", tag.WorkflowID(id),
+			tag.WorkflowRunID(newExecution.GetRunId()))
 		newExecutionStarted = true
 		break StartNewExecutionLoop
 	}
@@ -417,7 +422,7 @@ func (s *integrationSuite) TestSequentialWorkflow() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	activityCount := int32(10)
@@ -482,14 +487,14 @@ func (s *integrationSuite) TestSequentialWorkflow() {
 
 	for i := 0; i < 10; i++ {
 		_, err := poller.pollAndProcessDecisionTask(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 		s.Nil(err)
 		if i%2 == 0 {
 			err = poller.pollAndProcessActivityTask(false)
 		} else { // just for testing respondActivityTaskCompleteByID
 			err = poller.pollAndProcessActivityTaskWithID(false)
 		}
-		s.logger.Infof("pollAndProcessActivityTask: %v", err)
+		s.logger.Info("pollAndProcessActivityTask", tag.Error(err))
 		s.Nil(err)
 	}
 
@@ -557,7 +562,7 @@ Loop:
 
 			events = history.Events
 			if events == nil || len(events) == 0 {
-				p.logger.Fatalf("History Events are empty: %v", events)
+				p.logger.Fatal("History Events are empty", tag.Value(events))
 			}
 
 			nextPageToken := response.NextPageToken
@@ -627,7 +632,7 @@ Loop:
 		executionCtx, decisions, err := p.decisionHandler(response.WorkflowExecution, response.WorkflowType,
 			common.Int64Default(response.PreviousStartedEventId), common.Int64Default(response.StartedEventId), response.History)
 		if err != nil {
-			p.logger.Infof("Failing Decision. Decision handler failed with error: %v", err)
+			p.logger.Info("Failing Decision. Decision handler failed", tag.Error(err))
 			return isQueryTask, p.engine.RespondDecisionTaskFailed(createContext(), &workflow.RespondDecisionTaskFailedRequest{
 				TaskToken: response.TaskToken,
 				Cause:     common.DecisionTaskFailedCausePtr(workflow.DecisionTaskFailedCauseWorkflowWorkerUnhandledFailure),
@@ -636,7 +641,7 @@ Loop:
 			})
 		}
 
-		p.logger.Infof("Completing Decision.  Decisions: %v", decisions)
+		p.logger.Info("Completing Decision", tag.Value(decisions))
 		if !respondStickyTaskList {
 			// non sticky tasklist
 			return false, p.engine.RespondDecisionTaskCompleted(createContext(), &workflow.RespondDecisionTaskCompletedRequest{
@@ -695,7 +700,7 @@ retry:
 			p.logger.Info("Dropping Activity task: ")
 			return nil
 		}
-		p.logger.Debugf("Received Activity task: %v", response)
+		p.logger.Debug("Received Activity task", tag.Value(response))
 
 		result, cancel, err2 := p.activityHandler(response.WorkflowExecution, response.ActivityType, *response.ActivityId,
 			response.Input, response.TaskToken)
@@ -759,7 +764,7 @@ retry:
 			p.logger.Info("Dropping Activity task: ")
 			return nil
 		}
-		p.logger.Debugf("Received Activity task: %v", response)
+		p.logger.Debug("Received Activity task", tag.Value(response))
 
 		result, cancel, err2 := p.activityHandler(response.WorkflowExecution, response.ActivityType, *response.ActivityId,
 			response.Input, response.TaskToken)
@@ -827,7 +832,7 @@ func (s *integrationSuite) TestDecisionAndActivityTimeoutsWorkflow() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	activityCount := int32(4)
@@ -870,7 +875,7 @@ func (s *integrationSuite) TestDecisionAndActivityTimeoutsWorkflow() {
 		activityID string, input []byte, taskToken []byte) ([]byte, bool, error) {
 		s.Equal(id, *execution.WorkflowId)
 		s.Equal(activityName, *activityType.Name)
-		s.logger.Infof("Activity ID: %v", activityID)
+		s.logger.Info("Activity ID", tag.WorkflowActivityID(activityID))
 		return []byte("Activity Result."), false, nil
 	}
 
@@ -887,7 +892,7 @@ func (s *integrationSuite) TestDecisionAndActivityTimeoutsWorkflow() {
 
 	for i := 0; i < 8; i++ {
 		dropDecisionTask := (i%2 == 0)
-		s.logger.Infof("Calling Decision Task: %d", i)
+		s.logger.Info("Calling Decision Task", tag.Counter(i))
 		var err error
 		if dropDecisionTask {
 			_, err = poller.pollAndProcessDecisionTask(true, true)
@@ -908,13 +913,13 @@ func (s *integrationSuite) TestDecisionAndActivityTimeoutsWorkflow() {
 		}
 		s.True(err == nil || err == matching.ErrNoTasks, "Error: %v", err)
 		if !dropDecisionTask {
-			s.logger.Infof("Calling Activity Task: %d", i)
+			s.logger.Info("Calling Activity Task", tag.Counter(i))
 			err = poller.pollAndProcessActivityTask(i%4 == 0)
 			s.True(err == nil || err == matching.ErrNoTasks)
 		}
 	}
 
-	s.logger.Infof("Waiting for workflow to complete: RunId: %v", *we.RunId)
+	s.logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(we.GetRunId()))
 
 	s.False(workflowComplete)
 	_, err := poller.pollAndProcessDecisionTask(true, false)
@@ -950,7 +955,7 @@ func (s *integrationSuite) TestActivityHeartBeatWorkflow_Success() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	activityCount := int32(1)
@@ -995,7 +1000,7 @@ func (s *integrationSuite) TestActivityHeartBeatWorkflow_Success() {
 		s.Equal(id, *execution.WorkflowId)
 		s.Equal(activityName, *activityType.Name)
 		for i := 0; i < 10; i++ {
-			s.logger.Infof("Heartbeating for activity: %s, count: %d", activityID, i)
+			s.logger.Info("Heartbeating for activity", tag.WorkflowActivityID(activityID), tag.Counter(i))
 			_, err := s.engine.RecordActivityTaskHeartbeat(createContext(), &workflow.RecordActivityTaskHeartbeatRequest{
 				TaskToken: taskToken, Details: []byte("details")})
 			s.Nil(err)
@@ -1022,7 +1027,7 @@ func (s *integrationSuite) TestActivityHeartBeatWorkflow_Success() {
 	err = poller.pollAndProcessActivityTask(false)
 	s.True(err == nil || err == matching.ErrNoTasks)
 
-	s.logger.Infof("Waiting for workflow to complete: RunId: %v", *we.RunId)
+	s.logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(we.GetRunId()))
 
 	s.False(workflowComplete)
 	_, err = poller.pollAndProcessDecisionTask(true, false)
@@ -1060,7 +1065,7 @@ func (s *integrationSuite) TestActivityRetry() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	activitiesScheduled := false
@@ -1178,11 +1183,11 @@ func (s *integrationSuite) TestActivityRetry() {
 	err = poller.pollAndProcessActivityTask(false)
 	s.True(err == nil || err == matching.ErrNoTasks, err)
 
-	s.logger.Infof("Waiting for workflow to complete: RunId: %v", *we.RunId)
+	s.logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(we.GetRunId()))
 	for i := 0; i < 3; i++ {
 		s.False(workflowComplete)
 
-		s.logger.Infof("Processing decision task: %v", i)
+		s.logger.Info("Processing decision task", tag.Counter(i))
 		_, err := poller.pollAndProcessDecisionTaskWithoutRetry(false, false)
 		if err != nil {
 			s.printWorkflowHistory(s.domainName, &workflow.WorkflowExecution{
@@ -1233,7 +1238,7 @@ func (s *integrationSuite) TestActivityHeartBeatWorkflow_Timeout() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	activityCount := int32(1)
@@ -1242,7 +1247,7 @@ func (s *integrationSuite) TestActivityHeartBeatWorkflow_Timeout() {
 	dtHandler := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
 		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision, error) {
 
-		s.logger.Infof("Calling DecisionTask Handler: %d, %d.", activityCounter, activityCount)
+		s.logger.Info("Calling DecisionTask Handler", tag.Counter(int(activityCounter)), tag.Number(int64(activityCount)))
 
 		if activityCounter < activityCount {
 			activityCounter++
@@ -1300,7 +1305,7 @@ func (s *integrationSuite) TestActivityHeartBeatWorkflow_Timeout() {
 
 	err = poller.pollAndProcessActivityTask(false)
 
-	s.logger.Infof("Waiting for workflow to complete: RunId: %v", *we.RunId)
+	s.logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(we.GetRunId()))
 
 	s.False(workflowComplete)
 	_, err = poller.pollAndProcessDecisionTask(true, false)
@@ -1336,7 +1341,7 @@ func (s *integrationSuite) TestActivityTimeouts() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	activitiesScheduled := false
@@ -1450,7 +1455,7 @@ func (s *integrationSuite) TestActivityTimeouts() {
 		}
 
 		if failWorkflow {
-			s.logger.Errorf("Failing workflow.")
+			s.logger.Error("Failing workflow.")
 			workflowComplete = true
 			return nil, []*workflow.Decision{{
 				DecisionType: common.DecisionTypePtr(workflow.DecisionTypeFailWorkflowExecution),
@@ -1483,16 +1488,16 @@ func (s *integrationSuite) TestActivityTimeouts() {
 		case "ScheduleToStart":
 			s.Fail("Activity A not expected to be started.")
 		case "ScheduleClose":
-			s.logger.Infof("Sleeping activityB for 6 seconds.")
+			s.logger.Info("Sleeping activityB for 6 seconds.")
 			time.Sleep(7 * time.Second)
 		case "StartToClose":
-			s.logger.Infof("Sleeping activityC for 6 seconds.")
+			s.logger.Info("Sleeping activityC for 6 seconds.")
 			time.Sleep(8 * time.Second)
 		case "Heartbeat":
 			s.logger.Info("Starting hearbeat activity.")
 			go func() {
 				for i := 0; i < 6; i++ {
-					s.logger.Infof("Heartbeating for activity: %s, count: %d", activityID, i)
+					s.logger.Info("Heartbeating for activity", tag.WorkflowActivityID(activityID), tag.Counter(i))
 					_, err := s.engine.RecordActivityTaskHeartbeat(createContext(), &workflow.RecordActivityTaskHeartbeatRequest{
 						TaskToken: taskToken, Details: []byte(string(i))})
 					s.Nil(err)
@@ -1524,13 +1529,13 @@ func (s *integrationSuite) TestActivityTimeouts() {
 	for i := 0; i < 3; i++ {
 		go func() {
 			err = poller.pollAndProcessActivityTask(false)
-			s.logger.Infof("Activity Processing Completed.  Error: %v", err)
+			s.logger.Info("Activity Processing Completed", tag.Error(err))
 		}()
 	}
 
-	s.logger.Infof("Waiting for workflow to complete: RunId: %v", *we.RunId)
+	s.logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(we.GetRunId()))
 	for i := 0; i < 10; i++ {
-		s.logger.Infof("Processing decision task: %v", i)
+		s.logger.Info("Processing decision task", tag.Counter(i))
 		_, err := poller.pollAndProcessDecisionTask(false, false)
 		s.Nil(err, "Poll for decision task failed.")
 
@@ -1574,7 +1579,7 @@ func (s *integrationSuite) TestActivityHeartbeatTimeouts() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	activitiesScheduled := false
@@ -1646,7 +1651,7 @@ func (s *integrationSuite) TestActivityHeartbeatTimeouts() {
 		}
 
 		if failWorkflow {
-			s.logger.Errorf("Failing workflow. Reason: %v", failReason)
+			s.logger.Error("Failing workflow", tag.Value(failReason))
 			workflowComplete = true
 			return nil, []*workflow.Decision{{
 				DecisionType: common.DecisionTypePtr(workflow.DecisionTypeFailWorkflowExecution),
@@ -1672,24 +1677,24 @@ func (s *integrationSuite) TestActivityHeartbeatTimeouts() {
 
 	atHandler := func(execution *workflow.WorkflowExecution, activityType *workflow.ActivityType,
 		activityID string, input []byte, taskToken []byte) ([]byte, bool, error) {
-		s.logger.Infof("Starting heartbeat activity. ID: %v", activityID)
+		s.logger.Info("Starting heartbeat activity", tag.WorkflowActivityID(activityID))
 		for i := 0; i < 10; i++ {
 			if !workflowComplete {
-				s.logger.Infof("Heartbeating for activity: %s, count: %d", activityID, i)
+				s.logger.Info("Heartbeating for activity", tag.WorkflowActivityID(activityID), tag.Counter(i))
 				_, err := s.engine.RecordActivityTaskHeartbeat(createContext(), &workflow.RecordActivityTaskHeartbeatRequest{
 					TaskToken: taskToken, Details: []byte(strconv.Itoa(i))})
 				if err != nil {
-					s.logger.Errorf("Activity heartbeat failed.  ID: %v, Progress: %v, Error: %v", activityID, i, err)
+					s.logger.Error("Activity heartbeat failed", tag.WorkflowActivityID(activityID), tag.Counter(i), tag.Error(err))
 				}
 
 				secondsToSleep := rand.Intn(3)
-				s.logger.Infof("Activity ID '%v' sleeping for: %v seconds", activityID, secondsToSleep)
+				s.logger.Info("Activity sleeping", tag.WorkflowActivityID(activityID), tag.Number(int64(secondsToSleep)))
 				time.Sleep(time.Duration(secondsToSleep) * time.Second)
 			}
 		}
-		s.logger.Infof("End Heartbeating. ID: %v", activityID)
+		s.logger.Info("End Heartbeating", tag.WorkflowActivityID(activityID))
 
-		s.logger.Infof("Sleeping activity before completion. ID: %v", activityID)
+		s.logger.Info("Sleeping activity before completion", tag.WorkflowActivityID(activityID))
 		time.Sleep(5 * time.Second)
 
 		return []byte("Activity Result."), false, nil
@@ -1712,13 +1717,13 @@ func (s *integrationSuite) TestActivityHeartbeatTimeouts() {
 	for i := 0; i < activityCount; i++ {
 		go func() {
 			err = poller.pollAndProcessActivityTask(false)
-			s.logger.Infof("Activity Processing Completed.  Error: %v", err)
+			s.logger.Info("Activity Processing Completed", tag.Error(err))
 		}()
 	}
 
-	s.logger.Infof("Waiting for workflow to complete: RunId: %v", *we.RunId)
+	s.logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(we.GetRunId()))
 	for i := 0; i < 10; i++ {
-		s.logger.Infof("Processing decision task: %v", i)
+		s.logger.Info("Processing decision task", tag.Counter(i))
 		_, err := poller.pollAndProcessDecisionTask(false, false)
 		s.Nil(err, "Poll for decision task failed.")
 
@@ -1736,7 +1741,7 @@ func (s *integrationSuite) TestActivityHeartbeatTimeouts() {
 	s.Equal(activityCount, activitiesTimedout)
 	s.Equal(activityCount, len(lastHeartbeatMap))
 	for aID, lastHeartbeat := range lastHeartbeatMap {
-		s.logger.Infof("Last heartbeat for activity with scheduleID '%v': %v", aID, lastHeartbeat)
+		s.logger.Info("Last heartbeat for activity", tag.WorkflowScheduleID(aID), tag.Value(lastHeartbeat))
 		s.Equal(9, lastHeartbeat)
 	}
 }
@@ -1768,7 +1773,7 @@ func (s *integrationSuite) TestSequential_UserTimers() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	timerCount := int32(4)
@@ -1848,7 +1853,7 @@ func (s *integrationSuite) TestActivityCancellation() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", we.GetRunId())
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	activityCounter := int32(0)
 	scheduleActivity := true
@@ -1901,7 +1906,7 @@ func (s *integrationSuite) TestActivityCancellation() {
 		s.Equal(id, *execution.WorkflowId)
 		s.Equal(activityName, activityType.GetName())
 		for i := 0; i < 10; i++ {
-			s.logger.Infof("Heartbeating for activity: %s, count: %d", activityID, i)
+			s.logger.Info("Heartbeating for activity", tag.WorkflowActivityID(activityID), tag.Counter(i))
 			response, err := s.engine.RecordActivityTaskHeartbeat(createContext(),
 				&workflow.RecordActivityTaskHeartbeatRequest{
 					TaskToken: taskToken, Details: []byte("details")})
@@ -1944,7 +1949,7 @@ func (s *integrationSuite) TestActivityCancellation() {
 	s.True(err == nil || err == matching.ErrNoTasks)
 
 	<-cancelCh
-	s.logger.Infof("Waiting for workflow to complete: RunId: %v", *we.RunId)
+	s.logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(we.GetRunId()))
 }
 
 func (s *integrationSuite) TestSignalWorkflow() {
@@ -1990,7 +1995,7 @@ func (s *integrationSuite) TestSignalWorkflow() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	workflowComplete := false
@@ -2056,7 +2061,7 @@ func (s *integrationSuite) TestSignalWorkflow() {
 
 	// Make first decision to schedule activity
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	// Send first signal using RunID
@@ -2076,7 +2081,7 @@ func (s *integrationSuite) TestSignalWorkflow() {
 
 	// Process signal in decider
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	s.False(workflowComplete)
@@ -2101,7 +2106,7 @@ func (s *integrationSuite) TestSignalWorkflow() {
 
 	// Process signal in decider
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	s.False(workflowComplete)
@@ -2165,7 +2170,7 @@ func (s *integrationSuite) TestSignalWorkflow_DuplicateRequest() {
 
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	workflowComplete := false
@@ -2234,7 +2239,7 @@ func (s *integrationSuite) TestSignalWorkflow_DuplicateRequest() {
 
 	// Make first decision to schedule activity
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	// Send first signal
@@ -2257,7 +2262,7 @@ func (s *integrationSuite) TestSignalWorkflow_DuplicateRequest() {
 
 	// Process signal in decider
 	_, err = poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	s.False(workflowComplete)
@@ -2273,7 +2278,7 @@ func (s *integrationSuite) TestSignalWorkflow_DuplicateRequest() {
 
 	// Process signal in decider
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	s.False(workflowComplete)
@@ -2307,7 +2312,7 @@ func (s *integrationSuite) TestBufferedEvents() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	workflowComplete := false
@@ -2373,7 +2378,7 @@ func (s *integrationSuite) TestBufferedEvents() {
 
 	// first decision, which sends signal and the signal event should be buffered to append after first decision closed
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	// check history, the signal event should be after the complete decision task
@@ -2393,7 +2398,7 @@ func (s *integrationSuite) TestBufferedEvents() {
 
 	// Process signal in decider
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 	s.NotNil(signalEvent)
 	s.Equal(signalName, *signalEvent.WorkflowExecutionSignaledEventAttributes.SignalName)
@@ -2436,7 +2441,7 @@ func (s *integrationSuite) TestQueryWorkflow_Sticky() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	activityScheduled := false
@@ -2505,7 +2510,7 @@ func (s *integrationSuite) TestQueryWorkflow_Sticky() {
 
 	// Make first decision to schedule activity
 	_, err := poller.pollAndProcessDecisionTaskWithAttempt(false, false, false, true, int64(0))
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	type QueryResult struct {
@@ -2533,7 +2538,7 @@ func (s *integrationSuite) TestQueryWorkflow_Sticky() {
 	for {
 		// loop until process the query task
 		isQueryTask, errInner := poller.pollAndProcessDecisionTaskWithSticky(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 		s.Nil(errInner)
 		if isQueryTask {
 			break
@@ -2551,7 +2556,7 @@ func (s *integrationSuite) TestQueryWorkflow_Sticky() {
 	for {
 		// loop until process the query task
 		isQueryTask, errInner := poller.pollAndProcessDecisionTaskWithSticky(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 		s.Nil(errInner)
 		if isQueryTask {
 			break
@@ -2599,7 +2604,7 @@ func (s *integrationSuite) TestQueryWorkflow_StickyTimeout() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	activityScheduled := false
@@ -2668,7 +2673,7 @@ func (s *integrationSuite) TestQueryWorkflow_StickyTimeout() {
 
 	// Make first decision to schedule activity
 	_, err := poller.pollAndProcessDecisionTaskWithAttempt(false, false, false, true, int64(0))
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	type QueryResult struct {
@@ -2698,7 +2703,7 @@ func (s *integrationSuite) TestQueryWorkflow_StickyTimeout() {
 		// here we poll on normal tasklist, to simulate a worker crash and restart
 		// on the server side, server will first try the sticky tasklist and then the normal tasklist
 		isQueryTask, errInner := poller.pollAndProcessDecisionTask(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 		s.Nil(errInner)
 		if isQueryTask {
 			break
@@ -2743,7 +2748,7 @@ func (s *integrationSuite) TestQueryWorkflow_NonSticky() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	activityScheduled := false
@@ -2810,7 +2815,7 @@ func (s *integrationSuite) TestQueryWorkflow_NonSticky() {
 
 	// Make first decision to schedule activity
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	type QueryResult struct {
@@ -2838,7 +2843,7 @@ func (s *integrationSuite) TestQueryWorkflow_NonSticky() {
 	for {
 		// loop until process the query task
 		isQueryTask, errInner := poller.pollAndProcessDecisionTask(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 		s.Nil(errInner)
 		if isQueryTask {
 			break
@@ -2855,7 +2860,7 @@ func (s *integrationSuite) TestQueryWorkflow_NonSticky() {
 	for {
 		// loop until process the query task
 		isQueryTask, errInner := poller.pollAndProcessDecisionTask(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 		s.Nil(errInner)
 		if isQueryTask {
 			break
@@ -2868,6 +2873,188 @@ func (s *integrationSuite) TestQueryWorkflow_NonSticky() {
 	s.Equal("unknown-query-type", queryFailError.Message)
 }
 
+func (s *integrationSuite) TestAdminDescribeWorkflowExecution() {
+	id := "integration-admin-describe-wfe-test"
+	wt := "integration-admin-describe-wfe-test-type"
+	tl := "integration-admin-describe-wfe-test-tasklist"
+	identity := "worker1"
+
+	request := &workflow.StartWorkflowExecutionRequest{
+		RequestId:    common.StringPtr(uuid.New()),
+		Domain:       common.StringPtr(s.domainName),
+		WorkflowId:   common.StringPtr(id),
+		WorkflowType: &workflow.WorkflowType{Name: common.StringPtr(wt)},
+		TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+		Input:        nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		Identity:                            common.StringPtr(identity),
+	}
+
+	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
+	s.Nil(err0)
+
+	execution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      we.RunId,
+	}
+	adminClient := s.host.GetAdminClient()
+	describeResp, err := adminClient.DescribeWorkflowExecution(createContext(), &admin.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(s.domainName),
+		Execution: execution,
+	})
+	s.Nil(err)
+	s.Equal(strconv.Itoa(common.WorkflowIDToHistoryShard(id, testNumberOfHistoryShards)), describeResp.GetShardId())
+	s.NotEmpty(describeResp.GetHistoryAddr())
+	s.Contains(describeResp.GetMutableStateInDatabase(), we.GetRunId())
+
+	hostResp, err := adminClient.DescribeHistoryHost(createContext(), &workflow.DescribeHistoryHostRequest{
+		ExecutionForHost: execution,
+	})
+	s.Nil(err)
+	s.Equal(describeResp.GetHistoryAddr(), hostResp.GetAddress())
+	s.Equal(int32(testNumberOfHistoryShards), hostResp.GetNumberOfShards())
+	s.Equal(int(hostResp.GetNumberOfShards()), len(hostResp.ShardIDs))
+	s.Equal("started", hostResp.GetShardControllerStatus())
+}
+
+func (s *integrationSuite) TestGRPCFrontend() {
+	id := "integration-grpc-frontend-test"
+	wt := "integration-grpc-frontend-test-type"
+	tl := "integration-grpc-frontend-test-tasklist"
+	identity := "worker1"
+
+	grpcClient := s.host.GetFrontendGRPCClient()
+
+	domainResp, err := grpcClient.DescribeDomain(createContext(), &workflow.DescribeDomainRequest{
+		Name: common.StringPtr(s.domainName),
+	})
+	s.Nil(err)
+	s.Equal(s.domainName, domainResp.DomainInfo.GetName())
+
+	request := &workflow.StartWorkflowExecutionRequest{
+		RequestId:    common.StringPtr(uuid.New()),
+		Domain:       common.StringPtr(s.domainName),
+		WorkflowId:   common.StringPtr(id),
+		WorkflowType: &workflow.WorkflowType{Name: common.StringPtr(wt)},
+		TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+		Input:        nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		Identity:                            common.StringPtr(identity),
+	}
+	we, err := grpcClient.StartWorkflowExecution(createContext(), request)
+	s.Nil(err)
+
+	// the execution started over grpc is visible over tchannel
+	describeResp, err := s.engine.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(id),
+			RunId:      we.RunId,
+		},
+	})
+	s.Nil(err)
+	s.Equal(wt, describeResp.WorkflowExecutionInfo.Type.GetName())
+
+	// errors are mapped back to the thrift exceptions
+	request.RequestId = common.StringPtr(uuid.New())
+	_, err = grpcClient.StartWorkflowExecution(createContext(), request)
+	s.IsType(&workflow.WorkflowExecutionAlreadyStartedError{}, err)
+}
+
+func (s *integrationSuite) TestProtoFrontend() {
+	id := "integration-proto-frontend-test"
+	wt := "integration-proto-frontend-test-type"
+	tl := "integration-proto-frontend-test-tasklist"
+	identity := "worker1"
+
+	protoClient := s.host.GetFrontendProtoClient()
+
+	domainResp, err := protoClient.DescribeDomain(createContext(), &cadencepb.DescribeDomainRequest{
+		Name: common.StringPtr(s.domainName),
+	})
+	s.Nil(err)
+	s.Equal(s.domainName, domainResp.DomainInfo.GetName())
+
+	_, err = protoClient.DescribeDomain(createContext(), &cadencepb.DescribeDomainRequest{
+		Name: common.StringPtr("integration-proto-frontend-unknown-domain"),
+	})
+	s.Equal(yarpcerrors.CodeNotFound, yarpcerrors.FromError(err).Code())
+
+	rejectDuplicate := cadencepb.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE
+	request := &cadencepb.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(s.domainName),
+		WorkflowId:                          common.StringPtr(id),
+		WorkflowType:                        &cadencepb.WorkflowType{Name: common.StringPtr(wt)},
+		TaskList:                            &cadencepb.TaskList{Name: common.StringPtr(tl)},
+		Input:                               []byte("proto input"),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		Identity:                            common.StringPtr(identity),
+		WorkflowIdReusePolicy:               &rejectDuplicate,
+	}
+	we, err := protoClient.StartWorkflowExecution(createContext(), request)
+	s.Nil(err)
+
+	// the execution started over protobuf is visible over thrift
+	describeResp, err := s.engine.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(id),
+			RunId:      we.RunId,
+		},
+	})
+	s.Nil(err)
+	s.Equal(wt, describeResp.WorkflowExecutionInfo.Type.GetName())
+	s.Equal(int32(100), describeResp.ExecutionConfiguration.GetExecutionStartToCloseTimeoutSeconds())
+
+	pollResp, err := protoClient.PollForDecisionTask(createContext(), &cadencepb.PollForDecisionTaskRequest{
+		Domain:   common.StringPtr(s.domainName),
+		TaskList: &cadencepb.TaskList{Name: common.StringPtr(tl)},
+		Identity: common.StringPtr(identity),
+	})
+	s.Nil(err)
+	s.Equal(we.GetRunId(), pollResp.WorkflowExecution.GetRunId())
+	startedEvent := pollResp.History.Events[0]
+	s.Equal(cadencepb.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, startedEvent.GetEventType())
+	s.Equal([]byte("proto input"), startedEvent.WorkflowExecutionStartedEventAttributes.Input)
+
+	completeDecision := cadencepb.DecisionType_DECISION_TYPE_COMPLETE_WORKFLOW_EXECUTION
+	_, err = protoClient.RespondDecisionTaskCompleted(createContext(), &cadencepb.RespondDecisionTaskCompletedRequest{
+		TaskToken: pollResp.TaskToken,
+		Identity:  common.StringPtr(identity),
+		Decisions: []*cadencepb.Decision{{
+			DecisionType: &completeDecision,
+			CompleteWorkflowExecutionDecisionAttributes: &cadencepb.CompleteWorkflowExecutionDecisionAttributes{
+				Result: []byte("proto result"),
+			},
+		}},
+	})
+	s.Nil(err)
+
+	closeEventOnly := cadencepb.HistoryEventFilterType_HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT
+	historyResp, err := protoClient.GetWorkflowExecutionHistory(createContext(), &cadencepb.GetWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr(s.domainName),
+		Execution: &cadencepb.WorkflowExecution{
+			WorkflowId: common.StringPtr(id),
+			RunId:      we.RunId,
+		},
+		HistoryEventFilterType: &closeEventOnly,
+	})
+	s.Nil(err)
+	s.Equal(1, len(historyResp.History.Events))
+	closeEvent := historyResp.History.Events[0]
+	s.Equal(cadencepb.EventType_EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED, closeEvent.GetEventType())
+	s.Equal([]byte("proto result"), closeEvent.WorkflowExecutionCompletedEventAttributes.Result)
+
+	// the thrift exceptions are returned as grpc status codes
+	request.RequestId = common.StringPtr(uuid.New())
+	_, err = protoClient.StartWorkflowExecution(createContext(), request)
+	s.Equal(yarpcerrors.CodeAlreadyExists, yarpcerrors.FromError(err).Code())
+}
+
 func (s *integrationSuite) TestDescribeWorkflowExecution() {
 	id := "interation-describe-wfe-test"
 	wt := "interation-describe-wfe-test-type"
@@ -2893,7 +3080,7 @@ func (s *integrationSuite) TestDescribeWorkflowExecution() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	describeWorkflowExecution := func() (*workflow.DescribeWorkflowExecutionResponse, error) {
 		return s.engine.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
@@ -2960,7 +3147,7 @@ func (s *integrationSuite) TestDescribeWorkflowExecution() {
 
 	// first decision to schedule new activity
 	_, err = poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	dweResponse, err = describeWorkflowExecution()
@@ -3018,7 +3205,7 @@ func (s *integrationSuite) TestContinueAsNewWorkflow() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	continueAsNewCount := int32(10)
@@ -3067,7 +3254,7 @@ func (s *integrationSuite) TestContinueAsNewWorkflow() {
 
 	for i := 0; i < 10; i++ {
 		_, err := poller.pollAndProcessDecisionTask(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 		s.Nil(err, strconv.Itoa(i))
 	}
 
@@ -3105,7 +3292,7 @@ func (s *integrationSuite) TestContinueAsNewWorkflow_Timeout() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	continueAsNewCount := int32(1)
@@ -3150,7 +3337,7 @@ func (s *integrationSuite) TestContinueAsNewWorkflow_Timeout() {
 
 	// process the decision and continue as new
 	_, err := poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	s.False(workflowComplete)
@@ -3171,7 +3358,7 @@ GetHistoryLoop:
 
 		lastEvent := history.Events[len(history.Events)-1]
 		if *lastEvent.EventType != workflow.EventTypeWorkflowExecutionTimedOut {
-			s.logger.Warnf("Execution not timedout yet.")
+			s.logger.Warn("Execution not timedout yet.")
 			time.Sleep(200 * time.Millisecond)
 			continue GetHistoryLoop
 		}
@@ -3340,7 +3527,7 @@ func (s *integrationSuite) TestExternalRequestCancelWorkflowExecution() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	activityCount := int32(1)
 	activityCounter := int32(0)
@@ -3391,11 +3578,11 @@ func (s *integrationSuite) TestExternalRequestCancelWorkflowExecution() {
 	}
 
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	err = poller.pollAndProcessActivityTask(false)
-	s.logger.Infof("pollAndProcessActivityTask: %v", err)
+	s.logger.Info("pollAndProcessActivityTask", tag.Error(err))
 	s.Nil(err)
 
 	err = s.engine.RequestCancelWorkflowExecution(createContext(), &workflow.RequestCancelWorkflowExecutionRequest{
@@ -3418,7 +3605,7 @@ func (s *integrationSuite) TestExternalRequestCancelWorkflowExecution() {
 	s.IsType(&workflow.CancellationAlreadyRequestedError{}, err)
 
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	executionCancelled := false
@@ -3437,7 +3624,7 @@ GetHistoryLoop:
 
 		lastEvent := history.Events[len(history.Events)-1]
 		if *lastEvent.EventType != workflow.EventTypeWorkflowExecutionCanceled {
-			s.logger.Warnf("Execution not cancelled yet.")
+			s.logger.Warn("Execution not cancelled yet.")
 			time.Sleep(100 * time.Millisecond)
 			continue GetHistoryLoop
 		}
@@ -3476,7 +3663,7 @@ func (s *integrationSuite) TestRequestCancelWorkflowDecisionExecution() {
 	}
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	foreignRequest := &workflow.StartWorkflowExecutionRequest{
 		RequestId:    common.StringPtr(uuid.New()),
@@ -3491,7 +3678,7 @@ func (s *integrationSuite) TestRequestCancelWorkflowDecisionExecution() {
 	}
 	we2, err0 := s.engine.StartWorkflowExecution(createContext(), foreignRequest)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution on foreign domain: %v,  response: %v \n", s.foreignDomainName, *we2.RunId)
+	s.logger.Info("StartWorkflowExecution on foreign domain", tag.WorkflowDomainName(s.foreignDomainName), tag.WorkflowRunID(we2.GetRunId()))
 
 	activityCount := int32(1)
 	activityCounter := int32(0)
@@ -3588,20 +3775,20 @@ func (s *integrationSuite) TestRequestCancelWorkflowDecisionExecution() {
 
 	// Start both current and foreign workflows to make some progress.
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	_, err = foreignPoller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("foreign pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("foreign pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	err = foreignPoller.pollAndProcessActivityTask(false)
-	s.logger.Infof("foreign pollAndProcessActivityTask: %v", err)
+	s.logger.Info("foreign pollAndProcessActivityTask", tag.Error(err))
 	s.Nil(err)
 
 	// Cancel the foreign workflow with this decision request.
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	cancellationSent := false
@@ -3639,7 +3826,7 @@ CheckHistoryLoopForCancelSent:
 
 	// Accept cancellation.
 	_, err = foreignPoller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("foreign pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("foreign pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	executionCancelled := false
@@ -3658,7 +3845,7 @@ GetHistoryLoop:
 
 		lastEvent := history.Events[len(history.Events)-1]
 		if *lastEvent.EventType != workflow.EventTypeWorkflowExecutionCanceled {
-			s.logger.Warnf("Execution not cancelled yet.")
+			s.logger.Warn("Execution not cancelled yet.")
 			time.Sleep(100 * time.Millisecond)
 			continue GetHistoryLoop
 		}
@@ -3712,7 +3899,7 @@ func (s *integrationSuite) TestRequestCancelWorkflowDecisionExecution_UnKnownTar
 	}
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	activityCount := int32(1)
 	activityCounter := int32(0)
@@ -3766,12 +3953,12 @@ func (s *integrationSuite) TestRequestCancelWorkflowDecisionExecution_UnKnownTar
 
 	// Start workflows to make some progress.
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	// Cancel the foreign workflow with this decision request.
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	cancellationSentFailed := false
@@ -3845,7 +4032,7 @@ func (s *integrationSuite) TestHistoryVersionCompatibilityCheck() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 	activityCount := int32(4)
@@ -3888,7 +4075,7 @@ func (s *integrationSuite) TestHistoryVersionCompatibilityCheck() {
 		activityID string, input []byte, taskToken []byte) ([]byte, bool, error) {
 		s.Equal(id, *execution.WorkflowId)
 		s.Equal(activityName, *activityType.Name)
-		s.logger.Infof("Activity ID: %v", activityID)
+		s.logger.Info("Activity ID", tag.WorkflowActivityID(activityID))
 		return []byte("Activity Result."), false, nil
 	}
 
@@ -3915,7 +4102,7 @@ func (s *integrationSuite) TestHistoryVersionCompatibilityCheck() {
 			persistence.SetDefaultHistoryVersion(prevMaxVersion + 1)
 		}
 
-		s.logger.Infof("Calling Decision Task: %d", i)
+		s.logger.Info("Calling Decision Task", tag.Counter(i))
 		var err error
 		if decisionFailed {
 			_, err = poller.pollAndProcessDecisionTaskWithAttempt(false, false, false, false, int64(1))
@@ -3936,7 +4123,7 @@ func (s *integrationSuite) TestHistoryVersionCompatibilityCheck() {
 		}
 
 		s.True(err == nil || err == matching.ErrNoTasks)
-		s.logger.Infof("Calling Activity Task: %d", i)
+		s.logger.Info("Calling Activity Task", tag.Counter(i))
 		err = poller.pollAndProcessActivityTask(false)
 		s.True(err == nil || err == matching.ErrNoTasks)
 
@@ -3949,7 +4136,7 @@ func (s *integrationSuite) TestHistoryVersionCompatibilityCheck() {
 		}
 	}
 
-	s.logger.Infof("Waiting for workflow to complete: RunId: %v", *we.RunId)
+	s.logger.Info("Waiting for workflow to complete", tag.WorkflowRunID(we.GetRunId()))
 
 	s.False(workflowComplete)
 	_, err := poller.pollAndProcessDecisionTask(true, false)
@@ -3992,7 +4179,7 @@ func (s *integrationSuite) TestChildWorkflowExecution() {
 
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	childComplete := false
@@ -4004,7 +4191,7 @@ func (s *integrationSuite) TestChildWorkflowExecution() {
 	// Parent Decider Logic
 	dtHandlerParent := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
 		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision, error) {
-		s.logger.Infof("Processing decision task for WorkflowID: %v", *execution.WorkflowId)
+		s.logger.Info("Processing decision task for WorkflowID", tag.WorkflowID(execution.GetWorkflowId()))
 
 		if *execution.WorkflowId == parentID {
 			if !childExecutionStarted {
@@ -4056,7 +4243,7 @@ func (s *integrationSuite) TestChildWorkflowExecution() {
 			childStartedEvent = history.Events[0]
 		}
 
-		s.logger.Infof("Processing decision task for Child WorkflowID: %v", *execution.WorkflowId)
+		s.logger.Info("Processing decision task for Child WorkflowID", tag.WorkflowID(execution.GetWorkflowId()))
 		childComplete = true
 		return nil, []*workflow.Decision{{
 			DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
@@ -4088,7 +4275,7 @@ func (s *integrationSuite) TestChildWorkflowExecution() {
 
 	// Make first decision to start child execution
 	_, err := pollerParent.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 	s.True(childExecutionStarted)
 	s.Equal(workflow.ChildPolicyRequestCancel,
@@ -4096,11 +4283,11 @@ func (s *integrationSuite) TestChildWorkflowExecution() {
 
 	// Process ChildExecution Started event and Process Child Execution and complete it
 	_, err = pollerParent.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	_, err = pollerChild.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 	s.NotNil(startedEvent)
 	s.True(childComplete)
@@ -4115,7 +4302,7 @@ func (s *integrationSuite) TestChildWorkflowExecution() {
 
 	// Process ChildExecution completed event and complete parent execution
 	_, err = pollerParent.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 	s.NotNil(completedEvent)
 	completedAttributes := completedEvent.ChildWorkflowExecutionCompletedEventAttributes
@@ -4166,7 +4353,7 @@ func (s *integrationSuite) TestChildWorkflowWithContinueAsNew() {
 
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	childComplete := false
@@ -4178,7 +4365,7 @@ func (s *integrationSuite) TestChildWorkflowWithContinueAsNew() {
 	var completedEvent *workflow.HistoryEvent
 	dtHandler := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
 		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision, error) {
-		s.logger.Infof("Processing decision task for WorkflowID: %v", *execution.WorkflowId)
+		s.logger.Info("Processing decision task for WorkflowID", tag.WorkflowID(execution.GetWorkflowId()))
 
 		// Child Decider Logic
 		if *execution.WorkflowId == childID {
@@ -4257,15 +4444,15 @@ func (s *integrationSuite) TestChildWorkflowWithContinueAsNew() {
 
 	// Make first decision to start child execution
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 	s.True(childExecutionStarted)
 
 	// Process ChildExecution Started event and all generations of child executions
 	for i := 0; i < 11; i++ {
-		s.logger.Warnf("decision: %v", i)
+		s.logger.Warn("decision", tag.Counter(i))
 		_, err = poller.pollAndProcessDecisionTask(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 		s.Nil(err)
 	}
 
@@ -4274,13 +4461,13 @@ func (s *integrationSuite) TestChildWorkflowWithContinueAsNew() {
 
 	// Process Child Execution final decision to complete it
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 	s.True(childComplete)
 
 	// Process ChildExecution completed event and complete parent execution
 	_, err = poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 	s.NotNil(completedEvent)
 	completedAttributes := completedEvent.ChildWorkflowExecutionCompletedEventAttributes
@@ -4331,7 +4518,7 @@ func (s *integrationSuite) TestWorkflowTimeout() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowComplete := false
 
@@ -4350,7 +4537,7 @@ GetHistoryLoop:
 
 		lastEvent := history.Events[len(history.Events)-1]
 		if *lastEvent.EventType != workflow.EventTypeWorkflowExecutionTimedOut {
-			s.logger.Warnf("Execution not timedout yet.")
+			s.logger.Warn("Execution not timedout yet.")
 			time.Sleep(200 * time.Millisecond)
 			continue GetHistoryLoop
 		}
@@ -4414,7 +4601,7 @@ func (s *integrationSuite) TestDecisionTaskFailed() {
 
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	workflowExecution := &workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(id),
@@ -4503,12 +4690,12 @@ func (s *integrationSuite) TestDecisionTaskFailed() {
 
 	// Make first decision to schedule activity
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	// process activity
 	err = poller.pollAndProcessActivityTask(false)
-	s.logger.Infof("pollAndProcessActivityTask: %v", err)
+	s.logger.Info("pollAndProcessActivityTask", tag.Error(err))
 	s.Nil(err)
 
 	// fail decision 5 times
@@ -4522,7 +4709,7 @@ func (s *integrationSuite) TestDecisionTaskFailed() {
 
 	// process signal
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 	s.Equal(1, signalCount)
 
@@ -4552,7 +4739,7 @@ func (s *integrationSuite) TestDecisionTaskFailed() {
 
 	// Make complete workflow decision
 	_, err = poller.pollAndProcessDecisionTaskWithAttempt(true, false, false, false, int64(5))
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 	s.True(workflowComplete)
 	s.Equal(16, signalCount)
@@ -4596,7 +4783,7 @@ func (s *integrationSuite) TestGetWorkflowExecutionHistory_All() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	activityScheduled := false
@@ -4683,7 +4870,7 @@ func (s *integrationSuite) TestGetWorkflowExecutionHistory_All() {
 	// make first decision to schedule activity, this should affect the long poll above
 	time.AfterFunc(time.Second*8, func() {
 		_, errDecision1 := poller.pollAndProcessDecisionTask(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", errDecision1)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(errDecision1))
 	})
 	start = time.Now()
 	events, token = getHistory(s.domainName, workflowID, token, true)
@@ -4695,11 +4882,11 @@ func (s *integrationSuite) TestGetWorkflowExecutionHistory_All() {
 	// finish the activity and poll all events
 	time.AfterFunc(time.Second*5, func() {
 		errActivity := poller.pollAndProcessActivityTask(false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", errActivity)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(errActivity))
 	})
 	time.AfterFunc(time.Second*8, func() {
 		_, errDecision2 := poller.pollAndProcessDecisionTask(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", errDecision2)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(errDecision2))
 	})
 	for token != nil {
 		events, token = getHistory(s.domainName, workflowID, token, true)
@@ -4762,7 +4949,7 @@ func (s *integrationSuite) TestGetWorkflowExecutionHistory_Close() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	activityScheduled := false
@@ -4851,7 +5038,7 @@ func (s *integrationSuite) TestGetWorkflowExecutionHistory_Close() {
 	// make first decision to schedule activity, this should affect the long poll above
 	time.AfterFunc(time.Second*8, func() {
 		_, errDecision1 := poller.pollAndProcessDecisionTask(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", errDecision1)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(errDecision1))
 	})
 	start = time.Now()
 	events, token = getHistory(s.domainName, workflowID, token, true)
@@ -4863,11 +5050,11 @@ func (s *integrationSuite) TestGetWorkflowExecutionHistory_Close() {
 	// finish the activity and poll all events
 	time.AfterFunc(time.Second*5, func() {
 		errActivity := poller.pollAndProcessActivityTask(false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", errActivity)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(errActivity))
 	})
 	time.AfterFunc(time.Second*8, func() {
 		_, errDecision2 := poller.pollAndProcessDecisionTask(false, false)
-		s.logger.Infof("pollAndProcessDecisionTask: %v", errDecision2)
+		s.logger.Info("pollAndProcessDecisionTask", tag.Error(errDecision2))
 	})
 	for token != nil {
 		events, token = getHistory(s.domainName, workflowID, token, true)
@@ -4921,7 +5108,7 @@ func (s *integrationSuite) TestDescribeTaskList() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	activityScheduled := false
@@ -5045,7 +5232,7 @@ func (s *integrationSuite) TestSignalExternalWorkflowDecision() {
 
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	foreignRequest := &workflow.StartWorkflowExecutionRequest{
 		RequestId:    common.StringPtr(uuid.New()),
@@ -5060,7 +5247,7 @@ func (s *integrationSuite) TestSignalExternalWorkflowDecision() {
 	}
 	we2, err0 := s.engine.StartWorkflowExecution(createContext(), foreignRequest)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution on foreign domain: %v,  response: %v \n", s.foreignDomainName, *we2.RunId)
+	s.logger.Info("StartWorkflowExecution on foreign domain", tag.WorkflowDomainName(s.foreignDomainName), tag.WorkflowRunID(we2.GetRunId()))
 
 	activityCount := int32(1)
 	activityCounter := int32(0)
@@ -5173,20 +5360,20 @@ func (s *integrationSuite) TestSignalExternalWorkflowDecision() {
 
 	// Start both current and foreign workflows to make some progress.
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	_, err = foreignPoller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("foreign pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("foreign pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	err = foreignPoller.pollAndProcessActivityTask(false)
-	s.logger.Infof("foreign pollAndProcessActivityTask: %v", err)
+	s.logger.Info("foreign pollAndProcessActivityTask", tag.Error(err))
 	s.Nil(err)
 
 	// Signal the foreign workflow with this decision request.
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	// in source workflow
@@ -5226,7 +5413,7 @@ CheckHistoryLoopForSignalSent:
 
 	// process signal in decider for foreign workflow
 	_, err = foreignPoller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	s.False(workflowComplete)
@@ -5263,7 +5450,7 @@ func (s *integrationSuite) TestSignalExternalWorkflowDecision_WithoutRunID() {
 
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	foreignRequest := &workflow.StartWorkflowExecutionRequest{
 		RequestId:    common.StringPtr(uuid.New()),
@@ -5278,7 +5465,7 @@ func (s *integrationSuite) TestSignalExternalWorkflowDecision_WithoutRunID() {
 	}
 	we2, err0 := s.engine.StartWorkflowExecution(createContext(), foreignRequest)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution on foreign domain: %v,  response: %v \n", s.foreignDomainName, *we2.RunId)
+	s.logger.Info("StartWorkflowExecution on foreign domain", tag.WorkflowDomainName(s.foreignDomainName), tag.WorkflowRunID(we2.GetRunId()))
 
 	activityCount := int32(1)
 	activityCounter := int32(0)
@@ -5391,20 +5578,20 @@ func (s *integrationSuite) TestSignalExternalWorkflowDecision_WithoutRunID() {
 
 	// Start both current and foreign workflows to make some progress.
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	_, err = foreignPoller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("foreign pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("foreign pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	err = foreignPoller.pollAndProcessActivityTask(false)
-	s.logger.Infof("foreign pollAndProcessActivityTask: %v", err)
+	s.logger.Info("foreign pollAndProcessActivityTask", tag.Error(err))
 	s.Nil(err)
 
 	// Signal the foreign workflow with this decision request.
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	// in source workflow
@@ -5444,7 +5631,7 @@ CheckHistoryLoopForSignalSent:
 
 	// process signal in decider for foreign workflow
 	_, err = foreignPoller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	s.False(workflowComplete)
@@ -5480,7 +5667,7 @@ func (s *integrationSuite) TestSignalExternalWorkflowDecision_UnKnownTarget() {
 	}
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	activityCount := int32(1)
 	activityCounter := int32(0)
@@ -5540,12 +5727,12 @@ func (s *integrationSuite) TestSignalExternalWorkflowDecision_UnKnownTarget() {
 
 	// Start workflows to make some progress.
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	// Signal the foreign workflow with this decision request.
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	signalSentFailed := false
@@ -5608,7 +5795,7 @@ func (s *integrationSuite) TestSignalExternalWorkflowDecision_SignalSelf() {
 	}
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	activityCount := int32(1)
 	activityCounter := int32(0)
@@ -5668,12 +5855,12 @@ func (s *integrationSuite) TestSignalExternalWorkflowDecision_SignalSelf() {
 
 	// Start workflows to make some progress.
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	// Signal the foreign workflow with this decision request.
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	signalSentFailed := false
@@ -5740,7 +5927,7 @@ func (s *integrationSuite) TestSignalWithStartWorkflow() {
 	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
 	s.Nil(err0)
 
-	s.logger.Infof("StartWorkflowExecution: response: %v \n", *we.RunId)
+	s.logger.Info("StartWorkflowExecution", tag.WorkflowRunID(we.GetRunId()))
 
 	// decider logic
 	workflowComplete := false
@@ -5815,7 +6002,7 @@ func (s *integrationSuite) TestSignalWithStartWorkflow() {
 
 	// Make first decision to schedule activity
 	_, err := poller.pollAndProcessDecisionTask(false, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	// Send a signal
@@ -5840,7 +6027,7 @@ func (s *integrationSuite) TestSignalWithStartWorkflow() {
 
 	// Process signal in decider
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	s.False(workflowComplete)
@@ -5876,7 +6063,7 @@ func (s *integrationSuite) TestSignalWithStartWorkflow() {
 
 	// Process signal in decider
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	s.False(workflowComplete)
@@ -5899,7 +6086,7 @@ func (s *integrationSuite) TestSignalWithStartWorkflow() {
 
 	// Process signal in decider
 	_, err = poller.pollAndProcessDecisionTask(true, false)
-	s.logger.Infof("pollAndProcessDecisionTask: %v", err)
+	s.logger.Info("pollAndProcessDecisionTask", tag.Error(err))
 	s.Nil(err)
 
 	s.False(workflowComplete)
@@ -5914,7 +6101,7 @@ func (s *integrationSuite) setupShards() {
 	for shardID := 1; shardID < testNumberOfHistoryShards; shardID++ {
 		err := s.CreateShard(shardID, "", 0)
 		if err != nil {
-			s.logger.WithField("error", err).Fatal("Failed to create shard")
+			s.logger.Fatal("Failed to create shard", tag.Error(err))
 		}
 	}
 }
@@ -23,6 +23,7 @@ package host
 import (
 	"flag"
 	"fmt"
+	"net"
 	"reflect"
 	"sync"
 	"time"
@@ -30,12 +31,16 @@ import (
 	"errors"
 
 	"github.com/stretchr/testify/mock"
-	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/.gen/proto/cadencepb"
 	fecli "github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -50,6 +55,8 @@ import (
 	"github.com/uber/ringpop-go/swim"
 	tcg "github.com/uber/tchannel-go"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
@@ -57,7 +64,8 @@ const rpAppNamePrefix string = "cadence"
 const maxRpJoinTimeout = 30 * time.Second
 
 var (
-	integration = flag.Bool("integration", true, "run integration tests")
+	integration         = flag.Bool("integration", true, "run integration tests")
+	inMemoryPersistence = flag.Bool("inMemoryPersistence", false, "run integration tests against the in-memory persistence store")
 )
 
 const (
@@ -70,18 +78,23 @@ type Cadence interface {
 	Start() error
 	Stop()
 	GetFrontendClient() workflowserviceclient.Interface
+	GetAdminClient() adminserviceclient.Interface
+	GetFrontendGRPCClient() workflowserviceclient.Interface
+	GetFrontendProtoClient() cadencepb.WorkflowServiceYARPCClient
 	FrontendAddress() string
+	FrontendGRPCAddress() string
 	GetFrontendService() service.Service
 }
 
 type (
 	cadenceImpl struct {
 		frontendHandler       *frontend.WorkflowHandler
+		adminHandler          *frontend.AdminHandler
 		matchingHandler       *matching.Handler
 		historyHandlers       []*history.Handler
 		numberOfHistoryShards int
 		numberOfHistoryHosts  int
-		logger                bark.Logger
+		logger                logging.Logger
 		clusterMetadata       cluster.Metadata
 		messagingClient       messaging.Client
 		metadataMgr           persistence.MetadataManager
@@ -106,7 +119,7 @@ func NewCadence(clusterMetadata cluster.Metadata, messagingClient messaging.Clie
 	metadataMgr persistence.MetadataManager, shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager,
 	executionMgrFactory persistence.ExecutionManagerFactory, taskMgr persistence.TaskManager,
 	visibilityMgr persistence.VisibilityManager, numberOfHistoryShards, numberOfHistoryHosts int,
-	logger bark.Logger) Cadence {
+	logger logging.Logger) Cadence {
 
 	return &cadenceImpl{
 		numberOfHistoryShards: numberOfHistoryShards,
@@ -161,6 +174,10 @@ func (c *cadenceImpl) FrontendPProfPort() int {
 	return 7105
 }
 
+func (c *cadenceImpl) FrontendGRPCAddress() string {
+	return "127.0.0.1:7108"
+}
+
 func (c *cadenceImpl) HistoryServiceAddress() []string {
 	hosts := []string{}
 	startPort := 7200
@@ -169,7 +186,8 @@ func (c *cadenceImpl) HistoryServiceAddress() []string {
 		hosts = append(hosts, fmt.Sprintf("127.0.0.1:%v", port))
 	}
 
-	c.logger.Infof("History hosts: %v", hosts)
+	c.logger.Info("History hosts", tag.Value(hosts))
+
 	return hosts
 }
 
@@ -181,7 +199,8 @@ func (c *cadenceImpl) HistoryPProfPort() []int {
 		ports = append(ports, port)
 	}
 
-	c.logger.Infof("History pprof ports: %v", ports)
+	c.logger.Info("History pprof ports", tag.Value(ports))
+
 	return ports
 }
 
@@ -197,17 +216,44 @@ func (c *cadenceImpl) GetFrontendClient() workflowserviceclient.Interface {
 	return fecli.New(c.frontEndService.GetDispatcher())
 }
 
+func (c *cadenceImpl) GetAdminClient() adminserviceclient.Interface {
+	return adminserviceclient.New(c.frontEndService.GetDispatcher().ClientConfig(common.FrontendServiceName))
+}
+
+// GetFrontendGRPCClient returns a client calling the frontend through its gRPC inbound
+func (c *cadenceImpl) GetFrontendGRPCClient() workflowserviceclient.Interface {
+	return workflowserviceclient.New(c.newFrontendGRPCClientConfig())
+}
+
+// GetFrontendProtoClient returns a client calling the protobuf WorkflowService through the gRPC inbound
+func (c *cadenceImpl) GetFrontendProtoClient() cadencepb.WorkflowServiceYARPCClient {
+	return cadencepb.NewWorkflowServiceYARPCClient(c.newFrontendGRPCClientConfig())
+}
+
+func (c *cadenceImpl) newFrontendGRPCClientConfig() transport.ClientConfig {
+	d := yarpc.NewDispatcher(yarpc.Config{
+		Name: "cadence-grpc-client",
+		Outbounds: yarpc.Outbounds{
+			common.FrontendServiceName: {Unary: grpc.NewTransport().NewSingleOutbound(c.FrontendGRPCAddress())},
+		},
+	})
+	if err := d.Start(); err != nil {
+		c.logger.Fatal("Failed to create grpc outbound", tag.Error(err))
+	}
+	return d.ClientConfig(common.FrontendServiceName)
+}
+
 // For integration tests to get hold of FE instance.
 func (c *cadenceImpl) GetFrontendService() service.Service {
 	return c.frontEndService
 }
 
-func (c *cadenceImpl) startFrontend(logger bark.Logger, rpHosts []string, startWG *sync.WaitGroup) {
+func (c *cadenceImpl) startFrontend(logger logging.Logger, rpHosts []string, startWG *sync.WaitGroup) {
 	params := new(service.BootstrapParams)
 	params.Name = common.FrontendServiceName
 	params.Logger = logger
 	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.FrontendPProfPort())
-	params.RPCFactory = newRPCFactoryImpl(common.FrontendServiceName, c.FrontendAddress(), logger)
+	params.RPCFactory = newRPCFactoryImpl(common.FrontendServiceName, c.FrontendAddress(), c.FrontendGRPCAddress(), logger)
 	params.MetricScope = tally.NewTestScope(common.FrontendServiceName, make(map[string]string))
 	params.RingpopFactory = newRingpopFactory(common.FrontendServiceName, rpHosts)
 	params.ClusterMetadata = c.clusterMetadata
@@ -222,17 +268,25 @@ func (c *cadenceImpl) startFrontend(logger bark.Logger, rpHosts []string, startW
 
 	c.frontEndService = service.New(params)
 	c.frontendHandler = frontend.NewWorkflowHandler(
-		c.frontEndService, frontend.NewConfig(), c.metadataMgr, c.historyMgr, c.visibilityMgr, kafkaProducer)
+		c.frontEndService, frontend.NewConfig(dynamicconfig.NewNopCollection(), c.numberOfHistoryShards), c.metadataMgr, c.historyMgr, c.visibilityMgr, kafkaProducer,
+		authorization.NewNopAuthorizer())
+	c.adminHandler = frontend.NewAdminHandler(c.frontEndService, c.numberOfHistoryShards, c.metadataMgr,
+		authorization.NewNopAuthorizer())
+	c.adminHandler.RegisterHandler()
 	err := c.frontendHandler.Start()
 	if err != nil {
-		c.logger.WithField("error", err).Fatal("Failed to start frontend")
+		c.logger.Fatal("Failed to start frontend", tag.Error(err))
+	}
+	err = c.adminHandler.Start()
+	if err != nil {
+		c.logger.Fatal("Failed to start admin", tag.Error(err))
 	}
 	startWG.Done()
 	<-c.shutdownCh
 	c.shutdownWG.Done()
 }
 
-func (c *cadenceImpl) startHistory(logger bark.Logger, shardMgr persistence.ShardManager,
+func (c *cadenceImpl) startHistory(logger logging.Logger, shardMgr persistence.ShardManager,
 	metadataMgr persistence.MetadataManager, visibilityMgr persistence.VisibilityManager, historyMgr persistence.HistoryManager,
 	executionMgrFactory persistence.ExecutionManagerFactory, rpHosts []string, startWG *sync.WaitGroup) {
 
@@ -242,7 +296,7 @@ func (c *cadenceImpl) startHistory(logger bark.Logger, shardMgr persistence.Shar
 		params.Name = common.HistoryServiceName
 		params.Logger = logger
 		params.PProfInitializer = newPProfInitializerImpl(c.logger, pprofPorts[i])
-		params.RPCFactory = newRPCFactoryImpl(common.HistoryServiceName, hostport, logger)
+		params.RPCFactory = newRPCFactoryImpl(common.HistoryServiceName, hostport, "", logger)
 		params.MetricScope = tally.NewTestScope(common.HistoryServiceName, make(map[string]string))
 		params.RingpopFactory = newRingpopFactory(common.FrontendServiceName, rpHosts)
 		params.ClusterMetadata = c.clusterMetadata
@@ -262,14 +316,14 @@ func (c *cadenceImpl) startHistory(logger bark.Logger, shardMgr persistence.Shar
 	c.shutdownWG.Done()
 }
 
-func (c *cadenceImpl) startMatching(logger bark.Logger, taskMgr persistence.TaskManager,
+func (c *cadenceImpl) startMatching(logger logging.Logger, taskMgr persistence.TaskManager,
 	rpHosts []string, startWG *sync.WaitGroup) {
 
 	params := new(service.BootstrapParams)
 	params.Name = common.MatchingServiceName
 	params.Logger = logger
 	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.MatchingPProfPort())
-	params.RPCFactory = newRPCFactoryImpl(common.MatchingServiceName, c.MatchingServiceAddress(), logger)
+	params.RPCFactory = newRPCFactoryImpl(common.MatchingServiceName, c.MatchingServiceAddress(), "", logger)
 	params.MetricScope = tally.NewTestScope(common.MatchingServiceName, make(map[string]string))
 	params.RingpopFactory = newRingpopFactory(common.FrontendServiceName, rpHosts)
 	params.ClusterMetadata = c.clusterMetadata
@@ -337,10 +391,12 @@ type rpcFactoryImpl struct {
 	ch          *tchannel.ChannelTransport
 	serviceName string
 	hostPort    string
-	logger      bark.Logger
+	logger      logging.Logger
+	// grpcHostPort is the address of the gRPC inbound, there is none when it is empty
+	grpcHostPort string
 }
 
-func newPProfInitializerImpl(logger bark.Logger, port int) common.PProfInitializer {
+func newPProfInitializerImpl(logger logging.Logger, port int) common.PProfInitializer {
 	return &config.PProfInitializerImpl{
 		PProf: &config.PProf{
 			Port: port,
@@ -349,11 +405,12 @@ func newPProfInitializerImpl(logger bark.Logger, port int) common.PProfInitializ
 	}
 }
 
-func newRPCFactoryImpl(sName string, hostPort string, logger bark.Logger) common.RPCFactory {
+func newRPCFactoryImpl(sName string, hostPort string, grpcHostPort string, logger logging.Logger) common.RPCFactory {
 	return &rpcFactoryImpl{
-		serviceName: sName,
-		hostPort:    hostPort,
-		logger:      logger,
+		serviceName:  sName,
+		hostPort:     hostPort,
+		grpcHostPort: grpcHostPort,
+		logger:       logger,
 	}
 }
 
@@ -363,11 +420,19 @@ func (c *rpcFactoryImpl) CreateDispatcher() *yarpc.Dispatcher {
 	c.ch, err = tchannel.NewChannelTransport(
 		tchannel.ServiceName(c.serviceName), tchannel.ListenAddr(c.hostPort))
 	if err != nil {
-		c.logger.WithField("error", err).Fatal("Failed to create transport channel")
+		c.logger.Fatal("Failed to create transport channel", tag.Error(err))
+	}
+	inbounds := yarpc.Inbounds{c.ch.NewInbound()}
+	if c.grpcHostPort != "" {
+		listener, err := net.Listen("tcp", c.grpcHostPort)
+		if err != nil {
+			c.logger.Fatal("Failed to listen for grpc", tag.Error(err))
+		}
+		inbounds = append(inbounds, grpc.NewTransport().NewInbound(listener))
 	}
 	return yarpc.NewDispatcher(yarpc.Config{
 		Name:     c.serviceName,
-		Inbounds: yarpc.Inbounds{c.ch.NewInbound()},
+		Inbounds: inbounds,
 		// For integration tests to generate client out of the same outbound.
 		Outbounds: yarpc.Outbounds{
 			c.serviceName: {Unary: c.ch.NewSingleOutbound(c.hostPort)},
@@ -385,7 +450,7 @@ func (c *rpcFactoryImpl) CreateDispatcherForOutbound(
 		},
 	})
 	if err := d.Start(); err != nil {
-		c.logger.WithField("error", err).Fatal("Failed to create outbound transport channel")
+		c.logger.Fatal("Failed to create outbound transport channel", tag.Error(err))
 	}
 	return d
 }
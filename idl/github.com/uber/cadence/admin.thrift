@@ -0,0 +1,111 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+include "shared.thrift"
+include "history.thrift"
+include "replicator.thrift"
+
+namespace java com.uber.cadence.admin
+
+/**
+* AdminService provides advanced APIs for debugging and analysis with admin privilege
+**/
+service AdminService {
+  /**
+  * DescribeWorkflowExecution returns information about the internal states of workflow execution.
+  **/
+  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * DescribeHistoryHost returns information about the internal states of a history host
+  **/
+  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * GetReplicationMessages returns the replication tasks of a history shard, it is called by the worker of the remote
+  * clusters when the replication tasks are pulled over rpc.
+  **/
+  history.GetReplicationMessagesResponse GetReplicationMessages(1: history.GetReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * ReplicateDomain applies a domain replication task sent by a remote cluster when the replication tasks are
+  * shipped over rpc.
+  **/
+  void ReplicateDomain(1: replicator.DomainTaskAttributes request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * DescribeReplicationStatus returns the replication progress of the history shards with the remote clusters, all
+  * the shards are described when no shard ID is set on the request.
+  **/
+  DescribeReplicationStatusResponse DescribeReplicationStatus(1: DescribeReplicationStatusRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * GetWorkflowReplicationTasks returns the replication tasks of a range of events of a workflow execution, it is
+  * called by the history service of the remote clusters to resend the events they are missing.
+  **/
+  history.GetWorkflowReplicationTasksResponse GetWorkflowReplicationTasks(1: history.GetWorkflowReplicationTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+}
+
+struct DescribeWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+}
+
+struct DescribeWorkflowExecutionResponse {
+  10: optional string shardId
+  20: optional string historyAddr
+  40: optional string mutableStateInCache
+  50: optional string mutableStateInDatabase
+}
+
+struct DescribeReplicationStatusRequest {
+  10: optional list<i32> shardIds
+}
+
+struct DescribeReplicationStatusResponse {
+  10: optional string currentCluster
+  20: optional list<history.ShardReplicationStatus> shards
+}
@@ -42,6 +42,7 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.DomainAlreadyExistsError domainExistsError,
+      4: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -52,6 +53,7 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -62,6 +64,7 @@ service WorkflowService {
         1: shared.BadRequestError badRequestError,
         2: shared.InternalServiceError internalServiceError,
         3: shared.EntityNotExistsError entityNotExistError,
+        4: shared.AccessDeniedError accessDeniedError,
       )
 
   /**
@@ -74,6 +77,7 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -89,6 +93,7 @@ service WorkflowService {
       3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -101,6 +106,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -115,6 +121,7 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -130,6 +137,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -144,6 +152,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -160,6 +169,7 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -175,6 +185,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -190,6 +201,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -205,6 +217,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -220,6 +233,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -235,6 +249,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -250,6 +265,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -265,6 +281,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -280,6 +297,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -296,6 +314,7 @@ service WorkflowService {
       4: shared.CancellationAlreadyRequestedError cancellationAlreadyRequestedError,
       5: shared.ServiceBusyError serviceBusyError,
       6: shared.DomainNotActiveError domainNotActiveError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -309,6 +328,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -325,6 +345,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -338,6 +359,22 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ResetWorkflowExecution resets an existing workflow execution to the DecisionTaskCompleted event identified by
+  * decisionFinishEventId. A new run is created with the history up to that point, the in-flight decision is failed
+  * and signals received after the reset point are re-applied. The current run is terminated if it is still running.
+  **/
+  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: shared.ResetWorkflowExecutionRequest resetRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -349,6 +386,7 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -360,6 +398,35 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ListWorkflowExecutions is a visibility API to list the executions in a specific domain matching a query. The
+  * query is a conjunction of predicates on the execution fields and search attributes, for example
+  * "WorkflowType = 'X' AND CloseStatus = 'Failed' ORDER BY CloseTime".
+  **/
+  shared.ListWorkflowExecutionsResponse ListWorkflowExecutions(1: shared.ListWorkflowExecutionsRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * CountWorkflowExecutions is a visibility API to count the executions in a specific domain matching a query, the
+  * query has the same format as the one of ListWorkflowExecutions. The count can optionally be grouped by workflow
+  * type or close status.
+  **/
+  shared.CountWorkflowExecutionsResponse CountWorkflowExecutions(1: shared.CountWorkflowExecutionsRequest countRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -372,6 +439,7 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -383,6 +451,7 @@ service WorkflowService {
 	  2: shared.InternalServiceError internalServiceError,
 	  3: shared.EntityNotExistsError entityNotExistError,
 	  4: shared.QueryFailedError queryFailedError,
+	  5: shared.AccessDeniedError accessDeniedError,
 	)
 
   /**
@@ -393,6 +462,7 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -404,6 +474,7 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
     )
 
 }
@@ -42,6 +42,10 @@ struct StartWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.StartWorkflowExecutionRequest startRequest
   30: optional ParentExecutionInfo parentExecutionInfo
+  40: optional shared.ContinueAsNewInitiator continueAsNewInitiator
+  50: optional i32 firstDecisionTaskBackoffSeconds
+  60: optional i32 attempt
+  70: optional i64 (js.type = "Long") expirationTimestamp
 }
 
 struct GetMutableStateRequest {
@@ -163,6 +167,11 @@ struct TerminateWorkflowExecutionRequest {
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
 }
 
+struct ResetWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.ResetWorkflowExecutionRequest resetRequest
+}
+
 struct RequestCancelWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.RequestCancelWorkflowExecutionRequest cancelRequest
@@ -196,6 +205,16 @@ struct RecordChildExecutionCompletedRequest {
   50: optional shared.HistoryEvent completionEvent
 }
 
+struct DescribeMutableStateRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+}
+
+struct DescribeMutableStateResponse {
+  30: optional string mutableStateInCache
+  40: optional string mutableStateInDatabase
+}
+
 struct ReplicationInfo {
   10: optional i64 (js.type = "Long") version
   20: optional i64 (js.type = "Long") lastEventId
@@ -213,6 +232,73 @@ struct ReplicateEventsRequest {
   90: optional shared.History newRunHistory
 }
 
+struct GetReplicationMessagesRequest {
+  10: optional i32 shardId
+  20: optional string clusterName
+  30: optional i64 (js.type = "Long") lastRetrievedMessageId
+  40: optional i64 (js.type = "Long") lastProcessedMessageId
+}
+
+struct ReplicationMessage {
+  10: optional i64 (js.type = "Long") taskId
+  20: optional string domainId
+  30: optional string workflowId
+  40: optional string runId
+  50: optional i64 (js.type = "Long") firstEventId
+  60: optional i64 (js.type = "Long") nextEventId
+  70: optional i64 (js.type = "Long") version
+  80: optional map<string, ReplicationInfo> replicationInfo
+  90: optional shared.History history
+  100: optional shared.History newRunHistory
+}
+
+struct GetReplicationMessagesResponse {
+  10: optional list<ReplicationMessage> messages
+  20: optional i64 (js.type = "Long") lastRetrievedMessageId
+  30: optional bool hasMore
+}
+
+struct GetReplicationStatusRequest {
+  10: optional i32 shardId
+}
+
+struct ClusterReplicationStatus {
+  10: optional i64 (js.type = "Long") replicationAckLevel
+  20: optional i64 (js.type = "Long") transferAckLevel
+  30: optional i64 (js.type = "Long") timerAckLevel
+  40: optional i64 (js.type = "Long") lastReplicatedTimestamp
+}
+
+struct ShardReplicationStatus {
+  10: optional i32 shardId
+  20: optional i64 (js.type = "Long") timestamp
+  30: optional i64 (js.type = "Long") maxReadLevel
+  40: optional i64 (js.type = "Long") replicationAckLevel
+  50: optional map<string, ClusterReplicationStatus> remoteClusters
+}
+
+struct GetDomainReplicationLagRequest {
+  10: optional i32 shardId
+  20: optional string domainUUID
+  30: optional string clusterName
+}
+
+struct GetDomainReplicationLagResponse {
+  10: optional i64 (js.type = "Long") pendingReplicationTasks
+}
+
+struct GetWorkflowReplicationTasksRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution workflowExecution
+  30: optional i64 (js.type = "Long") firstEventId
+  40: optional i64 (js.type = "Long") nextEventId
+  50: optional i32 maximumPageSize
+}
+
+struct GetWorkflowReplicationTasksResponse {
+  10: optional list<ReplicateEventsRequest> replicationTasks
+}
+
 /**
 * HistoryService provides API to start a new long running workflow instance, as well as query and update the history
 * of workflow instances already created.
@@ -440,6 +526,19 @@ service HistoryService {
       5: shared.DomainNotActiveError domainNotActiveError,
     )
 
+  /**
+  * ResetWorkflowExecution resets an existing workflow execution to the DecisionTaskCompleted event identified by
+  * decisionFinishEventId, creating a new run and terminating the current run if it is still running.
+  **/
+  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: ResetWorkflowExecutionRequest resetRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+    )
+
   /**
   * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
@@ -502,4 +601,72 @@ service HistoryService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: ShardOwnershipLostError shardOwnershipLostError,
     )
+
+  /**
+  * DescribeMutableState returns the mutable state of the specified workflow execution, both as cached by the history
+  * host and as loaded from the database.
+  **/
+  DescribeMutableStateResponse DescribeMutableState(1: DescribeMutableStateRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * DescribeHistoryHost returns information about the internal states of a history host
+  **/
+  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+    )
+
+  /**
+  * GetReplicationMessages returns the replication tasks of a shard after lastRetrievedMessageId, it is used by the
+  * remote clusters to pull the replication tasks instead of having them published to kafka.  lastProcessedMessageId
+  * tells up to which task the remote cluster has applied the replication tasks, so they can be deleted.
+  **/
+  GetReplicationMessagesResponse GetReplicationMessages(1: GetReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * GetReplicationStatus returns the replication progress of a shard: the replication tasks acknowledged by the remote
+  * clusters and the replication tasks applied from them.  All timestamps are in nanoseconds since epoch.
+  **/
+  ShardReplicationStatus GetReplicationStatus(1: GetReplicationStatusRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * GetDomainReplicationLag returns the number of replication tasks of a domain in a shard which are not yet
+  * replicated to the remote cluster.
+  **/
+  GetDomainReplicationLagResponse GetDomainReplicationLag(1: GetDomainReplicationLagRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * GetWorkflowReplicationTasks rebuilds from the history of a workflow execution the replication tasks of the events
+  * from firstEventId up to nextEventId, one task per batch of events.  It is used by the remote clusters to recover
+  * the replication tasks they never received.
+  **/
+  GetWorkflowReplicationTasksResponse GetWorkflowReplicationTasks(1: GetWorkflowReplicationTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
 }
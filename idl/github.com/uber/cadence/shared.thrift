@@ -61,6 +61,10 @@ exception DomainNotActiveError {
   4: required string activeCluster
 }
 
+exception AccessDeniedError {
+  1: required string message
+}
+
 
 enum WorkflowIdReusePolicy {
   /*
@@ -86,6 +90,11 @@ enum DomainStatus {
   DELETED,
 }
 
+enum ArchivalStatus {
+  DISABLED,
+  ENABLED,
+}
+
 enum TimeoutType {
   START_TO_CLOSE,
   SCHEDULE_TO_START,
@@ -110,6 +119,7 @@ enum DecisionType {
   ContinueAsNewWorkflowExecution,
   StartChildWorkflowExecution,
   SignalExternalWorkflowExecution,
+  UpsertWorkflowSearchAttributes,
 }
 
 enum EventType {
@@ -154,6 +164,7 @@ enum EventType {
   SignalExternalWorkflowExecutionInitiated,
   SignalExternalWorkflowExecutionFailed,
   ExternalWorkflowExecutionSignaled,
+  UpsertWorkflowSearchAttributes,
 }
 
 enum DecisionTaskFailedCause {
@@ -173,6 +184,8 @@ enum DecisionTaskFailedCause {
   WORKFLOW_WORKER_UNHANDLED_FAILURE,
   BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
   BAD_START_CHILD_EXECUTION_ATTRIBUTES,
+  RESET_WORKFLOW,
+  BAD_SEARCH_ATTRIBUTES,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
@@ -223,6 +236,12 @@ enum TaskListKind {
   STICKY,
 }
 
+enum ContinueAsNewInitiator {
+  Decider,
+  RetryPolicy,
+  CronSchedule,
+}
+
 struct WorkflowType {
   10: optional string name
 }
@@ -245,6 +264,10 @@ struct WorkflowExecution {
   20: optional string runId
 }
 
+struct SearchAttributes {
+  10: optional map<string,binary> indexedFields
+}
+
 struct WorkflowExecutionInfo {
   10: optional WorkflowExecution execution
   20: optional WorkflowType type
@@ -252,6 +275,7 @@ struct WorkflowExecutionInfo {
   40: optional i64 (js.type = "Long") closeTime
   50: optional WorkflowExecutionCloseStatus closeStatus
   60: optional i64 (js.type = "Long") historyLength
+  70: optional SearchAttributes searchAttributes
 }
 
 struct WorkflowExecutionConfiguration {
@@ -333,6 +357,12 @@ struct ContinueAsNewWorkflowExecutionDecisionAttributes {
   30: optional binary input
   40: optional i32 executionStartToCloseTimeoutSeconds
   50: optional i32 taskStartToCloseTimeoutSeconds
+  60: optional i32 backoffStartIntervalInSeconds
+  70: optional ContinueAsNewInitiator initiator
+  80: optional RetryPolicy retryPolicy
+  90: optional string failureReason
+  100: optional binary failureDetails
+  110: optional SearchAttributes searchAttributes
 }
 
 struct StartChildWorkflowExecutionDecisionAttributes {
@@ -346,6 +376,12 @@ struct StartChildWorkflowExecutionDecisionAttributes {
   80: optional ChildPolicy childPolicy
   90: optional binary control
   100: optional WorkflowIdReusePolicy workflowIdReusePolicy
+  110: optional RetryPolicy retryPolicy
+  120: optional SearchAttributes searchAttributes
+}
+
+struct UpsertWorkflowSearchAttributesDecisionAttributes {
+  10: optional SearchAttributes searchAttributes
 }
 
 struct Decision {
@@ -362,6 +398,7 @@ struct Decision {
   90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes
   100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes
   110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes
+  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes
 }
 
 struct WorkflowExecutionStartedEventAttributes {
@@ -375,7 +412,14 @@ struct WorkflowExecutionStartedEventAttributes {
   50: optional i32 taskStartToCloseTimeoutSeconds
   52: optional ChildPolicy childPolicy
   54: optional string continuedExecutionRunId
+  55: optional ContinueAsNewInitiator initiator
   60: optional string identity
+  70: optional RetryPolicy retryPolicy
+  80: optional i32 attempt
+  90: optional string cronSchedule
+  100: optional i32 firstDecisionTaskBackoffSeconds
+  110: optional i64 (js.type = "Long") expirationTimestamp
+  120: optional SearchAttributes searchAttributes
 }
 
 struct WorkflowExecutionCompletedEventAttributes {
@@ -401,6 +445,10 @@ struct WorkflowExecutionContinuedAsNewEventAttributes {
   50: optional i32 executionStartToCloseTimeoutSeconds
   60: optional i32 taskStartToCloseTimeoutSeconds
   70: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  80: optional i32 backoffStartIntervalInSeconds
+  90: optional ContinueAsNewInitiator initiator
+  100: optional string failureReason
+  110: optional binary failureDetails
 }
 
 struct DecisionTaskScheduledEventAttributes {
@@ -602,6 +650,11 @@ struct ExternalWorkflowExecutionSignaledEventAttributes {
   40: optional binary control
 }
 
+struct UpsertWorkflowSearchAttributesEventAttributes {
+  10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  20: optional SearchAttributes searchAttributes
+}
+
 struct StartChildWorkflowExecutionInitiatedEventAttributes {
   10:  optional string domain
   20:  optional string workflowId
@@ -614,6 +667,8 @@ struct StartChildWorkflowExecutionInitiatedEventAttributes {
   90:  optional binary control
   100: optional i64 (js.type = "Long") decisionTaskCompletedEventId
   110: optional WorkflowIdReusePolicy workflowIdReusePolicy
+  120: optional RetryPolicy retryPolicy
+  130: optional SearchAttributes searchAttributes
 }
 
 struct StartChildWorkflowExecutionFailedEventAttributes {
@@ -724,6 +779,7 @@ struct HistoryEvent {
   420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes
   430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
   440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
+  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes
 }
 
 struct History {
@@ -753,6 +809,8 @@ struct DomainInfo {
 struct DomainConfiguration {
   10: optional i32 workflowExecutionRetentionPeriodInDays
   20: optional bool emitMetric
+  30: optional string archivalBucketName
+  40: optional ArchivalStatus archivalStatus
 }
 
 struct UpdateDomainInfo {
@@ -769,6 +827,13 @@ struct DomainReplicationConfiguration {
  20: optional list<ClusterReplicationConfiguration> clusters
 }
 
+struct DomainHandoverInfo {
+ 10: optional string targetClusterName
+ 20: optional i64 (js.type = "Long") startTimestamp
+ 30: optional i64 (js.type = "Long") expiryTimestamp
+ 40: optional i64 (js.type = "Long") pendingReplicationTasks
+}
+
 struct RegisterDomainRequest {
   10: optional string name
   20: optional string description
@@ -777,6 +842,8 @@ struct RegisterDomainRequest {
   50: optional bool emitMetric
   60: optional list<ClusterReplicationConfiguration> clusters
   70: optional string activeClusterName
+  80: optional string archivalBucketName
+  90: optional ArchivalStatus archivalStatus
 }
 
 struct DescribeDomainRequest {
@@ -789,6 +856,7 @@ struct DescribeDomainResponse {
   30: optional DomainReplicationConfiguration replicationConfiguration
   40: optional i64 (js.type = "Long") failoverVersion
   50: optional bool isGlobalDomain
+  60: optional DomainHandoverInfo handoverInfo
 }
 
 struct UpdateDomainRequest {
@@ -796,6 +864,7 @@ struct UpdateDomainRequest {
  20: optional UpdateDomainInfo updatedInfo
  30: optional DomainConfiguration configuration
  40: optional DomainReplicationConfiguration replicationConfiguration
+ 50: optional i32 gracefulFailoverTimeoutInSeconds
 }
 
 struct UpdateDomainResponse {
@@ -822,6 +891,9 @@ struct StartWorkflowExecutionRequest {
   90: optional string requestId
   100: optional WorkflowIdReusePolicy workflowIdReusePolicy
   110: optional ChildPolicy childPolicy
+  120: optional RetryPolicy retryPolicy
+  130: optional string cronSchedule
+  140: optional SearchAttributes searchAttributes
 }
 
 struct StartWorkflowExecutionResponse {
@@ -1000,6 +1072,9 @@ struct SignalWithStartWorkflowExecutionRequest {
   110: optional string signalName
   120: optional binary signalInput
   130: optional binary control
+  140: optional RetryPolicy retryPolicy
+  150: optional string cronSchedule
+  160: optional SearchAttributes searchAttributes
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1010,6 +1085,19 @@ struct TerminateWorkflowExecutionRequest {
   50: optional string identity
 }
 
+struct ResetWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional i64 (js.type = "Long") decisionFinishEventId
+  50: optional string requestId
+  60: optional string identity
+}
+
+struct ResetWorkflowExecutionResponse {
+  10: optional string runId
+}
+
 struct ListOpenWorkflowExecutionsRequest {
   10: optional string domain
   20: optional i32 maximumPageSize
@@ -1017,6 +1105,7 @@ struct ListOpenWorkflowExecutionsRequest {
   40: optional StartTimeFilter StartTimeFilter
   50: optional WorkflowExecutionFilter executionFilter
   60: optional WorkflowTypeFilter typeFilter
+  70: optional SearchAttributes searchAttributesFilter
 }
 
 struct ListOpenWorkflowExecutionsResponse {
@@ -1032,6 +1121,7 @@ struct ListClosedWorkflowExecutionsRequest {
   50: optional WorkflowExecutionFilter executionFilter
   60: optional WorkflowTypeFilter typeFilter
   70: optional WorkflowExecutionCloseStatus statusFilter
+  80: optional SearchAttributes searchAttributesFilter
 }
 
 struct ListClosedWorkflowExecutionsResponse {
@@ -1039,6 +1129,41 @@ struct ListClosedWorkflowExecutionsResponse {
   20: optional binary nextPageToken
 }
 
+struct ListWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional i32 pageSize
+  30: optional binary nextPageToken
+  40: optional string query
+}
+
+struct ListWorkflowExecutionsResponse {
+  10: optional list<WorkflowExecutionInfo> executions
+  20: optional binary nextPageToken
+}
+
+enum CountWorkflowExecutionsGroupBy {
+  WORKFLOW_TYPE,
+  CLOSE_STATUS,
+}
+
+struct CountWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional string query
+  30: optional CountWorkflowExecutionsGroupBy groupBy
+}
+
+struct CountWorkflowExecutionsGroup {
+  10: optional string workflowType
+  // not set for the group of open executions
+  20: optional WorkflowExecutionCloseStatus closeStatus
+  30: optional i64 (js.type = "Long") count
+}
+
+struct CountWorkflowExecutionsResponse {
+  10: optional i64 (js.type = "Long") count
+  20: optional list<CountWorkflowExecutionsGroup> groups
+}
+
 struct QueryWorkflowRequest {
   10: optional string domain
   20: optional WorkflowExecution execution
@@ -1080,6 +1205,20 @@ struct DescribeWorkflowExecutionResponse {
   30: optional list<PendingActivityInfo> pendingActivities
 }
 
+struct DescribeHistoryHostRequest {
+  10: optional string hostAddress //ip:port
+  20: optional i32 shardIdForHost
+  30: optional WorkflowExecution executionForHost
+}
+
+struct DescribeHistoryHostResponse {
+  10: optional i32 numberOfShards
+  20: optional list<i32> shardIDs
+  30: optional map<i32, i32> historyCacheSizes
+  40: optional string shardControllerStatus
+  50: optional string address
+}
+
 struct DescribeTaskListRequest {
   10: optional string domain
   20: optional TaskList taskList
@@ -1126,4 +1265,8 @@ struct RetryPolicy {
 
   // Non-Retriable errors. Will stop retrying if error matches this list.
   50: optional list<string> nonRetriableErrorReasons
+
+  // Expiration time for the whole retry process. Only used for workflow retry, activities are bounded
+  // by their ScheduleToClose timeout instead.
+  60: optional i32 expirationIntervalInSeconds
 }
@@ -0,0 +1,136 @@
+CREATE TABLE shards (
+  shard_id INTEGER NOT NULL,
+  -- Range identifier used for generating ack ids for tasks within shard.
+  -- Also used for optimistic concurrency and all writes to a shard are conditional on this value.
+  range_id BIGINT NOT NULL,
+  -- JSON encoded ShardInfo
+  data BLOB NOT NULL,
+  PRIMARY KEY (shard_id)
+);
+
+CREATE TABLE task_lists (
+  domain_id CHAR(64) NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  task_type SMALLINT NOT NULL, -- {Decision, Activity}
+  range_id BIGINT NOT NULL,
+  ack_level BIGINT NOT NULL DEFAULT 0,
+  kind SMALLINT NOT NULL, -- {Normal, Sticky}
+  PRIMARY KEY (domain_id, name, task_type)
+);
+
+CREATE TABLE tasks (
+  domain_id CHAR(64) NOT NULL,
+  task_list_name VARCHAR(255) NOT NULL,
+  task_type SMALLINT NOT NULL,
+  task_id BIGINT NOT NULL,
+  -- JSON encoded TaskInfo
+  data BLOB NOT NULL,
+  PRIMARY KEY (domain_id, task_list_name, task_type, task_id)
+);
+
+--- Workflow execution and mutable state ---
+CREATE TABLE executions (
+  shard_id INTEGER NOT NULL,
+  domain_id CHAR(64) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id CHAR(64) NOT NULL,
+  -- Used for optimistic concurrency, all updates to an execution are conditional on this value.
+  next_event_id BIGINT NOT NULL,
+  -- JSON encoded WorkflowExecutionInfo
+  data BLOB NOT NULL,
+  -- JSON encoded ReplicationState, only set for global domains
+  replication_state BLOB,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
+);
+
+-- Points to the run which is currently (or was most recently) executing for a workflow id.
+CREATE TABLE current_executions (
+  shard_id INTEGER NOT NULL,
+  domain_id CHAR(64) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id CHAR(64) NOT NULL,
+  create_request_id CHAR(64) NOT NULL,
+  state INTEGER NOT NULL,
+  close_status INTEGER NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id)
+);
+
+-- Activity, timer, child execution, request cancel, signal, signal requested and
+-- buffered replication task entries of the mutable state, keyed by map_type.
+CREATE TABLE mutable_state_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id CHAR(64) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id CHAR(64) NOT NULL,
+  map_type SMALLINT NOT NULL,
+  map_key VARCHAR(255) NOT NULL,
+  data BLOB NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, map_type, map_key)
+);
+
+CREATE TABLE buffered_events (
+  id BIGINT AUTO_INCREMENT NOT NULL,
+  shard_id INTEGER NOT NULL,
+  domain_id CHAR(64) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id CHAR(64) NOT NULL,
+  data BLOB NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX buffered_events_by_execution ON buffered_events (shard_id, domain_id, workflow_id, run_id);
+
+CREATE TABLE transfer_tasks (
+  shard_id INTEGER NOT NULL,
+  task_id BIGINT NOT NULL,
+  -- JSON encoded TransferTaskInfo
+  data BLOB NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE replication_tasks (
+  shard_id INTEGER NOT NULL,
+  task_id BIGINT NOT NULL,
+  -- JSON encoded ReplicationTaskInfo
+  data BLOB NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE timer_tasks (
+  shard_id INTEGER NOT NULL,
+  visibility_timestamp DATETIME(6) NOT NULL,
+  task_id BIGINT NOT NULL,
+  -- JSON encoded TimerTaskInfo
+  data BLOB NOT NULL,
+  PRIMARY KEY (shard_id, visibility_timestamp, task_id)
+);
+
+--- Workflow history ---
+CREATE TABLE events (
+  domain_id CHAR(64) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id CHAR(64) NOT NULL,
+  first_event_id BIGINT NOT NULL,
+  -- Used for conditional overwrite of a batch of events
+  range_id BIGINT NOT NULL,
+  tx_id BIGINT NOT NULL,
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(64) NOT NULL,
+  data_version INTEGER NOT NULL,
+  PRIMARY KEY (domain_id, workflow_id, run_id, first_event_id)
+);
+
+--- Domain metadata ---
+CREATE TABLE domains (
+  id CHAR(64) NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  -- JSON encoded DomainInfo, DomainConfig and DomainReplicationConfig
+  data BLOB NOT NULL,
+  is_global_domain BOOLEAN NOT NULL,
+  config_version BIGINT NOT NULL,
+  failover_version BIGINT NOT NULL,
+  -- Used for optimistic concurrency, all updates to a domain are conditional on this value.
+  db_version BIGINT NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE (name)
+);
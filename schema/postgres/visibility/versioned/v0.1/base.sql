@@ -0,0 +1,15 @@
+CREATE TABLE executions_visibility (
+  domain_id CHAR(64) NOT NULL,
+  run_id CHAR(64) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  start_time BIGINT NOT NULL, -- unix nanos
+  close_time BIGINT NULL, -- unix nanos, NULL while the execution is open
+  workflow_type_name VARCHAR(255) NOT NULL,
+  status INTEGER NULL, -- enum WorkflowExecutionCloseStatus {COMPLETED, FAILED, CANCELED, TERMINATED, CONTINUED_AS_NEW, TIMED_OUT}
+  history_length BIGINT NULL,
+  PRIMARY KEY (domain_id, run_id)
+);
+
+CREATE INDEX by_type_start_time ON executions_visibility (domain_id, workflow_type_name, close_time, start_time, run_id);
+CREATE INDEX by_workflow_id_start_time ON executions_visibility (domain_id, workflow_id, close_time, start_time, run_id);
+CREATE INDEX by_close_time ON executions_visibility (domain_id, close_time, start_time, run_id);
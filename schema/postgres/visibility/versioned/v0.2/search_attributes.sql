@@ -0,0 +1,11 @@
+ALTER TABLE executions_visibility ADD COLUMN search_attributes BYTEA NULL; -- json encoded map of the search attributes
+
+CREATE TABLE executions_visibility_search_attributes (
+  domain_id CHAR(64) NOT NULL,
+  run_id CHAR(64) NOT NULL,
+  attr_key VARCHAR(255) NOT NULL,
+  attr_value BYTEA NOT NULL,
+  PRIMARY KEY (domain_id, run_id, attr_key)
+);
+
+CREATE INDEX by_attr_key ON executions_visibility_search_attributes (domain_id, attr_key);
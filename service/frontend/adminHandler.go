@@ -0,0 +1,386 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/worker"
+)
+
+var _ adminserviceserver.Interface = (*AdminHandler)(nil)
+
+type (
+	// AdminHandler - Thrift handler inteface for admin service
+	AdminHandler struct {
+		numberOfHistoryShards  int
+		historyServiceResolver membership.ServiceResolver
+		history                history.Client
+		domainCache            cache.DomainCache
+		domainReplicator       worker.DomainReplicator
+		metricsClient          metrics.Client
+		authorizer             authorization.Authorizer
+		startWG                sync.WaitGroup
+		service.Service
+	}
+)
+
+const describeReplicationStatusConcurrency = 32
+
+var (
+	errHostNotSet    = &gen.BadRequestError{Message: "Host address, shard ID or workflow execution is not set on request."}
+	errShardIDNotSet = &gen.BadRequestError{Message: "Shard ID is not set on request."}
+)
+
+// NewAdminHandler creates a thrift handler for the cadence admin service, every call requires the admin role
+func NewAdminHandler(
+	sVice service.Service, numberOfHistoryShards int, metadataMgr persistence.MetadataManager,
+	authorizer authorization.Authorizer) *AdminHandler {
+	handler := &AdminHandler{
+		Service:               sVice,
+		numberOfHistoryShards: numberOfHistoryShards,
+		domainCache:           cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetLogger()),
+		domainReplicator:      worker.NewDomainReplicator(metadataMgr, sVice.GetLogger()),
+		authorizer:            authorizer,
+	}
+	// prevent us from trying to serve requests before handler's Start() is complete
+	handler.startWG.Add(1)
+	return handler
+}
+
+// RegisterHandler registers the admin service handler with the dispatcher, it must be called before the service
+// is started
+func (adh *AdminHandler) RegisterHandler() {
+	adh.Service.GetDispatcher().Register(adminserviceserver.New(adh))
+}
+
+// Start starts the handler, it must be called after the service is started
+func (adh *AdminHandler) Start() error {
+	var err error
+	adh.history, err = adh.Service.GetClientFactory().NewHistoryClient()
+	if err != nil {
+		return err
+	}
+	adh.historyServiceResolver, err = adh.Service.GetMembershipMonitor().GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return err
+	}
+	adh.metricsClient = adh.Service.GetMetricsClient()
+	adh.startWG.Done()
+	return nil
+}
+
+// DescribeWorkflowExecution returns information about the internal states of workflow execution.
+func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context,
+	request *admin.DescribeWorkflowExecutionRequest) (*admin.DescribeWorkflowExecutionResponse, error) {
+
+	scope := metrics.AdminDescribeWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	if err := adh.authorize(ctx, "AdminDescribeWorkflowExecution", request.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	shardID := common.WorkflowIDToHistoryShard(request.Execution.GetWorkflowId(), adh.numberOfHistoryShards)
+	historyHost, err := adh.historyServiceResolver.Lookup(string(shardID))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.DescribeMutableState(ctx, &h.DescribeMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &admin.DescribeWorkflowExecutionResponse{
+		ShardId:                common.StringPtr(strconv.Itoa(shardID)),
+		HistoryAddr:            common.StringPtr(historyHost.GetAddress()),
+		MutableStateInCache:    resp.MutableStateInCache,
+		MutableStateInDatabase: resp.MutableStateInDatabase,
+	}, nil
+}
+
+// DescribeHistoryHost returns information about the internal states of a history host
+func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context,
+	request *gen.DescribeHistoryHostRequest) (*gen.DescribeHistoryHostResponse, error) {
+
+	scope := metrics.AdminDescribeHistoryHostScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if request.HostAddress == nil && request.ShardIdForHost == nil && request.ExecutionForHost == nil {
+		return nil, adh.error(errHostNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "AdminDescribeHistoryHost", authorization.AllDomains, scope); err != nil {
+		return nil, err
+	}
+
+	if request.ExecutionForHost != nil {
+		if err := validateExecution(request.ExecutionForHost); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	resp, err := adh.history.DescribeHistoryHost(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// GetReplicationMessages returns the replication tasks of a history shard to the remote cluster pulling them
+func (adh *AdminHandler) GetReplicationMessages(ctx context.Context,
+	request *h.GetReplicationMessagesRequest) (*h.GetReplicationMessagesResponse, error) {
+
+	scope := metrics.AdminGetReplicationMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if request.ShardId == nil {
+		return nil, adh.error(errShardIDNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "AdminGetReplicationMessages", authorization.AllDomains, scope); err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.history.GetReplicationMessages(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ReplicateDomain applies a domain replication task pushed by a remote cluster
+func (adh *AdminHandler) ReplicateDomain(ctx context.Context, request *replicator.DomainTaskAttributes) error {
+
+	scope := metrics.AdminReplicateDomainScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "AdminReplicateDomain", authorization.AllDomains, scope); err != nil {
+		return err
+	}
+
+	if err := adh.domainReplicator.HandleReceivingTask(request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeReplicationStatus returns the replication progress of the history shards with the remote clusters
+func (adh *AdminHandler) DescribeReplicationStatus(ctx context.Context,
+	request *admin.DescribeReplicationStatusRequest) (*admin.DescribeReplicationStatusResponse, error) {
+
+	scope := metrics.AdminDescribeReplicationStatusScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.authorize(ctx, "AdminDescribeReplicationStatus", authorization.AllDomains, scope); err != nil {
+		return nil, err
+	}
+
+	shardIDs := request.ShardIds
+	if len(shardIDs) == 0 {
+		shardIDs = make([]int32, adh.numberOfHistoryShards)
+		for i := range shardIDs {
+			shardIDs[i] = int32(i)
+		}
+	}
+	for _, shardID := range shardIDs {
+		if shardID < 0 || int(shardID) >= adh.numberOfHistoryShards {
+			return nil, adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Invalid shard ID %v.", shardID)}, scope)
+		}
+	}
+
+	shards := make([]*h.ShardReplicationStatus, len(shardIDs))
+	errs := make([]error, len(shardIDs))
+	var wg sync.WaitGroup
+	// bounds the number of shards described at the same time
+	tokens := make(chan struct{}, describeReplicationStatusConcurrency)
+	for i, shardID := range shardIDs {
+		wg.Add(1)
+		tokens <- struct{}{}
+		go func(i int, shardID int32) {
+			defer func() {
+				<-tokens
+				wg.Done()
+			}()
+			shards[i], errs[i] = adh.history.GetReplicationStatus(ctx, &h.GetReplicationStatusRequest{
+				ShardId: common.Int32Ptr(shardID),
+			})
+		}(i, shardID)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return &admin.DescribeReplicationStatusResponse{
+		CurrentCluster: common.StringPtr(adh.GetClusterMetadata().GetCurrentClusterName()),
+		Shards:         shards,
+	}, nil
+}
+
+// GetWorkflowReplicationTasks returns the replication tasks of a range of events of a workflow execution to the
+// remote cluster which is missing them
+func (adh *AdminHandler) GetWorkflowReplicationTasks(ctx context.Context,
+	request *h.GetWorkflowReplicationTasksRequest) (*h.GetWorkflowReplicationTasksResponse, error) {
+
+	scope := metrics.AdminGetWorkflowReplicationTasksScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	if request.DomainUUID == nil {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+
+	if err := adh.authorizeDomainID(ctx, "AdminGetWorkflowReplicationTasks", request.GetDomainUUID(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := validateExecution(request.WorkflowExecution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	if request.GetFirstEventId() < common.FirstEventID || request.GetNextEventId() <= request.GetFirstEventId() {
+		return nil, adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Invalid event range [%v, %v).",
+			request.GetFirstEventId(), request.GetNextEventId())}, scope)
+	}
+
+	resp, err := adh.history.GetWorkflowReplicationTasks(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// authorize checks that the caller has the admin role on the domain, the APIs which apply to the whole
+// cluster are authorized on authorization.AllDomains
+func (adh *AdminHandler) authorize(ctx context.Context, apiName string, domainName string, scope int) error {
+	decision, err := adh.authorizer.Authorize(ctx, authorization.NewAttributes(ctx, apiName, domainName))
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if decision != authorization.DecisionAllow {
+		return adh.error(&gen.AccessDeniedError{
+			Message: fmt.Sprintf("Caller is not allowed to call %v on domain %v.", apiName, domainName),
+		}, scope)
+	}
+	return nil
+}
+
+// authorizeDomainID is authorize for the APIs which only know the domain ID
+func (adh *AdminHandler) authorizeDomainID(ctx context.Context, apiName string, domainID string, scope int) error {
+	domainEntry, err := adh.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return adh.authorize(ctx, apiName, domainEntry.GetInfo().Name, scope)
+}
+
+// startRequestProfile initiates recording of request metrics
+func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
+	adh.startWG.Wait()
+	sw := adh.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	adh.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	return sw
+}
+
+func (adh *AdminHandler) error(err error, scope int) error {
+	switch err.(type) {
+	case *gen.InternalServiceError:
+		logging.LogInternalServiceError(adh.Service.GetLogger(), err)
+		adh.metricsClient.IncCounter(scope, metrics.CadenceFailures)
+		return err
+	case *gen.BadRequestError:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceErrBadRequestCounter)
+		return err
+	case *gen.EntityNotExistsError:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceErrEntityNotExistsCounter)
+		return err
+	case *gen.AccessDeniedError:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceErrAccessDeniedCounter)
+		return err
+	default:
+		logging.LogUncategorizedError(adh.Service.GetLogger(), err)
+		adh.metricsClient.IncCounter(scope, metrics.CadenceFailures)
+		return &gen.InternalServiceError{Message: err.Error()}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	adminHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+		domainID          string
+		mockHistoryClient *mocks.HistoryClient
+		authorizer        *fakeAuthorizer
+		handler           *AdminHandler
+	}
+)
+
+func TestAdminHandlerSuite(t *testing.T) {
+	s := new(adminHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *adminHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.domainID = uuid.New()
+	mockMetadataMgr := &mocks.MetadataManager{}
+	mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
+		Info:              &persistence.DomainInfo{ID: s.domainID, Name: testDomainName},
+		Config:            &persistence.DomainConfig{Retention: 1},
+		ReplicationConfig: &persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+	}, nil)
+	mockClusterMetadata := &mocks.ClusterMetadata{}
+	mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	testService := service.NewTestService(mockClusterMetadata, nil, metricsClient, logging.NewNopLogger())
+	s.authorizer = &fakeAuthorizer{decision: authorization.DecisionDeny}
+	s.handler = NewAdminHandler(testService, 4, mockMetadataMgr, s.authorizer)
+	s.mockHistoryClient = &mocks.HistoryClient{}
+	s.handler.history = s.mockHistoryClient
+	s.handler.metricsClient = metricsClient
+	s.handler.startWG.Done()
+}
+
+func (s *adminHandlerSuite) TearDownTest() {
+	// the history service is never called once the caller is denied
+	s.mockHistoryClient.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) TestAccessDenied() {
+	ctx := context.Background()
+	execution := &gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-workflow"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	testCases := []struct {
+		apiName    string
+		domainName string
+		call       func() error
+	}{
+		{"AdminDescribeWorkflowExecution", testDomainName, func() error {
+			_, err := s.handler.DescribeWorkflowExecution(ctx, &admin.DescribeWorkflowExecutionRequest{
+				Domain:    common.StringPtr(testDomainName),
+				Execution: execution,
+			})
+			return err
+		}},
+		{"AdminGetWorkflowReplicationTasks", testDomainName, func() error {
+			_, err := s.handler.GetWorkflowReplicationTasks(ctx, &h.GetWorkflowReplicationTasksRequest{
+				DomainUUID:        common.StringPtr(s.domainID),
+				WorkflowExecution: execution,
+				FirstEventId:      common.Int64Ptr(common.FirstEventID),
+				NextEventId:       common.Int64Ptr(common.FirstEventID + 1),
+			})
+			return err
+		}},
+		{"AdminDescribeHistoryHost", authorization.AllDomains, func() error {
+			_, err := s.handler.DescribeHistoryHost(ctx, &gen.DescribeHistoryHostRequest{
+				ShardIdForHost: common.Int32Ptr(1),
+			})
+			return err
+		}},
+		{"AdminGetReplicationMessages", authorization.AllDomains, func() error {
+			_, err := s.handler.GetReplicationMessages(ctx, &h.GetReplicationMessagesRequest{
+				ShardId: common.Int32Ptr(1),
+			})
+			return err
+		}},
+		{"AdminReplicateDomain", authorization.AllDomains, func() error {
+			return s.handler.ReplicateDomain(ctx, &replicator.DomainTaskAttributes{
+				ID: common.StringPtr(s.domainID),
+			})
+		}},
+		{"AdminDescribeReplicationStatus", authorization.AllDomains, func() error {
+			_, err := s.handler.DescribeReplicationStatus(ctx, &admin.DescribeReplicationStatusRequest{})
+			return err
+		}},
+	}
+	for _, tc := range testCases {
+		s.authorizer.attributes = nil
+		err := tc.call()
+		s.IsType(&gen.AccessDeniedError{}, err, tc.apiName)
+		s.Equal(1, len(s.authorizer.attributes), tc.apiName)
+		s.Equal(tc.apiName, s.authorizer.attributes[0].APIName)
+		s.Equal(tc.domainName, s.authorizer.attributes[0].DomainName)
+	}
+}
+
+func (s *adminHandlerSuite) TestRoleBasedAuthorizer() {
+	// only the admin role on every domain allows the cluster wide admin APIs
+	authorizer := authorization.NewRoleBasedAuthorizer(&authorization.Config{
+		Enable: true,
+		Grants: map[string]map[string]authorization.Role{
+			"": {testDomainName: authorization.RoleAdmin},
+		},
+	})
+	s.handler.authorizer = authorizer
+
+	_, err := s.handler.DescribeReplicationStatus(context.Background(), &admin.DescribeReplicationStatusRequest{})
+	s.IsType(&gen.AccessDeniedError{}, err)
+
+	decision, err := authorizer.Authorize(context.Background(),
+		authorization.NewAttributes(context.Background(), "AdminDescribeWorkflowExecution", testDomainName))
+	s.Nil(err)
+	s.Equal(authorization.DecisionAllow, decision)
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/replicator"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/logging/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// domainReplicationLagConcurrency bounds the number of shards asked for the replication lag of a domain at the
+	// same time
+	domainReplicationLagConcurrency = 32
+	domainHandoverRPCTimeout        = 30 * time.Second
+	domainHandoverScanPageSize      = 100
+)
+
+type (
+	domainReplicationLagKey struct {
+		domainID    string
+		clusterName string
+	}
+
+	// domainReplicationLag is the number of replication tasks of a domain pending for a remote cluster, as measured
+	// at a given time
+	domainReplicationLag struct {
+		pendingTasks int64
+		measuredAt   time.Time
+	}
+)
+
+// validateGracefulFailover checks that a graceful failover only changes the active cluster of a global domain from
+// the current cluster
+func (wh *WorkflowHandler) validateGracefulFailover(existingDomain *persistence.GetDomainResponse,
+	previousActiveCluster string, targetCluster string, activeClusterChanged bool, configurationChanged bool,
+	timeoutInSeconds int32) error {
+	if !existingDomain.IsGlobalDomain {
+		return errGracefulFailoverNotGlobalDomain
+	}
+	// the replication tasks applied by the remote clusters are only known when they pull them from this cluster
+	if messagingClient := wh.GetMessagingClient(); messagingClient == nil || !messagingClient.IsPullBased() {
+		return errGracefulFailoverNotPullBased
+	}
+	if configurationChanged || !activeClusterChanged {
+		return errGracefulFailoverOnlyActiveCluster
+	}
+	if timeoutInSeconds <= 0 {
+		return errGracefulFailoverInvalidTimeout
+	}
+	if previousActiveCluster != wh.GetClusterMetadata().GetCurrentClusterName() {
+		return errGracefulFailoverNotActiveCluster
+	}
+	if targetCluster == previousActiveCluster {
+		return errGracefulFailoverToActiveCluster
+	}
+	if persistence.IsHandoverInProgress(existingDomain.ReplicationConfig, time.Now()) {
+		return errGracefulFailoverInProgress
+	}
+	return nil
+}
+
+// resumeDomainHandovers periodically scans the domains in handover from the current cluster, and drives the ones not
+// driven by this host yet.  A handover requested on a host which is stopped in the meantime is resumed this way.
+func (wh *WorkflowHandler) resumeDomainHandovers() {
+	ticker := time.NewTicker(wh.config.DomainHandoverScanInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := wh.scanDomainHandovers(); err != nil {
+			wh.GetLogger().Warn("Failed to scan the domains in handover, will retry.", tag.Error(err))
+		}
+		select {
+		case <-wh.stopC:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// scanDomainHandovers drives the handovers of all the domains in handover from the current cluster
+func (wh *WorkflowHandler) scanDomainHandovers() error {
+	currentClusterName := wh.GetClusterMetadata().GetCurrentClusterName()
+	var nextPageToken []byte
+	for {
+		response, err := wh.metadataMgr.ListDomains(&persistence.ListDomainsRequest{
+			PageSize:      domainHandoverScanPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, domain := range response.Domains {
+			replicationConfig := domain.ReplicationConfig
+			if replicationConfig.HandoverClusterName != "" && replicationConfig.ActiveClusterName == currentClusterName {
+				wh.startDomainHandover(domain.Info.Name, replicationConfig.HandoverStartTime)
+			}
+		}
+
+		if len(response.NextPageToken) == 0 {
+			return nil
+		}
+		nextPageToken = response.NextPageToken
+	}
+}
+
+// startDomainHandover drives the handover of a domain from this host, unless it already does.  Several hosts may
+// drive the same handover, the conditional update of the domain lets a single one of them complete it.
+func (wh *WorkflowHandler) startDomainHandover(domainName string, startTime time.Time) {
+	wh.handoversLock.Lock()
+	defer wh.handoversLock.Unlock()
+
+	if inProgress, ok := wh.handovers[domainName]; ok && inProgress.Equal(startTime) {
+		return
+	}
+	wh.handovers[domainName] = startTime
+
+	go func() {
+		wh.handOverDomain(domainName, startTime)
+
+		wh.handoversLock.Lock()
+		defer wh.handoversLock.Unlock()
+		// a later handover of the domain may be driven already
+		if inProgress, ok := wh.handovers[domainName]; ok && inProgress.Equal(startTime) {
+			delete(wh.handovers, domainName)
+		}
+	}()
+}
+
+// handOverDomain waits until the replication tasks of a domain in handover are replicated to the handover cluster,
+// then fails the domain over to it.  It stops once the handover completes, expires or is superseded by another
+// failover, or when this host is stopped.
+func (wh *WorkflowHandler) handOverDomain(domainName string, startTime time.Time) {
+	logger := wh.GetLogger().WithTags(tag.WorkflowDomainName(domainName))
+	ticker := time.NewTicker(wh.config.DomainHandoverPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-wh.stopC:
+			return
+		case <-ticker.C:
+			done, err := wh.completeDomainHandover(domainName, startTime)
+			if err != nil {
+				logger.Warn("Failed to complete domain handover, will retry.", tag.Error(err))
+				continue
+			}
+			if done {
+				return
+			}
+		}
+	}
+}
+
+// completeDomainHandover fails the domain over to the handover cluster once none of its replication tasks are pending,
+// it returns true when the handover is over
+func (wh *WorkflowHandler) completeDomainHandover(domainName string, startTime time.Time) (done bool, retError error) {
+	getResponse, err := wh.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		return false, err
+	}
+
+	info := getResponse.Info
+	defer func() {
+		if done {
+			wh.forgetDomainReplicationLags(info.ID)
+		}
+	}()
+	replicationConfig := getResponse.ReplicationConfig
+	if replicationConfig.HandoverClusterName == "" || !replicationConfig.HandoverStartTime.Equal(startTime) {
+		// the handover was superseded by another failover
+		return true, nil
+	}
+
+	logger := wh.GetLogger().WithTags(tag.WorkflowDomainName(domainName),
+		tag.TargetCluster(replicationConfig.HandoverClusterName))
+	now := time.Now()
+	if !persistence.IsHandoverInProgress(replicationConfig, now) {
+		clearDomainHandover(replicationConfig)
+		if err := wh.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
+			Info:              info,
+			Config:            getResponse.Config,
+			ReplicationConfig: replicationConfig,
+			ConfigVersion:     getResponse.ConfigVersion,
+			FailoverVersion:   getResponse.FailoverVersion,
+			DBVersion:         getResponse.DBVersion,
+		}); err != nil {
+			return false, err
+		}
+		wh.metricsClient.IncCounter(metrics.FrontendDomainHandoverScope, metrics.DomainHandoverAbortedCounter)
+		logger.Warn("Domain handover expired, the domain stays active in the current cluster.")
+		return true, nil
+	}
+
+	// the hosts which have not reloaded the domain yet still accept new workflows and signals
+	if now.Sub(startTime) < cache.DomainCacheRefreshInterval {
+		return false, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), domainHandoverRPCTimeout)
+	defer cancel()
+	pendingTasks, err := wh.getDomainReplicationLag(ctx, info.ID, replicationConfig.HandoverClusterName)
+	if err != nil {
+		return false, err
+	}
+	if pendingTasks > 0 {
+		logger.Debug("Waiting for the replication tasks of the domain in handover.", tag.Number(pendingTasks))
+		return false, nil
+	}
+
+	targetCluster := replicationConfig.HandoverClusterName
+	failoverVersion := wh.GetClusterMetadata().GetNextFailoverVersion(targetCluster, getResponse.FailoverVersion)
+	replicationConfig.ActiveClusterName = targetCluster
+	clearDomainHandover(replicationConfig)
+	if err := wh.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
+		Info:              info,
+		Config:            getResponse.Config,
+		ReplicationConfig: replicationConfig,
+		ConfigVersion:     getResponse.ConfigVersion,
+		FailoverVersion:   failoverVersion,
+		DBVersion:         getResponse.DBVersion,
+	}); err != nil {
+		return false, err
+	}
+	if err := wh.domainReplicator.HandleTransmissionTask(replicator.DomainOperationUpdate,
+		info, getResponse.Config, replicationConfig, getResponse.ConfigVersion, failoverVersion); err != nil {
+		// the domain is already failed over in the current cluster, the other clusters pick up the failover with
+		// the next update of the domain
+		logger.Error("Failed to replicate domain failover.", tag.Error(err))
+	}
+
+	wh.metricsClient.IncCounter(metrics.FrontendDomainHandoverScope, metrics.DomainHandoverCompletedCounter)
+	logger.Info("Domain handover completed.")
+	return true, nil
+}
+
+// createDomainHandoverInfo describes the progress of a domain handover
+func (wh *WorkflowHandler) createDomainHandoverInfo(ctx context.Context, domainID string,
+	replicationConfig *persistence.DomainReplicationConfig) (*gen.DomainHandoverInfo, error) {
+	handoverInfo := &gen.DomainHandoverInfo{
+		TargetClusterName: common.StringPtr(replicationConfig.HandoverClusterName),
+		StartTimestamp:    common.Int64Ptr(replicationConfig.HandoverStartTime.UnixNano()),
+		ExpiryTimestamp:   common.Int64Ptr(replicationConfig.HandoverExpiryTime.UnixNano()),
+	}
+	// the replication lag is only known to the active cluster
+	if replicationConfig.ActiveClusterName == wh.GetClusterMetadata().GetCurrentClusterName() {
+		pendingTasks, err := wh.getRecentDomainReplicationLag(ctx, domainID, replicationConfig.HandoverClusterName)
+		if err != nil {
+			return nil, err
+		}
+		handoverInfo.PendingReplicationTasks = common.Int64Ptr(pendingTasks)
+	}
+	return handoverInfo, nil
+}
+
+// getDomainReplicationLag returns the number of replication tasks of a domain in all the shards which are not yet
+// replicated to a remote cluster
+func (wh *WorkflowHandler) getDomainReplicationLag(ctx context.Context, domainID string,
+	clusterName string) (int64, error) {
+	var pendingTasks int64
+	var errOnce sync.Once
+	var firstErr error
+	var wg sync.WaitGroup
+	tokens := make(chan struct{}, domainReplicationLagConcurrency)
+	for shardID := 0; shardID < wh.config.NumHistoryShards; shardID++ {
+		wg.Add(1)
+		tokens <- struct{}{}
+		go func(shardID int32) {
+			defer func() {
+				<-tokens
+				wg.Done()
+			}()
+			response, err := wh.history.GetDomainReplicationLag(ctx, &h.GetDomainReplicationLagRequest{
+				ShardId:     common.Int32Ptr(shardID),
+				DomainUUID:  common.StringPtr(domainID),
+				ClusterName: common.StringPtr(clusterName),
+			})
+			if err != nil {
+				errOnce.Do(func() { firstErr = err })
+				return
+			}
+			atomic.AddInt64(&pendingTasks, response.GetPendingReplicationTasks())
+		}(int32(shardID))
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return 0, firstErr
+	}
+
+	wh.handoverLagsLock.Lock()
+	defer wh.handoverLagsLock.Unlock()
+	wh.handoverLags[domainReplicationLagKey{domainID: domainID, clusterName: clusterName}] = domainReplicationLag{
+		pendingTasks: pendingTasks,
+		measuredAt:   time.Now(),
+	}
+	return pendingTasks, nil
+}
+
+// getRecentDomainReplicationLag returns the replication lag of a domain measured within the last poll interval of
+// the handovers, it is measured again otherwise
+func (wh *WorkflowHandler) getRecentDomainReplicationLag(ctx context.Context, domainID string,
+	clusterName string) (int64, error) {
+	wh.handoverLagsLock.Lock()
+	lag, ok := wh.handoverLags[domainReplicationLagKey{domainID: domainID, clusterName: clusterName}]
+	wh.handoverLagsLock.Unlock()
+
+	if ok && time.Since(lag.measuredAt) < wh.config.DomainHandoverPollInterval {
+		return lag.pendingTasks, nil
+	}
+	return wh.getDomainReplicationLag(ctx, domainID, clusterName)
+}
+
+// forgetDomainReplicationLags drops the replication lags measured for a domain once its handover is over
+func (wh *WorkflowHandler) forgetDomainReplicationLags(domainID string) {
+	wh.handoverLagsLock.Lock()
+	defer wh.handoverLagsLock.Unlock()
+
+	for key := range wh.handoverLags {
+		if key.domainID == domainID {
+			delete(wh.handoverLags, key)
+		}
+	}
+}
+
+// clearDomainHandover ends the graceful failover of a domain
+func clearDomainHandover(replicationConfig *persistence.DomainReplicationConfig) {
+	replicationConfig.HandoverClusterName = ""
+	replicationConfig.HandoverStartTime = time.Time{}
+	replicationConfig.HandoverExpiryTime = time.Time{}
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	domainHandoverSuite struct {
+		suite.Suite
+		*require.Assertions
+		domainName          string
+		mockClusterMetadata *mocks.ClusterMetadata
+		mockHistoryClient   *mocks.HistoryClient
+		mockProducer        *mocks.KafkaProducer
+		metadataMgr         persistence.MetadataManager
+		handler             *WorkflowHandler
+	}
+
+	// pullBasedMessagingClient is the messaging client of the rpc replication transport
+	pullBasedMessagingClient struct {
+		messaging.Client
+	}
+)
+
+func (c *pullBasedMessagingClient) IsPullBased() bool {
+	return true
+}
+
+func TestDomainHandoverSuite(t *testing.T) {
+	s := new(domainHandoverSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainHandoverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(true)
+	s.mockHistoryClient = &mocks.HistoryClient{}
+	s.mockProducer = &mocks.KafkaProducer{}
+
+	logger := logging.NewNopLogger()
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	var err error
+	s.metadataMgr, err = persistence.NewMemoryMetadataPersistence(cluster.TestCurrentClusterName, logger)
+	s.Nil(err)
+	messagingClient := &pullBasedMessagingClient{Client: mocks.NewMockMessagingClient(s.mockProducer, nil)}
+	s.handler = NewWorkflowHandler(service.NewTestService(s.mockClusterMetadata, messagingClient, metricsClient, logger),
+		NewConfig(dynamicconfig.NewNopCollection(), 1), s.metadataMgr, &mocks.HistoryManager{},
+		&mocks.VisibilityManager{}, s.mockProducer, authorization.NewNopAuthorizer())
+	s.handler.history = s.mockHistoryClient
+	s.handler.metricsClient = metricsClient
+	s.handler.startWG.Done()
+
+	s.domainName = s.createDomain()
+}
+
+func (s *domainHandoverSuite) TearDownTest() {
+	close(s.handler.stopC)
+	s.mockHistoryClient.AssertExpectations(s.T())
+	s.mockProducer.AssertExpectations(s.T())
+}
+
+func (s *domainHandoverSuite) TestGracefulFailover() {
+	s.mockHistoryClient.On("GetDomainReplicationLag", mock.Anything, mock.Anything).Return(
+		&h.GetDomainReplicationLagResponse{PendingReplicationTasks: common.Int64Ptr(3)}, nil)
+
+	_, err := s.handler.UpdateDomain(context.Background(), s.gracefulFailoverRequest())
+	s.Nil(err)
+
+	replicationConfig := s.getReplicationConfig()
+	s.Equal(cluster.TestCurrentClusterName, replicationConfig.ActiveClusterName)
+	s.Equal(cluster.TestAlternativeClusterName, replicationConfig.HandoverClusterName)
+	s.True(s.isHandoverDriven(s.domainName, replicationConfig.HandoverStartTime))
+}
+
+func (s *domainHandoverSuite) TestGracefulFailoverReplicationPositionUnknown() {
+	// the replication tasks are published to kafka, the tasks applied by the target cluster are unknown
+	s.mockHistoryClient.On("GetDomainReplicationLag", mock.Anything, mock.Anything).Return(
+		nil, &gen.BadRequestError{Message: "unknown replication position"}).Once()
+
+	_, err := s.handler.UpdateDomain(context.Background(), s.gracefulFailoverRequest())
+	s.IsType(&gen.BadRequestError{}, err)
+
+	replicationConfig := s.getReplicationConfig()
+	s.Equal(cluster.TestCurrentClusterName, replicationConfig.ActiveClusterName)
+	s.Empty(replicationConfig.HandoverClusterName)
+}
+
+func (s *domainHandoverSuite) TestGracefulFailoverKafkaTransport() {
+	// the replication tasks published to kafka are not known to be applied by the target cluster
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	s.handler.Service = service.NewTestService(s.mockClusterMetadata, mocks.NewMockMessagingClient(s.mockProducer, nil),
+		metricsClient, logging.NewNopLogger())
+
+	_, err := s.handler.UpdateDomain(context.Background(), s.gracefulFailoverRequest())
+	s.Equal(errGracefulFailoverNotPullBased, err)
+	s.Empty(s.getReplicationConfig().HandoverClusterName)
+}
+
+func (s *domainHandoverSuite) TestCompleteDomainHandoverReplicationLagging() {
+	startTime := s.handoverStartTime()
+	s.persistHandover(s.domainName, cluster.TestCurrentClusterName, startTime)
+	s.mockHistoryClient.On("GetDomainReplicationLag", mock.Anything, mock.Anything).Return(
+		&h.GetDomainReplicationLagResponse{PendingReplicationTasks: common.Int64Ptr(3)}, nil).Once()
+
+	done, err := s.handler.completeDomainHandover(s.domainName, startTime)
+	s.Nil(err)
+	s.False(done)
+
+	replicationConfig := s.getReplicationConfig()
+	s.Equal(cluster.TestCurrentClusterName, replicationConfig.ActiveClusterName)
+	s.Equal(cluster.TestAlternativeClusterName, replicationConfig.HandoverClusterName)
+}
+
+func (s *domainHandoverSuite) TestCompleteDomainHandover() {
+	startTime := s.handoverStartTime()
+	s.persistHandover(s.domainName, cluster.TestCurrentClusterName, startTime)
+	existingDomain, err := s.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: s.domainName})
+	s.Nil(err)
+	failoverVersion := existingDomain.FailoverVersion + 10
+	s.mockClusterMetadata.On("GetNextFailoverVersion", cluster.TestAlternativeClusterName,
+		existingDomain.FailoverVersion).Return(failoverVersion).Once()
+	s.mockHistoryClient.On("GetDomainReplicationLag", mock.Anything, mock.Anything).Return(
+		&h.GetDomainReplicationLagResponse{PendingReplicationTasks: common.Int64Ptr(0)}, nil).Once()
+	// the failover is replicated to the other clusters
+	s.mockProducer.On("Publish", mock.Anything).Return(nil).Once()
+
+	done, err := s.handler.completeDomainHandover(s.domainName, startTime)
+	s.Nil(err)
+	s.True(done)
+
+	response, err := s.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: s.domainName})
+	s.Nil(err)
+	s.Equal(cluster.TestAlternativeClusterName, response.ReplicationConfig.ActiveClusterName)
+	s.Empty(response.ReplicationConfig.HandoverClusterName)
+	s.Equal(failoverVersion, response.FailoverVersion)
+	s.Empty(s.handler.handoverLags)
+}
+
+func (s *domainHandoverSuite) TestCompleteDomainHandoverExpired() {
+	startTime := s.handoverStartTime()
+	s.persistHandover(s.domainName, cluster.TestCurrentClusterName, startTime)
+	response, err := s.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: s.domainName})
+	s.Nil(err)
+	replicationConfig := response.ReplicationConfig
+	replicationConfig.HandoverExpiryTime = time.Now().Add(-time.Second)
+	s.Nil(s.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
+		Info:              response.Info,
+		Config:            response.Config,
+		ReplicationConfig: replicationConfig,
+		ConfigVersion:     response.ConfigVersion,
+		FailoverVersion:   response.FailoverVersion,
+		DBVersion:         response.DBVersion,
+	}))
+
+	// the replication lag is not checked anymore
+	done, err := s.handler.completeDomainHandover(s.domainName, startTime)
+	s.Nil(err)
+	s.True(done)
+
+	replicationConfig = s.getReplicationConfig()
+	s.Equal(cluster.TestCurrentClusterName, replicationConfig.ActiveClusterName)
+	s.Empty(replicationConfig.HandoverClusterName)
+}
+
+func (s *domainHandoverSuite) TestDescribeDomainReusesRecentReplicationLag() {
+	startTime := s.handoverStartTime()
+	s.persistHandover(s.domainName, cluster.TestCurrentClusterName, startTime)
+	s.mockHistoryClient.On("GetDomainReplicationLag", mock.Anything, mock.Anything).Return(
+		&h.GetDomainReplicationLagResponse{PendingReplicationTasks: common.Int64Ptr(3)}, nil).Once()
+
+	// the shards are asked once within the poll interval of the handovers
+	for i := 0; i < 2; i++ {
+		response, err := s.handler.DescribeDomain(context.Background(),
+			&gen.DescribeDomainRequest{Name: common.StringPtr(s.domainName)})
+		s.Nil(err)
+		s.Equal(cluster.TestAlternativeClusterName, response.HandoverInfo.GetTargetClusterName())
+		s.Equal(int64(3), response.HandoverInfo.GetPendingReplicationTasks())
+	}
+}
+
+func (s *domainHandoverSuite) TestNewWorkRejectedDuringHandover() {
+	s.persistHandover(s.domainName, cluster.TestCurrentClusterName, time.Now().Truncate(time.Millisecond))
+
+	_, err := s.handler.StartWorkflowExecution(context.Background(), &gen.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(s.domainName),
+		WorkflowId:                          common.StringPtr("workflow-id"),
+		WorkflowType:                        &gen.WorkflowType{Name: common.StringPtr("workflow-type")},
+		TaskList:                            &gen.TaskList{Name: common.StringPtr("task-list")},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		RequestId:                           common.StringPtr(uuid.New()),
+	})
+	s.Equal(errDomainInHandover, err)
+
+	err = s.handler.SignalWorkflowExecution(context.Background(), &gen.SignalWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+		WorkflowExecution: &gen.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflow-id"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		SignalName: common.StringPtr("signal-name"),
+	})
+	s.Equal(errDomainInHandover, err)
+}
+
+func (s *domainHandoverSuite) TestResumeDomainHandovers() {
+	// a handover requested on another host, and one from another cluster
+	startTime := time.Now().Truncate(time.Millisecond)
+	s.persistHandover(s.domainName, cluster.TestCurrentClusterName, startTime)
+	remoteDomainName := s.createDomain()
+	s.persistHandover(remoteDomainName, cluster.TestAlternativeClusterName, startTime)
+
+	s.Nil(s.handler.scanDomainHandovers())
+	s.True(s.isHandoverDriven(s.domainName, startTime))
+	s.False(s.isHandoverDriven(remoteDomainName, startTime))
+
+	// the handover is driven once
+	s.handler.startDomainHandover(s.domainName, startTime)
+	s.Nil(s.handler.scanDomainHandovers())
+	s.True(s.isHandoverDriven(s.domainName, startTime))
+
+	// a later handover of the domain supersedes it
+	laterStartTime := startTime.Add(time.Second)
+	s.persistHandover(s.domainName, cluster.TestCurrentClusterName, laterStartTime)
+	s.Nil(s.handler.scanDomainHandovers())
+	s.True(s.isHandoverDriven(s.domainName, laterStartTime))
+}
+
+func (s *domainHandoverSuite) createDomain() string {
+	domainName := "handover-domain-" + uuid.New()
+	_, err := s.metadataMgr.CreateDomain(&persistence.CreateDomainRequest{
+		Info:   &persistence.DomainInfo{ID: uuid.New(), Name: domainName},
+		Config: &persistence.DomainConfig{Retention: 1},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		IsGlobalDomain: true,
+	})
+	s.Nil(err)
+	return domainName
+}
+
+func (s *domainHandoverSuite) persistHandover(domainName string, activeClusterName string, startTime time.Time) {
+	response, err := s.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: domainName})
+	s.Nil(err)
+	replicationConfig := response.ReplicationConfig
+	replicationConfig.ActiveClusterName = activeClusterName
+	replicationConfig.HandoverClusterName = cluster.TestAlternativeClusterName
+	if activeClusterName == cluster.TestAlternativeClusterName {
+		replicationConfig.HandoverClusterName = cluster.TestCurrentClusterName
+	}
+	replicationConfig.HandoverStartTime = startTime
+	replicationConfig.HandoverExpiryTime = startTime.Add(time.Hour)
+	s.Nil(s.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
+		Info:              response.Info,
+		Config:            response.Config,
+		ReplicationConfig: replicationConfig,
+		ConfigVersion:     response.ConfigVersion,
+		FailoverVersion:   response.FailoverVersion,
+		DBVersion:         response.DBVersion,
+	}))
+}
+
+// handoverStartTime returns the start time of a handover which every host has reloaded the domain since
+func (s *domainHandoverSuite) handoverStartTime() time.Time {
+	return time.Now().Add(-cache.DomainCacheRefreshInterval - time.Second).Truncate(time.Millisecond)
+}
+
+func (s *domainHandoverSuite) isHandoverDriven(domainName string, startTime time.Time) bool {
+	s.handler.handoversLock.Lock()
+	defer s.handler.handoversLock.Unlock()
+	inProgress, ok := s.handler.handovers[domainName]
+	return ok && inProgress.Equal(startTime)
+}
+
+func (s *domainHandoverSuite) gracefulFailoverRequest() *gen.UpdateDomainRequest {
+	return &gen.UpdateDomainRequest{
+		Name: common.StringPtr(s.domainName),
+		ReplicationConfiguration: &gen.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(cluster.TestAlternativeClusterName),
+		},
+		GracefulFailoverTimeoutInSeconds: common.Int32Ptr(60),
+	}
+}
+
+func (s *domainHandoverSuite) getReplicationConfig() *persistence.DomainReplicationConfig {
+	response, err := s.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: s.domainName})
+	s.Nil(err)
+	return response.ReplicationConfig
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// domainRateLimiter keeps a token bucket per domain. The rps of each bucket is read from dynamic config
+	// filtered by the domain name, and the bucket is recreated when that value changes.  The domains are
+	// resolved through the domain cache and the buckets are keyed by domain ID, so that the requests for
+	// unknown domains do not grow the buckets.
+	domainRateLimiter struct {
+		sync.RWMutex
+		rps           dynamicconfig.IntPropertyFn
+		domainCache   cache.DomainCache
+		timeSource    common.TimeSource
+		metricsClient metrics.Client
+		buckets       map[string]*domainTokenBucket
+		domainMetrics map[string]metrics.Client
+	}
+
+	domainTokenBucket struct {
+		rps    int
+		bucket common.TokenBucket
+	}
+)
+
+func newDomainRateLimiter(rps dynamicconfig.IntPropertyFn, domainCache cache.DomainCache,
+	timeSource common.TimeSource, metricsClient metrics.Client) *domainRateLimiter {
+	return &domainRateLimiter{
+		rps:           rps,
+		domainCache:   domainCache,
+		timeSource:    timeSource,
+		metricsClient: metricsClient,
+		buckets:       make(map[string]*domainTokenBucket),
+		domainMetrics: make(map[string]metrics.Client),
+	}
+}
+
+// TryConsume takes a token from the bucket of the given domain, and emits a throttle metric
+// tagged with the domain under the given scope when the bucket is empty.  The requests for a domain
+// which cannot be resolved are let through, they are rejected by the domain lookup of the handler.
+func (d *domainRateLimiter) TryConsume(domain string, scope int) bool {
+	domainEntry, err := d.domainCache.GetDomain(domain)
+	if err != nil {
+		return true
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	if ok, _ := d.getBucket(domainID, domain).TryConsume(1); ok {
+		return true
+	}
+	d.getDomainMetricsClient(domainID, domain).IncCounter(scope, metrics.DomainThrottleCounter)
+	return false
+}
+
+func (d *domainRateLimiter) getBucket(domainID string, domain string) common.TokenBucket {
+	rps := d.rps(dynamicconfig.DomainFilter(domain))
+
+	d.RLock()
+	b, ok := d.buckets[domainID]
+	d.RUnlock()
+	if ok && b.rps == rps {
+		return b.bucket
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	b, ok = d.buckets[domainID]
+	if !ok || b.rps != rps {
+		b = &domainTokenBucket{
+			rps:    rps,
+			bucket: common.NewTokenBucket(rps, d.timeSource),
+		}
+		d.buckets[domainID] = b
+	}
+	return b.bucket
+}
+
+// getDomainMetricsClient returns a metrics client tagged with the domain, it is only created
+// once a domain gets throttled since a tagged client is expensive to build
+func (d *domainRateLimiter) getDomainMetricsClient(domainID string, domain string) metrics.Client {
+	d.RLock()
+	client, ok := d.domainMetrics[domainID]
+	d.RUnlock()
+	if ok {
+		return client
+	}
+
+	d.Lock()
+	defer d.Unlock()
+	if client, ok = d.domainMetrics[domainID]; !ok {
+		client = d.metricsClient.Tagged(map[string]string{metrics.DomainTagName: domain})
+		d.domainMetrics[domainID] = client
+	}
+	return client
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	domainRateLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+		timeSource *common.FakeTimeSource
+		domainRPS  map[string]int
+		domainIDs  map[string]string
+		limiter    *domainRateLimiter
+	}
+)
+
+func TestDomainRateLimiterSuite(t *testing.T) {
+	s := new(domainRateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainRateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.timeSource = common.NewFakeTimeSource()
+	s.timeSource.Update(time.Now())
+	s.domainRPS = map[string]int{}
+	rps := func(opts ...dynamicconfig.FilterOption) int {
+		filters := make(map[dynamicconfig.Filter]interface{})
+		for _, opt := range opts {
+			opt(filters)
+		}
+		if rps, ok := s.domainRPS[filters[dynamicconfig.DomainName].(string)]; ok {
+			return rps
+		}
+		return 100
+	}
+
+	s.domainIDs = map[string]string{
+		"noisy-domain": "noisy-domain-id",
+		"other-domain": "other-domain-id",
+		"test-domain":  "test-domain-id",
+	}
+	mockMetadataMgr := &mocks.MetadataManager{}
+	for name, id := range s.domainIDs {
+		mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: name}).Return(&persistence.GetDomainResponse{
+			Info:              &persistence.DomainInfo{ID: id, Name: name},
+			Config:            &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+		}, nil)
+	}
+	mockMetadataMgr.On("GetDomain", mock.Anything).Return(nil, &gen.EntityNotExistsError{})
+	mockClusterMetadata := &mocks.ClusterMetadata{}
+	mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	domainCache := cache.NewDomainCache(mockMetadataMgr, mockClusterMetadata, logging.NewNopLogger())
+
+	s.limiter = newDomainRateLimiter(rps, domainCache, s.timeSource, metrics.NewClient(tally.NoopScope, metrics.Frontend))
+}
+
+func (s *domainRateLimiterSuite) TestDomainsAreThrottledSeparately() {
+	s.domainRPS["noisy-domain"] = 10
+
+	s.Equal(1, s.consumeAll("noisy-domain"))
+	s.Equal(10, s.consumeAll("other-domain"))
+
+	s.timeSource.Update(s.timeSource.Now().Add(200 * time.Millisecond))
+	s.Equal(1, s.consumeAll("noisy-domain"))
+}
+
+func (s *domainRateLimiterSuite) TestRPSChange() {
+	s.Equal(10, s.consumeAll("test-domain"))
+
+	s.domainRPS["test-domain"] = 200
+	s.Equal(20, s.consumeAll("test-domain"))
+
+	s.domainRPS["test-domain"] = 0
+	s.Equal(0, s.consumeAll("test-domain"))
+}
+
+func (s *domainRateLimiterSuite) TestBucketsAreKeyedByDomainID() {
+	s.domainRPS["test-domain"] = 10
+	s.Equal(1, s.consumeAll("test-domain"))
+	s.Contains(s.limiter.buckets, s.domainIDs["test-domain"])
+	s.Contains(s.limiter.domainMetrics, s.domainIDs["test-domain"])
+}
+
+func (s *domainRateLimiterSuite) TestUnknownDomain() {
+	for i := 0; i < 100; i++ {
+		s.True(s.limiter.TryConsume(fmt.Sprintf("unknown-domain-%v", i), metrics.FrontendStartWorkflowExecutionScope))
+	}
+	s.Empty(s.limiter.buckets)
+	s.Empty(s.limiter.domainMetrics)
+}
+
+// consumeAll returns the number of tokens taken from the domain's bucket before it is throttled
+func (s *domainRateLimiterSuite) consumeAll(domain string) int {
+	count := 0
+	for s.limiter.TryConsume(domain, metrics.FrontendStartWorkflowExecutionScope) {
+		count++
+	}
+	return count
+}
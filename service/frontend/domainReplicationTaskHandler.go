@@ -23,10 +23,10 @@ package frontend
 import (
 	"errors"
 
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -48,12 +48,12 @@ type (
 
 	domainReplicatorImpl struct {
 		kafka  messaging.Producer
-		logger bark.Logger
+		logger logging.Logger
 	}
 )
 
 // NewDomainReplicator create a new instance odf domain replicator
-func NewDomainReplicator(kafka messaging.Producer, logger bark.Logger) DomainReplicator {
+func NewDomainReplicator(kafka messaging.Producer, logger logging.Logger) DomainReplicator {
 	return &domainReplicatorImpl{
 		kafka:  kafka,
 		logger: logger,
@@ -82,6 +82,8 @@ func (domainReplicator *domainReplicatorImpl) HandleTransmissionTask(domainOpera
 		Config: &shared.DomainConfiguration{
 			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(config.Retention),
 			EmitMetric:                             common.BoolPtr(config.EmitMetric),
+			ArchivalBucketName:                     common.StringPtr(config.ArchivalBucket),
+			ArchivalStatus:                         config.ArchivalStatus.Ptr(),
 		},
 		ReplicationConfig: &shared.DomainReplicationConfiguration{
 			ActiveClusterName: common.StringPtr(replicationConfig.ActiveClusterName),
@@ -26,12 +26,11 @@ import (
 	"testing"
 
 	"github.com/pborman/uuid"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -63,7 +62,7 @@ func (s *domainReplicatorSuite) SetupTest() {
 	s.kafkaProducer = &mocks.KafkaProducer{}
 	s.domainReplicator = NewDomainReplicator(
 		s.kafkaProducer,
-		bark.NewLoggerFromLogrus(logrus.New()),
+		logging.NewDevelopmentLogger(),
 	).(*domainReplicatorImpl)
 
 }
@@ -80,6 +79,8 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_RegisterDomainTask()
 	ownerEmail := "some random test owner"
 	retention := int32(10)
 	emitMetric := true
+	archivalBucket := "some random archival bucket"
+	archivalStatus := shared.ArchivalStatusEnabled
 	clusterActive := "some random active cluster name"
 	clusterStandby := "some random standby cluster name"
 	configVersion := int64(0)
@@ -102,8 +103,10 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_RegisterDomainTask()
 		OwnerEmail:  ownerEmail,
 	}
 	config := &persistence.DomainConfig{
-		Retention:  retention,
-		EmitMetric: emitMetric,
+		Retention:      retention,
+		EmitMetric:     emitMetric,
+		ArchivalBucket: archivalBucket,
+		ArchivalStatus: archivalStatus,
 	}
 	replicationConfig := &persistence.DomainReplicationConfig{
 		ActiveClusterName: clusterActive,
@@ -124,6 +127,8 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_RegisterDomainTask()
 			Config: &shared.DomainConfiguration{
 				WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(retention),
 				EmitMetric:                             common.BoolPtr(emitMetric),
+				ArchivalBucketName:                     common.StringPtr(archivalBucket),
+				ArchivalStatus:                         archivalStatus.Ptr(),
 			},
 			ReplicationConfig: &shared.DomainReplicationConfiguration{
 				ActiveClusterName: common.StringPtr(clusterActive),
@@ -147,6 +152,8 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_UpdateDomainTask() {
 	ownerEmail := "some random test owner"
 	retention := int32(10)
 	emitMetric := true
+	archivalBucket := "some random archival bucket"
+	archivalStatus := shared.ArchivalStatusEnabled
 	clusterActive := "some random active cluster name"
 	clusterStandby := "some random standby cluster name"
 	configVersion := int64(0)
@@ -169,8 +176,10 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_UpdateDomainTask() {
 		OwnerEmail:  ownerEmail,
 	}
 	config := &persistence.DomainConfig{
-		Retention:  retention,
-		EmitMetric: emitMetric,
+		Retention:      retention,
+		EmitMetric:     emitMetric,
+		ArchivalBucket: archivalBucket,
+		ArchivalStatus: archivalStatus,
 	}
 	replicationConfig := &persistence.DomainReplicationConfig{
 		ActiveClusterName: clusterActive,
@@ -191,6 +200,8 @@ func (s *domainReplicatorSuite) TestHandleTransmissionTask_UpdateDomainTask() {
 			Config: &shared.DomainConfiguration{
 				WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(retention),
 				EmitMetric:                             common.BoolPtr(emitMetric),
+				ArchivalBucketName:                     common.StringPtr(archivalBucket),
+				ArchivalStatus:                         archivalStatus.Ptr(),
 			},
 			ReplicationConfig: &shared.DomainReplicationConfiguration{
 				ActiveClusterName: common.StringPtr(clusterActive),
@@ -30,7 +30,6 @@ import (
 	"github.com/uber/cadence/common/messaging"
 
 	"github.com/pborman/uuid"
-	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
 	"github.com/uber/cadence/.gen/go/health"
@@ -39,13 +38,18 @@ import (
 	m "github.com/uber/cadence/.gen/go/matching"
 	"github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/proto/cadencepb"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archival"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -70,6 +74,21 @@ type (
 		rateLimiter        common.TokenBucket
 		config             *Config
 		domainReplicator   DomainReplicator
+		authorizer         authorization.Authorizer
+
+		// domainRateLimiter and domainPollRateLimiter throttle each domain separately so that a single domain
+		// cannot exhaust the rateLimiter above, which is kept as a global cap for the frontend host
+		domainRateLimiter     *domainRateLimiter
+		domainPollRateLimiter *domainRateLimiter
+		// stopC stops the graceful failovers driven by this host
+		stopC chan struct{}
+		// handovers are the start times of the graceful failovers driven by this host, by domain name
+		handoversLock sync.Mutex
+		handovers     map[string]time.Time
+		// handoverLags are the last replication lags measured for the domains in handover, so that describing a
+		// domain does not ask every history shard each time
+		handoverLagsLock sync.Mutex
+		handoverLags     map[domainReplicationLagKey]domainReplicationLag
 		service.Service
 	}
 
@@ -80,6 +99,11 @@ type (
 		IsWorkflowRunning bool
 		PersistenceToken  []byte
 		TransientDecision *gen.TransientDecisionInfo
+		// IsArchived is set when the history is served from the archive of the domain, ArchivePage
+		// and ArchiveOffset locate the next event within the pages of the archived history
+		IsArchived    bool
+		ArchivePage   int
+		ArchiveOffset int
 	}
 )
 
@@ -100,6 +124,7 @@ var (
 	errQueryNotSet                = &gen.BadRequestError{Message: "WorkflowQuery is not set on request."}
 	errQueryTypeNotSet            = &gen.BadRequestError{Message: "QueryType is not set on request."}
 	errRequestNotSet              = &gen.BadRequestError{Message: "Request is nil."}
+	errInvalidDecisionFinishID    = &gen.BadRequestError{Message: "DecisionFinishEventId is not set or invalid."}
 
 	// err indicating that this cluster is not the master, so cannot do domain registration or update
 	errNotMasterCluster                = &gen.BadRequestError{Message: "Cluster is not master cluster, cannot do domain registration or domain update."}
@@ -107,6 +132,17 @@ var (
 	errCannotRemoveClustersFromDomain  = &gen.BadRequestError{Message: "Cannot remove existing replicated clusters from a domain."}
 	errActiveClusterNotInClusters      = &gen.BadRequestError{Message: "Active cluster is not contained in all clusters."}
 	errCannotDoDomainFailoverAndUpdate = &gen.BadRequestError{Message: "Cannot set active cluster to current cluster when other parameters are set."}
+	errArchivalNotConfigured           = &gen.BadRequestError{Message: "Archival is not configured for this cluster."}
+	errArchivalBucketNotSet            = &gen.BadRequestError{Message: "ArchivalBucketName is not set when archival is enabled."}
+
+	errGracefulFailoverNotGlobalDomain   = &gen.BadRequestError{Message: "Graceful failover is only supported for global domains."}
+	errGracefulFailoverNotActiveCluster  = &gen.BadRequestError{Message: "Graceful failover must be requested on the active cluster of the domain."}
+	errGracefulFailoverOnlyActiveCluster = &gen.BadRequestError{Message: "Graceful failover can only change the active cluster."}
+	errGracefulFailoverInvalidTimeout    = &gen.BadRequestError{Message: "A valid GracefulFailoverTimeoutInSeconds is not set on request."}
+	errGracefulFailoverInProgress        = &gen.BadRequestError{Message: "A graceful failover of the domain is already in progress."}
+	errGracefulFailoverToActiveCluster   = &gen.BadRequestError{Message: "Cannot gracefully fail over a domain to its active cluster."}
+	errGracefulFailoverNotPullBased      = &gen.BadRequestError{Message: "Graceful failover requires the rpc replication transport, the replication tasks applied by the target cluster are unknown with kafka."}
+	errDomainInHandover                  = &gen.ServiceBusyError{Message: "Domain is being failed over to another cluster, retry later."}
 
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
@@ -115,7 +151,8 @@ var (
 func NewWorkflowHandler(
 	sVice service.Service, config *Config, metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager, visibilityMgr persistence.VisibilityManager,
-	kafkaProducer messaging.Producer) *WorkflowHandler {
+	kafkaProducer messaging.Producer, authorizer authorization.Authorizer) *WorkflowHandler {
+	domainCache := cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetLogger())
 	handler := &WorkflowHandler{
 		Service:            sVice,
 		config:             config,
@@ -124,9 +161,17 @@ func NewWorkflowHandler(
 		visibitiltyMgr:     visibilityMgr,
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		hSerializerFactory: persistence.NewHistorySerializerFactory(),
-		domainCache:        cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetLogger()),
+		domainCache:        domainCache,
 		rateLimiter:        common.NewTokenBucket(config.RPS, common.NewRealTimeSource()),
 		domainReplicator:   NewDomainReplicator(kafkaProducer, sVice.GetLogger()),
+		authorizer:         authorizer,
+		domainRateLimiter: newDomainRateLimiter(
+			config.DomainRPS, domainCache, common.NewRealTimeSource(), sVice.GetMetricsClient()),
+		domainPollRateLimiter: newDomainRateLimiter(
+			config.DomainPollRPS, domainCache, common.NewRealTimeSource(), sVice.GetMetricsClient()),
+		stopC:        make(chan struct{}),
+		handovers:    make(map[string]time.Time),
+		handoverLags: make(map[domainReplicationLagKey]domainReplicationLag),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -136,6 +181,7 @@ func NewWorkflowHandler(
 // Start starts the handler
 func (wh *WorkflowHandler) Start() error {
 	wh.Service.GetDispatcher().Register(workflowserviceserver.New(wh))
+	wh.Service.GetDispatcher().Register(cadencepb.BuildWorkflowServiceYARPCProcedures(NewProtoHandler(wh)))
 	wh.Service.GetDispatcher().Register(metaserver.New(wh))
 	wh.Service.Start()
 	var err error
@@ -148,12 +194,14 @@ func (wh *WorkflowHandler) Start() error {
 		return err
 	}
 	wh.metricsClient = wh.Service.GetMetricsClient()
+	go wh.resumeDomainHandovers()
 	wh.startWG.Done()
 	return nil
 }
 
 // Stop stops the handler
 func (wh *WorkflowHandler) Stop() {
+	close(wh.stopC)
 	wh.metadataMgr.Close()
 	wh.visibitiltyMgr.Close()
 	wh.historyMgr.Close()
@@ -195,6 +243,10 @@ func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "RegisterDomain", registerRequest.GetName(), scope); err != nil {
+		return err
+	}
+
 	activeClusterName := clusterMetadata.GetCurrentClusterName()
 	// input validation on cluster names
 	if registerRequest.ActiveClusterName != nil {
@@ -234,8 +286,10 @@ func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *
 			Description: registerRequest.GetDescription(),
 		},
 		Config: &persistence.DomainConfig{
-			Retention:  registerRequest.GetWorkflowExecutionRetentionPeriodInDays(),
-			EmitMetric: registerRequest.GetEmitMetric(),
+			Retention:      registerRequest.GetWorkflowExecutionRetentionPeriodInDays(),
+			EmitMetric:     registerRequest.GetEmitMetric(),
+			ArchivalBucket: registerRequest.GetArchivalBucketName(),
+			ArchivalStatus: registerRequest.GetArchivalStatus(),
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
 			ActiveClusterName: activeClusterName,
@@ -245,7 +299,12 @@ func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *
 		FailoverVersion: clusterMetadata.GetNextFailoverVersion(activeClusterName, 0),
 	}
 
-	domainResponse, err := wh.metadataMgr.CreateDomain(domainRequest)
+	if err := wh.validateArchivalConfig(domainRequest.Config); err != nil {
+		return wh.error(err, scope)
+	}
+
+	metadataMgr := persistence.MetadataManagerWithContext(ctx, wh.metadataMgr)
+	domainResponse, err := metadataMgr.CreateDomain(domainRequest)
 	if err != nil {
 		return wh.error(err, scope)
 	}
@@ -260,7 +319,9 @@ func (wh *WorkflowHandler) RegisterDomain(ctx context.Context, registerRequest *
 	}
 
 	// TODO: Log through logging framework.  We need to have good auditing of domain CRUD
-	wh.GetLogger().Debugf("Register domain succeeded for name: %v, Id: %v", registerRequest.GetName(), domainResponse.ID)
+	wh.GetLogger().Debug("Register domain succeeded",
+		tag.WorkflowDomainName(registerRequest.GetName()),
+		tag.WorkflowDomainID(domainResponse.ID))
 
 	return nil
 }
@@ -281,7 +342,12 @@ func (wh *WorkflowHandler) DescribeDomain(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	resp, err := wh.metadataMgr.GetDomain(&persistence.GetDomainRequest{
+	if err := wh.authorize(ctx, "DescribeDomain", describeRequest.GetName(), scope); err != nil {
+		return nil, err
+	}
+
+	metadataMgr := persistence.MetadataManagerWithContext(ctx, wh.metadataMgr)
+	resp, err := metadataMgr.GetDomain(&persistence.GetDomainRequest{
 		Name: describeRequest.GetName(),
 	})
 
@@ -296,6 +362,13 @@ func (wh *WorkflowHandler) DescribeDomain(ctx context.Context,
 	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = createDomainResponse(
 		resp.Info, resp.Config, resp.ReplicationConfig)
 
+	if persistence.IsHandoverInProgress(resp.ReplicationConfig, time.Now()) {
+		response.HandoverInfo, err = wh.createDomainHandoverInfo(ctx, resp.Info.ID, resp.ReplicationConfig)
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
+
 	return response, nil
 }
 
@@ -321,7 +394,12 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
-	getResponse, err0 := wh.metadataMgr.GetDomain(&persistence.GetDomainRequest{
+	if err := wh.authorize(ctx, "UpdateDomain", updateRequest.GetName(), scope); err != nil {
+		return nil, err
+	}
+
+	metadataMgr := persistence.MetadataManagerWithContext(ctx, wh.metadataMgr)
+	getResponse, err0 := metadataMgr.GetDomain(&persistence.GetDomainRequest{
 		Name: updateRequest.GetName(),
 	})
 
@@ -334,6 +412,7 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 	replicationConfig := getResponse.ReplicationConfig
 	configVersion := getResponse.ConfigVersion
 	failoverVersion := getResponse.FailoverVersion
+	previousActiveCluster := replicationConfig.ActiveClusterName
 
 	// whether active cluster is changed
 	activeClusterChanged := false
@@ -411,6 +490,19 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 			configurationChanged = true
 			config.Retention = updatedConfig.GetWorkflowExecutionRetentionPeriodInDays()
 		}
+		if updatedConfig.ArchivalBucketName != nil {
+			configurationChanged = true
+			config.ArchivalBucket = updatedConfig.GetArchivalBucketName()
+		}
+		if updatedConfig.ArchivalStatus != nil {
+			configurationChanged = true
+			config.ArchivalStatus = updatedConfig.GetArchivalStatus()
+		}
+		if updatedConfig.ArchivalBucketName != nil || updatedConfig.ArchivalStatus != nil {
+			if err := wh.validateArchivalConfig(config); err != nil {
+				return nil, wh.error(err, scope)
+			}
+		}
 	}
 	if updateRequest.ReplicationConfiguration != nil {
 		updateReplicationConfig := updateRequest.ReplicationConfiguration
@@ -420,7 +512,37 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 		}
 	}
 
-	if configurationChanged && activeClusterChanged {
+	if updateRequest.GracefulFailoverTimeoutInSeconds != nil {
+		if err := wh.validateGracefulFailover(getResponse, previousActiveCluster, replicationConfig.ActiveClusterName,
+			activeClusterChanged, configurationChanged, updateRequest.GetGracefulFailoverTimeoutInSeconds()); err != nil {
+			return nil, wh.error(err, scope)
+		}
+		// the handover cannot complete unless the replication tasks applied by the target cluster are known
+		if _, err := wh.getDomainReplicationLag(ctx, info.ID, replicationConfig.ActiveClusterName); err != nil {
+			return nil, wh.error(err, scope)
+		}
+
+		// the domain stays active in the current cluster until its replication tasks are replicated to the target
+		// cluster, the versions are bumped when the handover completes.  Timestamps are persisted in milliseconds.
+		now := time.Now().Truncate(time.Millisecond)
+		replicationConfig.HandoverClusterName = replicationConfig.ActiveClusterName
+		replicationConfig.ActiveClusterName = previousActiveCluster
+		replicationConfig.HandoverStartTime = now
+		replicationConfig.HandoverExpiryTime = now.Add(
+			time.Duration(updateRequest.GetGracefulFailoverTimeoutInSeconds()) * time.Second)
+		err := metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
+			Info:              info,
+			Config:            config,
+			ReplicationConfig: replicationConfig,
+			ConfigVersion:     configVersion,
+			FailoverVersion:   failoverVersion,
+			DBVersion:         getResponse.DBVersion,
+		})
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+		wh.startDomainHandover(info.Name, replicationConfig.HandoverStartTime)
+	} else if configurationChanged && activeClusterChanged {
 		return nil, wh.error(errCannotDoDomainFailoverAndUpdate, scope)
 	} else if configurationChanged || activeClusterChanged {
 		// TODO remove the IsGlobalDomainEnabled check once cross DC is public
@@ -434,9 +556,11 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 		}
 		if activeClusterChanged {
 			failoverVersion = clusterMetadata.GetNextFailoverVersion(replicationConfig.ActiveClusterName, failoverVersion)
+			// a failover supersedes any graceful failover in progress
+			clearDomainHandover(replicationConfig)
 		}
 
-		err := wh.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
+		err := metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
 			Info:              info,
 			Config:            config,
 			ReplicationConfig: replicationConfig,
@@ -493,7 +617,12 @@ func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest
 		return wh.error(errDomainNotSet, scope)
 	}
 
-	getResponse, err0 := wh.metadataMgr.GetDomain(&persistence.GetDomainRequest{
+	if err := wh.authorize(ctx, "DeprecateDomain", deprecateRequest.GetName(), scope); err != nil {
+		return err
+	}
+
+	metadataMgr := persistence.MetadataManagerWithContext(ctx, wh.metadataMgr)
+	getResponse, err0 := metadataMgr.GetDomain(&persistence.GetDomainRequest{
 		Name: *deprecateRequest.Name,
 	})
 
@@ -503,7 +632,7 @@ func (wh *WorkflowHandler) DeprecateDomain(ctx context.Context, deprecateRequest
 
 	getResponse.ConfigVersion = getResponse.ConfigVersion + 1
 	getResponse.Info.Status = persistence.DomainStatusDeprecated
-	err := wh.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
+	err := metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
 		Info:              getResponse.Info,
 		Config:            getResponse.Config,
 		ReplicationConfig: getResponse.ReplicationConfig,
@@ -539,6 +668,14 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "PollForActivityTask", pollRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainPollRateLimiter.TryConsume(pollRequest.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
 	if err := wh.validateTaskList(pollRequest.TaskList, scope); err != nil {
 		return nil, err
 	}
@@ -565,8 +702,11 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		err = wh.cancelOutstandingPoll(ctx, err, domainID, persistence.TaskListTypeActivity, pollRequest.TaskList, pollerID)
 		if err != nil {
 			// For all other errors log an error and return it back to client.
-			wh.Service.GetLogger().Errorf(
-				"PollForActivityTask failed. TaskList: %v, Error: %v", pollRequest.TaskList.GetName(), err)
+			wh.Service.GetLogger().Error("PollForActivityTask failed.",
+				tag.WorkflowDomainName(pollRequest.GetDomain()),
+
+				tag.TaskListName(pollRequest.TaskList.GetName()),
+				tag.Error(err))
 			return nil, wh.error(err, scope)
 		}
 	}
@@ -595,6 +735,14 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "PollForDecisionTask", pollRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainPollRateLimiter.TryConsume(pollRequest.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
 	if err := wh.validateTaskList(pollRequest.TaskList, scope); err != nil {
 		return nil, err
 	}
@@ -605,7 +753,9 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, wh.error(err, scope)
 	}
 
-	wh.Service.GetLogger().Debugf("Poll for decision. DomainName: %v, DomainID: %v", domainName, domainID)
+	wh.Service.GetLogger().Debug("Poll for decision.",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowDomainID(domainID))
 
 	pollerID := uuid.New()
 	var matchingResp *m.PollForDecisionTaskResponse
@@ -624,8 +774,10 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		err = wh.cancelOutstandingPoll(ctx, err, domainID, persistence.TaskListTypeDecision, pollRequest.TaskList, pollerID)
 		if err != nil {
 			// For all other errors log an error and return it back to client.
-			wh.Service.GetLogger().Errorf(
-				"PollForDecisionTask failed. TaskList: %v, Error: %v", pollRequest.TaskList.GetName(), err)
+			wh.Service.GetLogger().Error("PollForDecisionTask failed.",
+				tag.WorkflowDomainName(domainName),
+				tag.TaskListName(pollRequest.TaskList.GetName()),
+				tag.Error(err))
 			return nil, wh.error(err, scope)
 		}
 
@@ -654,8 +806,9 @@ func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error,
 		})
 		// We can not do much if this call fails.  Just log the error and move on
 		if err != nil {
-			wh.Service.GetLogger().Warnf("Failed to cancel outstanding poller.  Tasklist: %v, Error: %v,",
-				taskList.GetName(), err)
+			wh.Service.GetLogger().Warn("Failed to cancel outstanding poller.",
+				tag.TaskListName(taskList.GetName()),
+				tag.Error(err))
 		}
 
 		// Clear error as we don't want to report context cancellation error to count against our SLA
@@ -693,6 +846,10 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorizeDomainID(ctx, "RecordActivityTaskHeartbeat", taskToken.DomainID, scope); err != nil {
+		return nil, err
+	}
+
 	resp, err := wh.history.RecordActivityTaskHeartbeat(ctx, &h.RecordActivityTaskHeartbeatRequest{
 		DomainUUID:       common.StringPtr(taskToken.DomainID),
 		HeartbeatRequest: heartbeatRequest,
@@ -731,6 +888,10 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 	if domainID == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
+
+	if err := wh.authorize(ctx, "RecordActivityTaskHeartbeatByID", heartbeatRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
 	if workflowID == "" {
 		return nil, wh.error(errWorkflowIDNotSet, scope)
 	}
@@ -793,6 +954,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorizeDomainID(ctx, "RespondActivityTaskCompleted", taskToken.DomainID, scope); err != nil {
+		return err
+	}
+
 	err = wh.history.RespondActivityTaskCompleted(ctx, &h.RespondActivityTaskCompletedRequest{
 		DomainUUID:      common.StringPtr(taskToken.DomainID),
 		CompleteRequest: completeRequest,
@@ -830,6 +995,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 	if domainID == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
+
+	if err := wh.authorize(ctx, "RespondActivityTaskCompletedByID", completeRequest.GetDomain(), scope); err != nil {
+		return err
+	}
 	if workflowID == "" {
 		return wh.error(errWorkflowIDNotSet, scope)
 	}
@@ -892,6 +1061,10 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorizeDomainID(ctx, "RespondActivityTaskFailed", taskToken.DomainID, scope); err != nil {
+		return err
+	}
+
 	err = wh.history.RespondActivityTaskFailed(ctx, &h.RespondActivityTaskFailedRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
 		FailedRequest: failedRequest,
@@ -929,6 +1102,10 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 	if domainID == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
+
+	if err := wh.authorize(ctx, "RespondActivityTaskFailedByID", failedRequest.GetDomain(), scope); err != nil {
+		return err
+	}
 	if workflowID == "" {
 		return wh.error(errWorkflowIDNotSet, scope)
 	}
@@ -992,6 +1169,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorizeDomainID(ctx, "RespondActivityTaskCanceled", taskToken.DomainID, scope); err != nil {
+		return err
+	}
+
 	err = wh.history.RespondActivityTaskCanceled(ctx, &h.RespondActivityTaskCanceledRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
 		CancelRequest: cancelRequest,
@@ -1029,6 +1210,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 	if domainID == "" {
 		return wh.error(errDomainNotSet, scope)
 	}
+
+	if err := wh.authorize(ctx, "RespondActivityTaskCanceledByID", cancelRequest.GetDomain(), scope); err != nil {
+		return err
+	}
 	if workflowID == "" {
 		return wh.error(errWorkflowIDNotSet, scope)
 	}
@@ -1091,6 +1276,10 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorizeDomainID(ctx, "RespondDecisionTaskCompleted", taskToken.DomainID, scope); err != nil {
+		return err
+	}
+
 	err = wh.history.RespondDecisionTaskCompleted(ctx, &h.RespondDecisionTaskCompletedRequest{
 		DomainUUID:      common.StringPtr(taskToken.DomainID),
 		CompleteRequest: completeRequest},
@@ -1128,6 +1317,10 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorizeDomainID(ctx, "RespondDecisionTaskFailed", taskToken.DomainID, scope); err != nil {
+		return err
+	}
+
 	err = wh.history.RespondDecisionTaskFailed(ctx, &h.RespondDecisionTaskFailedRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
 		FailedRequest: failedRequest,
@@ -1165,6 +1358,10 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 		return wh.error(errInvalidTaskToken, scope)
 	}
 
+	if err := wh.authorizeDomainID(ctx, "RespondQueryTaskCompleted", queryTaskToken.DomainID, scope); err != nil {
+		return err
+	}
+
 	matchingRequest := &m.RespondQueryTaskCompletedRequest{
 		DomainUUID:       common.StringPtr(queryTaskToken.DomainID),
 		TaskList:         &gen.TaskList{Name: common.StringPtr(queryTaskToken.TaskList)},
@@ -1200,13 +1397,21 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "StartWorkflowExecution", startRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(startRequest.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
 	if startRequest.GetWorkflowId() == "" {
 		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowId is not set on request."}, scope)
 	}
 
-	wh.Service.GetLogger().Debugf(
-		"Received StartWorkflowExecution. WorkflowID: %v",
-		startRequest.GetWorkflowId())
+	wh.Service.GetLogger().Debug("Received StartWorkflowExecution.",
+		tag.WorkflowDomainName(startRequest.GetDomain()),
+		tag.WorkflowID(startRequest.GetWorkflowId()))
 
 	if startRequest.WorkflowType == nil || startRequest.WorkflowType.GetName() == "" {
 		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowType is not set on request."}, scope)
@@ -1226,14 +1431,20 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
+	if err := wh.validateSearchAttributes(startRequest.SearchAttributes, scope); err != nil {
+		return nil, err
+	}
+
 	domainName := startRequest.GetDomain()
-	wh.Service.GetLogger().Debugf("Start workflow execution request domain: %v", domainName)
-	domainID, err := wh.domainCache.GetDomainID(domainName)
+	domainID, err := wh.getDomainIDForNewWork(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
 
-	wh.Service.GetLogger().Debugf("Start workflow execution request domainID: %v", domainID)
+	wh.Service.GetLogger().Debug("Start workflow execution request domain.",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(startRequest.GetWorkflowId()))
 
 	resp, err := wh.history.StartWorkflowExecution(ctx, &h.StartWorkflowExecutionRequest{
 		DomainUUID:   common.StringPtr(domainID),
@@ -1266,6 +1477,14 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "GetWorkflowExecutionHistory", getRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(getRequest.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
 	if err := wh.validateExecution(getRequest.Execution, scope); err != nil {
 		return nil, err
 	}
@@ -1321,6 +1540,18 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 
 		execution.RunId = common.StringPtr(token.RunID)
 
+		if token.IsArchived {
+			archivedResponse, err := wh.getArchivedHistory(ctx, getRequest.GetDomain(), domainID, execution, token,
+				getRequest.GetMaximumPageSize(), isCloseEventOnly)
+			if err != nil {
+				return nil, wh.error(err, scope)
+			}
+			if archivedResponse == nil {
+				return nil, wh.error(errInvalidNextPageToken, scope)
+			}
+			return archivedResponse, nil
+		}
+
 		// we need to update the current next event ID and whether workflow is running
 		if len(token.PersistenceToken) == 0 && isLongPoll && token.IsWorkflowRunning {
 			if !isCloseEventOnly {
@@ -1341,6 +1572,18 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		}
 		runID, lastFirstEventID, nextEventID, isWorkflowRunning, err = queryHistory(domainID, execution, queryNextEventID)
 		if err != nil {
+			if _, ok := err.(*gen.EntityNotExistsError); ok && execution.GetRunId() != "" {
+				// the execution may have been deleted on retention expiry, serve it from the archive if possible
+				archivedResponse, archiveErr := wh.getArchivedHistory(ctx, getRequest.GetDomain(), domainID, execution,
+					&getHistoryContinuationToken{RunID: execution.GetRunId(), IsArchived: true},
+					getRequest.GetMaximumPageSize(), isCloseEventOnly)
+				if archiveErr != nil {
+					return nil, wh.error(archiveErr, scope)
+				}
+				if archivedResponse != nil {
+					return archivedResponse, nil
+				}
+			}
 			return nil, wh.error(err, scope)
 		}
 
@@ -1357,7 +1600,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	history.Events = []*gen.HistoryEvent{}
 	if isCloseEventOnly {
 		if !isWorkflowRunning {
-			history, _, err = wh.getHistory(domainID, *execution, lastFirstEventID, nextEventID,
+			history, _, err = wh.getHistory(ctx, domainID, *execution, lastFirstEventID, nextEventID,
 				getRequest.GetMaximumPageSize(), nil, token.TransientDecision)
 			if err != nil {
 				return nil, wh.error(err, scope)
@@ -1381,7 +1624,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			}
 		} else {
 			history, token.PersistenceToken, err =
-				wh.getHistory(domainID, *execution, token.FirstEventID, token.NextEventID,
+				wh.getHistory(ctx, domainID, *execution, token.FirstEventID, token.NextEventID,
 					getRequest.GetMaximumPageSize(), token.PersistenceToken, token.TransientDecision)
 			if err != nil {
 				return nil, wh.error(err, scope)
@@ -1424,6 +1667,14 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "SignalWorkflowExecution", signalRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(signalRequest.GetDomain(), scope); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
 	if err := wh.validateExecution(signalRequest.WorkflowExecution, scope); err != nil {
 		return err
 	}
@@ -1432,7 +1683,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(&gen.BadRequestError{Message: "SignalName is not set on request."}, scope)
 	}
 
-	domainID, err := wh.domainCache.GetDomainID(signalRequest.GetDomain())
+	domainID, err := wh.getDomainIDForNewWork(signalRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
 	}
@@ -1472,6 +1723,14 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "SignalWithStartWorkflowExecution", signalWithStartRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(signalWithStartRequest.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
 	if signalWithStartRequest.GetWorkflowId() == "" {
 		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowId is not set on request."}, scope)
 	}
@@ -1498,7 +1757,11 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
-	domainID, err := wh.domainCache.GetDomainID(signalWithStartRequest.GetDomain())
+	if err := wh.validateSearchAttributes(signalWithStartRequest.SearchAttributes, scope); err != nil {
+		return nil, err
+	}
+
+	domainID, err := wh.getDomainIDForNewWork(signalWithStartRequest.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -1535,6 +1798,14 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "TerminateWorkflowExecution", terminateRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(terminateRequest.GetDomain(), scope); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
 	if err := wh.validateExecution(terminateRequest.WorkflowExecution, scope); err != nil {
 		return err
 	}
@@ -1555,6 +1826,59 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// ResetWorkflowExecution resets an existing workflow execution to the DecisionTaskCompleted event identified by
+// DecisionFinishEventId, and immediately terminates the current run if it is still running.
+func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context,
+	resetRequest *gen.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+
+	scope := metrics.FrontendResetWorkflowExecutionScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if resetRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if resetRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "ResetWorkflowExecution", resetRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(resetRequest.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateExecution(resetRequest.WorkflowExecution, scope); err != nil {
+		return nil, err
+	}
+
+	if resetRequest.GetDecisionFinishEventId() <= common.FirstEventID {
+		return nil, wh.error(errInvalidDecisionFinishID, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(resetRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err := wh.history.ResetWorkflowExecution(ctx, &h.ResetWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		ResetRequest: resetRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
 // RequestCancelWorkflowExecution - requests to cancel a workflow execution
 func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	ctx context.Context,
@@ -1576,6 +1900,14 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "RequestCancelWorkflowExecution", cancelRequest.GetDomain(), scope); err != nil {
+		return err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(cancelRequest.GetDomain(), scope); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
 	if err := wh.validateExecution(cancelRequest.WorkflowExecution, scope); err != nil {
 		return err
 	}
@@ -1616,6 +1948,14 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "ListOpenWorkflowExecutions", listRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(listRequest.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
 	if listRequest.StartTimeFilter == nil {
 		return nil, wh.error(&gen.BadRequestError{Message: "StartTimeFilter is required"}, scope)
 	}
@@ -1628,9 +1968,20 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 		return nil, wh.error(&gen.BadRequestError{Message: "LatestTime in StartTimeFilter is required"}, scope)
 	}
 
-	if listRequest.ExecutionFilter != nil && listRequest.TypeFilter != nil {
+	filterCount := 0
+	if listRequest.ExecutionFilter != nil {
+		filterCount++
+	}
+	if listRequest.TypeFilter != nil {
+		filterCount++
+	}
+	if listRequest.SearchAttributesFilter != nil {
+		filterCount++
+	}
+
+	if filterCount > 1 {
 		return nil, wh.error(&gen.BadRequestError{
-			Message: "Only one of ExecutionFilter or TypeFilter is allowed"}, scope)
+			Message: "Only one of ExecutionFilter, TypeFilter or SearchAttributesFilter is allowed"}, scope)
 	}
 
 	if listRequest.GetMaximumPageSize() == 0 {
@@ -1650,20 +2001,27 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 		LatestStartTime:   listRequest.StartTimeFilter.GetLatestTime(),
 	}
 
+	visibilityMgr := persistence.VisibilityManagerWithContext(ctx, wh.visibitiltyMgr)
 	var persistenceResp *persistence.ListWorkflowExecutionsResponse
 	if listRequest.ExecutionFilter != nil {
-		persistenceResp, err = wh.visibitiltyMgr.ListOpenWorkflowExecutionsByWorkflowID(
+		persistenceResp, err = visibilityMgr.ListOpenWorkflowExecutionsByWorkflowID(
 			&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
 				ListWorkflowExecutionsRequest: baseReq,
 				WorkflowID:                    listRequest.ExecutionFilter.GetWorkflowId(),
 			})
 	} else if listRequest.TypeFilter != nil {
-		persistenceResp, err = wh.visibitiltyMgr.ListOpenWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
+		persistenceResp, err = visibilityMgr.ListOpenWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
 			ListWorkflowExecutionsRequest: baseReq,
 			WorkflowTypeName:              listRequest.TypeFilter.GetName(),
 		})
+	} else if listRequest.SearchAttributesFilter != nil {
+		persistenceResp, err = visibilityMgr.ListOpenWorkflowExecutionsBySearchAttributes(
+			&persistence.ListWorkflowExecutionsBySearchAttributesRequest{
+				ListWorkflowExecutionsRequest: baseReq,
+				SearchAttributes:              listRequest.SearchAttributesFilter.IndexedFields,
+			})
 	} else {
-		persistenceResp, err = wh.visibitiltyMgr.ListOpenWorkflowExecutions(&baseReq)
+		persistenceResp, err = visibilityMgr.ListOpenWorkflowExecutions(&baseReq)
 	}
 
 	if err != nil {
@@ -1696,6 +2054,14 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "ListClosedWorkflowExecutions", listRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(listRequest.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
 	if listRequest.StartTimeFilter == nil {
 		return nil, wh.error(&gen.BadRequestError{Message: "StartTimeFilter is required"}, scope)
 	}
@@ -1718,10 +2084,13 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 	if listRequest.StatusFilter != nil {
 		filterCount++
 	}
+	if listRequest.SearchAttributesFilter != nil {
+		filterCount++
+	}
 
 	if filterCount > 1 {
 		return nil, wh.error(&gen.BadRequestError{
-			Message: "Only one of ExecutionFilter, TypeFilter or StatusFilter is allowed"}, scope)
+			Message: "Only one of ExecutionFilter, TypeFilter, StatusFilter or SearchAttributesFilter is allowed"}, scope)
 	}
 
 	if listRequest.GetMaximumPageSize() == 0 {
@@ -1741,25 +2110,32 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 		LatestStartTime:   listRequest.StartTimeFilter.GetLatestTime(),
 	}
 
+	visibilityMgr := persistence.VisibilityManagerWithContext(ctx, wh.visibitiltyMgr)
 	var persistenceResp *persistence.ListWorkflowExecutionsResponse
 	if listRequest.ExecutionFilter != nil {
-		persistenceResp, err = wh.visibitiltyMgr.ListClosedWorkflowExecutionsByWorkflowID(
+		persistenceResp, err = visibilityMgr.ListClosedWorkflowExecutionsByWorkflowID(
 			&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
 				ListWorkflowExecutionsRequest: baseReq,
 				WorkflowID:                    listRequest.ExecutionFilter.GetWorkflowId(),
 			})
 	} else if listRequest.TypeFilter != nil {
-		persistenceResp, err = wh.visibitiltyMgr.ListClosedWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
+		persistenceResp, err = visibilityMgr.ListClosedWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
 			ListWorkflowExecutionsRequest: baseReq,
 			WorkflowTypeName:              listRequest.TypeFilter.GetName(),
 		})
 	} else if listRequest.StatusFilter != nil {
-		persistenceResp, err = wh.visibitiltyMgr.ListClosedWorkflowExecutionsByStatus(&persistence.ListClosedWorkflowExecutionsByStatusRequest{
+		persistenceResp, err = visibilityMgr.ListClosedWorkflowExecutionsByStatus(&persistence.ListClosedWorkflowExecutionsByStatusRequest{
 			ListWorkflowExecutionsRequest: baseReq,
 			Status: listRequest.GetStatusFilter(),
 		})
+	} else if listRequest.SearchAttributesFilter != nil {
+		persistenceResp, err = visibilityMgr.ListClosedWorkflowExecutionsBySearchAttributes(
+			&persistence.ListWorkflowExecutionsBySearchAttributesRequest{
+				ListWorkflowExecutionsRequest: baseReq,
+				SearchAttributes:              listRequest.SearchAttributesFilter.IndexedFields,
+			})
 	} else {
-		persistenceResp, err = wh.visibitiltyMgr.ListClosedWorkflowExecutions(&baseReq)
+		persistenceResp, err = visibilityMgr.ListClosedWorkflowExecutions(&baseReq)
 	}
 
 	if err != nil {
@@ -1772,6 +2148,136 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 	return resp, nil
 }
 
+// ListWorkflowExecutions - retrieves info for workflow executions in a domain matching a query
+func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context,
+	listRequest *gen.ListWorkflowExecutionsRequest) (*gen.ListWorkflowExecutionsResponse, error) {
+
+	scope := metrics.FrontendListWorkflowExecutionsScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if listRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "ListWorkflowExecutions", listRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(listRequest.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	query, err := parseVisibilityQuery(listRequest.GetQuery())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest.GetPageSize() == 0 {
+		listRequest.PageSize = common.Int32Ptr(wh.config.DefaultVisibilityMaxPageSize)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(listRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	visibilityMgr := persistence.VisibilityManagerWithContext(ctx, wh.visibitiltyMgr)
+	persistenceResp, err := visibilityMgr.ListWorkflowExecutions(&persistence.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID:    domainID,
+		Query:         query,
+		PageSize:      int(listRequest.GetPageSize()),
+		NextPageToken: listRequest.NextPageToken,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp := &gen.ListWorkflowExecutionsResponse{}
+	resp.Executions = persistenceResp.Executions
+	resp.NextPageToken = persistenceResp.NextPageToken
+	return resp, nil
+}
+
+// CountWorkflowExecutions - counts the workflow executions in a domain matching a query
+func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context,
+	countRequest *gen.CountWorkflowExecutionsRequest) (*gen.CountWorkflowExecutionsResponse, error) {
+
+	scope := metrics.FrontendCountWorkflowExecutionsScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if countRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if countRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.authorize(ctx, "CountWorkflowExecutions", countRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(countRequest.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	query, err := parseVisibilityQuery(countRequest.GetQuery())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	if query.OrderBy != persistence.VisibilityQueryFieldStartTime || query.Ascending {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: "ORDER BY is not supported when counting workflow executions."}, scope)
+	}
+
+	groupBy := ""
+	if countRequest.GroupBy != nil {
+		switch countRequest.GetGroupBy() {
+		case gen.CountWorkflowExecutionsGroupByWorkflowType:
+			groupBy = persistence.VisibilityQueryFieldWorkflowType
+		case gen.CountWorkflowExecutionsGroupByCloseStatus:
+			groupBy = persistence.VisibilityQueryFieldCloseStatus
+		default:
+			return nil, wh.error(&gen.BadRequestError{
+				Message: fmt.Sprintf("Unknown GroupBy: %v", countRequest.GetGroupBy())}, scope)
+		}
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(countRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	visibilityMgr := persistence.VisibilityManagerWithContext(ctx, wh.visibitiltyMgr)
+	persistenceResp, err := visibilityMgr.CountWorkflowExecutions(&persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Query:      query,
+		GroupBy:    groupBy,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp := &gen.CountWorkflowExecutionsResponse{}
+	resp.Count = common.Int64Ptr(persistenceResp.Count)
+	resp.Groups = persistenceResp.Groups
+	return resp, nil
+}
+
 // QueryWorkflow returns query result for a specified workflow execution
 func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context,
 	queryRequest *gen.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error) {
@@ -1788,6 +2294,10 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.authorize(ctx, "QueryWorkflow", queryRequest.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
 	if err := wh.validateExecution(queryRequest.Execution, scope); err != nil {
 		return nil, err
 	}
@@ -1888,6 +2398,14 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 	if request.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
+
+	if err := wh.authorize(ctx, "DescribeWorkflowExecution", request.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(request.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
 	domainID, err := wh.domainCache.GetDomainID(request.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -1928,6 +2446,14 @@ func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.De
 	if request.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
+
+	if err := wh.authorize(ctx, "DescribeTaskList", request.GetDomain(), scope); err != nil {
+		return nil, err
+	}
+
+	if ok := wh.domainRateLimiter.TryConsume(request.GetDomain(), scope); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
 	domainID, err := wh.domainCache.GetDomainID(request.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -1959,13 +2485,14 @@ func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.De
 	return response, nil
 }
 
-func (wh *WorkflowHandler) getHistory(domainID string, execution gen.WorkflowExecution,
+func (wh *WorkflowHandler) getHistory(ctx context.Context, domainID string, execution gen.WorkflowExecution,
 	firstEventID, nextEventID int64, pageSize int32, nextPageToken []byte,
 	transientDecision *gen.TransientDecisionInfo) (*gen.History, []byte, error) {
 
 	historyEvents := []*gen.HistoryEvent{}
 
-	response, err := wh.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+	historyMgr := persistence.HistoryManagerWithContext(ctx, wh.historyMgr)
+	response, err := historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     execution,
 		FirstEventID:  firstEventID,
@@ -1999,15 +2526,97 @@ func (wh *WorkflowHandler) getHistory(domainID string, execution gen.WorkflowExe
 	return executionHistory, nextPageToken, nil
 }
 
-func (wh *WorkflowHandler) getLoggerForTask(taskToken []byte) bark.Logger {
+// getArchivedHistory returns a page of the archived history of a workflow execution, starting at the position
+// of the token.  Nil is returned when archival is not enabled for the domain or the execution has not been archived.
+func (wh *WorkflowHandler) getArchivedHistory(ctx context.Context, domainName string, domainID string,
+	execution *gen.WorkflowExecution, token *getHistoryContinuationToken, pageSize int32,
+	isCloseEventOnly bool) (*gen.GetWorkflowExecutionHistoryResponse, error) {
+	blobstoreClient := wh.GetBlobstoreClient()
+	if blobstoreClient == nil {
+		return nil, nil
+	}
+
+	domainEntry, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, err
+	}
+	domainConfig := domainEntry.GetConfig()
+	if domainConfig.ArchivalStatus != gen.ArchivalStatusEnabled || len(domainConfig.ArchivalBucket) == 0 {
+		return nil, nil
+	}
+
+	downloadPage := func(page int) (*archival.HistoryBlob, error) {
+		return archival.DownloadHistoryBlob(ctx, blobstoreClient, domainConfig.ArchivalBucket, domainID,
+			execution.GetWorkflowId(), execution.GetRunId(), page)
+	}
+	historyBlob, err := downloadPage(token.ArchivePage)
+	if err != nil {
+		if err == blobstore.ErrBlobNotExists {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if isCloseEventOnly {
+		// the close event is the last event of the last page, only the first page knows the number of pages
+		if historyBlob.PageCount > 1 {
+			if historyBlob, err = downloadPage(historyBlob.PageCount - 1); err != nil {
+				return nil, err
+			}
+		}
+		events := historyBlob.Events
+		if len(events) > 0 {
+			events = events[len(events)-1:]
+		}
+		return createGetWorkflowExecutionHistoryResponse(&gen.History{Events: events}, nil), nil
+	}
+
+	if token.ArchiveOffset > len(historyBlob.Events) {
+		return nil, errInvalidNextPageToken
+	}
+	events := historyBlob.Events[token.ArchiveOffset:]
+	nextToken := *token
+	switch {
+	case len(events) > int(pageSize):
+		events = events[:pageSize]
+		nextToken.ArchiveOffset += int(pageSize)
+	case !historyBlob.IsLast:
+		nextToken.ArchivePage++
+		nextToken.ArchiveOffset = 0
+	default:
+		return createGetWorkflowExecutionHistoryResponse(&gen.History{Events: events}, nil), nil
+	}
+
+	nextPageToken, err := serializeHistoryToken(&nextToken)
+	if err != nil {
+		return nil, err
+	}
+	return createGetWorkflowExecutionHistoryResponse(&gen.History{Events: events}, nextPageToken), nil
+}
+
+func (wh *WorkflowHandler) validateArchivalConfig(config *persistence.DomainConfig) error {
+	if config.ArchivalStatus != gen.ArchivalStatusEnabled {
+		return nil
+	}
+	if wh.GetBlobstoreClient() == nil {
+		return errArchivalNotConfigured
+	}
+	if len(config.ArchivalBucket) == 0 {
+		return errArchivalBucketNotSet
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) getLoggerForTask(taskToken []byte) logging.Logger {
 	logger := wh.Service.GetLogger()
 	task, err := wh.tokenSerializer.Deserialize(taskToken)
 	if err == nil {
-		logger = logger.WithFields(bark.Fields{
-			"WorkflowID": task.WorkflowID,
-			"RunID":      task.RunID,
-			"ScheduleID": task.ScheduleID,
-		})
+		logger = logger.WithTags(
+			tag.WorkflowDomainID(task.DomainID),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID),
+			tag.WorkflowScheduleID(task.ScheduleID))
+
 	}
 	return logger
 }
@@ -2047,6 +2656,9 @@ func (wh *WorkflowHandler) error(err error, scope int) error {
 	case *gen.QueryFailedError:
 		wh.metricsClient.IncCounter(scope, metrics.CadenceErrQueryFailedCounter)
 		return err
+	case *gen.AccessDeniedError:
+		wh.metricsClient.IncCounter(scope, metrics.CadenceErrAccessDeniedCounter)
+		return err
 	default:
 		logging.LogUncategorizedError(wh.Service.GetLogger(), err)
 		wh.metricsClient.IncCounter(scope, metrics.CadenceFailures)
@@ -2054,6 +2666,29 @@ func (wh *WorkflowHandler) error(err error, scope int) error {
 	}
 }
 
+// authorize checks with the authorizer whether the caller in the context may call the API on the domain
+func (wh *WorkflowHandler) authorize(ctx context.Context, apiName string, domainName string, scope int) error {
+	decision, err := wh.authorizer.Authorize(ctx, authorization.NewAttributes(ctx, apiName, domainName))
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	if decision != authorization.DecisionAllow {
+		return wh.error(&gen.AccessDeniedError{
+			Message: fmt.Sprintf("Caller is not allowed to call %v on domain %v.", apiName, domainName),
+		}, scope)
+	}
+	return nil
+}
+
+// authorizeDomainID is authorize for the APIs which only know the domain ID from their task token
+func (wh *WorkflowHandler) authorizeDomainID(ctx context.Context, apiName string, domainID string, scope int) error {
+	domainEntry, err := wh.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	return wh.authorize(ctx, apiName, domainEntry.GetInfo().Name, scope)
+}
+
 func (wh *WorkflowHandler) validateTaskListType(t *gen.TaskListType, scope int) error {
 	if t == nil {
 		return wh.error(errTaskListTypeNotSet, scope)
@@ -2068,15 +2703,30 @@ func (wh *WorkflowHandler) validateTaskList(t *gen.TaskList, scope int) error {
 	return nil
 }
 
+func (wh *WorkflowHandler) validateSearchAttributes(searchAttributes *gen.SearchAttributes, scope int) error {
+	if err := common.ValidateSearchAttributes(searchAttributes, wh.config.SearchAttributesNumberOfKeysLimit,
+		wh.config.SearchAttributesSizeOfValueLimit, wh.config.SearchAttributesTotalSizeLimit); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) validateExecution(w *gen.WorkflowExecution, scope int) error {
+	if err := validateExecution(w); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+func validateExecution(w *gen.WorkflowExecution) error {
 	if w == nil {
-		return wh.error(errExecutionNotSet, scope)
+		return errExecutionNotSet
 	}
 	if w.WorkflowId == nil || w.GetWorkflowId() == "" {
-		return wh.error(errWorkflowIDNotSet, scope)
+		return errWorkflowIDNotSet
 	}
 	if w.GetRunId() != "" && uuid.Parse(w.GetRunId()) == nil {
-		return wh.error(errInvalidRunID, scope)
+		return errInvalidRunID
 	}
 	return nil
 }
@@ -2111,6 +2761,8 @@ func createDomainResponse(info *persistence.DomainInfo, config *persistence.Doma
 	configResult := &gen.DomainConfiguration{
 		EmitMetric:                             common.BoolPtr(config.EmitMetric),
 		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(config.Retention),
+		ArchivalBucketName:                     common.StringPtr(config.ArchivalBucket),
+		ArchivalStatus:                         config.ArchivalStatus.Ptr(),
 	}
 
 	clusters := []*gen.ClusterReplicationConfiguration{}
@@ -2162,6 +2814,7 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(ctx context.Context
 			firstEventID = matchingResp.GetPreviousStartedEventId() + 1
 		}
 		history, persistenceToken, err = wh.getHistory(
+			ctx,
 			domainID,
 			*matchingResp.WorkflowExecution,
 			firstEventID,
@@ -2226,6 +2879,19 @@ func serializeHistoryToken(token *getHistoryContinuationToken) ([]byte, error) {
 	return bytes, err
 }
 
+// getDomainIDForNewWork returns the ID of a domain, the requests starting or signalling workflows are rejected with a
+// retryable error while a graceful failover hands the domain over to another cluster
+func (wh *WorkflowHandler) getDomainIDForNewWork(domainName string) (string, error) {
+	domainEntry, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return "", err
+	}
+	if domainEntry.IsDomainInHandover() {
+		return "", errDomainInHandover
+	}
+	return domainEntry.GetInfo().ID, nil
+}
+
 func createServiceBusyError() *gen.ServiceBusyError {
 	err := &gen.ServiceBusyError{}
 	err.Message = "Too many outstanding requests to the cadence service"
@@ -0,0 +1,381 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+)
+
+type (
+	// gatewayHandler is the part of the WorkflowService served by the HTTP gateway
+	gatewayHandler interface {
+		StartWorkflowExecution(ctx context.Context,
+			request *gen.StartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error)
+		SignalWorkflowExecution(ctx context.Context, request *gen.SignalWorkflowExecutionRequest) error
+		QueryWorkflow(ctx context.Context, request *gen.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error)
+		DescribeWorkflowExecution(ctx context.Context,
+			request *gen.DescribeWorkflowExecutionRequest) (*gen.DescribeWorkflowExecutionResponse, error)
+		GetWorkflowExecutionHistory(ctx context.Context,
+			request *gen.GetWorkflowExecutionHistoryRequest) (*gen.GetWorkflowExecutionHistoryResponse, error)
+		ListWorkflowExecutions(ctx context.Context,
+			request *gen.ListWorkflowExecutionsRequest) (*gen.ListWorkflowExecutionsResponse, error)
+	}
+
+	// HTTPGateway serves the workflow APIs of the frontend as JSON over HTTP, or over HTTPS when given a TLS config.
+	// The routes are
+	//   POST /api/v1/domains/{domain}/workflows                       start a workflow
+	//   GET  /api/v1/domains/{domain}/workflows?query=                list workflows
+	//   GET  /api/v1/domains/{domain}/workflows/{workflowID}          describe a workflow
+	//   GET  /api/v1/domains/{domain}/workflows/{workflowID}/history  get, or long poll with waitForNewEvent, the history
+	//   POST /api/v1/domains/{domain}/workflows/{workflowID}/signal   signal a workflow
+	//   POST /api/v1/domains/{domain}/workflows/{workflowID}/query    query a workflow
+	// Request and response bodies are the JSON encoding of the shared.thrift types, binary fields are base64 encoded.
+	// The HTTP headers of a request are the headers of the call given to the authorizer, so the identity header
+	// of the authorization config is read from them, and the Rpc-Caller header names the caller.
+	HTTPGateway struct {
+		handler gatewayHandler
+		address string
+		logger  logging.Logger
+		server  *http.Server
+	}
+
+	httpError struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+)
+
+const (
+	httpGatewayPathPrefix = "/api/v1/domains/"
+	// httpGatewayCallerHeader is the header naming the caller, as set by the yarpc HTTP transport
+	httpGatewayCallerHeader = "Rpc-Caller"
+
+	// httpGatewayRequestTimeout is the timeout of the calls made by the gateway, except long polls
+	httpGatewayRequestTimeout = 30 * time.Second
+	// httpGatewayLongPollTimeout is the timeout of the history calls waiting for new events
+	httpGatewayLongPollTimeout = 3 * time.Minute
+)
+
+// NewHTTPGateway creates a gateway serving the workflow APIs of the handler on the address,
+// it serves HTTPS when the tls config is not nil
+func NewHTTPGateway(handler gatewayHandler, address string, tlsConfig *tls.Config, logger logging.Logger) *HTTPGateway {
+	gateway := &HTTPGateway{
+		handler: handler,
+		address: address,
+		logger:  logger,
+	}
+	gateway.server = &http.Server{
+		Handler:   gateway,
+		TLSConfig: tlsConfig,
+	}
+	return gateway
+}
+
+// Start starts serving the gateway
+func (g *HTTPGateway) Start() error {
+	listener, err := net.Listen("tcp", g.address)
+	if err != nil {
+		return err
+	}
+	go func() {
+		var err error
+		if g.server.TLSConfig != nil {
+			// the certificates are loaded in the tls config
+			err = g.server.ServeTLS(listener, "", "")
+		} else {
+			err = g.server.Serve(listener)
+		}
+		if err != nil && err != http.ErrServerClosed {
+			g.logger.Error("HTTP gateway stopped serving", tag.Error(err))
+		}
+	}()
+	g.logger.Info("Created HTTP gateway and listening", tag.Address(g.address))
+
+	return nil
+}
+
+// Stop stops the gateway
+func (g *HTTPGateway) Stop() {
+	g.server.Close()
+}
+
+// ServeHTTP routes the request to the matching workflow API
+func (g *HTTPGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, httpGatewayPathPrefix) {
+		g.writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("Unknown path %v.", r.URL.Path))
+		return
+	}
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, httpGatewayPathPrefix), "/"), "/")
+	if len(segments) < 2 || segments[0] == "" || segments[1] != "workflows" {
+		g.writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("Unknown path %v.", r.URL.Path))
+		return
+	}
+	domain := segments[0]
+	r = r.WithContext(authorization.WithCall(r.Context(), r.Header.Get(httpGatewayCallerHeader), getGatewayHeaders(r)))
+
+	switch {
+	case len(segments) == 2 && r.Method == http.MethodPost:
+		g.startWorkflow(w, r, domain)
+	case len(segments) == 2 && r.Method == http.MethodGet:
+		g.listWorkflows(w, r, domain)
+	case len(segments) == 3 && r.Method == http.MethodGet:
+		g.describeWorkflow(w, r, domain, segments[2])
+	case len(segments) == 4 && segments[3] == "history" && r.Method == http.MethodGet:
+		g.getHistory(w, r, domain, segments[2])
+	case len(segments) == 4 && segments[3] == "signal" && r.Method == http.MethodPost:
+		g.signalWorkflow(w, r, domain, segments[2])
+	case len(segments) == 4 && segments[3] == "query" && r.Method == http.MethodPost:
+		g.queryWorkflow(w, r, domain, segments[2])
+	case len(segments) <= 4:
+		g.writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed",
+			fmt.Sprintf("Method %v is not allowed on %v.", r.Method, r.URL.Path))
+	default:
+		g.writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("Unknown path %v.", r.URL.Path))
+	}
+}
+
+func (g *HTTPGateway) startWorkflow(w http.ResponseWriter, r *http.Request, domain string) {
+	request := &gen.StartWorkflowExecutionRequest{}
+	if !g.readRequest(w, r, request) {
+		return
+	}
+	request.Domain = common.StringPtr(domain)
+	if request.GetRequestId() == "" {
+		request.RequestId = common.StringPtr(uuid.New())
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), httpGatewayRequestTimeout)
+	defer cancel()
+	resp, err := g.handler.StartWorkflowExecution(ctx, request)
+	g.writeResponse(w, resp, err)
+}
+
+func (g *HTTPGateway) listWorkflows(w http.ResponseWriter, r *http.Request, domain string) {
+	params := r.URL.Query()
+	request := &gen.ListWorkflowExecutionsRequest{
+		Domain: common.StringPtr(domain),
+		Query:  common.StringPtr(params.Get("query")),
+	}
+	var err error
+	if request.PageSize, err = parseInt32Param(params.Get("pageSize")); err != nil {
+		g.writeResponse(w, nil, err)
+		return
+	}
+	if request.NextPageToken, err = parseTokenParam(params.Get("nextPageToken")); err != nil {
+		g.writeResponse(w, nil, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), httpGatewayRequestTimeout)
+	defer cancel()
+	resp, err := g.handler.ListWorkflowExecutions(ctx, request)
+	g.writeResponse(w, resp, err)
+}
+
+func (g *HTTPGateway) describeWorkflow(w http.ResponseWriter, r *http.Request, domain string, workflowID string) {
+	request := &gen.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(domain),
+		Execution: newGatewayExecution(r, workflowID),
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), httpGatewayRequestTimeout)
+	defer cancel()
+	resp, err := g.handler.DescribeWorkflowExecution(ctx, request)
+	g.writeResponse(w, resp, err)
+}
+
+func (g *HTTPGateway) getHistory(w http.ResponseWriter, r *http.Request, domain string, workflowID string) {
+	params := r.URL.Query()
+	request := &gen.GetWorkflowExecutionHistoryRequest{
+		Domain:    common.StringPtr(domain),
+		Execution: newGatewayExecution(r, workflowID),
+	}
+	var err error
+	if request.MaximumPageSize, err = parseInt32Param(params.Get("pageSize")); err != nil {
+		g.writeResponse(w, nil, err)
+		return
+	}
+	if request.NextPageToken, err = parseTokenParam(params.Get("nextPageToken")); err != nil {
+		g.writeResponse(w, nil, err)
+		return
+	}
+	if value := params.Get("waitForNewEvent"); value != "" {
+		waitForNewEvent, err := strconv.ParseBool(value)
+		if err != nil {
+			g.writeResponse(w, nil, &gen.BadRequestError{Message: "Invalid waitForNewEvent."})
+			return
+		}
+		request.WaitForNewEvent = common.BoolPtr(waitForNewEvent)
+	}
+	if value := params.Get("historyEventFilterType"); value != "" {
+		var filterType gen.HistoryEventFilterType
+		if err := filterType.UnmarshalText([]byte(value)); err != nil {
+			g.writeResponse(w, nil, &gen.BadRequestError{Message: "Invalid historyEventFilterType."})
+			return
+		}
+		request.HistoryEventFilterType = &filterType
+	}
+
+	timeout := httpGatewayRequestTimeout
+	if request.GetWaitForNewEvent() {
+		timeout = httpGatewayLongPollTimeout
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+	resp, err := g.handler.GetWorkflowExecutionHistory(ctx, request)
+	g.writeResponse(w, resp, err)
+}
+
+func (g *HTTPGateway) signalWorkflow(w http.ResponseWriter, r *http.Request, domain string, workflowID string) {
+	request := &gen.SignalWorkflowExecutionRequest{}
+	if !g.readRequest(w, r, request) {
+		return
+	}
+	request.Domain = common.StringPtr(domain)
+	request.WorkflowExecution = newGatewayExecution(r, workflowID)
+
+	ctx, cancel := context.WithTimeout(r.Context(), httpGatewayRequestTimeout)
+	defer cancel()
+	err := g.handler.SignalWorkflowExecution(ctx, request)
+	g.writeResponse(w, struct{}{}, err)
+}
+
+func (g *HTTPGateway) queryWorkflow(w http.ResponseWriter, r *http.Request, domain string, workflowID string) {
+	request := &gen.QueryWorkflowRequest{}
+	if !g.readRequest(w, r, request) {
+		return
+	}
+	request.Domain = common.StringPtr(domain)
+	request.Execution = newGatewayExecution(r, workflowID)
+
+	ctx, cancel := context.WithTimeout(r.Context(), httpGatewayRequestTimeout)
+	defer cancel()
+	resp, err := g.handler.QueryWorkflow(ctx, request)
+	g.writeResponse(w, resp, err)
+}
+
+// readRequest decodes the JSON body into the request, and writes a bad request response when it cannot
+func (g *HTTPGateway) readRequest(w http.ResponseWriter, r *http.Request, request interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		g.writeResponse(w, nil, &gen.BadRequestError{Message: fmt.Sprintf("Invalid request body: %v", err)})
+		return false
+	}
+	return true
+}
+
+func (g *HTTPGateway) writeResponse(w http.ResponseWriter, resp interface{}, err error) {
+	if err != nil {
+		status, errorType := getHTTPStatus(err)
+		g.writeError(w, status, errorType, err.Error())
+		return
+	}
+	g.writeJSON(w, http.StatusOK, resp)
+}
+
+func (g *HTTPGateway) writeError(w http.ResponseWriter, status int, errorType string, message string) {
+	g.writeJSON(w, status, &httpError{Error: errorType, Message: message})
+}
+
+func (g *HTTPGateway) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		g.logger.Warn("Failed to write HTTP gateway response", tag.Error(err))
+	}
+}
+
+// getHTTPStatus maps the errors returned by the WorkflowHandler to a status code and an error type
+func getHTTPStatus(err error) (int, string) {
+	switch err.(type) {
+	case *gen.BadRequestError:
+		return http.StatusBadRequest, "BadRequestError"
+	case *gen.EntityNotExistsError:
+		return http.StatusNotFound, "EntityNotExistsError"
+	case *gen.ServiceBusyError:
+		return http.StatusTooManyRequests, "ServiceBusyError"
+	case *gen.WorkflowExecutionAlreadyStartedError:
+		return http.StatusConflict, "WorkflowExecutionAlreadyStartedError"
+	case *gen.QueryFailedError:
+		return http.StatusBadRequest, "QueryFailedError"
+	case *gen.DomainNotActiveError:
+		return http.StatusBadRequest, "DomainNotActiveError"
+	case *gen.AccessDeniedError:
+		return http.StatusForbidden, "AccessDeniedError"
+	default:
+		return http.StatusInternalServerError, "InternalServiceError"
+	}
+}
+
+// getGatewayHeaders returns the first value of each header of the request
+func getGatewayHeaders(r *http.Request) map[string]string {
+	headers := make(map[string]string, len(r.Header))
+	for key := range r.Header {
+		headers[key] = r.Header.Get(key)
+	}
+	return headers
+}
+
+func newGatewayExecution(r *http.Request, workflowID string) *gen.WorkflowExecution {
+	execution := &gen.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)}
+	if runID := r.URL.Query().Get("runId"); runID != "" {
+		execution.RunId = common.StringPtr(runID)
+	}
+	return execution
+}
+
+func parseInt32Param(value string) (*int32, error) {
+	if value == "" {
+		return nil, nil
+	}
+	i, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, &gen.BadRequestError{Message: fmt.Sprintf("Invalid integer %v.", value)}
+	}
+	return common.Int32Ptr(int32(i)), nil
+}
+
+// parseTokenParam decodes a page token, which is base64 encoded in the JSON responses
+func parseTokenParam(value string) ([]byte, error) {
+	if value == "" {
+		return nil, nil
+	}
+	token, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errInvalidNextPageToken
+	}
+	return token, nil
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/logging"
+)
+
+type (
+	httpGatewaySuite struct {
+		suite.Suite
+		*require.Assertions
+		handler *fakeGatewayHandler
+		server  *httptest.Server
+	}
+
+	// fakeGatewayHandler records the last request and its context and returns the configured error
+	fakeGatewayHandler struct {
+		ctx     context.Context
+		request interface{}
+		err     error
+	}
+)
+
+func TestHTTPGatewaySuite(t *testing.T) {
+	s := new(httpGatewaySuite)
+	suite.Run(t, s)
+}
+
+func (s *httpGatewaySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.handler = &fakeGatewayHandler{}
+	s.server = httptest.NewServer(NewHTTPGateway(s.handler, "", nil, logging.NewNopLogger()))
+}
+
+func (s *httpGatewaySuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *httpGatewaySuite) TestStartWorkflow() {
+	resp := s.post("/api/v1/domains/test-domain/workflows",
+		`{"workflowId": "test-workflow", "workflowType": {"name": "test-type"}, "taskList": {"name": "test-tl"}}`)
+	defer resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	body := &gen.StartWorkflowExecutionResponse{}
+	s.NoError(json.NewDecoder(resp.Body).Decode(body))
+	s.Equal("test-run", body.GetRunId())
+
+	request := s.handler.request.(*gen.StartWorkflowExecutionRequest)
+	s.Equal("test-domain", request.GetDomain())
+	s.Equal("test-workflow", request.GetWorkflowId())
+	s.Equal("test-type", request.WorkflowType.GetName())
+	s.NotEmpty(request.GetRequestId())
+}
+
+func (s *httpGatewaySuite) TestGetHistoryLongPoll() {
+	resp := s.get("/api/v1/domains/test-domain/workflows/test-workflow/history" +
+		"?runId=test-run&waitForNewEvent=true&historyEventFilterType=CLOSE_EVENT&nextPageToken=dG9rZW4=")
+	defer resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	request := s.handler.request.(*gen.GetWorkflowExecutionHistoryRequest)
+	s.Equal("test-domain", request.GetDomain())
+	s.Equal("test-workflow", request.Execution.GetWorkflowId())
+	s.Equal("test-run", request.Execution.GetRunId())
+	s.True(request.GetWaitForNewEvent())
+	s.Equal(gen.HistoryEventFilterTypeCloseEvent, request.GetHistoryEventFilterType())
+	s.Equal([]byte("token"), request.NextPageToken)
+}
+
+func (s *httpGatewaySuite) TestSignalWorkflow() {
+	resp := s.post("/api/v1/domains/test-domain/workflows/test-workflow/signal",
+		`{"signalName": "test-signal", "input": "aW5wdXQ="}`)
+	defer resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	request := s.handler.request.(*gen.SignalWorkflowExecutionRequest)
+	s.Equal("test-workflow", request.WorkflowExecution.GetWorkflowId())
+	s.Equal("test-signal", request.GetSignalName())
+	s.Equal([]byte("input"), request.Input)
+}
+
+func (s *httpGatewaySuite) TestErrorStatus() {
+	testCases := []struct {
+		err    error
+		status int
+	}{
+		{&gen.BadRequestError{Message: "bad"}, http.StatusBadRequest},
+		{&gen.EntityNotExistsError{Message: "missing"}, http.StatusNotFound},
+		{&gen.ServiceBusyError{Message: "busy"}, http.StatusTooManyRequests},
+		{&gen.AccessDeniedError{Message: "denied"}, http.StatusForbidden},
+		{&gen.InternalServiceError{Message: "internal"}, http.StatusInternalServerError},
+	}
+	for _, tc := range testCases {
+		s.handler.err = tc.err
+		resp := s.get("/api/v1/domains/test-domain/workflows/test-workflow")
+		s.Equal(tc.status, resp.StatusCode)
+
+		body := &httpError{}
+		s.NoError(json.NewDecoder(resp.Body).Decode(body))
+		s.Equal(tc.err.Error(), body.Message)
+		resp.Body.Close()
+	}
+}
+
+func (s *httpGatewaySuite) TestInvalidRequests() {
+	resp := s.post("/api/v1/domains/test-domain/workflows", `{"workflowId": `)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = s.get("/api/v1/domains/test-domain/workflows?pageSize=ten")
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = s.get("/api/v1/domains/test-domain/workflows/test-workflow/signal")
+	s.Equal(http.StatusMethodNotAllowed, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = s.get("/api/v1/domains/test-domain/tasklists")
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+	resp.Body.Close()
+	s.Nil(s.handler.request)
+}
+
+func (s *httpGatewaySuite) TestTLS() {
+	// the test server provides a certificate and a client trusting it
+	certServer := httptest.NewTLSServer(http.NotFoundHandler())
+	defer certServer.Close()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	gateway := NewHTTPGateway(s.handler, address, certServer.TLS, logging.NewNopLogger())
+	s.NoError(gateway.Start())
+	defer gateway.Stop()
+
+	resp, err := certServer.Client().Get("https://" + address + "/api/v1/domains/test-domain/workflows/test-workflow")
+	s.NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+	s.Equal("test-workflow", s.handler.request.(*gen.DescribeWorkflowExecutionRequest).Execution.GetWorkflowId())
+
+	resp, err = http.Get("http://" + address + "/api/v1/domains/test-domain/workflows/test-workflow")
+	s.NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func (s *httpGatewaySuite) TestCallHeaders() {
+	request, err := http.NewRequest(http.MethodGet, s.server.URL+"/api/v1/domains/test-domain/workflows/test-workflow", nil)
+	s.NoError(err)
+	request.Header.Set("Rpc-Caller", "test-caller")
+	request.Header.Set("Cadence-Identity", "test-identity")
+	resp, err := http.DefaultClient.Do(request)
+	s.NoError(err)
+	resp.Body.Close()
+
+	attributes := authorization.NewAttributes(s.handler.ctx, "DescribeWorkflowExecution", "test-domain")
+	s.Equal("test-caller", attributes.Caller)
+	s.Equal("test-identity", attributes.Headers["cadence-identity"])
+}
+
+func (s *httpGatewaySuite) get(path string) *http.Response {
+	resp, err := http.Get(s.server.URL + path)
+	s.NoError(err)
+	return resp
+}
+
+func (s *httpGatewaySuite) post(path string, body string) *http.Response {
+	resp, err := http.Post(s.server.URL+path, "application/json", bytes.NewBufferString(body))
+	s.NoError(err)
+	return resp
+}
+
+func (h *fakeGatewayHandler) StartWorkflowExecution(ctx context.Context,
+	request *gen.StartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
+	h.request = request
+	return &gen.StartWorkflowExecutionResponse{RunId: common.StringPtr("test-run")}, h.err
+}
+
+func (h *fakeGatewayHandler) SignalWorkflowExecution(ctx context.Context,
+	request *gen.SignalWorkflowExecutionRequest) error {
+	h.request = request
+	return h.err
+}
+
+func (h *fakeGatewayHandler) QueryWorkflow(ctx context.Context,
+	request *gen.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error) {
+	h.request = request
+	return &gen.QueryWorkflowResponse{}, h.err
+}
+
+func (h *fakeGatewayHandler) DescribeWorkflowExecution(ctx context.Context,
+	request *gen.DescribeWorkflowExecutionRequest) (*gen.DescribeWorkflowExecutionResponse, error) {
+	h.ctx = ctx
+	h.request = request
+	return &gen.DescribeWorkflowExecutionResponse{}, h.err
+}
+
+func (h *fakeGatewayHandler) GetWorkflowExecutionHistory(ctx context.Context,
+	request *gen.GetWorkflowExecutionHistoryRequest) (*gen.GetWorkflowExecutionHistoryResponse, error) {
+	h.request = request
+	return &gen.GetWorkflowExecutionHistoryResponse{}, h.err
+}
+
+func (h *fakeGatewayHandler) ListWorkflowExecutions(ctx context.Context,
+	request *gen.ListWorkflowExecutionsRequest) (*gen.ListWorkflowExecutionsResponse, error) {
+	h.request = request
+	return &gen.ListWorkflowExecutionsResponse{}, h.err
+}
@@ -0,0 +1,438 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/proto/cadencepb"
+	"github.com/uber/cadence/common/protomapper"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+var _ cadencepb.WorkflowServiceYARPCServer = (*ProtoHandler)(nil)
+
+type (
+	// ProtoHandler serves the protobuf mirror of the WorkflowService, the requests are mapped to their thrift
+	// counterpart and handled by the WorkflowHandler so both encodings share the same validation, authorization,
+	// rate limiting and metrics
+	ProtoHandler struct {
+		handler workflowserviceserver.Interface
+	}
+)
+
+// NewProtoHandler creates a protobuf handler on top of the thrift WorkflowService handler
+func NewProtoHandler(handler workflowserviceserver.Interface) *ProtoHandler {
+	return &ProtoHandler{
+		handler: handler,
+	}
+}
+
+// RegisterDomain is the protobuf endpoint of WorkflowHandler.RegisterDomain
+func (h *ProtoHandler) RegisterDomain(
+	ctx context.Context,
+	request *cadencepb.RegisterDomainRequest,
+) (*cadencepb.RegisterDomainResponse, error) {
+	if err := h.handler.RegisterDomain(ctx, protomapper.ToThriftRegisterDomainRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RegisterDomainResponse{}, nil
+}
+
+// DescribeDomain is the protobuf endpoint of WorkflowHandler.DescribeDomain
+func (h *ProtoHandler) DescribeDomain(
+	ctx context.Context,
+	request *cadencepb.DescribeDomainRequest,
+) (*cadencepb.DescribeDomainResponse, error) {
+	response, err := h.handler.DescribeDomain(ctx, protomapper.ToThriftDescribeDomainRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoDescribeDomainResponse(response), nil
+}
+
+// UpdateDomain is the protobuf endpoint of WorkflowHandler.UpdateDomain
+func (h *ProtoHandler) UpdateDomain(
+	ctx context.Context,
+	request *cadencepb.UpdateDomainRequest,
+) (*cadencepb.UpdateDomainResponse, error) {
+	response, err := h.handler.UpdateDomain(ctx, protomapper.ToThriftUpdateDomainRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoUpdateDomainResponse(response), nil
+}
+
+// DeprecateDomain is the protobuf endpoint of WorkflowHandler.DeprecateDomain
+func (h *ProtoHandler) DeprecateDomain(
+	ctx context.Context,
+	request *cadencepb.DeprecateDomainRequest,
+) (*cadencepb.DeprecateDomainResponse, error) {
+	if err := h.handler.DeprecateDomain(ctx, protomapper.ToThriftDeprecateDomainRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.DeprecateDomainResponse{}, nil
+}
+
+// StartWorkflowExecution is the protobuf endpoint of WorkflowHandler.StartWorkflowExecution
+func (h *ProtoHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *cadencepb.StartWorkflowExecutionRequest,
+) (*cadencepb.StartWorkflowExecutionResponse, error) {
+	response, err := h.handler.StartWorkflowExecution(ctx, protomapper.ToThriftStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoStartWorkflowExecutionResponse(response), nil
+}
+
+// GetWorkflowExecutionHistory is the protobuf endpoint of WorkflowHandler.GetWorkflowExecutionHistory
+func (h *ProtoHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *cadencepb.GetWorkflowExecutionHistoryRequest,
+) (*cadencepb.GetWorkflowExecutionHistoryResponse, error) {
+	response, err := h.handler.GetWorkflowExecutionHistory(ctx, protomapper.ToThriftGetWorkflowExecutionHistoryRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoGetWorkflowExecutionHistoryResponse(response), nil
+}
+
+// PollForDecisionTask is the protobuf endpoint of WorkflowHandler.PollForDecisionTask
+func (h *ProtoHandler) PollForDecisionTask(
+	ctx context.Context,
+	request *cadencepb.PollForDecisionTaskRequest,
+) (*cadencepb.PollForDecisionTaskResponse, error) {
+	response, err := h.handler.PollForDecisionTask(ctx, protomapper.ToThriftPollForDecisionTaskRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoPollForDecisionTaskResponse(response), nil
+}
+
+// RespondDecisionTaskCompleted is the protobuf endpoint of WorkflowHandler.RespondDecisionTaskCompleted
+func (h *ProtoHandler) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *cadencepb.RespondDecisionTaskCompletedRequest,
+) (*cadencepb.RespondDecisionTaskCompletedResponse, error) {
+	if err := h.handler.RespondDecisionTaskCompleted(ctx, protomapper.ToThriftRespondDecisionTaskCompletedRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RespondDecisionTaskCompletedResponse{}, nil
+}
+
+// RespondDecisionTaskFailed is the protobuf endpoint of WorkflowHandler.RespondDecisionTaskFailed
+func (h *ProtoHandler) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *cadencepb.RespondDecisionTaskFailedRequest,
+) (*cadencepb.RespondDecisionTaskFailedResponse, error) {
+	if err := h.handler.RespondDecisionTaskFailed(ctx, protomapper.ToThriftRespondDecisionTaskFailedRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RespondDecisionTaskFailedResponse{}, nil
+}
+
+// PollForActivityTask is the protobuf endpoint of WorkflowHandler.PollForActivityTask
+func (h *ProtoHandler) PollForActivityTask(
+	ctx context.Context,
+	request *cadencepb.PollForActivityTaskRequest,
+) (*cadencepb.PollForActivityTaskResponse, error) {
+	response, err := h.handler.PollForActivityTask(ctx, protomapper.ToThriftPollForActivityTaskRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoPollForActivityTaskResponse(response), nil
+}
+
+// RecordActivityTaskHeartbeat is the protobuf endpoint of WorkflowHandler.RecordActivityTaskHeartbeat
+func (h *ProtoHandler) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *cadencepb.RecordActivityTaskHeartbeatRequest,
+) (*cadencepb.RecordActivityTaskHeartbeatResponse, error) {
+	response, err := h.handler.RecordActivityTaskHeartbeat(ctx, protomapper.ToThriftRecordActivityTaskHeartbeatRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+// RecordActivityTaskHeartbeatByID is the protobuf endpoint of WorkflowHandler.RecordActivityTaskHeartbeatByID
+func (h *ProtoHandler) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *cadencepb.RecordActivityTaskHeartbeatByIDRequest,
+) (*cadencepb.RecordActivityTaskHeartbeatResponse, error) {
+	response, err := h.handler.RecordActivityTaskHeartbeatByID(ctx, protomapper.ToThriftRecordActivityTaskHeartbeatByIDRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+// RespondActivityTaskCompleted is the protobuf endpoint of WorkflowHandler.RespondActivityTaskCompleted
+func (h *ProtoHandler) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *cadencepb.RespondActivityTaskCompletedRequest,
+) (*cadencepb.RespondActivityTaskCompletedResponse, error) {
+	if err := h.handler.RespondActivityTaskCompleted(ctx, protomapper.ToThriftRespondActivityTaskCompletedRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RespondActivityTaskCompletedResponse{}, nil
+}
+
+// RespondActivityTaskCompletedByID is the protobuf endpoint of WorkflowHandler.RespondActivityTaskCompletedByID
+func (h *ProtoHandler) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *cadencepb.RespondActivityTaskCompletedByIDRequest,
+) (*cadencepb.RespondActivityTaskCompletedByIDResponse, error) {
+	if err := h.handler.RespondActivityTaskCompletedByID(ctx, protomapper.ToThriftRespondActivityTaskCompletedByIDRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RespondActivityTaskCompletedByIDResponse{}, nil
+}
+
+// RespondActivityTaskFailed is the protobuf endpoint of WorkflowHandler.RespondActivityTaskFailed
+func (h *ProtoHandler) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *cadencepb.RespondActivityTaskFailedRequest,
+) (*cadencepb.RespondActivityTaskFailedResponse, error) {
+	if err := h.handler.RespondActivityTaskFailed(ctx, protomapper.ToThriftRespondActivityTaskFailedRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RespondActivityTaskFailedResponse{}, nil
+}
+
+// RespondActivityTaskFailedByID is the protobuf endpoint of WorkflowHandler.RespondActivityTaskFailedByID
+func (h *ProtoHandler) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *cadencepb.RespondActivityTaskFailedByIDRequest,
+) (*cadencepb.RespondActivityTaskFailedByIDResponse, error) {
+	if err := h.handler.RespondActivityTaskFailedByID(ctx, protomapper.ToThriftRespondActivityTaskFailedByIDRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RespondActivityTaskFailedByIDResponse{}, nil
+}
+
+// RespondActivityTaskCanceled is the protobuf endpoint of WorkflowHandler.RespondActivityTaskCanceled
+func (h *ProtoHandler) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *cadencepb.RespondActivityTaskCanceledRequest,
+) (*cadencepb.RespondActivityTaskCanceledResponse, error) {
+	if err := h.handler.RespondActivityTaskCanceled(ctx, protomapper.ToThriftRespondActivityTaskCanceledRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RespondActivityTaskCanceledResponse{}, nil
+}
+
+// RespondActivityTaskCanceledByID is the protobuf endpoint of WorkflowHandler.RespondActivityTaskCanceledByID
+func (h *ProtoHandler) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *cadencepb.RespondActivityTaskCanceledByIDRequest,
+) (*cadencepb.RespondActivityTaskCanceledByIDResponse, error) {
+	if err := h.handler.RespondActivityTaskCanceledByID(ctx, protomapper.ToThriftRespondActivityTaskCanceledByIDRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RespondActivityTaskCanceledByIDResponse{}, nil
+}
+
+// RequestCancelWorkflowExecution is the protobuf endpoint of WorkflowHandler.RequestCancelWorkflowExecution
+func (h *ProtoHandler) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *cadencepb.RequestCancelWorkflowExecutionRequest,
+) (*cadencepb.RequestCancelWorkflowExecutionResponse, error) {
+	if err := h.handler.RequestCancelWorkflowExecution(ctx, protomapper.ToThriftRequestCancelWorkflowExecutionRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RequestCancelWorkflowExecutionResponse{}, nil
+}
+
+// SignalWorkflowExecution is the protobuf endpoint of WorkflowHandler.SignalWorkflowExecution
+func (h *ProtoHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *cadencepb.SignalWorkflowExecutionRequest,
+) (*cadencepb.SignalWorkflowExecutionResponse, error) {
+	if err := h.handler.SignalWorkflowExecution(ctx, protomapper.ToThriftSignalWorkflowExecutionRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.SignalWorkflowExecutionResponse{}, nil
+}
+
+// SignalWithStartWorkflowExecution is the protobuf endpoint of WorkflowHandler.SignalWithStartWorkflowExecution
+func (h *ProtoHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *cadencepb.SignalWithStartWorkflowExecutionRequest,
+) (*cadencepb.StartWorkflowExecutionResponse, error) {
+	response, err := h.handler.SignalWithStartWorkflowExecution(ctx, protomapper.ToThriftSignalWithStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoStartWorkflowExecutionResponse(response), nil
+}
+
+// TerminateWorkflowExecution is the protobuf endpoint of WorkflowHandler.TerminateWorkflowExecution
+func (h *ProtoHandler) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *cadencepb.TerminateWorkflowExecutionRequest,
+) (*cadencepb.TerminateWorkflowExecutionResponse, error) {
+	if err := h.handler.TerminateWorkflowExecution(ctx, protomapper.ToThriftTerminateWorkflowExecutionRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.TerminateWorkflowExecutionResponse{}, nil
+}
+
+// ResetWorkflowExecution is the protobuf endpoint of WorkflowHandler.ResetWorkflowExecution
+func (h *ProtoHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	request *cadencepb.ResetWorkflowExecutionRequest,
+) (*cadencepb.ResetWorkflowExecutionResponse, error) {
+	response, err := h.handler.ResetWorkflowExecution(ctx, protomapper.ToThriftResetWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoResetWorkflowExecutionResponse(response), nil
+}
+
+// ListOpenWorkflowExecutions is the protobuf endpoint of WorkflowHandler.ListOpenWorkflowExecutions
+func (h *ProtoHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *cadencepb.ListOpenWorkflowExecutionsRequest,
+) (*cadencepb.ListOpenWorkflowExecutionsResponse, error) {
+	response, err := h.handler.ListOpenWorkflowExecutions(ctx, protomapper.ToThriftListOpenWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoListOpenWorkflowExecutionsResponse(response), nil
+}
+
+// ListClosedWorkflowExecutions is the protobuf endpoint of WorkflowHandler.ListClosedWorkflowExecutions
+func (h *ProtoHandler) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *cadencepb.ListClosedWorkflowExecutionsRequest,
+) (*cadencepb.ListClosedWorkflowExecutionsResponse, error) {
+	response, err := h.handler.ListClosedWorkflowExecutions(ctx, protomapper.ToThriftListClosedWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoListClosedWorkflowExecutionsResponse(response), nil
+}
+
+// ListWorkflowExecutions is the protobuf endpoint of WorkflowHandler.ListWorkflowExecutions
+func (h *ProtoHandler) ListWorkflowExecutions(
+	ctx context.Context,
+	request *cadencepb.ListWorkflowExecutionsRequest,
+) (*cadencepb.ListWorkflowExecutionsResponse, error) {
+	response, err := h.handler.ListWorkflowExecutions(ctx, protomapper.ToThriftListWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoListWorkflowExecutionsResponse(response), nil
+}
+
+// CountWorkflowExecutions is the protobuf endpoint of WorkflowHandler.CountWorkflowExecutions
+func (h *ProtoHandler) CountWorkflowExecutions(
+	ctx context.Context,
+	request *cadencepb.CountWorkflowExecutionsRequest,
+) (*cadencepb.CountWorkflowExecutionsResponse, error) {
+	response, err := h.handler.CountWorkflowExecutions(ctx, protomapper.ToThriftCountWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoCountWorkflowExecutionsResponse(response), nil
+}
+
+// RespondQueryTaskCompleted is the protobuf endpoint of WorkflowHandler.RespondQueryTaskCompleted
+func (h *ProtoHandler) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *cadencepb.RespondQueryTaskCompletedRequest,
+) (*cadencepb.RespondQueryTaskCompletedResponse, error) {
+	if err := h.handler.RespondQueryTaskCompleted(ctx, protomapper.ToThriftRespondQueryTaskCompletedRequest(request)); err != nil {
+		return nil, toProtoError(err)
+	}
+	return &cadencepb.RespondQueryTaskCompletedResponse{}, nil
+}
+
+// QueryWorkflow is the protobuf endpoint of WorkflowHandler.QueryWorkflow
+func (h *ProtoHandler) QueryWorkflow(
+	ctx context.Context,
+	request *cadencepb.QueryWorkflowRequest,
+) (*cadencepb.QueryWorkflowResponse, error) {
+	response, err := h.handler.QueryWorkflow(ctx, protomapper.ToThriftQueryWorkflowRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoQueryWorkflowResponse(response), nil
+}
+
+// DescribeWorkflowExecution is the protobuf endpoint of WorkflowHandler.DescribeWorkflowExecution
+func (h *ProtoHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *cadencepb.DescribeWorkflowExecutionRequest,
+) (*cadencepb.DescribeWorkflowExecutionResponse, error) {
+	response, err := h.handler.DescribeWorkflowExecution(ctx, protomapper.ToThriftDescribeWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoDescribeWorkflowExecutionResponse(response), nil
+}
+
+// DescribeTaskList is the protobuf endpoint of WorkflowHandler.DescribeTaskList
+func (h *ProtoHandler) DescribeTaskList(
+	ctx context.Context,
+	request *cadencepb.DescribeTaskListRequest,
+) (*cadencepb.DescribeTaskListResponse, error) {
+	response, err := h.handler.DescribeTaskList(ctx, protomapper.ToThriftDescribeTaskListRequest(request))
+	if err != nil {
+		return nil, toProtoError(err)
+	}
+	return protomapper.ToProtoDescribeTaskListResponse(response), nil
+}
+
+// toProtoError maps the thrift exceptions of the WorkflowService to the gRPC status codes documented in
+// cadence.proto, the exception message is kept as the status message
+func toProtoError(err error) error {
+	switch err := err.(type) {
+	case *gen.BadRequestError:
+		return yarpcerrors.InvalidArgumentErrorf("%v", err.Message)
+	case *gen.EntityNotExistsError:
+		return yarpcerrors.NotFoundErrorf("%v", err.Message)
+	case *gen.WorkflowExecutionAlreadyStartedError:
+		return yarpcerrors.AlreadyExistsErrorf("%v", err.GetMessage())
+	case *gen.DomainAlreadyExistsError:
+		return yarpcerrors.AlreadyExistsErrorf("%v", err.Message)
+	case *gen.CancellationAlreadyRequestedError:
+		return yarpcerrors.AlreadyExistsErrorf("%v", err.Message)
+	case *gen.ServiceBusyError:
+		return yarpcerrors.ResourceExhaustedErrorf("%v", err.Message)
+	case *gen.DomainNotActiveError:
+		return yarpcerrors.FailedPreconditionErrorf("%v", err.Message)
+	case *gen.QueryFailedError:
+		return yarpcerrors.FailedPreconditionErrorf("%v", err.Message)
+	case *gen.AccessDeniedError:
+		return yarpcerrors.PermissionDeniedErrorf("%v", err.Message)
+	case *gen.InternalServiceError:
+		return yarpcerrors.InternalErrorf("%v", err.Message)
+	case *yarpcerrors.Status:
+		return err
+	default:
+		return yarpcerrors.InternalErrorf("%v", err.Error())
+	}
+}
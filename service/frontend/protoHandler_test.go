@@ -0,0 +1,142 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/proto/cadencepb"
+	"github.com/uber/cadence/common"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+type (
+	protoHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+		thriftHandler *fakeThriftHandler
+		handler       *ProtoHandler
+	}
+
+	// fakeThriftHandler records the requests it gets, the APIs which are not overridden panic
+	fakeThriftHandler struct {
+		workflowserviceserver.Interface
+		startRequest    *gen.StartWorkflowExecutionRequest
+		completeRequest *gen.RespondDecisionTaskCompletedRequest
+		err             error
+	}
+)
+
+func TestProtoHandlerSuite(t *testing.T) {
+	s := new(protoHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *protoHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.thriftHandler = &fakeThriftHandler{}
+	s.handler = NewProtoHandler(s.thriftHandler)
+}
+
+func (s *protoHandlerSuite) TestStartWorkflowExecution() {
+	reusePolicy := cadencepb.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	response, err := s.handler.StartWorkflowExecution(context.Background(), &cadencepb.StartWorkflowExecutionRequest{
+		Domain:                common.StringPtr(testDomainName),
+		WorkflowId:            common.StringPtr("workflowID"),
+		WorkflowType:          &cadencepb.WorkflowType{Name: common.StringPtr("workflowType")},
+		Input:                 []byte("input"),
+		WorkflowIdReusePolicy: &reusePolicy,
+	})
+	s.NoError(err)
+	s.Equal("runID", response.GetRunId())
+
+	request := s.thriftHandler.startRequest
+	s.Equal(testDomainName, request.GetDomain())
+	s.Equal("workflowID", request.GetWorkflowId())
+	s.Equal("workflowType", request.WorkflowType.GetName())
+	s.Equal([]byte("input"), request.Input)
+	s.Equal(gen.WorkflowIdReusePolicyAllowDuplicate, request.GetWorkflowIdReusePolicy())
+	s.Nil(request.TaskList)
+	s.Nil(request.ExecutionStartToCloseTimeoutSeconds)
+}
+
+func (s *protoHandlerSuite) TestEmptyResponse() {
+	response, err := s.handler.RespondDecisionTaskCompleted(context.Background(),
+		&cadencepb.RespondDecisionTaskCompletedRequest{TaskToken: []byte("token")})
+	s.NoError(err)
+	s.NotNil(response)
+	s.Equal([]byte("token"), s.thriftHandler.completeRequest.TaskToken)
+}
+
+func (s *protoHandlerSuite) TestErrors() {
+	testCases := []struct {
+		err  error
+		code yarpcerrors.Code
+	}{
+		{&gen.BadRequestError{Message: "bad request"}, yarpcerrors.CodeInvalidArgument},
+		{&gen.EntityNotExistsError{Message: "not found"}, yarpcerrors.CodeNotFound},
+		{&gen.WorkflowExecutionAlreadyStartedError{Message: common.StringPtr("started")}, yarpcerrors.CodeAlreadyExists},
+		{&gen.DomainAlreadyExistsError{Message: "domain exists"}, yarpcerrors.CodeAlreadyExists},
+		{&gen.CancellationAlreadyRequestedError{Message: "cancel requested"}, yarpcerrors.CodeAlreadyExists},
+		{&gen.ServiceBusyError{Message: "busy"}, yarpcerrors.CodeResourceExhausted},
+		{&gen.DomainNotActiveError{Message: "not active"}, yarpcerrors.CodeFailedPrecondition},
+		{&gen.QueryFailedError{Message: "query failed"}, yarpcerrors.CodeFailedPrecondition},
+		{&gen.AccessDeniedError{Message: "denied"}, yarpcerrors.CodePermissionDenied},
+		{&gen.InternalServiceError{Message: "internal"}, yarpcerrors.CodeInternal},
+		{yarpcerrors.DeadlineExceededErrorf("deadline"), yarpcerrors.CodeDeadlineExceeded},
+		{errors.New("unknown"), yarpcerrors.CodeInternal},
+	}
+	for _, tc := range testCases {
+		s.thriftHandler.err = tc.err
+		_, err := s.handler.StartWorkflowExecution(context.Background(), &cadencepb.StartWorkflowExecutionRequest{})
+		status := yarpcerrors.FromError(err)
+		s.Equal(tc.code, status.Code(), "%T", tc.err)
+		s.NotEmpty(status.Message())
+
+		_, err = s.handler.RespondDecisionTaskCompleted(context.Background(), &cadencepb.RespondDecisionTaskCompletedRequest{})
+		s.Equal(tc.code, yarpcerrors.FromError(err).Code(), "%T", tc.err)
+	}
+}
+
+func (h *fakeThriftHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *gen.StartWorkflowExecutionRequest,
+) (*gen.StartWorkflowExecutionResponse, error) {
+	h.startRequest = request
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &gen.StartWorkflowExecutionResponse{RunId: common.StringPtr("runID")}, nil
+}
+
+func (h *fakeThriftHandler) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *gen.RespondDecisionTaskCompletedRequest,
+) error {
+	h.completeRequest = request
+	return h.err
+}
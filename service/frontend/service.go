@@ -21,30 +21,63 @@
 package frontend
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 // Config represents configuration for cadence-frontend service
 type Config struct {
+	NumHistoryShards int
+
 	DefaultVisibilityMaxPageSize int32
 	DefaultHistoryMaxPageSize    int32
 	RPS                          int
 
+	// Per domain rate limits, RPS above is kept as a global cap for the host
+	DomainRPS     dynamicconfig.IntPropertyFn
+	DomainPollRPS dynamicconfig.IntPropertyFn
+
 	// Persistence settings
 	HistoryMgrNumConns int
+
+	// Limits on the search attributes of a workflow execution
+	SearchAttributesNumberOfKeysLimit int
+	SearchAttributesSizeOfValueLimit  int
+	SearchAttributesTotalSizeLimit    int
+
+	// DomainHandoverPollInterval is how often a graceful failover checks the replication lag of the domain
+	DomainHandoverPollInterval time.Duration
+	// DomainHandoverScanInterval is how often the domains in handover are scanned to resume the graceful failovers
+	// not driven by this host, such as the ones of a stopped host
+	DomainHandoverScanInterval time.Duration
 }
 
 // NewConfig returns new service config with default values
-func NewConfig() *Config {
+func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int) *Config {
 	return &Config{
+		NumHistoryShards: numHistoryShards,
+
 		DefaultVisibilityMaxPageSize: 1000,
 		DefaultHistoryMaxPageSize:    1000,
 		RPS:                1200, // This limit is based on experimental runs.
 		HistoryMgrNumConns: 10,
+
+		DomainRPS:     dc.GetIntProperty(dynamicconfig.FrontendDomainRPS, 1200),
+		DomainPollRPS: dc.GetIntProperty(dynamicconfig.FrontendDomainPollRPS, 1200),
+
+		SearchAttributesNumberOfKeysLimit: 100,
+		SearchAttributesSizeOfValueLimit:  2 * 1024,
+		SearchAttributesTotalSizeLimit:    40 * 1024,
+
+		DomainHandoverPollInterval: 5 * time.Second,
+		DomainHandoverScanInterval: time.Minute,
 	}
 }
 
@@ -59,7 +92,7 @@ type Service struct {
 func NewService(params *service.BootstrapParams) common.Daemon {
 	return &Service{
 		params: params,
-		config: NewConfig(),
+		config: NewConfig(dynamicconfig.NewCollection(params.DynamicConfig, params.Logger), params.CassandraConfig.NumHistoryShards),
 		stopC:  make(chan struct{}),
 	}
 }
@@ -70,70 +103,66 @@ func (s *Service) Start() {
 	var p = s.params
 	var log = p.Logger
 
-	log.Infof("%v starting", common.FrontendServiceName)
+	log.Info("service starting", tag.Service(common.FrontendServiceName))
 
 	base := service.New(p)
 
-	metadata, err := persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.ClusterMetadata.GetCurrentClusterName(),
-		p.Logger)
+	pFactory := persistence.NewFactory(&p.PersistenceConfig, &p.CassandraConfig,
+		p.ClusterMetadata.GetCurrentClusterName(), p.Logger)
+	metadata, err := pFactory.NewMetadataManager()
 
 	if err != nil {
-		log.Fatalf("failed to create metadata manager: %v", err)
+		log.Fatal("failed to create metadata manager", tag.Error(err))
 	}
-	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient())
+	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient(), base.GetTracer())
 
-	visibility, err := persistence.NewCassandraVisibilityPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.VisibilityKeyspace,
-		p.Logger)
+	visibility, err := pFactory.NewVisibilityManager()
 
 	if err != nil {
-		log.Fatalf("failed to create visiblity manager: %v", err)
+		log.Fatal("failed to create visiblity manager", tag.Error(err))
 	}
-	visibility = persistence.NewVisibilityPersistenceClient(visibility, base.GetMetricsClient())
+	visibility = persistence.NewVisibilityPersistenceClient(visibility, base.GetMetricsClient(), base.GetTracer())
 
-	history, err := persistence.NewCassandraHistoryPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		s.config.HistoryMgrNumConns,
-		p.Logger)
+	history, err := pFactory.NewHistoryManager(s.config.HistoryMgrNumConns)
 
 	if err != nil {
-		log.Fatalf("Creating Cassandra history manager persistence failed: %v", err)
+		log.Fatal("Creating history manager persistence failed", tag.Error(err))
 	}
 
-	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient())
+	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient(), base.GetTracer())
 
 	// TODO when global domain is enabled, uncomment the line below and remove the line after
 	var kafkaProducer messaging.Producer
 	if base.GetClusterMetadata().IsGlobalDomainEnabled() {
 		kafkaProducer, err = base.GetMessagingClient().NewProducer(base.GetClusterMetadata().GetCurrentClusterName())
 		if err != nil {
-			log.Fatalf("Creating kafka producer failed: %v", err)
+			log.Fatal("Creating kafka producer failed", tag.Error(err))
 		}
 	} else {
 		kafkaProducer = &mocks.KafkaProducer{}
 	}
 
-	handler := NewWorkflowHandler(base, s.config, metadata, history, visibility, kafkaProducer)
+	handler := NewWorkflowHandler(base, s.config, metadata, history, visibility, kafkaProducer, p.Authorizer)
+	adminHandler := NewAdminHandler(base, p.CassandraConfig.NumHistoryShards, metadata, p.Authorizer)
+	adminHandler.RegisterHandler()
 	handler.Start()
+	adminHandler.Start()
+
+	var gateway *HTTPGateway
+	if len(p.HTTPGatewayAddress) > 0 {
+		gateway = NewHTTPGateway(handler, p.HTTPGatewayAddress, p.HTTPGatewayTLSConfig, p.Logger)
+		if err := gateway.Start(); err != nil {
+			log.Fatal("Starting http gateway failed", tag.Error(err))
+		}
+	}
 
-	log.Infof("%v started", common.FrontendServiceName)
+	log.Info("service started", tag.Service(common.FrontendServiceName))
 
 	<-s.stopC
 
+	if gateway != nil {
+		gateway.Stop()
+	}
 	base.Stop()
 }
 
@@ -143,5 +172,5 @@ func (s *Service) Stop() {
 	case s.stopC <- struct{}{}:
 	default:
 	}
-	s.params.Logger.Infof("%v stopped", common.FrontendServiceName)
+	s.params.Logger.Info("service stopped", tag.Service(common.FrontendServiceName))
 }
@@ -0,0 +1,311 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	queryTokenIdentifier queryTokenType = iota
+	queryTokenString
+	queryTokenNumber
+	queryTokenOperator
+	queryTokenEOF
+)
+
+type (
+	queryTokenType int
+
+	queryToken struct {
+		tokenType queryTokenType
+		text      string
+		position  int
+	}
+
+	// visibilityQueryParser parses the query of a ListWorkflowExecutions request.  A query is a conjunction
+	// of predicates optionally followed by an ORDER BY clause, for example:
+	//   WorkflowType = 'X' AND CloseStatus = 'Failed' AND StartTime > '2018-10-01T00:00:00Z' ORDER BY CloseTime
+	// Fields other than the ones of the execution records are the keys of search attributes, whose values
+	// are compared with the bytes of the literal.  Times are either RFC3339 strings or unix nanoseconds.
+	visibilityQueryParser struct {
+		tokens []queryToken
+		next   int
+	}
+)
+
+var queryOperators = map[string]persistence.VisibilityQueryOperator{
+	"=":  persistence.VisibilityQueryOperatorEqual,
+	"!=": persistence.VisibilityQueryOperatorNotEqual,
+	"<>": persistence.VisibilityQueryOperatorNotEqual,
+	"<":  persistence.VisibilityQueryOperatorLessThan,
+	"<=": persistence.VisibilityQueryOperatorLessThanOrEqual,
+	">":  persistence.VisibilityQueryOperatorGreaterThan,
+	">=": persistence.VisibilityQueryOperatorGreaterThanOrEqual,
+}
+
+// parseVisibilityQuery validates the query and converts it into the form understood by the visibility store
+func parseVisibilityQuery(query string) (*persistence.VisibilityQuery, error) {
+	tokens, err := tokenizeVisibilityQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &visibilityQueryParser{tokens: tokens}
+	return p.parse()
+}
+
+func (p *visibilityQueryParser) parse() (*persistence.VisibilityQuery, error) {
+	result := &persistence.VisibilityQuery{OrderBy: persistence.VisibilityQueryFieldStartTime}
+	if p.peek().tokenType == queryTokenEOF {
+		return result, nil
+	}
+
+	if !p.isKeyword("ORDER") {
+		for {
+			predicate, err := p.parsePredicate()
+			if err != nil {
+				return nil, err
+			}
+			result.Predicates = append(result.Predicates, predicate)
+			if !p.isKeyword("AND") {
+				break
+			}
+			p.advance()
+		}
+	}
+
+	if p.isKeyword("ORDER") {
+		p.advance()
+		if !p.isKeyword("BY") {
+			return nil, p.errorf(p.peek(), "expected BY")
+		}
+		p.advance()
+		field := p.advance()
+		if field.text != persistence.VisibilityQueryFieldStartTime && field.text != persistence.VisibilityQueryFieldCloseTime {
+			return nil, p.errorf(field, "executions can only be ordered by %v or %v",
+				persistence.VisibilityQueryFieldStartTime, persistence.VisibilityQueryFieldCloseTime)
+		}
+		result.OrderBy = field.text
+		if p.isKeyword("ASC") {
+			p.advance()
+			result.Ascending = true
+		} else if p.isKeyword("DESC") {
+			p.advance()
+		}
+	}
+
+	if token := p.peek(); token.tokenType != queryTokenEOF {
+		if p.isKeyword("OR") {
+			return nil, p.errorf(token, "only AND is supported to combine predicates")
+		}
+		return nil, p.errorf(token, "unexpected %q", token.text)
+	}
+	return result, nil
+}
+
+func (p *visibilityQueryParser) parsePredicate() (*persistence.VisibilityQueryPredicate, error) {
+	field := p.advance()
+	if field.tokenType != queryTokenIdentifier || isQueryKeyword(field.text) {
+		return nil, p.errorf(field, "expected a field name")
+	}
+	operatorToken := p.advance()
+	operator, ok := queryOperators[operatorToken.text]
+	if operatorToken.tokenType != queryTokenOperator || !ok {
+		return nil, p.errorf(operatorToken, "expected a comparison operator")
+	}
+	value := p.advance()
+	if value.tokenType != queryTokenString && value.tokenType != queryTokenNumber {
+		return nil, p.errorf(value, "expected a value")
+	}
+
+	predicate := &persistence.VisibilityQueryPredicate{Field: field.text, Operator: operator}
+	isEquality := operator == persistence.VisibilityQueryOperatorEqual ||
+		operator == persistence.VisibilityQueryOperatorNotEqual
+	switch field.text {
+	case persistence.VisibilityQueryFieldWorkflowID,
+		persistence.VisibilityQueryFieldRunID,
+		persistence.VisibilityQueryFieldWorkflowType:
+		if !isEquality || value.tokenType != queryTokenString {
+			return nil, p.errorf(field, "%v can only be compared for equality with a string", field.text)
+		}
+		predicate.Value = value.text
+
+	case persistence.VisibilityQueryFieldStartTime, persistence.VisibilityQueryFieldCloseTime:
+		timestamp, err := parseQueryTime(value)
+		if err != nil {
+			return nil, p.errorf(value, "invalid time %q, expected RFC3339 or unix nanoseconds", value.text)
+		}
+		predicate.Value = timestamp
+
+	case persistence.VisibilityQueryFieldCloseStatus:
+		status, err := parseQueryCloseStatus(value)
+		if !isEquality || err != nil {
+			return nil, p.errorf(field, "%v can only be compared for equality with a close status", field.text)
+		}
+		predicate.Value = status
+
+	case persistence.VisibilityQueryFieldExecutionStatus:
+		var status string
+		switch {
+		case strings.EqualFold(value.text, persistence.VisibilityExecutionStatusOpen):
+			status = persistence.VisibilityExecutionStatusOpen
+		case strings.EqualFold(value.text, persistence.VisibilityExecutionStatusClosed):
+			status = persistence.VisibilityExecutionStatusClosed
+		}
+		if !isEquality || status == "" {
+			return nil, p.errorf(field, "%v can only be compared for equality with %q or %q", field.text,
+				persistence.VisibilityExecutionStatusOpen, persistence.VisibilityExecutionStatusClosed)
+		}
+		predicate.Value = status
+
+	default:
+		predicate.SearchAttribute = true
+		predicate.Value = []byte(value.text)
+	}
+	return predicate, nil
+}
+
+func (p *visibilityQueryParser) peek() queryToken {
+	return p.tokens[p.next]
+}
+
+func (p *visibilityQueryParser) advance() queryToken {
+	token := p.tokens[p.next]
+	if token.tokenType != queryTokenEOF {
+		p.next++
+	}
+	return token
+}
+
+func (p *visibilityQueryParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.tokenType == queryTokenIdentifier && strings.EqualFold(token.text, keyword)
+}
+
+func (p *visibilityQueryParser) errorf(token queryToken, format string, args ...interface{}) error {
+	return &gen.BadRequestError{
+		Message: fmt.Sprintf("Invalid query at position %v: %v", token.position, fmt.Sprintf(format, args...)),
+	}
+}
+
+func isQueryKeyword(text string) bool {
+	switch strings.ToUpper(text) {
+	case "AND", "OR", "ORDER", "BY", "ASC", "DESC":
+		return true
+	}
+	return false
+}
+
+func parseQueryTime(value queryToken) (int64, error) {
+	if value.tokenType == queryTokenNumber {
+		return strconv.ParseInt(value.text, 10, 64)
+	}
+	t, err := time.Parse(time.RFC3339Nano, value.text)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano(), nil
+}
+
+// parseQueryCloseStatus accepts the close status by value or by name, e.g. 'Failed', 'TIMED_OUT' or 'TimedOut'
+func parseQueryCloseStatus(value queryToken) (gen.WorkflowExecutionCloseStatus, error) {
+	if value.tokenType == queryTokenNumber {
+		status, err := strconv.ParseInt(value.text, 10, 32)
+		return gen.WorkflowExecutionCloseStatus(status), err
+	}
+	name := strings.ToUpper(strings.Replace(value.text, "_", "", -1))
+	for _, status := range gen.WorkflowExecutionCloseStatus_Values() {
+		if strings.Replace(status.String(), "_", "", -1) == name {
+			return status, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown close status %q", value.text)
+}
+
+func tokenizeVisibilityQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case unicode.IsLetter(r) || r == '_':
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, queryToken{tokenType: queryTokenIdentifier, text: string(runes[start:i]), position: start})
+
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			i++
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, queryToken{tokenType: queryTokenNumber, text: string(runes[start:i]), position: start})
+
+		case r == '\'':
+			// quotes are escaped by doubling them
+			var text []rune
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, &gen.BadRequestError{
+						Message: fmt.Sprintf("Invalid query at position %v: unterminated string", start),
+					}
+				}
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						text = append(text, '\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				text = append(text, runes[i])
+				i++
+			}
+			tokens = append(tokens, queryToken{tokenType: queryTokenString, text: string(text), position: start})
+
+		case strings.ContainsRune("=!<>", r):
+			i++
+			if i < len(runes) && strings.ContainsRune("=>", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, queryToken{tokenType: queryTokenOperator, text: string(runes[start:i]), position: start})
+
+		default:
+			return nil, &gen.BadRequestError{
+				Message: fmt.Sprintf("Invalid query at position %v: unexpected character %q", start, r),
+			}
+		}
+	}
+	return append(tokens, queryToken{tokenType: queryTokenEOF, position: len(runes)}), nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	visibilityQueryParserSuite struct {
+		suite.Suite
+	}
+)
+
+func TestVisibilityQueryParserSuite(t *testing.T) {
+	s := new(visibilityQueryParserSuite)
+	suite.Run(t, s)
+}
+
+func (s *visibilityQueryParserSuite) TestEmptyQuery() {
+	query, err := parseVisibilityQuery("  ")
+	s.Nil(err)
+	s.Empty(query.Predicates)
+	s.Equal(persistence.VisibilityQueryFieldStartTime, query.OrderBy)
+	s.False(query.Ascending)
+}
+
+func (s *visibilityQueryParserSuite) TestPredicates() {
+	startTime, _ := time.Parse(time.RFC3339, "2018-10-01T00:00:00Z")
+	query, err := parseVisibilityQuery(
+		"WorkflowType = 'it''s' and CloseStatus = 'TimedOut' AND StartTime > '2018-10-01T00:00:00Z' " +
+			"AND CloseTime <= 100 AND CustomerID != 'c-1' ORDER BY CloseTime asc")
+	s.Nil(err)
+	s.Equal(persistence.VisibilityQueryFieldCloseTime, query.OrderBy)
+	s.True(query.Ascending)
+	s.Equal([]*persistence.VisibilityQueryPredicate{
+		{
+			Field:    persistence.VisibilityQueryFieldWorkflowType,
+			Operator: persistence.VisibilityQueryOperatorEqual,
+			Value:    "it's",
+		},
+		{
+			Field:    persistence.VisibilityQueryFieldCloseStatus,
+			Operator: persistence.VisibilityQueryOperatorEqual,
+			Value:    shared.WorkflowExecutionCloseStatusTimedOut,
+		},
+		{
+			Field:    persistence.VisibilityQueryFieldStartTime,
+			Operator: persistence.VisibilityQueryOperatorGreaterThan,
+			Value:    startTime.UnixNano(),
+		},
+		{
+			Field:    persistence.VisibilityQueryFieldCloseTime,
+			Operator: persistence.VisibilityQueryOperatorLessThanOrEqual,
+			Value:    int64(100),
+		},
+		{
+			Field:           "CustomerID",
+			SearchAttribute: true,
+			Operator:        persistence.VisibilityQueryOperatorNotEqual,
+			Value:           []byte("c-1"),
+		},
+	}, query.Predicates)
+}
+
+func (s *visibilityQueryParserSuite) TestOrderByOnly() {
+	query, err := parseVisibilityQuery("ORDER BY StartTime DESC")
+	s.Nil(err)
+	s.Empty(query.Predicates)
+	s.Equal(persistence.VisibilityQueryFieldStartTime, query.OrderBy)
+	s.False(query.Ascending)
+}
+
+func (s *visibilityQueryParserSuite) TestInvalidQueries() {
+	queries := []string{
+		"WorkflowType = 'X' OR WorkflowType = 'Y'",
+		"WorkflowType > 'X'",
+		"WorkflowID = 10",
+		"CloseStatus = 'Unknown'",
+		"ExecutionStatus = 'Running'",
+		"StartTime > 'yesterday'",
+		"WorkflowType = 'X",
+		"WorkflowType = 'X' AND",
+		"WorkflowType 'X'",
+		"WorkflowType => 'X'",
+		"ORDER BY WorkflowType",
+		"ORDER StartTime",
+		"WorkflowType = 'X' ORDER BY StartTime LIMIT 10",
+		"(WorkflowType = 'X')",
+	}
+	for _, q := range queries {
+		_, err := parseVisibilityQuery(q)
+		s.IsType(&shared.BadRequestError{}, err, q)
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archival"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	testDomainName     = "test-domain"
+	testArchivalBucket = "test-bucket"
+)
+
+type (
+	workflowHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+		dir                 string
+		domainID            string
+		blobstoreClient     blobstore.Client
+		mockMetadataMgr     *mocks.MetadataManager
+		mockClusterMetadata *mocks.ClusterMetadata
+		authorizer          *fakeAuthorizer
+		handler             *WorkflowHandler
+	}
+
+	// fakeAuthorizer records the attributes of the calls and returns the configured decision
+	fakeAuthorizer struct {
+		decision   authorization.Decision
+		attributes []*authorization.Attributes
+	}
+
+	// archivalTestService is a test service with a blob store client
+	archivalTestService struct {
+		service.Service
+		blobstoreClient blobstore.Client
+	}
+)
+
+func TestWorkflowHandlerSuite(t *testing.T) {
+	s := new(workflowHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "workflowHandlerSuite")
+	s.Nil(err)
+	s.dir = dir
+	s.blobstoreClient, err = filestore.NewClient(&filestore.Config{StoreDirectory: dir})
+	s.Nil(err)
+
+	s.domainID = uuid.New()
+	s.mockMetadataMgr = &mocks.MetadataManager{}
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
+		Info: &persistence.DomainInfo{ID: s.domainID, Name: testDomainName},
+		Config: &persistence.DomainConfig{
+			Retention:      1,
+			ArchivalBucket: testArchivalBucket,
+			ArchivalStatus: gen.ArchivalStatusEnabled,
+		},
+		ReplicationConfig: &persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+			},
+		},
+	}, nil)
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+
+	logger := logging.NewNopLogger()
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
+	testService := &archivalTestService{
+		Service:         service.NewTestService(s.mockClusterMetadata, nil, metricsClient, logger),
+		blobstoreClient: s.blobstoreClient,
+	}
+	s.authorizer = &fakeAuthorizer{decision: authorization.DecisionAllow}
+	s.handler = NewWorkflowHandler(testService, NewConfig(dynamicconfig.NewNopCollection(), 1), s.mockMetadataMgr,
+		&mocks.HistoryManager{}, &mocks.VisibilityManager{}, nil, s.authorizer)
+	s.handler.metricsClient = metricsClient
+	s.handler.startWG.Done()
+}
+
+func (s *workflowHandlerSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *workflowHandlerSuite) TestGetArchivedHistory() {
+	execution := &gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("archived-workflow"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	s.uploadHistory(execution, [][]int64{{1, 2, 3}, {4, 5}})
+
+	var eventIDs []int64
+	var pageTokens []*getHistoryContinuationToken
+	token := &getHistoryContinuationToken{RunID: execution.GetRunId(), IsArchived: true}
+	for {
+		response, err := s.handler.getArchivedHistory(context.Background(), testDomainName, s.domainID, execution,
+			token, 2, false)
+		s.Nil(err)
+		s.NotNil(response)
+		s.True(len(response.History.Events) <= 2)
+		for _, e := range response.History.Events {
+			eventIDs = append(eventIDs, e.GetEventId())
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		token, err = deserializeHistoryToken(response.NextPageToken)
+		s.Nil(err)
+		pageTokens = append(pageTokens, token)
+	}
+	s.Equal([]int64{1, 2, 3, 4, 5}, eventIDs)
+	s.Equal(2, len(pageTokens))
+	s.Equal(0, pageTokens[0].ArchivePage)
+	s.Equal(2, pageTokens[0].ArchiveOffset)
+	s.Equal(1, pageTokens[1].ArchivePage)
+	s.Equal(0, pageTokens[1].ArchiveOffset)
+}
+
+func (s *workflowHandlerSuite) TestGetArchivedHistory_CloseEventOnly() {
+	execution := &gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("archived-workflow"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	s.uploadHistory(execution, [][]int64{{1, 2, 3}, {4, 5}})
+
+	response, err := s.handler.getArchivedHistory(context.Background(), testDomainName, s.domainID, execution,
+		&getHistoryContinuationToken{RunID: execution.GetRunId(), IsArchived: true}, 100, true)
+	s.Nil(err)
+	s.Equal(1, len(response.History.Events))
+	s.Equal(int64(5), response.History.Events[0].GetEventId())
+	s.Empty(response.NextPageToken)
+}
+
+func (s *workflowHandlerSuite) TestGetArchivedHistory_NotArchived() {
+	execution := &gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("archived-workflow"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	response, err := s.handler.getArchivedHistory(context.Background(), testDomainName, s.domainID, execution,
+		&getHistoryContinuationToken{RunID: execution.GetRunId(), IsArchived: true}, 100, false)
+	s.Nil(err)
+	s.Nil(response)
+}
+
+func (s *workflowHandlerSuite) TestAccessDenied() {
+	s.authorizer.decision = authorization.DecisionDeny
+	ctx := context.Background()
+	execution := &gen.WorkflowExecution{WorkflowId: common.StringPtr("test-workflow")}
+	taskToken, err := common.NewJSONTaskTokenSerializer().Serialize(&common.TaskToken{
+		DomainID:   s.domainID,
+		WorkflowID: "test-workflow",
+		ScheduleID: 2,
+	})
+	s.Nil(err)
+
+	testCases := []struct {
+		apiName string
+		call    func() error
+	}{
+		{"RegisterDomain", func() error {
+			return s.handler.RegisterDomain(ctx, &gen.RegisterDomainRequest{Name: common.StringPtr(testDomainName)})
+		}},
+		{"DescribeDomain", func() error {
+			_, err := s.handler.DescribeDomain(ctx, &gen.DescribeDomainRequest{Name: common.StringPtr(testDomainName)})
+			return err
+		}},
+		{"StartWorkflowExecution", func() error {
+			_, err := s.handler.StartWorkflowExecution(ctx, &gen.StartWorkflowExecutionRequest{
+				Domain:     common.StringPtr(testDomainName),
+				WorkflowId: common.StringPtr("test-workflow"),
+			})
+			return err
+		}},
+		{"SignalWorkflowExecution", func() error {
+			return s.handler.SignalWorkflowExecution(ctx, &gen.SignalWorkflowExecutionRequest{
+				Domain:            common.StringPtr(testDomainName),
+				WorkflowExecution: execution,
+			})
+		}},
+		{"GetWorkflowExecutionHistory", func() error {
+			_, err := s.handler.GetWorkflowExecutionHistory(ctx, &gen.GetWorkflowExecutionHistoryRequest{
+				Domain:    common.StringPtr(testDomainName),
+				Execution: execution,
+			})
+			return err
+		}},
+		{"PollForDecisionTask", func() error {
+			_, err := s.handler.PollForDecisionTask(ctx, &gen.PollForDecisionTaskRequest{
+				Domain:   common.StringPtr(testDomainName),
+				TaskList: &gen.TaskList{Name: common.StringPtr("test-tasklist")},
+			})
+			return err
+		}},
+		{"RespondDecisionTaskCompleted", func() error {
+			return s.handler.RespondDecisionTaskCompleted(ctx, &gen.RespondDecisionTaskCompletedRequest{
+				TaskToken: taskToken,
+			})
+		}},
+	}
+	for _, tc := range testCases {
+		s.authorizer.attributes = nil
+		err := tc.call()
+		s.IsType(&gen.AccessDeniedError{}, err, tc.apiName)
+		s.Equal(1, len(s.authorizer.attributes), tc.apiName)
+		s.Equal(tc.apiName, s.authorizer.attributes[0].APIName)
+		s.Equal(testDomainName, s.authorizer.attributes[0].DomainName)
+	}
+}
+
+func (s *workflowHandlerSuite) uploadHistory(execution *gen.WorkflowExecution, pages [][]int64) {
+	for i, eventIDs := range pages {
+		historyBlob := &archival.HistoryBlob{
+			DomainID:   s.domainID,
+			WorkflowID: execution.GetWorkflowId(),
+			RunID:      execution.GetRunId(),
+			Page:       i,
+			IsLast:     i == len(pages)-1,
+		}
+		if i == 0 {
+			historyBlob.PageCount = len(pages)
+		}
+		for _, id := range eventIDs {
+			historyBlob.Events = append(historyBlob.Events, &gen.HistoryEvent{EventId: common.Int64Ptr(id)})
+		}
+		s.Nil(archival.UploadHistoryBlob(context.Background(), s.blobstoreClient, testArchivalBucket, historyBlob))
+	}
+}
+
+// TestHTTPGatewayAuthorization calls the handler through the HTTP gateway with the role based authorizer, the
+// identity of the caller is read from an HTTP header
+func (s *workflowHandlerSuite) TestHTTPGatewayAuthorization() {
+	s.handler.authorizer = authorization.NewAuthorizer(&authorization.Config{
+		Enable:         true,
+		IdentityHeader: "Cadence-Identity",
+		Grants: map[string]map[string]authorization.Role{
+			"reader": {testDomainName: authorization.RoleRead},
+		},
+	})
+	mockHistoryClient := &mocks.HistoryClient{}
+	mockHistoryClient.On("DescribeWorkflowExecution", mock.Anything, mock.Anything).Return(
+		nil, &gen.EntityNotExistsError{Message: "Workflow execution not found."})
+	s.handler.history = mockHistoryClient
+	server := httptest.NewServer(NewHTTPGateway(s.handler, "", nil, logging.NewNopLogger()))
+	defer server.Close()
+
+	workflowsURL := server.URL + "/api/v1/domains/" + testDomainName + "/workflows"
+	testCases := []struct {
+		method   string
+		url      string
+		identity string
+		status   int
+	}{
+		{http.MethodGet, workflowsURL + "/test-workflow", "", http.StatusForbidden},
+		{http.MethodGet, workflowsURL + "/test-workflow", "writer", http.StatusForbidden},
+		{http.MethodGet, workflowsURL + "/test-workflow", "reader", http.StatusNotFound},
+		{http.MethodPost, workflowsURL, "reader", http.StatusForbidden},
+	}
+	for _, tc := range testCases {
+		request, err := http.NewRequest(tc.method, tc.url, bytes.NewBufferString(`{"workflowId": "test-workflow"}`))
+		s.NoError(err)
+		if tc.identity != "" {
+			request.Header.Set("Cadence-Identity", tc.identity)
+		}
+		resp, err := http.DefaultClient.Do(request)
+		s.NoError(err)
+		resp.Body.Close()
+		s.Equal(tc.status, resp.StatusCode, "%v %v as %v", tc.method, tc.url, tc.identity)
+	}
+	mockHistoryClient.AssertNumberOfCalls(s.T(), "DescribeWorkflowExecution", 1)
+}
+
+func (s *archivalTestService) GetBlobstoreClient() blobstore.Client {
+	return s.blobstoreClient
+}
+
+func (a *fakeAuthorizer) Authorize(ctx context.Context, attributes *authorization.Attributes) (authorization.Decision, error) {
+	a.attributes = append(a.attributes, attributes)
+	return a.decision, nil
+}
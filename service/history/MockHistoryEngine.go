@@ -89,6 +89,29 @@ func (_m *MockHistoryEngine) GetMutableState(ctx context.Context, request *gohis
 	return r0, r1
 }
 
+// DescribeMutableState is mock implementation for DescribeMutableState of HistoryEngine
+func (_m *MockHistoryEngine) DescribeMutableState(ctx context.Context, request *gohistory.DescribeMutableStateRequest) (*gohistory.DescribeMutableStateResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *gohistory.DescribeMutableStateResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.DescribeMutableStateRequest) *gohistory.DescribeMutableStateResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.DescribeMutableStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.DescribeMutableStateRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResetStickyTaskList is mock implementation for ResetStickyTaskList of HistoryEngine
 func (_m *MockHistoryEngine) ResetStickyTaskList(request *gohistory.ResetStickyTaskListRequest) (*gohistory.ResetStickyTaskListResponse, error) {
 	ret := _m.Called(request)
@@ -354,6 +377,30 @@ func (_m *MockHistoryEngine) TerminateWorkflowExecution(request *gohistory.Termi
 	return r0
 }
 
+// ResetWorkflowExecution is mock implementation for ResetWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ResetWorkflowExecution(request *gohistory.ResetWorkflowExecutionRequest) (
+	*shared.ResetWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.ResetWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ResetWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.ResetWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ScheduleDecisionTask is mock implementation for ScheduleDecisionTask of HistoryEngine
 func (_m *MockHistoryEngine) ScheduleDecisionTask(request *gohistory.ScheduleDecisionTaskRequest) error {
 	ret := _m.Called(request)
@@ -396,4 +443,96 @@ func (_m *MockHistoryEngine) ReplicateEvents(request *gohistory.ReplicateEventsR
 	return r0
 }
 
+// GetReplicationMessages is mock implementation for GetReplicationMessages of HistoryEngine
+func (_m *MockHistoryEngine) GetReplicationMessages(request *gohistory.GetReplicationMessagesRequest) (*gohistory.GetReplicationMessagesResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.GetReplicationMessagesResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.GetReplicationMessagesRequest) *gohistory.GetReplicationMessagesResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.GetReplicationMessagesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.GetReplicationMessagesRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplicationStatus is mock implementation for GetReplicationStatus of HistoryEngine
+func (_m *MockHistoryEngine) GetReplicationStatus(request *gohistory.GetReplicationStatusRequest) (*gohistory.ShardReplicationStatus, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.ShardReplicationStatus
+	if rf, ok := ret.Get(0).(func(*gohistory.GetReplicationStatusRequest) *gohistory.ShardReplicationStatus); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.ShardReplicationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.GetReplicationStatusRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDomainReplicationLag is mock implementation for GetDomainReplicationLag of HistoryEngine
+func (_m *MockHistoryEngine) GetDomainReplicationLag(request *gohistory.GetDomainReplicationLagRequest) (*gohistory.GetDomainReplicationLagResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.GetDomainReplicationLagResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.GetDomainReplicationLagRequest) *gohistory.GetDomainReplicationLagResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.GetDomainReplicationLagResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.GetDomainReplicationLagRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkflowReplicationTasks is mock implementation for GetWorkflowReplicationTasks of HistoryEngine
+func (_m *MockHistoryEngine) GetWorkflowReplicationTasks(request *gohistory.GetWorkflowReplicationTasksRequest) (*gohistory.GetWorkflowReplicationTasksResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.GetWorkflowReplicationTasksResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.GetWorkflowReplicationTasksRequest) *gohistory.GetWorkflowReplicationTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.GetWorkflowReplicationTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.GetWorkflowReplicationTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
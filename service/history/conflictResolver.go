@@ -22,9 +22,9 @@ package history
 
 import (
 	"github.com/pborman/uuid"
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -34,18 +34,19 @@ type (
 		context            *workflowExecutionContext
 		historyMgr         persistence.HistoryManager
 		hSerializerFactory persistence.HistorySerializerFactory
-		logger             bark.Logger
+		logger             logging.Logger
 	}
 )
 
 func newConflictResolver(shard ShardContext, context *workflowExecutionContext, historyMgr persistence.HistoryManager,
-	logger bark.Logger) *conflictResolver {
+	logger logging.Logger) *conflictResolver {
 
 	return &conflictResolver{
-		shard:      shard,
-		context:    context,
-		historyMgr: historyMgr,
-		logger:     logger,
+		shard:              shard,
+		context:            context,
+		historyMgr:         historyMgr,
+		hSerializerFactory: persistence.NewHistorySerializerFactory(),
+		logger:             logger,
 	}
 }
 
@@ -54,28 +55,28 @@ func (r *conflictResolver) reset(replayEventID int64) (*mutableStateBuilder, err
 	execution := r.context.workflowExecution
 	replayNextEventID := replayEventID + 1
 	var nextPageToken []byte
-	var history *shared.History
+	var batches []*shared.History
 	var err error
 	var resetMutableStateBuilder *mutableStateBuilder
 	var sBuilder *stateBuilder
 	requestID := uuid.New()
 	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
-		history, nextPageToken, err = r.getHistory(domainID, execution, common.FirstEventID, replayNextEventID,
-			nextPageToken)
+		batches, nextPageToken, err = getHistoryBatches(r.historyMgr, r.hSerializerFactory, domainID, execution,
+			common.FirstEventID, replayNextEventID, nextPageToken)
 		if err != nil {
 			return nil, err
 		}
 
-		for _, event := range history.Events {
-			if event.GetEventId() == common.FirstEventID {
+		for _, batch := range batches {
+			firstEvent := batch.Events[0]
+			if firstEvent.GetEventId() == common.FirstEventID {
 				resetMutableStateBuilder = newMutableStateBuilderWithReplicationState(r.shard.GetConfig(), r.logger,
-					event.GetVersion())
+					firstEvent.GetVersion())
 
 				sBuilder = newStateBuilder(r.shard, resetMutableStateBuilder, r.logger)
 			}
 
-			_, _, _, err = sBuilder.applyEvents(common.EmptyVersion, "", domainID, requestID, execution, history, nil)
-			if err != nil {
+			if err := replayHistoryBatch(sBuilder, domainID, requestID, execution, batch); err != nil {
 				return nil, err
 			}
 		}
@@ -83,35 +84,3 @@ func (r *conflictResolver) reset(replayEventID int64) (*mutableStateBuilder, err
 
 	return r.context.resetWorkflowExecution(resetMutableStateBuilder)
 }
-
-func (r *conflictResolver) getHistory(domainID string, execution shared.WorkflowExecution, firstEventID,
-	nextEventID int64, nextPageToken []byte) (*shared.History, []byte, error) {
-
-	response, err := r.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
-		DomainID:      domainID,
-		Execution:     execution,
-		FirstEventID:  firstEventID,
-		NextEventID:   nextEventID,
-		PageSize:      defaultHistoryPageSize,
-		NextPageToken: nextPageToken,
-	})
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	historyEvents := []*shared.HistoryEvent{}
-	for _, e := range response.Events {
-		persistence.SetSerializedHistoryDefaults(&e)
-		s, _ := r.hSerializerFactory.Get(e.EncodingType)
-		history, err1 := s.Deserialize(&e)
-		if err1 != nil {
-			return nil, nil, err1
-		}
-		historyEvents = append(historyEvents, history.Events...)
-	}
-
-	executionHistory := &shared.History{}
-	executionHistory.Events = historyEvents
-	return executionHistory, nextPageToken, nil
-}
@@ -0,0 +1,228 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	conflictResolverSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		mockExecutionMgr    *mocks.ExecutionManager
+		mockHistoryMgr      *mocks.HistoryManager
+		mockMetadataMgr     *mocks.MetadataManager
+		mockClusterMetadata *mocks.ClusterMetadata
+		config              *Config
+		logger              logging.Logger
+		shard               *shardContextImpl
+		domainID            string
+		execution           workflow.WorkflowExecution
+		context             *workflowExecutionContext
+		conflictResolver    *conflictResolver
+	}
+)
+
+func TestConflictResolverSuite(t *testing.T) {
+	s := new(conflictResolverSuite)
+	suite.Run(t, s)
+}
+
+func (s *conflictResolverSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+
+	s.logger = logging.NewDevelopmentLogger()
+	s.config = NewConfig(dynamicconfig.NewNopCollection(), 1)
+}
+
+func (s *conflictResolverSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockHistoryMgr = &mocks.HistoryManager{}
+	s.mockMetadataMgr = &mocks.MetadataManager{}
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestAllClusterFailoverVersions)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+
+	s.shard = &shardContextImpl{
+		service:                   service.NewTestService(s.mockClusterMetadata, nil, metricsClient, s.logger),
+		shardInfo:                 &persistence.ShardInfo{ShardID: 0, RangeID: 1, TransferAckLevel: 0},
+		transferSequenceNumber:    1,
+		executionManager:          s.mockExecutionMgr,
+		historyMgr:                s.mockHistoryMgr,
+		domainCache:               cache.NewDomainCache(s.mockMetadataMgr, s.mockClusterMetadata, s.logger),
+		maxTransferSequenceNumber: 100000,
+		closeCh:                   make(chan int, 100),
+		config:                    s.config,
+		logger:                    s.logger,
+		metricsClient:             metricsClient,
+	}
+	s.domainID = validDomainID
+	s.execution = workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("conflict-resolver-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	s.context = newWorkflowExecutionContext(s.domainID, s.execution, s.shard, s.mockExecutionMgr, s.logger)
+	s.conflictResolver = newConflictResolver(s.shard, s.context, s.mockHistoryMgr, s.logger)
+}
+
+func (s *conflictResolverSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockHistoryMgr.AssertExpectations(s.T())
+}
+
+func (s *conflictResolverSuite) TestReset() {
+	now := time.Now().UnixNano()
+	taskList := &workflow.TaskList{Name: common.StringPtr("conflict-resolver-tasklist")}
+	startedBatch := []*workflow.HistoryEvent{{
+		EventId:   common.Int64Ptr(1),
+		Version:   common.Int64Ptr(cluster.TestCurrentClusterInitialFailoverVersion),
+		Timestamp: common.Int64Ptr(now),
+		EventType: workflow.EventTypeWorkflowExecutionStarted.Ptr(),
+		WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("conflict-resolver-type")},
+			TaskList:                            taskList,
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		},
+	}, {
+		EventId:   common.Int64Ptr(2),
+		Version:   common.Int64Ptr(cluster.TestCurrentClusterInitialFailoverVersion),
+		Timestamp: common.Int64Ptr(now),
+		EventType: workflow.EventTypeDecisionTaskScheduled.Ptr(),
+		DecisionTaskScheduledEventAttributes: &workflow.DecisionTaskScheduledEventAttributes{
+			TaskList:                   taskList,
+			StartToCloseTimeoutSeconds: common.Int32Ptr(10),
+		},
+	}}
+	decisionStartedBatch := []*workflow.HistoryEvent{{
+		EventId:   common.Int64Ptr(3),
+		Version:   common.Int64Ptr(cluster.TestCurrentClusterInitialFailoverVersion),
+		Timestamp: common.Int64Ptr(now),
+		EventType: workflow.EventTypeDecisionTaskStarted.Ptr(),
+		DecisionTaskStartedEventAttributes: &workflow.DecisionTaskStartedEventAttributes{
+			ScheduledEventId: common.Int64Ptr(2),
+			RequestId:        common.StringPtr(uuid.New()),
+		},
+	}}
+	// the last batch goes beyond the replayed event
+	decisionCompletedBatch := []*workflow.HistoryEvent{{
+		EventId:   common.Int64Ptr(4),
+		Version:   common.Int64Ptr(cluster.TestCurrentClusterInitialFailoverVersion),
+		Timestamp: common.Int64Ptr(now),
+		EventType: workflow.EventTypeDecisionTaskCompleted.Ptr(),
+		DecisionTaskCompletedEventAttributes: &workflow.DecisionTaskCompletedEventAttributes{
+			ScheduledEventId: common.Int64Ptr(2),
+			StartedEventId:   common.Int64Ptr(3),
+		},
+	}, {
+		EventId:   common.Int64Ptr(5),
+		Version:   common.Int64Ptr(cluster.TestCurrentClusterInitialFailoverVersion),
+		Timestamp: common.Int64Ptr(now),
+		EventType: workflow.EventTypeDecisionTaskScheduled.Ptr(),
+		DecisionTaskScheduledEventAttributes: &workflow.DecisionTaskScheduledEventAttributes{
+			TaskList:                   taskList,
+			StartToCloseTimeoutSeconds: common.Int32Ptr(10),
+		},
+	}}
+
+	nextPageToken := []byte("next-page")
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", &persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:     s.domainID,
+		Execution:    s.execution,
+		FirstEventID: common.FirstEventID,
+		NextEventID:  5,
+		PageSize:     defaultHistoryPageSize,
+	}).Return(&persistence.GetWorkflowExecutionHistoryResponse{
+		Events: []persistence.SerializedHistoryEventBatch{
+			*s.serializeEvents(startedBatch),
+			*s.serializeEvents(decisionStartedBatch),
+		},
+		NextPageToken: nextPageToken,
+	}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", &persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:      s.domainID,
+		Execution:     s.execution,
+		FirstEventID:  common.FirstEventID,
+		NextEventID:   5,
+		PageSize:      defaultHistoryPageSize,
+		NextPageToken: nextPageToken,
+	}).Return(&persistence.GetWorkflowExecutionHistoryResponse{
+		Events: []persistence.SerializedHistoryEventBatch{*s.serializeEvents(decisionCompletedBatch)},
+	}, nil).Once()
+
+	var resetRequest *persistence.ResetMutableStateRequest
+	s.mockExecutionMgr.On("ResetMutableState", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resetRequest = args.Get(0).(*persistence.ResetMutableStateRequest)
+	}).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(func(
+		request *persistence.GetWorkflowExecutionRequest) *persistence.GetWorkflowExecutionResponse {
+		return &persistence.GetWorkflowExecutionResponse{State: &persistence.WorkflowMutableState{
+			ExecutionInfo:    resetRequest.ExecutionInfo,
+			ReplicationState: resetRequest.ReplicationState,
+		}}
+	}, nil).Once()
+
+	msBuilder, err := s.conflictResolver.reset(4)
+	s.Nil(err)
+
+	// every batch is applied on its own, the event IDs are the ones of the last batch replayed
+	s.NotNil(resetRequest)
+	executionInfo := resetRequest.ExecutionInfo
+	s.Equal(int64(4), executionInfo.LastFirstEventID)
+	s.Equal(int64(5), executionInfo.NextEventID)
+	s.Equal(common.EmptyEventID, executionInfo.DecisionScheduleID)
+	s.Equal(int64(5), msBuilder.GetNextEventID())
+}
+
+func (s *conflictResolverSuite) serializeEvents(events []*workflow.HistoryEvent) *persistence.SerializedHistoryEventBatch {
+	serializedEvents, err := newHistoryBuilderFromEvents(events, s.config, s.logger).Serialize()
+	s.Nil(err)
+	return serializedEvents
+}
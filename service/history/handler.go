@@ -34,6 +34,8 @@ import (
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
@@ -60,6 +62,8 @@ type (
 		config                *Config
 		historyEventNotifier  historyEventNotifier
 		publisher             messaging.Producer
+		archivalProcessor     archiver.Processor
+		historyResender       *historyResender
 		service.Service
 	}
 )
@@ -73,6 +77,7 @@ var (
 	errTaskListNotSet          = &gen.BadRequestError{Message: "Tasklist not set."}
 	errWorkflowIDNotSet        = &gen.BadRequestError{Message: "WorkflowId is not set on request."}
 	errRunIDNotValid           = &gen.BadRequestError{Message: "RunID is not valid UUID."}
+	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID is not set on request."}
 )
 
 // NewHandler creates a thrift handler for the history service
@@ -114,19 +119,36 @@ func (h *Handler) Start() error {
 
 	hServiceResolver, err1 := h.GetMembershipMonitor().GetResolver(common.HistoryServiceName)
 	if err1 != nil {
-		h.Service.GetLogger().Fatalf("Unable to get history service resolver: ", err1)
+		h.Service.GetLogger().Fatal("Unable to get history service resolver.", tag.Error(err1))
 	}
 	h.hServiceResolver = hServiceResolver
 
 	// TODO when global domain is enabled, uncomment the line below and remove the line after
-	if h.GetClusterMetadata().IsGlobalDomainEnabled() {
+	// the replication tasks are not published when the remote clusters pull them
+	if h.GetClusterMetadata().IsGlobalDomainEnabled() && !h.GetMessagingClient().IsPullBased() {
 		var err error
 		h.publisher, err = h.GetMessagingClient().NewProducer(h.GetClusterMetadata().GetCurrentClusterName())
 		if err != nil {
-			h.GetLogger().Fatalf("Creating kafka producer failed: %v", err)
+			h.GetLogger().Fatal("Creating kafka producer failed", tag.Error(err))
 		}
 	}
 
+	if blobstoreClient := h.GetBlobstoreClient(); blobstoreClient != nil {
+		h.archivalProcessor = archiver.NewProcessor(
+			archiver.NewArchiver(h.historyMgr, h.visibilityMgr, blobstoreClient, h.GetLogger()),
+			blobstoreClient,
+			h.config.ArchivalProcessorConcurrency,
+			h.config.ArchivalProcessorQueueSize,
+			h.GetMetricsClient(),
+			h.GetLogger(),
+		)
+		h.archivalProcessor.Start()
+	}
+
+	if h.GetClusterMetadata().IsGlobalDomainEnabled() {
+		h.historyResender = newHistoryResender(h.Service.GetClientFactory())
+	}
+
 	h.controller = newShardController(h.Service, h.GetHostInfo(), hServiceResolver, h.shardManager, h.historyMgr,
 		h.metadataMgr, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
 	h.metricsClient = h.GetMetricsClient()
@@ -141,6 +163,9 @@ func (h *Handler) Start() error {
 // Stop stops the handler
 func (h *Handler) Stop() {
 	h.controller.Stop()
+	if h.archivalProcessor != nil {
+		h.archivalProcessor.Stop()
+	}
 	h.shardManager.Close()
 	h.historyMgr.Close()
 	h.executionMgrFactory.Close()
@@ -152,7 +177,8 @@ func (h *Handler) Stop() {
 
 // CreateEngine is implementation for HistoryEngineFactory used for creating the engine instance for shard
 func (h *Handler) CreateEngine(context ShardContext) Engine {
-	return NewEngineWithShardContext(context, h.visibilityMgr, h.matchingServiceClient, h.historyServiceClient, h.historyEventNotifier, h.publisher)
+	return NewEngineWithShardContext(context, h.visibilityMgr, h.matchingServiceClient, h.historyServiceClient, h.historyEventNotifier, h.publisher,
+		h.archivalProcessor, h.historyResender)
 }
 
 // Health is for health check
@@ -237,9 +263,11 @@ func (h *Handler) RecordActivityTaskStarted(ctx context.Context,
 func (h *Handler) RecordDecisionTaskStarted(ctx context.Context,
 	recordRequest *hist.RecordDecisionTaskStartedRequest) (*hist.RecordDecisionTaskStartedResponse, error) {
 	h.startWG.Wait()
-	h.Service.GetLogger().Debugf("RecordDecisionTaskStarted. DomainID: %v, WorkflowID: %v, RunID: %v, ScheduleID: %v",
-		recordRequest.GetDomainUUID(), recordRequest.WorkflowExecution.GetWorkflowId(),
-		common.StringDefault(recordRequest.WorkflowExecution.RunId), recordRequest.GetScheduleId())
+	h.Service.GetLogger().Debug("RecordDecisionTaskStarted",
+		tag.WorkflowDomainID(recordRequest.GetDomainUUID()),
+		tag.WorkflowID(recordRequest.WorkflowExecution.GetWorkflowId()),
+		tag.WorkflowRunID(recordRequest.WorkflowExecution.GetRunId()),
+		tag.WorkflowScheduleID(recordRequest.GetScheduleId()))
 
 	h.metricsClient.IncCounter(metrics.HistoryRecordDecisionTaskStartedScope, metrics.CadenceRequests)
 	sw := h.metricsClient.StartTimer(metrics.HistoryRecordDecisionTaskStartedScope, metrics.CadenceLatency)
@@ -256,11 +284,12 @@ func (h *Handler) RecordDecisionTaskStarted(ctx context.Context,
 	workflowExecution := recordRequest.WorkflowExecution
 	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
 	if err1 != nil {
-		h.Service.GetLogger().Errorf("RecordDecisionTaskStarted failed. Error: %v. WorkflowID: %v, RunID: %v, ScheduleID: %v",
-			err1,
-			recordRequest.WorkflowExecution.GetWorkflowId(),
-			recordRequest.WorkflowExecution.GetRunId(),
-			recordRequest.GetScheduleId())
+		h.Service.GetLogger().Error("RecordDecisionTaskStarted failed.",
+			tag.Error(err1),
+			tag.WorkflowID(recordRequest.WorkflowExecution.GetWorkflowId()),
+			tag.WorkflowRunID(recordRequest.WorkflowExecution.GetRunId()),
+			tag.WorkflowScheduleID(recordRequest.GetScheduleId()))
+
 		h.updateErrorMetric(metrics.HistoryRecordDecisionTaskStartedScope, err1)
 		return nil, err1
 	}
@@ -418,11 +447,11 @@ func (h *Handler) RespondDecisionTaskCompleted(ctx context.Context,
 		return err0
 	}
 
-	h.Service.GetLogger().Debugf("RespondDecisionTaskCompleted. DomainID: %v, WorkflowID: %v, RunID: %v, ScheduleID: %v",
-		token.DomainID,
-		token.WorkflowID,
-		token.RunID,
-		token.ScheduleID)
+	h.Service.GetLogger().Debug("RespondDecisionTaskCompleted.",
+		tag.WorkflowDomainID(token.DomainID),
+		tag.WorkflowID(token.WorkflowID),
+		tag.WorkflowRunID(token.RunID),
+		tag.WorkflowScheduleID(token.ScheduleID))
 
 	err0 = validateTaskToken(token)
 	if err0 != nil {
@@ -465,11 +494,11 @@ func (h *Handler) RespondDecisionTaskFailed(ctx context.Context,
 		return err0
 	}
 
-	h.Service.GetLogger().Debugf("RespondDecisionTaskFailed. DomainID: %v, WorkflowID: %v, RunID: %v, ScheduleID: %v",
-		token.DomainID,
-		token.WorkflowID,
-		token.RunID,
-		token.ScheduleID)
+	h.Service.GetLogger().Debug("RespondDecisionTaskFailed.",
+		tag.WorkflowDomainID(token.DomainID),
+		tag.WorkflowID(token.WorkflowID),
+		tag.WorkflowRunID(token.RunID),
+		tag.WorkflowScheduleID(token.ScheduleID))
 
 	err0 = validateTaskToken(token)
 	if err0 != nil {
@@ -511,7 +540,7 @@ func (h *Handler) StartWorkflowExecution(ctx context.Context,
 		return nil, err1
 	}
 
-	response, err2 := engine.StartWorkflowExecution(wrappedRequest)
+	response, err2 := engine.StartWorkflowExecution(ctx, wrappedRequest)
 	if err2 != nil {
 		tmpErr := h.convertError(err2)
 		h.updateErrorMetric(metrics.HistoryStartWorkflowExecutionScope, tmpErr)
@@ -549,6 +578,53 @@ func (h *Handler) GetMutableState(ctx context.Context,
 	return resp, nil
 }
 
+// DescribeMutableState - returns the mutable state of the workflow execution, from both cache and database
+func (h *Handler) DescribeMutableState(ctx context.Context,
+	request *hist.DescribeMutableStateRequest) (*hist.DescribeMutableStateResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryDescribeMutableStateScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryDescribeMutableStateScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetDomainUUID() == "" {
+		return nil, errDomainNotSet
+	}
+
+	workflowExecution := request.Execution
+	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryDescribeMutableStateScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.DescribeMutableState(ctx, request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryDescribeMutableStateScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+	return resp, nil
+}
+
+// DescribeHistoryHost returns information about the internal states of this history host
+func (h *Handler) DescribeHistoryHost(ctx context.Context,
+	request *gen.DescribeHistoryHostRequest) (*gen.DescribeHistoryHostResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryDescribeHistoryHostScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryDescribeHistoryHostScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	shardIDs := h.controller.shardIDs()
+	return &gen.DescribeHistoryHostResponse{
+		NumberOfShards:        common.Int32Ptr(int32(len(shardIDs))),
+		ShardIDs:              shardIDs,
+		HistoryCacheSizes:     h.controller.historyCacheSizes(),
+		ShardControllerStatus: common.StringPtr(h.controller.status()),
+		Address:               common.StringPtr(h.GetHostInfo().GetAddress()),
+	}, nil
+}
+
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (h *Handler) DescribeWorkflowExecution(ctx context.Context, request *hist.DescribeWorkflowExecutionRequest) (*gen.DescribeWorkflowExecutionResponse, error) {
 	h.startWG.Wait()
@@ -590,11 +666,11 @@ func (h *Handler) RequestCancelWorkflowExecution(ctx context.Context,
 	}
 
 	cancelRequest := request.CancelRequest
-	h.Service.GetLogger().Debugf("RequestCancelWorkflowExecution. DomainID: %v/%v, WorkflowID: %v, RunID: %v.",
-		cancelRequest.GetDomain(),
-		request.GetDomainUUID(),
-		cancelRequest.WorkflowExecution.GetWorkflowId(),
-		cancelRequest.WorkflowExecution.GetRunId())
+	h.Service.GetLogger().Debug("RequestCancelWorkflowExecution.",
+		tag.WorkflowDomainName(cancelRequest.GetDomain()),
+		tag.WorkflowDomainID(request.GetDomainUUID()),
+		tag.WorkflowID(cancelRequest.WorkflowExecution.GetWorkflowId()),
+		tag.WorkflowRunID(cancelRequest.WorkflowExecution.GetRunId()))
 
 	engine, err1 := h.controller.GetEngine(cancelRequest.WorkflowExecution.GetWorkflowId())
 	if err1 != nil {
@@ -632,7 +708,7 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context,
 		return err1
 	}
 
-	err2 := engine.SignalWorkflowExecution(wrappedRequest)
+	err2 := engine.SignalWorkflowExecution(ctx, wrappedRequest)
 	if err2 != nil {
 		h.updateErrorMetric(metrics.HistorySignalWorkflowExecutionScope, h.convertError(err2))
 		return h.convertError(err2)
@@ -665,7 +741,7 @@ func (h *Handler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, err1
 	}
 
-	resp, err2 := engine.SignalWithStartWorkflowExecution(wrappedRequest)
+	resp, err2 := engine.SignalWithStartWorkflowExecution(ctx, wrappedRequest)
 	if err2 != nil {
 		tmpErr := h.convertError(err2)
 		h.updateErrorMetric(metrics.HistorySignalWithStartWorkflowExecutionScope, tmpErr)
@@ -731,6 +807,37 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// ResetWorkflowExecution resets an existing workflow execution to the DecisionTaskCompleted event identified by
+// DecisionFinishEventId, and immediately terminates the current run if it is still running.
+func (h *Handler) ResetWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryResetWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryResetWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if wrappedRequest.GetDomainUUID() == "" {
+		return nil, errDomainNotSet
+	}
+
+	workflowExecution := wrappedRequest.ResetRequest.WorkflowExecution
+	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryResetWorkflowExecutionScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.ResetWorkflowExecution(wrappedRequest)
+	if err2 != nil {
+		tmpErr := h.convertError(err2)
+		h.updateErrorMetric(metrics.HistoryResetWorkflowExecutionScope, tmpErr)
+		return nil, tmpErr
+	}
+
+	return resp, nil
+}
+
 // ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
 // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
 // child execution without creating the decision task and then calls this API after updating the mutable state of
@@ -860,6 +967,128 @@ func (h *Handler) ReplicateEvents(ctx context.Context, replicateRequest *hist.Re
 	return nil
 }
 
+// GetReplicationMessages returns the replication tasks of a shard to the remote cluster pulling them
+func (h *Handler) GetReplicationMessages(ctx context.Context,
+	request *hist.GetReplicationMessagesRequest) (*hist.GetReplicationMessagesResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryGetReplicationMessagesScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryGetReplicationMessagesScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return nil, errShardIDNotSet
+	}
+
+	engine, err1 := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryGetReplicationMessagesScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.GetReplicationMessages(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryGetReplicationMessagesScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// GetReplicationStatus returns the replication ack levels of a shard with the remote clusters
+func (h *Handler) GetReplicationStatus(ctx context.Context,
+	request *hist.GetReplicationStatusRequest) (*hist.ShardReplicationStatus, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryGetReplicationStatusScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryGetReplicationStatusScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return nil, errShardIDNotSet
+	}
+
+	engine, err1 := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryGetReplicationStatusScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.GetReplicationStatus(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryGetReplicationStatusScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// GetDomainReplicationLag returns the number of replication tasks of a domain not yet replicated to a remote cluster
+func (h *Handler) GetDomainReplicationLag(ctx context.Context,
+	request *hist.GetDomainReplicationLagRequest) (*hist.GetDomainReplicationLagResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryGetDomainReplicationLagScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryGetDomainReplicationLagScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return nil, errShardIDNotSet
+	}
+
+	if request.DomainUUID == nil {
+		return nil, errDomainNotSet
+	}
+
+	engine, err1 := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryGetDomainReplicationLagScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.GetDomainReplicationLag(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryGetDomainReplicationLagScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// GetWorkflowReplicationTasks returns the replication tasks of a range of events of a workflow execution, to the
+// remote cluster which missed them
+func (h *Handler) GetWorkflowReplicationTasks(ctx context.Context,
+	request *hist.GetWorkflowReplicationTasksRequest) (*hist.GetWorkflowReplicationTasksResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryGetWorkflowReplicationTasksScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryGetWorkflowReplicationTasksScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.DomainUUID == nil {
+		return nil, errDomainNotSet
+	}
+
+	if request.WorkflowExecution == nil {
+		return nil, errWorkflowExecutionNotSet
+	}
+
+	workflowExecution := request.WorkflowExecution
+	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryGetWorkflowReplicationTasksScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.GetWorkflowReplicationTasks(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryGetWorkflowReplicationTasksScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -21,49 +21,71 @@
 package history
 
 import (
-	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
 )
 
 type (
 	historyBuilder struct {
-		serializer persistence.HistorySerializer
-		history    []*workflow.HistoryEvent
-		msBuilder  *mutableStateBuilder
-		logger     bark.Logger
+		serializerFactory persistence.HistorySerializerFactory
+		encodingType      common.EncodingType
+		history           []*workflow.HistoryEvent
+		msBuilder         *mutableStateBuilder
+		logger            logging.Logger
 	}
 )
 
-func newHistoryBuilder(msBuilder *mutableStateBuilder, logger bark.Logger) *historyBuilder {
+func newHistoryBuilder(msBuilder *mutableStateBuilder, logger logging.Logger) *historyBuilder {
 	return &historyBuilder{
-		serializer: persistence.NewJSONHistorySerializer(),
-		history:    []*workflow.HistoryEvent{},
-		msBuilder:  msBuilder,
-		logger:     logger.WithField(logging.TagWorkflowComponent, logging.TagValueHistoryBuilderComponent),
+		serializerFactory: persistence.NewHistorySerializerFactory(),
+		encodingType:      common.EncodingType(msBuilder.config.EventEncodingType()),
+		history:           []*workflow.HistoryEvent{},
+		msBuilder:         msBuilder,
+		logger:            logger.WithTags(tag.ComponentHistoryBuilder),
 	}
 }
 
-func newHistoryBuilderFromEvents(history []*workflow.HistoryEvent, logger bark.Logger) *historyBuilder {
+func newHistoryBuilderFromEvents(history []*workflow.HistoryEvent, config *Config, logger logging.Logger) *historyBuilder {
 	return &historyBuilder{
-		serializer: persistence.NewJSONHistorySerializer(),
-		history:    history,
-		logger:     logger.WithField(logging.TagWorkflowComponent, logging.TagValueHistoryBuilderComponent),
+		serializerFactory: persistence.NewHistorySerializerFactory(),
+		encodingType:      common.EncodingType(config.EventEncodingType()),
+		history:           history,
+		logger:            logger.WithTags(tag.ComponentHistoryBuilder),
 	}
 }
 
 func (b *historyBuilder) Serialize() (*persistence.SerializedHistoryEventBatch, error) {
 	eventBatch := persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(), b.history)
-	history, err := b.serializer.Serialize(eventBatch)
+	history, err := b.serialize(eventBatch)
 	if err != nil {
 		return nil, err
 	}
 	return history, nil
 }
 
+// serialize encodes the event batch with the encoding type configured for new history events
+func (b *historyBuilder) serialize(batch *persistence.HistoryEventBatch) (*persistence.SerializedHistoryEventBatch, error) {
+	serializer, err := b.serializerFactory.Get(b.encodingType)
+	if err != nil {
+		return nil, err
+	}
+	return serializer.Serialize(batch)
+}
+
+// deserialize decodes the event batch with the encoding type it was persisted with, which may differ from the
+// encoding type configured for new history events
+func (b *historyBuilder) deserialize(batch *persistence.SerializedHistoryEventBatch) (*persistence.HistoryEventBatch, error) {
+	serializer, err := b.serializerFactory.Get(batch.EncodingType)
+	if err != nil {
+		return nil, err
+	}
+	return serializer.Deserialize(batch)
+}
+
 func (b *historyBuilder) AddWorkflowExecutionStartedEvent(request *h.StartWorkflowExecutionRequest,
 	previousRunID *string) *workflow.HistoryEvent {
 	event := b.newWorkflowExecutionStartedEvent(request, previousRunID)
@@ -342,6 +364,13 @@ func (b *historyBuilder) AddMarkerRecordedEvent(decisionCompletedEventID int64,
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID int64,
+	attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	event := b.newUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddWorkflowExecutionSignaledEvent(
 	request *workflow.SignalWorkflowExecutionRequest) *workflow.HistoryEvent {
 	event := b.newWorkflowExecutionSignaledEvent(request)
@@ -433,7 +462,14 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(*request.TaskStartToCloseTimeoutSeconds)
 	attributes.ChildPolicy = request.ChildPolicy
 	attributes.ContinuedExecutionRunId = previousRunID
+	attributes.Initiator = startRequest.ContinueAsNewInitiator
 	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
+	attributes.RetryPolicy = request.RetryPolicy
+	attributes.Attempt = common.Int32Ptr(startRequest.GetAttempt())
+	attributes.ExpirationTimestamp = startRequest.ExpirationTimestamp
+	attributes.CronSchedule = request.CronSchedule
+	attributes.SearchAttributes = request.SearchAttributes
+	attributes.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(startRequest.GetFirstDecisionTaskBackoffSeconds())
 	parentInfo := startRequest.ParentExecutionInfo
 	if parentInfo != nil {
 		attributes.ParentWorkflowDomain = parentInfo.Domain
@@ -637,6 +673,17 @@ func (b *historyBuilder) newMarkerRecordedEventAttributes(decisionTaskCompletedE
 	return historyEvent
 }
 
+func (b *historyBuilder) newUpsertWorkflowSearchAttributesEvent(decisionTaskCompletedEventID int64,
+	request *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventTypeUpsertWorkflowSearchAttributes)
+	attributes := &workflow.UpsertWorkflowSearchAttributesEventAttributes{}
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.SearchAttributes = request.SearchAttributes
+	historyEvent.UpsertWorkflowSearchAttributesEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newWorkflowExecutionCancelRequestedEvent(cause string,
 	request *h.RequestCancelWorkflowExecutionRequest) *workflow.HistoryEvent {
 	event := b.msBuilder.createNewHistoryEvent(workflow.EventTypeWorkflowExecutionCancelRequested)
@@ -778,6 +825,10 @@ func (b *historyBuilder) newWorkflowExecutionContinuedAsNewEvent(decisionTaskCom
 	attributes.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(*request.ExecutionStartToCloseTimeoutSeconds)
 	attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(*request.TaskStartToCloseTimeoutSeconds)
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.BackoffStartIntervalInSeconds = common.Int32Ptr(request.GetBackoffStartIntervalInSeconds())
+	attributes.Initiator = request.Initiator
+	attributes.FailureReason = request.FailureReason
+	attributes.FailureDetails = request.FailureDetails
 	historyEvent.WorkflowExecutionContinuedAsNewEventAttributes = attributes
 
 	return historyEvent
@@ -798,6 +849,8 @@ func (b *historyBuilder) newStartChildWorkflowExecutionInitiatedEvent(decisionTa
 	attributes.Control = startAttributes.Control
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
 	attributes.WorkflowIdReusePolicy = startAttributes.WorkflowIdReusePolicy
+	attributes.RetryPolicy = startAttributes.RetryPolicy
+	attributes.SearchAttributes = startAttributes.SearchAttributes
 	historyEvent.StartChildWorkflowExecutionInitiatedEventAttributes = attributes
 
 	return historyEvent
@@ -23,16 +23,15 @@ package history
 import (
 	"testing"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 
 	"github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
@@ -46,7 +45,7 @@ type (
 		domainID  string
 		msBuilder *mutableStateBuilder
 		builder   *historyBuilder
-		logger    bark.Logger
+		logger    logging.Logger
 	}
 )
 
@@ -56,7 +55,7 @@ func TestHistoryBuilderSuite(t *testing.T) {
 }
 
 func (s *historyBuilderSuite) SetupTest() {
-	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.logger = logging.NewDevelopmentLogger()
 	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
 	s.Assertions = require.New(s.T())
 	s.domainID = "history-builder-test-domain"
@@ -911,7 +910,7 @@ func (s *historyBuilderSuite) validateRequestCancelExternalWorkflowExecutionFail
 func (s *historyBuilderSuite) printHistory() string {
 	history, err := s.builder.Serialize()
 	if err != nil {
-		s.logger.Errorf("Error serializing history: %v", err)
+		s.logger.Error("Error serializing history", tag.Error(err))
 		return ""
 	}
 
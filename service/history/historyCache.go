@@ -21,16 +21,17 @@
 package history
 
 import (
+	"context"
 	"sync/atomic"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
 
 	"github.com/pborman/uuid"
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/common"
 )
 
@@ -42,7 +43,7 @@ type (
 		shard            ShardContext
 		executionManager persistence.ExecutionManager
 		disabled         bool
-		logger           bark.Logger
+		logger           logging.Logger
 		config           *Config
 	}
 )
@@ -58,7 +59,7 @@ var (
 	ErrTryLock = &workflow.InternalServiceError{Message: "Failed to acquire lock, backoff and retry"}
 )
 
-func newHistoryCache(shard ShardContext, logger bark.Logger) *historyCache {
+func newHistoryCache(shard ShardContext, logger logging.Logger) *historyCache {
 	opts := &cache.Options{}
 	config := shard.GetConfig()
 	opts.InitialCapacity = config.HistoryCacheInitialSize
@@ -69,50 +70,70 @@ func newHistoryCache(shard ShardContext, logger bark.Logger) *historyCache {
 		Cache:            cache.New(config.HistoryCacheMaxSize, opts),
 		shard:            shard,
 		executionManager: shard.GetExecutionManager(),
-		logger: logger.WithFields(bark.Fields{
-			logging.TagWorkflowComponent: logging.TagValueHistoryCacheComponent,
-		}),
-		config: config,
+		logger:           logger.WithTags(tag.ComponentHistoryCache),
+		config:           config,
 	}
 }
 
-func (c *historyCache) getOrCreateWorkflowExecution(domainID string,
-	execution workflow.WorkflowExecution) (*workflowExecutionContext, releaseWorkflowExecutionFunc, error) {
-	if execution.GetWorkflowId() == "" {
-		return nil, nil, &workflow.BadRequestError{Message: "Can't load workflow execution.  WorkflowId not set."}
+// getAndCreateWorkflowExecution returns the cached workflow execution context, if any, together with a new context
+// which bypasses the cache, so the mutable state held by the cache can be compared with the one in the database.
+// The release function must be called to release the cached context.
+func (c *historyCache) getAndCreateWorkflowExecution(domainID string,
+	execution workflow.WorkflowExecution) (contextInCache *workflowExecutionContext, contextInDB *workflowExecutionContext,
+	release releaseWorkflowExecutionFunc, cacheHit bool, retError error) {
+	if err := c.validateWorkflowExecutionInfo(domainID, &execution); err != nil {
+		return nil, nil, nil, false, err
 	}
 
-	// RunID is not provided, lets try to retrieve the RunID for current active execution
-	if execution.GetRunId() == "" {
-		response, err := c.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
-			DomainID:   domainID,
-			WorkflowID: execution.GetWorkflowId(),
-		})
+	release = func(error) {}
+	contextInDB = newWorkflowExecutionContext(domainID, execution, c.shard, c.executionManager, c.logger)
+	if c.disabled {
+		return nil, contextInDB, release, false, nil
+	}
 
-		if err != nil {
-			return nil, nil, err
+	key := execution.GetRunId()
+	contextInCache, cacheHit = c.Get(key).(*workflowExecutionContext)
+	if cacheHit {
+		contextInCache.Lock()
+		release = func(error) {
+			contextInCache.Unlock()
+			c.Release(key)
 		}
+	}
+	return contextInCache, contextInDB, release, cacheHit, nil
+}
 
-		execution.RunId = common.StringPtr(response.RunID)
-	} else if uuid.Parse(execution.GetRunId()) == nil { // immediately return if invalid runID
-		return nil, nil, &workflow.BadRequestError{Message: "RunID is not valid UUID."}
+func (c *historyCache) getOrCreateWorkflowExecution(domainID string,
+	execution workflow.WorkflowExecution) (*workflowExecutionContext, releaseWorkflowExecutionFunc, error) {
+	return c.getOrCreateWorkflowExecutionWithContext(context.Background(), domainID, execution)
+}
+
+// getOrCreateWorkflowExecutionWithContext returns the locked workflow execution context tracing the persistence
+// calls it makes until it is released as children of the span of the context
+func (c *historyCache) getOrCreateWorkflowExecutionWithContext(ctx context.Context, domainID string,
+	execution workflow.WorkflowExecution) (*workflowExecutionContext, releaseWorkflowExecutionFunc, error) {
+	if err := c.validateWorkflowExecutionInfo(domainID, &execution); err != nil {
+		return nil, nil, err
 	}
 
+	shard := c.shard.WithContext(ctx)
+	executionManager := persistence.ExecutionManagerWithContext(ctx, c.executionManager)
+
 	// Test hook for disabling the cache
 	if c.disabled {
-		return newWorkflowExecutionContext(domainID, execution, c.shard, c.executionManager, c.logger), func(error) {}, nil
+		return newWorkflowExecutionContext(domainID, execution, shard, executionManager, c.logger), func(error) {}, nil
 	}
 
 	key := execution.GetRunId()
-	context, cacheHit := c.Get(key).(*workflowExecutionContext)
+	wfContext, cacheHit := c.Get(key).(*workflowExecutionContext)
 	if !cacheHit {
 		// Let's create the workflow execution context
-		context = newWorkflowExecutionContext(domainID, execution, c.shard, c.executionManager, c.logger)
-		elem, err := c.PutIfNotExist(key, context)
+		wfContext = newWorkflowExecutionContext(domainID, execution, c.shard, c.executionManager, c.logger)
+		elem, err := c.PutIfNotExist(key, wfContext)
 		if err != nil {
 			return nil, nil, err
 		}
-		context = elem.(*workflowExecutionContext)
+		wfContext = elem.(*workflowExecutionContext)
 	}
 
 	// This will create a closure on every request.
@@ -122,15 +143,43 @@ func (c *historyCache) getOrCreateWorkflowExecution(domainID string,
 		if atomic.CompareAndSwapInt32(&status, cacheNotReleased, cacheReleased) {
 			if err != nil {
 				// TODO see issue #668, there are certain type or errors which can bypass the clear
-				context.clear()
+				wfContext.clear()
 			}
-			context.Unlock()
+			// the cached context outlives the request, unbind it from the span of the request
+			wfContext.shard = c.shard
+			wfContext.executionManager = c.executionManager
+			wfContext.Unlock()
 			c.Release(key)
 		}
 	}
 
-	context.Lock()
-	return context, releaseFunc, nil
+	wfContext.Lock()
+	wfContext.shard = shard
+	wfContext.executionManager = executionManager
+	return wfContext, releaseFunc, nil
+}
+
+func (c *historyCache) validateWorkflowExecutionInfo(domainID string, execution *workflow.WorkflowExecution) error {
+	if execution.GetWorkflowId() == "" {
+		return &workflow.BadRequestError{Message: "Can't load workflow execution.  WorkflowId not set."}
+	}
+
+	// RunID is not provided, lets try to retrieve the RunID for current active execution
+	if execution.GetRunId() == "" {
+		response, err := c.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: execution.GetWorkflowId(),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		execution.RunId = common.StringPtr(response.RunID)
+	} else if uuid.Parse(execution.GetRunId()) == nil { // immediately return if invalid runID
+		return &workflow.BadRequestError{Message: "RunID is not valid UUID."}
+	}
+	return nil
 }
 
 func (c *historyCache) getCurrentExecutionWithRetry(
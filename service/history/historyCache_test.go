@@ -31,11 +31,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 
 	"github.com/uber-go/tally"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -48,7 +48,7 @@ type (
 		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
 		// not merely log an error
 		*require.Assertions
-		logger           bark.Logger
+		logger           logging.Logger
 		mockExecutionMgr *mocks.ExecutionManager
 		mockShard        *shardContextImpl
 		cache            *historyCache
@@ -71,7 +71,7 @@ func (s *historyCacheSuite) TearDownSuite() {
 }
 
 func (s *historyCacheSuite) SetupTest() {
-	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.logger = logging.NewDevelopmentLogger()
 	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
 	s.Assertions = require.New(s.T())
 	s.mockExecutionMgr = &mocks.ExecutionManager{}
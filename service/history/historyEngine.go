@@ -22,6 +22,7 @@ package history
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -29,14 +30,16 @@ import (
 	"go.uber.org/yarpc"
 
 	"github.com/pborman/uuid"
-	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -58,13 +61,16 @@ type (
 		txProcessor          transferQueueProcessor
 		timerProcessor       timerQueueProcessor
 		replicator           *historyReplicator
+		resetor              *workflowResetor
 		replicatorProcessor  queueProcessor
+		replicationReader    *replicationMessageReader
 		historyEventNotifier historyEventNotifier
 		tokenSerializer      common.TaskTokenSerializer
 		hSerializerFactory   persistence.HistorySerializerFactory
 		historyCache         *historyCache
+		archivalProcessor    archiver.Processor
 		metricsClient        metrics.Client
-		logger               bark.Logger
+		logger               logging.Logger
 	}
 
 	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor on new tasks.
@@ -101,6 +107,9 @@ var (
 	ErrDeserializingToken = &workflow.BadRequestError{Message: "Error deserializing task token."}
 	// ErrCancellationAlreadyRequested is the error indicating cancellation for target workflow is already requested
 	ErrCancellationAlreadyRequested = &workflow.CancellationAlreadyRequestedError{Message: "Cancellation already requested for this workflow execution."}
+	// ErrReplicationPositionUnknown is the error to indicate the replication tasks applied by a remote cluster are
+	// unknown as they are published to kafka instead of pulled by the remote cluster
+	ErrReplicationPositionUnknown = &workflow.BadRequestError{Message: "The replication tasks applied by the remote cluster are unknown, they are not pulled from this cluster."}
 	// FailedWorkflowCloseState is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
 	FailedWorkflowCloseState = map[int]bool{
@@ -113,7 +122,8 @@ var (
 
 // NewEngineWithShardContext creates an instance of history engine
 func NewEngineWithShardContext(shard ShardContext, visibilityMgr persistence.VisibilityManager,
-	matching matching.Client, historyClient hc.Client, historyEventNotifier historyEventNotifier, publisher messaging.Producer) Engine {
+	matching matching.Client, historyClient hc.Client, historyEventNotifier historyEventNotifier, publisher messaging.Producer,
+	archivalProcessor archiver.Processor, historyResender *historyResender) Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 	shardWrapper := &shardContextWrapper{
 		currentClusterName:   currentClusterName,
@@ -134,15 +144,15 @@ func NewEngineWithShardContext(shard ShardContext, visibilityMgr persistence.Vis
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		hSerializerFactory: historySerializerFactory,
 		historyCache:       historyCache,
-		logger: logger.WithFields(bark.Fields{
-			logging.TagWorkflowComponent: logging.TagValueHistoryEngineComponent,
-		}),
+		logger: logger.WithTags(tag.ComponentHistoryEngine),
 		metricsClient:        shard.GetMetricsClient(),
 		historyEventNotifier: historyEventNotifier,
+		archivalProcessor:    archivalProcessor,
 	}
 	txProcessor := newTransferQueueProcessor(shard, historyEngImpl, visibilityMgr, matching, historyClient, logger)
 	historyEngImpl.timerProcessor = newTimerQueueProcessor(shard, historyEngImpl, matching, logger)
 	historyEngImpl.txProcessor = txProcessor
+	historyEngImpl.resetor = newWorkflowResetor(historyEngImpl, visibilityMgr)
 	shardWrapper.txProcessor = txProcessor
 
 	// Only start the replicator processor if valid publisher is passed in
@@ -152,12 +162,24 @@ func NewEngineWithShardContext(shard ShardContext, visibilityMgr persistence.Vis
 		historyEngImpl.replicatorProcessor = replicatorProcessor
 		shardWrapper.replcatorProcessor = replicatorProcessor
 		historyEngImpl.replicator = newHistoryReplicator(shard, historyEngImpl, historyCache, shard.GetDomainCache(), historyManager,
-			logger)
+			historyResender, logger)
+	} else if isPullBasedReplication(shard) {
+		// the remote clusters pull the replication tasks through GetReplicationMessages
+		historyEngImpl.replicationReader = newReplicationMessageReader(shard, executionManager, historyManager,
+			historySerializerFactory, logger)
+		historyEngImpl.replicator = newHistoryReplicator(shard, historyEngImpl, historyCache, shard.GetDomainCache(), historyManager,
+			historyResender, logger)
 	}
 
 	return historyEngImpl
 }
 
+func isPullBasedReplication(shard ShardContext) bool {
+	messagingClient := shard.GetService().GetMessagingClient()
+	return shard.GetService().GetClusterMetadata().IsGlobalDomainEnabled() && messagingClient != nil &&
+		messagingClient.IsPullBased()
+}
+
 // Start will spin up all the components needed to start serving this shard.
 // Make sure all the components are loaded lazily so start can return immediately.  This is important because
 // ShardController calls start sequentially for all the shards for a given host during startup.
@@ -204,8 +226,8 @@ func (e *historyEngineImpl) Stop() {
 }
 
 // StartWorkflowExecution starts a workflow execution
-func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflowExecutionRequest) (
-	*workflow.StartWorkflowExecutionResponse, error) {
+func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context,
+	startRequest *h.StartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error) {
 
 	domainEntry, err := e.getActiveDomainEntry(startRequest.DomainUUID)
 	if err != nil {
@@ -218,6 +240,9 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 	if err != nil {
 		return nil, err
 	}
+	now := e.shard.GetTimeSource().Now()
+	setCronBackoff(startRequest, now)
+	setRetryExpiration(startRequest, now)
 
 	execution := workflow.WorkflowExecution{
 		WorkflowId: request.WorkflowId,
@@ -255,8 +280,9 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 	decisionScheduleID := common.EmptyEventID
 	decisionStartID := common.EmptyEventID
 	decisionTimeout := int32(0)
-	if parentInfo == nil {
-		// DecisionTask is only created when it is not a Child Workflow Execution
+	if parentInfo == nil && startRequest.GetFirstDecisionTaskBackoffSeconds() == 0 {
+		// DecisionTask is only created when it is not a Child Workflow Execution and there is no backoff, otherwise
+		// it is scheduled by the parent or the workflow backoff timer
 		di := msBuilder.AddDecisionTaskScheduledEvent()
 		if di == nil {
 			return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -271,10 +297,14 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		decisionTimeout = di.DecisionTimeout
 	}
 
-	duration := time.Duration(*request.ExecutionStartToCloseTimeoutSeconds) * time.Second
+	duration := time.Duration(request.GetExecutionStartToCloseTimeoutSeconds()+
+		startRequest.GetFirstDecisionTaskBackoffSeconds()) * time.Second
 	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
 		VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(duration),
 	}}
+	if backoffTimerTask := getWorkflowBackoffTimerTask(startedEvent); backoffTimerTask != nil {
+		timerTasks = append(timerTasks, backoffTimerTask)
+	}
 	// Serialize the history
 	serializedHistory, serializedError := msBuilder.hBuilder.Serialize()
 	if serializedError != nil {
@@ -284,7 +314,8 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		return nil, serializedError
 	}
 
-	err = e.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+	shard := e.shard.WithContext(ctx)
+	err = shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
 		DomainID:  domainID,
 		Execution: execution,
 		// It is ok to use 0 for TransactionID because RunID is unique so there are
@@ -321,7 +352,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 	setTaskVersion(msBuilder.GetCurrentVersion(), transferTasks, timerTasks)
 
 	createWorkflow := func(isBrandNew bool, prevRunID string) (string, error) {
-		_, err = e.shard.CreateWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
+		_, err = shard.CreateWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
 			RequestID:                   common.StringDefault(request.RequestId),
 			DomainID:                    domainID,
 			Execution:                   execution,
@@ -345,6 +376,16 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 			ContinueAsNew:               !isBrandNew,
 			PreviousRunID:               prevRunID,
 			ReplicationState:            replicationState,
+			CronSchedule:                request.GetCronSchedule(),
+			Attempt:                     msBuilder.executionInfo.Attempt,
+			HasRetryPolicy:              msBuilder.executionInfo.HasRetryPolicy,
+			InitialInterval:             msBuilder.executionInfo.InitialInterval,
+			BackoffCoefficient:          msBuilder.executionInfo.BackoffCoefficient,
+			MaximumInterval:             msBuilder.executionInfo.MaximumInterval,
+			ExpirationTime:              msBuilder.executionInfo.ExpirationTime,
+			MaximumAttempts:             msBuilder.executionInfo.MaximumAttempts,
+			NonRetriableErrors:          msBuilder.executionInfo.NonRetriableErrors,
+			SearchAttributes:            msBuilder.executionInfo.SearchAttributes,
 		})
 
 		if err != nil {
@@ -496,6 +537,63 @@ func (e *historyEngineImpl) GetMutableState(ctx context.Context,
 	return response, nil
 }
 
+// DescribeMutableState returns the mutable state of the workflow execution, both from the history cache and from
+// the database, serialized as JSON
+func (e *historyEngineImpl) DescribeMutableState(ctx context.Context,
+	request *h.DescribeMutableStateRequest) (retResp *h.DescribeMutableStateResponse, retError error) {
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	contextInCache, contextInDB, release, cacheHit, err := e.historyCache.getAndCreateWorkflowExecution(domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	response := &h.DescribeMutableStateResponse{}
+	if cacheHit && contextInCache.msBuilder != nil {
+		response.MutableStateInCache, err = e.toMutableStateJSON(contextInCache.msBuilder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	msBuilder, err := contextInDB.loadWorkflowExecution()
+	if err != nil {
+		return nil, err
+	}
+	response.MutableStateInDatabase, err = e.toMutableStateJSON(msBuilder)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (e *historyEngineImpl) toMutableStateJSON(msBuilder *mutableStateBuilder) (*string, error) {
+	ms := struct {
+		*persistence.WorkflowMutableState
+		RangeID int64
+	}{
+		WorkflowMutableState: msBuilder.CopyToPersistence(),
+		RangeID:              e.shard.GetRangeID(),
+	}
+
+	encoded, err := json.Marshal(ms)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{Message: err.Error()}
+	}
+	return common.StringPtr(string(encoded)), nil
+}
+
 func (e *historyEngineImpl) getMutableState(
 	domainID string, execution workflow.WorkflowExecution) (retResp *h.GetMutableStateResponse, retError error) {
 
@@ -541,7 +639,7 @@ func (e *historyEngineImpl) ResetStickyTaskList(resetRequest *h.ResetStickyTaskL
 		return nil, err
 	}
 
-	err = e.updateWorkflowExecution(domainID, *resetRequest.Execution, false, false,
+	err = e.updateWorkflowExecution(context.Background(), domainID, *resetRequest.Execution, false, false,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, nil
@@ -586,10 +684,11 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			ChildPolicy:                         common.ChildPolicyPtr(workflow.ChildPolicyTerminate),
 		},
 		WorkflowExecutionInfo: &workflow.WorkflowExecutionInfo{
-			Execution:     request.Request.Execution,
-			Type:          &workflow.WorkflowType{Name: common.StringPtr(msBuilder.executionInfo.WorkflowTypeName)},
-			StartTime:     common.Int64Ptr(msBuilder.executionInfo.StartTimestamp.UnixNano()),
-			HistoryLength: common.Int64Ptr(msBuilder.GetNextEventID() - common.FirstEventID),
+			Execution:        request.Request.Execution,
+			Type:             &workflow.WorkflowType{Name: common.StringPtr(msBuilder.executionInfo.WorkflowTypeName)},
+			StartTime:        common.Int64Ptr(msBuilder.executionInfo.StartTimestamp.UnixNano()),
+			HistoryLength:    common.Int64Ptr(msBuilder.GetNextEventID() - common.FirstEventID),
+			SearchAttributes: persistence.NewSearchAttributes(msBuilder.executionInfo.SearchAttributes),
 		},
 	}
 	if msBuilder.executionInfo.State == persistence.WorkflowStateCompleted {
@@ -737,7 +836,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 	}
 
 	response := &h.RecordActivityTaskStartedResponse{}
-	err = e.updateWorkflowExecution(domainID, execution, false, false,
+	err = e.updateWorkflowExecution(context.Background(), domainID, execution, false, false,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -834,7 +933,7 @@ func (e *historyEngineImpl) RespondDecisionTaskCompleted(ctx context.Context, re
 	clientFeatureVersion := call.Header(common.FeatureVersionHeaderName)
 	clientImpl := call.Header(common.ClientImplHeaderName)
 
-	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, workflowExecution)
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecutionWithContext(ctx, domainID, workflowExecution)
 	if err0 != nil {
 		return err0
 	}
@@ -950,7 +1049,13 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes
 					break Process_Decision_Loop
 				}
-				if e := msBuilder.AddCompletedWorkflowEvent(completedID, attributes); e == nil {
+				if msBuilder.executionInfo.CronSchedule != "" {
+					continueAsNewBuilder, continueAsNewTimerTasks, err = e.continueAsNewCronWorkflow(domainEntry, msBuilder,
+						completedID)
+					if err != nil {
+						return err
+					}
+				} else if e := msBuilder.AddCompletedWorkflowEvent(completedID, attributes); e == nil {
 					return &workflow.InternalServiceError{Message: "Unable to add complete workflow event."}
 				}
 				isComplete = true
@@ -976,7 +1081,19 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes
 					break Process_Decision_Loop
 				}
-				if e := msBuilder.AddFailWorkflowEvent(completedID, attributes); e == nil {
+				if backoffInterval := msBuilder.getRetryBackoffInterval(attributes.GetReason()); backoffInterval != backoff.NoBackoff {
+					continueAsNewBuilder, continueAsNewTimerTasks, err = e.continueAsNewRetryWorkflow(domainEntry, msBuilder,
+						completedID, backoffInterval, attributes.GetReason(), attributes.Details)
+					if err != nil {
+						return err
+					}
+				} else if msBuilder.executionInfo.CronSchedule != "" {
+					continueAsNewBuilder, continueAsNewTimerTasks, err = e.continueAsNewCronWorkflow(domainEntry, msBuilder,
+						completedID)
+					if err != nil {
+						return err
+					}
+				} else if e := msBuilder.AddFailWorkflowEvent(completedID, attributes); e == nil {
 					return &workflow.InternalServiceError{Message: "Unable to add fail workflow event."}
 				}
 				isComplete = true
@@ -1169,33 +1286,18 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCauseBadContinueAsNewAttributes
 					break Process_Decision_Loop
 				}
-
-				// Extract parentDomainName so it can be passed down to next run of workflow execution
-				var parentDomainName string
-				if msBuilder.hasParentExecution() {
-					parentDomainID := msBuilder.executionInfo.ParentDomainID
-					parentDomainEntry, err := e.shard.GetDomainCache().GetDomainByID(parentDomainID)
-					if err != nil {
-						return err
-					}
-					parentDomainName = parentDomainEntry.GetInfo().Name
+				if err = e.validateSearchAttributes(attributes.SearchAttributes); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadSearchAttributes
+					break Process_Decision_Loop
 				}
 
-				runID := uuid.New()
-				_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(completedID, domainEntry, runID, parentDomainName, attributes)
+				continueAsNewBuilder, continueAsNewTimerTasks, err = e.continueAsNewWorkflowExecution(domainEntry,
+					msBuilder, completedID, attributes)
 				if err != nil {
 					return err
 				}
-
-				// add timer task to new workflow
-				duration := time.Duration(*attributes.ExecutionStartToCloseTimeoutSeconds) * time.Second
-				continueAsNewTimerTasks = []persistence.Task{&persistence.WorkflowTimeoutTask{
-					VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(duration),
-				}}
-				msBuilder.continueAsNew.TimerTasks = continueAsNewTimerTasks
-
 				isComplete = true
-				continueAsNewBuilder = newStateBuilder
 
 			case workflow.DecisionTypeStartChildWorkflowExecution:
 				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
@@ -1207,6 +1309,11 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCauseBadStartChildExecutionAttributes
 					break Process_Decision_Loop
 				}
+				if err = e.validateSearchAttributes(attributes.SearchAttributes); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadSearchAttributes
+					break Process_Decision_Loop
+				}
 
 				// First check if we need to use a different target domain to schedule child execution
 				if attributes.Domain != nil {
@@ -1226,6 +1333,18 @@ Update_History_Loop:
 					InitiatedID:      *initiatedEvent.EventId,
 				})
 
+			case workflow.DecisionTypeUpsertWorkflowSearchAttributes:
+				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
+					metrics.DecisionTypeUpsertWorkflowSearchAttributesCounter)
+				attributes := d.UpsertWorkflowSearchAttributesDecisionAttributes
+				if err = e.validateUpsertWorkflowSearchAttributes(attributes); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadSearchAttributes
+					break Process_Decision_Loop
+				}
+				msBuilder.AddUpsertWorkflowSearchAttributesEvent(completedID, attributes)
+				transferTasks = append(transferTasks, &persistence.UpsertWorkflowSearchAttributesTask{})
+
 			default:
 				return &workflow.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", *d.DecisionType)}
 			}
@@ -1279,7 +1398,7 @@ Update_History_Loop:
 		}
 
 		// Generate a transaction ID for appending events to history
-		transactionID, err3 := e.shard.GetNextTransferTaskID()
+		transactionID, err3 := context.shard.GetNextTransferTaskID()
 		if err3 != nil {
 			return err3
 		}
@@ -1335,7 +1454,7 @@ func (e *historyEngineImpl) RespondDecisionTaskFailed(req *h.RespondDecisionTask
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecution(domainID, workflowExecution, false, true,
+	return e.updateWorkflowExecution(context.Background(), domainID, workflowExecution, false, true,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -1374,7 +1493,7 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(req *h.RespondActivityT
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecution(domainID, workflowExecution, false, true,
+	return e.updateWorkflowExecution(context.Background(), domainID, workflowExecution, false, true,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -1430,7 +1549,7 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(req *h.RespondActivityTask
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecutionWithAction(domainID, workflowExecution,
+	return e.updateWorkflowExecutionWithAction(context.Background(), domainID, workflowExecution,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -1494,7 +1613,7 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(req *h.RespondActivityTa
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecution(domainID, workflowExecution, false, true,
+	return e.updateWorkflowExecution(context.Background(), domainID, workflowExecution, false, true,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -1557,10 +1676,10 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 	}
 
 	var cancelRequested bool
-	err = e.updateWorkflowExecution(domainID, workflowExecution, false, false,
+	err = e.updateWorkflowExecution(context.Background(), domainID, workflowExecution, false, false,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
-				e.logger.Errorf("Heartbeat failed ")
+				e.logger.Error("Heartbeat failed ")
 				return nil, ErrWorkflowCompleted
 			}
 
@@ -1582,15 +1701,21 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(
 
 			if !isRunning || ai.StartedID == common.EmptyEventID ||
 				(token.ScheduleID != common.EmptyEventID && token.ScheduleAttempt != int64(ai.Attempt)) {
-				e.logger.Debugf("Activity HeartBeat: scheduleEventID: %v, ActivityInfo: %+v, Exist: %v", scheduleID, ai,
-					isRunning)
+				e.logger.Debug("Activity HeartBeat: activity not found.",
+					tag.WorkflowScheduleID(scheduleID),
+					tag.Attempt(token.ScheduleAttempt),
+					tag.Bool(isRunning))
+
 				return nil, ErrActivityTaskNotFound
 			}
 
 			cancelRequested = ai.CancelRequested
 
-			e.logger.Debugf("Activity HeartBeat: scheduleEventID: %v, ActivityInfo: %+v, CancelRequested: %v",
-				scheduleID, ai, cancelRequested)
+			e.logger.Debug("Activity HeartBeat.",
+				tag.WorkflowScheduleID(scheduleID),
+				tag.Attempt(int64(ai.Attempt)),
+				tag.Bool(cancelRequested))
+
 
 			// Save progress and last HB reported time.
 			msBuilder.updateActivityProgress(ai, request)
@@ -1623,7 +1748,7 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 		RunId:      request.WorkflowExecution.RunId,
 	}
 
-	return e.updateWorkflowExecution(domainID, execution, false, true,
+	return e.updateWorkflowExecution(context.Background(), domainID, execution, false, true,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -1660,7 +1785,8 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 		})
 }
 
-func (e *historyEngineImpl) SignalWorkflowExecution(signalRequest *h.SignalWorkflowExecutionRequest) error {
+func (e *historyEngineImpl) SignalWorkflowExecution(ctx context.Context,
+	signalRequest *h.SignalWorkflowExecutionRequest) error {
 
 	domainEntry, err := e.getActiveDomainEntry(signalRequest.DomainUUID)
 	if err != nil {
@@ -1676,7 +1802,7 @@ func (e *historyEngineImpl) SignalWorkflowExecution(signalRequest *h.SignalWorkf
 		RunId:      request.WorkflowExecution.RunId,
 	}
 
-	return e.updateWorkflowExecution(domainID, execution, false, true,
+	return e.updateWorkflowExecution(ctx, domainID, execution, false, true,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -1707,7 +1833,8 @@ func (e *historyEngineImpl) SignalWorkflowExecution(signalRequest *h.SignalWorkf
 		})
 }
 
-func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest) (
+func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context,
+	signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest) (
 	retResp *workflow.StartWorkflowExecutionResponse, retError error) {
 
 	domainEntry, err := e.getActiveDomainEntry(signalWithStartRequest.DomainUUID)
@@ -1725,7 +1852,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 	prevRunID := ""
 	attempt := 0
 
-	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecutionWithContext(ctx, domainID, execution)
 
 	if err0 == nil {
 		defer func() { release(retError) }()
@@ -1751,7 +1878,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 			var transferTasks []persistence.Task
 			var timerTasks []persistence.Task
 			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.isWorkflowBackoffPending() {
 				di := msBuilder.AddDecisionTaskScheduledEvent()
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
 					DomainID:   domainID,
@@ -1766,7 +1893,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 				}
 			}
 			// Generate a transaction ID for appending events to history
-			transactionID, err2 := e.shard.GetNextTransferTaskID()
+			transactionID, err2 := context.shard.GetNextTransferTaskID()
 			if err2 != nil {
 				return nil, err2
 			}
@@ -1800,6 +1927,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 	if err != nil {
 		return nil, err
 	}
+	now := e.shard.GetTimeSource().Now()
+	setCronBackoff(startRequest, now)
+	setRetryExpiration(startRequest, now)
 
 	execution = workflow.WorkflowExecution{
 		WorkflowId: request.WorkflowId,
@@ -1827,23 +1957,33 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 	}
 
 	var transferTasks []persistence.Task
-	di := msBuilder.AddDecisionTaskScheduledEvent()
-	if di == nil {
-		return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
-	}
+	decisionVersion := common.EmptyVersion
+	decisionScheduleID := common.EmptyEventID
+	decisionStartID := common.EmptyEventID
+	decisionTimeout := int32(0)
+	if startRequest.GetFirstDecisionTaskBackoffSeconds() == 0 {
+		di := msBuilder.AddDecisionTaskScheduledEvent()
+		if di == nil {
+			return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
+		}
 
-	transferTasks = []persistence.Task{&persistence.DecisionTask{
-		DomainID: domainID, TaskList: taskList, ScheduleID: di.ScheduleID,
-	}}
-	decisionVersion := di.Version
-	decisionScheduleID := di.ScheduleID
-	decisionStartID := di.StartedID
-	decisionTimeout := di.DecisionTimeout
+		transferTasks = []persistence.Task{&persistence.DecisionTask{
+			DomainID: domainID, TaskList: taskList, ScheduleID: di.ScheduleID,
+		}}
+		decisionVersion = di.Version
+		decisionScheduleID = di.ScheduleID
+		decisionStartID = di.StartedID
+		decisionTimeout = di.DecisionTimeout
+	}
 
-	duration := time.Duration(*request.ExecutionStartToCloseTimeoutSeconds) * time.Second
+	duration := time.Duration(request.GetExecutionStartToCloseTimeoutSeconds()+
+		startRequest.GetFirstDecisionTaskBackoffSeconds()) * time.Second
 	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
 		VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(duration),
 	}}
+	if backoffTimerTask := getWorkflowBackoffTimerTask(startedEvent); backoffTimerTask != nil {
+		timerTasks = append(timerTasks, backoffTimerTask)
+	}
 	// Serialize the history
 	serializedHistory, serializedError := msBuilder.hBuilder.Serialize()
 	if serializedError != nil {
@@ -1853,7 +1993,8 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 		return nil, serializedError
 	}
 
-	err = e.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+	shard := e.shard.WithContext(ctx)
+	err = shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
 		DomainID:  domainID,
 		Execution: execution,
 		// It is ok to use 0 for TransactionID because RunID is unique so there are
@@ -1869,7 +2010,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 	setTaskVersion(msBuilder.GetCurrentVersion(), transferTasks, timerTasks)
 
 	createWorkflow := func(isBrandNew bool, prevRunID string) (string, error) {
-		_, err = e.shard.CreateWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
+		_, err = shard.CreateWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
 			RequestID:                   common.StringDefault(request.RequestId),
 			DomainID:                    domainID,
 			Execution:                   execution,
@@ -1889,6 +2030,16 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequ
 			TimerTasks:                  timerTasks,
 			ContinueAsNew:               !isBrandNew,
 			PreviousRunID:               prevRunID,
+			CronSchedule:                request.GetCronSchedule(),
+			Attempt:                     msBuilder.executionInfo.Attempt,
+			HasRetryPolicy:              msBuilder.executionInfo.HasRetryPolicy,
+			InitialInterval:             msBuilder.executionInfo.InitialInterval,
+			BackoffCoefficient:          msBuilder.executionInfo.BackoffCoefficient,
+			MaximumInterval:             msBuilder.executionInfo.MaximumInterval,
+			ExpirationTime:              msBuilder.executionInfo.ExpirationTime,
+			MaximumAttempts:             msBuilder.executionInfo.MaximumAttempts,
+			NonRetriableErrors:          msBuilder.executionInfo.NonRetriableErrors,
+			SearchAttributes:            msBuilder.executionInfo.SearchAttributes,
 		})
 
 		if err != nil {
@@ -1932,7 +2083,7 @@ func (e *historyEngineImpl) RemoveSignalMutableState(request *h.RemoveSignalMuta
 		RunId:      request.WorkflowExecution.RunId,
 	}
 
-	return e.updateWorkflowExecution(domainID, execution, false, false,
+	return e.updateWorkflowExecution(context.Background(), domainID, execution, false, false,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -1958,7 +2109,7 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(terminateRequest *h.Termi
 		RunId:      request.WorkflowExecution.RunId,
 	}
 
-	return e.updateWorkflowExecution(domainID, execution, true, false,
+	return e.updateWorkflowExecution(context.Background(), domainID, execution, true, false,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -1972,6 +2123,19 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(terminateRequest *h.Termi
 		})
 }
 
+// ResetWorkflowExecution starts a new run of the workflow from the given DecisionTaskCompleted event of an existing
+// run, and terminates the current run if it is still running
+func (e *historyEngineImpl) ResetWorkflowExecution(
+	resetRequest *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error) {
+
+	domainEntry, err := e.getActiveDomainEntry(resetRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.resetor.resetWorkflowExecution(domainEntry, resetRequest.ResetRequest)
+}
+
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
 func (e *historyEngineImpl) ScheduleDecisionTask(scheduleRequest *h.ScheduleDecisionTaskRequest) error {
 
@@ -1986,7 +2150,7 @@ func (e *historyEngineImpl) ScheduleDecisionTask(scheduleRequest *h.ScheduleDeci
 		RunId:      scheduleRequest.WorkflowExecution.RunId,
 	}
 
-	return e.updateWorkflowExecution(domainID, execution, false, true,
+	return e.updateWorkflowExecution(context.Background(), domainID, execution, false, true,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -2012,7 +2176,7 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(completionRequest *h.R
 		RunId:      completionRequest.WorkflowExecution.RunId,
 	}
 
-	return e.updateWorkflowExecution(domainID, execution, false, true,
+	return e.updateWorkflowExecution(context.Background(), domainID, execution, false, true,
 		func(msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.isWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -2054,6 +2218,160 @@ func (e *historyEngineImpl) ReplicateEvents(replicateRequest *h.ReplicateEventsR
 	return e.replicator.ApplyEvents(replicateRequest)
 }
 
+// GetReplicationMessages returns the replication tasks of the shard to a remote cluster pulling them
+func (e *historyEngineImpl) GetReplicationMessages(
+	request *h.GetReplicationMessagesRequest) (*h.GetReplicationMessagesResponse, error) {
+	if e.replicationReader == nil {
+		return nil, &workflow.BadRequestError{Message: "Replication tasks are not pulled from this cluster."}
+	}
+	return e.replicationReader.getReplicationMessages(request)
+}
+
+// GetReplicationStatus returns the replication progress of the shard with every remote cluster
+func (e *historyEngineImpl) GetReplicationStatus(
+	request *h.GetReplicationStatusRequest) (*h.ShardReplicationStatus, error) {
+	var clusterAckLevels map[string]int64
+	if e.replicationReader != nil {
+		clusterAckLevels = e.replicationReader.getClusterAckLevels()
+	}
+
+	remoteClusters := make(map[string]*h.ClusterReplicationStatus)
+	for cluster := range e.shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions() {
+		if cluster == e.currentClusterName {
+			continue
+		}
+
+		status := &h.ClusterReplicationStatus{
+			TransferAckLevel: common.Int64Ptr(e.shard.GetTransferClusterAckLevel(cluster)),
+			TimerAckLevel:    common.Int64Ptr(e.shard.GetTimerClusterAckLevel(cluster).UnixNano()),
+			// the time of the last event replicated from the cluster, which is the standby timer ack level until
+			// a replication task is applied after the shard is loaded
+			LastReplicatedTimestamp: common.Int64Ptr(e.shard.GetCurrentTime(cluster).UnixNano()),
+		}
+		// the ack level of each remote cluster is only known when they pull the replication tasks
+		if ackLevel, ok := clusterAckLevels[cluster]; ok {
+			status.ReplicationAckLevel = common.Int64Ptr(ackLevel)
+		}
+		remoteClusters[cluster] = status
+	}
+
+	return &h.ShardReplicationStatus{
+		ShardId:             common.Int32Ptr(int32(e.shard.GetShardID())),
+		Timestamp:           common.Int64Ptr(time.Now().UnixNano()),
+		MaxReadLevel:        common.Int64Ptr(e.shard.GetTransferMaxReadLevel()),
+		ReplicationAckLevel: common.Int64Ptr(e.shard.GetReplicatorAckLevel()),
+		RemoteClusters:      remoteClusters,
+	}, nil
+}
+
+// GetDomainReplicationLag counts the replication tasks of a domain in the shard which are not yet applied by a
+// remote cluster.  The applied position of a remote cluster is only known when it pulls the replication tasks, the
+// tasks published to kafka may not be applied yet.
+func (e *historyEngineImpl) GetDomainReplicationLag(
+	request *h.GetDomainReplicationLagRequest) (*h.GetDomainReplicationLagResponse, error) {
+	if e.replicationReader == nil {
+		return nil, ErrReplicationPositionUnknown
+	}
+	readLevel, ok := e.replicationReader.getClusterAckLevels()[request.GetClusterName()]
+	if !ok {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Unknown cluster %v.", request.GetClusterName())}
+	}
+
+	maxReadLevel := e.shard.GetTransferMaxReadLevel()
+	batchSize := e.shard.GetConfig().ReplicatorTaskBatchSize
+	var pendingTasks int64
+	for readLevel < maxReadLevel {
+		response, err := e.executionManager.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+			ReadLevel:    readLevel,
+			MaxReadLevel: maxReadLevel,
+			BatchSize:    batchSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, task := range response.Tasks {
+			if task.DomainID == request.GetDomainUUID() {
+				pendingTasks++
+			}
+			readLevel = task.TaskID
+		}
+
+		if len(response.Tasks) < batchSize {
+			break
+		}
+	}
+
+	return &h.GetDomainReplicationLagResponse{
+		PendingReplicationTasks: common.Int64Ptr(pendingTasks),
+	}, nil
+}
+
+// GetWorkflowReplicationTasks rebuilds from the history of a workflow execution the replication tasks of the events
+// from FirstEventId up to NextEventId, one task per batch of events.  The tasks carry no replication information, the
+// remote cluster applies them to fill a gap in the history it has already received.
+func (e *historyEngineImpl) GetWorkflowReplicationTasks(
+	request *h.GetWorkflowReplicationTasksRequest) (*h.GetWorkflowReplicationTasksResponse, error) {
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := request.WorkflowExecution
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultHistoryPageSize
+	}
+
+	response, err := e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:     domainID,
+		Execution:    *execution,
+		FirstEventID: request.GetFirstEventId(),
+		NextEventID:  request.GetNextEventId(),
+		PageSize:     pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	replicationTasks := make([]*h.ReplicateEventsRequest, 0, len(response.Events))
+	for _, b := range response.Events {
+		persistence.SetSerializedHistoryDefaults(&b)
+		s, _ := e.hSerializerFactory.Get(b.EncodingType)
+		eventBatch, err := s.Deserialize(&b)
+		if err != nil {
+			return nil, err
+		}
+
+		events := eventBatch.Events
+		if len(events) == 0 {
+			continue
+		}
+		firstEvent := events[0]
+		lastEvent := events[len(events)-1]
+		newRunHistory, err := getNewRunHistory(e.historyMgr, e.hSerializerFactory, domainID,
+			execution.GetWorkflowId(), lastEvent)
+		if err != nil {
+			return nil, err
+		}
+
+		replicationTasks = append(replicationTasks, &h.ReplicateEventsRequest{
+			SourceCluster:     common.StringPtr(e.currentClusterName),
+			DomainUUID:        common.StringPtr(domainID),
+			WorkflowExecution: execution,
+			FirstEventId:      common.Int64Ptr(firstEvent.GetEventId()),
+			NextEventId:       common.Int64Ptr(lastEvent.GetEventId() + 1),
+			Version:           common.Int64Ptr(lastEvent.GetVersion()),
+			History:           &workflow.History{Events: events},
+			NewRunHistory:     newRunHistory,
+		})
+	}
+
+	return &h.GetWorkflowReplicationTasksResponse{
+		ReplicationTasks: replicationTasks,
+	}, nil
+}
+
 type updateWorkflowAction struct {
 	deleteWorkflow bool
 	createDecision bool
@@ -2061,9 +2379,10 @@ type updateWorkflowAction struct {
 	transferTasks  []persistence.Task
 }
 
-func (e *historyEngineImpl) updateWorkflowExecutionWithAction(domainID string, execution workflow.WorkflowExecution,
+func (e *historyEngineImpl) updateWorkflowExecutionWithAction(ctx context.Context, domainID string,
+	execution workflow.WorkflowExecution,
 	action func(builder *mutableStateBuilder, tBuilder *timerBuilder) (*updateWorkflowAction, error)) (retError error) {
-	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecutionWithContext(ctx, domainID, execution)
 	if err0 != nil {
 		return err0
 	}
@@ -2103,7 +2422,7 @@ Update_History_Loop:
 
 		if postActions.createDecision {
 			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.isWorkflowBackoffPending() {
 				di := msBuilder.AddDecisionTaskScheduledEvent()
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
 					DomainID:   domainID,
@@ -2120,7 +2439,7 @@ Update_History_Loop:
 		}
 
 		// Generate a transaction ID for appending events to history
-		transactionID, err2 := e.shard.GetNextTransferTaskID()
+		transactionID, err2 := context.shard.GetNextTransferTaskID()
 		if err2 != nil {
 			return err2
 		}
@@ -2139,10 +2458,10 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
-func (e *historyEngineImpl) updateWorkflowExecution(domainID string, execution workflow.WorkflowExecution,
-	createDeletionTask, createDecisionTask bool,
+func (e *historyEngineImpl) updateWorkflowExecution(ctx context.Context, domainID string,
+	execution workflow.WorkflowExecution, createDeletionTask, createDecisionTask bool,
 	action func(builder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, error)) error {
-	return e.updateWorkflowExecutionWithAction(domainID, execution,
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
 		func(builder *mutableStateBuilder, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 			timerTasks, err := action(builder, tBuilder)
 			if err != nil {
@@ -2157,6 +2476,129 @@ func (e *historyEngineImpl) updateWorkflowExecution(domainID string, execution w
 		})
 }
 
+// continueAsNewWorkflowExecution adds the ContinueAsNew event to the current run and creates the mutable state of
+// the new run, it returns the new run along with the timer tasks created for it
+func (e *historyEngineImpl) continueAsNewWorkflowExecution(domainEntry *cache.DomainCacheEntry,
+	msBuilder *mutableStateBuilder, decisionCompletedEventID int64,
+	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) (*mutableStateBuilder, []persistence.Task,
+	error) {
+
+	// Extract parentDomainName so it can be passed down to next run of workflow execution
+	var parentDomainName string
+	if msBuilder.hasParentExecution() {
+		parentDomainID := msBuilder.executionInfo.ParentDomainID
+		parentDomainEntry, err := e.shard.GetDomainCache().GetDomainByID(parentDomainID)
+		if err != nil {
+			return nil, nil, err
+		}
+		parentDomainName = parentDomainEntry.GetInfo().Name
+	}
+
+	runID := uuid.New()
+	_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(decisionCompletedEventID, domainEntry, runID,
+		parentDomainName, attributes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// add timer task to new workflow, workflow timeout starts after the backoff of the first decision
+	duration := time.Duration(attributes.GetExecutionStartToCloseTimeoutSeconds()+
+		attributes.GetBackoffStartIntervalInSeconds()) * time.Second
+	msBuilder.continueAsNew.TimerTasks = append(msBuilder.continueAsNew.TimerTasks, &persistence.WorkflowTimeoutTask{
+		VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(duration),
+	})
+
+	return newStateBuilder, msBuilder.continueAsNew.TimerTasks, nil
+}
+
+// continueAsNewCronWorkflow closes the current run of a cron workflow by continuing it as a new run with the same
+// input, the first decision of the new run is scheduled at the next time on the cron schedule
+func (e *historyEngineImpl) continueAsNewCronWorkflow(domainEntry *cache.DomainCacheEntry,
+	msBuilder *mutableStateBuilder, decisionCompletedEventID int64) (*mutableStateBuilder, []persistence.Task, error) {
+
+	backoffInterval := backoff.GetBackoffForNextSchedule(msBuilder.executionInfo.CronSchedule,
+		e.shard.GetTimeSource().Now())
+	if backoffInterval == backoff.NoBackoff {
+		return nil, nil, &workflow.InternalServiceError{Message: "Unable to get next run time for cron schedule."}
+	}
+
+	return e.continueAsNewWorkflowWithBackoff(domainEntry, msBuilder, decisionCompletedEventID, backoffInterval,
+		workflow.ContinueAsNewInitiatorCronSchedule, nil, nil)
+}
+
+// continueAsNewRetryWorkflow closes the current run of a workflow with a retry policy which failed or timed out by
+// continuing it as a new run with the same input, the first decision of the new run is scheduled after the backoff
+func (e *historyEngineImpl) continueAsNewRetryWorkflow(domainEntry *cache.DomainCacheEntry,
+	msBuilder *mutableStateBuilder, decisionCompletedEventID int64, backoffInterval time.Duration,
+	failureReason string, failureDetails []byte) (*mutableStateBuilder, []persistence.Task, error) {
+
+	return e.continueAsNewWorkflowWithBackoff(domainEntry, msBuilder, decisionCompletedEventID, backoffInterval,
+		workflow.ContinueAsNewInitiatorRetryPolicy, common.StringPtr(failureReason), failureDetails)
+}
+
+func (e *historyEngineImpl) continueAsNewWorkflowWithBackoff(domainEntry *cache.DomainCacheEntry,
+	msBuilder *mutableStateBuilder, decisionCompletedEventID int64, backoffInterval time.Duration,
+	initiator workflow.ContinueAsNewInitiator, failureReason *string, failureDetails []byte) (*mutableStateBuilder,
+	[]persistence.Task, error) {
+
+	executionInfo := msBuilder.executionInfo
+	startedEvent, err := e.getWorkflowStartedEvent(executionInfo.DomainID, workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+		RunId:      common.StringPtr(executionInfo.RunID),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	startedAttributes := startedEvent.WorkflowExecutionStartedEventAttributes
+
+	attributes := &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        startedAttributes.WorkflowType,
+		TaskList:                            startedAttributes.TaskList,
+		Input:                               startedAttributes.Input,
+		ExecutionStartToCloseTimeoutSeconds: startedAttributes.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      startedAttributes.TaskStartToCloseTimeoutSeconds,
+		BackoffStartIntervalInSeconds:       common.Int32Ptr(int32(backoffInterval.Seconds())),
+		Initiator:                           common.ContinueAsNewInitiatorPtr(initiator),
+		RetryPolicy:                         startedAttributes.RetryPolicy,
+		FailureReason:                       failureReason,
+		FailureDetails:                      failureDetails,
+	}
+	return e.continueAsNewWorkflowExecution(domainEntry, msBuilder, decisionCompletedEventID, attributes)
+}
+
+// getWorkflowStartedEvent reads the WorkflowExecutionStarted event of a run from its history
+func (e *historyEngineImpl) getWorkflowStartedEvent(domainID string,
+	execution workflow.WorkflowExecution) (*workflow.HistoryEvent, error) {
+	response, err := e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:     domainID,
+		Execution:    execution,
+		FirstEventID: common.FirstEventID,
+		NextEventID:  common.FirstEventID + 1,
+		PageSize:     1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Events) == 0 {
+		return nil, &workflow.InternalServiceError{Message: "Unable to load workflow execution started event."}
+	}
+
+	serializedBatch := response.Events[0]
+	persistence.SetSerializedHistoryDefaults(&serializedBatch)
+	serializer, err := e.hSerializerFactory.Get(serializedBatch.EncodingType)
+	if err != nil {
+		return nil, err
+	}
+	batch, err := serializer.Deserialize(&serializedBatch)
+	if err != nil {
+		return nil, err
+	}
+	if len(batch.Events) == 0 || batch.Events[0].GetEventType() != workflow.EventTypeWorkflowExecutionStarted {
+		return nil, &workflow.InternalServiceError{Message: "Unable to load workflow execution started event."}
+	}
+	return batch.Events[0], nil
+}
+
 func (e *historyEngineImpl) getDeleteWorkflowTasks(
 	domainID string,
 	tBuilder *timerBuilder,
@@ -2237,10 +2679,7 @@ func (e *historyEngineImpl) failDecision(context *workflowExecutionContext, sche
 }
 
 func (e *historyEngineImpl) getTimerBuilder(we *workflow.WorkflowExecution) *timerBuilder {
-	lg := e.logger.WithFields(bark.Fields{
-		logging.TagWorkflowExecutionID: we.WorkflowId,
-		logging.TagWorkflowRunID:       we.RunId,
-	})
+	lg := e.logger.WithTags(tag.WorkflowID(we.GetWorkflowId()), tag.WorkflowRunID(we.GetRunId()))
 	return newTimerBuilder(e.shard.GetConfig(), lg, common.NewRealTimeSource())
 }
 
@@ -2302,18 +2741,39 @@ func validateActivityScheduleAttributes(attributes *workflow.ScheduleActivityTas
 	if attributes.HeartbeatTimeoutSeconds == nil || *attributes.HeartbeatTimeoutSeconds < 0 {
 		return &workflow.BadRequestError{Message: "A valid HeartbeatTimeoutSeconds is not set on decision."}
 	}
-	if policy := attributes.RetryPolicy; policy != nil {
-		if policy.GetInitialIntervalInSeconds() <= 0 {
-			return &workflow.BadRequestError{Message: "A valid InitialIntervalInSeconds is not set on retry policy."}
-		}
-		if policy.GetBackoffCoefficient() < 1 {
-			return &workflow.BadRequestError{Message: "BackoffCoefficient cannot be less than 1 on retry policy."}
-		}
-		if policy.GetMaximumIntervalInSeconds() < policy.GetInitialIntervalInSeconds() {
-			return &workflow.BadRequestError{Message: "MaximumIntervalInSeconds cannot be less than InitialIntervalInSeconds on retry policy."}
-		}
+	if attributes.RetryPolicy != nil {
+		return validateRetryPolicy(attributes.RetryPolicy)
+	}
+
+	return nil
+}
+
+func validateRetryPolicy(policy *workflow.RetryPolicy) error {
+	if policy.GetInitialIntervalInSeconds() <= 0 {
+		return &workflow.BadRequestError{Message: "A valid InitialIntervalInSeconds is not set on retry policy."}
 	}
+	if policy.GetBackoffCoefficient() < 1 {
+		return &workflow.BadRequestError{Message: "BackoffCoefficient cannot be less than 1 on retry policy."}
+	}
+	if policy.GetMaximumIntervalInSeconds() < policy.GetInitialIntervalInSeconds() {
+		return &workflow.BadRequestError{Message: "MaximumIntervalInSeconds cannot be less than InitialIntervalInSeconds on retry policy."}
+	}
+	return nil
+}
 
+func validateWorkflowRetryPolicy(policy *workflow.RetryPolicy) error {
+	if err := validateRetryPolicy(policy); err != nil {
+		return err
+	}
+	if policy.GetMaximumAttempts() < 0 {
+		return &workflow.BadRequestError{Message: "MaximumAttempts cannot be less than 0 on retry policy."}
+	}
+	if policy.GetExpirationIntervalInSeconds() < 0 {
+		return &workflow.BadRequestError{Message: "ExpirationIntervalInSeconds cannot be less than 0 on retry policy."}
+	}
+	if policy.GetMaximumAttempts() == 0 && policy.GetExpirationIntervalInSeconds() == 0 {
+		return &workflow.BadRequestError{Message: "MaximumAttempts and ExpirationIntervalInSeconds are both 0 on retry policy, at least one of them must be set."}
+	}
 	return nil
 }
 
@@ -2360,6 +2820,23 @@ func validateRecordMarkerAttributes(attributes *workflow.RecordMarkerDecisionAtt
 	return nil
 }
 
+func (e *historyEngineImpl) validateUpsertWorkflowSearchAttributes(
+	attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) error {
+	if attributes == nil {
+		return &workflow.BadRequestError{Message: "UpsertWorkflowSearchAttributesDecisionAttributes is not set on decision."}
+	}
+	if len(persistence.GetSearchAttributesFields(attributes.SearchAttributes)) == 0 {
+		return &workflow.BadRequestError{Message: "SearchAttributes is not set on decision."}
+	}
+	return e.validateSearchAttributes(attributes.SearchAttributes)
+}
+
+func (e *historyEngineImpl) validateSearchAttributes(searchAttributes *workflow.SearchAttributes) error {
+	config := e.shard.GetConfig()
+	return common.ValidateSearchAttributes(searchAttributes, config.SearchAttributesNumberOfKeysLimit,
+		config.SearchAttributesSizeOfValueLimit, config.SearchAttributesTotalSizeLimit)
+}
+
 func validateCompleteWorkflowExecutionAttributes(attributes *workflow.CompleteWorkflowExecutionDecisionAttributes) error {
 	if attributes == nil {
 		return &workflow.BadRequestError{Message: "CompleteWorkflowExecutionDecisionAttributes is not set on decision."}
@@ -2449,6 +2926,10 @@ func validateContinueAsNewWorkflowExecutionAttributes(executionInfo *persistence
 		attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(executionInfo.DecisionTimeoutValue)
 	}
 
+	if attributes.RetryPolicy != nil {
+		return validateWorkflowRetryPolicy(attributes.RetryPolicy)
+	}
+
 	return nil
 }
 
@@ -2485,6 +2966,10 @@ func validateStartChildExecutionAttributes(parentInfo *persistence.WorkflowExecu
 		attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(parentInfo.DecisionTimeoutValue)
 	}
 
+	if attributes.RetryPolicy != nil {
+		return validateWorkflowRetryPolicy(attributes.RetryPolicy)
+	}
+
 	return nil
 }
 
@@ -2498,9 +2983,31 @@ func validateStartWorkflowExecutionRequest(request *workflow.StartWorkflowExecut
 	if request.TaskList == nil || request.TaskList.Name == nil || request.TaskList.GetName() == "" {
 		return &workflow.BadRequestError{Message: "Missing Tasklist."}
 	}
+	if request.RetryPolicy != nil {
+		if err := validateWorkflowRetryPolicy(request.RetryPolicy); err != nil {
+			return err
+		}
+	}
+	if request.GetCronSchedule() != "" {
+		return backoff.ValidateSchedule(request.GetCronSchedule())
+	}
 	return nil
 }
 
+// setCronBackoff delays the first decision of a cron workflow to the next time on its schedule
+func setCronBackoff(startRequest *h.StartWorkflowExecutionRequest, now time.Time) {
+	backoffInterval := backoff.GetBackoffForNextSchedule(startRequest.StartRequest.GetCronSchedule(), now)
+	if backoffInterval != backoff.NoBackoff {
+		startRequest.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(int32(backoffInterval.Seconds()))
+	}
+}
+
+// setRetryExpiration sets the time after which a failed workflow with a retry policy is no longer retried
+func setRetryExpiration(startRequest *h.StartWorkflowExecutionRequest, now time.Time) {
+	startRequest.ExpirationTimestamp = getWorkflowExpirationTimestamp(startRequest.StartRequest.RetryPolicy,
+		startRequest.GetFirstDecisionTaskBackoffSeconds(), now)
+}
+
 func validateDomainUUID(domainUUID *string) (string, error) {
 	if domainUUID == nil {
 		return "", &workflow.BadRequestError{Message: "Missing domain UUID."}
@@ -2566,6 +3073,9 @@ func getStartRequest(domainID string,
 		Identity:                            request.Identity,
 		RequestId:                           request.RequestId,
 		WorkflowIdReusePolicy:               &policy,
+		RetryPolicy:                         request.RetryPolicy,
+		CronSchedule:                        request.CronSchedule,
+		SearchAttributes:                    request.SearchAttributes,
 	}
 
 	startRequest := &h.StartWorkflowExecutionRequest{
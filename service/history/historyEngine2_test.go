@@ -26,6 +26,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 
@@ -33,7 +34,6 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 
 	"github.com/uber-go/tally"
 	h "github.com/uber/cadence/.gen/go/history"
@@ -41,12 +41,16 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/tracing"
+	jaeger "github.com/uber/jaeger-client-go"
 )
 
 type (
@@ -70,7 +74,7 @@ type (
 		shardClosedCh       chan int
 		eventSerializer     historyEventSerializer
 		config              *Config
-		logger              bark.Logger
+		logger              logging.Logger
 	}
 )
 
@@ -86,7 +90,7 @@ func (s *engine2Suite) SetupSuite() {
 
 	l := log.New()
 	l.Level = log.DebugLevel
-	s.logger = bark.NewLoggerFromLogrus(l)
+	s.logger = logging.NewDevelopmentLogger()
 	s.config = NewConfig(dynamicconfig.NewNopCollection(), 1)
 }
 
@@ -149,6 +153,7 @@ func (s *engine2Suite) SetupTest() {
 	}
 	h.txProcessor = newTransferQueueProcessor(mockShard, h, s.mockVisibilityMgr, s.mockMatchingClient, s.mockHistoryClient, s.logger)
 	h.timerProcessor = newTimerQueueProcessor(mockShard, h, s.mockMatchingClient, s.logger)
+	h.resetor = newWorkflowResetor(h, s.mockVisibilityMgr)
 	s.historyEngine = h
 }
 
@@ -292,7 +297,7 @@ func (s *engine2Suite) TestRecordDecisionTaskStartedIfTaskAlreadyStarted() {
 	s.Nil(response)
 	s.NotNil(err)
 	s.IsType(&h.EventAlreadyStartedError{}, err)
-	s.logger.Errorf("RecordDecisionTaskStarted failed with: %v", err)
+	s.logger.Error("RecordDecisionTaskStarted failed", tag.Error(err))
 }
 
 func (s *engine2Suite) TestRecordDecisionTaskStartedIfTaskAlreadyCompleted() {
@@ -342,7 +347,7 @@ func (s *engine2Suite) TestRecordDecisionTaskStartedIfTaskAlreadyCompleted() {
 	s.Nil(response)
 	s.NotNil(err)
 	s.IsType(&workflow.EntityNotExistsError{}, err)
-	s.logger.Errorf("RecordDecisionTaskStarted failed with: %v", err)
+	s.logger.Error("RecordDecisionTaskStarted failed", tag.Error(err))
 }
 
 func (s *engine2Suite) TestRecordDecisionTaskStartedConflictOnUpdate() {
@@ -516,7 +521,7 @@ func (s *engine2Suite) TestRecordDecisionTaskRetryDifferentRequest() {
 	s.Nil(response)
 	s.NotNil(err)
 	s.IsType(&h.EventAlreadyStartedError{}, err)
-	s.logger.Infof("Failed with error: %v", err)
+	s.logger.Info("Failed", tag.Error(err))
 }
 
 func (s *engine2Suite) TestRecordDecisionTaskStartedMaxAttemptsExceeded() {
@@ -664,7 +669,7 @@ func (s *engine2Suite) TestRecordActivityTaskStartedIfNoExecution() {
 		},
 	})
 	if err != nil {
-		s.logger.Errorf("Unexpected Error: %v", err)
+		s.logger.Error("Unexpected", tag.Error(err))
 	}
 	s.Nil(response)
 	s.NotNil(err)
@@ -834,10 +839,10 @@ func (s *engine2Suite) createExecutionStartedState(we workflow.WorkflowExecution
 func (s *engine2Suite) printHistory(builder *mutableStateBuilder) string {
 	history, err := builder.hBuilder.Serialize()
 	if err != nil {
-		s.logger.Errorf("Error serializing history: %v", err)
+		s.logger.Error("Error serializing history", tag.Error(err))
 		return ""
 	}
-	s.logger.Infof("Printing History: %v", history)
+	s.logger.Info("Printing History", tag.Value(history))
 	return history.String()
 }
 
@@ -857,7 +862,7 @@ func (s *engine2Suite) TestRespondDecisionTaskCompletedRecordMarkerDecision() {
 	markerDetails := []byte("marker details")
 	markerName := "marker name"
 
-	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	msBuilder := newMutableStateBuilder(s.config, logging.NewDevelopmentLogger())
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
 	di := addDecisionTaskScheduledEvent(msBuilder)
 	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
@@ -907,6 +912,110 @@ func (s *engine2Suite) TestRespondDecisionTaskCompletedRecordMarkerDecision() {
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engine2Suite) TestRespondDecisionTaskCompletedFailWorkflowWithRetry() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	reason := "fail workflow reason"
+
+	msBuilder := newMutableStateBuilder(s.config, logging.NewDevelopmentLogger())
+	msBuilder.AddWorkflowExecutionStartedEvent(we, &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:       common.StringPtr(domainID),
+			WorkflowId:   we.WorkflowId,
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+			Input:        []byte("input"),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            common.StringPtr(identity),
+			RetryPolicy: &workflow.RetryPolicy{
+				InitialIntervalInSeconds: common.Int32Ptr(10),
+				BackoffCoefficient:       common.Float64Ptr(2),
+				MaximumIntervalInSeconds: common.Int32Ptr(100),
+				MaximumAttempts:          common.Int32Ptr(3),
+			},
+		},
+	})
+	startedEvents := msBuilder.hBuilder.history[0:1]
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeFailWorkflowExecution),
+		FailWorkflowExecutionDecisionAttributes: &workflow.FailWorkflowExecutionDecisionAttributes{
+			Reason:  common.StringPtr(reason),
+			Details: []byte("fail workflow details"),
+		},
+	}}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*s.serializeEvents(startedEvents)},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Return(nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	err := s.historyEngine.RespondDecisionTaskCompleted(context.Background(), &h.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: nil,
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+	s.NotNil(updateRequest)
+	s.Equal(persistence.WorkflowCloseStatusContinuedAsNew, updateRequest.ExecutionInfo.CloseStatus)
+
+	newRun := updateRequest.ContinueAsNew
+	s.NotNil(newRun)
+	s.Equal(int32(1), newRun.Attempt)
+	s.True(newRun.HasRetryPolicy)
+	s.Equal(int32(3), newRun.MaximumAttempts)
+	s.Equal(common.EmptyEventID, newRun.DecisionScheduleID)
+	s.Empty(newRun.TransferTasks)
+	var backoffTask *persistence.WorkflowBackoffTimerTask
+	for _, task := range newRun.TimerTasks {
+		if t, ok := task.(*persistence.WorkflowBackoffTimerTask); ok {
+			backoffTask = t
+		}
+	}
+	s.NotNil(backoffTask)
+	s.Equal(persistence.WorkflowBackoffTimeoutTypeRetry, backoffTask.TimeoutType)
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	domainID := validDomainID
 	workflowID := "workflowID"
@@ -930,7 +1039,7 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 		nil,
 	)
 
-	resp, err := s.historyEngine.StartWorkflowExecution(&h.StartWorkflowExecutionRequest{
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
 		StartRequest: &workflow.StartWorkflowExecutionRequest{
 			Domain:       common.StringPtr(domainID),
@@ -946,6 +1055,147 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	s.NotNil(resp.RunId)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_PersistenceSpansAreChildrenOfRequestSpan() {
+	domainID := validDomainID
+	workflowID := "workflowID"
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+
+	tracer, reporter := tracing.NewInMemoryTracer("cadence-history")
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	shard := s.historyEngine.shard.(*shardContextImpl)
+	shard.executionManager = persistence.NewWorkflowExecutionPersistenceClient(s.mockExecutionMgr, metricsClient, tracer)
+	shard.historyMgr = persistence.NewHistoryPersistenceClient(s.mockHistoryMgr, metricsClient, tracer)
+
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(&persistence.CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	span, ctx := tracing.StartSpan(context.Background(), tracer, "StartWorkflowExecution")
+	resp, err := s.historyEngine.StartWorkflowExecution(ctx, &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:       common.StringPtr(domainID),
+			WorkflowId:   common.StringPtr(workflowID),
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr(identity),
+		},
+	})
+	tracing.FinishSpan(span, err)
+	s.Nil(err)
+	s.NotNil(resp.RunId)
+
+	spans := reporter.GetSpans()
+	s.Len(spans, 3)
+	requestSpan := spans[2].(*jaeger.Span)
+	s.Equal("StartWorkflowExecution", requestSpan.OperationName())
+	for i, operation := range []string{"persistence.AppendHistoryEvents", "persistence.CreateWorkflowExecution"} {
+		persistenceSpan := spans[i].(*jaeger.Span)
+		s.Equal(operation, persistenceSpan.OperationName())
+		s.Equal(requestSpan.SpanContext().SpanID(), persistenceSpan.SpanContext().ParentID())
+		s.Equal(requestSpan.SpanContext().TraceID(), persistenceSpan.SpanContext().TraceID())
+	}
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_CronSchedule() {
+	domainID := validDomainID
+	workflowID := "workflowID"
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	cronSchedule := "@every 5m"
+
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(request *persistence.CreateWorkflowExecutionRequest) bool {
+		if request.CronSchedule != cronSchedule || len(request.TransferTasks) != 0 {
+			return false
+		}
+		for _, task := range request.TimerTasks {
+			if backoffTask, ok := task.(*persistence.WorkflowBackoffTimerTask); ok {
+				return backoffTask.TimeoutType == persistence.WorkflowBackoffTimeoutTypeCron
+			}
+		}
+		return false
+	})).Return(&persistence.CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:       common.StringPtr(domainID),
+			WorkflowId:   common.StringPtr(workflowID),
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr(identity),
+			CronSchedule:                        common.StringPtr(cronSchedule),
+		},
+	})
+	s.Nil(err)
+	s.NotNil(resp.RunId)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_InvalidCronSchedule() {
+	domainID := validDomainID
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	_, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:       common.StringPtr(domainID),
+			WorkflowId:   common.StringPtr("workflowID"),
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("workflowType")},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr("testTaskList")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr("testIdentity"),
+			CronSchedule:                        common.StringPtr("invalid cron"),
+		},
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
 	domainID := validDomainID
 	workflowID := "workflowID"
@@ -979,7 +1229,7 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
 		nil,
 	)
 
-	resp, err := s.historyEngine.StartWorkflowExecution(&h.StartWorkflowExecutionRequest{
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
 		StartRequest: &workflow.StartWorkflowExecutionRequest{
 			Domain:       common.StringPtr(domainID),
@@ -1028,7 +1278,7 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_NonDeDup() {
 		nil,
 	)
 
-	resp, err := s.historyEngine.StartWorkflowExecution(&h.StartWorkflowExecutionRequest{
+	resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
 		StartRequest: &workflow.StartWorkflowExecutionRequest{
 			Domain:       common.StringPtr(domainID),
@@ -1099,7 +1349,7 @@ func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevSuccess() {
 			s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil).Once()
 		}
 
-		resp, err := s.historyEngine.StartWorkflowExecution(&h.StartWorkflowExecutionRequest{
+		resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
 			DomainUUID: common.StringPtr(domainID),
 			StartRequest: &workflow.StartWorkflowExecutionRequest{
 				Domain:       common.StringPtr(domainID),
@@ -1188,7 +1438,7 @@ func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevFail() {
 				s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil).Once()
 			}
 
-			resp, err := s.historyEngine.StartWorkflowExecution(&h.StartWorkflowExecutionRequest{
+			resp, err := s.historyEngine.StartWorkflowExecution(context.Background(), &h.StartWorkflowExecutionRequest{
 				DomainUUID: common.StringPtr(domainID),
 				StartRequest: &workflow.StartWorkflowExecutionRequest{
 					Domain:       common.StringPtr(domainID),
@@ -1218,7 +1468,7 @@ func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevFail() {
 
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 	sRequest := &h.SignalWithStartWorkflowExecutionRequest{}
-	_, err := s.historyEngine.SignalWithStartWorkflowExecution(sRequest)
+	_, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
 	s.EqualError(err, "BadRequestError{Message: Missing domain UUID.}")
 
 	domainID := validDomainID
@@ -1238,7 +1488,7 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 		},
 	}
 
-	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	msBuilder := newMutableStateBuilder(s.config, logging.NewDevelopmentLogger())
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: runID}
@@ -1261,14 +1511,14 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 		nil,
 	)
 
-	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(sRequest)
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
 	s.Nil(err)
 	s.Equal(runID, resp.GetRunId())
 }
 
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
 	sRequest := &h.SignalWithStartWorkflowExecutionRequest{}
-	_, err := s.historyEngine.SignalWithStartWorkflowExecution(sRequest)
+	_, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
 	s.EqualError(err, "BadRequestError{Message: Missing domain UUID.}")
 
 	domainID := validDomainID
@@ -1312,14 +1562,14 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
 		nil,
 	)
 
-	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(sRequest)
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
 	s.Nil(err)
 	s.NotNil(resp.GetRunId())
 }
 
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotRunning() {
 	sRequest := &h.SignalWithStartWorkflowExecutionRequest{}
-	_, err := s.historyEngine.SignalWithStartWorkflowExecution(sRequest)
+	_, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
 	s.EqualError(err, "BadRequestError{Message: Missing domain UUID.}")
 
 	domainID := validDomainID
@@ -1345,7 +1595,7 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotRunning()
 		},
 	}
 
-	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	msBuilder := newMutableStateBuilder(s.config, logging.NewDevelopmentLogger())
 	ms := createMutableState(msBuilder)
 	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
@@ -1369,7 +1619,7 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotRunning()
 		nil,
 	)
 
-	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(sRequest)
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
 	s.Nil(err)
 	s.NotNil(resp.GetRunId())
 	s.NotEqual(runID, resp.GetRunId())
@@ -1384,3 +1634,437 @@ func (s *engine2Suite) getBuilder(domainID string, we workflow.WorkflowExecution
 
 	return context.msBuilder
 }
+
+func (s *engine2Suite) TestResetWorkflowExecution() {
+	domainID := validDomainID
+	workflowID := "wId"
+	runID := validRunID
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	signalName := "my signal name"
+	input := []byte("test input")
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(runID)}
+
+	msBuilder := newMutableStateBuilder(s.config, logging.NewDevelopmentLogger())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", taskList, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, taskList, identity)
+	completedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.GetEventId(), nil, identity)
+	msBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+		SignalName: common.StringPtr(signalName),
+		Input:      input,
+		Identity:   common.StringPtr(identity),
+	})
+	addDecisionTaskScheduledEvent(msBuilder)
+	historyEvents := msBuilder.hBuilder.history
+	ms := createMutableState(msBuilder)
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: runID}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{
+				*s.serializeEvents(historyEvents[0:3]),
+				*s.serializeEvents(historyEvents[3:]),
+			},
+		}, nil).Once()
+
+	var appendRequests []*persistence.AppendHistoryEventsRequest
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Run(func(args mock.Arguments) {
+		appendRequests = append(appendRequests, args.Get(0).(*persistence.AppendHistoryEventsRequest))
+	}).Return(nil).Times(3)
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Return(nil).Once()
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionStarted", mock.Anything).Return(nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	resp, err := s.historyEngine.ResetWorkflowExecution(&h.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr(domainID),
+			WorkflowExecution:     &workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)},
+			Reason:                common.StringPtr("bad deploy"),
+			DecisionFinishEventId: common.Int64Ptr(completedEvent.GetEventId()),
+			Identity:              common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	newRunID := resp.GetRunId()
+	s.NotEqual(runID, newRunID)
+
+	// history before the reset point is copied to the new run, followed by the reset events
+	s.Equal(3, len(appendRequests))
+	s.Equal(newRunID, appendRequests[0].Execution.GetRunId())
+	s.Equal(common.FirstEventID, appendRequests[0].FirstEventID)
+	s.Equal(newRunID, appendRequests[1].Execution.GetRunId())
+	s.Equal(completedEvent.GetEventId(), appendRequests[1].FirstEventID)
+	newEvents := s.deserializeEvents(appendRequests[1].Events)
+	s.Equal(3, len(newEvents))
+	s.Equal(workflow.EventTypeDecisionTaskFailed, newEvents[0].GetEventType())
+	s.Equal(workflow.DecisionTaskFailedCauseResetWorkflow,
+		newEvents[0].DecisionTaskFailedEventAttributes.GetCause())
+	s.Equal(workflow.EventTypeWorkflowExecutionSignaled, newEvents[1].GetEventType())
+	s.Equal(signalName, newEvents[1].WorkflowExecutionSignaledEventAttributes.GetSignalName())
+	s.Equal(input, newEvents[1].WorkflowExecutionSignaledEventAttributes.Input)
+	s.Equal(workflow.EventTypeDecisionTaskScheduled, newEvents[2].GetEventType())
+
+	// the current run is terminated and the new run is created in the same update
+	s.Equal(runID, appendRequests[2].Execution.GetRunId())
+	s.Equal(persistence.WorkflowCloseStatusTerminated, updateRequest.ExecutionInfo.CloseStatus)
+	s.NotNil(updateRequest.ContinueAsNew)
+	s.Equal(newRunID, updateRequest.ContinueAsNew.Execution.GetRunId())
+	s.Equal(runID, updateRequest.ContinueAsNew.PreviousRunID)
+	s.Equal(newEvents[2].GetEventId(), updateRequest.ContinueAsNew.DecisionScheduleID)
+
+	// the full mutable state of the new run is written by the same update
+	resetSnapshot := updateRequest.ContinueAsNew.ResetSnapshot
+	s.NotNil(resetSnapshot)
+	s.Equal(newRunID, resetSnapshot.ExecutionInfo.RunID)
+	s.Equal(newEvents[2].GetEventId()+1, resetSnapshot.ExecutionInfo.NextEventID)
+	s.Equal(newEvents[2].GetEventId(), resetSnapshot.ExecutionInfo.DecisionScheduleID)
+	s.Equal(common.EmptyEventID, resetSnapshot.ExecutionInfo.DecisionStartedID)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "ResetMutableState", mock.Anything)
+}
+
+func (s *engine2Suite) TestResetWorkflowExecution_DuplicateRequest() {
+	domainID := validDomainID
+	workflowID := "wId"
+	runID := validRunID
+	requestID := uuid.New()
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(runID)}
+
+	// the current run is the run created by the first attempt of the reset
+	msBuilder := newMutableStateBuilder(s.config, logging.NewDevelopmentLogger())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "identity")
+	addDecisionTaskScheduledEvent(msBuilder)
+	msBuilder.executionInfo.CreateRequestID = requestID
+	ms := createMutableState(msBuilder)
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: runID}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	resp, err := s.historyEngine.ResetWorkflowExecution(&h.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr(domainID),
+			WorkflowExecution:     &workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)},
+			Reason:                common.StringPtr("bad deploy"),
+			DecisionFinishEventId: common.Int64Ptr(4),
+			RequestId:             common.StringPtr(requestID),
+		},
+	})
+	s.Nil(err)
+	s.Equal(runID, resp.GetRunId())
+	s.mockHistoryMgr.AssertNotCalled(s.T(), "GetWorkflowExecutionHistory", mock.Anything)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "CreateWorkflowExecution", mock.Anything)
+}
+
+func (s *engine2Suite) TestResetWorkflowExecution_InvalidEventID() {
+	domainID := validDomainID
+	workflowID := "wId"
+	runID := validRunID
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(runID)}
+
+	msBuilder := newMutableStateBuilder(s.config, logging.NewDevelopmentLogger())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", taskList, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, taskList, identity)
+	addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.GetEventId(), nil, identity)
+	historyEvents := msBuilder.hBuilder.history
+	ms := createMutableState(msBuilder)
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: runID}, nil)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: ms}, nil)
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*s.serializeEvents(historyEvents)},
+		}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	resetRequest := func(decisionFinishEventID int64) *h.ResetWorkflowExecutionRequest {
+		return &h.ResetWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(domainID),
+			ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+				Domain:                common.StringPtr(domainID),
+				WorkflowExecution:     &workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)},
+				Reason:                common.StringPtr("bad deploy"),
+				DecisionFinishEventId: common.Int64Ptr(decisionFinishEventID),
+				Identity:              common.StringPtr(identity),
+			},
+		}
+	}
+
+	_, err := s.historyEngine.ResetWorkflowExecution(resetRequest(msBuilder.GetNextEventID()))
+	s.Equal(errResetEventIDNotValid, err)
+
+	_, err = s.historyEngine.ResetWorkflowExecution(resetRequest(startedEvent.GetEventId()))
+	s.Equal(errResetEventNotDecisionCompleted, err)
+}
+
+func (s *engine2Suite) TestGetWorkflowReplicationTasks() {
+	domainID := validDomainID
+	workflowID := "wId"
+	runID := validRunID
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(runID)}
+
+	msBuilder := newMutableStateBuilder(s.config, logging.NewDevelopmentLogger())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", taskList, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, taskList, identity)
+	addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.GetEventId(), nil, identity)
+	historyEvents := msBuilder.hBuilder.history
+
+	var historyRequest *persistence.GetWorkflowExecutionHistoryRequest
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Run(func(args mock.Arguments) {
+		historyRequest = args.Get(0).(*persistence.GetWorkflowExecutionHistoryRequest)
+	}).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{
+				*s.serializeEvents(historyEvents[0:2]),
+				*s.serializeEvents(historyEvents[2:]),
+			},
+		}, nil).Once()
+
+	response, err := s.historyEngine.GetWorkflowReplicationTasks(&h.GetWorkflowReplicationTasksRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &we,
+		FirstEventId:      common.Int64Ptr(common.FirstEventID),
+		NextEventId:       common.Int64Ptr(msBuilder.GetNextEventID()),
+	})
+	s.Nil(err)
+	s.Equal(defaultHistoryPageSize, historyRequest.PageSize)
+	s.Equal(common.FirstEventID, historyRequest.FirstEventID)
+	s.Equal(msBuilder.GetNextEventID(), historyRequest.NextEventID)
+
+	s.Equal(2, len(response.ReplicationTasks))
+	s.Equal(cluster.TestCurrentClusterName, response.ReplicationTasks[0].GetSourceCluster())
+	s.Equal(common.FirstEventID, response.ReplicationTasks[0].GetFirstEventId())
+	s.Equal(historyEvents[2].GetEventId(), response.ReplicationTasks[0].GetNextEventId())
+	s.Equal(2, len(response.ReplicationTasks[0].History.Events))
+	s.Equal(historyEvents[2].GetEventId(), response.ReplicationTasks[1].GetFirstEventId())
+	s.Equal(msBuilder.GetNextEventID(), response.ReplicationTasks[1].GetNextEventId())
+	s.Nil(response.ReplicationTasks[1].NewRunHistory)
+}
+
+func (s *engine2Suite) TestGetDomainReplicationLag() {
+	shard := s.historyEngine.shard.(*shardContextImpl)
+	shard.transferMaxReadLevel = 20
+	s.historyEngine.replicationReader = newReplicationMessageReader(shard, s.mockExecutionMgr, s.mockHistoryMgr,
+		persistence.NewHistorySerializerFactory(), s.logger)
+	// the tasks up to 10 are applied by the remote cluster
+	s.historyEngine.replicationReader.clusterAckLevels[cluster.TestAlternativeClusterName] = 10
+
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 20,
+		BatchSize:    s.config.ReplicatorTaskBatchSize,
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{DomainID: validDomainID, TaskID: 12},
+			{DomainID: "other-domain", TaskID: 15},
+			{DomainID: validDomainID, TaskID: 20},
+		},
+	}, nil).Once()
+
+	response, err := s.historyEngine.GetDomainReplicationLag(&h.GetDomainReplicationLagRequest{
+		DomainUUID:  common.StringPtr(validDomainID),
+		ClusterName: common.StringPtr(cluster.TestAlternativeClusterName),
+	})
+	s.Nil(err)
+	s.Equal(int64(2), response.GetPendingReplicationTasks())
+}
+
+func (s *engine2Suite) TestGetDomainReplicationLagUnknownCluster() {
+	s.historyEngine.replicationReader = newReplicationMessageReader(s.historyEngine.shard, s.mockExecutionMgr,
+		s.mockHistoryMgr, persistence.NewHistorySerializerFactory(), s.logger)
+
+	_, err := s.historyEngine.GetDomainReplicationLag(&h.GetDomainReplicationLagRequest{
+		DomainUUID:  common.StringPtr(validDomainID),
+		ClusterName: common.StringPtr("unknown-cluster"),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engine2Suite) TestGetDomainReplicationLagPublishedToKafka() {
+	// the tasks published to kafka may not be applied by the remote cluster yet
+	_, err := s.historyEngine.GetDomainReplicationLag(&h.GetDomainReplicationLagRequest{
+		DomainUUID:  common.StringPtr(validDomainID),
+		ClusterName: common.StringPtr(cluster.TestAlternativeClusterName),
+	})
+	s.Equal(ErrReplicationPositionUnknown, err)
+}
+
+func (s *engine2Suite) TestGetReplicationStatus() {
+	timerAckLevel := time.Unix(0, 1000)
+	lastReplicated := time.Unix(0, 2000)
+	shard := s.historyEngine.shard.(*shardContextImpl)
+	shard.transferMaxReadLevel = 20
+	shard.shardInfo.ReplicationAckLevel = 5
+	shard.shardInfo.ClusterTransferAckLevel = map[string]int64{cluster.TestAlternativeClusterName: 7}
+	shard.shardInfo.ClusterTimerAckLevel = map[string]time.Time{cluster.TestAlternativeClusterName: timerAckLevel}
+	shard.standbyClusterCurrentTime = map[string]time.Time{cluster.TestAlternativeClusterName: lastReplicated}
+	s.historyEngine.replicationReader = newReplicationMessageReader(shard, s.mockExecutionMgr, s.mockHistoryMgr,
+		persistence.NewHistorySerializerFactory(), s.logger)
+	s.historyEngine.replicationReader.clusterAckLevels[cluster.TestAlternativeClusterName] = 10
+
+	status, err := s.historyEngine.GetReplicationStatus(&h.GetReplicationStatusRequest{})
+	s.Nil(err)
+	s.Equal(int32(shard.GetShardID()), status.GetShardId())
+	s.Equal(int64(20), status.GetMaxReadLevel())
+	s.Equal(int64(5), status.GetReplicationAckLevel())
+	s.Equal(1, len(status.RemoteClusters))
+	clusterStatus := status.RemoteClusters[cluster.TestAlternativeClusterName]
+	s.NotNil(clusterStatus)
+	s.Equal(int64(10), clusterStatus.GetReplicationAckLevel())
+	s.Equal(int64(7), clusterStatus.GetTransferAckLevel())
+	s.Equal(timerAckLevel.UnixNano(), clusterStatus.GetTimerAckLevel())
+	s.Equal(lastReplicated.UnixNano(), clusterStatus.GetLastReplicatedTimestamp())
+}
+
+func (s *engine2Suite) TestGetReplicationStatusPublishedToKafka() {
+	// the ack level of the remote clusters is unknown when the tasks are published to kafka
+	status, err := s.historyEngine.GetReplicationStatus(&h.GetReplicationStatusRequest{})
+	s.Nil(err)
+	clusterStatus := status.RemoteClusters[cluster.TestAlternativeClusterName]
+	s.NotNil(clusterStatus)
+	s.Nil(clusterStatus.ReplicationAckLevel)
+}
+
+func (s *engine2Suite) TestUpdateClusterAckLevelUnknownCluster() {
+	reader := newReplicationMessageReader(s.historyEngine.shard, s.mockExecutionMgr, s.mockHistoryMgr,
+		persistence.NewHistorySerializerFactory(), s.logger)
+
+	_, err := reader.updateClusterAckLevel("unknown-cluster", 10)
+	s.IsType(&workflow.BadRequestError{}, err)
+	s.Equal(int64(0), reader.ackLevel)
+}
+
+func (s *engine2Suite) TestUpdateClusterAckLevelOtherClusterLagging() {
+	reader := newReplicationMessageReader(s.historyEngine.shard, s.mockExecutionMgr, s.mockHistoryMgr,
+		persistence.NewHistorySerializerFactory(), s.logger)
+	// the tasks are kept until the other remote cluster processes them
+	reader.clusterAckLevels["third-cluster"] = 0
+
+	clusterAckLevel, err := reader.updateClusterAckLevel(cluster.TestAlternativeClusterName, 10)
+	s.Nil(err)
+	s.Equal(int64(10), clusterAckLevel)
+	s.Equal(int64(10), reader.getClusterAckLevels()[cluster.TestAlternativeClusterName])
+	s.Equal(int64(0), reader.ackLevel)
+	s.Equal(int64(0), s.historyEngine.shard.GetReplicatorAckLevel())
+}
+
+func (s *engine2Suite) TestUpdateClusterAckLevelCompletesTasks() {
+	reader := newReplicationMessageReader(s.historyEngine.shard, s.mockExecutionMgr, s.mockHistoryMgr,
+		persistence.NewHistorySerializerFactory(), s.logger)
+	reader.clusterAckLevels["third-cluster"] = 20
+
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: 10,
+		BatchSize:    s.config.ReplicatorTaskBatchSize,
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 5}, {TaskID: 10}},
+	}, nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask",
+		&persistence.CompleteReplicationTaskRequest{TaskID: 5}).Return(nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask",
+		&persistence.CompleteReplicationTaskRequest{TaskID: 10}).Return(nil).Once()
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.ReplicationAckLevel == 10
+	})).Return(nil).Once()
+
+	clusterAckLevel, err := reader.updateClusterAckLevel(cluster.TestAlternativeClusterName, 10)
+	s.Nil(err)
+	s.Equal(int64(10), clusterAckLevel)
+	s.Equal(int64(10), reader.ackLevel)
+	s.Equal(int64(10), s.historyEngine.shard.GetReplicatorAckLevel())
+}
+
+func (s *engine2Suite) TestUpdateClusterAckLevelCompleteTasksFailed() {
+	reader := newReplicationMessageReader(s.historyEngine.shard, s.mockExecutionMgr, s.mockHistoryMgr,
+		persistence.NewHistorySerializerFactory(), s.logger)
+
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: 10,
+		BatchSize:    s.config.ReplicatorTaskBatchSize,
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 5}, {TaskID: 10}},
+	}, nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask",
+		&persistence.CompleteReplicationTaskRequest{TaskID: 5}).Return(nil).Once()
+	s.mockExecutionMgr.On("CompleteReplicationTask",
+		&persistence.CompleteReplicationTaskRequest{TaskID: 10}).Return(&workflow.InternalServiceError{}).Once()
+
+	// the cluster ack level is recorded, the tasks are deleted on the next update
+	clusterAckLevel, err := reader.updateClusterAckLevel(cluster.TestAlternativeClusterName, 10)
+	s.Nil(err)
+	s.Equal(int64(10), clusterAckLevel)
+	s.Equal(int64(10), reader.getClusterAckLevels()[cluster.TestAlternativeClusterName])
+	s.Equal(int64(0), reader.ackLevel)
+	s.Equal(int64(0), s.historyEngine.shard.GetReplicatorAckLevel())
+}
+
+func (s *engine2Suite) serializeEvents(events []*workflow.HistoryEvent) *persistence.SerializedHistoryEventBatch {
+	serializedEvents, err := newHistoryBuilderFromEvents(events, s.config, s.logger).Serialize()
+	s.Nil(err)
+	return serializedEvents
+}
+
+func (s *engine2Suite) deserializeEvents(batch *persistence.SerializedHistoryEventBatch) []*workflow.HistoryEvent {
+	serializer, err := persistence.NewHistorySerializerFactory().Get(batch.EncodingType)
+	s.Nil(err)
+	history, err := serializer.Deserialize(batch)
+	s.Nil(err)
+	return history.Events
+}
@@ -49,9 +49,10 @@ type (
 	Engine interface {
 		common.Daemon
 		// TODO: Convert workflow.WorkflowExecution to pointer all over the place
-		StartWorkflowExecution(request *h.StartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse,
-			error)
+		StartWorkflowExecution(ctx context.Context, request *h.StartWorkflowExecutionRequest) (
+			*workflow.StartWorkflowExecutionResponse, error)
 		GetMutableState(ctx context.Context, request *h.GetMutableStateRequest) (*h.GetMutableStateResponse, error)
+		DescribeMutableState(ctx context.Context, request *h.DescribeMutableStateRequest) (*h.DescribeMutableStateResponse, error)
 		ResetStickyTaskList(resetRequest *h.ResetStickyTaskListRequest) (*h.ResetStickyTaskListResponse, error)
 		DescribeWorkflowExecution(
 			request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error)
@@ -64,14 +65,19 @@ type (
 		RespondActivityTaskCanceled(request *h.RespondActivityTaskCanceledRequest) error
 		RecordActivityTaskHeartbeat(request *h.RecordActivityTaskHeartbeatRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(request *h.RequestCancelWorkflowExecutionRequest) error
-		SignalWorkflowExecution(request *h.SignalWorkflowExecutionRequest) error
-		SignalWithStartWorkflowExecution(request *h.SignalWithStartWorkflowExecutionRequest) (
+		SignalWorkflowExecution(ctx context.Context, request *h.SignalWorkflowExecutionRequest) error
+		SignalWithStartWorkflowExecution(ctx context.Context, request *h.SignalWithStartWorkflowExecutionRequest) (
 			*workflow.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(request *h.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(request *h.TerminateWorkflowExecutionRequest) error
+		ResetWorkflowExecution(request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(request *h.RecordChildExecutionCompletedRequest) error
 		ReplicateEvents(request *h.ReplicateEventsRequest) error
+		GetReplicationMessages(request *h.GetReplicationMessagesRequest) (*h.GetReplicationMessagesResponse, error)
+		GetReplicationStatus(request *h.GetReplicationStatusRequest) (*h.ShardReplicationStatus, error)
+		GetDomainReplicationLag(request *h.GetDomainReplicationLagRequest) (*h.GetDomainReplicationLagResponse, error)
+		GetWorkflowReplicationTasks(request *h.GetWorkflowReplicationTasksRequest) (*h.GetWorkflowReplicationTasksResponse, error)
 	}
 
 	// EngineFactory is used to create an instance of sharded history engine
@@ -0,0 +1,82 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// getHistoryBatches reads a page of the history of a run, the batches of events are returned in the order they were
+// persisted and the events from nextEventID on are dropped
+func getHistoryBatches(historyMgr persistence.HistoryManager, hSerializerFactory persistence.HistorySerializerFactory,
+	domainID string, execution shared.WorkflowExecution, firstEventID, nextEventID int64,
+	nextPageToken []byte) ([]*shared.History, []byte, error) {
+
+	response, err := historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:      domainID,
+		Execution:     execution,
+		FirstEventID:  firstEventID,
+		NextEventID:   nextEventID,
+		PageSize:      defaultHistoryPageSize,
+		NextPageToken: nextPageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var batches []*shared.History
+	for _, e := range response.Events {
+		persistence.SetSerializedHistoryDefaults(&e)
+		s, _ := hSerializerFactory.Get(e.EncodingType)
+		history, err := s.Deserialize(&e)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// the last batch could contain events beyond the requested range
+		var events []*shared.HistoryEvent
+		for _, event := range history.Events {
+			if event.GetEventId() < nextEventID {
+				events = append(events, event)
+			}
+		}
+		if len(events) > 0 {
+			batches = append(batches, &shared.History{Events: events})
+		}
+	}
+	return batches, response.NextPageToken, nil
+}
+
+// replayHistoryBatch applies a batch of events read from the history of a run to the mutable state rebuilt from that
+// history, the event IDs of the mutable state are moved past the batch the same way persisting it would
+func replayHistoryBatch(sBuilder *stateBuilder, domainID, requestID string, execution shared.WorkflowExecution,
+	batch *shared.History) error {
+
+	lastEvent, _, _, err := sBuilder.applyEvents(common.EmptyVersion, "", domainID, requestID, execution, batch, nil)
+	if err != nil {
+		return err
+	}
+	sBuilder.msBuilder.executionInfo.LastFirstEventID = batch.Events[0].GetEventId()
+	sBuilder.msBuilder.executionInfo.NextEventID = lastEvent.GetEventId() + 1
+	return nil
+}
@@ -23,57 +23,97 @@ package history
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pborman/uuid"
-	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
 type (
 	historyReplicator struct {
-		shard             ShardContext
-		historyEngine     *historyEngineImpl
-		historyCache      *historyCache
-		domainCache       cache.DomainCache
-		historyMgr        persistence.HistoryManager
-		historySerializer persistence.HistorySerializer
-		metadataMgr       cluster.Metadata
-		logger            bark.Logger
+		shard                    ShardContext
+		historyEngine            *historyEngineImpl
+		historyCache             *historyCache
+		domainCache              cache.DomainCache
+		historyMgr               persistence.HistoryManager
+		historySerializerFactory persistence.HistorySerializerFactory
+		metadataMgr              cluster.Metadata
+		historyResender          *historyResender
+		metricsClient            metrics.Client
+		logger                   logging.Logger
+
+		// gaps are the gaps in the history of the workflow executions before the replication tasks which cannot be
+		// applied yet, the missing events are only resent once a gap persists as their tasks may merely be late.
+		// A gap is forgotten once filled, when its workflow execution is dropped, or after ReplicatorResendGapTTL.
+		gapsLock sync.Mutex
+		gaps     cache.Cache
+	}
+
+	// replicationGap is a gap in the history of a workflow execution from its next event
+	replicationGap struct {
+		nextEventID int64
+		firstSeen   time.Time
+		attempts    int
 	}
 )
 
 func newHistoryReplicator(shard ShardContext, historyEngine *historyEngineImpl, historyCache *historyCache, domainCache cache.DomainCache,
-	historyMgr persistence.HistoryManager, logger bark.Logger) *historyReplicator {
+	historyMgr persistence.HistoryManager, historyResender *historyResender, logger logging.Logger) *historyReplicator {
+	config := shard.GetConfig()
 	replicator := &historyReplicator{
-		shard:             shard,
-		historyEngine:     historyEngine,
-		historyCache:      historyCache,
-		domainCache:       domainCache,
-		historyMgr:        historyMgr,
-		historySerializer: persistence.NewJSONHistorySerializer(),
-		metadataMgr:       shard.GetService().GetClusterMetadata(),
-		logger:            logger,
+		shard:                    shard,
+		historyEngine:            historyEngine,
+		historyCache:             historyCache,
+		domainCache:              domainCache,
+		historyMgr:               historyMgr,
+		historySerializerFactory: persistence.NewHistorySerializerFactory(),
+		metadataMgr:              shard.GetService().GetClusterMetadata(),
+		historyResender:          historyResender,
+		metricsClient:            shard.GetMetricsClient(),
+		logger:                   logger,
+		gaps:                     cache.New(config.ReplicatorResendGapMaxSize, &cache.Options{TTL: config.ReplicatorResendGapTTL}),
 	}
 
 	return replicator
 }
 
-func (r *historyReplicator) ApplyEvents(request *h.ReplicateEventsRequest) (retError error) {
+func (r *historyReplicator) ApplyEvents(request *h.ReplicateEventsRequest) error {
 	if request == nil || request.History == nil || len(request.History.Events) == 0 {
 		r.logger.Warn("Dropping empty replication task")
 		return nil
 	}
 
+	missingEventID, err := r.applyEvents(request)
+	if missingEventID == common.EmptyEventID {
+		return err
+	}
+
+	// the missing events are resent without holding the lock of the workflow execution, err is returned when the
+	// resend is not configured
+	if resendErr := r.resendMissingEvents(request, missingEventID); resendErr != client.ErrUnknownRemoteCluster {
+		return resendErr
+	}
+	return err
+}
+
+// applyEvents applies a replication task to its workflow execution.  When the events before the task are missing
+// for long enough, it returns the first missing event to be resent by the source cluster, common.EmptyEventID
+// otherwise.
+func (r *historyReplicator) applyEvents(request *h.ReplicateEventsRequest) (missingEventID int64, retError error) {
+	missingEventID = common.EmptyEventID
 	domainID, err := validateDomainUUID(request.DomainUUID)
 	if err != nil {
-		return err
+		return missingEventID, err
 	}
 
 	execution := *request.WorkflowExecution
@@ -89,13 +129,18 @@ func (r *historyReplicator) ApplyEvents(request *h.ReplicateEventsRequest) (retE
 		var release releaseWorkflowExecutionFunc
 		context, release, err = r.historyCache.getOrCreateWorkflowExecution(domainID, execution)
 		if err != nil {
-			return err
+			return missingEventID, err
 		}
 		defer func() { release(retError) }()
 
 		msBuilder, err = context.loadWorkflowExecution()
 		if err != nil {
-			return err
+			// the replication task starting the workflow execution may be late, or lost
+			if _, ok := err.(*shared.EntityNotExistsError); ok &&
+				r.isGapPersistent(domainID, execution, common.FirstEventID) {
+				missingEventID = common.FirstEventID
+			}
+			return missingEventID, err
 		}
 
 		rState := msBuilder.replicationState
@@ -103,9 +148,11 @@ func (r *historyReplicator) ApplyEvents(request *h.ReplicateEventsRequest) (retE
 		if rState.CurrentVersion > request.GetVersion() {
 			// Replication state is already on a higher version, we can drop this event
 			// TODO: We need to replay external events like signal to the new version
-			r.logger.Warnf("Dropping stale replication task.  Current Version: %v, Task Version: %v", rState.CurrentVersion,
-				request.GetVersion())
-			return nil
+			r.logger.Warn("Dropping stale replication task.",
+				tag.WorkflowVersion(rState.CurrentVersion),
+				tag.IncomingVersion(request.GetVersion()))
+			r.clearGap(domainID, execution)
+			return missingEventID, nil
 		}
 
 		// Check if this is the first event after failover
@@ -113,58 +160,72 @@ func (r *historyReplicator) ApplyEvents(request *h.ReplicateEventsRequest) (retE
 			previousActiveCluster := r.metadataMgr.ClusterNameForFailoverVersion(rState.CurrentVersion)
 			ri, ok := request.ReplicationInfo[previousActiveCluster]
 			if !ok {
-				r.logger.Errorf("No replication information found for previous active cluster.  Previous: %v, Current: %v",
-					previousActiveCluster, request.GetSourceCluster())
+				r.logger.Error("No replication information found for previous active cluster.",
+					tag.PrevActiveCluster(previousActiveCluster),
+					tag.SourceCluster(request.GetSourceCluster()))
 
 				// TODO: Handle missing replication information
-				return nil
+				return missingEventID, nil
 			}
 
 			// Detect conflict
 			if ri.GetLastEventId() != rState.LastWriteEventID {
-				r.logger.Infof("Conflict detected.  State: {Version: %, LastWriteEventID: %v}, Task: {SourceCluster: %v, Version: %v, LastEventID: %v}",
-					rState.CurrentVersion, rState.LastWriteEventID, request.GetSourceCluster(), ri.GetVersion(),
-					ri.GetLastEventId())
+				r.logger.Info("Conflict detected.",
+					tag.WorkflowVersion(rState.CurrentVersion),
+					tag.WorkflowLastEventID(rState.LastWriteEventID),
+					tag.SourceCluster(request.GetSourceCluster()),
+					tag.IncomingVersion(ri.GetVersion()),
+					tag.WorkflowHistoryEventID(ri.GetLastEventId()))
 
 				resolver := newConflictResolver(r.shard, context, r.historyMgr, r.logger)
 				msBuilder, err = resolver.reset(ri.GetLastEventId())
 				if err != nil {
-					return err
+					return missingEventID, err
 				}
 			}
 		}
 
 		// Check for duplicate processing of replication task
 		if firstEvent.GetEventId() < msBuilder.GetNextEventID() {
-			return nil
+			if !msBuilder.HasBufferedReplicationTasks() {
+				r.clearGap(domainID, execution)
+			}
+			return missingEventID, nil
 		}
 
 		// Check for out of order replication task and store it in the buffer
 		if firstEvent.GetEventId() > msBuilder.GetNextEventID() {
 			if err := msBuilder.BufferReplicationTask(request); err != nil {
-				return errors.New("failed to add buffered replication task")
+				return missingEventID, errors.New("failed to add buffered replication task")
 			}
 
-			return nil
+			// the replication tasks of the events in between may be late, or lost
+			if r.isGapPersistent(domainID, execution, msBuilder.GetNextEventID()) {
+				missingEventID = msBuilder.GetNextEventID()
+			}
+			return missingEventID, nil
 		}
 	}
 
 	// First check if there are events which needs to be flushed before applying the update
 	err = r.FlushBuffer(context, msBuilder, request)
 	if err != nil {
-		return err
+		return missingEventID, err
 	}
 
 	// Apply the replication task
 	err = r.ApplyReplicationTask(context, msBuilder, request)
 	if err != nil {
-		return err
+		return missingEventID, err
 	}
 
 	// Flush buffered replication tasks after applying the update
 	err = r.FlushBuffer(context, msBuilder, request)
+	if err == nil && !msBuilder.HasBufferedReplicationTasks() {
+		r.clearGap(domainID, execution)
+	}
 
-	return err
+	return missingEventID, err
 }
 
 func (r *historyReplicator) ApplyReplicationTask(context *workflowExecutionContext, msBuilder *mutableStateBuilder,
@@ -285,6 +346,16 @@ func (r *historyReplicator) ApplyReplicationTask(context *workflowExecutionConte
 				ContinueAsNew:               !isBrandNew,
 				PreviousRunID:               prevRunID,
 				ReplicationState:            replicationState,
+				CronSchedule:                msBuilder.executionInfo.CronSchedule,
+				Attempt:                     msBuilder.executionInfo.Attempt,
+				HasRetryPolicy:              msBuilder.executionInfo.HasRetryPolicy,
+				InitialInterval:             msBuilder.executionInfo.InitialInterval,
+				BackoffCoefficient:          msBuilder.executionInfo.BackoffCoefficient,
+				MaximumInterval:             msBuilder.executionInfo.MaximumInterval,
+				ExpirationTime:              msBuilder.executionInfo.ExpirationTime,
+				MaximumAttempts:             msBuilder.executionInfo.MaximumAttempts,
+				NonRetriableErrors:          msBuilder.executionInfo.NonRetriableErrors,
+				SearchAttributes:            msBuilder.executionInfo.SearchAttributes,
 			})
 
 			if err != nil {
@@ -381,9 +452,84 @@ func (r *historyReplicator) FlushBuffer(context *workflowExecutionContext, msBui
 	return nil
 }
 
+// resendMissingEvents applies the replication tasks resent by the source cluster for the events of the workflow
+// execution from missingEventID up to the first event of the request, then the request.  The lock of the workflow
+// execution is only held to apply each task.  client.ErrUnknownRemoteCluster is returned when the resend is not
+// configured.
+func (r *historyReplicator) resendMissingEvents(request *h.ReplicateEventsRequest, missingEventID int64) error {
+	r.metricsClient.IncCounter(metrics.HistoryReplicateEventsScope, metrics.ReplicationHistoryResendRequests)
+	tasks, err := r.historyResender.getMissingReplicationTasks(request.GetSourceCluster(), request.GetDomainUUID(),
+		request.WorkflowExecution, missingEventID, request.GetFirstEventId())
+	if err != nil {
+		if err == client.ErrUnknownRemoteCluster {
+			return err
+		}
+		r.metricsClient.IncCounter(metrics.HistoryReplicateEventsScope, metrics.ReplicationHistoryResendFailures)
+		if _, ok := err.(*shared.EntityNotExistsError); ok || err == errResentEventsMismatch {
+			// the resend cannot succeed later on, the gap is tracked again when the next task hits it
+			r.clearGap(request.GetDomainUUID(), *request.WorkflowExecution)
+		}
+		r.logger.Warn("Failed to resend the missing events of the workflow execution.",
+			tag.SourceCluster(request.GetSourceCluster()),
+			tag.WorkflowID(request.WorkflowExecution.GetWorkflowId()),
+			tag.WorkflowRunID(request.WorkflowExecution.GetRunId()),
+			tag.Error(err))
+		return err
+	}
+
+	r.logger.Info("Resending the missing events of the workflow execution.",
+		tag.SourceCluster(request.GetSourceCluster()),
+		tag.WorkflowID(request.WorkflowExecution.GetWorkflowId()),
+		tag.WorkflowRunID(request.WorkflowExecution.GetRunId()),
+		tag.WorkflowNextEventID(missingEventID),
+		tag.WorkflowHistoryEventID(request.GetFirstEventId()))
+	for _, task := range tasks {
+		if _, err := r.applyEvents(task); err != nil {
+			return err
+		}
+	}
+
+	// the request is a duplicate when it was buffered and flushed with the resent tasks
+	_, err = r.applyEvents(request)
+	return err
+}
+
+// isGapPersistent records a gap in the history of a workflow execution from nextEventID, it returns true once the gap
+// persisted for ReplicatorResendGapDelay or was hit ReplicatorResendGapAttempts times
+func (r *historyReplicator) isGapPersistent(domainID string, execution shared.WorkflowExecution,
+	nextEventID int64) bool {
+	key := workflowIdentifier{domainID: domainID, workflowID: execution.GetWorkflowId(), runID: execution.GetRunId()}
+	now := time.Now()
+
+	r.gapsLock.Lock()
+	defer r.gapsLock.Unlock()
+	gap, ok := r.gaps.Get(key).(*replicationGap)
+	if !ok || gap.nextEventID != nextEventID {
+		gap = &replicationGap{nextEventID: nextEventID, firstSeen: now}
+		r.gaps.Put(key, gap)
+	}
+	gap.attempts++
+
+	config := r.shard.GetConfig()
+	return gap.attempts >= config.ReplicatorResendGapAttempts || now.Sub(gap.firstSeen) >= config.ReplicatorResendGapDelay
+}
+
+// clearGap forgets the gap in the history of a workflow execution once it is filled or the execution is dropped
+func (r *historyReplicator) clearGap(domainID string, execution shared.WorkflowExecution) {
+	key := workflowIdentifier{domainID: domainID, workflowID: execution.GetWorkflowId(), runID: execution.GetRunId()}
+
+	r.gapsLock.Lock()
+	defer r.gapsLock.Unlock()
+	r.gaps.Delete(key)
+}
+
 func (r *historyReplicator) Serialize(history *shared.History) (*persistence.SerializedHistoryEventBatch, error) {
 	eventBatch := persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(), history.Events)
-	h, err := r.historySerializer.Serialize(eventBatch)
+	serializer, err := r.historySerializerFactory.Get(common.EncodingType(r.shard.GetConfig().EventEncodingType()))
+	if err != nil {
+		return nil, err
+	}
+	h, err := serializer.Serialize(eventBatch)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,205 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	historyReplicatorSuite struct {
+		suite.Suite
+		*require.Assertions
+		mockCtrl            *gomock.Controller
+		mockAdminClient     *adminservicetest.MockClient
+		mockExecutionMgr    *mocks.ExecutionManager
+		mockClusterMetadata *mocks.ClusterMetadata
+		config              *Config
+		execution           workflow.WorkflowExecution
+		replicator          *historyReplicator
+	}
+)
+
+func TestHistoryReplicatorSuite(t *testing.T) {
+	s := new(historyReplicatorSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyReplicatorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockAdminClient = adminservicetest.NewMockClient(s.mockCtrl)
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.config = NewConfig(dynamicconfig.NewNopCollection(), 1)
+	s.config.ReplicatorResendGapAttempts = 2
+	s.config.ReplicatorResendGapDelay = time.Hour
+	s.execution = workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("replicated-workflow"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	logger := logging.NewNopLogger()
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	shard := &shardContextImpl{
+		service:          service.NewTestService(s.mockClusterMetadata, nil, metricsClient, logger),
+		shardInfo:        &persistence.ShardInfo{ShardID: 0, RangeID: 1},
+		executionManager: s.mockExecutionMgr,
+		config:           s.config,
+		logger:           logger,
+		metricsClient:    metricsClient,
+	}
+	resender := newHistoryResender(nil)
+	resender.adminClients[testResendSourceCluster] = s.mockAdminClient
+	s.replicator = newHistoryReplicator(shard, nil, newHistoryCache(shard, logger), nil, nil, resender, logger)
+}
+
+func (s *historyReplicatorSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+	s.mockExecutionMgr.AssertExpectations(s.T())
+}
+
+func (s *historyReplicatorSuite) TestIsGapPersistent_Attempts() {
+	s.False(s.replicator.isGapPersistent(validDomainID, s.execution, 5))
+	s.True(s.replicator.isGapPersistent(validDomainID, s.execution, 5))
+
+	// the gap moved, the replication tasks of the events in between were late
+	s.False(s.replicator.isGapPersistent(validDomainID, s.execution, 7))
+
+	// the gap was filled
+	s.replicator.clearGap(validDomainID, s.execution)
+	s.False(s.replicator.isGapPersistent(validDomainID, s.execution, 7))
+}
+
+func (s *historyReplicatorSuite) TestIsGapPersistent_Delay() {
+	s.config.ReplicatorResendGapAttempts = 100
+	s.False(s.replicator.isGapPersistent(validDomainID, s.execution, 5))
+
+	s.config.ReplicatorResendGapDelay = 0
+	s.True(s.replicator.isGapPersistent(validDomainID, s.execution, 5))
+}
+
+func (s *historyReplicatorSuite) TestIsGapPersistent_Expired() {
+	s.replicator.gaps = cache.New(10, &cache.Options{TTL: time.Millisecond})
+	s.False(s.replicator.isGapPersistent(validDomainID, s.execution, 5))
+
+	// the gap is tracked again from scratch once expired
+	time.Sleep(2 * time.Millisecond)
+	s.False(s.replicator.isGapPersistent(validDomainID, s.execution, 5))
+	s.True(s.replicator.isGapPersistent(validDomainID, s.execution, 5))
+}
+
+func (s *historyReplicatorSuite) TestIsGapPersistent_MaxSize() {
+	s.replicator.gaps = cache.New(1, nil)
+	otherExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("other-replicated-workflow"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	s.False(s.replicator.isGapPersistent(validDomainID, s.execution, 5))
+	s.False(s.replicator.isGapPersistent(validDomainID, otherExecution, 5))
+	s.Equal(1, s.replicator.gaps.Size())
+
+	// the gap of the least recently hit execution was evicted
+	s.False(s.replicator.isGapPersistent(validDomainID, s.execution, 5))
+}
+
+func (s *historyReplicatorSuite) TestApplyEvents_MissingStartResentOutsideLock() {
+	request := &h.ReplicateEventsRequest{
+		SourceCluster:     common.StringPtr(testResendSourceCluster),
+		DomainUUID:        common.StringPtr(validDomainID),
+		WorkflowExecution: &s.execution,
+		FirstEventId:      common.Int64Ptr(5),
+		NextEventId:       common.Int64Ptr(6),
+		Version:           common.Int64Ptr(1),
+		History: &workflow.History{Events: []*workflow.HistoryEvent{{
+			EventId:   common.Int64Ptr(5),
+			EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionSignaled),
+		}}},
+	}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{}).Twice()
+
+	// the replication task starting the workflow execution may merely be late
+	err := s.replicator.ApplyEvents(request)
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+
+	s.mockAdminClient.EXPECT().GetWorkflowReplicationTasks(gomock.Any(), &h.GetWorkflowReplicationTasksRequest{
+		DomainUUID:        common.StringPtr(validDomainID),
+		WorkflowExecution: &s.execution,
+		FirstEventId:      common.Int64Ptr(common.FirstEventID),
+		NextEventId:       common.Int64Ptr(5),
+		MaximumPageSize:   common.Int32Ptr(historyResendPageSize),
+	}).Do(func(ctx context.Context, request *h.GetWorkflowReplicationTasksRequest) {
+		s.True(s.isExecutionUnlocked())
+	}).Return(&h.GetWorkflowReplicationTasksResponse{
+		ReplicationTasks: []*h.ReplicateEventsRequest{{
+			FirstEventId: common.Int64Ptr(3),
+			NextEventId:  common.Int64Ptr(5),
+		}},
+	}, nil)
+
+	err = s.replicator.ApplyEvents(request)
+	s.Equal(errResentEventsMismatch, err)
+
+	// the resend cannot succeed later on, the gap is forgotten
+	s.Equal(0, s.replicator.gaps.Size())
+}
+
+// isExecutionUnlocked returns true when the workflow execution can be locked
+func (s *historyReplicatorSuite) isExecutionUnlocked() bool {
+	lockedCh := make(chan struct{})
+	go func() {
+		_, release, err := s.replicator.historyCache.getOrCreateWorkflowExecution(validDomainID, s.execution)
+		if err == nil {
+			release(nil)
+		}
+		close(lockedCh)
+	}()
+
+	select {
+	case <-lockedCh:
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
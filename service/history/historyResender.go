@@ -0,0 +1,129 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// historyResender fetches from the source cluster the replication tasks of the history events missed by the
+	// current cluster, when their replication tasks were lost by the replication transport.  It is shared by all the
+	// shards of the host, since each admin client of a remote cluster opens its own connection.
+	historyResender struct {
+		clientFactory client.Factory
+
+		sync.Mutex
+		adminClients map[string]adminserviceclient.Interface
+	}
+)
+
+const (
+	historyResendTimeout  = 30 * time.Second
+	historyResendPageSize = 100
+)
+
+var errResentEventsMismatch = errors.New("resent replication tasks do not follow the history of the workflow execution")
+
+func newHistoryResender(clientFactory client.Factory) *historyResender {
+	return &historyResender{
+		clientFactory: clientFactory,
+		adminClients:  make(map[string]adminserviceclient.Interface),
+	}
+}
+
+// getReplicationTasks returns the replication tasks of the events of a workflow execution from firstEventID up to
+// nextEventID, rebuilt by the source cluster from its history.  The tasks may stop short of nextEventID, in which
+// case the caller is expected to ask for the remaining events.  client.ErrUnknownRemoteCluster is returned when the
+// frontend address of the source cluster is not configured.
+func (r *historyResender) getReplicationTasks(sourceCluster, domainID string, execution *shared.WorkflowExecution,
+	firstEventID, nextEventID int64) ([]*h.ReplicateEventsRequest, error) {
+
+	adminClient, err := r.getAdminClient(sourceCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), historyResendTimeout)
+	defer cancel()
+	response, err := adminClient.GetWorkflowReplicationTasks(ctx, &h.GetWorkflowReplicationTasksRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: execution,
+		FirstEventId:      common.Int64Ptr(firstEventID),
+		NextEventId:       common.Int64Ptr(nextEventID),
+		MaximumPageSize:   common.Int32Ptr(historyResendPageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.ReplicationTasks, nil
+}
+
+// getMissingReplicationTasks returns the replication tasks of the events of a workflow execution from firstEventID up
+// to nextEventID, asking the source cluster for as many pages as needed.  errResentEventsMismatch is returned when
+// the tasks do not follow each other from firstEventID.
+func (r *historyResender) getMissingReplicationTasks(sourceCluster, domainID string,
+	execution *shared.WorkflowExecution, firstEventID, nextEventID int64) ([]*h.ReplicateEventsRequest, error) {
+
+	var missingTasks []*h.ReplicateEventsRequest
+	for firstEventID < nextEventID {
+		tasks, err := r.getReplicationTasks(sourceCluster, domainID, execution, firstEventID, nextEventID)
+		if err != nil {
+			return nil, err
+		}
+		if len(tasks) == 0 {
+			return nil, errResentEventsMismatch
+		}
+		for _, task := range tasks {
+			if task.GetFirstEventId() != firstEventID || task.GetNextEventId() <= firstEventID {
+				return nil, errResentEventsMismatch
+			}
+			firstEventID = task.GetNextEventId()
+			missingTasks = append(missingTasks, task)
+		}
+	}
+	return missingTasks, nil
+}
+
+func (r *historyResender) getAdminClient(cluster string) (adminserviceclient.Interface, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if adminClient, ok := r.adminClients[cluster]; ok {
+		return adminClient, nil
+	}
+
+	adminClient, err := r.clientFactory.NewRemoteAdminClient(cluster)
+	if err != nil {
+		return nil, err
+	}
+	r.adminClients[cluster] = adminClient
+	return adminClient, nil
+}
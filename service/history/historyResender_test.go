@@ -0,0 +1,132 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	historyResenderSuite struct {
+		suite.Suite
+		*require.Assertions
+		mockCtrl        *gomock.Controller
+		mockAdminClient *adminservicetest.MockClient
+		execution       *workflow.WorkflowExecution
+		resender        *historyResender
+	}
+)
+
+const testResendSourceCluster = "active"
+
+func TestHistoryResenderSuite(t *testing.T) {
+	s := new(historyResenderSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyResenderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockAdminClient = adminservicetest.NewMockClient(s.mockCtrl)
+	s.execution = &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("resend-workflow"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	s.resender = newHistoryResender(nil)
+	s.resender.adminClients[testResendSourceCluster] = s.mockAdminClient
+}
+
+func (s *historyResenderSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *historyResenderSuite) TestGetMissingReplicationTasks_MissingStart() {
+	// the source cluster returns the tasks in pages
+	s.expectGetWorkflowReplicationTasks(common.FirstEventID, 6, [][2]int64{{1, 3}, {3, 4}})
+	s.expectGetWorkflowReplicationTasks(4, 6, [][2]int64{{4, 6}})
+
+	tasks, err := s.resender.getMissingReplicationTasks(testResendSourceCluster, validDomainID, s.execution,
+		common.FirstEventID, 6)
+	s.Nil(err)
+	s.Equal(3, len(tasks))
+	s.Equal(common.FirstEventID, tasks[0].GetFirstEventId())
+	s.Equal(int64(3), tasks[1].GetFirstEventId())
+	s.Equal(int64(4), tasks[2].GetFirstEventId())
+	s.Equal(int64(6), tasks[2].GetNextEventId())
+}
+
+func (s *historyResenderSuite) TestGetMissingReplicationTasks_GapInTheMiddle() {
+	s.expectGetWorkflowReplicationTasks(5, 8, [][2]int64{{5, 7}, {7, 8}})
+
+	tasks, err := s.resender.getMissingReplicationTasks(testResendSourceCluster, validDomainID, s.execution, 5, 8)
+	s.Nil(err)
+	s.Equal(2, len(tasks))
+	s.Equal(int64(5), tasks[0].GetFirstEventId())
+	s.Equal(int64(7), tasks[1].GetFirstEventId())
+}
+
+func (s *historyResenderSuite) TestGetMissingReplicationTasks_Mismatch() {
+	// the resent tasks skip the first missing event
+	s.expectGetWorkflowReplicationTasks(5, 8, [][2]int64{{6, 8}})
+	_, err := s.resender.getMissingReplicationTasks(testResendSourceCluster, validDomainID, s.execution, 5, 8)
+	s.Equal(errResentEventsMismatch, err)
+
+	// the resent tasks have a gap
+	s.expectGetWorkflowReplicationTasks(5, 8, [][2]int64{{5, 6}, {7, 8}})
+	_, err = s.resender.getMissingReplicationTasks(testResendSourceCluster, validDomainID, s.execution, 5, 8)
+	s.Equal(errResentEventsMismatch, err)
+
+	// the source cluster has none of the missing events
+	s.expectGetWorkflowReplicationTasks(5, 8, nil)
+	_, err = s.resender.getMissingReplicationTasks(testResendSourceCluster, validDomainID, s.execution, 5, 8)
+	s.Equal(errResentEventsMismatch, err)
+}
+
+// expectGetWorkflowReplicationTasks expects the source cluster to be asked for the events from firstEventID up to
+// nextEventID, and to return a task for each of the given event ranges
+func (s *historyResenderSuite) expectGetWorkflowReplicationTasks(firstEventID, nextEventID int64, eventRanges [][2]int64) {
+	var tasks []*h.ReplicateEventsRequest
+	for _, eventRange := range eventRanges {
+		tasks = append(tasks, &h.ReplicateEventsRequest{
+			SourceCluster:     common.StringPtr(testResendSourceCluster),
+			DomainUUID:        common.StringPtr(validDomainID),
+			WorkflowExecution: s.execution,
+			FirstEventId:      common.Int64Ptr(eventRange[0]),
+			NextEventId:       common.Int64Ptr(eventRange[1]),
+		})
+	}
+	s.mockAdminClient.EXPECT().GetWorkflowReplicationTasks(gomock.Any(), &h.GetWorkflowReplicationTasksRequest{
+		DomainUUID:        common.StringPtr(validDomainID),
+		WorkflowExecution: s.execution,
+		FirstEventId:      common.Int64Ptr(firstEventID),
+		NextEventId:       common.Int64Ptr(nextEventID),
+		MaximumPageSize:   common.Int32Ptr(historyResendPageSize),
+	}).Return(&h.GetWorkflowReplicationTasksResponse{ReplicationTasks: tasks}, nil)
+}
@@ -21,17 +21,17 @@
 package history
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	log "github.com/sirupsen/logrus"
-
-	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -72,7 +72,7 @@ type (
 		executionMgr              persistence.ExecutionManager
 		domainCache               cache.DomainCache
 		config                    *Config
-		logger                    bark.Logger
+		logger                    logging.Logger
 		metricsClient             metrics.Client
 		standbyClusterCurrentTime map[string]time.Time
 	}
@@ -89,7 +89,7 @@ var _ ShardContext = (*TestShardContext)(nil)
 func newTestShardContext(shardInfo *persistence.ShardInfo, transferSequenceNumber int64,
 	historyMgr persistence.HistoryManager, executionMgr persistence.ExecutionManager,
 	metadataMgr persistence.MetadataManager, clusterMetadata cluster.Metadata, config *Config,
-	logger bark.Logger) *TestShardContext {
+	logger logging.Logger) *TestShardContext {
 	domainCache := cache.NewDomainCache(metadataMgr, clusterMetadata, logger)
 	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
 
@@ -261,8 +261,10 @@ func (s *TestShardContext) UpdateWorkflowExecution(request *persistence.UpdateWo
 			panic(err)
 		}
 		task.SetTaskID(seqID)
-		s.logger.Infof("%v: TestShardContext: Assigning timer (timestamp: %v, seq: %v)",
-			time.Now().UTC(), persistence.GetVisibilityTSFrom(task).UTC(), task.GetTaskID())
+		s.logger.Info("TestShardContext: Assigning timer",
+			tag.Timestamp(persistence.GetVisibilityTSFrom(task).UTC()),
+			tag.TaskID(task.GetTaskID()))
+
 	}
 	return s.executionMgr.UpdateWorkflowExecution(request)
 }
@@ -288,7 +290,7 @@ func (s *TestShardContext) GetConfig() *Config {
 }
 
 // GetLogger test implementation
-func (s *TestShardContext) GetLogger() bark.Logger {
+func (s *TestShardContext) GetLogger() logging.Logger {
 	return s.logger
 }
 
@@ -297,6 +299,11 @@ func (s *TestShardContext) GetMetricsClient() metrics.Client {
 	return s.metricsClient
 }
 
+// WithContext test implementation
+func (s *TestShardContext) WithContext(ctx context.Context) ShardContext {
+	return s
+}
+
 // Reset test implementation
 func (s *TestShardContext) Reset() {
 	atomic.StoreInt64(&s.shardInfo.RangeID, 0)
@@ -340,7 +347,7 @@ func (s *TestShardContext) GetCurrentTime(cluster string) time.Time {
 // SetupWorkflowStoreWithOptions to setup workflow test base
 func (s *TestBase) SetupWorkflowStoreWithOptions(options persistence.TestBaseOptions) {
 	s.TestBase.SetupWorkflowStoreWithOptions(options)
-	log := bark.NewLoggerFromLogrus(log.New())
+	log := logging.NewDevelopmentLogger()
 	config := NewConfig(dynamicconfig.NewNopCollection(), 1)
 	clusterMetadata := cluster.GetTestClusterMetadata(options.EnableGlobalDomain, options.IsMasterCluster)
 	s.ShardContext = newTestShardContext(s.ShardInfo, 0, s.HistoryMgr, s.WorkflowMgr, s.MetadataManager, clusterMetadata,
@@ -351,7 +358,7 @@ func (s *TestBase) SetupWorkflowStoreWithOptions(options persistence.TestBaseOpt
 // SetupWorkflowStore to setup workflow test base
 func (s *TestBase) SetupWorkflowStore() {
 	s.TestBase.SetupWorkflowStore()
-	log := bark.NewLoggerFromLogrus(log.New())
+	log := logging.NewDevelopmentLogger()
 	config := NewConfig(dynamicconfig.NewNopCollection(), 1)
 	clusterMetadata := cluster.GetTestClusterMetadata(false, false)
 	s.ShardContext = newTestShardContext(s.ShardInfo, 0, s.HistoryMgr, s.WorkflowMgr, s.MetadataManager, clusterMetadata,
@@ -28,12 +28,13 @@ import (
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
 
 	"github.com/pborman/uuid"
-	"github.com/uber-common/bark"
 )
 
 const (
@@ -81,7 +82,7 @@ type (
 		hBuilder         *historyBuilder
 		eventSerializer  historyEventSerializer
 		config           *Config
-		logger           bark.Logger
+		logger           logging.Logger
 	}
 
 	mutableStateSessionUpdates struct {
@@ -118,7 +119,7 @@ type (
 	}
 )
 
-func newMutableStateBuilder(config *Config, logger bark.Logger) *mutableStateBuilder {
+func newMutableStateBuilder(config *Config, logger logging.Logger) *mutableStateBuilder {
 	s := &mutableStateBuilder{
 		updateActivityInfos:             make(map[*persistence.ActivityInfo]struct{}),
 		pendingActivityInfoIDs:          make(map[int64]*persistence.ActivityInfo),
@@ -160,7 +161,7 @@ func newMutableStateBuilder(config *Config, logger bark.Logger) *mutableStateBui
 	return s
 }
 
-func newMutableStateBuilderWithReplicationState(config *Config, logger bark.Logger, version int64) *mutableStateBuilder {
+func newMutableStateBuilderWithReplicationState(config *Config, logger logging.Logger, version int64) *mutableStateBuilder {
 	s := newMutableStateBuilder(config, logger)
 	s.replicationState = &persistence.ReplicationState{
 		StartVersion:   version,
@@ -186,6 +187,22 @@ func (e *mutableStateBuilder) Load(state *persistence.WorkflowMutableState) {
 	}
 }
 
+// CopyToPersistence returns the workflow mutable state in the form it is loaded from persistence
+func (e *mutableStateBuilder) CopyToPersistence() *persistence.WorkflowMutableState {
+	return &persistence.WorkflowMutableState{
+		ActivitInfos:             e.pendingActivityInfoIDs,
+		TimerInfos:               e.pendingTimerInfoIDs,
+		ChildExecutionInfos:      e.pendingChildExecutionInfoIDs,
+		RequestCancelInfos:       e.pendingRequestCancelInfoIDs,
+		SignalInfos:              e.pendingSignalInfoIDs,
+		SignalRequestedIDs:       e.pendingSignalRequestedIDs,
+		ExecutionInfo:            e.executionInfo,
+		ReplicationState:         e.replicationState,
+		BufferedEvents:           e.bufferedEvents,
+		BufferedReplicationTasks: e.bufferedReplicationTasks,
+	}
+}
+
 func (e *mutableStateBuilder) ResetSnapshot() *persistence.ResetMutableStateRequest {
 	insertActivities := make([]*persistence.ActivityInfo, 0, len(e.pendingActivityInfoIDs))
 	for _, info := range e.pendingActivityInfoIDs {
@@ -246,8 +263,7 @@ func (e *mutableStateBuilder) FlushBufferedEvents() error {
 	// no decision in-flight, flush all buffered events to committed bucket
 	if !e.HasInFlightDecisionTask() {
 		flush := func(bufferedEventBatch *persistence.SerializedHistoryEventBatch) error {
-			// TODO: get serializer based on eventBatch's EncodingType when we support multiple encoding
-			eventBatch, err := e.hBuilder.serializer.Deserialize(bufferedEventBatch)
+			eventBatch, err := e.hBuilder.deserialize(bufferedEventBatch)
 			if err != nil {
 				logging.LogHistoryDeserializationErrorEvent(e.logger, err, "Unable to serialize execution history for update.")
 				return err
@@ -290,7 +306,7 @@ func (e *mutableStateBuilder) FlushBufferedEvents() error {
 	if e.HasInFlightDecisionTask() && len(newBufferedEvents) > 0 {
 		// decision in-flight, and some new events needs to be buffered
 		bufferedBatch := persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(), newBufferedEvents)
-		serializedEvents, err := e.hBuilder.serializer.Serialize(bufferedBatch)
+		serializedEvents, err := e.hBuilder.serialize(bufferedBatch)
 		if err != nil {
 			logging.LogHistorySerializationErrorEvent(e.logger, err, "Unable to serialize execution history for update.")
 			return err
@@ -405,7 +421,7 @@ func (e *mutableStateBuilder) BufferReplicationTask(
 	var serializedHistoryBatch, serializedNewRunHistoryBatch *persistence.SerializedHistoryEventBatch
 	if request.History != nil {
 		historyBatch := persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(), request.History.Events)
-		serializedHistoryBatch, err = e.hBuilder.serializer.Serialize(historyBatch)
+		serializedHistoryBatch, err = e.hBuilder.serialize(historyBatch)
 		if err != nil {
 			return err
 		}
@@ -414,7 +430,7 @@ func (e *mutableStateBuilder) BufferReplicationTask(
 	if request.NewRunHistory != nil {
 		newRunHistoryBatch := persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(),
 			request.NewRunHistory.Events)
-		serializedNewRunHistoryBatch, err = e.hBuilder.serializer.Serialize(newRunHistoryBatch)
+		serializedNewRunHistoryBatch, err = e.hBuilder.serialize(newRunHistoryBatch)
 		if err != nil {
 			return err
 		}
@@ -450,7 +466,7 @@ func (e *mutableStateBuilder) GetBufferedHistory(
 
 	if serializedHistory != nil {
 		var history []*workflow.HistoryEvent
-		batch, err := e.hBuilder.serializer.Deserialize(serializedHistory)
+		batch, err := e.hBuilder.deserialize(serializedHistory)
 		if err != nil {
 			// TODO: return proper error here
 			return nil
@@ -672,7 +688,8 @@ func (e *mutableStateBuilder) shouldBufferEvent(eventType workflow.EventType) bo
 		workflow.EventTypeRequestCancelExternalWorkflowExecutionInitiated,
 		workflow.EventTypeMarkerRecorded,
 		workflow.EventTypeStartChildWorkflowExecutionInitiated,
-		workflow.EventTypeSignalExternalWorkflowExecutionInitiated:
+		workflow.EventTypeSignalExternalWorkflowExecutionInitiated,
+		workflow.EventTypeUpsertWorkflowSearchAttributes:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -938,6 +955,36 @@ func (e *mutableStateBuilder) HasInFlightDecisionTask() bool {
 	return e.executionInfo.DecisionStartedID > 0
 }
 
+// hasProcessedOrPendingDecisionTask returns false for a run which is still waiting on its workflow backoff timer to
+// schedule the first decision
+func (e *mutableStateBuilder) hasProcessedOrPendingDecisionTask() bool {
+	return e.HasPendingDecisionTask() || e.executionInfo.LastProcessedEvent != common.EmptyEventID
+}
+
+// isFirstDecision returns true if the given decision is the first one of the run, which is not always scheduled right
+// after the WorkflowExecutionStarted event for runs started with a backoff
+func (e *mutableStateBuilder) isFirstDecision(di *decisionInfo) bool {
+	return di.Attempt == 0 && e.executionInfo.LastProcessedEvent == common.EmptyEventID
+}
+
+// isWorkflowBackoffPending returns true for a run of a cron workflow which is not yet due or a retry of a workflow
+// which is still backing off, no decision should be scheduled for such a run until the workflow backoff timer fires
+func (e *mutableStateBuilder) isWorkflowBackoffPending() bool {
+	return (e.executionInfo.CronSchedule != "" || e.executionInfo.Attempt > 0) && !e.hasProcessedOrPendingDecisionTask()
+}
+
+// getRetryBackoffInterval returns the interval to wait before retrying the workflow after it failed with the given
+// reason, or backoff.NoBackoff if the workflow should not be retried
+func (e *mutableStateBuilder) getRetryBackoffInterval(errReason string) time.Duration {
+	info := e.executionInfo
+	if !info.HasRetryPolicy {
+		return backoff.NoBackoff
+	}
+
+	return getBackoffInterval(info.Attempt, info.MaximumAttempts, info.InitialInterval, info.MaximumInterval,
+		info.BackoffCoefficient, time.Now(), info.ExpirationTime, errReason, info.NonRetriableErrors)
+}
+
 func (e *mutableStateBuilder) HasBufferedEvents() bool {
 	if len(e.bufferedEvents) > 0 || e.updateBufferedEvents != nil {
 		return true
@@ -970,8 +1017,14 @@ func (e *mutableStateBuilder) UpdateDecision(di *decisionInfo) {
 	e.executionInfo.DecisionAttempt = di.Attempt
 	e.executionInfo.DecisionTimestamp = di.Timestamp
 
-	e.logger.Debugf("Decision Updated: {Schedule: %v, Started: %v, ID: %v, Timeout: %v, Attempt: %v, Timestamp: %v}",
-		di.ScheduleID, di.StartedID, di.RequestID, di.DecisionTimeout, di.Attempt, di.Timestamp)
+	e.logger.Debug("Decision Updated.",
+		tag.WorkflowScheduleID(di.ScheduleID),
+		tag.WorkflowStartedID(di.StartedID),
+		tag.WorkflowRequestID(di.RequestID),
+		tag.WorkflowTimeout(di.DecisionTimeout),
+		tag.Attempt(di.Attempt),
+		tag.Timestamp(time.Unix(0, di.Timestamp)))
+
 }
 
 // DeleteDecision deletes a decision task.
@@ -1099,11 +1152,33 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEventForContinueAsNew(d
 		Input:    attributes.Input,
 		Identity: nil,
 	}
+	if previousExecutionState.executionInfo.CronSchedule != "" {
+		// cron schedule is carried over to every run of the workflow
+		createRequest.CronSchedule = common.StringPtr(previousExecutionState.executionInfo.CronSchedule)
+	}
+	createRequest.RetryPolicy = attributes.RetryPolicy
+	createRequest.SearchAttributes = attributes.SearchAttributes
+	if createRequest.SearchAttributes == nil {
+		// search attributes are carried over unless the new run overrides them
+		createRequest.SearchAttributes = persistence.NewSearchAttributes(previousExecutionState.executionInfo.SearchAttributes)
+	}
 
 	req := &h.StartWorkflowExecutionRequest{
-		DomainUUID:          common.StringPtr(domainID),
-		StartRequest:        createRequest,
-		ParentExecutionInfo: parentExecutionInfo,
+		DomainUUID:                      common.StringPtr(domainID),
+		StartRequest:                    createRequest,
+		ParentExecutionInfo:             parentExecutionInfo,
+		ContinueAsNewInitiator:          attributes.Initiator,
+		FirstDecisionTaskBackoffSeconds: common.Int32Ptr(attributes.GetBackoffStartIntervalInSeconds()),
+	}
+	if attributes.GetInitiator() == workflow.ContinueAsNewInitiatorRetryPolicy {
+		// a retry keeps counting attempts against the expiration of the original run
+		req.Attempt = common.Int32Ptr(previousExecutionState.executionInfo.Attempt + 1)
+		if !previousExecutionState.executionInfo.ExpirationTime.IsZero() {
+			req.ExpirationTimestamp = common.Int64Ptr(previousExecutionState.executionInfo.ExpirationTime.UnixNano())
+		}
+	} else {
+		req.ExpirationTimestamp = getWorkflowExpirationTimestamp(attributes.RetryPolicy,
+			attributes.GetBackoffStartIntervalInSeconds(), time.Now())
 	}
 
 	// History event only has domainName so domainID has to be passed in explicitly to update the mutable state
@@ -1124,7 +1199,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(execution workflo
 	request := startRequest.StartRequest
 	eventID := e.GetNextEventID()
 	if eventID != common.FirstEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowStarted, eventID, "")
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionWorkflowStarted, eventID, "")
 		return nil
 	}
 
@@ -1158,6 +1233,20 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(domainID st
 	e.executionInfo.DecisionStartedID = common.EmptyEventID
 	e.executionInfo.DecisionRequestID = emptyUUID
 	e.executionInfo.DecisionTimeout = 0
+	e.executionInfo.CronSchedule = event.GetCronSchedule()
+	e.executionInfo.SearchAttributes = persistence.GetSearchAttributesFields(event.SearchAttributes)
+	e.executionInfo.Attempt = event.GetAttempt()
+	if event.GetExpirationTimestamp() > 0 {
+		e.executionInfo.ExpirationTime = time.Unix(0, event.GetExpirationTimestamp())
+	}
+	if event.RetryPolicy != nil {
+		e.executionInfo.HasRetryPolicy = true
+		e.executionInfo.InitialInterval = event.RetryPolicy.GetInitialIntervalInSeconds()
+		e.executionInfo.BackoffCoefficient = event.RetryPolicy.GetBackoffCoefficient()
+		e.executionInfo.MaximumInterval = event.RetryPolicy.GetMaximumIntervalInSeconds()
+		e.executionInfo.MaximumAttempts = event.RetryPolicy.GetMaximumAttempts()
+		e.executionInfo.NonRetriableErrors = event.RetryPolicy.NonRetriableErrorReasons
+	}
 
 	if parentDomainID != nil {
 		e.executionInfo.ParentDomainID = *parentDomainID
@@ -1173,7 +1262,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(domainID st
 
 func (e *mutableStateBuilder) AddDecisionTaskScheduledEvent() *decisionInfo {
 	if e.HasPendingDecisionTask() {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskScheduled, e.GetNextEventID(),
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionDecisionTaskScheduled, e.GetNextEventID(),
 			fmt.Sprintf("{Pending Decision ScheduleID: %v}", e.executionInfo.DecisionScheduleID))
 		return nil
 	}
@@ -1224,7 +1313,7 @@ func (e *mutableStateBuilder) AddDecisionTaskStartedEvent(scheduleEventID int64,
 	hasPendingDecision := e.HasPendingDecisionTask()
 	di, ok := e.GetPendingDecision(scheduleEventID)
 	if !hasPendingDecision || !ok || di.StartedID != common.EmptyEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskStarted, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionDecisionTaskStarted, e.GetNextEventID(), fmt.Sprintf(
 			"{HasPending: %v, ScheduleID: %v, Exist: %v, Value: %v}", hasPendingDecision, scheduleEventID, ok, e))
 		return nil, nil
 	}
@@ -1303,7 +1392,7 @@ func (e *mutableStateBuilder) AddDecisionTaskCompletedEvent(scheduleEventID, sta
 	hasPendingDecision := e.HasPendingDecisionTask()
 	di, ok := e.GetPendingDecision(scheduleEventID)
 	if !hasPendingDecision || !ok || di.StartedID != startedEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskCompleted, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionDecisionTaskCompleted, e.GetNextEventID(), fmt.Sprintf(
 			"{HasPending: %v, ScheduleID: %v, StartedID: %v, Exist: %v}", hasPendingDecision, scheduleEventID,
 			startedEventID, ok))
 		return nil
@@ -1334,7 +1423,7 @@ func (e *mutableStateBuilder) AddDecisionTaskTimedOutEvent(scheduleEventID int64
 	hasPendingDecision := e.HasPendingDecisionTask()
 	dt, ok := e.GetPendingDecision(scheduleEventID)
 	if !hasPendingDecision || !ok || dt.StartedID != startedEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskTimedOut, e.GetNextEventID(),
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionDecisionTaskTimedOut, e.GetNextEventID(),
 			fmt.Sprintf("{HasPending: %v, ScheduleID: %v, StartedID: %v, Exist: %v}", hasPendingDecision, scheduleEventID,
 				startedEventID, ok))
 		return nil
@@ -1356,7 +1445,7 @@ func (e *mutableStateBuilder) ReplicateDecisionTaskTimedOutEvent(scheduleID, sta
 
 func (e *mutableStateBuilder) AddDecisionTaskScheduleToStartTimeoutEvent(scheduleEventID int64) *workflow.HistoryEvent {
 	if e.executionInfo.DecisionScheduleID != scheduleEventID || e.executionInfo.DecisionStartedID > 0 {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskTimedOut, e.GetNextEventID(),
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionDecisionTaskTimedOut, e.GetNextEventID(),
 			fmt.Sprintf("{DecisionScheduleID: %v, DecisionStartedID: %v, ScheduleEventID: %v}",
 				e.executionInfo.DecisionScheduleID, e.executionInfo.DecisionStartedID, scheduleEventID))
 		return nil
@@ -1377,7 +1466,7 @@ func (e *mutableStateBuilder) AddDecisionTaskFailedEvent(scheduleEventID int64,
 	hasPendingDecision := e.HasPendingDecisionTask()
 	dt, ok := e.GetPendingDecision(scheduleEventID)
 	if !hasPendingDecision || !ok || dt.StartedID != startedEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskFailed, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionDecisionTaskFailed, e.GetNextEventID(), fmt.Sprintf(
 			"{HasPending: %v, ScheduleID: %v, StartedID: %v, Exist: %v}", hasPendingDecision, scheduleEventID,
 			startedEventID, ok))
 		return nil
@@ -1400,7 +1489,7 @@ func (e *mutableStateBuilder) ReplicateDecisionTaskFailedEvent(scheduleID, start
 func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID int64,
 	attributes *workflow.ScheduleActivityTaskDecisionAttributes) (*workflow.HistoryEvent, *persistence.ActivityInfo) {
 	if ai, ok := e.GetActivityInfo(e.GetNextEventID()); ok {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskScheduled, ai.ScheduleID, fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionActivityTaskScheduled, ai.ScheduleID, fmt.Sprintf(
 			"{Exist: %v, Value: %v}", ok, ai.StartedID))
 		return nil, nil
 	}
@@ -1530,7 +1619,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskStartedEvent(event *workflow.
 func (e *mutableStateBuilder) AddActivityTaskCompletedEvent(scheduleEventID, startedEventID int64,
 	request *workflow.RespondActivityTaskCompletedRequest) *workflow.HistoryEvent {
 	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskCompleted, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionActivityTaskCompleted, e.GetNextEventID(), fmt.Sprintf(
 			"{ScheduleID: %v, StartedID: %v, Exist: %v}", scheduleEventID, startedEventID, ok))
 		return nil
 	}
@@ -1554,7 +1643,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskCompletedEvent(event *workflo
 func (e *mutableStateBuilder) AddActivityTaskFailedEvent(scheduleEventID, startedEventID int64,
 	request *workflow.RespondActivityTaskFailedRequest) *workflow.HistoryEvent {
 	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskFailed, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionActivityTaskFailed, e.GetNextEventID(), fmt.Sprintf(
 			"{ScheduleID: %v, StartedID: %v, Exist: %v}", scheduleEventID, startedEventID, ok))
 		return nil
 	}
@@ -1580,7 +1669,7 @@ func (e *mutableStateBuilder) AddActivityTaskTimedOutEvent(scheduleEventID, star
 	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID ||
 		((timeoutType == workflow.TimeoutTypeStartToClose || timeoutType == workflow.TimeoutTypeHeartbeat) &&
 			ai.StartedID == common.EmptyEventID) {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskTimedOut, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionActivityTaskTimedOut, e.GetNextEventID(), fmt.Sprintf(
 			"{ScheduleID: %v, StartedID: %v, TimeOutType: %v, Exist: %v}", scheduleEventID, startedEventID,
 			timeoutType, ok))
 		return nil
@@ -1608,7 +1697,7 @@ func (e *mutableStateBuilder) AddActivityTaskCancelRequestedEvent(decisionComple
 
 	ai, isRunning := e.GetActivityByActivityID(activityID)
 	if !isRunning || ai.CancelRequested {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskCancelRequest, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionActivityTaskCancelRequest, e.GetNextEventID(), fmt.Sprintf(
 			"{isRunning: %v, ActivityID: %v}", isRunning, activityID))
 		return nil, nil, false
 	}
@@ -1641,14 +1730,14 @@ func (e *mutableStateBuilder) AddActivityTaskCanceledEvent(scheduleEventID, star
 	latestCancelRequestedEventID int64, details []byte, identity string) *workflow.HistoryEvent {
 	ai, ok := e.GetActivityInfo(scheduleEventID)
 	if !ok || ai.StartedID != startedEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskCanceled, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionActivityTaskCanceled, e.GetNextEventID(), fmt.Sprintf(
 			"{ScheduleID: %v, StartedID: %v, Exist: %v}", scheduleEventID, startedEventID, ok))
 		return nil
 	}
 
 	// Verify cancel request as well.
 	if !ai.CancelRequested {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskCanceled, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionActivityTaskCanceled, e.GetNextEventID(), fmt.Sprintf(
 			"{No outstanding cancel request. ScheduleID: %v, ActivityID: %v, Exist: %v, Value: %v}",
 			scheduleEventID, ai.ActivityID, ok, ai.StartedID))
 		return nil
@@ -1674,7 +1763,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskCanceledEvent(event *workflow
 func (e *mutableStateBuilder) AddCompletedWorkflowEvent(decisionCompletedEventID int64,
 	attributes *workflow.CompleteWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionCompleteWorkflow, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionCompleteWorkflow, e.GetNextEventID(), fmt.Sprintf(
 			"{State: %v}", e.executionInfo.State))
 		return nil
 	}
@@ -1693,7 +1782,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionCompletedEvent(event *wo
 func (e *mutableStateBuilder) AddFailWorkflowEvent(decisionCompletedEventID int64,
 	attributes *workflow.FailWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionFailWorkflow, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionFailWorkflow, e.GetNextEventID(), fmt.Sprintf(
 			"{State: %v}", e.executionInfo.State))
 		return nil
 	}
@@ -1712,7 +1801,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionFailedEvent(event *workf
 
 func (e *mutableStateBuilder) AddTimeoutWorkflowEvent() *workflow.HistoryEvent {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionTimeoutWorkflow, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionTimeoutWorkflow, e.GetNextEventID(), fmt.Sprintf(
 			"{State: %v}", e.executionInfo.State))
 		return nil
 	}
@@ -1732,7 +1821,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionTimedoutEvent(event *wor
 func (e *mutableStateBuilder) AddWorkflowExecutionCancelRequestedEvent(cause string,
 	request *h.RequestCancelWorkflowExecutionRequest) *workflow.HistoryEvent {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted || e.executionInfo.CancelRequested {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionRequestCancelWorkflow, e.GetNextEventID(),
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionRequestCancelWorkflow, e.GetNextEventID(),
 			fmt.Sprintf("{State: %v, CancelRequested: %v, RequestID: %v}", e.executionInfo.State,
 				e.executionInfo.CancelRequested, e.executionInfo.CancelRequestID))
 
@@ -1754,7 +1843,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionCancelRequestedEvent(eve
 func (e *mutableStateBuilder) AddWorkflowExecutionCanceledEvent(decisionTaskCompletedEventID int64,
 	attributes *workflow.CancelWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowCanceled, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionWorkflowCanceled, e.GetNextEventID(), fmt.Sprintf(
 			"{State: %v}", e.executionInfo.State))
 	}
 
@@ -1802,7 +1891,7 @@ func (e *mutableStateBuilder) AddExternalWorkflowExecutionCancelRequested(initia
 	domain, workflowID, runID string) *workflow.HistoryEvent {
 	_, ok := e.GetRequestCancelInfo(initiatedID)
 	if !ok {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowCancelRequested, e.GetNextEventID(),
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionWorkflowCancelRequested, e.GetNextEventID(),
 			fmt.Sprintf("{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 
 		return nil
@@ -1824,7 +1913,7 @@ func (e *mutableStateBuilder) AddRequestCancelExternalWorkflowExecutionFailedEve
 	domain, workflowID, runID string, cause workflow.CancelExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
 	_, ok := e.GetRequestCancelInfo(initiatedID)
 	if !ok {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowCancelFailed, e.GetNextEventID(),
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionWorkflowCancelFailed, e.GetNextEventID(),
 			fmt.Sprintf("{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 
 		return nil
@@ -1878,7 +1967,7 @@ func (e *mutableStateBuilder) AddExternalWorkflowExecutionSignaled(initiatedID i
 	domain, workflowID, runID string, control []byte) *workflow.HistoryEvent {
 	_, ok := e.GetSignalInfo(initiatedID)
 	if !ok {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowSignalRequested, e.GetNextEventID(),
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionWorkflowSignalRequested, e.GetNextEventID(),
 			fmt.Sprintf("{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 		return nil
 	}
@@ -1899,7 +1988,7 @@ func (e *mutableStateBuilder) AddSignalExternalWorkflowExecutionFailedEvent(
 	control []byte, cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
 	_, ok := e.GetSignalInfo(initiatedID)
 	if !ok {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowSignalFailed, e.GetNextEventID(),
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionWorkflowSignalFailed, e.GetNextEventID(),
 			fmt.Sprintf("{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 
 		return nil
@@ -1922,7 +2011,7 @@ func (e *mutableStateBuilder) AddTimerStartedEvent(decisionCompletedEventID int6
 	timerID := request.GetTimerId()
 	isTimerRunning, ti := e.GetUserTimer(timerID)
 	if isTimerRunning {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionTimerStarted, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionTimerStarted, e.GetNextEventID(), fmt.Sprintf(
 			"{IsTimerRunning: %v, TimerID: %v, StartedID: %v}", isTimerRunning, timerID, ti.StartedID))
 		return nil, nil
 	}
@@ -1958,7 +2047,7 @@ func (e *mutableStateBuilder) ReplicateTimerStartedEvent(event *workflow.History
 func (e *mutableStateBuilder) AddTimerFiredEvent(startedEventID int64, timerID string) *workflow.HistoryEvent {
 	isTimerRunning, _ := e.GetUserTimer(timerID)
 	if !isTimerRunning {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionTimerFired, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionTimerFired, e.GetNextEventID(), fmt.Sprintf(
 			"{startedEventID: %v, Exist: %v, TimerID: %v}", startedEventID, isTimerRunning, timerID))
 		return nil
 	}
@@ -1982,7 +2071,7 @@ func (e *mutableStateBuilder) AddTimerCanceledEvent(decisionCompletedEventID int
 	timerID := *attributes.TimerId
 	isTimerRunning, ti := e.GetUserTimer(timerID)
 	if !isTimerRunning {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionTimerCanceled, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionTimerCanceled, e.GetNextEventID(), fmt.Sprintf(
 			"{IsTimerRunning: %v, timerID: %v}", isTimerRunning, timerID))
 		return nil
 	}
@@ -2015,10 +2104,33 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(decisionCompletedEventID int6
 	return e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes)
 }
 
+func (e *mutableStateBuilder) AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID int64,
+	attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	event := e.hBuilder.AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID, attributes)
+	e.ReplicateUpsertWorkflowSearchAttributesEvent(event)
+
+	return event
+}
+
+// ReplicateUpsertWorkflowSearchAttributesEvent merges the upserted search attributes into the ones of the execution
+func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(event *workflow.HistoryEvent) {
+	upsertFields := persistence.GetSearchAttributesFields(
+		event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes)
+	// a new map is created as the current one may still be referenced by the persisted execution info
+	searchAttributes := make(map[string][]byte, len(e.executionInfo.SearchAttributes)+len(upsertFields))
+	for key, value := range e.executionInfo.SearchAttributes {
+		searchAttributes[key] = value
+	}
+	for key, value := range upsertFields {
+		searchAttributes[key] = value
+	}
+	e.executionInfo.SearchAttributes = searchAttributes
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 	request *workflow.TerminateWorkflowExecutionRequest) *workflow.HistoryEvent {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowTerminated, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionWorkflowTerminated, e.GetNextEventID(), fmt.Sprintf(
 			"{State: %v}", e.executionInfo.State))
 		return nil
 	}
@@ -2038,7 +2150,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionTerminatedEvent(event *w
 func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 	request *workflow.SignalWorkflowExecutionRequest) *workflow.HistoryEvent {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowSignaled, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionWorkflowSignaled, e.GetNextEventID(), fmt.Sprintf(
 			"{State: %v}", e.executionInfo.State))
 		return nil
 	}
@@ -2051,7 +2163,7 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 	parentDomainName string, attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) (*workflow.HistoryEvent, *mutableStateBuilder,
 	error) {
 	if e.hasPendingTasks() || e.HasPendingDecisionTask() {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionContinueAsNew, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionContinueAsNew, e.GetNextEventID(), fmt.Sprintf(
 			"{OutStandingActivityTasks: %v, HasPendingDecision: %v}", len(e.pendingActivityInfoIDs),
 			e.HasPendingDecisionTask()))
 	}
@@ -2090,9 +2202,14 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 	if startedEvent == nil {
 		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
-	di := newStateBuilder.AddDecisionTaskScheduledEvent()
-	if di == nil {
-		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
+
+	// First decision for the new run is scheduled by the workflow backoff timer when there is a backoff
+	var di *decisionInfo
+	if attributes.GetBackoffStartIntervalInSeconds() == 0 {
+		di = newStateBuilder.AddDecisionTaskScheduledEvent()
+		if di == nil {
+			return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
+		}
 	}
 
 	e.ReplicateWorkflowExecutionContinuedAsNewEvent("", domainID, continueAsNewEvent, startedEvent, di, newStateBuilder)
@@ -2128,16 +2245,34 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(sour
 		initiatedID = newStateBuilder.executionInfo.InitiatedID
 	}
 
-	if newStateBuilder.replicationState != nil {
-		newStateBuilder.updateReplicationStateLastEventID(sourceClusterName, di.ScheduleID)
+	lastEventID := startedEvent.GetEventId()
+	var newTransferTasks []persistence.Task
+	decisionVersion := common.EmptyVersion
+	decisionScheduleID := common.EmptyEventID
+	decisionStartID := common.EmptyEventID
+	decisionTimeout := int32(0)
+	if di != nil {
+		lastEventID = di.ScheduleID
+		newTransferTasks = []persistence.Task{&persistence.DecisionTask{
+			DomainID:   domainID,
+			TaskList:   newStateBuilder.executionInfo.TaskList,
+			ScheduleID: di.ScheduleID,
+		}}
+		decisionVersion = di.Version
+		decisionScheduleID = di.ScheduleID
+		decisionStartID = di.StartedID
+		decisionTimeout = di.DecisionTimeout
 	}
 
-	newTransferTasks := []persistence.Task{&persistence.DecisionTask{
-		DomainID:   domainID,
-		TaskList:   newStateBuilder.executionInfo.TaskList,
-		ScheduleID: di.ScheduleID,
-	}}
-	setTaskVersion(newStateBuilder.GetCurrentVersion(), newTransferTasks, nil)
+	var newTimerTasks []persistence.Task
+	if backoffTimerTask := getWorkflowBackoffTimerTask(startedEvent); backoffTimerTask != nil {
+		newTimerTasks = append(newTimerTasks, backoffTimerTask)
+	}
+
+	if newStateBuilder.replicationState != nil {
+		newStateBuilder.updateReplicationStateLastEventID(sourceClusterName, lastEventID)
+	}
+	setTaskVersion(newStateBuilder.GetCurrentVersion(), newTransferTasks, newTimerTasks)
 
 	e.continueAsNew = &persistence.CreateWorkflowExecutionRequest{
 		RequestID:                   uuid.New(),
@@ -2154,13 +2289,24 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(sour
 		NextEventID:                 newStateBuilder.GetNextEventID(),
 		LastProcessedEvent:          common.EmptyEventID,
 		TransferTasks:               newTransferTasks,
-		DecisionVersion:             di.Version,
-		DecisionScheduleID:          di.ScheduleID,
-		DecisionStartedID:           di.StartedID,
-		DecisionStartToCloseTimeout: di.DecisionTimeout,
+		TimerTasks:                  newTimerTasks,
+		DecisionVersion:             decisionVersion,
+		DecisionScheduleID:          decisionScheduleID,
+		DecisionStartedID:           decisionStartID,
+		DecisionStartToCloseTimeout: decisionTimeout,
 		ContinueAsNew:               true,
 		PreviousRunID:               prevRunID,
 		ReplicationState:            newStateBuilder.replicationState,
+		CronSchedule:                newStateBuilder.executionInfo.CronSchedule,
+		Attempt:                     newStateBuilder.executionInfo.Attempt,
+		HasRetryPolicy:              newStateBuilder.executionInfo.HasRetryPolicy,
+		InitialInterval:             newStateBuilder.executionInfo.InitialInterval,
+		BackoffCoefficient:          newStateBuilder.executionInfo.BackoffCoefficient,
+		MaximumInterval:             newStateBuilder.executionInfo.MaximumInterval,
+		ExpirationTime:              newStateBuilder.executionInfo.ExpirationTime,
+		MaximumAttempts:             newStateBuilder.executionInfo.MaximumAttempts,
+		NonRetriableErrors:          newStateBuilder.executionInfo.NonRetriableErrors,
+		SearchAttributes:            newStateBuilder.executionInfo.SearchAttributes,
 	}
 }
 
@@ -2202,7 +2348,7 @@ func (e *mutableStateBuilder) AddChildWorkflowExecutionStartedEvent(domain *stri
 	workflowType *workflow.WorkflowType, initiatedID int64) *workflow.HistoryEvent {
 	ci, ok := e.GetChildExecutionInfo(initiatedID)
 	if !ok || ci.StartedID != common.EmptyEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionChildExecutionStarted, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionChildExecutionStarted, e.GetNextEventID(), fmt.Sprintf(
 			"{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 		return nil
 	}
@@ -2237,7 +2383,7 @@ func (e *mutableStateBuilder) AddStartChildWorkflowExecutionFailedEvent(initiate
 	initiatedEventAttributes *workflow.StartChildWorkflowExecutionInitiatedEventAttributes) *workflow.HistoryEvent {
 	ci, ok := e.GetChildExecutionInfo(initiatedID)
 	if !ok || ci.StartedID != common.EmptyEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionStartChildExecutionFailed, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionStartChildExecutionFailed, e.GetNextEventID(), fmt.Sprintf(
 			"{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 		return nil
 	}
@@ -2260,7 +2406,7 @@ func (e *mutableStateBuilder) AddChildWorkflowExecutionCompletedEvent(initiatedI
 	attributes *workflow.WorkflowExecutionCompletedEventAttributes) *workflow.HistoryEvent {
 	ci, ok := e.GetChildExecutionInfo(initiatedID)
 	if !ok || ci.StartedID == common.EmptyEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionChildExecutionCompleted, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionChildExecutionCompleted, e.GetNextEventID(), fmt.Sprintf(
 			"{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 		return nil
 	}
@@ -2288,7 +2434,7 @@ func (e *mutableStateBuilder) AddChildWorkflowExecutionFailedEvent(initiatedID i
 	attributes *workflow.WorkflowExecutionFailedEventAttributes) *workflow.HistoryEvent {
 	ci, ok := e.GetChildExecutionInfo(initiatedID)
 	if !ok || ci.StartedID == common.EmptyEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionChildExecutionFailed, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionChildExecutionFailed, e.GetNextEventID(), fmt.Sprintf(
 			"{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 		return nil
 	}
@@ -2316,7 +2462,7 @@ func (e *mutableStateBuilder) AddChildWorkflowExecutionCanceledEvent(initiatedID
 	attributes *workflow.WorkflowExecutionCanceledEventAttributes) *workflow.HistoryEvent {
 	ci, ok := e.GetChildExecutionInfo(initiatedID)
 	if !ok || ci.StartedID == common.EmptyEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionChildExecutionCanceled, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionChildExecutionCanceled, e.GetNextEventID(), fmt.Sprintf(
 			"{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 		return nil
 	}
@@ -2344,7 +2490,7 @@ func (e *mutableStateBuilder) AddChildWorkflowExecutionTerminatedEvent(initiated
 	attributes *workflow.WorkflowExecutionTerminatedEventAttributes) *workflow.HistoryEvent {
 	ci, ok := e.GetChildExecutionInfo(initiatedID)
 	if !ok || ci.StartedID == common.EmptyEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionChildExecutionTerminated, e.GetNextEventID(), fmt.Sprintf(
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionChildExecutionTerminated, e.GetNextEventID(), fmt.Sprintf(
 			"{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 		return nil
 	}
@@ -2372,7 +2518,7 @@ func (e *mutableStateBuilder) AddChildWorkflowExecutionTimedOutEvent(initiatedID
 	attributes *workflow.WorkflowExecutionTimedOutEventAttributes) *workflow.HistoryEvent {
 	ci, ok := e.GetChildExecutionInfo(initiatedID)
 	if !ok || ci.StartedID == common.EmptyEventID {
-		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionChildExecutionTimedOut, e.GetNextEventID(),
+		logging.LogInvalidHistoryActionEvent(e.logger, tag.ActionChildExecutionTimedOut, e.GetNextEventID(),
 			fmt.Sprintf("{InitiatedID: %v, Exist: %v}", initiatedID, ok))
 		return nil
 	}
@@ -26,8 +26,8 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
@@ -35,7 +35,7 @@ type (
 	mutableStateSuite struct {
 		suite.Suite
 		msBuilder *mutableStateBuilder
-		logger    bark.Logger
+		logger    logging.Logger
 	}
 )
 
@@ -56,7 +56,7 @@ func (s *mutableStateSuite) TearDownSuite() {
 }
 
 func (s *mutableStateSuite) SetupTest() {
-	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.logger = logging.NewDevelopmentLogger()
 	s.msBuilder = newMutableStateBuilder(NewConfig(dynamicconfig.NewNopCollection(), 1), s.logger)
 }
 
@@ -23,11 +23,10 @@ package history
 import (
 	"sync"
 
-	"github.com/uber-common/bark"
-
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 )
 
@@ -41,7 +40,7 @@ type (
 		shard         ShardContext
 		options       *QueueProcessorOptions
 		processor     processor
-		logger        bark.Logger
+		logger        logging.Logger
 		metricsClient metrics.Client
 		finishedChan  chan struct{}
 
@@ -56,7 +55,7 @@ type (
 	}
 )
 
-func newQueueAckMgr(shard ShardContext, options *QueueProcessorOptions, processor processor, ackLevel int64, logger bark.Logger) *queueAckMgrImpl {
+func newQueueAckMgr(shard ShardContext, options *QueueProcessorOptions, processor processor, ackLevel int64, logger logging.Logger) *queueAckMgrImpl {
 
 	return &queueAckMgrImpl{
 		isFailover:       false,
@@ -72,7 +71,7 @@ func newQueueAckMgr(shard ShardContext, options *QueueProcessorOptions, processo
 	}
 }
 
-func newQueueFailoverAckMgr(shard ShardContext, options *QueueProcessorOptions, processor processor, ackLevel int64, logger bark.Logger) *queueAckMgrImpl {
+func newQueueFailoverAckMgr(shard ShardContext, options *QueueProcessorOptions, processor processor, ackLevel int64, logger logging.Logger) *queueAckMgrImpl {
 
 	return &queueAckMgrImpl{
 		isFailover:       true,
@@ -117,15 +116,17 @@ TaskFilterLoop:
 		_, isLoaded := a.outstandingTasks[task.GetTaskID()]
 		if isLoaded {
 			// timer already loaded
-			a.logger.Debugf("Skipping transfer task: %v.", task)
+			a.logger.Debug("Skipping transfer task.", tag.TaskID(task.GetTaskID()))
 			continue TaskFilterLoop
 		}
 
 		if a.readLevel >= task.GetTaskID() {
-			a.logger.Fatalf("Next task ID is less than current read level.  TaskID: %v, ReadLevel: %v", task.GetTaskID(),
-				a.readLevel)
+			a.logger.Fatal("Next task ID is less than current read level.",
+				tag.TaskID(task.GetTaskID()),
+				tag.ReadLevel(a.readLevel))
 		}
-		a.logger.Debugf("Moving read level: %v", task.GetTaskID())
+		a.logger.Debug("Moving read level.", tag.ReadLevel(task.GetTaskID()))
+
 		a.readLevel = task.GetTaskID()
 		a.outstandingTasks[task.GetTaskID()] = false
 	}
@@ -162,7 +163,8 @@ MoveAckLevelLoop:
 		// TODO: What happens if !ok?
 		if acked, ok := a.outstandingTasks[current]; ok {
 			if acked {
-				a.logger.Debugf("Updating ack level: %v", current)
+				a.logger.Debug("Updating ack level.", tag.AckLevel(current))
+
 				ackLevel = current
 				a.finishedTaskCounter++
 				delete(a.outstandingTasks, current)
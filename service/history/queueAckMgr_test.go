@@ -28,10 +28,10 @@ import (
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
@@ -55,7 +55,7 @@ type (
 		mockClusterMetadata *mocks.ClusterMetadata
 		mockProcessor       *MockProcessor
 		metricsClient       metrics.Client
-		logger              bark.Logger
+		logger              logging.Logger
 		queueAckMgr         *queueAckMgrImpl
 	}
 
@@ -73,7 +73,7 @@ type (
 		mockClusterMetadata *mocks.ClusterMetadata
 		mockProcessor       *MockProcessor
 		metricsClient       metrics.Client
-		logger              bark.Logger
+		logger              logging.Logger
 		domainID            string
 		queueFailoverAckMgr *queueAckMgrImpl
 	}
@@ -110,7 +110,7 @@ func (s *queueAckMgrSuite) SetupTest() {
 	s.mockShardMgr = &mocks.ShardManager{}
 	s.mockMetadataMgr = &mocks.MetadataManager{}
 	s.mockHistoryMgr = &mocks.HistoryManager{}
-	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.logger = logging.NewDevelopmentLogger()
 	s.metricsClient = metrics.NewClient(tally.NoopScope, metrics.History)
 	s.mockClusterMetadata = &mocks.ClusterMetadata{}
 	s.mockProducer = &mocks.KafkaProducer{}
@@ -312,7 +312,7 @@ func (s *queueFailoverAckMgrSuite) SetupTest() {
 	s.mockShardMgr = &mocks.ShardManager{}
 	s.mockMetadataMgr = &mocks.MetadataManager{}
 	s.mockHistoryMgr = &mocks.HistoryManager{}
-	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.logger = logging.NewDevelopmentLogger()
 	s.metricsClient = metrics.NewClient(tally.NoopScope, metrics.History)
 	s.mockClusterMetadata = &mocks.ClusterMetadata{}
 	s.mockProducer = &mocks.KafkaProducer{}
@@ -27,10 +27,9 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/uber-common/bark"
-
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 )
 
@@ -51,7 +50,7 @@ type (
 		shard         ShardContext
 		options       *QueueProcessorOptions
 		processor     processor
-		logger        bark.Logger
+		logger        logging.Logger
 		metricsClient metrics.Client
 		rateLimiter   common.TokenBucket // Read rate limiter
 		ackMgr        queueAckMgr
@@ -71,7 +70,7 @@ var (
 	errUnexpectedQueueTask = errors.New("unexpected queue task")
 )
 
-func newQueueProcessorBase(shard ShardContext, options *QueueProcessorOptions, processor processor, queueAckMgr queueAckMgr, logger bark.Logger) *queueProcessorBase {
+func newQueueProcessorBase(shard ShardContext, options *QueueProcessorOptions, processor processor, queueAckMgr queueAckMgr, logger logging.Logger) *queueProcessorBase {
 	workerNotificationChans := []chan struct{}{}
 	for index := 0; index < options.WorkerCount; index++ {
 		workerNotificationChans = append(workerNotificationChans, make(chan struct{}, 1))
@@ -183,7 +182,7 @@ func (p *queueProcessorBase) processBatch(tasksCh chan<- queueTaskInfo) {
 	tasks, more, err := p.ackMgr.readQueueTasks()
 
 	if err != nil {
-		p.logger.Warnf("Processor unable to retrieve tasks: %v", err)
+		p.logger.Warn("Processor unable to retrieve tasks", tag.Error(err))
 		p.notifyNewTask() // re-enqueue the event
 		return
 	}
@@ -230,7 +229,8 @@ func (p *queueProcessorBase) retryTasks() {
 }
 
 func (p *queueProcessorBase) processWithRetry(notificationChan <-chan struct{}, task queueTaskInfo) {
-	p.logger.Debugf("Processing task: %v, type: %v", task.GetTaskID(), task.GetTaskType())
+	p.logger.Debug("Processing task.", tag.TaskID(task.GetTaskID()), tag.TaskType(task.GetTaskType()))
+
 ProcessRetryLoop:
 	for retryCount := 1; retryCount <= p.options.MaxRetryCount; {
 		select {
@@ -0,0 +1,251 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"sync"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// replicationMessageReader serves the replication tasks of a shard to the remote clusters pulling them, instead
+	// of having them published by the replicator queue processor.  The tasks are deleted once every remote cluster
+	// has processed them.
+	replicationMessageReader struct {
+		shard              ShardContext
+		executionMgr       persistence.ExecutionManager
+		historyMgr         persistence.HistoryManager
+		hSerializerFactory persistence.HistorySerializerFactory
+		batchSize          int
+		metricsClient      metrics.Client
+		lagMetricsClients  map[string]metrics.Client
+		logger             logging.Logger
+
+		sync.Mutex
+		// clusterAckLevels is the last task processed by each remote cluster, the persisted replicator ack level
+		// is the minimum of them
+		clusterAckLevels map[string]int64
+		ackLevel         int64
+	}
+)
+
+func newReplicationMessageReader(shard ShardContext, executionMgr persistence.ExecutionManager,
+	historyMgr persistence.HistoryManager, hSerializerFactory persistence.HistorySerializerFactory,
+	logger logging.Logger) *replicationMessageReader {
+
+	clusterMetadata := shard.GetService().GetClusterMetadata()
+	ackLevel := shard.GetReplicatorAckLevel()
+	clusterAckLevels := make(map[string]int64)
+	for cluster := range clusterMetadata.GetAllClusterFailoverVersions() {
+		if cluster != clusterMetadata.GetCurrentClusterName() {
+			clusterAckLevels[cluster] = ackLevel
+		}
+	}
+
+	return &replicationMessageReader{
+		shard:              shard,
+		executionMgr:       executionMgr,
+		historyMgr:         historyMgr,
+		hSerializerFactory: hSerializerFactory,
+		batchSize:          shard.GetConfig().ReplicatorTaskBatchSize,
+		metricsClient:      shard.GetMetricsClient(),
+		lagMetricsClients:  newReplicationLagMetricsClients(shard),
+		logger:             logger.WithTags(tag.ComponentReplicatorQueue),
+		clusterAckLevels:   clusterAckLevels,
+		ackLevel:           ackLevel,
+	}
+}
+
+// getReplicationMessages returns the replication tasks after the last retrieved one, and records the ones processed
+// by the remote cluster
+func (r *replicationMessageReader) getReplicationMessages(
+	request *h.GetReplicationMessagesRequest) (*h.GetReplicationMessagesResponse, error) {
+
+	clusterAckLevel, err := r.updateClusterAckLevel(request.GetClusterName(), request.GetLastProcessedMessageId())
+	if err != nil {
+		return nil, err
+	}
+
+	readLevel := request.GetLastRetrievedMessageId()
+	if readLevel < clusterAckLevel {
+		readLevel = clusterAckLevel
+	}
+
+	response, err := r.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+		ReadLevel:    readLevel,
+		MaxReadLevel: r.shard.GetTransferMaxReadLevel(),
+		BatchSize:    r.batchSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*h.ReplicationMessage
+	lastRetrieved := readLevel
+	for _, task := range response.Tasks {
+		message, err := r.toReplicationMessage(task)
+		if err != nil {
+			return nil, err
+		}
+		if message != nil {
+			messages = append(messages, message)
+		}
+		lastRetrieved = task.TaskID
+	}
+
+	return &h.GetReplicationMessagesResponse{
+		Messages:               messages,
+		LastRetrievedMessageId: common.Int64Ptr(lastRetrieved),
+		HasMore:                common.BoolPtr(len(response.Tasks) >= r.batchSize),
+	}, nil
+}
+
+// toReplicationMessage loads the history of a replication task, it returns nil for a task which can never be
+// replicated so the remote cluster skips it
+func (r *replicationMessageReader) toReplicationMessage(task *persistence.ReplicationTaskInfo) (*h.ReplicationMessage, error) {
+	if task.TaskType != persistence.ReplicationTaskTypeHistory {
+		r.logger.Error("Skipping unknown replication task.", tag.TaskID(task.TaskID), tag.TaskType(task.TaskType))
+		return nil, nil
+	}
+
+	r.metricsClient.IncCounter(metrics.ReplicatorTaskHistoryScope, metrics.TaskRequests)
+	sw := r.metricsClient.StartTimer(metrics.ReplicatorTaskHistoryScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	history, newRunHistory, err := getReplicationHistory(r.historyMgr, r.hSerializerFactory, task)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			// the history was deleted once the retention expired
+			r.logger.Warn("Skipping replication task whose history no longer exists.", tag.TaskID(task.TaskID))
+			return nil, nil
+		}
+		r.metricsClient.IncCounter(metrics.ReplicatorTaskHistoryScope, metrics.TaskFailures)
+		return nil, err
+	}
+
+	return &h.ReplicationMessage{
+		TaskId:          common.Int64Ptr(task.TaskID),
+		DomainId:        common.StringPtr(task.DomainID),
+		WorkflowId:      common.StringPtr(task.WorkflowID),
+		RunId:           common.StringPtr(task.RunID),
+		FirstEventId:    common.Int64Ptr(task.FirstEventID),
+		NextEventId:     common.Int64Ptr(task.NextEventID),
+		Version:         common.Int64Ptr(task.Version),
+		ReplicationInfo: convertLastReplicationInfo(task.LastReplicationInfo),
+		History:         history,
+		NewRunHistory:   newRunHistory,
+	}, nil
+}
+
+// updateClusterAckLevel records the last task processed by a remote cluster, the tasks processed by every remote
+// cluster are deleted.  It returns the ack level of the remote cluster.
+func (r *replicationMessageReader) updateClusterAckLevel(cluster string, lastProcessed int64) (int64, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	clusterAckLevel, ok := r.clusterAckLevels[cluster]
+	if !ok {
+		return 0, &shared.BadRequestError{Message: fmt.Sprintf("Unknown cluster %v.", cluster)}
+	}
+	defer r.updateLagMetric()
+	if lastProcessed <= clusterAckLevel {
+		return clusterAckLevel, nil
+	}
+	r.clusterAckLevels[cluster] = lastProcessed
+
+	ackLevel := lastProcessed
+	for _, level := range r.clusterAckLevels {
+		if level < ackLevel {
+			ackLevel = level
+		}
+	}
+	if ackLevel > r.ackLevel {
+		if err := r.completeTasks(r.ackLevel, ackLevel); err != nil {
+			// the tasks are deleted on the next update
+			r.logger.Warn("Failed to complete replication tasks.", tag.Error(err))
+		} else {
+			r.ackLevel = ackLevel
+		}
+	}
+
+	return lastProcessed, nil
+}
+
+// getClusterAckLevels returns the last task processed by each remote cluster
+func (r *replicationMessageReader) getClusterAckLevels() map[string]int64 {
+	r.Lock()
+	defer r.Unlock()
+
+	clusterAckLevels := make(map[string]int64, len(r.clusterAckLevels))
+	for cluster, ackLevel := range r.clusterAckLevels {
+		clusterAckLevels[cluster] = ackLevel
+	}
+	return clusterAckLevels
+}
+
+// updateLagMetric reports how far each remote cluster is behind, it must be called with the lock held
+func (r *replicationMessageReader) updateLagMetric() {
+	maxReadLevel := r.shard.GetTransferMaxReadLevel()
+	for cluster, clusterAckLevel := range r.clusterAckLevels {
+		if metricsClient, ok := r.lagMetricsClients[cluster]; ok {
+			metricsClient.UpdateGauge(metrics.ReplicatorQueueProcessorScope, metrics.ReplicationTasksLag,
+				float64(maxReadLevel-clusterAckLevel))
+		}
+	}
+}
+
+// completeTasks deletes the replication tasks in (readLevel, ackLevel] and persists the ack level
+func (r *replicationMessageReader) completeTasks(readLevel int64, ackLevel int64) error {
+	for {
+		response, err := r.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+			ReadLevel:    readLevel,
+			MaxReadLevel: ackLevel,
+			BatchSize:    r.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, task := range response.Tasks {
+			if err := r.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{
+				TaskID: task.TaskID,
+			}); err != nil {
+				return err
+			}
+			readLevel = task.TaskID
+		}
+
+		if len(response.Tasks) < r.batchSize {
+			break
+		}
+	}
+
+	r.logger.Debug("Updating replicator ack level.", tag.AckLevel(ackLevel))
+	return r.shard.UpdateReplicatorAckLevel(ackLevel)
+}
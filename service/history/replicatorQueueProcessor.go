@@ -22,13 +22,14 @@ package history
 
 import (
 	"errors"
+	"strconv"
 
-	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -42,8 +43,9 @@ type (
 		hSerializerFactory persistence.HistorySerializerFactory
 		replicator         messaging.Producer
 		metricsClient      metrics.Client
+		lagMetricsClients  map[string]metrics.Client
 		options            *QueueProcessorOptions
-		logger             bark.Logger
+		logger             logging.Logger
 		*queueProcessorBase
 		queueAckMgr
 	}
@@ -56,7 +58,7 @@ var (
 
 func newReplicatorQueueProcessor(shard ShardContext, replicator messaging.Producer,
 	executionMgr persistence.ExecutionManager, historyMgr persistence.HistoryManager,
-	hSerializerFactory persistence.HistorySerializerFactory, logger bark.Logger) queueProcessor {
+	hSerializerFactory persistence.HistorySerializerFactory, logger logging.Logger) queueProcessor {
 
 	config := shard.GetConfig()
 	options := &QueueProcessorOptions{
@@ -70,9 +72,7 @@ func newReplicatorQueueProcessor(shard ShardContext, replicator messaging.Produc
 		UpdateShardTaskCount: config.ReplicatorProcessorUpdateShardTaskCount,
 	}
 
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowComponent: logging.TagValueReplicatorQueueComponent,
-	})
+	logger = logger.WithTags(tag.ComponentReplicatorQueue)
 
 	processor := &replicatorQueueProcessorImpl{
 		shard:              shard,
@@ -81,6 +81,7 @@ func newReplicatorQueueProcessor(shard ShardContext, replicator messaging.Produc
 		hSerializerFactory: hSerializerFactory,
 		replicator:         replicator,
 		metricsClient:      shard.GetMetricsClient(),
+		lagMetricsClients:  newReplicationLagMetricsClients(shard),
 		options:            options,
 		logger:             logger,
 	}
@@ -121,25 +122,11 @@ func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persi
 	sw := p.metricsClient.StartTimer(metrics.ReplicatorTaskHistoryScope, metrics.TaskLatency)
 	defer sw.Stop()
 
-	history, err := p.getHistory(task.DomainID, task.WorkflowID, task.RunID, task.FirstEventID, task.NextEventID)
+	history, newRunHistory, err := getReplicationHistory(p.historyMgr, p.hSerializerFactory, task)
 	if err != nil {
 		return err
 	}
 
-	// Check if this is replication task for ContinueAsNew event, then retrieve the history for new execution
-	var newRunHistory *shared.History
-	events := history.Events
-	if len(events) > 0 {
-		lastEvent := events[len(events)-1]
-		if lastEvent.GetEventType() == shared.EventTypeWorkflowExecutionContinuedAsNew {
-			newRunID := lastEvent.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunId()
-			newRunHistory, err = p.getHistory(task.DomainID, task.WorkflowID, newRunID, common.FirstEventID, int64(3))
-			if err != nil {
-				return err
-			}
-		}
-	}
-
 	replicationTask := &replicator.ReplicationTask{
 		TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistory),
 		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
@@ -185,16 +172,75 @@ func (p *replicatorQueueProcessorImpl) completeTask(taskID int64) error {
 }
 
 func (p *replicatorQueueProcessorImpl) updateAckLevel(ackLevel int64) error {
+	// every remote cluster consumes the tasks published to kafka, so they all lag behind the publishing
+	lag := float64(p.shard.GetTransferMaxReadLevel() - ackLevel)
+	for _, metricsClient := range p.lagMetricsClients {
+		metricsClient.UpdateGauge(metrics.ReplicatorQueueProcessorScope, metrics.ReplicationTasksLag, lag)
+	}
 	return p.shard.UpdateReplicatorAckLevel(ackLevel)
 }
 
-func (p *replicatorQueueProcessorImpl) getHistory(domainID, workflowID, runID string, firstEventID,
-	nextEventID int64) (*shared.History, error) {
+// newReplicationLagMetricsClients returns the metrics clients reporting the replication lag of the shard, by remote
+// cluster
+func newReplicationLagMetricsClients(shard ShardContext) map[string]metrics.Client {
+	clusterMetadata := shard.GetService().GetClusterMetadata()
+	metricsClients := make(map[string]metrics.Client)
+	for cluster := range clusterMetadata.GetAllClusterFailoverVersions() {
+		if cluster != clusterMetadata.GetCurrentClusterName() {
+			metricsClients[cluster] = shard.GetMetricsClient().Tagged(map[string]string{
+				metrics.ShardTagName:         strconv.Itoa(shard.GetShardID()),
+				metrics.TargetClusterTagName: cluster,
+			})
+		}
+	}
+	return metricsClients
+}
+
+// getReplicationHistory loads the history events of a replication task, along with the first events of the new run
+// when the events end with ContinueAsNew
+func getReplicationHistory(historyMgr persistence.HistoryManager, hSerializerFactory persistence.HistorySerializerFactory,
+	task *persistence.ReplicationTaskInfo) (*shared.History, *shared.History, error) {
+
+	history, err := getHistory(historyMgr, hSerializerFactory, task.DomainID, task.WorkflowID, task.RunID,
+		task.FirstEventID, task.NextEventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check if this is replication task for ContinueAsNew event, then retrieve the history for new execution
+	var newRunHistory *shared.History
+	events := history.Events
+	if len(events) > 0 {
+		newRunHistory, err = getNewRunHistory(historyMgr, hSerializerFactory, task.DomainID, task.WorkflowID,
+			events[len(events)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return history, newRunHistory, nil
+}
+
+// getNewRunHistory loads the first events of the new execution when the last event of a batch is ContinueAsNew,
+// nil is returned otherwise
+func getNewRunHistory(historyMgr persistence.HistoryManager, hSerializerFactory persistence.HistorySerializerFactory,
+	domainID, workflowID string, lastEvent *shared.HistoryEvent) (*shared.History, error) {
+
+	if lastEvent.GetEventType() != shared.EventTypeWorkflowExecutionContinuedAsNew {
+		return nil, nil
+	}
+
+	newRunID := lastEvent.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunId()
+	return getHistory(historyMgr, hSerializerFactory, domainID, workflowID, newRunID, common.FirstEventID, int64(3))
+}
+
+func getHistory(historyMgr persistence.HistoryManager, hSerializerFactory persistence.HistorySerializerFactory,
+	domainID, workflowID, runID string, firstEventID, nextEventID int64) (*shared.History, error) {
 
 	var nextPageToken []byte
 	historyEvents := []*shared.HistoryEvent{}
 	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
-		response, err := p.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		response, err := historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
 			DomainID: domainID,
 			Execution: shared.WorkflowExecution{
 				WorkflowId: common.StringPtr(workflowID),
@@ -212,7 +258,7 @@ func (p *replicatorQueueProcessorImpl) getHistory(domainID, workflowID, runID st
 
 		for _, e := range response.Events {
 			persistence.SetSerializedHistoryDefaults(&e)
-			s, _ := p.hSerializerFactory.Get(e.EncodingType)
+			s, _ := hSerializerFactory.Get(e.EncodingType)
 			history, err1 := s.Deserialize(&e)
 			if err1 != nil {
 				return nil, err1
@@ -26,6 +26,7 @@ import (
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -38,60 +39,85 @@ func prepareNextRetryWithNowTime(a *persistence.ActivityInfo, errReason string,
 		return nil
 	}
 
-	if a.MaximumAttempts == 0 && a.ExpirationTime.IsZero() {
-		// Invalid retry policy, Decider worker should reject this.
+	backoffInterval := getBackoffInterval(a.Attempt, a.MaximumAttempts, a.InitialInterval, a.MaximumInterval,
+		a.BackoffCoefficient, now, a.ExpirationTime, errReason, a.NonRetriableErrors)
+	if backoffInterval == backoff.NoBackoff {
 		return nil
 	}
+	nextScheduleTime := now.Add(backoffInterval)
+
+	// a retry is needed, update activity info for next retry
+	a.Attempt++
+	a.ScheduledTime = nextScheduleTime // update to next schedule time
+	a.StartedID = common.EmptyEventID
+	a.RequestID = ""
+	a.StartedTime = time.Time{}
+	a.LastHeartBeatUpdatedTime = time.Time{}
+	// clear timer created bits except for ScheduleToClose
+	a.TimerTaskStatus = TimerTaskStatusNone | (a.TimerTaskStatus & TimerTaskStatusCreatedScheduleToClose)
+
+	return &persistence.RetryTimerTask{
+		Version:             a.Version,
+		VisibilityTimestamp: a.ScheduledTime,
+		EventID:             a.ScheduleID,
+		Attempt:             a.Attempt,
+	}
+}
+
+// getBackoffInterval returns the interval to wait before the next attempt, or backoff.NoBackoff if the retry policy
+// does not allow another attempt
+func getBackoffInterval(currAttempt, maxAttempts, initInterval, maxInterval int32, backoffCoefficient float64,
+	now, expirationTime time.Time, errReason string, nonRetriableErrors []string) time.Duration {
+	if maxAttempts == 0 && expirationTime.IsZero() {
+		// Invalid retry policy, Decider worker should reject this.
+		return backoff.NoBackoff
+	}
 
-	if a.MaximumAttempts > 0 && a.Attempt >= a.MaximumAttempts-1 {
+	if maxAttempts > 0 && currAttempt >= maxAttempts-1 {
 		// Attempt starts from 0.
 		// MaximumAttempts is the total attempts, including initial (non-retry) attempt.
-		return nil
+		return backoff.NoBackoff
 	}
 
-	nextInterval := int64(float64(a.InitialInterval) * math.Pow(a.BackoffCoefficient, float64(a.Attempt)))
+	nextInterval := int64(float64(initInterval) * math.Pow(backoffCoefficient, float64(currAttempt)))
 	if nextInterval <= 0 {
 		// math.Pow() could overflow
-		if a.MaximumInterval > 0 {
-			nextInterval = int64(a.MaximumInterval)
+		if maxInterval > 0 {
+			nextInterval = int64(maxInterval)
 		} else {
-			return nil
+			return backoff.NoBackoff
 		}
 	}
 
-	if a.MaximumInterval > 0 && nextInterval > int64(a.MaximumInterval) {
+	if maxInterval > 0 && nextInterval > int64(maxInterval) {
 		// cap next interval to MaxInterval
-		nextInterval = int64(a.MaximumInterval)
+		nextInterval = int64(maxInterval)
 	}
 
-	nextScheduleTime := now.Add(time.Duration(nextInterval) * time.Second)
-	if !a.ExpirationTime.IsZero() && nextScheduleTime.After(a.ExpirationTime) {
-		return nil
+	backoffInterval := time.Duration(nextInterval) * time.Second
+	if !expirationTime.IsZero() && now.Add(backoffInterval).After(expirationTime) {
+		return backoff.NoBackoff
 	}
 
 	// check if error is non-retriable
-	for _, er := range a.NonRetriableErrors {
+	for _, er := range nonRetriableErrors {
 		if er == errReason {
-			return nil
+			return backoff.NoBackoff
 		}
 	}
 
-	// a retry is needed, update activity info for next retry
-	a.Attempt++
-	a.ScheduledTime = nextScheduleTime // update to next schedule time
-	a.StartedID = common.EmptyEventID
-	a.RequestID = ""
-	a.StartedTime = time.Time{}
-	a.LastHeartBeatUpdatedTime = time.Time{}
-	// clear timer created bits except for ScheduleToClose
-	a.TimerTaskStatus = TimerTaskStatusNone | (a.TimerTaskStatus & TimerTaskStatusCreatedScheduleToClose)
+	return backoffInterval
+}
 
-	return &persistence.RetryTimerTask{
-		Version:             a.Version,
-		VisibilityTimestamp: a.ScheduledTime,
-		EventID:             a.ScheduleID,
-		Attempt:             a.Attempt,
+// getWorkflowExpirationTimestamp returns the time in unix nanos after which a workflow is no longer retried, the
+// expiration interval counts from the first decision so an initial backoff is not charged against it
+func getWorkflowExpirationTimestamp(retryPolicy *shared.RetryPolicy, firstDecisionTaskBackoffSeconds int32,
+	now time.Time) *int64 {
+	if retryPolicy == nil || retryPolicy.GetExpirationIntervalInSeconds() <= 0 {
+		return nil
 	}
+	expiration := time.Duration(firstDecisionTaskBackoffSeconds+retryPolicy.GetExpirationIntervalInSeconds()) * time.Second
+	return common.Int64Ptr(now.Add(expiration).UnixNano())
 }
 
 func getTimeoutErrorReason(timeoutType shared.TimeoutType) string {
@@ -25,6 +25,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -115,3 +117,22 @@ func Test_NextRetry(t *testing.T) {
 	retryTask = prepareNextRetryWithNowTime(ai, reason, now)
 	a.Equal(now.Add(time.Second*10), retryTask.(*persistence.RetryTimerTask).VisibilityTimestamp)
 }
+
+func Test_WorkflowExpirationTimestamp(t *testing.T) {
+	a := assert.New(t)
+	now, _ := time.Parse(time.RFC3339, "2018-04-13T16:08:08+00:00")
+
+	// no expiration without retry policy or expiration interval
+	a.Nil(getWorkflowExpirationTimestamp(nil, 0, now))
+	policy := &shared.RetryPolicy{
+		InitialIntervalInSeconds: common.Int32Ptr(1),
+		BackoffCoefficient:       common.Float64Ptr(2),
+		MaximumAttempts:          common.Int32Ptr(5),
+	}
+	a.Nil(getWorkflowExpirationTimestamp(policy, 0, now))
+
+	// expiration counts from the first decision
+	policy.ExpirationIntervalInSeconds = common.Int32Ptr(60)
+	a.Equal(now.Add(time.Minute).UnixNano(), *getWorkflowExpirationTimestamp(policy, 0, now))
+	a.Equal(now.Add(time.Minute+time.Second*30).UnixNano(), *getWorkflowExpirationTimestamp(policy, 30, now))
+}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -80,6 +81,20 @@ type Config struct {
 	ReplicatorProcessorUpdateShardTaskCount int
 	ReplicatorProcessorMaxPollInterval      time.Duration
 	ReplicatorProcessorUpdateAckInterval    time.Duration
+	// ReplicatorResendGapDelay and ReplicatorResendGapAttempts hold back the resend of the events missing before
+	// a replication task, until the gap persists that long or is hit that many times.  At most
+	// ReplicatorResendGapMaxSize gaps are tracked per shard, each for ReplicatorResendGapTTL
+	ReplicatorResendGapDelay    time.Duration
+	ReplicatorResendGapAttempts int
+	ReplicatorResendGapMaxSize  int
+	ReplicatorResendGapTTL      time.Duration
+
+	// Archival settings
+	ArchivalProcessorConcurrency int
+	ArchivalProcessorQueueSize   int
+	// ArchivalDeleteRetryInterval is the delay before the deletion of an execution which is not completely
+	// archived yet is attempted again
+	ArchivalDeleteRetryInterval time.Duration
 
 	// Persistence settings
 	ExecutionMgrNumConns int
@@ -88,9 +103,18 @@ type Config struct {
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval time.Duration
 
+	// Limits on the search attributes which can be upserted by a decision
+	SearchAttributesNumberOfKeysLimit int
+	SearchAttributesSizeOfValueLimit  int
+	SearchAttributesTotalSizeLimit    int
+
 	// Time to hold a poll request before returning an empty response
 	// right now only used by GetMutableState
 	LongPollExpirationInterval dynamicconfig.DurationPropertyFn
+
+	// EventEncodingType is the encoding type used to persist new history events, histories with events of
+	// a different encoding type can still be read
+	EventEncodingType dynamicconfig.StringPropertyFn
 }
 
 // NewConfig returns new service config with default values
@@ -132,13 +156,24 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int) *Config {
 		ReplicatorProcessorUpdateShardTaskCount:            100,
 		ReplicatorProcessorMaxPollInterval:                 60 * time.Second,
 		ReplicatorProcessorUpdateAckInterval:               1 * time.Minute,
+		ReplicatorResendGapDelay:                           30 * time.Second,
+		ReplicatorResendGapAttempts:                        3,
+		ReplicatorResendGapMaxSize:                         10000,
+		ReplicatorResendGapTTL:                             10 * time.Minute,
+		ArchivalProcessorConcurrency:                       10,
+		ArchivalProcessorQueueSize:                         1000,
+		ArchivalDeleteRetryInterval:                        5 * time.Minute,
 		ExecutionMgrNumConns:                               100,
 		HistoryMgrNumConns:                                 100,
 		ShardUpdateMinInterval:                             60 * time.Second,
+		SearchAttributesNumberOfKeysLimit:                  100,
+		SearchAttributesSizeOfValueLimit:                   2 * 1024,
+		SearchAttributesTotalSizeLimit:                     40 * 1024,
 		// history client: client/history/client.go set the client timeout 30s
 		LongPollExpirationInterval: dc.GetDurationProperty(
 			dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20,
 		),
+		EventEncodingType: dc.GetStringProperty(dynamicconfig.HistoryEventEncodingType, string(common.EncodingTypeJSON)),
 	}
 }
 
@@ -173,25 +208,20 @@ func (s *Service) Start() {
 	var p = s.params
 	var log = p.Logger
 
-	log.Infof("%v starting", common.HistoryServiceName)
+	log.Info("service starting", tag.Service(common.HistoryServiceName))
 
 	base := service.New(p)
 
 	s.metricsClient = base.GetMetricsClient()
 
-	shardMgr, err := persistence.NewCassandraShardPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.ClusterMetadata.GetCurrentClusterName(),
-		p.Logger)
+	pFactory := persistence.NewFactory(&p.PersistenceConfig, &p.CassandraConfig,
+		p.ClusterMetadata.GetCurrentClusterName(), p.Logger)
+	shardMgr, err := pFactory.NewShardManager()
 
 	if err != nil {
-		log.Fatalf("failed to create shard manager: %v", err)
+		log.Fatal("failed to create shard manager", tag.Error(err))
 	}
-	shardMgr = persistence.NewShardPersistenceClient(shardMgr, base.GetMetricsClient())
+	shardMgr = persistence.NewShardPersistenceClient(shardMgr, base.GetMetricsClient(), base.GetTracer())
 
 	// Hack to create shards for bootstrap purposes
 	// TODO: properly pre-create all shards before deployment.
@@ -205,64 +235,37 @@ func (s *Service) Start() {
 
 		if err != nil {
 			if _, ok := err.(*persistence.ShardAlreadyExistError); !ok {
-				log.Fatalf("failed to create shard for ShardId: %v, with error: %v", shardID, err)
+				log.Fatal("failed to create shard", tag.ShardID(shardID), tag.Error(err))
+
 			}
 		}
 	}
 
-	metadata, err := persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.ClusterMetadata.GetCurrentClusterName(),
-		p.Logger)
+	metadata, err := pFactory.NewMetadataManager()
 
 	if err != nil {
-		log.Fatalf("failed to create metadata manager: %v", err)
+		log.Fatal("failed to create metadata manager", tag.Error(err))
 	}
-	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient())
+	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient(), base.GetTracer())
 
-	visibility, err := persistence.NewCassandraVisibilityPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.VisibilityKeyspace,
-		p.Logger)
+	visibility, err := pFactory.NewVisibilityManager()
 
 	if err != nil {
-		log.Fatalf("failed to create visiblity manager: %v", err)
+		log.Fatal("failed to create visiblity manager", tag.Error(err))
 	}
-	visibility = persistence.NewVisibilityPersistenceClient(visibility, base.GetMetricsClient())
+	visibility = persistence.NewVisibilityPersistenceClient(visibility, base.GetMetricsClient(), base.GetTracer())
 
-	history, err := persistence.NewCassandraHistoryPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		s.config.HistoryMgrNumConns,
-		p.Logger)
+	history, err := pFactory.NewHistoryManager(s.config.HistoryMgrNumConns)
 
 	if err != nil {
-		log.Fatalf("Creating Cassandra history manager persistence failed: %v", err)
+		log.Fatal("Creating history manager persistence failed", tag.Error(err))
 	}
-	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient())
-
-	execMgrFactory, err := persistence.NewCassandraPersistenceClientFactory(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		s.config.ExecutionMgrNumConns,
-		p.Logger,
-		s.metricsClient,
-	)
+	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient(), base.GetTracer())
+
+	execMgrFactory, err := pFactory.NewExecutionManagerFactory(s.config.ExecutionMgrNumConns, s.metricsClient,
+		base.GetTracer())
 	if err != nil {
-		log.Fatalf("Creating Cassandra execution manager persistence factory failed: %v", err)
+		log.Fatal("Creating execution manager persistence factory failed", tag.Error(err))
 	}
 
 	handler := NewHandler(base,
@@ -275,7 +278,7 @@ func (s *Service) Start() {
 
 	handler.Start()
 
-	log.Infof("%v started", common.HistoryServiceName)
+	log.Info("service started", tag.Service(common.HistoryServiceName))
 
 	<-s.stopC
 	base.Stop()
@@ -287,5 +290,5 @@ func (s *Service) Stop() {
 	case s.stopC <- struct{}{}:
 	default:
 	}
-	s.params.Logger.Infof("%v stopped", common.HistoryServiceName)
+	s.params.Logger.Info("service stopped", tag.Service(common.HistoryServiceName))
 }
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -29,12 +30,11 @@ import (
 	"github.com/uber/cadence/common/cache"
 
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
-
-	"github.com/uber-common/bark"
-	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/service"
 )
 
@@ -42,6 +42,7 @@ type (
 	// ShardContext represents a history engine shard
 	ShardContext interface {
 		GetShardID() int
+		GetRangeID() int64
 		GetService() service.Service
 		GetExecutionManager() persistence.ExecutionManager
 		GetHistoryManager() persistence.HistoryManager
@@ -65,11 +66,12 @@ type (
 		AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) error
 		NotifyNewHistoryEvent(event *historyEventNotification) error
 		GetConfig() *Config
-		GetLogger() bark.Logger
+		GetLogger() logging.Logger
 		GetMetricsClient() metrics.Client
 		GetTimeSource() common.TimeSource
 		SetCurrentTime(cluster string, currentTime time.Time)
 		GetCurrentTime(cluster string) time.Time
+		WithContext(ctx context.Context) ShardContext
 	}
 
 	shardContextImpl struct {
@@ -83,7 +85,7 @@ type (
 		closeCh          chan<- int
 		isClosed         bool
 		config           *Config
-		logger           bark.Logger
+		logger           logging.Logger
 		metricsClient    metrics.Client
 
 		sync.RWMutex
@@ -94,14 +96,29 @@ type (
 		transferMaxReadLevel      int64
 		standbyClusterCurrentTime map[string]time.Time
 	}
+
+	// shardContextWithContext traces the persistence calls made by the shard for a request as children of the
+	// span of the request
+	shardContextWithContext struct {
+		*shardContextImpl
+		ctx context.Context
+	}
 )
 
 var _ ShardContext = (*shardContextImpl)(nil)
+var _ ShardContext = (*shardContextWithContext)(nil)
 
 func (s *shardContextImpl) GetShardID() int {
 	return s.shardID
 }
 
+func (s *shardContextImpl) GetRangeID() int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.getRangeID()
+}
+
 func (s *shardContextImpl) GetService() service.Service {
 	return s.service
 }
@@ -122,7 +139,7 @@ func (s *shardContextImpl) GetNextTransferTaskID() (int64, error) {
 	s.Lock()
 	defer s.Unlock()
 
-	return s.getNextTransferTaskIDLocked()
+	return s.getNextTransferTaskIDLocked(context.Background())
 }
 
 func (s *shardContextImpl) GetTransferMaxReadLevel() int64 {
@@ -224,6 +241,11 @@ func (s *shardContextImpl) UpdateTimerClusterAckLevel(cluster string, ackLevel t
 
 func (s *shardContextImpl) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
 	*persistence.CreateWorkflowExecutionResponse, error) {
+	return s.createWorkflowExecution(context.Background(), request)
+}
+
+func (s *shardContextImpl) createWorkflowExecution(ctx context.Context,
+	request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
 	s.Lock()
 	defer s.Unlock()
 
@@ -232,34 +254,34 @@ func (s *shardContextImpl) CreateWorkflowExecution(request *persistence.CreateWo
 	// Must be done under the shard lock to ensure transfer tasks are written to persistence in increasing
 	// ID order
 	for _, task := range request.TransferTasks {
-		id, err := s.getNextTransferTaskIDLocked()
+		id, err := s.getNextTransferTaskIDLocked(ctx)
 		if err != nil {
 			return nil, err
 		}
-		s.logger.Debugf("Assigning transfer task ID: %v", id)
+		s.logger.Debug("Assigning transfer task ID.", tag.TaskID(id))
 		task.SetTaskID(id)
 		transferMaxReadLevel = id
 	}
 
 	for _, task := range request.ReplicationTasks {
-		id, err := s.getNextTransferTaskIDLocked()
+		id, err := s.getNextTransferTaskIDLocked(ctx)
 		if err != nil {
 			return nil, err
 		}
-		s.logger.Debugf("Assigning replication task ID: %v", id)
+		s.logger.Debug("Assigning replication task ID.", tag.TaskID(id))
 		task.SetTaskID(id)
 		transferMaxReadLevel = id
 	}
 
 	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
 
-	s.allocateTimerIDsLocked(request.TimerTasks)
+	s.allocateTimerIDsLocked(ctx, request.TimerTasks)
 
 Create_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
-		response, err := s.executionManager.CreateWorkflowExecution(request)
+		response, err := persistence.ExecutionManagerWithContext(ctx, s.executionManager).CreateWorkflowExecution(request)
 		if err != nil {
 			switch err.(type) {
 			case *shared.WorkflowExecutionAlreadyStartedError, *shared.ServiceBusyError:
@@ -282,7 +304,7 @@ Create_Loop:
 					// will either see that write, or know for certain that it failed.
 					// This allows the callers to reliably check the outcome by performing
 					// a read.
-					err1 := s.renewRangeLocked(false)
+					err1 := s.renewRangeLocked(ctx, false)
 					if err1 != nil {
 						// At this point we have no choice but to unload the shard, so that it
 						// gets a new RangeID when it's reloaded.
@@ -299,6 +321,11 @@ Create_Loop:
 }
 
 func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) error {
+	return s.updateWorkflowExecution(context.Background(), request)
+}
+
+func (s *shardContextImpl) updateWorkflowExecution(ctx context.Context,
+	request *persistence.UpdateWorkflowExecutionRequest) error {
 	s.Lock()
 	defer s.Unlock()
 
@@ -307,55 +334,55 @@ func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWo
 	// Must be done under the shard lock to ensure transfer tasks are written to persistence in increasing
 	// ID order
 	for _, task := range request.TransferTasks {
-		id, err := s.getNextTransferTaskIDLocked()
+		id, err := s.getNextTransferTaskIDLocked(ctx)
 		if err != nil {
 			return err
 		}
-		s.logger.Debugf("Assigning transfer task ID: %v", id)
+		s.logger.Debug("Assigning transfer task ID.", tag.TaskID(id))
 		task.SetTaskID(id)
 		transferMaxReadLevel = id
 	}
 
 	for _, task := range request.ReplicationTasks {
-		id, err := s.getNextTransferTaskIDLocked()
+		id, err := s.getNextTransferTaskIDLocked(ctx)
 		if err != nil {
 			return err
 		}
-		s.logger.Debugf("Assigning replication task ID: %v", id)
+		s.logger.Debug("Assigning replication task ID.", tag.TaskID(id))
 		task.SetTaskID(id)
 		transferMaxReadLevel = id
 	}
 
 	if request.ContinueAsNew != nil {
 		for _, task := range request.ContinueAsNew.TransferTasks {
-			id, err := s.getNextTransferTaskIDLocked()
+			id, err := s.getNextTransferTaskIDLocked(ctx)
 			if err != nil {
 				return err
 			}
-			s.logger.Debugf("Assigning transfer task ID: %v", id)
+			s.logger.Debug("Assigning transfer task ID.", tag.TaskID(id))
 			task.SetTaskID(id)
 			transferMaxReadLevel = id
 		}
 
 		for _, task := range request.ContinueAsNew.ReplicationTasks {
-			id, err := s.getNextTransferTaskIDLocked()
+			id, err := s.getNextTransferTaskIDLocked(ctx)
 			if err != nil {
 				return err
 			}
-			s.logger.Debugf("Assigning replication task ID: %v", id)
+			s.logger.Debug("Assigning replication task ID.", tag.TaskID(id))
 			task.SetTaskID(id)
 			transferMaxReadLevel = id
 		}
 	}
 	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
 
-	s.allocateTimerIDsLocked(request.TimerTasks)
+	s.allocateTimerIDsLocked(ctx, request.TimerTasks)
 
 Update_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
-		err := s.executionManager.UpdateWorkflowExecution(request)
+		err := persistence.ExecutionManagerWithContext(ctx, s.executionManager).UpdateWorkflowExecution(request)
 		if err != nil {
 			switch err.(type) {
 			case *persistence.ConditionFailedError, *shared.ServiceBusyError:
@@ -378,7 +405,7 @@ Update_Loop:
 					// will either see that write, or know for certain that it failed.
 					// This allows the callers to reliably check the outcome by performing
 					// a read.
-					err1 := s.renewRangeLocked(false)
+					err1 := s.renewRangeLocked(ctx, false)
 					if err1 != nil {
 						// At this point we have no choice but to unload the shard, so that it
 						// gets a new RangeID when it's reloaded.
@@ -395,6 +422,10 @@ Update_Loop:
 }
 
 func (s *shardContextImpl) ResetMutableState(request *persistence.ResetMutableStateRequest) error {
+	return s.resetMutableState(context.Background(), request)
+}
+
+func (s *shardContextImpl) resetMutableState(ctx context.Context, request *persistence.ResetMutableStateRequest) error {
 	s.Lock()
 	defer s.Unlock()
 
@@ -402,7 +433,7 @@ Reset_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
 		request.RangeID = currentRangeID
-		err := s.executionManager.ResetMutableState(request)
+		err := persistence.ExecutionManagerWithContext(ctx, s.executionManager).ResetMutableState(request)
 		if err != nil {
 			switch err.(type) {
 			case *persistence.ConditionFailedError, *shared.ServiceBusyError:
@@ -425,7 +456,7 @@ Reset_Loop:
 					// will either see that write, or know for certain that it failed.
 					// This allows the callers to reliably check the outcome by performing
 					// a read.
-					err1 := s.renewRangeLocked(false)
+					err1 := s.renewRangeLocked(ctx, false)
 					if err1 != nil {
 						// At this point we have no choice but to unload the shard, so that it
 						// gets a new RangeID when it's reloaded.
@@ -442,15 +473,21 @@ Reset_Loop:
 }
 
 func (s *shardContextImpl) AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) error {
+	return s.appendHistoryEvents(context.Background(), request)
+}
+
+func (s *shardContextImpl) appendHistoryEvents(ctx context.Context,
+	request *persistence.AppendHistoryEventsRequest) error {
+	historyMgr := persistence.HistoryManagerWithContext(ctx, s.historyMgr)
 	// No need to lock context here, as we can write concurrently to append history events
 	currentRangeID := atomic.LoadInt64(&s.rangeID)
 	request.RangeID = currentRangeID
-	err0 := s.historyMgr.AppendHistoryEvents(request)
+	err0 := historyMgr.AppendHistoryEvents(request)
 	if err0 != nil {
 		if _, ok := err0.(*persistence.ConditionFailedError); ok {
 			// Inserting a new event failed, lets try to overwrite the tail
 			request.Overwrite = true
-			return s.historyMgr.AppendHistoryEvents(request)
+			return historyMgr.AppendHistoryEvents(request)
 		}
 	}
 
@@ -468,7 +505,7 @@ func (s *shardContextImpl) GetConfig() *Config {
 	return s.config
 }
 
-func (s *shardContextImpl) GetLogger() bark.Logger {
+func (s *shardContextImpl) GetLogger() logging.Logger {
 	return s.logger
 }
 
@@ -498,8 +535,8 @@ func (s *shardContextImpl) closeShard() {
 	}
 }
 
-func (s *shardContextImpl) getNextTransferTaskIDLocked() (int64, error) {
-	if err := s.updateRangeIfNeededLocked(); err != nil {
+func (s *shardContextImpl) getNextTransferTaskIDLocked(ctx context.Context) (int64, error) {
+	if err := s.updateRangeIfNeededLocked(ctx); err != nil {
 		return -1, err
 	}
 
@@ -509,22 +546,22 @@ func (s *shardContextImpl) getNextTransferTaskIDLocked() (int64, error) {
 	return taskID, nil
 }
 
-func (s *shardContextImpl) updateRangeIfNeededLocked() error {
+func (s *shardContextImpl) updateRangeIfNeededLocked(ctx context.Context) error {
 	if s.transferSequenceNumber < s.maxTransferSequenceNumber {
 		return nil
 	}
 
-	return s.renewRangeLocked(false)
+	return s.renewRangeLocked(ctx, false)
 }
 
-func (s *shardContextImpl) renewRangeLocked(isStealing bool) error {
+func (s *shardContextImpl) renewRangeLocked(ctx context.Context, isStealing bool) error {
 	updatedShardInfo := copyShardInfo(s.shardInfo)
 	updatedShardInfo.RangeID++
 	if isStealing {
 		updatedShardInfo.StolenSinceRenew++
 	}
 
-	err := s.shardManager.UpdateShard(&persistence.UpdateShardRequest{
+	err := persistence.ShardManagerWithContext(ctx, s.shardManager).UpdateShard(&persistence.UpdateShardRequest{
 		ShardInfo:       updatedShardInfo,
 		PreviousRangeID: s.shardInfo.RangeID})
 	if err != nil {
@@ -533,7 +570,7 @@ func (s *shardContextImpl) renewRangeLocked(isStealing bool) error {
 			s.closeShard()
 		} else {
 			// Failure in updating shard to grab new RangeID
-			logging.LogPersistantStoreErrorEvent(s.logger, logging.TagValueStoreOperationUpdateShard, err,
+			logging.LogPersistantStoreErrorEvent(s.logger, tag.StoreOperationUpdateShard, err,
 				fmt.Sprintf("{PreviousRangeID: %v, NewRangeID: %v}", s.shardInfo.RangeID, updatedShardInfo.RangeID))
 		}
 		return err
@@ -554,7 +591,7 @@ func (s *shardContextImpl) renewRangeLocked(isStealing bool) error {
 
 func (s *shardContextImpl) updateMaxReadLevelLocked(rl int64) {
 	if rl > s.transferMaxReadLevel {
-		s.logger.Debugf("Updating MaxReadLevel: %v", rl)
+		s.logger.Debug("Updating MaxReadLevel.", tag.ReadLevel(rl))
 		s.transferMaxReadLevel = rl
 	}
 }
@@ -583,26 +620,29 @@ func (s *shardContextImpl) updateShardInfoLocked() error {
 	return err
 }
 
-func (s *shardContextImpl) allocateTimerIDsLocked(timerTasks []persistence.Task) error {
+func (s *shardContextImpl) allocateTimerIDsLocked(ctx context.Context, timerTasks []persistence.Task) error {
 	// assign IDs for the timer tasks. They need to be assigned under shard lock.
 	for _, task := range timerTasks {
 		ts := persistence.GetVisibilityTSFrom(task)
 		if ts.Before(s.shardInfo.TimerAckLevel) {
 			// This is not a common scenario, the shard can move and new host might have a time SKU.
 			// We generate a new timer ID that is above the ack level with an offset.
-			s.logger.Warnf("%v: New timer generated is less than ack level. timestamp: %v, ackLevel: %v",
-				time.Now(), ts, s.shardInfo.TimerAckLevel)
+			s.logger.Warn("New timer generated is less than ack level.",
+				tag.Timestamp(ts),
+				tag.AckLevel(s.shardInfo.TimerAckLevel))
 			newTimestamp := s.shardInfo.TimerAckLevel
 			persistence.SetVisibilityTSFrom(task, newTimestamp.Add(time.Second))
 		}
 
-		seqNum, err := s.getNextTransferTaskIDLocked()
+		seqNum, err := s.getNextTransferTaskIDLocked(ctx)
 		if err != nil {
 			return err
 		}
 		task.SetTaskID(seqNum)
-		s.logger.Debugf("Assigning new timer (timestamp: %v, seq: %v) ackLeveL: %v",
-			persistence.GetVisibilityTSFrom(task), task.GetTaskID(), s.shardInfo.TimerAckLevel)
+		s.logger.Debug("Assigning new timer.",
+			tag.Timestamp(persistence.GetVisibilityTSFrom(task)),
+			tag.TaskID(task.GetTaskID()),
+			tag.AckLevel(s.shardInfo.TimerAckLevel))
 	}
 	return nil
 }
@@ -633,10 +673,47 @@ func (s *shardContextImpl) GetCurrentTime(cluster string) time.Time {
 	return time.Now()
 }
 
+// WithContext returns the shard tracing the persistence calls it makes as children of the span of the context
+func (s *shardContextImpl) WithContext(ctx context.Context) ShardContext {
+	return &shardContextWithContext{shardContextImpl: s, ctx: ctx}
+}
+
+func (s *shardContextWithContext) GetExecutionManager() persistence.ExecutionManager {
+	return persistence.ExecutionManagerWithContext(s.ctx, s.executionManager)
+}
+
+func (s *shardContextWithContext) GetHistoryManager() persistence.HistoryManager {
+	return persistence.HistoryManagerWithContext(s.ctx, s.historyMgr)
+}
+
+func (s *shardContextWithContext) GetNextTransferTaskID() (int64, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.getNextTransferTaskIDLocked(s.ctx)
+}
+
+func (s *shardContextWithContext) CreateWorkflowExecution(request *persistence.CreateWorkflowExecutionRequest) (
+	*persistence.CreateWorkflowExecutionResponse, error) {
+	return s.createWorkflowExecution(s.ctx, request)
+}
+
+func (s *shardContextWithContext) UpdateWorkflowExecution(request *persistence.UpdateWorkflowExecutionRequest) error {
+	return s.updateWorkflowExecution(s.ctx, request)
+}
+
+func (s *shardContextWithContext) ResetMutableState(request *persistence.ResetMutableStateRequest) error {
+	return s.resetMutableState(s.ctx, request)
+}
+
+func (s *shardContextWithContext) AppendHistoryEvents(request *persistence.AppendHistoryEventsRequest) error {
+	return s.appendHistoryEvents(s.ctx, request)
+}
+
 // TODO: This method has too many parameters.  Clean it up.  Maybe create a struct to pass in as parameter.
 func acquireShard(shardID int, svc service.Service, shardManager persistence.ShardManager,
 	historyMgr persistence.HistoryManager, executionMgr persistence.ExecutionManager, domainCache cache.DomainCache,
-	owner string, closeCh chan<- int, config *Config, logger bark.Logger, metricsClient metrics.Client) (ShardContext,
+	owner string, closeCh chan<- int, config *Config, logger logging.Logger, metricsClient metrics.Client) (ShardContext,
 	error) {
 	response, err0 := shardManager.GetShard(&persistence.GetShardRequest{ShardID: shardID})
 	if err0 != nil {
@@ -659,7 +736,7 @@ func acquireShard(shardID int, svc service.Service, shardManager persistence.Sha
 		}
 	}
 
-	context := &shardContextImpl{
+	shardContext := &shardContextImpl{
 		shardID:          shardID,
 		service:          svc,
 		shardManager:     shardManager,
@@ -672,16 +749,14 @@ func acquireShard(shardID int, svc service.Service, shardManager persistence.Sha
 		config:           config,
 		standbyClusterCurrentTime: standbyClusterCurrentTime,
 	}
-	context.logger = logger.WithFields(bark.Fields{
-		logging.TagHistoryShardID: shardID,
-	})
+	shardContext.logger = logger.WithTags(tag.ShardID(shardID))
 
-	err1 := context.renewRangeLocked(true)
+	err1 := shardContext.renewRangeLocked(context.Background(), true)
 	if err1 != nil {
 		return nil, err1
 	}
 
-	return context, nil
+	return shardContext, nil
 }
 
 func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
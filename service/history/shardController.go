@@ -22,16 +22,16 @@ package history
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/cache"
 
-	"github.com/uber-common/bark"
-
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -40,6 +40,10 @@ import (
 
 const (
 	shardControllerMembershipUpdateListenerName = "ShardController"
+
+	shardControllerStatusInitialized = "initialized"
+	shardControllerStatusStarted     = "started"
+	shardControllerStatusStopped     = "stopped"
 )
 
 type (
@@ -59,7 +63,7 @@ type (
 		isStopped           int32
 		shutdownWG          sync.WaitGroup
 		shutdownCh          chan struct{}
-		logger              bark.Logger
+		logger              logging.Logger
 		config              *Config
 		metricsClient       metrics.Client
 
@@ -80,7 +84,7 @@ type (
 		host          *membership.HostInfo
 		engine        Engine
 		config        *Config
-		logger        bark.Logger
+		logger        logging.Logger
 		metricsClient metrics.Client
 	}
 )
@@ -88,10 +92,8 @@ type (
 func newShardController(svc service.Service, host *membership.HostInfo, resolver membership.ServiceResolver,
 	shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager, metadataMgr persistence.MetadataManager,
 	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory,
-	config *Config, logger bark.Logger, metricsClient metrics.Client) *shardController {
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowComponent: logging.TagValueShardController,
-	})
+	config *Config, logger logging.Logger, metricsClient metrics.Client) *shardController {
+	logger = logger.WithTags(tag.ComponentShardController)
 	return &shardController{
 		service:             svc,
 		host:                host,
@@ -115,7 +117,7 @@ func newShardController(svc service.Service, host *membership.HostInfo, resolver
 func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence.ShardManager,
 	historyMgr persistence.HistoryManager, domainCache cache.DomainCache,
 	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory, host *membership.HostInfo,
-	config *Config, logger bark.Logger, metricsClient metrics.Client) (*historyShardsItem, error) {
+	config *Config, logger logging.Logger, metricsClient metrics.Client) (*historyShardsItem, error) {
 
 	executionMgr, err := executionMgrFactory.CreateExecutionManager(shardID)
 	if err != nil {
@@ -132,9 +134,7 @@ func newHistoryShardsItem(shardID int, svc service.Service, shardMgr persistence
 		engineFactory: factory,
 		host:          host,
 		config:        config,
-		logger: logger.WithFields(bark.Fields{
-			logging.TagHistoryShardID: shardID,
-		}),
+		logger: logger.WithTags(tag.ShardID(shardID)),
 		metricsClient: metricsClient,
 	}, nil
 }
@@ -359,6 +359,43 @@ func (c *shardController) numShards() int {
 	return nShards
 }
 
+func (c *shardController) shardIDs() []int32 {
+	c.RLock()
+	defer c.RUnlock()
+
+	ids := make([]int32, 0, len(c.historyShards))
+	for id := range c.historyShards {
+		ids = append(ids, int32(id))
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// historyCacheSizes returns the number of workflow executions held by the history cache of each shard engine
+func (c *shardController) historyCacheSizes() map[int32]int32 {
+	c.RLock()
+	defer c.RUnlock()
+
+	sizes := make(map[int32]int32, len(c.historyShards))
+	for id, item := range c.historyShards {
+		if engine, ok := item.getEngine().(*historyEngineImpl); ok {
+			sizes[int32(id)] = int32(engine.historyCache.Size())
+		}
+	}
+	return sizes
+}
+
+func (c *shardController) status() string {
+	switch {
+	case atomic.LoadInt32(&c.isStopped) == 1:
+		return shardControllerStatusStopped
+	case atomic.LoadInt32(&c.isStarted) == 1:
+		return shardControllerStatusStarted
+	default:
+		return shardControllerStatusInitialized
+	}
+}
+
 func (i *historyShardsItem) getEngine() Engine {
 	i.RLock()
 	defer i.RUnlock()
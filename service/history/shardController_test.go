@@ -21,21 +21,19 @@
 package history
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
-	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
-
-	"errors"
-
-	"sync"
-	"time"
 
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
@@ -61,7 +59,7 @@ type (
 		mockMessagingClient     messaging.Client
 		mockService             service.Service
 		config                  *Config
-		logger                  bark.Logger
+		logger                  logging.Logger
 		metricsClient           metrics.Client
 	}
 )
@@ -72,7 +70,7 @@ func TestShardControllerSuite(t *testing.T) {
 }
 
 func (s *shardControllerSuite) SetupTest() {
-	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.logger = logging.NewDevelopmentLogger()
 	s.config = NewConfig(dynamicconfig.NewNopCollection(), 1)
 	s.metricsClient = metrics.NewClient(tally.NoopScope, metrics.History)
 	s.hostInfo = membership.NewHostInfo("shardController-host-test", nil)
@@ -404,7 +402,7 @@ func (s *shardControllerSuite) TestHistoryEngineClosed() {
 				for shardID := 0; shardID < 2; shardID++ {
 					_, err := s.controller.getEngineForShard(shardID)
 					if err != nil {
-						s.logger.Errorf("ShardLost: %v", err)
+						s.logger.Error("ShardLost", tag.Error(err))
 						shardLost = true
 					}
 					time.Sleep(20 * time.Millisecond)
@@ -484,7 +482,7 @@ func (s *shardControllerSuite) TestRingUpdated() {
 				for shardID := 0; shardID < 2; shardID++ {
 					_, err := s.controller.getEngineForShard(shardID)
 					if err != nil {
-						s.logger.Errorf("ShardLost: %v", err)
+						s.logger.Error("ShardLost", tag.Error(err))
 						shardLost = true
 					}
 					time.Sleep(20 * time.Millisecond)
@@ -539,7 +537,7 @@ func (s *shardControllerSuite) TestShardControllerClosed() {
 				for shardID := 0; shardID < numShards; shardID++ {
 					_, err := s.controller.getEngineForShard(shardID)
 					if err != nil {
-						s.logger.Errorf("ShardLost: %v", err)
+						s.logger.Error("ShardLost", tag.Error(err))
 						shardLost = true
 					}
 					time.Sleep(20 * time.Millisecond)
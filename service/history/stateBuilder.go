@@ -24,10 +24,10 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -36,7 +36,7 @@ type (
 		shard       ShardContext
 		msBuilder   *mutableStateBuilder
 		domainCache cache.DomainCache
-		logger      bark.Logger
+		logger      logging.Logger
 
 		transferTasks       []persistence.Task
 		timerTasks          []persistence.Task
@@ -45,7 +45,7 @@ type (
 	}
 )
 
-func newStateBuilder(shard ShardContext, msBuilder *mutableStateBuilder, logger bark.Logger) *stateBuilder {
+func newStateBuilder(shard ShardContext, msBuilder *mutableStateBuilder, logger logging.Logger) *stateBuilder {
 
 	return &stateBuilder{
 		shard:       shard,
@@ -76,7 +76,11 @@ func (b *stateBuilder) applyEvents(version int64, sourceClusterName string, doma
 			}
 			b.msBuilder.ReplicateWorkflowExecutionStartedEvent(domainID, parentDomainID, execution, requestID, attributes)
 
-			b.timerTasks = append(b.timerTasks, b.scheduleWorkflowTimerTask(event, b.msBuilder))
+			b.timerTasks = append(b.timerTasks, b.scheduleWorkflowTimerTask(event, b.msBuilder,
+				attributes.GetFirstDecisionTaskBackoffSeconds()))
+			if timerTask := getWorkflowBackoffTimerTask(event); timerTask != nil {
+				b.timerTasks = append(b.timerTasks, timerTask)
+			}
 
 		case shared.EventTypeDecisionTaskScheduled:
 			attributes := event.DecisionTaskScheduledEventAttributes
@@ -246,6 +250,10 @@ func (b *stateBuilder) applyEvents(version int64, sourceClusterName string, doma
 		case shared.EventTypeMarkerRecorded:
 			// No mutable state action is needed
 
+		case shared.EventTypeUpsertWorkflowSearchAttributes:
+			b.msBuilder.ReplicateUpsertWorkflowSearchAttributesEvent(event)
+			b.transferTasks = append(b.transferTasks, b.scheduleUpsertWorkflowSearchAttributesTransferTask())
+
 		case shared.EventTypeWorkflowExecutionSignaled:
 			// No mutable state action is needed
 
@@ -301,7 +309,6 @@ func (b *stateBuilder) applyEvents(version int64, sourceClusterName string, doma
 			// ContinuedAsNew event also has history for first 2 events for next run as they are created transactionally
 			startedEvent := newRunHistory.Events[0]
 			startedAttributes := startedEvent.WorkflowExecutionStartedEventAttributes
-			dtScheduledEvent := newRunHistory.Events[1]
 
 			// History event only have the parentDomainName.  Lookup the domain ID from cache
 			var parentDomainID *string
@@ -324,21 +331,29 @@ func (b *stateBuilder) applyEvents(version int64, sourceClusterName string, doma
 			newRunStateBuilder = newMutableStateBuilderWithReplicationState(b.shard.GetConfig(), b.logger, version)
 			newRunStateBuilder.ReplicateWorkflowExecutionStartedEvent(domainID, parentDomainID, newExecution, uuid.New(),
 				startedAttributes)
-			di := newRunStateBuilder.ReplicateDecisionTaskScheduledEvent(
-				dtScheduledEvent.GetVersion(),
-				dtScheduledEvent.GetEventId(),
-				dtScheduledEvent.DecisionTaskScheduledEventAttributes.TaskList.GetName(),
-				dtScheduledEvent.DecisionTaskScheduledEventAttributes.GetStartToCloseTimeoutSeconds(),
-			)
-			nextEventID := di.ScheduleID + 1
+			// New run started with a backoff does not have the decision scheduled event, its first decision is
+			// scheduled by the workflow backoff timer
+			var di *decisionInfo
+			nextEventID := startedEvent.GetEventId() + 1
+			if len(newRunHistory.Events) > 1 {
+				dtScheduledEvent := newRunHistory.Events[1]
+				di = newRunStateBuilder.ReplicateDecisionTaskScheduledEvent(
+					dtScheduledEvent.GetVersion(),
+					dtScheduledEvent.GetEventId(),
+					dtScheduledEvent.DecisionTaskScheduledEventAttributes.TaskList.GetName(),
+					dtScheduledEvent.DecisionTaskScheduledEventAttributes.GetStartToCloseTimeoutSeconds(),
+				)
+				nextEventID = di.ScheduleID + 1
+				b.newRunTransferTasks = append(b.newRunTransferTasks, b.scheduleDecisionTransferTask(domainID,
+					b.getTaskList(newRunStateBuilder), di.ScheduleID))
+			}
 			newRunStateBuilder.executionInfo.NextEventID = nextEventID
 			newRunStateBuilder.executionInfo.LastFirstEventID = startedEvent.GetEventId()
 			// Set the history from replication task on the newStateBuilder
-			newRunStateBuilder.hBuilder = newHistoryBuilderFromEvents(newRunHistory.Events, b.logger)
+			newRunStateBuilder.hBuilder = newHistoryBuilderFromEvents(newRunHistory.Events, b.shard.GetConfig(), b.logger)
 
-			b.newRunTransferTasks = append(b.newRunTransferTasks, b.scheduleDecisionTransferTask(domainID,
-				b.getTaskList(newRunStateBuilder), di.ScheduleID))
-			b.newRunTimerTasks = append(b.newRunTimerTasks, b.scheduleWorkflowTimerTask(event, newRunStateBuilder))
+			b.newRunTimerTasks = append(b.newRunTimerTasks, b.scheduleWorkflowTimerTask(event, newRunStateBuilder,
+				startedAttributes.GetFirstDecisionTaskBackoffSeconds()))
 
 			b.msBuilder.ReplicateWorkflowExecutionContinuedAsNewEvent(sourceClusterName, domainID, event,
 				startedEvent, di, newRunStateBuilder)
@@ -404,6 +419,10 @@ func (b *stateBuilder) scheduleSignalWorkflowTransferTask(domainID string, workf
 	}
 }
 
+func (b *stateBuilder) scheduleUpsertWorkflowSearchAttributesTransferTask() persistence.Task {
+	return &persistence.UpsertWorkflowSearchAttributesTask{}
+}
+
 func (b *stateBuilder) scheduleDeleteHistoryTransferTask() persistence.Task {
 	return &persistence.CloseExecutionTask{}
 }
@@ -424,9 +443,9 @@ func (b *stateBuilder) scheduleActivityTimerTask(event *shared.HistoryEvent,
 }
 
 func (b *stateBuilder) scheduleWorkflowTimerTask(event *shared.HistoryEvent,
-	msBuilder *mutableStateBuilder) persistence.Task {
+	msBuilder *mutableStateBuilder, backoffSeconds int32) persistence.Task {
 	now := time.Unix(0, event.GetTimestamp())
-	timeout := now.Add(time.Duration(msBuilder.executionInfo.WorkflowTimeout) * time.Second)
+	timeout := now.Add(time.Duration(msBuilder.executionInfo.WorkflowTimeout+backoffSeconds) * time.Second)
 	return &persistence.WorkflowTimeoutTask{VisibilityTimestamp: timeout}
 }
 
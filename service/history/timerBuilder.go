@@ -26,10 +26,10 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/uber-common/bark"
 	w "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -69,7 +69,7 @@ type (
 		pendingActivityTimers  map[int64]*persistence.ActivityInfo
 		isLoadedActivityTimers bool
 		config                 *Config
-		logger                 bark.Logger
+		logger                 logging.Logger
 		localSeqNumGen         SequenceNumberGenerator // This one used to order in-memory list.
 		timeSource             common.TimeSource
 	}
@@ -120,14 +120,14 @@ func (l *localSeqNumGenerator) NextSeq() int64 {
 }
 
 // newTimerBuilder creates a timer builder.
-func newTimerBuilder(config *Config, logger bark.Logger, timeSource common.TimeSource) *timerBuilder {
+func newTimerBuilder(config *Config, logger logging.Logger, timeSource common.TimeSource) *timerBuilder {
 	return &timerBuilder{
 		userTimers:            timers{},
 		pendingUserTimers:     make(map[string]*persistence.TimerInfo),
 		activityTimers:        timers{},
 		pendingActivityTimers: make(map[int64]*persistence.ActivityInfo),
 		config:                config,
-		logger:                logger.WithField(logging.TagWorkflowComponent, "timer-builder"),
+		logger:                logger.WithTags(tag.ComponentTimerBuilder),
 		localSeqNumGen:        &localSeqNumGenerator{counter: 1},
 		timeSource:            timeSource,
 	}
@@ -138,8 +138,9 @@ func (tb *timerBuilder) AddStartToCloseDecisionTimoutTask(scheduleID, scheduleAt
 	startToCloseTimeout int32) *persistence.DecisionTimeoutTask {
 	timeOutTask := tb.createDecisionTimeoutTask(startToCloseTimeout, scheduleID, scheduleAttempt,
 		w.TimeoutTypeStartToClose)
-	tb.logger.Debugf("Adding Decision Timeout: with timeout: %v sec, EventID: %v",
-		startToCloseTimeout, timeOutTask.EventID)
+	tb.logger.Debug("Adding Decision Timeout.",
+		tag.WorkflowTimeout(startToCloseTimeout),
+		tag.WorkflowHistoryEventID(timeOutTask.EventID))
 	return timeOutTask
 }
 
@@ -148,8 +149,9 @@ func (tb *timerBuilder) AddScheduleToStartDecisionTimoutTask(scheduleID, schedul
 	scheduleToStartTimeout int32) *persistence.DecisionTimeoutTask {
 	timeOutTask := tb.createDecisionTimeoutTask(scheduleToStartTimeout, scheduleID, scheduleAttempt,
 		w.TimeoutTypeScheduleToStart)
-	tb.logger.Debugf("Adding Decision ScheduleToStartTimeout: with timeout: %v sec, EventID: %v",
-		scheduleToStartTimeout, timeOutTask.EventID)
+	tb.logger.Debug("Adding Decision ScheduleToStartTimeout.",
+		tag.WorkflowTimeout(scheduleToStartTimeout),
+		tag.WorkflowHistoryEventID(timeOutTask.EventID))
 	return timeOutTask
 }
 
@@ -174,8 +176,10 @@ func (tb *timerBuilder) AddActivityTimeoutTask(scheduleID int64,
 	}
 
 	timeOutTask := tb.createActivityTimeoutTask(fireTimeout, timeoutType, scheduleID, baseTime)
-	tb.logger.Debugf("%s: Adding Activity Timeout: with timeout: %v sec, TimeoutType: %v, EventID: %v",
-		time.Now(), fireTimeout, timeoutType.String(), timeOutTask.EventID)
+	tb.logger.Debug("Adding Activity Timeout.",
+		tag.WorkflowTimeout(fireTimeout),
+		tag.WorkflowTimeoutType(timeoutType),
+		tag.WorkflowHistoryEventID(timeOutTask.EventID))
 	return timeOutTask
 }
 
@@ -190,7 +194,7 @@ func (tb *timerBuilder) AddUserTimer(ti *persistence.TimerInfo, msBuilder *mutab
 		TimerID:         ti.TimerID,
 		TaskCreated:     ti.TaskID == TimerTaskStatusCreated}
 	tb.insertTimer(timer)
-	tb.logger.Debugf("Added User Timeout for timer ID: %s", ti.TimerID)
+	tb.logger.Debug("Added User Timeout.", tag.WorkflowTimerID(ti.TimerID))
 }
 
 // GetUserTimerTaskIfNeeded - if we need create a timer task for the user timers
@@ -248,8 +252,12 @@ func (tb *timerBuilder) GetActivityTimerTaskIfNeeded(msBuilder *mutableStateBuil
 		ai.TimerTaskStatus = ai.TimerTaskStatus | getActivityTimerStatus(w.TimeoutType(at.TimeoutType))
 		msBuilder.UpdateActivity(ai)
 
-		tb.logger.Debugf("%s: Adding Activity Timeout: with timeout: %v sec, ExpiryTime: %s, TimeoutType: %v, EventID: %v",
-			time.Now(), td.TimeoutSec, at.VisibilityTimestamp, td.TimeoutType.String(), at.EventID)
+		tb.logger.Debug("Adding Activity Timeout.",
+			tag.WorkflowTimeout(td.TimeoutSec),
+			tag.Timestamp(at.VisibilityTimestamp),
+			tag.WorkflowTimeoutType(td.TimeoutType),
+			tag.WorkflowHistoryEventID(at.EventID))
+
 	}
 	return timerTask
 }
@@ -437,6 +445,26 @@ func compareTimerIDLess(first *TimerSequenceID, second *TimerSequenceID) bool {
 	return false
 }
 
+// getWorkflowBackoffTimerTask returns the timer task which schedules the first decision of a run started with a
+// backoff, or nil if the run should get its first decision right away
+func getWorkflowBackoffTimerTask(startedEvent *w.HistoryEvent) persistence.Task {
+	attributes := startedEvent.WorkflowExecutionStartedEventAttributes
+	backoffSeconds := attributes.GetFirstDecisionTaskBackoffSeconds()
+	if backoffSeconds <= 0 {
+		return nil
+	}
+
+	timeoutType := persistence.WorkflowBackoffTimeoutTypeCron
+	if attributes.GetInitiator() == w.ContinueAsNewInitiatorRetryPolicy {
+		timeoutType = persistence.WorkflowBackoffTimeoutTypeRetry
+	}
+	startTime := time.Unix(0, startedEvent.GetTimestamp())
+	return &persistence.WorkflowBackoffTimerTask{
+		VisibilityTimestamp: startTime.Add(time.Duration(backoffSeconds) * time.Second),
+		TimeoutType:         timeoutType,
+	}
+}
+
 func getActivityTimerStatus(timeoutType w.TimeoutType) int32 {
 	switch timeoutType {
 	case w.TimeoutTypeHeartbeat:
@@ -26,6 +26,8 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 
@@ -36,7 +38,6 @@ import (
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 )
 
@@ -45,7 +46,7 @@ type (
 		suite.Suite
 		tb     *timerBuilder
 		config *Config
-		logger bark.Logger
+		logger logging.Logger
 	}
 )
 
@@ -66,9 +67,7 @@ func (s *timerBuilderProcessorSuite) SetupSuite() {
 	if testing.Verbose() {
 		log.SetOutput(os.Stdout)
 	}
-	logger := log.New()
-	//logger.Level = log.DebugLevel
-	s.logger = bark.NewLoggerFromLogrus(logger)
+	s.logger = logging.NewDevelopmentLogger()
 	s.config = NewConfig(dynamicconfig.NewNopCollection(), 1)
 	s.tb = newTimerBuilder(s.config, s.logger, &mockTimeSource{currTime: time.Now()})
 }
@@ -210,7 +209,7 @@ func (s *timerBuilderProcessorSuite) TestDecodeHistory() {
 	var historyEvents []*workflow.HistoryEvent
 	err = json.Unmarshal(data, &historyEvents)
 	if err != nil {
-		s.logger.Errorf("DecodeString failed with error: %+v", err)
+		s.logger.Error("DecodeString failed", tag.Error(err))
 		panic("Failed deserialization of history")
 	}
 
@@ -222,5 +221,5 @@ func (s *timerBuilderProcessorSuite) TestDecodeHistory() {
 
 func (s *timerBuilderProcessorSuite) TestDecodeKey() {
 	taskID := TimerSequenceID{VisibilityTimestamp: time.Unix(0, 0), TaskID: 1}
-	s.logger.Infof("Timer: %s, expiry: %v", TimerSequenceID(taskID), taskID.VisibilityTimestamp)
+	s.logger.Info("Timer", tag.Value(TimerSequenceID(taskID)), tag.Timestamp(taskID.VisibilityTimestamp))
 }
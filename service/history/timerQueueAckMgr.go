@@ -26,7 +26,8 @@ import (
 	"sync"
 	"time"
 
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -43,7 +44,7 @@ type (
 		clusterName   string
 		shard         ShardContext
 		executionMgr  persistence.ExecutionManager
-		logger        bark.Logger
+		logger        logging.Logger
 		metricsClient metrics.Client
 		config        *Config
 		// immutable max possible timer level
@@ -91,7 +92,7 @@ func (t timerSequenceIDs) Less(i, j int) bool {
 	return compareTimerIDLess(&t[i], &t[j])
 }
 
-func newTimerQueueAckMgr(shard ShardContext, metricsClient metrics.Client, clusterName string, logger bark.Logger) *timerQueueAckMgrImpl {
+func newTimerQueueAckMgr(shard ShardContext, metricsClient metrics.Client, clusterName string, logger logging.Logger) *timerQueueAckMgrImpl {
 	ackLevel := TimerSequenceID{VisibilityTimestamp: shard.GetTimerClusterAckLevel(clusterName)}
 	maxAckLevel := timerQueueAckMgrMaxTimestamp
 
@@ -114,7 +115,7 @@ func newTimerQueueAckMgr(shard ShardContext, metricsClient metrics.Client, clust
 	return timerQueueAckMgrImpl
 }
 
-func newTimerQueueFailoverAckMgr(shard ShardContext, metricsClient metrics.Client, standbyClusterName string, logger bark.Logger) *timerQueueAckMgrImpl {
+func newTimerQueueFailoverAckMgr(shard ShardContext, metricsClient metrics.Client, standbyClusterName string, logger logging.Logger) *timerQueueAckMgrImpl {
 	// failover ack manager will start from the standby cluster's ack level to active cluster's ack level
 	ackLevel := TimerSequenceID{VisibilityTimestamp: shard.GetTimerClusterAckLevel(standbyClusterName)}
 	maxAckLevel := shard.GetTimerClusterAckLevel(shard.GetService().GetClusterMetadata().GetCurrentClusterName())
@@ -155,7 +156,10 @@ func (t *timerQueueAckMgrImpl) readTimerTasks() ([]*persistence.TimerTaskInfo, *
 		if err != nil {
 			return nil, nil, false, err
 		}
-		t.logger.Debugf("readTimerTasks: ReadLevel: (%s) count: %v, more timer: %v", readLevel, len(tasks), morePage)
+		t.logger.Debug("readTimerTasks.",
+			tag.ReadLevel(readLevel),
+			tag.Counter(len(tasks)),
+			tag.Bool(morePage))
 	}
 
 	// We filter tasks so read only moves to desired timer tasks.
@@ -181,8 +185,12 @@ TaskFilterLoop:
 		_, isLoaded := outstandingTasks[timerSequenceID]
 		if isLoaded {
 			// timer already loaded
-			t.logger.Debugf("Skipping timer task: %v. WorkflowID: %v, RunID: %v, Type: %v",
-				timerSequenceID.String(), task.WorkflowID, task.RunID, task.TaskType)
+			t.logger.Debug("Skipping timer task.",
+				tag.Timestamp(timerSequenceID.VisibilityTimestamp),
+				tag.TaskID(timerSequenceID.TaskID),
+				tag.WorkflowID(task.WorkflowID),
+				tag.WorkflowRunID(task.RunID),
+				tag.TaskType(task.TaskType))
 			continue TaskFilterLoop
 		}
 
@@ -191,9 +199,10 @@ TaskFilterLoop:
 		// and one of the call is really slow, causing the read level updated by other threads,
 		// leading the if below to be true
 		if task.VisibilityTimestamp.Before(readLevel.VisibilityTimestamp) {
-			t.logger.Fatalf(
-				"Next timer task time stamp is less than current timer task read level. timer task: (%s), ReadLevel: (%s)",
-				timerSequenceID, readLevel)
+			t.logger.Fatal("Next timer task time stamp is less than current timer task read level.",
+				tag.Timestamp(timerSequenceID.VisibilityTimestamp),
+				tag.TaskID(timerSequenceID.TaskID),
+				tag.ReadLevel(readLevel))
 		}
 
 		if !t.isProcessNow(task.VisibilityTimestamp) {
@@ -201,7 +210,7 @@ TaskFilterLoop:
 			break TaskFilterLoop
 		}
 
-		t.logger.Debugf("Moving timer read level: (%s)", timerSequenceID)
+		t.logger.Debug("Moving timer read level.", tag.ReadLevel(timerSequenceID))
 		readLevel = timerSequenceID
 		outstandingTasks[timerSequenceID] = false
 		filteredTasks = append(filteredTasks, task)
@@ -293,14 +302,14 @@ func (t *timerQueueAckMgrImpl) getTimerTasks(minTimestamp time.Time, maxTimestam
 }
 
 func (t *timerQueueAckMgrImpl) updateTimerAckLevel(ackLevel TimerSequenceID) {
-	t.logger.Debugf("Updating timer ack level: %v", ackLevel)
+	t.logger.Debug("Updating timer ack level.", tag.AckLevel(ackLevel))
 
 	// not failover ack level updating
 	if !t.isFailover {
 		// Always update ackLevel to detect if the shared is stolen
 		if err := t.shard.UpdateTimerClusterAckLevel(t.clusterName, ackLevel.VisibilityTimestamp); err != nil {
 			t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.AckLevelUpdateFailedCounter)
-			t.logger.Errorf("Error updating timer ack level for shard: %v", err)
+			t.logger.Error("Error updating timer ack level for shard", tag.Error(err))
 		}
 	} else {
 		// TODO failover ack manager should persist failover ack level to Cassandra: issue #646
@@ -30,10 +30,10 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
@@ -56,7 +56,7 @@ type (
 		mockProducer        *mocks.KafkaProducer
 		mockClusterMetadata *mocks.ClusterMetadata
 		metricsClient       metrics.Client
-		logger              bark.Logger
+		logger              logging.Logger
 		clusterName         string
 		timerQueueAckMgr    *timerQueueAckMgrImpl
 	}
@@ -74,7 +74,7 @@ type (
 		mockProducer             *mocks.KafkaProducer
 		mockClusterMetadata      *mocks.ClusterMetadata
 		metricsClient            metrics.Client
-		logger                   bark.Logger
+		logger                   logging.Logger
 		domainID                 string
 		standbyClusterName       string
 		timerQueueFailoverAckMgr *timerQueueAckMgrImpl
@@ -107,7 +107,7 @@ func (s *timerQueueAckMgrSuite) SetupTest() {
 	s.mockShardMgr = &mocks.ShardManager{}
 	s.mockMetadataMgr = &mocks.MetadataManager{}
 	s.mockHistoryMgr = &mocks.HistoryManager{}
-	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.logger = logging.NewDevelopmentLogger()
 	s.metricsClient = metrics.NewClient(tally.NoopScope, metrics.History)
 	s.mockClusterMetadata = &mocks.ClusterMetadata{}
 	s.mockProducer = &mocks.KafkaProducer{}
@@ -555,7 +555,7 @@ func (s *timerQueueFailoverAckMgrSuite) SetupTest() {
 	s.mockShardMgr = &mocks.ShardManager{}
 	s.mockMetadataMgr = &mocks.MetadataManager{}
 	s.mockHistoryMgr = &mocks.HistoryManager{}
-	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.logger = logging.NewDevelopmentLogger()
 	s.metricsClient = metrics.NewClient(tally.NoopScope, metrics.History)
 	s.mockClusterMetadata = &mocks.ClusterMetadata{}
 	s.mockProducer = &mocks.KafkaProducer{}
@@ -21,17 +21,20 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"time"
 
-	"github.com/uber-common/bark"
 	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 )
 
 type (
@@ -40,7 +43,7 @@ type (
 		historyService          *historyEngineImpl
 		cache                   *historyCache
 		timerTaskFilter         timerTaskFilter
-		logger                  bark.Logger
+		logger                  logging.Logger
 		metricsClient           metrics.Client
 		currentClusterName      string
 		matchingClient          matching.Client
@@ -50,14 +53,12 @@ type (
 	}
 )
 
-func newTimerQueueActiveProcessor(shard ShardContext, historyService *historyEngineImpl, matchingClient matching.Client, logger bark.Logger) *timerQueueActiveProcessorImpl {
+func newTimerQueueActiveProcessor(shard ShardContext, historyService *historyEngineImpl, matchingClient matching.Client, logger logging.Logger) *timerQueueActiveProcessorImpl {
 	clusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 	timeNow := func() time.Time {
 		return shard.GetCurrentTime(clusterName)
 	}
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowCluster: clusterName,
-	})
+	logger = logger.WithTags(tag.WorkflowCluster(clusterName))
 	timerTaskFilter := func(timer *persistence.TimerTaskInfo) (bool, error) {
 		domainEntry, err := shard.GetDomainCache().GetDomainByID(timer.DomainID)
 		if err != nil {
@@ -96,15 +97,13 @@ func newTimerQueueActiveProcessor(shard ShardContext, historyService *historyEng
 	return processor
 }
 
-func newTimerQueueFailoverProcessor(shard ShardContext, historyService *historyEngineImpl, domainID string, standbyClusterName string, matchingClient matching.Client, logger bark.Logger) *timerQueueActiveProcessorImpl {
+func newTimerQueueFailoverProcessor(shard ShardContext, historyService *historyEngineImpl, domainID string, standbyClusterName string, matchingClient matching.Client, logger logging.Logger) *timerQueueActiveProcessorImpl {
 	clusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 	timeNow := func() time.Time {
 		// should use current cluster's time when doing domain failover
 		return shard.GetCurrentTime(clusterName)
 	}
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowCluster: clusterName,
-	})
+	logger = logger.WithTags(tag.WorkflowCluster(clusterName))
 	timerTaskFilter := func(timer *persistence.TimerTaskInfo) (bool, error) {
 		if timer.DomainID == domainID {
 			return true, nil
@@ -162,9 +161,23 @@ func (t *timerQueueActiveProcessorImpl) process(timerTask *persistence.TimerTask
 	}
 
 	taskID := TimerSequenceID{VisibilityTimestamp: timerTask.VisibilityTimestamp, TaskID: timerTask.TaskID}
-	t.logger.Debugf("Processing timer: (%s), for WorkflowID: %v, RunID: %v, Type: %v, TimeoutType: %v, EventID: %v, Attempt: %v",
-		taskID, timerTask.WorkflowID, timerTask.RunID, t.timerQueueProcessorBase.getTimerTaskType(timerTask.TaskType),
-		workflow.TimeoutType(timerTask.TimeoutType).String(), timerTask.EventID, timerTask.ScheduleAttempt)
+	t.logger.Debug("Processing timer.",
+		tag.WorkflowDomainID(timerTask.DomainID),
+		tag.WorkflowID(timerTask.WorkflowID),
+		tag.WorkflowRunID(timerTask.RunID),
+		tag.TaskID(taskID.TaskID),
+		tag.Timestamp(taskID.VisibilityTimestamp),
+		tag.TaskType(timerTask.TaskType),
+		tag.WorkflowTimeoutType(workflow.TimeoutType(timerTask.TimeoutType)),
+		tag.WorkflowHistoryEventID(timerTask.EventID),
+		tag.Attempt(timerTask.ScheduleAttempt))
+
+	// the calls to matching are traced as children of the task span
+	span, ctx := tracing.StartSpan(context.Background(), t.shard.GetService().GetTracer(), "TimerActiveTask")
+	span.SetTag("task-type", t.timerQueueProcessorBase.getTimerTaskType(timerTask.TaskType))
+	span.SetTag("execution-id", timerTask.WorkflowID)
+	span.SetTag("run-id", timerTask.RunID)
+	defer func() { tracing.FinishSpan(span, err) }()
 
 	scope := metrics.TimerQueueProcessorScope
 	switch timerTask.TaskType {
@@ -186,7 +199,11 @@ func (t *timerQueueActiveProcessorImpl) process(timerTask *persistence.TimerTask
 
 	case persistence.TaskTypeRetryTimer:
 		scope = metrics.TimerTaskRetryTimerScope
-		err = t.processRetryTimer(timerTask)
+		err = t.processRetryTimer(ctx, timerTask)
+
+	case persistence.TaskTypeWorkflowBackoffTimer:
+		scope = metrics.TimerTaskWorkflowBackoffTimerScope
+		err = t.processWorkflowBackoffTimer(timerTask)
 
 	case persistence.TaskTypeDeleteHistoryEvent:
 		scope = metrics.TimerTaskDeleteHistoryEvent
@@ -241,7 +258,7 @@ Update_History_Loop:
 		for _, td := range tBuilder.GetUserTimers(msBuilder) {
 			hasTimer, ti := tBuilder.GetUserTimer(td.TimerID)
 			if !hasTimer {
-				t.logger.Debugf("Failed to find in memory user timer: %s", td.TimerID)
+				t.logger.Debug("Failed to find in memory user timer.", tag.WorkflowTimerID(td.TimerID))
 				return fmt.Errorf("Failed to find in memory user timer: %s", td.TimerID)
 			}
 
@@ -306,8 +323,9 @@ Update_History_Loop:
 		// some extreme cassandra failure cases.
 		if scheduleID >= msBuilder.GetNextEventID() {
 			t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.StaleMutableStateCounter)
-			t.logger.Debugf("processActivityTimeout: scheduleID mismatch. MS NextEventID: %v, scheduleID: %v",
-				msBuilder.GetNextEventID(), scheduleID)
+			t.logger.Debug("processActivityTimeout: scheduleID mismatch.",
+				tag.WorkflowNextEventID(msBuilder.GetNextEventID()),
+				tag.WorkflowScheduleID(scheduleID))
 			// Reload workflow execution history
 			context.clear()
 			continue Update_History_Loop
@@ -349,8 +367,10 @@ Update_History_Loop:
 
 			if isExpired := tBuilder.IsTimerExpired(td, timerTask.VisibilityTimestamp); isExpired {
 				timeoutType := td.TimeoutType
-				t.logger.Debugf("Activity TimeoutType: %v, scheduledID: %v, startedId: %v. \n",
-					timeoutType, ai.ScheduleID, ai.StartedID)
+				t.logger.Debug("Activity timed out.",
+					tag.WorkflowTimeoutType(timeoutType),
+					tag.WorkflowScheduleID(ai.ScheduleID),
+					tag.WorkflowStartedID(ai.StartedID))
 
 				if td.Attempt < ai.Attempt && timeoutType != workflow.TimeoutTypeScheduleToClose {
 					// retry could update ai.Attempt, and we should ignore further timeouts for previous attempt
@@ -365,8 +385,10 @@ Update_History_Loop:
 						timerTasks = append(timerTasks, retryTask)
 						createNewTimer = true
 
-						t.logger.Debugf("Ignore ActivityTimeout (%v) as retry is needed. New attempt: %v, retry backoff duration: %v.",
-							timeoutType, ai.Attempt, retryTask.(*persistence.RetryTimerTask).VisibilityTimestamp.Sub(time.Now()))
+						t.logger.Debug("Ignore ActivityTimeout as retry is needed.",
+							tag.WorkflowTimeoutType(timeoutType),
+							tag.Attempt(int64(ai.Attempt)),
+							tag.Duration(retryTask.(*persistence.RetryTimerTask).VisibilityTimestamp.Sub(time.Now())))
 
 						continue
 					}
@@ -425,8 +447,11 @@ Update_History_Loop:
 					msBuilder.UpdateActivity(ai)
 					createNewTimer = true
 
-					t.logger.Debugf("%s: Adding Activity Timeout: with timeout: %v sec, ExpiryTime: %s, TimeoutType: %v, EventID: %v",
-						time.Now(), td.TimeoutSec, at.VisibilityTimestamp, td.TimeoutType.String(), at.EventID)
+					t.logger.Debug("Adding Activity Timeout.",
+						tag.WorkflowTimeout(td.TimeoutSec),
+						tag.Timestamp(at.VisibilityTimestamp),
+						tag.WorkflowTimeoutType(td.TimeoutType),
+						tag.WorkflowHistoryEventID(at.EventID))
 				}
 
 				// Done!
@@ -540,7 +565,7 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
-func (t *timerQueueActiveProcessorImpl) processRetryTimer(task *persistence.TimerTaskInfo) error {
+func (t *timerQueueActiveProcessorImpl) processRetryTimer(ctx context.Context, task *persistence.TimerTaskInfo) error {
 	t.metricsClient.IncCounter(metrics.TimerTaskRetryTimerScope, metrics.TaskRequests)
 	sw := t.metricsClient.StartTimer(metrics.TimerTaskRetryTimerScope, metrics.TaskLatency)
 	defer sw.Stop()
@@ -597,7 +622,7 @@ func (t *timerQueueActiveProcessorImpl) processRetryTimer(task *persistence.Time
 		scheduleToStartTimeout := ai.ScheduleToStartTimeout
 
 		release(nil) // release earlier as we don't need the lock anymore
-		err = t.matchingClient.AddActivityTask(nil, &m.AddActivityTaskRequest{
+		err = t.matchingClient.AddActivityTask(ctx, &m.AddActivityTaskRequest{
 			DomainUUID:                    common.StringPtr(targetDomainID),
 			SourceDomainUUID:              common.StringPtr(domainID),
 			Execution:                     &execution,
@@ -606,8 +631,14 @@ func (t *timerQueueActiveProcessorImpl) processRetryTimer(task *persistence.Time
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
 		})
 
-		t.logger.Debugf("Adding ActivityTask for retry, WorkflowID: %v, RunID: %v, ScheduledID: %v, TaskList: %v, Attempt: %v, Err: %v",
-			task.WorkflowID, task.RunID, scheduledID, taskList.GetName(), task.ScheduleAttempt, err)
+		t.logger.Debug("Adding ActivityTask for retry.",
+			tag.WorkflowDomainID(task.DomainID),
+			tag.WorkflowID(task.WorkflowID),
+			tag.WorkflowRunID(task.RunID),
+			tag.WorkflowScheduleID(scheduledID),
+			tag.TaskListName(taskList.GetName()),
+			tag.Attempt(task.ScheduleAttempt),
+			tag.Error(err))
 
 		return err
 	}
@@ -650,6 +681,18 @@ Update_History_Loop:
 			return nil
 		}
 
+		timeoutReason := getTimeoutErrorReason(workflow.TimeoutTypeStartToClose)
+		retryBackoffInterval := msBuilder.getRetryBackoffInterval(timeoutReason)
+		if retryBackoffInterval != backoff.NoBackoff || msBuilder.executionInfo.CronSchedule != "" {
+			// timed out run of a workflow with a retry policy is retried after the backoff, otherwise timed out run of
+			// a cron workflow is continued as a new run for the next scheduled time
+			err = t.continueAsNewWorkflow(context, msBuilder, retryBackoffInterval, timeoutReason)
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+			return err
+		}
+
 		if e := msBuilder.AddTimeoutWorkflowEvent(); e == nil {
 			// If we failed to add the event that means the workflow is already completed.
 			// we drop this timeout event.
@@ -669,6 +712,94 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
+func (t *timerQueueActiveProcessorImpl) processWorkflowBackoffTimer(task *persistence.TimerTaskInfo) (retError error) {
+	t.metricsClient.IncCounter(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	context, release, err0 := t.cache.getOrCreateWorkflowExecution(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task))
+	if err0 != nil {
+		return err0
+	}
+	defer func() { release(retError) }()
+
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return err1
+		}
+
+		if !msBuilder.isWorkflowExecutionRunning() || msBuilder.hasProcessedOrPendingDecisionTask() {
+			// first decision is already scheduled, nothing to do
+			return nil
+		}
+
+		ok, err := verifyTimerTaskVersion(t.shard, task.DomainID, msBuilder.GetStartVersion(), task)
+		if err != nil {
+			return err
+		} else if !ok {
+			return nil
+		}
+
+		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
+		// the history and try the operation again.
+		err = t.updateWorkflowExecution(context, msBuilder, true, false, nil, nil)
+		if err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+		}
+		return err
+	}
+	return ErrMaxAttemptsExceeded
+}
+
+func (t *timerQueueActiveProcessorImpl) continueAsNewWorkflow(context *workflowExecutionContext,
+	msBuilder *mutableStateBuilder, retryBackoffInterval time.Duration, failureReason string) error {
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(msBuilder.executionInfo.DomainID)
+	if err != nil {
+		return err
+	}
+	var newStateBuilder *mutableStateBuilder
+	var newRunTimerTasks []persistence.Task
+	if retryBackoffInterval != backoff.NoBackoff {
+		newStateBuilder, newRunTimerTasks, err = t.historyService.continueAsNewRetryWorkflow(domainEntry, msBuilder,
+			common.EmptyEventID, retryBackoffInterval, failureReason, nil)
+	} else {
+		newStateBuilder, newRunTimerTasks, err = t.historyService.continueAsNewCronWorkflow(domainEntry, msBuilder,
+			common.EmptyEventID)
+	}
+	if err != nil {
+		return err
+	}
+
+	tBuilder := t.historyService.getTimerBuilder(&context.workflowExecution)
+	tranT, timerT, err := t.historyService.getDeleteWorkflowTasks(msBuilder.executionInfo.DomainID, tBuilder)
+	if err != nil {
+		return err
+	}
+
+	// Generate a transaction ID for appending events to history
+	transactionID, err := t.historyService.shard.GetNextTransferTaskID()
+	if err != nil {
+		return err
+	}
+
+	timerTasks := []persistence.Task{timerT}
+	err = context.continueAsNewWorkflowExecution(nil, newStateBuilder, []persistence.Task{tranT}, timerTasks,
+		transactionID)
+	if err != nil {
+		if isShardOwnershiptLostError(err) {
+			// Shard is stolen.  Stop timer processing to reduce duplicates
+			t.timerQueueProcessorBase.Stop()
+		}
+		return err
+	}
+	t.notifyNewTimers(append(timerTasks, newRunTimerTasks...))
+	return nil
+}
+
 func (t *timerQueueActiveProcessorImpl) updateWorkflowExecution(
 	context *workflowExecutionContext,
 	msBuilder *mutableStateBuilder,
@@ -25,9 +25,9 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -42,7 +42,7 @@ type (
 		config                 *Config
 		historyService         *historyEngineImpl
 		ackLevel               TimerSequenceID
-		logger                 bark.Logger
+		logger                 logging.Logger
 		matchingClient         matching.Client
 		isStarted              int32
 		isStopped              int32
@@ -53,11 +53,9 @@ type (
 	}
 )
 
-func newTimerQueueProcessor(shard ShardContext, historyService *historyEngineImpl, matchingClient matching.Client, logger bark.Logger) timerQueueProcessor {
+func newTimerQueueProcessor(shard ShardContext, historyService *historyEngineImpl, matchingClient matching.Client, logger logging.Logger) timerQueueProcessor {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowComponent: logging.TagValueTimerQueueComponent,
-	})
+	logger = logger.WithTags(tag.ComponentTimerQueue)
 	standbyTimerProcessors := make(map[string]*timerQueueStandbyProcessorImpl)
 	for clusterName := range shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions() {
 		if clusterName != shard.GetService().GetClusterMetadata().GetCurrentClusterName() {
@@ -157,7 +155,7 @@ func (t *timerQueueProcessorImpl) completeTimersLoop() {
 			for attempt := 0; attempt < t.config.TimerProcessorCompleteTimerFailureRetryCount; attempt++ {
 				err := t.completeTimers()
 				if err != nil {
-					t.logger.Infof("Failed to complete timers: %v.", err)
+					t.logger.Info("Failed to complete timers", tag.Error(err))
 					backoff := time.Duration(attempt * 100)
 					time.Sleep(backoff * time.Millisecond)
 				} else {
@@ -214,7 +212,11 @@ LoadCompleteLoop:
 			if err := executionMgr.CompleteTimerTask(&persistence.CompleteTimerTaskRequest{
 				VisibilityTimestamp: timer.VisibilityTimestamp,
 				TaskID:              timer.TaskID}); err != nil {
-				t.logger.Warnf("Timer queue ack manager unable to complete timer task: %v; %v", timer, err)
+				t.logger.Warn("Timer queue ack manager unable to complete timer task.",
+					tag.Timestamp(timer.VisibilityTimestamp),
+					tag.TaskID(timer.TaskID),
+					tag.Error(err))
+
 			}
 			t.finishedTaskCounter++
 		}
@@ -21,6 +21,7 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"os"
 	"testing"
@@ -29,6 +30,7 @@ import (
 	"github.com/pborman/uuid"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/metrics"
@@ -39,9 +41,9 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/service"
 )
@@ -52,7 +54,7 @@ type (
 		mockShardManager *mocks.ShardManager
 		shardClosedCh    chan int
 		config           *Config
-		logger           bark.Logger
+		logger           logging.Logger
 
 		mockHistoryEngine   *historyEngineImpl
 		mockMatchingClient  *mocks.MatchingClient
@@ -66,6 +68,11 @@ type (
 		mockMessagingClient messaging.Client
 		mockService         service.Service
 	}
+
+	mockArchivalProcessor struct {
+		archived bool
+		requests []*archiver.ArchiveRequest
+	}
 )
 
 func TestTimerQueueProcessor2Suite(t *testing.T) {
@@ -80,7 +87,7 @@ func (s *timerQueueProcessor2Suite) SetupSuite() {
 
 	log2 := log.New()
 	log2.Level = log.DebugLevel
-	s.logger = bark.NewLoggerFromLogrus(log2)
+	s.logger = logging.NewDevelopmentLogger()
 
 	s.config = NewConfig(dynamicconfig.NewNopCollection(), 1)
 }
@@ -300,3 +307,101 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout() {
 	<-waitCh
 	s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor.Stop()
 }
+
+func (s *timerQueueProcessor2Suite) TestDeleteHistoryEvent_NotArchived() {
+	archivalProcessor := &mockArchivalProcessor{}
+	s.mockHistoryEngine.archivalProcessor = archivalProcessor
+	timerTask := s.setupDeleteHistoryEvent("delete-history-not-archived-test")
+
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.TimerTasks) == 1 && request.TimerTasks[0].GetType() == persistence.TaskTypeDeleteHistoryEvent &&
+			len(request.TransferTasks) == 0 && len(request.ReplicationTasks) == 0
+	})).Return(nil).Once()
+
+	processor := s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor
+	s.Nil(processor.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask))
+	s.Equal([]*archiver.ArchiveRequest{{
+		DomainID:   timerTask.DomainID,
+		WorkflowID: timerTask.WorkflowID,
+		RunID:      timerTask.RunID,
+		Bucket:     "archival-bucket",
+	}}, archivalProcessor.requests)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "DeleteWorkflowExecution", mock.Anything)
+	s.mockHistoryMgr.AssertNotCalled(s.T(), "DeleteWorkflowExecutionHistory", mock.Anything)
+}
+
+func (s *timerQueueProcessor2Suite) TestDeleteHistoryEvent_Archived() {
+	archivalProcessor := &mockArchivalProcessor{archived: true}
+	s.mockHistoryEngine.archivalProcessor = archivalProcessor
+	timerTask := s.setupDeleteHistoryEvent("delete-history-archived-test")
+
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", &persistence.DeleteWorkflowExecutionRequest{
+		DomainID:   timerTask.DomainID,
+		WorkflowID: timerTask.WorkflowID,
+		RunID:      timerTask.RunID,
+	}).Return(nil).Once()
+	s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil).Once()
+
+	processor := s.mockHistoryEngine.timerProcessor.(*timerQueueProcessorImpl).activeTimerProcessor
+	s.Nil(processor.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask))
+	s.Empty(archivalProcessor.requests)
+}
+
+func (s *timerQueueProcessor2Suite) setupDeleteHistoryEvent(workflowID string) *persistence.TimerTaskInfo {
+	domainID := testDomainActiveID
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(validRunID)}
+
+	s.mockMetadataMgr.ExpectedCalls = nil
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info: &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{
+				Retention:      1,
+				ArchivalBucket: "archival-bucket",
+				ArchivalStatus: workflow.ArchivalStatusEnabled,
+			},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					&persistence.ClusterReplicationConfig{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+		},
+		nil,
+	)
+
+	builder := newMutableStateBuilder(s.config, s.logger)
+	builder.AddWorkflowExecutionStartedEvent(we, &history.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:     common.TaskListPtr(workflow.TaskList{Name: common.StringPtr("delete-history-tasklist")}),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		},
+	})
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: createMutableState(builder)}, nil).Once()
+
+	return &persistence.TimerTaskInfo{
+		DomainID:            domainID,
+		WorkflowID:          workflowID,
+		RunID:               validRunID,
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeDeleteHistoryEvent,
+		VisibilityTimestamp: time.Now(),
+	}
+}
+
+func (p *mockArchivalProcessor) Start() {}
+
+func (p *mockArchivalProcessor) Stop() {}
+
+func (p *mockArchivalProcessor) Enqueue(request *archiver.ArchiveRequest) bool {
+	p.requests = append(p.requests, request)
+	return true
+}
+
+func (p *mockArchivalProcessor) IsArchived(ctx context.Context, request *archiver.ArchiveRequest) (bool, error) {
+	return p.archived, nil
+}
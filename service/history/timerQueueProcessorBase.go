@@ -21,17 +21,19 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"math"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -56,7 +58,7 @@ type (
 		shutdownCh       chan struct{}
 		tasksCh          chan *persistence.TimerTaskInfo
 		config           *Config
-		logger           bark.Logger
+		logger           logging.Logger
 		metricsClient    metrics.Client
 		now              timeNow
 		timerFiredCount  uint64
@@ -73,10 +75,8 @@ type (
 	}
 )
 
-func newTimerQueueProcessorBase(shard ShardContext, historyService *historyEngineImpl, timerQueueAckMgr timerQueueAckMgr, timeNow timeNow, logger bark.Logger) *timerQueueProcessorBase {
-	log := logger.WithFields(bark.Fields{
-		logging.TagWorkflowComponent: logging.TagValueTimerQueueComponent,
-	})
+func newTimerQueueProcessorBase(shard ShardContext, historyService *historyEngineImpl, timerQueueAckMgr timerQueueAckMgr, timeNow timeNow, logger logging.Logger) *timerQueueProcessorBase {
+	log := logger.WithTags(tag.ComponentTimerQueue)
 
 	workerNotificationChans := []chan struct{}{}
 	for index := 0; index < shard.GetConfig().TimerTaskWorkerCount; index++ {
@@ -154,7 +154,7 @@ RetryProcessor:
 		default:
 			err := t.internalProcessor()
 			if err != nil {
-				t.logger.Error("processor pump failed with error: ", err)
+				t.logger.Error("processor pump failed with error", tag.Error(err))
 			}
 		}
 	}
@@ -234,6 +234,8 @@ func (t *timerQueueProcessorBase) notifyNewTimers(timerTasks []persistence.Task,
 			t.metricsClient.IncCounter(metrics.TimerTaskDeleteHistoryEvent, counterType)
 		case persistence.TaskTypeRetryTimer:
 			t.metricsClient.IncCounter(metrics.TimerTaskRetryTimerScope, counterType)
+		case persistence.TaskTypeWorkflowBackoffTimer:
+			t.metricsClient.IncCounter(metrics.TimerTaskWorkflowBackoffTimerScope, counterType)
 			// TODO add default
 		}
 	}
@@ -299,7 +301,7 @@ continueProcessor:
 				t.newTimeLock.Unlock()
 				// New Timer has arrived.
 				t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.NewTimerNotifyCounter)
-				t.logger.Debugf("Woke up by the timer")
+				t.logger.Debug("Woke up by the timer")
 
 				if timerGate.Update(newTime) {
 					// this means timer is updated, to the new time provided
@@ -308,7 +310,7 @@ continueProcessor:
 				}
 
 				now = t.now()
-				t.logger.Debugf("%v: Next key after woke up by timer: %v", now.UTC(), newTime.UTC())
+				t.logger.Debug("Next key after woke up by timer.", tag.Timestamp(newTime.UTC()))
 				if timerGate.FireAfter(now) {
 					continue continueProcessor
 				}
@@ -323,7 +325,7 @@ continueProcessor:
 
 		if nextKeyTask != nil {
 			nextKey := TimerSequenceID{VisibilityTimestamp: nextKeyTask.VisibilityTimestamp, TaskID: nextKeyTask.TaskID}
-			t.logger.Debugf("%s: GetNextKey: %s", time.Now(), nextKey)
+			t.logger.Debug("GetNextKey.", tag.Timestamp(nextKey.VisibilityTimestamp), tag.TaskID(nextKey.TaskID))
 
 			timerGate.Update(nextKey.VisibilityTimestamp)
 		}
@@ -391,6 +393,15 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.Ti
 		return nil
 	}
 
+	archived, err := t.archiveWorkflowExecution(task)
+	if err != nil {
+		return err
+	}
+	if !archived {
+		// the history is only deleted once it is completely archived, check again later
+		return t.rescheduleDeleteHistoryEvent(task, context, msBuilder)
+	}
+
 	op := func() error {
 		return t.executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
 			DomainID:   task.DomainID,
@@ -413,7 +424,74 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.Ti
 			})
 	}
 
-	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err = backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	if err == nil && t.historyService.replicator != nil {
+		// the replication tasks still buffered for the execution are gone with it
+		t.historyService.replicator.clearGap(domainID, workflowExecution)
+	}
+	return err
+}
+
+// archiveWorkflowExecution tells whether the execution can be deleted, which is the case when archival is not
+// configured for the cluster, not enabled for the domain, or once the execution is completely archived.  The
+// archival is handed over to the archival processor when the execution is not archived yet.
+func (t *timerQueueProcessorBase) archiveWorkflowExecution(task *persistence.TimerTaskInfo) (bool, error) {
+	if t.historyService.archivalProcessor == nil {
+		return true, nil
+	}
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(task.DomainID)
+	if err != nil {
+		return false, err
+	}
+	domainConfig := domainEntry.GetConfig()
+	if domainConfig.ArchivalStatus != workflow.ArchivalStatusEnabled || len(domainConfig.ArchivalBucket) == 0 {
+		return true, nil
+	}
+
+	request := &archiver.ArchiveRequest{
+		DomainID:   task.DomainID,
+		WorkflowID: task.WorkflowID,
+		RunID:      task.RunID,
+		Bucket:     domainConfig.ArchivalBucket,
+	}
+	archived, err := t.historyService.archivalProcessor.IsArchived(context.Background(), request)
+	if err != nil || archived {
+		return archived, err
+	}
+
+	if !t.historyService.archivalProcessor.Enqueue(request) {
+		// the delete history timer is rescheduled anyway, the archival is requested again when it fires
+		t.logger.Warn("Archival queue is full.", tag.WorkflowDomainID(task.DomainID),
+			tag.WorkflowID(task.WorkflowID), tag.WorkflowRunID(task.RunID))
+	}
+	return false, nil
+}
+
+// rescheduleDeleteHistoryEvent creates a new delete history timer for the execution, the current one
+// is completed
+func (t *timerQueueProcessorBase) rescheduleDeleteHistoryEvent(task *persistence.TimerTaskInfo,
+	context *workflowExecutionContext, msBuilder *mutableStateBuilder) error {
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(task.DomainID)
+	if err != nil {
+		return err
+	}
+
+	tBuilder := t.historyService.getTimerBuilder(&context.workflowExecution)
+	timerTasks := []persistence.Task{tBuilder.createDeleteHistoryEventTimerTask(t.config.ArchivalDeleteRetryInterval)}
+	setTaskVersion(msBuilder.GetCurrentVersion(), nil, timerTasks)
+	if err := t.shard.UpdateWorkflowExecution(&persistence.UpdateWorkflowExecutionRequest{
+		ExecutionInfo:    msBuilder.executionInfo,
+		ReplicationState: msBuilder.replicationState,
+		TimerTasks:       timerTasks,
+		Condition:        msBuilder.GetNextEventID(),
+	}); err != nil {
+		return err
+	}
+
+	clusterName := domainEntry.GetReplicationConfig().ActiveClusterName
+	t.historyService.timerProcessor.NotifyNewTimers(clusterName, t.shard.GetCurrentTime(clusterName), timerTasks)
+	return nil
 }
 
 func (t *timerQueueProcessorBase) getTimerTaskType(taskType int) string {
@@ -430,6 +508,8 @@ func (t *timerQueueProcessorBase) getTimerTaskType(taskType int) string {
 		return "DeleteHistoryEvent"
 	case persistence.TaskTypeRetryTimer:
 		return "RetryTimerTask"
+	case persistence.TaskTypeWorkflowBackoffTimer:
+		return "WorkflowBackoffTimerTask"
 	}
 	return "UnKnown"
 }
@@ -28,12 +28,13 @@ import (
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -46,7 +47,7 @@ type (
 		engineImpl     *historyEngineImpl
 		matchingClient matching.Client
 		shardClosedCh  chan int
-		logger         bark.Logger
+		logger         logging.Logger
 
 		mockMetadataMgr   *mocks.MetadataManager
 		mockVisibilityMgr *mocks.VisibilityManager
@@ -68,7 +69,7 @@ func (s *timerQueueProcessorSuite) SetupSuite() {
 
 	log2 := log.New()
 	log2.Level = log.DebugLevel
-	s.logger = bark.NewLoggerFromLogrus(log2)
+	s.logger = logging.NewDevelopmentLogger()
 
 	// override config for testing
 	s.ShardContext.config.ShardUpdateMinInterval = 0 * time.Second
@@ -117,8 +118,8 @@ func (s *timerQueueProcessorSuite) updateTimerSeqNumbers(timerTasks []persistenc
 			panic(err)
 		}
 		task.SetTaskID(taskID)
-		s.logger.Infof("%v: TestTimerQueueProcessorSuite: Assigning timer: %s",
-			time.Now().UTC(), TimerSequenceID{VisibilityTimestamp: persistence.GetVisibilityTSFrom(task), TaskID: task.GetTaskID()})
+		s.logger.Info("TestTimerQueueProcessorSuite: Assigning timer", tag.Timestamp(time.Now().UTC()),
+			tag.Value(TimerSequenceID{VisibilityTimestamp: persistence.GetVisibilityTSFrom(task), TaskID: task.GetTaskID()}))
 	}
 }
 
@@ -303,7 +304,7 @@ func (s *timerQueueProcessorSuite) TestManyTimerTasks() {
 
 	for {
 		timerInfo, err := s.GetTimerIndexTasks()
-		s.logger.Infof("TestManyTimerTasks: GetTimerIndexTasks: Response Count: %d \n", len(timerInfo))
+		s.logger.Info("TestManyTimerTasks: GetTimerIndexTasks", tag.Counter(len(timerInfo)))
 		s.Nil(err, "No error expected.")
 		if len(timerInfo) == 0 {
 			processor.Stop()
@@ -953,7 +954,7 @@ func (s *timerQueueProcessorSuite) TestTimersOnClosedWorkflow() {
 func (s *timerQueueProcessorSuite) printHistory(builder *mutableStateBuilder) string {
 	history, err := builder.hBuilder.Serialize()
 	if err != nil {
-		s.logger.Errorf("Error serializing history: %v", err)
+		s.logger.Error("Error serializing history", tag.Error(err))
 		return ""
 	}
 
@@ -24,10 +24,10 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -38,7 +38,7 @@ type (
 		historyService          *historyEngineImpl
 		cache                   *historyCache
 		timerTaskFilter         timerTaskFilter
-		logger                  bark.Logger
+		logger                  logging.Logger
 		metricsClient           metrics.Client
 		clusterName             string
 		timerGate               RemoteTimerGate
@@ -47,13 +47,11 @@ type (
 	}
 )
 
-func newTimerQueueStandbyProcessor(shard ShardContext, historyService *historyEngineImpl, clusterName string, logger bark.Logger) *timerQueueStandbyProcessorImpl {
+func newTimerQueueStandbyProcessor(shard ShardContext, historyService *historyEngineImpl, clusterName string, logger logging.Logger) *timerQueueStandbyProcessorImpl {
 	timeNow := func() time.Time {
 		return shard.GetCurrentTime(clusterName)
 	}
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowCluster: clusterName,
-	})
+	logger = logger.WithTags(tag.WorkflowCluster(clusterName))
 	timerTaskFilter := func(timer *persistence.TimerTaskInfo) (bool, error) {
 		domainEntry, err := shard.GetDomainCache().GetDomainByID(timer.DomainID)
 		if err != nil {
@@ -130,9 +128,15 @@ func (t *timerQueueStandbyProcessorImpl) process(timerTask *persistence.TimerTas
 	}
 
 	taskID := TimerSequenceID{VisibilityTimestamp: timerTask.VisibilityTimestamp, TaskID: timerTask.TaskID}
-	t.logger.Debugf("Processing timer: (%s), for WorkflowID: %v, RunID: %v, Type: %v, TimeoutType: %v, EventID: %v",
-		taskID, timerTask.WorkflowID, timerTask.RunID, t.timerQueueProcessorBase.getTimerTaskType(timerTask.TaskType),
-		workflow.TimeoutType(timerTask.TimeoutType).String(), timerTask.EventID)
+	t.logger.Debug("Processing timer.",
+		tag.WorkflowDomainID(timerTask.DomainID),
+		tag.WorkflowID(timerTask.WorkflowID),
+		tag.WorkflowRunID(timerTask.RunID),
+		tag.TaskID(taskID.TaskID),
+		tag.Timestamp(taskID.VisibilityTimestamp),
+		tag.TaskType(timerTask.TaskType),
+		tag.WorkflowTimeoutType(workflow.TimeoutType(timerTask.TimeoutType)),
+		tag.WorkflowHistoryEventID(timerTask.EventID))
 
 	scope := metrics.TimerQueueProcessorScope
 	switch timerTask.TaskType {
@@ -156,6 +160,10 @@ func (t *timerQueueStandbyProcessorImpl) process(timerTask *persistence.TimerTas
 		scope = metrics.TimerTaskRetryTimerScope
 		err = nil // retry backoff timer should not get created on passive cluster
 
+	case persistence.TaskTypeWorkflowBackoffTimer:
+		scope = metrics.TimerTaskWorkflowBackoffTimerScope
+		err = t.processWorkflowBackoffTimer(timerTask)
+
 	case persistence.TaskTypeDeleteHistoryEvent:
 		scope = metrics.TimerTaskDeleteHistoryEvent
 		err = t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
@@ -193,7 +201,7 @@ func (t *timerQueueStandbyProcessorImpl) processExpiredUserTimer(timerTask *pers
 		for _, td := range tBuilder.GetUserTimers(msBuilder) {
 			hasTimer, _ := tBuilder.GetUserTimer(td.TimerID)
 			if !hasTimer {
-				t.logger.Debugf("Failed to find in memory user timer: %s", td.TimerID)
+				t.logger.Debug("Failed to find in memory user timer.", tag.WorkflowTimerID(td.TimerID))
 				return fmt.Errorf("Failed to find in memory user timer: %s", td.TimerID)
 			}
 			if !td.TaskCreated {
@@ -307,6 +315,28 @@ func (t *timerQueueStandbyProcessorImpl) processWorkflowTimeout(timerTask *persi
 	})
 }
 
+func (t *timerQueueStandbyProcessorImpl) processWorkflowBackoffTimer(timerTask *persistence.TimerTaskInfo) error {
+	t.metricsClient.IncCounter(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	return t.processTimer(timerTask, func(msBuilder *mutableStateBuilder) error {
+		if msBuilder.hasProcessedOrPendingDecisionTask() {
+			// first decision is already scheduled by the active cluster
+			return nil
+		}
+
+		ok, err := verifyTimerTaskVersion(t.shard, timerTask.DomainID, msBuilder.GetStartVersion(), timerTask)
+		if err != nil {
+			return err
+		} else if !ok {
+			return nil
+		}
+
+		return ErrTaskRetry
+	})
+}
+
 func (t *timerQueueStandbyProcessorImpl) processTimer(timerTask *persistence.TimerTaskInfo, fn func(*mutableStateBuilder) error) (retError error) {
 	context, release, err := t.cache.getOrCreateWorkflowExecution(t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(timerTask))
 	if err != nil {
@@ -331,7 +361,10 @@ Process_Loop:
 		// some extreme cassandra failure cases.
 		if timerTask.EventID >= msBuilder.GetNextEventID() {
 			t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.StaleMutableStateCounter)
-			t.logger.Debugf("processExpiredUserTimer: timer event ID: %v >= MS NextEventID: %v.", timerTask.EventID, msBuilder.GetNextEventID())
+			t.logger.Debug("processExpiredUserTimer: timer event ID >= MS NextEventID.",
+				tag.WorkflowHistoryEventID(timerTask.EventID),
+				tag.WorkflowNextEventID(msBuilder.GetNextEventID()))
+
 			// Reload workflow execution history
 			context.clear()
 			continue Process_Loop
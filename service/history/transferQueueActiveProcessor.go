@@ -21,10 +21,9 @@
 package history
 
 import (
+	"context"
 	"time"
 
-	"github.com/uber-common/bark"
-
 	"errors"
 
 	h "github.com/uber/cadence/.gen/go/history"
@@ -35,8 +34,10 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/tracing"
 )
 
 const identityHistoryService = "history-service"
@@ -56,7 +57,7 @@ type (
 		historyClient         history.Client
 		cache                 *historyCache
 		transferTaskFilter    transferTaskFilter
-		logger                bark.Logger
+		logger                logging.Logger
 		metricsClient         metrics.Client
 		maxReadAckLevel       maxReadAckLevel
 		updateClusterAckLevel updateClusterAckLevel
@@ -70,7 +71,7 @@ var (
 )
 
 func newTransferQueueActiveProcessor(shard ShardContext, historyService *historyEngineImpl, visibilityMgr persistence.VisibilityManager,
-	matchingClient matching.Client, historyClient history.Client, logger bark.Logger) *transferQueueActiveProcessorImpl {
+	matchingClient matching.Client, historyClient history.Client, logger logging.Logger) *transferQueueActiveProcessorImpl {
 	config := shard.GetConfig()
 	options := &QueueProcessorOptions{
 		BatchSize:            config.TransferTaskBatchSize,
@@ -83,9 +84,7 @@ func newTransferQueueActiveProcessor(shard ShardContext, historyService *history
 		UpdateShardTaskCount: config.TransferProcessorUpdateShardTaskCount,
 	}
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowCluster: currentClusterName,
-	})
+	logger = logger.WithTags(tag.WorkflowCluster(currentClusterName))
 	transferTaskFilter := func(task *persistence.TransferTaskInfo) (bool, error) {
 		domainEntry, err := shard.GetDomainCache().GetDomainByID(task.DomainID)
 		if err != nil {
@@ -135,7 +134,7 @@ func newTransferQueueActiveProcessor(shard ShardContext, historyService *history
 }
 
 func newTransferQueueFailoverProcessor(shard ShardContext, historyService *historyEngineImpl, visibilityMgr persistence.VisibilityManager,
-	matchingClient matching.Client, historyClient history.Client, domainID string, standbyClusterName string, logger bark.Logger) *transferQueueActiveProcessorImpl {
+	matchingClient matching.Client, historyClient history.Client, domainID string, standbyClusterName string, logger logging.Logger) *transferQueueActiveProcessorImpl {
 	config := shard.GetConfig()
 	options := &QueueProcessorOptions{
 		BatchSize:            config.TransferTaskBatchSize,
@@ -148,9 +147,7 @@ func newTransferQueueFailoverProcessor(shard ShardContext, historyService *histo
 		UpdateShardTaskCount: config.TransferProcessorUpdateShardTaskCount,
 	}
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowCluster: currentClusterName,
-	})
+	logger = logger.WithTags(tag.WorkflowCluster(currentClusterName))
 	transferTaskFilter := func(task *persistence.TransferTaskInfo) (bool, error) {
 		if task.DomainID == domainID {
 			return true, nil
@@ -236,17 +233,24 @@ func (t *transferQueueActiveProcessorImpl) process(qTask queueTaskInfo) error {
 		return nil
 	}
 
+	// the calls to matching and to the visibility store are traced as children of the task span
+	span, ctx := tracing.StartSpan(context.Background(), t.shard.GetService().GetTracer(), "TransferActiveTask")
+	span.SetTag("task-type", task.TaskType)
+	span.SetTag("execution-id", task.WorkflowID)
+	span.SetTag("run-id", task.RunID)
+	defer func() { tracing.FinishSpan(span, err) }()
+
 	scope := metrics.TransferQueueProcessorScope
 	switch task.TaskType {
 	case persistence.TransferTaskTypeActivityTask:
 		scope = metrics.TransferTaskActivityScope
-		err = t.processActivityTask(task)
+		err = t.processActivityTask(ctx, task)
 	case persistence.TransferTaskTypeDecisionTask:
 		scope = metrics.TransferTaskDecisionScope
-		err = t.processDecisionTask(task)
+		err = t.processDecisionTask(ctx, task)
 	case persistence.TransferTaskTypeCloseExecution:
 		scope = metrics.TransferTaskCloseExecutionScope
-		err = t.processCloseExecution(task)
+		err = t.processCloseExecution(ctx, task)
 	case persistence.TransferTaskTypeCancelExecution:
 		scope = metrics.TransferTaskCancelExecutionScope
 		err = t.processCancelExecution(task)
@@ -256,6 +260,9 @@ func (t *transferQueueActiveProcessorImpl) process(qTask queueTaskInfo) error {
 	case persistence.TransferTaskTypeStartChildExecution:
 		scope = metrics.TransferTaskStartChildExecutionScope
 		err = t.processStartChildExecution(task)
+	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
+		scope = metrics.TransferTaskUpsertWorkflowSearchAttributesScope
+		err = t.processUpsertWorkflowSearchAttributes(ctx, task)
 	default:
 		err = errUnknownTransferTask
 	}
@@ -277,7 +284,8 @@ func (t *transferQueueActiveProcessorImpl) process(qTask queueTaskInfo) error {
 	return err
 }
 
-func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processActivityTask(ctx context.Context,
+	task *persistence.TransferTaskInfo) (retError error) {
 	t.metricsClient.IncCounter(metrics.TransferTaskActivityScope, metrics.TaskRequests)
 	sw := t.metricsClient.StartTimer(metrics.TransferTaskActivityScope, metrics.TaskLatency)
 	defer sw.Stop()
@@ -324,7 +332,7 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	err = t.matchingClient.AddActivityTask(nil, &m.AddActivityTaskRequest{
+	err = t.matchingClient.AddActivityTask(ctx, &m.AddActivityTaskRequest{
 		DomainUUID:                    common.StringPtr(targetDomainID),
 		SourceDomainUUID:              common.StringPtr(domainID),
 		Execution:                     &execution,
@@ -336,7 +344,8 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(task *persistence
 	return err
 }
 
-func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processDecisionTask(ctx context.Context,
+	task *persistence.TransferTaskInfo) (retError error) {
 	t.metricsClient.IncCounter(metrics.TransferTaskDecisionScope, metrics.TaskRequests)
 	sw := t.metricsClient.StartTimer(metrics.TransferTaskDecisionScope, metrics.TaskLatency)
 	defer sw.Stop()
@@ -383,6 +392,8 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	decisionTimeout := workflowTimeout
 	wfTypeName := msBuilder.executionInfo.WorkflowTypeName
 	startTimestamp := msBuilder.executionInfo.StartTimestamp
+	searchAttributes := msBuilder.executionInfo.SearchAttributes
+	isFirstDecision := msBuilder.isFirstDecision(di)
 	if msBuilder.isStickyTaskListEnabled() {
 		taskList.Name = common.StringPtr(msBuilder.executionInfo.StickyTaskList)
 		taskList.Kind = common.TaskListKindPtr(workflow.TaskListKindSticky)
@@ -392,7 +403,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	err = t.matchingClient.AddDecisionTask(nil, &m.AddDecisionTaskRequest{
+	err = t.matchingClient.AddDecisionTask(ctx, &m.AddDecisionTaskRequest{
 		DomainUUID:                    common.StringPtr(domainID),
 		Execution:                     &execution,
 		TaskList:                      taskList,
@@ -404,8 +415,9 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 		return err
 	}
 
-	if task.ScheduleID == common.FirstEventID+1 {
-		err = t.recordWorkflowExecutionStarted(execution, task, wfTypeName, startTimestamp, workflowTimeout)
+	if isFirstDecision {
+		err = t.recordWorkflowExecutionStarted(ctx, execution, task, wfTypeName, startTimestamp, workflowTimeout,
+			searchAttributes)
 	}
 
 	if err != nil {
@@ -418,7 +430,8 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(task *persistence
 	return err
 }
 
-func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persistence.TransferTaskInfo) (retError error) {
+func (t *transferQueueActiveProcessorImpl) processCloseExecution(ctx context.Context,
+	task *persistence.TransferTaskInfo) (retError error) {
 	t.metricsClient.IncCounter(metrics.TransferTaskCloseExecutionScope, metrics.TaskRequests)
 	sw := t.metricsClient.StartTimer(metrics.TransferTaskCloseExecutionScope, metrics.TaskLatency)
 	defer sw.Stop()
@@ -466,6 +479,7 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 	workflowCloseTimestamp := msBuilder.getLastUpdatedTimestamp()
 	workflowCloseStatus := getWorkflowExecutionCloseStatus(msBuilder.executionInfo.CloseStatus)
 	workflowHistoryLength := msBuilder.GetNextEventID()
+	searchAttributes := msBuilder.executionInfo.SearchAttributes
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -509,7 +523,8 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 		retentionSeconds = int64(domainEntry.GetConfig().Retention) * 24 * 60 * 60
 	}
 
-	return t.visibilityManager.RecordWorkflowExecutionClosed(&persistence.RecordWorkflowExecutionClosedRequest{
+	visibilityManager := persistence.VisibilityManagerWithContext(ctx, t.visibilityManager)
+	return visibilityManager.RecordWorkflowExecutionClosed(&persistence.RecordWorkflowExecutionClosedRequest{
 		DomainUUID:       task.DomainID,
 		Execution:        execution,
 		WorkflowTypeName: workflowTypeName,
@@ -518,9 +533,56 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(task *persisten
 		Status:           workflowCloseStatus,
 		HistoryLength:    workflowHistoryLength,
 		RetentionSeconds: retentionSeconds,
+		SearchAttributes: searchAttributes,
 	})
 }
 
+func (t *transferQueueActiveProcessorImpl) processUpsertWorkflowSearchAttributes(ctx context.Context,
+	task *persistence.TransferTaskInfo) (retError error) {
+	t.metricsClient.IncCounter(metrics.TransferTaskUpsertWorkflowSearchAttributesScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TransferTaskUpsertWorkflowSearchAttributesScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	domainID := task.DomainID
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+
+	context, release, err := t.cache.getOrCreateWorkflowExecution(domainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// this could happen if this is a duplicate processing of the task, and the execution has already completed.
+			return nil
+		}
+		return err
+	}
+	if !msBuilder.isWorkflowExecutionRunning() {
+		// the close execution task records the latest search attributes
+		return nil
+	}
+
+	request := &persistence.UpsertWorkflowExecutionRequest{
+		DomainUUID:       domainID,
+		Execution:        execution,
+		WorkflowTypeName: msBuilder.executionInfo.WorkflowTypeName,
+		StartTimestamp:   msBuilder.executionInfo.StartTimestamp.UnixNano(),
+		WorkflowTimeout:  int64(msBuilder.executionInfo.WorkflowTimeout),
+		SearchAttributes: msBuilder.executionInfo.SearchAttributes,
+	}
+
+	// release the context lock since we no longer need mutable state builder and
+	// the rest of logic is making RPC call, which takes time.
+	release(nil)
+	return persistence.VisibilityManagerWithContext(ctx, t.visibilityManager).UpsertWorkflowExecution(request)
+}
+
 func (t *transferQueueActiveProcessorImpl) processCancelExecution(task *persistence.TransferTaskInfo) (retError error) {
 	t.metricsClient.IncCounter(metrics.TransferTaskCancelExecutionScope, metrics.TaskRequests)
 	sw := t.metricsClient.StartTimer(metrics.TransferTaskCancelExecutionScope, metrics.TaskLatency)
@@ -619,7 +681,7 @@ func (t *transferQueueActiveProcessorImpl) processCancelExecution(task *persiste
 			// to make workflow cancellation idempotent, we should clear this error.
 			err = nil
 		} else {
-			t.logger.Debugf("Failed to cancel external workflow execution. Error: %v", err)
+			t.logger.Debug("Failed to cancel external workflow execution", tag.Error(err))
 			// Check to see if the error is non-transient, in which case add RequestCancelFailed
 			// event and complete transfer task by setting the err = nil
 			if common.IsServiceNonRetryableError(err) {
@@ -633,8 +695,10 @@ func (t *transferQueueActiveProcessorImpl) processCancelExecution(task *persiste
 		}
 	}
 
-	t.logger.Debugf("RequestCancel successfully recorded to external workflow execution.  WorkflowID: %v, RunID: %v",
-		task.TargetWorkflowID, task.TargetRunID)
+	t.logger.Debug("RequestCancel successfully recorded to external workflow execution.",
+		tag.WorkflowDomainID(task.TargetDomainID),
+		tag.WorkflowID(task.TargetWorkflowID),
+		tag.WorkflowRunID(task.TargetRunID))
 
 	// Record ExternalWorkflowExecutionCancelRequested in source execution
 	err = t.requestCancelCompleted(task, context, cancelRequest)
@@ -739,7 +803,7 @@ func (t *transferQueueActiveProcessorImpl) processSignalExecution(task *persiste
 	err = t.SignalExecutionWithRetry(signalRequest)
 
 	if err != nil {
-		t.logger.Debugf("Failed to signal external workflow execution. Error: %v", err)
+		t.logger.Debug("Failed to signal external workflow execution", tag.Error(err))
 
 		// Check to see if the error is non-transient, in which case add SignalFailed
 		// event and complete transfer task by setting the err = nil
@@ -753,8 +817,10 @@ func (t *transferQueueActiveProcessorImpl) processSignalExecution(task *persiste
 		return err
 	}
 
-	t.logger.Debugf("Signal successfully recorded to external workflow execution.  WorkflowID: %v, RunID: %v",
-		task.TargetWorkflowID, task.TargetRunID)
+	t.logger.Debug("Signal successfully recorded to external workflow execution.",
+		tag.WorkflowDomainID(task.TargetDomainID),
+		tag.WorkflowID(task.TargetWorkflowID),
+		tag.WorkflowRunID(task.TargetRunID))
 
 	err = t.requestSignalCompleted(task, context, signalRequest)
 	if _, ok := err.(*workflow.EntityNotExistsError); ok {
@@ -866,6 +932,8 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 				RequestId:             common.StringPtr(ci.CreateRequestID),
 				WorkflowIdReusePolicy: attributes.WorkflowIdReusePolicy,
 				ChildPolicy:           attributes.ChildPolicy,
+				RetryPolicy:           attributes.RetryPolicy,
+				SearchAttributes:      attributes.SearchAttributes,
 			},
 			ParentExecutionInfo: &h.ParentExecutionInfo{
 				DomainUUID: common.StringPtr(domainID),
@@ -881,7 +949,7 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 		var startResponse *workflow.StartWorkflowExecutionResponse
 		startResponse, err = t.historyClient.StartWorkflowExecution(nil, startRequest)
 		if err != nil {
-			t.logger.Debugf("Failed to start child workflow execution. Error: %v", err)
+			t.logger.Debug("Failed to start child workflow execution", tag.Error(err))
 
 			// Check to see if the error is non-transient, in which case add StartChildWorkflowExecutionFailed
 			// event and complete transfer task by setting the err = nil
@@ -892,8 +960,11 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 			return err
 		}
 
-		t.logger.Debugf("Child Execution started successfully.  WorkflowID: %v, RunID: %v",
-			*attributes.WorkflowId, *startResponse.RunId)
+		t.logger.Debug("Child Execution started successfully.",
+			tag.WorkflowDomainID(targetDomainID),
+			tag.WorkflowID(attributes.GetWorkflowId()),
+			tag.WorkflowRunID(startResponse.GetRunId()))
+
 
 		// Child execution is successfully started, record ChildExecutionStartedEvent in parent execution
 		err = t.recordChildExecutionStarted(task, context, attributes, *startResponse.RunId)
@@ -916,16 +987,18 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 	return err
 }
 
-func (t *transferQueueActiveProcessorImpl) recordWorkflowExecutionStarted(
+func (t *transferQueueActiveProcessorImpl) recordWorkflowExecutionStarted(ctx context.Context,
 	execution workflow.WorkflowExecution, task *persistence.TransferTaskInfo, wfTypeName string,
-	startTimestamp time.Time, timeout int32,
+	startTimestamp time.Time, timeout int32, searchAttributes map[string][]byte,
 ) error {
-	err := t.visibilityManager.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
+	visibilityManager := persistence.VisibilityManagerWithContext(ctx, t.visibilityManager)
+	err := visibilityManager.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
 		DomainUUID:       task.DomainID,
 		Execution:        execution,
 		WorkflowTypeName: wfTypeName,
 		StartTimestamp:   startTimestamp.UnixNano(),
 		WorkflowTimeout:  int64(timeout),
+		SearchAttributes: searchAttributes,
 	})
 
 	return err
@@ -1135,10 +1208,7 @@ Update_History_Loop:
 					ScheduleID: di.ScheduleID,
 				})
 				if msBuilder.isStickyTaskListEnabled() {
-					lg := t.logger.WithFields(bark.Fields{
-						logging.TagWorkflowExecutionID: context.workflowExecution.WorkflowId,
-						logging.TagWorkflowRunID:       context.workflowExecution.RunId,
-					})
+					lg := t.logger.WithTags(tag.WorkflowID(context.workflowExecution.GetWorkflowId()), tag.WorkflowRunID(context.workflowExecution.GetRunId()))
 					tBuilder := newTimerBuilder(t.shard.GetConfig(), lg, common.NewRealTimeSource())
 					stickyTaskTimeoutTimer := tBuilder.AddScheduleToStartDecisionTimoutTask(di.ScheduleID, di.Attempt,
 						msBuilder.executionInfo.StickyScheduleToStartTimeout)
@@ -25,11 +25,10 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/uber-common/bark"
-
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -46,7 +45,7 @@ type (
 		matchingClient        matching.Client
 		historyClient         history.Client
 		ackLevel              int64
-		logger                bark.Logger
+		logger                logging.Logger
 		isStarted             int32
 		isStopped             int32
 		finishedTaskCounter   int
@@ -57,10 +56,8 @@ type (
 )
 
 func newTransferQueueProcessor(shard ShardContext, historyService *historyEngineImpl, visibilityMgr persistence.VisibilityManager,
-	matchingClient matching.Client, historyClient history.Client, logger bark.Logger) *transferQueueProcessorImpl {
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowComponent: logging.TagValueTransferQueueComponent,
-	})
+	matchingClient matching.Client, historyClient history.Client, logger logging.Logger) *transferQueueProcessorImpl {
+	logger = logger.WithTags(tag.ComponentTransferQueue)
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 	standbyTaskProcessors := make(map[string]*transferQueueStandbyProcessorImpl)
 	for clusterName := range shard.GetService().GetClusterMetadata().GetAllClusterFailoverVersions() {
@@ -158,7 +155,7 @@ func (t *transferQueueProcessorImpl) completeTransferLoop() {
 			for attempt := 0; attempt < t.config.TransferProcessorCompleteTransferFailureRetryCount; attempt++ {
 				err := t.completeTransfer()
 				if err != nil {
-					t.logger.Infof("Failed to complete transfer task: %v.", err)
+					t.logger.Info("Failed to complete transfer task", tag.Error(err))
 					backoff := time.Duration(attempt * 100)
 					time.Sleep(backoff * time.Millisecond)
 				} else {
@@ -210,7 +207,10 @@ LoadCompleteLoop:
 			}
 			lowerAckLevel = task.GetTaskID()
 			if err := executionMgr.CompleteTransferTask(&persistence.CompleteTransferTaskRequest{TaskID: task.GetTaskID()}); err != nil {
-				t.logger.Warnf("Timer queue ack manager unable to complete timer task: %v; %v", task, err)
+				t.logger.Warn("Transfer queue ack manager unable to complete transfer task.",
+					tag.TaskID(task.GetTaskID()),
+					tag.Error(err))
+
 			}
 			t.finishedTaskCounter++
 		}
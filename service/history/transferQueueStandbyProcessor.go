@@ -21,10 +21,10 @@
 package history
 
 import (
-	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -39,7 +39,7 @@ type (
 		visibilityMgr      persistence.VisibilityManager
 		cache              *historyCache
 		transferTaskFilter transferTaskFilter
-		logger             bark.Logger
+		logger             logging.Logger
 		metricsClient      metrics.Client
 		*queueProcessorBase
 		queueAckMgr
@@ -47,7 +47,7 @@ type (
 )
 
 func newTransferQueueStandbyProcessor(clusterName string, shard ShardContext, historyService *historyEngineImpl,
-	visibilityMgr persistence.VisibilityManager, logger bark.Logger) *transferQueueStandbyProcessorImpl {
+	visibilityMgr persistence.VisibilityManager, logger logging.Logger) *transferQueueStandbyProcessorImpl {
 	config := shard.GetConfig()
 	options := &QueueProcessorOptions{
 		BatchSize:            config.TransferTaskBatchSize,
@@ -59,9 +59,7 @@ func newTransferQueueStandbyProcessor(clusterName string, shard ShardContext, hi
 		MetricScope:          metrics.TransferQueueProcessorScope,
 		UpdateShardTaskCount: config.TransferProcessorUpdateShardTaskCount,
 	}
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowCluster: clusterName,
-	})
+	logger = logger.WithTags(tag.WorkflowCluster(clusterName))
 
 	transferTaskFilter := func(task *persistence.TransferTaskInfo) (bool, error) {
 		domainEntry, err := shard.GetDomainCache().GetDomainByID(task.DomainID)
@@ -163,6 +161,9 @@ func (t *transferQueueStandbyProcessorImpl) process(qTask queueTaskInfo) error {
 	case persistence.TransferTaskTypeStartChildExecution:
 		scope = metrics.TransferTaskStartChildExecutionScope
 		err = t.processStartChildExecution(task)
+	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
+		scope = metrics.TransferTaskUpsertWorkflowSearchAttributesScope
+		err = t.processUpsertWorkflowSearchAttributes(task)
 	default:
 		err = errUnknownTransferTask
 	}
@@ -236,7 +237,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(transferTask *pe
 			return ErrTaskRetry
 		}
 
-		if transferTask.ScheduleID == common.FirstEventID+1 {
+		if msBuilder.isFirstDecision(decisionInfo) {
 			return t.recordWorkflowStarted(msBuilder)
 		}
 
@@ -279,6 +280,29 @@ func (t *transferQueueStandbyProcessorImpl) processCloseExecution(transferTask *
 	})
 }
 
+func (t *transferQueueStandbyProcessorImpl) processUpsertWorkflowSearchAttributes(
+	transferTask *persistence.TransferTaskInfo) error {
+	t.metricsClient.IncCounter(metrics.TransferTaskUpsertWorkflowSearchAttributesScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TransferTaskUpsertWorkflowSearchAttributesScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	// the close execution task records the latest search attributes
+	processTaskIfClosed := false
+	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder *mutableStateBuilder) error {
+		return t.visibilityMgr.UpsertWorkflowExecution(&persistence.UpsertWorkflowExecutionRequest{
+			DomainUUID: msBuilder.executionInfo.DomainID,
+			Execution: workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(msBuilder.executionInfo.WorkflowID),
+				RunId:      common.StringPtr(msBuilder.executionInfo.RunID),
+			},
+			WorkflowTypeName: msBuilder.executionInfo.WorkflowTypeName,
+			StartTimestamp:   msBuilder.executionInfo.StartTimestamp.UnixNano(),
+			WorkflowTimeout:  int64(msBuilder.executionInfo.WorkflowTimeout),
+			SearchAttributes: msBuilder.executionInfo.SearchAttributes,
+		})
+	})
+}
+
 func (t *transferQueueStandbyProcessorImpl) processCancelExecution(transferTask *persistence.TransferTaskInfo) error {
 	t.metricsClient.IncCounter(metrics.TransferTaskCancelExecutionScope, metrics.TaskRequests)
 	sw := t.metricsClient.StartTimer(metrics.TransferTaskCancelExecutionScope, metrics.TaskLatency)
@@ -375,7 +399,10 @@ Process_Loop:
 		// some extreme cassandra failure cases.
 		if transferTask.ScheduleID >= msBuilder.GetNextEventID() {
 			t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.StaleMutableStateCounter)
-			t.logger.Debugf("processExpiredUserTimer: timer event ID: %v >= MS NextEventID: %v.", transferTask.ScheduleID, msBuilder.GetNextEventID())
+			t.logger.Debug("processExpiredUserTimer: timer event ID >= MS NextEventID.",
+				tag.WorkflowScheduleID(transferTask.ScheduleID),
+				tag.WorkflowNextEventID(msBuilder.GetNextEventID()))
+
 			// Reload workflow execution history
 			context.clear()
 			continue Process_Loop
@@ -408,6 +435,7 @@ func (t *transferQueueStandbyProcessorImpl) recordWorkflowStarted(msBuilder *mut
 		WorkflowTypeName: msBuilder.executionInfo.WorkflowTypeName,
 		StartTimestamp:   msBuilder.executionInfo.StartTimestamp.UnixNano(),
 		WorkflowTimeout:  int64(msBuilder.executionInfo.WorkflowTimeout),
+		SearchAttributes: msBuilder.executionInfo.SearchAttributes,
 	})
 }
 
@@ -424,5 +452,6 @@ func (t *transferQueueStandbyProcessorImpl) recordWorkflowClosed(msBuilder *muta
 		Status:           getWorkflowExecutionCloseStatus(msBuilder.executionInfo.CloseStatus),
 		HistoryLength:    msBuilder.GetNextEventID(),
 		RetentionSeconds: retentionSeconds,
+		SearchAttributes: msBuilder.executionInfo.SearchAttributes,
 	})
 }
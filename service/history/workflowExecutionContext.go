@@ -30,9 +30,8 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
-
-	"github.com/uber-common/bark"
 )
 
 const (
@@ -45,7 +44,7 @@ type (
 		workflowExecution workflow.WorkflowExecution
 		shard             ShardContext
 		executionManager  persistence.ExecutionManager
-		logger            bark.Logger
+		logger            logging.Logger
 
 		sync.Mutex
 		msBuilder       *mutableStateBuilder
@@ -59,11 +58,8 @@ var (
 )
 
 func newWorkflowExecutionContext(domainID string, execution workflow.WorkflowExecution, shard ShardContext,
-	executionManager persistence.ExecutionManager, logger bark.Logger) *workflowExecutionContext {
-	lg := logger.WithFields(bark.Fields{
-		logging.TagWorkflowExecutionID: *execution.WorkflowId,
-		logging.TagWorkflowRunID:       *execution.RunId,
-	})
+	executionManager persistence.ExecutionManager, logger logging.Logger) *workflowExecutionContext {
+	lg := logger.WithTags(tag.WorkflowID(execution.GetWorkflowId()), tag.WorkflowRunID(execution.GetRunId()))
 
 	return &workflowExecutionContext{
 		domainID:          domainID,
@@ -88,7 +84,7 @@ func (c *workflowExecutionContext) loadWorkflowExecution() (*mutableStateBuilder
 	})
 	if err != nil {
 		if common.IsPersistenceTransientError(err) {
-			logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationGetWorkflowExecution, err, "")
+			logging.LogPersistantStoreErrorEvent(c.logger, tag.StoreOperationGetWorkflowExecution, err, "")
 		}
 		return nil, err
 	}
@@ -143,7 +139,7 @@ func (c *workflowExecutionContext) replicateWorkflowExecution(request *h.Replica
 	c.msBuilder.updateReplicationStateLastEventID(request.GetSourceCluster(), lastEventID)
 	c.msBuilder.executionInfo.NextEventID = nextEventID
 
-	builder := newHistoryBuilderFromEvents(request.History.Events, c.logger)
+	builder := newHistoryBuilderFromEvents(request.History.Events, c.shard.GetConfig(), c.logger)
 	return c.updateHelper(builder, transferTasks, timerTasks, false, transactionID)
 }
 
@@ -220,7 +216,7 @@ func (c *workflowExecutionContext) updateHelper(builder *historyBuilder, transfe
 				return ErrConflict
 			}
 
-			logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationUpdateWorkflowExecution, err0,
+			logging.LogPersistantStoreErrorEvent(c.logger, tag.StoreOperationUpdateWorkflowExecution, err0,
 				fmt.Sprintf("{updateCondition: %v}", c.updateCondition))
 			return err0
 		}
@@ -284,7 +280,7 @@ func (c *workflowExecutionContext) updateHelper(builder *historyBuilder, transfe
 			return ErrConflict
 		}
 
-		logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationUpdateWorkflowExecution, err1,
+		logging.LogPersistantStoreErrorEvent(c.logger, tag.StoreOperationUpdateWorkflowExecution, err1,
 			fmt.Sprintf("{updateCondition: %v}", c.updateCondition))
 		return err1
 	}
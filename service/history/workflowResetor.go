@@ -0,0 +1,421 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	workflowResetor struct {
+		shard              ShardContext
+		historyEngine      *historyEngineImpl
+		historyCache       *historyCache
+		historyMgr         persistence.HistoryManager
+		visibilityMgr      persistence.VisibilityManager
+		hSerializerFactory persistence.HistorySerializerFactory
+		logger             logging.Logger
+	}
+
+	// resetHistory is the part of the base run history needed to build the reset run
+	resetHistory struct {
+		// batches of events before the reset point, in the order they were persisted
+		batches []*workflow.History
+		// the DecisionTaskCompleted event the workflow is reset to
+		decisionCompletedEvent *workflow.HistoryEvent
+		// signals received after the reset point
+		signals []*workflow.HistoryEvent
+	}
+)
+
+var (
+	errResetEventIDNotValid = &workflow.BadRequestError{
+		Message: "DecisionFinishEventId is not within the history of the workflow execution."}
+	errResetEventNotDecisionCompleted = &workflow.BadRequestError{
+		Message: "DecisionFinishEventId does not refer to a DecisionTaskCompleted event."}
+	errResetGlobalDomain = &workflow.BadRequestError{
+		Message: "Reset is not supported for workflow executions of global domains."}
+)
+
+func newWorkflowResetor(historyEngine *historyEngineImpl, visibilityMgr persistence.VisibilityManager) *workflowResetor {
+	return &workflowResetor{
+		shard:              historyEngine.shard,
+		historyEngine:      historyEngine,
+		historyCache:       historyEngine.historyCache,
+		historyMgr:         historyEngine.historyMgr,
+		visibilityMgr:      visibilityMgr,
+		hSerializerFactory: historyEngine.hSerializerFactory,
+		logger:             historyEngine.logger.WithTags(tag.ComponentWorkflowResetor),
+	}
+}
+
+// resetWorkflowExecution creates a new run of the workflow whose history is a copy of the base run history up to
+// the given DecisionTaskCompleted event. The decision completed by that event is failed, signals received by the
+// base run after it are applied again and a new decision is scheduled. The current run is terminated if it is
+// still running, the same way continue as new closes a run.
+func (r *workflowResetor) resetWorkflowExecution(domainEntry *cache.DomainCacheEntry,
+	request *workflow.ResetWorkflowExecutionRequest) (response *workflow.ResetWorkflowExecutionResponse, retError error) {
+
+	if domainEntry.IsGlobalDomain() {
+		return nil, errResetGlobalDomain
+	}
+	domainID := domainEntry.GetInfo().ID
+	workflowID := request.WorkflowExecution.GetWorkflowId()
+
+	// the current run is locked for the whole operation, so no new events can be added to it while the new run
+	// is being built
+	currentContext, currentRelease, err0 := r.historyCache.getOrCreateWorkflowExecution(domainID,
+		workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)})
+	if err0 != nil {
+		return nil, err0
+	}
+	defer func() { currentRelease(retError) }()
+
+	currentMsBuilder, err1 := currentContext.loadWorkflowExecution()
+	if err1 != nil {
+		return nil, err1
+	}
+	currentRunID := currentContext.workflowExecution.GetRunId()
+
+	// a retry of a reset which already succeeded finds the run it created as the current run
+	requestID := request.GetRequestId()
+	if requestID != "" && currentMsBuilder.executionInfo.CreateRequestID == requestID {
+		return &workflow.ResetWorkflowExecutionResponse{RunId: common.StringPtr(currentRunID)}, nil
+	}
+
+	baseExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(currentRunID),
+	}
+	baseNextEventID := currentMsBuilder.GetNextEventID()
+	if baseRunID := request.WorkflowExecution.GetRunId(); baseRunID != "" && baseRunID != currentRunID {
+		baseExecution.RunId = common.StringPtr(baseRunID)
+		baseNextEventID, err1 = r.getNextEventID(domainID, baseExecution)
+		if err1 != nil {
+			return nil, err1
+		}
+	}
+
+	decisionFinishEventID := request.GetDecisionFinishEventId()
+	if decisionFinishEventID <= common.FirstEventID || decisionFinishEventID >= baseNextEventID {
+		return nil, errResetEventIDNotValid
+	}
+
+	history, err2 := r.getResetHistory(domainID, baseExecution, decisionFinishEventID, baseNextEventID)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+	newExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	newRunCreated := false
+	defer func() {
+		if _, ok := retError.(*persistence.TimeoutError); retError != nil && !ok && !newRunCreated {
+			r.historyEngine.deleteEvents(domainID, newExecution)
+		}
+	}()
+
+	msBuilder, transferTasks, timerTasks, err4 := r.buildNewRun(domainID, newExecution, requestID, history, request)
+	if err4 != nil {
+		return nil, err4
+	}
+
+	createRequest := r.getCreateWorkflowExecutionRequest(domainID, newExecution, requestID, currentRunID, msBuilder,
+		transferTasks, timerTasks)
+	if currentMsBuilder.isWorkflowExecutionRunning() {
+		err4 = r.terminateCurrentRun(domainID, currentContext, currentMsBuilder, createRequest, request)
+	} else {
+		_, err4 = r.shard.CreateWorkflowExecution(createRequest)
+	}
+	if err4 != nil {
+		return nil, err4
+	}
+	newRunCreated = true
+	r.historyEngine.timerProcessor.NotifyNewTimers(r.historyEngine.currentClusterName,
+		r.shard.GetCurrentTime(r.historyEngine.currentClusterName), timerTasks)
+
+	// the first decision of the new run is not the one following the started event, so the transfer queue will not
+	// record the new run as open
+	if err := r.visibilityMgr.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       domainID,
+		Execution:        newExecution,
+		WorkflowTypeName: msBuilder.executionInfo.WorkflowTypeName,
+		StartTimestamp:   msBuilder.executionInfo.StartTimestamp.UnixNano(),
+		WorkflowTimeout:  int64(msBuilder.executionInfo.WorkflowTimeout),
+		SearchAttributes: msBuilder.executionInfo.SearchAttributes,
+	}); err != nil {
+		r.logger.Warn("Failed to record reset workflow execution as started.", tag.WorkflowID(workflowID), tag.WorkflowRunID(newExecution.GetRunId()), tag.Error(err))
+	}
+
+	return &workflow.ResetWorkflowExecutionResponse{RunId: newExecution.RunId}, nil
+}
+
+// buildNewRun replays the base run history up to the reset point into the mutable state of the new run, copies that
+// history to the new run and then appends the events which fail the reset decision, apply the signals again and
+// schedule a new decision.
+func (r *workflowResetor) buildNewRun(domainID string, newExecution workflow.WorkflowExecution, requestID string,
+	history *resetHistory, request *workflow.ResetWorkflowExecutionRequest) (*mutableStateBuilder, []persistence.Task,
+	[]persistence.Task, error) {
+
+	msBuilder := newMutableStateBuilder(r.shard.GetConfig(), r.logger)
+	sBuilder := newStateBuilder(r.shard, msBuilder, r.logger)
+	for _, batch := range history.batches {
+		if err := replayHistoryBatch(sBuilder, domainID, requestID, newExecution, batch); err != nil {
+			return nil, nil, nil, err
+		}
+
+		serializedHistory, err := newHistoryBuilderFromEvents(batch.Events, r.shard.GetConfig(), r.logger).Serialize()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if err := r.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+			DomainID:  domainID,
+			Execution: newExecution,
+			// It is ok to use 0 for TransactionID because RunID is unique so there are
+			// no potential duplicates to override.
+			TransactionID: 0,
+			FirstEventID:  batch.Events[0].GetEventId(),
+			Events:        serializedHistory,
+		}); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	startedEvent := history.batches[0].Events[0]
+	msBuilder.executionInfo.StartTimestamp = time.Unix(0, startedEvent.GetTimestamp())
+	msBuilder.executionInfo.LastUpdatedTimestamp = time.Now()
+
+	// The decision completed at the reset point is in flight in the rebuilt state. Reset its attempt so the failure
+	// is always written to history, even if the decision was a retry of a failed one.
+	attributes := history.decisionCompletedEvent.DecisionTaskCompletedEventAttributes
+	msBuilder.executionInfo.DecisionAttempt = 0
+	if msBuilder.AddDecisionTaskFailedEvent(attributes.GetScheduledEventId(), attributes.GetStartedEventId(),
+		workflow.DecisionTaskFailedCauseResetWorkflow, []byte(request.GetReason()), request.GetIdentity()) == nil {
+		return nil, nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision failed event."}
+	}
+	msBuilder.executionInfo.DecisionAttempt = 0
+
+	for _, event := range history.signals {
+		signalAttributes := event.WorkflowExecutionSignaledEventAttributes
+		if msBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+			SignalName: signalAttributes.SignalName,
+			Input:      signalAttributes.Input,
+			Identity:   signalAttributes.Identity,
+		}) == nil {
+			return nil, nil, nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
+		}
+	}
+
+	di := msBuilder.AddDecisionTaskScheduledEvent()
+	if di == nil {
+		return nil, nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
+	}
+
+	firstEvent := msBuilder.hBuilder.history[0]
+	serializedHistory, err := msBuilder.hBuilder.Serialize()
+	if err != nil {
+		logging.LogHistorySerializationErrorEvent(r.logger, err, fmt.Sprintf(
+			"HistoryEventBatch serialization error on reset workflow.  WorkflowID: %v, RunID: %v",
+			newExecution.GetWorkflowId(), newExecution.GetRunId()))
+		return nil, nil, nil, err
+	}
+	if err := r.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+		DomainID:      domainID,
+		Execution:     newExecution,
+		TransactionID: 0,
+		FirstEventID:  firstEvent.GetEventId(),
+		Events:        serializedHistory,
+	}); err != nil {
+		return nil, nil, nil, err
+	}
+	msBuilder.executionInfo.LastFirstEventID = firstEvent.GetEventId()
+
+	// tasks for events replayed from history which are no longer pending are dropped by the queue processors
+	transferTasks := append(sBuilder.transferTasks, &persistence.DecisionTask{
+		DomainID:   domainID,
+		TaskList:   di.TaskList,
+		ScheduleID: di.ScheduleID,
+	})
+	timerTasks := sBuilder.timerTasks
+	setTaskVersion(msBuilder.GetCurrentVersion(), transferTasks, timerTasks)
+
+	return msBuilder, transferTasks, timerTasks, nil
+}
+
+func (r *workflowResetor) getCreateWorkflowExecutionRequest(domainID string, newExecution workflow.WorkflowExecution,
+	requestID, currentRunID string, msBuilder *mutableStateBuilder, transferTasks []persistence.Task,
+	timerTasks []persistence.Task) *persistence.CreateWorkflowExecutionRequest {
+
+	executionInfo := msBuilder.executionInfo
+	var parentExecution *workflow.WorkflowExecution
+	if msBuilder.hasParentExecution() {
+		parentExecution = &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(executionInfo.ParentWorkflowID),
+			RunId:      common.StringPtr(executionInfo.ParentRunID),
+		}
+	}
+
+	return &persistence.CreateWorkflowExecutionRequest{
+		RequestID:                   requestID,
+		DomainID:                    domainID,
+		Execution:                   newExecution,
+		ParentDomainID:              executionInfo.ParentDomainID,
+		ParentExecution:             parentExecution,
+		InitiatedID:                 executionInfo.InitiatedID,
+		TaskList:                    executionInfo.TaskList,
+		WorkflowTypeName:            executionInfo.WorkflowTypeName,
+		WorkflowTimeout:             executionInfo.WorkflowTimeout,
+		DecisionTimeoutValue:        executionInfo.DecisionTimeoutValue,
+		ExecutionContext:            nil,
+		NextEventID:                 msBuilder.GetNextEventID(),
+		LastProcessedEvent:          common.EmptyEventID,
+		TransferTasks:               transferTasks,
+		TimerTasks:                  timerTasks,
+		DecisionVersion:             executionInfo.DecisionVersion,
+		DecisionScheduleID:          executionInfo.DecisionScheduleID,
+		DecisionStartedID:           executionInfo.DecisionStartedID,
+		DecisionStartToCloseTimeout: executionInfo.DecisionTimeout,
+		ContinueAsNew:               true,
+		PreviousRunID:               currentRunID,
+		CronSchedule:                executionInfo.CronSchedule,
+		Attempt:                     executionInfo.Attempt,
+		HasRetryPolicy:              executionInfo.HasRetryPolicy,
+		InitialInterval:             executionInfo.InitialInterval,
+		BackoffCoefficient:          executionInfo.BackoffCoefficient,
+		MaximumInterval:             executionInfo.MaximumInterval,
+		ExpirationTime:              executionInfo.ExpirationTime,
+		MaximumAttempts:             executionInfo.MaximumAttempts,
+		NonRetriableErrors:          executionInfo.NonRetriableErrors,
+		SearchAttributes:            executionInfo.SearchAttributes,
+		// the new run is written with the full mutable state rebuilt from history in the same call which
+		// creates it, so a failure cannot leave a current run with only part of its state
+		ResetSnapshot: msBuilder.ResetSnapshot(),
+	}
+}
+
+// terminateCurrentRun terminates the running current run and creates the new run in the same update
+func (r *workflowResetor) terminateCurrentRun(domainID string, currentContext *workflowExecutionContext,
+	currentMsBuilder *mutableStateBuilder, createRequest *persistence.CreateWorkflowExecutionRequest,
+	request *workflow.ResetWorkflowExecutionRequest) error {
+
+	if currentMsBuilder.AddWorkflowExecutionTerminatedEvent(&workflow.TerminateWorkflowExecutionRequest{
+		Reason:   request.Reason,
+		Details:  []byte(fmt.Sprintf("Workflow execution is reset to new run %v.", createRequest.Execution.GetRunId())),
+		Identity: request.Identity,
+	}) == nil {
+		return &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+	}
+	currentMsBuilder.continueAsNew = createRequest
+
+	tBuilder := r.historyEngine.getTimerBuilder(&currentContext.workflowExecution)
+	closeTask, cleanupTask, err := r.historyEngine.getDeleteWorkflowTasks(domainID, tBuilder)
+	if err != nil {
+		return err
+	}
+	timerTasks := []persistence.Task{cleanupTask}
+
+	transactionID, err := r.shard.GetNextTransferTaskID()
+	if err != nil {
+		return err
+	}
+	if err := currentContext.updateWorkflowExecution([]persistence.Task{closeTask}, timerTasks,
+		transactionID); err != nil {
+		return err
+	}
+
+	r.historyEngine.txProcessor.NotifyNewTask(r.historyEngine.currentClusterName,
+		r.shard.GetCurrentTime(r.historyEngine.currentClusterName), createRequest.TransferTasks)
+	r.historyEngine.timerProcessor.NotifyNewTimers(r.historyEngine.currentClusterName,
+		r.shard.GetCurrentTime(r.historyEngine.currentClusterName), timerTasks)
+	return nil
+}
+
+func (r *workflowResetor) getNextEventID(domainID string, execution workflow.WorkflowExecution) (nextEventID int64,
+	retError error) {
+	context, release, err0 := r.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	if err0 != nil {
+		return 0, err0
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err1 := context.loadWorkflowExecution()
+	if err1 != nil {
+		return 0, err1
+	}
+	return msBuilder.GetNextEventID(), nil
+}
+
+// getResetHistory reads the history of the base run and splits it at the reset point
+func (r *workflowResetor) getResetHistory(domainID string, execution workflow.WorkflowExecution,
+	decisionFinishEventID, nextEventID int64) (*resetHistory, error) {
+
+	history := &resetHistory{}
+	var nextPageToken []byte
+	var batches []*workflow.History
+	var err error
+	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
+		batches, nextPageToken, err = getHistoryBatches(r.historyMgr, r.hSerializerFactory, domainID, execution,
+			common.FirstEventID, nextEventID, nextPageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, batch := range batches {
+			var replayEvents []*workflow.HistoryEvent
+			for _, event := range batch.Events {
+				eventID := event.GetEventId()
+				switch {
+				case eventID < decisionFinishEventID:
+					replayEvents = append(replayEvents, event)
+				case eventID == decisionFinishEventID:
+					history.decisionCompletedEvent = event
+				case event.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled:
+					history.signals = append(history.signals, event)
+				}
+			}
+			if len(replayEvents) > 0 {
+				history.batches = append(history.batches, &workflow.History{Events: replayEvents})
+			}
+		}
+	}
+
+	if len(history.batches) == 0 || history.decisionCompletedEvent == nil {
+		return nil, errResetEventIDNotValid
+	}
+	if history.decisionCompletedEvent.GetEventType() != workflow.EventTypeDecisionTaskCompleted {
+		return nil, errResetEventNotDecisionCompleted
+	}
+	return history, nil
+}
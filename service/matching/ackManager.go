@@ -21,13 +21,14 @@
 package matching
 
 import (
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"go.uber.org/atomic"
 )
 
 // Used to convert out of order acks into ackLevel movement.
 type ackManager struct {
-	logger bark.Logger
+	logger logging.Logger
 
 	outstandingTasks map[int64]bool // key->TaskID, value->(true for acked, false->for non acked)
 	readLevel        int64          // Maximum TaskID inserted into outstandingTasks
@@ -38,18 +39,20 @@ type ackManager struct {
 // Registers task as in-flight and moves read level to it. Tasks can be added in increasing order of taskID only.
 func (m *ackManager) addTask(taskID int64) {
 	if m.readLevel >= taskID {
-		m.logger.Fatalf("Next task ID is less than current read level.  TaskID: %v, ReadLevel: %v", taskID,
-			m.readLevel)
+		m.logger.Fatal("Next task ID is less than current read level.",
+			tag.TaskID(taskID),
+			tag.ReadLevel(m.readLevel))
 	}
 	m.readLevel = taskID
 	if _, ok := m.outstandingTasks[taskID]; ok {
-		m.logger.Fatalf("Already present in outstanding tasks: taskID=%v", taskID)
+		m.logger.Fatal("Already present in outstanding tasks", tag.TaskID(taskID))
+
 	}
 	m.outstandingTasks[taskID] = false // true is for acked
 	m.backlogCounter.Inc()
 }
 
-func newAckManager(logger bark.Logger) ackManager {
+func newAckManager(logger logging.Logger) ackManager {
 	return ackManager{logger: logger, outstandingTasks: make(map[int64]bool), readLevel: -1, ackLevel: -1}
 }
 
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/.gen/go/matching/matchingserviceserver"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -94,7 +95,7 @@ func (h *Handler) Health(ctx context.Context) (*health.HealthStatus, error) {
 func (h *Handler) startRequestProfile(api string, scope int) tally.Stopwatch {
 	h.startWG.Wait()
 	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
-	h.Service.GetLogger().WithField("api", api).Debug("Received new request")
+	h.Service.GetLogger().Debug("Received new request", tag.Name(api))
 	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
 	return sw
 }
@@ -104,7 +105,7 @@ func (h *Handler) AddActivityTask(ctx context.Context, addRequest *m.AddActivity
 	scope := metrics.MatchingAddActivityTaskScope
 	sw := h.startRequestProfile("AddActivityTask", scope)
 	defer sw.Stop()
-	return h.handleErr(h.engine.AddActivityTask(addRequest), scope)
+	return h.handleErr(h.engine.AddActivityTask(ctx, addRequest), scope)
 }
 
 // AddDecisionTask - adds a decision task.
@@ -112,7 +113,7 @@ func (h *Handler) AddDecisionTask(ctx context.Context, addRequest *m.AddDecision
 	scope := metrics.MatchingAddDecisionTaskScope
 	sw := h.startRequestProfile("AddDecisionTask", scope)
 	defer sw.Stop()
-	return h.handleErr(h.engine.AddDecisionTask(addRequest), scope)
+	return h.handleErr(h.engine.AddDecisionTask(ctx, addRequest), scope)
 }
 
 // PollForActivityTask - long poll for an activity task.
@@ -33,11 +33,11 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 
 	"github.com/pborman/uuid"
-	"github.com/uber-common/bark"
 )
 
 // Implements matching.Engine
@@ -47,7 +47,7 @@ type matchingEngineImpl struct {
 	taskManager     persistence.TaskManager
 	historyService  history.Client
 	tokenSerializer common.TaskTokenSerializer
-	logger          bark.Logger
+	logger          logging.Logger
 	metricsClient   metrics.Client
 	taskListsLock   sync.RWMutex                   // locks mutation of taskLists
 	taskLists       map[taskListID]taskListManager // Convert to LRU cache
@@ -103,7 +103,7 @@ var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed im
 func NewEngine(taskManager persistence.TaskManager,
 	historyService history.Client,
 	config *Config,
-	logger bark.Logger,
+	logger logging.Logger,
 	metricsClient metrics.Client,
 ) Engine {
 
@@ -112,12 +112,10 @@ func NewEngine(taskManager persistence.TaskManager,
 		historyService:  historyService,
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		taskLists:       make(map[taskListID]taskListManager),
-		logger: logger.WithFields(bark.Fields{
-			logging.TagWorkflowComponent: logging.TagValueMatchingEngineComponent,
-		}),
-		metricsClient: metricsClient,
-		config:        config,
-		queryTaskMap:  make(map[string]chan *workflow.RespondQueryTaskCompletedRequest),
+		logger:          logger.WithTags(tag.ComponentMatchingEngine),
+		metricsClient:   metricsClient,
+		config:          config,
+		queryTaskMap:    make(map[string]chan *workflow.RespondQueryTaskCompletedRequest),
 	}
 }
 
@@ -202,13 +200,15 @@ func (e *matchingEngineImpl) removeTaskListManager(id *taskListID) {
 }
 
 // AddDecisionTask either delivers task directly to waiting poller or save it into task list persistence.
-func (e *matchingEngineImpl) AddDecisionTask(addRequest *m.AddDecisionTaskRequest) error {
+func (e *matchingEngineImpl) AddDecisionTask(ctx context.Context, addRequest *m.AddDecisionTaskRequest) error {
 	domainID := addRequest.GetDomainUUID()
 	taskListName := addRequest.TaskList.GetName()
 	taskListKind := common.TaskListKindPtr(addRequest.TaskList.GetKind())
-	e.logger.Debugf("Received AddDecisionTask for taskList=%v, WorkflowID=%v, RunID=%v, ScheduleToStartTimeout=%v",
-		addRequest.TaskList.GetName(), addRequest.Execution.GetWorkflowId(), addRequest.Execution.GetRunId(),
-		addRequest.GetScheduleToStartTimeoutSeconds())
+	e.logger.Debug("Received AddDecisionTask",
+		tag.WorkflowDomainID(domainID),
+		tag.TaskListName(taskListName),
+		tag.WorkflowID(addRequest.Execution.GetWorkflowId()),
+		tag.WorkflowRunID(addRequest.Execution.GetRunId()))
 	taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
@@ -221,17 +221,20 @@ func (e *matchingEngineImpl) AddDecisionTask(addRequest *m.AddDecisionTaskReques
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 	}
-	return tlMgr.AddTask(addRequest.Execution, taskInfo)
+	return tlMgr.AddTask(ctx, addRequest.Execution, taskInfo)
 }
 
 // AddActivityTask either delivers task directly to waiting poller or save it into task list persistence.
-func (e *matchingEngineImpl) AddActivityTask(addRequest *m.AddActivityTaskRequest) error {
+func (e *matchingEngineImpl) AddActivityTask(ctx context.Context, addRequest *m.AddActivityTaskRequest) error {
 	domainID := addRequest.GetDomainUUID()
 	sourceDomainID := addRequest.GetSourceDomainUUID()
 	taskListName := addRequest.TaskList.GetName()
 	taskListKind := common.TaskListKindPtr(addRequest.TaskList.GetKind())
-	e.logger.Debugf("Received AddActivityTask for taskList=%v WorkflowID=%v, RunID=%v",
-		taskListName, addRequest.Execution.WorkflowId, addRequest.Execution.RunId)
+	e.logger.Debug("Received AddActivityTask",
+		tag.WorkflowDomainID(domainID),
+		tag.TaskListName(taskListName),
+		tag.WorkflowID(addRequest.Execution.GetWorkflowId()),
+		tag.WorkflowRunID(addRequest.Execution.GetRunId()))
 	taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeActivity)
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
@@ -244,7 +247,7 @@ func (e *matchingEngineImpl) AddActivityTask(addRequest *m.AddActivityTaskReques
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 	}
-	return tlMgr.AddTask(addRequest.Execution, taskInfo)
+	return tlMgr.AddTask(ctx, addRequest.Execution, taskInfo)
 }
 
 // PollForDecisionTask tries to get the decision task using exponential backoff.
@@ -254,7 +257,7 @@ func (e *matchingEngineImpl) PollForDecisionTask(ctx context.Context, req *m.Pol
 	pollerID := req.GetPollerID()
 	request := req.PollRequest
 	taskListName := request.TaskList.GetName()
-	e.logger.Debugf("Received PollForDecisionTask for taskList=%v", taskListName)
+	e.logger.Debug("Received PollForDecisionTask", tag.WorkflowDomainID(domainID), tag.TaskListName(taskListName))
 pollLoop:
 	for {
 		err := common.IsValidContext(ctx)
@@ -329,8 +332,9 @@ pollLoop:
 		if err != nil {
 			switch err.(type) {
 			case *workflow.EntityNotExistsError, *h.EventAlreadyStartedError:
-				e.logger.Debugf("Duplicated decision task taskList=%v, taskID=%v",
-					taskListName, tCtx.info.TaskID)
+				e.logger.Debug("Duplicated decision task",
+					tag.TaskListName(taskListName),
+					tag.TaskID(tCtx.info.TaskID))
 				tCtx.completeTask(nil)
 			default:
 				tCtx.completeTask(err)
@@ -352,7 +356,7 @@ func (e *matchingEngineImpl) PollForActivityTask(ctx context.Context, req *m.Pol
 	pollerID := req.GetPollerID()
 	request := req.PollRequest
 	taskListName := request.TaskList.GetName()
-	e.logger.Debugf("Received PollForActivityTask for taskList=%v", taskListName)
+	e.logger.Debug("Received PollForActivityTask", tag.WorkflowDomainID(domainID), tag.TaskListName(taskListName))
 pollLoop:
 	for {
 		err := common.IsValidContext(ctx)
@@ -391,8 +395,10 @@ pollLoop:
 		if err != nil {
 			switch err.(type) {
 			case *workflow.EntityNotExistsError, *h.EventAlreadyStartedError:
-				e.logger.Debugf("Duplicated activity task taskList=%v, taskID=%v",
-					taskListName, tCtx.info.TaskID)
+				e.logger.Debug("Duplicated activity task",
+					tag.TaskListName(taskListName),
+					tag.TaskID(tCtx.info.TaskID))
+
 				tCtx.completeTask(nil)
 			default:
 				tCtx.completeTask(err)
@@ -31,8 +31,8 @@ type (
 	// Engine exposes interfaces for clients to poll for activity and decision tasks.
 	Engine interface {
 		Stop()
-		AddDecisionTask(addRequest *m.AddDecisionTaskRequest) error
-		AddActivityTask(addRequest *m.AddActivityTaskRequest) error
+		AddDecisionTask(ctx context.Context, addRequest *m.AddDecisionTaskRequest) error
+		AddActivityTask(ctx context.Context, addRequest *m.AddActivityTaskRequest) error
 		PollForDecisionTask(ctx context.Context, request *m.PollForDecisionTaskRequest) (*m.PollForDecisionTaskResponse, error)
 		PollForActivityTask(ctx context.Context, request *m.PollForActivityTaskRequest) (*workflow.PollForActivityTaskResponse, error)
 		QueryWorkflow(ctx context.Context, request *m.QueryWorkflowRequest) (*workflow.QueryWorkflowResponse, error)
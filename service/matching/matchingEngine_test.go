@@ -36,6 +36,8 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -46,7 +48,6 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
 )
 
@@ -57,7 +58,7 @@ type (
 		matchingEngine       *matchingEngineImpl
 		taskManager          *testTaskManager
 		mockExecutionManager *mocks.ExecutionManager
-		logger               bark.Logger
+		logger               logging.Logger
 		callContext          context.Context
 		sync.Mutex
 	}
@@ -72,9 +73,7 @@ func (s *matchingEngineSuite) SetupSuite() {
 	if testing.Verbose() {
 		log.SetOutput(os.Stdout)
 	}
-	l := log.New()
-	//l.Level = log.DebugLevel
-	s.logger = bark.NewLoggerFromLogrus(l)
+	s.logger = logging.NewDevelopmentLogger()
 	http.Handle("/test/tasks", http.HandlerFunc(s.TasksHandler))
 	// Get pprof HTTP UI at http://localhost:6060/debug/pprof/
 	// Add the following
@@ -117,7 +116,7 @@ func (s *matchingEngineSuite) newMatchingEngine(
 
 func newMatchingEngine(
 	config *Config, taskMgr persistence.TaskManager, historyClient history.Client,
-	logger bark.Logger,
+	logger logging.Logger,
 ) *matchingEngineImpl {
 	return &matchingEngineImpl{
 		taskManager:     taskMgr,
@@ -285,7 +284,7 @@ func (s *matchingEngineSuite) AddTasksTest(taskType int) {
 				ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 			}
 
-			err = s.matchingEngine.AddActivityTask(&addRequest)
+			err = s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 		} else {
 			addRequest := matching.AddDecisionTaskRequest{
 				DomainUUID:                    common.StringPtr(domainID),
@@ -295,7 +294,7 @@ func (s *matchingEngineSuite) AddTasksTest(taskType int) {
 				ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 			}
 
-			err = s.matchingEngine.AddDecisionTask(&addRequest)
+			err = s.matchingEngine.AddDecisionTask(context.Background(), &addRequest)
 		}
 		s.NoError(err)
 	}
@@ -344,12 +343,12 @@ func (s *matchingEngineSuite) TestTaskWriterShutdown() {
 	// now attempt to add a task
 	scheduleID := int64(5)
 	addRequest.ScheduleId = &scheduleID
-	err = s.matchingEngine.AddActivityTask(&addRequest)
+	err = s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 	s.Error(err)
 
 	// test race
 	tlmImpl.taskWriter.stopped = 0
-	err = s.matchingEngine.AddActivityTask(&addRequest)
+	err = s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 	s.Error(err)
 	tlmImpl.taskWriter.stopped = 1 // reset it back to old value
 }
@@ -386,7 +385,7 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 		}
 
-		err := s.matchingEngine.AddActivityTask(&addRequest)
+		err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 		s.NoError(err)
 	}
 	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
@@ -433,7 +432,7 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 		s.NoError(err)
 		s.NotNil(result)
 		if len(result.TaskToken) == 0 {
-			s.logger.Debugf("empty poll returned")
+			s.logger.Debug("empty poll returned")
 			continue
 		}
 		s.EqualValues(activityID, *result.ActivityId)
@@ -558,13 +557,13 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 			TaskList:                      taskList,
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 		}
-		err := s.matchingEngine.AddActivityTask(&addRequest)
+		err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 		wg.Wait()
 		s.NoError(err)
 		s.NoError(pollErr)
 		s.NotNil(result)
 		if len(result.TaskToken) == 0 {
-			s.logger.Debugf("empty poll returned")
+			s.logger.Debug("empty poll returned")
 			continue
 		}
 		s.EqualValues(activityID, *result.ActivityId)
@@ -620,7 +619,7 @@ func (s *matchingEngineSuite) TestConcurrentPublishConsumeActivitiesWithZeroDisp
 	const taskCount = 100
 	s.matchingEngine.metricsClient = metrics.NewClient(tally.NewTestScope("test", nil), metrics.Matching)
 	throttleCt := s.concurrentPublishConsumeActivities(workerCount, taskCount, dispatchLimitFn)
-	s.logger.Infof("Number of tasks throttled: %d", throttleCt)
+	s.logger.Info("Number of tasks throttled", tag.Number(throttleCt))
 	// atleast once from 0 dispatch poll, and until TTL is hit at which time throttle limit is reset
 	// hard to predict exactly how many times, since the atomic.Value load might not have updated.
 	s.True(throttleCt >= 1)
@@ -670,9 +669,9 @@ func (s *matchingEngineSuite) concurrentPublishConsumeActivities(
 					ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 				}
 
-				err := s.matchingEngine.AddActivityTask(&addRequest)
+				err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 				if err != nil {
-					s.logger.Infof("Failure in AddActivityTask: %v", err)
+					s.logger.Info("Failure in AddActivityTask", tag.Error(err))
 					i--
 				}
 			}
@@ -722,7 +721,7 @@ func (s *matchingEngineSuite) concurrentPublishConsumeActivities(
 				s.NoError(err)
 				s.NotNil(result)
 				if len(result.TaskToken) == 0 {
-					s.logger.Debugf("empty poll returned")
+					s.logger.Debug("empty poll returned")
 					continue
 				}
 				s.EqualValues(activityID, *result.ActivityId)
@@ -797,7 +796,7 @@ func (s *matchingEngineSuite) TestConcurrentPublishConsumeDecisions() {
 					ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 				}
 
-				err := s.matchingEngine.AddDecisionTask(&addRequest)
+				err := s.matchingEngine.AddDecisionTask(context.Background(), &addRequest)
 				if err != nil {
 					panic(err)
 				}
@@ -836,7 +835,7 @@ func (s *matchingEngineSuite) TestConcurrentPublishConsumeDecisions() {
 				}
 				s.NotNil(result)
 				if len(result.TaskToken) == 0 {
-					s.logger.Debugf("empty poll returned")
+					s.logger.Debug("empty poll returned")
 					continue
 				}
 				s.EqualValues(workflowExecution, *result.WorkflowExecution)
@@ -951,7 +950,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 					ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 				}
 
-				err := engine.AddActivityTask(&addRequest)
+				err := engine.AddActivityTask(context.Background(), &addRequest)
 				if err != nil {
 					if _, ok := err.(*persistence.ConditionFailedError); ok {
 						i-- // retry adding
@@ -978,7 +977,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 			if _, ok := startedTasks[*taskRequest.TaskId]; ok {
 				return nil
 			}
-			s.logger.Debugf("Mock Received RecordActivityTaskStartedRequest for taskID=%v", taskRequest.TaskId)
+			s.logger.Debug("Mock Received RecordActivityTaskStartedRequest", tag.TaskID(taskRequest.GetTaskId()))
 
 			return &gohistory.RecordActivityTaskStartedResponse{
 				ScheduledEvent: newActivityTaskScheduledEvent(*taskRequest.ScheduleId, 0,
@@ -996,7 +995,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 		},
 		func(ctx context.Context, taskRequest *gohistory.RecordActivityTaskStartedRequest) error {
 			if _, ok := startedTasks[*taskRequest.TaskId]; ok {
-				s.logger.Debugf("From error function Mock Received DUPLICATED RecordActivityTaskStartedRequest for taskID=%v", taskRequest.TaskId)
+				s.logger.Debug("From error function Mock Received DUPLICATED RecordActivityTaskStartedRequest", tag.TaskID(taskRequest.GetTaskId()))
 				return &workflow.EntityNotExistsError{Message: "already started"}
 			}
 			startedTasks[*taskRequest.TaskId] = true
@@ -1017,7 +1016,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 				}
 				s.NotNil(result)
 				if len(result.TaskToken) == 0 {
-					s.logger.Debugf("empty poll returned")
+					s.logger.Debug("empty poll returned")
 					continue
 				}
 				s.EqualValues(activityID, *result.ActivityId)
@@ -1103,7 +1102,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesDecisionsRangeStealing() {
 					ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 				}
 
-				err := engine.AddDecisionTask(&addRequest)
+				err := engine.AddDecisionTask(context.Background(), &addRequest)
 				if err != nil {
 					if _, ok := err.(*persistence.ConditionFailedError); ok {
 						i-- // retry adding
@@ -1129,7 +1128,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesDecisionsRangeStealing() {
 			if _, ok := startedTasks[*taskRequest.TaskId]; ok {
 				return nil
 			}
-			s.logger.Debugf("Mock Received RecordDecisionTaskStartedRequest for taskID=%v", taskRequest.TaskId)
+			s.logger.Debug("Mock Received RecordDecisionTaskStartedRequest", tag.TaskID(taskRequest.GetTaskId()))
 			s.logger.Debug("Mock Received RecordDecisionTaskStartedRequest")
 			return &gohistory.RecordDecisionTaskStartedResponse{
 				PreviousStartedEventId: &startedEventID,
@@ -1140,7 +1139,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesDecisionsRangeStealing() {
 		},
 		func(ctx context.Context, taskRequest *gohistory.RecordDecisionTaskStartedRequest) error {
 			if _, ok := startedTasks[*taskRequest.TaskId]; ok {
-				s.logger.Debugf("From error function Mock Received DUPLICATED RecordDecisionTaskStartedRequest for taskID=%v", taskRequest.TaskId)
+				s.logger.Debug("From error function Mock Received DUPLICATED RecordDecisionTaskStartedRequest", tag.TaskID(taskRequest.GetTaskId()))
 				return &gohistory.EventAlreadyStartedError{Message: "already started"}
 			}
 			startedTasks[*taskRequest.TaskId] = true
@@ -1161,7 +1160,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesDecisionsRangeStealing() {
 				}
 				s.NotNil(result)
 				if len(result.TaskToken) == 0 {
-					s.logger.Debugf("empty poll returned")
+					s.logger.Debug("empty poll returned")
 					continue
 				}
 				s.EqualValues(workflowExecution, *result.WorkflowExecution)
@@ -1228,7 +1227,7 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 	}
 
-	err := s.matchingEngine.AddActivityTask(&addRequest)
+	err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 	s.NoError(err)
 	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
 
@@ -1277,7 +1276,7 @@ func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
 		}
 
-		err := s.matchingEngine.AddActivityTask(&addRequest)
+		err := s.matchingEngine.AddActivityTask(context.Background(), &addRequest)
 		s.NoError(err)
 	}
 	tlMgr, ok := s.matchingEngine.taskLists[*tlID].(*taskListManagerImpl)
@@ -1344,7 +1343,7 @@ func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 		s.NoError(err)
 		s.NotNil(result)
 		if len(result.TaskToken) == 0 {
-			s.logger.Debugf("empty poll returned")
+			s.logger.Debug("empty poll returned")
 			continue
 		}
 	}
@@ -1402,10 +1401,10 @@ var _ persistence.TaskManager = (*testTaskManager)(nil) // Asserts that interfac
 type testTaskManager struct {
 	sync.Mutex
 	taskLists map[taskListID]*testTaskListManager
-	logger    bark.Logger
+	logger    logging.Logger
 }
 
-func newTestTaskManager(logger bark.Logger) *testTaskManager {
+func newTestTaskManager(logger logging.Logger) *testTaskManager {
 	return &testTaskManager{taskLists: make(map[taskListID]*testTaskListManager), logger: logger}
 }
 
@@ -1456,7 +1455,7 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 	tlm.Lock()
 	defer tlm.Unlock()
 	tlm.rangeID++
-	m.logger.Debugf("LeaseTaskList rangeID=%v", tlm.rangeID)
+	m.logger.Debug("LeaseTaskList", tag.ShardRangeID(tlm.rangeID))
 
 	return &persistence.LeaseTaskListResponse{
 		TaskListInfo: &persistence.TaskListInfo{
@@ -1472,7 +1471,7 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 
 // UpdateTaskList provides a mock function with given fields: request
 func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequest) (*persistence.UpdateTaskListResponse, error) {
-	m.logger.Debugf("UpdateTaskList taskListInfo=%v, ackLevel=%v", request.TaskListInfo, request.TaskListInfo.AckLevel)
+	m.logger.Debug("UpdateTaskList", tag.Value(request.TaskListInfo), tag.AckLevel(request.TaskListInfo.AckLevel))
 
 	tli := request.TaskListInfo
 	tlm := m.getTaskListManager(newTaskListID(tli.DomainID, tli.Name, tli.TaskType))
@@ -1490,7 +1489,7 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 
 // CompleteTask provides a mock function with given fields: request
 func (m *testTaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
-	m.logger.Debugf("CompleteTask taskID=%v, ackLevel=%v", request.TaskID, request.TaskList.AckLevel)
+	m.logger.Debug("CompleteTask", tag.TaskID(request.TaskID), tag.AckLevel(request.TaskList.AckLevel))
 	if request.TaskID <= 0 {
 		panic(fmt.Errorf("Invalid taskID=%v", request.TaskID))
 	}
@@ -1518,14 +1517,14 @@ func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (
 
 	// First validate the entire batch
 	for _, task := range request.Tasks {
-		m.logger.Debugf("testTaskManager.CreateTask taskID=%v, rangeID=%v", task.TaskID, rangeID)
+		m.logger.Debug("testTaskManager.CreateTask", tag.TaskID(task.TaskID), tag.ShardRangeID(rangeID))
 		if task.TaskID <= 0 {
 			panic(fmt.Errorf("Invalid taskID=%v", task.TaskID))
 		}
 
 		if tlm.rangeID != rangeID {
-			m.logger.Debugf("testTaskManager.CreateTask ConditionFailedError taskID=%v, rangeID: %v, db rangeID: %v",
-				task.TaskID, rangeID, tlm.rangeID)
+			m.logger.Debug("testTaskManager.CreateTask ConditionFailedError", tag.TaskID(task.TaskID),
+				tag.ShardRangeID(rangeID), tag.Value(tlm.rangeID))
 
 			return nil, &persistence.ConditionFailedError{
 				Msg: fmt.Sprintf("testTaskManager.CreateTask failed. TaskList: %v, taskType: %v, rangeID: %v, db rangeID: %v",
@@ -1556,7 +1555,7 @@ func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (
 
 // GetTasks provides a mock function with given fields: request
 func (m *testTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
-	m.logger.Debugf("testTaskManager.GetTasks readLevel=%v, maxReadLevel=%v", request.ReadLevel, request.MaxReadLevel)
+	m.logger.Debug("testTaskManager.GetTasks", tag.ReadLevel(request.ReadLevel), tag.Value(request.MaxReadLevel))
 
 	tlm := m.getTaskListManager(newTaskListID(request.DomainID, request.TaskList, request.TaskType))
 	tlm.Lock()
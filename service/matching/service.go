@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -96,28 +97,24 @@ func (s *Service) Start() {
 	var p = s.params
 	var log = p.Logger
 
-	log.Infof("%v starting", common.MatchingServiceName)
+	log.Info("service starting", tag.Service(common.MatchingServiceName))
 
 	base := service.New(p)
 
-	taskPersistence, err := persistence.NewCassandraTaskPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		base.GetLogger())
+	pFactory := persistence.NewFactory(&p.PersistenceConfig, &p.CassandraConfig,
+		p.ClusterMetadata.GetCurrentClusterName(), base.GetLogger())
+	taskPersistence, err := pFactory.NewTaskManager()
 
 	if err != nil {
-		log.Fatalf("failed to create task persistence: %v", err)
+		log.Fatal("failed to create task persistence", tag.Error(err))
 	}
 
-	taskPersistence = persistence.NewTaskPersistenceClient(taskPersistence, base.GetMetricsClient())
+	taskPersistence = persistence.NewTaskPersistenceClient(taskPersistence, base.GetMetricsClient(), base.GetTracer())
 
 	handler := NewHandler(base, s.config, taskPersistence)
 	handler.Start()
 
-	log.Infof("%v started", common.MatchingServiceName)
+	log.Info("service started", tag.Service(common.MatchingServiceName))
 	<-s.stopC
 	base.Stop()
 }
@@ -128,5 +125,5 @@ func (s *Service) Stop() {
 	case s.stopC <- struct{}{}:
 	default:
 	}
-	s.params.Logger.Infof("%v stopped", common.MatchingServiceName)
+	s.params.Logger.Info("service stopped", tag.Service(common.MatchingServiceName))
 }
@@ -37,10 +37,9 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
-
-	"github.com/uber-common/bark"
 	"golang.org/x/time/rate"
 )
 
@@ -59,7 +58,7 @@ var errAddTasklistThrottled = errors.New("cannot add to tasklist, limit exceeded
 type taskListManager interface {
 	Start() error
 	Stop()
-	AddTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) error
+	AddTask(ctx context.Context, execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) error
 	GetTaskContext(ctx context.Context, maxDispatchPerSecond *float64) (*taskContext, error)
 	SyncMatchQueryTask(ctx context.Context, queryTask *queryTaskInfo) error
 	CancelPoller(pollerID string)
@@ -207,10 +206,10 @@ func newTaskListManagerWithRateLimiter(
 		cancelCtx:               ctx,
 		cancelFunc:              cancel,
 		taskListID:              taskList,
-		logger: e.logger.WithFields(bark.Fields{
-			logging.TagTaskListType: taskList.taskType,
-			logging.TagTaskListName: taskList.taskListName,
-		}),
+		logger: e.logger.WithTags(
+			tag.WorkflowDomainID(taskList.domainID),
+			tag.TaskListName(taskList.taskListName),
+			tag.TaskListType(taskList.taskType)),
 		metricsClient:       e.metricsClient,
 		taskAckManager:      newAckManager(e.logger),
 		tasksForPoll:        make(chan *getTaskResult),
@@ -243,7 +242,7 @@ type queryTaskInfo struct {
 // Single task list in memory state
 type taskListManagerImpl struct {
 	taskListID    *taskListID
-	logger        bark.Logger
+	logger        logging.Logger
 	metricsClient metrics.Client
 	engine        *matchingEngineImpl
 	config        *taskListConfig
@@ -343,14 +342,15 @@ func (c *taskListManagerImpl) Stop() {
 	logging.LogTaskListUnloadedEvent(c.logger)
 }
 
-func (c *taskListManagerImpl) AddTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) error {
+func (c *taskListManagerImpl) AddTask(ctx context.Context, execution *s.WorkflowExecution,
+	taskInfo *persistence.TaskInfo) error {
 	c.startWG.Wait()
 	_, err := c.executeWithRetry(func(rangeID int64) (interface{}, error) {
 		r, err := c.trySyncMatch(taskInfo)
 		if (err != nil && err != errAddTasklistThrottled) || r != nil {
 			return r, err
 		}
-		r, err = c.taskWriter.appendTask(execution, taskInfo, rangeID)
+		r, err = c.taskWriter.appendTask(ctx, execution, taskInfo, rangeID)
 		return r, err
 	})
 	if err == nil {
@@ -626,8 +626,10 @@ func (c *taskListManagerImpl) updateRangeIfNeededLocked(e *matchingEngineImpl) e
 	c.taskAckManager.setAckLevel(tli.AckLevel)
 	c.taskSequenceNumber = (tli.RangeID-1)*c.config.RangeSize + 1
 	c.nextRangeSequenceNumber = (tli.RangeID)*c.config.RangeSize + 1
-	c.logger.Debugf("updateRangeLocked rangeID=%v, c.taskSequenceNumber=%v, c.nextRangeSequenceNumber=%v",
-		c.rangeID, c.taskSequenceNumber, c.nextRangeSequenceNumber)
+	c.logger.Debug("updateRangeLocked",
+		tag.ShardRangeID(c.rangeID),
+		tag.TaskID(c.taskSequenceNumber),
+		tag.Number(c.nextRangeSequenceNumber))
 	return nil
 }
 
@@ -699,10 +701,7 @@ deliverBufferTasksLoop:
 				c.logger.Info("Tasklist manager context is cancelled, shutting down")
 				break deliverBufferTasksLoop
 			}
-			c.logger.Debugf(
-				"Unable to add buffer task, rate limit failed, domainId: %s, tasklist: %s, error: %s",
-				c.taskListID.domainID, c.taskListID.taskListName, err.Error(),
-			)
+			c.logger.Debug("Unable to add buffer task, rate limit failed", tag.Error(err))
 			c.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.BufferThrottleCounter)
 			// This is to prevent busy looping when throttling is set to 0
 			runtime.Gosched()
@@ -772,7 +771,7 @@ getTasksPumpLoop:
 						// This indicates the task list may have moved to another host.
 						c.Stop()
 					} else {
-						logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationUpdateTaskList, err,
+						logging.LogPersistantStoreErrorEvent(c.logger, tag.StoreOperationUpdateTaskList, err,
 							"Persist AckLevel failed")
 					}
 					// keep going as saving ack is not critical
@@ -808,7 +807,7 @@ func (c *taskListManagerImpl) executeWithRetry(
 
 	var retryCount int64
 	err = backoff.Retry(op, persistenceOperationRetryPolicy, func(err error) bool {
-		c.logger.Debugf("Retry executeWithRetry as task list range has changed. retryCount=%v, errType=%T", retryCount, err)
+		c.logger.Debug("Retry executeWithRetry as task list range has changed", tag.Attempt(retryCount), tag.Error(err))
 
 		// Operation failed due to invalid range, but this task list has a different rangeID as well.
 		// Retry as the failure could be due to a rangeID update by this task list instance.
@@ -819,7 +818,7 @@ func (c *taskListManagerImpl) executeWithRetry(
 			}
 			// Our range has changed.
 			// Could be still owning the next range, so keep retrying.
-			c.logger.Debugf("Retry executeWithRetry as task list range has changed. retryCount=%v, errType=%T", retryCount, err)
+			c.logger.Debug("Retry executeWithRetry as task list range has changed", tag.Attempt(retryCount), tag.Error(err))
 			retryCount++
 			return true
 		}
@@ -828,7 +827,7 @@ func (c *taskListManagerImpl) executeWithRetry(
 
 	if _, ok := err.(*persistence.ConditionFailedError); ok {
 		c.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.ConditionFailedErrorCounter)
-		c.logger.Debugf("Stopping task list due to persistence condition failure. Err: %v", err)
+		c.logger.Debug("Stopping task list due to persistence condition failure", tag.Error(err))
 		c.Stop()
 	}
 	return
@@ -880,8 +879,8 @@ func (c *taskContext) RecordActivityTaskStartedWithRetry(
 // If poll received task from persistence then task is deleted from it if no error was reported.
 func (c *taskContext) completeTask(err error) {
 	tlMgr := c.tlMgr
-	tlMgr.logger.Debugf("completeTask task taskList=%v, taskID=%v, err=%v",
-		tlMgr.taskListID.taskListName, c.info.TaskID, err)
+	tlMgr.logger.Debug("completeTask task", tag.TaskID(c.info.TaskID), tag.Error(err))
+
 	if c.syncResponseCh != nil {
 		// It is OK to succeed task creation as it was already completed
 		c.syncResponseCh <- &syncMatchResponse{
@@ -898,14 +897,14 @@ func (c *taskContext) completeTask(err error) {
 		// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
 		// re-written to persistence frequently.
 		_, err = tlMgr.executeWithRetry(func(rangeID int64) (interface{}, error) {
-			return tlMgr.taskWriter.appendTask(&c.workflowExecution, c.info, rangeID)
+			return tlMgr.taskWriter.appendTask(context.Background(), &c.workflowExecution, c.info, rangeID)
 		})
 
 		if err != nil {
 			// OK, we also failed to write to persistence.
 			// This should only happen in very extreme cases where persistence is completely down.
 			// We still can't lose the old task so we just unload the entire task list
-			logging.LogPersistantStoreErrorEvent(tlMgr.logger, logging.TagValueStoreOperationStopTaskList, err,
+			logging.LogPersistantStoreErrorEvent(tlMgr.logger, tag.StoreOperationStopTaskList, err,
 				fmt.Sprintf("task writer failed to write task. Unloading TaskList{taskType: %v, taskList: %v}",
 					tlMgr.taskListID.taskType, tlMgr.taskListID.taskListName))
 			tlMgr.Stop()
@@ -928,7 +927,7 @@ func (c *taskContext) completeTask(err error) {
 	})
 
 	if err2 != nil {
-		logging.LogPersistantStoreErrorEvent(tlMgr.logger, logging.TagValueStoreOperationCompleteTask, err2,
+		logging.LogPersistantStoreErrorEvent(tlMgr.logger, tag.StoreOperationCompleteTask, err2,
 			fmt.Sprintf("{taskID: %v, taskType: %v, taskList: %v}",
 				c.info.TaskID, tlMgr.taskListID.taskType, tlMgr.taskListID.taskListName))
 	}
@@ -28,12 +28,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/time/rate"
 
-	"github.com/uber/cadence/common/mocks"
-
-	log "github.com/sirupsen/logrus"
-	"github.com/uber-common/bark"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -67,7 +65,7 @@ func TestNewRateLimiter(t *testing.T) {
 }
 
 func createTestTaskListManager() *taskListManagerImpl {
-	logger := bark.NewLoggerFromLogrus(log.New())
+	logger := logging.NewDevelopmentLogger()
 	tm := newTestTaskManager(logger)
 	cfg := defaultTestConfig()
 	me := newMatchingEngine(
@@ -21,13 +21,14 @@
 package matching
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync/atomic"
 
-	"github.com/uber-common/bark"
 	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -38,6 +39,7 @@ type (
 	}
 
 	writeTaskRequest struct {
+		ctx        context.Context
 		execution  *s.WorkflowExecution
 		taskInfo   *persistence.TaskInfo
 		rangeID    int64
@@ -53,7 +55,7 @@ type (
 		appendCh     chan *writeTaskRequest
 		maxReadLevel int64
 		stopped      int64 // set to 1 if the writer is stopped or is shutting down
-		logger       bark.Logger
+		logger       logging.Logger
 		stopCh       chan struct{} // shutdown signal for all routines in this class
 	}
 )
@@ -89,7 +91,7 @@ func (w *taskWriter) isStopped() bool {
 	return atomic.LoadInt64(&w.stopped) == 1
 }
 
-func (w *taskWriter) appendTask(execution *s.WorkflowExecution,
+func (w *taskWriter) appendTask(ctx context.Context, execution *s.WorkflowExecution,
 	taskInfo *persistence.TaskInfo, rangeID int64) (*persistence.CreateTasksResponse, error) {
 
 	if w.isStopped() {
@@ -98,6 +100,7 @@ func (w *taskWriter) appendTask(execution *s.WorkflowExecution,
 
 	ch := make(chan *writeTaskResponse)
 	req := &writeTaskRequest{
+		ctx:        ctx,
 		execution:  execution,
 		taskInfo:   taskInfo,
 		rangeID:    rangeID,
@@ -167,15 +170,17 @@ writerLoop:
 					Kind:     w.tlMgr.getTaskListKind(),
 				}
 
+				// the tasks of a batch are written by a single call, traced as a child of the span of the first request
+				taskManager := persistence.TaskManagerWithContext(reqs[0].ctx, w.taskManager)
 				w.tlMgr.persistenceLock.Lock()
-				r, err := w.taskManager.CreateTasks(&persistence.CreateTasksRequest{
+				r, err := taskManager.CreateTasks(&persistence.CreateTasksRequest{
 					TaskListInfo: tlInfo,
 					Tasks:        tasks,
 				})
 				w.tlMgr.persistenceLock.Unlock()
 
 				if err != nil {
-					logging.LogPersistantStoreErrorEvent(w.logger, logging.TagValueStoreOperationCreateTask, err,
+					logging.LogPersistantStoreErrorEvent(w.logger, tag.StoreOperationCreateTask, err,
 						fmt.Sprintf("{taskID: [%v, %v], taskType: %v, taskList: %v}",
 							taskIDs[0], taskIDs[batchSize-1], w.taskListID.taskType, w.taskListID.taskListName))
 				}
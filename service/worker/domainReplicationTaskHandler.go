@@ -23,9 +23,9 @@ package worker
 import (
 	"errors"
 
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -55,12 +55,12 @@ var (
 type (
 	domainReplicatorImpl struct {
 		metadataManager persistence.MetadataManager
-		logger          bark.Logger
+		logger          logging.Logger
 	}
 )
 
 // NewDomainReplicator create a new instance odf domain replicator
-func NewDomainReplicator(metadataManager persistence.MetadataManager, logger bark.Logger) DomainReplicator {
+func NewDomainReplicator(metadataManager persistence.MetadataManager, logger logging.Logger) DomainReplicator {
 	return &domainReplicatorImpl{
 		metadataManager: metadataManager,
 		logger:          logger,
@@ -100,8 +100,10 @@ func (domainReplicator *domainReplicatorImpl) handleDomainCreationReplicationTas
 			OwnerEmail:  task.Info.GetOwnerEmail(),
 		},
 		Config: &persistence.DomainConfig{
-			Retention:  task.Config.GetWorkflowExecutionRetentionPeriodInDays(),
-			EmitMetric: task.Config.GetEmitMetric(),
+			Retention:      task.Config.GetWorkflowExecutionRetentionPeriodInDays(),
+			EmitMetric:     task.Config.GetEmitMetric(),
+			ArchivalBucket: task.Config.GetArchivalBucketName(),
+			ArchivalStatus: task.Config.GetArchivalStatus(),
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
 			ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -159,15 +161,21 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 			OwnerEmail:  task.Info.GetOwnerEmail(),
 		}
 		request.Config = &persistence.DomainConfig{
-			Retention:  task.Config.GetWorkflowExecutionRetentionPeriodInDays(),
-			EmitMetric: task.Config.GetEmitMetric(),
+			Retention:      task.Config.GetWorkflowExecutionRetentionPeriodInDays(),
+			EmitMetric:     task.Config.GetEmitMetric(),
+			ArchivalBucket: task.Config.GetArchivalBucketName(),
+			ArchivalStatus: task.Config.GetArchivalStatus(),
 		}
 		request.ReplicationConfig.Clusters = domainReplicator.convertClusterReplicationConfigFromThrift(task.ReplicationConfig.Clusters)
 		request.ConfigVersion = task.GetConfigVersion()
 	}
 	if resp.FailoverVersion < task.GetFailoverVersion() {
 		recordUpdated = true
-		request.ReplicationConfig.ActiveClusterName = task.ReplicationConfig.GetActiveClusterName()
+		// a failover done by another cluster supersedes any graceful failover in progress in this cluster
+		request.ReplicationConfig = &persistence.DomainReplicationConfig{
+			ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
+			Clusters:          request.ReplicationConfig.Clusters,
+		}
 		request.FailoverVersion = task.GetFailoverVersion()
 	}
 
@@ -24,14 +24,14 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -62,7 +62,7 @@ func (s *domainReplicatorSuite) SetupTest() {
 	s.SetupWorkflowStore()
 	s.domainReplicator = NewDomainReplicator(
 		s.MetadataManager,
-		bark.NewLoggerFromLogrus(logrus.New()),
+		logging.NewDevelopmentLogger(),
 	).(*domainReplicatorImpl)
 }
 
@@ -79,6 +79,8 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_RegisterDomainTask() {
 	ownerEmail := "some random test owner"
 	retention := int32(10)
 	emitMetric := true
+	archivalBucket := "some random archival bucket"
+	archivalStatus := shared.ArchivalStatusEnabled
 	clusterActive := "some random active cluster name"
 	clusterStandby := "some random standby cluster name"
 	configVersion := int64(0)
@@ -104,6 +106,8 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_RegisterDomainTask() {
 		Config: &shared.DomainConfiguration{
 			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(retention),
 			EmitMetric:                             common.BoolPtr(emitMetric),
+			ArchivalBucketName:                     common.StringPtr(archivalBucket),
+			ArchivalStatus:                         archivalStatus.Ptr(),
 		},
 		ReplicationConfig: &shared.DomainReplicationConfiguration{
 			ActiveClusterName: common.StringPtr(clusterActive),
@@ -126,6 +130,8 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_RegisterDomainTask() {
 	s.Equal(ownerEmail, resp.Info.OwnerEmail)
 	s.Equal(retention, resp.Config.Retention)
 	s.Equal(emitMetric, resp.Config.EmitMetric)
+	s.Equal(archivalBucket, resp.Config.ArchivalBucket)
+	s.Equal(archivalStatus, resp.Config.ArchivalStatus)
 	s.Equal(clusterActive, resp.ReplicationConfig.ActiveClusterName)
 	s.Equal(s.domainReplicator.convertClusterReplicationConfigFromThrift(clusters), resp.ReplicationConfig.Clusters)
 	s.Equal(configVersion, resp.ConfigVersion)
@@ -249,6 +255,8 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_UpdateC
 	updateOwnerEmail := "other random domain test owner"
 	updateRetention := int32(122)
 	updateEmitMetric := true
+	updateArchivalBucket := "other random archival bucket"
+	updateArchivalStatus := shared.ArchivalStatusEnabled
 	updateClusterActive := "other random active cluster name"
 	updateClusterStandby := "other random standby cluster name"
 	updateConfigVersion := configVersion + 1
@@ -273,6 +281,8 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_UpdateC
 		Config: &shared.DomainConfiguration{
 			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(updateRetention),
 			EmitMetric:                             common.BoolPtr(updateEmitMetric),
+			ArchivalBucketName:                     common.StringPtr(updateArchivalBucket),
+			ArchivalStatus:                         updateArchivalStatus.Ptr(),
 		},
 		ReplicationConfig: &shared.DomainReplicationConfiguration{
 			ActiveClusterName: common.StringPtr(updateClusterActive),
@@ -293,6 +303,8 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_UpdateC
 	s.Equal(updateOwnerEmail, resp.Info.OwnerEmail)
 	s.Equal(updateRetention, resp.Config.Retention)
 	s.Equal(updateEmitMetric, resp.Config.EmitMetric)
+	s.Equal(updateArchivalBucket, resp.Config.ArchivalBucket)
+	s.Equal(updateArchivalStatus, resp.Config.ArchivalStatus)
 	s.Equal(updateClusterActive, resp.ReplicationConfig.ActiveClusterName)
 	s.Equal(s.domainReplicator.convertClusterReplicationConfigFromThrift(updateClusters), resp.ReplicationConfig.Clusters)
 	s.Equal(updateConfigVersion, resp.ConfigVersion)
@@ -508,6 +520,90 @@ func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_NoUpdat
 	s.Equal(int64(1), resp.DBVersion)
 }
 
+func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_UpdateActiveCluster_ClearHandover() {
+	operation := replicator.DomainOperationCreate
+	id := uuid.New()
+	name := "some random domain test name"
+	status := shared.DomainStatusRegistered
+	clusterActive := "some random active cluster name"
+	clusterStandby := "some random standby cluster name"
+	configVersion := int64(0)
+	failoverVersion := int64(59)
+	clusters := []*shared.ClusterReplicationConfiguration{
+		&shared.ClusterReplicationConfiguration{
+			ClusterName: common.StringPtr(clusterActive),
+		},
+		&shared.ClusterReplicationConfiguration{
+			ClusterName: common.StringPtr(clusterStandby),
+		},
+	}
+
+	createTask := &replicator.DomainTaskAttributes{
+		DomainOperation: &operation,
+		ID:              common.StringPtr(id),
+		Info: &shared.DomainInfo{
+			Name:   common.StringPtr(name),
+			Status: &status,
+		},
+		Config: &shared.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(10),
+			EmitMetric:                             common.BoolPtr(true),
+		},
+		ReplicationConfig: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(clusterActive),
+			Clusters:          clusters,
+		},
+		ConfigVersion:   common.Int64Ptr(configVersion),
+		FailoverVersion: common.Int64Ptr(failoverVersion),
+	}
+
+	err := s.domainReplicator.HandleReceivingTask(createTask)
+	s.Nil(err)
+
+	// the active cluster is gracefully failing the domain over to the standby cluster
+	resp, err := s.MetadataManager.GetDomain(&persistence.GetDomainRequest{Name: name})
+	s.Nil(err)
+	resp.ReplicationConfig.HandoverClusterName = clusterStandby
+	resp.ReplicationConfig.HandoverStartTime = time.Now().Truncate(time.Millisecond)
+	resp.ReplicationConfig.HandoverExpiryTime = resp.ReplicationConfig.HandoverStartTime.Add(time.Minute)
+	err = s.MetadataManager.UpdateDomain(&persistence.UpdateDomainRequest{
+		Info:              resp.Info,
+		Config:            resp.Config,
+		ReplicationConfig: resp.ReplicationConfig,
+		ConfigVersion:     resp.ConfigVersion,
+		FailoverVersion:   resp.FailoverVersion,
+		DBVersion:         resp.DBVersion,
+	})
+	s.Nil(err)
+
+	// while another cluster forces the failover
+	updateOperation := replicator.DomainOperationUpdate
+	updateFailoverVersion := failoverVersion + 1
+	updateTask := &replicator.DomainTaskAttributes{
+		DomainOperation: &updateOperation,
+		ID:              common.StringPtr(id),
+		Info:            createTask.Info,
+		Config:          createTask.Config,
+		ReplicationConfig: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(clusterStandby),
+			Clusters:          clusters,
+		},
+		ConfigVersion:   common.Int64Ptr(configVersion),
+		FailoverVersion: common.Int64Ptr(updateFailoverVersion),
+	}
+	err = s.domainReplicator.HandleReceivingTask(updateTask)
+	s.Nil(err)
+	resp, err = s.MetadataManager.GetDomain(&persistence.GetDomainRequest{Name: name})
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(clusterStandby, resp.ReplicationConfig.ActiveClusterName)
+	s.Equal(s.domainReplicator.convertClusterReplicationConfigFromThrift(clusters), resp.ReplicationConfig.Clusters)
+	s.Equal("", resp.ReplicationConfig.HandoverClusterName)
+	s.True(resp.ReplicationConfig.HandoverStartTime.IsZero())
+	s.True(resp.ReplicationConfig.HandoverExpiryTime.IsZero())
+	s.Equal(updateFailoverVersion, resp.FailoverVersion)
+}
+
 func (s *domainReplicatorSuite) TestHandleReceivingTask_UpdateDomainTask_NoUpdateConfig_NoUpdateActiveCluster() {
 	operation := replicator.DomainOperationCreate
 	id := uuid.New()
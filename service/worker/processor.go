@@ -21,24 +21,23 @@
 package worker
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	"encoding/json"
-
-	"context"
-
-	"github.com/uber-common/bark"
-	"github.com/uber-go/kafka-client/kafka"
 	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 )
@@ -55,16 +54,32 @@ type (
 		topicName        string
 		consumerName     string
 		client           messaging.Client
-		consumer         kafka.Consumer
+		consumer         messaging.Consumer
 		isStarted        int32
 		isStopped        int32
 		shutdownWG       sync.WaitGroup
 		shutdownCh       chan struct{}
 		config           *Config
-		logger           bark.Logger
+		logger           logging.Logger
 		metricsClient    metrics.Client
 		domainReplicator DomainReplicator
 		historyClient    history.Client
+		retryProducer    messaging.FailedTaskProducer
+		dlqProducer      messaging.FailedTaskProducer
+		retryPolicy      backoff.RetryPolicy
+
+		// the messages of the retry topic which are not due yet wait in delayedMessages, ordered by due time,
+		// instead of blocking a worker, the delay pump hands them back to the workers through dueCh once due
+		delayedLock     sync.Mutex
+		delayedMessages []*delayedMessage
+		delayStopped    bool
+		delayedCh       chan struct{}
+		dueCh           chan messaging.Message
+	}
+
+	delayedMessage struct {
+		msg     messaging.Message
+		dueTime time.Time
 	}
 )
 
@@ -76,23 +91,24 @@ var (
 )
 
 func newReplicationTaskProcessor(currentCluster, sourceCluster, consumer string, client messaging.Client, config *Config,
-	logger bark.Logger, metricsClient metrics.Client, domainReplicator DomainReplicator,
+	logger logging.Logger, metricsClient metrics.Client, domainReplicator DomainReplicator,
 	historyClient history.Client) *replicationTaskProcessor {
+	retryPolicy := backoff.NewExponentialRetryPolicy(config.ReplicationTaskRetryInitialInterval)
+	retryPolicy.SetMaximumInterval(config.ReplicationTaskRetryMaxInterval)
 	return &replicationTaskProcessor{
-		currentCluster: currentCluster,
-		sourceCluster:  sourceCluster,
-		consumerName:   consumer,
-		client:         client,
-		shutdownCh:     make(chan struct{}),
-		config:         config,
-		logger: logger.WithFields(bark.Fields{
-			logging.TagWorkflowComponent: logging.TagValueReplicationTaskProcessorComponent,
-			logging.TagSourceCluster:     sourceCluster,
-			logging.TagConsumerName:      consumer,
-		}),
-		metricsClient:    metricsClient,
+		currentCluster:   currentCluster,
+		sourceCluster:    sourceCluster,
+		consumerName:     consumer,
+		client:           client,
+		shutdownCh:       make(chan struct{}),
+		config:           config,
+		logger:           logger.WithTags(tag.ComponentReplicationTaskProcessor, tag.SourceCluster(sourceCluster), tag.KafkaConsumerName(consumer)),
+		metricsClient:    metricsClient.Tagged(map[string]string{metrics.SourceClusterTagName: sourceCluster}),
 		domainReplicator: domainReplicator,
 		historyClient:    historyClient,
+		retryPolicy:      retryPolicy,
+		delayedCh:        make(chan struct{}, 1),
+		dueCh:            make(chan messaging.Message),
 	}
 }
 
@@ -102,6 +118,20 @@ func (p *replicationTaskProcessor) Start() error {
 	}
 
 	logging.LogReplicationTaskProcessorStartingEvent(p.logger)
+	retryProducer, err := p.client.NewRetryProducer(p.currentCluster)
+	if err != nil {
+		logging.LogReplicationTaskProcessorStartFailedEvent(p.logger, err)
+		return err
+	}
+	p.retryProducer = retryProducer
+
+	dlqProducer, err := p.client.NewDLQProducer(p.currentCluster)
+	if err != nil {
+		logging.LogReplicationTaskProcessorStartFailedEvent(p.logger, err)
+		return err
+	}
+	p.dlqProducer = dlqProducer
+
 	consumer, err := p.client.NewConsumer(p.currentCluster, p.sourceCluster, p.consumerName, p.config.ReplicatorConcurrency)
 	if err != nil {
 		logging.LogReplicationTaskProcessorStartFailedEvent(p.logger, err)
@@ -114,8 +144,9 @@ func (p *replicationTaskProcessor) Start() error {
 	}
 
 	p.consumer = consumer
-	p.shutdownWG.Add(1)
+	p.shutdownWG.Add(2)
 	go p.processorPump()
+	go p.delayPump()
 
 	logging.LogReplicationTaskProcessorStartedEvent(p.logger)
 	return nil
@@ -157,6 +188,8 @@ func (p *replicationTaskProcessor) processorPump() {
 	if success := common.AwaitWaitGroup(&workerWG, 10*time.Second); !success {
 		p.logger.Warn("Replication task processor timed out on worker shutdown.")
 	}
+	p.retryProducer.Close()
+	p.dlqProducer.Close()
 }
 
 func (p *replicationTaskProcessor) worker(workerWG *sync.WaitGroup) {
@@ -170,71 +203,9 @@ func (p *replicationTaskProcessor) worker(workerWG *sync.WaitGroup) {
 				return // channel closed
 			}
 
-			p.metricsClient.IncCounter(metrics.ReplicatorScope, metrics.ReplicatorMessages)
-			sw := p.metricsClient.StartTimer(metrics.ReplicatorScope, metrics.ReplicatorLatency)
-
-			// TODO: We skip over any messages which cannot be deserialized.  Figure out DLQ story for corrupted messages.
-			task, err := deserialize(msg.Value())
-			if err != nil {
-				err = fmt.Errorf("Deserialize Error. Value: %v, Error: %v", string(msg.Value()), err)
-			} else {
-
-				// TODO: We need to figure out DLQ story for corrupted payload
-				if task.TaskType == nil {
-					err = ErrEmptyReplicationTask
-				} else {
-					switch task.GetTaskType() {
-					case replicator.ReplicationTaskTypeDomain:
-						p.logger.Debugf("Received domain replication task %v.", task.DomainTaskAttributes)
-						err = p.domainReplicator.HandleReceivingTask(task.DomainTaskAttributes)
-					case replicator.ReplicationTaskTypeHistory:
-					ApplyLoop:
-						for {
-							err = p.historyClient.ReplicateEvents(context.Background(), &h.ReplicateEventsRequest{
-								SourceCluster: common.StringPtr(p.sourceCluster),
-								DomainUUID:    task.HistoryTaskAttributes.DomainId,
-								WorkflowExecution: &shared.WorkflowExecution{
-									WorkflowId: task.HistoryTaskAttributes.WorkflowId,
-									RunId:      task.HistoryTaskAttributes.RunId,
-								},
-								FirstEventId:    task.HistoryTaskAttributes.FirstEventId,
-								NextEventId:     task.HistoryTaskAttributes.NextEventId,
-								Version:         task.HistoryTaskAttributes.Version,
-								ReplicationInfo: task.HistoryTaskAttributes.ReplicationInfo,
-								History:         task.HistoryTaskAttributes.History,
-								NewRunHistory:   task.HistoryTaskAttributes.NewRunHistory,
-							})
-
-							// ReplicateEvents succeeded, break out of the loop and complete task
-							if err == nil {
-								break ApplyLoop
-							}
-
-							// ReplicateEvents failed with some error other than workflow execution not exist
-							// break out of the loop and nack the task to move to DLQ
-							if _, ok := err.(*shared.EntityNotExistsError); !ok {
-								break ApplyLoop
-							}
-
-							// TODO: If failed with EntityNotExistsError, then move the task to retry queue
-							// Let's wait for create execution task to be replicated and try again
-							time.Sleep(20 * time.Millisecond)
-						}
-
-					default:
-						err = ErrUnknownReplicationTask
-					}
-				}
-			}
-
-			if err != nil {
-				p.logger.WithField(logging.TagErr, err).Error("Error processing replication task.")
-				p.metricsClient.IncCounter(metrics.ReplicatorScope, metrics.ReplicatorFailures)
-				msg.Nack()
-			} else {
-				msg.Ack()
-			}
-			sw.Stop()
+			p.handleMessage(msg)
+		case msg := <-p.dueCh:
+			p.handleMessage(msg)
 		case <-p.consumer.Closed():
 			p.logger.Info("Consumer closed. Processor shutting down.")
 			return
@@ -242,11 +213,259 @@ func (p *replicationTaskProcessor) worker(workerWG *sync.WaitGroup) {
 	}
 }
 
-func deserialize(payload []byte) (*replicator.ReplicationTask, error) {
+func (p *replicationTaskProcessor) handleMessage(msg messaging.Message) {
+	p.metricsClient.IncCounter(metrics.ReplicatorScope, metrics.ReplicatorMessages)
+	sw := p.metricsClient.StartTimer(metrics.ReplicatorScope, metrics.ReplicatorLatency)
+	defer sw.Stop()
+
+	task, failedTask, err := deserialize(msg.Value())
+	if err != nil {
+		// messages which cannot be deserialized will never apply, move them to the dlq right away
+		err = fmt.Errorf("Deserialize Error: %v", err)
+		p.moveToDLQ(msg, &messaging.FailedReplicationTask{
+			SourceCluster: p.sourceCluster,
+			Payload:       msg.Value(),
+			Partition:     msg.Partition(),
+			Offset:        msg.Offset(),
+		}, err)
+		return
+	}
+
+	attempt := 0
+	partition, offset := msg.Partition(), msg.Offset()
+	if failedTask != nil {
+		if failedTask.SourceCluster != p.sourceCluster {
+			// the retry topic is shared by the processors of all the source clusters
+			msg.Ack()
+			return
+		}
+
+		if task == nil {
+			// the raw payload of a message which is not a replication task, replayed from the dlq
+			p.moveToDLQ(msg, failedTask, ErrEmptyReplicationTask)
+			return
+		}
+
+		attempt = failedTask.Attempt
+		partition, offset = failedTask.Partition, failedTask.Offset
+		if dueTime := time.Unix(0, failedTask.NextAttemptTimestamp); time.Now().Before(dueTime) {
+			// the backoff of the task has not expired yet, the worker moves on to the next message
+			p.delay(msg, dueTime)
+			return
+		}
+	}
+
+	err = p.applyTask(task)
+	if err == nil {
+		p.updateLagMetric(task)
+		msg.Ack()
+		return
+	}
+
+	p.logger.Error("Error processing replication task.", tag.Error(err), tag.Attempt(int64(attempt)))
+	p.metricsClient.IncCounter(metrics.ReplicatorScope, metrics.ReplicatorFailures)
+
+	failedTask = &messaging.FailedReplicationTask{
+		SourceCluster: p.sourceCluster,
+		Task:          task,
+		Partition:     partition,
+		Offset:        offset,
+		Attempt:       attempt + 1,
+	}
+	if isPoisonTaskError(err) || failedTask.Attempt >= p.config.ReplicationTaskMaxRetryCount {
+		p.moveToDLQ(msg, failedTask, err)
+		return
+	}
+	p.moveToRetry(msg, failedTask, err)
+}
+
+func (p *replicationTaskProcessor) applyTask(task *replicator.ReplicationTask) error {
+	if task.TaskType == nil {
+		return ErrEmptyReplicationTask
+	}
+
+	switch task.GetTaskType() {
+	case replicator.ReplicationTaskTypeDomain:
+		p.logger.Debug("Received domain replication task.", tag.Value(task.DomainTaskAttributes))
+
+		return p.domainReplicator.HandleReceivingTask(task.DomainTaskAttributes)
+	case replicator.ReplicationTaskTypeHistory:
+		// ReplicateEvents failing with EntityNotExistsError means the task creating the workflow execution is
+		// not replicated yet, the task goes through the retry topic until it is
+		return p.historyClient.ReplicateEvents(context.Background(), &h.ReplicateEventsRequest{
+			SourceCluster: common.StringPtr(p.sourceCluster),
+			DomainUUID:    task.HistoryTaskAttributes.DomainId,
+			WorkflowExecution: &shared.WorkflowExecution{
+				WorkflowId: task.HistoryTaskAttributes.WorkflowId,
+				RunId:      task.HistoryTaskAttributes.RunId,
+			},
+			FirstEventId:    task.HistoryTaskAttributes.FirstEventId,
+			NextEventId:     task.HistoryTaskAttributes.NextEventId,
+			Version:         task.HistoryTaskAttributes.Version,
+			ReplicationInfo: task.HistoryTaskAttributes.ReplicationInfo,
+			History:         task.HistoryTaskAttributes.History,
+			NewRunHistory:   task.HistoryTaskAttributes.NewRunHistory,
+		})
+	default:
+		return ErrUnknownReplicationTask
+	}
+}
+
+// updateLagMetric reports the time elapsed since the last event of an applied history task was created on the
+// source cluster
+func (p *replicationTaskProcessor) updateLagMetric(task *replicator.ReplicationTask) {
+	if task.GetTaskType() != replicator.ReplicationTaskTypeHistory || task.HistoryTaskAttributes.History == nil {
+		return
+	}
+
+	events := task.HistoryTaskAttributes.History.Events
+	if len(events) == 0 {
+		return
+	}
+	lastEventTime := time.Unix(0, events[len(events)-1].GetTimestamp())
+	p.metricsClient.RecordTimer(metrics.ReplicatorScope, metrics.ReplicatorLag, time.Since(lastEventTime))
+}
+
+// delay holds a message until its due time.  The message is neither acked nor nacked if the processor stops
+// before then, a nack would move it to the dlq, so it is delivered again once the consumer restarts.
+func (p *replicationTaskProcessor) delay(msg messaging.Message, dueTime time.Time) {
+	p.delayedLock.Lock()
+	defer p.delayedLock.Unlock()
+
+	if p.delayStopped {
+		// processor is shutting down, leave the message unacked for the next consumer
+		return
+	}
+
+	i := sort.Search(len(p.delayedMessages), func(i int) bool {
+		return p.delayedMessages[i].dueTime.After(dueTime)
+	})
+	p.delayedMessages = append(p.delayedMessages, nil)
+	copy(p.delayedMessages[i+1:], p.delayedMessages[i:])
+	p.delayedMessages[i] = &delayedMessage{msg: msg, dueTime: dueTime}
+
+	select {
+	case p.delayedCh <- struct{}{}:
+	default:
+	}
+}
+
+// delayPump hands the delayed messages back to the workers once they are due, the messages still delayed on
+// shutdown are left unacked
+func (p *replicationTaskProcessor) delayPump() {
+	defer p.shutdownWG.Done()
+	defer p.stopDelay()
+
+	for {
+		msg, wait := p.nextDueMessage()
+		if msg != nil {
+			select {
+			case p.dueCh <- msg:
+			case <-p.shutdownCh:
+				return
+			}
+			continue
+		}
+
+		var timer *time.Timer
+		var timerCh <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			timerCh = timer.C
+		}
+		select {
+		case <-timerCh:
+		case <-p.delayedCh:
+		case <-p.shutdownCh:
+			return
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// nextDueMessage removes and returns the first delayed message if it is due, otherwise it returns how long
+// until it is, or zero if there is no delayed message
+func (p *replicationTaskProcessor) nextDueMessage() (messaging.Message, time.Duration) {
+	p.delayedLock.Lock()
+	defer p.delayedLock.Unlock()
+
+	if len(p.delayedMessages) == 0 {
+		return nil, 0
+	}
+	first := p.delayedMessages[0]
+	if wait := first.dueTime.Sub(time.Now()); wait > 0 {
+		return nil, wait
+	}
+	p.delayedMessages[0] = nil
+	p.delayedMessages = p.delayedMessages[1:]
+	return first.msg, 0
+}
+
+func (p *replicationTaskProcessor) stopDelay() {
+	p.delayedLock.Lock()
+	defer p.delayedLock.Unlock()
+
+	p.delayStopped = true
+	p.delayedMessages = nil
+}
+
+func (p *replicationTaskProcessor) moveToRetry(msg messaging.Message, task *messaging.FailedReplicationTask, err error) {
+	task.Error = err.Error()
+	task.NextAttemptTimestamp = time.Now().Add(p.retryPolicy.ComputeNextDelay(0, task.Attempt)).UnixNano()
+	if err := p.retryProducer.Publish(task); err != nil {
+		p.logger.Error("Failed to publish replication task to retry topic.", tag.Error(err))
+		msg.Nack()
+		return
+	}
+
+	p.metricsClient.IncCounter(metrics.ReplicatorScope, metrics.ReplicatorRetries)
+	msg.Ack()
+}
+
+func (p *replicationTaskProcessor) moveToDLQ(msg messaging.Message, task *messaging.FailedReplicationTask, err error) {
+	p.logger.Error("Moving replication task to dlq.", tag.Error(err), tag.Attempt(int64(task.Attempt)))
+
+	task.Error = err.Error()
+	if err := p.dlqProducer.Publish(task); err != nil {
+		p.logger.Error("Failed to publish replication task to dlq.", tag.Error(err))
+		msg.Nack()
+		return
+	}
+
+	p.metricsClient.IncCounter(metrics.ReplicatorScope, metrics.ReplicatorDLQMessages)
+	msg.Ack()
+}
+
+// isPoisonTaskError tells whether the task can never apply, so there is no point in retrying it
+func isPoisonTaskError(err error) bool {
+	switch err.(type) {
+	case *shared.BadRequestError:
+		return true
+	}
+	return err == ErrEmptyReplicationTask || err == ErrUnknownReplicationTask
+}
+
+// deserialize decodes a message of the replication topic, or of the retry topic where the replication task is
+// wrapped with its failed attempts.  The task is nil for a wrapped payload which is not a replication task.
+func deserialize(payload []byte) (*replicator.ReplicationTask, *messaging.FailedReplicationTask, error) {
+	var failedTask messaging.FailedReplicationTask
+	if err := json.Unmarshal(payload, &failedTask); err == nil && failedTask.SourceCluster != "" {
+		if failedTask.Task == nil && len(failedTask.Payload) > 0 {
+			// a raw message of the replication topic, replayed from the dlq
+			var task replicator.ReplicationTask
+			if err := json.Unmarshal(failedTask.Payload, &task); err == nil && task.TaskType != nil {
+				failedTask.Task = &task
+			}
+		}
+		return failedTask.Task, &failedTask, nil
+	}
+
 	var task replicator.ReplicationTask
 	if err := json.Unmarshal(payload, &task); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &task, nil
+	return &task, nil, nil
 }
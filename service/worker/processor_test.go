@@ -0,0 +1,350 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+)
+
+type (
+	replicationTaskProcessorSuite struct {
+		suite.Suite
+		*require.Assertions
+		historyClient *mocks.HistoryClient
+		client        *fakeMessagingClient
+		processor     *replicationTaskProcessor
+	}
+
+	// fakeMessagingClient hands out a consumer fed by the test and producers recording the published tasks
+	fakeMessagingClient struct {
+		consumer      *fakeConsumer
+		retryProducer *fakeFailedTaskProducer
+		dlqProducer   *fakeFailedTaskProducer
+	}
+
+	fakeConsumer struct {
+		messages  chan messaging.Message
+		closed    chan struct{}
+		closeOnce sync.Once
+	}
+
+	fakeFailedTaskProducer struct {
+		tasks chan *messaging.FailedReplicationTask
+	}
+
+	// fakeMessage reports on handled whether it is acked or nacked
+	fakeMessage struct {
+		value   []byte
+		handled chan string
+	}
+)
+
+const (
+	testCurrentCluster = "standby"
+	testSourceCluster  = "active"
+	testMaxAttempts    = 3
+	messageAcked       = "ack"
+	messageNacked      = "nack"
+)
+
+func TestReplicationTaskProcessorSuite(t *testing.T) {
+	suite.Run(t, new(replicationTaskProcessorSuite))
+}
+
+func (s *replicationTaskProcessorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.historyClient = &mocks.HistoryClient{}
+	s.client = &fakeMessagingClient{
+		consumer: &fakeConsumer{
+			messages: make(chan messaging.Message),
+			closed:   make(chan struct{}),
+		},
+		retryProducer: &fakeFailedTaskProducer{tasks: make(chan *messaging.FailedReplicationTask, 10)},
+		dlqProducer:   &fakeFailedTaskProducer{tasks: make(chan *messaging.FailedReplicationTask, 10)},
+	}
+	config := &Config{
+		ReplicatorConcurrency:               1,
+		ReplicationTaskMaxRetryCount:        testMaxAttempts,
+		ReplicationTaskRetryInitialInterval: 10 * time.Millisecond,
+		ReplicationTaskRetryMaxInterval:     100 * time.Millisecond,
+	}
+	s.processor = newReplicationTaskProcessor(testCurrentCluster, testSourceCluster, "test-consumer", s.client, config,
+		logging.NewNopLogger(), metrics.NewClient(tally.NoopScope, metrics.Worker), nil, s.historyClient)
+	s.NoError(s.processor.Start())
+}
+
+func (s *replicationTaskProcessorSuite) TearDownTest() {
+	s.processor.Stop()
+	s.historyClient.AssertExpectations(s.T())
+}
+
+func (s *replicationTaskProcessorSuite) TestDeserializeFailureMovesToDLQ() {
+	msg := s.send([]byte("not a replication task"))
+	s.Equal(messageAcked, s.waitHandled(msg))
+
+	task := s.waitPublished(s.client.dlqProducer)
+	s.Equal(testSourceCluster, task.SourceCluster)
+	s.Nil(task.Task)
+	s.Equal(msg.value, task.Payload)
+	s.Contains(task.Error, "Deserialize Error")
+	s.Empty(s.client.retryProducer.tasks)
+}
+
+func (s *replicationTaskProcessorSuite) TestEntityNotExistsRetries() {
+	s.historyClient.On("ReplicateEvents", mock.Anything, mock.Anything).Return(
+		&shared.EntityNotExistsError{Message: "Workflow execution not found."}).Once()
+
+	task := newTestHistoryTask()
+	msg := s.send(s.serialize(task))
+	s.Equal(messageAcked, s.waitHandled(msg))
+
+	failedTask := s.waitPublished(s.client.retryProducer)
+	s.Equal(testSourceCluster, failedTask.SourceCluster)
+	s.Equal(task, failedTask.Task)
+	s.Equal(1, failedTask.Attempt)
+	s.True(failedTask.NextAttemptTimestamp > time.Now().Add(-time.Second).UnixNano())
+	s.Empty(s.client.dlqProducer.tasks)
+}
+
+func (s *replicationTaskProcessorSuite) TestRetriedTaskApplies() {
+	s.historyClient.On("ReplicateEvents", mock.Anything, mock.Anything).Return(nil).Once()
+
+	msg := s.send(s.serialize(&messaging.FailedReplicationTask{
+		SourceCluster: testSourceCluster,
+		Task:          newTestHistoryTask(),
+		Attempt:       1,
+	}))
+	s.Equal(messageAcked, s.waitHandled(msg))
+	s.Empty(s.client.retryProducer.tasks)
+	s.Empty(s.client.dlqProducer.tasks)
+}
+
+func (s *replicationTaskProcessorSuite) TestReplayedRawTaskApplies() {
+	s.historyClient.On("ReplicateEvents", mock.Anything, mock.Anything).Return(nil).Once()
+
+	msg := s.send(s.serialize(&messaging.FailedReplicationTask{
+		SourceCluster: testSourceCluster,
+		Payload:       s.serialize(newTestHistoryTask()),
+	}))
+	s.Equal(messageAcked, s.waitHandled(msg))
+	s.Empty(s.client.retryProducer.tasks)
+	s.Empty(s.client.dlqProducer.tasks)
+}
+
+func (s *replicationTaskProcessorSuite) TestMaxAttemptsMovesToDLQ() {
+	s.historyClient.On("ReplicateEvents", mock.Anything, mock.Anything).Return(
+		&shared.EntityNotExistsError{Message: "Workflow execution not found."}).Once()
+
+	task := newTestHistoryTask()
+	msg := s.send(s.serialize(&messaging.FailedReplicationTask{
+		SourceCluster: testSourceCluster,
+		Task:          task,
+		Attempt:       testMaxAttempts - 1,
+	}))
+	s.Equal(messageAcked, s.waitHandled(msg))
+
+	failedTask := s.waitPublished(s.client.dlqProducer)
+	s.Equal(task, failedTask.Task)
+	s.Equal(testMaxAttempts, failedTask.Attempt)
+	s.Empty(s.client.retryProducer.tasks)
+}
+
+func (s *replicationTaskProcessorSuite) TestOtherSourceClusterAcked() {
+	msg := s.send(s.serialize(&messaging.FailedReplicationTask{
+		SourceCluster: "other",
+		Task:          newTestHistoryTask(),
+		Attempt:       1,
+	}))
+	s.Equal(messageAcked, s.waitHandled(msg))
+	s.Empty(s.client.retryProducer.tasks)
+	s.Empty(s.client.dlqProducer.tasks)
+}
+
+func (s *replicationTaskProcessorSuite) TestTaskNotDueDoesNotBlockWorker() {
+	s.historyClient.On("ReplicateEvents", mock.Anything, mock.Anything).Return(nil).Twice()
+
+	dueTime := time.Now().Add(300 * time.Millisecond)
+	delayed := s.send(s.serialize(&messaging.FailedReplicationTask{
+		SourceCluster:        testSourceCluster,
+		Task:                 newTestHistoryTask(),
+		Attempt:              1,
+		NextAttemptTimestamp: dueTime.UnixNano(),
+	}))
+	// the only worker handles the next message while the first one waits for its backoff
+	msg := s.send(s.serialize(newTestHistoryTask()))
+	s.Equal(messageAcked, s.waitHandled(msg))
+	s.Empty(delayed.handled)
+
+	s.Equal(messageAcked, s.waitHandled(delayed))
+	s.False(time.Now().Before(dueTime))
+}
+
+func (s *replicationTaskProcessorSuite) TestTaskNotDueUnackedOnStop() {
+	delayed := s.send(s.serialize(&messaging.FailedReplicationTask{
+		SourceCluster:        testSourceCluster,
+		Task:                 newTestHistoryTask(),
+		Attempt:              1,
+		NextAttemptTimestamp: time.Now().Add(time.Hour).UnixNano(),
+	}))
+
+	// a nack would move the message to the dlq, it is left unacked to be delivered again after a restart
+	s.processor.Stop()
+	s.Empty(delayed.handled)
+	s.Empty(s.client.dlqProducer.tasks)
+}
+
+func (s *replicationTaskProcessorSuite) send(value []byte) *fakeMessage {
+	msg := &fakeMessage{value: value, handled: make(chan string, 1)}
+	select {
+	case s.client.consumer.messages <- msg:
+	case <-time.After(5 * time.Second):
+		s.FailNow("replication task processor did not receive the message")
+	}
+	return msg
+}
+
+func (s *replicationTaskProcessorSuite) waitHandled(msg *fakeMessage) string {
+	select {
+	case result := <-msg.handled:
+		return result
+	case <-time.After(5 * time.Second):
+		s.FailNow("replication task processor did not handle the message")
+		return ""
+	}
+}
+
+func (s *replicationTaskProcessorSuite) waitPublished(producer *fakeFailedTaskProducer) *messaging.FailedReplicationTask {
+	select {
+	case task := <-producer.tasks:
+		return task
+	case <-time.After(5 * time.Second):
+		s.FailNow("replication task processor did not publish the task")
+		return nil
+	}
+}
+
+func (s *replicationTaskProcessorSuite) serialize(value interface{}) []byte {
+	payload, err := json.Marshal(value)
+	s.NoError(err)
+	return payload
+}
+
+func newTestHistoryTask() *replicator.ReplicationTask {
+	return &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskTypeHistory.Ptr(),
+		HistoryTaskAttributes: &replicator.HistoryTaskAttributes{
+			DomainId:     common.StringPtr("test-domain-id"),
+			WorkflowId:   common.StringPtr("test-workflow"),
+			RunId:        common.StringPtr("test-run"),
+			FirstEventId: common.Int64Ptr(1),
+			NextEventId:  common.Int64Ptr(3),
+			Version:      common.Int64Ptr(1),
+		},
+	}
+}
+
+func (c *fakeMessagingClient) NewConsumer(currentCluster, sourceCluster, consumerName string,
+	concurrency int) (messaging.Consumer, error) {
+	return c.consumer, nil
+}
+
+func (c *fakeMessagingClient) NewProducer(sourceCluster string) (messaging.Producer, error) {
+	return nil, nil
+}
+
+func (c *fakeMessagingClient) NewRetryProducer(currentCluster string) (messaging.FailedTaskProducer, error) {
+	return c.retryProducer, nil
+}
+
+func (c *fakeMessagingClient) NewDLQProducer(currentCluster string) (messaging.FailedTaskProducer, error) {
+	return c.dlqProducer, nil
+}
+
+func (c *fakeMessagingClient) NewDLQReader(currentCluster string) (messaging.DLQReader, error) {
+	return nil, nil
+}
+
+func (c *fakeMessagingClient) IsPullBased() bool {
+	return false
+}
+
+func (c *fakeConsumer) Start() error {
+	return nil
+}
+
+func (c *fakeConsumer) Stop() {
+	c.closeOnce.Do(func() { close(c.closed) })
+}
+
+func (c *fakeConsumer) Messages() <-chan messaging.Message {
+	return c.messages
+}
+
+func (c *fakeConsumer) Closed() <-chan struct{} {
+	return c.closed
+}
+
+func (p *fakeFailedTaskProducer) Publish(task *messaging.FailedReplicationTask) error {
+	p.tasks <- task
+	return nil
+}
+
+func (p *fakeFailedTaskProducer) Close() error {
+	return nil
+}
+
+func (m *fakeMessage) Value() []byte {
+	return m.value
+}
+
+func (m *fakeMessage) Partition() int32 {
+	return 0
+}
+
+func (m *fakeMessage) Offset() int64 {
+	return 0
+}
+
+func (m *fakeMessage) Ack() error {
+	m.handled <- messageAcked
+	return nil
+}
+
+func (m *fakeMessage) Nack() error {
+	m.handled <- messageNacked
+	return nil
+}
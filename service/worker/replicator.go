@@ -23,10 +23,10 @@ package worker
 import (
 	"fmt"
 
-	"github.com/uber-common/bark"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -41,18 +41,16 @@ type (
 		config           *Config
 		client           messaging.Client
 		processors       []*replicationTaskProcessor
-		logger           bark.Logger
+		logger           logging.Logger
 		metricsClient    metrics.Client
 	}
 )
 
 // NewReplicator creates a new replicator for processing replication tasks
 func NewReplicator(clusterMetadata cluster.Metadata, metadataManager persistence.MetadataManager,
-	historyClient history.Client, config *Config, client messaging.Client, logger bark.Logger,
+	historyClient history.Client, config *Config, client messaging.Client, logger logging.Logger,
 	metricsClient metrics.Client) *Replicator {
-	logger = logger.WithFields(bark.Fields{
-		logging.TagWorkflowComponent: logging.TagValueReplicatorComponent,
-	})
+	logger = logger.WithTags(tag.ComponentReplicator)
 	return &Replicator{
 		clusterMetadata:  clusterMetadata,
 		domainReplicator: NewDomainReplicator(metadataManager, logger),
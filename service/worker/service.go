@@ -21,7 +21,10 @@
 package worker
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -42,6 +45,12 @@ type (
 	Config struct {
 		// Replicator settings
 		ReplicatorConcurrency int
+		// ReplicationTaskMaxRetryCount is the number of attempts before a replication task is moved to the dlq
+		ReplicationTaskMaxRetryCount int
+		// ReplicationTaskRetryInitialInterval is the backoff before the first retry of a replication task
+		ReplicationTaskRetryInitialInterval time.Duration
+		// ReplicationTaskRetryMaxInterval is the cap of the backoff between retries of a replication task
+		ReplicationTaskRetryMaxInterval time.Duration
 	}
 )
 
@@ -57,7 +66,10 @@ func NewService(params *service.BootstrapParams) common.Daemon {
 // NewConfig builds the new Config for cadence-worker service
 func NewConfig() *Config {
 	return &Config{
-		ReplicatorConcurrency: 10,
+		ReplicatorConcurrency:               10,
+		ReplicationTaskMaxRetryCount:        10,
+		ReplicationTaskRetryInitialInterval: 50 * time.Millisecond,
+		ReplicationTaskRetryMaxInterval:     time.Minute,
 	}
 }
 
@@ -66,39 +78,34 @@ func (s *Service) Start() {
 	p := s.params
 	log := p.Logger
 
-	log.Infof("%v starting", common.WorkerServiceName)
+	log.Info("service starting", tag.Service(common.WorkerServiceName))
 	base := service.New(p)
 	base.Start()
 
 	s.metricsClient = base.GetMetricsClient()
 
-	metadataManager, err := persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.ClusterMetadata.GetCurrentClusterName(),
-		p.Logger)
+	pFactory := persistence.NewFactory(&p.PersistenceConfig, &p.CassandraConfig,
+		p.ClusterMetadata.GetCurrentClusterName(), p.Logger)
+	metadataManager, err := pFactory.NewMetadataManager()
 
 	if err != nil {
-		log.Fatalf("failed to create metadata manager: %v", err)
+		log.Fatal("failed to create metadata manager", tag.Error(err))
 	}
-	metadataManager = persistence.NewMetadataPersistenceClient(metadataManager, base.GetMetricsClient())
+	metadataManager = persistence.NewMetadataPersistenceClient(metadataManager, base.GetMetricsClient(), base.GetTracer())
 
 	history, err := base.GetClientFactory().NewHistoryClient()
 	if err != nil {
-		log.Fatalf("failed to create history service client: %v", err)
+		log.Fatal("failed to create history service client", tag.Error(err))
 	}
 
 	replicator := NewReplicator(p.ClusterMetadata, metadataManager, history, s.config, p.MessagingClient, log,
 		s.metricsClient)
 	if err := replicator.Start(); err != nil {
 		replicator.Stop()
-		log.Fatalf("Fail to start replicator: %v", err)
+		log.Fatal("Fail to start replicator", tag.Error(err))
 	}
 
-	log.Infof("%v started", common.WorkerServiceName)
+	log.Info("service started", tag.Service(common.WorkerServiceName))
 	<-s.stopC
 	base.Stop()
 }
@@ -109,5 +116,5 @@ func (s *Service) Stop() {
 	case s.stopC <- struct{}{}:
 	default:
 	}
-	s.params.Logger.Infof("%v stopped", common.WorkerServiceName)
+	s.params.Logger.Info("service stopped", tag.Service(common.WorkerServiceName))
 }
@@ -25,7 +25,7 @@ import (
 	"github.com/gocql/gocql"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
 	"io/ioutil"
 	"math/rand"
 	"os"
@@ -42,7 +42,7 @@ type (
 		keyspace string
 		session  *gocql.Session
 		client   CQLClient
-		log      bark.Logger
+		log      logging.Logger
 	}
 )
 
@@ -55,7 +55,7 @@ func (s *CQLClientTestSuite) SetupTest() {
 }
 
 func (s *CQLClientTestSuite) SetupSuite() {
-	s.log = bark.NewLoggerFromLogrus(log.New())
+	s.log = logging.NewDevelopmentLogger()
 	rand := rand.New(rand.NewSource(time.Now().UnixNano()))
 	s.keyspace = fmt.Sprintf("cql_client_test_%v", rand.Int63())
 
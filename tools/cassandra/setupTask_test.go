@@ -25,7 +25,7 @@ import (
 	"github.com/gocql/gocql"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
 	"math/rand"
 	"testing"
 	"time"
@@ -44,7 +44,7 @@ type (
 		keyspace string
 		session  *gocql.Session
 		client   CQLClient
-		log      bark.Logger
+		log      logging.Logger
 	}
 )
 
@@ -57,7 +57,7 @@ func (s *SetupSchemaTestSuite) SetupTest() {
 }
 
 func (s *SetupSchemaTestSuite) SetupSuite() {
-	s.log = bark.NewLoggerFromLogrus(log.New())
+	s.log = logging.NewDevelopmentLogger()
 	s.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
 	s.keyspace = fmt.Sprintf("setup_schema_test_%v", s.rand.Int63())
 
@@ -32,7 +32,8 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/logging/tag"
 )
 
 type (
@@ -43,7 +44,7 @@ type (
 		keyspace string
 		session  *gocql.Session
 		client   CQLClient
-		log      bark.Logger
+		log      logging.Logger
 	}
 )
 
@@ -57,7 +58,7 @@ func (s *UpdateSchemaTestSuite) SetupTest() {
 
 func (s *UpdateSchemaTestSuite) SetupSuite() {
 
-	s.log = bark.NewLoggerFromLogrus(log.New())
+	s.log = logging.NewDevelopmentLogger()
 	s.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
 	s.keyspace = fmt.Sprintf("update_schema_test_%v", s.rand.Int63())
 
@@ -129,8 +130,8 @@ func (s *UpdateSchemaTestSuite) TestDryrun() {
 	ver, err := client.ReadSchemaVersion()
 	s.Nil(err)
 	// update the version to the latest
-	s.log.Infof("Ver: %v", ver)
-	s.Equal(0, cmpVersion(ver, "0.7"))
+	s.log.Info("Ver", tag.Value(ver))
+	s.Equal(0, cmpVersion(ver, "0.12"))
 
 	dropAllTablesTypes(client)
 }
@@ -0,0 +1,144 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newAdminWorkflowCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe internal information of workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeWorkflow(c)
+			},
+		},
+	}
+}
+
+func newAdminHistoryHostCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe internal information of history host",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagHostAddressWithAlias,
+					Usage: "History Host address(IP:PORT)",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeHistoryHost(c)
+			},
+		},
+	}
+}
+
+func newAdminDLQCommands() []cli.Command {
+	flags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagConfigFile,
+			Usage: "Cadence server config file with the kafka section",
+		},
+		cli.StringFlag{
+			Name:  FlagCluster,
+			Usage: "Cadence cluster owning the dlq",
+		},
+	}
+	return []cli.Command{
+		{
+			Name:  "read",
+			Usage: "Print the replication tasks in the dlq",
+			Flags: append(flags, cli.IntFlag{
+				Name:  FlagMaxCountWithAlias,
+				Value: defaultMaxCountForDLQ,
+				Usage: "Maximum number of tasks to print",
+			}),
+			Action: func(c *cli.Context) {
+				AdminReadDLQ(c)
+			},
+		},
+		{
+			Name:  "replay",
+			Usage: "Move the replication tasks in the dlq back to the retry topic",
+			Flags: append(flags,
+				cli.IntFlag{
+					Name:  FlagMaxCountWithAlias,
+					Value: defaultMaxCountForDLQ,
+					Usage: "Maximum number of tasks to replay",
+				},
+				cli.StringFlag{
+					Name:  FlagSourceCluster,
+					Usage: "Source cluster of the raw replication tasks, which the dlq holds without their source cluster",
+				}),
+			Action: func(c *cli.Context) {
+				AdminReplayDLQ(c)
+			},
+		},
+		{
+			Name:  "purge",
+			Usage: "Drop all the replication tasks in the dlq",
+			Flags: flags,
+			Action: func(c *cli.Context) {
+				AdminPurgeDLQ(c)
+			},
+		},
+	}
+}
+
+func newAdminClusterCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage:   "Describe the replication lag of the history shards with each remote cluster",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID, all the shards are described if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeReplicationStatus(c)
+			},
+		},
+	}
+}
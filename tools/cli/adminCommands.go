@@ -0,0 +1,304 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/urfave/cli"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+)
+
+// AdminDescribeWorkflow describes the internal information of a workflow execution
+func AdminDescribeWorkflow(c *cli.Context) {
+	adminClient := getAdminServiceClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	resp, err := adminClient.DescribeWorkflowExecution(ctx, &admin.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Describe workflow execution failed", err)
+	}
+
+	fmt.Printf("Shard ID: %v\n", resp.GetShardId())
+	fmt.Printf("History host: %v\n", resp.GetHistoryAddr())
+	fmt.Println("Mutable state in cache:")
+	prettyPrintJSONString(resp.GetMutableStateInCache())
+	fmt.Println("Mutable state in database:")
+	prettyPrintJSONString(resp.GetMutableStateInDatabase())
+}
+
+// AdminDescribeHistoryHost describes the internal information of a history host
+func AdminDescribeHistoryHost(c *cli.Context) {
+	adminClient := getAdminServiceClient(c)
+
+	wid := c.String(FlagWorkflowID)
+	sid := c.Int(FlagShardID)
+	addr := c.String(FlagHostAddress)
+
+	flagsSet := 0
+	request := &shared.DescribeHistoryHostRequest{}
+	if len(wid) > 0 {
+		request.ExecutionForHost = &shared.WorkflowExecution{WorkflowId: common.StringPtr(wid)}
+		flagsSet++
+	}
+	if c.IsSet(FlagShardID) {
+		request.ShardIdForHost = common.Int32Ptr(int32(sid))
+		flagsSet++
+	}
+	if len(addr) > 0 {
+		request.HostAddress = common.StringPtr(addr)
+		flagsSet++
+	}
+	if flagsSet != 1 {
+		ExitIfError(errors.New("exactly one of workflow_id, shard_id and host_address is required"))
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	resp, err := adminClient.DescribeHistoryHost(ctx, request)
+	if err != nil {
+		ErrorAndExit("Describe history host failed", err)
+	}
+
+	prettyPrintJSONObject(resp)
+}
+
+// AdminReadDLQ prints the replication tasks in the dlq of a cluster
+func AdminReadDLQ(c *cli.Context) {
+	handler := getDLQHandler(c)
+
+	msgs, err := handler.Read(c.Int(FlagMaxCount))
+	if err != nil {
+		ErrorAndExit("Read dlq failed", err)
+	}
+
+	for _, msg := range msgs {
+		fmt.Printf("Partition: %v, Offset: %v\n", msg.Partition, msg.Offset)
+		prettyPrintJSONObject(msg.Task)
+	}
+}
+
+// AdminReplayDLQ moves the replication tasks in the dlq of a cluster back to its retry topic
+func AdminReplayDLQ(c *cli.Context) {
+	handler := getDLQHandler(c)
+
+	count, err := handler.Replay(c.Int(FlagMaxCount), c.String(FlagSourceCluster))
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Replay dlq failed after %v tasks", count), err)
+	}
+	fmt.Printf("%v tasks replayed.\n", count)
+}
+
+// AdminPurgeDLQ drops all the replication tasks in the dlq of a cluster
+func AdminPurgeDLQ(c *cli.Context) {
+	handler := getDLQHandler(c)
+
+	if err := handler.Purge(); err != nil {
+		ErrorAndExit("Purge dlq failed", err)
+	}
+	fmt.Println("Dlq purged.")
+}
+
+// AdminDescribeReplicationStatus prints the replication lag of the history shards with each remote cluster
+func AdminDescribeReplicationStatus(c *cli.Context) {
+	adminClient := getAdminServiceClient(c)
+
+	request := &admin.DescribeReplicationStatusRequest{}
+	if c.IsSet(FlagShardID) {
+		request.ShardIds = []int32{int32(c.Int(FlagShardID))}
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	resp, err := adminClient.DescribeReplicationStatus(ctx, request)
+	if err != nil {
+		ErrorAndExit("Describe replication status failed", err)
+	}
+
+	fmt.Printf("Current cluster: %v\n", resp.GetCurrentCluster())
+	fmt.Println(colorMagenta("Lag per shard:"))
+	clusterLags := make(map[string]*replicationLag)
+	table := newReplicationLagTable("Shard")
+	for _, shard := range resp.Shards {
+		for _, cluster := range sortedRemoteClusters(shard) {
+			lag := getReplicationLag(shard, shard.RemoteClusters[cluster])
+			table.Append(append([]string{strconv.Itoa(int(shard.GetShardId())), cluster}, lag.toRow()...))
+			if clusterLag, ok := clusterLags[cluster]; ok {
+				clusterLag.max(lag)
+			} else {
+				clusterLags[cluster] = &lag
+			}
+		}
+	}
+	table.Render()
+
+	fmt.Println(colorMagenta("Lag per remote cluster, over all the shards:"))
+	clusters := make([]string, 0, len(clusterLags))
+	for cluster := range clusterLags {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+	table = newReplicationLagTable()
+	for _, cluster := range clusters {
+		table.Append(append([]string{cluster}, clusterLags[cluster].toRow()...))
+	}
+	table.Render()
+}
+
+type replicationLag struct {
+	// outboundTasks is the task ID distance between the last task created and the last one acked by the remote
+	// cluster, or by the replicator queue when the replication tasks are published to kafka
+	outboundTasks int64
+	// inbound is the time since the last event replicated from the remote cluster was created
+	inbound time.Duration
+	// standbyTimer is the time since the ack level of the timers of the domains active in the remote cluster
+	standbyTimer time.Duration
+}
+
+func getReplicationLag(shard *h.ShardReplicationStatus, status *h.ClusterReplicationStatus) replicationLag {
+	ackLevel := shard.GetReplicationAckLevel()
+	if status.ReplicationAckLevel != nil {
+		ackLevel = status.GetReplicationAckLevel()
+	}
+	return replicationLag{
+		outboundTasks: shard.GetMaxReadLevel() - ackLevel,
+		inbound:       time.Duration(shard.GetTimestamp() - status.GetLastReplicatedTimestamp()),
+		standbyTimer:  time.Duration(shard.GetTimestamp() - status.GetTimerAckLevel()),
+	}
+}
+
+func (l *replicationLag) max(other replicationLag) {
+	if other.outboundTasks > l.outboundTasks {
+		l.outboundTasks = other.outboundTasks
+	}
+	if other.inbound > l.inbound {
+		l.inbound = other.inbound
+	}
+	if other.standbyTimer > l.standbyTimer {
+		l.standbyTimer = other.standbyTimer
+	}
+}
+
+func (l *replicationLag) toRow() []string {
+	return []string{
+		strconv.FormatInt(l.outboundTasks, 10),
+		l.inbound.Round(time.Millisecond).String(),
+		l.standbyTimer.Round(time.Millisecond).String(),
+	}
+}
+
+func newReplicationLagTable(keyColumns ...string) *tablewriter.Table {
+	header := append(keyColumns, "Remote Cluster", "Outbound Task Lag", "Inbound Lag", "Standby Timer Lag")
+	headerColors := make([]tablewriter.Colors, len(header))
+	for i := range headerColors {
+		headerColors[i] = tableHeaderBlue
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader(header)
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(headerColors...)
+	return table
+}
+
+func sortedRemoteClusters(shard *h.ShardReplicationStatus) []string {
+	clusters := make([]string, 0, len(shard.RemoteClusters))
+	for cluster := range shard.RemoteClusters {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+	return clusters
+}
+
+func getDLQHandler(c *cli.Context) *messaging.DLQHandler {
+	configFile := getRequiredOption(c, FlagConfigFile)
+	cluster := getRequiredOption(c, FlagCluster)
+
+	content, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		ErrorAndExit("Read config file failed", err)
+	}
+	var cfg struct {
+		Kafka messaging.KafkaConfig `yaml:"kafka"`
+	}
+	if err := yaml.Unmarshal(content, &cfg); err != nil {
+		ErrorAndExit("Parse config file failed", err)
+	}
+
+	client := cfg.Kafka.NewKafkaClient(zap.NewNop(), logging.NewNopLogger(), tally.NoopScope)
+	return messaging.NewDLQHandler(client, cluster)
+}
+
+func getAdminServiceClient(c *cli.Context) adminserviceclient.Interface {
+	client, err := cBuilder.BuildAdminClient(c)
+	if err != nil {
+		ExitIfError(err)
+	}
+
+	return client
+}
+
+func prettyPrintJSONString(s string) {
+	if len(s) == 0 {
+		fmt.Println("{}")
+		return
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, []byte(s), "", "  "); err != nil {
+		fmt.Println(s)
+		return
+	}
+	fmt.Println(out.String())
+}
@@ -46,6 +46,26 @@ func NewCliApp() *cli.App {
 			Usage:  "cadence workflow domain",
 			EnvVar: "CADENCE_CLI_DOMAIN",
 		},
+		cli.StringFlag{
+			Name:   FlagTLSCertPath,
+			Usage:  "path to the client certificate, for a frontend requiring mutual tls",
+			EnvVar: "CADENCE_CLI_TLS_CERT_PATH",
+		},
+		cli.StringFlag{
+			Name:   FlagTLSKeyPath,
+			Usage:  "path to the private key of the client certificate",
+			EnvVar: "CADENCE_CLI_TLS_KEY_PATH",
+		},
+		cli.StringFlag{
+			Name:   FlagTLSCaPath,
+			Usage:  "path to the CA certificates used to verify the frontend, connect with tls when set",
+			EnvVar: "CADENCE_CLI_TLS_CA_PATH",
+		},
+		cli.StringFlag{
+			Name:   FlagTLSServerName,
+			Usage:  "name used to verify the frontend certificate, defaults to the host of the address",
+			EnvVar: "CADENCE_CLI_TLS_SERVER_NAME",
+		},
 	}
 	app.Commands = []cli.Command{
 		{
@@ -66,6 +86,36 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence tasklist",
 			Subcommands: newTaskListCommands(),
 		},
+		{
+			Name:    "admin",
+			Aliases: []string{"adm"},
+			Usage:   "Run admin operation",
+			Subcommands: []cli.Command{
+				{
+					Name:        "workflow",
+					Aliases:     []string{"wf"},
+					Usage:       "Run admin operation on workflow",
+					Subcommands: newAdminWorkflowCommands(),
+				},
+				{
+					Name:        "history_host",
+					Aliases:     []string{"hh"},
+					Usage:       "Run admin operation on history host",
+					Subcommands: newAdminHistoryHostCommands(),
+				},
+				{
+					Name:        "dlq",
+					Usage:       "Run admin operation on the replication dlq",
+					Subcommands: newAdminDLQCommands(),
+				},
+				{
+					Name:        "cluster",
+					Aliases:     []string{"cl"},
+					Usage:       "Run admin operation on cluster",
+					Subcommands: newAdminClusterCommands(),
+				},
+			},
+		},
 	}
 
 	// set builder if not customized
@@ -27,6 +27,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	"github.com/uber/cadence/.gen/go/history"
+	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
 
@@ -37,19 +42,25 @@ import (
 
 type cliAppSuite struct {
 	suite.Suite
-	app      *cli.App
-	mockCtrl *gomock.Controller
-	service  *workflowservicetest.MockClient
+	app          *cli.App
+	mockCtrl     *gomock.Controller
+	service      *workflowservicetest.MockClient
+	adminService *adminservicetest.MockClient
 }
 
 type workflowClientBuilderMock struct {
-	service workflowserviceclient.Interface
+	service      workflowserviceclient.Interface
+	adminService adminserviceclient.Interface
 }
 
 func (mock *workflowClientBuilderMock) BuildServiceClient(c *cli.Context) (workflowserviceclient.Interface, error) {
 	return mock.service, nil
 }
 
+func (mock *workflowClientBuilderMock) BuildAdminClient(c *cli.Context) (adminserviceclient.Interface, error) {
+	return mock.adminService, nil
+}
+
 // this is the mock for yarpcCallOptions, make sure length are the same
 var callOptions = []interface{}{gomock.Any(), gomock.Any(), gomock.Any()}
 
@@ -57,6 +68,7 @@ var commands = []string{
 	"domain", "d",
 	"workflow", "wf",
 	"tasklist", "tl",
+	"admin", "adm",
 }
 
 var domainName = "cli-test-domain"
@@ -73,7 +85,8 @@ func (s *cliAppSuite) SetupSuite() {
 func (s *cliAppSuite) SetupTest() {
 	s.mockCtrl = gomock.NewController(s.T())
 	s.service = workflowservicetest.NewMockClient(s.mockCtrl)
-	SetBuilder(&workflowClientBuilderMock{service: s.service})
+	s.adminService = adminservicetest.NewMockClient(s.mockCtrl)
+	SetBuilder(&workflowClientBuilderMock{service: s.service, adminService: s.adminService})
 }
 
 func (s *cliAppSuite) TearDownTest() {
@@ -154,6 +167,12 @@ func (s *cliAppSuite) TestDomainUpdate_Failed() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDomainFailover() {
+	s.service.EXPECT().UpdateDomain(gomock.Any(), gomock.Any(), callOptions...).Return(nil, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "failover", "--ac", "standby"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDomainDescribe() {
 	resp := describeDomainResponse
 	s.service.EXPECT().DescribeDomain(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -161,6 +180,19 @@ func (s *cliAppSuite) TestDomainDescribe() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDomainDescribe_Handover() {
+	resp := *describeDomainResponse
+	resp.HandoverInfo = &shared.DomainHandoverInfo{
+		TargetClusterName:       common.StringPtr("standby"),
+		StartTimestamp:          common.Int64Ptr(time.Now().UnixNano()),
+		ExpiryTimestamp:         common.Int64Ptr(time.Now().Add(time.Minute).UnixNano()),
+		PendingReplicationTasks: common.Int64Ptr(10),
+	}
+	s.service.EXPECT().DescribeDomain(gomock.Any(), gomock.Any(), callOptions...).Return(&resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "describe"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDomainDescribe_DomainNotExist() {
 	resp := describeDomainResponse
 	s.service.EXPECT().DescribeDomain(gomock.Any(), gomock.Any(), callOptions...).Return(resp, &shared.EntityNotExistsError{})
@@ -236,6 +268,16 @@ func (s *cliAppSuite) TestStartWorkflow() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestStartWorkflow_CronSchedule() {
+	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	s.service.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *shared.StartWorkflowExecutionRequest, _ ...interface{}) {
+			s.Equal("*/5 * * * *", request.GetCronSchedule())
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "--cron", "*/5 * * * *"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestRunWorkflow() {
 	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
 	history := getWorkflowExecutionHistoryResponse
@@ -359,6 +401,91 @@ func (s *cliAppSuite) TestListWorkflow_PrintRawTime() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestListWorkflow_WithQuery() {
+	resp := &shared.ListWorkflowExecutionsResponse{}
+	s.service.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Do(
+		func(_ interface{}, request *shared.ListWorkflowExecutionsRequest, _ ...interface{}) {
+			s.Equal("WorkflowType = 'X' ORDER BY CloseTime", request.GetQuery())
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list", "-q", "WorkflowType = 'X' ORDER BY CloseTime"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCountWorkflow() {
+	resp := &shared.CountWorkflowExecutionsResponse{
+		Count: common.Int64Ptr(2),
+		Groups: []*shared.CountWorkflowExecutionsGroup{
+			{WorkflowType: common.StringPtr("testWorkflowType"), Count: common.Int64Ptr(2)},
+		},
+	}
+	s.service.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Do(
+		func(_ interface{}, request *shared.CountWorkflowExecutionsRequest, _ ...interface{}) {
+			s.Equal("ExecutionStatus = 'Open'", request.GetQuery())
+			s.Equal(shared.CountWorkflowExecutionsGroupByWorkflowType, request.GetGroupBy())
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "count", "-q", "ExecutionStatus = 'Open'", "-gb", "WorkflowType"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeWorkflow() {
+	resp := &admin.DescribeWorkflowExecutionResponse{
+		ShardId:                common.StringPtr("1"),
+		HistoryAddr:            common.StringPtr("127.0.0.1:7934"),
+		MutableStateInDatabase: common.StringPtr(`{"ExecutionInfo":{"WorkflowID":"wid"}}`),
+	}
+	s.adminService.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *admin.DescribeWorkflowExecutionRequest, _ ...interface{}) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal("wid", request.Execution.GetWorkflowId())
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "describe", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeHistoryHost() {
+	resp := &gen.DescribeHistoryHostResponse{
+		NumberOfShards:        common.Int32Ptr(1),
+		ShardIDs:              []int32{2},
+		ShardControllerStatus: common.StringPtr("started"),
+		Address:               common.StringPtr("127.0.0.1:7934"),
+	}
+	s.adminService.EXPECT().DescribeHistoryHost(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *gen.DescribeHistoryHostRequest, _ ...interface{}) {
+			s.Equal(int32(2), request.GetShardIdForHost())
+			s.Nil(request.HostAddress)
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "hh", "describe", "-sid", "2"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeReplicationStatus() {
+	now := time.Now().UnixNano()
+	resp := &admin.DescribeReplicationStatusResponse{
+		CurrentCluster: common.StringPtr("active"),
+		Shards: []*history.ShardReplicationStatus{
+			{
+				ShardId:             common.Int32Ptr(2),
+				Timestamp:           common.Int64Ptr(now),
+				MaxReadLevel:        common.Int64Ptr(120),
+				ReplicationAckLevel: common.Int64Ptr(100),
+				RemoteClusters: map[string]*history.ClusterReplicationStatus{
+					"standby": {
+						TransferAckLevel:        common.Int64Ptr(80),
+						TimerAckLevel:           common.Int64Ptr(now - int64(time.Minute)),
+						LastReplicatedTimestamp: common.Int64Ptr(now - int64(time.Second)),
+					},
+				},
+			},
+		},
+	}
+	s.adminService.EXPECT().DescribeReplicationStatus(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *admin.DescribeReplicationStatusRequest, _ ...interface{}) {
+			s.Equal([]int32{2}, request.ShardIds)
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "cluster", "replication-status", "-sid", "2"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestListWorkflow_Open() {
 	resp := listOpenWorkflowExecutionsResponse
 	s.service.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
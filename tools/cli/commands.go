@@ -109,6 +109,28 @@ const (
 	FlagActiveClusterNameWithAlias = FlagActiveClusterName + ", ac"
 	FlagClusters                   = "clusters"
 	FlagClustersWithAlias          = FlagClusters + ", cl"
+	FlagCronSchedule               = "cron"
+	FlagListQuery                  = "query"
+	FlagListQueryWithAlias         = FlagListQuery + ", q"
+	FlagGroupBy                    = "group_by"
+	FlagGroupByWithAlias           = FlagGroupBy + ", gb"
+	FlagHostAddress                = "host_address"
+	FlagHostAddressWithAlias       = FlagHostAddress + ", ha"
+	FlagShardID                    = "shard_id"
+	FlagShardIDWithAlias           = FlagShardID + ", sid"
+	FlagTLSCertPath                = "tls_cert_path"
+	FlagTLSKeyPath                 = "tls_key_path"
+	FlagTLSCaPath                  = "tls_ca_path"
+	FlagTLSServerName              = "tls_server_name"
+	FlagConfigFile                 = "config_file"
+	FlagCluster                    = "cluster"
+	FlagSourceCluster              = "source_cluster"
+	FlagMaxCount                   = "max_count"
+	FlagMaxCountWithAlias          = FlagMaxCount + ", mc"
+	FlagGraceful                   = "graceful"
+	FlagGracefulWithAlias          = FlagGraceful + ", g"
+	FlagFailoverTimeout            = "failover_timeout"
+	FlagFailoverTimeoutWithAlias   = FlagFailoverTimeout + ", ft"
 )
 
 const (
@@ -123,6 +145,10 @@ const (
 	defaultContextTimeoutForLongPoll = 2 * time.Minute
 	defaultDecisionTimeoutInSeconds  = 10
 	defaultPageSizeForList           = 500
+	defaultMaxCountForDLQ            = 100
+
+	defaultGracefulFailoverTimeoutInSeconds = 300
+	domainFailoverPollInterval              = 5 * time.Second
 )
 
 // For color output to terminal
@@ -317,6 +343,64 @@ func UpdateDomain(c *cli.Context) {
 	}
 }
 
+// FailoverDomain changes the active cluster of a domain, a graceful failover hands the domain over to the new active
+// cluster once its replication tasks are replicated there
+func FailoverDomain(c *cli.Context) {
+	domainClient := getDomainClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	activeCluster := getRequiredOption(c, FlagActiveClusterName)
+
+	updateRequest := &s.UpdateDomainRequest{
+		Name: common.StringPtr(domain),
+		ReplicationConfiguration: &s.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(activeCluster),
+		},
+	}
+	graceful := c.Bool(FlagGraceful)
+	if graceful {
+		timeout := defaultGracefulFailoverTimeoutInSeconds
+		if c.IsSet(FlagFailoverTimeout) {
+			timeout = c.Int(FlagFailoverTimeout)
+		}
+		updateRequest.GracefulFailoverTimeoutInSeconds = common.Int32Ptr(int32(timeout))
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+	if err := domainClient.Update(ctx, updateRequest); err != nil {
+		ErrorAndExit("Failover domain failed", err)
+	}
+	if !graceful {
+		fmt.Printf("Domain %s successfully failed over to %s.\n", domain, activeCluster)
+		return
+	}
+
+	fmt.Printf("Handing domain %s over to %s, new workflows and signals are rejected until the handover completes.\n",
+		domain, activeCluster)
+	for {
+		time.Sleep(domainFailoverPollInterval)
+
+		ctx, cancel := newContext()
+		resp, err := domainClient.Describe(ctx, domain)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Describe domain failed", err)
+		}
+
+		if resp.ReplicationConfiguration.GetActiveClusterName() == activeCluster {
+			fmt.Printf("Domain %s successfully failed over to %s.\n", domain, activeCluster)
+			return
+		}
+		handoverInfo := resp.HandoverInfo
+		if handoverInfo == nil || handoverInfo.GetTargetClusterName() != activeCluster {
+			ErrorAndExit("Graceful failover did not complete", fmt.Errorf("domain %s is still active in %s",
+				domain, resp.ReplicationConfiguration.GetActiveClusterName()))
+		}
+		fmt.Printf("Pending replication tasks: %v, handover expires at %v.\n",
+			handoverInfo.GetPendingReplicationTasks(), convertTime(handoverInfo.GetExpiryTimestamp(), false))
+	}
+}
+
 // DescribeDomain updates a domain
 func DescribeDomain(c *cli.Context) {
 	domainClient := getDomainClient(c)
@@ -342,6 +426,13 @@ func DescribeDomain(c *cli.Context) {
 			resp.Configuration.GetEmitMetric(),
 			resp.ReplicationConfiguration.GetActiveClusterName(),
 			clustersToString(resp.ReplicationConfiguration.Clusters))
+		if handoverInfo := resp.HandoverInfo; handoverInfo != nil {
+			fmt.Printf("HandoverCluster: %v\nHandoverStartTime: %v\nHandoverExpiryTime: %v\nPendingReplicationTasks: %v\n",
+				handoverInfo.GetTargetClusterName(),
+				convertTime(handoverInfo.GetStartTimestamp(), false),
+				convertTime(handoverInfo.GetExpiryTimestamp(), false),
+				handoverInfo.GetPendingReplicationTasks())
+		}
 	}
 }
 
@@ -428,7 +519,7 @@ func StartWorkflow(c *cli.Context) {
 	tcCtx, cancel := newContext()
 	defer cancel()
 
-	resp, err := serviceClient.StartWorkflowExecution(tcCtx, &s.StartWorkflowExecutionRequest{
+	startRequest := &s.StartWorkflowExecutionRequest{
 		RequestId:  common.StringPtr(uuid.New()),
 		Domain:     common.StringPtr(domain),
 		WorkflowId: common.StringPtr(wid),
@@ -442,7 +533,12 @@ func StartWorkflow(c *cli.Context) {
 		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(et)),
 		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(dt)),
 		Identity:                            common.StringPtr(getCliIdentity()),
-	})
+	}
+	if c.IsSet(FlagCronSchedule) {
+		startRequest.CronSchedule = common.StringPtr(c.String(FlagCronSchedule))
+	}
+
+	resp, err := serviceClient.StartWorkflowExecution(tcCtx, startRequest)
 
 	if err != nil {
 		ErrorAndExit("Failed to create workflow", err)
@@ -743,6 +839,62 @@ func ListAllWorkflow(c *cli.Context) {
 	table.Render()
 }
 
+// CountWorkflow count workflow executions matching the query, optionally grouped by workflow type or close status
+func CountWorkflow(c *cli.Context) {
+	serviceClient := getWorkflowServiceClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	request := &s.CountWorkflowExecutionsRequest{
+		Domain: common.StringPtr(domain),
+		Query:  common.StringPtr(c.String(FlagListQuery)),
+	}
+	groupBy := c.String(FlagGroupBy)
+	switch strings.ToLower(groupBy) {
+	case "":
+	case "workflowtype":
+		request.GroupBy = s.CountWorkflowExecutionsGroupByWorkflowType.Ptr()
+	case "closestatus":
+		request.GroupBy = s.CountWorkflowExecutionsGroupByCloseStatus.Ptr()
+	default:
+		ExitIfError(fmt.Errorf("invalid %v %q, supported values are WorkflowType and CloseStatus", FlagGroupBy, groupBy))
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+	response, err := serviceClient.CountWorkflowExecutions(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to count workflow executions", err)
+	}
+
+	fmt.Printf("Total: %d\n", response.GetCount())
+	if request.GroupBy == nil {
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	if request.GetGroupBy() == s.CountWorkflowExecutionsGroupByWorkflowType {
+		table.SetHeader([]string{"Workflow Type", "Count"})
+	} else {
+		table.SetHeader([]string{"Close Status", "Count"})
+	}
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
+	for _, group := range response.Groups {
+		var value string
+		if request.GetGroupBy() == s.CountWorkflowExecutionsGroupByWorkflowType {
+			value = trimWorkflowType(group.GetWorkflowType())
+		} else if group.CloseStatus == nil {
+			value = "OPEN"
+		} else {
+			value = group.GetCloseStatus().String()
+		}
+		table.Append([]string{value, fmt.Sprintf("%d", group.GetCount())})
+	}
+	table.Render()
+}
+
 // DescribeWorkflow show information about the specified workflow execution
 func DescribeWorkflow(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
@@ -883,10 +1035,18 @@ func listWorkflow(c *cli.Context, table *tablewriter.Table) func([]byte) ([]byte
 		ExitIfError(errors.New("you can filter on workflow_id or workflow_type, but not on both"))
 	}
 
+	query := c.String(FlagListQuery)
+	if c.IsSet(FlagListQuery) && (queryOpen || len(workflowID) > 0 || len(workflowType) > 0 ||
+		c.IsSet(FlagEarliestTime) || c.IsSet(FlagLatestTime)) {
+		ExitIfError(errors.New("the query cannot be combined with the other filters, add them to the query instead"))
+	}
+
 	prepareTable := func(next []byte) ([]byte, int) {
 		var result []*s.WorkflowExecutionInfo
 		var nextPageToken []byte
-		if queryOpen {
+		if c.IsSet(FlagListQuery) {
+			result, nextPageToken = listWorkflowByQuery(c, pageSize, query, next)
+		} else if queryOpen {
 			result, nextPageToken = listOpenWorkflow(wfClient, pageSize, earliestTime, latestTime, workflowID, workflowType, next)
 		} else {
 			result, nextPageToken = listClosedWorkflow(wfClient, pageSize, earliestTime, latestTime, workflowID, workflowType, next)
@@ -909,6 +1069,28 @@ func listWorkflow(c *cli.Context, table *tablewriter.Table) func([]byte) ([]byte
 	return prepareTable
 }
 
+// listWorkflowByQuery lists both open and closed executions matching the query, this uses the service client
+// because the query API is not exposed by cadence.Client
+func listWorkflowByQuery(c *cli.Context, pageSize int, query string, nextPageToken []byte) ([]*s.WorkflowExecutionInfo, []byte) {
+	serviceClient := getWorkflowServiceClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	request := &s.ListWorkflowExecutionsRequest{
+		Domain:        common.StringPtr(domain),
+		PageSize:      common.Int32Ptr(int32(pageSize)),
+		NextPageToken: nextPageToken,
+		Query:         common.StringPtr(query),
+	}
+
+	ctx, cancel := newContext()
+	defer cancel()
+	response, err := serviceClient.ListWorkflowExecutions(ctx, request)
+	if err != nil {
+		ExitIfError(err)
+	}
+	return response.Executions, response.NextPageToken
+}
+
 func listOpenWorkflow(client client.Client, pageSize int, earliestTime, latestTime int64, workflowID, workflowType string, nextPageToken []byte) ([]*s.WorkflowExecutionInfo, []byte) {
 	request := &s.ListOpenWorkflowExecutionsRequest{
 		MaximumPageSize: common.Int32Ptr(int32(pageSize)),
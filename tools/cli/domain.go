@@ -92,6 +92,31 @@ func newDomainCommands() []cli.Command {
 				UpdateDomain(c)
 			},
 		},
+		{
+			Name:    "failover",
+			Aliases: []string{"fo"},
+			Usage:   "Fail a global domain over to another cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagActiveClusterNameWithAlias,
+					Usage: "Cluster to make active",
+				},
+				cli.BoolFlag{
+					Name: FlagGracefulWithAlias,
+					Usage: "Reject new workflows and signals until the replication of the domain catches up before " +
+						"changing the active cluster, must be run against the current active cluster. Requires the rpc " +
+						"replication transport",
+				},
+				cli.IntFlag{
+					Name:  FlagFailoverTimeoutWithAlias,
+					Value: defaultGracefulFailoverTimeoutInSeconds,
+					Usage: "Seconds after which a graceful failover is abandoned",
+				},
+			},
+			Action: func(c *cli.Context) {
+				FailoverDomain(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
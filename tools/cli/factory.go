@@ -23,6 +23,9 @@ package cli
 import (
 	"errors"
 
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/common/service/config"
+	tcg "github.com/uber/tchannel-go"
 	"github.com/urfave/cli"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
@@ -41,11 +44,13 @@ const (
 // The customized builder may have more processing on Env, Address and other info.
 type WorkflowClientBuilderInterface interface {
 	BuildServiceClient(c *cli.Context) (workflowserviceclient.Interface, error)
+	BuildAdminClient(c *cli.Context) (adminserviceclient.Interface, error)
 }
 
 // WorkflowClientBuilder build client to cadence service
 type WorkflowClientBuilder struct {
 	hostPort   string
+	tls        *config.TLS
 	dispatcher *yarpc.Dispatcher
 	logger     *zap.Logger
 }
@@ -68,6 +73,7 @@ func (b *WorkflowClientBuilder) BuildServiceClient(c *cli.Context) (workflowserv
 	if addr := c.GlobalString(FlagAddress); addr != "" {
 		b.hostPort = addr
 	}
+	b.tls = getTLSConfig(c)
 
 	if err := b.build(); err != nil {
 		return nil, err
@@ -80,6 +86,25 @@ func (b *WorkflowClientBuilder) BuildServiceClient(c *cli.Context) (workflowserv
 	return workflowserviceclient.New(b.dispatcher.ClientConfig(_cadenceFrontendService)), nil
 }
 
+// BuildAdminClient builds a rpc service client to the cadence admin service
+func (b *WorkflowClientBuilder) BuildAdminClient(c *cli.Context) (adminserviceclient.Interface, error) {
+	b.hostPort = localHostPort
+	if addr := c.GlobalString(FlagAddress); addr != "" {
+		b.hostPort = addr
+	}
+	b.tls = getTLSConfig(c)
+
+	if err := b.build(); err != nil {
+		return nil, err
+	}
+
+	if b.dispatcher == nil {
+		b.logger.Fatal("No RPC dispatcher provided to create a connection to Cadence Service")
+	}
+
+	return adminserviceclient.New(b.dispatcher.ClientConfig(_cadenceFrontendService)), nil
+}
+
 func (b *WorkflowClientBuilder) build() error {
 	if b.dispatcher != nil {
 		return nil
@@ -89,8 +114,7 @@ func (b *WorkflowClientBuilder) build() error {
 		return errors.New("HostPort is empty")
 	}
 
-	ch, err := tchannel.NewChannelTransport(
-		tchannel.ServiceName(_cadenceClientName))
+	ch, err := b.newChannelTransport()
 	if err != nil {
 		b.logger.Fatal("Failed to create transport channel", zap.Error(err))
 	}
@@ -110,3 +134,35 @@ func (b *WorkflowClientBuilder) build() error {
 
 	return nil
 }
+
+func (b *WorkflowClientBuilder) newChannelTransport() (*tchannel.ChannelTransport, error) {
+	if b.tls == nil {
+		return tchannel.NewChannelTransport(tchannel.ServiceName(_cadenceClientName))
+	}
+
+	tlsConfig, err := b.tls.NewClientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	ch, err := tcg.NewChannel(_cadenceClientName, &tcg.ChannelOptions{
+		Dialer: config.NewTLSDialer(tlsConfig),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tchannel.NewChannelTransport(tchannel.WithChannel(ch))
+}
+
+// getTLSConfig returns the tls config given by the global flags, or nil when the frontend is called without tls
+func getTLSConfig(c *cli.Context) *config.TLS {
+	tls := &config.TLS{
+		CertFile:   c.GlobalString(FlagTLSCertPath),
+		KeyFile:    c.GlobalString(FlagTLSKeyPath),
+		CAFile:     c.GlobalString(FlagTLSCaPath),
+		ServerName: c.GlobalString(FlagTLSServerName),
+	}
+	if tls.CertFile == "" && tls.CAFile == "" && tls.ServerName == "" {
+		return nil
+	}
+	return tls
+}
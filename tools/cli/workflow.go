@@ -109,6 +109,11 @@ func newWorkflowCommands() []cli.Command {
 					Usage: "Optional input for the workflow from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
 						"Input from file will be overwrite by input from command line",
 				},
+				cli.StringFlag{
+					Name: FlagCronSchedule,
+					Usage: "Optional cron schedule for the workflow, in standard cron format (minute hour day-of-month " +
+						"month day-of-week), e.g. \"*/5 * * * *\" to run the workflow every 5 minutes",
+				},
 			},
 			Action: func(c *cli.Context) {
 				StartWorkflow(c)
@@ -266,6 +271,12 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagWorkflowTypeWithAlias,
 					Usage: "WorkflowTypeName",
 				},
+				cli.StringFlag{
+					Name: FlagListQueryWithAlias,
+					Usage: "List open and closed workflow executions matching the query, e.g. \"WorkflowType = 'X' AND " +
+						"CloseStatus = 'Failed' AND StartTime > '2006-01-02T15:04:05Z' ORDER BY CloseTime\". Only AND is " +
+						"supported to combine predicates, the query cannot be used with the other filters",
+				},
 				cli.BoolFlag{
 					Name:  FlagPrintRawTimeWithAlias,
 					Usage: "Print raw time stamp",
@@ -304,6 +315,12 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagWorkflowTypeWithAlias,
 					Usage: "WorkflowTypeName",
 				},
+				cli.StringFlag{
+					Name: FlagListQueryWithAlias,
+					Usage: "List open and closed workflow executions matching the query, e.g. \"WorkflowType = 'X' AND " +
+						"CloseStatus = 'Failed' AND StartTime > '2006-01-02T15:04:05Z' ORDER BY CloseTime\". Only AND is " +
+						"supported to combine predicates, the query cannot be used with the other filters",
+				},
 				cli.BoolFlag{
 					Name:  FlagPrintRawTimeWithAlias,
 					Usage: "Print raw time stamp",
@@ -317,6 +334,26 @@ func newWorkflowCommands() []cli.Command {
 				ListAllWorkflow(c)
 			},
 		},
+		{
+			Name:        "count",
+			Aliases:     []string{"cnt"},
+			Usage:       "count workflow executions matching a query",
+			Description: "count the open and closed workflow executions, use flag --group_by to count by workflow type or close status",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name: FlagListQueryWithAlias,
+					Usage: "Optional query the executions have to match, e.g. \"WorkflowType = 'X' AND ExecutionStatus = " +
+						"'Open'\", all executions are counted by default",
+				},
+				cli.StringFlag{
+					Name:  FlagGroupByWithAlias,
+					Usage: "Optional field to group the count by, either WorkflowType or CloseStatus",
+				},
+			},
+			Action: func(c *cli.Context) {
+				CountWorkflow(c)
+			},
+		},
 		{
 			Name:  "query",
 			Usage: "query workflow execution",
@@ -0,0 +1,328 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"bufio"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	// mysql driver for database/sql
+	_ "github.com/go-sql-driver/mysql"
+	// postgres driver for database/sql
+	_ "github.com/lib/pq"
+)
+
+type (
+	// SQLClient is the interface for implementations
+	// that provide a way to talk to a sql database
+	SQLClient interface {
+		// Exec executes a sql statement
+		Exec(stmt string) error
+		// ListTables lists the table names in the database
+		ListTables() ([]string, error)
+		// CreateDatabase creates a database, if it doesn't exist
+		CreateDatabase(name string) error
+		// DropTable drops the given table
+		DropTable(name string) error
+		// DropDatabase drops a database
+		DropDatabase(name string) error
+		// CreateSchemaVersionTables sets up the schema version tables
+		CreateSchemaVersionTables() error
+		// ReadSchemaVersion returns the current schema version for the database
+		ReadSchemaVersion() (string, error)
+		// UpdateSchemaVersion updates the schema version for the database
+		UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error
+		// WriteSchemaUpdateLog adds an entry to the schema update history table
+		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		// Close gracefully closes the client object
+		Close()
+	}
+	sqlClient struct {
+		db         *sql.DB
+		driverName string
+		database   string
+	}
+)
+
+var errGetSchemaVersion = errors.New("Failed to get current schema version from sql database")
+
+const (
+	newLineDelim        = '\n'
+	driverMySQL         = "mysql"
+	driverPostgres      = "postgres"
+	defaultMySQLPort    = 3306
+	defaultPostgresPort = 5432
+)
+
+const (
+	readSchemaVersionSQL        = `SELECT curr_version from schema_version where db_name=?`
+	deleteSchemaVersionSQL      = `DELETE from schema_version where db_name=?`
+	writeSchemaVersionSQL       = `INSERT into schema_version(db_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
+	writeSchemaUpdateHistorySQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
+
+	listTablesMySQL    = `SHOW TABLES`
+	listTablesPostgres = `SELECT tablename FROM pg_catalog.pg_tables WHERE schemaname = 'public'`
+
+	createSchemaVersionTableSQL = `CREATE TABLE schema_version(db_name VARCHAR(255) NOT NULL, ` +
+		`creation_time %v, ` +
+		`curr_version VARCHAR(64), ` +
+		`min_compatible_version VARCHAR(64), ` +
+		`PRIMARY KEY (db_name));`
+
+	createSchemaUpdateHistoryTableSQL = `CREATE TABLE schema_update_history(` +
+		`year int NOT NULL, ` +
+		`month int NOT NULL, ` +
+		`update_time %v NOT NULL, ` +
+		`description VARCHAR(255), ` +
+		`manifest_md5 VARCHAR(64), ` +
+		`new_version VARCHAR(64), ` +
+		`old_version VARCHAR(64), ` +
+		`PRIMARY KEY (year, month, update_time));`
+)
+
+// newSQLClient returns a new instance of SQLClient connected to the given
+// database, an empty database name connects to the server without selecting one
+func newSQLClient(cfg *BaseConfig, database string) (SQLClient, error) {
+	var dsn string
+	switch cfg.DriverName {
+	case driverMySQL:
+		dsn = fmt.Sprintf("%v:%v@tcp(%v:%v)/%v?parseTime=true&multiStatements=true",
+			cfg.SQLUser, cfg.SQLPassword, cfg.SQLHost, cfg.SQLPort, database)
+	case driverPostgres:
+		if database == "" {
+			database = "postgres"
+		}
+		dsn = fmt.Sprintf("postgres://%v:%v@%v:%v/%v?sslmode=disable",
+			cfg.SQLUser, cfg.SQLPassword, cfg.SQLHost, cfg.SQLPort, database)
+	default:
+		return nil, fmt.Errorf("unsupported sql driver: %v", cfg.DriverName)
+	}
+
+	db, err := sql.Open(cfg.DriverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqlClient{db: db, driverName: cfg.DriverName, database: database}, nil
+}
+
+// CreateDatabase creates a database if it doesn't exist
+func (client *sqlClient) CreateDatabase(name string) error {
+	if client.driverName == driverPostgres {
+		// postgres has no IF NOT EXISTS for databases
+		var exists bool
+		err := client.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM pg_database WHERE datname = $1)`, name).
+			Scan(&exists)
+		if err != nil || exists {
+			return err
+		}
+		return client.Exec(fmt.Sprintf("CREATE DATABASE %v", name))
+	}
+	return client.Exec(fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %v", name))
+}
+
+// ListTables lists the table names in the database
+func (client *sqlClient) ListTables() ([]string, error) {
+	query := listTablesMySQL
+	if client.driverName == driverPostgres {
+		query = listTablesPostgres
+	}
+	rows, err := client.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+// DropTable drops a given table from the database
+func (client *sqlClient) DropTable(name string) error {
+	return client.Exec(fmt.Sprintf("DROP TABLE %v", name))
+}
+
+// DropDatabase drops a database
+func (client *sqlClient) DropDatabase(name string) error {
+	return client.Exec(fmt.Sprintf("DROP DATABASE IF EXISTS %v", name))
+}
+
+// CreateSchemaVersionTables sets up the schema version tables
+func (client *sqlClient) CreateSchemaVersionTables() error {
+	timeType := "DATETIME(6)"
+	if client.driverName == driverPostgres {
+		timeType = "TIMESTAMP"
+	}
+	if err := client.Exec(fmt.Sprintf(createSchemaVersionTableSQL, timeType)); err != nil {
+		return err
+	}
+	return client.Exec(fmt.Sprintf(createSchemaUpdateHistoryTableSQL, timeType))
+}
+
+// ReadSchemaVersion returns the current schema version for the database
+func (client *sqlClient) ReadSchemaVersion() (string, error) {
+	var version string
+	if err := client.db.QueryRow(client.rebind(readSchemaVersionSQL), client.database).Scan(&version); err != nil {
+		if err == sql.ErrNoRows {
+			return "", errGetSchemaVersion
+		}
+		return "", err
+	}
+	return version, nil
+}
+
+// UpdateSchemaVersion updates the schema version for the database
+func (client *sqlClient) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	tx, err := client.db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(client.rebind(deleteSchemaVersionSQL), client.database); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec(client.rebind(writeSchemaVersionSQL), client.database, time.Now().UTC(), newVersion,
+		minCompatibleVersion); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// WriteSchemaUpdateLog adds an entry to the schema update history table
+func (client *sqlClient) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	now := time.Now().UTC()
+	_, err := client.db.Exec(client.rebind(writeSchemaUpdateHistorySQL), now.Year(), int(now.Month()), now,
+		oldVersion, newVersion, manifestMD5, desc)
+	return err
+}
+
+// Exec executes a sql statement
+func (client *sqlClient) Exec(stmt string) error {
+	_, err := client.db.Exec(stmt)
+	return err
+}
+
+// Close closes the sql client
+func (client *sqlClient) Close() {
+	if client.db != nil {
+		client.db.Close()
+	}
+}
+
+// rebind converts ? placeholders to the $n format used by postgres
+func (client *sqlClient) rebind(query string) string {
+	if client.driverName != driverPostgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, c := range query {
+		if c == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// ParseSQLFile takes a sql file path as input
+// and returns an array of sql statements on
+// success.
+func ParseSQLFile(filePath string) ([]string, error) {
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+
+	var line string
+	var currStmt string
+	var stmts = make([]string, 0, 4)
+
+	for err == nil {
+
+		line, err = reader.ReadString(newLineDelim)
+		line = strings.TrimSpace(line)
+		if len(line) < 1 {
+			continue
+		}
+
+		// Filter out the comment lines, the
+		// only recognized comment line format
+		// is any line that starts with double dashes
+		tokens := strings.Split(line, "--")
+		if len(tokens) > 0 && len(strings.TrimSpace(tokens[0])) > 0 {
+			if len(currStmt) > 0 {
+				currStmt += " "
+			}
+			currStmt += strings.TrimSpace(tokens[0])
+			// semi-colon is the end of statement delim
+			if strings.HasSuffix(currStmt, ";") {
+				stmts = append(stmts, currStmt)
+				currStmt = ""
+			}
+		}
+	}
+
+	if err == io.EOF {
+		return stmts, nil
+	}
+
+	return nil, err
+}
+
+// dropAllTables deletes all tables in the
+// database without deleting the database
+func dropAllTables(client SQLClient) {
+	tables, err := client.ListTables()
+	if err != nil {
+		return
+	}
+	log.Printf("Dropping following tables: %v\n", tables)
+	for _, table := range tables {
+		err1 := client.DropTable(table)
+		if err1 != nil {
+			log.Printf("Error dropping table %v, err=%v\n", table, err1)
+		}
+	}
+}
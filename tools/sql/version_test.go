@@ -0,0 +1,117 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	VersionTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestVersionTestSuite(t *testing.T) {
+	suite.Run(t, new(VersionTestSuite))
+}
+
+func (s *VersionTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *VersionTestSuite) TestCmpVersion() {
+	s.Equal(0, cmpVersion("0.1", "0.1"))
+	s.True(cmpVersion("0.5", "0.1") > 0)
+	s.True(cmpVersion("1.1", "0.9") > 0)
+	s.True(cmpVersion("0.1", "1.1") < 0)
+}
+
+func (s *VersionTestSuite) TestParseValidateVersion() {
+	inputs := []string{"0", "1000", "0.1", "99.9"}
+	for _, in := range inputs {
+		s.execParseValidateTest(in, in, false)
+		s.execParseValidateTest("v"+in, in, false)
+	}
+
+	errInputs := []string{"1.2a", "ab", "0.88"}
+	for _, in := range errInputs {
+		s.execParseValidateTest(in, "", true)
+		s.execParseValidateTest("v"+in, "", true)
+	}
+}
+
+func (s *VersionTestSuite) TestGetExpectedVersion() {
+	root, err := ioutil.TempDir("", "sqlVersionTest")
+	s.Nil(err)
+	defer os.RemoveAll(root)
+
+	_, err = getExpectedVersion(root)
+	s.NotNil(err)
+
+	for _, dir := range []string{"v0.1", "v0.10", "v1.2", "foo"} {
+		s.Nil(os.Mkdir(path.Join(root, dir), os.FileMode(0755)))
+	}
+	// v0.10 is not a valid version dir since minor versions are single digit
+	ver, err := getExpectedVersion(root)
+	s.Nil(err)
+	s.Equal("1.2", ver)
+}
+
+func (s *VersionTestSuite) TestParseSQLFile() {
+	f, err := ioutil.TempFile("", "sqlParseTest")
+	s.Nil(err)
+	defer os.Remove(f.Name())
+
+	content := "-- comment line\n" +
+		"CREATE TABLE foo (\n" +
+		"  id BIGINT NOT NULL, -- inline comment\n" +
+		"  PRIMARY KEY (id)\n" +
+		");\n\n" +
+		"CREATE INDEX foo_idx ON foo (id);\n"
+	_, err = f.WriteString(content)
+	s.Nil(err)
+	s.Nil(f.Close())
+
+	stmts, err := ParseSQLFile(f.Name())
+	s.Nil(err)
+	s.Equal([]string{
+		"CREATE TABLE foo ( id BIGINT NOT NULL, PRIMARY KEY (id) );",
+		"CREATE INDEX foo_idx ON foo (id);",
+	}, stmts)
+}
+
+func (s *VersionTestSuite) execParseValidateTest(input string, output string, isErr bool) {
+	ver, err := parseValidateVersion(input)
+	if isErr {
+		s.NotNil(err)
+		return
+	}
+	s.Nil(err)
+	s.Equal(output, ver)
+}